@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"time"
+
+	"multitrackticketing/config"
+	"multitrackticketing/internal/adapters/email"
+	"multitrackticketing/internal/doctor"
+	"multitrackticketing/internal/repository/postgres"
+)
+
+// runDoctor runs the startup self-check (`api doctor`) and returns the exit code: 1 when a check
+// fails, 2 on bad flags. It prints the report instead of starting the server, so it can run
+// against a new deployment's environment before the first start.
+func runDoctor(args []string) int {
+	fs := flag.NewFlagSet("doctor", flag.ContinueOnError)
+	migrationsDir := fs.String("migrations", "migrations", "directory holding the migration files")
+	timeout := fs.Duration("timeout", 10*time.Second, "how long each check may take")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	// Config warnings (such as a missing .env) would interleave with the report.
+	cfg, err := config.Load(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "doctor: load config:", err)
+		return 1
+	}
+	db, err := sql.Open("postgres", cfg.DBUrl)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "doctor: open database:", err)
+		return 1
+	}
+	defer db.Close()
+
+	results := doctor.Run(context.Background(), []doctor.Check{
+		doctor.ConfigCheck(cfg),
+		doctor.DatabaseCheck(db),
+		doctor.MigrationsCheck(postgres.NewSchemaRepository(db), os.DirFS(*migrationsDir)),
+		doctor.EmailCheck(email.MailerConfig{
+			Provider:    cfg.Email.Provider,
+			FromAddress: cfg.Email.FromAddress,
+			FromName:    cfg.Email.FromName,
+			SES: email.SESConfig{
+				Region:             cfg.Email.SES.Region,
+				AccessKeyID:        cfg.Email.SES.AccessKeyID,
+				SecretAccessKey:    cfg.Email.SES.SecretAccessKey,
+				InsecureSkipVerify: cfg.Email.SES.InsecureSkipVerify,
+			},
+		}),
+		doctor.BlobStorageCheck(),
+	}, *timeout)
+	doctor.Print(os.Stdout, results)
+	if doctor.Failed(results) {
+		return 1
+	}
+	return 0
+}
@@ -1,9 +1,12 @@
 package main
 
 import (
+	"context"
 	"database/sql"
+	"log/slog"
 	"net/http"
 	"os"
+	"slices"
 	"time"
 
 	_ "github.com/lib/pq"
@@ -11,12 +14,21 @@ import (
 	"multitrackticketing/config"
 	_ "multitrackticketing/docs" // This will be generated by swag init
 	"multitrackticketing/internal/adapters/auth"
+	"multitrackticketing/internal/adapters/captcha"
+	"multitrackticketing/internal/adapters/cdn"
 	"multitrackticketing/internal/adapters/email"
+	"multitrackticketing/internal/adapters/enrichment"
+	"multitrackticketing/internal/adapters/faults"
+	"multitrackticketing/internal/adapters/images"
+	"multitrackticketing/internal/adapters/sentry"
 	"multitrackticketing/internal/adapters/sessionize"
 	httpDelivery "multitrackticketing/internal/delivery/http"
 	"multitrackticketing/internal/delivery/http/controllers"
 	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+	"multitrackticketing/internal/repository/instrumented"
 	"multitrackticketing/internal/repository/postgres"
+	"multitrackticketing/internal/repository/shadow"
 	"multitrackticketing/internal/services"
 )
 
@@ -29,7 +41,13 @@ import (
 // @in header
 // @name Authorization
 func main() {
-	logger := config.NewLogger()
+	// `api doctor` checks the deployment and exits instead of serving.
+	if len(os.Args) > 1 && os.Args[1] == "doctor" {
+		os.Exit(runDoctor(os.Args[2:]))
+	}
+
+	// Lines logged for a request made with X-Act-As name the acting admin.
+	logger := slog.New(middleware.ActorLogHandler(config.NewLogger().Handler()))
 
 	// 1. Configuration
 	cfg, err := config.Load(logger)
@@ -52,17 +70,95 @@ func main() {
 	}
 	logger.Info("connected to database")
 
+	anonymizationKey := cfg.AnonymizationKey
+	if anonymizationKey == "" {
+		if cfg.Environment == "production" {
+			logger.Error("ANONYMIZATION_KEY is required in production")
+			os.Exit(1)
+		}
+		anonymizationKey = "dev-anonymization-key-change-in-production"
+	}
+	trustedProxies, err := config.ParseTrustedProxies(cfg.TrustedProxies)
+	if err != nil {
+		logger.Error("invalid trusted proxies", "err", err)
+		os.Exit(1)
+	}
+
 	// 3. Init Layers
-	eventRepo := postgres.NewEventRepository(db)
-	sessionRepo := postgres.NewSessionRepository(db)
-	tagRepo := postgres.NewTagRepository(db)
-	eventTeamMemberRepo := postgres.NewEventTeamMemberRepository(db)
-	eventInvitationRepo := postgres.NewEventInvitationRepository(db)
-	eventRegistrationRepo := postgres.NewEventRegistrationRepository(db)
-	userRepo := postgres.NewUserRepository(db)
-	roleRepo := postgres.NewRoleRepository(db)
-	loginCodeRepo := postgres.NewLoginCodeRepository(db)
-	sessionizeFetcher := sessionize.NewHTTPFetcher(nil)
+	// Every repository call is timed; the report is served on the debug listener (PPROF_ADDR).
+	queryRecorder := instrumented.NewRecorder(cfg.SlowQueryThreshold, instrumented.DefaultSlowCapacity)
+	eventRepo := instrumented.NewEventRepository(postgres.NewEventRepository(db, []byte(anonymizationKey)), queryRecorder)
+	sessionRepo := instrumented.NewSessionRepository(postgres.NewSessionRepository(db), queryRecorder)
+	// With SHADOW_DATABASE_URL a sample of the event and session reads also runs against a second
+	// database, and differing results are logged, to check a replacement before switching to it.
+	if cfg.ShadowDatabaseURL != "" {
+		shadowDB, err := sql.Open("postgres", cfg.ShadowDatabaseURL)
+		if err != nil {
+			logger.Error("failed to open shadow database", "err", err)
+			os.Exit(1)
+		}
+		defer shadowDB.Close()
+		if err := shadowDB.Ping(); err != nil {
+			logger.Warn("shadow database unreachable; its failed reads will be logged as divergences", "err", err)
+		}
+		comparer := shadow.NewComparer(logger, cfg.ShadowReadSampleRate, cfg.ShadowReadTimeout)
+		eventRepo = shadow.NewEventRepository(eventRepo, postgres.NewEventRepository(shadowDB, []byte(anonymizationKey)), comparer)
+		sessionRepo = shadow.NewSessionRepository(sessionRepo, postgres.NewSessionRepository(shadowDB), comparer)
+		logger.Warn("shadow reads enabled", "sample_rate", cfg.ShadowReadSampleRate)
+	}
+	tagRepo := instrumented.NewTagRepository(postgres.NewTagRepository(db), queryRecorder)
+	eventTeamMemberRepo := instrumented.NewEventTeamMemberRepository(postgres.NewEventTeamMemberRepository(db), queryRecorder)
+	eventInvitationRepo := instrumented.NewEventInvitationRepository(postgres.NewEventInvitationRepository(db), queryRecorder)
+	eventRegistrationRepo := instrumented.NewEventRegistrationRepository(postgres.NewEventRegistrationRepository(db), queryRecorder)
+	userRepo := instrumented.NewUserRepository(postgres.NewUserRepository(db), queryRecorder)
+	roleRepo := instrumented.NewRoleRepository(postgres.NewRoleRepository(db), queryRecorder)
+	loginCodeRepo := instrumented.NewLoginCodeRepository(postgres.NewLoginCodeRepository(db), queryRecorder)
+	importMappingRepo := instrumented.NewImportMappingRepository(postgres.NewImportMappingRepository(db), queryRecorder)
+	speakerMergeRepo := instrumented.NewSpeakerMergeRepository(postgres.NewSpeakerMergeRepository(db), queryRecorder)
+	integrityRepo := instrumented.NewIntegrityRepository(postgres.NewIntegrityRepository(db), queryRecorder)
+	scheduleRulesRepo := instrumented.NewScheduleRulesRepository(postgres.NewScheduleRulesRepository(db), queryRecorder)
+	operatingHoursRepo := instrumented.NewOperatingHoursRepository(postgres.NewOperatingHoursRepository(db), queryRecorder)
+	sessionChangeRepo := instrumented.NewSessionChangeRepository(postgres.NewSessionChangeRepository(db), queryRecorder)
+	checklistRepo := instrumented.NewChecklistRepository(postgres.NewChecklistRepository(db), queryRecorder)
+	scheduleGridRepo := instrumented.NewScheduleGridRepository(postgres.NewScheduleGridRepository(db), queryRecorder)
+	syncRepo := instrumented.NewSyncRepository(postgres.NewSyncRepository(db), queryRecorder)
+	customFieldRepo := instrumented.NewCustomFieldRepository(postgres.NewCustomFieldRepository(db), queryRecorder)
+	retentionRepo := instrumented.NewRetentionRepository(postgres.NewRetentionRepository(db, []byte(anonymizationKey)), queryRecorder)
+	eventEmailRepo := instrumented.NewEventEmailRepository(postgres.NewEventEmailRepository(db), queryRecorder)
+	announcementRepo := instrumented.NewAnnouncementRepository(postgres.NewAnnouncementRepository(db), queryRecorder)
+	contactRepo := instrumented.NewContactRepository(postgres.NewContactRepository(db), queryRecorder)
+	abuseReportRepo := instrumented.NewAbuseReportRepository(postgres.NewAbuseReportRepository(db), queryRecorder)
+	publicPageRepo := instrumented.NewPublicPageRepository(postgres.NewPublicPageRepository(db), queryRecorder)
+	ipAllowlistRepo := instrumented.NewIPAllowlistRepository(postgres.NewIPAllowlistRepository(db), queryRecorder)
+	machineClientRepo := instrumented.NewMachineClientRepository(postgres.NewMachineClientRepository(db), queryRecorder)
+	userActivityRepo := instrumented.NewUserActivityRepository(postgres.NewUserActivityRepository(db), queryRecorder)
+	eventDeletionRepo := instrumented.NewEventDeletionRepository(postgres.NewEventDeletionRepository(db), queryRecorder)
+	exhibitorRepo := instrumented.NewExhibitorRepository(postgres.NewExhibitorRepository(db), queryRecorder)
+	leadRepo := instrumented.NewLeadRepository(postgres.NewLeadRepository(db), queryRecorder)
+	// During a rolling deploy the database may be migrated past what this build's queries know;
+	// the guard then refuses writes from requests and background jobs until a newer build takes over.
+	schemaGuard := services.NewSchemaGuard(instrumented.NewSchemaRepository(postgres.NewSchemaRepository(db), queryRecorder), postgres.SchemaVersion, cfg.SchemaCheckInterval)
+	// With FAULT_INJECTION the email provider, Sessionize and picture downloads can be slowed down
+	// or failed from the debug listener, to exercise retries and fallbacks in staging.
+	var faultInjector *faults.Injector
+	var faultToggles domain.FaultInjector
+	if cfg.FaultInjection {
+		if cfg.Environment == "production" {
+			logger.Error("FAULT_INJECTION is not allowed in production")
+			os.Exit(1)
+		}
+		faultInjector = faults.NewInjector()
+		faultToggles = faultInjector
+		logger.Warn("fault injection enabled: dependency faults are set on the debug listener", "faults_addr", cfg.PprofAddr)
+	}
+	var sessionizeHTTP domain.SessionFetcher = sessionize.NewHTTPFetcher(nil)
+	var thumbnailFetcher domain.ThumbnailFetcher = images.NewThumbnailFetcher(nil)
+	if faultInjector != nil {
+		// Faults go under the resilient fetcher so its retries and circuit breaker see them.
+		sessionizeHTTP = faults.SessionFetcher(sessionizeHTTP, faultInjector)
+		thumbnailFetcher = faults.ThumbnailFetcher(thumbnailFetcher, faultInjector)
+	}
+	sessionizeFetcher := sessionize.NewResilientFetcher(sessionizeHTTP, sessionize.ResilienceConfig{})
 
 	mailerCfg := email.MailerConfig{
 		Provider:    cfg.Email.Provider,
@@ -75,18 +171,59 @@ func main() {
 			InsecureSkipVerify: cfg.Email.SES.InsecureSkipVerify,
 		},
 	}
-	mailer, err := email.NewMailer(mailerCfg)
-	if err != nil {
-		logger.Error("failed to create mailer", "err", err)
-		os.Exit(1)
+	// With EMAIL_PROVIDER=sandbox messages are recorded instead of sent and read back from the debug
+	// listener's mailbox; it exposes login codes, so it is refused in production.
+	var mailer domain.Mailer
+	var mailbox domain.Mailbox
+	if cfg.Email.Provider == "sandbox" {
+		if cfg.Environment == "production" {
+			logger.Error("EMAIL_PROVIDER=sandbox is not allowed in production")
+			os.Exit(1)
+		}
+		sandbox := email.NewSandboxMailer(email.DefaultSandboxCapacity)
+		mailer, mailbox = sandbox, sandbox
+		logger.Warn("email sandbox enabled: messages are recorded, not sent", "mailbox_addr", cfg.PprofAddr)
+	} else {
+		mailer, err = email.NewMailer(mailerCfg)
+		if err != nil {
+			logger.Error("failed to create mailer", "err", err)
+			os.Exit(1)
+		}
+	}
+	if faultInjector != nil {
+		mailer = faults.Mailer(mailer, faultInjector)
 	}
+	// The sending domain's SPF, DKIM and DMARC records are checked once at startup and on demand by
+	// event owners and on the debug listener, so deliverability problems show up before a large invitation send.
+	var emailDomainChecker domain.EmailDomainChecker
+	if cfg.Email.Provider == "ses" {
+		emailDomainChecker, err = email.NewDomainChecker(nil, email.DomainCheckConfig{
+			FromAddress:   cfg.Email.FromAddress,
+			DKIMSelectors: cfg.Email.DKIMSelectors,
+			SPFInclude:    cfg.Email.SPFInclude,
+		})
+		if err != nil {
+			logger.Warn("email domain check disabled", "err", err)
+		} else {
+			go checkEmailDomain(logger, emailDomainChecker)
+		}
+	}
+	// Invitations and registrations are counted per event and served to Prometheus on the debug
+	// listener, with the active registrations read from the database at scrape time.
+	businessMetrics := services.NewBusinessMetrics(eventRegistrationRepo, cfg.MetricsMaxEvents, 10*time.Second)
 	templateRenderer := email.NewTemplateRenderer()
-	emailService := services.NewEmailService(mailer, templateRenderer)
+	emailService := services.NewEmailService(mailer, templateRenderer, eventEmailRepo, businessMetrics, eventRepo)
 
-	manageScheduleService := services.NewEventService(eventRepo, sessionRepo, tagRepo, eventTeamMemberRepo, userRepo, eventInvitationRepo, emailService, sessionizeFetcher, 10*time.Second)
+	eventCodeFormat := domain.EventCodeFormat{Length: cfg.EventCodeLength, Alphabet: cfg.EventCodeAlphabet}
+	manageScheduleService := services.NewEventService(eventRepo, sessionRepo, tagRepo, eventTeamMemberRepo, userRepo, eventInvitationRepo, importMappingRepo, speakerMergeRepo, integrityRepo, scheduleRulesRepo, operatingHoursRepo, sessionChangeRepo, checklistRepo, scheduleGridRepo, customFieldRepo, emailService, sessionizeFetcher, services.SchedulePolicy{Tolerance: cfg.ScheduleTimeTolerance}, eventCodeFormat, 10*time.Second)
 	scheduleController := controllers.NewScheduleController(logger, manageScheduleService)
-	attendeeService := services.NewAttendeeService(eventRepo, eventRegistrationRepo, sessionRepo)
+	roomOccupancyRepo := instrumented.NewRoomOccupancyRepository(postgres.NewRoomOccupancyRepository(db), queryRecorder)
+	eventDocumentRepo := instrumented.NewEventDocumentRepository(postgres.NewEventDocumentRepository(db), queryRecorder)
+	sessionStreamRepo := instrumented.NewSessionStreamRepository(postgres.NewSessionStreamRepository(db), queryRecorder)
+	attendeeService := services.NewAttendeeService(eventRepo, eventRegistrationRepo, sessionRepo, operatingHoursRepo, sessionChangeRepo, syncRepo, customFieldRepo, thumbnailFetcher, abuseReportRepo, businessMetrics, images.NewCardRenderer(), publicPageRepo, roomOccupancyRepo, eventDocumentRepo, sessionStreamRepo)
 	attendeeController := controllers.NewAttendeeController(logger, attendeeService)
+	attendeeController.PublicBaseURL = cfg.PublicBaseURL
+	attendeeController.PublicSiteURL = cfg.PublicSiteURL
 
 	jwtSecret := cfg.JWTSecret
 	if jwtSecret == "" {
@@ -100,13 +237,130 @@ func main() {
 
 	userService := services.NewUserService(userRepo, roleRepo, loginCodeRepo, jwtAuth, cfg.JWTExpiry, emailService)
 	userController := controllers.NewUserController(logger, userService)
-	requireAuth := middleware.RequireAuth(jwtAuth, logger)
+	announcementService := services.NewAnnouncementService(announcementRepo, roleRepo, 10*time.Second)
+	announcementController := controllers.NewAnnouncementController(logger, announcementService)
+	contactService := services.NewContactService(eventRepo, eventTeamMemberRepo, userRepo, contactRepo, emailService, domain.ContactLimit{Max: cfg.ContactRateLimit, Window: cfg.ContactRateWindow}, 10*time.Second)
+	contactController := controllers.NewContactController(logger, contactService)
+	var purger domain.CachePurger
+	if cfg.CDNPurgeURL != "" {
+		purger = cdn.NewPurger(cfg.CDNPurgeURL, cfg.CDNPurgeToken, nil)
+	}
+	abuseReportService := services.NewAbuseReportService(eventRepo, sessionRepo, abuseReportRepo, roleRepo, purger, domain.ModerationPolicy{Threshold: cfg.AbuseReportThreshold, UnlistFor: cfg.AbuseUnlistDuration}, 10*time.Second)
+	abuseReportController := controllers.NewAbuseReportController(logger, abuseReportService)
+	ipAllowlistService := services.NewIPAllowlistService(ipAllowlistRepo, userRepo, machineClientRepo, roleRepo, 5*time.Second)
+	ipAllowlistController := controllers.NewIPAllowlistController(logger, ipAllowlistService)
+	machineClientService := services.NewMachineClientService(machineClientRepo, eventRepo, roleRepo, jwtAuth, eventCodeFormat, 5*time.Second)
+	machineClientController := controllers.NewMachineClientController(logger, machineClientService)
+	activityService := services.NewActivityService(userActivityRepo, userRepo, roleRepo, 5*time.Second)
+	activityController := controllers.NewActivityController(logger, activityService)
+	eventDeletionService := services.NewEventDeletionService(manageScheduleService, eventRepo, eventDeletionRepo, userRepo, roleRepo, emailService, 10*time.Second)
+	eventDeletionController := controllers.NewEventDeletionController(logger, eventDeletionService)
+	exhibitorService := services.NewExhibitorService(eventRepo, eventTeamMemberRepo, userRepo, eventRegistrationRepo, sessionRepo, operatingHoursRepo, exhibitorRepo, leadRepo, 10*time.Second)
+	exhibitorController := controllers.NewExhibitorController(logger, exhibitorService)
+	// Session suggestions need ENRICHMENT_API_URL; without it they answer 503.
+	var sessionEnricher domain.SessionEnricher
+	if cfg.EnrichmentAPIURL != "" {
+		sessionEnricher = enrichment.NewEnricher(cfg.EnrichmentAPIURL, cfg.EnrichmentAPIKey, cfg.EnrichmentModel, nil)
+	}
+	enrichmentService := services.NewEnrichmentService(manageScheduleService, eventRepo, sessionRepo, tagRepo, sessionEnricher, 45*time.Second)
+	enrichmentController := controllers.NewEnrichmentController(logger, enrichmentService)
+	warmupRepo := instrumented.NewInvitationWarmupRepository(postgres.NewInvitationWarmupRepository(db), queryRecorder)
+	warmupService := services.NewInvitationWarmupService(manageScheduleService, eventRepo, warmupRepo, emailDomainChecker, 10*time.Second)
+	warmupController := controllers.NewInvitationWarmupController(logger, warmupService)
+	roomOccupancyService := services.NewRoomOccupancyService(eventRepo, sessionRepo, roomOccupancyRepo, userRepo, emailService, 10*time.Second)
+	occupancyController := controllers.NewRoomOccupancyController(logger, roomOccupancyService)
+	eventDocumentService := services.NewEventDocumentService(eventRepo, eventDocumentRepo, 10*time.Second)
+	documentController := controllers.NewEventDocumentController(logger, eventDocumentService)
+	speakerPhotoArchiveRepo := instrumented.NewSpeakerPhotoArchiveRepository(postgres.NewSpeakerPhotoArchiveRepository(db), queryRecorder)
+	speakerPhotoService := services.NewSpeakerPhotoService(eventRepo, sessionRepo, speakerPhotoArchiveRepo, images.NewPictureFetcher(nil), 10*time.Second)
+	speakerPhotoController := controllers.NewSpeakerPhotoController(logger, speakerPhotoService)
+	sessionStreamService := services.NewSessionStreamService(eventRepo, sessionRepo, sessionStreamRepo, 10*time.Second)
+	sessionStreamController := controllers.NewSessionStreamController(logger, sessionStreamService)
+	// Accounts with an IP allowlist may only be used from its ranges. The caller's own list applies
+	// before an admin acts as another user with X-Act-As.
+	authenticate, allowIP, actAs := middleware.RequireAuth(jwtAuth, logger), middleware.RequireAllowedIP(ipAllowlistService, logger), middleware.ActAs(activityService, logger)
+	requireAuth := func(next http.HandlerFunc) http.HandlerFunc { return authenticate(allowIP(actAs(next))) }
+	var captchaVerifier domain.CaptchaVerifier
+	if cfg.CaptchaVerifyURL != "" {
+		captchaVerifier = captcha.NewVerifier(cfg.CaptchaVerifyURL, cfg.CaptchaSecret, nil)
+	}
+	challengePolicies := make(map[string]domain.ChallengePolicy)
+	for _, endpoint := range cfg.BotChallenge.HoneypotEndpoints {
+		p := challengePolicies[endpoint]
+		p.Honeypot = true
+		challengePolicies[endpoint] = p
+	}
+	for _, endpoint := range cfg.BotChallenge.CaptchaEndpoints {
+		p := challengePolicies[endpoint]
+		p.Captcha = true
+		challengePolicies[endpoint] = p
+	}
+	for endpoint := range challengePolicies {
+		if !slices.Contains(domain.ChallengeEndpoints, endpoint) {
+			logger.Warn("unknown bot challenge endpoint ignored", "endpoint", endpoint)
+		}
+	}
+	challengeRecorder := middleware.NewChallengeRecorder()
+	botChallenge := middleware.BotChallenge(challengePolicies, cfg.BotChallenge.HoneypotField, captchaVerifier, challengeRecorder, logger)
+	const day = 24 * time.Hour
+	retentionService := services.NewRetentionService(retentionRepo, []domain.RetentionPolicy{
+		{DataClass: domain.RetentionSessionChanges, Retention: time.Duration(cfg.Retention.SessionChangesDays) * day},
+		{DataClass: domain.RetentionLoginCodes, Retention: time.Duration(cfg.Retention.LoginCodesDays) * day},
+		{DataClass: domain.RetentionPendingInvitations, Retention: time.Duration(cfg.Retention.PendingInvitationsDays) * day},
+		{DataClass: domain.RetentionResolvedSpeakerMatches, Retention: time.Duration(cfg.Retention.ResolvedSpeakerMatchesDays) * day},
+		{DataClass: domain.RetentionSandboxEvents, Retention: time.Duration(cfg.Retention.SandboxEventsDays) * day},
+		{DataClass: domain.RetentionEventPersonalData, Retention: time.Duration(cfg.Retention.EventPersonalDataDays) * day},
+		{DataClass: domain.RetentionEventEmails, Retention: time.Duration(cfg.Retention.EventEmailsDays) * day},
+		{DataClass: domain.RetentionSpeakerPhotoArchives, Retention: time.Duration(cfg.Retention.SpeakerPhotoArchivesDays) * day},
+	}, time.Minute)
+	metaController := controllers.NewMetaController(logger, postgres.NewReadinessChecker(db), sessionizeFetcher, schemaGuard)
 
 	// 4. Router
-	mux := httpDelivery.NewRouter(scheduleController, userController, attendeeController, requireAuth)
-	handler := middleware.CORS(cfg.CORSOrigins, middleware.LoggingMiddleware(logger, mux))
+	limitBody := middleware.LimitBody(map[string]int64{
+		httpDelivery.BodyClassDefault: cfg.MaxRequestBodyBytes,
+		httpDelivery.BodyClassBulk:    cfg.MaxBulkRequestBodyBytes,
+	})
+	withDeadline := middleware.RequestDeadline(map[string]time.Duration{
+		httpDelivery.DeadlineClassDefault: cfg.RequestTimeout,
+		httpDelivery.DeadlineClassLong:    cfg.LongRequestTimeout,
+	}, logger)
+	mux := httpDelivery.NewRouter(scheduleController, userController, attendeeController, metaController, announcementController, contactController, abuseReportController, ipAllowlistController, machineClientController, activityController, eventDeletionController, exhibitorController, enrichmentController, warmupController, occupancyController, documentController, speakerPhotoController, sessionStreamController, requireAuth, middleware.RequireScope(jwtAuth, ipAllowlistService, logger), middleware.PurgeEventCache(purger, logger), botChallenge, limitBody, withDeadline)
+	// Panics are logged, counted on the debug listener and, with SENTRY_DSN, reported to Sentry.
+	panicRecorder := middleware.NewPanicRecorder()
+	var errorReporter domain.ErrorReporter
+	if cfg.SentryDSN != "" {
+		errorReporter, err = sentry.NewReporter(cfg.SentryDSN, cfg.Environment, nil)
+		if err != nil {
+			logger.Error("invalid sentry dsn", "err", err)
+			os.Exit(1)
+		}
+	}
+	// RequireJSON only turns away bodies no route accepts; each route applies its own class's limit.
+	// Recover sits right outside it so the router sets the route pattern on the request it sees.
+	// The client's address is resolved once, before anything logs or checks it.
+	handler := middleware.CORS(cfg.CORSOrigins, middleware.SecurityHeaders(middleware.RequestID(middleware.ResolveClientIP(trustedProxies, middleware.LoggingMiddleware(logger, middleware.Recover(logger, panicRecorder, errorReporter, middleware.GuardSchema(schemaGuard, logger, middleware.RequireJSON(max(cfg.MaxRequestBodyBytes, cfg.MaxBulkRequestBodyBytes), mux))))))))
 
 	// 5. Server
+	if cfg.PprofAddr != "" {
+		go func() {
+			logger.Info("debug server starting", "addr", cfg.PprofAddr)
+			if err := http.ListenAndServe(cfg.PprofAddr, httpDelivery.NewDebugHandler(queryRecorder, retentionService, mailbox, emailDomainChecker, challengeRecorder, panicRecorder, businessMetrics, faultToggles)); err != nil {
+				logger.Error("debug server failed", "err", err)
+			}
+		}()
+	}
+	if cfg.IntegrityCheckInterval > 0 {
+		go runIntegrityChecks(logger, services.NewIntegrityChecker(integrityRepo, time.Minute), postgres.NewJobLocker(db), schemaGuard, cfg.IntegrityCheckInterval)
+	}
+	if cfg.Retention.PurgeInterval > 0 {
+		go runRetentionPurges(logger, retentionService, postgres.NewJobLocker(db), schemaGuard, cfg.Retention.PurgeInterval)
+	}
+	if cfg.InvitationWarmupInterval > 0 {
+		go runInvitationWarmups(logger, warmupService, postgres.NewJobLocker(db), schemaGuard, cfg.InvitationWarmupInterval)
+	}
+	if cfg.SpeakerPhotoArchiveInterval > 0 {
+		go runSpeakerPhotoArchives(logger, speakerPhotoService, postgres.NewJobLocker(db), schemaGuard, cfg.SpeakerPhotoArchiveInterval)
+	}
 	port := ":" + cfg.Port
 	logger.Info("server starting", "port", port)
 	if err := http.ListenAndServe(port, handler); err != nil {
@@ -114,3 +368,151 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// runIntegrityChecks checks every event once per interval and logs the events with issues. Owners
+// fix them through the cleanup endpoint; the check itself only deletes tags nothing references.
+// With several replicas, the one holding the "integrity-check" job lock runs each sweep. Sweeps
+// are skipped while guard refuses writes.
+func runIntegrityChecks(logger *slog.Logger, checker domain.IntegrityChecker, locker domain.JobLocker, guard domain.SchemaGuard, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if err := guard.AllowWrites(context.Background()); err != nil {
+			logger.Warn("integrity check skipped", "err", err)
+			continue
+		}
+		var sweep *domain.IntegritySweep
+		ran, err := services.RunExclusive(context.Background(), locker, "integrity-check", func(ctx context.Context) error {
+			var err error
+			sweep, err = checker.CheckAll(ctx)
+			return err
+		})
+		if err != nil {
+			logger.Error("integrity check failed", "err", err)
+		}
+		if !ran {
+			if err == nil {
+				logger.Info("integrity check skipped: another replica is running it")
+			}
+			continue
+		}
+		if sweep == nil {
+			continue
+		}
+		for _, report := range sweep.Reports {
+			logger.Warn("integrity issues found", "event_id", report.EventID, "issues", len(report.Issues), "fixable", report.Fixable)
+		}
+		logger.Info("integrity check finished", "events", sweep.EventsChecked, "events_with_issues", len(sweep.Reports), "orphan_tags_deleted", sweep.OrphanTagsDeleted)
+	}
+}
+
+// checkEmailDomain logs a warning for every missing or invalid email DNS record of the sender's domain.
+func checkEmailDomain(logger *slog.Logger, checker domain.EmailDomainChecker) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	report, err := checker.CheckEmailDomain(ctx, false)
+	if err != nil {
+		logger.Warn("email domain check failed", "err", err)
+		return
+	}
+	for _, rec := range report.Records {
+		if rec.Status == domain.EmailRecordMissing || rec.Status == domain.EmailRecordInvalid {
+			logger.Warn("email domain record "+rec.Status, "domain", report.Domain, "kind", rec.Kind, "name", rec.Name, "problem", rec.Problem)
+		}
+	}
+}
+
+// runRetentionPurges deletes the data older than its retention policy once per interval. Purges
+// are skipped while guard refuses writes.
+func runRetentionPurges(logger *slog.Logger, retention domain.RetentionService, locker domain.JobLocker, guard domain.SchemaGuard, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if err := guard.AllowWrites(context.Background()); err != nil {
+			logger.Warn("retention purge skipped", "err", err)
+			continue
+		}
+		var report *domain.RetentionReport
+		ran, err := services.RunExclusive(context.Background(), locker, "retention-purge", func(ctx context.Context) error {
+			var err error
+			report, err = retention.Purge(ctx)
+			return err
+		})
+		if err != nil {
+			logger.Error("retention purge failed", "err", err)
+		}
+		if !ran {
+			if err == nil {
+				logger.Info("retention purge skipped: another replica is running it")
+			}
+			continue
+		}
+		if report == nil {
+			continue
+		}
+		for _, class := range report.Classes {
+			if class.DataClass == domain.RetentionEventPersonalData {
+				logger.Info("events anonymized by retention policy", "audit", "event.anonymize", "cutoff", class.Cutoff, "events", class.Rows)
+				continue
+			}
+			logger.Info("retention purge finished", "data_class", class.DataClass, "cutoff", class.Cutoff, "deleted", class.Rows)
+		}
+	}
+}
+
+// runInvitationWarmups sends the invitations active warm-ups have due once per interval. With
+// several replicas, the one holding the "invitation-warmup" job lock sends them. Sends are skipped
+// while guard refuses writes.
+func runInvitationWarmups(logger *slog.Logger, warmups domain.InvitationWarmupService, locker domain.JobLocker, guard domain.SchemaGuard, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if err := guard.AllowWrites(context.Background()); err != nil {
+			logger.Warn("invitation warm-up skipped", "err", err)
+			continue
+		}
+		var run *domain.WarmupRun
+		ran, err := services.RunExclusive(context.Background(), locker, "invitation-warmup", func(ctx context.Context) error {
+			var err error
+			run, err = warmups.SendDue(ctx)
+			return err
+		})
+		if err != nil {
+			logger.Error("invitation warm-up failed", "err", err)
+		}
+		if !ran || run == nil || run.Sent+run.Failed+run.Skipped+run.Completed == 0 {
+			continue
+		}
+		logger.Info("invitation warm-up sent", "warmups", run.Warmups, "sent", run.Sent, "failed", run.Failed, "skipped", run.Skipped, "completed", run.Completed)
+	}
+}
+
+// runSpeakerPhotoArchives builds the pending speaker photo archives once per interval and logs the
+// photos that could not be downloaded. With several replicas, the one holding the
+// "speaker-photo-archives" job lock builds them. Builds are skipped while guard refuses writes.
+func runSpeakerPhotoArchives(logger *slog.Logger, photos domain.SpeakerPhotoService, locker domain.JobLocker, guard domain.SchemaGuard, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if err := guard.AllowWrites(context.Background()); err != nil {
+			logger.Warn("speaker photo archives skipped", "err", err)
+			continue
+		}
+		var built int
+		ctx, failures := domain.WithBestEffortFailures(context.Background())
+		_, err := services.RunExclusive(ctx, locker, "speaker-photo-archives", func(ctx context.Context) error {
+			var err error
+			built, err = photos.BuildPendingArchives(ctx)
+			return err
+		})
+		if err != nil {
+			logger.Error("speaker photo archives failed", "err", err)
+		}
+		for _, err := range failures.Errors() {
+			logger.Warn("speaker photo skipped", "err", err)
+		}
+		if built > 0 {
+			logger.Info("speaker photo archives built", "archives", built)
+		}
+	}
+}
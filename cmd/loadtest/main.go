@@ -0,0 +1,116 @@
+// Command loadtest runs the load scenarios in internal/loadtest against a running API and
+// exits non-zero when any scenario exceeds its performance budget.
+//
+// Usage:
+//
+//	go run ./cmd/loadtest -base-url http://localhost:8080 -token "$TOKEN" -event-id "$EVENT_ID" -duration 30s -concurrency 16
+//
+// The token must belong to the owner of the event. Run against a throwaway database with
+// EMAIL_PROVIDER=noop: the send-invitations scenario inserts one invitation per request.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"multitrackticketing/internal/loadtest"
+)
+
+func main() {
+	baseURL := flag.String("base-url", "http://localhost:8080", "API origin")
+	token := flag.String("token", os.Getenv("LOADTEST_TOKEN"), "bearer token of the event owner (default $LOADTEST_TOKEN)")
+	eventID := flag.String("event-id", os.Getenv("LOADTEST_EVENT_ID"), "event used by the scenarios (default $LOADTEST_EVENT_ID)")
+	duration := flag.Duration("duration", 30*time.Second, "how long each scenario runs")
+	concurrency := flag.Int("concurrency", 16, "concurrent workers per scenario")
+	warmup := flag.Int("warmup", 5, "unmeasured warmup requests per scenario")
+	only := flag.String("scenarios", "", "comma-separated scenario names to run (default all)")
+	budgetsPath := flag.String("budgets", "", "JSON file overriding the default budgets")
+	jsonOut := flag.Bool("json", false, "print results as JSON instead of a table")
+	flag.Parse()
+
+	if err := run(*baseURL, *token, *eventID, *only, *budgetsPath, *jsonOut, loadtest.Options{
+		Duration:    *duration,
+		Concurrency: *concurrency,
+		Warmup:      *warmup,
+	}); err != nil {
+		fmt.Fprintln(os.Stderr, "loadtest:", err)
+		os.Exit(1)
+	}
+}
+
+func run(baseURL, token, eventID, only, budgetsPath string, jsonOut bool, opts loadtest.Options) error {
+	if eventID == "" {
+		return fmt.Errorf("-event-id is required")
+	}
+	scenarios, err := selectScenarios(loadtest.DefaultScenarios(), only)
+	if err != nil {
+		return err
+	}
+	if budgetsPath != "" {
+		budgets, err := loadtest.LoadBudgets(budgetsPath)
+		if err != nil {
+			return err
+		}
+		for i := range scenarios {
+			if b, ok := budgets[scenarios[i].Name]; ok {
+				scenarios[i].Budget = b
+			}
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	client := &http.Client{
+		Timeout:   10 * time.Second,
+		Transport: &http.Transport{MaxIdleConnsPerHost: opts.Concurrency},
+	}
+	target := loadtest.Target{BaseURL: baseURL, Token: token, EventID: eventID}
+	var results []loadtest.Result
+	for _, sc := range scenarios {
+		results = append(results, loadtest.Run(ctx, client, target, sc, opts))
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	if jsonOut {
+		err = loadtest.WriteJSON(os.Stdout, results)
+	} else {
+		err = loadtest.WriteText(os.Stdout, results)
+	}
+	if err != nil {
+		return err
+	}
+	for _, r := range results {
+		if len(r.Failures) > 0 {
+			return fmt.Errorf("performance budget exceeded")
+		}
+	}
+	return nil
+}
+
+func selectScenarios(all []loadtest.Scenario, only string) ([]loadtest.Scenario, error) {
+	if only == "" {
+		return all, nil
+	}
+	byName := make(map[string]loadtest.Scenario, len(all))
+	for _, sc := range all {
+		byName[sc.Name] = sc
+	}
+	var out []loadtest.Scenario
+	for _, name := range strings.Split(only, ",") {
+		sc, ok := byName[strings.TrimSpace(name)]
+		if !ok {
+			return nil, fmt.Errorf("unknown scenario %q", name)
+		}
+		out = append(out, sc)
+	}
+	return out, nil
+}
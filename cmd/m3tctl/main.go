@@ -0,0 +1,174 @@
+// Command m3tctl is a command-line companion to the API for organizers.
+//
+// Usage:
+//
+//	go run ./cmd/m3tctl validate schedule [-event-id ID -base-url URL -token TOKEN] file.json
+//
+// validate schedule checks a Sessionize "All" export (the JSON the import downloads) with the same
+// checks POST /events/{eventID}/schedule/validate runs after an import: room overlaps, speaker
+// clashes, sessions ending before they start, and, with -event-id, the event's schedule rules,
+// operating hours and room renames, fetched through the API with the owner's token. Nothing is
+// imported. It exits non-zero when the file cannot be read or any check finds a problem.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"multitrackticketing/internal/domain"
+	"multitrackticketing/internal/services"
+	"multitrackticketing/sdk/go/m3t"
+)
+
+const usage = `usage: m3tctl validate schedule [flags] file.json
+
+Run "m3tctl validate schedule -h" for the flags.`
+
+// errProblems reports that the checks ran and found problems; they are already printed.
+var errProblems = errors.New("the schedule has problems")
+
+func main() {
+	args := os.Args[1:]
+	if len(args) < 2 || args[0] != "validate" || args[1] != "schedule" {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err := validateSchedule(args[2:], os.Stdout); err != nil {
+		if !errors.Is(err, errProblems) {
+			fmt.Fprintln(os.Stderr, "m3tctl:", err)
+		}
+		os.Exit(1)
+	}
+}
+
+func validateSchedule(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("validate schedule", flag.ExitOnError)
+	eventID := fs.String("event-id", "", "check against this event's schedule rules, operating hours and import mapping")
+	baseURL := fs.String("base-url", "http://localhost:8080", "API origin, used with -event-id")
+	token := fs.String("token", os.Getenv("M3T_TOKEN"), "bearer token of the event owner, used with -event-id (default $M3T_TOKEN)")
+	tolerance := fs.Duration("tolerance", 30*time.Second, "clock skew to allow, as the server's SCHEDULE_TIME_TOLERANCE")
+	jsonOut := fs.Bool("json", false, "print the result as JSON instead of text")
+	_ = fs.Parse(args)
+	if fs.NArg() != 1 {
+		return fmt.Errorf("expected one schedule file, got %d arguments", fs.NArg())
+	}
+
+	data, err := readExport(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	rules := domain.NewScheduleRules(*eventID)
+	var days []*domain.OperatingDay
+	var mapping *domain.ImportMapping
+	if *eventID != "" {
+		client := m3t.NewClient(*baseURL, m3t.WithToken(*token), m3t.WithHTTPClient(&http.Client{Timeout: 10 * time.Second}))
+		if rules, days, mapping, err = fetchEventSettings(ctx, client, *eventID); err != nil {
+			return err
+		}
+	}
+
+	policy := services.SchedulePolicy{Tolerance: *tolerance}
+	v, notes, err := policy.ValidateSessionizeExport(*eventID, data, mapping, rules, days)
+	if err != nil {
+		return err
+	}
+	if *jsonOut {
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(struct {
+			*domain.ScheduleValidation
+			Notes []string `json:"notes"`
+		}{v, notes})
+	} else {
+		err = writeText(out, v, notes)
+	}
+	if err != nil {
+		return err
+	}
+	if !v.Valid {
+		return errProblems
+	}
+	return nil
+}
+
+// readExport decodes a Sessionize export the way the import does, so a file it rejects would fail
+// the import too.
+func readExport(path string) (domain.SessionFetcherResponse, error) {
+	var data domain.SessionFetcherResponse
+	f, err := os.Open(path)
+	if err != nil {
+		return data, err
+	}
+	defer f.Close()
+	if err := json.NewDecoder(f).Decode(&data); err != nil {
+		return domain.SessionFetcherResponse{}, fmt.Errorf("%s is not a Sessionize export: %w", path, err)
+	}
+	return data, nil
+}
+
+// fetchEventSettings fetches what the event's validation depends on besides its schedule.
+func fetchEventSettings(ctx context.Context, client *m3t.Client, eventID string) (*domain.ScheduleRules, []*domain.OperatingDay, *domain.ImportMapping, error) {
+	apiRules, err := client.GetScheduleRules(ctx, eventID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("get schedule rules: %w", err)
+	}
+	apiDays, err := client.GetOperatingHours(ctx, eventID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("get operating hours: %w", err)
+	}
+	apiMapping, err := client.GetImportMapping(ctx, eventID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("get import mapping: %w", err)
+	}
+	// The client's types mirror the API's JSON, which is the domain types' JSON.
+	var rules *domain.ScheduleRules
+	var days []*domain.OperatingDay
+	var mapping *domain.ImportMapping
+	for _, c := range []struct{ from, to any }{{apiRules, &rules}, {apiDays, &days}, {apiMapping, &mapping}} {
+		b, err := json.Marshal(c.from)
+		if err == nil {
+			err = json.Unmarshal(b, c.to)
+		}
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("read event settings: %w", err)
+		}
+	}
+	if rules == nil {
+		rules = domain.NewScheduleRules(eventID)
+	}
+	return rules, days, mapping, nil
+}
+
+func writeText(out io.Writer, v *domain.ScheduleValidation, notes []string) error {
+	fmt.Fprintf(out, "checked %d sessions: %d problems\n", v.SessionsChecked, v.ProblemCount)
+	for _, category := range domain.ValidationCategories {
+		problems := v.Categories[category]
+		if len(problems) == 0 {
+			continue
+		}
+		fmt.Fprintf(out, "\n%s (%d)\n", category, len(problems))
+		for _, p := range problems {
+			fmt.Fprintf(out, "  %s\n", p.Message)
+		}
+	}
+	if len(notes) > 0 {
+		fmt.Fprintf(out, "\nimport notes (%d)\n", len(notes))
+		for _, n := range notes {
+			fmt.Fprintf(out, "  %s\n", n)
+		}
+	}
+	_, err := fmt.Fprintln(out)
+	return err
+}
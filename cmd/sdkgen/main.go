@@ -0,0 +1,64 @@
+// Command sdkgen generates the Go and TypeScript API clients from the Swagger document.
+//
+// Usage:
+//
+//	go run ./cmd/sdkgen -spec docs/swagger.json -go sdk/go/m3t/client.go -ts sdk/typescript/src/client.ts
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"multitrackticketing/internal/sdkgen"
+)
+
+func main() {
+	specPath := flag.String("spec", "docs/swagger.json", "path to the Swagger 2.0 JSON document")
+	goOut := flag.String("go", "sdk/go/m3t/client.go", "output file for the Go client (empty to skip)")
+	goPkg := flag.String("go-package", "m3t", "package name of the Go client")
+	tsOut := flag.String("ts", "sdk/typescript/src/client.ts", "output file for the TypeScript client (empty to skip)")
+	flag.Parse()
+
+	if err := run(*specPath, *goOut, *goPkg, *tsOut); err != nil {
+		fmt.Fprintln(os.Stderr, "sdkgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(specPath, goOut, goPkg, tsOut string) error {
+	spec, err := sdkgen.LoadSpec(specPath)
+	if err != nil {
+		return err
+	}
+	if goOut != "" {
+		src, err := sdkgen.GenerateGo(spec, goPkg)
+		if err != nil {
+			return err
+		}
+		if err := writeFile(goOut, src); err != nil {
+			return err
+		}
+	}
+	if tsOut != "" {
+		src, err := sdkgen.GenerateTypeScript(spec)
+		if err != nil {
+			return err
+		}
+		if err := writeFile(tsOut, src); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create %s: %w", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	return nil
+}
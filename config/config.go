@@ -1,9 +1,12 @@
 package config
 
 import (
+	"fmt"
 	"log/slog"
+	"net/netip"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -16,6 +19,10 @@ type EmailConfig struct {
 	FromAddress string
 	FromName    string
 	SES         SESConfig
+	// DKIMSelectors are the DKIM selectors of the sender's domain, checked with its SPF and DMARC
+	// records. SPFInclude, when set, must be included by its SPF record.
+	DKIMSelectors []string
+	SPFInclude    string
 }
 
 // SESConfig holds AWS SES configuration.
@@ -26,6 +33,34 @@ type SESConfig struct {
 	InsecureSkipVerify bool
 }
 
+// RetentionConfig holds how long each data class is kept, in days; 0 keeps it forever.
+type RetentionConfig struct {
+	// PurgeInterval is how often expired data is deleted. Zero disables the purge.
+	PurgeInterval              time.Duration
+	SessionChangesDays         int
+	LoginCodesDays             int
+	PendingInvitationsDays     int
+	ResolvedSpeakerMatchesDays int
+	// EventPersonalDataDays is how long after its date an event is anonymized.
+	EventPersonalDataDays int
+	EventEmailsDays       int
+	// SandboxEventsDays is how long after creation a sandbox event is deleted.
+	SandboxEventsDays int
+	// SpeakerPhotoArchivesDays is how long after it is requested a speaker photo archive is deleted.
+	SpeakerPhotoArchivesDays int
+}
+
+// BotChallengeConfig holds which public endpoints are screened for bots. Endpoints are named
+// contact, report, login and registration.
+type BotChallengeConfig struct {
+	// HoneypotField is the body field forms render hidden from people; requests that fill it in are
+	// rejected. Empty disables the honeypot.
+	HoneypotField     string
+	HoneypotEndpoints []string
+	// CaptchaEndpoints require a captcha token; they are only checked when CaptchaVerifyURL is set.
+	CaptchaEndpoints []string
+}
+
 // Config holds all configuration for the application
 type Config struct {
 	DBUrl       string
@@ -34,7 +69,95 @@ type Config struct {
 	JWTSecret   string
 	JWTExpiry   time.Duration
 	CORSOrigins []string
-	Email       EmailConfig
+	// TrustedProxies are the addresses and CIDR ranges of the load balancers and proxies in front of
+	// the API. Only requests from them have their X-Forwarded-For header read for the client's
+	// address; with none, the connection's address is used. See ParseTrustedProxies.
+	TrustedProxies []string
+	Email          EmailConfig
+	// PprofAddr is the listen address of the operator debug server (e.g. "localhost:6060") serving
+	// pprof and the repository query report. Empty disables it. Keep it off public interfaces: the
+	// endpoints are unauthenticated.
+	PprofAddr string
+	// SlowQueryThreshold is the latency at which a repository call is kept in the slow-query report.
+	SlowQueryThreshold time.Duration
+	// IntegrityCheckInterval is how often every event is checked for orphaned or inconsistent data.
+	// Zero disables the periodic check.
+	IntegrityCheckInterval time.Duration
+	// InvitationWarmupInterval is how often the invitations due in active invitation warm-ups are
+	// sent. Zero stops warm-ups from sending.
+	InvitationWarmupInterval time.Duration
+	// SpeakerPhotoArchiveInterval is how often pending speaker photo archives are built. Zero stops
+	// large events' photo exports from completing.
+	SpeakerPhotoArchiveInterval time.Duration
+	// ScheduleTimeTolerance is how far client-sent session times may fall outside schedule rules and
+	// operating hours, to absorb clock skew.
+	ScheduleTimeTolerance time.Duration
+	Retention             RetentionConfig
+	// AnonymizationKey is the secret anonymized invitation emails are hashed with (HMAC-SHA256), so
+	// the hashes cannot be matched against a list of known addresses. Required in production.
+	AnonymizationKey string
+	// CDNPurgeURL receives a purge request, with the surrogate keys to evict, whenever an event
+	// changes. Empty disables purging. CDNPurgeToken authenticates it.
+	CDNPurgeURL   string
+	CDNPurgeToken string
+	// CaptchaVerifyURL is the siteverify endpoint captcha tokens are checked with (e.g.
+	// https://challenges.cloudflare.com/turnstile/v0/siteverify or https://api.hcaptcha.com/siteverify).
+	// Empty disables the captcha. CaptchaSecret authenticates it.
+	CaptchaVerifyURL string
+	CaptchaSecret    string
+	BotChallenge     BotChallengeConfig
+	// SentryDSN is the Sentry project recovered panics are reported to. Empty only logs and counts
+	// them.
+	SentryDSN string
+	// EnrichmentAPIURL is the OpenAI-compatible chat completions endpoint session tags and summaries
+	// are suggested with, using EnrichmentModel. Empty disables suggestions. EnrichmentAPIKey
+	// authenticates it.
+	EnrichmentAPIURL string
+	EnrichmentAPIKey string
+	EnrichmentModel  string
+	// ContactRateLimit is how many contact form messages one address, or one sender email, may send
+	// per ContactRateWindow. Zero disables the limit.
+	ContactRateLimit  int
+	ContactRateWindow time.Duration
+	// AbuseReportThreshold is how many different addresses must report a piece of public content
+	// before it is unlisted for AbuseUnlistDuration, pending review. Zero never unlists automatically.
+	AbuseReportThreshold int
+	AbuseUnlistDuration  time.Duration
+	// MaxRequestBodyBytes is the largest request body most routes accept; larger ones get 413.
+	// MaxBulkRequestBodyBytes applies instead to imports and bulk edits.
+	MaxRequestBodyBytes     int64
+	MaxBulkRequestBodyBytes int64
+	// RequestTimeout is how long most requests may run before their queries are canceled and they
+	// get 504. LongRequestTimeout applies instead to imports, bulk mail and streamed lists. Zero
+	// disables the deadline.
+	RequestTimeout     time.Duration
+	LongRequestTimeout time.Duration
+	// SchemaCheckInterval is how often the database's migration version is read to decide whether
+	// this build may still write to it.
+	SchemaCheckInterval time.Duration
+	// MetricsMaxEvents is how many events get their own event_id label in each business metric on
+	// the debug listener's /metrics; the rest are summed under "other". Zero labels none.
+	MetricsMaxEvents int
+	// FaultInjection installs the operator fault toggles on the email provider, the Sessionize
+	// fetcher and picture downloads, set on the debug listener's /debug/faults. Refused in production.
+	FaultInjection bool
+	// ShadowDatabaseURL, when set, is a second database the event and session reads are also run
+	// against, for ShadowReadSampleRate (0 to 1) of the calls, each waiting at most ShadowReadTimeout.
+	// Differing results are logged. Writes never go to it.
+	ShadowDatabaseURL    string
+	ShadowReadSampleRate float64
+	ShadowReadTimeout    time.Duration
+	// PublicBaseURL is the origin clients reach the API at, e.g. "https://api.example.com". Links the
+	// API hands out for others to fetch, such as session preview images, are made absolute with it.
+	PublicBaseURL string
+	// PublicSiteURL is the origin of the public attendee site, whose pages are the canonical URLs of
+	// the sitemap and SEO metadata.
+	PublicSiteURL string
+	// EventCodeLength (4 to 16) and EventCodeAlphabet are the shape of the codes generated for new
+	// events and on regeneration. The alphabet is at least 10 distinct lowercase letters or digits;
+	// codes already handed out keep working whatever the setting.
+	EventCodeLength   int
+	EventCodeAlphabet string
 }
 
 // Load loads configuration from environment variables.
@@ -64,7 +187,132 @@ func Load(logger *slog.Logger) (*Config, error) {
 		}
 	}
 
-	corsOrigins := parseCORSOrigins(os.Getenv("CORS_ORIGINS"))
+	slowQueryThreshold := 100 * time.Millisecond
+	if s := os.Getenv("SLOW_QUERY_THRESHOLD"); s != "" {
+		if d, err := time.ParseDuration(s); err == nil && d > 0 {
+			slowQueryThreshold = d
+		}
+	}
+
+	integrityCheckInterval := 6 * time.Hour
+	if s := os.Getenv("INTEGRITY_CHECK_INTERVAL"); s != "" {
+		if d, err := time.ParseDuration(s); err == nil && d >= 0 {
+			integrityCheckInterval = d
+		}
+	}
+
+	invitationWarmupInterval := 5 * time.Minute
+	if s := os.Getenv("INVITATION_WARMUP_INTERVAL"); s != "" {
+		if d, err := time.ParseDuration(s); err == nil && d >= 0 {
+			invitationWarmupInterval = d
+		}
+	}
+
+	speakerPhotoArchiveInterval := 30 * time.Second
+	if s := os.Getenv("SPEAKER_PHOTO_ARCHIVE_INTERVAL"); s != "" {
+		if d, err := time.ParseDuration(s); err == nil && d >= 0 {
+			speakerPhotoArchiveInterval = d
+		}
+	}
+
+	scheduleTimeTolerance := 30 * time.Second
+	if s := os.Getenv("SCHEDULE_TIME_TOLERANCE"); s != "" {
+		if d, err := time.ParseDuration(s); err == nil && d >= 0 {
+			scheduleTimeTolerance = d
+		}
+	}
+
+	retentionPurgeInterval := 24 * time.Hour
+	if s := os.Getenv("RETENTION_PURGE_INTERVAL"); s != "" {
+		if d, err := time.ParseDuration(s); err == nil && d >= 0 {
+			retentionPurgeInterval = d
+		}
+	}
+
+	contactRateLimit := 5
+	if n, err := strconv.Atoi(strings.TrimSpace(os.Getenv("CONTACT_RATE_LIMIT"))); err == nil && n >= 0 {
+		contactRateLimit = n
+	}
+	contactRateWindow := time.Hour
+	if s := os.Getenv("CONTACT_RATE_WINDOW"); s != "" {
+		if d, err := time.ParseDuration(s); err == nil && d > 0 {
+			contactRateWindow = d
+		}
+	}
+
+	abuseReportThreshold := 3
+	if n, err := strconv.Atoi(strings.TrimSpace(os.Getenv("ABUSE_REPORT_THRESHOLD"))); err == nil && n >= 0 {
+		abuseReportThreshold = n
+	}
+	abuseUnlistDuration := 72 * time.Hour
+	if s := os.Getenv("ABUSE_UNLIST_DURATION"); s != "" {
+		if d, err := time.ParseDuration(s); err == nil && d > 0 {
+			abuseUnlistDuration = d
+		}
+	}
+
+	maxRequestBodyBytes := int64(1 << 20)
+	if n, err := strconv.ParseInt(strings.TrimSpace(os.Getenv("MAX_REQUEST_BODY_BYTES")), 10, 64); err == nil && n > 0 {
+		maxRequestBodyBytes = n
+	}
+	maxBulkRequestBodyBytes := int64(16 << 20)
+	if n, err := strconv.ParseInt(strings.TrimSpace(os.Getenv("MAX_BULK_REQUEST_BODY_BYTES")), 10, 64); err == nil && n > 0 {
+		maxBulkRequestBodyBytes = n
+	}
+
+	requestTimeout := 15 * time.Second
+	if s := os.Getenv("REQUEST_TIMEOUT"); s != "" {
+		if d, err := time.ParseDuration(s); err == nil && d >= 0 {
+			requestTimeout = d
+		}
+	}
+	longRequestTimeout := 2 * time.Minute
+	if s := os.Getenv("LONG_REQUEST_TIMEOUT"); s != "" {
+		if d, err := time.ParseDuration(s); err == nil && d >= 0 {
+			longRequestTimeout = d
+		}
+	}
+
+	schemaCheckInterval := 30 * time.Second
+	if s := os.Getenv("SCHEMA_CHECK_INTERVAL"); s != "" {
+		if d, err := time.ParseDuration(s); err == nil && d > 0 {
+			schemaCheckInterval = d
+		}
+	}
+	eventCodeLength := 4
+	if n, err := strconv.Atoi(strings.TrimSpace(os.Getenv("EVENT_CODE_LENGTH"))); err == nil && n >= 4 && n <= 16 {
+		eventCodeLength = n
+	}
+	metricsMaxEvents := 100
+	if n, err := strconv.Atoi(strings.TrimSpace(os.Getenv("METRICS_MAX_EVENTS"))); err == nil && n >= 0 {
+		metricsMaxEvents = n
+	}
+	shadowReadSampleRate := 0.01
+	if f, err := strconv.ParseFloat(strings.TrimSpace(os.Getenv("SHADOW_READ_SAMPLE_RATE")), 64); err == nil && f >= 0 && f <= 1 {
+		shadowReadSampleRate = f
+	}
+	shadowReadTimeout := 2 * time.Second
+	if s := os.Getenv("SHADOW_READ_TIMEOUT"); s != "" {
+		if d, err := time.ParseDuration(s); err == nil && d > 0 {
+			shadowReadTimeout = d
+		}
+	}
+
+	// Setting a list to the empty string turns that challenge off everywhere.
+	honeypotField, ok := os.LookupEnv("BOT_HONEYPOT_FIELD")
+	if !ok {
+		honeypotField = "website"
+	}
+	honeypotEndpoints := []string{"contact", "report", "login"}
+	if s, ok := os.LookupEnv("BOT_HONEYPOT_ENDPOINTS"); ok {
+		honeypotEndpoints = parseList(s)
+	}
+	captchaEndpoints := []string{"contact", "report"}
+	if s, ok := os.LookupEnv("BOT_CAPTCHA_ENDPOINTS"); ok {
+		captchaEndpoints = parseList(s)
+	}
+
+	corsOrigins := parseList(os.Getenv("CORS_ORIGINS"))
 	if len(corsOrigins) == 0 {
 		corsOrigins = []string{"https://m3tadminfe-7h545.sevalla.app"}
 	}
@@ -74,16 +322,68 @@ func Load(logger *slog.Logger) (*Config, error) {
 		emailProvider = "noop"
 	}
 	cfg := &Config{
-		Environment: env,
-		DBUrl:       os.Getenv("DATABASE_URL"),
-		Port:        os.Getenv("PORT"),
-		JWTSecret:   os.Getenv("JWT_SECRET"),
-		JWTExpiry:   jwtExpiry,
-		CORSOrigins: corsOrigins,
+		Environment:                 env,
+		DBUrl:                       os.Getenv("DATABASE_URL"),
+		Port:                        os.Getenv("PORT"),
+		JWTSecret:                   os.Getenv("JWT_SECRET"),
+		AnonymizationKey:            os.Getenv("ANONYMIZATION_KEY"),
+		JWTExpiry:                   jwtExpiry,
+		CORSOrigins:                 corsOrigins,
+		TrustedProxies:              parseList(os.Getenv("TRUSTED_PROXIES")),
+		PprofAddr:                   os.Getenv("PPROF_ADDR"),
+		SlowQueryThreshold:          slowQueryThreshold,
+		IntegrityCheckInterval:      integrityCheckInterval,
+		InvitationWarmupInterval:    invitationWarmupInterval,
+		SpeakerPhotoArchiveInterval: speakerPhotoArchiveInterval,
+		ScheduleTimeTolerance:       scheduleTimeTolerance,
+		CDNPurgeURL:                 os.Getenv("CDN_PURGE_URL"),
+		CDNPurgeToken:               os.Getenv("CDN_PURGE_TOKEN"),
+		CaptchaVerifyURL:            os.Getenv("CAPTCHA_VERIFY_URL"),
+		CaptchaSecret:               os.Getenv("CAPTCHA_SECRET"),
+		SentryDSN:                   strings.TrimSpace(os.Getenv("SENTRY_DSN")),
+		EnrichmentAPIURL:            strings.TrimSpace(os.Getenv("ENRICHMENT_API_URL")),
+		EnrichmentAPIKey:            os.Getenv("ENRICHMENT_API_KEY"),
+		EnrichmentModel:             strings.TrimSpace(os.Getenv("ENRICHMENT_MODEL")),
+		BotChallenge: BotChallengeConfig{
+			HoneypotField:     strings.TrimSpace(honeypotField),
+			HoneypotEndpoints: honeypotEndpoints,
+			CaptchaEndpoints:  captchaEndpoints,
+		},
+		ContactRateLimit:        contactRateLimit,
+		ContactRateWindow:       contactRateWindow,
+		AbuseReportThreshold:    abuseReportThreshold,
+		AbuseUnlistDuration:     abuseUnlistDuration,
+		MaxRequestBodyBytes:     maxRequestBodyBytes,
+		MaxBulkRequestBodyBytes: maxBulkRequestBodyBytes,
+		RequestTimeout:          requestTimeout,
+		LongRequestTimeout:      longRequestTimeout,
+		SchemaCheckInterval:     schemaCheckInterval,
+		MetricsMaxEvents:        metricsMaxEvents,
+		FaultInjection:          parseBool(os.Getenv("FAULT_INJECTION")),
+		ShadowDatabaseURL:       strings.TrimSpace(os.Getenv("SHADOW_DATABASE_URL")),
+		ShadowReadSampleRate:    shadowReadSampleRate,
+		ShadowReadTimeout:       shadowReadTimeout,
+		PublicBaseURL:           strings.TrimRight(strings.TrimSpace(os.Getenv("PUBLIC_BASE_URL")), "/"),
+		PublicSiteURL:           strings.TrimRight(strings.TrimSpace(os.Getenv("PUBLIC_SITE_URL")), "/"),
+		EventCodeLength:         eventCodeLength,
+		EventCodeAlphabet:       parseEventCodeAlphabet(os.Getenv("EVENT_CODE_ALPHABET")),
+		Retention: RetentionConfig{
+			PurgeInterval:              retentionPurgeInterval,
+			SessionChangesDays:         parseDays(os.Getenv("RETENTION_SESSION_CHANGES_DAYS"), 365),
+			LoginCodesDays:             parseDays(os.Getenv("RETENTION_LOGIN_CODES_DAYS"), 7),
+			PendingInvitationsDays:     parseDays(os.Getenv("RETENTION_PENDING_INVITATIONS_DAYS"), 0),
+			ResolvedSpeakerMatchesDays: parseDays(os.Getenv("RETENTION_RESOLVED_SPEAKER_MATCHES_DAYS"), 90),
+			EventPersonalDataDays:      parseDays(os.Getenv("RETENTION_EVENT_PERSONAL_DATA_DAYS"), 0),
+			EventEmailsDays:            parseDays(os.Getenv("RETENTION_EVENT_EMAILS_DAYS"), 180),
+			SandboxEventsDays:          parseDays(os.Getenv("RETENTION_SANDBOX_EVENTS_DAYS"), 7),
+			SpeakerPhotoArchivesDays:   parseDays(os.Getenv("RETENTION_SPEAKER_PHOTO_ARCHIVES_DAYS"), 1),
+		},
 		Email: EmailConfig{
-			Provider:    emailProvider,
-			FromAddress: os.Getenv("EMAIL_FROM_ADDRESS"),
-			FromName:    os.Getenv("EMAIL_FROM_NAME"),
+			Provider:      emailProvider,
+			FromAddress:   os.Getenv("EMAIL_FROM_ADDRESS"),
+			FromName:      os.Getenv("EMAIL_FROM_NAME"),
+			DKIMSelectors: parseList(os.Getenv("EMAIL_DKIM_SELECTORS")),
+			SPFInclude:    strings.TrimSpace(os.Getenv("EMAIL_SPF_INCLUDE")),
 			SES: SESConfig{
 				Region:             os.Getenv("AWS_SES_REGION"),
 				AccessKeyID:        os.Getenv("AWS_SES_ACCESS_KEY_ID"),
@@ -119,8 +419,33 @@ func parseBool(s string) bool {
 	}
 }
 
-// parseCORSOrigins splits a comma-separated list of origins, trims spaces, and omits empty entries.
-func parseCORSOrigins(s string) []string {
+// parseDays parses a non-negative number of days, returning def when s is empty or invalid.
+func parseDays(s string, def int) int {
+	n, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil || n < 0 {
+		return def
+	}
+	return n
+}
+
+// parseEventCodeAlphabet returns s lowercased when it is at least 10 distinct letters or digits,
+// and the letters and digits otherwise.
+func parseEventCodeAlphabet(s string) string {
+	const def = "abcdefghijklmnopqrstuvwxyz0123456789"
+	s = strings.ToLower(strings.TrimSpace(s))
+	if len(s) < 10 {
+		return def
+	}
+	for i, c := range s {
+		if !strings.ContainsRune(def, c) || strings.ContainsRune(s[:i], c) {
+			return def
+		}
+	}
+	return s
+}
+
+// parseList splits a comma-separated list (e.g. of CORS origins), trims spaces, and omits empty entries.
+func parseList(s string) []string {
 	if s == "" {
 		return nil
 	}
@@ -134,6 +459,25 @@ func parseCORSOrigins(s string) []string {
 	return out
 }
 
+// ParseTrustedProxies parses TRUSTED_PROXIES entries: CIDR ranges (e.g. 10.0.0.0/8) or single
+// addresses, which trust only themselves.
+func ParseTrustedProxies(entries []string) ([]netip.Prefix, error) {
+	out := make([]netip.Prefix, 0, len(entries))
+	for _, e := range entries {
+		if p, err := netip.ParsePrefix(e); err == nil {
+			out = append(out, p.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(e)
+		if err != nil {
+			return nil, fmt.Errorf("TRUSTED_PROXIES: %q is not an address or CIDR range", e)
+		}
+		addr = addr.Unmap()
+		out = append(out, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return out, nil
+}
+
 // setDefaultSSLMode adds sslmode=defaultMode to the Postgres URL if no sslmode is set.
 func setDefaultSSLMode(dbURL, defaultMode string) string {
 	u, err := url.Parse(dbURL)
@@ -15,26 +15,41 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/attendee/events": {
+        "/admin/announcements": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns the list of events the authenticated user is registered for, including registration metadata.",
+                "description": "Returns a paginated list of every announcement, drafts and scheduled ones first, then newest first. Only platform admins can list. Requires authentication.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "attendee"
+                    "announcements"
+                ],
+                "summary": "List all announcements",
+                "operationId": "ListAnnouncements",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get events the current user is registered for",
                 "responses": {
                     "200": {
-                        "description": "data is an array of event + registration objects",
+                        "description": "data contains items and pagination",
                         "schema": {
-                            "$ref": "#/definitions/controllers.ListMyRegisteredEventsSuccessResponse"
+                            "$ref": "#/definitions/controllers.ListAnnouncementsSuccessResponse"
                         }
                     },
                     "401": {
@@ -43,6 +58,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
+                    "403": {
+                        "description": "error.code: forbidden (not admin)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
                     "500": {
                         "description": "error.code: internal_error",
                         "schema": {
@@ -50,43 +71,41 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/attendee/events/{eventID}/registrations": {
+            },
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Registers the authenticated user as an attendee for the specified event. Idempotent: returns 201 when a new registration is created, 200 when already registered.",
+                "description": "Creates a product announcement. It shows in the changelog from published_at on; without published_at it stays a draft. Only platform admins can create. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "attendee"
+                    "announcements"
                 ],
-                "summary": "Register the current attendee for an event",
+                "summary": "Create an announcement",
+                "operationId": "CreateAnnouncement",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Event ID (UUID)",
-                        "name": "eventID",
-                        "in": "path",
-                        "required": true
+                        "description": "Announcement",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.AnnouncementRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Already registered",
-                        "schema": {
-                            "$ref": "#/definitions/controllers.RegisterForEventSuccessResponse"
-                        }
-                    },
                     "201": {
-                        "description": "New registration created",
+                        "description": "data is the created announcement",
                         "schema": {
-                            "$ref": "#/definitions/controllers.RegisterForEventSuccessResponse"
+                            "$ref": "#/definitions/controllers.AnnouncementSuccessResponse"
                         }
                     },
                     "400": {
@@ -101,8 +120,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
-                    "404": {
-                        "description": "error.code: not_found",
+                    "403": {
+                        "description": "error.code: forbidden (not admin)",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -116,35 +135,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/attendee/events/{eventID}/schedule": {
-            "get": {
+        "/admin/announcements/{announcementID}": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns the event schedule (event plus bookable rooms with nested sessions) for the specified event. Only registered attendees or the event owner may access this. Only rooms with not_bookable=false are included.",
+                "description": "Replaces the announcement's title, body, category and published_at; omitting published_at turns it back into a draft. Read marks are kept. Only platform admins can update. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "attendee"
+                    "announcements"
                 ],
-                "summary": "Get event schedule for a registered attendee",
+                "summary": "Update an announcement",
+                "operationId": "UpdateAnnouncement",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Event ID (UUID)",
-                        "name": "eventID",
+                        "description": "Announcement ID (UUID)",
+                        "name": "announcementID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Announcement",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.AnnouncementRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "data contains event and rooms (bookable only) with nested sessions",
+                        "description": "data is the updated announcement",
                         "schema": {
-                            "$ref": "#/definitions/controllers.GetEventScheduleSuccessResponse"
+                            "$ref": "#/definitions/controllers.AnnouncementSuccessResponse"
                         }
                     },
                     "400": {
@@ -160,7 +192,7 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "error.code: forbidden (not registered or owner)",
+                        "description": "error.code: forbidden (not admin)",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -178,49 +210,34 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/attendee/registrations": {
-            "post": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Registers the authenticated user as an attendee for the event with the given event_code. Idempotent: returns 201 when a new registration is created, 200 when already registered.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Deletes the announcement and every user's read mark of it. Only platform admins can delete. Requires authentication.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "attendee"
+                    "announcements"
                 ],
-                "summary": "Register for an event by event code",
+                "summary": "Delete an announcement",
+                "operationId": "DeleteAnnouncement",
                 "parameters": [
                     {
-                        "description": "Event code (4 characters)",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/controllers.RegisterForEventByCodeRequest"
-                        }
+                        "type": "string",
+                        "description": "Announcement ID (UUID)",
+                        "name": "announcementID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Already registered",
-                        "schema": {
-                            "$ref": "#/definitions/controllers.RegisterForEventSuccessResponse"
-                        }
-                    },
-                    "201": {
-                        "description": "New registration created",
-                        "schema": {
-                            "$ref": "#/definitions/controllers.RegisterForEventSuccessResponse"
-                        }
+                    "204": {
+                        "description": "No content"
                     },
                     "400": {
                         "description": "error.code: bad_request",
@@ -234,6 +251,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
+                    "403": {
+                        "description": "error.code: forbidden (not admin)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
                     "404": {
                         "description": "error.code: not_found",
                         "schema": {
@@ -249,35 +272,36 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/login/request": {
-            "post": {
-                "description": "Send a one-time login code to the given email. The code expires after a short period.",
-                "consumes": [
-                    "application/json"
+        "/admin/events/{eventID}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Deletes the event, everything in it and its attendee registrations without the owner's email confirmation. Only admins may call this. Requires authentication.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "admin"
                 ],
-                "summary": "Request a login code",
+                "summary": "Delete any event at once (admin)",
+                "operationId": "ForceDeleteEvent",
                 "parameters": [
                     {
-                        "description": "Email to receive the code",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/controllers.RequestLoginCodeRequest"
-                        }
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "success",
+                        "description": "data contains status",
                         "schema": {
-                            "$ref": "#/definitions/helpers.APIResponse"
+                            "$ref": "#/definitions/controllers.DeleteEventSuccessResponse"
                         }
                     },
                     "400": {
@@ -286,6 +310,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not an admin)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
                     "500": {
                         "description": "error.code: internal_error",
                         "schema": {
@@ -295,45 +337,37 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/login/verify": {
-            "post": {
-                "description": "Exchange the one-time code for a JWT and user. Creates the user on first successful login. Returns token, token_type, and user.",
-                "consumes": [
-                    "application/json"
+        "/admin/machine-clients": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Returns every machine client, newest first, revoked ones included. Secrets are never returned. Only platform admins can list. Requires authentication.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "auth"
                 ],
-                "summary": "Verify login code and get token",
-                "parameters": [
-                    {
-                        "description": "Email and code",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/controllers.VerifyLoginCodeRequest"
-                        }
-                    }
-                ],
+                "summary": "List machine clients",
+                "operationId": "ListMachineClients",
                 "responses": {
                     "200": {
-                        "description": "data contains token, token_type, and user",
+                        "description": "data is the clients",
                         "schema": {
-                            "$ref": "#/definitions/controllers.LoginSuccessResponse"
+                            "$ref": "#/definitions/controllers.ListMachineClientsSuccessResponse"
                         }
                     },
-                    "400": {
-                        "description": "error.code: bad_request",
+                    "401": {
+                        "description": "error.code: unauthorized",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
-                    "401": {
-                        "description": "error.code: unauthorized",
+                    "403": {
+                        "description": "error.code: forbidden (not admin) or ip_not_allowed",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -345,16 +379,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/events": {
+            },
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new conference event. Only name is accepted in the body; id, event_code and timestamps are server-generated. The authenticated user becomes the event owner.",
+                "description": "Registers an internal service (badge printer, signage) bound to one event with the given scopes. A client with the sandbox:write scope takes no event and no other scope; it creates sandbox events owned by the admin creating it. The response holds the client secret; it is shown only this once. Only platform admins can create. Requires authentication.",
                 "consumes": [
                     "application/json"
                 ],
@@ -362,29 +394,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "auth"
                 ],
-                "summary": "Create a new event",
+                "summary": "Create a machine client",
+                "operationId": "CreateMachineClient",
                 "parameters": [
                     {
-                        "description": "Event data (name only)",
-                        "name": "event",
+                        "description": "Client",
+                        "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controllers.CreateEventRequest"
+                            "$ref": "#/definitions/controllers.CreateMachineClientRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "data contains the created event",
+                        "description": "data is the client with its secret",
                         "schema": {
-                            "$ref": "#/definitions/controllers.CreateEventSuccessResponse"
+                            "$ref": "#/definitions/controllers.MachineClientCredentialsSuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "error.code: bad_request",
+                        "description": "error.code: bad_request (e.g. unknown scope)",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -395,39 +428,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
-                    "500": {
-                        "description": "error.code: internal_error",
+                    "403": {
+                        "description": "error.code: forbidden (not admin) or ip_not_allowed",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/events/me": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Returns events where the authenticated user is the owner. Requires Bearer token.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "events"
-                ],
-                "summary": "List events owned by the current user",
-                "responses": {
-                    "200": {
-                        "description": "data is an array of events",
-                        "schema": {
-                            "$ref": "#/definitions/controllers.ListMyEventsSuccessResponse"
-                        }
                     },
-                    "401": {
-                        "description": "error.code: unauthorized",
+                    "404": {
+                        "description": "error.code: event_not_found",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -441,35 +449,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/{eventID}": {
+        "/admin/machine-clients/{clientID}/ip-allowlist": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns the event, its rooms, and all sessions for that event. Requires authentication.",
+                "description": "Returns the address ranges the machine client's requests to /machine routes must come from. An empty list allows any address. Only platform admins can read it. Requires authentication.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "auth"
                 ],
-                "summary": "Get an event by ID",
+                "summary": "Get a machine client's IP allowlist",
+                "operationId": "GetMachineClientIPAllowlist",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Event ID (UUID)",
-                        "name": "eventID",
+                        "description": "Client ID (UUID)",
+                        "name": "clientID",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "data contains event, rooms, and sessions",
+                        "description": "data is the allowlist",
                         "schema": {
-                            "$ref": "#/definitions/controllers.GetEventByIDSuccessResponse"
+                            "$ref": "#/definitions/controllers.IPAllowlistSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
                     "401": {
@@ -478,6 +493,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
+                    "403": {
+                        "description": "error.code: forbidden (not admin) or ip_not_allowed",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
                     "404": {
                         "description": "error.code: not_found",
                         "schema": {
@@ -492,34 +513,53 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete an event and all its associated data (rooms, sessions). Only the event owner can delete. Requires authentication.",
+                "description": "Replaces the address ranges the machine client's requests to /machine routes must come from; a request from any other address gets 403 ip_not_allowed, even with a valid token. An empty list removes it. Changes can take up to 30 seconds to reach every server. Only platform admins can update. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "auth"
                 ],
-                "summary": "Delete an event",
+                "summary": "Replace a machine client's IP allowlist",
+                "operationId": "UpdateMachineClientIPAllowlist",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Event ID (UUID)",
-                        "name": "eventID",
+                        "description": "Client ID (UUID)",
+                        "name": "clientID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Ranges",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.IPAllowlistRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "data contains status",
+                        "description": "data is the normalized allowlist",
                         "schema": {
-                            "$ref": "#/definitions/controllers.DeleteEventSuccessResponse"
+                            "$ref": "#/definitions/controllers.IPAllowlistSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
                     "401": {
@@ -529,7 +569,7 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "error.code: forbidden (not owner)",
+                        "description": "error.code: forbidden (not admin) or ip_not_allowed",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -547,47 +587,38 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/admin/machine-clients/{clientID}/revoke": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates event date, description, and location (lat/lng). Only the event owner can update. Optional fields omitted from body are unchanged. Requires authentication.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Stops the client from getting new tokens; tokens it already has stay valid until they expire, within 15 minutes. The client is kept for the record. Only platform admins can revoke. Requires authentication.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "auth"
                 ],
-                "summary": "Update event details",
+                "summary": "Revoke a machine client",
+                "operationId": "RevokeMachineClient",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Event ID (UUID)",
-                        "name": "eventID",
+                        "description": "Client ID (UUID)",
+                        "name": "clientID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Fields to update (all optional)",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/controllers.UpdateEventRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "data contains the updated event",
+                        "description": "data is the revoked client",
                         "schema": {
-                            "$ref": "#/definitions/controllers.UpdateEventSuccessResponse"
+                            "$ref": "#/definitions/controllers.MachineClientSuccessResponse"
                         }
                     },
                     "400": {
@@ -603,7 +634,7 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "error.code: forbidden (not owner)",
+                        "description": "error.code: forbidden (not admin) or ip_not_allowed",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -623,49 +654,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/{eventID}/import/sessionize/{sessionizeID}": {
-            "post": {
+        "/admin/moderation-queue": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Import rooms and sessions from Sessionize for a specific event",
+                "description": "Returns a paginated list of the content with open abuse reports, most reported first, without the reports themselves. unlisted tells whether the content is hidden from public pages now. Only platform admins can list. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "events"
+                    "moderation"
                 ],
-                "summary": "Import schedule from Sessionize",
+                "summary": "List the moderation queue",
+                "operationId": "ListModerationQueue",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Event ID",
-                        "name": "eventID",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "description": "Page number (default 1)",
+                        "name": "page",
+                        "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Sessionize ID",
-                        "name": "sessionizeID",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "page_size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "data contains status message",
+                        "description": "data contains items and pagination",
                         "schema": {
-                            "$ref": "#/definitions/controllers.ImportSessionizeSuccessResponse"
+                            "$ref": "#/definitions/controllers.ModerationQueueSuccessResponse"
                         }
                     },
-                    "400": {
-                        "description": "error.code: bad_request",
+                    "401": {
+                        "description": "error.code: unauthorized",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
-                    "401": {
-                        "description": "error.code: unauthorized",
+                    "403": {
+                        "description": "error.code: forbidden (not admin)",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -679,53 +712,43 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/{eventID}/invitations": {
+        "/admin/moderation-queue/{targetType}/{targetID}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns a paginated list of emails invited to the event (with id and sent_at). Only the event owner can list. Use page and page_size query params. Optional search filters by email substring (case-insensitive). Requires authentication.",
+                "description": "Returns the reported content with its open reports, oldest first. Only platform admins can read it. Requires authentication.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "moderation"
                 ],
-                "summary": "List invited emails for an event",
+                "summary": "Get reported content",
+                "operationId": "GetModerationItem",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Event ID (UUID)",
-                        "name": "eventID",
+                        "description": "event, session or speaker",
+                        "name": "targetType",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Filter emails containing this string (case-insensitive)",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page number (default 1)",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page size (default 20, max 100)",
-                        "name": "page_size",
-                        "in": "query"
+                        "description": "Target ID (UUID)",
+                        "name": "targetID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "data contains items and pagination",
+                        "description": "data is the item with its reports",
                         "schema": {
-                            "$ref": "#/definitions/controllers.ListEventInvitationsSuccessResponse"
+                            "$ref": "#/definitions/controllers.ModerationItemSuccessResponse"
                         }
                     },
                     "400": {
@@ -741,13 +764,13 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "error.code: forbidden (not owner)",
+                        "description": "error.code: forbidden (not admin)",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
                     "404": {
-                        "description": "error.code: not_found",
+                        "description": "error.code: not_found (no open reports)",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -759,14 +782,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/admin/moderation-queue/{targetType}/{targetID}/resolve": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Send invitation emails to register for the event. Body contains a string of emails separated by commas or spaces. Only the event owner can invite. Each invitation is persisted and emailed; duplicates for the same event are skipped. Returns count of sent and list of failed addresses.",
+                "description": "Closes the content's open reports. dismiss lists the content again; unlist keeps it unlisted until a later dismissal. Only platform admins can resolve. Requires authentication.",
                 "consumes": [
                     "application/json"
                 ],
@@ -774,36 +799,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "moderation"
                 ],
-                "summary": "Send event invitation emails",
+                "summary": "Resolve reported content",
+                "operationId": "ResolveModerationItem",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Event ID (UUID)",
-                        "name": "eventID",
+                        "description": "event, session or speaker",
+                        "name": "targetType",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Emails string (comma or space separated)",
+                        "type": "string",
+                        "description": "Target ID (UUID)",
+                        "name": "targetID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Action",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controllers.SendEventInvitationsRequest"
+                            "$ref": "#/definitions/controllers.ResolveModerationItemRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "data contains sent count and failed list",
+                        "description": "data.status is resolved",
                         "schema": {
-                            "$ref": "#/definitions/controllers.SendEventInvitationsSuccessResponse"
+                            "$ref": "#/definitions/controllers.ResolveModerationItemSuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "error.code: bad_request (empty or no valid emails)",
+                        "description": "error.code: bad_request",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -815,13 +848,13 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "error.code: forbidden (not owner)",
+                        "description": "error.code: forbidden (not admin)",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
                     "404": {
-                        "description": "error.code: not_found",
+                        "description": "error.code: not_found (no open reports)",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -835,35 +868,36 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/{eventID}/rooms": {
+        "/admin/users/{userID}/ip-allowlist": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns the list of rooms for the event. Only the event owner can list. Requires authentication.",
+                "description": "Returns the address ranges the user's authenticated requests must come from. Only platform admins can read it. Requires authentication.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "users"
                 ],
-                "summary": "List rooms for an event",
+                "summary": "Get a user's IP allowlist",
+                "operationId": "GetUserIPAllowlist",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Event ID (UUID)",
-                        "name": "eventID",
+                        "description": "User ID (UUID)",
+                        "name": "userID",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "data is an array of rooms",
+                        "description": "data is the allowlist",
                         "schema": {
-                            "$ref": "#/definitions/controllers.ListRoomsSuccessResponse"
+                            "$ref": "#/definitions/controllers.IPAllowlistSuccessResponse"
                         }
                     },
                     "400": {
@@ -879,13 +913,13 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "error.code: forbidden (not owner)",
+                        "description": "error.code: forbidden (not admin) or ip_not_allowed",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
                     "404": {
-                        "description": "error.code: not_found",
+                        "description": "error.code: user_not_found",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -898,13 +932,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Creates a new room for the event. Only the event owner can create. Requires authentication.",
+                "description": "Replaces the address ranges the user's authenticated requests must come from, e.g. to let a locked-out user back in; an empty list removes it. Only platform admins can update. Requires authentication.",
                 "consumes": [
                     "application/json"
                 ],
@@ -912,32 +946,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "users"
                 ],
-                "summary": "Create a room",
+                "summary": "Replace a user's IP allowlist",
+                "operationId": "UpdateUserIPAllowlist",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Event ID (UUID)",
-                        "name": "eventID",
+                        "description": "User ID (UUID)",
+                        "name": "userID",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Room data",
+                        "description": "Ranges",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controllers.CreateRoomRequest"
+                            "$ref": "#/definitions/controllers.IPAllowlistRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "data contains the created room",
+                    "200": {
+                        "description": "data is the normalized allowlist",
                         "schema": {
-                            "$ref": "#/definitions/controllers.CreateRoomSuccessResponse"
+                            "$ref": "#/definitions/controllers.IPAllowlistSuccessResponse"
                         }
                     },
                     "400": {
@@ -953,13 +988,13 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "error.code: forbidden",
+                        "description": "error.code: forbidden (not admin) or ip_not_allowed",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
                     "404": {
-                        "description": "error.code: not_found",
+                        "description": "error.code: user_not_found",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -973,48 +1008,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/{eventID}/rooms/{roomID}": {
+        "/attendee/events": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns a single room for the event. Only the event owner can access. Requires authentication.",
+                "description": "Returns the list of events the authenticated user is registered for, including registration metadata.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "events"
-                ],
-                "summary": "Get a room by ID",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Event ID (UUID)",
-                        "name": "eventID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Room ID (UUID)",
-                        "name": "roomID",
-                        "in": "path",
-                        "required": true
-                    }
+                    "attendee"
                 ],
+                "summary": "Get events the current user is registered for",
+                "operationId": "ListMyRegisteredEvents",
                 "responses": {
                     "200": {
-                        "description": "data contains the room",
-                        "schema": {
-                            "$ref": "#/definitions/controllers.GetRoomSuccessResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "error.code: bad_request",
+                        "description": "data is an array of event + registration objects",
                         "schema": {
-                            "$ref": "#/definitions/helpers.APIResponse"
+                            "$ref": "#/definitions/controllers.ListMyRegisteredEventsSuccessResponse"
                         }
                     },
                     "401": {
@@ -1023,18 +1037,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
-                    "403": {
-                        "description": "error.code: forbidden (not owner)",
-                        "schema": {
-                            "$ref": "#/definitions/helpers.APIResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "error.code: not_found",
-                        "schema": {
-                            "$ref": "#/definitions/helpers.APIResponse"
-                        }
-                    },
                     "500": {
                         "description": "error.code: internal_error",
                         "schema": {
@@ -1042,21 +1044,24 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/attendee/events/{eventID}/documents": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a room and its sessions. Only the event owner can delete. Requires authentication.",
+                "description": "Returns the current version of each of the event's documents with the latest version the caller accepted. needs_acceptance is set on those to accept before registering, or again after the owner changed them. Any signed-in user can read them. Requires authentication.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "attendee"
                 ],
-                "summary": "Delete a room",
+                "summary": "List an event's documents with what I accepted",
+                "operationId": "ListMyEventDocuments",
                 "parameters": [
                     {
                         "type": "string",
@@ -1064,20 +1069,13 @@ const docTemplate = `{
                         "name": "eventID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Room ID (UUID)",
-                        "name": "roomID",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "data contains status",
+                        "description": "data is the list of documents",
                         "schema": {
-                            "$ref": "#/definitions/controllers.DeleteRoomSuccessResponse"
+                            "$ref": "#/definitions/controllers.AttendeeDocumentsSuccessResponse"
                         }
                     },
                     "400": {
@@ -1092,12 +1090,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
-                    "403": {
-                        "description": "error.code: forbidden (not owner)",
-                        "schema": {
-                            "$ref": "#/definitions/helpers.APIResponse"
-                        }
-                    },
                     "404": {
                         "description": "error.code: not_found",
                         "schema": {
@@ -1111,14 +1103,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/attendee/events/{eventID}/documents/accept": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates room details (name, capacity, description, how_to_get_there, not_bookable). Only the event owner can update. Optional fields omitted from body are unchanged (name and not_bookable keep current value when omitted). Requires authentication.",
+                "description": "Records that the caller accepts the given versions of the event's documents, with the time. Each must be the current version; accepting one again keeps the first record. Use it before POST /attendee/events/{eventID}/registrations, or when an attendee accepts a changed document. Any signed-in user can accept. Requires authentication.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1126,9 +1120,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "attendee"
                 ],
-                "summary": "Update a room",
+                "summary": "Accept an event's documents",
+                "operationId": "AcceptEventDocuments",
                 "parameters": [
                     {
                         "type": "string",
@@ -1138,31 +1133,24 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Room ID (UUID)",
-                        "name": "roomID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Room fields to update",
+                        "description": "Versions accepted",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controllers.UpdateRoomRequest"
+                            "$ref": "#/definitions/controllers.AcceptEventDocumentsRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "data contains the updated room",
+                        "description": "data is the list of documents",
                         "schema": {
-                            "$ref": "#/definitions/controllers.UpdateRoomSuccessResponse"
+                            "$ref": "#/definitions/controllers.AttendeeDocumentsSuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "error.code: bad_request",
+                        "description": "error.code: bad_request (unknown kind or not the current version)",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -1173,12 +1161,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
-                    "403": {
-                        "description": "error.code: forbidden (not owner)",
-                        "schema": {
-                            "$ref": "#/definitions/helpers.APIResponse"
-                        }
-                    },
                     "404": {
                         "description": "error.code: not_found",
                         "schema": {
@@ -1194,21 +1176,22 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/{eventID}/rooms/{roomID}/not-bookable": {
-            "patch": {
+        "/attendee/events/{eventID}/lead-consent": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Toggles the not_bookable flag for a room. Only the event owner can toggle. Requires authentication.",
+                "description": "Returns whether the caller shares their name and email with the exhibitors whose booth staff scan their lead code, and the code itself while they do. Apps show the code as a QR code. Only attendees registered for the event have one. Requires authentication.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "attendee"
                 ],
-                "summary": "Toggle room not_bookable flag",
+                "summary": "Get my lead sharing consent for an event",
+                "operationId": "GetLeadConsent",
                 "parameters": [
                     {
                         "type": "string",
@@ -1216,36 +1199,29 @@ const docTemplate = `{
                         "name": "eventID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Room ID (UUID)",
-                        "name": "roomID",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "data contains the updated room",
+                        "description": "data is the consent",
                         "schema": {
-                            "$ref": "#/definitions/controllers.ToggleRoomNotBookableSuccessResponse"
+                            "$ref": "#/definitions/controllers.LeadConsentSuccessResponse"
                         }
                     },
-                    "401": {
-                        "description": "error.code: unauthorized",
+                    "400": {
+                        "description": "error.code: bad_request",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
-                    "403": {
-                        "description": "error.code: forbidden (not owner)",
+                    "401": {
+                        "description": "error.code: unauthorized",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
-                    "404": {
-                        "description": "error.code: not_found",
+                    "403": {
+                        "description": "error.code: forbidden (not registered)",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -1257,16 +1233,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/events/{eventID}/sessions": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Creates a new session for the event in a given room and time slot, with optional tags and speakers. Only the event owner can create. Requires authentication.",
+                "description": "With consent true, the caller agrees to share their name and email with the exhibitors whose booth staff scan their lead code, and gets the code; consenting again keeps it. With consent false the code stops working, and consenting later gives a new one. Leads already scanned stay with their exhibitors. Only attendees registered for the event can consent. Requires authentication.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1274,9 +1248,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "attendee"
                 ],
-                "summary": "Create a session",
+                "summary": "Give or withdraw my lead sharing consent for an event",
+                "operationId": "SetLeadConsent",
                 "parameters": [
                     {
                         "type": "string",
@@ -1286,20 +1261,20 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Session data",
+                        "description": "Consent",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controllers.CreateSessionRequest"
+                            "$ref": "#/definitions/controllers.SetLeadConsentRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "data contains the created session",
+                    "200": {
+                        "description": "data is the consent",
                         "schema": {
-                            "$ref": "#/definitions/controllers.CreateSessionSuccessResponse"
+                            "$ref": "#/definitions/controllers.LeadConsentSuccessResponse"
                         }
                     },
                     "400": {
@@ -1315,13 +1290,7 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "error.code: forbidden (not owner)",
-                        "schema": {
-                            "$ref": "#/definitions/helpers.APIResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "error.code: not_found",
+                        "description": "error.code: forbidden (not registered)",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -1335,21 +1304,22 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/{eventID}/sessions/{sessionID}": {
-            "delete": {
+        "/attendee/events/{eventID}/registrations": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a session. Only the event owner can delete. Requires authentication.",
+                "description": "Registers the authenticated user as an attendee for the specified event. When the event has documents such as a code of conduct, the current version of each must have been accepted with POST /attendee/events/{eventID}/documents/accept first. An event unlisted after abuse reports takes no new registrations, and answers 404 like an unknown event. Idempotent: returns 201 when a new registration is created, 200 when already registered.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "attendee"
                 ],
-                "summary": "Delete a session",
+                "summary": "Register the current attendee for an event",
+                "operationId": "RegisterForEvent",
                 "parameters": [
                     {
                         "type": "string",
@@ -1357,20 +1327,19 @@ const docTemplate = `{
                         "name": "eventID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Session ID (UUID)",
-                        "name": "sessionID",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "data contains status",
+                        "description": "Already registered",
                         "schema": {
-                            "$ref": "#/definitions/controllers.DeleteRoomSuccessResponse"
+                            "$ref": "#/definitions/controllers.RegisterForEventSuccessResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "New registration created",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.RegisterForEventSuccessResponse"
                         }
                     },
                     "400": {
@@ -1385,14 +1354,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
-                    "403": {
-                        "description": "error.code: forbidden (not owner)",
+                    "404": {
+                        "description": "error.code: event_not_found",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
-                    "404": {
-                        "description": "error.code: not_found",
+                    "409": {
+                        "description": "error.code: documents_not_accepted",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -1404,24 +1373,24 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/attendee/events/{eventID}/schedule": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Moves a session to a different room and/or time slot by updating room_id, start_time, and end_time. Only the event owner can update. Optional fields omitted from body are unchanged. Requires authentication.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Returns the event schedule (event plus bookable rooms with nested sessions) for the specified event. Only registered attendees or the event owner may access this. Only rooms with not_bookable=false are included. Rooms whose door sensors or clickers have reported carry their live occupancy. Sessions carry the caption and translation stream links visible now (PUT /events/{eventID}/sessions/{sessionID}/content/streams).",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "attendee"
                 ],
-                "summary": "Update session schedule",
+                "summary": "Get event schedule for a registered attendee",
+                "operationId": "GetEventSchedule",
                 "parameters": [
                     {
                         "type": "string",
@@ -1429,29 +1398,13 @@ const docTemplate = `{
                         "name": "eventID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Session ID (UUID)",
-                        "name": "sessionID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Fields to update (all optional)",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/controllers.UpdateSessionScheduleRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "data contains the updated session",
+                        "description": "data contains event and rooms (bookable only) with nested sessions",
                         "schema": {
-                            "$ref": "#/definitions/controllers.UpdateSessionScheduleSuccessResponse"
+                            "$ref": "#/definitions/controllers.GetEventScheduleSuccessResponse"
                         }
                     },
                     "400": {
@@ -1467,13 +1420,13 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "error.code: forbidden (not owner)",
+                        "description": "error.code: forbidden (not registered or owner)",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
                     "404": {
-                        "description": "error.code: not_found",
+                        "description": "error.code: event_not_found",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -1487,24 +1440,22 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/{eventID}/sessions/{sessionID}/content": {
-            "patch": {
+        "/attendee/events/{eventID}/schedule/changes": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates a session's title and/or description. Only the event owner can update. Optional fields omitted from body are unchanged. Requires authentication.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Returns the sessions of the event that moved to another room or time, newest first, so apps and signage can show \"moved from Room A\". Pass the changed_at of the newest change seen as since to get only later ones. Only registered attendees or the event owner may access this.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "attendee"
                 ],
-                "summary": "Update session content",
+                "summary": "List recent schedule changes for a registered attendee",
+                "operationId": "ListScheduleChanges",
                 "parameters": [
                     {
                         "type": "string",
@@ -1515,26 +1466,22 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Session ID (UUID)",
-                        "name": "sessionID",
-                        "in": "path",
-                        "required": true
+                        "description": "Only changes after this time (RFC 3339)",
+                        "name": "since",
+                        "in": "query"
                     },
                     {
-                        "description": "Fields to update (all optional)",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/controllers.UpdateSessionContentRequest"
-                        }
+                        "type": "integer",
+                        "description": "Most changes to return (1-200, default 50)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "data contains the updated session",
+                        "description": "data contains the changes",
                         "schema": {
-                            "$ref": "#/definitions/controllers.UpdateSessionContentSuccessResponse"
+                            "$ref": "#/definitions/controllers.ListScheduleChangesSuccessResponse"
                         }
                     },
                     "400": {
@@ -1550,13 +1497,13 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "error.code: forbidden (not owner)",
+                        "description": "error.code: forbidden (not registered or owner)",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
                     "404": {
-                        "description": "error.code: not_found",
+                        "description": "error.code: event_not_found",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -1570,46 +1517,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/{eventID}/sessions/{sessionID}/speakers": {
-            "get": {
+        "/attendee/registrations": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns the list of speakers for the session (full speaker objects). Only the event owner can list. Requires authentication.",
+                "description": "Registers the authenticated user as an attendee for the event with the given event_code. When the event has documents such as a code of conduct (GET /public/events/{eventCode}/documents), accepted_documents must name the current version of each the user has not accepted yet. An event unlisted after abuse reports takes no new registrations, and answers 404 like an unknown code. Requests may be screened for bots (see the README). Idempotent: returns 201 when a new registration is created, 200 when already registered.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "attendee"
                 ],
-                "summary": "List speakers for a session",
+                "summary": "Register for an event by event code",
+                "operationId": "RegisterForEventByCode",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Event ID (UUID)",
-                        "name": "eventID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Session ID (UUID)",
-                        "name": "sessionID",
-                        "in": "path",
-                        "required": true
+                        "description": "Event code (4 to 16 characters)",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.RegisterForEventByCodeRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "data is an array of speakers",
+                        "description": "Already registered",
                         "schema": {
-                            "$ref": "#/definitions/controllers.ListSpeakersSuccessResponse"
+                            "$ref": "#/definitions/controllers.RegisterForEventSuccessResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "New registration created",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.RegisterForEventSuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "error.code: bad_request",
+                        "description": "error.code: bad_request, bot_detected or captcha_failed",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -1620,14 +1572,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
-                    "403": {
-                        "description": "error.code: forbidden (not owner)",
+                    "404": {
+                        "description": "error.code: event_not_found",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
-                    "404": {
-                        "description": "error.code: not_found",
+                    "409": {
+                        "description": "error.code: documents_not_accepted",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -1639,14 +1591,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/auth/login/request": {
             "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Links a speaker (by id) to a session. The speaker must already belong to the event. Only the event owner can add. Requires authentication.",
+                "description": "Send a one-time login code to the given email. The code expires after a short period. Requests may be screened for bots (see the README).",
                 "consumes": [
                     "application/json"
                 ],
@@ -1654,58 +1603,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "auth"
                 ],
-                "summary": "Add a speaker to a session",
+                "summary": "Request a login code",
+                "operationId": "RequestLoginCode",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Event ID (UUID)",
-                        "name": "eventID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Session ID (UUID)",
-                        "name": "sessionID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Speaker ID",
+                        "description": "Email to receive the code",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controllers.AddSessionSpeakerRequest"
+                            "$ref": "#/definitions/controllers.RequestLoginCodeRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No content"
-                    },
-                    "400": {
-                        "description": "error.code: bad_request",
-                        "schema": {
-                            "$ref": "#/definitions/helpers.APIResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "error.code: unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/helpers.APIResponse"
-                        }
-                    },
-                    "403": {
-                        "description": "error.code: forbidden (not owner)",
+                    "200": {
+                        "description": "success",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
-                    "404": {
-                        "description": "error.code: not_found",
+                    "400": {
+                        "description": "error.code: bad_request, bot_detected or captcha_failed",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -1719,47 +1640,37 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/{eventID}/sessions/{sessionID}/speakers/{speakerID}": {
-            "delete": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
+        "/auth/login/verify": {
+            "post": {
+                "description": "Exchange the one-time code for a JWT and user. Creates the user on first successful login. Returns token, token_type, and user.",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "Unlinks a speaker from a session. Only the event owner can remove. Requires authentication.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "auth"
                 ],
-                "summary": "Remove a speaker from a session",
+                "summary": "Verify login code and get token",
+                "operationId": "VerifyLoginCode",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Event ID (UUID)",
-                        "name": "eventID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Session ID (UUID)",
-                        "name": "sessionID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Speaker ID (UUID)",
-                        "name": "speakerID",
-                        "in": "path",
-                        "required": true
+                        "description": "Email and code",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.VerifyLoginCodeRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No content"
+                    "200": {
+                        "description": "data contains token, token_type, and user",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.LoginSuccessResponse"
+                        }
                     },
                     "400": {
                         "description": "error.code: bad_request",
@@ -1773,18 +1684,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
-                    "403": {
-                        "description": "error.code: forbidden (not owner)",
-                        "schema": {
-                            "$ref": "#/definitions/helpers.APIResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "error.code: not_found",
-                        "schema": {
-                            "$ref": "#/definitions/helpers.APIResponse"
-                        }
-                    },
                     "500": {
                         "description": "error.code: internal_error",
                         "schema": {
@@ -1794,14 +1693,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/{eventID}/sessions/{sessionID}/tags": {
+        "/auth/machine-token": {
             "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Links a tag (by id) to a session. The tag must already belong to the event. Only the event owner can add. Requires authentication.",
+                "description": "Exchanges a machine client's ID and secret for an access token carrying the client's scopes, valid for 15 minutes. Send it as \"Authorization: Bearer\" to the /machine routes; it is not accepted anywhere a user's token is, nor the other way round.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1809,37 +1703,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "auth"
                 ],
-                "summary": "Add a tag to a session",
+                "summary": "Get a machine access token",
+                "operationId": "IssueMachineToken",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Event ID (UUID)",
-                        "name": "eventID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Session ID (UUID)",
-                        "name": "sessionID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Tag ID",
+                        "description": "Client credentials",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controllers.AddSessionTagRequest"
+                            "$ref": "#/definitions/controllers.MachineTokenRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No content"
+                    "200": {
+                        "description": "data is the access token",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.MachineTokenSuccessResponse"
+                        }
                     },
                     "400": {
                         "description": "error.code: bad_request",
@@ -1848,19 +1732,7 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "error.code: unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/helpers.APIResponse"
-                        }
-                    },
-                    "403": {
-                        "description": "error.code: forbidden (not owner)",
-                        "schema": {
-                            "$ref": "#/definitions/helpers.APIResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "error.code: not_found",
+                        "description": "error.code: invalid_client (unknown client, wrong secret, or revoked)",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -1874,52 +1746,41 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/{eventID}/sessions/{sessionID}/tags/{tagID}": {
-            "delete": {
+        "/changelog": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Unlinks a tag from a session. Only the event owner can remove. Requires authentication.",
+                "description": "Returns a paginated list of the published product announcements, newest first, each with whether the caller has read it, plus how many the caller has not read. Drafts and scheduled announcements are not listed. Requires authentication.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "announcements"
                 ],
-                "summary": "Remove a tag from a session",
+                "summary": "List the product changelog",
+                "operationId": "GetChangelog",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Event ID (UUID)",
-                        "name": "eventID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Session ID (UUID)",
-                        "name": "sessionID",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "description": "Page number (default 1)",
+                        "name": "page",
+                        "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Tag ID (UUID)",
-                        "name": "tagID",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "page_size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No content"
-                    },
-                    "400": {
-                        "description": "error.code: bad_request",
+                    "200": {
+                        "description": "data contains items, unread and pagination",
                         "schema": {
-                            "$ref": "#/definitions/helpers.APIResponse"
+                            "$ref": "#/definitions/controllers.ChangelogSuccessResponse"
                         }
                     },
                     "401": {
@@ -1928,18 +1789,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
-                    "403": {
-                        "description": "error.code: forbidden (not owner)",
-                        "schema": {
-                            "$ref": "#/definitions/helpers.APIResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "error.code: not_found",
-                        "schema": {
-                            "$ref": "#/definitions/helpers.APIResponse"
-                        }
-                    },
                     "500": {
                         "description": "error.code: internal_error",
                         "schema": {
@@ -1949,35 +1798,41 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/{eventID}/speakers": {
-            "get": {
+        "/changelog/read": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns the list of speakers for the event. Only the event owner can list. Requires authentication.",
+                "description": "Marks the listed announcements read for the caller, or every published announcement when announcement_ids is empty or omitted. Unknown and unpublished IDs are ignored. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "announcements"
                 ],
-                "summary": "List speakers for an event",
+                "summary": "Mark changelog announcements read",
+                "operationId": "MarkAnnouncementsRead",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Event ID (UUID)",
-                        "name": "eventID",
-                        "in": "path",
-                        "required": true
+                        "description": "Announcements to mark read",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.MarkAnnouncementsReadRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "data is an array of speakers",
+                        "description": "data.status is read",
                         "schema": {
-                            "$ref": "#/definitions/controllers.ListSpeakersSuccessResponse"
+                            "$ref": "#/definitions/controllers.MarkAnnouncementsReadSuccessResponse"
                         }
                     },
                     "400": {
@@ -1992,18 +1847,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
-                    "403": {
-                        "description": "error.code: forbidden (not owner)",
-                        "schema": {
-                            "$ref": "#/definitions/helpers.APIResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "error.code: not_found",
-                        "schema": {
-                            "$ref": "#/definitions/helpers.APIResponse"
-                        }
-                    },
                     "500": {
                         "description": "error.code: internal_error",
                         "schema": {
@@ -2011,47 +1854,45 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/events": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Creates a new speaker for the event (manual create). Only the event owner can create. Requires authentication.",
+                "description": "Create a new conference event. Only name is accepted in the body; id, event_code and timestamps are server-generated. The authenticated user becomes the event owner.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/vnd.api+json",
+                    "application/vnd.m3t+json"
                 ],
                 "tags": [
                     "events"
                 ],
-                "summary": "Create a speaker",
+                "summary": "Create a new event",
+                "operationId": "CreateEvent",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Event ID (UUID)",
-                        "name": "eventID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Speaker data",
-                        "name": "body",
+                        "description": "Event data (name only)",
+                        "name": "event",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controllers.CreateSpeakerRequest"
+                            "$ref": "#/definitions/controllers.CreateEventRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "data contains the created speaker",
+                        "description": "data contains the created event",
                         "schema": {
-                            "$ref": "#/definitions/controllers.CreateSpeakerSuccessResponse"
+                            "$ref": "#/definitions/controllers.CreateEventSuccessResponse"
                         }
                     },
                     "400": {
@@ -2066,18 +1907,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
-                    "403": {
-                        "description": "error.code: forbidden (not owner)",
-                        "schema": {
-                            "$ref": "#/definitions/helpers.APIResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "error.code: not_found",
-                        "schema": {
-                            "$ref": "#/definitions/helpers.APIResponse"
-                        }
-                    },
                     "500": {
                         "description": "error.code: internal_error",
                         "schema": {
@@ -2087,46 +1916,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/{eventID}/speakers/{speakerID}": {
-            "get": {
+        "/events/join": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns a single speaker for the event with the list of sessions they speak in. Only the event owner can access. Requires authentication.",
+                "description": "Registers the authenticated user as an attendee of the event with the given code, as typed in the app at the venue, and returns the registration with the event's schedule, as GET /attendee/events/{eventID}/schedule has it, in one call. An event unlisted after abuse reports cannot be joined, and answers 404 like an unknown code; attendees already registered still get its schedule. When the event has documents such as a code of conduct (GET /public/events/{eventCode}/documents), accepted_documents must name the current version of each the user has not accepted yet; attendees already registered are not turned away when one changes, but get it in pending_documents until they accept it. Requests may be screened for bots like POST /attendee/registrations (see the README). Idempotent: returns 201 when the user joined, 200 when already registered.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "attendee"
                 ],
-                "summary": "Get a speaker by ID",
+                "summary": "Join an event by its code",
+                "operationId": "JoinEvent",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Event ID (UUID)",
-                        "name": "eventID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Speaker ID (UUID)",
-                        "name": "speakerID",
-                        "in": "path",
-                        "required": true
+                        "description": "Event code (4 to 16 characters, case-insensitive)",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.JoinEventRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "data contains speaker and sessions",
+                        "description": "Already registered; data contains the registration and schedule",
                         "schema": {
-                            "$ref": "#/definitions/controllers.GetEventSpeakerSuccessResponse"
+                            "$ref": "#/definitions/controllers.JoinEventSuccessResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "Joined; data contains the new registration and schedule",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.JoinEventSuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "error.code: bad_request",
+                        "description": "error.code: bad_request, bot_detected or captcha_failed",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -2137,14 +1971,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
-                    "403": {
-                        "description": "error.code: forbidden (not owner)",
+                    "404": {
+                        "description": "error.code: event_not_found",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
-                    "404": {
-                        "description": "error.code: not_found",
+                    "409": {
+                        "description": "error.code: documents_not_accepted",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -2156,43 +1990,85 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/events/joined": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a speaker. Session-speaker links are removed by cascade. Only the event owner can delete. Requires authentication.",
+                "description": "Returns events where the authenticated user is a team member but not the owner, newest first. Requires Bearer token.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "events"
                 ],
-                "summary": "Delete a speaker",
+                "summary": "List events the current user has joined as a team member",
+                "operationId": "ListJoinedEvents",
+                "responses": {
+                    "200": {
+                        "description": "data is an array of events",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ListJoinedEventsSuccessResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the events the authenticated user owns or is a team member of, newest first. Each event has role set to owner or team_member. owner_only=true leaves out the events the user is only a team member of. With include=stats each event carries stats: its room, session and speaker counts, invitations sent and accepted, and team size (owner included), all computed in one query. Requires Bearer token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "List events the current user owns or helps run",
+                "operationId": "ListMyEvents",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Event ID (UUID)",
-                        "name": "eventID",
-                        "in": "path",
-                        "required": true
+                        "type": "boolean",
+                        "description": "Only return events the user owns",
+                        "name": "owner_only",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Speaker ID (UUID)",
-                        "name": "speakerID",
-                        "in": "path",
-                        "required": true
+                        "description": "Comma-separated extras to include; the only one is stats",
+                        "name": "include",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No content"
+                    "200": {
+                        "description": "data is an array of events",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ListMyEventsSuccessResponse"
+                        }
                     },
                     "400": {
-                        "description": "error.code: bad_request",
+                        "description": "error.code: bad_request (invalid owner_only or unknown include)",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -2203,14 +2079,84 @@ const docTemplate = `{
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
-                    "403": {
-                        "description": "error.code: forbidden (not owner)",
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/search": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a paginated list of events the authenticated user owns, is a team member of or is registered for, each with the user's roles in it. Newest event date first; events without a date come last. Optional search matches the name, event code or description (case-insensitive). from and to (YYYY-MM-DD, inclusive) limit results to events dated in that range. role (comma-separated owner, team_member, attendee) keeps events where the user has any of the given roles. Requires Bearer token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Search the current user's events",
+                "operationId": "SearchEvents",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter events whose name, code or description contains this string (case-insensitive)",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Earliest event date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Latest event date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated roles: owner, team_member, attendee",
+                        "name": "role",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains items and pagination",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.SearchEventsSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
-                    "404": {
-                        "description": "error.code: not_found",
+                    "401": {
+                        "description": "error.code: unauthorized",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -2224,21 +2170,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/{eventID}/tags": {
+        "/events/{eventID}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns the list of tags associated with the event. Only the event owner can list. Requires authentication.",
+                "description": "Returns the event, its rooms, and all sessions for that event. The event includes its operating hours, the open and close times of each event day. Requires authentication.",
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/vnd.api+json",
+                    "application/vnd.m3t+json"
                 ],
                 "tags": [
                     "events"
                 ],
-                "summary": "List tags for an event",
+                "summary": "Get an event by ID",
+                "operationId": "GetEventByID",
                 "parameters": [
                     {
                         "type": "string",
@@ -2250,15 +2199,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "data is an array of tags",
-                        "schema": {
-                            "$ref": "#/definitions/controllers.ListEventTagsSuccessResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "error.code: bad_request",
+                        "description": "data contains event, rooms, and sessions",
                         "schema": {
-                            "$ref": "#/definitions/helpers.APIResponse"
+                            "$ref": "#/definitions/controllers.GetEventByIDSuccessResponse"
                         }
                     },
                     "401": {
@@ -2267,14 +2210,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
-                    "403": {
-                        "description": "error.code: forbidden (not owner)",
-                        "schema": {
-                            "$ref": "#/definitions/helpers.APIResponse"
-                        }
-                    },
                     "404": {
-                        "description": "error.code: not_found",
+                        "description": "error.code: event_not_found",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -2287,23 +2224,21 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Adds one or more tags to the event by name (creates tags if missing). Only the event owner can add. Requires authentication.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Starts deleting an event and all its associated data (rooms, sessions). Nothing is deleted yet: the owner is emailed a confirmation token, and POST /events/{eventID}/deletion/confirm with it deletes the event within 30 minutes. Requesting again replaces the earlier token. An event attendees have registered for is only deleted with force=true, which removes their registrations too; otherwise it fails with event_has_registrations. Only the event owner can delete. Requires authentication.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "events"
                 ],
-                "summary": "Add tags to an event",
+                "summary": "Request deleting an event",
+                "operationId": "DeleteEvent",
                 "parameters": [
                     {
                         "type": "string",
@@ -2313,20 +2248,17 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Tag names",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/controllers.AddEventTagsRequest"
-                        }
+                        "type": "boolean",
+                        "description": "Also delete attendee registrations",
+                        "name": "force",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "data contains the event's tags after add",
+                    "202": {
+                        "description": "data is the pending deletion",
                         "schema": {
-                            "$ref": "#/definitions/controllers.AddEventTagsSuccessResponse"
+                            "$ref": "#/definitions/controllers.PendingEventDeletionSuccessResponse"
                         }
                     },
                     "400": {
@@ -2348,7 +2280,13 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "error.code: not_found",
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "error.code: event_has_registrations",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -2360,23 +2298,27 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/events/{eventID}/tags/{tagID}": {
-            "delete": {
+            },
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Removes the tag from the event and from all sessions of that event. Only the event owner can remove. Requires authentication.",
-                "produces": [
+                "description": "Updates event date, description, and location (lat/lng). Only the event owner can update. Optional fields omitted from body are unchanged. Requires authentication.",
+                "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "application/json",
+                    "application/vnd.api+json",
+                    "application/vnd.m3t+json"
+                ],
                 "tags": [
                     "events"
                 ],
-                "summary": "Remove a tag from an event",
+                "summary": "Update event details",
+                "operationId": "UpdateEvent",
                 "parameters": [
                     {
                         "type": "string",
@@ -2386,16 +2328,21 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Tag ID (UUID)",
-                        "name": "tagID",
-                        "in": "path",
-                        "required": true
+                        "description": "Fields to update (all optional)",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.UpdateEventRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No content"
+                    "200": {
+                        "description": "data contains the updated event",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.UpdateEventSuccessResponse"
+                        }
                     },
                     "400": {
                         "description": "error.code: bad_request",
@@ -2416,7 +2363,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "error.code: not_found",
+                        "description": "error.code: event_not_found",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -2428,24 +2375,24 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/events/{eventID}/anonymize": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Renames a tag that belongs to the event. Only the event owner can update. Requires authentication.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Removes the personal data of an event that has taken place, in one transaction: invitation emails are replaced by hashes, speaker contact emails are cleared and the values of private text and URL custom fields are deleted. Registration, invitation and acceptance counts from before are kept in the returned record, which also says who anonymized the event and when; the event's stats keep using them. Anonymizing again returns the first record. With dry_run=true nothing is changed and the record returned has dry_run set and counts what would be removed. Only the event owner can anonymize. Requires authentication.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "events"
                 ],
-                "summary": "Update an event tag",
+                "summary": "Anonymize an event's personal data",
+                "operationId": "AnonymizeEvent",
                 "parameters": [
                     {
                         "type": "string",
@@ -2455,31 +2402,21 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Tag ID (UUID)",
-                        "name": "tagID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "New tag name",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/controllers.UpdateEventTagRequest"
-                        }
+                        "type": "boolean",
+                        "description": "Count what would be removed without changing anything",
+                        "name": "dry_run",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "data contains the updated tag",
+                        "description": "data contains the anonymization record",
                         "schema": {
-                            "$ref": "#/definitions/controllers.UpdateEventTagSuccessResponse"
+                            "$ref": "#/definitions/controllers.EventAnonymizationSuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "error.code: bad_request",
+                        "description": "error.code: bad_request (event dated in the future)",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -2497,13 +2434,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "error.code: not_found",
-                        "schema": {
-                            "$ref": "#/definitions/helpers.APIResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "error.code: conflict (duplicate name)",
+                        "description": "error.code: event_not_found",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -2517,21 +2448,22 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/{eventID}/team-members": {
+        "/events/{eventID}/checklist": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns the list of team members for the event. Only the event owner can list. Requires authentication.",
+                "description": "Returns the items every session of the event needs before it is ready, in order. The event owner and team members can read it. Requires authentication.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "events"
                 ],
-                "summary": "List team members of an event",
+                "summary": "Get the event's session checklist",
+                "operationId": "GetChecklist",
                 "parameters": [
                     {
                         "type": "string",
@@ -2543,9 +2475,15 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "data is an array of team members",
+                        "description": "data contains the checklist items",
                         "schema": {
-                            "$ref": "#/definitions/controllers.ListEventTeamMembersSuccessResponse"
+                            "$ref": "#/definitions/controllers.ChecklistSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
                     "401": {
@@ -2555,13 +2493,13 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "error.code: forbidden (not owner)",
+                        "description": "error.code: forbidden (not owner or team member)",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
                     "404": {
-                        "description": "error.code: not_found",
+                        "description": "error.code: event_not_found",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -2574,13 +2512,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Add a user as a team member of the event by email. Only the event owner can add. Returns 404 with a message if no user exists with that email. Requires authentication.",
+                "description": "Replaces the checklist items, in order, such as \"Slides received\" or \"AV check\". Items whose label is kept keep their ticks; the ticks of removed items are deleted. Labels are trimmed and must be non-empty, at most 255 characters and unique ignoring case; an event has at most 50 items. An empty list removes the checklist. Only the event owner can update. Requires authentication.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2590,7 +2528,8 @@ const docTemplate = `{
                 "tags": [
                     "events"
                 ],
-                "summary": "Add a team member to an event",
+                "summary": "Replace the event's session checklist",
+                "operationId": "UpdateChecklist",
                 "parameters": [
                     {
                         "type": "string",
@@ -2600,20 +2539,20 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Email of the user to add",
+                        "description": "Checklist item labels",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controllers.AddEventTeamMemberRequest"
+                            "$ref": "#/definitions/controllers.UpdateChecklistRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "data contains the added team member",
+                    "200": {
+                        "description": "data contains the saved checklist items",
                         "schema": {
-                            "$ref": "#/definitions/controllers.AddEventTeamMemberSuccessResponse"
+                            "$ref": "#/definitions/controllers.ChecklistSuccessResponse"
                         }
                     },
                     "400": {
@@ -2635,13 +2574,78 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "error.code: not_found (no user with that email)",
+                        "description": "error.code: event_not_found",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
-                    "409": {
-                        "description": "error.code: conflict (already member or invalid)",
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/checklist/progress": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns every session's checklist ordered by start time, how many sessions are ready (every item done) and how many items each team member ticked. Use ready=false to list only the sessions that are not ready yet, or ready=true for those that are; the totals always count every session. The event owner and team members can read it. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Get the checklist progress of an event's sessions",
+                "operationId": "GetChecklistProgress",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only list sessions that are (true) or are not (false) ready",
+                        "name": "ready",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the checklist progress",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ChecklistProgressSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner or team member)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -2655,21 +2659,22 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/{eventID}/team-members/{userID}": {
-            "delete": {
+        "/events/{eventID}/contact-threads": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Remove a user from the event's team members. Only the event owner can remove. Requires authentication.",
+                "description": "Returns a paginated list of the threads started from the event's contact form, most recently active first, without their messages. The event owner and team members can list. Requires authentication.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "events"
                 ],
-                "summary": "Remove a team member from an event",
+                "summary": "List an event's contact threads",
+                "operationId": "ListContactThreads",
                 "parameters": [
                     {
                         "type": "string",
@@ -2679,18 +2684,29 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "User ID (UUID) of the team member to remove",
-                        "name": "userID",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "description": "Page number (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "page_size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "data contains status",
+                        "description": "data contains items and pagination",
                         "schema": {
-                            "$ref": "#/definitions/controllers.RemoveEventTeamMemberSuccessResponse"
+                            "$ref": "#/definitions/controllers.ListContactThreadsSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
                     "401": {
@@ -2700,7 +2716,7 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "error.code: forbidden (not owner)",
+                        "description": "error.code: forbidden (not owner or team member)",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -2720,26 +2736,49 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me": {
+        "/events/{eventID}/contact-threads/{threadID}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns the authenticated user's profile (id, email, name, created_at, updated_at). Requires Bearer token.",
+                "description": "Returns the thread with its messages, oldest first. The event owner and team members can read it. Requires authentication.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "events"
+                ],
+                "summary": "Get a contact thread",
+                "operationId": "GetContactThread",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Thread ID (UUID)",
+                        "name": "threadID",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get current user",
                 "responses": {
                     "200": {
-                        "description": "data contains the user",
+                        "description": "data is the thread with its messages",
                         "schema": {
-                            "$ref": "#/definitions/controllers.GetMeSuccessResponse"
+                            "$ref": "#/definitions/controllers.ContactThreadSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
                     "401": {
@@ -2748,6 +2787,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
+                    "403": {
+                        "description": "error.code: forbidden (not owner or team member)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
                     "404": {
                         "description": "error.code: not_found",
                         "schema": {
@@ -2761,14 +2806,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/events/{eventID}/contact-threads/{threadID}/replies": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update the authenticated user's profile. Accepts optional name and/or last_name only; email cannot be updated. Requires Bearer token.",
+                "description": "Adds the team's reply to the thread and emails it to the sender. The email names the event, not the team member. A failed send does not fail the reply; it is in the event's sent emails to resend. The event owner and team members can reply. Requires authentication.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2776,25 +2823,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "events"
                 ],
-                "summary": "Update current user",
+                "summary": "Reply to a contact thread",
+                "operationId": "ReplyToContactThread",
                 "parameters": [
                     {
-                        "description": "Fields to update (name and/or last_name, both optional)",
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Thread ID (UUID)",
+                        "name": "threadID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reply",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controllers.UpdateUserRequest"
+                            "$ref": "#/definitions/controllers.ReplyToContactThreadRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "data contains the updated user",
+                    "201": {
+                        "description": "data is the reply",
                         "schema": {
-                            "$ref": "#/definitions/controllers.UpdateUserSuccessResponse"
+                            "$ref": "#/definitions/controllers.ContactMessageSuccessResponse"
                         }
                     },
                     "400": {
@@ -2809,14 +2871,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
-                    "404": {
-                        "description": "error.code: not_found",
+                    "403": {
+                        "description": "error.code: forbidden (not owner or team member)",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
                     },
-                    "409": {
-                        "description": "error.code: conflict",
+                    "404": {
+                        "description": "error.code: not_found",
                         "schema": {
                             "$ref": "#/definitions/helpers.APIResponse"
                         }
@@ -2829,755 +2891,12472 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "controllers.AddEventTagsRequest": {
-            "type": "object",
-            "properties": {
-                "tags": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                }
-            }
         },
-        "controllers.AddEventTagsSuccessResponse": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/domain.Tag"
+        "/events/{eventID}/custom-fields": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the extra fields the event defines for its sessions and speakers, oldest first. The event owner and team members can read them. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "List the event's custom fields",
+                "operationId": "ListCustomFields",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the custom fields",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.CustomFieldsSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner or team member)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
                     }
-                },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
-                }
-            }
-        },
-        "controllers.AddEventTeamMemberRequest": {
-            "type": "object",
-            "properties": {
-                "email": {
-                    "type": "string"
-                }
-            }
-        },
-        "controllers.AddEventTeamMemberSuccessResponse": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "$ref": "#/definitions/domain.EventTeamMember"
-                },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
-                }
-            }
-        },
-        "controllers.AddSessionSpeakerRequest": {
-            "type": "object",
-            "properties": {
-                "speaker_id": {
-                    "type": "string"
                 }
-            }
-        },
-        "controllers.AddSessionTagRequest": {
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adds an extra field to the event's sessions or speakers, such as \"streaming consent\" (boolean) or \"paper DOI\" (url). Types are text, number, boolean, date (YYYY-MM-DD) and url (http or https). Public fields are also shown to attendees in the schedule, delta sync and offline bundle. Names are trimmed, at most 100 characters and unique per entity; an event has at most 50 fields. Only the event owner can create. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Create a custom field",
+                "operationId": "CreateCustomField",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Field definition",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.CreateCustomFieldRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "data contains the created field",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.CustomFieldSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "error.code: conflict (name already used) or quota_exceeded (the event has 50 fields)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/custom-fields/{fieldID}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes the field and every session's or speaker's value of it. Only the event owner can delete. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Delete a custom field",
+                "operationId": "DeleteCustomField",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Custom field ID (UUID)",
+                        "name": "fieldID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Renames the field and/or changes whether attendees see it. Omitted properties are kept. Only the event owner can update. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Rename a custom field or change whether it is public",
+                "operationId": "UpdateCustomField",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Custom field ID (UUID)",
+                        "name": "fieldID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.UpdateCustomFieldRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the updated field",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.CustomFieldSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "error.code: conflict (name already used)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/deletion/confirm": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes the event with the token DELETE /events/{eventID} emailed to the owner. A token works once and only for 30 minutes; a wrong, used or expired one gets invalid_confirmation. Only the event owner can confirm. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Confirm deleting an event",
+                "operationId": "ConfirmEventDeletion",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Confirmation token",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ConfirmEventDeletionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains status",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.DeleteEventSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request, invalid_confirmation",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "error.code: event_has_registrations",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/documents": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the current version of each document, such as the code of conduct, that registering for the event needs accepted, in kind order. Withdrawn documents are left out. Only the event owner can list. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "List an event's documents",
+                "operationId": "ListEventDocuments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is the list of documents",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.EventDocumentListSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/documents/{kind}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Makes title and body the current version of the event's document of kind (code_of_conduct, photo_policy or terms). New attendees must accept it to register; attendees already registered keep their place and are asked to accept the new version (pending_documents of POST /events/join, needs_acceptance of GET /attendee/events/{eventID}/documents). Earlier versions and who accepted them are kept. Publishing a withdrawn document makes it current again. Returns 201 when a new version is published, 200 when title and body equal the current version's and nothing changed. Only the event owner can publish. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Publish an event document",
+                "operationId": "PublishEventDocument",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "code_of_conduct",
+                            "photo_policy",
+                            "terms"
+                        ],
+                        "type": "string",
+                        "description": "Document kind",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Document",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.PublishEventDocumentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Unchanged; data is the current version",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.EventDocumentSuccessResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "data is the new version",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.EventDocumentSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stops asking attendees to accept the event's document of kind. Its versions and who accepted them stay on record, and publishing it again makes it current once more. Only the event owner can withdraw. Requires authentication.",
+                "tags": [
+                    "events"
+                ],
+                "summary": "Withdraw an event document",
+                "operationId": "WithdrawEventDocument",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "code_of_conduct",
+                            "photo_policy",
+                            "terms"
+                        ],
+                        "type": "string",
+                        "description": "Document kind",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Withdrawn"
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found (no such event or current document)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/documents/{kind}/acceptances": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns each registered attendee, by email, with the latest version of the event's document of kind they accepted and when, and counts of those who accepted the current version, only an older one, or none, such as attendees registered before the document was published. Only the event owner can read it. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Report who accepted an event document",
+                "operationId": "GetDocumentAcceptanceReport",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "code_of_conduct",
+                            "photo_policy",
+                            "terms"
+                        ],
+                        "type": "string",
+                        "description": "Document kind",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is the report",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.DocumentAcceptanceReportSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found (no such event or current document)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/documents/{kind}/versions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns every version of the event's document of kind, newest first, including those of a withdrawn document. Only the event owner can list. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "List the versions of an event document",
+                "operationId": "ListEventDocumentVersions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "code_of_conduct",
+                            "photo_policy",
+                            "terms"
+                        ],
+                        "type": "string",
+                        "description": "Document kind",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is the list of versions",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.EventDocumentListSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found (no such event or document)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/emails": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a paginated list of the emails sent on behalf of the event (invitations, reminders, team notices, contact form messages and replies, and room capacity alerts), newest first, with their rendered subject and bodies and whether the mailer accepted them. Failed sends and resends are listed too. Optional to filters by recipient substring (case-insensitive), kind by template and status by sent or failed. Only the event owner can list. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "List the emails sent for an event",
+                "operationId": "ListEventEmails",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter recipients containing this string (case-insensitive)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "event_invitation",
+                            "event_invitation_reminder",
+                            "team_member_left",
+                            "contact_message",
+                            "contact_reply",
+                            "room_capacity_alert"
+                        ],
+                        "type": "string",
+                        "description": "Filter by kind",
+                        "name": "kind",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "sent",
+                            "failed",
+                            "skipped"
+                        ],
+                        "type": "string",
+                        "description": "Filter by status; skipped is a sandbox event's email",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains items and pagination",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ListEventEmailsSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/emails/{emailID}/resend": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sends one of the event's emails again to the same recipient, exactly as it was first rendered, and returns the new attempt; its resend_of is the original email's ID. A mailer failure is not an error: the attempt is returned with status failed and the mailer's error. Only the event owner can resend. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Resend a sent email",
+                "operationId": "ResendEventEmail",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Email ID (UUID)",
+                        "name": "emailID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is the new send attempt",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.EventEmailSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found or email_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/exhibitors": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the event's exhibitors by name, each with its booth staff. The event owner and team members can list. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "List an event's exhibitors",
+                "operationId": "ListExhibitors",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is the list of exhibitors",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ExhibitorsSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner or team member)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adds a sponsor or vendor with a booth at the event. The booth number is optional and unique within the event. Add booth staff with POST /events/{eventID}/exhibitors/{exhibitorID}/staff. The event owner and team members can add exhibitors. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Add an exhibitor to an event",
+                "operationId": "CreateExhibitor",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Exhibitor",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.CreateExhibitorRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "data is the exhibitor",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ExhibitorSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner or team member)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "error.code: conflict (booth number taken)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/exhibitors/{exhibitorID}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes the exhibitor with its booth staff and every lead it scanned. The event owner and team members can delete. Requires authentication.",
+                "tags": [
+                    "events"
+                ],
+                "summary": "Delete an exhibitor",
+                "operationId": "DeleteExhibitor",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Exhibitor ID (UUID)",
+                        "name": "exhibitorID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner or team member)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/exhibitors/{exhibitorID}/staff": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lets the user with the email scan leads for the exhibitor and export them after the event. The user must already have an account. Adding someone who already staffs the booth changes nothing. The event owner and team members can add staff. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Add booth staff to an exhibitor",
+                "operationId": "AddExhibitorStaff",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Exhibitor ID (UUID)",
+                        "name": "exhibitorID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Staff member's email",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.AddExhibitorStaffRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "data is the staff member",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ExhibitorStaffSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner or team member)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found or user_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/exhibitors/{exhibitorID}/staff/{userID}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stops the user from scanning and exporting the exhibitor's leads. Leads they scanned stay. The event owner and team members can remove staff. Requires authentication.",
+                "tags": [
+                    "events"
+                ],
+                "summary": "Remove booth staff from an exhibitor",
+                "operationId": "RemoveExhibitorStaff",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Exhibitor ID (UUID)",
+                        "name": "exhibitorID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Staff member's user ID (UUID)",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner or team member)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/import/mapping": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns how Sessionize categories map to tags, session type and track, and which rooms are renamed on import. Events without a saved mapping return the defaults (every category becomes a tag). Only the event owner can read it. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Get the event's import mapping",
+                "operationId": "GetImportMapping",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the import mapping",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ImportMappingSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the import mapping applied by the next Sessionize import. Empty tag_categories means every category not used for session type or track becomes a tag. Only the event owner can update. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Replace the event's import mapping",
+                "operationId": "UpdateImportMapping",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Import mapping",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.UpdateImportMappingRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the saved import mapping",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ImportMappingSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/import/sessionize/{sessionizeID}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Import rooms and sessions from Sessionize for a specific event. Rooms, sessions and speakers the import no longer contains are deleted. With dry_run=true nothing is changed: data is a SessionizeImportPreview (event_id, dry_run, and rooms, sessions and speakers each listing {id, name, action} with action create, update or delete); only the event owner can preview.",
+                "tags": [
+                    "events"
+                ],
+                "summary": "Import schedule from Sessionize",
+                "operationId": "ImportSessionize",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sessionize ID",
+                        "name": "sessionizeID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Download the full schedule even if Sessionize reports it unchanged since the last import",
+                        "name": "force_refresh",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "List what the import would change without changing anything",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains status message, or in a dry run the import preview",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ImportSessionizeSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (dry run by someone other than the owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found (dry run)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "error.code: import_unavailable (Sessionize is failing; retry later)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/integrity-report": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists unused tags, session tags missing from the event, sessions linked to another event's speakers, speakers without sessions, empty rooms, sessions that do not end after they start and overlapping sessions in a room. Issues with fixable=true are resolved by the cleanup endpoint. Only the event owner can read it. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Report orphaned and inconsistent event data",
+                "operationId": "GetIntegrityReport",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the integrity report",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.IntegrityReportSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/integrity-report/cleanup": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Fixes the report's fixable issues in one transaction: unused tags are unlinked from the event, session tags missing from the event are added to it, and links to another event's speakers are removed. With dry_run=true nothing is changed and the response lists what would be fixed. Only the event owner can clean up. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Fix orphaned and inconsistent event data",
+                "operationId": "CleanupIntegrityIssues",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "List the fixes without applying them",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the fixed (or, in a dry run, fixable) issues",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.IntegrityCleanupSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/invitations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a paginated list of emails invited to the event (with id and sent_at). accepted_by holds the user who registered for the event with the invited email, if any. Only the event owner can list. Use page and page_size query params. Optional search filters by email substring (case-insensitive). With Accept: application/x-ndjson, every matching invitation is streamed as one JSON object per line and pagination is ignored. Requires authentication.",
+                "produces": [
+                    "application/json",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "List invited emails for an event",
+                "operationId": "ListEventInvitations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter emails containing this string (case-insensitive)",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains items and pagination",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ListEventInvitationsSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Send invitation emails to register for the event. Body contains a string of emails separated by commas or spaces. Only the event owner can invite. Each invitation is persisted and emailed; duplicates for the same event are skipped. Addresses the event's invitation domain rules do not permit are listed in skipped and not invited. Returns count of sent and lists of failed and skipped addresses.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Send event invitation emails",
+                "operationId": "SendEventInvitations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Emails string (comma or space separated)",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.SendEventInvitationsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains sent count and failed and skipped lists",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.SendEventInvitationsSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request (empty or no valid emails)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/invitations/domain-rules": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the email domains invitations can and cannot be sent to. A domain also covers its subdomains; blocked domains win over allowed ones, and with no allowed domains every domain that is not blocked is allowed. Events without saved rules return empty rules. Only the event owner can read them. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Get the event's invitation domain rules",
+                "operationId": "GetInvitationDomainRules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the domain rules",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.InvitationDomainRulesSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the email domains invitations can and cannot be sent to. Domains are lowercased and deduplicated; a leading \"@\" is ignored. Existing invitations are kept. Only the event owner can update. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Replace the event's invitation domain rules",
+                "operationId": "UpdateInvitationDomainRules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Domain rules",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.UpdateInvitationDomainRulesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the saved domain rules",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.InvitationDomainRulesSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/invitations/email-domain": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Looks up the SPF, DKIM and DMARC records of the domain the event's invitations are sent from and reports each one that is missing or wrong, so deliverability problems show up before a large send. Every event is sent from the platform sender for now. Reports are cached for 10 minutes; refresh=true looks the records up again once the report is a minute old. Only the event owner can check. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Check the invitations' sending domain",
+                "operationId": "CheckEmailDomain",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Look the records up again instead of using the cached report",
+                        "name": "refresh",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is the report; data.ok is false when a record is missing or invalid",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.EmailDomainReportSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found (no event, or the server does not send through SES)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/invitations/remind": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Emails a reminder to everyone invited to the event who has not registered yet. Invitees emailed (invited or reminded) less than min_interval_hours ago, 72 by default, are skipped, and nobody gets more than 3 reminders; both count as throttled. Returns the count of reminders sent, the addresses that failed and the throttled count. Only the event owner can send reminders. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Remind invitees who have not registered",
+                "operationId": "RemindEventInvitations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Campaign options; send {} for the defaults",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.RemindEventInvitationsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains sent count, failed list and throttled count",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.RemindEventInvitationsSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/invitations/warmups": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the event's invitation warm-ups, newest first, each with its progress and schedule. Only the event owner can list. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "List an event's invitation warm-ups",
+                "operationId": "ListInvitationWarmups",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is the list of warm-ups",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.InvitationWarmupsSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Queues the emails to be invited over several days, so that a new sending domain builds its reputation before sending in volume. days spreads the send over that many days, doubling the volume every day; daily_volumes names each day's volume instead, the last repeating until every address is invited; with neither the default ramp (50, 100, 250, 500, 1000, 2500, then 5000 a day) applies. Each day's volume is spread over its 24 hours. Invitations go out as with POST /events/{eventID}/invitations, so the event's domain rules apply when each address's turn comes. With dry_run=true nothing is queued and data previews the schedule. Only the event owner can start a warm-up. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Start an invitation warm-up",
+                "operationId": "CreateInvitationWarmup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Preview the schedule without queueing anything",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Emails and the daily volumes",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.CreateInvitationWarmupRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is the previewed warm-up (dry_run=true)",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.InvitationWarmupSuccessResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "data is the warm-up with its schedule",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.InvitationWarmupSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request (no valid emails, or bad days or daily_volumes)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/invitations/warmups/{warmupID}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the warm-up with its progress and schedule. Only the event owner can get it. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Get an invitation warm-up",
+                "operationId": "GetInvitationWarmup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Warm-up ID (UUID)",
+                        "name": "warmupID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is the warm-up",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.InvitationWarmupSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/invitations/warmups/{warmupID}/pause": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stops sending the warm-up's invitations until it is resumed. Pausing a paused warm-up changes nothing. Only the event owner can pause. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Pause an invitation warm-up",
+                "operationId": "PauseInvitationWarmup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Warm-up ID (UUID)",
+                        "name": "warmupID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is the paused warm-up",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.InvitationWarmupSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "error.code: conflict (warm-up already completed)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/invitations/warmups/{warmupID}/resume": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Resumes sending a paused warm-up where it left off: its schedule moves later by the time it was paused, so no day's volume is skipped or doubled. Resuming an active warm-up changes nothing. Only the event owner can resume. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Resume an invitation warm-up",
+                "operationId": "ResumeInvitationWarmup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Warm-up ID (UUID)",
+                        "name": "warmupID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is the resumed warm-up with its new schedule",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.InvitationWarmupSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "error.code: conflict (warm-up already completed)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/invitations/{invitationID}/promote": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adds the user the invitation was sent to as a team member of the event, resolved by the invited email. role defaults to team_member, the only team role. Only the event owner can promote. Returns 404 user_not_found if nobody has signed up with the invited email yet. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Promote an invitee to team member",
+                "operationId": "PromoteInvitation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Invitation ID (UUID)",
+                        "name": "invitationID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Role to grant",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.PromoteInvitationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "data contains the added team member",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.AddEventTeamMemberSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found, invitation_not_found or user_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "error.code: already_member or conflict (invitee is the owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/operating-hours": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the open and close times of each event day, ordered by day. Events without operating hours return an empty list and allow sessions at any time. Only the event owner can read them here; attendees see them on the event in the schedule. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Get the event's operating hours",
+                "operationId": "GetOperatingHours",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the operating days",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.OperatingHoursSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the open and close times of each event day: doors open at opens_at and the last session ends by closes_at, which may be after midnight. Sessions created or moved to a new time slot must then fit inside one day; existing sessions are not rechecked. Days must not repeat or overlap. An empty list removes the operating hours. Only the event owner can update. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Replace the event's operating hours",
+                "operationId": "UpdateOperatingHours",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Operating days",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.UpdateOperatingHoursRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the saved operating days",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.OperatingHoursSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/regenerate-code": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Gives the event a new random event_code in the configured format, for when the old one has leaked. The old code stops working at once: registering, the public schedule and the public event page only answer to the new one. Invitees who have not registered yet are sent their invitation again with the new code; addresses the email could not be sent to are listed in invitations_failed. Attendees already registered are not affected. Only the event owner can regenerate the code. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Replace the event code",
+                "operationId": "RegenerateEventCode",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the updated event, the previous code and how the invitations were resent",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.EventCodeChangeSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "error.code: conflict (no unused code was found; retry)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/rooms": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the list of rooms for the event. Only the event owner can list. Requires authentication.",
+                "produces": [
+                    "application/json",
+                    "application/vnd.api+json",
+                    "application/vnd.m3t+json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "List rooms for an event",
+                "operationId": "ListEventRooms",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is an array of rooms",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ListRoomsSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a new room for the event. Only the event owner can create. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/vnd.api+json",
+                    "application/vnd.m3t+json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Create a room",
+                "operationId": "CreateEventRoom",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Room data",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.CreateRoomRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "data contains the created room",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.CreateRoomSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/rooms/order": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sets the order rooms are shown in; tracks have their own order (PUT /events/{eventID}/tracks/order). room_ids must list every room of the event exactly once; the first one is shown first. The order is used by the room list, the schedule grid, the attendee schedule and the public schedule. New rooms are added last. Only the event owner can reorder. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/vnd.api+json",
+                    "application/vnd.m3t+json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Set the display order of an event's rooms",
+                "operationId": "ReorderEventRooms",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Room IDs in display order",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ReorderRoomsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is the rooms in their new order",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ListRoomsSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request (room_ids missing, repeated or not the event's rooms)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/rooms/{roomID}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a single room for the event. Only the event owner can access. Requires authentication.",
+                "produces": [
+                    "application/json",
+                    "application/vnd.api+json",
+                    "application/vnd.m3t+json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Get a room by ID",
+                "operationId": "GetEventRoom",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Room ID (UUID)",
+                        "name": "roomID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the room",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.GetRoomSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a room. mode decides what happens to its sessions: cascade (default) deletes them, reassign moves them to target_room_id, and unschedule keeps them without a room. With dry_run=true nothing is changed: data is a RoomDeletionPreview (event_id, room_id, mode, dry_run, and sessions listing {id, name, action} with action delete or move). Only the event owner can delete. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Delete a room",
+                "operationId": "DeleteEventRoom",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Room ID (UUID)",
+                        "name": "roomID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "cascade",
+                            "reassign",
+                            "unschedule"
+                        ],
+                        "type": "string",
+                        "description": "What happens to the room's sessions",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Room (UUID) that receives the sessions; required when mode is reassign",
+                        "name": "target_room_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "List the sessions the deletion would delete or move without changing anything",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains status, or in a dry run the deletion preview",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.DeleteRoomSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Updates room details (name, capacity, description, how_to_get_there, not_bookable). Only the event owner can update. Optional fields omitted from body are unchanged (name and not_bookable keep current value when omitted). Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/vnd.api+json",
+                    "application/vnd.m3t+json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Update a room",
+                "operationId": "UpdateEventRoom",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Room ID (UUID)",
+                        "name": "roomID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Room fields to update",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.UpdateRoomRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the updated room",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.UpdateRoomSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/rooms/{roomID}/not-bookable": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Toggles the not_bookable flag for a room. Only the event owner can toggle. Requires authentication.",
+                "produces": [
+                    "application/json",
+                    "application/vnd.api+json",
+                    "application/vnd.m3t+json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Toggle room not_bookable flag",
+                "operationId": "ToggleRoomNotBookable",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Room ID (UUID)",
+                        "name": "roomID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the updated room",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ToggleRoomNotBookableSuccessResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/schedule": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the schedule attendees saw at as_of, laid out as GET /attendee/events/{eventID}/schedule, to settle what it said before a change. It is rebuilt from the room and time moves made since (GET /events/{eventID}/sessions/{sessionID}/history), including those made by Sessionize imports: sessions are back in the rooms and time slots they had, rooms and sessions created later are left out, and rooms deleted since are back under the name they had. Titles, descriptions, speakers and custom fields are the current ones. Sessions deleted since are listed in deleted_session_ids and sessions whose fields were edited since in edited_session_ids; partial is true when either is non-empty, since the snapshot then differs from what attendees saw. Moves older than RETENTION_SESSION_CHANGES_DAYS are not known. Only the event owner can read it; support staff use X-Act-As. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Get the event schedule as it was at a past time",
+                "operationId": "GetEventScheduleAsOf",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The time to show the schedule at (RFC 3339), not in the future; default now",
+                        "name": "as_of",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains event and rooms (bookable only) with nested sessions, and what the snapshot could not restore",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ScheduleSnapshotSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/schedule-rules": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the rules new and moved sessions are checked against: allowed durations, earliest start and latest end time of day, and allowed days, read in the rules' time zone. Events without saved rules return empty rules, which allow any time slot. Only the event owner can read them. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Get the event's schedule rules",
+                "operationId": "GetScheduleRules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the schedule rules",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ScheduleRulesSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the rules sessions are checked against when they are created or moved to a new time slot; existing sessions are not rechecked. Empty fields impose no limit and an empty timezone means UTC. Only the event owner can update. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Replace the event's schedule rules",
+                "operationId": "UpdateScheduleRules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Schedule rules",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.UpdateScheduleRulesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the saved schedule rules",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ScheduleRulesSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/schedule/grid": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the event's schedule laid out as days × rooms × time slots, ready to render. Each day has the rows' start times (slots) and a column per room holding its sessions with their slot, span and lane, the free gaps and the overlapping pairs. Sessions without a room are listed apart. The server keeps the grid up to date as sessions, rooms, schedule rules and operating hours change. The event owner and team members can read it. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Get the schedule grid of an event",
+                "operationId": "GetScheduleGrid",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the schedule grid",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ScheduleGridSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner or team member)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/schedule/overlaps": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cuts the event's schedule into time buckets and lists, for each bucket with a session running, the sessions running in it grouped by tag or track, so program chairs can balance tracks, e.g. avoid two AI talks at once. A session is in every bucket it overlaps, and with group_by=tag in the group of each of its tags; sessions without one share a group with an empty key, listed last. clash is set on a named group with more than one session in the bucket. Buckets line up with UTC midnight. The event owner and team members can read it. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Get the parallel sessions of an event by tag or track",
+                "operationId": "GetScheduleOverlaps",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "tag (default) or track",
+                        "name": "group_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Bucket size in minutes, 5 to 240 and dividing a day (default 30)",
+                        "name": "bucket_minutes",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the buckets",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ScheduleOverlapsSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner or team member)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/schedule/validate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Runs every conflict and constraint check on the event's sessions and returns one report with the problems by category: room_overlap (sessions in the same room at overlapping times), speaker_clash (a speaker in overlapping sessions), capacity (rooms holding sessions without a capacity set), operating_hours (sessions outside the operating hours) and schedule_rules (sessions breaking the schedule rules or ending before they start). Every category is present, empty when it passed. Run it after imports or bulk reschedules; nothing is changed. Only the event owner can validate. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Validate the whole schedule of an event",
+                "operationId": "ValidateSchedule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the validation report",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ScheduleValidationSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns every session of the event with its tags and speaker IDs, ordered by start time. Only the event owner can list. With Accept: application/x-ndjson, sessions are streamed as one JSON object per line as they are read. Requires authentication.",
+                "produces": [
+                    "application/json",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "List all sessions of an event",
+                "operationId": "ListEventSessions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the sessions",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ListEventSessionsSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a new session for the event in a given room and time slot, with optional tags and speakers. The time slot must satisfy the event's schedule rules and fit inside one of its operating days. Only the event owner can create. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/vnd.api+json",
+                    "application/vnd.m3t+json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Create a session",
+                "operationId": "CreateEventSession",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Session data",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.CreateSessionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "data contains the created session",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.CreateSessionSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request or schedule_rule_violation (breaks the event's schedule rules or operating hours)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "error.code: session_time_conflict (overlaps another session in the room)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/sessions/{sessionID}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a session. Only the event owner can delete. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Delete a session",
+                "operationId": "DeleteEventSession",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Session ID (UUID)",
+                        "name": "sessionID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains status",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.DeleteRoomSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Moves a session to a different room and/or time slot by updating room_id, start_time, and end_time. Only the event owner can update. Optional fields omitted from body are unchanged. A new time slot must satisfy the event's schedule rules and fit inside one of its operating days. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/vnd.api+json",
+                    "application/vnd.m3t+json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Update session schedule",
+                "operationId": "UpdateSessionSchedule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Session ID (UUID)",
+                        "name": "sessionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update (all optional)",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.UpdateSessionScheduleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the updated session",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.UpdateSessionScheduleSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request or schedule_rule_violation (breaks the event's schedule rules or operating hours)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "error.code: session_time_conflict (overlaps another session in the room)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/sessions/{sessionID}/checklist/{itemID}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Marks a checklist item done or not done for one session and returns the session's checklist. The caller is recorded as the team member who ticked it; ticking an item that is already done keeps the original tick. The event owner and team members can tick items. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Tick or untick a checklist item for a session",
+                "operationId": "SetSessionChecklistItem",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Session ID (UUID)",
+                        "name": "sessionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Checklist item ID (UUID)",
+                        "name": "itemID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Whether the item is done",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.SetChecklistItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the session's checklist",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.SessionChecklistSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner or team member)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/sessions/{sessionID}/content": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Updates a session's title and/or description. Only the event owner can update. Optional fields omitted from body are unchanged. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/vnd.api+json",
+                    "application/vnd.m3t+json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Update session content",
+                "operationId": "UpdateSessionContent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Session ID (UUID)",
+                        "name": "sessionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update (all optional)",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.UpdateSessionContentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the updated session",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.UpdateSessionContentSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/sessions/{sessionID}/content/streams": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the session's stream links in order, including those outside their visibility window. Only the event owner can list. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "List a session's caption and translation streams",
+                "operationId": "ListSessionStreams",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Session ID (UUID)",
+                        "name": "sessionID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is the session's stream links",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.SessionStreamsSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the session's stream links, for hybrid events that caption or translate their sessions live. Each link has a kind (captions or translation), a language code such as en or pt-BR, an http or https url, and an optional visibility window: attendees get the link in the session of their schedule only from visible_from until visible_until, and either may be left open. A session has at most 20 links and one per kind and language. Only the event owner can set them. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Set a session's caption and translation streams",
+                "operationId": "SetSessionStreams",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Session ID (UUID)",
+                        "name": "sessionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "All of the session's links",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.SetSessionStreamsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is the session's stream links",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.SessionStreamsSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/sessions/{sessionID}/custom-fields": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sets the session's values of the event's session fields, keyed by field ID. Values must match the field's type: a string for text, url and date (YYYY-MM-DD), a number or true/false. Null or an empty string clears a field; fields not listed keep their value. Returns all of the session's values. Only the event owner can set them. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Set a session's custom field values",
+                "operationId": "SetSessionCustomFields",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Session ID (UUID)",
+                        "name": "sessionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Values by field ID",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.SetCustomFieldValuesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the session's custom field values",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.CustomFieldValuesSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/sessions/{sessionID}/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns every room or time move of the session, oldest first, with the room names at the time of the move. Moves are recorded when the session is rescheduled, when a Sessionize import moves it and when its room is deleted with reassign or unschedule. Only the event owner can list. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "List a session's room and time changes",
+                "operationId": "ListSessionHistory",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Session ID (UUID)",
+                        "name": "sessionID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the changes",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ListSessionHistorySuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/sessions/{sessionID}/speakers": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the list of speakers for the session (full speaker objects). Only the event owner can list. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "List speakers for a session",
+                "operationId": "ListSessionSpeakers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Session ID (UUID)",
+                        "name": "sessionID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is an array of speakers",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ListSpeakersSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Links a speaker (by id) to a session. The speaker must already belong to the event. Only the event owner can add. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Add a speaker to a session",
+                "operationId": "AddSessionSpeaker",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Session ID (UUID)",
+                        "name": "sessionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Speaker ID",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.AddSessionSpeakerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/sessions/{sessionID}/speakers/{speakerID}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Unlinks a speaker from a session. Only the event owner can remove. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Remove a speaker from a session",
+                "operationId": "RemoveSessionSpeaker",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Session ID (UUID)",
+                        "name": "sessionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Speaker ID (UUID)",
+                        "name": "speakerID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/sessions/{sessionID}/suggestions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Asks the server's language model provider for tags when the session has none, and for a short summary when it has no description. Existing event tags are preferred; suggested tags the event already has carry their tag_id. Nothing is saved: show the suggestions to the owner and send the approved ones to POST /events/{eventID}/sessions/{sessionID}/suggestions/apply. A session with tags and a description gets empty suggestions. Only the event owner can ask. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Suggest tags and a summary for a session",
+                "operationId": "SuggestSessionEnrichment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Session ID (UUID)",
+                        "name": "sessionID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is the suggestion",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.SessionSuggestionSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "error.code: enrichment_unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/sessions/{sessionID}/suggestions/apply": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Saves the tags and summary the owner approved: the tags are added to the event and the session, and the summary becomes the session's description. A summary is refused once the session has a description, so it is never overwritten. Only the event owner can apply. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Apply approved suggestions to a session",
+                "operationId": "ApplySessionSuggestion",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Session ID (UUID)",
+                        "name": "sessionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Approved tags and summary",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ApplySessionSuggestionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is the updated session",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.UpdateSessionContentSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request (including a summary for a session that has a description)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/sessions/{sessionID}/tags": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Links a tag (by id) to a session. The tag must already belong to the event. Only the event owner can add. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Add a tag to a session",
+                "operationId": "AddSessionTag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Session ID (UUID)",
+                        "name": "sessionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tag ID",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.AddSessionTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/sessions/{sessionID}/tags/{tagID}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Unlinks a tag from a session. Only the event owner can remove. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Remove a tag from a session",
+                "operationId": "RemoveSessionTag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Session ID (UUID)",
+                        "name": "sessionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tag ID (UUID)",
+                        "name": "tagID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/speakers": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the list of speakers for the event. Only the event owner can list. Requires authentication.",
+                "produces": [
+                    "application/json",
+                    "application/vnd.api+json",
+                    "application/vnd.m3t+json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "List speakers for an event",
+                "operationId": "ListEventSpeakers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is an array of speakers",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ListSpeakersSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a new speaker for the event (manual create). Only the event owner can create. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/vnd.api+json",
+                    "application/vnd.m3t+json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Create a speaker",
+                "operationId": "CreateEventSpeaker",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Speaker data",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.CreateSpeakerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "data contains the created speaker",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.CreateSpeakerSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/speakers/bulk": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Applies profile updates to many of the event's speakers in one transaction, for spreadsheet-style editing. Each update names a speaker_id and the fields to change; omitted fields keep their value and an empty string clears a text field. When any update is invalid (unknown speaker, speaker listed twice, nothing to change, no name left, bad email) nothing is applied: applied is false and each result's error says which items failed. Otherwise applied is true and each result has the updated speaker. With dry_run=true nothing is applied: dry_run is true and each valid result has the speaker as it would be. At most 500 updates per request. Only the event owner can update speakers. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Update many speakers at once",
+                "operationId": "BulkUpdateSpeakers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Speaker updates",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.BulkUpdateSpeakersRequest"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate the updates and show the result without applying them",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains whether the updates were applied and the per-item results",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.BulkUpdateSpeakersSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/speakers/merge-candidates": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the pending pairs of an imported speaker and an existing speaker that may be the same person. Sessionize imports queue a pair when the match is not certain (for example similar names, or equal names with different emails). Only the event owner can list. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "List possible duplicate speakers",
+                "operationId": "ListSpeakerMergeCandidates",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is an array of pending merge candidates",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ListSpeakerMergeCandidatesSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/speakers/merge-candidates/{candidateID}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Resolves a pending merge candidate. \"merge\" moves the imported speaker's sessions and Sessionize link onto the existing speaker and deletes the imported one, so the existing speaker keeps its ID. \"reject\" keeps both speakers and stops the pair from being suggested again. Only the event owner can resolve. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Merge or reject a possible duplicate speaker",
+                "operationId": "ResolveSpeakerMergeCandidate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Merge candidate ID (UUID)",
+                        "name": "candidateID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Resolution",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ResolveSpeakerMergeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the resolved merge candidate",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ResolveSpeakerMergeSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "error.code: conflict (already merged or rejected)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/speakers/photos.zip": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a zip of the speakers' photos at their original size, each named after its speaker (\"Ada Lovelace.jpg\", with \" (2)\" for a name already taken). Photos that cannot be downloaded are listed in missing.txt with their URL. Events with more than 50 speakers with a photo get 202 instead: the archive is built in the background, and data is the pending archive to poll at the Location header until its status is ready or failed. Asking again while it is pending returns the same archive. Only the event owner can export. Requires authentication.",
+                "produces": [
+                    "application/zip"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Download the photos of an event's speakers",
+                "operationId": "ExportSpeakerPhotos",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "zip archive",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "202": {
+                        "description": "data is the pending archive",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.SpeakerPhotoArchiveSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/speakers/photos/archives/{archiveID}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the archive's status: pending while it is built, then ready with the number of photos and the speakers whose photo could not be downloaded, or failed with the error. Only the event owner can read it. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Get a speaker photo archive",
+                "operationId": "GetSpeakerPhotoArchive",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Archive ID (UUID)",
+                        "name": "archiveID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is the archive",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.SpeakerPhotoArchiveSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/speakers/photos/archives/{archiveID}/download": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the zip of a ready archive, laid out as GET /events/{eventID}/speakers/photos.zip. Archives are kept for a day (RETENTION_SPEAKER_PHOTO_ARCHIVES_DAYS). Only the event owner can download it. Requires authentication.",
+                "produces": [
+                    "application/zip"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Download a speaker photo archive",
+                "operationId": "DownloadSpeakerPhotoArchive",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Archive ID (UUID)",
+                        "name": "archiveID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "zip archive",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "error.code: conflict (the archive is pending or failed)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/speakers/{speakerID}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a single speaker for the event with the list of sessions they speak in. Only the event owner can access. Requires authentication.",
+                "produces": [
+                    "application/json",
+                    "application/vnd.api+json",
+                    "application/vnd.m3t+json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Get a speaker by ID",
+                "operationId": "GetEventSpeaker",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Speaker ID (UUID)",
+                        "name": "speakerID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains speaker and sessions",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.GetEventSpeakerSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a speaker. Session-speaker links are removed by cascade. Only the event owner can delete. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Delete a speaker",
+                "operationId": "DeleteEventSpeaker",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Speaker ID (UUID)",
+                        "name": "speakerID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/speakers/{speakerID}/custom-fields": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sets the speaker's values of the event's speaker fields, keyed by field ID. Values must match the field's type: a string for text, url and date (YYYY-MM-DD), a number or true/false. Null or an empty string clears a field; fields not listed keep their value. Returns all of the speaker's values. Only the event owner can set them. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Set a speaker's custom field values",
+                "operationId": "SetSpeakerCustomFields",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Speaker ID (UUID)",
+                        "name": "speakerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Values by field ID",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.SetCustomFieldValuesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the speaker's custom field values",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.CustomFieldValuesSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/sync": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the rooms, sessions and speakers created, updated or deleted since the cursor, with their current data, so offline-first apps download only what changed. Omit since on the first sync to get everything, then pass the returned cursor; while has_more is true, request again with it right away. Rooms that are not bookable are included with not_bookable set. Only registered attendees or the event owner may access this.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attendee"
+                ],
+                "summary": "Get the schedule changes since a sync cursor",
+                "operationId": "SyncEvent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned by the previous sync",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Most changes to apply (1-500, default 200)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the changes and the next cursor",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.SyncEventSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not registered or owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/tags": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the list of tags associated with the event. Only the event owner can list. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "List tags for an event",
+                "operationId": "ListEventTags",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is an array of tags",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ListEventTagsSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adds one or more tags to the event by name (creates tags if missing). Only the event owner can add. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Add tags to an event",
+                "operationId": "AddEventTags",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tag names",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.AddEventTagsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "data contains the event's tags after add",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.AddEventTagsSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/tags/{tagID}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes the tag from the event and from all sessions of that event. Only the event owner can remove. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Remove a tag from an event",
+                "operationId": "RemoveEventTag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tag ID (UUID)",
+                        "name": "tagID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Renames a tag that belongs to the event. Only the event owner can update. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Update an event tag",
+                "operationId": "UpdateEventTag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tag ID (UUID)",
+                        "name": "tagID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New tag name",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.UpdateEventTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the updated tag",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.UpdateEventTagSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "error.code: conflict (duplicate name)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/team-members": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the list of team members for the event with each member's name, email and avatar_url. Only the event owner can list. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "List team members of an event",
+                "operationId": "ListEventTeamMembers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is an array of team members",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ListEventTeamMembersSuccessResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add a user as a team member of the event by email. Only the event owner can add. Returns 404 with a message if no user exists with that email. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Add a team member to an event",
+                "operationId": "AddEventTeamMember",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Email of the user to add",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.AddEventTeamMemberRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "data contains the added team member",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.AddEventTeamMemberSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found or user_not_found (no user with that email)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "error.code: already_member or conflict (invalid)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/team-members/me": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes the authenticated user from the event's team members and emails the event owner. The owner cannot leave their own event. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Leave an event's team",
+                "operationId": "LeaveEvent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data.status is left",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.RemoveEventTeamMemberSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request (caller is the owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found (no such event or caller is not a team member)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/team-members/{userID}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a user from the event's team members. Only the event owner can remove. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Remove a team member from an event",
+                "operationId": "RemoveEventTeamMember",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID) of the team member to remove",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains status",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.RemoveEventTeamMemberSuccessResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/theme": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the colors, fonts, footer text and support email white-label attendee apps show the event with. Events without a saved theme return empty fields, meaning the app's defaults. Only the event owner can read it here; apps read it from GET /public/events/{eventCode}/theme. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Get the event's theme",
+                "operationId": "GetEventTheme",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the theme",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.EventThemeSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the event's white-label theme. Colors are hex as #RRGGBB or #RRGGBBAA, fonts are family names the app loads itself, and empty fields fall back to the app's defaults. Only the event owner can update. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Replace the event's theme",
+                "operationId": "UpdateEventTheme",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Theme",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.UpdateEventThemeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the saved theme",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.EventThemeSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/tracks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the tracks of the event's sessions (the session track field) in display order: those placed with PUT /events/{eventID}/tracks/order first, then new ones by name. Only the event owner can list. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "List an event's tracks in display order",
+                "operationId": "ListEventTracks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is the track names in order",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ListTracksSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{eventID}/tracks/order": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sets the order tracks are shown in. tracks must list every track of the event's sessions exactly once; the first one is shown first. The order is used by the tracks list of the schedule grid, the attendee, public, machine and as_of schedules and the offline bundle. Tracks that sessions get later are added last, by name. Only the event owner can reorder. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Set the display order of an event's tracks",
+                "operationId": "ReorderEventTracks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Track names in display order",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ReorderTracksRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is the tracks in their new order",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ListTracksSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request (tracks missing, repeated or not the event's tracks)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not owner)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/exhibitor/booths": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the exhibitors the caller is booth staff of, across events, each with its event's name. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "exhibitor"
+                ],
+                "summary": "List the booths I staff",
+                "operationId": "ListMyBooths",
+                "responses": {
+                    "200": {
+                        "description": "data is the list of exhibitors",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ExhibitorsSuccessResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/exhibitors/{exhibitorID}/leads": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Records the attendee whose lead code was scanned as a lead of the exhibitor and returns their name and email. Only attendees of the exhibitor's event who consented have a code. Scanning the same attendee again returns the existing lead with 200, replacing its notes when notes are given. Booth staff of the exhibitor can scan. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "exhibitor"
+                ],
+                "summary": "Scan an attendee's lead code",
+                "operationId": "ScanLead",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibitor ID (UUID)",
+                        "name": "exhibitorID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Lead code and notes",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ScanLeadRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is the existing lead",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.LeadSuccessResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "data is the new lead",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.LeadSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not booth staff)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found (unknown exhibitor or code)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/exhibitors/{exhibitorID}/leads/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the exhibitor's leads as CSV, oldest scan first, with a header row: name, last_name, email, notes, scanned_at (RFC 3339, UTC). Available once the event has ended: after the last operating day closes, else after the last session, else the day after the event's date. Booth staff of the exhibitor can export. Requires authentication. Errors are the usual JSON envelope.",
+                "produces": [
+                    "text/csv"
+                ],
+                "tags": [
+                    "exhibitor"
+                ],
+                "summary": "Export an exhibitor's leads",
+                "operationId": "ExportLeads",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exhibitor ID (UUID)",
+                        "name": "exhibitorID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "CSV of the leads",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: forbidden (not booth staff)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "error.code: conflict (event not over)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/machine/events/{eventID}/rooms/{roomID}/occupancy": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Records people entering or leaving a room, for door sensors and manual clickers: delta adds to the count (1 in, -1 out; the count never goes below 0) and count sets it, e.g. after a head count. When a room with a capacity fills to 90% of it, the event owner is emailed once; they are alerted again only after it has emptied below 75%. The count shows in the rooms of the event's schedule. Requires a machine access token (POST /auth/machine-token) with the occupancy:write scope, issued to a client of this event.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attendee"
+                ],
+                "summary": "Report a room's occupancy",
+                "operationId": "UpdateRoomOccupancy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Room ID (UUID)",
+                        "name": "roomID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "delta or count",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.UpdateRoomOccupancyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is the room's occupancy",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.RoomOccupancySuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: insufficient_scope, or forbidden (client of another event)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/machine/events/{eventID}/schedule": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the same schedule as GET /attendee/events/{eventID}/schedule to an internal service such as signage. Requires a machine access token (POST /auth/machine-token) with the schedule:read scope, issued to a client of this event.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attendee"
+                ],
+                "summary": "Get event schedule for a machine client",
+                "operationId": "GetMachineEventSchedule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID (UUID)",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains event and rooms (bookable only) with nested sessions",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.GetEventScheduleSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: insufficient_scope, or forbidden (client of another event)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/machine/sandbox-events": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a disposable event for an integrator to test against, owned by the admin who created the machine client. Sandbox events work like any other event, except that their emails are archived as skipped instead of sent, they are left out of the business metrics, and they are deleted with everything in them RETENTION_SANDBOX_EVENTS_DAYS (default 7) after creation. Requires a machine access token (POST /auth/machine-token) with the sandbox:write scope.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Create a sandbox event",
+                "operationId": "CreateSandboxEvent",
+                "parameters": [
+                    {
+                        "description": "Event",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.CreateSandboxEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "data is the sandbox event, with its code",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.CreateEventSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized, or invalid_client (client revoked)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: insufficient_scope",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/meta/error-codes": {
+            "get": {
+                "description": "Returns the catalog of machine-readable error codes the API can return in error.code, with the HTTP status each is sent with and a short description.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "meta"
+                ],
+                "summary": "List error codes",
+                "operationId": "ListErrorCodes",
+                "responses": {
+                    "200": {
+                        "description": "data is an array of error code entries",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ListErrorCodesSuccessResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/public/events/{eventCode}/contact": {
+            "post": {
+                "description": "Sends the event's team a message from the public contact form. The message is stored as a thread and emailed to the owner and every team member; their replies are emailed back to the given address, so neither side's address is shown to the other in the app. Each address and each sender email may start a limited number of threads per window. Requests may be screened for bots (see the README): a filled-in honeypot field gets bot_detected, and where a captcha is required its token goes in captcha_token or the X-Captcha-Token header. Does not require authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attendee"
+                ],
+                "summary": "Contact an event's organizers",
+                "operationId": "ContactOrganizers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event code (4 characters)",
+                        "name": "eventCode",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Message",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ContactOrganizersRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "data.status is sent",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ContactOrganizersSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request, bot_detected or captcha_failed",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "error.code: rate_limited",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/public/events/{eventCode}/documents": {
+            "get": {
+                "description": "Returns the current version of each document, such as the code of conduct or photo policy, that registering for the event needs accepted. Apps show them before POST /events/join or POST /attendee/registrations and send the versions shown in accepted_documents. Responses carry Surrogate-Key event-{eventID}, which is purged from the CDN whenever the event changes. No login is needed; the event_code identifies the event.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attendee"
+                ],
+                "summary": "Get the documents an event asks attendees to accept",
+                "operationId": "GetPublicEventDocuments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event code (4 to 16 lowercase letters or digits)",
+                        "name": "eventCode",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the documents; none when the event asks for none",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.EventDocumentsSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/public/events/{eventCode}/metadata": {
+            "get": {
+                "description": "Returns the canonical URL, title, description and schema.org JSON-LD of the event's page and of a page per listed session and speaker, for public pages to put in their heads. The event is a schema.org Event with the sessions as subEvent; sessions are Events with their speakers as performer; speakers are Persons. Canonical URLs are on the server's PUBLIC_SITE_URL; without one the event's URL is its schedule page and sessions and speakers have none. Times are in UTC. Responses carry Surrogate-Key event-{eventID}, which is purged from the CDN whenever the event changes. No login is needed; the event_code identifies the event.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attendee"
+                ],
+                "summary": "Get the SEO metadata of an event's public pages",
+                "operationId": "GetPublicEventMetadata",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event code (4 lowercase letters or digits)",
+                        "name": "eventCode",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the metadata",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.EventMetadataSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/public/events/{eventCode}/offline-bundle": {
+            "get": {
+                "description": "Returns a zip archive the attendee app can prefetch before arriving at a venue with bad connectivity: manifest.json (version, generated_at, and the path, size and SHA-256 of every other file), schedule.json (the event with its location and operating hours, bookable rooms with directions and sessions, and the speakers of those sessions) and photos/{speakerID}.jpg thumbnails. Speaker photos that cannot be fetched are left out. The ETag is the manifest version; send it as If-None-Match to get 304 when nothing changed. Responses carry Surrogate-Key event-{eventID}, which is purged from the CDN whenever the event changes. No login is needed; the event_code identifies the event.",
+                "produces": [
+                    "application/zip"
+                ],
+                "tags": [
+                    "attendee"
+                ],
+                "summary": "Download the offline bundle of an event",
+                "operationId": "GetOfflineBundle",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event code (4 lowercase letters or digits)",
+                        "name": "eventCode",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Version of the bundle the app already has",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "zip archive",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "304": {
+                        "description": "the app's version is current"
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/public/events/{eventCode}/schedule.html": {
+            "get": {
+                "description": "Returns a minimal HTML page of the event's schedule, for organizers to share before they have a site of their own: the listed sessions by day and start time, with their room and speakers, in the event's theme colors and fonts. It prints cleanly. Times are in UTC. The ETag covers the page; send it as If-None-Match to get 304 when nothing changed. Responses carry Surrogate-Key event-{eventID}, which is purged from the CDN whenever the event changes. No login is needed; the event_code identifies the event. Errors are the usual JSON envelope.",
+                "produces": [
+                    "text/html"
+                ],
+                "tags": [
+                    "attendee"
+                ],
+                "summary": "Get the printable schedule page of an event",
+                "operationId": "GetPublicSchedulePage",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event code (4 lowercase letters or digits)",
+                        "name": "eventCode",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the page the client already has",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "HTML page",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "the client's page is current"
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/public/events/{eventCode}/theme": {
+            "get": {
+                "description": "Returns the colors, fonts, footer text and support email a white-label attendee app shows the event with. Empty fields mean the app's defaults. Responses carry Surrogate-Key event-{eventID}, which is purged from the CDN whenever the event changes. No login is needed; the event_code identifies the event.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attendee"
+                ],
+                "summary": "Get the theme of an event",
+                "operationId": "GetPublicEventTheme",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event code (4 lowercase letters or digits)",
+                        "name": "eventCode",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the theme",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.EventThemeSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: event_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/public/reports": {
+            "post": {
+                "description": "Flags an event, or one of its sessions or speakers, for review by the platform admins. Reports are anonymous; each address counts once per target. Once enough different addresses have reported a target it is unlisted from the public offline bundle and theme until an admin reviews it or the unlisting ends. Requests may be screened for bots (see the README): a filled-in honeypot field gets bot_detected, and where a captcha is required its token goes in captcha_token or the X-Captcha-Token header. Does not require authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attendee"
+                ],
+                "summary": "Report public content",
+                "operationId": "ReportAbuse",
+                "parameters": [
+                    {
+                        "description": "Report",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ReportAbuseRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "data.status is received",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ReportAbuseSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request, bot_detected or captcha_failed",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found (unknown event code, or target not the event's)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/public/sessions/{sessionID}/card": {
+            "get": {
+                "description": "Returns the title, description and preview image a page about the session puts in its head, and the matching Open Graph and Twitter card meta tags, so links to it look good when shared. The image is GET /public/sessions/{sessionID}/card.png, an absolute URL when the server's PUBLIC_BASE_URL is set. Only sessions on the public schedule have one. Responses carry Surrogate-Key event-{eventID}, which is purged from the CDN whenever the event changes. No login is needed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attendee"
+                ],
+                "summary": "Get the link preview tags of a session",
+                "operationId": "GetPublicSessionCard",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Session ID (UUID)",
+                        "name": "sessionID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the tags",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.SessionCardMetaSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/public/sessions/{sessionID}/card.png": {
+            "get": {
+                "description": "Returns a 1200x630 PNG for social media to show with shared links to the session: its title, time in UTC, room and speakers under the event's name, in the event's theme colors. Only sessions on the public schedule have one. The ETag covers the image; send it as If-None-Match to get 304 when nothing changed. Responses carry Surrogate-Key event-{eventID}, which is purged from the CDN whenever the event changes. No login is needed. Errors are the usual JSON envelope.",
+                "produces": [
+                    "image/png"
+                ],
+                "tags": [
+                    "attendee"
+                ],
+                "summary": "Get the preview image of a session",
+                "operationId": "GetPublicSessionCardImage",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Session ID (UUID)",
+                        "name": "sessionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the image the client already has",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "PNG image",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "304": {
+                        "description": "the client's image is current"
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/public/sitemap.xml": {
+            "get": {
+                "description": "Returns a sitemaps.org XML sitemap of every event's page and, when the server's PUBLIC_SITE_URL is set, the pages of the sessions in bookable rooms and of their speakers, with when each last changed. Unlisted content is left out. Without PUBLIC_SITE_URL the event pages are the server's schedule pages, absolute when PUBLIC_BASE_URL is set. At most 50,000 URLs are listed. No login is needed. Errors are the usual JSON envelope.",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "attendee"
+                ],
+                "summary": "Get the sitemap of the public pages",
+                "operationId": "GetSitemap",
+                "responses": {
+                    "200": {
+                        "description": "XML sitemap",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/readyz": {
+            "get": {
+                "description": "Checks the database and external providers. Returns 200 while every critical dependency is up (status \"degraded\" if a non-critical one such as Sessionize is failing) and 503 when a critical one is down.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "meta"
+                ],
+                "summary": "Readiness probe",
+                "operationId": "Readyz",
+                "responses": {
+                    "200": {
+                        "description": "data contains overall status and per-dependency health",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ReadyzSuccessResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "error.code: not_ready",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the authenticated user's profile (id, email, name, created_at, updated_at). Requires Bearer token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get current user",
+                "operationId": "GetMe",
+                "responses": {
+                    "200": {
+                        "description": "data contains the user",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.GetMeSuccessResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: user_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update the authenticated user's profile. Accepts optional name, last_name and avatar_url (an empty avatar_url removes it); email cannot be updated. Requires Bearer token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Update current user",
+                "operationId": "UpdateMe",
+                "parameters": [
+                    {
+                        "description": "Fields to update (name, last_name and avatar_url, all optional)",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the updated user",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.UpdateUserSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "error.code: user_not_found",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "error.code: duplicate_email",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/activity": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the requests support admins made acting as the caller with the X-Act-As header, newest first, with the admin's name, the method, path and resulting status. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "List requests admins made on my behalf",
+                "operationId": "ListMyActivity",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Most entries to return (1-200, default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data contains the activity",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ListMyActivitySuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/ip-allowlist": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the address ranges the caller's authenticated requests must come from. An empty list allows any address. Requires authentication.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get my IP allowlist",
+                "operationId": "GetMyIPAllowlist",
+                "responses": {
+                    "200": {
+                        "description": "data is the allowlist",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.IPAllowlistSuccessResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: ip_not_allowed",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the address ranges the caller's authenticated requests must come from; every other address gets 403 ip_not_allowed. The list must include the address the change is made from, so nobody locks themselves out; an empty list removes it. Changes can take up to 30 seconds to reach every server. Requires authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Replace my IP allowlist",
+                "operationId": "UpdateMyIPAllowlist",
+                "parameters": [
+                    {
+                        "description": "Ranges",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.IPAllowlistRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data is the normalized allowlist",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.IPAllowlistSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error.code: bad_request (malformed range, or the current address left out)",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "error.code: unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "error.code: ip_not_allowed",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "error.code: internal_error",
+                        "schema": {
+                            "$ref": "#/definitions/helpers.APIResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "controllers.AcceptEventDocumentsRequest": {
+            "type": "object",
+            "properties": {
+                "accepted_documents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.DocumentVersion"
+                    }
+                }
+            }
+        },
+        "controllers.AddEventTagsRequest": {
+            "type": "object",
+            "properties": {
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "controllers.AddEventTagsSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Tag"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.AddEventTeamMemberRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.AddEventTeamMemberSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.EventTeamMember"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.AddExhibitorStaffRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.AddSessionSpeakerRequest": {
+            "type": "object",
+            "properties": {
+                "speaker_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.AddSessionTagRequest": {
+            "type": "object",
+            "properties": {
+                "tag_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.AnnouncementRequest": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "description": "Body is Markdown.",
+                    "type": "string"
+                },
+                "category": {
+                    "description": "Category is one of feature, improvement and fix.",
+                    "type": "string"
+                },
+                "published_at": {
+                    "description": "PublishedAt is when the announcement shows in the changelog; omit it to keep a draft.",
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.AnnouncementSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.Announcement"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ApplySessionSuggestionRequest": {
+            "type": "object",
+            "properties": {
+                "summary": {
+                    "description": "Summary becomes the session's description; only allowed while it has none.",
+                    "type": "string"
+                },
+                "tags": {
+                    "description": "Tags are tag names to add to the event and the session.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "controllers.AttendeeDocumentsSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.AttendeeDocument"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.BulkUpdateSpeakersRequest": {
+            "type": "object",
+            "properties": {
+                "updates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.SpeakerUpdate"
+                    }
+                }
+            }
+        },
+        "controllers.BulkUpdateSpeakersSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.SpeakerBulkUpdateResult"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ChangelogResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Announcement"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/helpers.PaginationMeta"
+                },
+                "unread": {
+                    "description": "Unread is how many published announcements the caller has not read, across all pages.",
+                    "type": "integer"
+                }
+            }
+        },
+        "controllers.ChangelogSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/controllers.ChangelogResponse"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ChecklistProgressSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.ChecklistProgress"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ChecklistSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ChecklistItem"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ConfirmEventDeletionRequest": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "description": "Token is the confirmation token emailed to the owner.",
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.ContactMessageSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.ContactMessage"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ContactOrganizersRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "subject": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.ContactOrganizersResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string"
+                },
+                "thread_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.ContactOrganizersSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/controllers.ContactOrganizersResponse"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ContactThreadSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.ContactThread"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.CreateCustomFieldRequest": {
+            "type": "object",
+            "properties": {
+                "applies_to": {
+                    "description": "AppliesTo is session or speaker.",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "public": {
+                    "type": "boolean"
+                },
+                "type": {
+                    "description": "Type is one of text, number, boolean, date and url.",
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.CreateEventRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.CreateEventSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.Event"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.CreateExhibitorRequest": {
+            "type": "object",
+            "properties": {
+                "booth_number": {
+                    "description": "BoothNumber is optional; when set it must be unique within the event.",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.CreateInvitationWarmupRequest": {
+            "type": "object",
+            "properties": {
+                "daily_volumes": {
+                    "description": "DailyVolumes is the most invitations each day sends; the last volume repeats until all are sent.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "days": {
+                    "description": "Days spreads the send over that many days, doubling the volume every day.",
+                    "type": "integer"
+                },
+                "emails": {
+                    "description": "Emails is a long string of emails separated by commas or spaces.",
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.CreateMachineClientRequest": {
+            "type": "object",
+            "properties": {
+                "event_id": {
+                    "description": "EventID is the event the client is bound to. Omit it for a sandbox:write client.",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "description": "Scopes are what the client's tokens may do, e.g. [\"schedule:read\"].",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "controllers.CreateRoomRequest": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "how_to_get_there": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "not_bookable": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "controllers.CreateRoomSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.Room"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.CreateSandboxEventRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.CreateSessionRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "end_time": {
+                    "type": "string"
+                },
+                "room_id": {
+                    "type": "string"
+                },
+                "speaker_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.CreateSessionSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.Session"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.CreateSpeakerRequest": {
+            "type": "object",
+            "properties": {
+                "bio": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "is_top_speaker": {
+                    "type": "boolean"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "profile_picture": {
+                    "type": "string"
+                },
+                "tag_line": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.CreateSpeakerSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.Speaker"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.CustomFieldSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.CustomField"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.CustomFieldValuesSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.CustomFieldValue"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.CustomFieldsSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.CustomField"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.DeleteEventResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.DeleteEventSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/controllers.DeleteEventResponse"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.DeleteRoomSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/controllers.DeleteEventResponse"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.DocumentAcceptanceReportSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.DocumentAcceptanceReport"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.EmailDomainReportSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.EmailDomainReport"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.EventAnonymizationSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.EventAnonymization"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.EventCodeChangeSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.EventCodeChange"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.EventDocumentListSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.EventDocument"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.EventDocumentSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.EventDocument"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.EventDocumentsSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.EventDocuments"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.EventEmailSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.EventEmail"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.EventMetadata": {
+            "type": "object",
+            "properties": {
+                "event": {
+                    "$ref": "#/definitions/controllers.PageMetadata"
+                },
+                "sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controllers.PageMetadata"
+                    }
+                },
+                "speakers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controllers.PageMetadata"
+                    }
+                }
+            }
+        },
+        "controllers.EventMetadataSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/controllers.EventMetadata"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.EventThemeSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.EventTheme"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ExhibitorStaffSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.ExhibitorStaff"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ExhibitorSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.Exhibitor"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ExhibitorsSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Exhibitor"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.GetEventByIDResponse": {
+            "type": "object",
+            "properties": {
+                "event": {
+                    "$ref": "#/definitions/domain.Event"
+                },
+                "rooms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Room"
+                    }
+                },
+                "sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Session"
+                    }
+                }
+            }
+        },
+        "controllers.GetEventByIDSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/controllers.GetEventByIDResponse"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.GetEventScheduleSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.EventSchedule"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.GetEventSpeakerResponse": {
+            "type": "object",
+            "properties": {
+                "sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Session"
+                    }
+                },
+                "speaker": {
+                    "$ref": "#/definitions/domain.Speaker"
+                }
+            }
+        },
+        "controllers.GetEventSpeakerSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/controllers.GetEventSpeakerResponse"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.GetMeSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.User"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.GetRoomSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.Room"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.IPAllowlistRequest": {
+            "type": "object",
+            "properties": {
+                "ranges": {
+                    "description": "Ranges are CIDR ranges (e.g. 10.0.0.0/8) or single addresses; an empty list removes the allowlist.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "controllers.IPAllowlistSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.IPAllowlist"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ImportMappingSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.ImportMapping"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ImportSessionizeResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.ImportSessionizeSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/controllers.ImportSessionizeResponse"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.IntegrityCleanupSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.IntegrityCleanup"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.IntegrityReportSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.IntegrityReport"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.InvitationDomainRulesSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.InvitationDomainRules"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.InvitationWarmupSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.InvitationWarmup"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.InvitationWarmupsSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.InvitationWarmup"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.JoinEventRequest": {
+            "type": "object",
+            "properties": {
+                "accepted_documents": {
+                    "description": "AcceptedDocuments are the versions of the event's documents the user accepts, as\nGET /public/events/{eventCode}/documents lists them.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.DocumentVersion"
+                    }
+                },
+                "code": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.JoinEventSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.EventJoin"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.LeadConsentSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.LeadConsent"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.LeadSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.Lead"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ListAnnouncementsResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Announcement"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/helpers.PaginationMeta"
+                }
+            }
+        },
+        "controllers.ListAnnouncementsSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/controllers.ListAnnouncementsResponse"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ListContactThreadsResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ContactThread"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/helpers.PaginationMeta"
+                }
+            }
+        },
+        "controllers.ListContactThreadsSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/controllers.ListContactThreadsResponse"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ListErrorCodesSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/helpers.ErrorCodeInfo"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ListEventEmailsResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.EventEmail"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/helpers.PaginationMeta"
+                }
+            }
+        },
+        "controllers.ListEventEmailsSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/controllers.ListEventEmailsResponse"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ListEventInvitationsResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.EventInvitation"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/helpers.PaginationMeta"
+                }
+            }
+        },
+        "controllers.ListEventInvitationsSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/controllers.ListEventInvitationsResponse"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ListEventSessionsSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Session"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ListEventTagsSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Tag"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ListEventTeamMembersSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.EventTeamMember"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ListJoinedEventsSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Event"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ListMachineClientsSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.MachineClient"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ListMyActivitySuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.UserActivity"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ListMyEventsSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Event"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ListMyRegisteredEventsItem": {
+            "type": "object",
+            "properties": {
+                "event": {
+                    "$ref": "#/definitions/domain.Event"
+                },
+                "registration": {
+                    "$ref": "#/definitions/domain.EventRegistration"
+                }
+            }
+        },
+        "controllers.ListMyRegisteredEventsSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controllers.ListMyRegisteredEventsItem"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ListRoomsSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Room"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ListScheduleChangesSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.SessionChange"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ListSessionHistorySuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.SessionChange"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ListSpeakerMergeCandidatesSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.SpeakerMergeCandidate"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ListSpeakersSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Speaker"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ListTracksSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string"
+                },
+                "token_type": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/domain.User"
+                }
+            }
+        },
+        "controllers.LoginSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/controllers.LoginResponse"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.MachineClientCredentialsSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.MachineClientCredentials"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.MachineClientSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.MachineClient"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.MachineTokenRequest": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "string"
+                },
+                "client_secret": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.MachineTokenSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.MachineToken"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.MarkAnnouncementsReadRequest": {
+            "type": "object",
+            "properties": {
+                "announcement_ids": {
+                    "description": "AnnouncementIDs lists the announcements to mark read; empty or omitted marks all of them.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "controllers.MarkAnnouncementsReadResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.MarkAnnouncementsReadSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/controllers.MarkAnnouncementsReadResponse"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.MetaTag": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "property": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.ModerationItemSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.ModerationItem"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ModerationQueueResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ModerationItem"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/helpers.PaginationMeta"
+                }
+            }
+        },
+        "controllers.ModerationQueueSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/controllers.ModerationQueueResponse"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.OperatingDayRequest": {
+            "type": "object",
+            "properties": {
+                "closes_at": {
+                    "type": "string"
+                },
+                "day": {
+                    "type": "string"
+                },
+                "opens_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.OperatingHoursSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.OperatingDay"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.PageMetadata": {
+            "type": "object",
+            "properties": {
+                "canonical_url": {
+                    "description": "CanonicalURL is the page's preferred URL; empty when the page is not served anywhere.",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "json_ld": {
+                    "description": "JSONLD is the page's schema.org structured data, for a \u003cscript type=\"application/ld+json\"\u003e.",
+                    "type": "object"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.PendingEventDeletionSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.PendingEventDeletion"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.PromoteInvitationRequest": {
+            "type": "object",
+            "properties": {
+                "role": {
+                    "description": "Role is the role to grant; team_member, the only team role, when omitted.",
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.PublishEventDocumentRequest": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "description": "Body is the document's text, shown to attendees as is.",
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.ReadyzResponse": {
+            "type": "object",
+            "properties": {
+                "dependencies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.DependencyHealth"
+                    }
+                },
+                "status": {
+                    "description": "Status is \"ready\", or \"degraded\" when a non-critical dependency is failing.",
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.ReadyzSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/controllers.ReadyzResponse"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.RegisterForEventByCodeRequest": {
+            "type": "object",
+            "properties": {
+                "accepted_documents": {
+                    "description": "AcceptedDocuments are the versions of the event's documents the user accepts, as\nGET /public/events/{eventCode}/documents lists them.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.DocumentVersion"
+                    }
+                },
+                "event_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.RegisterForEventSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.EventRegistration"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.RemindEventInvitationsRequest": {
+            "type": "object",
+            "properties": {
+                "min_interval_hours": {
+                    "description": "MinIntervalHours is how long since the last email an invitee must wait for a reminder.\nOmitted or 0 means 72; the least allowed is 24.",
+                    "type": "integer"
+                }
+            }
+        },
+        "controllers.RemindEventInvitationsSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.InvitationReminderResult"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.RemoveEventTeamMemberResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.RemoveEventTeamMemberSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/controllers.RemoveEventTeamMemberResponse"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ReorderRoomsRequest": {
+            "type": "object",
+            "properties": {
+                "room_ids": {
+                    "description": "RoomIDs lists every room of the event once, in the order they should be shown.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "controllers.ReorderTracksRequest": {
+            "type": "object",
+            "properties": {
+                "tracks": {
+                    "description": "Tracks lists every track of the event once, in the order they should be shown.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "controllers.ReplyToContactThreadRequest": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.ReportAbuseRequest": {
+            "type": "object",
+            "properties": {
+                "details": {
+                    "type": "string"
+                },
+                "event_code": {
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "Reason is one of spam, offensive, impersonation, illegal and other.",
+                    "type": "string"
+                },
+                "target_id": {
+                    "description": "TargetID is the session or speaker ID; it may be left out for the event itself.",
+                    "type": "string"
+                },
+                "target_type": {
+                    "description": "TargetType is one of event, session and speaker.",
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.ReportAbuseResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.ReportAbuseSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/controllers.ReportAbuseResponse"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.RequestLoginCodeRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.ResolveModerationItemRequest": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "Action is dismiss (list the content again) or unlist (keep it unlisted until relisted).",
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.ResolveModerationItemResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.ResolveModerationItemSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/controllers.ResolveModerationItemResponse"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ResolveSpeakerMergeRequest": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "Action is \"merge\" to fold the imported speaker into the existing one, or \"reject\" to keep both.",
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.ResolveSpeakerMergeSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.SpeakerMergeCandidate"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.RoomOccupancySuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.RoomOccupancy"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ScanLeadRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "Code is the attendee's lead code, read from the QR code their app shows.",
+                    "type": "string"
+                },
+                "notes": {
+                    "description": "Notes replace the lead's notes when not empty.",
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.ScheduleGridSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.ScheduleGrid"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ScheduleOverlapsSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.ScheduleOverlaps"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ScheduleRulesSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.ScheduleRules"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ScheduleSnapshotSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.ScheduleSnapshot"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ScheduleValidationSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.ScheduleValidation"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.SearchEventsResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.EventSearchResult"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/helpers.PaginationMeta"
+                }
+            }
+        },
+        "controllers.SearchEventsSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/controllers.SearchEventsResponse"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.SendEventInvitationsRequest": {
+            "type": "object",
+            "properties": {
+                "emails": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.SendEventInvitationsResponse": {
+            "type": "object",
+            "properties": {
+                "failed": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "sent": {
+                    "type": "integer"
+                },
+                "skipped": {
+                    "description": "Skipped are the addresses the event's domain rules do not permit; they were not invited.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "controllers.SendEventInvitationsSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/controllers.SendEventInvitationsResponse"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.SessionCardMeta": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "image_height": {
+                    "type": "integer"
+                },
+                "image_url": {
+                    "description": "ImageURL is the session's preview image; absolute when the server knows its public URL.",
+                    "type": "string"
+                },
+                "image_width": {
+                    "type": "integer"
+                },
+                "tags": {
+                    "description": "Tags are the Open Graph and Twitter card tags for the values above.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controllers.MetaTag"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.SessionCardMetaSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/controllers.SessionCardMeta"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.SessionChecklistSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.SessionChecklist"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.SessionStreamsSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.SessionStreamLink"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.SessionSuggestionSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.SessionSuggestion"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.SetChecklistItemRequest": {
+            "type": "object",
+            "properties": {
+                "done": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "controllers.SetCustomFieldValuesRequest": {
+            "type": "object",
+            "properties": {
+                "values": {
+                    "description": "Values maps field IDs to values; null or an empty string clears a field. Fields not listed keep their value.",
+                    "type": "object",
+                    "additionalProperties": {}
+                }
+            }
+        },
+        "controllers.SetLeadConsentRequest": {
+            "type": "object",
+            "properties": {
+                "consent": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "controllers.SetSessionStreamsRequest": {
+            "type": "object",
+            "properties": {
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.SessionStreamLink"
+                    }
+                }
+            }
+        },
+        "controllers.SpeakerPhotoArchiveSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.SpeakerPhotoArchive"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.SyncEventSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.SyncDelta"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.ToggleRoomNotBookableSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.Room"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.UpdateChecklistRequest": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "controllers.UpdateCustomFieldRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "public": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "controllers.UpdateEventRequest": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "location_lat": {
+                    "type": "number"
+                },
+                "location_lng": {
+                    "type": "number"
+                }
+            }
+        },
+        "controllers.UpdateEventSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.Event"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.UpdateEventTagRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.UpdateEventTagSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.Tag"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.UpdateEventThemeRequest": {
+            "type": "object",
+            "properties": {
+                "background_color": {
+                    "type": "string"
+                },
+                "font_family": {
+                    "type": "string"
+                },
+                "footer_text": {
+                    "type": "string"
+                },
+                "heading_font_family": {
+                    "type": "string"
+                },
+                "primary_color": {
+                    "type": "string"
+                },
+                "secondary_color": {
+                    "type": "string"
+                },
+                "support_email": {
+                    "type": "string"
+                },
+                "text_color": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.UpdateImportMappingRequest": {
+            "type": "object",
+            "properties": {
+                "room_name_overrides": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "session_type_category": {
+                    "type": "string"
+                },
+                "tag_categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "track_category": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.UpdateInvitationDomainRulesRequest": {
+            "type": "object",
+            "properties": {
+                "allowed_domains": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "blocked_domains": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "controllers.UpdateOperatingHoursRequest": {
+            "type": "object",
+            "properties": {
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controllers.OperatingDayRequest"
+                    }
+                }
+            }
+        },
+        "controllers.UpdateRoomOccupancyRequest": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "delta": {
+                    "type": "integer"
+                }
+            }
+        },
+        "controllers.UpdateRoomRequest": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "how_to_get_there": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "not_bookable": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "controllers.UpdateRoomSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.Room"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.UpdateScheduleRulesRequest": {
+            "type": "object",
+            "properties": {
+                "allowed_days": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "allowed_durations": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "earliest_start": {
+                    "type": "string"
+                },
+                "latest_end": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.UpdateSessionContentRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.UpdateSessionContentSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.Session"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.UpdateSessionScheduleRequest": {
+            "type": "object",
+            "properties": {
+                "end_time": {
+                    "type": "string"
+                },
+                "room_id": {
+                    "type": "string"
+                },
+                "start_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.UpdateSessionScheduleSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.Session"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.UpdateUserRequest": {
+            "type": "object",
+            "properties": {
+                "avatar_url": {
+                    "description": "AvatarURL is an http(s) URL of the profile picture; an empty string removes it.",
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.UpdateUserSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/domain.User"
+                },
+                "error": {
+                    "$ref": "#/definitions/helpers.APIError"
+                }
+            }
+        },
+        "controllers.VerifyLoginCodeRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.AbuseReport": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "details": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "resolved_at": {
+                    "type": "string"
+                },
+                "resolved_by": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "target_id": {
+                    "type": "string"
+                },
+                "target_type": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.Announcement": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "description": "Body is Markdown.",
+                    "type": "string"
+                },
+                "category": {
+                    "description": "Category is one of feature, improvement and fix.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "published_at": {
+                    "description": "PublishedAt is when the announcement shows in the changelog. Nil keeps it a draft and a future\ntime schedules it.",
+                    "type": "string"
+                },
+                "read": {
+                    "description": "Read reports whether the caller has read it; it is only set in the changelog.",
+                    "type": "boolean"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.AttendeeAcceptance": {
+            "type": "object",
+            "properties": {
+                "accepted_at": {
+                    "type": "string"
+                },
+                "accepted_version": {
+                    "description": "AcceptedVersion is the latest version the attendee accepted; 0 when they never did.",
+                    "type": "integer"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.AttendeeDocument": {
+            "type": "object",
+            "properties": {
+                "accepted_at": {
+                    "type": "string"
+                },
+                "accepted_version": {
+                    "description": "AcceptedVersion is the latest version the user accepted; 0 when they never did.",
+                    "type": "integer"
+                },
+                "document": {
+                    "$ref": "#/definitions/domain.EventDocument"
+                },
+                "needs_acceptance": {
+                    "description": "NeedsAcceptance is set until the user accepts the current version, such as after it changed.",
+                    "type": "boolean"
+                }
+            }
+        },
+        "domain.ChecklistItem": {
+            "type": "object",
+            "properties": {
+                "event_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.ChecklistMemberProgress": {
+            "type": "object",
+            "properties": {
+                "completed": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.ChecklistProgress": {
+            "type": "object",
+            "properties": {
+                "event_id": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ChecklistItem"
+                    }
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ChecklistMemberProgress"
+                    }
+                },
+                "sessions": {
+                    "description": "Sessions are ordered by start time and may be filtered by readiness; the totals above are not.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.SessionChecklist"
+                    }
+                },
+                "sessions_ready": {
+                    "type": "integer"
+                },
+                "sessions_total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.ContactMessage": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "description": "Author is sender or team.",
+                    "type": "string"
+                },
+                "body": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "thread_id": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "UserID is the team member who replied; empty for the sender's messages.",
+                    "type": "string"
+                }
+            }
+        },
+        "domain.ContactThread": {
             "type": "object",
             "properties": {
-                "tag_id": {
+                "created_at": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "message_count": {
+                    "description": "MessageCount is how many messages the thread has, the first one included.",
+                    "type": "integer"
+                },
+                "messages": {
+                    "description": "Messages is the conversation, oldest first; it is only set when a single thread is fetched.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ContactMessage"
+                    }
+                },
+                "sender_email": {
+                    "type": "string"
+                },
+                "sender_name": {
+                    "type": "string"
+                },
+                "subject": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt is when the last message was added.",
                     "type": "string"
                 }
             }
         },
-        "controllers.CreateEventRequest": {
+        "domain.CustomField": {
+            "type": "object",
+            "properties": {
+                "applies_to": {
+                    "description": "AppliesTo is session or speaker.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "public": {
+                    "description": "Public fields are shown to attendees: in the schedule, delta sync and offline bundle.",
+                    "type": "boolean"
+                },
+                "type": {
+                    "description": "Type is one of text, number, boolean, date and url.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.CustomFieldValue": {
+            "type": "object",
+            "properties": {
+                "field_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "value": {}
+            }
+        },
+        "domain.DependencyHealth": {
+            "type": "object",
+            "properties": {
+                "critical": {
+                    "description": "Critical dependencies make the API not ready when down; others only degrade some features.",
+                    "type": "boolean"
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.DocumentAcceptanceReport": {
+            "type": "object",
+            "properties": {
+                "accepted_current": {
+                    "description": "AcceptedCurrent counts the attendees who accepted the current version, AcceptedOlder those\nwhose latest acceptance is of an older one, and NeverAccepted the rest, such as those who\nregistered before the document was published.",
+                    "type": "integer"
+                },
+                "accepted_older": {
+                    "type": "integer"
+                },
+                "attendees": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.AttendeeAcceptance"
+                    }
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "never_accepted": {
+                    "type": "integer"
+                },
+                "registered": {
+                    "type": "integer"
+                },
+                "version": {
+                    "description": "Version is the current version.",
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.DocumentVersion": {
+            "type": "object",
+            "properties": {
+                "kind": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.EmailDomainRecord": {
+            "type": "object",
+            "properties": {
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name is the DNS name that was looked up (e.g. _dmarc.example.com).",
+                    "type": "string"
+                },
+                "problem": {
+                    "description": "Problem says what is missing or wrong; it may also warn about a weak but valid record.",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "values": {
+                    "description": "Values are the TXT values found that look like this kind of record.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "domain.EmailDomainReport": {
+            "type": "object",
+            "properties": {
+                "checked_at": {
+                    "type": "string"
+                },
+                "domain": {
+                    "type": "string"
+                },
+                "ok": {
+                    "description": "OK is false when any record is missing or invalid.",
+                    "type": "boolean"
+                },
+                "records": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.EmailDomainRecord"
+                    }
+                }
+            }
+        },
+        "domain.Event": {
             "type": "object",
             "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "event_code": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "location_lat": {
+                    "type": "number"
+                },
+                "location_lng": {
+                    "type": "number"
+                },
                 "name": {
                     "type": "string"
+                },
+                "operating_hours": {
+                    "description": "OperatingHours lists the open and close times of each event day. It is only filled in\nwhere the full event is returned, such as GET /events/{eventID} and the attendee schedule.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.OperatingDay"
+                    }
+                },
+                "owner_id": {
+                    "type": "string"
+                },
+                "role": {
+                    "description": "Role is the caller's role in the event, owner or team_member. It is only set in the\ncaller's event list, GET /events/me.",
+                    "type": "string"
+                },
+                "sandbox": {
+                    "description": "Sandbox marks a disposable test event created by a sandbox machine client. Sandbox events send\nno email, are left out of the business metrics and are deleted after RETENTION_SANDBOX_EVENTS_DAYS.",
+                    "type": "boolean"
+                },
+                "stats": {
+                    "description": "Stats is only filled in when asked for, as in GET /events/me?include=stats.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.EventStats"
+                        }
+                    ]
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.EventAnonymization": {
+            "type": "object",
+            "properties": {
+                "anonymized_at": {
+                    "type": "string"
+                },
+                "anonymized_by": {
+                    "description": "AnonymizedBy is the user who anonymized the event; it is empty when the retention policy did.",
+                    "type": "string"
+                },
+                "custom_field_values_cleared": {
+                    "type": "integer"
+                },
+                "dry_run": {
+                    "description": "DryRun is set on the preview of an anonymization; its counts are what would be removed.",
+                    "type": "boolean"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "invitations_accepted": {
+                    "type": "integer"
+                },
+                "invitations_hashed": {
+                    "description": "InvitationsHashed, SpeakerEmailsCleared and CustomFieldValuesCleared count what was removed.",
+                    "type": "integer"
+                },
+                "invitations_sent": {
+                    "type": "integer"
+                },
+                "registrations": {
+                    "type": "integer"
+                },
+                "speaker_emails_cleared": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.EventCodeChange": {
+            "type": "object",
+            "properties": {
+                "event": {
+                    "$ref": "#/definitions/domain.Event"
+                },
+                "invitations_failed": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "invitations_resent": {
+                    "description": "InvitationsResent counts the invitees who had not registered yet and were emailed the new\ncode; InvitationsFailed lists the addresses that could not be.",
+                    "type": "integer"
+                },
+                "previous_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.EventDocument": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "published_at": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "version": {
+                    "description": "Version counts from 1 for each kind of document of the event.",
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.EventDocuments": {
+            "type": "object",
+            "properties": {
+                "documents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.EventDocument"
+                    }
+                },
+                "event_id": {
+                    "type": "string"
                 }
             }
         },
-        "controllers.CreateEventSuccessResponse": {
+        "domain.EventEmail": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/domain.Event"
+                "created_at": {
+                    "type": "string"
                 },
                 "error": {
-                    "$ref": "#/definitions/helpers.APIError"
+                    "description": "Error is why the mailer could not send the message; it is empty when it was sent.",
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "html": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "resend_of": {
+                    "description": "ResendOf is the ID of the email this one sent again, if it is a resend.",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "subject": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
                 }
             }
         },
-        "controllers.CreateRoomRequest": {
+        "domain.EventInvitation": {
             "type": "object",
             "properties": {
-                "capacity": {
-                    "type": "integer"
+                "accepted_by": {
+                    "description": "AcceptedBy is set once a user with the invited email has registered for the event.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.InvitationAcceptance"
+                        }
+                    ]
                 },
-                "description": {
+                "email": {
                     "type": "string"
                 },
-                "how_to_get_there": {
+                "event_id": {
                     "type": "string"
                 },
-                "name": {
+                "id": {
                     "type": "string"
                 },
-                "not_bookable": {
-                    "type": "boolean"
+                "sent_at": {
+                    "type": "string"
                 }
             }
         },
-        "controllers.CreateRoomSuccessResponse": {
+        "domain.EventJoin": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/domain.Room"
+                "joined": {
+                    "description": "Joined is set when this call registered the user; false when they already were.",
+                    "type": "boolean"
                 },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
+                "pending_documents": {
+                    "description": "PendingDocuments are the event's documents changed since the attendee accepted them, to\nprompt for accepting again; always empty when Joined.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.EventDocument"
+                    }
+                },
+                "registration": {
+                    "$ref": "#/definitions/domain.EventRegistration"
+                },
+                "schedule": {
+                    "$ref": "#/definitions/domain.EventSchedule"
                 }
             }
         },
-        "controllers.CreateSessionRequest": {
+        "domain.EventRegistration": {
             "type": "object",
             "properties": {
-                "description": {
+                "created_at": {
                     "type": "string"
                 },
-                "end_time": {
+                "event_id": {
                     "type": "string"
                 },
-                "room_id": {
+                "id": {
                     "type": "string"
                 },
-                "speaker_ids": {
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.EventSchedule": {
+            "type": "object",
+            "properties": {
+                "event": {
+                    "$ref": "#/definitions/domain.Event"
+                },
+                "rooms": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/domain.RoomWithSessions"
                     }
                 },
-                "start_time": {
-                    "type": "string"
-                },
-                "tags": {
+                "tracks": {
+                    "description": "Tracks are the tracks of the sessions, in the order the owner set.",
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
+                }
+            }
+        },
+        "domain.EventSearchResult": {
+            "type": "object",
+            "properties": {
+                "event": {
+                    "$ref": "#/definitions/domain.Event"
                 },
-                "title": {
-                    "type": "string"
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "controllers.CreateSessionSuccessResponse": {
+        "domain.EventStats": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/domain.Session"
+                "invitations_accepted": {
+                    "type": "integer"
                 },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
+                "invitations_sent": {
+                    "description": "InvitationsSent is how many emails were invited to register; InvitationsAccepted is how\nmany of them have registered since.",
+                    "type": "integer"
+                },
+                "rooms": {
+                    "type": "integer"
+                },
+                "sessions": {
+                    "type": "integer"
+                },
+                "speakers": {
+                    "type": "integer"
+                },
+                "team_size": {
+                    "description": "TeamSize counts the owner and the team members.",
+                    "type": "integer"
                 }
             }
         },
-        "controllers.CreateSpeakerRequest": {
+        "domain.EventTeamMember": {
             "type": "object",
             "properties": {
-                "bio": {
+                "avatar_url": {
+                    "description": "AvatarURL is the member's profile picture; empty when they have not set one.",
                     "type": "string"
                 },
-                "first_name": {
+                "email": {
                     "type": "string"
                 },
-                "is_top_speaker": {
-                    "type": "boolean"
+                "event_id": {
+                    "type": "string"
                 },
                 "last_name": {
                     "type": "string"
                 },
-                "profile_picture": {
+                "name": {
                     "type": "string"
                 },
-                "tag_line": {
+                "user_id": {
                     "type": "string"
                 }
             }
         },
-        "controllers.CreateSpeakerSuccessResponse": {
+        "domain.EventTheme": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/domain.Speaker"
+                "background_color": {
+                    "type": "string"
                 },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
+                "event_id": {
+                    "type": "string"
+                },
+                "font_family": {
+                    "description": "FontFamily and HeadingFontFamily are CSS font family names (e.g. \"Inter\"); apps load them\nthemselves.",
+                    "type": "string"
+                },
+                "footer_text": {
+                    "type": "string"
+                },
+                "heading_font_family": {
+                    "type": "string"
+                },
+                "primary_color": {
+                    "description": "Colors are hex colors as \"#RRGGBB\" or \"#RRGGBBAA\".",
+                    "type": "string"
+                },
+                "secondary_color": {
+                    "type": "string"
+                },
+                "support_email": {
+                    "type": "string"
+                },
+                "text_color": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "controllers.DeleteEventResponse": {
+        "domain.Exhibitor": {
             "type": "object",
             "properties": {
-                "status": {
+                "booth_number": {
+                    "description": "BoothNumber is unique within the event; empty while the booth is unassigned.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "event_name": {
+                    "description": "EventName is only set in the booths of the caller, GET /exhibitor/booths.",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "staff": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ExhibitorStaff"
+                    }
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "controllers.DeleteEventSuccessResponse": {
+        "domain.ExhibitorStaff": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/controllers.DeleteEventResponse"
+                "email": {
+                    "type": "string"
                 },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
+                "exhibitor_id": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
                 }
             }
         },
-        "controllers.DeleteRoomSuccessResponse": {
+        "domain.IPAllowlist": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/controllers.DeleteEventResponse"
+                "client_id": {
+                    "type": "string"
                 },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
+                "ranges": {
+                    "description": "Ranges are CIDR ranges; single addresses are kept as /32 (IPv4) or /128 (IPv6) ranges.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "user_id": {
+                    "description": "UserID is set on an account's list, ClientID on a machine client's.",
+                    "type": "string"
                 }
             }
         },
-        "controllers.GetEventByIDResponse": {
+        "domain.ImportMapping": {
             "type": "object",
             "properties": {
-                "event": {
-                    "$ref": "#/definitions/domain.Event"
+                "event_id": {
+                    "type": "string"
                 },
-                "rooms": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/domain.Room"
+                "room_name_overrides": {
+                    "description": "RoomNameOverrides renames imported rooms, keyed by the Sessionize room name.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
                     }
                 },
-                "sessions": {
+                "session_type_category": {
+                    "description": "SessionTypeCategory is the category whose item sets Session.SessionType (e.g. \"Tipo de sesión\").",
+                    "type": "string"
+                },
+                "tag_categories": {
+                    "description": "TagCategories lists the categories whose items become session tags. Empty means every\ncategory not used for the session type or track.",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/domain.Session"
+                        "type": "string"
                     }
-                }
-            }
-        },
-        "controllers.GetEventByIDSuccessResponse": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "$ref": "#/definitions/controllers.GetEventByIDResponse"
                 },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
-                }
-            }
-        },
-        "controllers.GetEventScheduleSuccessResponse": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "$ref": "#/definitions/domain.EventSchedule"
+                "track_category": {
+                    "description": "TrackCategory is the category whose item sets Session.Track.",
+                    "type": "string"
                 },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "controllers.GetEventSpeakerResponse": {
+        "domain.IntegrityCleanup": {
             "type": "object",
             "properties": {
-                "sessions": {
+                "changed": {
+                    "description": "Changed is the number of rows the cleanup inserted or deleted; always 0 in a dry run.",
+                    "type": "integer"
+                },
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "issues": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/domain.Session"
+                        "$ref": "#/definitions/domain.IntegrityIssue"
                     }
-                },
-                "speaker": {
-                    "$ref": "#/definitions/domain.Speaker"
                 }
             }
         },
-        "controllers.GetEventSpeakerSuccessResponse": {
+        "domain.IntegrityIssue": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/controllers.GetEventSpeakerResponse"
+                "detail": {
+                    "description": "Detail is a human-readable name for the entity, such as the tag name or session title.",
+                    "type": "string"
                 },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
+                "entity_id": {
+                    "description": "EntityID is the tag, session, speaker or room the issue is about.",
+                    "type": "string"
+                },
+                "fixable": {
+                    "type": "boolean"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "related_id": {
+                    "description": "RelatedID is the other side of a pair: the tag of an undeclared session tag, the speaker of a\ncross-event link or the second session of an overlap.",
+                    "type": "string"
                 }
             }
         },
-        "controllers.GetMeSuccessResponse": {
+        "domain.IntegrityReport": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/domain.User"
+                "event_id": {
+                    "type": "string"
                 },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
+                "fixable": {
+                    "description": "Fixable is the number of issues a cleanup would resolve.",
+                    "type": "integer"
+                },
+                "generated_at": {
+                    "type": "string"
+                },
+                "issues": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.IntegrityIssue"
+                    }
                 }
             }
         },
-        "controllers.GetRoomSuccessResponse": {
+        "domain.InvitationAcceptance": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/domain.Room"
+                "accepted_at": {
+                    "description": "AcceptedAt is when the user registered for the event.",
+                    "type": "string"
                 },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
+                "avatar_url": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
                 }
             }
         },
-        "controllers.ImportSessionizeResponse": {
+        "domain.InvitationDomainRules": {
             "type": "object",
             "properties": {
-                "status": {
+                "allowed_domains": {
+                    "description": "AllowedDomains, when not empty, are the only domains invitations can be sent to (e.g. \"acme.com\").",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "blocked_domains": {
+                    "description": "BlockedDomains are domains invitations are never sent to (e.g. \"gmail.com\").",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "controllers.ImportSessionizeSuccessResponse": {
+        "domain.InvitationReminderResult": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/controllers.ImportSessionizeResponse"
+                "failed": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
+                "sent": {
+                    "type": "integer"
+                },
+                "throttled": {
+                    "description": "Throttled counts the invitees left alone because they were emailed within the interval or\nalready got MaxInvitationReminders reminders.",
+                    "type": "integer"
                 }
             }
         },
-        "controllers.ListEventInvitationsResponse": {
+        "domain.InvitationWarmup": {
             "type": "object",
             "properties": {
-                "items": {
+                "completed_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "daily_volumes": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/domain.EventInvitation"
+                        "type": "integer"
                     }
                 },
-                "pagination": {
-                    "$ref": "#/definitions/helpers.PaginationMeta"
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "id": {
+                    "description": "ID is empty in a dry run.",
+                    "type": "string"
+                },
+                "paused_at": {
+                    "type": "string"
+                },
+                "queued": {
+                    "type": "integer"
+                },
+                "schedule": {
+                    "description": "Schedule lists every day of the warm-up with its volume.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.WarmupDay"
+                    }
+                },
+                "sent": {
+                    "type": "integer"
+                },
+                "skipped": {
+                    "description": "Skipped counts addresses the event's invitation domain rules did not permit when their turn came.",
+                    "type": "integer"
+                },
+                "starts_at": {
+                    "description": "StartsAt is when day 1 began. Resuming a paused warm-up moves it later by the time paused, so\nno day's volume is lost or doubled.",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "controllers.ListEventInvitationsSuccessResponse": {
+        "domain.Lead": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/controllers.ListEventInvitationsResponse"
+                "email": {
+                    "type": "string"
                 },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
+                "event_id": {
+                    "type": "string"
+                },
+                "exhibitor_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "scanned_at": {
+                    "type": "string"
+                },
+                "scanned_by": {
+                    "description": "ScannedBy is the staff member who scanned the attendee first; empty once their account is gone.",
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
                 }
             }
         },
-        "controllers.ListEventTagsSuccessResponse": {
+        "domain.LeadConsent": {
             "type": "object",
             "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/domain.Tag"
-                    }
+                "code": {
+                    "description": "Code is what the attendee app shows as a QR code for booth staff to scan.",
+                    "type": "string"
                 },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
+                "consent": {
+                    "type": "boolean"
+                },
+                "event_id": {
+                    "type": "string"
                 }
             }
         },
-        "controllers.ListEventTeamMembersSuccessResponse": {
+        "domain.MachineClient": {
             "type": "object",
             "properties": {
-                "data": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "CreatedBy is the admin who created the client.",
+                    "type": "string"
+                },
+                "event_id": {
+                    "description": "EventID is empty for sandbox clients, which create their own events.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID is the client ID sent with the secret to get a token.",
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "scopes": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/domain.EventTeamMember"
+                        "type": "string"
                     }
-                },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
                 }
             }
         },
-        "controllers.ListMyEventsSuccessResponse": {
+        "domain.MachineClientCredentials": {
             "type": "object",
             "properties": {
-                "data": {
+                "client_secret": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "CreatedBy is the admin who created the client.",
+                    "type": "string"
+                },
+                "event_id": {
+                    "description": "EventID is empty for sandbox clients, which create their own events.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID is the client ID sent with the secret to get a token.",
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "scopes": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/domain.Event"
+                        "type": "string"
                     }
+                }
+            }
+        },
+        "domain.MachineToken": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
                 },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
+                "expires_in": {
+                    "description": "ExpiresIn is the token's lifetime in seconds.",
+                    "type": "integer"
+                },
+                "scope": {
+                    "description": "Scope is the token's scopes, space-separated.",
+                    "type": "string"
+                },
+                "token_type": {
+                    "type": "string"
                 }
             }
         },
-        "controllers.ListMyRegisteredEventsItem": {
+        "domain.ModerationItem": {
             "type": "object",
             "properties": {
-                "event": {
-                    "$ref": "#/definitions/domain.Event"
+                "event_id": {
+                    "type": "string"
                 },
-                "registration": {
-                    "$ref": "#/definitions/domain.EventRegistration"
+                "event_name": {
+                    "type": "string"
+                },
+                "first_reported_at": {
+                    "type": "string"
+                },
+                "last_reported_at": {
+                    "type": "string"
+                },
+                "open_reports": {
+                    "type": "integer"
+                },
+                "reports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.AbuseReport"
+                    }
+                },
+                "target_id": {
+                    "type": "string"
+                },
+                "target_type": {
+                    "type": "string"
+                },
+                "title": {
+                    "description": "Title is the session title or speaker name; the event name for event targets. It is empty\nwhen the target has since been deleted.",
+                    "type": "string"
+                },
+                "unlisted": {
+                    "description": "Unlisted reports whether the target is hidden from public pages now; UnlistedUntil is when a\ntemporary unlisting ends.",
+                    "type": "boolean"
+                },
+                "unlisted_until": {
+                    "type": "string"
                 }
             }
         },
-        "controllers.ListMyRegisteredEventsSuccessResponse": {
+        "domain.OperatingDay": {
             "type": "object",
             "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/controllers.ListMyRegisteredEventsItem"
-                    }
+                "closes_at": {
+                    "type": "string"
                 },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
+                "day": {
+                    "description": "Day is the event day, as \"YYYY-MM-DD\".",
+                    "type": "string"
+                },
+                "opens_at": {
+                    "type": "string"
                 }
             }
         },
-        "controllers.ListRoomsSuccessResponse": {
+        "domain.OverlapBucket": {
             "type": "object",
             "properties": {
-                "data": {
+                "end": {
+                    "type": "string"
+                },
+                "groups": {
+                    "description": "Groups are the groups with a session in the bucket, the most sessions first; the sessions\nwithout a tag or track come last.",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/domain.Room"
+                        "$ref": "#/definitions/domain.OverlapGroup"
                     }
                 },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
+                "session_count": {
+                    "description": "SessionCount is how many sessions run in the bucket; a session counts once even when it is\nin several groups.",
+                    "type": "integer"
+                },
+                "start": {
+                    "type": "string"
                 }
             }
         },
-        "controllers.ListSpeakersSuccessResponse": {
+        "domain.OverlapGroup": {
             "type": "object",
             "properties": {
-                "data": {
+                "clash": {
+                    "description": "Clash is set when more than one session of a named group runs in the bucket.",
+                    "type": "boolean"
+                },
+                "key": {
+                    "description": "Key is the tag name or track; empty for the sessions without one. A session with several\ntags is in the group of each.",
+                    "type": "string"
+                },
+                "sessions": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/domain.Speaker"
+                        "$ref": "#/definitions/domain.OverlapSession"
                     }
-                },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
                 }
             }
         },
-        "controllers.LoginResponse": {
+        "domain.OverlapSession": {
             "type": "object",
             "properties": {
-                "token": {
+                "end_time": {
                     "type": "string"
                 },
-                "token_type": {
+                "room_id": {
                     "type": "string"
                 },
-                "user": {
-                    "$ref": "#/definitions/domain.User"
-                }
-            }
-        },
-        "controllers.LoginSuccessResponse": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "$ref": "#/definitions/controllers.LoginResponse"
+                "session_id": {
+                    "type": "string"
                 },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
+                "start_time": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
                 }
             }
         },
-        "controllers.RegisterForEventByCodeRequest": {
+        "domain.PendingEventDeletion": {
             "type": "object",
             "properties": {
-                "event_code": {
+                "event_id": {
+                    "type": "string"
+                },
+                "expires_at": {
                     "type": "string"
+                },
+                "force": {
+                    "description": "Force is whether confirming also deletes attendee registrations.",
+                    "type": "boolean"
                 }
             }
         },
-        "controllers.RegisterForEventSuccessResponse": {
+        "domain.Room": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/domain.EventRegistration"
+                "capacity": {
+                    "type": "integer"
                 },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "display_order": {
+                    "description": "DisplayOrder is the room's position in the event's schedules, starting at 1; rooms are listed\nby it, then by name. New rooms go last.",
+                    "type": "integer"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "how_to_get_there": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "not_bookable": {
+                    "type": "boolean"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "source_session_id": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "controllers.RemoveEventTeamMemberResponse": {
+        "domain.RoomOccupancy": {
             "type": "object",
             "properties": {
-                "status": {
+                "alerted_at": {
+                    "description": "AlertedAt is when the owner was alerted the room is near capacity; cleared once it empties\nbelow CapacityRearmPercent.",
+                    "type": "string"
+                },
+                "capacity": {
+                    "description": "Capacity is the room's capacity; 0 when it has none.",
+                    "type": "integer"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "near_capacity": {
+                    "description": "NearCapacity is set once Count reaches CapacityAlertPercent of Capacity.",
+                    "type": "boolean"
+                },
+                "room_id": {
+                    "type": "string"
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "controllers.RemoveEventTeamMemberSuccessResponse": {
+        "domain.RoomWithSessions": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/controllers.RemoveEventTeamMemberResponse"
+                "occupancy": {
+                    "description": "Occupancy is how many people are in the room now, for signage; absent until a device of the\nevent reports it.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.RoomOccupancy"
+                        }
+                    ]
                 },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
+                "room": {
+                    "$ref": "#/definitions/domain.Room"
+                },
+                "sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Session"
+                    }
                 }
             }
         },
-        "controllers.RequestLoginCodeRequest": {
+        "domain.ScheduleGrid": {
             "type": "object",
             "properties": {
-                "email": {
+                "built_at": {
+                    "type": "string"
+                },
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ScheduleGridDay"
+                    }
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "rooms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ScheduleGridRoom"
+                    }
+                },
+                "timezone": {
+                    "description": "Timezone is the IANA time zone days are read in: the schedule rules' time zone, or UTC.",
                     "type": "string"
+                },
+                "tracks": {
+                    "description": "Tracks are the tracks of the sessions, in the order the owner set.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "unscheduled": {
+                    "description": "Unscheduled lists the sessions without a room, ordered by start time.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ScheduleGridSession"
+                    }
                 }
             }
         },
-        "controllers.SendEventInvitationsRequest": {
+        "domain.ScheduleGridColumn": {
             "type": "object",
             "properties": {
-                "emails": {
+                "gaps": {
+                    "description": "Gaps are the free stretches of the room between the day's Start and End.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ScheduleGridGap"
+                    }
+                },
+                "overlaps": {
+                    "description": "Overlaps are the pairs of sessions in the room that run at the same time.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ScheduleGridOverlap"
+                    }
+                },
+                "room_id": {
                     "type": "string"
+                },
+                "sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ScheduleGridSession"
+                    }
                 }
             }
         },
-        "controllers.SendEventInvitationsResponse": {
+        "domain.ScheduleGridDay": {
             "type": "object",
             "properties": {
-                "failed": {
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ScheduleGridColumn"
+                    }
+                },
+                "day": {
+                    "description": "Day is the date as YYYY-MM-DD.",
+                    "type": "string"
+                },
+                "end": {
+                    "type": "string"
+                },
+                "slots": {
+                    "description": "Slots are the times a row begins: Start, every session start and end, and End, in order.",
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "sent": {
-                    "type": "integer"
+                "start": {
+                    "description": "Start and End bound the day's rows: the operating hours when set, widened to fit every session.",
+                    "type": "string"
                 }
             }
         },
-        "controllers.SendEventInvitationsSuccessResponse": {
+        "domain.ScheduleGridGap": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/controllers.SendEventInvitationsResponse"
+                "end": {
+                    "type": "string"
                 },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
+                "start": {
+                    "type": "string"
                 }
             }
         },
-        "controllers.ToggleRoomNotBookableSuccessResponse": {
+        "domain.ScheduleGridOverlap": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/domain.Room"
+                "end": {
+                    "type": "string"
                 },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
+                "related_session_id": {
+                    "type": "string"
+                },
+                "session_id": {
+                    "type": "string"
+                },
+                "start": {
+                    "type": "string"
                 }
             }
         },
-        "controllers.UpdateEventRequest": {
+        "domain.ScheduleGridRoom": {
             "type": "object",
             "properties": {
-                "date": {
+                "capacity": {
+                    "type": "integer"
+                },
+                "id": {
                     "type": "string"
                 },
-                "description": {
+                "name": {
                     "type": "string"
                 },
-                "location_lat": {
-                    "type": "number"
+                "not_bookable": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "domain.ScheduleGridSession": {
+            "type": "object",
+            "properties": {
+                "end_time": {
+                    "type": "string"
                 },
-                "location_lng": {
-                    "type": "number"
+                "lane": {
+                    "description": "Lane is 0 unless the session overlaps an earlier one in the room; overlapping sessions are\nplaced side by side in lanes 0, 1, ...",
+                    "type": "integer"
+                },
+                "session_id": {
+                    "type": "string"
+                },
+                "slot": {
+                    "description": "Slot is the index in the day's Slots the session starts at and Span the number of slots it\ncovers. Both are 0 for unscheduled sessions; Span is also 0 for a session that does not end\nafter it starts.",
+                    "type": "integer"
+                },
+                "span": {
+                    "type": "integer"
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "track": {
+                    "type": "string"
                 }
             }
         },
-        "controllers.UpdateEventSuccessResponse": {
+        "domain.ScheduleOverlaps": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/domain.Event"
+                "bucket_minutes": {
+                    "type": "integer"
                 },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
+                "buckets": {
+                    "description": "Buckets are the buckets with at least one session running, in time order.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.OverlapBucket"
+                    }
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "group_by": {
+                    "description": "GroupBy is tag or track.",
+                    "type": "string"
                 }
             }
         },
-        "controllers.UpdateEventTagRequest": {
+        "domain.ScheduleProblem": {
             "type": "object",
             "properties": {
-                "name": {
+                "message": {
+                    "type": "string"
+                },
+                "related_session_id": {
+                    "description": "RelatedSessionID is the second session of an overlap or clash.",
+                    "type": "string"
+                },
+                "room_id": {
+                    "type": "string"
+                },
+                "session_id": {
+                    "description": "SessionID is the session the problem is about; empty for a room's capacity.",
+                    "type": "string"
+                },
+                "speaker_id": {
                     "type": "string"
                 }
             }
         },
-        "controllers.UpdateEventTagSuccessResponse": {
+        "domain.ScheduleRules": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/domain.Tag"
+                "allowed_days": {
+                    "description": "AllowedDays lists the dates sessions may take place on, as \"YYYY-MM-DD\".",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
+                "allowed_durations": {
+                    "description": "AllowedDurations lists the allowed session lengths in minutes (e.g. 30, 45, 60).",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "earliest_start": {
+                    "description": "EarliestStart is the earliest time of day a session may start, as \"HH:MM\".",
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "latest_end": {
+                    "description": "LatestEnd is the latest time of day a session may end, as \"HH:MM\".",
+                    "type": "string"
+                },
+                "timezone": {
+                    "description": "Timezone is the IANA time zone times of day and dates are read in (e.g. \"Europe/Madrid\"); empty means UTC.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "controllers.UpdateRoomRequest": {
+        "domain.ScheduleSnapshot": {
             "type": "object",
             "properties": {
-                "capacity": {
-                    "type": "integer"
-                },
-                "description": {
+                "as_of": {
                     "type": "string"
                 },
-                "how_to_get_there": {
-                    "type": "string"
+                "deleted_session_ids": {
+                    "description": "DeletedSessionIDs are the sessions deleted since AsOf. Those that existed then are missing.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "name": {
-                    "type": "string"
+                "edited_session_ids": {
+                    "description": "EditedSessionIDs are the sessions whose last update since AsOf was not a recorded move, e.g. a\nnew title or a Sessionize import, which saves every session again. They are in their rewound\nroom and time slot with their current fields.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "not_bookable": {
+                "event": {
+                    "$ref": "#/definitions/domain.Event"
+                },
+                "partial": {
+                    "description": "Partial is set when the snapshot may differ from what attendees saw: some session was deleted\nor edited since AsOf.",
                     "type": "boolean"
+                },
+                "rooms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.RoomWithSessions"
+                    }
+                },
+                "tracks": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "controllers.UpdateRoomSuccessResponse": {
+        "domain.ScheduleValidation": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/domain.Room"
+                "categories": {
+                    "description": "Categories maps every category in ValidationCategories to its problems.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/domain.ScheduleProblem"
+                        }
+                    }
                 },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
+                "event_id": {
+                    "type": "string"
+                },
+                "generated_at": {
+                    "type": "string"
+                },
+                "problem_count": {
+                    "type": "integer"
+                },
+                "sessions_checked": {
+                    "type": "integer"
+                },
+                "valid": {
+                    "description": "Valid is true when no check found a problem.",
+                    "type": "boolean"
                 }
             }
         },
-        "controllers.UpdateSessionContentRequest": {
+        "domain.Session": {
             "type": "object",
             "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "custom_fields": {
+                    "description": "CustomFields are the session's values for the event's custom fields; attendees only get\nthose of public fields.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.CustomFieldValue"
+                    }
+                },
                 "description": {
                     "type": "string"
                 },
+                "end_time": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "room_id": {
+                    "description": "RoomID is empty while the session is unscheduled (e.g. its room was deleted).",
+                    "type": "string"
+                },
+                "session_type": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "source_session_id": {
+                    "type": "string"
+                },
+                "speaker_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "stream_links": {
+                    "description": "StreamLinks are the session's caption and translation streams; attendees get those visible\nat the time of the request.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.SessionStreamLink"
+                    }
+                },
+                "tags": {
+                    "description": "Tags are the tags associated with this session. Each tag includes both its ID and name.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Tag"
+                    }
+                },
                 "title": {
                     "type": "string"
+                },
+                "track": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "controllers.UpdateSessionContentSuccessResponse": {
+        "domain.SessionChange": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/domain.Session"
+                "changed_at": {
+                    "type": "string"
                 },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
+                "event_id": {
+                    "type": "string"
+                },
+                "from_end_time": {
+                    "type": "string"
+                },
+                "from_room_id": {
+                    "type": "string"
+                },
+                "from_room_name": {
+                    "type": "string"
+                },
+                "from_start_time": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "session_id": {
+                    "type": "string"
+                },
+                "session_title": {
+                    "description": "SessionTitle is the session's current title, filled in when changes are listed.",
+                    "type": "string"
+                },
+                "to_end_time": {
+                    "type": "string"
+                },
+                "to_room_id": {
+                    "type": "string"
+                },
+                "to_room_name": {
+                    "type": "string"
+                },
+                "to_start_time": {
+                    "type": "string"
                 }
             }
         },
-        "controllers.UpdateSessionScheduleRequest": {
+        "domain.SessionChecklist": {
             "type": "object",
             "properties": {
-                "end_time": {
-                    "type": "string"
+                "done": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.SessionChecklistItem"
+                    }
+                },
+                "ready": {
+                    "type": "boolean"
                 },
                 "room_id": {
                     "type": "string"
                 },
+                "session_id": {
+                    "type": "string"
+                },
                 "start_time": {
                     "type": "string"
-                }
-            }
-        },
-        "controllers.UpdateSessionScheduleSuccessResponse": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "$ref": "#/definitions/domain.Session"
                 },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
+                "title": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
                 }
             }
         },
-        "controllers.UpdateUserRequest": {
+        "domain.SessionChecklistItem": {
             "type": "object",
             "properties": {
-                "last_name": {
+                "completed_at": {
                     "type": "string"
                 },
-                "name": {
+                "completed_by": {
+                    "description": "CompletedBy and CompletedByName are the team member who ticked the item.",
+                    "type": "string"
+                },
+                "completed_by_name": {
+                    "type": "string"
+                },
+                "done": {
+                    "type": "boolean"
+                },
+                "item_id": {
+                    "type": "string"
+                },
+                "label": {
                     "type": "string"
                 }
             }
         },
-        "controllers.UpdateUserSuccessResponse": {
+        "domain.SessionStreamLink": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/domain.User"
+                "kind": {
+                    "description": "Kind is captions or translation.",
+                    "type": "string"
                 },
-                "error": {
-                    "$ref": "#/definitions/helpers.APIError"
+                "language": {
+                    "description": "Language is the stream's language code, such as \"en\" or \"pt-BR\".",
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "visible_from": {
+                    "description": "VisibleFrom and VisibleUntil bound when attendees get the link; either may be left open.",
+                    "type": "string"
+                },
+                "visible_until": {
+                    "type": "string"
                 }
             }
         },
-        "controllers.VerifyLoginCodeRequest": {
+        "domain.SessionSuggestion": {
             "type": "object",
             "properties": {
-                "code": {
+                "session_id": {
                     "type": "string"
                 },
-                "email": {
+                "summary": {
                     "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.SuggestedTag"
+                    }
                 }
             }
         },
-        "domain.Event": {
+        "domain.Speaker": {
             "type": "object",
             "properties": {
+                "bio": {
+                    "type": "string"
+                },
                 "created_at": {
                     "type": "string"
                 },
-                "date": {
+                "custom_fields": {
+                    "description": "CustomFields are the speaker's values for the event's custom fields; attendees only get\nthose of public fields.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.CustomFieldValue"
+                    }
+                },
+                "email": {
+                    "description": "Email is optional. Imports use it together with the name to recognize the same speaker.",
                     "type": "string"
                 },
-                "description": {
+                "event_id": {
                     "type": "string"
                 },
-                "event_code": {
+                "first_name": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "location_lat": {
-                    "type": "number"
+                "is_top_speaker": {
+                    "type": "boolean"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "profile_picture": {
+                    "type": "string"
                 },
-                "location_lng": {
-                    "type": "number"
+                "source": {
+                    "type": "string"
                 },
-                "name": {
+                "source_session_id": {
                     "type": "string"
                 },
-                "owner_id": {
+                "tag_line": {
                     "type": "string"
                 },
                 "updated_at": {
@@ -3585,26 +15364,53 @@ const docTemplate = `{
                 }
             }
         },
-        "domain.EventInvitation": {
+        "domain.SpeakerBulkItemResult": {
             "type": "object",
             "properties": {
-                "email": {
+                "error": {
+                    "description": "Error says why the update was rejected; it is empty for valid updates.",
                     "type": "string"
                 },
-                "event_id": {
-                    "type": "string"
+                "speaker": {
+                    "description": "Speaker is the updated speaker, set when the bulk update was applied or, in a dry run, valid.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.Speaker"
+                        }
+                    ]
                 },
-                "id": {
+                "speaker_id": {
                     "type": "string"
+                }
+            }
+        },
+        "domain.SpeakerBulkUpdateResult": {
+            "type": "object",
+            "properties": {
+                "applied": {
+                    "type": "boolean"
                 },
-                "sent_at": {
-                    "type": "string"
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.SpeakerBulkItemResult"
+                    }
                 }
             }
         },
-        "domain.EventRegistration": {
+        "domain.SpeakerMergeCandidate": {
             "type": "object",
             "properties": {
+                "candidate": {
+                    "$ref": "#/definitions/domain.Speaker"
+                },
+                "candidate_id": {
+                    "description": "CandidateID is the existing speaker it may duplicate.",
+                    "type": "string"
+                },
                 "created_at": {
                     "type": "string"
                 },
@@ -3614,182 +15420,240 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
-                "updated_at": {
+                "reason": {
                     "type": "string"
                 },
-                "user_id": {
+                "resolved_at": {
+                    "type": "string"
+                },
+                "speaker": {
+                    "description": "Speaker and Candidate are filled when listing candidates for review.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.Speaker"
+                        }
+                    ]
+                },
+                "speaker_id": {
+                    "description": "SpeakerID is the speaker created by the import.",
+                    "type": "string"
+                },
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "domain.EventSchedule": {
+        "domain.SpeakerPhotoArchive": {
             "type": "object",
             "properties": {
-                "event": {
-                    "$ref": "#/definitions/domain.Event"
+                "completed_at": {
+                    "type": "string"
                 },
-                "rooms": {
+                "created_at": {
+                    "type": "string"
+                },
+                "error": {
+                    "description": "Error says why a failed archive could not be built.",
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "missing": {
+                    "description": "Missing names the speakers whose photo could not be downloaded; the archive lists them in\nmissing.txt.",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/domain.RoomWithSessions"
+                        "type": "string"
                     }
+                },
+                "photos": {
+                    "description": "Photos counts the photos in the ready archive.",
+                    "type": "integer"
+                },
+                "speakers": {
+                    "description": "Speakers counts the speakers with a photo when the archive was requested.",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "Status is pending until the archive is built, then ready or failed.",
+                    "type": "string"
                 }
             }
         },
-        "domain.EventTeamMember": {
+        "domain.SpeakerUpdate": {
             "type": "object",
             "properties": {
+                "bio": {
+                    "type": "string",
+                    "x-nullable": true
+                },
                 "email": {
-                    "type": "string"
+                    "type": "string",
+                    "x-nullable": true
                 },
-                "event_id": {
-                    "type": "string"
+                "first_name": {
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "is_top_speaker": {
+                    "type": "boolean",
+                    "x-nullable": true
                 },
                 "last_name": {
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "profile_picture": {
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "speaker_id": {
                     "type": "string"
                 },
+                "tag_line": {
+                    "type": "string",
+                    "x-nullable": true
+                }
+            }
+        },
+        "domain.SuggestedTag": {
+            "type": "object",
+            "properties": {
                 "name": {
                     "type": "string"
                 },
-                "user_id": {
+                "tag_id": {
                     "type": "string"
                 }
             }
         },
-        "domain.Room": {
+        "domain.SyncDelta": {
             "type": "object",
             "properties": {
-                "capacity": {
-                    "type": "integer"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "description": {
+                "cursor": {
+                    "description": "Cursor is passed as since on the next request. It equals the request's cursor when nothing changed.",
                     "type": "string"
                 },
                 "event_id": {
                     "type": "string"
                 },
-                "how_to_get_there": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "not_bookable": {
+                "has_more": {
+                    "description": "HasMore is true when more changes are waiting; request again with Cursor right away.",
                     "type": "boolean"
                 },
-                "source": {
-                    "type": "string"
+                "rooms": {
+                    "$ref": "#/definitions/domain.SyncRoomChanges"
                 },
-                "source_session_id": {
-                    "type": "integer"
+                "sessions": {
+                    "$ref": "#/definitions/domain.SyncSessionChanges"
                 },
-                "updated_at": {
-                    "type": "string"
+                "speakers": {
+                    "$ref": "#/definitions/domain.SyncSpeakerChanges"
                 }
             }
         },
-        "domain.RoomWithSessions": {
+        "domain.SyncRoomChanges": {
             "type": "object",
             "properties": {
-                "room": {
-                    "$ref": "#/definitions/domain.Room"
+                "created": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Room"
+                    }
                 },
-                "sessions": {
+                "deleted": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/domain.Session"
+                        "type": "string"
+                    }
+                },
+                "updated": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Room"
                     }
                 }
             }
         },
-        "domain.Session": {
+        "domain.SyncSessionChanges": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "end_time": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "room_id": {
-                    "type": "string"
-                },
-                "source": {
-                    "type": "string"
-                },
-                "source_session_id": {
-                    "type": "string"
+                "created": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Session"
+                    }
                 },
-                "speaker_ids": {
+                "deleted": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "start_time": {
-                    "type": "string"
+                "updated": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Session"
+                    }
+                }
+            }
+        },
+        "domain.SyncSpeakerChanges": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Speaker"
+                    }
                 },
-                "tags": {
-                    "description": "Tags are the tags associated with this session. Each tag includes both its ID and name.",
+                "deleted": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/domain.Tag"
+                        "type": "string"
                     }
                 },
-                "title": {
+                "updated": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Speaker"
+                    }
+                }
+            }
+        },
+        "domain.Tag": {
+            "type": "object",
+            "properties": {
+                "id": {
                     "type": "string"
                 },
-                "updated_at": {
+                "name": {
                     "type": "string"
                 }
             }
         },
-        "domain.Speaker": {
+        "domain.User": {
             "type": "object",
             "properties": {
-                "bio": {
+                "avatar_url": {
                     "type": "string"
                 },
                 "created_at": {
                     "type": "string"
                 },
-                "event_id": {
-                    "type": "string"
-                },
-                "first_name": {
+                "email": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "is_top_speaker": {
-                    "type": "boolean"
-                },
                 "last_name": {
                     "type": "string"
                 },
-                "profile_picture": {
-                    "type": "string"
-                },
-                "source": {
-                    "type": "string"
-                },
-                "source_session_id": {
-                    "type": "string"
-                },
-                "tag_line": {
+                "name": {
                     "type": "string"
                 },
                 "updated_at": {
@@ -3797,37 +15661,50 @@ const docTemplate = `{
                 }
             }
         },
-        "domain.Tag": {
+        "domain.UserActivity": {
             "type": "object",
             "properties": {
-                "id": {
+                "actor_id": {
+                    "description": "ActorID is the admin who acted as the user; empty once that admin's account is deleted.",
                     "type": "string"
                 },
-                "name": {
+                "actor_name": {
                     "type": "string"
-                }
-            }
-        },
-        "domain.User": {
-            "type": "object",
-            "properties": {
+                },
                 "created_at": {
                     "type": "string"
                 },
-                "email": {
+                "id": {
                     "type": "string"
                 },
-                "id": {
+                "method": {
                     "type": "string"
                 },
-                "last_name": {
+                "path": {
                     "type": "string"
                 },
-                "name": {
+                "status": {
+                    "description": "Status is the HTTP status the request got.",
+                    "type": "integer"
+                },
+                "user_id": {
                     "type": "string"
+                }
+            }
+        },
+        "domain.WarmupDay": {
+            "type": "object",
+            "properties": {
+                "day": {
+                    "description": "Day counts from 1.",
+                    "type": "integer"
                 },
-                "updated_at": {
+                "starts_at": {
+                    "description": "StartsAt is when the day's sending begins; its invitations are spread over the next 24 hours.",
                     "type": "string"
+                },
+                "volume": {
+                    "type": "integer"
                 }
             }
         },
@@ -3851,6 +15728,20 @@ const docTemplate = `{
                 }
             }
         },
+        "helpers.ErrorCodeInfo": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "integer"
+                }
+            }
+        },
         "helpers.PaginationMeta": {
             "type": "object",
             "properties": {
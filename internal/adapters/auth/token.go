@@ -2,15 +2,25 @@ package auth
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
+	"multitrackticketing/internal/domain"
+
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// machineAudience marks machine access tokens. Verify rejects tokens that carry it and
+// VerifyMachine requires it, so a machine token never passes for a user's and vice versa.
+const machineAudience = "machine"
+
 type jwtClaims struct {
 	jwt.RegisteredClaims
 	Email string   `json:"email"`
 	Roles []string `json:"roles"`
+	// EventID and Scopes are only set on machine tokens.
+	EventID string   `json:"event_id,omitempty"`
+	Scopes  []string `json:"scopes,omitempty"`
 }
 
 type jwtIssuer struct {
@@ -18,13 +28,37 @@ type jwtIssuer struct {
 }
 
 // NewJWTIssuer returns a TokenIssuer and TokenVerifier that sign/verify JWTs with HS256 using the given secret.
-// The expiry passed to Issue is used for each token. The same value implements domain.TokenVerifier for protected routes.
+// The expiry passed to Issue is used for each token. The same value implements domain.TokenVerifier for protected routes,
+// and domain.MachineTokenIssuer and domain.MachineTokenVerifier for machine clients.
 func NewJWTIssuer(secret string, _ time.Duration) *jwtIssuer {
 	return &jwtIssuer{secret: []byte(secret)}
 }
 
 // Verify parses and validates the JWT and returns the subject (user ID). Implements domain.TokenVerifier.
 func (i *jwtIssuer) Verify(tokenString string) (string, error) {
+	claims, err := i.parse(tokenString)
+	if err != nil {
+		return "", err
+	}
+	if slices.Contains(claims.Audience, machineAudience) {
+		return "", fmt.Errorf("machine token used as a user token")
+	}
+	return claims.Subject, nil
+}
+
+// VerifyMachine parses and validates a machine access token. Implements domain.MachineTokenVerifier.
+func (i *jwtIssuer) VerifyMachine(tokenString string) (*domain.MachinePrincipal, error) {
+	claims, err := i.parse(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(claims.Audience, machineAudience) {
+		return nil, fmt.Errorf("not a machine token")
+	}
+	return &domain.MachinePrincipal{ClientID: claims.Subject, EventID: claims.EventID, Scopes: claims.Scopes}, nil
+}
+
+func (i *jwtIssuer) parse(tokenString string) (*jwtClaims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &jwtClaims{}, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
@@ -32,13 +66,13 @@ func (i *jwtIssuer) Verify(tokenString string) (string, error) {
 		return i.secret, nil
 	})
 	if err != nil {
-		return "", fmt.Errorf("invalid or expired token: %w", err)
+		return nil, fmt.Errorf("invalid or expired token: %w", err)
 	}
 	claims, ok := token.Claims.(*jwtClaims)
 	if !ok || !token.Valid {
-		return "", fmt.Errorf("invalid token claims")
+		return nil, fmt.Errorf("invalid token claims")
 	}
-	return claims.Subject, nil
+	return claims, nil
 }
 
 func (i *jwtIssuer) Issue(userID, email string, roles []string, expiry time.Duration) (string, error) {
@@ -52,6 +86,26 @@ func (i *jwtIssuer) Issue(userID, email string, roles []string, expiry time.Dura
 		Email: email,
 		Roles: roles,
 	}
+	return i.sign(claims)
+}
+
+// IssueMachine signs an access token for a machine client. Implements domain.MachineTokenIssuer.
+func (i *jwtIssuer) IssueMachine(p *domain.MachinePrincipal, expiry time.Duration) (string, error) {
+	now := time.Now()
+	claims := jwtClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   p.ClientID,
+			Audience:  jwt.ClaimStrings{machineAudience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(expiry)),
+		},
+		EventID: p.EventID,
+		Scopes:  p.Scopes,
+	}
+	return i.sign(claims)
+}
+
+func (i *jwtIssuer) sign(claims jwtClaims) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	tokenString, err := token.SignedString(i.secret)
 	if err != nil {
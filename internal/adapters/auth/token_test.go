@@ -4,6 +4,8 @@ import (
 	"testing"
 	"time"
 
+	"multitrackticketing/internal/domain"
+
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -30,3 +32,23 @@ func TestJWTIssuer_Issue(t *testing.T) {
 	assert.Equal(t, "u@example.com", claims.Email)
 	assert.Equal(t, []string{"admin", "attendee"}, claims.Roles)
 }
+
+func TestJWTIssuer_MachineTokensAreSeparate(t *testing.T) {
+	issuer := NewJWTIssuer("test-secret", time.Hour)
+
+	machine, err := issuer.IssueMachine(&domain.MachinePrincipal{ClientID: "client-1", EventID: "event-1", Scopes: []string{domain.ScopeScheduleRead}}, time.Minute)
+	require.NoError(t, err)
+	p, err := issuer.VerifyMachine(machine)
+	require.NoError(t, err)
+	assert.Equal(t, &domain.MachinePrincipal{ClientID: "client-1", EventID: "event-1", Scopes: []string{domain.ScopeScheduleRead}}, p)
+	_, err = issuer.Verify(machine)
+	assert.Error(t, err, "a machine token is not a user token")
+
+	user, err := issuer.Issue("user-123", "u@example.com", nil, time.Minute)
+	require.NoError(t, err)
+	_, err = issuer.VerifyMachine(user)
+	assert.Error(t, err, "a user token is not a machine token")
+	userID, err := issuer.Verify(user)
+	require.NoError(t, err)
+	assert.Equal(t, "user-123", userID)
+}
@@ -0,0 +1,59 @@
+// Package captcha verifies captcha tokens with a provider's siteverify endpoint.
+package captcha
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+type verifier struct {
+	url    string
+	secret string
+	client *http.Client
+}
+
+// NewVerifier returns a verifier that POSTs the token, secret and remote IP as a form to verifyURL
+// and reads the JSON "success" field: the protocol Cloudflare Turnstile, hCaptcha and reCAPTCHA
+// share. With a nil client it uses one that gives up after 10 seconds.
+func NewVerifier(verifyURL, secret string, client *http.Client) domain.CaptchaVerifier {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &verifier{url: verifyURL, secret: secret, client: client}
+}
+
+func (v *verifier) Verify(ctx context.Context, token, remoteIP string) (bool, error) {
+	form := url.Values{"secret": {v.secret}, "response": {token}}
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return false, fmt.Errorf("build captcha request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("verify captcha: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+		return false, fmt.Errorf("verify captcha: unexpected status %d", resp.StatusCode)
+	}
+	var result struct {
+		Success bool `json:"success"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<16)).Decode(&result); err != nil {
+		return false, fmt.Errorf("decode captcha response: %w", err)
+	}
+	return result.Success, nil
+}
@@ -0,0 +1,49 @@
+package captcha
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifier_Verify(t *testing.T) {
+	var got *http.Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		got = r
+		w.Header().Set("Content-Type", "application/json")
+		if r.PostForm.Get("response") == "good" {
+			_, _ = w.Write([]byte(`{"success":true}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"success":false,"error-codes":["invalid-input-response"]}`))
+	}))
+	defer srv.Close()
+
+	v := NewVerifier(srv.URL+"/siteverify", "s3cret", srv.Client())
+	ok, err := v.Verify(context.Background(), "good", "203.0.113.7")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	require.NotNil(t, got)
+	assert.Equal(t, http.MethodPost, got.Method)
+	assert.Equal(t, "s3cret", got.PostForm.Get("secret"))
+	assert.Equal(t, "203.0.113.7", got.PostForm.Get("remoteip"))
+
+	ok, err = v.Verify(context.Background(), "bad", "")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestVerifier_VerifyProviderError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	_, err := NewVerifier(srv.URL, "s3cret", srv.Client()).Verify(context.Background(), "good", "")
+	require.Error(t, err)
+}
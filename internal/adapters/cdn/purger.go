@@ -0,0 +1,53 @@
+// Package cdn purges cached public responses from a CDN in front of the API.
+package cdn
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+type purger struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+// NewPurger returns a purger that POSTs to purgeURL with the keys space-separated in the
+// Surrogate-Key header, as Fastly's purge-by-key API expects; token, when set, is sent as Fastly-Key.
+// With a nil client it uses one that gives up after 10 seconds.
+func NewPurger(purgeURL, token string, client *http.Client) domain.CachePurger {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &purger{url: purgeURL, token: token, client: client}
+}
+
+func (p *purger) Purge(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, nil)
+	if err != nil {
+		return fmt.Errorf("build purge request: %w", err)
+	}
+	req.Header.Set("Surrogate-Key", strings.Join(keys, " "))
+	if p.token != "" {
+		req.Header.Set("Fastly-Key", p.token)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("purge %s: %w", strings.Join(keys, " "), err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("purge %s: unexpected status %d", strings.Join(keys, " "), resp.StatusCode)
+	}
+	return nil
+}
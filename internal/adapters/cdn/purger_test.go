@@ -0,0 +1,43 @@
+package cdn
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurger_Purge(t *testing.T) {
+	var got *http.Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	err := NewPurger(srv.URL+"/service/abc/purge", "secret", srv.Client()).Purge(context.Background(), "event-1", "event-2")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, http.MethodPost, got.Method)
+	assert.Equal(t, "/service/abc/purge", got.URL.Path)
+	assert.Equal(t, "event-1 event-2", got.Header.Get("Surrogate-Key"))
+	assert.Equal(t, "secret", got.Header.Get("Fastly-Key"))
+}
+
+func TestPurger_Purge_Errors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+	p := NewPurger(srv.URL, "", srv.Client())
+
+	err := p.Purge(context.Background(), "event-1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "401")
+
+	// Nothing to purge sends no request.
+	require.NoError(t, NewPurger("http://127.0.0.1:0", "", nil).Purge(context.Background()))
+}
@@ -0,0 +1,221 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+// DefaultDomainCheckTTL is how long a domain check is reused before DNS is queried again.
+const DefaultDomainCheckTTL = 10 * time.Minute
+
+// TXTResolver looks up TXT records; *net.Resolver implements it.
+type TXTResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// DomainCheckConfig configures NewDomainChecker.
+type DomainCheckConfig struct {
+	// FromAddress is the platform sender; its domain is checked.
+	FromAddress string
+	// DKIMSelectors are looked up at <selector>._domainkey.<domain>. With none, DKIM is unchecked.
+	DKIMSelectors []string
+	// SPFInclude, when set, must be included by the SPF record (e.g. amazonses.com for SES).
+	SPFInclude string
+	// TTL is how long a report is reused; 0 means DefaultDomainCheckTTL.
+	TTL time.Duration
+}
+
+type domainChecker struct {
+	resolver TXTResolver
+	config   DomainCheckConfig
+	domain   string
+	now      func() time.Time
+
+	mu     sync.Mutex
+	cached *domain.EmailDomainReport
+}
+
+// NewDomainChecker returns a checker of the SPF, DKIM and DMARC records of the sender's domain. With
+// a nil resolver it uses net.DefaultResolver.
+func NewDomainChecker(resolver TXTResolver, config DomainCheckConfig) (domain.EmailDomainChecker, error) {
+	_, sendingDomain, ok := strings.Cut(config.FromAddress, "@")
+	sendingDomain = strings.ToLower(strings.TrimSpace(sendingDomain))
+	if !ok || sendingDomain == "" {
+		return nil, fmt.Errorf("sender %q has no domain", config.FromAddress)
+	}
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	if config.TTL <= 0 {
+		config.TTL = DefaultDomainCheckTTL
+	}
+	return &domainChecker{resolver: resolver, config: config, domain: sendingDomain, now: time.Now}, nil
+}
+
+func (c *domainChecker) CheckEmailDomain(ctx context.Context, refresh bool) (*domain.EmailDomainReport, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !refresh && c.cached != nil && c.now().Sub(c.cached.CheckedAt) < c.config.TTL {
+		return c.cached, nil
+	}
+
+	report := &domain.EmailDomainReport{Domain: c.domain, CheckedAt: c.now().UTC(), OK: true}
+	spf, err := c.checkSPF(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report.Records = append(report.Records, spf)
+	dkim, err := c.checkDKIM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report.Records = append(report.Records, dkim...)
+	dmarc, err := c.checkDMARC(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report.Records = append(report.Records, dmarc)
+	for _, r := range report.Records {
+		if r.Status == domain.EmailRecordMissing || r.Status == domain.EmailRecordInvalid {
+			report.OK = false
+		}
+	}
+	c.cached = report
+	return report, nil
+}
+
+// lookup returns the TXT values at name that start with prefix (case-insensitive). A name that does
+// not exist has no values; other DNS failures are returned, so a flaky resolver is not reported as
+// missing records.
+func (c *domainChecker) lookup(ctx context.Context, name, prefix string) ([]string, error) {
+	txts, err := c.resolver.LookupTXT(ctx, name)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return []string{}, nil
+		}
+		return nil, fmt.Errorf("look up TXT %s: %w", name, err)
+	}
+	values := []string{}
+	for _, txt := range txts {
+		if strings.HasPrefix(strings.ToLower(strings.TrimSpace(txt)), prefix) {
+			values = append(values, txt)
+		}
+	}
+	return values, nil
+}
+
+func (c *domainChecker) checkSPF(ctx context.Context) (*domain.EmailDomainRecord, error) {
+	rec := &domain.EmailDomainRecord{Kind: domain.EmailRecordSPF, Name: c.domain}
+	values, err := c.lookup(ctx, rec.Name, "v=spf1")
+	if err != nil {
+		return nil, err
+	}
+	rec.Values = values
+	switch {
+	case len(values) == 0:
+		rec.Status, rec.Problem = domain.EmailRecordMissing, "no v=spf1 TXT record"
+	case len(values) > 1:
+		rec.Status, rec.Problem = domain.EmailRecordInvalid, "more than one SPF record; receivers treat this as a permanent error"
+	case hasMechanism(values[0], "+all") || hasMechanism(values[0], "all"):
+		rec.Status, rec.Problem = domain.EmailRecordInvalid, "the record ends in +all, which lets anyone send as the domain"
+	case c.config.SPFInclude != "" && !hasMechanism(values[0], "include:"+c.config.SPFInclude):
+		rec.Status, rec.Problem = domain.EmailRecordInvalid, "the record does not include:"+c.config.SPFInclude+", the platform's mail provider"
+	default:
+		rec.Status = domain.EmailRecordOK
+	}
+	return rec, nil
+}
+
+// hasMechanism reports whether the SPF record has the given term (case-insensitive).
+func hasMechanism(record, term string) bool {
+	for _, field := range strings.Fields(strings.ToLower(record)) {
+		if field == term {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *domainChecker) checkDKIM(ctx context.Context) ([]*domain.EmailDomainRecord, error) {
+	if len(c.config.DKIMSelectors) == 0 {
+		return []*domain.EmailDomainRecord{{
+			Kind: domain.EmailRecordDKIM, Name: "_domainkey." + c.domain, Status: domain.EmailRecordUnchecked,
+			Values: []string{}, Problem: "no DKIM selector is configured (EMAIL_DKIM_SELECTORS)",
+		}}, nil
+	}
+	records := make([]*domain.EmailDomainRecord, 0, len(c.config.DKIMSelectors))
+	for _, selector := range c.config.DKIMSelectors {
+		rec := &domain.EmailDomainRecord{Kind: domain.EmailRecordDKIM, Name: selector + "._domainkey." + c.domain}
+		// Keys usually start with v=DKIM1 but the tag is optional; every key has p=.
+		txts, err := c.lookup(ctx, rec.Name, "")
+		if err != nil {
+			return nil, err
+		}
+		rec.Values = []string{}
+		for _, txt := range txts {
+			if dkimTag(txt, "p") != nil {
+				rec.Values = append(rec.Values, txt)
+			}
+		}
+		switch {
+		case len(rec.Values) == 0:
+			rec.Status, rec.Problem = domain.EmailRecordMissing, "no DKIM key (p=) at the selector"
+		case *dkimTag(rec.Values[0], "p") == "":
+			rec.Status, rec.Problem = domain.EmailRecordInvalid, "the key is revoked (empty p=)"
+		default:
+			rec.Status = domain.EmailRecordOK
+		}
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+// dkimTag returns the value of a tag=value pair of a DKIM or DMARC record, or nil when the tag is
+// absent.
+func dkimTag(record, tag string) *string {
+	for _, pair := range strings.Split(record, ";") {
+		name, value, ok := strings.Cut(pair, "=")
+		if ok && strings.EqualFold(strings.TrimSpace(name), tag) {
+			value = strings.Join(strings.Fields(value), "")
+			return &value
+		}
+	}
+	return nil
+}
+
+func (c *domainChecker) checkDMARC(ctx context.Context) (*domain.EmailDomainRecord, error) {
+	rec := &domain.EmailDomainRecord{Kind: domain.EmailRecordDMARC, Name: "_dmarc." + c.domain}
+	values, err := c.lookup(ctx, rec.Name, "v=dmarc1")
+	if err != nil {
+		return nil, err
+	}
+	rec.Values = values
+	if len(values) == 0 {
+		rec.Status, rec.Problem = domain.EmailRecordMissing, "no v=DMARC1 TXT record"
+		return rec, nil
+	}
+	if len(values) > 1 {
+		rec.Status, rec.Problem = domain.EmailRecordInvalid, "more than one DMARC record; receivers ignore them all"
+		return rec, nil
+	}
+	policy := dkimTag(values[0], "p")
+	switch {
+	case policy == nil:
+		rec.Status, rec.Problem = domain.EmailRecordInvalid, "the record has no p= policy"
+	case strings.EqualFold(*policy, "none"):
+		rec.Status, rec.Problem = domain.EmailRecordOK, "p=none only reports failures; spoofed mail is still delivered"
+	case strings.EqualFold(*policy, "quarantine") || strings.EqualFold(*policy, "reject"):
+		rec.Status = domain.EmailRecordOK
+	default:
+		rec.Status, rec.Problem = domain.EmailRecordInvalid, "p= must be none, quarantine or reject"
+	}
+	return rec, nil
+}
@@ -0,0 +1,150 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeResolver answers from txt by name; names it does not know do not exist.
+type fakeResolver struct {
+	txt     map[string][]string
+	err     error
+	lookups int
+}
+
+func (r *fakeResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	r.lookups++
+	if r.err != nil {
+		return nil, r.err
+	}
+	if values, ok := r.txt[name]; ok {
+		return values, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+func recordsByName(report *domain.EmailDomainReport) map[string]*domain.EmailDomainRecord {
+	out := make(map[string]*domain.EmailDomainRecord)
+	for _, r := range report.Records {
+		out[r.Name] = r
+	}
+	return out
+}
+
+func TestDomainChecker(t *testing.T) {
+	ctx := context.Background()
+	config := DomainCheckConfig{FromAddress: "events@Mail.Example.com", DKIMSelectors: []string{"s1", "s2"}, SPFInclude: "amazonses.com"}
+
+	t.Run("healthy domain", func(t *testing.T) {
+		resolver := &fakeResolver{txt: map[string][]string{
+			"mail.example.com":               {"google-site-verification=abc", "v=spf1 include:amazonses.com -all"},
+			"s1._domainkey.mail.example.com": {"v=DKIM1; k=rsa; p=MIIBIjANBg"},
+			"s2._domainkey.mail.example.com": {"k=rsa; p=MIIB IjAN"},
+			"_dmarc.mail.example.com":        {"v=DMARC1; p=reject; rua=mailto:dmarc@example.com"},
+		}}
+		checker, err := NewDomainChecker(resolver, config)
+		require.NoError(t, err)
+		report, err := checker.CheckEmailDomain(ctx, false)
+		require.NoError(t, err)
+		assert.Equal(t, "mail.example.com", report.Domain)
+		assert.True(t, report.OK)
+		require.Len(t, report.Records, 4)
+		for _, r := range report.Records {
+			assert.Equal(t, domain.EmailRecordOK, r.Status, r.Name)
+		}
+		assert.Equal(t, []string{"v=spf1 include:amazonses.com -all"}, report.Records[0].Values)
+	})
+
+	t.Run("broken records", func(t *testing.T) {
+		resolver := &fakeResolver{txt: map[string][]string{
+			"mail.example.com":               {"v=spf1 include:_spf.google.com ~all"},
+			"s1._domainkey.mail.example.com": {"v=DKIM1; p="},
+			"_dmarc.mail.example.com":        {"v=DMARC1; p=none"},
+		}}
+		checker, err := NewDomainChecker(resolver, config)
+		require.NoError(t, err)
+		report, err := checker.CheckEmailDomain(ctx, false)
+		require.NoError(t, err)
+		assert.False(t, report.OK)
+		byName := recordsByName(report)
+		assert.Equal(t, domain.EmailRecordInvalid, byName["mail.example.com"].Status)
+		assert.Contains(t, byName["mail.example.com"].Problem, "include:amazonses.com")
+		assert.Equal(t, domain.EmailRecordInvalid, byName["s1._domainkey.mail.example.com"].Status, "revoked key")
+		assert.Equal(t, domain.EmailRecordMissing, byName["s2._domainkey.mail.example.com"].Status)
+		assert.Equal(t, domain.EmailRecordOK, byName["_dmarc.mail.example.com"].Status)
+		assert.Contains(t, byName["_dmarc.mail.example.com"].Problem, "p=none")
+	})
+
+	t.Run("spf problems", func(t *testing.T) {
+		for name, txt := range map[string][]string{
+			"two records": {"v=spf1 -all", "v=spf1 include:amazonses.com -all"},
+			"allows all":  {"v=spf1 include:amazonses.com +all"},
+		} {
+			resolver := &fakeResolver{txt: map[string][]string{"mail.example.com": txt}}
+			checker, err := NewDomainChecker(resolver, DomainCheckConfig{FromAddress: "events@mail.example.com"})
+			require.NoError(t, err)
+			report, err := checker.CheckEmailDomain(ctx, false)
+			require.NoError(t, err)
+			assert.Equal(t, domain.EmailRecordInvalid, report.Records[0].Status, name)
+		}
+	})
+
+	t.Run("nothing configured", func(t *testing.T) {
+		checker, err := NewDomainChecker(&fakeResolver{}, DomainCheckConfig{FromAddress: "events@mail.example.com"})
+		require.NoError(t, err)
+		report, err := checker.CheckEmailDomain(ctx, false)
+		require.NoError(t, err)
+		assert.False(t, report.OK)
+		byName := recordsByName(report)
+		assert.Equal(t, domain.EmailRecordMissing, byName["mail.example.com"].Status)
+		assert.Equal(t, domain.EmailRecordUnchecked, byName["_domainkey.mail.example.com"].Status)
+		assert.Equal(t, domain.EmailRecordMissing, byName["_dmarc.mail.example.com"].Status)
+	})
+
+	t.Run("reports are cached", func(t *testing.T) {
+		resolver := &fakeResolver{}
+		c, err := NewDomainChecker(resolver, DomainCheckConfig{FromAddress: "events@mail.example.com", TTL: time.Minute})
+		require.NoError(t, err)
+		checker := c.(*domainChecker)
+		now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+		checker.now = func() time.Time { return now }
+
+		first, err := checker.CheckEmailDomain(ctx, false)
+		require.NoError(t, err)
+		lookups := resolver.lookups
+		again, err := checker.CheckEmailDomain(ctx, false)
+		require.NoError(t, err)
+		assert.Same(t, first, again)
+		assert.Equal(t, lookups, resolver.lookups)
+
+		_, err = checker.CheckEmailDomain(ctx, true)
+		require.NoError(t, err)
+		assert.Greater(t, resolver.lookups, lookups, "refresh looks the records up again")
+
+		lookups = resolver.lookups
+		now = now.Add(2 * time.Minute)
+		_, err = checker.CheckEmailDomain(ctx, false)
+		require.NoError(t, err)
+		assert.Greater(t, resolver.lookups, lookups, "expired reports are not reused")
+	})
+
+	t.Run("dns failure is an error", func(t *testing.T) {
+		checker, err := NewDomainChecker(&fakeResolver{err: errors.New("i/o timeout")}, config)
+		require.NoError(t, err)
+		_, err = checker.CheckEmailDomain(ctx, false)
+		require.Error(t, err)
+	})
+
+	t.Run("sender without domain", func(t *testing.T) {
+		_, err := NewDomainChecker(nil, DomainCheckConfig{FromAddress: "events"})
+		require.Error(t, err)
+	})
+}
@@ -35,30 +35,10 @@ type MailerConfig struct {
 func NewMailer(config MailerConfig) (domain.Mailer, error) {
 	switch config.Provider {
 	case "ses":
-		sesConfig := config.SES
 		if config.SES.InsecureSkipVerify {
 			log.Printf("[MAILER] WARNING: TLS certificate verification is disabled for SES. Use only in development.")
 		}
-		httpClient := &http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{
-					InsecureSkipVerify: sesConfig.InsecureSkipVerify,
-					MinVersion:         tls.VersionTLS12,
-				},
-			},
-		}
-		awsCfg := aws.Config{
-			Region: sesConfig.Region,
-			Credentials: aws.NewCredentialsCache(
-				credentials.NewStaticCredentialsProvider(
-					sesConfig.AccessKeyID,
-					sesConfig.SecretAccessKey,
-					"",
-				),
-			),
-			HTTPClient: httpClient,
-		}
-		client := ses.NewFromConfig(awsCfg)
+		client := newSESClient(config.SES)
 		return &sesMailer{
 			client:      client,
 			fromAddress: config.FromAddress,
@@ -72,6 +52,50 @@ func NewMailer(config MailerConfig) (domain.Mailer, error) {
 	}
 }
 
+// VerifyMailer checks that the provider of config accepts its credentials without sending
+// anything: for SES it reads the account's sending quota, which fails on bad keys or region, and
+// fails when sending is paused. Other providers need no credentials and always pass.
+func VerifyMailer(ctx context.Context, config MailerConfig) error {
+	if config.Provider != "ses" {
+		return nil
+	}
+	client := newSESClient(config.SES)
+	if _, err := client.GetSendQuota(ctx, &ses.GetSendQuotaInput{}); err != nil {
+		return fmt.Errorf("read SES send quota: %w", err)
+	}
+	enabled, err := client.GetAccountSendingEnabled(ctx, &ses.GetAccountSendingEnabledInput{})
+	if err != nil {
+		return fmt.Errorf("read SES sending status: %w", err)
+	}
+	if !enabled.Enabled {
+		return fmt.Errorf("SES sending is paused for the account in %s", config.SES.Region)
+	}
+	return nil
+}
+
+func newSESClient(sesConfig SESConfig) *ses.Client {
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: sesConfig.InsecureSkipVerify,
+				MinVersion:         tls.VersionTLS12,
+			},
+		},
+	}
+	awsCfg := aws.Config{
+		Region: sesConfig.Region,
+		Credentials: aws.NewCredentialsCache(
+			credentials.NewStaticCredentialsProvider(
+				sesConfig.AccessKeyID,
+				sesConfig.SecretAccessKey,
+				"",
+			),
+		),
+		HTTPClient: httpClient,
+	}
+	return ses.NewFromConfig(awsCfg)
+}
+
 type sesMailer struct {
 	client      *ses.Client
 	fromAddress string
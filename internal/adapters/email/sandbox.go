@@ -0,0 +1,58 @@
+package email
+
+import (
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+// DefaultSandboxCapacity is how many messages a sandbox mailer keeps; older ones are dropped.
+const DefaultSandboxCapacity = 500
+
+// SandboxMailer records messages in memory instead of sending them. It is both the Mailer and the
+// Mailbox the recorded messages are read from.
+type SandboxMailer struct {
+	mu       sync.Mutex
+	capacity int
+	sent     []domain.SentEmail
+}
+
+// NewSandboxMailer returns a sandbox mailer keeping the last capacity messages.
+func NewSandboxMailer(capacity int) *SandboxMailer {
+	if capacity < 1 {
+		capacity = DefaultSandboxCapacity
+	}
+	return &SandboxMailer{capacity: capacity}
+}
+
+func (m *SandboxMailer) Send(to, subject, html, text string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.sent) == m.capacity {
+		m.sent = append(m.sent[:0], m.sent[1:]...)
+	}
+	m.sent = append(m.sent, domain.SentEmail{To: to, Subject: subject, HTML: html, Text: text, SentAt: time.Now().UTC()})
+	log.Println("[MAILER] Email recorded (sandbox)", "to", to, "subject", subject)
+	return nil
+}
+
+func (m *SandboxMailer) SentEmails(to string) []domain.SentEmail {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := []domain.SentEmail{}
+	for i := len(m.sent) - 1; i >= 0; i-- {
+		if to == "" || strings.EqualFold(m.sent[i].To, to) {
+			out = append(out, m.sent[i])
+		}
+	}
+	return out
+}
+
+func (m *SandboxMailer) ClearSentEmails() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sent = nil
+}
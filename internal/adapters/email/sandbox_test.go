@@ -0,0 +1,27 @@
+package email
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSandboxMailer(t *testing.T) {
+	m := NewSandboxMailer(2)
+	require.NoError(t, m.Send("a@example.com", "First", "<p>1</p>", "1"))
+	require.NoError(t, m.Send("b@example.com", "Second", "<p>2</p>", "2"))
+	require.NoError(t, m.Send("A@example.com", "Third", "<p>3</p>", "3"))
+
+	all := m.SentEmails("")
+	require.Len(t, all, 2, "the oldest message is dropped at capacity")
+	assert.Equal(t, "Third", all[0].Subject)
+	assert.Equal(t, "Second", all[1].Subject)
+
+	toA := m.SentEmails("a@EXAMPLE.com")
+	require.Len(t, toA, 1)
+	assert.Equal(t, "3", toA[0].Text)
+
+	m.ClearSentEmails()
+	assert.Empty(t, m.SentEmails(""))
+}
@@ -0,0 +1,140 @@
+// Package enrichment suggests session tags and summaries with a language model behind an
+// OpenAI-compatible chat completions endpoint.
+package enrichment
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+const systemPrompt = `You help conference organizers catalogue their sessions. Reply with a JSON object only: ` +
+	`{"tags": [...], "summary": "..."}. Tags are 1 to 3 words each, at most 5, and reuse the event's ` +
+	`existing tags when they fit. The summary is one or two plain sentences for attendees, without ` +
+	`marketing language, in the language of the session title. Leave out what you are not asked for.`
+
+type enricher struct {
+	url    string
+	apiKey string
+	model  string
+	client *http.Client
+}
+
+// NewEnricher returns a SessionEnricher that POSTs a chat completion request for model to
+// completionsURL (e.g. https://api.openai.com/v1/chat/completions), with apiKey as the bearer
+// token when set, and reads a JSON object from the reply. With a nil client it uses one that gives
+// up after 30 seconds.
+func NewEnricher(completionsURL, apiKey, model string, client *http.Client) domain.SessionEnricher {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &enricher{url: completionsURL, apiKey: apiKey, model: model, client: client}
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatRequest struct {
+	Model          string            `json:"model,omitempty"`
+	Messages       []chatMessage     `json:"messages"`
+	ResponseFormat map[string]string `json:"response_format"`
+	Temperature    float64           `json:"temperature"`
+}
+
+type chatResponse struct {
+	Choices []struct {
+		Message chatMessage `json:"message"`
+	} `json:"choices"`
+}
+
+func (e *enricher) EnrichSession(ctx context.Context, in *domain.EnrichmentInput) (*domain.EnrichmentOutput, error) {
+	body, err := json.Marshal(chatRequest{
+		Model: e.model,
+		Messages: []chatMessage{
+			{Role: "system", Content: systemPrompt},
+			{Role: "user", Content: userPrompt(in)},
+		},
+		ResponseFormat: map[string]string{"type": "json_object"},
+		Temperature:    0.2,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("encode enrichment request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("build enrichment request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if e.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+e.apiKey)
+	}
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request enrichment: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+		return nil, fmt.Errorf("request enrichment: unexpected status %d", resp.StatusCode)
+	}
+	var result chatResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<16)).Decode(&result); err != nil {
+		return nil, fmt.Errorf("decode enrichment response: %w", err)
+	}
+	if len(result.Choices) == 0 {
+		return nil, fmt.Errorf("decode enrichment response: no choices")
+	}
+	var suggestion struct {
+		Tags    []string `json:"tags"`
+		Summary string   `json:"summary"`
+	}
+	content := strings.TrimSpace(result.Choices[0].Message.Content)
+	content = strings.TrimSuffix(strings.TrimPrefix(strings.TrimPrefix(content, "```json"), "```"), "```")
+	if err := json.Unmarshal([]byte(content), &suggestion); err != nil {
+		return nil, fmt.Errorf("decode enrichment suggestion: %w", err)
+	}
+	out := &domain.EnrichmentOutput{}
+	if in.WantTags {
+		out.Tags = suggestion.Tags
+	}
+	if in.WantSummary {
+		out.Summary = suggestion.Summary
+	}
+	return out, nil
+}
+
+// userPrompt describes the session and says what to suggest for it.
+func userPrompt(in *domain.EnrichmentInput) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Event: %s\nSession title: %s\n", in.EventName, in.Title)
+	if in.SessionType != "" {
+		fmt.Fprintf(&b, "Session type: %s\n", in.SessionType)
+	}
+	if in.Track != "" {
+		fmt.Fprintf(&b, "Track: %s\n", in.Track)
+	}
+	if in.Description != "" {
+		fmt.Fprintf(&b, "Description: %s\n", in.Description)
+	}
+	if len(in.EventTags) > 0 {
+		fmt.Fprintf(&b, "Existing event tags: %s\n", strings.Join(in.EventTags, ", "))
+	}
+	var want []string
+	if in.WantTags {
+		want = append(want, "tags")
+	}
+	if in.WantSummary {
+		want = append(want, "a summary")
+	}
+	fmt.Fprintf(&b, "Suggest %s.", strings.Join(want, " and "))
+	return b.String()
+}
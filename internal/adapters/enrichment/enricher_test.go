@@ -0,0 +1,72 @@
+package enrichment
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"multitrackticketing/internal/domain"
+)
+
+func TestEnricher_EnrichSession(t *testing.T) {
+	var got chatRequest
+	var auth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"{\"tags\":[\"Go\",\"Generics\"],\"summary\":\"How to use generics well.\"}"}}]}`))
+	}))
+	defer srv.Close()
+
+	e := NewEnricher(srv.URL+"/v1/chat/completions", "sk-test", "small-model", srv.Client())
+	out, err := e.EnrichSession(context.Background(), &domain.EnrichmentInput{
+		EventName: "GopherCon", Title: "Generics in practice", EventTags: []string{"Go"}, WantTags: true, WantSummary: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Go", "Generics"}, out.Tags)
+	assert.Equal(t, "How to use generics well.", out.Summary)
+
+	assert.Equal(t, "Bearer sk-test", auth)
+	assert.Equal(t, "small-model", got.Model)
+	assert.Equal(t, "json_object", got.ResponseFormat["type"])
+	require.Len(t, got.Messages, 2)
+	assert.Contains(t, got.Messages[1].Content, "Session title: Generics in practice")
+	assert.Contains(t, got.Messages[1].Content, "Existing event tags: Go")
+	assert.Contains(t, got.Messages[1].Content, "Suggest tags and a summary.")
+}
+
+func TestEnricher_EnrichSessionOnlyWhatWasAsked(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"choices":[{"message":{"content":"` + "```json" + `{\"tags\":[\"Go\"],\"summary\":\"Unwanted.\"}` + "```" + `"}}]}`))
+	}))
+	defer srv.Close()
+
+	out, err := NewEnricher(srv.URL, "", "", srv.Client()).EnrichSession(context.Background(), &domain.EnrichmentInput{Title: "Go", WantTags: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Go"}, out.Tags)
+	assert.Empty(t, out.Summary)
+}
+
+func TestEnricher_EnrichSessionProviderError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	_, err := NewEnricher(srv.URL, "sk-test", "", srv.Client()).EnrichSession(context.Background(), &domain.EnrichmentInput{Title: "Go", WantTags: true})
+	require.Error(t, err)
+
+	bad := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"choices":[{"message":{"content":"Sure! Here are some tags."}}]}`))
+	}))
+	defer bad.Close()
+
+	_, err = NewEnricher(bad.URL, "", "", bad.Client()).EnrichSession(context.Background(), &domain.EnrichmentInput{Title: "Go", WantTags: true})
+	require.Error(t, err)
+}
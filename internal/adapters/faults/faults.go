@@ -0,0 +1,143 @@
+// Package faults wraps the email provider, the Sessionize fetcher and the picture downloads with
+// injected latency and errors, so staging can exercise retries, circuit breakers and fallbacks.
+// The wrappers are only installed with FAULT_INJECTION, which production refuses.
+package faults
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+// ErrInjected is the error of a call failed on purpose.
+var ErrInjected = errors.New("fault injected")
+
+// Injector holds the current fault of each dependency. It implements domain.FaultInjector and is
+// safe for concurrent use; faults can be changed while calls are in flight.
+type Injector struct {
+	random func() float64
+	sleep  func(ctx context.Context, d time.Duration) error
+
+	mu     sync.Mutex
+	faults map[string]domain.Fault
+}
+
+// NewInjector returns an Injector that injects nothing until a fault is set.
+func NewInjector() *Injector {
+	return &Injector{random: rand.Float64, sleep: sleepContext, faults: make(map[string]domain.Fault)}
+}
+
+func (in *Injector) Faults() []domain.Fault {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	faults := make([]domain.Fault, len(domain.FaultDependencies))
+	for i, dep := range domain.FaultDependencies {
+		faults[i] = in.faults[dep]
+		faults[i].Dependency = dep
+	}
+	return faults
+}
+
+func (in *Injector) SetFault(f domain.Fault) error {
+	if !slices.Contains(domain.FaultDependencies, f.Dependency) {
+		return fmt.Errorf("unknown dependency %q: %w", f.Dependency, domain.ErrInvalidInput)
+	}
+	if f.LatencyMs < 0 {
+		return fmt.Errorf("latency_ms must not be negative: %w", domain.ErrInvalidInput)
+	}
+	if f.ErrorRate < 0 || f.ErrorRate > 1 {
+		return fmt.Errorf("error_rate must be between 0 and 1: %w", domain.ErrInvalidInput)
+	}
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	in.faults[f.Dependency] = f
+	return nil
+}
+
+// inject waits out the dependency's latency and then returns ErrInjected for its share of calls.
+// It returns ctx's error when ctx ends during the wait.
+func (in *Injector) inject(ctx context.Context, dependency string) error {
+	in.mu.Lock()
+	f := in.faults[dependency]
+	in.mu.Unlock()
+	if f.LatencyMs > 0 {
+		if err := in.sleep(ctx, time.Duration(f.LatencyMs)*time.Millisecond); err != nil {
+			return err
+		}
+	}
+	if f.ErrorRate > 0 && in.random() < f.ErrorRate {
+		return fmt.Errorf("%s: %w", dependency, ErrInjected)
+	}
+	return nil
+}
+
+type mailer struct {
+	next domain.Mailer
+	in   *Injector
+}
+
+// Mailer returns next with the email fault injected before every send.
+func Mailer(next domain.Mailer, in *Injector) domain.Mailer {
+	return &mailer{next: next, in: in}
+}
+
+func (m *mailer) Send(to, subject, html, text string) error {
+	if err := m.in.inject(context.Background(), domain.FaultDependencyEmail); err != nil {
+		return err
+	}
+	return m.next.Send(to, subject, html, text)
+}
+
+type sessionFetcher struct {
+	next domain.SessionFetcher
+	in   *Injector
+}
+
+// SessionFetcher returns next with the sessionize fault injected before every fetch. Wrap the HTTP
+// fetcher, not the resilient one: injected failures look like transport errors, so they are
+// retried and count towards the circuit breaker.
+func SessionFetcher(next domain.SessionFetcher, in *Injector) domain.SessionFetcher {
+	return &sessionFetcher{next: next, in: in}
+}
+
+func (f *sessionFetcher) Fetch(ctx context.Context, sessionizeID string, forceRefresh bool) (domain.SessionFetcherResponse, error) {
+	if err := f.in.inject(ctx, domain.FaultDependencySessionize); err != nil {
+		return domain.SessionFetcherResponse{}, &url.Error{Op: "Get", URL: "sessionize/" + sessionizeID, Err: err}
+	}
+	return f.next.Fetch(ctx, sessionizeID, forceRefresh)
+}
+
+type thumbnailFetcher struct {
+	next domain.ThumbnailFetcher
+	in   *Injector
+}
+
+// ThumbnailFetcher returns next with the images fault injected before every download.
+func ThumbnailFetcher(next domain.ThumbnailFetcher, in *Injector) domain.ThumbnailFetcher {
+	return &thumbnailFetcher{next: next, in: in}
+}
+
+func (f *thumbnailFetcher) FetchThumbnail(ctx context.Context, pictureURL string) ([]byte, error) {
+	if err := f.in.inject(ctx, domain.FaultDependencyImages); err != nil {
+		return nil, err
+	}
+	return f.next.FetchThumbnail(ctx, pictureURL)
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
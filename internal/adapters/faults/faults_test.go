@@ -0,0 +1,152 @@
+package faults
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestInjector returns an Injector whose draws are fixed at roll and whose sleeps are recorded.
+func newTestInjector(roll float64) (*Injector, *[]time.Duration) {
+	in := NewInjector()
+	var sleeps []time.Duration
+	in.random = func() float64 { return roll }
+	in.sleep = func(ctx context.Context, d time.Duration) error {
+		sleeps = append(sleeps, d)
+		return ctx.Err()
+	}
+	return in, &sleeps
+}
+
+type stubMailer struct{ sent int }
+
+func (m *stubMailer) Send(to, subject, html, text string) error {
+	m.sent++
+	return nil
+}
+
+type stubFetcher struct{ calls int }
+
+func (f *stubFetcher) Fetch(ctx context.Context, sessionizeID string, forceRefresh bool) (domain.SessionFetcherResponse, error) {
+	f.calls++
+	return domain.SessionFetcherResponse{}, nil
+}
+
+type stubThumbnails struct{ calls int }
+
+func (f *stubThumbnails) FetchThumbnail(ctx context.Context, pictureURL string) ([]byte, error) {
+	f.calls++
+	return []byte("jpeg"), nil
+}
+
+func TestInjector_SetFault(t *testing.T) {
+	tests := []struct {
+		name    string
+		fault   domain.Fault
+		wantErr bool
+	}{
+		{name: "latency and errors", fault: domain.Fault{Dependency: domain.FaultDependencyEmail, LatencyMs: 200, ErrorRate: 0.5}},
+		{name: "clear", fault: domain.Fault{Dependency: domain.FaultDependencyImages}},
+		{name: "unknown dependency", fault: domain.Fault{Dependency: "blob"}, wantErr: true},
+		{name: "negative latency", fault: domain.Fault{Dependency: domain.FaultDependencyEmail, LatencyMs: -1}, wantErr: true},
+		{name: "rate above one", fault: domain.Fault{Dependency: domain.FaultDependencySessionize, ErrorRate: 1.5}, wantErr: true},
+		{name: "negative rate", fault: domain.Fault{Dependency: domain.FaultDependencySessionize, ErrorRate: -0.1}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := NewInjector()
+			err := in.SetFault(tt.fault)
+			if tt.wantErr {
+				require.ErrorIs(t, err, domain.ErrInvalidInput)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, in.Faults(), tt.fault)
+		})
+	}
+}
+
+func TestInjector_Faults_ListsEveryDependency(t *testing.T) {
+	in := NewInjector()
+	require.NoError(t, in.SetFault(domain.Fault{Dependency: domain.FaultDependencySessionize, ErrorRate: 1}))
+
+	assert.Equal(t, []domain.Fault{
+		{Dependency: domain.FaultDependencyEmail},
+		{Dependency: domain.FaultDependencySessionize, ErrorRate: 1},
+		{Dependency: domain.FaultDependencyImages},
+	}, in.Faults())
+}
+
+func TestMailer(t *testing.T) {
+	t.Run("no fault passes through", func(t *testing.T) {
+		in, sleeps := newTestInjector(0)
+		next := &stubMailer{}
+		require.NoError(t, Mailer(next, in).Send("a@example.com", "s", "h", "t"))
+		assert.Equal(t, 1, next.sent)
+		assert.Empty(t, *sleeps)
+	})
+	t.Run("latency then pass", func(t *testing.T) {
+		in, sleeps := newTestInjector(0.9)
+		require.NoError(t, in.SetFault(domain.Fault{Dependency: domain.FaultDependencyEmail, LatencyMs: 250, ErrorRate: 0.5}))
+		next := &stubMailer{}
+		require.NoError(t, Mailer(next, in).Send("a@example.com", "s", "h", "t"))
+		assert.Equal(t, 1, next.sent)
+		assert.Equal(t, []time.Duration{250 * time.Millisecond}, *sleeps)
+	})
+	t.Run("error drawn", func(t *testing.T) {
+		in, _ := newTestInjector(0.1)
+		require.NoError(t, in.SetFault(domain.Fault{Dependency: domain.FaultDependencyEmail, ErrorRate: 0.5}))
+		next := &stubMailer{}
+		err := Mailer(next, in).Send("a@example.com", "s", "h", "t")
+		require.ErrorIs(t, err, ErrInjected)
+		assert.Zero(t, next.sent)
+	})
+}
+
+func TestSessionFetcher_InjectedErrorIsTransportError(t *testing.T) {
+	in, _ := newTestInjector(0)
+	require.NoError(t, in.SetFault(domain.Fault{Dependency: domain.FaultDependencySessionize, ErrorRate: 1}))
+	next := &stubFetcher{}
+
+	_, err := SessionFetcher(next, in).Fetch(context.Background(), "abc", false)
+
+	var urlErr *url.Error
+	require.True(t, errors.As(err, &urlErr))
+	assert.ErrorIs(t, err, ErrInjected)
+	assert.Zero(t, next.calls)
+}
+
+func TestSessionFetcher_ContextEndsDuringLatency(t *testing.T) {
+	in, _ := newTestInjector(0)
+	require.NoError(t, in.SetFault(domain.Fault{Dependency: domain.FaultDependencySessionize, LatencyMs: 1000}))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	next := &stubFetcher{}
+
+	_, err := SessionFetcher(next, in).Fetch(ctx, "abc", false)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Zero(t, next.calls)
+}
+
+func TestThumbnailFetcher(t *testing.T) {
+	in, _ := newTestInjector(0.5)
+	next := &stubThumbnails{}
+	f := ThumbnailFetcher(next, in)
+
+	data, err := f.FetchThumbnail(context.Background(), "https://example.com/a.jpg")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("jpeg"), data)
+
+	require.NoError(t, in.SetFault(domain.Fault{Dependency: domain.FaultDependencyImages, ErrorRate: 1}))
+	_, err = f.FetchThumbnail(context.Background(), "https://example.com/a.jpg")
+	require.ErrorIs(t, err, ErrInjected)
+	assert.Equal(t, 1, next.calls)
+}
@@ -0,0 +1,211 @@
+package images
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+
+	"multitrackticketing/internal/domain"
+)
+
+// Card layout, in pixels of the domain.SessionCardWidth by domain.SessionCardHeight image.
+const (
+	cardMargin     = 72
+	cardBandHeight = 110
+	cardFooter     = 16
+)
+
+// Colors of a card whose theme leaves them unset.
+var (
+	defaultCardBackground = color.RGBA{0xff, 0xff, 0xff, 0xff}
+	defaultCardText       = color.RGBA{0x11, 0x18, 0x27, 0xff}
+	defaultCardPrimary    = color.RGBA{0x1f, 0x29, 0x37, 0xff}
+	defaultCardSecondary  = color.RGBA{0x63, 0x66, 0xf1, 0xff}
+)
+
+// cardFonts are the Go fonts, parsed once. Themes name fonts the apps load themselves; the server
+// has no font files of its own, so cards always use these.
+var cardFonts = sync.OnceValues(func() ([2]*opentype.Font, error) {
+	regular, err := opentype.Parse(goregular.TTF)
+	if err != nil {
+		return [2]*opentype.Font{}, err
+	}
+	bold, err := opentype.Parse(gobold.TTF)
+	if err != nil {
+		return [2]*opentype.Font{}, err
+	}
+	return [2]*opentype.Font{regular, bold}, nil
+})
+
+type cardRenderer struct{}
+
+// NewCardRenderer returns a renderer of session preview images in the event's theme colors.
+func NewCardRenderer() domain.SessionCardRenderer {
+	return cardRenderer{}
+}
+
+func (cardRenderer) RenderSessionCard(card *domain.SessionCard) ([]byte, error) {
+	fonts, err := cardFonts()
+	if err != nil {
+		return nil, fmt.Errorf("parse fonts: %w", err)
+	}
+	theme := card.Theme
+	if theme == nil {
+		theme = domain.NewEventTheme(card.EventID)
+	}
+	background := hexColor(theme.BackgroundColor, defaultCardBackground)
+	text := hexColor(theme.TextColor, defaultCardText)
+	primary := hexColor(theme.PrimaryColor, defaultCardPrimary)
+	secondary := hexColor(theme.SecondaryColor, defaultCardSecondary)
+
+	w, h := domain.SessionCardWidth, domain.SessionCardHeight
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.Draw(img, img.Bounds(), image.NewUniform(background), image.Point{}, draw.Src)
+	draw.Draw(img, image.Rect(0, 0, w, cardBandHeight), image.NewUniform(primary), image.Point{}, draw.Src)
+	draw.Draw(img, image.Rect(0, h-cardFooter, w, h), image.NewUniform(secondary), image.Point{}, draw.Src)
+
+	width := w - 2*cardMargin
+	faces := make([]font.Face, 0, 4)
+	defer func() {
+		for _, f := range faces {
+			f.Close()
+		}
+	}()
+	face := func(f *opentype.Font, size float64) (font.Face, error) {
+		ff, err := opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull})
+		if err == nil {
+			faces = append(faces, ff)
+		}
+		return ff, err
+	}
+	eventFace, err := face(fonts[1], 40)
+	if err != nil {
+		return nil, err
+	}
+	titleFace, err := face(fonts[1], 64)
+	if err != nil {
+		return nil, err
+	}
+	detailFace, err := face(fonts[0], 34)
+	if err != nil {
+		return nil, err
+	}
+
+	drawLines(img, eventFace, contrastText(primary), cardMargin, 72, width, 0, wrap(eventFace, card.EventName, width, 1))
+	y := cardBandHeight + 96
+	y = drawLines(img, titleFace, text, cardMargin, y, width, 76, wrap(titleFace, card.Title, width, 3))
+	y += 24
+	var details []string
+	if when := card.When(); when != "" {
+		details = append(details, when)
+	}
+	if card.Room != "" {
+		details = append(details, card.Room)
+	}
+	y = drawLines(img, detailFace, text, cardMargin, y, width, 46, wrap(detailFace, strings.Join(details, " · "), width, 1))
+	if len(card.Speakers) > 0 {
+		drawLines(img, detailFace, primaryOn(primary, background, text), cardMargin, y+8, width, 46, wrap(detailFace, strings.Join(card.Speakers, ", "), width, 2))
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("encode png: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// drawLines draws lines from baseline y, lineHeight apart, and returns the baseline after the last.
+func drawLines(img draw.Image, face font.Face, c color.Color, x, y, width, lineHeight int, lines []string) int {
+	d := &font.Drawer{Dst: img, Src: image.NewUniform(c), Face: face}
+	for _, line := range lines {
+		d.Dot = fixed.P(x, y)
+		d.DrawString(line)
+		y += lineHeight
+	}
+	return y
+}
+
+// wrap breaks s into at most maxLines lines no wider than width. A line that does not fit, and the
+// last line when s goes on, end with an ellipsis.
+func wrap(face font.Face, s string, width, maxLines int) []string {
+	limit := fixed.I(width)
+	var lines []string
+	more := false
+	for _, word := range strings.Fields(s) {
+		if n := len(lines); n > 0 && font.MeasureString(face, lines[n-1]+" "+word) <= limit {
+			lines[n-1] += " " + word
+			continue
+		}
+		if len(lines) == maxLines {
+			more = true
+			break
+		}
+		lines = append(lines, word)
+	}
+	for i, line := range lines {
+		if (more && i == len(lines)-1) || font.MeasureString(face, line) > limit {
+			lines[i] = ellipsize(face, line, limit)
+		}
+	}
+	return lines
+}
+
+// ellipsize shortens s until it fits in limit with an ellipsis after it.
+func ellipsize(face font.Face, s string, limit fixed.Int26_6) string {
+	r := []rune(s)
+	for len(r) > 0 && font.MeasureString(face, string(r)+"…") > limit {
+		r = r[:len(r)-1]
+	}
+	return strings.TrimSpace(string(r)) + "…"
+}
+
+// hexColor parses a theme color, "#RRGGBB" or "#RRGGBBAA", or returns fallback.
+func hexColor(s string, fallback color.RGBA) color.RGBA {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "#")
+	if len(s) == 6 {
+		s += "ff"
+	}
+	if len(s) != 8 {
+		return fallback
+	}
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return fallback
+	}
+	c := color.NRGBA{R: uint8(v >> 24), G: uint8(v >> 16), B: uint8(v >> 8), A: uint8(v)}
+	return color.RGBAModel.Convert(c).(color.RGBA)
+}
+
+// contrastText returns black or white, whichever reads better on background.
+func contrastText(background color.RGBA) color.RGBA {
+	if luminance(background) > 0.5 {
+		return color.RGBA{0, 0, 0, 0xff}
+	}
+	return color.RGBA{0xff, 0xff, 0xff, 0xff}
+}
+
+// primaryOn returns the primary color for text on background, or text when the two are too alike
+// to read.
+func primaryOn(primary, background, text color.RGBA) color.RGBA {
+	d := luminance(primary) - luminance(background)
+	if d < 0.3 && d > -0.3 {
+		return text
+	}
+	return primary
+}
+
+func luminance(c color.RGBA) float64 {
+	return (0.2126*float64(c.R) + 0.7152*float64(c.G) + 0.0722*float64(c.B)) / 255
+}
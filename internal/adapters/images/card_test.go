@@ -0,0 +1,67 @@
+package images
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCardRenderer_RenderSessionCard(t *testing.T) {
+	start := time.Date(2026, 5, 14, 9, 0, 0, 0, time.UTC)
+	card := &domain.SessionCard{
+		EventID: "ev-1", EventName: "GopherConf", Title: strings.Repeat("A very long session title ", 20),
+		Speakers: []string{"Ada Lovelace"}, Room: "Main Hall", StartTime: start, EndTime: start.Add(time.Hour),
+		Theme: &domain.EventTheme{PrimaryColor: "#112233", BackgroundColor: "#fafafa", SecondaryColor: "not a color"},
+	}
+
+	out, err := NewCardRenderer().RenderSessionCard(card)
+	require.NoError(t, err)
+	img, err := png.Decode(bytes.NewReader(out))
+	require.NoError(t, err)
+	assert.Equal(t, domain.SessionCardWidth, img.Bounds().Dx())
+	assert.Equal(t, domain.SessionCardHeight, img.Bounds().Dy())
+	assert.Equal(t, color.RGBA{0x11, 0x22, 0x33, 0xff}, color.RGBAModel.Convert(img.At(1, 1)), "band in the primary color")
+	assert.Equal(t, defaultCardSecondary, color.RGBAModel.Convert(img.At(1, domain.SessionCardHeight-1)), "invalid colors fall back")
+
+	card.Theme = nil
+	_, err = NewCardRenderer().RenderSessionCard(card)
+	require.NoError(t, err, "a card without a theme uses the defaults")
+}
+
+func TestWrap(t *testing.T) {
+	fonts, err := cardFonts()
+	require.NoError(t, err)
+	face, err := opentype.NewFace(fonts[0], &opentype.FaceOptions{Size: 20, DPI: 72})
+	require.NoError(t, err)
+	defer face.Close()
+	width := font.MeasureString(face, "aaaa bbbb").Ceil()
+
+	assert.Equal(t, []string{"aaaa bbbb", "cc"}, wrap(face, "aaaa bbbb cc", width, 3))
+	assert.Equal(t, []string{"aaaa bbbb", "cc dddd…"}, wrap(face, "aaaa bbbb cc dddd eeee ffff", width, 2), "the last line shows there is more")
+	lines := wrap(face, "abcdefghijklmnopqrstuvwxyz", width, 2)
+	require.Len(t, lines, 1)
+	assert.True(t, strings.HasSuffix(lines[0], "…"))
+	assert.LessOrEqual(t, font.MeasureString(face, lines[0]), fixed.I(width), "a long word is cut to fit")
+	assert.Empty(t, wrap(face, "  ", width, 2))
+}
+
+func TestHexColor(t *testing.T) {
+	fallback := color.RGBA{1, 2, 3, 0xff}
+	assert.Equal(t, color.RGBA{0xff, 0x00, 0x80, 0xff}, hexColor("#FF0080", fallback))
+	assert.Equal(t, color.RGBA{0x80, 0x00, 0x00, 0x80}, hexColor("#ff000080", fallback), "alpha is premultiplied")
+	assert.Equal(t, fallback, hexColor("", fallback))
+	assert.Equal(t, fallback, hexColor("#abc", fallback))
+	assert.Equal(t, fallback, hexColor("#gggggg", fallback))
+}
@@ -0,0 +1,187 @@
+// Package images downloads pictures such as speaker photos and shrinks them to thumbnails.
+package images
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif" // decoders for the formats speaker photos come in
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+const (
+	// maxPictureBytes bounds how much of a picture is downloaded.
+	maxPictureBytes = 5 << 20
+	// maxPicturePixels bounds the decoded size, so a small file cannot expand into a huge image.
+	maxPicturePixels = 40_000_000
+	// ThumbnailSize is the longest side of a thumbnail in pixels.
+	ThumbnailSize    = 256
+	thumbnailQuality = 80
+)
+
+// ErrBlockedAddress is returned for pictures hosted on loopback, private or otherwise non-public
+// addresses. Picture URLs come from organizers and imports, so they must not reach internal services.
+var ErrBlockedAddress = errors.New("picture host is not a public address")
+
+type thumbnailFetcher struct {
+	client *http.Client
+}
+
+// NewThumbnailFetcher returns a fetcher that downloads pictures with client. With a nil client it
+// uses one that only connects to public addresses and gives up after 10 seconds.
+func NewThumbnailFetcher(client *http.Client) domain.ThumbnailFetcher {
+	return newThumbnailFetcher(client)
+}
+
+// NewPictureFetcher returns a fetcher that downloads pictures at their original size, with the
+// same client and limits as NewThumbnailFetcher.
+func NewPictureFetcher(client *http.Client) domain.PictureFetcher {
+	return newThumbnailFetcher(client)
+}
+
+func newThumbnailFetcher(client *http.Client) *thumbnailFetcher {
+	if client == nil {
+		dialer := &net.Dialer{Timeout: 5 * time.Second, Control: publicAddressesOnly}
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.Proxy = nil
+		transport.DialContext = dialer.DialContext
+		client = &http.Client{Transport: transport, Timeout: 10 * time.Second}
+	}
+	return &thumbnailFetcher{client: client}
+}
+
+func (f *thumbnailFetcher) FetchThumbnail(ctx context.Context, pictureURL string) ([]byte, error) {
+	data, err := f.download(ctx, pictureURL)
+	if err != nil {
+		return nil, err
+	}
+	return Thumbnail(data)
+}
+
+func (f *thumbnailFetcher) FetchPicture(ctx context.Context, pictureURL string) ([]byte, string, error) {
+	data, err := f.download(ctx, pictureURL)
+	if err != nil {
+		return nil, "", err
+	}
+	_, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to decode picture: %w", err)
+	}
+	return data, format, nil
+}
+
+// download returns the picture at pictureURL, refusing anything but http and https and anything
+// over maxPictureBytes.
+func (f *thumbnailFetcher) download(ctx context.Context, pictureURL string) ([]byte, error) {
+	u, err := url.Parse(pictureURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid picture url %q", pictureURL)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch picture: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("picture host returned status: %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxPictureBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read picture: %w", err)
+	}
+	if len(data) > maxPictureBytes {
+		return nil, fmt.Errorf("picture is larger than %d bytes", maxPictureBytes)
+	}
+	return data, nil
+}
+
+// Thumbnail decodes a JPEG, PNG or GIF picture and returns it as a JPEG whose longest side is at
+// most ThumbnailSize pixels. Smaller pictures keep their size.
+func Thumbnail(data []byte) ([]byte, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode picture: %w", err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxPicturePixels {
+		return nil, fmt.Errorf("picture of %dx%d pixels is not supported", cfg.Width, cfg.Height)
+	}
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode picture: %w", err)
+	}
+	var out bytes.Buffer
+	if err := jpeg.Encode(&out, shrink(src, ThumbnailSize), &jpeg.Options{Quality: thumbnailQuality}); err != nil {
+		return nil, fmt.Errorf("failed to encode thumbnail: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// shrink scales src down to fit in size × size, averaging the source pixels each target pixel
+// covers. Transparent areas become white, as JPEG has no alpha channel.
+func shrink(src image.Image, size int) image.Image {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w > size || h > size {
+		if w >= h {
+			w, h = size, max(1, h*size/b.Dx())
+		} else {
+			w, h = max(1, w*size/b.Dy()), size
+		}
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := range h {
+		y0, y1 := b.Min.Y+y*b.Dy()/h, b.Min.Y+(y+1)*b.Dy()/h
+		for x := range w {
+			x0, x1 := b.Min.X+x*b.Dx()/w, b.Min.X+(x+1)*b.Dx()/w
+			var r, g, bl, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					// Blend onto white: the colors are alpha-premultiplied.
+					r += uint64(cr + 0xffff - ca)
+					g += uint64(cg + 0xffff - ca)
+					bl += uint64(cb + 0xffff - ca)
+					n++
+				}
+			}
+			dst.SetRGBA(x, y, color.RGBA{R: uint8(r / n >> 8), G: uint8(g / n >> 8), B: uint8(bl / n >> 8), A: 0xff})
+		}
+	}
+	return dst
+}
+
+// publicAddressesOnly is a net.Dialer Control that refuses to connect to non-public addresses. It
+// runs after name resolution, so a public name pointing at a private address is refused too.
+func publicAddressesOnly(network, address string, _ syscall.RawConn) error {
+	ap, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrBlockedAddress, address)
+	}
+	if !isPublic(ap.Addr()) {
+		return fmt.Errorf("%w: %s", ErrBlockedAddress, ap.Addr())
+	}
+	return nil
+}
+
+func isPublic(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() && !addr.IsLoopback() && !addr.IsLinkLocalUnicast() &&
+		!netip.MustParsePrefix("100.64.0.0/10").Contains(addr) // carrier-grade NAT
+}
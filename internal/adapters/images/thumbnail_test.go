@@ -0,0 +1,141 @@
+package images
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bodyTransport answers every request with status and body, without touching the network.
+type bodyTransport struct {
+	status int
+	body   []byte
+}
+
+func (b bodyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: b.status,
+		Body:       io.NopCloser(bytes.NewReader(b.body)),
+		Header:     make(http.Header),
+		Request:    req,
+	}, nil
+}
+
+func encodePNG(t *testing.T, w, h int, c color.Color) []byte {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := range h {
+		for x := range w {
+			img.Set(x, y, c)
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestThumbnail(t *testing.T) {
+	tests := []struct {
+		name          string
+		w, h          int
+		c             color.Color
+		wantW, wantH  int
+		wantNearWhite bool
+	}{
+		{name: "landscape is shrunk to the longest side", w: 1024, h: 512, c: color.NRGBA{R: 200, A: 255}, wantW: 256, wantH: 128},
+		{name: "portrait", w: 300, h: 600, c: color.NRGBA{B: 200, A: 255}, wantW: 128, wantH: 256},
+		{name: "small picture keeps its size", w: 64, h: 48, c: color.NRGBA{G: 200, A: 255}, wantW: 64, wantH: 48},
+		{name: "transparent becomes white", w: 10, h: 10, c: color.NRGBA{}, wantW: 10, wantH: 10, wantNearWhite: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := Thumbnail(encodePNG(t, tt.w, tt.h, tt.c))
+			require.NoError(t, err)
+			img, err := jpeg.Decode(bytes.NewReader(out))
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantW, img.Bounds().Dx())
+			assert.Equal(t, tt.wantH, img.Bounds().Dy())
+			if tt.wantNearWhite {
+				r, g, b, _ := img.At(5, 5).RGBA()
+				assert.Greater(t, min(r, g, b), uint32(0xf000))
+			}
+		})
+	}
+}
+
+func TestThumbnail_NotAPicture(t *testing.T) {
+	_, err := Thumbnail([]byte("<html>not found</html>"))
+	require.Error(t, err)
+}
+
+func TestThumbnailFetcher_FetchThumbnail(t *testing.T) {
+	ctx := context.Background()
+	pic := encodePNG(t, 512, 512, color.NRGBA{R: 10, G: 20, B: 30, A: 255})
+
+	out, err := NewThumbnailFetcher(&http.Client{Transport: bodyTransport{status: http.StatusOK, body: pic}}).
+		FetchThumbnail(ctx, "https://sessionize.com/image/abc.png")
+	require.NoError(t, err)
+	cfg, err := jpeg.DecodeConfig(bytes.NewReader(out))
+	require.NoError(t, err)
+	assert.Equal(t, ThumbnailSize, cfg.Width)
+
+	_, err = NewThumbnailFetcher(&http.Client{Transport: bodyTransport{status: http.StatusNotFound}}).
+		FetchThumbnail(ctx, "https://sessionize.com/image/missing.png")
+	require.Error(t, err)
+
+	_, err = NewThumbnailFetcher(&http.Client{Transport: bodyTransport{status: http.StatusOK, body: pic}}).
+		FetchThumbnail(ctx, "file:///etc/passwd")
+	require.Error(t, err)
+}
+
+func TestPictureFetcher_FetchPicture(t *testing.T) {
+	ctx := context.Background()
+	pic := encodePNG(t, 512, 512, color.NRGBA{R: 10, G: 20, B: 30, A: 255})
+
+	out, format, err := NewPictureFetcher(&http.Client{Transport: bodyTransport{status: http.StatusOK, body: pic}}).
+		FetchPicture(ctx, "https://sessionize.com/image/abc.png")
+	require.NoError(t, err)
+	assert.Equal(t, "png", format)
+	assert.Equal(t, pic, out, "the original is returned unchanged")
+
+	_, _, err = NewPictureFetcher(&http.Client{Transport: bodyTransport{status: http.StatusOK, body: []byte("<html>not found</html>")}}).
+		FetchPicture(ctx, "https://sessionize.com/image/abc.png")
+	require.Error(t, err)
+}
+
+func TestThumbnailFetcher_BlocksPrivateAddresses(t *testing.T) {
+	// The default client refuses to connect before any request reaches the address.
+	_, err := NewThumbnailFetcher(nil).FetchThumbnail(context.Background(), "http://127.0.0.1:1/photo.png")
+	require.True(t, errors.Is(err, ErrBlockedAddress), "err = %v", err)
+}
+
+func TestIsPublic(t *testing.T) {
+	tests := map[string]bool{
+		"203.0.113.7":     true,
+		"2001:db8::1":     true,
+		"127.0.0.1":       false,
+		"10.1.2.3":        false,
+		"192.168.0.10":    false,
+		"169.254.169.254": false,
+		"100.64.0.1":      false,
+		"::1":             false,
+		"fd00::1":         false,
+		"::ffff:10.0.0.1": false,
+		"0.0.0.0":         false,
+		"224.0.0.1":       false,
+	}
+	for in, want := range tests {
+		assert.Equal(t, want, isPublic(netip.MustParseAddr(in)), in)
+	}
+}
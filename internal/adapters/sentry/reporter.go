@@ -0,0 +1,122 @@
+// Package sentry reports recovered panics to Sentry through its envelope API, without the SDK.
+package sentry
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+// clientName identifies this reporter in the X-Sentry-Auth header.
+const clientName = "m3t-backend/1.0"
+
+type reporter struct {
+	dsn         string
+	envelopeURL string
+	publicKey   string
+	environment string
+	client      *http.Client
+}
+
+// NewReporter returns a reporter that sends each panic as a Sentry event to the project of dsn
+// (https://<public key>@<host>/<project id>), tagged with environment and the request's ID and
+// route. With a nil client it uses one that gives up after 10 seconds. It fails when dsn cannot be
+// parsed.
+func NewReporter(dsn, environment string, client *http.Client) (domain.ErrorReporter, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("parse sentry dsn: %w", err)
+	}
+	projectID := strings.Trim(u.Path, "/")
+	if u.Scheme == "" || u.Host == "" || u.User == nil || u.User.Username() == "" || projectID == "" {
+		return nil, fmt.Errorf("parse sentry dsn: want https://<public key>@<host>/<project id>")
+	}
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &reporter{
+		dsn:         dsn,
+		envelopeURL: fmt.Sprintf("%s://%s/api/%s/envelope/", u.Scheme, u.Host, projectID),
+		publicKey:   u.User.Username(),
+		environment: environment,
+		client:      client,
+	}, nil
+}
+
+// event is the part of Sentry's event payload a panic fills in.
+type event struct {
+	EventID     string            `json:"event_id"`
+	Timestamp   string            `json:"timestamp"`
+	Level       string            `json:"level"`
+	Platform    string            `json:"platform"`
+	Environment string            `json:"environment,omitempty"`
+	Transaction string            `json:"transaction,omitempty"`
+	Message     string            `json:"message"`
+	Tags        map[string]string `json:"tags"`
+	Request     struct {
+		Method string `json:"method"`
+		URL    string `json:"url"`
+	} `json:"request"`
+	Extra map[string]string `json:"extra"`
+}
+
+func (r *reporter) ReportPanic(ctx context.Context, report *domain.PanicReport) error {
+	ev := event{
+		EventID:     newEventID(),
+		Timestamp:   report.At.UTC().Format(time.RFC3339Nano),
+		Level:       "fatal",
+		Platform:    "go",
+		Environment: r.environment,
+		Transaction: report.Route,
+		Message:     "panic: " + report.Value,
+		Tags:        map[string]string{"request_id": report.RequestID, "route": report.Route},
+		Extra:       map[string]string{"stack": report.Stack},
+	}
+	ev.Request.Method = report.Method
+	ev.Request.URL = report.Path
+
+	var body bytes.Buffer
+	enc := json.NewEncoder(&body)
+	for _, part := range []any{
+		map[string]string{"event_id": ev.EventID, "dsn": r.dsn},
+		map[string]string{"type": "event"},
+		ev,
+	} {
+		if err := enc.Encode(part); err != nil {
+			return fmt.Errorf("encode sentry envelope: %w", err)
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.envelopeURL, &body)
+	if err != nil {
+		return fmt.Errorf("build sentry request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-sentry-envelope")
+	req.Header.Set("X-Sentry-Auth", fmt.Sprintf("Sentry sentry_version=7, sentry_client=%s, sentry_key=%s", clientName, r.publicKey))
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("report panic %s: %w", report.RequestID, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("report panic %s: unexpected status %d", report.RequestID, resp.StatusCode)
+	}
+	return nil
+}
+
+// newEventID returns a random 32 hex digit ID, the form Sentry expects.
+func newEventID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
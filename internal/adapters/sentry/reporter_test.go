@@ -0,0 +1,70 @@
+package sentry
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReporter_ReportPanic(t *testing.T) {
+	var got *http.Request
+	var lines []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		sc := bufio.NewScanner(r.Body)
+		for sc.Scan() {
+			lines = append(lines, sc.Text())
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	dsn := strings.Replace(srv.URL, "://", "://pubkey@", 1) + "/42"
+	rep, err := NewReporter(dsn, "production", srv.Client())
+	require.NoError(t, err)
+	err = rep.ReportPanic(context.Background(), &domain.PanicReport{
+		RequestID: "req-1", Method: http.MethodGet, Path: "/events/e1", Route: "GET /events/{eventID}",
+		Value: "boom", Stack: "goroutine 1", At: time.Now(),
+	})
+	require.NoError(t, err)
+
+	require.NotNil(t, got)
+	assert.Equal(t, "/api/42/envelope/", got.URL.Path)
+	assert.Contains(t, got.Header.Get("X-Sentry-Auth"), "sentry_key=pubkey")
+	require.Len(t, lines, 3)
+	var ev event
+	require.NoError(t, json.Unmarshal([]byte(lines[2]), &ev))
+	assert.Equal(t, "panic: boom", ev.Message)
+	assert.Equal(t, "production", ev.Environment)
+	assert.Equal(t, "req-1", ev.Tags["request_id"])
+	assert.Equal(t, "GET /events/{eventID}", ev.Transaction)
+	assert.Equal(t, "goroutine 1", ev.Extra["stack"])
+	assert.Len(t, ev.EventID, 32)
+}
+
+func TestReporter_Errors(t *testing.T) {
+	for _, dsn := range []string{"", "https://sentry.example.com/42", "https://key@sentry.example.com/", "://"} {
+		_, err := NewReporter(dsn, "", nil)
+		assert.Error(t, err, dsn)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+	rep, err := NewReporter(strings.Replace(srv.URL, "://", "://pubkey@", 1)+"/42", "", srv.Client())
+	require.NoError(t, err)
+	err = rep.ReportPanic(context.Background(), &domain.PanicReport{RequestID: "req-1", At: time.Now()})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "429")
+}
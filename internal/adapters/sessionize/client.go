@@ -5,41 +5,121 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sync"
 
 	"multitrackticketing/internal/domain"
 )
 
+// maxCachedResponses bounds how many Sessionize payloads the fetcher keeps; the least recently
+// used one is evicted first.
+const maxCachedResponses = 32
+
+// StatusError is returned by the HTTP fetcher when Sessionize answers with a non-200 status.
+type StatusError struct {
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("sessionize api returned status: %d", e.StatusCode)
+}
+
+// cachedResponse is the last payload fetched for one sessionizeID with its validators.
+type cachedResponse struct {
+	etag         string
+	lastModified string
+	data         domain.SessionFetcherResponse
+	lastUse      uint64 // value of useCount when the entry was last read or written
+}
+
 type sessionizeHTTPFetcher struct {
-	client *http.Client
+	client  *http.Client
+	baseURL string
+
+	mu       sync.Mutex
+	cache    map[string]*cachedResponse
+	useCount uint64
 }
 
-// NewHTTPFetcher returns a fetcher that calls the Sessionize API.
+// NewHTTPFetcher returns a fetcher that calls the Sessionize API. It keeps the last response per
+// sessionizeID and revalidates it with If-None-Match / If-Modified-Since, so an unchanged
+// schedule costs a 304 instead of a full download.
 func NewHTTPFetcher(client *http.Client) domain.SessionFetcher {
 	if client == nil {
 		client = http.DefaultClient
 	}
-	return &sessionizeHTTPFetcher{client: client}
+	return &sessionizeHTTPFetcher{
+		client:  client,
+		baseURL: "https://sessionize.com/api/v2",
+		cache:   make(map[string]*cachedResponse),
+	}
 }
 
-func (f *sessionizeHTTPFetcher) Fetch(ctx context.Context, sessionizeID string) (domain.SessionFetcherResponse, error) {
-	url := fmt.Sprintf("https://sessionize.com/api/v2/%s/view/All", sessionizeID)
+func (f *sessionizeHTTPFetcher) Fetch(ctx context.Context, sessionizeID string, forceRefresh bool) (domain.SessionFetcherResponse, error) {
+	url := fmt.Sprintf("%s/%s/view/All", f.baseURL, sessionizeID)
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return domain.SessionFetcherResponse{}, fmt.Errorf("failed to create request: %w", err)
 	}
+	cached := f.cached(sessionizeID)
+	if cached != nil && !forceRefresh {
+		if cached.etag != "" {
+			req.Header.Set("If-None-Match", cached.etag)
+		}
+		if cached.lastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.lastModified)
+		}
+	}
 	resp, err := f.client.Do(req)
 	if err != nil {
 		return domain.SessionFetcherResponse{}, fmt.Errorf("failed to fetch from sessionize: %w", err)
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotModified && cached != nil && !forceRefresh {
+		return cached.data, nil
+	}
 	if resp.StatusCode != http.StatusOK {
-		return domain.SessionFetcherResponse{}, fmt.Errorf("sessionize api returned status: %d", resp.StatusCode)
+		return domain.SessionFetcherResponse{}, &StatusError{StatusCode: resp.StatusCode}
 	}
 
 	var data domain.SessionFetcherResponse
 	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
 		return domain.SessionFetcherResponse{}, fmt.Errorf("failed to decode sessionize response: %w", err)
 	}
+	f.store(sessionizeID, resp.Header.Get("ETag"), resp.Header.Get("Last-Modified"), data)
 	return data, nil
 }
+
+// cached returns the cache entry for sessionizeID and marks it used, or nil.
+func (f *sessionizeHTTPFetcher) cached(sessionizeID string) *cachedResponse {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	entry := f.cache[sessionizeID]
+	if entry != nil {
+		f.useCount++
+		entry.lastUse = f.useCount
+	}
+	return entry
+}
+
+// store caches data when the response carried a validator to revalidate it with later.
+func (f *sessionizeHTTPFetcher) store(sessionizeID, etag, lastModified string, data domain.SessionFetcherResponse) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if etag == "" && lastModified == "" {
+		delete(f.cache, sessionizeID)
+		return
+	}
+	if _, ok := f.cache[sessionizeID]; !ok && len(f.cache) >= maxCachedResponses {
+		var oldestID string
+		var oldest uint64
+		for id, entry := range f.cache {
+			if oldestID == "" || entry.lastUse < oldest {
+				oldestID, oldest = id, entry.lastUse
+			}
+		}
+		delete(f.cache, oldestID)
+	}
+	f.useCount++
+	f.cache[sessionizeID] = &cachedResponse{etag: etag, lastModified: lastModified, data: data, lastUse: f.useCount}
+}
@@ -0,0 +1,140 @@
+package sessionize
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bodyTransport answers every request with status and body, without touching the network.
+type bodyTransport struct {
+	status int
+	body   string
+}
+
+func (b bodyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: b.status,
+		Body:       io.NopCloser(strings.NewReader(b.body)),
+		Header:     make(http.Header),
+		Request:    req,
+	}, nil
+}
+
+// FuzzHTTPFetcher_Fetch feeds arbitrary Sessionize All API bodies to the fetcher. It must never
+// panic, and must return the zero response together with any error.
+func FuzzHTTPFetcher_Fetch(f *testing.F) {
+	seeds := []string{
+		``,
+		`{}`,
+		`null`,
+		`{"sessions":null,"speakers":[],"rooms":[{}],"categories":[{"items":null}]}`,
+		`{"sessions":[{"id":"1","title":"Talk","startsAt":"2026-01-01T10:00:00","roomId":1,"categoryItems":[1,1,-1],"speakers":["a"]}],"rooms":[{"id":1,"name":"A"}]}`,
+		`{"sessions":[{"startsAt":"2026-01-01T10:00:00Z","endsAt":"not a time"}]}`,
+		`{"rooms":[{"id":99999999999999999999}]}`,
+		`{"speakers":[{"id":"\ud800","firstName":"` + strings.Repeat("é", 500) + `"}]}`,
+		"{\"speakers\":[{\"firstName\":\"\xff\"}]}",
+		`[1,2,3]`,
+	}
+	for _, s := range seeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, body string) {
+		fetcher := NewHTTPFetcher(&http.Client{Transport: bodyTransport{status: http.StatusOK, body: body}})
+		data, err := fetcher.Fetch(context.Background(), "abc123", false)
+		if err != nil {
+			assert.Equal(t, domain.SessionFetcherResponse{}, data)
+		}
+	})
+}
+
+func TestHTTPFetcher_Fetch_NonOKStatus(t *testing.T) {
+	fetcher := NewHTTPFetcher(&http.Client{Transport: bodyTransport{status: http.StatusNotFound, body: `{}`}})
+	_, err := fetcher.Fetch(context.Background(), "missing", false)
+	assert.ErrorContains(t, err, "status: 404")
+}
+
+// etagServer serves a Sessionize All payload with an ETag and answers 304 to a matching
+// If-None-Match. It counts full downloads.
+type etagServer struct {
+	etag      string
+	body      string
+	downloads int
+	requests  int
+}
+
+func (s *etagServer) RoundTrip(req *http.Request) (*http.Response, error) {
+	s.requests++
+	header := make(http.Header)
+	header.Set("ETag", s.etag)
+	if req.Header.Get("If-None-Match") == s.etag {
+		return &http.Response{StatusCode: http.StatusNotModified, Body: http.NoBody, Header: header, Request: req}, nil
+	}
+	s.downloads++
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(s.body)), Header: header, Request: req}, nil
+}
+
+func TestHTTPFetcher_Fetch_ConditionalCache(t *testing.T) {
+	srv := &etagServer{etag: `"v1"`, body: `{"rooms":[{"id":1,"name":"Main"}]}`}
+	fetcher := NewHTTPFetcher(&http.Client{Transport: srv})
+	ctx := context.Background()
+
+	first, err := fetcher.Fetch(ctx, "abc123", false)
+	require.NoError(t, err)
+	second, err := fetcher.Fetch(ctx, "abc123", false)
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+	assert.Equal(t, 2, srv.requests)
+	assert.Equal(t, 1, srv.downloads, "unchanged schedule is served from cache after a 304")
+
+	_, err = fetcher.Fetch(ctx, "abc123", true)
+	require.NoError(t, err)
+	assert.Equal(t, 2, srv.downloads, "force refresh skips the validators")
+
+	srv.etag, srv.body = `"v2"`, `{"rooms":[{"id":1,"name":"Main"},{"id":2,"name":"Side"}]}`
+	changed, err := fetcher.Fetch(ctx, "abc123", false)
+	require.NoError(t, err)
+	assert.Len(t, changed.Rooms, 2)
+	assert.Equal(t, 3, srv.downloads)
+
+	_, err = fetcher.Fetch(ctx, "other", false)
+	require.NoError(t, err)
+	assert.Equal(t, 4, srv.downloads, "cache is per sessionizeID")
+}
+
+func TestHTTPFetcher_Fetch_NoValidatorsNotCached(t *testing.T) {
+	fetcher := NewHTTPFetcher(&http.Client{Transport: bodyTransport{status: http.StatusOK, body: `{}`}}).(*sessionizeHTTPFetcher)
+
+	_, err := fetcher.Fetch(context.Background(), "abc123", false)
+
+	require.NoError(t, err)
+	assert.Empty(t, fetcher.cache)
+}
+
+func TestHTTPFetcher_Fetch_CacheEvictsLeastRecentlyUsed(t *testing.T) {
+	fetcher := NewHTTPFetcher(&http.Client{Transport: &etagServer{etag: `"v1"`, body: `{}`}}).(*sessionizeHTTPFetcher)
+	ctx := context.Background()
+
+	for i := 0; i <= maxCachedResponses; i++ {
+		_, err := fetcher.Fetch(ctx, fmt.Sprintf("event-%d", i), false)
+		require.NoError(t, err)
+		if i == 0 {
+			continue
+		}
+		// Keep event-0 in use so event-1 is the least recently used entry.
+		_, err = fetcher.Fetch(ctx, "event-0", false)
+		require.NoError(t, err)
+	}
+
+	assert.Len(t, fetcher.cache, maxCachedResponses)
+	assert.Contains(t, fetcher.cache, "event-0")
+	assert.NotContains(t, fetcher.cache, "event-1")
+}
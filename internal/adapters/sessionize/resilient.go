@@ -0,0 +1,215 @@
+package sessionize
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+// ResilienceConfig tunes NewResilientFetcher. Zero fields take the defaults in parentheses.
+// The defaults keep a full retry cycle inside the event service's 10s import timeout.
+type ResilienceConfig struct {
+	MaxAttempts      int           // tries per Fetch, including the first (3)
+	AttemptTimeout   time.Duration // limit for a single try (3s)
+	Budget           time.Duration // limit for all tries and backoff together (7s)
+	BaseBackoff      time.Duration // delay before the first retry, doubled for each later one (250ms)
+	FailureThreshold int           // consecutive failed fetches that open the circuit (5)
+	OpenDuration     time.Duration // how long the circuit stays open before a trial fetch (30s)
+}
+
+func (c ResilienceConfig) withDefaults() ResilienceConfig {
+	if c.MaxAttempts <= 0 {
+		c.MaxAttempts = 3
+	}
+	if c.AttemptTimeout <= 0 {
+		c.AttemptTimeout = 3 * time.Second
+	}
+	if c.Budget <= 0 {
+		c.Budget = 7 * time.Second
+	}
+	if c.BaseBackoff <= 0 {
+		c.BaseBackoff = 250 * time.Millisecond
+	}
+	if c.FailureThreshold <= 0 {
+		c.FailureThreshold = 5
+	}
+	if c.OpenDuration <= 0 {
+		c.OpenDuration = 30 * time.Second
+	}
+	return c
+}
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+// ResilientFetcher wraps a SessionFetcher with retries, timeout budgets, and a circuit breaker.
+// Outages surface as domain.ErrProviderUnavailable instead of raw transport errors, and the
+// breaker state is reported through CheckReadiness.
+type ResilientFetcher struct {
+	next  domain.SessionFetcher
+	cfg   ResilienceConfig
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+
+	mu            sync.Mutex
+	state         circuitState
+	failures      int // consecutive failed fetches
+	openUntil     time.Time
+	trialInFlight bool
+	lastErr       error
+}
+
+// NewResilientFetcher returns next wrapped with the retry and circuit-breaker policy in cfg.
+func NewResilientFetcher(next domain.SessionFetcher, cfg ResilienceConfig) *ResilientFetcher {
+	return &ResilientFetcher{
+		next:  next,
+		cfg:   cfg.withDefaults(),
+		now:   time.Now,
+		sleep: sleepContext,
+	}
+}
+
+// Fetch calls the wrapped fetcher, retrying transient failures with exponential backoff. Errors
+// Sessionize answers deliberately (e.g. 404 for an unknown ID) are returned as-is without retrying.
+func (f *ResilientFetcher) Fetch(ctx context.Context, sessionizeID string, forceRefresh bool) (domain.SessionFetcherResponse, error) {
+	if err := f.allow(); err != nil {
+		return domain.SessionFetcherResponse{}, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, f.cfg.Budget)
+	defer cancel()
+
+	var lastErr error
+	for attempt := 1; attempt <= f.cfg.MaxAttempts; attempt++ {
+		attemptCtx, attemptCancel := context.WithTimeout(ctx, f.cfg.AttemptTimeout)
+		data, err := f.next.Fetch(attemptCtx, sessionizeID, forceRefresh)
+		attemptCancel()
+		if err == nil {
+			f.record(nil)
+			return data, nil
+		}
+		if !retryable(err) {
+			// The provider answered, so it is healthy even though this request failed.
+			f.record(nil)
+			return domain.SessionFetcherResponse{}, err
+		}
+		lastErr = err
+		if attempt == f.cfg.MaxAttempts {
+			break
+		}
+		if err := f.sleep(ctx, f.backoff(attempt)); err != nil {
+			break
+		}
+	}
+	if errors.Is(ctx.Err(), context.Canceled) {
+		// The caller went away; that says nothing about the provider.
+		f.release()
+		return domain.SessionFetcherResponse{}, ctx.Err()
+	}
+	f.record(lastErr)
+	return domain.SessionFetcherResponse{}, fmt.Errorf("%w: %v", domain.ErrProviderUnavailable, lastErr)
+}
+
+// CheckReadiness implements domain.ReadinessChecker. Sessionize is not critical: while it is
+// down only schedule imports fail.
+func (f *ResilientFetcher) CheckReadiness(ctx context.Context) domain.DependencyHealth {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	h := domain.DependencyHealth{Name: "sessionize", Status: domain.DependencyUp}
+	switch {
+	case f.state == circuitOpen && f.now().Before(f.openUntil):
+		h.Status = domain.DependencyDown
+		h.Detail = fmt.Sprintf("circuit open until %s after %d consecutive failures: %v", f.openUntil.UTC().Format(time.RFC3339), f.failures, f.lastErr)
+	case f.state != circuitClosed || f.failures > 0:
+		h.Status = domain.DependencyDegraded
+		h.Detail = fmt.Sprintf("%d consecutive failures: %v", f.failures, f.lastErr)
+	}
+	return h
+}
+
+// allow reports whether a fetch may start: always while closed, never while open, and for a
+// single trial fetch once the open period has passed.
+func (f *ResilientFetcher) allow() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch f.state {
+	case circuitOpen:
+		if f.now().Before(f.openUntil) {
+			return domain.ErrProviderUnavailable
+		}
+		f.state = circuitHalfOpen
+		f.trialInFlight = true
+	case circuitHalfOpen:
+		if f.trialInFlight {
+			return domain.ErrProviderUnavailable
+		}
+		f.trialInFlight = true
+	}
+	return nil
+}
+
+// record updates the breaker with the outcome of a fetch; err is nil when the provider responded.
+func (f *ResilientFetcher) record(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.trialInFlight = false
+	if err == nil {
+		f.state = circuitClosed
+		f.failures = 0
+		f.lastErr = nil
+		return
+	}
+	f.failures++
+	f.lastErr = err
+	if f.state == circuitHalfOpen || f.failures >= f.cfg.FailureThreshold {
+		f.state = circuitOpen
+		f.openUntil = f.now().Add(f.cfg.OpenDuration)
+	}
+}
+
+// release ends a fetch without recording an outcome, so a half-open trial can be retried.
+func (f *ResilientFetcher) release() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.trialInFlight = false
+}
+
+// backoff returns the delay before retry number attempt: BaseBackoff doubled per attempt,
+// with up to half of it randomized so concurrent imports do not retry in lockstep.
+func (f *ResilientFetcher) backoff(attempt int) time.Duration {
+	d := f.cfg.BaseBackoff << (attempt - 1)
+	return d/2 + rand.N(d/2+1)
+}
+
+// retryable reports whether err is a transient provider failure: a transport error (including a
+// per-attempt timeout) or a 408, 429, or 5xx status.
+func retryable(err error) bool {
+	var se *StatusError
+	if errors.As(err, &se) {
+		return se.StatusCode == http.StatusRequestTimeout || se.StatusCode == http.StatusTooManyRequests || se.StatusCode >= 500
+	}
+	var ue *url.Error
+	return errors.As(err, &ue)
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
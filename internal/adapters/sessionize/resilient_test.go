@@ -0,0 +1,170 @@
+package sessionize
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scriptedFetcher returns errs in order, then succeeds.
+type scriptedFetcher struct {
+	errs  []error
+	calls int
+}
+
+func (s *scriptedFetcher) Fetch(ctx context.Context, sessionizeID string, forceRefresh bool) (domain.SessionFetcherResponse, error) {
+	s.calls++
+	if len(s.errs) > 0 {
+		err := s.errs[0]
+		s.errs = s.errs[1:]
+		return domain.SessionFetcherResponse{}, err
+	}
+	return domain.SessionFetcherResponse{Rooms: []domain.SessionFetcherRoom{{ID: 1, Name: "Main"}}}, nil
+}
+
+var (
+	errRefused     = &url.Error{Op: "Get", URL: "https://sessionize.com", Err: errors.New("connection refused")}
+	errUnavailable = &StatusError{StatusCode: 503}
+	errNotFound    = &StatusError{StatusCode: 404}
+)
+
+// newTestFetcher returns a ResilientFetcher with no real sleeping and a clock the test controls.
+func newTestFetcher(next domain.SessionFetcher, cfg ResilienceConfig) (*ResilientFetcher, *time.Time, *[]time.Duration) {
+	f := NewResilientFetcher(next, cfg)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var sleeps []time.Duration
+	f.now = func() time.Time { return now }
+	f.sleep = func(ctx context.Context, d time.Duration) error {
+		sleeps = append(sleeps, d)
+		return nil
+	}
+	return f, &now, &sleeps
+}
+
+func TestResilientFetcher_Fetch_Retries(t *testing.T) {
+	tests := []struct {
+		name       string
+		errs       []error
+		wantCalls  int
+		wantSleeps int
+		wantErr    error
+	}{
+		{name: "success first try", wantCalls: 1},
+		{name: "transient errors then success", errs: []error{errRefused, errUnavailable}, wantCalls: 3, wantSleeps: 2},
+		{name: "429 is retried", errs: []error{&StatusError{StatusCode: 429}}, wantCalls: 2, wantSleeps: 1},
+		{name: "404 is not retried", errs: []error{errNotFound}, wantCalls: 1, wantErr: errNotFound},
+		{name: "retries exhausted", errs: []error{errRefused, errRefused, errUnavailable}, wantCalls: 3, wantSleeps: 2, wantErr: domain.ErrProviderUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := &scriptedFetcher{errs: tt.errs}
+			f, _, sleeps := newTestFetcher(next, ResilienceConfig{BaseBackoff: 100 * time.Millisecond})
+
+			data, err := f.Fetch(context.Background(), "abc", false)
+
+			assert.Equal(t, tt.wantCalls, next.calls)
+			require.Len(t, *sleeps, tt.wantSleeps)
+			for i, d := range *sleeps {
+				base := 100 * time.Millisecond << i
+				assert.GreaterOrEqual(t, d, base/2)
+				assert.LessOrEqual(t, d, base)
+			}
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, data.Rooms, 1)
+		})
+	}
+}
+
+func TestResilientFetcher_CircuitBreaker(t *testing.T) {
+	next := &scriptedFetcher{}
+	f, now, _ := newTestFetcher(next, ResilienceConfig{MaxAttempts: 1, FailureThreshold: 2, OpenDuration: time.Minute})
+	ctx := context.Background()
+
+	next.errs = []error{errUnavailable, errUnavailable}
+	_, err := f.Fetch(ctx, "abc", false)
+	require.ErrorIs(t, err, domain.ErrProviderUnavailable)
+	assert.Equal(t, domain.DependencyDegraded, f.CheckReadiness(ctx).Status)
+	_, err = f.Fetch(ctx, "abc", false)
+	require.ErrorIs(t, err, domain.ErrProviderUnavailable)
+
+	// Open: calls fail fast without reaching Sessionize.
+	health := f.CheckReadiness(ctx)
+	assert.Equal(t, domain.DependencyDown, health.Status)
+	assert.False(t, health.Critical)
+	assert.Contains(t, health.Detail, "circuit open")
+	_, err = f.Fetch(ctx, "abc", false)
+	require.ErrorIs(t, err, domain.ErrProviderUnavailable)
+	assert.Equal(t, 2, next.calls)
+
+	// Half-open: a failed trial re-opens the circuit at once.
+	*now = now.Add(time.Minute)
+	next.errs = []error{errRefused}
+	_, err = f.Fetch(ctx, "abc", false)
+	require.ErrorIs(t, err, domain.ErrProviderUnavailable)
+	assert.Equal(t, 3, next.calls)
+	assert.Equal(t, domain.DependencyDown, f.CheckReadiness(ctx).Status)
+
+	// A successful trial closes it again.
+	*now = now.Add(time.Minute)
+	_, err = f.Fetch(ctx, "abc", false)
+	require.NoError(t, err)
+	assert.Equal(t, domain.DependencyUp, f.CheckReadiness(ctx).Status)
+}
+
+func TestResilientFetcher_NotFoundKeepsCircuitClosed(t *testing.T) {
+	next := &scriptedFetcher{errs: []error{errNotFound, errNotFound, errNotFound}}
+	f, _, _ := newTestFetcher(next, ResilienceConfig{FailureThreshold: 1})
+
+	for i := 0; i < 3; i++ {
+		_, err := f.Fetch(context.Background(), "missing", false)
+		require.ErrorIs(t, err, errNotFound)
+	}
+	assert.Equal(t, domain.DependencyUp, f.CheckReadiness(context.Background()).Status)
+}
+
+func TestResilientFetcher_CallerCancelDoesNotCount(t *testing.T) {
+	next := &scriptedFetcher{errs: []error{errRefused}}
+	f, _, _ := newTestFetcher(next, ResilienceConfig{FailureThreshold: 1})
+	ctx, cancel := context.WithCancel(context.Background())
+	f.sleep = func(context.Context, time.Duration) error {
+		cancel()
+		return context.Canceled
+	}
+
+	_, err := f.Fetch(ctx, "abc", false)
+
+	require.ErrorIs(t, err, context.Canceled)
+	assert.NotErrorIs(t, err, domain.ErrProviderUnavailable)
+	assert.Equal(t, domain.DependencyUp, f.CheckReadiness(context.Background()).Status)
+}
+
+func TestResilientFetcher_AttemptTimeout(t *testing.T) {
+	slow := fetcherFunc(func(ctx context.Context) error {
+		<-ctx.Done()
+		return &url.Error{Op: "Get", URL: "https://sessionize.com", Err: ctx.Err()}
+	})
+	f, _, _ := newTestFetcher(slow, ResilienceConfig{MaxAttempts: 2, AttemptTimeout: 10 * time.Millisecond, Budget: time.Second})
+
+	start := time.Now()
+	_, err := f.Fetch(context.Background(), "abc", false)
+
+	require.ErrorIs(t, err, domain.ErrProviderUnavailable)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+}
+
+type fetcherFunc func(ctx context.Context) error
+
+func (fn fetcherFunc) Fetch(ctx context.Context, sessionizeID string, forceRefresh bool) (domain.SessionFetcherResponse, error) {
+	return domain.SessionFetcherResponse{}, fn(ctx)
+}
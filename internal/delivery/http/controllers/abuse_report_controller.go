@@ -0,0 +1,286 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+// AbuseReportController serves public abuse reports and the admins' moderation queue.
+type AbuseReportController struct {
+	Logger  *slog.Logger
+	Service domain.AbuseReportService
+}
+
+func NewAbuseReportController(logger *slog.Logger, svc domain.AbuseReportService) *AbuseReportController {
+	return &AbuseReportController{
+		Logger:  logger,
+		Service: svc,
+	}
+}
+
+// ReportAbuseRequest is the request body for POST /public/reports.
+type ReportAbuseRequest struct {
+	EventCode string `json:"event_code"`
+	// TargetType is one of event, session and speaker.
+	TargetType string `json:"target_type"`
+	// TargetID is the session or speaker ID; it may be left out for the event itself.
+	TargetID string `json:"target_id"`
+	// Reason is one of spam, offensive, impersonation, illegal and other.
+	Reason  string `json:"reason"`
+	Details string `json:"details"`
+}
+
+// Validate implements Validator.
+func (c ReportAbuseRequest) Validate() []string {
+	var errs []string
+	if !eventCodeRegex.MatchString(strings.ToLower(strings.TrimSpace(c.EventCode))) {
+		errs = append(errs, "event_code must be 4 to 16 letters or digits")
+	}
+	if strings.TrimSpace(c.TargetType) == "" {
+		errs = append(errs, "target_type is required")
+	}
+	if c.TargetID != "" && !uuidRegex.MatchString(c.TargetID) {
+		errs = append(errs, "target_id must be a UUID")
+	} else if c.TargetID == "" && c.TargetType != domain.AbuseTargetEvent {
+		errs = append(errs, "target_id is required")
+	}
+	if strings.TrimSpace(c.Reason) == "" {
+		errs = append(errs, "reason is required")
+	}
+	return errs
+}
+
+// ReportAbuseResponse is the data payload for POST /public/reports (202).
+type ReportAbuseResponse struct {
+	Status string `json:"status"`
+}
+
+// ReportAbuseSuccessResponse is the success response envelope for POST /public/reports (202).
+type ReportAbuseSuccessResponse struct {
+	Data  ReportAbuseResponse `json:"data"`
+	Error *helpers.APIError   `json:"error"`
+}
+
+// ModerationQueueResponse is the data payload for GET /admin/moderation-queue (200).
+type ModerationQueueResponse struct {
+	Items      []*domain.ModerationItem `json:"items"`
+	Pagination helpers.PaginationMeta   `json:"pagination"`
+}
+
+// ModerationQueueSuccessResponse is the success response envelope for GET /admin/moderation-queue (200).
+type ModerationQueueSuccessResponse struct {
+	Data  ModerationQueueResponse `json:"data"`
+	Error *helpers.APIError       `json:"error"`
+}
+
+// ModerationItemSuccessResponse is the success response envelope for GET /admin/moderation-queue/{targetType}/{targetID} (200).
+type ModerationItemSuccessResponse struct {
+	Data  domain.ModerationItem `json:"data"`
+	Error *helpers.APIError     `json:"error"`
+}
+
+// ResolveModerationItemRequest is the request body for POST /admin/moderation-queue/{targetType}/{targetID}/resolve.
+type ResolveModerationItemRequest struct {
+	// Action is dismiss (list the content again) or unlist (keep it unlisted until relisted).
+	Action string `json:"action"`
+}
+
+// Validate implements Validator.
+func (c ResolveModerationItemRequest) Validate() []string {
+	if c.Action != domain.ModerationDismiss && c.Action != domain.ModerationUnlist {
+		return []string{"action must be dismiss or unlist"}
+	}
+	return nil
+}
+
+// ResolveModerationItemResponse is the data payload for POST /admin/moderation-queue/{targetType}/{targetID}/resolve (200).
+type ResolveModerationItemResponse struct {
+	Status string `json:"status"`
+}
+
+// ResolveModerationItemSuccessResponse is the success response envelope for POST /admin/moderation-queue/{targetType}/{targetID}/resolve (200).
+type ResolveModerationItemSuccessResponse struct {
+	Data  ResolveModerationItemResponse `json:"data"`
+	Error *helpers.APIError             `json:"error"`
+}
+
+// ReportAbuse godoc
+// @Summary Report public content
+// @ID ReportAbuse
+// @Description Flags an event, or one of its sessions or speakers, for review by the platform admins. Reports are anonymous; each address counts once per target. Once enough different addresses have reported a target it is unlisted from the public offline bundle and theme until an admin reviews it or the unlisting ends. Requests may be screened for bots (see the README): a filled-in honeypot field gets bot_detected, and where a captcha is required its token goes in captcha_token or the X-Captcha-Token header. Does not require authentication.
+// @Tags attendee
+// @Accept json
+// @Produce json
+// @Param body body controllers.ReportAbuseRequest true "Report"
+// @Success 202 {object} controllers.ReportAbuseSuccessResponse "data.status is received"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request, bot_detected or captcha_failed"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found (unknown event code, or target not the event's)"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /public/reports [post]
+func (c *AbuseReportController) ReportAbuse(w http.ResponseWriter, r *http.Request) {
+	var req ReportAbuseRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	outcome, err := c.Service.ReportAbuse(r.Context(), &domain.AbuseReportRequest{
+		EventCode:  req.EventCode,
+		TargetType: req.TargetType,
+		TargetID:   req.TargetID,
+		Reason:     req.Reason,
+		Details:    req.Details,
+		RemoteIP:   helpers.ClientIP(r),
+	})
+	if err != nil {
+		c.writeAbuseReportError(w, r, err)
+		return
+	}
+	if outcome.Unlisted {
+		c.Logger.InfoContext(r.Context(), "content unlisted after abuse reports",
+			"target_type", outcome.Target.Type, "target_id", outcome.Target.ID, "reporters", outcome.Reporters)
+	}
+	helpers.WriteJSONSuccess(w, http.StatusAccepted, ReportAbuseResponse{Status: "received"})
+}
+
+// ListModerationQueue godoc
+// @Summary List the moderation queue
+// @ID ListModerationQueue
+// @Description Returns a paginated list of the content with open abuse reports, most reported first, without the reports themselves. unlisted tells whether the content is hidden from public pages now. Only platform admins can list. Requires authentication.
+// @Tags moderation
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Page size (default 20, max 100)"
+// @Success 200 {object} controllers.ModerationQueueSuccessResponse "data contains items and pagination"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not admin)"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /admin/moderation-queue [get]
+func (c *AbuseReportController) ListModerationQueue(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	params := helpers.ParsePagination(r)
+	items, total, err := c.Service.ListModerationQueue(r.Context(), adminID, params)
+	if err != nil {
+		c.writeAbuseReportError(w, r, err)
+		return
+	}
+	meta := helpers.NewPaginationMeta(params.Page, params.PageSize, total)
+	helpers.WriteJSONSuccess(w, http.StatusOK, ModerationQueueResponse{Items: items, Pagination: meta})
+}
+
+// GetModerationItem godoc
+// @Summary Get reported content
+// @ID GetModerationItem
+// @Description Returns the reported content with its open reports, oldest first. Only platform admins can read it. Requires authentication.
+// @Tags moderation
+// @Produce json
+// @Security BearerAuth
+// @Param targetType path string true "event, session or speaker"
+// @Param targetID path string true "Target ID (UUID)"
+// @Success 200 {object} controllers.ModerationItemSuccessResponse "data is the item with its reports"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not admin)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found (no open reports)"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /admin/moderation-queue/{targetType}/{targetID} [get]
+func (c *AbuseReportController) GetModerationItem(w http.ResponseWriter, r *http.Request) {
+	target, ok := moderationTarget(w, r)
+	if !ok {
+		return
+	}
+	adminID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	item, err := c.Service.GetModerationItem(r.Context(), adminID, target)
+	if err != nil {
+		c.writeAbuseReportError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, item)
+}
+
+// ResolveModerationItem godoc
+// @Summary Resolve reported content
+// @ID ResolveModerationItem
+// @Description Closes the content's open reports. dismiss lists the content again; unlist keeps it unlisted until a later dismissal. Only platform admins can resolve. Requires authentication.
+// @Tags moderation
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param targetType path string true "event, session or speaker"
+// @Param targetID path string true "Target ID (UUID)"
+// @Param body body controllers.ResolveModerationItemRequest true "Action"
+// @Success 200 {object} controllers.ResolveModerationItemSuccessResponse "data.status is resolved"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not admin)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found (no open reports)"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /admin/moderation-queue/{targetType}/{targetID}/resolve [post]
+func (c *AbuseReportController) ResolveModerationItem(w http.ResponseWriter, r *http.Request) {
+	target, ok := moderationTarget(w, r)
+	if !ok {
+		return
+	}
+	var req ResolveModerationItemRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	adminID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	if err := c.Service.ResolveModerationItem(r.Context(), adminID, target, req.Action); err != nil {
+		c.writeAbuseReportError(w, r, err)
+		return
+	}
+	c.Logger.InfoContext(r.Context(), "moderation item resolved", "audit", "moderation.resolve",
+		"user_id", adminID, "target_type", target.Type, "target_id", target.ID, "action", req.Action)
+	helpers.WriteJSONSuccess(w, http.StatusOK, ResolveModerationItemResponse{Status: "resolved"})
+}
+
+// moderationTarget reads the {targetType} and {targetID} path values, writing 400 when they are
+// invalid.
+func moderationTarget(w http.ResponseWriter, r *http.Request) (domain.AbuseTarget, bool) {
+	target := domain.AbuseTarget{Type: r.PathValue("targetType"), ID: r.PathValue("targetID")}
+	switch target.Type {
+	case domain.AbuseTargetEvent, domain.AbuseTargetSession, domain.AbuseTargetSpeaker:
+	default:
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "targetType must be event, session or speaker")
+		return target, false
+	}
+	if !uuidRegex.MatchString(target.ID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid targetID")
+		return target, false
+	}
+	return target, true
+}
+
+func (c *AbuseReportController) writeAbuseReportError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, domain.ErrNotFound) {
+		helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event or content not found")
+		return
+	}
+	if errors.Is(err, domain.ErrForbidden) {
+		helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+		return
+	}
+	if errors.Is(err, domain.ErrInvalidInput) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
+		return
+	}
+	helpers.WriteServiceError(w, r, c.Logger, err)
+}
@@ -0,0 +1,126 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+type mockAbuseReportService struct {
+	err        error
+	lastReq    *domain.AbuseReportRequest
+	lastTarget domain.AbuseTarget
+	lastAction string
+}
+
+func (m *mockAbuseReportService) ReportAbuse(ctx context.Context, req *domain.AbuseReportRequest) (*domain.AbuseReportOutcome, error) {
+	m.lastReq = req
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.AbuseReportOutcome{Target: domain.AbuseTarget{Type: req.TargetType, ID: req.TargetID}}, nil
+}
+
+func (m *mockAbuseReportService) ListModerationQueue(ctx context.Context, adminID string, params domain.PaginationParams) ([]*domain.ModerationItem, int, error) {
+	if m.err != nil {
+		return nil, 0, m.err
+	}
+	return []*domain.ModerationItem{}, 0, nil
+}
+
+func (m *mockAbuseReportService) GetModerationItem(ctx context.Context, adminID string, target domain.AbuseTarget) (*domain.ModerationItem, error) {
+	m.lastTarget = target
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.ModerationItem{TargetType: target.Type, TargetID: target.ID}, nil
+}
+
+func (m *mockAbuseReportService) ResolveModerationItem(ctx context.Context, adminID string, target domain.AbuseTarget, action string) error {
+	m.lastTarget, m.lastAction = target, action
+	return m.err
+}
+
+func TestAbuseReportController_ReportAbuse(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	const body = `{"event_code":"gc25","target_type":"session","target_id":"11111111-1111-1111-1111-111111111111","reason":"spam"}`
+
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantStatus int
+	}{
+		{name: "received", body: body, wantStatus: http.StatusAccepted},
+		{name: "event without target id", body: `{"event_code":"gc25","target_type":"event","reason":"spam"}`, wantStatus: http.StatusAccepted},
+		{name: "session without target id", body: `{"event_code":"gc25","target_type":"session","reason":"spam"}`, wantStatus: http.StatusBadRequest},
+		{name: "bad event code", body: `{"event_code":"gc-25","target_type":"event","reason":"spam"}`, wantStatus: http.StatusBadRequest},
+		{name: "bad target id", body: `{"event_code":"gc25","target_type":"speaker","target_id":"x","reason":"spam"}`, wantStatus: http.StatusBadRequest},
+		{name: "unknown reason", body: body, err: domain.ErrInvalidInput, wantStatus: http.StatusBadRequest},
+		{name: "unknown target", body: body, err: domain.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &mockAbuseReportService{err: tt.err}
+			ctrl := NewAbuseReportController(logger, svc)
+
+			req := httptest.NewRequest(http.MethodPost, "/public/reports", bytes.NewBufferString(tt.body))
+			req.RemoteAddr = "203.0.113.7:1234"
+			w := httptest.NewRecorder()
+			ctrl.ReportAbuse(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if tt.wantStatus == http.StatusAccepted && svc.lastReq.RemoteIP != "203.0.113.7" {
+				t.Fatalf("unexpected request: %+v", svc.lastReq)
+			}
+		})
+	}
+}
+
+func TestAbuseReportController_ResolveModerationItem(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	const id = "11111111-1111-1111-1111-111111111111"
+
+	tests := []struct {
+		name       string
+		path       string
+		body       string
+		err        error
+		wantStatus int
+	}{
+		{name: "dismissed", path: "/admin/moderation-queue/speaker/" + id + "/resolve", body: `{"action":"dismiss"}`, wantStatus: http.StatusOK},
+		{name: "unknown target type", path: "/admin/moderation-queue/room/" + id + "/resolve", body: `{"action":"dismiss"}`, wantStatus: http.StatusBadRequest},
+		{name: "unknown action", path: "/admin/moderation-queue/speaker/" + id + "/resolve", body: `{"action":"ban"}`, wantStatus: http.StatusBadRequest},
+		{name: "not admin", path: "/admin/moderation-queue/speaker/" + id + "/resolve", body: `{"action":"unlist"}`, err: domain.ErrForbidden, wantStatus: http.StatusForbidden},
+		{name: "nothing open", path: "/admin/moderation-queue/speaker/" + id + "/resolve", body: `{"action":"unlist"}`, err: domain.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &mockAbuseReportService{err: tt.err}
+			ctrl := NewAbuseReportController(logger, svc)
+			mux := http.NewServeMux()
+			mux.HandleFunc("POST /admin/moderation-queue/{targetType}/{targetID}/resolve", ctrl.ResolveModerationItem)
+
+			req := httptest.NewRequest(http.MethodPost, tt.path, bytes.NewBufferString(tt.body))
+			req = req.WithContext(middleware.SetUserID(req.Context(), "admin-1"))
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if tt.wantStatus == http.StatusOK && (svc.lastTarget != domain.AbuseTarget{Type: domain.AbuseTargetSpeaker, ID: id} || svc.lastAction != domain.ModerationDismiss) {
+				t.Fatalf("unexpected call: %+v %q", svc.lastTarget, svc.lastAction)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+// defaultUserActivity is how many entries the activity feed returns without a limit.
+const defaultUserActivity = 50
+
+// ActivityController serves the users' activity feeds.
+type ActivityController struct {
+	Logger  *slog.Logger
+	Service domain.ActivityService
+}
+
+func NewActivityController(logger *slog.Logger, svc domain.ActivityService) *ActivityController {
+	return &ActivityController{
+		Logger:  logger,
+		Service: svc,
+	}
+}
+
+// ListMyActivitySuccessResponse is the success response envelope for GET /users/me/activity (200).
+type ListMyActivitySuccessResponse struct {
+	Data  []*domain.UserActivity `json:"data"`
+	Error *helpers.APIError      `json:"error"`
+}
+
+// ListMyActivity godoc
+// @Summary List requests admins made on my behalf
+// @ID ListMyActivity
+// @Description Returns the requests support admins made acting as the caller with the X-Act-As header, newest first, with the admin's name, the method, path and resulting status. Requires authentication.
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "Most entries to return (1-200, default 50)"
+// @Success 200 {object} controllers.ListMyActivitySuccessResponse "data contains the activity"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /users/me/activity [get]
+func (c *ActivityController) ListMyActivity(w http.ResponseWriter, r *http.Request) {
+	limit := defaultUserActivity
+	if s := r.URL.Query().Get("limit"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "limit must be a number")
+			return
+		}
+		limit = n
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+
+	activity, err := c.Service.ListMyActivity(r.Context(), userID, limit)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, activity)
+}
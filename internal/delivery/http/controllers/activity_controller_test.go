@@ -0,0 +1,70 @@
+package controllers
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+type mockActivityService struct {
+	err       error
+	lastLimit int
+}
+
+func (m *mockActivityService) AuthorizeActAs(ctx context.Context, actorID, userID string) error {
+	return m.err
+}
+
+func (m *mockActivityService) RecordActivity(ctx context.Context, a *domain.UserActivity) error {
+	return m.err
+}
+
+func (m *mockActivityService) ListMyActivity(ctx context.Context, userID string, limit int) ([]*domain.UserActivity, error) {
+	m.lastLimit = limit
+	if m.err != nil {
+		return nil, m.err
+	}
+	return []*domain.UserActivity{}, nil
+}
+
+func TestActivityController_ListMyActivity(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	tests := []struct {
+		name       string
+		query      string
+		err        error
+		wantStatus int
+		wantLimit  int
+	}{
+		{name: "default limit", wantStatus: http.StatusOK, wantLimit: defaultUserActivity},
+		{name: "given limit", query: "?limit=10", wantStatus: http.StatusOK, wantLimit: 10},
+		{name: "limit not a number", query: "?limit=ten", wantStatus: http.StatusBadRequest},
+		{name: "limit out of range", query: "?limit=500", err: domain.ErrInvalidInput, wantStatus: http.StatusBadRequest, wantLimit: 500},
+		{name: "store failed", err: context.DeadlineExceeded, wantStatus: http.StatusInternalServerError, wantLimit: defaultUserActivity},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &mockActivityService{err: tt.err}
+			ctrl := NewActivityController(logger, svc)
+
+			req := httptest.NewRequest(http.MethodGet, "/users/me/activity"+tt.query, nil)
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-1"))
+			w := httptest.NewRecorder()
+			ctrl.ListMyActivity(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if svc.lastLimit != tt.wantLimit {
+				t.Fatalf("expected limit %d, got %d", tt.wantLimit, svc.lastLimit)
+			}
+		})
+	}
+}
@@ -0,0 +1,340 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+// AnnouncementController serves the product changelog and its admin endpoints.
+type AnnouncementController struct {
+	Logger  *slog.Logger
+	Service domain.AnnouncementService
+}
+
+func NewAnnouncementController(logger *slog.Logger, svc domain.AnnouncementService) *AnnouncementController {
+	return &AnnouncementController{
+		Logger:  logger,
+		Service: svc,
+	}
+}
+
+// ChangelogResponse is the data payload for GET /changelog (200).
+type ChangelogResponse struct {
+	Items []*domain.Announcement `json:"items"`
+	// Unread is how many published announcements the caller has not read, across all pages.
+	Unread     int                    `json:"unread"`
+	Pagination helpers.PaginationMeta `json:"pagination"`
+}
+
+// ChangelogSuccessResponse is the success response envelope for GET /changelog (200).
+type ChangelogSuccessResponse struct {
+	Data  ChangelogResponse `json:"data"`
+	Error *helpers.APIError `json:"error"`
+}
+
+// MarkAnnouncementsReadRequest is the request body for POST /changelog/read.
+type MarkAnnouncementsReadRequest struct {
+	// AnnouncementIDs lists the announcements to mark read; empty or omitted marks all of them.
+	AnnouncementIDs []string `json:"announcement_ids"`
+}
+
+// Validate implements Validator.
+func (m MarkAnnouncementsReadRequest) Validate() []string {
+	var errs []string
+	if len(m.AnnouncementIDs) > domain.MaxAnnouncementsMarkedRead {
+		errs = append(errs, fmt.Sprintf("announcement_ids must have at most %d entries", domain.MaxAnnouncementsMarkedRead))
+	}
+	for _, id := range m.AnnouncementIDs {
+		if !uuidRegex.MatchString(id) {
+			errs = append(errs, "announcement_ids must be UUIDs")
+			break
+		}
+	}
+	return errs
+}
+
+// MarkAnnouncementsReadResponse is the data payload for POST /changelog/read (200).
+type MarkAnnouncementsReadResponse struct {
+	Status string `json:"status"`
+}
+
+// MarkAnnouncementsReadSuccessResponse is the success response envelope for POST /changelog/read (200).
+type MarkAnnouncementsReadSuccessResponse struct {
+	Data  MarkAnnouncementsReadResponse `json:"data"`
+	Error *helpers.APIError             `json:"error"`
+}
+
+// AnnouncementRequest is the request body for POST /admin/announcements and
+// PUT /admin/announcements/{announcementID}.
+type AnnouncementRequest struct {
+	Title string `json:"title"`
+	// Body is Markdown.
+	Body string `json:"body"`
+	// Category is one of feature, improvement and fix.
+	Category string `json:"category"`
+	// PublishedAt is when the announcement shows in the changelog; omit it to keep a draft.
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+}
+
+// Validate implements Validator.
+func (a AnnouncementRequest) Validate() []string {
+	var errs []string
+	if strings.TrimSpace(a.Title) == "" {
+		errs = append(errs, "title is required")
+	} else if len(a.Title) > 200 {
+		errs = append(errs, "title must be at most 200 characters")
+	}
+	if a.Category == "" {
+		errs = append(errs, "category is required")
+	}
+	return errs
+}
+
+func (a AnnouncementRequest) announcement(id string) *domain.Announcement {
+	return &domain.Announcement{ID: id, Title: a.Title, Body: a.Body, Category: a.Category, PublishedAt: a.PublishedAt}
+}
+
+// AnnouncementSuccessResponse is the success response envelope for POST /admin/announcements (201)
+// and PUT /admin/announcements/{announcementID} (200).
+type AnnouncementSuccessResponse struct {
+	Data  domain.Announcement `json:"data"`
+	Error *helpers.APIError   `json:"error"`
+}
+
+// ListAnnouncementsResponse is the data payload for GET /admin/announcements (200).
+type ListAnnouncementsResponse struct {
+	Items      []*domain.Announcement `json:"items"`
+	Pagination helpers.PaginationMeta `json:"pagination"`
+}
+
+// ListAnnouncementsSuccessResponse is the success response envelope for GET /admin/announcements (200).
+type ListAnnouncementsSuccessResponse struct {
+	Data  ListAnnouncementsResponse `json:"data"`
+	Error *helpers.APIError         `json:"error"`
+}
+
+// GetChangelog godoc
+// @Summary List the product changelog
+// @ID GetChangelog
+// @Description Returns a paginated list of the published product announcements, newest first, each with whether the caller has read it, plus how many the caller has not read. Drafts and scheduled announcements are not listed. Requires authentication.
+// @Tags announcements
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Page size (default 20, max 100)"
+// @Success 200 {object} controllers.ChangelogSuccessResponse "data contains items, unread and pagination"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /changelog [get]
+func (c *AnnouncementController) GetChangelog(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	params := helpers.ParsePagination(r)
+	items, total, unread, err := c.Service.ListChangelog(r.Context(), userID, params)
+	if err != nil {
+		c.writeAnnouncementError(w, r, err)
+		return
+	}
+	meta := helpers.NewPaginationMeta(params.Page, params.PageSize, total)
+	helpers.WriteJSONSuccess(w, http.StatusOK, ChangelogResponse{Items: items, Unread: unread, Pagination: meta})
+}
+
+// MarkAnnouncementsRead godoc
+// @Summary Mark changelog announcements read
+// @ID MarkAnnouncementsRead
+// @Description Marks the listed announcements read for the caller, or every published announcement when announcement_ids is empty or omitted. Unknown and unpublished IDs are ignored. Requires authentication.
+// @Tags announcements
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body controllers.MarkAnnouncementsReadRequest true "Announcements to mark read"
+// @Success 200 {object} controllers.MarkAnnouncementsReadSuccessResponse "data.status is read"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /changelog/read [post]
+func (c *AnnouncementController) MarkAnnouncementsRead(w http.ResponseWriter, r *http.Request) {
+	var req MarkAnnouncementsReadRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	if err := c.Service.MarkAnnouncementsRead(r.Context(), userID, req.AnnouncementIDs); err != nil {
+		c.writeAnnouncementError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, MarkAnnouncementsReadResponse{Status: "read"})
+}
+
+// ListAnnouncements godoc
+// @Summary List all announcements
+// @ID ListAnnouncements
+// @Description Returns a paginated list of every announcement, drafts and scheduled ones first, then newest first. Only platform admins can list. Requires authentication.
+// @Tags announcements
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Page size (default 20, max 100)"
+// @Success 200 {object} controllers.ListAnnouncementsSuccessResponse "data contains items and pagination"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not admin)"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /admin/announcements [get]
+func (c *AnnouncementController) ListAnnouncements(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	params := helpers.ParsePagination(r)
+	items, total, err := c.Service.ListAnnouncements(r.Context(), adminID, params)
+	if err != nil {
+		c.writeAnnouncementError(w, r, err)
+		return
+	}
+	meta := helpers.NewPaginationMeta(params.Page, params.PageSize, total)
+	helpers.WriteJSONSuccess(w, http.StatusOK, ListAnnouncementsResponse{Items: items, Pagination: meta})
+}
+
+// CreateAnnouncement godoc
+// @Summary Create an announcement
+// @ID CreateAnnouncement
+// @Description Creates a product announcement. It shows in the changelog from published_at on; without published_at it stays a draft. Only platform admins can create. Requires authentication.
+// @Tags announcements
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body controllers.AnnouncementRequest true "Announcement"
+// @Success 201 {object} controllers.AnnouncementSuccessResponse "data is the created announcement"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not admin)"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /admin/announcements [post]
+func (c *AnnouncementController) CreateAnnouncement(w http.ResponseWriter, r *http.Request) {
+	var req AnnouncementRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	adminID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	a, err := c.Service.CreateAnnouncement(r.Context(), adminID, req.announcement(""))
+	if err != nil {
+		c.writeAnnouncementError(w, r, err)
+		return
+	}
+	c.Logger.InfoContext(r.Context(), "announcement created", "audit", "announcement.create",
+		"user_id", adminID, "announcement_id", a.ID)
+	helpers.WriteJSONSuccess(w, http.StatusCreated, a)
+}
+
+// UpdateAnnouncement godoc
+// @Summary Update an announcement
+// @ID UpdateAnnouncement
+// @Description Replaces the announcement's title, body, category and published_at; omitting published_at turns it back into a draft. Read marks are kept. Only platform admins can update. Requires authentication.
+// @Tags announcements
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param announcementID path string true "Announcement ID (UUID)"
+// @Param body body controllers.AnnouncementRequest true "Announcement"
+// @Success 200 {object} controllers.AnnouncementSuccessResponse "data is the updated announcement"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not admin)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /admin/announcements/{announcementID} [put]
+func (c *AnnouncementController) UpdateAnnouncement(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("announcementID")
+	if !uuidRegex.MatchString(id) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid announcementID")
+		return
+	}
+	var req AnnouncementRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	adminID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	a, err := c.Service.UpdateAnnouncement(r.Context(), adminID, req.announcement(id))
+	if err != nil {
+		c.writeAnnouncementError(w, r, err)
+		return
+	}
+	c.Logger.InfoContext(r.Context(), "announcement updated", "audit", "announcement.update",
+		"user_id", adminID, "announcement_id", id)
+	helpers.WriteJSONSuccess(w, http.StatusOK, a)
+}
+
+// DeleteAnnouncement godoc
+// @Summary Delete an announcement
+// @ID DeleteAnnouncement
+// @Description Deletes the announcement and every user's read mark of it. Only platform admins can delete. Requires authentication.
+// @Tags announcements
+// @Produce json
+// @Security BearerAuth
+// @Param announcementID path string true "Announcement ID (UUID)"
+// @Success 204 "No content"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not admin)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /admin/announcements/{announcementID} [delete]
+func (c *AnnouncementController) DeleteAnnouncement(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("announcementID")
+	if !uuidRegex.MatchString(id) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid announcementID")
+		return
+	}
+	adminID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	if err := c.Service.DeleteAnnouncement(r.Context(), adminID, id); err != nil {
+		c.writeAnnouncementError(w, r, err)
+		return
+	}
+	c.Logger.InfoContext(r.Context(), "announcement deleted", "audit", "announcement.delete",
+		"user_id", adminID, "announcement_id", id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c *AnnouncementController) writeAnnouncementError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, domain.ErrNotFound) {
+		helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "announcement not found")
+		return
+	}
+	if errors.Is(err, domain.ErrForbidden) {
+		helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+		return
+	}
+	if errors.Is(err, domain.ErrInvalidInput) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
+		return
+	}
+	helpers.WriteServiceError(w, r, c.Logger, err)
+}
@@ -0,0 +1,165 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+type mockAnnouncementService struct {
+	items     []*domain.Announcement
+	unread    int
+	err       error
+	lastIDs   []string
+	lastSaved *domain.Announcement
+}
+
+func (m *mockAnnouncementService) ListChangelog(ctx context.Context, userID string, params domain.PaginationParams) ([]*domain.Announcement, int, int, error) {
+	if m.err != nil {
+		return nil, 0, 0, m.err
+	}
+	return m.items, len(m.items), m.unread, nil
+}
+
+func (m *mockAnnouncementService) MarkAnnouncementsRead(ctx context.Context, userID string, ids []string) error {
+	m.lastIDs = ids
+	return m.err
+}
+
+func (m *mockAnnouncementService) ListAnnouncements(ctx context.Context, adminID string, params domain.PaginationParams) ([]*domain.Announcement, int, error) {
+	if m.err != nil {
+		return nil, 0, m.err
+	}
+	return m.items, len(m.items), nil
+}
+
+func (m *mockAnnouncementService) CreateAnnouncement(ctx context.Context, adminID string, a *domain.Announcement) (*domain.Announcement, error) {
+	m.lastSaved = a
+	if m.err != nil {
+		return nil, m.err
+	}
+	a.ID = "11111111-1111-1111-1111-111111111111"
+	return a, nil
+}
+
+func (m *mockAnnouncementService) UpdateAnnouncement(ctx context.Context, adminID string, a *domain.Announcement) (*domain.Announcement, error) {
+	m.lastSaved = a
+	if m.err != nil {
+		return nil, m.err
+	}
+	return a, nil
+}
+
+func (m *mockAnnouncementService) DeleteAnnouncement(ctx context.Context, adminID, id string) error {
+	return m.err
+}
+
+func TestAnnouncementController_GetChangelog(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	svc := &mockAnnouncementService{
+		items:  []*domain.Announcement{{ID: "a1", Title: "Dark mode", Category: domain.AnnouncementFeature}},
+		unread: 1,
+	}
+	ctrl := NewAnnouncementController(logger, svc)
+
+	req := httptest.NewRequest(http.MethodGet, "/changelog", nil)
+	req = req.WithContext(middleware.SetUserID(req.Context(), "u1"))
+	w := httptest.NewRecorder()
+	ctrl.GetChangelog(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	var resp ChangelogSuccessResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if resp.Data.Unread != 1 || len(resp.Data.Items) != 1 || resp.Data.Pagination.Total != 1 {
+		t.Fatalf("unexpected changelog: %+v", resp.Data)
+	}
+}
+
+func TestAnnouncementController_MarkAnnouncementsRead(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantIDs    int
+	}{
+		{name: "listed ids", body: `{"announcement_ids":["11111111-1111-1111-1111-111111111111"]}`, wantStatus: http.StatusOK, wantIDs: 1},
+		{name: "empty body marks all", body: `{}`, wantStatus: http.StatusOK, wantIDs: 0},
+		{name: "invalid id", body: `{"announcement_ids":["nope"]}`, wantStatus: http.StatusBadRequest},
+		{name: "too many ids", body: `{"announcement_ids":[` + strings.Repeat(`"11111111-1111-1111-1111-111111111111",`, domain.MaxAnnouncementsMarkedRead) + `"11111111-1111-1111-1111-111111111111"]}`, wantStatus: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &mockAnnouncementService{}
+			ctrl := NewAnnouncementController(logger, svc)
+			req := httptest.NewRequest(http.MethodPost, "/changelog/read", bytes.NewBufferString(tt.body))
+			req = req.WithContext(middleware.SetUserID(req.Context(), "u1"))
+			w := httptest.NewRecorder()
+			ctrl.MarkAnnouncementsRead(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if tt.wantStatus == http.StatusOK && len(svc.lastIDs) != tt.wantIDs {
+				t.Fatalf("expected %d ids, got %v", tt.wantIDs, svc.lastIDs)
+			}
+		})
+	}
+}
+
+func TestAnnouncementController_Admin(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	const id = "11111111-1111-1111-1111-111111111111"
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		err        error
+		wantStatus int
+	}{
+		{name: "create", method: http.MethodPost, path: "/admin/announcements", body: `{"title":"Dark mode","category":"feature"}`, wantStatus: http.StatusCreated},
+		{name: "create missing title", method: http.MethodPost, path: "/admin/announcements", body: `{"category":"feature"}`, wantStatus: http.StatusBadRequest},
+		{name: "create not admin", method: http.MethodPost, path: "/admin/announcements", body: `{"title":"Dark mode","category":"feature"}`, err: domain.ErrForbidden, wantStatus: http.StatusForbidden},
+		{name: "create bad category", method: http.MethodPost, path: "/admin/announcements", body: `{"title":"Dark mode","category":"rumor"}`, err: domain.ErrInvalidInput, wantStatus: http.StatusBadRequest},
+		{name: "update", method: http.MethodPut, path: "/admin/announcements/" + id, body: `{"title":"Dark mode","category":"feature"}`, wantStatus: http.StatusOK},
+		{name: "update missing", method: http.MethodPut, path: "/admin/announcements/" + id, body: `{"title":"Dark mode","category":"feature"}`, err: domain.ErrNotFound, wantStatus: http.StatusNotFound},
+		{name: "update invalid id", method: http.MethodPut, path: "/admin/announcements/nope", body: `{"title":"Dark mode","category":"feature"}`, wantStatus: http.StatusBadRequest},
+		{name: "delete", method: http.MethodDelete, path: "/admin/announcements/" + id, wantStatus: http.StatusNoContent},
+		{name: "list not admin", method: http.MethodGet, path: "/admin/announcements", err: domain.ErrForbidden, wantStatus: http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewAnnouncementController(logger, &mockAnnouncementService{err: tt.err})
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /admin/announcements", ctrl.ListAnnouncements)
+			mux.HandleFunc("POST /admin/announcements", ctrl.CreateAnnouncement)
+			mux.HandleFunc("PUT /admin/announcements/{announcementID}", ctrl.UpdateAnnouncement)
+			mux.HandleFunc("DELETE /admin/announcements/{announcementID}", ctrl.DeleteAnnouncement)
+
+			req := httptest.NewRequest(tt.method, tt.path, bytes.NewBufferString(tt.body))
+			req = req.WithContext(middleware.SetUserID(req.Context(), "admin-1"))
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+		})
+	}
+}
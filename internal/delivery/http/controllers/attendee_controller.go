@@ -2,10 +2,13 @@ package controllers
 
 import (
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"multitrackticketing/internal/delivery/http/helpers"
 	"multitrackticketing/internal/delivery/http/middleware"
@@ -15,9 +18,20 @@ import (
 // uuidRegexAttendee matches a canonical UUID string (8-4-4-4-12 hex).
 var uuidRegexAttendee = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
 
+// eventCodeRegex matches an event_code: 4 to 16 lowercase letters or digits, as the configured
+// format may have changed since older codes were handed out.
+var eventCodeRegex = regexp.MustCompile(`^[a-z0-9]{4,16}$`)
+
 type AttendeeController struct {
 	Logger  *slog.Logger
 	Service domain.AttendeeService
+	// PublicBaseURL is the server's public origin, e.g. "https://api.example.com", that preview
+	// images are linked from. Empty links them by path.
+	PublicBaseURL string
+	// PublicSiteURL is the origin of the public site with pages at /events/{eventCode} and
+	// /events/{eventCode}/sessions/{sessionID} and /speakers/{speakerID} under it, the canonical URLs
+	// of the sitemap and metadata. Empty makes the server's schedule page the event's only page.
+	PublicSiteURL string
 }
 
 func NewAttendeeController(logger *slog.Logger, svc domain.AttendeeService) *AttendeeController {
@@ -35,7 +49,8 @@ type RegisterForEventSuccessResponse struct {
 
 // RegisterForEvent godoc
 // @Summary Register the current attendee for an event
-// @Description Registers the authenticated user as an attendee for the specified event. Idempotent: returns 201 when a new registration is created, 200 when already registered.
+// @ID RegisterForEvent
+// @Description Registers the authenticated user as an attendee for the specified event. When the event has documents such as a code of conduct, the current version of each must have been accepted with POST /attendee/events/{eventID}/documents/accept first. An event unlisted after abuse reports takes no new registrations, and answers 404 like an unknown event. Idempotent: returns 201 when a new registration is created, 200 when already registered.
 // @Tags attendee
 // @Produce json
 // @Security BearerAuth
@@ -44,7 +59,8 @@ type RegisterForEventSuccessResponse struct {
 // @Success 201 {object} controllers.RegisterForEventSuccessResponse "New registration created"
 // @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
-// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 409 {object} helpers.APIResponse "error.code: documents_not_accepted"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
 // @Router /attendee/events/{eventID}/registrations [post]
 func (c *AttendeeController) RegisterForEvent(w http.ResponseWriter, r *http.Request) {
@@ -67,15 +83,18 @@ func (c *AttendeeController) RegisterForEvent(w http.ResponseWriter, r *http.Req
 	reg, created, err := c.Service.RegisterForEvent(r.Context(), eventID, userID)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event not found")
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, domain.ErrDocumentsNotAccepted) {
+			helpers.WriteJSONError(w, http.StatusConflict, helpers.ErrCodeDocumentsNotAccepted, err.Error())
 			return
 		}
 		if errors.Is(err, domain.ErrInvalidInput) {
 			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
 			return
 		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		helpers.WriteServiceError(w, r, c.Logger, err)
 		return
 	}
 	if created {
@@ -88,6 +107,9 @@ func (c *AttendeeController) RegisterForEvent(w http.ResponseWriter, r *http.Req
 // RegisterForEventByCodeRequest is the request body for POST /attendee/registrations.
 type RegisterForEventByCodeRequest struct {
 	EventCode string `json:"event_code"`
+	// AcceptedDocuments are the versions of the event's documents the user accepts, as
+	// GET /public/events/{eventCode}/documents lists them.
+	AcceptedDocuments []domain.DocumentVersion `json:"accepted_documents"`
 }
 
 // Validate implements helpers.Validator.
@@ -96,8 +118,8 @@ func (r *RegisterForEventByCodeRequest) Validate() []string {
 	if code == "" {
 		return []string{"event_code is required"}
 	}
-	if len(code) != 4 {
-		return []string{"event_code must be exactly 4 characters"}
+	if len(code) < domain.MinEventCodeLength || len(code) > domain.MaxEventCodeLength {
+		return []string{fmt.Sprintf("event_code must be %d to %d characters", domain.MinEventCodeLength, domain.MaxEventCodeLength)}
 	}
 	for _, c := range code {
 		if (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') {
@@ -105,23 +127,28 @@ func (r *RegisterForEventByCodeRequest) Validate() []string {
 		}
 		return []string{"event_code must contain only lowercase letters and digits"}
 	}
+	if errs := validateDocumentVersions(r.AcceptedDocuments); len(errs) > 0 {
+		return errs
+	}
 	r.EventCode = code
 	return nil
 }
 
 // RegisterForEventByCode godoc
 // @Summary Register for an event by event code
-// @Description Registers the authenticated user as an attendee for the event with the given event_code. Idempotent: returns 201 when a new registration is created, 200 when already registered.
+// @ID RegisterForEventByCode
+// @Description Registers the authenticated user as an attendee for the event with the given event_code. When the event has documents such as a code of conduct (GET /public/events/{eventCode}/documents), accepted_documents must name the current version of each the user has not accepted yet. An event unlisted after abuse reports takes no new registrations, and answers 404 like an unknown code. Requests may be screened for bots (see the README). Idempotent: returns 201 when a new registration is created, 200 when already registered.
 // @Tags attendee
 // @Accept json
 // @Produce json
 // @Security BearerAuth
-// @Param body body controllers.RegisterForEventByCodeRequest true "Event code (4 characters)"
+// @Param body body controllers.RegisterForEventByCodeRequest true "Event code (4 to 16 characters)"
 // @Success 200 {object} controllers.RegisterForEventSuccessResponse "Already registered"
 // @Success 201 {object} controllers.RegisterForEventSuccessResponse "New registration created"
-// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request, bot_detected or captcha_failed"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
-// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 409 {object} helpers.APIResponse "error.code: documents_not_accepted"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
 // @Router /attendee/registrations [post]
 func (c *AttendeeController) RegisterForEventByCode(w http.ResponseWriter, r *http.Request) {
@@ -136,18 +163,21 @@ func (c *AttendeeController) RegisterForEventByCode(w http.ResponseWriter, r *ht
 		return
 	}
 
-	reg, created, err := c.Service.RegisterForEventByCode(r.Context(), req.EventCode, userID)
+	reg, created, err := c.Service.RegisterForEventByCode(r.Context(), req.EventCode, userID, req.AcceptedDocuments)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event not found")
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, domain.ErrDocumentsNotAccepted) {
+			helpers.WriteJSONError(w, http.StatusConflict, helpers.ErrCodeDocumentsNotAccepted, err.Error())
 			return
 		}
 		if errors.Is(err, domain.ErrInvalidInput) {
 			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
 			return
 		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		helpers.WriteServiceError(w, r, c.Logger, err)
 		return
 	}
 	if created {
@@ -157,6 +187,83 @@ func (c *AttendeeController) RegisterForEventByCode(w http.ResponseWriter, r *ht
 	helpers.WriteJSONSuccess(w, http.StatusOK, reg)
 }
 
+// JoinEventRequest is the request body for POST /events/join.
+type JoinEventRequest struct {
+	Code string `json:"code"`
+	// AcceptedDocuments are the versions of the event's documents the user accepts, as
+	// GET /public/events/{eventCode}/documents lists them.
+	AcceptedDocuments []domain.DocumentVersion `json:"accepted_documents"`
+}
+
+// Validate implements helpers.Validator.
+func (r *JoinEventRequest) Validate() []string {
+	code := strings.ToLower(strings.TrimSpace(r.Code))
+	if code == "" {
+		return []string{"code is required"}
+	}
+	if !eventCodeRegex.MatchString(code) {
+		return []string{fmt.Sprintf("code must be %d to %d letters or digits", domain.MinEventCodeLength, domain.MaxEventCodeLength)}
+	}
+	if errs := validateDocumentVersions(r.AcceptedDocuments); len(errs) > 0 {
+		return errs
+	}
+	r.Code = code
+	return nil
+}
+
+// JoinEventSuccessResponse is the success response envelope for POST /events/join (200, 201).
+type JoinEventSuccessResponse struct {
+	Data  *domain.EventJoin `json:"data"`
+	Error *helpers.APIError `json:"error"`
+}
+
+// JoinEvent godoc
+// @Summary Join an event by its code
+// @ID JoinEvent
+// @Description Registers the authenticated user as an attendee of the event with the given code, as typed in the app at the venue, and returns the registration with the event's schedule, as GET /attendee/events/{eventID}/schedule has it, in one call. An event unlisted after abuse reports cannot be joined, and answers 404 like an unknown code; attendees already registered still get its schedule. When the event has documents such as a code of conduct (GET /public/events/{eventCode}/documents), accepted_documents must name the current version of each the user has not accepted yet; attendees already registered are not turned away when one changes, but get it in pending_documents until they accept it. Requests may be screened for bots like POST /attendee/registrations (see the README). Idempotent: returns 201 when the user joined, 200 when already registered.
+// @Tags attendee
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body controllers.JoinEventRequest true "Event code (4 to 16 characters, case-insensitive)"
+// @Success 200 {object} controllers.JoinEventSuccessResponse "Already registered; data contains the registration and schedule"
+// @Success 201 {object} controllers.JoinEventSuccessResponse "Joined; data contains the new registration and schedule"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request, bot_detected or captcha_failed"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 409 {object} helpers.APIResponse "error.code: documents_not_accepted"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/join [post]
+func (c *AttendeeController) JoinEvent(w http.ResponseWriter, r *http.Request) {
+	var req JoinEventRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	join, err := c.Service.JoinEventByCode(r.Context(), req.Code, userID, req.AcceptedDocuments)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, domain.ErrDocumentsNotAccepted) {
+			helpers.WriteJSONError(w, http.StatusConflict, helpers.ErrCodeDocumentsNotAccepted, err.Error())
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	if join.Joined {
+		helpers.WriteJSONSuccess(w, http.StatusCreated, join)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, join)
+}
+
 // ListMyRegisteredEventsItem is an item in the response for GET /attendee/events.
 type ListMyRegisteredEventsItem struct {
 	Event        *domain.Event               `json:"event"`
@@ -171,6 +278,7 @@ type ListMyRegisteredEventsSuccessResponse struct {
 
 // ListMyRegisteredEvents godoc
 // @Summary Get events the current user is registered for
+// @ID ListMyRegisteredEvents
 // @Description Returns the list of events the authenticated user is registered for, including registration metadata.
 // @Tags attendee
 // @Produce json
@@ -188,8 +296,7 @@ func (c *AttendeeController) ListMyRegisteredEvents(w http.ResponseWriter, r *ht
 
 	items, err := c.Service.ListMyRegisteredEvents(r.Context(), userID)
 	if err != nil {
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		helpers.WriteServiceError(w, r, c.Logger, err)
 		return
 	}
 
@@ -216,7 +323,8 @@ type GetEventScheduleSuccessResponse struct {
 
 // GetEventSchedule godoc
 // @Summary Get event schedule for a registered attendee
-// @Description Returns the event schedule (event plus bookable rooms with nested sessions) for the specified event. Only registered attendees or the event owner may access this. Only rooms with not_bookable=false are included.
+// @ID GetEventSchedule
+// @Description Returns the event schedule (event plus bookable rooms with nested sessions) for the specified event. Only registered attendees or the event owner may access this. Only rooms with not_bookable=false are included. Rooms whose door sensors or clickers have reported carry their live occupancy. Sessions carry the caption and translation stream links visible now (PUT /events/{eventID}/sessions/{sessionID}/content/streams).
 // @Tags attendee
 // @Produce json
 // @Security BearerAuth
@@ -225,7 +333,7 @@ type GetEventScheduleSuccessResponse struct {
 // @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
 // @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not registered or owner)"
-// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
 // @Router /attendee/events/{eventID}/schedule [get]
 func (c *AttendeeController) GetEventSchedule(w http.ResponseWriter, r *http.Request) {
@@ -248,18 +356,332 @@ func (c *AttendeeController) GetEventSchedule(w http.ResponseWriter, r *http.Req
 	schedule, err := c.Service.GetEventSchedule(r.Context(), eventID, userID)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event not found")
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
 			return
 		}
 		if errors.Is(err, domain.ErrForbidden) {
 			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
 			return
 		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+
+	helpers.WriteJSONSuccess(w, http.StatusOK, schedule)
+}
+
+// GetMachineEventSchedule godoc
+// @Summary Get event schedule for a machine client
+// @ID GetMachineEventSchedule
+// @Description Returns the same schedule as GET /attendee/events/{eventID}/schedule to an internal service such as signage. Requires a machine access token (POST /auth/machine-token) with the schedule:read scope, issued to a client of this event.
+// @Tags attendee
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Success 200 {object} controllers.GetEventScheduleSuccessResponse "data contains event and rooms (bookable only) with nested sessions"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: insufficient_scope, or forbidden (client of another event)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /machine/events/{eventID}/schedule [get]
+func (c *AttendeeController) GetMachineEventSchedule(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if !uuidRegexAttendee.MatchString(eventID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID")
+		return
+	}
+	client, ok := middleware.MachineFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
 		return
 	}
 
+	schedule, err := c.Service.GetEventScheduleForClient(r.Context(), eventID, client)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
 	helpers.WriteJSONSuccess(w, http.StatusOK, schedule)
 }
 
+// defaultScheduleChanges is how many changes the schedule change feed returns without a limit.
+const defaultScheduleChanges = 50
+
+// ListScheduleChangesSuccessResponse is the success response envelope for GET /attendee/events/{eventID}/schedule/changes (200).
+type ListScheduleChangesSuccessResponse struct {
+	Data  []*domain.SessionChange `json:"data"`
+	Error *helpers.APIError       `json:"error"`
+}
+
+// ListScheduleChanges godoc
+// @Summary List recent schedule changes for a registered attendee
+// @ID ListScheduleChanges
+// @Description Returns the sessions of the event that moved to another room or time, newest first, so apps and signage can show "moved from Room A". Pass the changed_at of the newest change seen as since to get only later ones. Only registered attendees or the event owner may access this.
+// @Tags attendee
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param since query string false "Only changes after this time (RFC 3339)"
+// @Param limit query int false "Most changes to return (1-200, default 50)"
+// @Success 200 {object} controllers.ListScheduleChangesSuccessResponse "data contains the changes"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not registered or owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /attendee/events/{eventID}/schedule/changes [get]
+func (c *AttendeeController) ListScheduleChanges(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
+		return
+	}
+	if !uuidRegexAttendee.MatchString(eventID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID")
+		return
+	}
+	var since time.Time
+	if s := r.URL.Query().Get("since"); s != "" {
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "since must be an RFC 3339 time")
+			return
+		}
+		since = t
+	}
+	limit := defaultScheduleChanges
+	if s := r.URL.Query().Get("limit"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "limit must be a number")
+			return
+		}
+		limit = n
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+
+	changes, err := c.Service.ListScheduleChanges(r.Context(), eventID, userID, since, limit)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+
+	helpers.WriteJSONSuccess(w, http.StatusOK, changes)
+}
+
+// defaultSyncChanges is how many changes a sync request applies without a limit.
+const defaultSyncChanges = 200
+
+// SyncEventSuccessResponse is the success response envelope for GET /events/{eventID}/sync (200).
+type SyncEventSuccessResponse struct {
+	Data  *domain.SyncDelta `json:"data"`
+	Error *helpers.APIError `json:"error"`
+}
+
+// SyncEvent godoc
+// @Summary Get the schedule changes since a sync cursor
+// @ID SyncEvent
+// @Description Returns the rooms, sessions and speakers created, updated or deleted since the cursor, with their current data, so offline-first apps download only what changed. Omit since on the first sync to get everything, then pass the returned cursor; while has_more is true, request again with it right away. Rooms that are not bookable are included with not_bookable set. Only registered attendees or the event owner may access this.
+// @Tags attendee
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param since query string false "Cursor returned by the previous sync"
+// @Param limit query int false "Most changes to apply (1-500, default 200)"
+// @Success 200 {object} controllers.SyncEventSuccessResponse "data contains the changes and the next cursor"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not registered or owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/sync [get]
+func (c *AttendeeController) SyncEvent(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
+		return
+	}
+	if !uuidRegexAttendee.MatchString(eventID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID")
+		return
+	}
+	limit := defaultSyncChanges
+	if s := r.URL.Query().Get("limit"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "limit must be a number")
+			return
+		}
+		limit = n
+	}
+
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+
+	delta, err := c.Service.SyncEvent(r.Context(), eventID, userID, r.URL.Query().Get("since"), limit)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+
+	helpers.WriteJSONSuccess(w, http.StatusOK, delta)
+}
+
+// GetOfflineBundle godoc
+// @Summary Download the offline bundle of an event
+// @ID GetOfflineBundle
+// @Description Returns a zip archive the attendee app can prefetch before arriving at a venue with bad connectivity: manifest.json (version, generated_at, and the path, size and SHA-256 of every other file), schedule.json (the event with its location and operating hours, bookable rooms with directions and sessions, and the speakers of those sessions) and photos/{speakerID}.jpg thumbnails. Speaker photos that cannot be fetched are left out. The ETag is the manifest version; send it as If-None-Match to get 304 when nothing changed. Responses carry Surrogate-Key event-{eventID}, which is purged from the CDN whenever the event changes. No login is needed; the event_code identifies the event.
+// @Tags attendee
+// @Produce application/zip
+// @Param eventCode path string true "Event code (4 lowercase letters or digits)"
+// @Param If-None-Match header string false "Version of the bundle the app already has"
+// @Success 200 {file} binary "zip archive"
+// @Success 304 "the app's version is current"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /public/events/{eventCode}/offline-bundle [get]
+func (c *AttendeeController) GetOfflineBundle(w http.ResponseWriter, r *http.Request) {
+	eventCode := strings.ToLower(r.PathValue("eventCode"))
+	if !eventCodeRegex.MatchString(eventCode) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventCode")
+		return
+	}
+	known := strings.Trim(strings.TrimPrefix(r.Header.Get("If-None-Match"), "W/"), `"`)
+
+	bundle, err := c.Service.GetOfflineBundle(r.Context(), eventCode, known)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+
+	w.Header().Set("ETag", `"`+bundle.Manifest.Version+`"`)
+	// Apps revalidate every time (see the route's Cache-Control), while a CDN may keep the bundle for
+	// a day: every change to the event purges its surrogate key.
+	w.Header().Set("Surrogate-Key", domain.EventSurrogateKey(bundle.EventID))
+	w.Header().Set("Surrogate-Control", "max-age=86400")
+	if bundle.Archive == nil {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+eventCode+`-offline-bundle.zip"`)
+	w.Header().Set("Content-Length", strconv.Itoa(len(bundle.Archive)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(bundle.Archive)
+}
+
+// GetPublicEventTheme godoc
+// @Summary Get the theme of an event
+// @ID GetPublicEventTheme
+// @Description Returns the colors, fonts, footer text and support email a white-label attendee app shows the event with. Empty fields mean the app's defaults. Responses carry Surrogate-Key event-{eventID}, which is purged from the CDN whenever the event changes. No login is needed; the event_code identifies the event.
+// @Tags attendee
+// @Produce json
+// @Param eventCode path string true "Event code (4 lowercase letters or digits)"
+// @Success 200 {object} controllers.EventThemeSuccessResponse "data contains the theme"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /public/events/{eventCode}/theme [get]
+func (c *AttendeeController) GetPublicEventTheme(w http.ResponseWriter, r *http.Request) {
+	eventCode := strings.ToLower(r.PathValue("eventCode"))
+	if !eventCodeRegex.MatchString(eventCode) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventCode")
+		return
+	}
+	theme, err := c.Service.GetEventTheme(r.Context(), eventCode)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	w.Header().Set("Surrogate-Key", domain.EventSurrogateKey(theme.EventID))
+	w.Header().Set("Surrogate-Control", "max-age=86400")
+	helpers.WriteJSONSuccess(w, http.StatusOK, theme)
+}
+
+// EventDocumentsSuccessResponse is the success response envelope for GET /public/events/{eventCode}/documents (200).
+type EventDocumentsSuccessResponse struct {
+	Data  *domain.EventDocuments `json:"data"`
+	Error *helpers.APIError      `json:"error"`
+}
+
+// GetPublicEventDocuments godoc
+// @Summary Get the documents an event asks attendees to accept
+// @ID GetPublicEventDocuments
+// @Description Returns the current version of each document, such as the code of conduct or photo policy, that registering for the event needs accepted. Apps show them before POST /events/join or POST /attendee/registrations and send the versions shown in accepted_documents. Responses carry Surrogate-Key event-{eventID}, which is purged from the CDN whenever the event changes. No login is needed; the event_code identifies the event.
+// @Tags attendee
+// @Produce json
+// @Param eventCode path string true "Event code (4 to 16 lowercase letters or digits)"
+// @Success 200 {object} controllers.EventDocumentsSuccessResponse "data contains the documents; none when the event asks for none"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /public/events/{eventCode}/documents [get]
+func (c *AttendeeController) GetPublicEventDocuments(w http.ResponseWriter, r *http.Request) {
+	eventCode := strings.ToLower(r.PathValue("eventCode"))
+	if !eventCodeRegex.MatchString(eventCode) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventCode")
+		return
+	}
+	docs, err := c.Service.GetPublicEventDocuments(r.Context(), eventCode)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	w.Header().Set("Surrogate-Key", domain.EventSurrogateKey(docs.EventID))
+	w.Header().Set("Surrogate-Control", "max-age=86400")
+	helpers.WriteJSONSuccess(w, http.StatusOK, docs)
+}
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"multitrackticketing/internal/delivery/http/helpers"
 	"multitrackticketing/internal/delivery/http/middleware"
@@ -22,21 +23,55 @@ type mockAttendeeService struct {
 	registerByCodeReg    *domain.EventRegistration
 	registerByCodeErr    error
 	registerByCodeCreated bool
+	join                  *domain.EventJoin
+	joinErr               error
 	getEventScheduleResult *domain.EventSchedule
 	getEventScheduleErr   error
+	scheduleChanges       []*domain.SessionChange
+	scheduleChangesErr    error
+	lastSince             time.Time
+	lastLimit             int
+	syncDelta             *domain.SyncDelta
+	syncErr               error
+	lastSyncSince         string
+	offlineBundle         *domain.OfflineBundle
+	offlineBundleErr      error
+	lastEventCode         string
+	lastKnownVersion      string
+	eventTheme            *domain.EventTheme
+	eventThemeErr         error
+	publicSchedule        *domain.PublicSchedule
+	publicScheduleErr     error
+	sessionCard           *domain.SessionCard
+	sessionCardImage      *domain.SessionCardImage
+	sessionCardErr        error
+	publicPages           []*domain.PublicPage
+	publicPagesErr        error
+	lastAccepted          []domain.DocumentVersion
+	publicDocuments       *domain.EventDocuments
+	publicDocumentsErr    error
 }
 
 func (m *mockAttendeeService) RegisterForEvent(ctx context.Context, eventID, userID string) (*domain.EventRegistration, bool, error) {
 	return nil, false, nil
 }
 
-func (m *mockAttendeeService) RegisterForEventByCode(ctx context.Context, eventCode, userID string) (*domain.EventRegistration, bool, error) {
+func (m *mockAttendeeService) RegisterForEventByCode(ctx context.Context, eventCode, userID string, accepted []domain.DocumentVersion) (*domain.EventRegistration, bool, error) {
+	m.lastAccepted = accepted
 	if m.registerByCodeErr != nil {
 		return nil, false, m.registerByCodeErr
 	}
 	return m.registerByCodeReg, m.registerByCodeCreated, nil
 }
 
+func (m *mockAttendeeService) JoinEventByCode(ctx context.Context, eventCode, userID string, accepted []domain.DocumentVersion) (*domain.EventJoin, error) {
+	m.lastEventCode, m.lastAccepted = eventCode, accepted
+	if m.joinErr != nil {
+		return nil, m.joinErr
+	}
+	return m.join, nil
+}
+
 func (m *mockAttendeeService) ListMyRegisteredEvents(ctx context.Context, userID string) ([]*domain.EventRegistrationWithEvent, error) {
 	if m.err != nil {
 		return nil, m.err
@@ -51,6 +86,82 @@ func (m *mockAttendeeService) GetEventSchedule(ctx context.Context, eventID, use
 	return m.getEventScheduleResult, nil
 }
 
+func (m *mockAttendeeService) GetEventScheduleForClient(ctx context.Context, eventID string, client *domain.MachinePrincipal) (*domain.EventSchedule, error) {
+	if m.getEventScheduleErr != nil {
+		return nil, m.getEventScheduleErr
+	}
+	return m.getEventScheduleResult, nil
+}
+
+func (m *mockAttendeeService) ListScheduleChanges(ctx context.Context, eventID, userID string, since time.Time, limit int) ([]*domain.SessionChange, error) {
+	m.lastSince, m.lastLimit = since, limit
+	if m.scheduleChangesErr != nil {
+		return nil, m.scheduleChangesErr
+	}
+	return m.scheduleChanges, nil
+}
+
+func (m *mockAttendeeService) GetOfflineBundle(ctx context.Context, eventCode, knownVersion string) (*domain.OfflineBundle, error) {
+	m.lastEventCode, m.lastKnownVersion = eventCode, knownVersion
+	if m.offlineBundleErr != nil {
+		return nil, m.offlineBundleErr
+	}
+	return m.offlineBundle, nil
+}
+
+func (m *mockAttendeeService) GetPublicSchedule(ctx context.Context, eventCode string) (*domain.PublicSchedule, error) {
+	m.lastEventCode = eventCode
+	if m.publicScheduleErr != nil {
+		return nil, m.publicScheduleErr
+	}
+	return m.publicSchedule, nil
+}
+
+func (m *mockAttendeeService) ListPublicPages(ctx context.Context) ([]*domain.PublicPage, error) {
+	if m.publicPagesErr != nil {
+		return nil, m.publicPagesErr
+	}
+	return m.publicPages, nil
+}
+
+func (m *mockAttendeeService) GetSessionCard(ctx context.Context, sessionID string) (*domain.SessionCard, error) {
+	if m.sessionCardErr != nil {
+		return nil, m.sessionCardErr
+	}
+	return m.sessionCard, nil
+}
+
+func (m *mockAttendeeService) GetSessionCardImage(ctx context.Context, sessionID string) (*domain.SessionCardImage, error) {
+	if m.sessionCardErr != nil {
+		return nil, m.sessionCardErr
+	}
+	return m.sessionCardImage, nil
+}
+
+func (m *mockAttendeeService) GetEventTheme(ctx context.Context, eventCode string) (*domain.EventTheme, error) {
+	m.lastEventCode = eventCode
+	if m.eventThemeErr != nil {
+		return nil, m.eventThemeErr
+	}
+	return m.eventTheme, nil
+}
+
+func (m *mockAttendeeService) GetPublicEventDocuments(ctx context.Context, eventCode string) (*domain.EventDocuments, error) {
+	m.lastEventCode = eventCode
+	if m.publicDocumentsErr != nil {
+		return nil, m.publicDocumentsErr
+	}
+	return m.publicDocuments, nil
+}
+
+func (m *mockAttendeeService) SyncEvent(ctx context.Context, eventID, userID, since string, limit int) (*domain.SyncDelta, error) {
+	m.lastSyncSince, m.lastLimit = since, limit
+	if m.syncErr != nil {
+		return nil, m.syncErr
+	}
+	return m.syncDelta, nil
+}
+
 func TestAttendeeController_ListMyRegisteredEvents_Unauthorized(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
 	svc := &mockAttendeeService{}
@@ -158,7 +269,7 @@ func TestAttendeeController_RegisterForEventByCode(t *testing.T) {
 			setUserID:  true,
 			svc:        &mockAttendeeService{registerByCodeErr: domain.ErrNotFound},
 			wantStatus: http.StatusNotFound,
-			wantErrCode: helpers.ErrCodeNotFound,
+			wantErrCode: helpers.ErrCodeEventNotFound,
 		},
 		{
 			name:       "validation missing event_code",
@@ -229,6 +340,85 @@ func TestAttendeeController_RegisterForEventByCode(t *testing.T) {
 	}
 }
 
+func TestAttendeeController_JoinEvent(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	schedule := &domain.EventSchedule{Event: &domain.Event{ID: "e1"}, Rooms: []*domain.RoomWithSessions{}}
+
+	tests := []struct {
+		name        string
+		body        string
+		svc         *mockAttendeeService
+		wantStatus  int
+		wantErrCode string
+		wantCode    string
+	}{
+		{
+			name:       "joined",
+			body:       `{"code":" AB12cd "}`,
+			svc:        &mockAttendeeService{join: &domain.EventJoin{Registration: &domain.EventRegistration{ID: "r1"}, Joined: true, Schedule: schedule}},
+			wantStatus: http.StatusCreated,
+			wantCode:   "ab12cd",
+		},
+		{
+			name:       "already registered",
+			body:       `{"code":"ab12"}`,
+			svc:        &mockAttendeeService{join: &domain.EventJoin{Registration: &domain.EventRegistration{ID: "r1"}, Schedule: schedule}},
+			wantStatus: http.StatusOK,
+			wantCode:   "ab12",
+		},
+		{name: "missing code", body: `{}`, svc: &mockAttendeeService{}, wantStatus: http.StatusBadRequest, wantErrCode: helpers.ErrCodeBadRequest},
+		{name: "invalid code", body: `{"code":"ab-1"}`, svc: &mockAttendeeService{}, wantStatus: http.StatusBadRequest, wantErrCode: helpers.ErrCodeBadRequest},
+		{name: "unknown or unlisted event", body: `{"code":"ab12"}`, svc: &mockAttendeeService{joinErr: domain.ErrNotFound}, wantStatus: http.StatusNotFound, wantErrCode: helpers.ErrCodeEventNotFound, wantCode: "ab12"},
+		{name: "service error", body: `{"code":"ab12"}`, svc: &mockAttendeeService{joinErr: errors.New("db error")}, wantStatus: http.StatusInternalServerError, wantErrCode: helpers.ErrCodeInternalError, wantCode: "ab12"},
+		{name: "documents not accepted", body: `{"code":"ab12"}`, svc: &mockAttendeeService{joinErr: domain.ErrDocumentsNotAccepted}, wantStatus: http.StatusConflict, wantErrCode: helpers.ErrCodeDocumentsNotAccepted, wantCode: "ab12"},
+		{name: "unknown document kind", body: `{"code":"ab12","accepted_documents":[{"kind":"privacy","version":1}]}`, svc: &mockAttendeeService{}, wantStatus: http.StatusBadRequest, wantErrCode: helpers.ErrCodeBadRequest},
+		{
+			name:       "documents accepted",
+			body:       `{"code":"ab12","accepted_documents":[{"kind":"code_of_conduct","version":3}]}`,
+			svc:        &mockAttendeeService{join: &domain.EventJoin{Registration: &domain.EventRegistration{ID: "r1"}, Joined: true, Schedule: schedule}},
+			wantStatus: http.StatusCreated,
+			wantCode:   "ab12",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewAttendeeController(logger, tt.svc)
+			req := httptest.NewRequest(http.MethodPost, "/events/join", bytes.NewReader([]byte(tt.body)))
+			req.Header.Set("Content-Type", "application/json")
+			req = req.WithContext(middleware.SetUserID(req.Context(), "u1"))
+			w := httptest.NewRecorder()
+
+			ctrl.JoinEvent(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status: want %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if tt.svc.lastEventCode != tt.wantCode {
+				t.Errorf("code: want %q, got %q", tt.wantCode, tt.svc.lastEventCode)
+			}
+			if tt.name == "documents accepted" && (len(tt.svc.lastAccepted) != 1 || tt.svc.lastAccepted[0].Version != 3) {
+				t.Errorf("accepted documents: got %+v", tt.svc.lastAccepted)
+			}
+			var resp struct {
+				Data  *domain.EventJoin `json:"data"`
+				Error *helpers.APIError `json:"error"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("unmarshal response: %v", err)
+			}
+			if tt.wantErrCode != "" {
+				if resp.Error == nil || resp.Error.Code != tt.wantErrCode {
+					t.Errorf("error code: want %q, got %v", tt.wantErrCode, resp.Error)
+				}
+				return
+			}
+			if resp.Data == nil || resp.Data.Schedule == nil || resp.Data.Schedule.Event.ID != "e1" {
+				t.Errorf("expected the schedule in data, got %s", w.Body.String())
+			}
+		})
+	}
+}
+
 func TestAttendeeController_GetEventSchedule(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
 	eventID := "550e8400-e29b-41d4-a716-446655440000"
@@ -294,7 +484,7 @@ func TestAttendeeController_GetEventSchedule(t *testing.T) {
 			setUserID:  true,
 			svc:        &mockAttendeeService{getEventScheduleErr: domain.ErrNotFound},
 			wantStatus: http.StatusNotFound,
-			wantErrCode: helpers.ErrCodeNotFound,
+			wantErrCode: helpers.ErrCodeEventNotFound,
 		},
 		{
 			name:       "bad request when eventID missing",
@@ -355,3 +545,383 @@ func TestAttendeeController_GetEventSchedule(t *testing.T) {
 	}
 }
 
+
+func TestAttendeeController_ListScheduleChanges(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	eventID := "550e8400-e29b-41d4-a716-446655440000"
+	since := time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		query       string
+		setUserID   bool
+		svc         *mockAttendeeService
+		wantStatus  int
+		wantErrCode string
+		check       func(t *testing.T, svc *mockAttendeeService, data interface{})
+	}{
+		{
+			name:      "defaults",
+			setUserID: true,
+			svc: &mockAttendeeService{scheduleChanges: []*domain.SessionChange{
+				{ID: "c1", SessionID: "s1", FromRoomName: "Room A", ToRoomName: "Room B"},
+			}},
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, svc *mockAttendeeService, data interface{}) {
+				if !svc.lastSince.IsZero() || svc.lastLimit != defaultScheduleChanges {
+					t.Errorf("since/limit: got %v/%d", svc.lastSince, svc.lastLimit)
+				}
+				items, ok := data.([]interface{})
+				if !ok || len(items) != 1 {
+					t.Fatalf("expected 1 change, got %v", data)
+				}
+				if from := items[0].(map[string]interface{})["from_room_name"]; from != "Room A" {
+					t.Errorf("from_room_name: got %v", from)
+				}
+			},
+		},
+		{
+			name:       "since and limit",
+			query:      "?since=2025-03-01T09:30:00Z&limit=10",
+			setUserID:  true,
+			svc:        &mockAttendeeService{},
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, svc *mockAttendeeService, data interface{}) {
+				if !svc.lastSince.Equal(since) || svc.lastLimit != 10 {
+					t.Errorf("since/limit: got %v/%d", svc.lastSince, svc.lastLimit)
+				}
+			},
+		},
+		{
+			name:        "invalid since",
+			query:       "?since=yesterday",
+			setUserID:   true,
+			svc:         &mockAttendeeService{},
+			wantStatus:  http.StatusBadRequest,
+			wantErrCode: helpers.ErrCodeBadRequest,
+		},
+		{
+			name:        "invalid limit",
+			query:       "?limit=many",
+			setUserID:   true,
+			svc:         &mockAttendeeService{},
+			wantStatus:  http.StatusBadRequest,
+			wantErrCode: helpers.ErrCodeBadRequest,
+		},
+		{
+			name:        "limit out of range",
+			query:       "?limit=500",
+			setUserID:   true,
+			svc:         &mockAttendeeService{scheduleChangesErr: domain.ErrInvalidInput},
+			wantStatus:  http.StatusBadRequest,
+			wantErrCode: helpers.ErrCodeBadRequest,
+		},
+		{
+			name:        "unauthorized",
+			svc:         &mockAttendeeService{},
+			wantStatus:  http.StatusUnauthorized,
+			wantErrCode: helpers.ErrCodeUnauthorized,
+		},
+		{
+			name:        "forbidden",
+			setUserID:   true,
+			svc:         &mockAttendeeService{scheduleChangesErr: domain.ErrForbidden},
+			wantStatus:  http.StatusForbidden,
+			wantErrCode: helpers.ErrCodeForbidden,
+		},
+		{
+			name:        "event not found",
+			setUserID:   true,
+			svc:         &mockAttendeeService{scheduleChangesErr: domain.ErrNotFound},
+			wantStatus:  http.StatusNotFound,
+			wantErrCode: helpers.ErrCodeEventNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewAttendeeController(logger, tt.svc)
+			req := httptest.NewRequest(http.MethodGet, "/attendee/events/"+eventID+"/schedule/changes"+tt.query, nil)
+			req.SetPathValue("eventID", eventID)
+			if tt.setUserID {
+				req = req.WithContext(middleware.SetUserID(req.Context(), "u1"))
+			}
+			w := httptest.NewRecorder()
+
+			ctrl.ListScheduleChanges(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status: want %d, got %d", tt.wantStatus, w.Code)
+			}
+			var resp helpers.APIResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("unmarshal response: %v", err)
+			}
+			if tt.wantErrCode != "" && (resp.Error == nil || resp.Error.Code != tt.wantErrCode) {
+				t.Errorf("error code: want %q, got %v", tt.wantErrCode, resp.Error)
+			}
+			if tt.check != nil {
+				tt.check(t, tt.svc, resp.Data)
+			}
+		})
+	}
+}
+
+func TestAttendeeController_SyncEvent(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	eventID := "550e8400-e29b-41d4-a716-446655440000"
+
+	tests := []struct {
+		name        string
+		query       string
+		setUserID   bool
+		svc         *mockAttendeeService
+		wantStatus  int
+		wantErrCode string
+		check       func(t *testing.T, svc *mockAttendeeService, data interface{})
+	}{
+		{
+			name:      "first sync",
+			setUserID: true,
+			svc: &mockAttendeeService{syncDelta: &domain.SyncDelta{
+				EventID: eventID, Cursor: "42", HasMore: true,
+				Rooms: domain.SyncRoomChanges{Created: []*domain.Room{{ID: "r1", Name: "Room A"}}, Updated: []*domain.Room{}, Deleted: []string{}},
+			}},
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, svc *mockAttendeeService, data interface{}) {
+				if svc.lastSyncSince != "" || svc.lastLimit != defaultSyncChanges {
+					t.Errorf("since/limit: got %q/%d", svc.lastSyncSince, svc.lastLimit)
+				}
+				delta, ok := data.(map[string]interface{})
+				if !ok || delta["cursor"] != "42" || delta["has_more"] != true {
+					t.Fatalf("unexpected delta: %v", data)
+				}
+				created := delta["rooms"].(map[string]interface{})["created"].([]interface{})
+				if len(created) != 1 || created[0].(map[string]interface{})["name"] != "Room A" {
+					t.Errorf("rooms.created: got %v", created)
+				}
+			},
+		},
+		{
+			name:       "since and limit",
+			query:      "?since=42&limit=10",
+			setUserID:  true,
+			svc:        &mockAttendeeService{syncDelta: &domain.SyncDelta{EventID: eventID, Cursor: "42"}},
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, svc *mockAttendeeService, data interface{}) {
+				if svc.lastSyncSince != "42" || svc.lastLimit != 10 {
+					t.Errorf("since/limit: got %q/%d", svc.lastSyncSince, svc.lastLimit)
+				}
+			},
+		},
+		{
+			name:        "invalid limit",
+			query:       "?limit=many",
+			setUserID:   true,
+			svc:         &mockAttendeeService{},
+			wantStatus:  http.StatusBadRequest,
+			wantErrCode: helpers.ErrCodeBadRequest,
+		},
+		{
+			name:        "invalid cursor",
+			query:       "?since=abc",
+			setUserID:   true,
+			svc:         &mockAttendeeService{syncErr: domain.ErrInvalidInput},
+			wantStatus:  http.StatusBadRequest,
+			wantErrCode: helpers.ErrCodeBadRequest,
+		},
+		{
+			name:        "unauthorized",
+			svc:         &mockAttendeeService{},
+			wantStatus:  http.StatusUnauthorized,
+			wantErrCode: helpers.ErrCodeUnauthorized,
+		},
+		{
+			name:        "forbidden",
+			setUserID:   true,
+			svc:         &mockAttendeeService{syncErr: domain.ErrForbidden},
+			wantStatus:  http.StatusForbidden,
+			wantErrCode: helpers.ErrCodeForbidden,
+		},
+		{
+			name:        "event not found",
+			setUserID:   true,
+			svc:         &mockAttendeeService{syncErr: domain.ErrNotFound},
+			wantStatus:  http.StatusNotFound,
+			wantErrCode: helpers.ErrCodeEventNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewAttendeeController(logger, tt.svc)
+			req := httptest.NewRequest(http.MethodGet, "/events/"+eventID+"/sync"+tt.query, nil)
+			req.SetPathValue("eventID", eventID)
+			if tt.setUserID {
+				req = req.WithContext(middleware.SetUserID(req.Context(), "u1"))
+			}
+			w := httptest.NewRecorder()
+
+			ctrl.SyncEvent(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status: want %d, got %d", tt.wantStatus, w.Code)
+			}
+			var resp helpers.APIResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("unmarshal response: %v", err)
+			}
+			if tt.wantErrCode != "" && (resp.Error == nil || resp.Error.Code != tt.wantErrCode) {
+				t.Errorf("error code: want %q, got %v", tt.wantErrCode, resp.Error)
+			}
+			if tt.check != nil {
+				tt.check(t, tt.svc, resp.Data)
+			}
+		})
+	}
+}
+
+func TestAttendeeController_GetOfflineBundle(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	manifest := &domain.OfflineBundleManifest{EventCode: "ab12", Version: "v7"}
+
+	tests := []struct {
+		name        string
+		code        string
+		ifNoneMatch string
+		svc         *mockAttendeeService
+		wantStatus  int
+		wantErrCode string
+		wantKnown   string
+	}{
+		{
+			name:       "download",
+			code:       "AB12",
+			svc:        &mockAttendeeService{offlineBundle: &domain.OfflineBundle{EventID: "ev-1", Manifest: manifest, Archive: []byte("PK zip")}},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:        "unchanged",
+			code:        "ab12",
+			ifNoneMatch: `W/"v7"`,
+			svc:         &mockAttendeeService{offlineBundle: &domain.OfflineBundle{EventID: "ev-1", Manifest: manifest}},
+			wantStatus:  http.StatusNotModified,
+			wantKnown:   "v7",
+		},
+		{
+			name:        "invalid code",
+			code:        "ab-1",
+			svc:         &mockAttendeeService{},
+			wantStatus:  http.StatusBadRequest,
+			wantErrCode: helpers.ErrCodeBadRequest,
+		},
+		{
+			name:        "event not found",
+			code:        "zz99",
+			svc:         &mockAttendeeService{offlineBundleErr: domain.ErrNotFound},
+			wantStatus:  http.StatusNotFound,
+			wantErrCode: helpers.ErrCodeEventNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewAttendeeController(logger, tt.svc)
+			req := httptest.NewRequest(http.MethodGet, "/public/events/"+tt.code+"/offline-bundle", nil)
+			req.SetPathValue("eventCode", tt.code)
+			if tt.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", tt.ifNoneMatch)
+			}
+			w := httptest.NewRecorder()
+
+			ctrl.GetOfflineBundle(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status: want %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantErrCode != "" {
+				var resp helpers.APIResponse
+				if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+					t.Fatalf("unmarshal response: %v", err)
+				}
+				if resp.Error == nil || resp.Error.Code != tt.wantErrCode {
+					t.Errorf("error code: want %q, got %v", tt.wantErrCode, resp.Error)
+				}
+				return
+			}
+			if tt.svc.lastEventCode != "ab12" || tt.svc.lastKnownVersion != tt.wantKnown {
+				t.Errorf("code/known version: got %q/%q", tt.svc.lastEventCode, tt.svc.lastKnownVersion)
+			}
+			if etag := w.Header().Get("ETag"); etag != `"v7"` {
+				t.Errorf("ETag: got %q", etag)
+			}
+			if key := w.Header().Get("Surrogate-Key"); key != "event-ev-1" {
+				t.Errorf("Surrogate-Key: got %q", key)
+			}
+			if tt.wantStatus == http.StatusOK {
+				if ct := w.Header().Get("Content-Type"); ct != "application/zip" {
+					t.Errorf("Content-Type: got %q", ct)
+				}
+				if w.Body.String() != "PK zip" {
+					t.Errorf("body: got %q", w.Body.String())
+				}
+			} else if w.Body.Len() != 0 {
+				t.Errorf("304 must have no body, got %q", w.Body.String())
+			}
+		})
+	}
+}
+
+func TestAttendeeController_GetPublicEventTheme(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	theme := &domain.EventTheme{EventID: "ev-1", PrimaryColor: "#112233", SupportEmail: "help@conf.example"}
+
+	tests := []struct {
+		name        string
+		code        string
+		svc         *mockAttendeeService
+		wantStatus  int
+		wantErrCode string
+	}{
+		{name: "theme", code: "AB12", svc: &mockAttendeeService{eventTheme: theme}, wantStatus: http.StatusOK},
+		{name: "invalid code", code: "ab-1", svc: &mockAttendeeService{}, wantStatus: http.StatusBadRequest, wantErrCode: helpers.ErrCodeBadRequest},
+		{name: "event not found", code: "zz99", svc: &mockAttendeeService{eventThemeErr: domain.ErrNotFound}, wantStatus: http.StatusNotFound, wantErrCode: helpers.ErrCodeEventNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewAttendeeController(logger, tt.svc)
+			req := httptest.NewRequest(http.MethodGet, "/public/events/"+tt.code+"/theme", nil)
+			req.SetPathValue("eventCode", tt.code)
+			w := httptest.NewRecorder()
+
+			ctrl.GetPublicEventTheme(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status: want %d, got %d", tt.wantStatus, w.Code)
+			}
+			var resp struct {
+				Data  *domain.EventTheme `json:"data"`
+				Error *helpers.APIError  `json:"error"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("unmarshal response: %v", err)
+			}
+			if tt.wantErrCode != "" {
+				if resp.Error == nil || resp.Error.Code != tt.wantErrCode {
+					t.Errorf("error code: want %q, got %v", tt.wantErrCode, resp.Error)
+				}
+				return
+			}
+			if tt.svc.lastEventCode != "ab12" {
+				t.Errorf("event code: got %q", tt.svc.lastEventCode)
+			}
+			if resp.Data == nil || resp.Data.PrimaryColor != "#112233" || resp.Data.SupportEmail != "help@conf.example" {
+				t.Errorf("theme: got %+v", resp.Data)
+			}
+			if key := w.Header().Get("Surrogate-Key"); key != "event-ev-1" {
+				t.Errorf("Surrogate-Key: got %q", key)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+// largeEvent builds an event with rooms and sessions shaped like a big conference import.
+func largeEvent(rooms, sessions int) (*domain.Event, []*domain.Room, []*domain.Session) {
+	start := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	desc := "Annual multi-track conference"
+	event := &domain.Event{ID: "ev-1", Name: "Conf", EventCode: "ab12", OwnerID: "owner-1", CreatedAt: start, UpdatedAt: start, Description: &desc}
+	rs := make([]*domain.Room, rooms)
+	for i := range rs {
+		rs[i] = &domain.Room{ID: fmt.Sprintf("room-%d", i), EventID: event.ID, Name: fmt.Sprintf("Room %d", i), Source: "sessionize", Capacity: 200, CreatedAt: start, UpdatedAt: start}
+	}
+	tags := []*domain.Tag{{ID: "tag-1", Name: "go"}, {ID: "tag-2", Name: "cloud"}, {ID: "tag-3", Name: "ai"}}
+	ss := make([]*domain.Session, sessions)
+	for i := range ss {
+		ss[i] = &domain.Session{
+			ID:          fmt.Sprintf("session-%d", i),
+			RoomID:      rs[i%rooms].ID,
+			Source:      "sessionize",
+			Title:       fmt.Sprintf("Talk number %d about distributed systems", i),
+			Description: "A deep dive into building and operating large systems, with lessons learned in production.",
+			StartTime:   start.Add(time.Duration(i/rooms) * time.Hour),
+			EndTime:     start.Add(time.Duration(i/rooms)*time.Hour + 45*time.Minute),
+			Tags:        tags[:1+i%3],
+			SpeakerIDs:  []string{fmt.Sprintf("speaker-%d", i), fmt.Sprintf("speaker-%d", i+1)},
+			CreatedAt:   start,
+			UpdatedAt:   start,
+		}
+	}
+	return event, rs, ss
+}
+
+func BenchmarkGetEventByID_500Sessions(b *testing.B) {
+	event, rooms, sessions := largeEvent(20, 500)
+	svc := &fakeEventService{eventByID: map[string]struct {
+		event    *domain.Event
+		rooms    []*domain.Room
+		sessions []*domain.Session
+	}{event.ID: {event, rooms, sessions}}}
+	ctrl := NewScheduleController(testLogger, svc)
+	req := httptest.NewRequest(http.MethodGet, "/events/"+event.ID, nil)
+	req.SetPathValue("eventID", event.ID)
+	req = req.WithContext(middleware.SetUserID(req.Context(), "owner-1"))
+
+	b.ReportAllocs()
+	for b.Loop() {
+		rec := httptest.NewRecorder()
+		ctrl.GetEventByID(rec, req)
+		if rec.Code != http.StatusOK {
+			b.Fatalf("status %d", rec.Code)
+		}
+	}
+}
@@ -0,0 +1,282 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+// ContactController serves the public contact form and the team's contact inbox.
+type ContactController struct {
+	Logger  *slog.Logger
+	Service domain.ContactService
+}
+
+func NewContactController(logger *slog.Logger, svc domain.ContactService) *ContactController {
+	return &ContactController{
+		Logger:  logger,
+		Service: svc,
+	}
+}
+
+// ContactOrganizersRequest is the request body for POST /public/events/{eventCode}/contact.
+type ContactOrganizersRequest struct {
+	Name    string `json:"name"`
+	Email   string `json:"email"`
+	Subject string `json:"subject"`
+	Message string `json:"message"`
+}
+
+// Validate implements Validator.
+func (c ContactOrganizersRequest) Validate() []string {
+	var errs []string
+	if strings.TrimSpace(c.Name) == "" {
+		errs = append(errs, "name is required")
+	}
+	if strings.TrimSpace(c.Email) == "" {
+		errs = append(errs, "email is required")
+	}
+	if strings.TrimSpace(c.Subject) == "" {
+		errs = append(errs, "subject is required")
+	}
+	if strings.TrimSpace(c.Message) == "" {
+		errs = append(errs, "message is required")
+	}
+	return errs
+}
+
+// ContactOrganizersResponse is the data payload for POST /public/events/{eventCode}/contact (201).
+type ContactOrganizersResponse struct {
+	ThreadID string `json:"thread_id"`
+	Status   string `json:"status"`
+}
+
+// ContactOrganizersSuccessResponse is the success response envelope for POST /public/events/{eventCode}/contact (201).
+type ContactOrganizersSuccessResponse struct {
+	Data  ContactOrganizersResponse `json:"data"`
+	Error *helpers.APIError         `json:"error"`
+}
+
+// ListContactThreadsResponse is the data payload for GET /events/{eventID}/contact-threads (200).
+type ListContactThreadsResponse struct {
+	Items      []*domain.ContactThread `json:"items"`
+	Pagination helpers.PaginationMeta  `json:"pagination"`
+}
+
+// ListContactThreadsSuccessResponse is the success response envelope for GET /events/{eventID}/contact-threads (200).
+type ListContactThreadsSuccessResponse struct {
+	Data  ListContactThreadsResponse `json:"data"`
+	Error *helpers.APIError          `json:"error"`
+}
+
+// ContactThreadSuccessResponse is the success response envelope for GET /events/{eventID}/contact-threads/{threadID} (200).
+type ContactThreadSuccessResponse struct {
+	Data  domain.ContactThread `json:"data"`
+	Error *helpers.APIError    `json:"error"`
+}
+
+// ReplyToContactThreadRequest is the request body for POST /events/{eventID}/contact-threads/{threadID}/replies.
+type ReplyToContactThreadRequest struct {
+	Body string `json:"body"`
+}
+
+// Validate implements Validator.
+func (c ReplyToContactThreadRequest) Validate() []string {
+	if strings.TrimSpace(c.Body) == "" {
+		return []string{"body is required"}
+	}
+	return nil
+}
+
+// ContactMessageSuccessResponse is the success response envelope for POST /events/{eventID}/contact-threads/{threadID}/replies (201).
+type ContactMessageSuccessResponse struct {
+	Data  domain.ContactMessage `json:"data"`
+	Error *helpers.APIError     `json:"error"`
+}
+
+// ContactOrganizers godoc
+// @Summary Contact an event's organizers
+// @ID ContactOrganizers
+// @Description Sends the event's team a message from the public contact form. The message is stored as a thread and emailed to the owner and every team member; their replies are emailed back to the given address, so neither side's address is shown to the other in the app. Each address and each sender email may start a limited number of threads per window. Requests may be screened for bots (see the README): a filled-in honeypot field gets bot_detected, and where a captcha is required its token goes in captcha_token or the X-Captcha-Token header. Does not require authentication.
+// @Tags attendee
+// @Accept json
+// @Produce json
+// @Param eventCode path string true "Event code (4 characters)"
+// @Param body body controllers.ContactOrganizersRequest true "Message"
+// @Success 201 {object} controllers.ContactOrganizersSuccessResponse "data.status is sent"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request, bot_detected or captcha_failed"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 429 {object} helpers.APIResponse "error.code: rate_limited"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /public/events/{eventCode}/contact [post]
+func (c *ContactController) ContactOrganizers(w http.ResponseWriter, r *http.Request) {
+	eventCode := strings.ToLower(strings.TrimSpace(r.PathValue("eventCode")))
+	if !eventCodeRegex.MatchString(eventCode) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventCode")
+		return
+	}
+	var req ContactOrganizersRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	thread, err := c.Service.ContactOrganizers(r.Context(), &domain.ContactRequest{
+		EventCode: eventCode,
+		Name:      req.Name,
+		Email:     req.Email,
+		Subject:   req.Subject,
+		Message:   req.Message,
+		RemoteIP:  helpers.ClientIP(r),
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		c.writeContactError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusCreated, ContactOrganizersResponse{ThreadID: thread.ID, Status: "sent"})
+}
+
+// ListContactThreads godoc
+// @Summary List an event's contact threads
+// @ID ListContactThreads
+// @Description Returns a paginated list of the threads started from the event's contact form, most recently active first, without their messages. The event owner and team members can list. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Page size (default 20, max 100)"
+// @Success 200 {object} controllers.ListContactThreadsSuccessResponse "data contains items and pagination"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner or team member)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/contact-threads [get]
+func (c *ContactController) ListContactThreads(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if !uuidRegex.MatchString(eventID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID")
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	params := helpers.ParsePagination(r)
+	threads, total, err := c.Service.ListContactThreads(r.Context(), eventID, userID, params)
+	if err != nil {
+		c.writeContactError(w, r, err)
+		return
+	}
+	meta := helpers.NewPaginationMeta(params.Page, params.PageSize, total)
+	helpers.WriteJSONSuccess(w, http.StatusOK, ListContactThreadsResponse{Items: threads, Pagination: meta})
+}
+
+// GetContactThread godoc
+// @Summary Get a contact thread
+// @ID GetContactThread
+// @Description Returns the thread with its messages, oldest first. The event owner and team members can read it. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param threadID path string true "Thread ID (UUID)"
+// @Success 200 {object} controllers.ContactThreadSuccessResponse "data is the thread with its messages"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner or team member)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/contact-threads/{threadID} [get]
+func (c *ContactController) GetContactThread(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	threadID := r.PathValue("threadID")
+	if !uuidRegex.MatchString(eventID) || !uuidRegex.MatchString(threadID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID or threadID")
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	thread, err := c.Service.GetContactThread(r.Context(), eventID, userID, threadID)
+	if err != nil {
+		c.writeContactError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, thread)
+}
+
+// ReplyToContactThread godoc
+// @Summary Reply to a contact thread
+// @ID ReplyToContactThread
+// @Description Adds the team's reply to the thread and emails it to the sender. The email names the event, not the team member. A failed send does not fail the reply; it is in the event's sent emails to resend. The event owner and team members can reply. Requires authentication.
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param threadID path string true "Thread ID (UUID)"
+// @Param body body controllers.ReplyToContactThreadRequest true "Reply"
+// @Success 201 {object} controllers.ContactMessageSuccessResponse "data is the reply"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner or team member)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/contact-threads/{threadID}/replies [post]
+func (c *ContactController) ReplyToContactThread(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	threadID := r.PathValue("threadID")
+	if !uuidRegex.MatchString(eventID) || !uuidRegex.MatchString(threadID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID or threadID")
+		return
+	}
+	var req ReplyToContactThreadRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	reply, err := c.Service.ReplyToContactThread(r.Context(), eventID, userID, threadID, req.Body)
+	if err != nil {
+		c.writeContactError(w, r, err)
+		return
+	}
+	c.Logger.InfoContext(r.Context(), "contact thread replied", "audit", "contact_thread.reply",
+		"event_id", eventID, "user_id", userID, "thread_id", threadID)
+	helpers.WriteJSONSuccess(w, http.StatusCreated, reply)
+}
+
+func (c *ContactController) writeContactError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, domain.ErrNotFound) {
+		helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event or thread not found")
+		return
+	}
+	if errors.Is(err, domain.ErrForbidden) {
+		helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+		return
+	}
+	if errors.Is(err, domain.ErrInvalidInput) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
+		return
+	}
+	if errors.Is(err, domain.ErrRateLimited) {
+		helpers.WriteJSONError(w, http.StatusTooManyRequests, helpers.ErrCodeRateLimited, "too many messages; try again later")
+		return
+	}
+	helpers.WriteServiceError(w, r, c.Logger, err)
+}
@@ -0,0 +1,120 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+type mockContactService struct {
+	err     error
+	lastReq *domain.ContactRequest
+}
+
+func (m *mockContactService) ContactOrganizers(ctx context.Context, req *domain.ContactRequest) (*domain.ContactThread, error) {
+	m.lastReq = req
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.ContactThread{ID: "th-1"}, nil
+}
+
+func (m *mockContactService) ListContactThreads(ctx context.Context, eventID, userID string, params domain.PaginationParams) ([]*domain.ContactThread, int, error) {
+	if m.err != nil {
+		return nil, 0, m.err
+	}
+	return []*domain.ContactThread{}, 0, nil
+}
+
+func (m *mockContactService) GetContactThread(ctx context.Context, eventID, userID, threadID string) (*domain.ContactThread, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.ContactThread{ID: threadID, EventID: eventID}, nil
+}
+
+func (m *mockContactService) ReplyToContactThread(ctx context.Context, eventID, userID, threadID, body string) (*domain.ContactMessage, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.ContactMessage{ThreadID: threadID, Author: domain.ContactAuthorTeam, UserID: userID, Body: body}, nil
+}
+
+func TestContactController_ContactOrganizers(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	const body = `{"name":"Ada","email":"ada@example.com","subject":"Parking","message":"Is there parking?"}`
+
+	tests := []struct {
+		name       string
+		code       string
+		body       string
+		err        error
+		wantStatus int
+	}{
+		{name: "sent", code: "gc25", body: body, wantStatus: http.StatusCreated},
+		{name: "invalid event code", code: "gc-25", body: body, wantStatus: http.StatusBadRequest},
+		{name: "missing message", code: "gc25", body: `{"name":"Ada","email":"ada@example.com","subject":"Parking"}`, wantStatus: http.StatusBadRequest},
+		{name: "unknown event", code: "gc25", body: body, err: domain.ErrNotFound, wantStatus: http.StatusNotFound},
+		{name: "rate limited", code: "gc25", body: body, err: domain.ErrRateLimited, wantStatus: http.StatusTooManyRequests},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &mockContactService{err: tt.err}
+			ctrl := NewContactController(logger, svc)
+			mux := http.NewServeMux()
+			mux.HandleFunc("POST /public/events/{eventCode}/contact", ctrl.ContactOrganizers)
+
+			req := httptest.NewRequest(http.MethodPost, "/public/events/"+tt.code+"/contact", bytes.NewBufferString(tt.body))
+			req.RemoteAddr = "203.0.113.7:1234"
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if tt.wantStatus == http.StatusCreated && svc.lastReq.RemoteIP != "203.0.113.7" {
+				t.Fatalf("unexpected request: %+v", svc.lastReq)
+			}
+		})
+	}
+}
+
+func TestContactController_ReplyToContactThread(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	const path = "/events/11111111-1111-1111-1111-111111111111/contact-threads/22222222-2222-2222-2222-222222222222/replies"
+
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantStatus int
+	}{
+		{name: "replied", body: `{"body":"Yes, level -1."}`, wantStatus: http.StatusCreated},
+		{name: "empty body", body: `{"body":" "}`, wantStatus: http.StatusBadRequest},
+		{name: "not on the team", body: `{"body":"Yes"}`, err: domain.ErrForbidden, wantStatus: http.StatusForbidden},
+		{name: "thread of another event", body: `{"body":"Yes"}`, err: domain.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewContactController(logger, &mockContactService{err: tt.err})
+			mux := http.NewServeMux()
+			mux.HandleFunc("POST /events/{eventID}/contact-threads/{threadID}/replies", ctrl.ReplyToContactThread)
+
+			req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(tt.body))
+			req = req.WithContext(middleware.SetUserID(req.Context(), "u1"))
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+		})
+	}
+}
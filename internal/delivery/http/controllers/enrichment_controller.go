@@ -0,0 +1,167 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+// EnrichmentController serves tag and summary suggestions for sessions and applies the ones the
+// event owner approves.
+type EnrichmentController struct {
+	Logger  *slog.Logger
+	Service domain.EnrichmentService
+}
+
+func NewEnrichmentController(logger *slog.Logger, svc domain.EnrichmentService) *EnrichmentController {
+	return &EnrichmentController{
+		Logger:  logger,
+		Service: svc,
+	}
+}
+
+// SessionSuggestionSuccessResponse is the success response envelope for GET /events/{eventID}/sessions/{sessionID}/suggestions (200).
+type SessionSuggestionSuccessResponse struct {
+	Data  domain.SessionSuggestion `json:"data"`
+	Error *helpers.APIError        `json:"error"`
+}
+
+// ApplySessionSuggestionRequest is the request body for POST /events/{eventID}/sessions/{sessionID}/suggestions/apply.
+// Send the suggested tags and summary the owner approved, edited as they like.
+type ApplySessionSuggestionRequest struct {
+	// Tags are tag names to add to the event and the session.
+	Tags []string `json:"tags"`
+	// Summary becomes the session's description; only allowed while it has none.
+	Summary string `json:"summary"`
+}
+
+// Validate implements Validator.
+func (c ApplySessionSuggestionRequest) Validate() []string {
+	var errs []string
+	if len(c.Tags) == 0 && strings.TrimSpace(c.Summary) == "" {
+		errs = append(errs, "tags or summary is required")
+	}
+	if len(c.Tags) > domain.MaxSuggestedTags {
+		errs = append(errs, fmt.Sprintf("at most %d tags", domain.MaxSuggestedTags))
+	}
+	for _, tag := range c.Tags {
+		if strings.TrimSpace(tag) == "" {
+			errs = append(errs, "tags must not be empty")
+			break
+		}
+	}
+	if utf8.RuneCountInString(strings.TrimSpace(c.Summary)) > domain.MaxSuggestedSummaryLength {
+		errs = append(errs, fmt.Sprintf("summary must be at most %d characters", domain.MaxSuggestedSummaryLength))
+	}
+	return errs
+}
+
+// SuggestSessionEnrichment godoc
+// @Summary Suggest tags and a summary for a session
+// @ID SuggestSessionEnrichment
+// @Description Asks the server's language model provider for tags when the session has none, and for a short summary when it has no description. Existing event tags are preferred; suggested tags the event already has carry their tag_id. Nothing is saved: show the suggestions to the owner and send the approved ones to POST /events/{eventID}/sessions/{sessionID}/suggestions/apply. A session with tags and a description gets empty suggestions. Only the event owner can ask. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param sessionID path string true "Session ID (UUID)"
+// @Success 200 {object} controllers.SessionSuggestionSuccessResponse "data is the suggestion"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Failure 503 {object} helpers.APIResponse "error.code: enrichment_unavailable"
+// @Router /events/{eventID}/sessions/{sessionID}/suggestions [get]
+func (c *EnrichmentController) SuggestSessionEnrichment(w http.ResponseWriter, r *http.Request) {
+	eventID, sessionID := r.PathValue("eventID"), r.PathValue("sessionID")
+	if !uuidRegex.MatchString(eventID) || !uuidRegex.MatchString(sessionID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID or sessionID")
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+
+	suggestion, err := c.Service.SuggestSessionEnrichment(r.Context(), eventID, sessionID, ownerID)
+	if err != nil {
+		c.writeEnrichmentError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, suggestion)
+}
+
+// ApplySessionSuggestion godoc
+// @Summary Apply approved suggestions to a session
+// @ID ApplySessionSuggestion
+// @Description Saves the tags and summary the owner approved: the tags are added to the event and the session, and the summary becomes the session's description. A summary is refused once the session has a description, so it is never overwritten. Only the event owner can apply. Requires authentication.
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param sessionID path string true "Session ID (UUID)"
+// @Param body body controllers.ApplySessionSuggestionRequest true "Approved tags and summary"
+// @Success 200 {object} controllers.UpdateSessionContentSuccessResponse "data is the updated session"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request (including a summary for a session that has a description)"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/sessions/{sessionID}/suggestions/apply [post]
+func (c *EnrichmentController) ApplySessionSuggestion(w http.ResponseWriter, r *http.Request) {
+	eventID, sessionID := r.PathValue("eventID"), r.PathValue("sessionID")
+	if !uuidRegex.MatchString(eventID) || !uuidRegex.MatchString(sessionID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID or sessionID")
+		return
+	}
+	var req ApplySessionSuggestionRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+
+	session, err := c.Service.ApplySessionEnrichment(r.Context(), eventID, sessionID, ownerID, req.Tags, req.Summary)
+	if err != nil {
+		c.writeEnrichmentError(w, r, err)
+		return
+	}
+	c.Logger.InfoContext(r.Context(), "session suggestion applied", "audit", "session.suggestion_apply",
+		"event_id", eventID, "session_id", sessionID, "user_id", ownerID, "tags", len(req.Tags), "summary", req.Summary != "")
+	helpers.WriteJSONSuccess(w, http.StatusOK, session)
+}
+
+// writeEnrichmentError writes the error response for a failed suggestion request or apply.
+func (c *EnrichmentController) writeEnrichmentError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, domain.ErrEnrichmentUnavailable) {
+		c.Logger.WarnContext(r.Context(), "session enrichment unavailable", "path", r.URL.Path, "method", r.Method, "err", err)
+		helpers.WriteJSONError(w, http.StatusServiceUnavailable, helpers.ErrCodeEnrichmentUnavailable, "suggestions are unavailable, please try again later")
+		return
+	}
+	if errors.Is(err, domain.ErrNotFound) {
+		helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event or session not found")
+		return
+	}
+	if errors.Is(err, domain.ErrForbidden) {
+		helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+		return
+	}
+	if errors.Is(err, domain.ErrInvalidInput) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
+		return
+	}
+	helpers.WriteServiceError(w, r, c.Logger, err)
+}
@@ -0,0 +1,102 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+type mockEnrichmentService struct {
+	err error
+}
+
+func (m *mockEnrichmentService) SuggestSessionEnrichment(ctx context.Context, eventID, sessionID, ownerID string) (*domain.SessionSuggestion, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.SessionSuggestion{SessionID: sessionID, Tags: []*domain.SuggestedTag{{Name: "Go"}}, Summary: "About Go."}, nil
+}
+
+func (m *mockEnrichmentService) ApplySessionEnrichment(ctx context.Context, eventID, sessionID, ownerID string, tags []string, summary string) (*domain.Session, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.Session{ID: sessionID, EventID: eventID, Description: summary}, nil
+}
+
+func TestEnrichmentController_SuggestSessionEnrichment(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	const path = "/events/11111111-1111-1111-1111-111111111111/sessions/22222222-2222-2222-2222-222222222222/suggestions"
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "suggested", wantStatus: http.StatusOK},
+		{name: "no provider", err: domain.ErrEnrichmentUnavailable, wantStatus: http.StatusServiceUnavailable},
+		{name: "provider failed", err: fmt.Errorf("enrich session: timeout: %w", domain.ErrEnrichmentUnavailable), wantStatus: http.StatusServiceUnavailable},
+		{name: "not owner", err: domain.ErrForbidden, wantStatus: http.StatusForbidden},
+		{name: "unknown session", err: domain.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewEnrichmentController(logger, &mockEnrichmentService{err: tt.err})
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /events/{eventID}/sessions/{sessionID}/suggestions", ctrl.SuggestSessionEnrichment)
+
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			req = req.WithContext(middleware.SetUserID(req.Context(), "owner-1"))
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestEnrichmentController_ApplySessionSuggestion(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	const path = "/events/11111111-1111-1111-1111-111111111111/sessions/22222222-2222-2222-2222-222222222222/suggestions/apply"
+
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantStatus int
+	}{
+		{name: "tags and summary", body: `{"tags":["Go","Generics"],"summary":"About Go."}`, wantStatus: http.StatusOK},
+		{name: "tags only", body: `{"tags":["Go"]}`, wantStatus: http.StatusOK},
+		{name: "nothing to apply", body: `{}`, wantStatus: http.StatusBadRequest},
+		{name: "empty tag", body: `{"tags":[" "]}`, wantStatus: http.StatusBadRequest},
+		{name: "too many tags", body: `{"tags":["a","b","c","d","e","f"]}`, wantStatus: http.StatusBadRequest},
+		{name: "has a description", body: `{"summary":"About Go."}`, err: fmt.Errorf("session already has a description: %w", domain.ErrInvalidInput), wantStatus: http.StatusBadRequest},
+		{name: "not owner", body: `{"tags":["Go"]}`, err: domain.ErrForbidden, wantStatus: http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewEnrichmentController(logger, &mockEnrichmentService{err: tt.err})
+			mux := http.NewServeMux()
+			mux.HandleFunc("POST /events/{eventID}/sessions/{sessionID}/suggestions/apply", ctrl.ApplySessionSuggestion)
+
+			req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(tt.body))
+			req = req.WithContext(middleware.SetUserID(req.Context(), "owner-1"))
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,6 +20,16 @@ var uuidRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4
 // emailRegex matches a simple email format (local@domain with at least one dot in domain).
 var emailRegex = regexp.MustCompile(`^[^@]+@[^@]+\.[^@]+$`)
 
+// emailDomainRegex matches a lowercase domain name with at least one dot (e.g. acme.com).
+var emailDomainRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)+$`)
+
+// hexColorRegex matches a hex color as #RRGGBB or #RRGGBBAA.
+var hexColorRegex = regexp.MustCompile(`^#([0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
+
+// fontFamilyRegex matches a font family name. Quotes, commas and semicolons are left out so apps can
+// put it in CSS as is.
+var fontFamilyRegex = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9 _-]{0,99}$`)
+
 // CreateEventRequest is the request body for POST /events. Only name is accepted.
 type CreateEventRequest struct {
 	Name string `json:"name"`
@@ -53,10 +64,11 @@ func NewScheduleController(logger *slog.Logger, svc domain.EventService) *Schedu
 
 // CreateEvent godoc
 // @Summary Create a new event
+// @ID CreateEvent
 // @Description Create a new conference event. Only name is accepted in the body; id, event_code and timestamps are server-generated. The authenticated user becomes the event owner.
 // @Tags events
 // @Accept json
-// @Produce json
+// @Produce json,application/vnd.api+json,application/vnd.m3t+json
 // @Security BearerAuth
 // @Param event body CreateEventRequest true "Event data (name only)"
 // @Success 201 {object} controllers.CreateEventSuccessResponse "data contains the created event"
@@ -77,11 +89,10 @@ func (c *ScheduleController) CreateEvent(w http.ResponseWriter, r *http.Request)
 	now := time.Now()
 	event := domain.NewEvent(req.Name, "", userID, now, now)
 	if err := c.Service.CreateEvent(r.Context(), event); err != nil {
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		helpers.WriteServiceError(w, r, c.Logger, err)
 		return
 	}
-	helpers.WriteJSONSuccess(w, http.StatusCreated, event)
+	helpers.WriteJSONSuccessWithLinks(w, r, http.StatusCreated, event, helpers.EventLinks(event.ID))
 }
 
 // GetEventByIDResponse is the response body for GET /events/{eventID}. Contains the event, its rooms, and sessions.
@@ -99,14 +110,15 @@ type GetEventByIDSuccessResponse struct {
 
 // GetEventByID godoc
 // @Summary Get an event by ID
-// @Description Returns the event, its rooms, and all sessions for that event. Requires authentication.
+// @ID GetEventByID
+// @Description Returns the event, its rooms, and all sessions for that event. The event includes its operating hours, the open and close times of each event day. Requires authentication.
 // @Tags events
-// @Produce json
+// @Produce json,application/vnd.api+json,application/vnd.m3t+json
 // @Security BearerAuth
 // @Param eventID path string true "Event ID (UUID)"
 // @Success 200 {object} controllers.GetEventByIDSuccessResponse "data contains event, rooms, and sessions"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
-// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
 // @Router /events/{eventID} [get]
 func (c *ScheduleController) GetEventByID(w http.ResponseWriter, r *http.Request) {
@@ -123,14 +135,13 @@ func (c *ScheduleController) GetEventByID(w http.ResponseWriter, r *http.Request
 	event, rooms, sessions, err := c.Service.GetEventByID(r.Context(), eventID)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event not found")
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
 			return
 		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		helpers.WriteServiceError(w, r, c.Logger, err)
 		return
 	}
-	helpers.WriteJSONSuccess(w, http.StatusOK, GetEventByIDResponse{Event: event, Rooms: rooms, Sessions: sessions})
+	helpers.WriteJSONSuccessWithLinks(w, r, http.StatusOK, GetEventByIDResponse{Event: event, Rooms: rooms, Sessions: sessions}, helpers.EventLinks(eventID))
 }
 
 // UpdateEventRequest is the request body for PATCH /events/{eventID}. All fields optional; omitted fields are unchanged.
@@ -161,10 +172,11 @@ type UpdateEventSuccessResponse struct {
 
 // UpdateEvent godoc
 // @Summary Update event details
+// @ID UpdateEvent
 // @Description Updates event date, description, and location (lat/lng). Only the event owner can update. Optional fields omitted from body are unchanged. Requires authentication.
 // @Tags events
 // @Accept json
-// @Produce json
+// @Produce json,application/vnd.api+json,application/vnd.m3t+json
 // @Security BearerAuth
 // @Param eventID path string true "Event ID (UUID)"
 // @Param body body UpdateEventRequest true "Fields to update (all optional)"
@@ -172,7 +184,7 @@ type UpdateEventSuccessResponse struct {
 // @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
 // @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
-// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
 // @Router /events/{eventID} [patch]
 func (c *ScheduleController) UpdateEvent(w http.ResponseWriter, r *http.Request) {
@@ -193,18 +205,69 @@ func (c *ScheduleController) UpdateEvent(w http.ResponseWriter, r *http.Request)
 	event, err := c.Service.UpdateEvent(r.Context(), eventID, ownerID, req.Date, req.Description, req.LocationLat, req.LocationLng)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event not found")
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
 			return
 		}
 		if errors.Is(err, domain.ErrForbidden) {
 			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
 			return
 		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	helpers.WriteJSONSuccessWithLinks(w, r, http.StatusOK, event, helpers.EventLinks(eventID))
+}
+
+// EventCodeChangeSuccessResponse is the success response envelope for POST /events/{eventID}/regenerate-code (200).
+type EventCodeChangeSuccessResponse struct {
+	Data  *domain.EventCodeChange `json:"data"`
+	Error *helpers.APIError       `json:"error"`
+}
+
+// RegenerateEventCode godoc
+// @Summary Replace the event code
+// @ID RegenerateEventCode
+// @Description Gives the event a new random event_code in the configured format, for when the old one has leaked. The old code stops working at once: registering, the public schedule and the public event page only answer to the new one. Invitees who have not registered yet are sent their invitation again with the new code; addresses the email could not be sent to are listed in invitations_failed. Attendees already registered are not affected. Only the event owner can regenerate the code. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Success 200 {object} controllers.EventCodeChangeSuccessResponse "data contains the updated event, the previous code and how the invitations were resent"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 409 {object} helpers.APIResponse "error.code: conflict (no unused code was found; retry)"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/regenerate-code [post]
+func (c *ScheduleController) RegenerateEventCode(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	change, err := c.Service.RegenerateEventCode(r.Context(), eventID, ownerID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
 		return
 	}
-	helpers.WriteJSONSuccess(w, http.StatusOK, event)
+	c.Logger.InfoContext(r.Context(), "event code regenerated", "audit", "event.regenerate_code",
+		"event_id", eventID, "user_id", ownerID, "invitations_resent", change.InvitationsResent,
+		"invitations_failed", len(change.InvitationsFailed))
+	helpers.WriteJSONSuccess(w, http.StatusOK, change)
 }
 
 // ImportSessionizeResponse is the data payload for POST /events/{eventID}/import/sessionize/{sessionizeID} (200).
@@ -220,15 +283,21 @@ type ImportSessionizeSuccessResponse struct {
 
 // ImportSessionize godoc
 // @Summary Import schedule from Sessionize
-// @Description Import rooms and sessions from Sessionize for a specific event
+// @ID ImportSessionize
+// @Description Import rooms and sessions from Sessionize for a specific event. Rooms, sessions and speakers the import no longer contains are deleted. With dry_run=true nothing is changed: data is a SessionizeImportPreview (event_id, dry_run, and rooms, sessions and speakers each listing {id, name, action} with action create, update or delete); only the event owner can preview.
 // @Tags events
 // @Security BearerAuth
 // @Param eventID path string true "Event ID"
 // @Param sessionizeID path string true "Sessionize ID"
-// @Success 200 {object} controllers.ImportSessionizeSuccessResponse "data contains status message"
+// @Param force_refresh query bool false "Download the full schedule even if Sessionize reports it unchanged since the last import"
+// @Param dry_run query bool false "List what the import would change without changing anything"
+// @Success 200 {object} controllers.ImportSessionizeSuccessResponse "data contains status message, or in a dry run the import preview"
 // @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (dry run by someone other than the owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found (dry run)"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Failure 503 {object} helpers.APIResponse "error.code: import_unavailable (Sessionize is failing; retry later)"
 // @Router /events/{eventID}/import/sessionize/{sessionizeID} [post]
 func (c *ScheduleController) ImportSessionize(w http.ResponseWriter, r *http.Request) {
 	eventID := r.PathValue("eventID")
@@ -239,350 +308,532 @@ func (c *ScheduleController) ImportSessionize(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	if err := c.Service.ImportSessionizeData(r.Context(), eventID, sessionizeID); err != nil {
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+	forceRefresh := false
+	if s := r.URL.Query().Get("force_refresh"); s != "" {
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "force_refresh must be true or false")
+			return
+		}
+		forceRefresh = v
+	}
+	dryRun, ok := helpers.ParseDryRun(w, r)
+	if !ok {
+		return
+	}
+
+	if dryRun {
+		ownerID, ok := middleware.UserIDFromContext(r.Context())
+		if !ok {
+			helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+			return
+		}
+		preview, err := c.Service.PreviewSessionizeImport(r.Context(), eventID, ownerID, sessionizeID, forceRefresh)
+		if err != nil {
+			c.writeImportError(w, r, err)
+			return
+		}
+		helpers.WriteJSONSuccess(w, http.StatusOK, preview)
+		return
+	}
+	if err := c.Service.ImportSessionizeData(r.Context(), eventID, sessionizeID, forceRefresh); err != nil {
+		c.writeImportError(w, r, err)
 		return
 	}
 
 	helpers.WriteJSONSuccess(w, http.StatusOK, ImportSessionizeResponse{Status: "imported successfully"})
 }
 
-// ListMyEventsSuccessResponse is the success response envelope for GET /events/me (200).
-type ListMyEventsSuccessResponse struct {
-	Data  []*domain.Event   `json:"data"`
-	Error *helpers.APIError `json:"error"`
+// writeImportError writes the error response for a failed Sessionize import or import preview.
+func (c *ScheduleController) writeImportError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, domain.ErrProviderUnavailable) {
+		c.Logger.WarnContext(r.Context(), "sessionize unavailable", "path", r.URL.Path, "method", r.Method, "err", err)
+		helpers.WriteJSONError(w, http.StatusServiceUnavailable, helpers.ErrCodeImportUnavailable, "import temporarily unavailable, please try again in a few minutes")
+		return
+	}
+	if errors.Is(err, domain.ErrNotFound) {
+		helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+		return
+	}
+	if errors.Is(err, domain.ErrForbidden) {
+		helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+		return
+	}
+	helpers.WriteServiceError(w, r, c.Logger, err)
 }
 
-// DeleteEventResponse is the data payload for DELETE /events/{eventID} (200).
-type DeleteEventResponse struct {
-	Status string `json:"status"`
+// ImportMappingSuccessResponse is the success response envelope for GET and PUT /events/{eventID}/import/mapping (200).
+type ImportMappingSuccessResponse struct {
+	Data  *domain.ImportMapping `json:"data"`
+	Error *helpers.APIError     `json:"error"`
 }
 
-// DeleteEventSuccessResponse is the success response envelope for DELETE /events/{eventID} (200).
-type DeleteEventSuccessResponse struct {
-	Data  DeleteEventResponse `json:"data"`
-	Error *helpers.APIError   `json:"error"`
+// UpdateImportMappingRequest is the request body for PUT /events/{eventID}/import/mapping.
+// Category titles are matched case-insensitively against the Sessionize categories.
+type UpdateImportMappingRequest struct {
+	TagCategories       []string          `json:"tag_categories"`
+	SessionTypeCategory string            `json:"session_type_category"`
+	TrackCategory       string            `json:"track_category"`
+	RoomNameOverrides   map[string]string `json:"room_name_overrides"`
+}
+
+// Validate implements Validator.
+func (u UpdateImportMappingRequest) Validate() []string {
+	var errs []string
+	for _, c := range u.TagCategories {
+		if strings.TrimSpace(c) == "" || len(c) > 255 {
+			errs = append(errs, "tag_categories entries must be 1-255 characters")
+			break
+		}
+	}
+	if len(u.SessionTypeCategory) > 255 {
+		errs = append(errs, "session_type_category must be at most 255 characters")
+	}
+	if len(u.TrackCategory) > 255 {
+		errs = append(errs, "track_category must be at most 255 characters")
+	}
+	if t := strings.TrimSpace(u.SessionTypeCategory); t != "" && strings.EqualFold(t, strings.TrimSpace(u.TrackCategory)) {
+		errs = append(errs, "session_type_category and track_category must differ")
+	}
+	for from, to := range u.RoomNameOverrides {
+		if from == "" || strings.TrimSpace(to) == "" || len(to) > 255 {
+			errs = append(errs, "room_name_overrides must map room names to non-empty names of at most 255 characters")
+			break
+		}
+	}
+	return errs
 }
 
-// DeleteEvent godoc
-// @Summary Delete an event
-// @Description Delete an event and all its associated data (rooms, sessions). Only the event owner can delete. Requires authentication.
+// GetImportMapping godoc
+// @Summary Get the event's import mapping
+// @ID GetImportMapping
+// @Description Returns how Sessionize categories map to tags, session type and track, and which rooms are renamed on import. Events without a saved mapping return the defaults (every category becomes a tag). Only the event owner can read it. Requires authentication.
 // @Tags events
 // @Produce json
 // @Security BearerAuth
 // @Param eventID path string true "Event ID (UUID)"
-// @Success 200 {object} controllers.DeleteEventSuccessResponse "data contains status"
+// @Success 200 {object} controllers.ImportMappingSuccessResponse "data contains the import mapping"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
 // @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
-// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
-// @Router /events/{eventID} [delete]
-func (c *ScheduleController) DeleteEvent(w http.ResponseWriter, r *http.Request) {
+// @Router /events/{eventID}/import/mapping [get]
+func (c *ScheduleController) GetImportMapping(w http.ResponseWriter, r *http.Request) {
 	eventID := r.PathValue("eventID")
 	if eventID == "" {
 		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
 		return
 	}
-	userID, ok := middleware.UserIDFromContext(r.Context())
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
 	if !ok {
 		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
 		return
 	}
-	if err := c.Service.DeleteEvent(r.Context(), eventID, userID); err != nil {
-		if errors.Is(err, domain.ErrNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event not found")
-			return
-		}
-		if errors.Is(err, domain.ErrForbidden) {
-			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
-			return
-		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+	mapping, err := c.Service.GetImportMapping(r.Context(), eventID, ownerID)
+	if err != nil {
+		c.writeImportMappingError(w, r, err)
 		return
 	}
-	helpers.WriteJSONSuccess(w, http.StatusOK, DeleteEventResponse{Status: "deleted"})
+	helpers.WriteJSONSuccess(w, http.StatusOK, mapping)
 }
 
-// ToggleRoomNotBookableSuccessResponse is the success response envelope for PATCH /events/{eventID}/rooms/{roomID}/not-bookable (200).
-type ToggleRoomNotBookableSuccessResponse struct {
-	Data  *domain.Room      `json:"data"`
-	Error *helpers.APIError `json:"error"`
+// UpdateImportMapping godoc
+// @Summary Replace the event's import mapping
+// @ID UpdateImportMapping
+// @Description Replaces the import mapping applied by the next Sessionize import. Empty tag_categories means every category not used for session type or track becomes a tag. Only the event owner can update. Requires authentication.
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param body body UpdateImportMappingRequest true "Import mapping"
+// @Success 200 {object} controllers.ImportMappingSuccessResponse "data contains the saved import mapping"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/import/mapping [put]
+func (c *ScheduleController) UpdateImportMapping(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
+		return
+	}
+	var req UpdateImportMappingRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	mapping, err := c.Service.UpdateImportMapping(r.Context(), eventID, ownerID, &domain.ImportMapping{
+		TagCategories:       req.TagCategories,
+		SessionTypeCategory: strings.TrimSpace(req.SessionTypeCategory),
+		TrackCategory:       strings.TrimSpace(req.TrackCategory),
+		RoomNameOverrides:   req.RoomNameOverrides,
+	})
+	if err != nil {
+		c.writeImportMappingError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, mapping)
 }
 
-// CreateRoomRequest is the request body for POST /events/{eventID}/rooms.
-type CreateRoomRequest struct {
-	Name          string `json:"name"`
-	Capacity      int    `json:"capacity"`
-	Description   string `json:"description"`
-	HowToGetThere string `json:"how_to_get_there"`
-	NotBookable   bool   `json:"not_bookable"`
+func (c *ScheduleController) writeImportMappingError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, domain.ErrNotFound) {
+		helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+		return
+	}
+	if errors.Is(err, domain.ErrForbidden) {
+		helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+		return
+	}
+	helpers.WriteServiceError(w, r, c.Logger, err)
+}
+
+// ScheduleRulesSuccessResponse is the success response envelope for GET and PUT /events/{eventID}/schedule-rules (200).
+type ScheduleRulesSuccessResponse struct {
+	Data  *domain.ScheduleRules `json:"data"`
+	Error *helpers.APIError     `json:"error"`
+}
+
+// UpdateScheduleRulesRequest is the request body for PUT /events/{eventID}/schedule-rules.
+// Empty fields impose no limit.
+type UpdateScheduleRulesRequest struct {
+	AllowedDurations []int    `json:"allowed_durations"`
+	EarliestStart    string   `json:"earliest_start"`
+	LatestEnd        string   `json:"latest_end"`
+	AllowedDays      []string `json:"allowed_days"`
+	Timezone         string   `json:"timezone"`
 }
 
 // Validate implements Validator.
-func (c CreateRoomRequest) Validate() []string {
+func (u UpdateScheduleRulesRequest) Validate() []string {
 	var errs []string
-	if strings.TrimSpace(c.Name) == "" {
-		errs = append(errs, "name is required")
+	for _, d := range u.AllowedDurations {
+		if d < 1 || d > 24*60 {
+			errs = append(errs, "allowed_durations entries must be between 1 and 1440 minutes")
+			break
+		}
 	}
-	if c.Capacity < 0 {
-		errs = append(errs, "capacity must be non-negative")
+	earliest, earliestErr := time.Parse("15:04", u.EarliestStart)
+	if u.EarliestStart != "" && earliestErr != nil {
+		errs = append(errs, "earliest_start must be a time of day as HH:MM")
+	}
+	latest, latestErr := time.Parse("15:04", u.LatestEnd)
+	if u.LatestEnd != "" && latestErr != nil {
+		errs = append(errs, "latest_end must be a time of day as HH:MM")
+	}
+	if earliestErr == nil && latestErr == nil && !latest.After(earliest) {
+		errs = append(errs, "latest_end must be after earliest_start")
+	}
+	for _, day := range u.AllowedDays {
+		if _, err := time.Parse(time.DateOnly, day); err != nil {
+			errs = append(errs, "allowed_days entries must be dates as YYYY-MM-DD")
+			break
+		}
+	}
+	if u.Timezone != "" {
+		if _, err := time.LoadLocation(u.Timezone); err != nil {
+			errs = append(errs, "timezone must be an IANA time zone such as Europe/Madrid")
+		}
 	}
 	return errs
 }
 
-// ToggleRoomNotBookable godoc
-// @Summary Toggle room not_bookable flag
-// @Description Toggles the not_bookable flag for a room. Only the event owner can toggle. Requires authentication.
+// GetScheduleRules godoc
+// @Summary Get the event's schedule rules
+// @ID GetScheduleRules
+// @Description Returns the rules new and moved sessions are checked against: allowed durations, earliest start and latest end time of day, and allowed days, read in the rules' time zone. Events without saved rules return empty rules, which allow any time slot. Only the event owner can read them. Requires authentication.
 // @Tags events
 // @Produce json
 // @Security BearerAuth
 // @Param eventID path string true "Event ID (UUID)"
-// @Param roomID path string true "Room ID (UUID)"
-// @Success 200 {object} controllers.ToggleRoomNotBookableSuccessResponse "data contains the updated room"
+// @Success 200 {object} controllers.ScheduleRulesSuccessResponse "data contains the schedule rules"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
 // @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
-// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
-// @Router /events/{eventID}/rooms/{roomID}/not-bookable [patch]
-func (c *ScheduleController) ToggleRoomNotBookable(w http.ResponseWriter, r *http.Request) {
+// @Router /events/{eventID}/schedule-rules [get]
+func (c *ScheduleController) GetScheduleRules(w http.ResponseWriter, r *http.Request) {
 	eventID := r.PathValue("eventID")
-	roomID := r.PathValue("roomID")
-	if eventID == "" || roomID == "" {
-		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or roomID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
 		return
 	}
-	userID, ok := middleware.UserIDFromContext(r.Context())
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
 	if !ok {
 		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
 		return
 	}
-	room, err := c.Service.ToggleRoomNotBookable(r.Context(), eventID, roomID, userID)
+	rules, err := c.Service.GetScheduleRules(r.Context(), eventID, ownerID)
 	if err != nil {
-		if errors.Is(err, domain.ErrNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event or room not found")
-			return
-		}
-		if errors.Is(err, domain.ErrForbidden) {
-			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
-			return
-		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		c.writeScheduleRulesError(w, r, err)
 		return
 	}
-	helpers.WriteJSONSuccess(w, http.StatusOK, room)
+	helpers.WriteJSONSuccess(w, http.StatusOK, rules)
 }
 
-// CreateEventRoom godoc
-// @Summary Create a room
-// @Description Creates a new room for the event. Only the event owner can create. Requires authentication.
+// UpdateScheduleRules godoc
+// @Summary Replace the event's schedule rules
+// @ID UpdateScheduleRules
+// @Description Replaces the rules sessions are checked against when they are created or moved to a new time slot; existing sessions are not rechecked. Empty fields impose no limit and an empty timezone means UTC. Only the event owner can update. Requires authentication.
 // @Tags events
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param eventID path string true "Event ID (UUID)"
-// @Param body body CreateRoomRequest true "Room data"
-// @Success 201 {object} controllers.CreateRoomSuccessResponse "data contains the created room"
+// @Param body body UpdateScheduleRulesRequest true "Schedule rules"
+// @Success 200 {object} controllers.ScheduleRulesSuccessResponse "data contains the saved schedule rules"
 // @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
-// @Failure 403 {object} helpers.APIResponse "error.code: forbidden"
-// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
-// @Router /events/{eventID}/rooms [post]
-func (c *ScheduleController) CreateEventRoom(w http.ResponseWriter, r *http.Request) {
+// @Router /events/{eventID}/schedule-rules [put]
+func (c *ScheduleController) UpdateScheduleRules(w http.ResponseWriter, r *http.Request) {
 	eventID := r.PathValue("eventID")
 	if eventID == "" {
 		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
 		return
 	}
-
-	var req CreateRoomRequest
+	var req UpdateScheduleRulesRequest
 	if !helpers.DecodeAndValidate(w, r, &req) {
 		return
 	}
-
 	ownerID, ok := middleware.UserIDFromContext(r.Context())
 	if !ok {
 		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
 		return
 	}
-
-	room, err := c.Service.CreateEventRoom(r.Context(), eventID, ownerID, req.Name, req.Capacity, req.Description, req.HowToGetThere, req.NotBookable)
+	rules, err := c.Service.UpdateScheduleRules(r.Context(), eventID, ownerID, &domain.ScheduleRules{
+		AllowedDurations: req.AllowedDurations,
+		EarliestStart:    req.EarliestStart,
+		LatestEnd:        req.LatestEnd,
+		AllowedDays:      req.AllowedDays,
+		Timezone:         req.Timezone,
+	})
 	if err != nil {
-		if errors.Is(err, domain.ErrNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event not found")
-			return
-		}
-		if errors.Is(err, domain.ErrForbidden) {
-			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
-			return
-		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		c.writeScheduleRulesError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, rules)
+}
+
+func (c *ScheduleController) writeScheduleRulesError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, domain.ErrNotFound) {
+		helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
 		return
 	}
+	if errors.Is(err, domain.ErrForbidden) {
+		helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+		return
+	}
+	helpers.WriteServiceError(w, r, c.Logger, err)
+}
 
-	helpers.WriteJSONSuccess(w, http.StatusCreated, room)
+// EventThemeSuccessResponse is the success response envelope for GET and PUT /events/{eventID}/theme
+// and GET /public/events/{eventCode}/theme (200).
+type EventThemeSuccessResponse struct {
+	Data  *domain.EventTheme `json:"data"`
+	Error *helpers.APIError  `json:"error"`
 }
 
-// UpdateRoomRequest is the request body for PATCH /events/{eventID}/rooms/{roomID}.
-type UpdateRoomRequest struct {
-	Name          *string `json:"name"`
-	Capacity      int     `json:"capacity"`
-	Description   string  `json:"description"`
-	HowToGetThere string  `json:"how_to_get_there"`
-	NotBookable   *bool   `json:"not_bookable"`
+// UpdateEventThemeRequest is the request body for PUT /events/{eventID}/theme. Empty fields fall back
+// to the app's defaults.
+type UpdateEventThemeRequest struct {
+	PrimaryColor      string `json:"primary_color"`
+	SecondaryColor    string `json:"secondary_color"`
+	BackgroundColor   string `json:"background_color"`
+	TextColor         string `json:"text_color"`
+	FontFamily        string `json:"font_family"`
+	HeadingFontFamily string `json:"heading_font_family"`
+	FooterText        string `json:"footer_text"`
+	SupportEmail      string `json:"support_email"`
 }
 
 // Validate implements Validator.
-func (u UpdateRoomRequest) Validate() []string {
+func (u UpdateEventThemeRequest) Validate() []string {
 	var errs []string
-	if u.Capacity < 0 {
-		errs = append(errs, "capacity must be non-negative")
+	colors := [][2]string{
+		{"primary_color", u.PrimaryColor}, {"secondary_color", u.SecondaryColor},
+		{"background_color", u.BackgroundColor}, {"text_color", u.TextColor},
+	}
+	for _, c := range colors {
+		if color := strings.TrimSpace(c[1]); color != "" && !hexColorRegex.MatchString(color) {
+			errs = append(errs, c[0]+" must be a hex color as #RRGGBB or #RRGGBBAA")
+		}
+	}
+	for _, f := range [][2]string{{"font_family", u.FontFamily}, {"heading_font_family", u.HeadingFontFamily}} {
+		if font := strings.TrimSpace(f[1]); font != "" && !fontFamilyRegex.MatchString(font) {
+			errs = append(errs, f[0]+" must be a font name of at most 100 letters, digits, spaces, hyphens or underscores")
+		}
+	}
+	if len(strings.TrimSpace(u.FooterText)) > 500 {
+		errs = append(errs, "footer_text must be at most 500 characters")
+	}
+	if email := strings.TrimSpace(u.SupportEmail); email != "" && (len(email) > 255 || !emailRegex.MatchString(email)) {
+		errs = append(errs, "support_email must be a valid email address of at most 255 characters")
 	}
 	return errs
 }
 
-// GetRoomSuccessResponse is the success response envelope for GET /events/{eventID}/rooms/{roomID} (200).
-type GetRoomSuccessResponse struct {
-	Data  *domain.Room      `json:"data"`
-	Error *helpers.APIError `json:"error"`
+// GetEventTheme godoc
+// @Summary Get the event's theme
+// @ID GetEventTheme
+// @Description Returns the colors, fonts, footer text and support email white-label attendee apps show the event with. Events without a saved theme return empty fields, meaning the app's defaults. Only the event owner can read it here; apps read it from GET /public/events/{eventCode}/theme. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Success 200 {object} controllers.EventThemeSuccessResponse "data contains the theme"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/theme [get]
+func (c *ScheduleController) GetEventTheme(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	theme, err := c.Service.GetEventTheme(r.Context(), eventID, ownerID)
+	if err != nil {
+		c.writeEventThemeError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, theme)
 }
 
-// ListRoomsSuccessResponse is the success response envelope for GET /events/{eventID}/rooms (200).
-type ListRoomsSuccessResponse struct {
-	Data  []*domain.Room    `json:"data"`
-	Error *helpers.APIError `json:"error"`
-}
-
-// UpdateRoomSuccessResponse is the success response envelope for PATCH /events/{eventID}/rooms/{roomID} (200).
-type UpdateRoomSuccessResponse struct {
-	Data  *domain.Room      `json:"data"`
-	Error *helpers.APIError `json:"error"`
-}
-
-// DeleteRoomSuccessResponse is the success response envelope for DELETE /events/{eventID}/rooms/{roomID} (200).
-type DeleteRoomSuccessResponse struct {
-	Data  DeleteEventResponse `json:"data"`
-	Error *helpers.APIError   `json:"error"`
-}
-
-// CreateRoomSuccessResponse is the success response envelope for POST /events/{eventID}/rooms (201).
-type CreateRoomSuccessResponse struct {
-	Data  *domain.Room      `json:"data"`
-	Error *helpers.APIError `json:"error"`
-}
-
-// CreateSpeakerRequest is the request body for POST /events/{eventID}/speakers.
-type CreateSpeakerRequest struct {
-	FirstName      string `json:"first_name"`
-	LastName       string `json:"last_name"`
-	Bio            string `json:"bio"`
-	TagLine        string `json:"tag_line"`
-	ProfilePicture string `json:"profile_picture"`
-	IsTopSpeaker   bool   `json:"is_top_speaker"`
-}
-
-// Validate implements Validator. At least one of first_name or last_name must be non-empty.
-func (c CreateSpeakerRequest) Validate() []string {
-	hasName := strings.TrimSpace(c.FirstName) != "" || strings.TrimSpace(c.LastName) != ""
-	if !hasName {
-		return []string{"at least one of first_name or last_name is required"}
-	}
-	return nil
-}
-
-// GetEventSpeakerResponse is the data payload for GET /events/{eventID}/speakers/{speakerID} (200).
-type GetEventSpeakerResponse struct {
-	Speaker  *domain.Speaker   `json:"speaker"`
-	Sessions []*domain.Session `json:"sessions"`
-}
-
-// ListSpeakersSuccessResponse is the success response envelope for GET /events/{eventID}/speakers (200).
-type ListSpeakersSuccessResponse struct {
-	Data  []*domain.Speaker `json:"data"`
-	Error *helpers.APIError `json:"error"`
-}
-
-// GetEventSpeakerSuccessResponse is the success response envelope for GET /events/{eventID}/speakers/{speakerID} (200).
-type GetEventSpeakerSuccessResponse struct {
-	Data  GetEventSpeakerResponse `json:"data"`
-	Error *helpers.APIError       `json:"error"`
-}
-
-// CreateSpeakerSuccessResponse is the success response envelope for POST /events/{eventID}/speakers (201).
-type CreateSpeakerSuccessResponse struct {
-	Data  *domain.Speaker   `json:"data"`
-	Error *helpers.APIError `json:"error"`
-}
-
-// ListEventRooms godoc
-// @Summary List rooms for an event
-// @Description Returns the list of rooms for the event. Only the event owner can list. Requires authentication.
+// UpdateEventTheme godoc
+// @Summary Replace the event's theme
+// @ID UpdateEventTheme
+// @Description Replaces the event's white-label theme. Colors are hex as #RRGGBB or #RRGGBBAA, fonts are family names the app loads itself, and empty fields fall back to the app's defaults. Only the event owner can update. Requires authentication.
 // @Tags events
+// @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param eventID path string true "Event ID (UUID)"
-// @Success 200 {object} controllers.ListRoomsSuccessResponse "data is an array of rooms"
+// @Param body body UpdateEventThemeRequest true "Theme"
+// @Success 200 {object} controllers.EventThemeSuccessResponse "data contains the saved theme"
 // @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
 // @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
-// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
-// @Router /events/{eventID}/rooms [get]
-func (c *ScheduleController) ListEventRooms(w http.ResponseWriter, r *http.Request) {
+// @Router /events/{eventID}/theme [put]
+func (c *ScheduleController) UpdateEventTheme(w http.ResponseWriter, r *http.Request) {
 	eventID := r.PathValue("eventID")
 	if eventID == "" {
 		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
 		return
 	}
+	var req UpdateEventThemeRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
 	ownerID, ok := middleware.UserIDFromContext(r.Context())
 	if !ok {
 		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
 		return
 	}
-	rooms, err := c.Service.ListEventRooms(r.Context(), eventID, ownerID)
+	theme, err := c.Service.UpdateEventTheme(r.Context(), eventID, ownerID, &domain.EventTheme{
+		PrimaryColor:      req.PrimaryColor,
+		SecondaryColor:    req.SecondaryColor,
+		BackgroundColor:   req.BackgroundColor,
+		TextColor:         req.TextColor,
+		FontFamily:        req.FontFamily,
+		HeadingFontFamily: req.HeadingFontFamily,
+		FooterText:        req.FooterText,
+		SupportEmail:      req.SupportEmail,
+	})
 	if err != nil {
-		if errors.Is(err, domain.ErrNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event not found")
-			return
-		}
-		if errors.Is(err, domain.ErrForbidden) {
-			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
-			return
-		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		c.writeEventThemeError(w, r, err)
 		return
 	}
-	if rooms == nil {
-		rooms = []*domain.Room{}
+	helpers.WriteJSONSuccess(w, http.StatusOK, theme)
+}
+
+func (c *ScheduleController) writeEventThemeError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, domain.ErrNotFound) {
+		helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+		return
+	}
+	if errors.Is(err, domain.ErrForbidden) {
+		helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+		return
 	}
-	helpers.WriteJSONSuccess(w, http.StatusOK, rooms)
+	helpers.WriteServiceError(w, r, c.Logger, err)
 }
 
-// GetEventRoom godoc
-// @Summary Get a room by ID
-// @Description Returns a single room for the event. Only the event owner can access. Requires authentication.
+// OperatingHoursSuccessResponse is the success response envelope for GET and PUT /events/{eventID}/operating-hours (200).
+type OperatingHoursSuccessResponse struct {
+	Data  []*domain.OperatingDay `json:"data"`
+	Error *helpers.APIError      `json:"error"`
+}
+
+// OperatingDayRequest is one event day in UpdateOperatingHoursRequest.
+type OperatingDayRequest struct {
+	Day      string    `json:"day"`
+	OpensAt  time.Time `json:"opens_at"`
+	ClosesAt time.Time `json:"closes_at"`
+}
+
+// UpdateOperatingHoursRequest is the request body for PUT /events/{eventID}/operating-hours.
+// An empty list removes the operating hours.
+type UpdateOperatingHoursRequest struct {
+	Days []OperatingDayRequest `json:"days"`
+}
+
+// Validate implements Validator.
+func (u UpdateOperatingHoursRequest) Validate() []string {
+	var errs []string
+	for _, day := range u.Days {
+		if _, err := time.Parse(time.DateOnly, day.Day); err != nil {
+			errs = append(errs, "days[].day must be a date as YYYY-MM-DD")
+			break
+		}
+	}
+	for _, day := range u.Days {
+		if day.OpensAt.IsZero() || day.ClosesAt.IsZero() {
+			errs = append(errs, "days[].opens_at and days[].closes_at are required")
+			break
+		}
+	}
+	return errs
+}
+
+// GetOperatingHours godoc
+// @Summary Get the event's operating hours
+// @ID GetOperatingHours
+// @Description Returns the open and close times of each event day, ordered by day. Events without operating hours return an empty list and allow sessions at any time. Only the event owner can read them here; attendees see them on the event in the schedule. Requires authentication.
 // @Tags events
 // @Produce json
 // @Security BearerAuth
 // @Param eventID path string true "Event ID (UUID)"
-// @Param roomID path string true "Room ID (UUID)"
-// @Success 200 {object} controllers.GetRoomSuccessResponse "data contains the room"
+// @Success 200 {object} controllers.OperatingHoursSuccessResponse "data contains the operating days"
 // @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
 // @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
-// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
-// @Router /events/{eventID}/rooms/{roomID} [get]
-func (c *ScheduleController) GetEventRoom(w http.ResponseWriter, r *http.Request) {
+// @Router /events/{eventID}/operating-hours [get]
+func (c *ScheduleController) GetOperatingHours(w http.ResponseWriter, r *http.Request) {
 	eventID := r.PathValue("eventID")
-	roomID := r.PathValue("roomID")
-	if eventID == "" || roomID == "" {
-		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or roomID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
 		return
 	}
 	ownerID, ok := middleware.UserIDFromContext(r.Context())
@@ -590,48 +841,38 @@ func (c *ScheduleController) GetEventRoom(w http.ResponseWriter, r *http.Request
 		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
 		return
 	}
-	room, err := c.Service.GetEventRoom(r.Context(), eventID, roomID, ownerID)
+	days, err := c.Service.GetOperatingHours(r.Context(), eventID, ownerID)
 	if err != nil {
-		if errors.Is(err, domain.ErrNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event or room not found")
-			return
-		}
-		if errors.Is(err, domain.ErrForbidden) {
-			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
-			return
-		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		c.writeOperatingHoursError(w, r, err)
 		return
 	}
-	helpers.WriteJSONSuccess(w, http.StatusOK, room)
+	helpers.WriteJSONSuccess(w, http.StatusOK, days)
 }
 
-// UpdateEventRoom godoc
-// @Summary Update a room
-// @Description Updates room details (name, capacity, description, how_to_get_there, not_bookable). Only the event owner can update. Optional fields omitted from body are unchanged (name and not_bookable keep current value when omitted). Requires authentication.
+// UpdateOperatingHours godoc
+// @Summary Replace the event's operating hours
+// @ID UpdateOperatingHours
+// @Description Replaces the open and close times of each event day: doors open at opens_at and the last session ends by closes_at, which may be after midnight. Sessions created or moved to a new time slot must then fit inside one day; existing sessions are not rechecked. Days must not repeat or overlap. An empty list removes the operating hours. Only the event owner can update. Requires authentication.
 // @Tags events
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param eventID path string true "Event ID (UUID)"
-// @Param roomID path string true "Room ID (UUID)"
-// @Param body body UpdateRoomRequest true "Room fields to update"
-// @Success 200 {object} controllers.UpdateRoomSuccessResponse "data contains the updated room"
+// @Param body body UpdateOperatingHoursRequest true "Operating days"
+// @Success 200 {object} controllers.OperatingHoursSuccessResponse "data contains the saved operating days"
 // @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
 // @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
-// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
-// @Router /events/{eventID}/rooms/{roomID} [patch]
-func (c *ScheduleController) UpdateEventRoom(w http.ResponseWriter, r *http.Request) {
+// @Router /events/{eventID}/operating-hours [put]
+func (c *ScheduleController) UpdateOperatingHours(w http.ResponseWriter, r *http.Request) {
 	eventID := r.PathValue("eventID")
-	roomID := r.PathValue("roomID")
-	if eventID == "" || roomID == "" {
-		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or roomID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
 		return
 	}
-	var req UpdateRoomRequest
+	var req UpdateOperatingHoursRequest
 	if !helpers.DecodeAndValidate(w, r, &req) {
 		return
 	}
@@ -640,43 +881,65 @@ func (c *ScheduleController) UpdateEventRoom(w http.ResponseWriter, r *http.Requ
 		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
 		return
 	}
-	room, err := c.Service.UpdateEventRoom(r.Context(), eventID, roomID, ownerID, req.Name, req.Capacity, req.Description, req.HowToGetThere, req.NotBookable)
+	days := make([]*domain.OperatingDay, len(req.Days))
+	for i, day := range req.Days {
+		days[i] = &domain.OperatingDay{Day: day.Day, OpensAt: day.OpensAt, ClosesAt: day.ClosesAt}
+	}
+	days, err := c.Service.UpdateOperatingHours(r.Context(), eventID, ownerID, days)
 	if err != nil {
-		if errors.Is(err, domain.ErrNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event or room not found")
-			return
-		}
-		if errors.Is(err, domain.ErrForbidden) {
-			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
-			return
-		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		c.writeOperatingHoursError(w, r, err)
 		return
 	}
-	helpers.WriteJSONSuccess(w, http.StatusOK, room)
+	helpers.WriteJSONSuccess(w, http.StatusOK, days)
 }
 
-// DeleteEventRoom godoc
-// @Summary Delete a room
-// @Description Deletes a room and its sessions. Only the event owner can delete. Requires authentication.
+func (c *ScheduleController) writeOperatingHoursError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, domain.ErrNotFound) {
+		helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+		return
+	}
+	if errors.Is(err, domain.ErrForbidden) {
+		helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+		return
+	}
+	if errors.Is(err, domain.ErrInvalidInput) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
+		return
+	}
+	helpers.WriteServiceError(w, r, c.Logger, err)
+}
+
+// IntegrityReportSuccessResponse is the success response envelope for GET /events/{eventID}/integrity-report (200).
+type IntegrityReportSuccessResponse struct {
+	Data  *domain.IntegrityReport `json:"data"`
+	Error *helpers.APIError       `json:"error"`
+}
+
+// IntegrityCleanupSuccessResponse is the success response envelope for POST /events/{eventID}/integrity-report/cleanup (200).
+type IntegrityCleanupSuccessResponse struct {
+	Data  *domain.IntegrityCleanup `json:"data"`
+	Error *helpers.APIError        `json:"error"`
+}
+
+// GetIntegrityReport godoc
+// @Summary Report orphaned and inconsistent event data
+// @ID GetIntegrityReport
+// @Description Lists unused tags, session tags missing from the event, sessions linked to another event's speakers, speakers without sessions, empty rooms, sessions that do not end after they start and overlapping sessions in a room. Issues with fixable=true are resolved by the cleanup endpoint. Only the event owner can read it. Requires authentication.
 // @Tags events
 // @Produce json
 // @Security BearerAuth
 // @Param eventID path string true "Event ID (UUID)"
-// @Param roomID path string true "Room ID (UUID)"
-// @Success 200 {object} controllers.DeleteRoomSuccessResponse "data contains status"
+// @Success 200 {object} controllers.IntegrityReportSuccessResponse "data contains the integrity report"
 // @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
 // @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
-// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
-// @Router /events/{eventID}/rooms/{roomID} [delete]
-func (c *ScheduleController) DeleteEventRoom(w http.ResponseWriter, r *http.Request) {
+// @Router /events/{eventID}/integrity-report [get]
+func (c *ScheduleController) GetIntegrityReport(w http.ResponseWriter, r *http.Request) {
 	eventID := r.PathValue("eventID")
-	roomID := r.PathValue("roomID")
-	if eventID == "" || roomID == "" {
-		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or roomID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
 		return
 	}
 	ownerID, ok := middleware.UserIDFromContext(r.Context())
@@ -684,87 +947,78 @@ func (c *ScheduleController) DeleteEventRoom(w http.ResponseWriter, r *http.Requ
 		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
 		return
 	}
-	if err := c.Service.DeleteEventRoom(r.Context(), eventID, roomID, ownerID); err != nil {
-		if errors.Is(err, domain.ErrNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event or room not found")
-			return
-		}
-		if errors.Is(err, domain.ErrForbidden) {
-			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
-			return
-		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+	report, err := c.Service.GetIntegrityReport(r.Context(), eventID, ownerID)
+	if err != nil {
+		c.writeIntegrityError(w, r, err)
 		return
 	}
-	helpers.WriteJSONSuccess(w, http.StatusOK, DeleteEventResponse{Status: "deleted"})
+	helpers.WriteJSONSuccess(w, http.StatusOK, report)
 }
 
-// ListEventSpeakers godoc
-// @Summary List speakers for an event
-// @Description Returns the list of speakers for the event. Only the event owner can list. Requires authentication.
+// CleanupIntegrityIssues godoc
+// @Summary Fix orphaned and inconsistent event data
+// @ID CleanupIntegrityIssues
+// @Description Fixes the report's fixable issues in one transaction: unused tags are unlinked from the event, session tags missing from the event are added to it, and links to another event's speakers are removed. With dry_run=true nothing is changed and the response lists what would be fixed. Only the event owner can clean up. Requires authentication.
 // @Tags events
 // @Produce json
 // @Security BearerAuth
 // @Param eventID path string true "Event ID (UUID)"
-// @Success 200 {object} controllers.ListSpeakersSuccessResponse "data is an array of speakers"
+// @Param dry_run query bool false "List the fixes without applying them"
+// @Success 200 {object} controllers.IntegrityCleanupSuccessResponse "data contains the fixed (or, in a dry run, fixable) issues"
 // @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
 // @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
-// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
-// @Router /events/{eventID}/speakers [get]
-func (c *ScheduleController) ListEventSpeakers(w http.ResponseWriter, r *http.Request) {
+// @Router /events/{eventID}/integrity-report/cleanup [post]
+func (c *ScheduleController) CleanupIntegrityIssues(w http.ResponseWriter, r *http.Request) {
 	eventID := r.PathValue("eventID")
 	if eventID == "" {
 		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
 		return
 	}
+	dryRun, ok := helpers.ParseDryRun(w, r)
+	if !ok {
+		return
+	}
 	ownerID, ok := middleware.UserIDFromContext(r.Context())
 	if !ok {
 		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
 		return
 	}
-	speakers, err := c.Service.ListEventSpeakers(r.Context(), eventID, ownerID)
+	cleanup, err := c.Service.CleanupIntegrityIssues(r.Context(), eventID, ownerID, dryRun)
 	if err != nil {
-		if errors.Is(err, domain.ErrNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event not found")
-			return
-		}
-		if errors.Is(err, domain.ErrForbidden) {
-			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
-			return
-		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		c.writeIntegrityError(w, r, err)
 		return
 	}
-	if speakers == nil {
-		speakers = []*domain.Speaker{}
-	}
-	helpers.WriteJSONSuccess(w, http.StatusOK, speakers)
+	helpers.WriteJSONSuccess(w, http.StatusOK, cleanup)
 }
 
-// GetEventSpeaker godoc
-// @Summary Get a speaker by ID
-// @Description Returns a single speaker for the event with the list of sessions they speak in. Only the event owner can access. Requires authentication.
+// ScheduleValidationSuccessResponse is the success response envelope for POST /events/{eventID}/schedule/validate (200).
+type ScheduleValidationSuccessResponse struct {
+	Data  *domain.ScheduleValidation `json:"data"`
+	Error *helpers.APIError          `json:"error"`
+}
+
+// ValidateSchedule godoc
+// @Summary Validate the whole schedule of an event
+// @ID ValidateSchedule
+// @Description Runs every conflict and constraint check on the event's sessions and returns one report with the problems by category: room_overlap (sessions in the same room at overlapping times), speaker_clash (a speaker in overlapping sessions), capacity (rooms holding sessions without a capacity set), operating_hours (sessions outside the operating hours) and schedule_rules (sessions breaking the schedule rules or ending before they start). Every category is present, empty when it passed. Run it after imports or bulk reschedules; nothing is changed. Only the event owner can validate. Requires authentication.
 // @Tags events
 // @Produce json
 // @Security BearerAuth
 // @Param eventID path string true "Event ID (UUID)"
-// @Param speakerID path string true "Speaker ID (UUID)"
-// @Success 200 {object} controllers.GetEventSpeakerSuccessResponse "data contains speaker and sessions"
+// @Success 200 {object} controllers.ScheduleValidationSuccessResponse "data contains the validation report"
 // @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
 // @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
-// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
-// @Router /events/{eventID}/speakers/{speakerID} [get]
-func (c *ScheduleController) GetEventSpeaker(w http.ResponseWriter, r *http.Request) {
+// @Router /events/{eventID}/schedule/validate [post]
+func (c *ScheduleController) ValidateSchedule(w http.ResponseWriter, r *http.Request) {
 	eventID := r.PathValue("eventID")
-	speakerID := r.PathValue("speakerID")
-	if eventID == "" || speakerID == "" {
-		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or speakerID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
 		return
 	}
 	ownerID, ok := middleware.UserIDFromContext(r.Context())
@@ -772,401 +1026,2390 @@ func (c *ScheduleController) GetEventSpeaker(w http.ResponseWriter, r *http.Requ
 		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
 		return
 	}
-	speaker, sessions, err := c.Service.GetEventSpeaker(r.Context(), eventID, speakerID, ownerID)
+	report, err := c.Service.ValidateSchedule(r.Context(), eventID, ownerID)
 	if err != nil {
-		if errors.Is(err, domain.ErrNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event or speaker not found")
-			return
-		}
-		if errors.Is(err, domain.ErrForbidden) {
-			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
-			return
-		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		c.writeIntegrityError(w, r, err)
 		return
 	}
-	if sessions == nil {
-		sessions = []*domain.Session{}
-	}
-	helpers.WriteJSONSuccess(w, http.StatusOK, GetEventSpeakerResponse{Speaker: speaker, Sessions: sessions})
+	helpers.WriteJSONSuccess(w, http.StatusOK, report)
 }
 
-// DeleteEventSpeaker godoc
-// @Summary Delete a speaker
-// @Description Deletes a speaker. Session-speaker links are removed by cascade. Only the event owner can delete. Requires authentication.
+// ScheduleGridSuccessResponse is the success response envelope for GET /events/{eventID}/schedule/grid (200).
+type ScheduleGridSuccessResponse struct {
+	Data  *domain.ScheduleGrid `json:"data"`
+	Error *helpers.APIError    `json:"error"`
+}
+
+// GetScheduleGrid godoc
+// @Summary Get the schedule grid of an event
+// @ID GetScheduleGrid
+// @Description Returns the event's schedule laid out as days × rooms × time slots, ready to render. Each day has the rows' start times (slots) and a column per room holding its sessions with their slot, span and lane, the free gaps and the overlapping pairs. Sessions without a room are listed apart. The server keeps the grid up to date as sessions, rooms, schedule rules and operating hours change. The event owner and team members can read it. Requires authentication.
 // @Tags events
 // @Produce json
 // @Security BearerAuth
 // @Param eventID path string true "Event ID (UUID)"
-// @Param speakerID path string true "Speaker ID (UUID)"
-// @Success 204 "No content"
+// @Success 200 {object} controllers.ScheduleGridSuccessResponse "data contains the schedule grid"
 // @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
-// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
-// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner or team member)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
-// @Router /events/{eventID}/speakers/{speakerID} [delete]
-func (c *ScheduleController) DeleteEventSpeaker(w http.ResponseWriter, r *http.Request) {
+// @Router /events/{eventID}/schedule/grid [get]
+func (c *ScheduleController) GetScheduleGrid(w http.ResponseWriter, r *http.Request) {
 	eventID := r.PathValue("eventID")
-	speakerID := r.PathValue("speakerID")
-	if eventID == "" || speakerID == "" {
-		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or speakerID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
 		return
 	}
-	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	userID, ok := middleware.UserIDFromContext(r.Context())
 	if !ok {
 		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
 		return
 	}
-	if err := c.Service.DeleteEventSpeaker(r.Context(), eventID, speakerID, ownerID); err != nil {
-		if errors.Is(err, domain.ErrNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event or speaker not found")
-			return
-		}
-		if errors.Is(err, domain.ErrForbidden) {
-			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
-			return
-		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+	grid, err := c.Service.GetScheduleGrid(r.Context(), eventID, userID)
+	if err != nil {
+		c.writeIntegrityError(w, r, err)
 		return
 	}
-	w.WriteHeader(http.StatusNoContent)
+	helpers.WriteJSONSuccess(w, http.StatusOK, grid)
 }
 
-// CreateEventSpeaker godoc
-// @Summary Create a speaker
-// @Description Creates a new speaker for the event (manual create). Only the event owner can create. Requires authentication.
+// ScheduleOverlapsSuccessResponse is the success response envelope for GET /events/{eventID}/schedule/overlaps (200).
+type ScheduleOverlapsSuccessResponse struct {
+	Data  *domain.ScheduleOverlaps `json:"data"`
+	Error *helpers.APIError        `json:"error"`
+}
+
+// GetScheduleOverlaps godoc
+// @Summary Get the parallel sessions of an event by tag or track
+// @ID GetScheduleOverlaps
+// @Description Cuts the event's schedule into time buckets and lists, for each bucket with a session running, the sessions running in it grouped by tag or track, so program chairs can balance tracks, e.g. avoid two AI talks at once. A session is in every bucket it overlaps, and with group_by=tag in the group of each of its tags; sessions without one share a group with an empty key, listed last. clash is set on a named group with more than one session in the bucket. Buckets line up with UTC midnight. The event owner and team members can read it. Requires authentication.
 // @Tags events
-// @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param eventID path string true "Event ID (UUID)"
-// @Param body body CreateSpeakerRequest true "Speaker data"
-// @Success 201 {object} controllers.CreateSpeakerSuccessResponse "data contains the created speaker"
+// @Param group_by query string false "tag (default) or track"
+// @Param bucket_minutes query int false "Bucket size in minutes, 5 to 240 and dividing a day (default 30)"
+// @Success 200 {object} controllers.ScheduleOverlapsSuccessResponse "data contains the buckets"
 // @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
-// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
-// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner or team member)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
-// @Router /events/{eventID}/speakers [post]
-func (c *ScheduleController) CreateEventSpeaker(w http.ResponseWriter, r *http.Request) {
+// @Router /events/{eventID}/schedule/overlaps [get]
+func (c *ScheduleController) GetScheduleOverlaps(w http.ResponseWriter, r *http.Request) {
 	eventID := r.PathValue("eventID")
 	if eventID == "" {
 		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
 		return
 	}
-	var req CreateSpeakerRequest
-	if !helpers.DecodeAndValidate(w, r, &req) {
-		return
-	}
-	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	userID, ok := middleware.UserIDFromContext(r.Context())
 	if !ok {
 		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
 		return
 	}
-	speaker, err := c.Service.CreateEventSpeaker(r.Context(), eventID, ownerID, req.FirstName, req.LastName, req.Bio, req.TagLine, req.ProfilePicture, req.IsTopSpeaker)
-	if err != nil {
-		if errors.Is(err, domain.ErrNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event not found")
+	groupBy := r.URL.Query().Get("group_by")
+	if groupBy == "" {
+		groupBy = domain.OverlapGroupByTag
+	}
+	bucketMinutes := domain.DefaultOverlapBucketMinutes
+	if s := r.URL.Query().Get("bucket_minutes"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "bucket_minutes must be a number")
 			return
 		}
-		if errors.Is(err, domain.ErrForbidden) {
-			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+		bucketMinutes = n
+	}
+	overlaps, err := c.Service.GetScheduleOverlaps(r.Context(), eventID, userID, groupBy, bucketMinutes)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
 			return
 		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		c.writeIntegrityError(w, r, err)
 		return
 	}
-	helpers.WriteJSONSuccess(w, http.StatusCreated, speaker)
+	helpers.WriteJSONSuccess(w, http.StatusOK, overlaps)
 }
 
-// ListMyEvents godoc
-// @Summary List events owned by the current user
-// @Description Returns events where the authenticated user is the owner. Requires Bearer token.
-// @Tags events
-// @Produce json
-// @Security BearerAuth
-// @Success 200 {object} controllers.ListMyEventsSuccessResponse "data is an array of events"
-// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
-// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
-// @Router /events/me [get]
-func (c *ScheduleController) ListMyEvents(w http.ResponseWriter, r *http.Request) {
-	userID, ok := middleware.UserIDFromContext(r.Context())
-	if !ok {
-		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+func (c *ScheduleController) writeIntegrityError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, domain.ErrNotFound) {
+		helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
 		return
 	}
-	events, err := c.Service.ListEventsByOwner(r.Context(), userID)
-	if err != nil {
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+	if errors.Is(err, domain.ErrForbidden) {
+		helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
 		return
 	}
-	if events == nil {
-		events = []*domain.Event{}
+	helpers.WriteServiceError(w, r, c.Logger, err)
+}
+
+// ChecklistSuccessResponse is the success response envelope for GET and PUT /events/{eventID}/checklist (200).
+type ChecklistSuccessResponse struct {
+	Data  []*domain.ChecklistItem `json:"data"`
+	Error *helpers.APIError       `json:"error"`
+}
+
+// UpdateChecklistRequest is the request body for PUT /events/{eventID}/checklist.
+// An empty list removes the checklist.
+type UpdateChecklistRequest struct {
+	Items []string `json:"items"`
+}
+
+// Validate implements Validator.
+func (u UpdateChecklistRequest) Validate() []string {
+	if len(u.Items) > domain.MaxChecklistItems {
+		return []string{"items must have at most " + strconv.Itoa(domain.MaxChecklistItems) + " entries"}
 	}
-	helpers.WriteJSONSuccess(w, http.StatusOK, events)
+	return nil
 }
 
-// AddEventTeamMemberRequest is the request body for POST /events/{eventID}/team-members.
-type AddEventTeamMemberRequest struct {
-	Email string `json:"email"`
+// SetChecklistItemRequest is the request body for PUT /events/{eventID}/sessions/{sessionID}/checklist/{itemID}.
+type SetChecklistItemRequest struct {
+	Done *bool `json:"done"`
 }
 
 // Validate implements Validator.
-func (a AddEventTeamMemberRequest) Validate() []string {
-	var errs []string
-	if a.Email == "" {
-		errs = append(errs, "email is required")
-	} else if !emailRegex.MatchString(strings.TrimSpace(a.Email)) {
-		errs = append(errs, "email must be a valid email address")
+func (s SetChecklistItemRequest) Validate() []string {
+	if s.Done == nil {
+		return []string{"done is required"}
 	}
-	return errs
+	return nil
 }
 
-// AddEventTeamMemberSuccessResponse is the success response envelope for POST /events/{eventID}/team-members (201).
-type AddEventTeamMemberSuccessResponse struct {
-	Data  *domain.EventTeamMember `json:"data"`
-	Error *helpers.APIError       `json:"error"`
+// SessionChecklistSuccessResponse is the success response envelope for PUT /events/{eventID}/sessions/{sessionID}/checklist/{itemID} (200).
+type SessionChecklistSuccessResponse struct {
+	Data  *domain.SessionChecklist `json:"data"`
+	Error *helpers.APIError        `json:"error"`
 }
 
-// AddEventTeamMember godoc
-// @Summary Add a team member to an event
-// @Description Add a user as a team member of the event by email. Only the event owner can add. Returns 404 with a message if no user exists with that email. Requires authentication.
+// ChecklistProgressSuccessResponse is the success response envelope for GET /events/{eventID}/checklist/progress (200).
+type ChecklistProgressSuccessResponse struct {
+	Data  *domain.ChecklistProgress `json:"data"`
+	Error *helpers.APIError         `json:"error"`
+}
+
+// GetChecklist godoc
+// @Summary Get the event's session checklist
+// @ID GetChecklist
+// @Description Returns the items every session of the event needs before it is ready, in order. The event owner and team members can read it. Requires authentication.
 // @Tags events
-// @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param eventID path string true "Event ID (UUID)"
-// @Param body body AddEventTeamMemberRequest true "Email of the user to add"
-// @Success 201 {object} controllers.AddEventTeamMemberSuccessResponse "data contains the added team member"
+// @Success 200 {object} controllers.ChecklistSuccessResponse "data contains the checklist items"
 // @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
-// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
-// @Failure 404 {object} helpers.APIResponse "error.code: not_found (no user with that email)"
-// @Failure 409 {object} helpers.APIResponse "error.code: conflict (already member or invalid)"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner or team member)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
-// @Router /events/{eventID}/team-members [post]
-func (c *ScheduleController) AddEventTeamMember(w http.ResponseWriter, r *http.Request) {
+// @Router /events/{eventID}/checklist [get]
+func (c *ScheduleController) GetChecklist(w http.ResponseWriter, r *http.Request) {
 	eventID := r.PathValue("eventID")
 	if eventID == "" {
 		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
 		return
 	}
-	var req AddEventTeamMemberRequest
-	if !helpers.DecodeAndValidate(w, r, &req) {
-		return
-	}
-	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	userID, ok := middleware.UserIDFromContext(r.Context())
 	if !ok {
 		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
 		return
 	}
-	member, err := c.Service.AddEventTeamMemberByEmail(r.Context(), eventID, req.Email, ownerID)
+	items, err := c.Service.GetChecklist(r.Context(), eventID, userID)
 	if err != nil {
-		if errors.Is(err, domain.ErrUserNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "no user with that email")
-			return
-		}
-		if errors.Is(err, domain.ErrNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event not found")
-			return
-		}
-		if errors.Is(err, domain.ErrForbidden) {
-			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
-			return
-		}
-		if errors.Is(err, domain.ErrAlreadyMember) || errors.Is(err, domain.ErrInvalidInput) {
-			helpers.WriteJSONError(w, http.StatusConflict, helpers.ErrCodeConflict, err.Error())
-			return
-		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		c.writeOperatingHoursError(w, r, err)
 		return
 	}
-	helpers.WriteJSONSuccess(w, http.StatusCreated, member)
-}
-
-// ListEventTeamMembersSuccessResponse is the success response envelope for GET /events/{eventID}/team-members (200).
-type ListEventTeamMembersSuccessResponse struct {
-	Data  []*domain.EventTeamMember `json:"data"`
-	Error *helpers.APIError         `json:"error"`
+	helpers.WriteJSONSuccess(w, http.StatusOK, items)
 }
 
-// ListEventTeamMembers godoc
-// @Summary List team members of an event
-// @Description Returns the list of team members for the event. Only the event owner can list. Requires authentication.
+// UpdateChecklist godoc
+// @Summary Replace the event's session checklist
+// @ID UpdateChecklist
+// @Description Replaces the checklist items, in order, such as "Slides received" or "AV check". Items whose label is kept keep their ticks; the ticks of removed items are deleted. Labels are trimmed and must be non-empty, at most 255 characters and unique ignoring case; an event has at most 50 items. An empty list removes the checklist. Only the event owner can update. Requires authentication.
 // @Tags events
+// @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param eventID path string true "Event ID (UUID)"
-// @Success 200 {object} controllers.ListEventTeamMembersSuccessResponse "data is an array of team members"
+// @Param body body UpdateChecklistRequest true "Checklist item labels"
+// @Success 200 {object} controllers.ChecklistSuccessResponse "data contains the saved checklist items"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
 // @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
-// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
-// @Router /events/{eventID}/team-members [get]
-func (c *ScheduleController) ListEventTeamMembers(w http.ResponseWriter, r *http.Request) {
+// @Router /events/{eventID}/checklist [put]
+func (c *ScheduleController) UpdateChecklist(w http.ResponseWriter, r *http.Request) {
 	eventID := r.PathValue("eventID")
 	if eventID == "" {
 		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
 		return
 	}
-	callerID, ok := middleware.UserIDFromContext(r.Context())
+	var req UpdateChecklistRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
 	if !ok {
 		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
 		return
 	}
-	members, err := c.Service.ListEventTeamMembers(r.Context(), eventID, callerID)
+	items, err := c.Service.UpdateChecklist(r.Context(), eventID, ownerID, req.Items)
 	if err != nil {
-		if errors.Is(err, domain.ErrNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event not found")
-			return
-		}
-		if errors.Is(err, domain.ErrForbidden) {
-			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
-			return
-		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		c.writeOperatingHoursError(w, r, err)
 		return
 	}
-	if members == nil {
-		members = []*domain.EventTeamMember{}
-	}
-	helpers.WriteJSONSuccess(w, http.StatusOK, members)
-}
-
-// RemoveEventTeamMemberResponse is the data payload for DELETE /events/{eventID}/team-members/{userID} (200).
-type RemoveEventTeamMemberResponse struct {
-	Status string `json:"status"`
-}
-
-// RemoveEventTeamMemberSuccessResponse is the success response envelope for DELETE /events/{eventID}/team-members/{userID} (200).
-type RemoveEventTeamMemberSuccessResponse struct {
-	Data  RemoveEventTeamMemberResponse `json:"data"`
-	Error *helpers.APIError             `json:"error"`
+	helpers.WriteJSONSuccess(w, http.StatusOK, items)
 }
 
-// RemoveEventTeamMember godoc
-// @Summary Remove a team member from an event
-// @Description Remove a user from the event's team members. Only the event owner can remove. Requires authentication.
+// SetSessionChecklistItem godoc
+// @Summary Tick or untick a checklist item for a session
+// @ID SetSessionChecklistItem
+// @Description Marks a checklist item done or not done for one session and returns the session's checklist. The caller is recorded as the team member who ticked it; ticking an item that is already done keeps the original tick. The event owner and team members can tick items. Requires authentication.
 // @Tags events
+// @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param eventID path string true "Event ID (UUID)"
-// @Param userID path string true "User ID (UUID) of the team member to remove"
-// @Success 200 {object} controllers.RemoveEventTeamMemberSuccessResponse "data contains status"
+// @Param sessionID path string true "Session ID (UUID)"
+// @Param itemID path string true "Checklist item ID (UUID)"
+// @Param body body SetChecklistItemRequest true "Whether the item is done"
+// @Success 200 {object} controllers.SessionChecklistSuccessResponse "data contains the session's checklist"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
-// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner or team member)"
 // @Failure 404 {object} helpers.APIResponse "error.code: not_found"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
-// @Router /events/{eventID}/team-members/{userID} [delete]
-func (c *ScheduleController) RemoveEventTeamMember(w http.ResponseWriter, r *http.Request) {
+// @Router /events/{eventID}/sessions/{sessionID}/checklist/{itemID} [put]
+func (c *ScheduleController) SetSessionChecklistItem(w http.ResponseWriter, r *http.Request) {
 	eventID := r.PathValue("eventID")
-	userID := r.PathValue("userID")
-	if eventID == "" || userID == "" {
-		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or userID")
+	sessionID := r.PathValue("sessionID")
+	itemID := r.PathValue("itemID")
+	if eventID == "" || sessionID == "" || itemID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID, sessionID or itemID")
 		return
 	}
-	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	var req SetChecklistItemRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
 	if !ok {
 		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
 		return
 	}
-	err := c.Service.RemoveEventTeamMember(r.Context(), eventID, userID, ownerID)
+	checklist, err := c.Service.SetSessionChecklistItem(r.Context(), eventID, sessionID, itemID, userID, *req.Done)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event or team member not found")
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event, session or checklist item not found")
 			return
 		}
 		if errors.Is(err, domain.ErrForbidden) {
 			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
 			return
 		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		helpers.WriteServiceError(w, r, c.Logger, err)
 		return
 	}
-	helpers.WriteJSONSuccess(w, http.StatusOK, RemoveEventTeamMemberResponse{Status: "removed"})
-}
-
-// ListEventInvitationsResponse is the data payload for GET /events/{eventID}/invitations (200).
-type ListEventInvitationsResponse struct {
-	Items      []*domain.EventInvitation `json:"items"`
-	Pagination helpers.PaginationMeta    `json:"pagination"`
-}
-
-// ListEventInvitationsSuccessResponse is the success response envelope for GET /events/{eventID}/invitations (200).
-type ListEventInvitationsSuccessResponse struct {
-	Data  ListEventInvitationsResponse `json:"data"`
-	Error *helpers.APIError            `json:"error"`
+	helpers.WriteJSONSuccess(w, http.StatusOK, checklist)
 }
 
-// ListEventInvitations godoc
-// @Summary List invited emails for an event
-// @Description Returns a paginated list of emails invited to the event (with id and sent_at). Only the event owner can list. Use page and page_size query params. Optional search filters by email substring (case-insensitive). Requires authentication.
+// GetChecklistProgress godoc
+// @Summary Get the checklist progress of an event's sessions
+// @ID GetChecklistProgress
+// @Description Returns every session's checklist ordered by start time, how many sessions are ready (every item done) and how many items each team member ticked. Use ready=false to list only the sessions that are not ready yet, or ready=true for those that are; the totals always count every session. The event owner and team members can read it. Requires authentication.
 // @Tags events
 // @Produce json
 // @Security BearerAuth
 // @Param eventID path string true "Event ID (UUID)"
-// @Param search query string false "Filter emails containing this string (case-insensitive)"
-// @Param page query int false "Page number (default 1)"
-// @Param page_size query int false "Page size (default 20, max 100)"
-// @Success 200 {object} controllers.ListEventInvitationsSuccessResponse "data contains items and pagination"
+// @Param ready query bool false "Only list sessions that are (true) or are not (false) ready"
+// @Success 200 {object} controllers.ChecklistProgressSuccessResponse "data contains the checklist progress"
 // @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
-// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
-// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner or team member)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
-// @Router /events/{eventID}/invitations [get]
-func (c *ScheduleController) ListEventInvitations(w http.ResponseWriter, r *http.Request) {
+// @Router /events/{eventID}/checklist/progress [get]
+func (c *ScheduleController) GetChecklistProgress(w http.ResponseWriter, r *http.Request) {
 	eventID := r.PathValue("eventID")
 	if eventID == "" {
 		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
 		return
 	}
-	callerID, ok := middleware.UserIDFromContext(r.Context())
+	var ready *bool
+	if v := r.URL.Query().Get("ready"); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "ready must be true or false")
+			return
+		}
+		ready = &parsed
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
 	if !ok {
 		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
 		return
 	}
-	search := strings.TrimSpace(r.URL.Query().Get("search"))
-	params := helpers.ParsePagination(r)
-	list, total, err := c.Service.ListEventInvitations(r.Context(), eventID, callerID, search, params)
+	progress, err := c.Service.GetChecklistProgress(r.Context(), eventID, userID, ready)
 	if err != nil {
-		if errors.Is(err, domain.ErrNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event not found")
-			return
-		}
-		if errors.Is(err, domain.ErrForbidden) {
-			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
-			return
-		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		c.writeOperatingHoursError(w, r, err)
 		return
 	}
-	if list == nil {
-		list = []*domain.EventInvitation{}
+	helpers.WriteJSONSuccess(w, http.StatusOK, progress)
+}
+
+// CustomFieldsSuccessResponse is the success response envelope for GET /events/{eventID}/custom-fields (200).
+type CustomFieldsSuccessResponse struct {
+	Data  []*domain.CustomField `json:"data"`
+	Error *helpers.APIError     `json:"error"`
+}
+
+// CustomFieldSuccessResponse is the success response envelope for POST /events/{eventID}/custom-fields (201)
+// and PATCH /events/{eventID}/custom-fields/{fieldID} (200).
+type CustomFieldSuccessResponse struct {
+	Data  *domain.CustomField `json:"data"`
+	Error *helpers.APIError   `json:"error"`
+}
+
+// CustomFieldValuesSuccessResponse is the success response envelope for PUT .../custom-fields on a session or speaker (200).
+type CustomFieldValuesSuccessResponse struct {
+	Data  []*domain.CustomFieldValue `json:"data"`
+	Error *helpers.APIError          `json:"error"`
+}
+
+// CreateCustomFieldRequest is the request body for POST /events/{eventID}/custom-fields.
+type CreateCustomFieldRequest struct {
+	Name string `json:"name"`
+	// Type is one of text, number, boolean, date and url.
+	Type string `json:"type"`
+	// AppliesTo is session or speaker.
+	AppliesTo string `json:"applies_to"`
+	Public    bool   `json:"public"`
+}
+
+// Validate implements Validator.
+func (c CreateCustomFieldRequest) Validate() []string {
+	var errs []string
+	if strings.TrimSpace(c.Name) == "" {
+		errs = append(errs, "name is required")
 	}
-	meta := helpers.NewPaginationMeta(params.Page, params.PageSize, total)
-	helpers.WriteJSONSuccess(w, http.StatusOK, ListEventInvitationsResponse{Items: list, Pagination: meta})
+	if c.Type == "" {
+		errs = append(errs, "type is required")
+	}
+	if c.AppliesTo == "" {
+		errs = append(errs, "applies_to is required")
+	}
+	return errs
 }
 
-// SendEventInvitationsRequest is the request body for POST /events/{eventID}/invitations.
-// Emails is a long string of emails separated by commas or spaces.
-type SendEventInvitationsRequest struct {
-	Emails string `json:"emails"`
+// UpdateCustomFieldRequest is the request body for PATCH /events/{eventID}/custom-fields/{fieldID}.
+// A field's type and entity cannot change; delete it and create another instead.
+type UpdateCustomFieldRequest struct {
+	Name   *string `json:"name"`
+	Public *bool   `json:"public"`
 }
 
 // Validate implements Validator.
-func (s SendEventInvitationsRequest) Validate() []string {
-	if strings.TrimSpace(s.Emails) == "" {
-		return []string{"emails is required"}
+func (u UpdateCustomFieldRequest) Validate() []string {
+	if u.Name == nil && u.Public == nil {
+		return []string{"name or public is required"}
+	}
+	return nil
+}
+
+// SetCustomFieldValuesRequest is the request body for PUT .../custom-fields on a session or speaker.
+type SetCustomFieldValuesRequest struct {
+	// Values maps field IDs to values; null or an empty string clears a field. Fields not listed keep their value.
+	Values map[string]any `json:"values"`
+}
+
+// Validate implements Validator.
+func (s SetCustomFieldValuesRequest) Validate() []string {
+	if s.Values == nil {
+		return []string{"values is required"}
+	}
+	if len(s.Values) > domain.MaxCustomFields {
+		return []string{"values must have at most " + strconv.Itoa(domain.MaxCustomFields) + " entries"}
+	}
+	return nil
+}
+
+// ListCustomFields godoc
+// @Summary List the event's custom fields
+// @ID ListCustomFields
+// @Description Returns the extra fields the event defines for its sessions and speakers, oldest first. The event owner and team members can read them. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Success 200 {object} controllers.CustomFieldsSuccessResponse "data contains the custom fields"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner or team member)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/custom-fields [get]
+func (c *ScheduleController) ListCustomFields(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	fields, err := c.Service.ListCustomFields(r.Context(), eventID, userID)
+	if err != nil {
+		c.writeOperatingHoursError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, fields)
+}
+
+// CreateCustomField godoc
+// @Summary Create a custom field
+// @ID CreateCustomField
+// @Description Adds an extra field to the event's sessions or speakers, such as "streaming consent" (boolean) or "paper DOI" (url). Types are text, number, boolean, date (YYYY-MM-DD) and url (http or https). Public fields are also shown to attendees in the schedule, delta sync and offline bundle. Names are trimmed, at most 100 characters and unique per entity; an event has at most 50 fields. Only the event owner can create. Requires authentication.
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param body body CreateCustomFieldRequest true "Field definition"
+// @Success 201 {object} controllers.CustomFieldSuccessResponse "data contains the created field"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 409 {object} helpers.APIResponse "error.code: conflict (name already used) or quota_exceeded (the event has 50 fields)"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/custom-fields [post]
+func (c *ScheduleController) CreateCustomField(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
+		return
+	}
+	var req CreateCustomFieldRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	field, err := c.Service.CreateCustomField(r.Context(), eventID, ownerID, &domain.CustomField{
+		Name: req.Name, Type: req.Type, AppliesTo: req.AppliesTo, Public: req.Public,
+	})
+	if err != nil {
+		c.writeCustomFieldError(w, r, err, "event not found")
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusCreated, field)
+}
+
+// UpdateCustomField godoc
+// @Summary Rename a custom field or change whether it is public
+// @ID UpdateCustomField
+// @Description Renames the field and/or changes whether attendees see it. Omitted properties are kept. Only the event owner can update. Requires authentication.
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param fieldID path string true "Custom field ID (UUID)"
+// @Param body body UpdateCustomFieldRequest true "Fields to update"
+// @Success 200 {object} controllers.CustomFieldSuccessResponse "data contains the updated field"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 409 {object} helpers.APIResponse "error.code: conflict (name already used)"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/custom-fields/{fieldID} [patch]
+func (c *ScheduleController) UpdateCustomField(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	fieldID := r.PathValue("fieldID")
+	if eventID == "" || fieldID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or fieldID")
+		return
+	}
+	var req UpdateCustomFieldRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	field, err := c.Service.UpdateCustomField(r.Context(), eventID, fieldID, ownerID, req.Name, req.Public)
+	if err != nil {
+		c.writeCustomFieldError(w, r, err, "event or custom field not found")
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, field)
+}
+
+// DeleteCustomField godoc
+// @Summary Delete a custom field
+// @ID DeleteCustomField
+// @Description Deletes the field and every session's or speaker's value of it. Only the event owner can delete. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param fieldID path string true "Custom field ID (UUID)"
+// @Success 204 "No content"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/custom-fields/{fieldID} [delete]
+func (c *ScheduleController) DeleteCustomField(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	fieldID := r.PathValue("fieldID")
+	if eventID == "" || fieldID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or fieldID")
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	if err := c.Service.DeleteCustomField(r.Context(), eventID, fieldID, ownerID); err != nil {
+		c.writeCustomFieldError(w, r, err, "event or custom field not found")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SetSessionCustomFields godoc
+// @Summary Set a session's custom field values
+// @ID SetSessionCustomFields
+// @Description Sets the session's values of the event's session fields, keyed by field ID. Values must match the field's type: a string for text, url and date (YYYY-MM-DD), a number or true/false. Null or an empty string clears a field; fields not listed keep their value. Returns all of the session's values. Only the event owner can set them. Requires authentication.
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param sessionID path string true "Session ID (UUID)"
+// @Param body body SetCustomFieldValuesRequest true "Values by field ID"
+// @Success 200 {object} controllers.CustomFieldValuesSuccessResponse "data contains the session's custom field values"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/sessions/{sessionID}/custom-fields [put]
+func (c *ScheduleController) SetSessionCustomFields(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	sessionID := r.PathValue("sessionID")
+	if eventID == "" || sessionID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or sessionID")
+		return
+	}
+	var req SetCustomFieldValuesRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	values, err := c.Service.SetSessionCustomFields(r.Context(), eventID, sessionID, ownerID, req.Values)
+	if err != nil {
+		c.writeCustomFieldError(w, r, err, "event or session not found")
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, values)
+}
+
+// SetSpeakerCustomFields godoc
+// @Summary Set a speaker's custom field values
+// @ID SetSpeakerCustomFields
+// @Description Sets the speaker's values of the event's speaker fields, keyed by field ID. Values must match the field's type: a string for text, url and date (YYYY-MM-DD), a number or true/false. Null or an empty string clears a field; fields not listed keep their value. Returns all of the speaker's values. Only the event owner can set them. Requires authentication.
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param speakerID path string true "Speaker ID (UUID)"
+// @Param body body SetCustomFieldValuesRequest true "Values by field ID"
+// @Success 200 {object} controllers.CustomFieldValuesSuccessResponse "data contains the speaker's custom field values"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/speakers/{speakerID}/custom-fields [put]
+func (c *ScheduleController) SetSpeakerCustomFields(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	speakerID := r.PathValue("speakerID")
+	if eventID == "" || speakerID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or speakerID")
+		return
+	}
+	var req SetCustomFieldValuesRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	values, err := c.Service.SetSpeakerCustomFields(r.Context(), eventID, speakerID, ownerID, req.Values)
+	if err != nil {
+		c.writeCustomFieldError(w, r, err, "event or speaker not found")
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, values)
+}
+
+// writeCustomFieldError writes the error response of the custom field endpoints.
+func (c *ScheduleController) writeCustomFieldError(w http.ResponseWriter, r *http.Request, err error, notFound string) {
+	if errors.Is(err, domain.ErrNotFound) {
+		helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, notFound)
+		return
+	}
+	if errors.Is(err, domain.ErrForbidden) {
+		helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+		return
+	}
+	if errors.Is(err, domain.ErrInvalidInput) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
+		return
+	}
+	if errors.Is(err, domain.ErrDuplicateCustomField) {
+		helpers.WriteJSONError(w, http.StatusConflict, helpers.ErrCodeConflict, err.Error())
+		return
+	}
+	helpers.WriteServiceError(w, r, c.Logger, err)
+}
+
+// ListMyEventsSuccessResponse is the success response envelope for GET /events/me (200).
+type ListMyEventsSuccessResponse struct {
+	Data  []*domain.Event   `json:"data"`
+	Error *helpers.APIError `json:"error"`
+}
+
+// SearchEventsResponse is the data payload for GET /events/search (200).
+type SearchEventsResponse struct {
+	Items      []*domain.EventSearchResult `json:"items"`
+	Pagination helpers.PaginationMeta      `json:"pagination"`
+}
+
+// SearchEventsSuccessResponse is the success response envelope for GET /events/search (200).
+type SearchEventsSuccessResponse struct {
+	Data  SearchEventsResponse `json:"data"`
+	Error *helpers.APIError    `json:"error"`
+}
+
+// ListJoinedEventsSuccessResponse is the success response envelope for GET /events/joined (200).
+type ListJoinedEventsSuccessResponse struct {
+	Data  []*domain.Event   `json:"data"`
+	Error *helpers.APIError `json:"error"`
+}
+
+// DeleteEventResponse is the data payload of the endpoints that delete something (200).
+type DeleteEventResponse struct {
+	Status string `json:"status"`
+}
+
+// DeleteEventSuccessResponse is the success response envelope of the endpoints that delete something (200).
+type DeleteEventSuccessResponse struct {
+	Data  DeleteEventResponse `json:"data"`
+	Error *helpers.APIError   `json:"error"`
+}
+
+// ToggleRoomNotBookableSuccessResponse is the success response envelope for PATCH /events/{eventID}/rooms/{roomID}/not-bookable (200).
+type ToggleRoomNotBookableSuccessResponse struct {
+	Data  *domain.Room      `json:"data"`
+	Error *helpers.APIError `json:"error"`
+}
+
+// CreateRoomRequest is the request body for POST /events/{eventID}/rooms.
+type CreateRoomRequest struct {
+	Name          string `json:"name"`
+	Capacity      int    `json:"capacity"`
+	Description   string `json:"description"`
+	HowToGetThere string `json:"how_to_get_there"`
+	NotBookable   bool   `json:"not_bookable"`
+}
+
+// Validate implements Validator.
+func (c CreateRoomRequest) Validate() []string {
+	var errs []string
+	if strings.TrimSpace(c.Name) == "" {
+		errs = append(errs, "name is required")
+	}
+	if c.Capacity < 0 {
+		errs = append(errs, "capacity must be non-negative")
+	}
+	return errs
+}
+
+// ToggleRoomNotBookable godoc
+// @Summary Toggle room not_bookable flag
+// @ID ToggleRoomNotBookable
+// @Description Toggles the not_bookable flag for a room. Only the event owner can toggle. Requires authentication.
+// @Tags events
+// @Produce json,application/vnd.api+json,application/vnd.m3t+json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param roomID path string true "Room ID (UUID)"
+// @Success 200 {object} controllers.ToggleRoomNotBookableSuccessResponse "data contains the updated room"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/rooms/{roomID}/not-bookable [patch]
+func (c *ScheduleController) ToggleRoomNotBookable(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	roomID := r.PathValue("roomID")
+	if eventID == "" || roomID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or roomID")
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	room, err := c.Service.ToggleRoomNotBookable(r.Context(), eventID, roomID, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event or room not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	helpers.WriteJSONSuccessWithLinks(w, r, http.StatusOK, room, helpers.RoomLinks(eventID, roomID))
+}
+
+// CreateEventRoom godoc
+// @Summary Create a room
+// @ID CreateEventRoom
+// @Description Creates a new room for the event. Only the event owner can create. Requires authentication.
+// @Tags events
+// @Accept json
+// @Produce json,application/vnd.api+json,application/vnd.m3t+json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param body body CreateRoomRequest true "Room data"
+// @Success 201 {object} controllers.CreateRoomSuccessResponse "data contains the created room"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/rooms [post]
+func (c *ScheduleController) CreateEventRoom(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
+		return
+	}
+
+	var req CreateRoomRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+
+	room, err := c.Service.CreateEventRoom(r.Context(), eventID, ownerID, req.Name, req.Capacity, req.Description, req.HowToGetThere, req.NotBookable)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+
+	helpers.WriteJSONSuccessWithLinks(w, r, http.StatusCreated, room, helpers.RoomLinks(eventID, room.ID))
+}
+
+// UpdateRoomRequest is the request body for PATCH /events/{eventID}/rooms/{roomID}.
+type UpdateRoomRequest struct {
+	Name          *string `json:"name"`
+	Capacity      int     `json:"capacity"`
+	Description   string  `json:"description"`
+	HowToGetThere string  `json:"how_to_get_there"`
+	NotBookable   *bool   `json:"not_bookable"`
+}
+
+// Validate implements Validator.
+func (u UpdateRoomRequest) Validate() []string {
+	var errs []string
+	if u.Capacity < 0 {
+		errs = append(errs, "capacity must be non-negative")
+	}
+	return errs
+}
+
+// GetRoomSuccessResponse is the success response envelope for GET /events/{eventID}/rooms/{roomID} (200).
+type GetRoomSuccessResponse struct {
+	Data  *domain.Room      `json:"data"`
+	Error *helpers.APIError `json:"error"`
+}
+
+// ListRoomsSuccessResponse is the success response envelope for GET /events/{eventID}/rooms (200).
+type ListRoomsSuccessResponse struct {
+	Data  []*domain.Room    `json:"data"`
+	Error *helpers.APIError `json:"error"`
+}
+
+// ReorderRoomsRequest is the request body for PUT /events/{eventID}/rooms/order.
+type ReorderRoomsRequest struct {
+	// RoomIDs lists every room of the event once, in the order they should be shown.
+	RoomIDs []string `json:"room_ids"`
+}
+
+// Validate implements Validator.
+func (r ReorderRoomsRequest) Validate() []string {
+	if len(r.RoomIDs) == 0 {
+		return []string{"room_ids is required"}
+	}
+	return nil
+}
+
+// ListTracksSuccessResponse is the success response envelope for GET /events/{eventID}/tracks and PUT /events/{eventID}/tracks/order (200).
+type ListTracksSuccessResponse struct {
+	Data  []string          `json:"data"`
+	Error *helpers.APIError `json:"error"`
+}
+
+// ReorderTracksRequest is the request body for PUT /events/{eventID}/tracks/order.
+type ReorderTracksRequest struct {
+	// Tracks lists every track of the event once, in the order they should be shown.
+	Tracks []string `json:"tracks"`
+}
+
+// Validate implements Validator.
+func (r ReorderTracksRequest) Validate() []string {
+	if len(r.Tracks) == 0 {
+		return []string{"tracks is required"}
+	}
+	return nil
+}
+
+// UpdateRoomSuccessResponse is the success response envelope for PATCH /events/{eventID}/rooms/{roomID} (200).
+type UpdateRoomSuccessResponse struct {
+	Data  *domain.Room      `json:"data"`
+	Error *helpers.APIError `json:"error"`
+}
+
+// DeleteRoomSuccessResponse is the success response envelope for DELETE /events/{eventID}/rooms/{roomID} (200).
+type DeleteRoomSuccessResponse struct {
+	Data  DeleteEventResponse `json:"data"`
+	Error *helpers.APIError   `json:"error"`
+}
+
+// CreateRoomSuccessResponse is the success response envelope for POST /events/{eventID}/rooms (201).
+type CreateRoomSuccessResponse struct {
+	Data  *domain.Room      `json:"data"`
+	Error *helpers.APIError `json:"error"`
+}
+
+// CreateSpeakerRequest is the request body for POST /events/{eventID}/speakers.
+type CreateSpeakerRequest struct {
+	FirstName      string `json:"first_name"`
+	LastName       string `json:"last_name"`
+	Email          string `json:"email"`
+	Bio            string `json:"bio"`
+	TagLine        string `json:"tag_line"`
+	ProfilePicture string `json:"profile_picture"`
+	IsTopSpeaker   bool   `json:"is_top_speaker"`
+}
+
+// Validate implements Validator. At least one of first_name or last_name must be non-empty.
+// email is optional; when set it is used to recognise the speaker on later imports.
+func (c CreateSpeakerRequest) Validate() []string {
+	var errs []string
+	hasName := strings.TrimSpace(c.FirstName) != "" || strings.TrimSpace(c.LastName) != ""
+	if !hasName {
+		errs = append(errs, "at least one of first_name or last_name is required")
+	}
+	if email := strings.TrimSpace(c.Email); email != "" && (len(email) > 255 || !strings.Contains(email, "@")) {
+		errs = append(errs, "email must be a valid email address of at most 255 characters")
+	}
+	return errs
+}
+
+// BulkUpdateSpeakersRequest is the request body for PATCH /events/{eventID}/speakers/bulk.
+type BulkUpdateSpeakersRequest struct {
+	Updates []*domain.SpeakerUpdate `json:"updates"`
+}
+
+// Validate implements Validator. Each update is checked by the service, which reports errors per item.
+func (b BulkUpdateSpeakersRequest) Validate() []string {
+	if len(b.Updates) == 0 {
+		return []string{"updates is required"}
+	}
+	if len(b.Updates) > domain.MaxSpeakerBulkUpdates {
+		return []string{"updates must have at most " + strconv.Itoa(domain.MaxSpeakerBulkUpdates) + " entries"}
+	}
+	for _, u := range b.Updates {
+		if u == nil {
+			return []string{"updates must not contain null"}
+		}
+	}
+	return nil
+}
+
+// BulkUpdateSpeakersSuccessResponse is the success response envelope for PATCH /events/{eventID}/speakers/bulk (200).
+type BulkUpdateSpeakersSuccessResponse struct {
+	Data  domain.SpeakerBulkUpdateResult `json:"data"`
+	Error *helpers.APIError              `json:"error"`
+}
+
+// GetEventSpeakerResponse is the data payload for GET /events/{eventID}/speakers/{speakerID} (200).
+type GetEventSpeakerResponse struct {
+	Speaker  *domain.Speaker   `json:"speaker"`
+	Sessions []*domain.Session `json:"sessions"`
+}
+
+// ListSpeakersSuccessResponse is the success response envelope for GET /events/{eventID}/speakers (200).
+type ListSpeakersSuccessResponse struct {
+	Data  []*domain.Speaker `json:"data"`
+	Error *helpers.APIError `json:"error"`
+}
+
+// GetEventSpeakerSuccessResponse is the success response envelope for GET /events/{eventID}/speakers/{speakerID} (200).
+type GetEventSpeakerSuccessResponse struct {
+	Data  GetEventSpeakerResponse `json:"data"`
+	Error *helpers.APIError       `json:"error"`
+}
+
+// CreateSpeakerSuccessResponse is the success response envelope for POST /events/{eventID}/speakers (201).
+type CreateSpeakerSuccessResponse struct {
+	Data  *domain.Speaker   `json:"data"`
+	Error *helpers.APIError `json:"error"`
+}
+
+// ListSpeakerMergeCandidatesSuccessResponse is the success response envelope for GET /events/{eventID}/speakers/merge-candidates (200).
+type ListSpeakerMergeCandidatesSuccessResponse struct {
+	Data  []*domain.SpeakerMergeCandidate `json:"data"`
+	Error *helpers.APIError               `json:"error"`
+}
+
+// ResolveSpeakerMergeRequest is the request body for POST /events/{eventID}/speakers/merge-candidates/{candidateID}.
+type ResolveSpeakerMergeRequest struct {
+	// Action is "merge" to fold the imported speaker into the existing one, or "reject" to keep both.
+	Action string `json:"action"`
+}
+
+// Validate implements Validator.
+func (r ResolveSpeakerMergeRequest) Validate() []string {
+	if r.Action != "merge" && r.Action != "reject" {
+		return []string{"action must be merge or reject"}
+	}
+	return nil
+}
+
+// ResolveSpeakerMergeSuccessResponse is the success response envelope for POST /events/{eventID}/speakers/merge-candidates/{candidateID} (200).
+type ResolveSpeakerMergeSuccessResponse struct {
+	Data  *domain.SpeakerMergeCandidate `json:"data"`
+	Error *helpers.APIError             `json:"error"`
+}
+
+// ListEventRooms godoc
+// @Summary List rooms for an event
+// @ID ListEventRooms
+// @Description Returns the list of rooms for the event. Only the event owner can list. Requires authentication.
+// @Tags events
+// @Produce json,application/vnd.api+json,application/vnd.m3t+json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Success 200 {object} controllers.ListRoomsSuccessResponse "data is an array of rooms"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/rooms [get]
+func (c *ScheduleController) ListEventRooms(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	rooms, err := c.Service.ListEventRooms(r.Context(), eventID, ownerID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	if rooms == nil {
+		rooms = []*domain.Room{}
+	}
+	helpers.WriteJSONSuccessWithLinks(w, r, http.StatusOK, rooms, helpers.RoomsLinks(eventID))
+}
+
+// ReorderEventRooms godoc
+// @Summary Set the display order of an event's rooms
+// @ID ReorderEventRooms
+// @Description Sets the order rooms are shown in; tracks have their own order (PUT /events/{eventID}/tracks/order). room_ids must list every room of the event exactly once; the first one is shown first. The order is used by the room list, the schedule grid, the attendee schedule and the public schedule. New rooms are added last. Only the event owner can reorder. Requires authentication.
+// @Tags events
+// @Accept json
+// @Produce json,application/vnd.api+json,application/vnd.m3t+json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param body body ReorderRoomsRequest true "Room IDs in display order"
+// @Success 200 {object} controllers.ListRoomsSuccessResponse "data is the rooms in their new order"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request (room_ids missing, repeated or not the event's rooms)"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/rooms/order [put]
+func (c *ScheduleController) ReorderEventRooms(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
+		return
+	}
+	var req ReorderRoomsRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	rooms, err := c.Service.ReorderEventRooms(r.Context(), eventID, ownerID, req.RoomIDs)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		if errors.Is(err, domain.ErrInvalidInput) {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	helpers.WriteJSONSuccessWithLinks(w, r, http.StatusOK, rooms, helpers.RoomsLinks(eventID))
+}
+
+// ListEventTracks godoc
+// @Summary List an event's tracks in display order
+// @ID ListEventTracks
+// @Description Returns the tracks of the event's sessions (the session track field) in display order: those placed with PUT /events/{eventID}/tracks/order first, then new ones by name. Only the event owner can list. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Success 200 {object} controllers.ListTracksSuccessResponse "data is the track names in order"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/tracks [get]
+func (c *ScheduleController) ListEventTracks(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	tracks, err := c.Service.ListEventTracks(r.Context(), eventID, ownerID)
+	if err != nil {
+		c.writeTrackError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, tracks)
+}
+
+// ReorderEventTracks godoc
+// @Summary Set the display order of an event's tracks
+// @ID ReorderEventTracks
+// @Description Sets the order tracks are shown in. tracks must list every track of the event's sessions exactly once; the first one is shown first. The order is used by the tracks list of the schedule grid, the attendee, public, machine and as_of schedules and the offline bundle. Tracks that sessions get later are added last, by name. Only the event owner can reorder. Requires authentication.
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param body body ReorderTracksRequest true "Track names in display order"
+// @Success 200 {object} controllers.ListTracksSuccessResponse "data is the tracks in their new order"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request (tracks missing, repeated or not the event's tracks)"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/tracks/order [put]
+func (c *ScheduleController) ReorderEventTracks(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
+		return
+	}
+	var req ReorderTracksRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	tracks, err := c.Service.ReorderEventTracks(r.Context(), eventID, ownerID, req.Tracks)
+	if err != nil {
+		c.writeTrackError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, tracks)
+}
+
+func (c *ScheduleController) writeTrackError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+	case errors.Is(err, domain.ErrForbidden):
+		helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+	case errors.Is(err, domain.ErrInvalidInput):
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
+	default:
+		helpers.WriteServiceError(w, r, c.Logger, err)
+	}
+}
+
+// GetEventRoom godoc
+// @Summary Get a room by ID
+// @ID GetEventRoom
+// @Description Returns a single room for the event. Only the event owner can access. Requires authentication.
+// @Tags events
+// @Produce json,application/vnd.api+json,application/vnd.m3t+json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param roomID path string true "Room ID (UUID)"
+// @Success 200 {object} controllers.GetRoomSuccessResponse "data contains the room"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/rooms/{roomID} [get]
+func (c *ScheduleController) GetEventRoom(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	roomID := r.PathValue("roomID")
+	if eventID == "" || roomID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or roomID")
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	room, err := c.Service.GetEventRoom(r.Context(), eventID, roomID, ownerID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event or room not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	helpers.WriteJSONSuccessWithLinks(w, r, http.StatusOK, room, helpers.RoomLinks(eventID, roomID))
+}
+
+// UpdateEventRoom godoc
+// @Summary Update a room
+// @ID UpdateEventRoom
+// @Description Updates room details (name, capacity, description, how_to_get_there, not_bookable). Only the event owner can update. Optional fields omitted from body are unchanged (name and not_bookable keep current value when omitted). Requires authentication.
+// @Tags events
+// @Accept json
+// @Produce json,application/vnd.api+json,application/vnd.m3t+json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param roomID path string true "Room ID (UUID)"
+// @Param body body UpdateRoomRequest true "Room fields to update"
+// @Success 200 {object} controllers.UpdateRoomSuccessResponse "data contains the updated room"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/rooms/{roomID} [patch]
+func (c *ScheduleController) UpdateEventRoom(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	roomID := r.PathValue("roomID")
+	if eventID == "" || roomID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or roomID")
+		return
+	}
+	var req UpdateRoomRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	room, err := c.Service.UpdateEventRoom(r.Context(), eventID, roomID, ownerID, req.Name, req.Capacity, req.Description, req.HowToGetThere, req.NotBookable)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event or room not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	helpers.WriteJSONSuccessWithLinks(w, r, http.StatusOK, room, helpers.RoomLinks(eventID, roomID))
+}
+
+// DeleteEventRoom godoc
+// @Summary Delete a room
+// @ID DeleteEventRoom
+// @Description Deletes a room. mode decides what happens to its sessions: cascade (default) deletes them, reassign moves them to target_room_id, and unschedule keeps them without a room. With dry_run=true nothing is changed: data is a RoomDeletionPreview (event_id, room_id, mode, dry_run, and sessions listing {id, name, action} with action delete or move). Only the event owner can delete. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param roomID path string true "Room ID (UUID)"
+// @Param mode query string false "What happens to the room's sessions" Enums(cascade, reassign, unschedule)
+// @Param target_room_id query string false "Room (UUID) that receives the sessions; required when mode is reassign"
+// @Param dry_run query bool false "List the sessions the deletion would delete or move without changing anything"
+// @Success 200 {object} controllers.DeleteRoomSuccessResponse "data contains status, or in a dry run the deletion preview"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/rooms/{roomID} [delete]
+func (c *ScheduleController) DeleteEventRoom(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	roomID := r.PathValue("roomID")
+	if eventID == "" || roomID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or roomID")
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	mode := r.URL.Query().Get("mode")
+	targetRoomID := r.URL.Query().Get("target_room_id")
+	switch mode {
+	case "", domain.RoomDeleteCascade, domain.RoomDeleteUnschedule:
+		if targetRoomID != "" {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "target_room_id is only allowed with mode reassign")
+			return
+		}
+	case domain.RoomDeleteReassign:
+		if targetRoomID == "" {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "target_room_id is required with mode reassign")
+			return
+		}
+	default:
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "mode must be cascade, reassign or unschedule")
+		return
+	}
+	dryRun, ok := helpers.ParseDryRun(w, r)
+	if !ok {
+		return
+	}
+	var preview *domain.RoomDeletionPreview
+	var err error
+	if dryRun {
+		preview, err = c.Service.PreviewDeleteEventRoom(r.Context(), eventID, roomID, ownerID, mode, targetRoomID)
+	} else {
+		err = c.Service.DeleteEventRoom(r.Context(), eventID, roomID, ownerID, mode, targetRoomID)
+	}
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event or room not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	if dryRun {
+		helpers.WriteJSONSuccess(w, http.StatusOK, preview)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, DeleteEventResponse{Status: "deleted"})
+}
+
+// ListEventSpeakers godoc
+// @Summary List speakers for an event
+// @ID ListEventSpeakers
+// @Description Returns the list of speakers for the event. Only the event owner can list. Requires authentication.
+// @Tags events
+// @Produce json,application/vnd.api+json,application/vnd.m3t+json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Success 200 {object} controllers.ListSpeakersSuccessResponse "data is an array of speakers"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/speakers [get]
+func (c *ScheduleController) ListEventSpeakers(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	speakers, err := c.Service.ListEventSpeakers(r.Context(), eventID, ownerID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	if speakers == nil {
+		speakers = []*domain.Speaker{}
+	}
+	helpers.WriteJSONSuccessWithLinks(w, r, http.StatusOK, speakers, helpers.SpeakersLinks(eventID))
+}
+
+// GetEventSpeaker godoc
+// @Summary Get a speaker by ID
+// @ID GetEventSpeaker
+// @Description Returns a single speaker for the event with the list of sessions they speak in. Only the event owner can access. Requires authentication.
+// @Tags events
+// @Produce json,application/vnd.api+json,application/vnd.m3t+json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param speakerID path string true "Speaker ID (UUID)"
+// @Success 200 {object} controllers.GetEventSpeakerSuccessResponse "data contains speaker and sessions"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/speakers/{speakerID} [get]
+func (c *ScheduleController) GetEventSpeaker(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	speakerID := r.PathValue("speakerID")
+	if eventID == "" || speakerID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or speakerID")
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	speaker, sessions, err := c.Service.GetEventSpeaker(r.Context(), eventID, speakerID, ownerID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event or speaker not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	if sessions == nil {
+		sessions = []*domain.Session{}
+	}
+	helpers.WriteJSONSuccessWithLinks(w, r, http.StatusOK, GetEventSpeakerResponse{Speaker: speaker, Sessions: sessions}, helpers.SpeakerLinks(eventID, speakerID))
+}
+
+// DeleteEventSpeaker godoc
+// @Summary Delete a speaker
+// @ID DeleteEventSpeaker
+// @Description Deletes a speaker. Session-speaker links are removed by cascade. Only the event owner can delete. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param speakerID path string true "Speaker ID (UUID)"
+// @Success 204 "No content"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/speakers/{speakerID} [delete]
+func (c *ScheduleController) DeleteEventSpeaker(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	speakerID := r.PathValue("speakerID")
+	if eventID == "" || speakerID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or speakerID")
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	if err := c.Service.DeleteEventSpeaker(r.Context(), eventID, speakerID, ownerID); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event or speaker not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// CreateEventSpeaker godoc
+// @Summary Create a speaker
+// @ID CreateEventSpeaker
+// @Description Creates a new speaker for the event (manual create). Only the event owner can create. Requires authentication.
+// @Tags events
+// @Accept json
+// @Produce json,application/vnd.api+json,application/vnd.m3t+json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param body body CreateSpeakerRequest true "Speaker data"
+// @Success 201 {object} controllers.CreateSpeakerSuccessResponse "data contains the created speaker"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/speakers [post]
+func (c *ScheduleController) CreateEventSpeaker(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
+		return
+	}
+	var req CreateSpeakerRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	speaker, err := c.Service.CreateEventSpeaker(r.Context(), eventID, ownerID, req.FirstName, req.LastName, strings.TrimSpace(req.Email), req.Bio, req.TagLine, req.ProfilePicture, req.IsTopSpeaker)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	helpers.WriteJSONSuccessWithLinks(w, r, http.StatusCreated, speaker, helpers.SpeakerLinks(eventID, speaker.ID))
+}
+
+// BulkUpdateSpeakers godoc
+// @Summary Update many speakers at once
+// @ID BulkUpdateSpeakers
+// @Description Applies profile updates to many of the event's speakers in one transaction, for spreadsheet-style editing. Each update names a speaker_id and the fields to change; omitted fields keep their value and an empty string clears a text field. When any update is invalid (unknown speaker, speaker listed twice, nothing to change, no name left, bad email) nothing is applied: applied is false and each result's error says which items failed. Otherwise applied is true and each result has the updated speaker. With dry_run=true nothing is applied: dry_run is true and each valid result has the speaker as it would be. At most 500 updates per request. Only the event owner can update speakers. Requires authentication.
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param body body BulkUpdateSpeakersRequest true "Speaker updates"
+// @Param dry_run query bool false "Validate the updates and show the result without applying them"
+// @Success 200 {object} controllers.BulkUpdateSpeakersSuccessResponse "data contains whether the updates were applied and the per-item results"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/speakers/bulk [patch]
+func (c *ScheduleController) BulkUpdateSpeakers(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
+		return
+	}
+	dryRun, ok := helpers.ParseDryRun(w, r)
+	if !ok {
+		return
+	}
+	var req BulkUpdateSpeakersRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	result, err := c.Service.BulkUpdateSpeakers(r.Context(), eventID, ownerID, req.Updates, dryRun)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		if errors.Is(err, domain.ErrInvalidInput) {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid input")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, result)
+}
+
+// ListSpeakerMergeCandidates godoc
+// @Summary List possible duplicate speakers
+// @ID ListSpeakerMergeCandidates
+// @Description Returns the pending pairs of an imported speaker and an existing speaker that may be the same person. Sessionize imports queue a pair when the match is not certain (for example similar names, or equal names with different emails). Only the event owner can list. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Success 200 {object} controllers.ListSpeakerMergeCandidatesSuccessResponse "data is an array of pending merge candidates"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/speakers/merge-candidates [get]
+func (c *ScheduleController) ListSpeakerMergeCandidates(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	candidates, err := c.Service.ListSpeakerMergeCandidates(r.Context(), eventID, ownerID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, candidates)
+}
+
+// ResolveSpeakerMergeCandidate godoc
+// @Summary Merge or reject a possible duplicate speaker
+// @ID ResolveSpeakerMergeCandidate
+// @Description Resolves a pending merge candidate. "merge" moves the imported speaker's sessions and Sessionize link onto the existing speaker and deletes the imported one, so the existing speaker keeps its ID. "reject" keeps both speakers and stops the pair from being suggested again. Only the event owner can resolve. Requires authentication.
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param candidateID path string true "Merge candidate ID (UUID)"
+// @Param body body ResolveSpeakerMergeRequest true "Resolution"
+// @Success 200 {object} controllers.ResolveSpeakerMergeSuccessResponse "data contains the resolved merge candidate"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 409 {object} helpers.APIResponse "error.code: conflict (already merged or rejected)"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/speakers/merge-candidates/{candidateID} [post]
+func (c *ScheduleController) ResolveSpeakerMergeCandidate(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	candidateID := r.PathValue("candidateID")
+	if eventID == "" || candidateID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or candidateID")
+		return
+	}
+	var req ResolveSpeakerMergeRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	candidate, err := c.Service.ResolveSpeakerMergeCandidate(r.Context(), eventID, candidateID, ownerID, req.Action == "merge")
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event or merge candidate not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		if errors.Is(err, domain.ErrAlreadyResolved) {
+			helpers.WriteJSONError(w, http.StatusConflict, helpers.ErrCodeConflict, "merge candidate already resolved")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, candidate)
+}
+
+// ListMyEvents godoc
+// @Summary List events the current user owns or helps run
+// @ID ListMyEvents
+// @Description Returns the events the authenticated user owns or is a team member of, newest first. Each event has role set to owner or team_member. owner_only=true leaves out the events the user is only a team member of. With include=stats each event carries stats: its room, session and speaker counts, invitations sent and accepted, and team size (owner included), all computed in one query. Requires Bearer token.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param owner_only query bool false "Only return events the user owns"
+// @Param include query string false "Comma-separated extras to include; the only one is stats"
+// @Success 200 {object} controllers.ListMyEventsSuccessResponse "data is an array of events"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request (invalid owner_only or unknown include)"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/me [get]
+func (c *ScheduleController) ListMyEvents(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	var params domain.MyEventsParams
+	if s := r.URL.Query().Get("owner_only"); s != "" {
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "owner_only must be true or false")
+			return
+		}
+		params.OwnerOnly = v
+	}
+	if include := r.URL.Query().Get("include"); include != "" {
+		for _, v := range strings.Split(include, ",") {
+			switch strings.TrimSpace(v) {
+			case "stats":
+				params.WithStats = true
+			case "":
+			default:
+				helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "include must be a comma-separated list of: stats")
+				return
+			}
+		}
+	}
+	events, err := c.Service.ListMyEvents(r.Context(), userID, params)
+	if err != nil {
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	if events == nil {
+		events = []*domain.Event{}
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, events)
+}
+
+// SearchEvents godoc
+// @Summary Search the current user's events
+// @ID SearchEvents
+// @Description Returns a paginated list of events the authenticated user owns, is a team member of or is registered for, each with the user's roles in it. Newest event date first; events without a date come last. Optional search matches the name, event code or description (case-insensitive). from and to (YYYY-MM-DD, inclusive) limit results to events dated in that range. role (comma-separated owner, team_member, attendee) keeps events where the user has any of the given roles. Requires Bearer token.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param search query string false "Filter events whose name, code or description contains this string (case-insensitive)"
+// @Param from query string false "Earliest event date (YYYY-MM-DD)"
+// @Param to query string false "Latest event date (YYYY-MM-DD)"
+// @Param role query string false "Comma-separated roles: owner, team_member, attendee"
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Page size (default 20, max 100)"
+// @Success 200 {object} controllers.SearchEventsSuccessResponse "data contains items and pagination"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/search [get]
+func (c *ScheduleController) SearchEvents(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	q := r.URL.Query()
+	params := domain.EventSearchParams{
+		Query:      strings.TrimSpace(q.Get("search")),
+		Pagination: helpers.ParsePagination(r),
+	}
+	if s := q.Get("from"); s != "" {
+		from, err := time.Parse(time.DateOnly, s)
+		if err != nil {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "from must be YYYY-MM-DD")
+			return
+		}
+		params.From = &from
+	}
+	if s := q.Get("to"); s != "" {
+		to, err := time.Parse(time.DateOnly, s)
+		if err != nil {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "to must be YYYY-MM-DD")
+			return
+		}
+		// to is inclusive; the search takes the first instant after it.
+		to = to.AddDate(0, 0, 1)
+		params.To = &to
+	}
+	for _, role := range strings.Split(q.Get("role"), ",") {
+		if role = strings.TrimSpace(role); role != "" {
+			params.Roles = append(params.Roles, role)
+		}
+	}
+	results, total, err := c.Service.SearchEvents(r.Context(), userID, params)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	if results == nil {
+		results = []*domain.EventSearchResult{}
+	}
+	meta := helpers.NewPaginationMeta(params.Pagination.Page, params.Pagination.PageSize, total)
+	helpers.WriteJSONSuccess(w, http.StatusOK, SearchEventsResponse{Items: results, Pagination: meta})
+}
+
+// ListJoinedEvents godoc
+// @Summary List events the current user has joined as a team member
+// @ID ListJoinedEvents
+// @Description Returns events where the authenticated user is a team member but not the owner, newest first. Requires Bearer token.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} controllers.ListJoinedEventsSuccessResponse "data is an array of events"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/joined [get]
+func (c *ScheduleController) ListJoinedEvents(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	events, err := c.Service.ListJoinedEvents(r.Context(), userID)
+	if err != nil {
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	if events == nil {
+		events = []*domain.Event{}
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, events)
+}
+
+// AddEventTeamMemberRequest is the request body for POST /events/{eventID}/team-members.
+type AddEventTeamMemberRequest struct {
+	Email string `json:"email"`
+}
+
+// Validate implements Validator.
+func (a AddEventTeamMemberRequest) Validate() []string {
+	var errs []string
+	if a.Email == "" {
+		errs = append(errs, "email is required")
+	} else if !emailRegex.MatchString(strings.TrimSpace(a.Email)) {
+		errs = append(errs, "email must be a valid email address")
+	}
+	return errs
+}
+
+// AddEventTeamMemberSuccessResponse is the success response envelope for POST /events/{eventID}/team-members (201).
+type AddEventTeamMemberSuccessResponse struct {
+	Data  *domain.EventTeamMember `json:"data"`
+	Error *helpers.APIError       `json:"error"`
+}
+
+// AddEventTeamMember godoc
+// @Summary Add a team member to an event
+// @ID AddEventTeamMember
+// @Description Add a user as a team member of the event by email. Only the event owner can add. Returns 404 with a message if no user exists with that email. Requires authentication.
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param body body AddEventTeamMemberRequest true "Email of the user to add"
+// @Success 201 {object} controllers.AddEventTeamMemberSuccessResponse "data contains the added team member"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found or user_not_found (no user with that email)"
+// @Failure 409 {object} helpers.APIResponse "error.code: already_member or conflict (invalid)"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/team-members [post]
+func (c *ScheduleController) AddEventTeamMember(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
+		return
+	}
+	var req AddEventTeamMemberRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	member, err := c.Service.AddEventTeamMemberByEmail(r.Context(), eventID, req.Email, ownerID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeUserNotFound, "no user with that email")
+			return
+		}
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			middleware.LogSecurityEvent(c.Logger, r, "team member add refused", middleware.SecurityEvent{
+				Type: middleware.SecurityTeamMemberAdd, Outcome: middleware.SecurityOutcomeDenied, EventID: eventID,
+			})
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		if errors.Is(err, domain.ErrAlreadyMember) {
+			helpers.WriteJSONError(w, http.StatusConflict, helpers.ErrCodeAlreadyMember, err.Error())
+			return
+		}
+		if errors.Is(err, domain.ErrInvalidInput) {
+			helpers.WriteJSONError(w, http.StatusConflict, helpers.ErrCodeConflict, err.Error())
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	middleware.LogSecurityEvent(c.Logger, r, "team member added", middleware.SecurityEvent{
+		Type: middleware.SecurityTeamMemberAdd, Outcome: middleware.SecurityOutcomeSuccess,
+		EventID: eventID, TargetType: "user", TargetID: member.UserID,
+		Details: []slog.Attr{slog.String("role", domain.EventRoleTeamMember)},
+	})
+	helpers.WriteJSONSuccess(w, http.StatusCreated, member)
+}
+
+// PromoteInvitationRequest is the request body for POST /events/{eventID}/invitations/{invitationID}/promote.
+type PromoteInvitationRequest struct {
+	// Role is the role to grant; team_member, the only team role, when omitted.
+	Role string `json:"role"`
+}
+
+// Validate implements Validator.
+func (p PromoteInvitationRequest) Validate() []string {
+	if p.Role != "" && p.Role != domain.EventRoleTeamMember {
+		return []string{"role must be " + domain.EventRoleTeamMember}
+	}
+	return nil
+}
+
+// PromoteInvitation godoc
+// @Summary Promote an invitee to team member
+// @ID PromoteInvitation
+// @Description Adds the user the invitation was sent to as a team member of the event, resolved by the invited email. role defaults to team_member, the only team role. Only the event owner can promote. Returns 404 user_not_found if nobody has signed up with the invited email yet. Requires authentication.
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param invitationID path string true "Invitation ID (UUID)"
+// @Param body body PromoteInvitationRequest true "Role to grant"
+// @Success 201 {object} controllers.AddEventTeamMemberSuccessResponse "data contains the added team member"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found, invitation_not_found or user_not_found"
+// @Failure 409 {object} helpers.APIResponse "error.code: already_member or conflict (invitee is the owner)"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/invitations/{invitationID}/promote [post]
+func (c *ScheduleController) PromoteInvitation(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	invitationID := r.PathValue("invitationID")
+	if eventID == "" || invitationID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or invitationID")
+		return
+	}
+	var req PromoteInvitationRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	member, err := c.Service.PromoteInvitation(r.Context(), eventID, invitationID, ownerID, req.Role)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvitationNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeInvitationNotFound, "invitation not found")
+			return
+		}
+		if errors.Is(err, domain.ErrUserNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeUserNotFound, "no user with the invited email")
+			return
+		}
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			middleware.LogSecurityEvent(c.Logger, r, "invitation promotion refused", middleware.SecurityEvent{
+				Type: middleware.SecurityInvitationPromote, Outcome: middleware.SecurityOutcomeDenied, EventID: eventID,
+				Details: []slog.Attr{slog.String("invitation_id", invitationID)},
+			})
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		if errors.Is(err, domain.ErrAlreadyMember) {
+			helpers.WriteJSONError(w, http.StatusConflict, helpers.ErrCodeAlreadyMember, err.Error())
+			return
+		}
+		if errors.Is(err, domain.ErrInvalidInput) {
+			helpers.WriteJSONError(w, http.StatusConflict, helpers.ErrCodeConflict, err.Error())
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	middleware.LogSecurityEvent(c.Logger, r, "invitation promoted to team member", middleware.SecurityEvent{
+		Type: middleware.SecurityInvitationPromote, Outcome: middleware.SecurityOutcomeSuccess,
+		EventID: eventID, TargetType: "user", TargetID: member.UserID,
+		Details: []slog.Attr{slog.String("invitation_id", invitationID), slog.String("role", domain.EventRoleTeamMember)},
+	})
+	helpers.WriteJSONSuccess(w, http.StatusCreated, member)
+}
+
+// ListEventTeamMembersSuccessResponse is the success response envelope for GET /events/{eventID}/team-members (200).
+type ListEventTeamMembersSuccessResponse struct {
+	Data  []*domain.EventTeamMember `json:"data"`
+	Error *helpers.APIError         `json:"error"`
+}
+
+// ListEventTeamMembers godoc
+// @Summary List team members of an event
+// @ID ListEventTeamMembers
+// @Description Returns the list of team members for the event with each member's name, email and avatar_url. Only the event owner can list. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Success 200 {object} controllers.ListEventTeamMembersSuccessResponse "data is an array of team members"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/team-members [get]
+func (c *ScheduleController) ListEventTeamMembers(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
+		return
+	}
+	callerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	members, err := c.Service.ListEventTeamMembers(r.Context(), eventID, callerID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	if members == nil {
+		members = []*domain.EventTeamMember{}
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, members)
+}
+
+// RemoveEventTeamMemberResponse is the data payload for DELETE /events/{eventID}/team-members/{userID} (200).
+type RemoveEventTeamMemberResponse struct {
+	Status string `json:"status"`
+}
+
+// RemoveEventTeamMemberSuccessResponse is the success response envelope for DELETE /events/{eventID}/team-members/{userID} (200).
+type RemoveEventTeamMemberSuccessResponse struct {
+	Data  RemoveEventTeamMemberResponse `json:"data"`
+	Error *helpers.APIError             `json:"error"`
+}
+
+// RemoveEventTeamMember godoc
+// @Summary Remove a team member from an event
+// @ID RemoveEventTeamMember
+// @Description Remove a user from the event's team members. Only the event owner can remove. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param userID path string true "User ID (UUID) of the team member to remove"
+// @Success 200 {object} controllers.RemoveEventTeamMemberSuccessResponse "data contains status"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/team-members/{userID} [delete]
+func (c *ScheduleController) RemoveEventTeamMember(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	userID := r.PathValue("userID")
+	if eventID == "" || userID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or userID")
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	err := c.Service.RemoveEventTeamMember(r.Context(), eventID, userID, ownerID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event or team member not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			middleware.LogSecurityEvent(c.Logger, r, "team member removal refused", middleware.SecurityEvent{
+				Type: middleware.SecurityTeamMemberRemove, Outcome: middleware.SecurityOutcomeDenied,
+				EventID: eventID, TargetType: "user", TargetID: userID,
+			})
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	middleware.LogSecurityEvent(c.Logger, r, "team member removed", middleware.SecurityEvent{
+		Type: middleware.SecurityTeamMemberRemove, Outcome: middleware.SecurityOutcomeSuccess,
+		EventID: eventID, TargetType: "user", TargetID: userID,
+	})
+	helpers.WriteJSONSuccess(w, http.StatusOK, RemoveEventTeamMemberResponse{Status: "removed"})
+}
+
+// LeaveEvent godoc
+// @Summary Leave an event's team
+// @ID LeaveEvent
+// @Description Removes the authenticated user from the event's team members and emails the event owner. The owner cannot leave their own event. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Success 200 {object} controllers.RemoveEventTeamMemberSuccessResponse "data.status is left"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request (caller is the owner)"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found (no such event or caller is not a team member)"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/team-members/me [delete]
+func (c *ScheduleController) LeaveEvent(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	ownerNotified, err := c.Service.LeaveEvent(r.Context(), eventID, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event not found or not a team member")
+			return
+		}
+		if errors.Is(err, domain.ErrInvalidInput) {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	middleware.LogSecurityEvent(c.Logger, r, "team member left event", middleware.SecurityEvent{
+		Type: middleware.SecurityTeamMemberLeave, Outcome: middleware.SecurityOutcomeSuccess, UserID: userID,
+		EventID: eventID, TargetType: "user", TargetID: userID,
+		Details: []slog.Attr{slog.Bool("owner_notified", ownerNotified)},
+	})
+	helpers.WriteJSONSuccess(w, http.StatusOK, RemoveEventTeamMemberResponse{Status: "left"})
+}
+
+// ListEventInvitationsResponse is the data payload for GET /events/{eventID}/invitations (200).
+type ListEventInvitationsResponse struct {
+	Items      []*domain.EventInvitation `json:"items"`
+	Pagination helpers.PaginationMeta    `json:"pagination"`
+}
+
+// ListEventInvitationsSuccessResponse is the success response envelope for GET /events/{eventID}/invitations (200).
+type ListEventInvitationsSuccessResponse struct {
+	Data  ListEventInvitationsResponse `json:"data"`
+	Error *helpers.APIError            `json:"error"`
+}
+
+// ListEventInvitations godoc
+// @Summary List invited emails for an event
+// @ID ListEventInvitations
+// @Description Returns a paginated list of emails invited to the event (with id and sent_at). accepted_by holds the user who registered for the event with the invited email, if any. Only the event owner can list. Use page and page_size query params. Optional search filters by email substring (case-insensitive). With Accept: application/x-ndjson, every matching invitation is streamed as one JSON object per line and pagination is ignored. Requires authentication.
+// @Tags events
+// @Produce json,application/x-ndjson
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param search query string false "Filter emails containing this string (case-insensitive)"
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Page size (default 20, max 100)"
+// @Success 200 {object} controllers.ListEventInvitationsSuccessResponse "data contains items and pagination"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/invitations [get]
+func (c *ScheduleController) ListEventInvitations(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
+		return
+	}
+	callerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	search := strings.TrimSpace(r.URL.Query().Get("search"))
+	if helpers.WantsNDJSON(r) {
+		nd := helpers.NewNDJSONWriter(w)
+		rows := 0
+		err := c.Service.StreamEventInvitations(r.Context(), eventID, callerID, search, func(inv *domain.EventInvitation) error {
+			rows++
+			return nd.Write(inv)
+		})
+		c.logInvitationExport(r, eventID, "ndjson", rows, err)
+		c.finishStream(w, r, nd, err)
+		return
+	}
+	params := helpers.ParsePagination(r)
+	list, total, err := c.Service.ListEventInvitations(r.Context(), eventID, callerID, search, params)
+	c.logInvitationExport(r, eventID, "json", len(list), err)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	if list == nil {
+		list = []*domain.EventInvitation{}
+	}
+	meta := helpers.NewPaginationMeta(params.Page, params.PageSize, total)
+	helpers.WriteJSONSuccess(w, http.StatusOK, ListEventInvitationsResponse{Items: list, Pagination: meta})
+}
+
+// logInvitationExport logs a read of the event's invitation list, the attendee emails, as a security
+// event. A refused read is logged as denied; one that failed before returning any row is not logged.
+func (c *ScheduleController) logInvitationExport(r *http.Request, eventID, format string, rows int, err error) {
+	if errors.Is(err, domain.ErrForbidden) {
+		middleware.LogSecurityEvent(c.Logger, r, "attendee data export refused", middleware.SecurityEvent{
+			Type: middleware.SecurityAttendeeDataExport, Outcome: middleware.SecurityOutcomeDenied, EventID: eventID,
+			Details: []slog.Attr{slog.String("dataset", "invitations")},
+		})
+		return
+	}
+	if err != nil && rows == 0 {
+		return
+	}
+	middleware.LogSecurityEvent(c.Logger, r, "attendee data exported", middleware.SecurityEvent{
+		Type: middleware.SecurityAttendeeDataExport, Outcome: middleware.SecurityOutcomeSuccess, EventID: eventID,
+		Details: []slog.Attr{slog.String("dataset", "invitations"), slog.String("format", format), slog.Int("rows", rows)},
+	})
+}
+
+// ListEventSessionsSuccessResponse is the success response envelope for GET /events/{eventID}/sessions (200).
+type ListEventSessionsSuccessResponse struct {
+	Data  []*domain.Session `json:"data"`
+	Error *helpers.APIError `json:"error"`
+}
+
+// ListEventSessions godoc
+// @Summary List all sessions of an event
+// @ID ListEventSessions
+// @Description Returns every session of the event with its tags and speaker IDs, ordered by start time. Only the event owner can list. With Accept: application/x-ndjson, sessions are streamed as one JSON object per line as they are read. Requires authentication.
+// @Tags events
+// @Produce json,application/x-ndjson
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Success 200 {object} controllers.ListEventSessionsSuccessResponse "data contains the sessions"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/sessions [get]
+func (c *ScheduleController) ListEventSessions(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	if helpers.WantsNDJSON(r) {
+		nd := helpers.NewNDJSONWriter(w)
+		err := c.Service.StreamEventSessions(r.Context(), eventID, ownerID, func(sess *domain.Session) error {
+			return nd.Write(sess)
+		})
+		c.finishStream(w, r, nd, err)
+		return
+	}
+	sessions := []*domain.Session{}
+	err := c.Service.StreamEventSessions(r.Context(), eventID, ownerID, func(sess *domain.Session) error {
+		sessions = append(sessions, sess)
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, sessions)
+}
+
+// finishStream ends an NDJSON response. Errors before the first row get the usual JSON error
+// response; errors mid-stream are logged and reported on a trailing error line.
+func (c *ScheduleController) finishStream(w http.ResponseWriter, r *http.Request, nd *helpers.NDJSONWriter, err error) {
+	if err == nil {
+		nd.Close()
+		return
+	}
+	if !nd.Started() {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+	}
+	c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
+	if !nd.Started() {
+		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		return
+	}
+	nd.Fail(helpers.ErrCodeInternalError, err.Error())
+}
+
+// SendEventInvitationsRequest is the request body for POST /events/{eventID}/invitations.
+// Emails is a long string of emails separated by commas or spaces.
+type SendEventInvitationsRequest struct {
+	Emails string `json:"emails"`
+}
+
+// Validate implements Validator.
+func (s SendEventInvitationsRequest) Validate() []string {
+	if strings.TrimSpace(s.Emails) == "" {
+		return []string{"emails is required"}
 	}
 	return nil
 }
@@ -1195,16 +3438,90 @@ func parseEmailsFromString(raw string) []string {
 	return out
 }
 
-// SendEventInvitationsResponse is the data payload for POST /events/{eventID}/invitations (200).
-type SendEventInvitationsResponse struct {
-	Sent   int      `json:"sent"`
-	Failed []string `json:"failed"`
+// SendEventInvitationsResponse is the data payload for POST /events/{eventID}/invitations (200).
+type SendEventInvitationsResponse struct {
+	Sent   int      `json:"sent"`
+	Failed []string `json:"failed"`
+	// Skipped are the addresses the event's domain rules do not permit; they were not invited.
+	Skipped []string `json:"skipped"`
+}
+
+// SendEventInvitationsSuccessResponse is the success response envelope for POST /events/{eventID}/invitations (200).
+type SendEventInvitationsSuccessResponse struct {
+	Data  SendEventInvitationsResponse `json:"data"`
+	Error *helpers.APIError            `json:"error"`
+}
+
+// EventAnonymizationSuccessResponse is the success response envelope for POST /events/{eventID}/anonymize (200).
+type EventAnonymizationSuccessResponse struct {
+	Data  *domain.EventAnonymization `json:"data"`
+	Error *helpers.APIError          `json:"error"`
+}
+
+// InvitationDomainRulesSuccessResponse is the success response envelope for GET and PUT /events/{eventID}/invitations/domain-rules (200).
+type InvitationDomainRulesSuccessResponse struct {
+	Data  *domain.InvitationDomainRules `json:"data"`
+	Error *helpers.APIError             `json:"error"`
+}
+
+// UpdateInvitationDomainRulesRequest is the request body for PUT /events/{eventID}/invitations/domain-rules.
+// Domains are matched case-insensitively and cover their subdomains.
+type UpdateInvitationDomainRulesRequest struct {
+	AllowedDomains []string `json:"allowed_domains"`
+	BlockedDomains []string `json:"blocked_domains"`
+}
+
+// Validate implements Validator.
+func (u UpdateInvitationDomainRulesRequest) Validate() []string {
+	var errs []string
+	allowed := make(map[string]bool, len(u.AllowedDomains))
+	for _, list := range []struct {
+		field   string
+		domains []string
+	}{{"allowed_domains", u.AllowedDomains}, {"blocked_domains", u.BlockedDomains}} {
+		if len(list.domains) > domain.MaxInvitationDomains {
+			errs = append(errs, list.field+" must have at most "+strconv.Itoa(domain.MaxInvitationDomains)+" entries")
+		}
+		for _, d := range list.domains {
+			d = domain.NormalizeEmailDomain(d)
+			if len(d) > 253 || !emailDomainRegex.MatchString(d) {
+				errs = append(errs, list.field+" entries must be domain names such as acme.com")
+				break
+			}
+		}
+	}
+	for _, d := range u.AllowedDomains {
+		allowed[domain.NormalizeEmailDomain(d)] = true
+	}
+	for _, d := range u.BlockedDomains {
+		if allowed[domain.NormalizeEmailDomain(d)] {
+			errs = append(errs, "a domain cannot be both allowed and blocked")
+			break
+		}
+	}
+	return errs
+}
+
+// RemindEventInvitationsRequest is the request body for POST /events/{eventID}/invitations/remind.
+type RemindEventInvitationsRequest struct {
+	// MinIntervalHours is how long since the last email an invitee must wait for a reminder.
+	// Omitted or 0 means 72; the least allowed is 24.
+	MinIntervalHours int `json:"min_interval_hours"`
+}
+
+// Validate implements Validator.
+func (r RemindEventInvitationsRequest) Validate() []string {
+	minHours := int(domain.MinInvitationReminderInterval / time.Hour)
+	if r.MinIntervalHours != 0 && r.MinIntervalHours < minHours {
+		return []string{"min_interval_hours must be at least " + strconv.Itoa(minHours)}
+	}
+	return nil
 }
 
-// SendEventInvitationsSuccessResponse is the success response envelope for POST /events/{eventID}/invitations (200).
-type SendEventInvitationsSuccessResponse struct {
-	Data  SendEventInvitationsResponse `json:"data"`
-	Error *helpers.APIError            `json:"error"`
+// RemindEventInvitationsSuccessResponse is the success response envelope for POST /events/{eventID}/invitations/remind (200).
+type RemindEventInvitationsSuccessResponse struct {
+	Data  domain.InvitationReminderResult `json:"data"`
+	Error *helpers.APIError               `json:"error"`
 }
 
 // CreateSessionRequest is the request body for POST /events/{eventID}/sessions.
@@ -1259,55 +3576,397 @@ func (u UpdateSessionScheduleRequest) Validate() []string {
 	if u.RoomID != nil && strings.TrimSpace(*u.RoomID) == "" {
 		errs = append(errs, "room_id cannot be empty")
 	}
-	return errs
-}
-
-// UpdateSessionScheduleSuccessResponse is the success response envelope for PATCH /events/{eventID}/sessions/{sessionID} (200).
-type UpdateSessionScheduleSuccessResponse struct {
-	Data  *domain.Session   `json:"data"`
-	Error *helpers.APIError `json:"error"`
+	return errs
+}
+
+// UpdateSessionScheduleSuccessResponse is the success response envelope for PATCH /events/{eventID}/sessions/{sessionID} (200).
+type UpdateSessionScheduleSuccessResponse struct {
+	Data  *domain.Session   `json:"data"`
+	Error *helpers.APIError `json:"error"`
+}
+
+// UpdateSessionSchedule godoc
+// @Summary Update session schedule
+// @ID UpdateSessionSchedule
+// @Description Moves a session to a different room and/or time slot by updating room_id, start_time, and end_time. Only the event owner can update. Optional fields omitted from body are unchanged. A new time slot must satisfy the event's schedule rules and fit inside one of its operating days. Requires authentication.
+// @Tags events
+// @Accept json
+// @Produce json,application/vnd.api+json,application/vnd.m3t+json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param sessionID path string true "Session ID (UUID)"
+// @Param body body UpdateSessionScheduleRequest true "Fields to update (all optional)"
+// @Success 200 {object} controllers.UpdateSessionScheduleSuccessResponse "data contains the updated session"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request or schedule_rule_violation (breaks the event's schedule rules or operating hours)"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 409 {object} helpers.APIResponse "error.code: session_time_conflict (overlaps another session in the room)"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/sessions/{sessionID} [patch]
+func (c *ScheduleController) UpdateSessionSchedule(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	sessionID := r.PathValue("sessionID")
+	if eventID == "" || sessionID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or sessionID")
+		return
+	}
+
+	var req UpdateSessionScheduleRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+
+	session, err := c.Service.UpdateSessionSchedule(r.Context(), eventID, sessionID, ownerID, req.RoomID, req.StartTime, req.EndTime)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event, session, or room not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		if errors.Is(err, domain.ErrScheduleRuleViolation) {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeScheduleRuleViolation, err.Error())
+			return
+		}
+		if errors.Is(err, domain.ErrInvalidInput) {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+
+	helpers.WriteJSONSuccessWithLinks(w, r, http.StatusOK, session, helpers.SessionLinks(eventID, sessionID, session.RoomID))
+}
+
+// UpdateSessionContentRequest is the request body for PATCH /events/{eventID}/sessions/{sessionID}/content.
+// All fields are optional; omitted fields are unchanged.
+type UpdateSessionContentRequest struct {
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
+}
+
+// Validate implements Validator.
+func (u UpdateSessionContentRequest) Validate() []string {
+	var errs []string
+	if u.Title != nil && strings.TrimSpace(*u.Title) == "" {
+		errs = append(errs, "title cannot be empty")
+	}
+	return errs
+}
+
+// UpdateSessionContentSuccessResponse is the success response envelope for PATCH /events/{eventID}/sessions/{sessionID}/content (200).
+type UpdateSessionContentSuccessResponse struct {
+	Data  *domain.Session   `json:"data"`
+	Error *helpers.APIError `json:"error"`
+}
+
+// UpdateSessionContent godoc
+// @Summary Update session content
+// @ID UpdateSessionContent
+// @Description Updates a session's title and/or description. Only the event owner can update. Optional fields omitted from body are unchanged. Requires authentication.
+// @Tags events
+// @Accept json
+// @Produce json,application/vnd.api+json,application/vnd.m3t+json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param sessionID path string true "Session ID (UUID)"
+// @Param body body UpdateSessionContentRequest true "Fields to update (all optional)"
+// @Success 200 {object} controllers.UpdateSessionContentSuccessResponse "data contains the updated session"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/sessions/{sessionID}/content [patch]
+func (c *ScheduleController) UpdateSessionContent(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	sessionID := r.PathValue("sessionID")
+	if eventID == "" || sessionID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or sessionID")
+		return
+	}
+
+	var req UpdateSessionContentRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+
+	session, err := c.Service.UpdateSessionContent(r.Context(), eventID, sessionID, ownerID, req.Title, req.Description)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event or session not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+
+	helpers.WriteJSONSuccessWithLinks(w, r, http.StatusOK, session, helpers.SessionLinks(eventID, sessionID, session.RoomID))
+}
+
+// ListSessionHistorySuccessResponse is the success response envelope for GET /events/{eventID}/sessions/{sessionID}/history (200).
+type ListSessionHistorySuccessResponse struct {
+	Data  []*domain.SessionChange `json:"data"`
+	Error *helpers.APIError       `json:"error"`
+}
+
+// ListSessionHistory godoc
+// @Summary List a session's room and time changes
+// @ID ListSessionHistory
+// @Description Returns every room or time move of the session, oldest first, with the room names at the time of the move. Moves are recorded when the session is rescheduled, when a Sessionize import moves it and when its room is deleted with reassign or unschedule. Only the event owner can list. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param sessionID path string true "Session ID (UUID)"
+// @Success 200 {object} controllers.ListSessionHistorySuccessResponse "data contains the changes"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/sessions/{sessionID}/history [get]
+func (c *ScheduleController) ListSessionHistory(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	sessionID := r.PathValue("sessionID")
+	if eventID == "" || sessionID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or sessionID")
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	changes, err := c.Service.ListSessionHistory(r.Context(), eventID, sessionID, ownerID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event or session not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, changes)
+}
+
+// ScheduleSnapshotSuccessResponse is the success response envelope for GET /events/{eventID}/schedule (200).
+type ScheduleSnapshotSuccessResponse struct {
+	Data  *domain.ScheduleSnapshot `json:"data"`
+	Error *helpers.APIError        `json:"error"`
+}
+
+// GetEventScheduleAsOf godoc
+// @Summary Get the event schedule as it was at a past time
+// @ID GetEventScheduleAsOf
+// @Description Returns the schedule attendees saw at as_of, laid out as GET /attendee/events/{eventID}/schedule, to settle what it said before a change. It is rebuilt from the room and time moves made since (GET /events/{eventID}/sessions/{sessionID}/history), including those made by Sessionize imports: sessions are back in the rooms and time slots they had, rooms and sessions created later are left out, and rooms deleted since are back under the name they had. Titles, descriptions, speakers and custom fields are the current ones. Sessions deleted since are listed in deleted_session_ids and sessions whose fields were edited since in edited_session_ids; partial is true when either is non-empty, since the snapshot then differs from what attendees saw. Moves older than RETENTION_SESSION_CHANGES_DAYS are not known. Only the event owner can read it; support staff use X-Act-As. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param as_of query string false "The time to show the schedule at (RFC 3339), not in the future; default now"
+// @Success 200 {object} controllers.ScheduleSnapshotSuccessResponse "data contains event and rooms (bookable only) with nested sessions, and what the snapshot could not restore"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/schedule [get]
+func (c *ScheduleController) GetEventScheduleAsOf(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
+		return
+	}
+	asOf := time.Now()
+	if s := r.URL.Query().Get("as_of"); s != "" {
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "as_of must be an RFC 3339 time")
+			return
+		}
+		asOf = t
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	snapshot, err := c.Service.GetEventScheduleAsOf(r.Context(), eventID, ownerID, asOf)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, snapshot)
+}
+
+// DeleteEventSession godoc
+// @Summary Delete a session
+// @ID DeleteEventSession
+// @Description Deletes a session. Only the event owner can delete. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param sessionID path string true "Session ID (UUID)"
+// @Success 200 {object} controllers.DeleteRoomSuccessResponse "data contains status"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/sessions/{sessionID} [delete]
+func (c *ScheduleController) DeleteEventSession(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	sessionID := r.PathValue("sessionID")
+	if eventID == "" || sessionID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or sessionID")
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	if err := c.Service.DeleteEventSession(r.Context(), eventID, sessionID, ownerID); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event or session not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, DeleteEventResponse{Status: "deleted"})
 }
 
-// UpdateSessionSchedule godoc
-// @Summary Update session schedule
-// @Description Moves a session to a different room and/or time slot by updating room_id, start_time, and end_time. Only the event owner can update. Optional fields omitted from body are unchanged. Requires authentication.
+// SendEventInvitations godoc
+// @Summary Send event invitation emails
+// @ID SendEventInvitations
+// @Description Send invitation emails to register for the event. Body contains a string of emails separated by commas or spaces. Only the event owner can invite. Each invitation is persisted and emailed; duplicates for the same event are skipped. Addresses the event's invitation domain rules do not permit are listed in skipped and not invited. Returns count of sent and lists of failed and skipped addresses.
 // @Tags events
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param eventID path string true "Event ID (UUID)"
-// @Param sessionID path string true "Session ID (UUID)"
-// @Param body body UpdateSessionScheduleRequest true "Fields to update (all optional)"
-// @Success 200 {object} controllers.UpdateSessionScheduleSuccessResponse "data contains the updated session"
-// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Param body body SendEventInvitationsRequest true "Emails string (comma or space separated)"
+// @Success 200 {object} controllers.SendEventInvitationsSuccessResponse "data contains sent count and failed and skipped lists"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request (empty or no valid emails)"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
 // @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
-// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
-// @Router /events/{eventID}/sessions/{sessionID} [patch]
-func (c *ScheduleController) UpdateSessionSchedule(w http.ResponseWriter, r *http.Request) {
+// @Router /events/{eventID}/invitations [post]
+func (c *ScheduleController) SendEventInvitations(w http.ResponseWriter, r *http.Request) {
 	eventID := r.PathValue("eventID")
-	sessionID := r.PathValue("sessionID")
-	if eventID == "" || sessionID == "" {
-		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or sessionID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
 		return
 	}
-
-	var req UpdateSessionScheduleRequest
+	var req SendEventInvitationsRequest
 	if !helpers.DecodeAndValidate(w, r, &req) {
 		return
 	}
-
+	emails := parseEmailsFromString(req.Emails)
+	if len(emails) == 0 {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "no valid emails found")
+		return
+	}
 	ownerID, ok := middleware.UserIDFromContext(r.Context())
 	if !ok {
 		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
 		return
 	}
+	sent, failed, skipped, err := c.Service.SendEventInvitations(r.Context(), eventID, ownerID, emails)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, SendEventInvitationsResponse{Sent: sent, Failed: failed, Skipped: skipped})
+}
 
-	session, err := c.Service.UpdateSessionSchedule(r.Context(), eventID, sessionID, ownerID, req.RoomID, req.StartTime, req.EndTime)
+// AnonymizeEvent godoc
+// @Summary Anonymize an event's personal data
+// @ID AnonymizeEvent
+// @Description Removes the personal data of an event that has taken place, in one transaction: invitation emails are replaced by hashes, speaker contact emails are cleared and the values of private text and URL custom fields are deleted. Registration, invitation and acceptance counts from before are kept in the returned record, which also says who anonymized the event and when; the event's stats keep using them. Anonymizing again returns the first record. With dry_run=true nothing is changed and the record returned has dry_run set and counts what would be removed. Only the event owner can anonymize. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param dry_run query bool false "Count what would be removed without changing anything"
+// @Success 200 {object} controllers.EventAnonymizationSuccessResponse "data contains the anonymization record"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request (event dated in the future)"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/anonymize [post]
+func (c *ScheduleController) AnonymizeEvent(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	dryRun, ok := helpers.ParseDryRun(w, r)
+	if !ok {
+		return
+	}
+	anonymization, err := c.Service.AnonymizeEvent(r.Context(), eventID, ownerID, dryRun)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event, session, or room not found")
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
 			return
 		}
 		if errors.Is(err, domain.ErrForbidden) {
@@ -1318,110 +3977,133 @@ func (c *ScheduleController) UpdateSessionSchedule(w http.ResponseWriter, r *htt
 			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
 			return
 		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		helpers.WriteServiceError(w, r, c.Logger, err)
 		return
 	}
-
-	helpers.WriteJSONSuccess(w, http.StatusOK, session)
-}
-
-// UpdateSessionContentRequest is the request body for PATCH /events/{eventID}/sessions/{sessionID}/content.
-// All fields are optional; omitted fields are unchanged.
-type UpdateSessionContentRequest struct {
-	Title       *string `json:"title"`
-	Description *string `json:"description"`
-}
-
-// Validate implements Validator.
-func (u UpdateSessionContentRequest) Validate() []string {
-	var errs []string
-	if u.Title != nil && strings.TrimSpace(*u.Title) == "" {
-		errs = append(errs, "title cannot be empty")
+	if dryRun {
+		helpers.WriteJSONSuccess(w, http.StatusOK, anonymization)
+		return
 	}
-	return errs
+	c.Logger.InfoContext(r.Context(), "event anonymized", "audit", "event.anonymize",
+		"event_id", eventID, "user_id", ownerID, "invitations_hashed", anonymization.InvitationsHashed,
+		"speaker_emails_cleared", anonymization.SpeakerEmailsCleared, "custom_field_values_cleared", anonymization.CustomFieldValuesCleared)
+	helpers.WriteJSONSuccess(w, http.StatusOK, anonymization)
 }
 
-// UpdateSessionContentSuccessResponse is the success response envelope for PATCH /events/{eventID}/sessions/{sessionID}/content (200).
-type UpdateSessionContentSuccessResponse struct {
-	Data  *domain.Session   `json:"data"`
-	Error *helpers.APIError `json:"error"`
+// GetInvitationDomainRules godoc
+// @Summary Get the event's invitation domain rules
+// @ID GetInvitationDomainRules
+// @Description Returns the email domains invitations can and cannot be sent to. A domain also covers its subdomains; blocked domains win over allowed ones, and with no allowed domains every domain that is not blocked is allowed. Events without saved rules return empty rules. Only the event owner can read them. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Success 200 {object} controllers.InvitationDomainRulesSuccessResponse "data contains the domain rules"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/invitations/domain-rules [get]
+func (c *ScheduleController) GetInvitationDomainRules(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	rules, err := c.Service.GetInvitationDomainRules(r.Context(), eventID, ownerID)
+	if err != nil {
+		c.writeInvitationDomainRulesError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, rules)
 }
 
-// UpdateSessionContent godoc
-// @Summary Update session content
-// @Description Updates a session's title and/or description. Only the event owner can update. Optional fields omitted from body are unchanged. Requires authentication.
+// UpdateInvitationDomainRules godoc
+// @Summary Replace the event's invitation domain rules
+// @ID UpdateInvitationDomainRules
+// @Description Replaces the email domains invitations can and cannot be sent to. Domains are lowercased and deduplicated; a leading "@" is ignored. Existing invitations are kept. Only the event owner can update. Requires authentication.
 // @Tags events
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param eventID path string true "Event ID (UUID)"
-// @Param sessionID path string true "Session ID (UUID)"
-// @Param body body UpdateSessionContentRequest true "Fields to update (all optional)"
-// @Success 200 {object} controllers.UpdateSessionContentSuccessResponse "data contains the updated session"
+// @Param body body UpdateInvitationDomainRulesRequest true "Domain rules"
+// @Success 200 {object} controllers.InvitationDomainRulesSuccessResponse "data contains the saved domain rules"
 // @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
 // @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
-// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
-// @Router /events/{eventID}/sessions/{sessionID}/content [patch]
-func (c *ScheduleController) UpdateSessionContent(w http.ResponseWriter, r *http.Request) {
+// @Router /events/{eventID}/invitations/domain-rules [put]
+func (c *ScheduleController) UpdateInvitationDomainRules(w http.ResponseWriter, r *http.Request) {
 	eventID := r.PathValue("eventID")
-	sessionID := r.PathValue("sessionID")
-	if eventID == "" || sessionID == "" {
-		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or sessionID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
 		return
 	}
-
-	var req UpdateSessionContentRequest
+	var req UpdateInvitationDomainRulesRequest
 	if !helpers.DecodeAndValidate(w, r, &req) {
 		return
 	}
-
 	ownerID, ok := middleware.UserIDFromContext(r.Context())
 	if !ok {
 		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
 		return
 	}
-
-	session, err := c.Service.UpdateSessionContent(r.Context(), eventID, sessionID, ownerID, req.Title, req.Description)
+	rules, err := c.Service.UpdateInvitationDomainRules(r.Context(), eventID, ownerID, &domain.InvitationDomainRules{
+		AllowedDomains: req.AllowedDomains,
+		BlockedDomains: req.BlockedDomains,
+	})
 	if err != nil {
-		if errors.Is(err, domain.ErrNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event or session not found")
-			return
-		}
-		if errors.Is(err, domain.ErrForbidden) {
-			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
-			return
-		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		c.writeInvitationDomainRulesError(w, r, err)
 		return
 	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, rules)
+}
 
-	helpers.WriteJSONSuccess(w, http.StatusOK, session)
+func (c *ScheduleController) writeInvitationDomainRulesError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, domain.ErrNotFound) {
+		helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+		return
+	}
+	if errors.Is(err, domain.ErrForbidden) {
+		helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+		return
+	}
+	helpers.WriteServiceError(w, r, c.Logger, err)
 }
 
-// DeleteEventSession godoc
-// @Summary Delete a session
-// @Description Deletes a session. Only the event owner can delete. Requires authentication.
+// RemindEventInvitations godoc
+// @Summary Remind invitees who have not registered
+// @ID RemindEventInvitations
+// @Description Emails a reminder to everyone invited to the event who has not registered yet. Invitees emailed (invited or reminded) less than min_interval_hours ago, 72 by default, are skipped, and nobody gets more than 3 reminders; both count as throttled. Returns the count of reminders sent, the addresses that failed and the throttled count. Only the event owner can send reminders. Requires authentication.
 // @Tags events
+// @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param eventID path string true "Event ID (UUID)"
-// @Param sessionID path string true "Session ID (UUID)"
-// @Success 200 {object} controllers.DeleteRoomSuccessResponse "data contains status"
+// @Param body body RemindEventInvitationsRequest true "Campaign options; send {} for the defaults"
+// @Success 200 {object} controllers.RemindEventInvitationsSuccessResponse "data contains sent count, failed list and throttled count"
 // @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
 // @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
-// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
-// @Router /events/{eventID}/sessions/{sessionID} [delete]
-func (c *ScheduleController) DeleteEventSession(w http.ResponseWriter, r *http.Request) {
+// @Router /events/{eventID}/invitations/remind [post]
+func (c *ScheduleController) RemindEventInvitations(w http.ResponseWriter, r *http.Request) {
 	eventID := r.PathValue("eventID")
-	sessionID := r.PathValue("sessionID")
-	if eventID == "" || sessionID == "" {
-		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or sessionID")
+	if eventID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
+		return
+	}
+	var req RemindEventInvitationsRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
 		return
 	}
 	ownerID, ok := middleware.UserIDFromContext(r.Context())
@@ -1429,51 +4111,123 @@ func (c *ScheduleController) DeleteEventSession(w http.ResponseWriter, r *http.R
 		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
 		return
 	}
-	if err := c.Service.DeleteEventSession(r.Context(), eventID, sessionID, ownerID); err != nil {
+	result, err := c.Service.RemindEventInvitations(r.Context(), eventID, ownerID, time.Duration(req.MinIntervalHours)*time.Hour)
+	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event or session not found")
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
 			return
 		}
 		if errors.Is(err, domain.ErrForbidden) {
 			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
 			return
 		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		if errors.Is(err, domain.ErrInvalidInput) {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
 		return
 	}
-	helpers.WriteJSONSuccess(w, http.StatusOK, DeleteEventResponse{Status: "deleted"})
+	helpers.WriteJSONSuccess(w, http.StatusOK, result)
 }
 
-// SendEventInvitations godoc
-// @Summary Send event invitation emails
-// @Description Send invitation emails to register for the event. Body contains a string of emails separated by commas or spaces. Only the event owner can invite. Each invitation is persisted and emailed; duplicates for the same event are skipped. Returns count of sent and list of failed addresses.
+// ListEventEmailsResponse is the data payload for GET /events/{eventID}/emails (200).
+type ListEventEmailsResponse struct {
+	Items      []*domain.EventEmail   `json:"items"`
+	Pagination helpers.PaginationMeta `json:"pagination"`
+}
+
+// ListEventEmailsSuccessResponse is the success response envelope for GET /events/{eventID}/emails (200).
+type ListEventEmailsSuccessResponse struct {
+	Data  ListEventEmailsResponse `json:"data"`
+	Error *helpers.APIError       `json:"error"`
+}
+
+// EventEmailSuccessResponse is the success response envelope for POST /events/{eventID}/emails/{emailID}/resend (200).
+type EventEmailSuccessResponse struct {
+	Data  domain.EventEmail `json:"data"`
+	Error *helpers.APIError `json:"error"`
+}
+
+// ListEventEmails godoc
+// @Summary List the emails sent for an event
+// @ID ListEventEmails
+// @Description Returns a paginated list of the emails sent on behalf of the event (invitations, reminders, team notices, contact form messages and replies, and room capacity alerts), newest first, with their rendered subject and bodies and whether the mailer accepted them. Failed sends and resends are listed too. Optional to filters by recipient substring (case-insensitive), kind by template and status by sent or failed. Only the event owner can list. Requires authentication.
 // @Tags events
-// @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param eventID path string true "Event ID (UUID)"
-// @Param body body SendEventInvitationsRequest true "Emails string (comma or space separated)"
-// @Success 200 {object} controllers.SendEventInvitationsSuccessResponse "data contains sent count and failed list"
-// @Failure 400 {object} helpers.APIResponse "error.code: bad_request (empty or no valid emails)"
+// @Param to query string false "Filter recipients containing this string (case-insensitive)"
+// @Param kind query string false "Filter by kind" Enums(event_invitation, event_invitation_reminder, team_member_left, contact_message, contact_reply, room_capacity_alert)
+// @Param status query string false "Filter by status; skipped is a sandbox event's email" Enums(sent, failed, skipped)
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Page size (default 20, max 100)"
+// @Success 200 {object} controllers.ListEventEmailsSuccessResponse "data contains items and pagination"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
 // @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
-// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
-// @Router /events/{eventID}/invitations [post]
-func (c *ScheduleController) SendEventInvitations(w http.ResponseWriter, r *http.Request) {
+// @Router /events/{eventID}/emails [get]
+func (c *ScheduleController) ListEventEmails(w http.ResponseWriter, r *http.Request) {
 	eventID := r.PathValue("eventID")
 	if eventID == "" {
 		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID")
 		return
 	}
-	var req SendEventInvitationsRequest
-	if !helpers.DecodeAndValidate(w, r, &req) {
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
 		return
 	}
-	emails := parseEmailsFromString(req.Emails)
-	if len(emails) == 0 {
-		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "no valid emails found")
+	q := r.URL.Query()
+	filter := domain.EventEmailFilter{
+		To:     strings.TrimSpace(q.Get("to")),
+		Kind:   q.Get("kind"),
+		Status: q.Get("status"),
+	}
+	switch filter.Kind {
+	case "", domain.EventEmailInvitation, domain.EventEmailInvitationReminder, domain.EventEmailTeamMemberLeft,
+		domain.EventEmailContactMessage, domain.EventEmailContactReply, domain.EventEmailRoomCapacityAlert:
+	default:
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "kind must be one of: event_invitation, event_invitation_reminder, team_member_left, contact_message, contact_reply, room_capacity_alert")
+		return
+	}
+	if filter.Status != "" && filter.Status != domain.EventEmailSent && filter.Status != domain.EventEmailFailed && filter.Status != domain.EventEmailSkipped {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "status must be sent, failed or skipped")
+		return
+	}
+	params := helpers.ParsePagination(r)
+	emails, total, err := c.Service.ListEventEmails(r.Context(), eventID, ownerID, filter, params)
+	if err != nil {
+		c.writeEventEmailError(w, r, err)
+		return
+	}
+	meta := helpers.NewPaginationMeta(params.Page, params.PageSize, total)
+	helpers.WriteJSONSuccess(w, http.StatusOK, ListEventEmailsResponse{Items: emails, Pagination: meta})
+}
+
+// ResendEventEmail godoc
+// @Summary Resend a sent email
+// @ID ResendEventEmail
+// @Description Sends one of the event's emails again to the same recipient, exactly as it was first rendered, and returns the new attempt; its resend_of is the original email's ID. A mailer failure is not an error: the attempt is returned with status failed and the mailer's error. Only the event owner can resend. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param emailID path string true "Email ID (UUID)"
+// @Success 200 {object} controllers.EventEmailSuccessResponse "data is the new send attempt"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found or email_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/emails/{emailID}/resend [post]
+func (c *ScheduleController) ResendEventEmail(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	emailID := r.PathValue("emailID")
+	if eventID == "" || emailID == "" {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "missing eventID or emailID")
 		return
 	}
 	ownerID, ok := middleware.UserIDFromContext(r.Context())
@@ -1481,21 +4235,30 @@ func (c *ScheduleController) SendEventInvitations(w http.ResponseWriter, r *http
 		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
 		return
 	}
-	sent, failed, err := c.Service.SendEventInvitations(r.Context(), eventID, ownerID, emails)
+	email, err := c.Service.ResendEventEmail(r.Context(), eventID, ownerID, emailID)
 	if err != nil {
-		if errors.Is(err, domain.ErrNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event not found")
-			return
-		}
-		if errors.Is(err, domain.ErrForbidden) {
-			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
-			return
-		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		c.writeEventEmailError(w, r, err)
+		return
+	}
+	c.Logger.InfoContext(r.Context(), "event email resent", "audit", "event_email.resend",
+		"event_id", eventID, "user_id", ownerID, "email_id", emailID, "status", email.Status)
+	helpers.WriteJSONSuccess(w, http.StatusOK, email)
+}
+
+func (c *ScheduleController) writeEventEmailError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, domain.ErrEventEmailNotFound) {
+		helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEmailNotFound, "email not found")
+		return
+	}
+	if errors.Is(err, domain.ErrNotFound) {
+		helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+		return
+	}
+	if errors.Is(err, domain.ErrForbidden) {
+		helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
 		return
 	}
-	helpers.WriteJSONSuccess(w, http.StatusOK, SendEventInvitationsResponse{Sent: sent, Failed: failed})
+	helpers.WriteServiceError(w, r, c.Logger, err)
 }
 
 // ListEventTagsSuccessResponse is the success response envelope for GET /events/{eventID}/tags (200).
@@ -1506,6 +4269,7 @@ type ListEventTagsSuccessResponse struct {
 
 // ListEventTags godoc
 // @Summary List tags for an event
+// @ID ListEventTags
 // @Description Returns the list of tags associated with the event. Only the event owner can list. Requires authentication.
 // @Tags events
 // @Produce json
@@ -1515,7 +4279,7 @@ type ListEventTagsSuccessResponse struct {
 // @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
 // @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
-// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
 // @Router /events/{eventID}/tags [get]
 func (c *ScheduleController) ListEventTags(w http.ResponseWriter, r *http.Request) {
@@ -1532,15 +4296,14 @@ func (c *ScheduleController) ListEventTags(w http.ResponseWriter, r *http.Reques
 	tags, err := c.Service.ListEventTags(r.Context(), eventID, callerID)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event not found")
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
 			return
 		}
 		if errors.Is(err, domain.ErrForbidden) {
 			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
 			return
 		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		helpers.WriteServiceError(w, r, c.Logger, err)
 		return
 	}
 	if tags == nil {
@@ -1570,6 +4333,7 @@ type AddEventTagsSuccessResponse struct {
 
 // AddEventTags godoc
 // @Summary Add tags to an event
+// @ID AddEventTags
 // @Description Adds one or more tags to the event by name (creates tags if missing). Only the event owner can add. Requires authentication.
 // @Tags events
 // @Accept json
@@ -1581,7 +4345,7 @@ type AddEventTagsSuccessResponse struct {
 // @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
 // @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
-// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
 // @Router /events/{eventID}/tags [post]
 func (c *ScheduleController) AddEventTags(w http.ResponseWriter, r *http.Request) {
@@ -1602,15 +4366,14 @@ func (c *ScheduleController) AddEventTags(w http.ResponseWriter, r *http.Request
 	tags, err := c.Service.AddEventTags(r.Context(), eventID, ownerID, req.Tags)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event not found")
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
 			return
 		}
 		if errors.Is(err, domain.ErrForbidden) {
 			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
 			return
 		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		helpers.WriteServiceError(w, r, c.Logger, err)
 		return
 	}
 	if tags == nil {
@@ -1640,6 +4403,7 @@ type UpdateEventTagSuccessResponse struct {
 
 // UpdateEventTag godoc
 // @Summary Update an event tag
+// @ID UpdateEventTag
 // @Description Renames a tag that belongs to the event. Only the event owner can update. Requires authentication.
 // @Tags events
 // @Accept json
@@ -1690,8 +4454,7 @@ func (c *ScheduleController) UpdateEventTag(w http.ResponseWriter, r *http.Reque
 			helpers.WriteJSONError(w, http.StatusConflict, helpers.ErrCodeConflict, err.Error())
 			return
 		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		helpers.WriteServiceError(w, r, c.Logger, err)
 		return
 	}
 	helpers.WriteJSONSuccess(w, http.StatusOK, tag)
@@ -1699,6 +4462,7 @@ func (c *ScheduleController) UpdateEventTag(w http.ResponseWriter, r *http.Reque
 
 // RemoveEventTag godoc
 // @Summary Remove a tag from an event
+// @ID RemoveEventTag
 // @Description Removes the tag from the event and from all sessions of that event. Only the event owner can remove. Requires authentication.
 // @Tags events
 // @Produce json
@@ -1734,8 +4498,7 @@ func (c *ScheduleController) RemoveEventTag(w http.ResponseWriter, r *http.Reque
 			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
 			return
 		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		helpers.WriteServiceError(w, r, c.Logger, err)
 		return
 	}
 	w.WriteHeader(http.StatusNoContent)
@@ -1756,6 +4519,7 @@ func (a AddSessionTagRequest) Validate() []string {
 
 // AddSessionTag godoc
 // @Summary Add a tag to a session
+// @ID AddSessionTag
 // @Description Links a tag (by id) to a session. The tag must already belong to the event. Only the event owner can add. Requires authentication.
 // @Tags events
 // @Accept json
@@ -1797,8 +4561,7 @@ func (c *ScheduleController) AddSessionTag(w http.ResponseWriter, r *http.Reques
 			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
 			return
 		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		helpers.WriteServiceError(w, r, c.Logger, err)
 		return
 	}
 	w.WriteHeader(http.StatusNoContent)
@@ -1806,6 +4569,7 @@ func (c *ScheduleController) AddSessionTag(w http.ResponseWriter, r *http.Reques
 
 // RemoveSessionTag godoc
 // @Summary Remove a tag from a session
+// @ID RemoveSessionTag
 // @Description Unlinks a tag from a session. Only the event owner can remove. Requires authentication.
 // @Tags events
 // @Produce json
@@ -1843,8 +4607,7 @@ func (c *ScheduleController) RemoveSessionTag(w http.ResponseWriter, r *http.Req
 			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
 			return
 		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		helpers.WriteServiceError(w, r, c.Logger, err)
 		return
 	}
 	w.WriteHeader(http.StatusNoContent)
@@ -1852,6 +4615,7 @@ func (c *ScheduleController) RemoveSessionTag(w http.ResponseWriter, r *http.Req
 
 // ListSessionSpeakers godoc
 // @Summary List speakers for a session
+// @ID ListSessionSpeakers
 // @Description Returns the list of speakers for the session (full speaker objects). Only the event owner can list. Requires authentication.
 // @Tags events
 // @Produce json
@@ -1887,8 +4651,7 @@ func (c *ScheduleController) ListSessionSpeakers(w http.ResponseWriter, r *http.
 			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
 			return
 		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		helpers.WriteServiceError(w, r, c.Logger, err)
 		return
 	}
 	if speakers == nil {
@@ -1912,6 +4675,7 @@ func (a AddSessionSpeakerRequest) Validate() []string {
 
 // AddSessionSpeaker godoc
 // @Summary Add a speaker to a session
+// @ID AddSessionSpeaker
 // @Description Links a speaker (by id) to a session. The speaker must already belong to the event. Only the event owner can add. Requires authentication.
 // @Tags events
 // @Accept json
@@ -1953,8 +4717,7 @@ func (c *ScheduleController) AddSessionSpeaker(w http.ResponseWriter, r *http.Re
 			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
 			return
 		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		helpers.WriteServiceError(w, r, c.Logger, err)
 		return
 	}
 	w.WriteHeader(http.StatusNoContent)
@@ -1962,6 +4725,7 @@ func (c *ScheduleController) AddSessionSpeaker(w http.ResponseWriter, r *http.Re
 
 // RemoveSessionSpeaker godoc
 // @Summary Remove a speaker from a session
+// @ID RemoveSessionSpeaker
 // @Description Unlinks a speaker from a session. Only the event owner can remove. Requires authentication.
 // @Tags events
 // @Produce json
@@ -1999,8 +4763,7 @@ func (c *ScheduleController) RemoveSessionSpeaker(w http.ResponseWriter, r *http
 			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
 			return
 		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		helpers.WriteServiceError(w, r, c.Logger, err)
 		return
 	}
 	w.WriteHeader(http.StatusNoContent)
@@ -2008,18 +4771,20 @@ func (c *ScheduleController) RemoveSessionSpeaker(w http.ResponseWriter, r *http
 
 // CreateEventSession godoc
 // @Summary Create a session
-// @Description Creates a new session for the event in a given room and time slot, with optional tags and speakers. Only the event owner can create. Requires authentication.
+// @ID CreateEventSession
+// @Description Creates a new session for the event in a given room and time slot, with optional tags and speakers. The time slot must satisfy the event's schedule rules and fit inside one of its operating days. Only the event owner can create. Requires authentication.
 // @Tags events
 // @Accept json
-// @Produce json
+// @Produce json,application/vnd.api+json,application/vnd.m3t+json
 // @Security BearerAuth
 // @Param eventID path string true "Event ID (UUID)"
 // @Param body body CreateSessionRequest true "Session data"
 // @Success 201 {object} controllers.CreateSessionSuccessResponse "data contains the created session"
-// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request or schedule_rule_violation (breaks the event's schedule rules or operating hours)"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
 // @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
 // @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 409 {object} helpers.APIResponse "error.code: session_time_conflict (overlaps another session in the room)"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
 // @Router /events/{eventID}/sessions [post]
 func (c *ScheduleController) CreateEventSession(w http.ResponseWriter, r *http.Request) {
@@ -2050,14 +4815,17 @@ func (c *ScheduleController) CreateEventSession(w http.ResponseWriter, r *http.R
 			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
 			return
 		}
+		if errors.Is(err, domain.ErrScheduleRuleViolation) {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeScheduleRuleViolation, err.Error())
+			return
+		}
 		if errors.Is(err, domain.ErrInvalidInput) {
 			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
 			return
 		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		helpers.WriteServiceError(w, r, c.Logger, err)
 		return
 	}
 
-	helpers.WriteJSONSuccess(w, http.StatusCreated, session)
+	helpers.WriteJSONSuccessWithLinks(w, r, http.StatusCreated, session, helpers.SessionLinks(eventID, session.ID, session.RoomID))
 }
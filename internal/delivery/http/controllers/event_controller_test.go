@@ -29,7 +29,12 @@ var testLogger = slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{L
 type fakeEventService struct {
 	createEventErr              error
 	importSessionizeErr         error
+	importMappingErr            error
+	scheduleRulesErr            error
+	eventThemeErr               error
+	operatingHoursErr           error
 	listEventsByOwnerErr        error
+	lastListMyEventsParams      domain.MyEventsParams
 	getEventByIDErr             error
 	deleteEventErr              error
 	toggleRoomErr               error
@@ -40,11 +45,45 @@ type fakeEventService struct {
 	listTeamMembersErr          error
 	listTeamMembersResult       []*domain.EventTeamMember
 	removeTeamMemberErr         error
+	promoteInvitationErr        error
+	leaveEventErr               error
+	lastLeaveEventID            string
+	lastLeaveUserID             string
+	lastPromoteInvitationID     string
+	lastPromoteRole             string
 	lastCreateEvent             *domain.Event
 	lastImportEventID           string
 	lastImportSessionizeID      string
+	lastImportForceRefresh      bool
+	lastDryRun                  bool
+	lastImportMapping           *domain.ImportMapping
+	lastScheduleRules           *domain.ScheduleRules
+	lastEventTheme              *domain.EventTheme
+	lastOperatingHours          []*domain.OperatingDay
 	lastDeleteEventID           string
 	lastDeleteOwnerID           string
+	lastDeleteForce             bool
+	// Checklist
+	checklistErr                error
+	lastChecklistLabels         []string
+	lastChecklistDone           *bool
+	lastChecklistReady          *bool
+	// Custom fields
+	customFieldErr              error
+	lastCustomField             *domain.CustomField
+	lastCustomFieldValues       map[string]any
+	// Bulk speaker updates
+	bulkSpeakersErr             error
+	bulkSpeakersResult          *domain.SpeakerBulkUpdateResult
+	lastBulkSpeakerUpdates      []*domain.SpeakerUpdate
+	// ListSessionHistory
+	sessionHistory              []*domain.SessionChange
+	sessionHistoryErr           error
+	lastSessionHistorySessionID string
+	// GetEventScheduleAsOf
+	scheduleSnapshot            *domain.ScheduleSnapshot
+	scheduleSnapshotErr         error
+	lastScheduleAsOf            time.Time
 	lastAddTeamMemberEventID    string
 	lastAddTeamMemberEmail      string
 	lastAddTeamMemberOwnerID    string
@@ -59,13 +98,34 @@ type fakeEventService struct {
 		rooms    []*domain.Room
 		sessions []*domain.Session
 	}
+	// SearchEvents / ListJoinedEvents
+	searchEventsErr        error
+	searchEventsResult     []*domain.EventSearchResult
+	searchEventsTotal      int
+	lastSearchEventsUserID string
+	lastSearchEventsParams domain.EventSearchParams
+	listJoinedEventsErr    error
+	joinedEvents           []*domain.Event
 	// SendEventInvitations
-	sendEventInvitationsErr    error
-	sendEventInvitationsSent   int
-	sendEventInvitationsFailed []string
-	lastSendInvitationsEventID string
-	lastSendInvitationsOwnerID string
-	lastSendInvitationsEmails  []string
+	sendEventInvitationsErr     error
+	sendEventInvitationsSent    int
+	sendEventInvitationsFailed  []string
+	sendEventInvitationsSkipped []string
+	lastSendInvitationsEventID  string
+	lastSendInvitationsOwnerID  string
+	lastSendInvitationsEmails   []string
+	// RemindEventInvitations
+	remindInvitationsErr        error
+	lastRemindInterval          time.Duration
+	// AnonymizeEvent
+	anonymizeEventErr           error
+	regenerateCodeErr error
+	// ListEventEmails / ResendEventEmail
+	eventEmailsErr              error
+	lastEventEmailFilter        domain.EventEmailFilter
+	// Get/UpdateInvitationDomainRules
+	invitationDomainRulesErr    error
+	lastInvitationDomainRules   *domain.InvitationDomainRules
 	// ListEventInvitations
 	listEventInvitationsErr     error
 	listEventInvitationsResult  []*domain.EventInvitation
@@ -74,6 +134,13 @@ type fakeEventService struct {
 	lastListInvitationsCallerID string
 	lastListInvitationsSearch   string
 	lastListInvitationsParams   domain.PaginationParams
+	// StreamEventInvitations / StreamEventSessions
+	streamErr                     error
+	streamErrAfter                int // rows emitted before streamErr is returned
+	streamEventSessionsResult     []*domain.Session
+	lastStreamInvitationsSearch   string
+	lastStreamInvitationsCallerID string
+	lastStreamSessionsOwnerID     string
 	// Room CRUD
 	listEventRoomsErr          error
 	listEventRoomsResult       []*domain.Room
@@ -84,6 +151,11 @@ type fakeEventService struct {
 	deleteEventRoomErr         error
 	lastListEventRoomsEventID  string
 	lastListEventRoomsOwnerID  string
+	reorderEventRoomsErr       error
+	lastReorderEventRoomIDs    []string
+	eventTracks                []string
+	eventTracksErr             error
+	lastReorderEventTracks     []string
 	lastGetEventRoomEventID    string
 	lastGetEventRoomRoomID     string
 	lastGetEventRoomOwnerID    string
@@ -94,6 +166,8 @@ type fakeEventService struct {
 	lastDeleteEventRoomEventID string
 	lastDeleteEventRoomRoomID  string
 	lastDeleteEventRoomOwnerID string
+	lastDeleteEventRoomMode    string
+	lastDeleteEventRoomTarget  string
 	// DeleteEventSession
 	deleteEventSessionErr           error
 	lastDeleteEventSessionEventID   string
@@ -138,6 +212,18 @@ type fakeEventService struct {
 	lastCreateEventSpeakerOwnerID   string
 	lastCreateEventSpeakerFirstName string
 	lastCreateEventSpeakerLastName  string
+	lastCreateEventSpeakerEmail     string
+	// Speaker merge candidates
+	speakerMergeErr        error
+	lastResolveCandidateID string
+	lastResolveMerge       bool
+	// Integrity
+	integrityErr      error
+	lastCleanupDryRun bool
+	cleanupCalled     bool
+	// Schedule overlaps
+	lastOverlapsGroupBy string
+	lastOverlapsBucket  int
 	// CreateEventRoom
 	createEventRoomErr          error
 	createEventRoomResult       *domain.Room
@@ -215,24 +301,121 @@ func (f *fakeEventService) CreateEvent(ctx context.Context, event *domain.Event)
 	return nil
 }
 
-func (f *fakeEventService) ImportSessionizeData(ctx context.Context, eventID, sessionizeID string) error {
+func (f *fakeEventService) ImportSessionizeData(ctx context.Context, eventID, sessionizeID string, forceRefresh bool) error {
 	f.lastImportEventID = eventID
 	f.lastImportSessionizeID = sessionizeID
+	f.lastImportForceRefresh = forceRefresh
+	f.lastDryRun = false
 	return f.importSessionizeErr
 }
 
-func (f *fakeEventService) ListEventsByOwner(ctx context.Context, ownerID string) ([]*domain.Event, error) {
+func (f *fakeEventService) PreviewSessionizeImport(ctx context.Context, eventID, ownerID, sessionizeID string, forceRefresh bool) (*domain.SessionizeImportPreview, error) {
+	f.lastImportEventID = eventID
+	f.lastImportSessionizeID = sessionizeID
+	f.lastDryRun = true
+	if f.importSessionizeErr != nil {
+		return nil, f.importSessionizeErr
+	}
+	return &domain.SessionizeImportPreview{
+		EventID: eventID, DryRun: true,
+		Rooms:    []*domain.DryRunChange{{Name: "Hall A", Action: domain.DryRunCreate}},
+		Sessions: []*domain.DryRunChange{{ID: "sess-1", Name: "Old talk", Action: domain.DryRunDelete}},
+		Speakers: []*domain.DryRunChange{},
+	}, nil
+}
+
+func (f *fakeEventService) GetImportMapping(ctx context.Context, eventID, ownerID string) (*domain.ImportMapping, error) {
+	if f.importMappingErr != nil {
+		return nil, f.importMappingErr
+	}
+	return domain.NewImportMapping(eventID), nil
+}
+
+func (f *fakeEventService) UpdateImportMapping(ctx context.Context, eventID, ownerID string, mapping *domain.ImportMapping) (*domain.ImportMapping, error) {
+	f.lastImportMapping = mapping
+	if f.importMappingErr != nil {
+		return nil, f.importMappingErr
+	}
+	mapping.EventID = eventID
+	return mapping, nil
+}
+
+func (f *fakeEventService) GetScheduleRules(ctx context.Context, eventID, ownerID string) (*domain.ScheduleRules, error) {
+	if f.scheduleRulesErr != nil {
+		return nil, f.scheduleRulesErr
+	}
+	return domain.NewScheduleRules(eventID), nil
+}
+
+func (f *fakeEventService) UpdateScheduleRules(ctx context.Context, eventID, ownerID string, rules *domain.ScheduleRules) (*domain.ScheduleRules, error) {
+	f.lastScheduleRules = rules
+	if f.scheduleRulesErr != nil {
+		return nil, f.scheduleRulesErr
+	}
+	rules.EventID = eventID
+	return rules, nil
+}
+
+func (f *fakeEventService) GetEventTheme(ctx context.Context, eventID, ownerID string) (*domain.EventTheme, error) {
+	if f.eventThemeErr != nil {
+		return nil, f.eventThemeErr
+	}
+	return domain.NewEventTheme(eventID), nil
+}
+
+func (f *fakeEventService) UpdateEventTheme(ctx context.Context, eventID, ownerID string, theme *domain.EventTheme) (*domain.EventTheme, error) {
+	f.lastEventTheme = theme
+	if f.eventThemeErr != nil {
+		return nil, f.eventThemeErr
+	}
+	theme.EventID = eventID
+	return theme, nil
+}
+
+func (f *fakeEventService) GetOperatingHours(ctx context.Context, eventID, ownerID string) ([]*domain.OperatingDay, error) {
+	if f.operatingHoursErr != nil {
+		return nil, f.operatingHoursErr
+	}
+	return []*domain.OperatingDay{}, nil
+}
+
+func (f *fakeEventService) UpdateOperatingHours(ctx context.Context, eventID, ownerID string, days []*domain.OperatingDay) ([]*domain.OperatingDay, error) {
+	f.lastOperatingHours = days
+	if f.operatingHoursErr != nil {
+		return nil, f.operatingHoursErr
+	}
+	return days, nil
+}
+
+func (f *fakeEventService) ListMyEvents(ctx context.Context, userID string, params domain.MyEventsParams) ([]*domain.Event, error) {
+	f.lastListMyEventsParams = params
 	if f.listEventsByOwnerErr != nil {
 		return nil, f.listEventsByOwnerErr
 	}
 	if f.eventsByOwner != nil {
-		if events, ok := f.eventsByOwner[ownerID]; ok {
+		if events, ok := f.eventsByOwner[userID]; ok {
 			return events, nil
 		}
 	}
 	return []*domain.Event{}, nil
 }
 
+func (f *fakeEventService) SearchEvents(ctx context.Context, userID string, params domain.EventSearchParams) ([]*domain.EventSearchResult, int, error) {
+	f.lastSearchEventsUserID = userID
+	f.lastSearchEventsParams = params
+	if f.searchEventsErr != nil {
+		return nil, 0, f.searchEventsErr
+	}
+	return f.searchEventsResult, f.searchEventsTotal, nil
+}
+
+func (f *fakeEventService) ListJoinedEvents(ctx context.Context, userID string) ([]*domain.Event, error) {
+	if f.listJoinedEventsErr != nil {
+		return nil, f.listJoinedEventsErr
+	}
+	return f.joinedEvents, nil
+}
+
 func (f *fakeEventService) GetEventByID(ctx context.Context, eventID string) (*domain.Event, []*domain.Room, []*domain.Session, error) {
 	if f.getEventByIDErr != nil {
 		return nil, nil, nil, f.getEventByIDErr
@@ -245,9 +428,10 @@ func (f *fakeEventService) GetEventByID(ctx context.Context, eventID string) (*d
 	return nil, nil, nil, domain.ErrNotFound
 }
 
-func (f *fakeEventService) DeleteEvent(ctx context.Context, eventID string, ownerID string) error {
+func (f *fakeEventService) DeleteEvent(ctx context.Context, eventID string, ownerID string, force bool) error {
 	f.lastDeleteEventID = eventID
 	f.lastDeleteOwnerID = ownerID
+	f.lastDeleteForce = force
 	return f.deleteEventErr
 }
 
@@ -279,6 +463,29 @@ func (f *fakeEventService) ListEventRooms(ctx context.Context, eventID, ownerID
 	return []*domain.Room{}, nil
 }
 
+func (f *fakeEventService) ReorderEventRooms(ctx context.Context, eventID, ownerID string, roomIDs []string) ([]*domain.Room, error) {
+	f.lastReorderEventRoomIDs = roomIDs
+	if f.reorderEventRoomsErr != nil {
+		return nil, f.reorderEventRoomsErr
+	}
+	return []*domain.Room{}, nil
+}
+
+func (f *fakeEventService) ListEventTracks(ctx context.Context, eventID, ownerID string) ([]string, error) {
+	if f.eventTracksErr != nil {
+		return nil, f.eventTracksErr
+	}
+	return f.eventTracks, nil
+}
+
+func (f *fakeEventService) ReorderEventTracks(ctx context.Context, eventID, ownerID string, tracks []string) ([]string, error) {
+	f.lastReorderEventTracks = tracks
+	if f.eventTracksErr != nil {
+		return nil, f.eventTracksErr
+	}
+	return tracks, nil
+}
+
 func (f *fakeEventService) GetEventRoom(ctx context.Context, eventID, roomID, ownerID string) (*domain.Room, error) {
 	f.lastGetEventRoomEventID = eventID
 	f.lastGetEventRoomRoomID = roomID
@@ -300,13 +507,42 @@ func (f *fakeEventService) UpdateEventRoom(ctx context.Context, eventID, roomID,
 	return f.updateEventRoomResult, nil
 }
 
-func (f *fakeEventService) DeleteEventRoom(ctx context.Context, eventID, roomID, ownerID string) error {
+func (f *fakeEventService) DeleteEventRoom(ctx context.Context, eventID, roomID, ownerID, mode, targetRoomID string) error {
 	f.lastDeleteEventRoomEventID = eventID
 	f.lastDeleteEventRoomRoomID = roomID
 	f.lastDeleteEventRoomOwnerID = ownerID
+	f.lastDeleteEventRoomMode = mode
+	f.lastDeleteEventRoomTarget = targetRoomID
+	f.lastDryRun = false
 	return f.deleteEventRoomErr
 }
 
+func (f *fakeEventService) PreviewDeleteEventRoom(ctx context.Context, eventID, roomID, ownerID, mode, targetRoomID string) (*domain.RoomDeletionPreview, error) {
+	f.lastDeleteEventRoomMode = mode
+	f.lastDryRun = true
+	if f.deleteEventRoomErr != nil {
+		return nil, f.deleteEventRoomErr
+	}
+	return &domain.RoomDeletionPreview{EventID: eventID, RoomID: roomID, Mode: mode, DryRun: true,
+		Sessions: []*domain.DryRunChange{{ID: "sess-1", Name: "Keynote", Action: domain.DryRunMove}}}, nil
+}
+
+func (f *fakeEventService) ListSessionHistory(ctx context.Context, eventID, sessionID, ownerID string) ([]*domain.SessionChange, error) {
+	f.lastSessionHistorySessionID = sessionID
+	if f.sessionHistoryErr != nil {
+		return nil, f.sessionHistoryErr
+	}
+	return f.sessionHistory, nil
+}
+
+func (f *fakeEventService) GetEventScheduleAsOf(ctx context.Context, eventID, ownerID string, asOf time.Time) (*domain.ScheduleSnapshot, error) {
+	f.lastScheduleAsOf = asOf
+	if f.scheduleSnapshotErr != nil {
+		return nil, f.scheduleSnapshotErr
+	}
+	return f.scheduleSnapshot, nil
+}
+
 func (f *fakeEventService) DeleteEventSession(ctx context.Context, eventID, sessionID, ownerID string) error {
 	f.lastDeleteEventSessionEventID = eventID
 	f.lastDeleteEventSessionSessionID = sessionID
@@ -352,6 +588,21 @@ func (f *fakeEventService) RemoveEventTeamMember(ctx context.Context, eventID, u
 	return f.removeTeamMemberErr
 }
 
+func (f *fakeEventService) PromoteInvitation(ctx context.Context, eventID, invitationID, ownerID, role string) (*domain.EventTeamMember, error) {
+	f.lastPromoteInvitationID = invitationID
+	f.lastPromoteRole = role
+	if f.promoteInvitationErr != nil {
+		return nil, f.promoteInvitationErr
+	}
+	return &domain.EventTeamMember{EventID: eventID, UserID: "user-456", Email: "invitee@example.com"}, nil
+}
+
+func (f *fakeEventService) LeaveEvent(ctx context.Context, eventID, userID string) (bool, error) {
+	f.lastLeaveEventID = eventID
+	f.lastLeaveUserID = userID
+	return f.leaveEventErr == nil, f.leaveEventErr
+}
+
 func (f *fakeEventService) UpdateSessionSchedule(ctx context.Context, eventID, sessionID, ownerID string, roomID *string, startTime, endTime *time.Time) (*domain.Session, error) {
 	return nil, nil
 }
@@ -368,14 +619,68 @@ func (f *fakeEventService) UpdateSessionContent(ctx context.Context, eventID, se
 	return f.updateSessionContentResult, nil
 }
 
-func (f *fakeEventService) SendEventInvitations(ctx context.Context, eventID, ownerID string, emails []string) (sent int, failed []string, err error) {
+func (f *fakeEventService) SendEventInvitations(ctx context.Context, eventID, ownerID string, emails []string) (sent int, failed, skipped []string, err error) {
 	f.lastSendInvitationsEventID = eventID
 	f.lastSendInvitationsOwnerID = ownerID
 	f.lastSendInvitationsEmails = emails
 	if f.sendEventInvitationsErr != nil {
-		return 0, nil, f.sendEventInvitationsErr
+		return 0, nil, nil, f.sendEventInvitationsErr
+	}
+	return f.sendEventInvitationsSent, f.sendEventInvitationsFailed, f.sendEventInvitationsSkipped, nil
+}
+
+func (f *fakeEventService) AnonymizeEvent(ctx context.Context, eventID, ownerID string, dryRun bool) (*domain.EventAnonymization, error) {
+	f.lastDryRun = dryRun
+	if f.anonymizeEventErr != nil {
+		return nil, f.anonymizeEventErr
+	}
+	return &domain.EventAnonymization{EventID: eventID, AnonymizedBy: ownerID, InvitationsSent: 10, InvitationsHashed: 10, DryRun: dryRun}, nil
+}
+
+func (f *fakeEventService) RegenerateEventCode(ctx context.Context, eventID, ownerID string) (*domain.EventCodeChange, error) {
+	if f.regenerateCodeErr != nil {
+		return nil, f.regenerateCodeErr
+	}
+	return &domain.EventCodeChange{Event: &domain.Event{ID: eventID, EventCode: "k3x9p2"}, PreviousCode: "abc1", InvitationsResent: 2, InvitationsFailed: []string{}}, nil
+}
+
+func (f *fakeEventService) GetInvitationDomainRules(ctx context.Context, eventID, ownerID string) (*domain.InvitationDomainRules, error) {
+	if f.invitationDomainRulesErr != nil {
+		return nil, f.invitationDomainRulesErr
+	}
+	return domain.NewInvitationDomainRules(eventID), nil
+}
+
+func (f *fakeEventService) UpdateInvitationDomainRules(ctx context.Context, eventID, ownerID string, rules *domain.InvitationDomainRules) (*domain.InvitationDomainRules, error) {
+	f.lastInvitationDomainRules = rules
+	if f.invitationDomainRulesErr != nil {
+		return nil, f.invitationDomainRulesErr
+	}
+	rules.EventID = eventID
+	return rules, nil
+}
+
+func (f *fakeEventService) RemindEventInvitations(ctx context.Context, eventID, ownerID string, minInterval time.Duration) (*domain.InvitationReminderResult, error) {
+	f.lastRemindInterval = minInterval
+	if f.remindInvitationsErr != nil {
+		return nil, f.remindInvitationsErr
+	}
+	return &domain.InvitationReminderResult{Sent: 2, Failed: []string{"bad@example.com"}, Throttled: 1}, nil
+}
+
+func (f *fakeEventService) ListEventEmails(ctx context.Context, eventID, ownerID string, filter domain.EventEmailFilter, params domain.PaginationParams) ([]*domain.EventEmail, int, error) {
+	f.lastEventEmailFilter = filter
+	if f.eventEmailsErr != nil {
+		return nil, 0, f.eventEmailsErr
+	}
+	return []*domain.EventEmail{{ID: "em-1", EventID: eventID, Kind: domain.EventEmailInvitation, To: "alice@example.com", Status: domain.EventEmailSent}}, 1, nil
+}
+
+func (f *fakeEventService) ResendEventEmail(ctx context.Context, eventID, ownerID, emailID string) (*domain.EventEmail, error) {
+	if f.eventEmailsErr != nil {
+		return nil, f.eventEmailsErr
 	}
-	return f.sendEventInvitationsSent, f.sendEventInvitationsFailed, nil
+	return &domain.EventEmail{ID: "em-2", EventID: eventID, Kind: domain.EventEmailInvitation, To: "alice@example.com", Status: domain.EventEmailFailed, Error: "smtp down", ResendOf: emailID}, nil
 }
 
 func (f *fakeEventService) ListEventInvitations(ctx context.Context, eventID, callerID string, search string, params domain.PaginationParams) ([]*domain.EventInvitation, int, error) {
@@ -392,6 +697,33 @@ func (f *fakeEventService) ListEventInvitations(ctx context.Context, eventID, ca
 	return []*domain.EventInvitation{}, 0, nil
 }
 
+func (f *fakeEventService) StreamEventInvitations(ctx context.Context, eventID, callerID string, search string, fn func(*domain.EventInvitation) error) error {
+	f.lastStreamInvitationsCallerID = callerID
+	f.lastStreamInvitationsSearch = search
+	for i, inv := range f.listEventInvitationsResult {
+		if f.streamErr != nil && i == f.streamErrAfter {
+			return f.streamErr
+		}
+		if err := fn(inv); err != nil {
+			return err
+		}
+	}
+	return f.streamErr
+}
+
+func (f *fakeEventService) StreamEventSessions(ctx context.Context, eventID, ownerID string, fn func(*domain.Session) error) error {
+	f.lastStreamSessionsOwnerID = ownerID
+	for i, sess := range f.streamEventSessionsResult {
+		if f.streamErr != nil && i == f.streamErrAfter {
+			return f.streamErr
+		}
+		if err := fn(sess); err != nil {
+			return err
+		}
+	}
+	return f.streamErr
+}
+
 func (f *fakeEventService) ListEventTags(ctx context.Context, eventID, callerID string) ([]*domain.Tag, error) {
 	f.lastListEventTagsEventID = eventID
 	f.lastListEventTagsCallerID = callerID
@@ -519,11 +851,12 @@ func (f *fakeEventService) DeleteEventSpeaker(ctx context.Context, eventID, spea
 	return f.deleteEventSpeakerErr
 }
 
-func (f *fakeEventService) CreateEventSpeaker(ctx context.Context, eventID, ownerID string, firstName, lastName, bio, tagLine, profilePicture string, isTopSpeaker bool) (*domain.Speaker, error) {
+func (f *fakeEventService) CreateEventSpeaker(ctx context.Context, eventID, ownerID string, firstName, lastName, email, bio, tagLine, profilePicture string, isTopSpeaker bool) (*domain.Speaker, error) {
 	f.lastCreateEventSpeakerEventID = eventID
 	f.lastCreateEventSpeakerOwnerID = ownerID
 	f.lastCreateEventSpeakerFirstName = firstName
 	f.lastCreateEventSpeakerLastName = lastName
+	f.lastCreateEventSpeakerEmail = email
 	if f.createEventSpeakerErr != nil {
 		return nil, f.createEventSpeakerErr
 	}
@@ -533,6 +866,206 @@ func (f *fakeEventService) CreateEventSpeaker(ctx context.Context, eventID, owne
 	return &domain.Speaker{ID: "sp-created", EventID: eventID, FirstName: firstName, LastName: lastName}, nil
 }
 
+func (f *fakeEventService) ListSpeakerMergeCandidates(ctx context.Context, eventID, ownerID string) ([]*domain.SpeakerMergeCandidate, error) {
+	if f.speakerMergeErr != nil {
+		return nil, f.speakerMergeErr
+	}
+	return []*domain.SpeakerMergeCandidate{
+		{ID: "mc-1", EventID: eventID, SpeakerID: "sp-new", CandidateID: "sp-old", Reason: "similar name", Status: domain.MergeStatusPending},
+	}, nil
+}
+
+func (f *fakeEventService) ResolveSpeakerMergeCandidate(ctx context.Context, eventID, candidateID, ownerID string, merge bool) (*domain.SpeakerMergeCandidate, error) {
+	f.lastResolveCandidateID = candidateID
+	f.lastResolveMerge = merge
+	if f.speakerMergeErr != nil {
+		return nil, f.speakerMergeErr
+	}
+	status := domain.MergeStatusRejected
+	if merge {
+		status = domain.MergeStatusMerged
+	}
+	return &domain.SpeakerMergeCandidate{ID: candidateID, EventID: eventID, Status: status}, nil
+}
+
+func (f *fakeEventService) GetIntegrityReport(ctx context.Context, eventID, ownerID string) (*domain.IntegrityReport, error) {
+	if f.integrityErr != nil {
+		return nil, f.integrityErr
+	}
+	return &domain.IntegrityReport{
+		EventID: eventID,
+		Issues:  []*domain.IntegrityIssue{{Kind: domain.IssueUnusedTag, EntityID: "tag-1", Detail: "go", Fixable: true}},
+		Fixable: 1,
+	}, nil
+}
+
+func (f *fakeEventService) CleanupIntegrityIssues(ctx context.Context, eventID, ownerID string, dryRun bool) (*domain.IntegrityCleanup, error) {
+	f.cleanupCalled = true
+	f.lastCleanupDryRun = dryRun
+	if f.integrityErr != nil {
+		return nil, f.integrityErr
+	}
+	return &domain.IntegrityCleanup{EventID: eventID, DryRun: dryRun, Issues: []*domain.IntegrityIssue{}}, nil
+}
+
+func (f *fakeEventService) GetChecklist(ctx context.Context, eventID, callerID string) ([]*domain.ChecklistItem, error) {
+	if f.checklistErr != nil {
+		return nil, f.checklistErr
+	}
+	return []*domain.ChecklistItem{{ID: "item-1", EventID: eventID, Label: "Slides received"}}, nil
+}
+
+func (f *fakeEventService) UpdateChecklist(ctx context.Context, eventID, ownerID string, labels []string) ([]*domain.ChecklistItem, error) {
+	f.lastChecklistLabels = labels
+	if f.checklistErr != nil {
+		return nil, f.checklistErr
+	}
+	items := make([]*domain.ChecklistItem, len(labels))
+	for i, label := range labels {
+		items[i] = &domain.ChecklistItem{ID: fmt.Sprintf("item-%d", i+1), EventID: eventID, Label: label, Position: i}
+	}
+	return items, nil
+}
+
+func (f *fakeEventService) SetSessionChecklistItem(ctx context.Context, eventID, sessionID, itemID, userID string, done bool) (*domain.SessionChecklist, error) {
+	f.lastChecklistDone = &done
+	if f.checklistErr != nil {
+		return nil, f.checklistErr
+	}
+	return &domain.SessionChecklist{
+		SessionID: sessionID,
+		Done:      1,
+		Total:     1,
+		Ready:     done,
+		Items:     []*domain.SessionChecklistItem{{ItemID: itemID, Label: "Slides received", Done: done, CompletedBy: userID}},
+	}, nil
+}
+
+func (f *fakeEventService) GetChecklistProgress(ctx context.Context, eventID, callerID string, ready *bool) (*domain.ChecklistProgress, error) {
+	f.lastChecklistReady = ready
+	if f.checklistErr != nil {
+		return nil, f.checklistErr
+	}
+	return &domain.ChecklistProgress{
+		EventID:       eventID,
+		Items:         []*domain.ChecklistItem{},
+		SessionsTotal: 2,
+		SessionsReady: 1,
+		Members:       []*domain.ChecklistMemberProgress{},
+		Sessions:      []*domain.SessionChecklist{{SessionID: "sess-2"}},
+	}, nil
+}
+
+func (f *fakeEventService) ListCustomFields(ctx context.Context, eventID, callerID string) ([]*domain.CustomField, error) {
+	if f.customFieldErr != nil {
+		return nil, f.customFieldErr
+	}
+	return []*domain.CustomField{{ID: "field-1", EventID: eventID, Name: "Paper DOI", Type: domain.CustomFieldURL, AppliesTo: domain.CustomFieldSession}}, nil
+}
+
+func (f *fakeEventService) CreateCustomField(ctx context.Context, eventID, ownerID string, field *domain.CustomField) (*domain.CustomField, error) {
+	f.lastCustomField = field
+	if f.customFieldErr != nil {
+		return nil, f.customFieldErr
+	}
+	field.ID, field.EventID = "field-1", eventID
+	return field, nil
+}
+
+func (f *fakeEventService) UpdateCustomField(ctx context.Context, eventID, fieldID, ownerID string, name *string, public *bool) (*domain.CustomField, error) {
+	if f.customFieldErr != nil {
+		return nil, f.customFieldErr
+	}
+	return &domain.CustomField{ID: fieldID, EventID: eventID}, nil
+}
+
+func (f *fakeEventService) DeleteCustomField(ctx context.Context, eventID, fieldID, ownerID string) error {
+	return f.customFieldErr
+}
+
+func (f *fakeEventService) SetSessionCustomFields(ctx context.Context, eventID, sessionID, ownerID string, values map[string]any) ([]*domain.CustomFieldValue, error) {
+	f.lastCustomFieldValues = values
+	if f.customFieldErr != nil {
+		return nil, f.customFieldErr
+	}
+	return []*domain.CustomFieldValue{}, nil
+}
+
+func (f *fakeEventService) SetSpeakerCustomFields(ctx context.Context, eventID, speakerID, ownerID string, values map[string]any) ([]*domain.CustomFieldValue, error) {
+	f.lastCustomFieldValues = values
+	if f.customFieldErr != nil {
+		return nil, f.customFieldErr
+	}
+	return []*domain.CustomFieldValue{}, nil
+}
+
+func (f *fakeEventService) BulkUpdateSpeakers(ctx context.Context, eventID, ownerID string, updates []*domain.SpeakerUpdate, dryRun bool) (*domain.SpeakerBulkUpdateResult, error) {
+	f.lastBulkSpeakerUpdates = updates
+	f.lastDryRun = dryRun
+	if f.bulkSpeakersErr != nil {
+		return nil, f.bulkSpeakersErr
+	}
+	return f.bulkSpeakersResult, nil
+}
+
+func (f *fakeEventService) GetScheduleGrid(ctx context.Context, eventID, callerID string) (*domain.ScheduleGrid, error) {
+	if f.integrityErr != nil {
+		return nil, f.integrityErr
+	}
+	start := time.Date(2026, 5, 14, 9, 0, 0, 0, time.UTC)
+	return &domain.ScheduleGrid{
+		EventID: eventID,
+		Rooms:   []*domain.ScheduleGridRoom{{ID: "room-1", Name: "Room A"}},
+		Days: []*domain.ScheduleGridDay{{
+			Day:   "2026-05-14",
+			Start: start,
+			End:   start.Add(time.Hour),
+			Slots: []time.Time{start, start.Add(time.Hour)},
+			Columns: []*domain.ScheduleGridColumn{{
+				RoomID:   "room-1",
+				Sessions: []*domain.ScheduleGridSession{{SessionID: "sess-1", StartTime: start, EndTime: start.Add(time.Hour), Span: 1}},
+			}},
+		}},
+		Unscheduled: []*domain.ScheduleGridSession{},
+	}, nil
+}
+
+func (f *fakeEventService) GetScheduleOverlaps(ctx context.Context, eventID, callerID, groupBy string, bucketMinutes int) (*domain.ScheduleOverlaps, error) {
+	f.lastOverlapsGroupBy, f.lastOverlapsBucket = groupBy, bucketMinutes
+	if f.integrityErr != nil {
+		return nil, f.integrityErr
+	}
+	start := time.Date(2026, 5, 14, 9, 0, 0, 0, time.UTC)
+	return &domain.ScheduleOverlaps{
+		EventID:       eventID,
+		GroupBy:       groupBy,
+		BucketMinutes: bucketMinutes,
+		Buckets: []*domain.OverlapBucket{{
+			Start:        start,
+			End:          start.Add(time.Duration(bucketMinutes) * time.Minute),
+			SessionCount: 2,
+			Groups: []*domain.OverlapGroup{{
+				Key:      "AI",
+				Sessions: []*domain.OverlapSession{{SessionID: "sess-1"}, {SessionID: "sess-2"}},
+				Clash:    true,
+			}},
+		}},
+	}, nil
+}
+
+func (f *fakeEventService) ValidateSchedule(ctx context.Context, eventID, ownerID string) (*domain.ScheduleValidation, error) {
+	if f.integrityErr != nil {
+		return nil, f.integrityErr
+	}
+	return &domain.ScheduleValidation{
+		EventID:      eventID,
+		ProblemCount: 1,
+		Categories: map[string][]*domain.ScheduleProblem{
+			domain.ValidationRoomOverlap: {{SessionID: "sess-1", RelatedSessionID: "sess-2", RoomID: "room-1", Message: "overlap"}},
+		},
+	}, nil
+}
+
 func (f *fakeEventService) CreateEventRoom(ctx context.Context, eventID, ownerID, name string, capacity int, description, howToGetThere string, notBookable bool) (*domain.Room, error) {
 	f.lastCreateEventRoomEventID = eventID
 	f.lastCreateEventRoomOwnerID = ownerID
@@ -730,6 +1263,14 @@ func TestScheduleController_ImportSessionize(t *testing.T) {
 			wantBodySubstr: "import failed",
 			wantStatusJSON: "",
 		},
+		{
+			name:           "sessionize unavailable",
+			path:           "/events/ev-1/import/sessionize/xyz",
+			fakeErr:        fmt.Errorf("%w: dial tcp: connection refused", domain.ErrProviderUnavailable),
+			wantStatus:     http.StatusServiceUnavailable,
+			wantBodySubstr: "import temporarily unavailable",
+			wantStatusJSON: "",
+		},
 	}
 
 	for _, tt := range tests {
@@ -749,7 +1290,7 @@ func TestScheduleController_ImportSessionize(t *testing.T) {
 			case "missing sessionizeID":
 				req.SetPathValue("eventID", "ev-1")
 				req.SetPathValue("sessionizeID", "")
-			case "service error":
+			case "service error", "sessionize unavailable":
 				req.SetPathValue("eventID", "ev-1")
 				req.SetPathValue("sessionizeID", "xyz")
 			}
@@ -774,78 +1315,976 @@ func TestScheduleController_ImportSessionize(t *testing.T) {
 	}
 }
 
-func TestScheduleController_ListMyEvents(t *testing.T) {
+func TestScheduleController_ImportSessionize_ForceRefresh(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		wantForce  bool
+	}{
+		{name: "default uses cache", query: "", wantStatus: http.StatusOK, wantForce: false},
+		{name: "force_refresh=true", query: "?force_refresh=true", wantStatus: http.StatusOK, wantForce: true},
+		{name: "force_refresh=0", query: "?force_refresh=0", wantStatus: http.StatusOK, wantForce: false},
+		{name: "invalid value", query: "?force_refresh=please", wantStatus: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(http.MethodPost, "http://test/events/ev-1/import/sessionize/abc123"+tt.query, nil)
+			req.SetPathValue("eventID", "ev-1")
+			req.SetPathValue("sessionizeID", "abc123")
+			rr := httptest.NewRecorder()
+
+			ctrl.ImportSessionize(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, "abc123", fake.lastImportSessionizeID)
+				assert.Equal(t, tt.wantForce, fake.lastImportForceRefresh)
+			} else {
+				assert.Empty(t, fake.lastImportSessionizeID, "service must not be called")
+			}
+		})
+	}
+}
+
+func TestScheduleController_GetImportMapping(t *testing.T) {
+	tests := []struct {
+		name       string
+		fakeErr    error
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "success", wantStatus: http.StatusOK},
+		{name: "not owner", fakeErr: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantCode: helpers.ErrCodeForbidden},
+		{name: "event not found", fakeErr: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantCode: helpers.ErrCodeEventNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewScheduleController(testLogger, &fakeEventService{importMappingErr: tt.fakeErr})
+			req := httptest.NewRequest(http.MethodGet, "http://test/events/ev-1/import/mapping", nil)
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			req.SetPathValue("eventID", "ev-1")
+			rr := httptest.NewRecorder()
+
+			ctrl.GetImportMapping(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			if tt.wantCode != "" {
+				assert.Contains(t, rr.Body.String(), tt.wantCode)
+				return
+			}
+			var resp ImportMappingSuccessResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+			require.NotNil(t, resp.Data)
+			assert.Equal(t, "ev-1", resp.Data.EventID)
+			assert.Equal(t, []string{}, resp.Data.TagCategories)
+		})
+	}
+}
+
+func TestScheduleController_UpdateImportMapping(t *testing.T) {
 	tests := []struct {
 		name           string
-		noUserContext  bool
+		body           string
 		fakeErr        error
-		eventsByOwner  map[string][]*domain.Event
 		wantStatus     int
 		wantBodySubstr string
-		checkEvents    func(t *testing.T, events []domain.Event)
 	}{
 		{
-			name: "success with events",
-			eventsByOwner: map[string][]*domain.Event{
-				"user-123": {
-					{ID: "ev-1", Name: "Conf A", OwnerID: "user-123"},
-					{ID: "ev-2", Name: "Conf B", OwnerID: "user-123"},
-				},
-			},
-			wantStatus:     http.StatusOK,
-			wantBodySubstr: "",
-			checkEvents: func(t *testing.T, events []domain.Event) {
-				require.Len(t, events, 2)
-				assert.Equal(t, "ev-1", events[0].ID)
-				assert.Equal(t, "Conf A", events[0].Name)
-				assert.Equal(t, "user-123", events[0].OwnerID)
-			},
+			name:       "success",
+			body:       `{"tag_categories":["Topics"],"session_type_category":" Format ","track_category":"Track","room_name_overrides":{"Room 1":"Main Hall"}}`,
+			wantStatus: http.StatusOK,
 		},
 		{
-			name:           "success empty",
-			eventsByOwner:  map[string][]*domain.Event{"user-123": {}},
-			wantStatus:     http.StatusOK,
-			wantBodySubstr: "",
-			checkEvents: func(t *testing.T, events []domain.Event) {
-				require.Len(t, events, 0)
-			},
+			name:           "same type and track category",
+			body:           `{"session_type_category":"Format","track_category":"format"}`,
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "must differ",
 		},
 		{
-			name:           "no user in context",
-			noUserContext:  true,
-			wantStatus:     http.StatusUnauthorized,
-			wantBodySubstr: "unauthorized",
-			checkEvents:    nil,
+			name:           "empty override",
+			body:           `{"room_name_overrides":{"Room 1":" "}}`,
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "room_name_overrides",
+		},
+		{
+			name:           "blank tag category",
+			body:           `{"tag_categories":[""]}`,
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "tag_categories",
+		},
+		{
+			name:           "not owner",
+			body:           `{}`,
+			fakeErr:        domain.ErrForbidden,
+			wantStatus:     http.StatusForbidden,
+			wantBodySubstr: helpers.ErrCodeForbidden,
 		},
 		{
 			name:           "service error",
-			fakeErr:        errors.New("db error"),
+			body:           `{}`,
+			fakeErr:        errors.New("db down"),
 			wantStatus:     http.StatusInternalServerError,
-			wantBodySubstr: "db error",
-			checkEvents:    nil,
+			wantBodySubstr: "db down",
 		},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			fake := &fakeEventService{
-				listEventsByOwnerErr: tt.fakeErr,
-				eventsByOwner:        tt.eventsByOwner,
-			}
+			fake := &fakeEventService{importMappingErr: tt.fakeErr}
 			ctrl := NewScheduleController(testLogger, fake)
-			req := httptest.NewRequest(http.MethodGet, "/events/me", nil)
-			if !tt.noUserContext {
-				req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
-			}
+			req := httptest.NewRequest(http.MethodPut, "http://test/events/ev-1/import/mapping", strings.NewReader(tt.body))
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			req.SetPathValue("eventID", "ev-1")
 			rr := httptest.NewRecorder()
-			ctrl.ListMyEvents(rr, req)
 
-			require.Equal(t, tt.wantStatus, rr.Code, "status code")
-			var envelope helpers.APIResponse
-			require.NoError(t, json.NewDecoder(rr.Body).Decode(&envelope), "response must be valid JSON envelope")
-			if tt.wantStatus == http.StatusOK && tt.checkEvents != nil {
-				require.Nil(t, envelope.Error, "success response must have error nil")
-				dataBytes, err := json.Marshal(envelope.Data)
+			ctrl.UpdateImportMapping(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			assert.Contains(t, rr.Body.String(), tt.wantBodySubstr)
+			if tt.wantStatus == http.StatusOK {
+				require.NotNil(t, fake.lastImportMapping)
+				assert.Equal(t, []string{"Topics"}, fake.lastImportMapping.TagCategories)
+				assert.Equal(t, "Format", fake.lastImportMapping.SessionTypeCategory)
+				assert.Equal(t, map[string]string{"Room 1": "Main Hall"}, fake.lastImportMapping.RoomNameOverrides)
+			}
+			if tt.wantStatus == http.StatusBadRequest {
+				assert.Nil(t, fake.lastImportMapping, "service must not be called")
+			}
+		})
+	}
+}
+
+func TestScheduleController_GetScheduleRules(t *testing.T) {
+	tests := []struct {
+		name       string
+		fakeErr    error
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "success", wantStatus: http.StatusOK},
+		{name: "not owner", fakeErr: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantCode: helpers.ErrCodeForbidden},
+		{name: "event not found", fakeErr: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantCode: helpers.ErrCodeEventNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewScheduleController(testLogger, &fakeEventService{scheduleRulesErr: tt.fakeErr})
+			req := httptest.NewRequest(http.MethodGet, "http://test/events/ev-1/schedule-rules", nil)
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			req.SetPathValue("eventID", "ev-1")
+			rr := httptest.NewRecorder()
+
+			ctrl.GetScheduleRules(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			if tt.wantCode != "" {
+				assert.Contains(t, rr.Body.String(), tt.wantCode)
+				return
+			}
+			var resp ScheduleRulesSuccessResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+			require.NotNil(t, resp.Data)
+			assert.Equal(t, "ev-1", resp.Data.EventID)
+			assert.Equal(t, []int{}, resp.Data.AllowedDurations)
+		})
+	}
+}
+
+func TestScheduleController_UpdateScheduleRules(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		fakeErr        error
+		wantStatus     int
+		wantBodySubstr string
+	}{
+		{
+			name:       "success",
+			body:       `{"allowed_durations":[30,60],"earliest_start":"09:00","latest_end":"20:00","allowed_days":["2026-05-14"],"timezone":"Europe/Madrid"}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "empty rules",
+			body:       `{}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:           "zero duration",
+			body:           `{"allowed_durations":[0]}`,
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "allowed_durations",
+		},
+		{
+			name:           "malformed time of day",
+			body:           `{"earliest_start":"9am"}`,
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "earliest_start must be a time of day",
+		},
+		{
+			name:           "latest end before earliest start",
+			body:           `{"earliest_start":"18:00","latest_end":"09:00"}`,
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "latest_end must be after earliest_start",
+		},
+		{
+			name:           "malformed day",
+			body:           `{"allowed_days":["14/05/2026"]}`,
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "allowed_days",
+		},
+		{
+			name:           "unknown time zone",
+			body:           `{"timezone":"Mars/Olympus"}`,
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "timezone",
+		},
+		{
+			name:           "not owner",
+			body:           `{}`,
+			fakeErr:        domain.ErrForbidden,
+			wantStatus:     http.StatusForbidden,
+			wantBodySubstr: helpers.ErrCodeForbidden,
+		},
+		{
+			name:           "service error",
+			body:           `{}`,
+			fakeErr:        errors.New("db down"),
+			wantStatus:     http.StatusInternalServerError,
+			wantBodySubstr: "db down",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{scheduleRulesErr: tt.fakeErr}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(http.MethodPut, "http://test/events/ev-1/schedule-rules", strings.NewReader(tt.body))
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			req.SetPathValue("eventID", "ev-1")
+			rr := httptest.NewRecorder()
+
+			ctrl.UpdateScheduleRules(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			assert.Contains(t, rr.Body.String(), tt.wantBodySubstr)
+			if tt.name == "success" {
+				require.NotNil(t, fake.lastScheduleRules)
+				assert.Equal(t, []int{30, 60}, fake.lastScheduleRules.AllowedDurations)
+				assert.Equal(t, "09:00", fake.lastScheduleRules.EarliestStart)
+				assert.Equal(t, "20:00", fake.lastScheduleRules.LatestEnd)
+				assert.Equal(t, []string{"2026-05-14"}, fake.lastScheduleRules.AllowedDays)
+				assert.Equal(t, "Europe/Madrid", fake.lastScheduleRules.Timezone)
+			}
+			if tt.wantStatus == http.StatusBadRequest {
+				assert.Nil(t, fake.lastScheduleRules, "service must not be called")
+			}
+		})
+	}
+}
+
+func TestScheduleController_UpdateEventTheme(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		fakeErr        error
+		wantStatus     int
+		wantBodySubstr string
+	}{
+		{
+			name:           "success",
+			body:           `{"primary_color":"#112233","background_color":"#FFFFFF80","font_family":"Source Sans 3","footer_text":"© Conf","support_email":"help@conf.example"}`,
+			wantStatus:     http.StatusOK,
+			wantBodySubstr: `"primary_color":"#112233"`,
+		},
+		{
+			name:           "empty theme resets to defaults",
+			body:           `{}`,
+			wantStatus:     http.StatusOK,
+			wantBodySubstr: `"event_id":"ev-1"`,
+		},
+		{
+			name:           "color is not hex",
+			body:           `{"text_color":"red"}`,
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "text_color must be a hex color",
+		},
+		{
+			name:           "font could break out of css",
+			body:           `{"font_family":"Inter; background: url(x)"}`,
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "font_family",
+		},
+		{
+			name:           "footer too long",
+			body:           `{"footer_text":"` + strings.Repeat("x", 501) + `"}`,
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "footer_text",
+		},
+		{
+			name:           "invalid support email",
+			body:           `{"support_email":"help"}`,
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "support_email",
+		},
+		{
+			name:           "not owner",
+			body:           `{}`,
+			fakeErr:        domain.ErrForbidden,
+			wantStatus:     http.StatusForbidden,
+			wantBodySubstr: helpers.ErrCodeForbidden,
+		},
+		{
+			name:           "event not found",
+			body:           `{}`,
+			fakeErr:        domain.ErrNotFound,
+			wantStatus:     http.StatusNotFound,
+			wantBodySubstr: helpers.ErrCodeEventNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{eventThemeErr: tt.fakeErr}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(http.MethodPut, "http://test/events/ev-1/theme", strings.NewReader(tt.body))
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			req.SetPathValue("eventID", "ev-1")
+			rr := httptest.NewRecorder()
+
+			ctrl.UpdateEventTheme(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			assert.Contains(t, rr.Body.String(), tt.wantBodySubstr)
+			if tt.name == "success" {
+				require.NotNil(t, fake.lastEventTheme)
+				assert.Equal(t, "#FFFFFF80", fake.lastEventTheme.BackgroundColor)
+				assert.Equal(t, "Source Sans 3", fake.lastEventTheme.FontFamily)
+				assert.Equal(t, "help@conf.example", fake.lastEventTheme.SupportEmail)
+			}
+			if tt.wantStatus == http.StatusBadRequest {
+				assert.Nil(t, fake.lastEventTheme, "service must not be called")
+			}
+		})
+	}
+}
+
+func TestScheduleController_GetOperatingHours(t *testing.T) {
+	tests := []struct {
+		name       string
+		fakeErr    error
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "success", wantStatus: http.StatusOK},
+		{name: "not owner", fakeErr: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantCode: helpers.ErrCodeForbidden},
+		{name: "event not found", fakeErr: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantCode: helpers.ErrCodeEventNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewScheduleController(testLogger, &fakeEventService{operatingHoursErr: tt.fakeErr})
+			req := httptest.NewRequest(http.MethodGet, "http://test/events/ev-1/operating-hours", nil)
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			req.SetPathValue("eventID", "ev-1")
+			rr := httptest.NewRecorder()
+
+			ctrl.GetOperatingHours(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			if tt.wantCode != "" {
+				assert.Contains(t, rr.Body.String(), tt.wantCode)
+				return
+			}
+			var resp OperatingHoursSuccessResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+			assert.Equal(t, []*domain.OperatingDay{}, resp.Data)
+		})
+	}
+}
+
+func TestScheduleController_UpdateOperatingHours(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		fakeErr        error
+		wantStatus     int
+		wantBodySubstr string
+	}{
+		{
+			name:       "success",
+			body:       `{"days":[{"day":"2026-05-14","opens_at":"2026-05-14T08:30:00+02:00","closes_at":"2026-05-14T20:00:00+02:00"}]}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "empty list",
+			body:       `{"days":[]}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:           "malformed day",
+			body:           `{"days":[{"day":"14/05/2026","opens_at":"2026-05-14T08:30:00Z","closes_at":"2026-05-14T20:00:00Z"}]}`,
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "days[].day",
+		},
+		{
+			name:           "missing close time",
+			body:           `{"days":[{"day":"2026-05-14","opens_at":"2026-05-14T08:30:00Z"}]}`,
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "days[].closes_at",
+		},
+		{
+			name:           "overlapping days",
+			body:           `{"days":[]}`,
+			fakeErr:        fmt.Errorf("2026-05-15 opens before 2026-05-14 closes: %w", domain.ErrInvalidInput),
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "opens before",
+		},
+		{
+			name:           "not owner",
+			body:           `{"days":[]}`,
+			fakeErr:        domain.ErrForbidden,
+			wantStatus:     http.StatusForbidden,
+			wantBodySubstr: helpers.ErrCodeForbidden,
+		},
+		{
+			name:           "service error",
+			body:           `{"days":[]}`,
+			fakeErr:        errors.New("db down"),
+			wantStatus:     http.StatusInternalServerError,
+			wantBodySubstr: "db down",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{operatingHoursErr: tt.fakeErr}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(http.MethodPut, "http://test/events/ev-1/operating-hours", strings.NewReader(tt.body))
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			req.SetPathValue("eventID", "ev-1")
+			rr := httptest.NewRecorder()
+
+			ctrl.UpdateOperatingHours(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			assert.Contains(t, rr.Body.String(), tt.wantBodySubstr)
+			if tt.name == "success" {
+				require.Len(t, fake.lastOperatingHours, 1)
+				day := fake.lastOperatingHours[0]
+				assert.Equal(t, "2026-05-14", day.Day)
+				assert.True(t, day.OpensAt.Equal(time.Date(2026, 5, 14, 6, 30, 0, 0, time.UTC)))
+				assert.True(t, day.ClosesAt.Equal(time.Date(2026, 5, 14, 18, 0, 0, 0, time.UTC)))
+			}
+			if tt.fakeErr == nil && tt.wantStatus == http.StatusBadRequest {
+				assert.Nil(t, fake.lastOperatingHours, "service must not be called")
+			}
+		})
+	}
+}
+
+func TestScheduleController_ListSpeakerMergeCandidates(t *testing.T) {
+	tests := []struct {
+		name       string
+		fakeErr    error
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "success", wantStatus: http.StatusOK},
+		{name: "not owner", fakeErr: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantCode: helpers.ErrCodeForbidden},
+		{name: "event not found", fakeErr: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantCode: helpers.ErrCodeEventNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewScheduleController(testLogger, &fakeEventService{speakerMergeErr: tt.fakeErr})
+			req := httptest.NewRequest(http.MethodGet, "http://test/events/ev-1/speakers/merge-candidates", nil)
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			req.SetPathValue("eventID", "ev-1")
+			rr := httptest.NewRecorder()
+
+			ctrl.ListSpeakerMergeCandidates(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			if tt.wantCode != "" {
+				assert.Contains(t, rr.Body.String(), tt.wantCode)
+				return
+			}
+			var resp ListSpeakerMergeCandidatesSuccessResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+			require.Len(t, resp.Data, 1)
+			assert.Equal(t, "mc-1", resp.Data[0].ID)
+		})
+	}
+}
+
+func TestScheduleController_ResolveSpeakerMergeCandidate(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		fakeErr        error
+		wantStatus     int
+		wantBodySubstr string
+		wantMerge      bool
+	}{
+		{name: "merge", body: `{"action":"merge"}`, wantStatus: http.StatusOK, wantBodySubstr: domain.MergeStatusMerged, wantMerge: true},
+		{name: "reject", body: `{"action":"reject"}`, wantStatus: http.StatusOK, wantBodySubstr: domain.MergeStatusRejected},
+		{name: "unknown action", body: `{"action":"keep"}`, wantStatus: http.StatusBadRequest, wantBodySubstr: "action must be merge or reject"},
+		{name: "not found", body: `{"action":"merge"}`, fakeErr: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantBodySubstr: helpers.ErrCodeNotFound, wantMerge: true},
+		{name: "not owner", body: `{"action":"merge"}`, fakeErr: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantBodySubstr: helpers.ErrCodeForbidden, wantMerge: true},
+		{name: "already resolved", body: `{"action":"reject"}`, fakeErr: domain.ErrAlreadyResolved, wantStatus: http.StatusConflict, wantBodySubstr: helpers.ErrCodeConflict},
+		{name: "service error", body: `{"action":"merge"}`, fakeErr: errors.New("db down"), wantStatus: http.StatusInternalServerError, wantBodySubstr: "db down", wantMerge: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{speakerMergeErr: tt.fakeErr}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(http.MethodPost, "http://test/events/ev-1/speakers/merge-candidates/mc-1", strings.NewReader(tt.body))
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			req.SetPathValue("eventID", "ev-1")
+			req.SetPathValue("candidateID", "mc-1")
+			rr := httptest.NewRecorder()
+
+			ctrl.ResolveSpeakerMergeCandidate(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			assert.Contains(t, rr.Body.String(), tt.wantBodySubstr)
+			if tt.wantStatus == http.StatusBadRequest {
+				assert.Empty(t, fake.lastResolveCandidateID, "service must not be called")
+				return
+			}
+			assert.Equal(t, "mc-1", fake.lastResolveCandidateID)
+			assert.Equal(t, tt.wantMerge, fake.lastResolveMerge)
+		})
+	}
+}
+
+func TestScheduleController_GetIntegrityReport(t *testing.T) {
+	tests := []struct {
+		name       string
+		fakeErr    error
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "success", wantStatus: http.StatusOK},
+		{name: "not owner", fakeErr: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantCode: helpers.ErrCodeForbidden},
+		{name: "event not found", fakeErr: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantCode: helpers.ErrCodeEventNotFound},
+		{name: "service error", fakeErr: errors.New("db down"), wantStatus: http.StatusInternalServerError, wantCode: helpers.ErrCodeInternalError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewScheduleController(testLogger, &fakeEventService{integrityErr: tt.fakeErr})
+			req := httptest.NewRequest(http.MethodGet, "http://test/events/ev-1/integrity-report", nil)
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			req.SetPathValue("eventID", "ev-1")
+			rr := httptest.NewRecorder()
+
+			ctrl.GetIntegrityReport(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			if tt.wantCode != "" {
+				assert.Contains(t, rr.Body.String(), tt.wantCode)
+				return
+			}
+			var resp IntegrityReportSuccessResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+			require.NotNil(t, resp.Data)
+			require.Len(t, resp.Data.Issues, 1)
+			assert.Equal(t, domain.IssueUnusedTag, resp.Data.Issues[0].Kind)
+			assert.Equal(t, 1, resp.Data.Fixable)
+		})
+	}
+}
+
+func TestScheduleController_ValidateSchedule(t *testing.T) {
+	tests := []struct {
+		name       string
+		fakeErr    error
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "success", wantStatus: http.StatusOK},
+		{name: "not owner", fakeErr: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantCode: helpers.ErrCodeForbidden},
+		{name: "event not found", fakeErr: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantCode: helpers.ErrCodeEventNotFound},
+		{name: "service error", fakeErr: errors.New("db down"), wantStatus: http.StatusInternalServerError, wantCode: helpers.ErrCodeInternalError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewScheduleController(testLogger, &fakeEventService{integrityErr: tt.fakeErr})
+			req := httptest.NewRequest(http.MethodPost, "http://test/events/ev-1/schedule/validate", nil)
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			req.SetPathValue("eventID", "ev-1")
+			rr := httptest.NewRecorder()
+
+			ctrl.ValidateSchedule(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			if tt.wantCode != "" {
+				assert.Contains(t, rr.Body.String(), tt.wantCode)
+				return
+			}
+			var resp ScheduleValidationSuccessResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+			require.NotNil(t, resp.Data)
+			assert.False(t, resp.Data.Valid)
+			require.Len(t, resp.Data.Categories[domain.ValidationRoomOverlap], 1)
+			assert.Equal(t, "sess-2", resp.Data.Categories[domain.ValidationRoomOverlap][0].RelatedSessionID)
+		})
+	}
+}
+
+func TestScheduleController_UpdateChecklist(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		fakeErr        error
+		wantStatus     int
+		wantBodySubstr string
+	}{
+		{name: "success", body: `{"items":["Slides received","AV check"]}`, wantStatus: http.StatusOK, wantBodySubstr: "AV check"},
+		{name: "too many items", body: `{"items":[` + strings.Repeat(`"x",`, domain.MaxChecklistItems) + `"x"]}`, wantStatus: http.StatusBadRequest, wantBodySubstr: "items must have at most"},
+		{
+			name:           "duplicate items",
+			body:           `{"items":["AV check","av check"]}`,
+			fakeErr:        fmt.Errorf("checklist item %q is listed more than once: %w", "av check", domain.ErrInvalidInput),
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "more than once",
+		},
+		{name: "not owner", body: `{"items":[]}`, fakeErr: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantBodySubstr: helpers.ErrCodeForbidden},
+		{name: "event not found", body: `{"items":[]}`, fakeErr: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantBodySubstr: helpers.ErrCodeEventNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{checklistErr: tt.fakeErr}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(http.MethodPut, "http://test/events/ev-1/checklist", strings.NewReader(tt.body))
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			req.SetPathValue("eventID", "ev-1")
+			rr := httptest.NewRecorder()
+
+			ctrl.UpdateChecklist(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			assert.Contains(t, rr.Body.String(), tt.wantBodySubstr)
+			if tt.name == "success" {
+				assert.Equal(t, []string{"Slides received", "AV check"}, fake.lastChecklistLabels)
+			}
+		})
+	}
+}
+
+func TestScheduleController_SetSessionChecklistItem(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		fakeErr    error
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "success", body: `{"done":true}`, wantStatus: http.StatusOK},
+		{name: "missing done", body: `{}`, wantStatus: http.StatusBadRequest, wantCode: "done is required"},
+		{name: "not a team member", body: `{"done":false}`, fakeErr: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantCode: helpers.ErrCodeForbidden},
+		{name: "item not found", body: `{"done":true}`, fakeErr: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantCode: helpers.ErrCodeNotFound},
+		{name: "service error", body: `{"done":true}`, fakeErr: errors.New("db down"), wantStatus: http.StatusInternalServerError, wantCode: helpers.ErrCodeInternalError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{checklistErr: tt.fakeErr}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(http.MethodPut, "http://test/events/ev-1/sessions/sess-1/checklist/item-1", strings.NewReader(tt.body))
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			req.SetPathValue("eventID", "ev-1")
+			req.SetPathValue("sessionID", "sess-1")
+			req.SetPathValue("itemID", "item-1")
+			rr := httptest.NewRecorder()
+
+			ctrl.SetSessionChecklistItem(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			if tt.wantCode != "" {
+				assert.Contains(t, rr.Body.String(), tt.wantCode)
+				return
+			}
+			var resp SessionChecklistSuccessResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+			require.NotNil(t, resp.Data)
+			assert.True(t, resp.Data.Ready)
+			assert.Equal(t, "user-123", resp.Data.Items[0].CompletedBy)
+			require.NotNil(t, fake.lastChecklistDone)
+			assert.True(t, *fake.lastChecklistDone)
+		})
+	}
+}
+
+func TestScheduleController_GetChecklistProgress(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		fakeErr    error
+		wantStatus int
+		wantCode   string
+		wantReady  *bool
+	}{
+		{name: "all sessions", wantStatus: http.StatusOK},
+		{name: "sessions not ready", query: "?ready=false", wantStatus: http.StatusOK, wantReady: new(bool)},
+		{name: "bad ready", query: "?ready=soon", wantStatus: http.StatusBadRequest, wantCode: "ready must be true or false"},
+		{name: "not a team member", fakeErr: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantCode: helpers.ErrCodeForbidden},
+		{name: "event not found", fakeErr: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantCode: helpers.ErrCodeEventNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{checklistErr: tt.fakeErr}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(http.MethodGet, "http://test/events/ev-1/checklist/progress"+tt.query, nil)
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			req.SetPathValue("eventID", "ev-1")
+			rr := httptest.NewRecorder()
+
+			ctrl.GetChecklistProgress(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			if tt.wantCode != "" {
+				assert.Contains(t, rr.Body.String(), tt.wantCode)
+				return
+			}
+			assert.Equal(t, tt.wantReady, fake.lastChecklistReady)
+			var resp ChecklistProgressSuccessResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+			require.NotNil(t, resp.Data)
+			assert.Equal(t, 2, resp.Data.SessionsTotal)
+			assert.Equal(t, 1, resp.Data.SessionsReady)
+		})
+	}
+}
+
+func TestScheduleController_GetScheduleGrid(t *testing.T) {
+	tests := []struct {
+		name       string
+		fakeErr    error
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "success", wantStatus: http.StatusOK},
+		{name: "not a team member", fakeErr: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantCode: helpers.ErrCodeForbidden},
+		{name: "event not found", fakeErr: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantCode: helpers.ErrCodeEventNotFound},
+		{name: "service error", fakeErr: errors.New("db down"), wantStatus: http.StatusInternalServerError, wantCode: helpers.ErrCodeInternalError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewScheduleController(testLogger, &fakeEventService{integrityErr: tt.fakeErr})
+			req := httptest.NewRequest(http.MethodGet, "http://test/events/ev-1/schedule/grid", nil)
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			req.SetPathValue("eventID", "ev-1")
+			rr := httptest.NewRecorder()
+
+			ctrl.GetScheduleGrid(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			if tt.wantCode != "" {
+				assert.Contains(t, rr.Body.String(), tt.wantCode)
+				return
+			}
+			var resp ScheduleGridSuccessResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+			require.NotNil(t, resp.Data)
+			require.Len(t, resp.Data.Days, 1)
+			require.Len(t, resp.Data.Days[0].Columns, 1)
+			assert.Equal(t, "sess-1", resp.Data.Days[0].Columns[0].Sessions[0].SessionID)
+		})
+	}
+}
+
+func TestScheduleController_GetScheduleOverlaps(t *testing.T) {
+	tests := []struct {
+		name        string
+		query       string
+		fakeErr     error
+		wantStatus  int
+		wantCode    string
+		wantGroupBy string
+		wantBucket  int
+	}{
+		{name: "defaults", wantStatus: http.StatusOK, wantGroupBy: domain.OverlapGroupByTag, wantBucket: 30},
+		{name: "by track", query: "?group_by=track&bucket_minutes=60", wantStatus: http.StatusOK, wantGroupBy: domain.OverlapGroupByTrack, wantBucket: 60},
+		{name: "bucket not a number", query: "?bucket_minutes=half", wantStatus: http.StatusBadRequest, wantCode: helpers.ErrCodeBadRequest},
+		{name: "invalid input", query: "?group_by=room", fakeErr: fmt.Errorf("group_by: %w", domain.ErrInvalidInput), wantStatus: http.StatusBadRequest, wantCode: helpers.ErrCodeBadRequest},
+		{name: "not a team member", fakeErr: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantCode: helpers.ErrCodeForbidden},
+		{name: "event not found", fakeErr: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantCode: helpers.ErrCodeEventNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &fakeEventService{integrityErr: tt.fakeErr}
+			ctrl := NewScheduleController(testLogger, svc)
+			req := httptest.NewRequest(http.MethodGet, "http://test/events/ev-1/schedule/overlaps"+tt.query, nil)
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			req.SetPathValue("eventID", "ev-1")
+			rr := httptest.NewRecorder()
+
+			ctrl.GetScheduleOverlaps(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			if tt.wantCode != "" {
+				assert.Contains(t, rr.Body.String(), tt.wantCode)
+				return
+			}
+			assert.Equal(t, tt.wantGroupBy, svc.lastOverlapsGroupBy)
+			assert.Equal(t, tt.wantBucket, svc.lastOverlapsBucket)
+			var resp ScheduleOverlapsSuccessResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+			require.NotNil(t, resp.Data)
+			require.Len(t, resp.Data.Buckets, 1)
+			assert.True(t, resp.Data.Buckets[0].Groups[0].Clash)
+		})
+	}
+}
+
+func TestScheduleController_CleanupIntegrityIssues(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		fakeErr    error
+		wantStatus int
+		wantDryRun bool
+		wantCalled bool
+	}{
+		{name: "apply", wantStatus: http.StatusOK, wantCalled: true},
+		{name: "dry run", query: "?dry_run=true", wantStatus: http.StatusOK, wantDryRun: true, wantCalled: true},
+		{name: "invalid dry_run", query: "?dry_run=maybe", wantStatus: http.StatusBadRequest},
+		{name: "not owner", fakeErr: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantCalled: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{integrityErr: tt.fakeErr}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(http.MethodPost, "http://test/events/ev-1/integrity-report/cleanup"+tt.query, nil)
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			req.SetPathValue("eventID", "ev-1")
+			rr := httptest.NewRecorder()
+
+			ctrl.CleanupIntegrityIssues(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			assert.Equal(t, tt.wantCalled, fake.cleanupCalled)
+			assert.Equal(t, tt.wantDryRun, fake.lastCleanupDryRun)
+			if tt.wantStatus == http.StatusOK {
+				var resp IntegrityCleanupSuccessResponse
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+				require.NotNil(t, resp.Data)
+				assert.Equal(t, tt.wantDryRun, resp.Data.DryRun)
+			}
+		})
+	}
+}
+
+func TestScheduleController_ListMyEvents(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		noUserContext  bool
+		fakeErr        error
+		eventsByOwner  map[string][]*domain.Event
+		wantStatus     int
+		wantParams     domain.MyEventsParams
+		wantBodySubstr string
+		checkEvents    func(t *testing.T, events []domain.Event)
+	}{
+		{
+			name: "success with events",
+			eventsByOwner: map[string][]*domain.Event{
+				"user-123": {
+					{ID: "ev-1", Name: "Conf A", OwnerID: "user-123"},
+					{ID: "ev-2", Name: "Conf B", OwnerID: "user-123"},
+				},
+			},
+			wantStatus:     http.StatusOK,
+			wantBodySubstr: "",
+			checkEvents: func(t *testing.T, events []domain.Event) {
+				require.Len(t, events, 2)
+				assert.Equal(t, "ev-1", events[0].ID)
+				assert.Equal(t, "Conf A", events[0].Name)
+				assert.Equal(t, "user-123", events[0].OwnerID)
+			},
+		},
+		{
+			name:           "success empty",
+			eventsByOwner:  map[string][]*domain.Event{"user-123": {}},
+			wantStatus:     http.StatusOK,
+			wantBodySubstr: "",
+			checkEvents: func(t *testing.T, events []domain.Event) {
+				require.Len(t, events, 0)
+			},
+		},
+		{
+			name:  "include stats",
+			query: "?include=stats",
+			eventsByOwner: map[string][]*domain.Event{
+				"user-123": {{ID: "ev-1", Name: "Conf A", OwnerID: "user-123", Stats: &domain.EventStats{Rooms: 2, Sessions: 5, TeamSize: 1}}},
+			},
+			wantStatus: http.StatusOK,
+			wantParams: domain.MyEventsParams{WithStats: true},
+			checkEvents: func(t *testing.T, events []domain.Event) {
+				require.Len(t, events, 1)
+				require.NotNil(t, events[0].Stats)
+				assert.Equal(t, 5, events[0].Stats.Sessions)
+			},
+		},
+		{
+			name:  "owner only",
+			query: "?owner_only=true",
+			eventsByOwner: map[string][]*domain.Event{
+				"user-123": {{ID: "ev-1", OwnerID: "user-123", Role: domain.EventRoleOwner}},
+			},
+			wantStatus: http.StatusOK,
+			wantParams: domain.MyEventsParams{OwnerOnly: true},
+			checkEvents: func(t *testing.T, events []domain.Event) {
+				require.Len(t, events, 1)
+				assert.Equal(t, domain.EventRoleOwner, events[0].Role)
+			},
+		},
+		{
+			name:           "invalid owner_only",
+			query:          "?owner_only=maybe",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "owner_only must be true or false",
+		},
+		{
+			name:           "unknown include",
+			query:          "?include=stats,tickets",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "include must be",
+		},
+		{
+			name:           "no user in context",
+			noUserContext:  true,
+			wantStatus:     http.StatusUnauthorized,
+			wantBodySubstr: "unauthorized",
+			checkEvents:    nil,
+		},
+		{
+			name:           "service error",
+			fakeErr:        errors.New("db error"),
+			wantStatus:     http.StatusInternalServerError,
+			wantBodySubstr: "db error",
+			checkEvents:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{
+				listEventsByOwnerErr: tt.fakeErr,
+				eventsByOwner:        tt.eventsByOwner,
+			}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(http.MethodGet, "/events/me"+tt.query, nil)
+			if !tt.noUserContext {
+				req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			}
+			rr := httptest.NewRecorder()
+			ctrl.ListMyEvents(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, "status code")
+			assert.Equal(t, tt.wantParams, fake.lastListMyEventsParams, "params")
+			var envelope helpers.APIResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&envelope), "response must be valid JSON envelope")
+			if tt.wantStatus == http.StatusOK && tt.checkEvents != nil {
+				require.Nil(t, envelope.Error, "success response must have error nil")
+				dataBytes, err := json.Marshal(envelope.Data)
 				require.NoError(t, err)
 				var events []domain.Event
 				require.NoError(t, json.Unmarshal(dataBytes, &events))
@@ -859,6 +2298,154 @@ func TestScheduleController_ListMyEvents(t *testing.T) {
 	}
 }
 
+func TestScheduleController_SearchEvents(t *testing.T) {
+	date := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name           string
+		query          string
+		noUserContext  bool
+		fakeErr        error
+		wantStatus     int
+		wantBodySubstr string
+		checkParams    func(t *testing.T, params domain.EventSearchParams)
+	}{
+		{
+			name:       "success with filters",
+			query:      "?search=+go+&from=2026-05-01&to=2026-05-31&role=owner,+attendee&page=2&page_size=5",
+			wantStatus: http.StatusOK,
+			checkParams: func(t *testing.T, params domain.EventSearchParams) {
+				assert.Equal(t, "go", params.Query)
+				require.NotNil(t, params.From)
+				assert.Equal(t, date, *params.From)
+				require.NotNil(t, params.To)
+				assert.Equal(t, time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC), *params.To, "to is inclusive")
+				assert.Equal(t, []string{"owner", "attendee"}, params.Roles)
+				assert.Equal(t, domain.PaginationParams{Page: 2, PageSize: 5}, params.Pagination)
+			},
+		},
+		{
+			name:       "success without filters",
+			wantStatus: http.StatusOK,
+			checkParams: func(t *testing.T, params domain.EventSearchParams) {
+				assert.Empty(t, params.Query)
+				assert.Nil(t, params.From)
+				assert.Nil(t, params.To)
+				assert.Empty(t, params.Roles)
+			},
+		},
+		{
+			name:           "invalid from",
+			query:          "?from=May",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "from must be YYYY-MM-DD",
+		},
+		{
+			name:           "invalid to",
+			query:          "?to=2026-13-01",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "to must be YYYY-MM-DD",
+		},
+		{
+			name:           "invalid role",
+			query:          "?role=speaker",
+			fakeErr:        fmt.Errorf("role \"speaker\" is unknown: %w", domain.ErrInvalidInput),
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "speaker",
+		},
+		{
+			name:           "no user in context",
+			noUserContext:  true,
+			wantStatus:     http.StatusUnauthorized,
+			wantBodySubstr: "unauthorized",
+		},
+		{
+			name:           "service error",
+			fakeErr:        errors.New("db error"),
+			wantStatus:     http.StatusInternalServerError,
+			wantBodySubstr: "db error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{
+				searchEventsErr: tt.fakeErr,
+				searchEventsResult: []*domain.EventSearchResult{
+					{Event: &domain.Event{ID: "ev-1", Name: "GopherCon", Date: &date}, Roles: []string{"attendee", "owner"}},
+				},
+				searchEventsTotal: 6,
+			}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(http.MethodGet, "/events/search"+tt.query, nil)
+			if !tt.noUserContext {
+				req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			}
+			rr := httptest.NewRecorder()
+			ctrl.SearchEvents(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, "status code")
+			var envelope helpers.APIResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&envelope), "response must be valid JSON envelope")
+			if tt.wantStatus != http.StatusOK {
+				require.NotNil(t, envelope.Error, "error response must have error set")
+				assert.Contains(t, envelope.Error.Message, tt.wantBodySubstr, "error message")
+				return
+			}
+			require.Nil(t, envelope.Error, "success response must have error nil")
+			assert.Equal(t, "user-123", fake.lastSearchEventsUserID)
+			tt.checkParams(t, fake.lastSearchEventsParams)
+			dataBytes, err := json.Marshal(envelope.Data)
+			require.NoError(t, err)
+			var data SearchEventsResponse
+			require.NoError(t, json.Unmarshal(dataBytes, &data))
+			require.Len(t, data.Items, 1)
+			assert.Equal(t, "ev-1", data.Items[0].Event.ID)
+			assert.Equal(t, []string{"attendee", "owner"}, data.Items[0].Roles)
+			assert.Equal(t, 6, data.Pagination.Total)
+		})
+	}
+}
+
+func TestScheduleController_ListJoinedEvents(t *testing.T) {
+	tests := []struct {
+		name          string
+		noUserContext bool
+		fakeErr       error
+		joined        []*domain.Event
+		wantStatus    int
+		wantLen       int
+	}{
+		{name: "success", joined: []*domain.Event{{ID: "ev-1", OwnerID: "user-9"}}, wantStatus: http.StatusOK, wantLen: 1},
+		{name: "success empty", wantStatus: http.StatusOK, wantLen: 0},
+		{name: "no user in context", noUserContext: true, wantStatus: http.StatusUnauthorized},
+		{name: "service error", fakeErr: errors.New("db error"), wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{listJoinedEventsErr: tt.fakeErr, joinedEvents: tt.joined}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(http.MethodGet, "/events/joined", nil)
+			if !tt.noUserContext {
+				req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			}
+			rr := httptest.NewRecorder()
+			ctrl.ListJoinedEvents(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, "status code")
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var body struct {
+				Data []domain.Event `json:"data"`
+			}
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&body))
+			require.NotNil(t, body.Data, "data must be an array, not null")
+			assert.Len(t, body.Data, tt.wantLen)
+		})
+	}
+}
+
 func TestScheduleController_GetEventByID(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -975,6 +2562,59 @@ func TestScheduleController_GetEventByID(t *testing.T) {
 	}
 }
 
+func TestScheduleController_GetEventByID_Hypermedia(t *testing.T) {
+	tests := []struct {
+		name            string
+		accept          string
+		wantContentType string
+		wantLinks       bool
+	}{
+		{name: "no accept header", accept: "", wantContentType: "application/json", wantLinks: false},
+		{name: "plain json", accept: "application/json", wantContentType: "application/json", wantLinks: false},
+		{name: "hypermedia", accept: helpers.MediaTypeHypermedia, wantContentType: helpers.MediaTypeHypermedia, wantLinks: true},
+		{name: "hypermedia among others", accept: "text/html, application/vnd.m3t+json;q=0.9", wantContentType: helpers.MediaTypeHypermedia, wantLinks: true},
+		{name: "json api", accept: "application/vnd.api+json", wantContentType: helpers.MediaTypeJSONAPI, wantLinks: true},
+		{name: "both hypermedia types", accept: "application/vnd.m3t+json, application/vnd.api+json", wantContentType: helpers.MediaTypeJSONAPI, wantLinks: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{
+				eventByID: map[string]struct {
+					event    *domain.Event
+					rooms    []*domain.Room
+					sessions []*domain.Session
+				}{
+					"ev-123": {event: &domain.Event{ID: "ev-123", Name: "Conf"}, rooms: []*domain.Room{}, sessions: []*domain.Session{}},
+				},
+			}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(http.MethodGet, "http://test/events/ev-123", nil)
+			req.SetPathValue("eventID", "ev-123")
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			rr := httptest.NewRecorder()
+			ctrl.GetEventByID(rr, req)
+
+			require.Equal(t, http.StatusOK, rr.Code)
+			assert.Equal(t, tt.wantContentType, rr.Header().Get("Content-Type"))
+			var body helpers.HypermediaResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&body))
+			assert.Nil(t, body.Error)
+			if tt.wantLinks {
+				assert.Equal(t, "/events/ev-123", body.Links["self"])
+				assert.Equal(t, "/events/ev-123/rooms", body.Links["rooms"])
+				assert.Equal(t, "/events/ev-123/speakers", body.Links["speakers"])
+				assert.Equal(t, "/events/ev-123/sessions", body.Links["sessions"])
+			} else {
+				assert.Nil(t, body.Links)
+			}
+		})
+	}
+}
+
 func TestScheduleController_ToggleRoomNotBookable(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -1142,25 +2782,137 @@ func TestScheduleController_ListEventRooms(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			fake := &fakeEventService{listEventRoomsErr: tt.fakeErr, listEventRoomsResult: tt.fakeResult}
+			fake := &fakeEventService{listEventRoomsErr: tt.fakeErr, listEventRoomsResult: tt.fakeResult}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(http.MethodGet, "http://test/events/"+tt.eventID+"/rooms", nil)
+			if tt.eventID != "" {
+				req.SetPathValue("eventID", tt.eventID)
+			}
+			if !tt.noUserContext {
+				req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			}
+			rr := httptest.NewRecorder()
+			ctrl.ListEventRooms(rr, req)
+			require.Equal(t, tt.wantStatus, rr.Code)
+			var envelope helpers.APIResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&envelope))
+			if tt.wantStatus == http.StatusOK && tt.checkCall != nil {
+				require.Nil(t, envelope.Error)
+				tt.checkCall(t, fake)
+			}
+			if tt.wantBodySubstr != "" && envelope.Error != nil {
+				assert.Contains(t, envelope.Error.Message, tt.wantBodySubstr)
+			}
+		})
+	}
+}
+
+func TestScheduleController_ReorderEventRooms(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		fakeErr        error
+		wantStatus     int
+		wantBodySubstr string
+	}{
+		{name: "success", body: `{"room_ids":["room-2","room-1"]}`, wantStatus: http.StatusOK},
+		{name: "empty order", body: `{"room_ids":[]}`, wantStatus: http.StatusBadRequest, wantBodySubstr: "room_ids is required"},
+		{name: "not every room", body: `{"room_ids":["room-2"]}`, fakeErr: fmt.Errorf("room room-1 missing from order: %w", domain.ErrInvalidInput), wantStatus: http.StatusBadRequest, wantBodySubstr: "room-1 missing"},
+		{name: "forbidden", body: `{"room_ids":["room-2","room-1"]}`, fakeErr: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantBodySubstr: "forbidden"},
+		{name: "event not found", body: `{"room_ids":["room-2","room-1"]}`, fakeErr: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantBodySubstr: "event not found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{reorderEventRoomsErr: tt.fakeErr}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(http.MethodPut, "http://test/events/ev-1/rooms/order", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.SetPathValue("eventID", "ev-1")
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			rr := httptest.NewRecorder()
+			ctrl.ReorderEventRooms(rr, req)
+			require.Equal(t, tt.wantStatus, rr.Code)
+			var envelope helpers.APIResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&envelope))
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, []string{"room-2", "room-1"}, fake.lastReorderEventRoomIDs)
+			}
+			if tt.wantBodySubstr != "" {
+				require.NotNil(t, envelope.Error)
+				assert.Contains(t, envelope.Error.Message, tt.wantBodySubstr)
+			}
+		})
+	}
+}
+
+func TestScheduleController_ListEventTracks(t *testing.T) {
+	tests := []struct {
+		name           string
+		fakeErr        error
+		wantStatus     int
+		wantBodySubstr string
+	}{
+		{name: "success", wantStatus: http.StatusOK},
+		{name: "forbidden", fakeErr: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantBodySubstr: "forbidden"},
+		{name: "event not found", fakeErr: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantBodySubstr: "event not found"},
+		{name: "service error", fakeErr: errors.New("db error"), wantStatus: http.StatusInternalServerError, wantBodySubstr: "db error"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{eventTracks: []string{"Backend", "Frontend"}, eventTracksErr: tt.fakeErr}
 			ctrl := NewScheduleController(testLogger, fake)
-			req := httptest.NewRequest(http.MethodGet, "http://test/events/"+tt.eventID+"/rooms", nil)
-			if tt.eventID != "" {
-				req.SetPathValue("eventID", tt.eventID)
-			}
-			if !tt.noUserContext {
-				req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			req := httptest.NewRequest(http.MethodGet, "http://test/events/ev-1/tracks", nil)
+			req.SetPathValue("eventID", "ev-1")
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			rr := httptest.NewRecorder()
+			ctrl.ListEventTracks(rr, req)
+			require.Equal(t, tt.wantStatus, rr.Code)
+			if tt.wantStatus != http.StatusOK {
+				var envelope helpers.APIResponse
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(&envelope))
+				require.NotNil(t, envelope.Error)
+				assert.Contains(t, envelope.Error.Message, tt.wantBodySubstr)
+				return
 			}
+			var resp ListTracksSuccessResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+			assert.Equal(t, []string{"Backend", "Frontend"}, resp.Data)
+		})
+	}
+}
+
+func TestScheduleController_ReorderEventTracks(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		fakeErr        error
+		wantStatus     int
+		wantBodySubstr string
+	}{
+		{name: "success", body: `{"tracks":["Frontend","Backend"]}`, wantStatus: http.StatusOK},
+		{name: "empty order", body: `{"tracks":[]}`, wantStatus: http.StatusBadRequest, wantBodySubstr: "tracks is required"},
+		{name: "not every track", body: `{"tracks":["Frontend"]}`, fakeErr: fmt.Errorf("track \"Backend\" missing from order: %w", domain.ErrInvalidInput), wantStatus: http.StatusBadRequest, wantBodySubstr: "Backend\" missing"},
+		{name: "forbidden", body: `{"tracks":["Frontend","Backend"]}`, fakeErr: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantBodySubstr: "forbidden"},
+		{name: "event not found", body: `{"tracks":["Frontend","Backend"]}`, fakeErr: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantBodySubstr: "event not found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{eventTracksErr: tt.fakeErr}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(http.MethodPut, "http://test/events/ev-1/tracks/order", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.SetPathValue("eventID", "ev-1")
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
 			rr := httptest.NewRecorder()
-			ctrl.ListEventRooms(rr, req)
+			ctrl.ReorderEventTracks(rr, req)
 			require.Equal(t, tt.wantStatus, rr.Code)
 			var envelope helpers.APIResponse
 			require.NoError(t, json.NewDecoder(rr.Body).Decode(&envelope))
-			if tt.wantStatus == http.StatusOK && tt.checkCall != nil {
-				require.Nil(t, envelope.Error)
-				tt.checkCall(t, fake)
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, []string{"Frontend", "Backend"}, fake.lastReorderEventTracks)
 			}
-			if tt.wantBodySubstr != "" && envelope.Error != nil {
+			if tt.wantBodySubstr != "" {
+				require.NotNil(t, envelope.Error)
 				assert.Contains(t, envelope.Error.Message, tt.wantBodySubstr)
 			}
 		})
@@ -1391,6 +3143,7 @@ func TestScheduleController_DeleteEventRoom(t *testing.T) {
 		name           string
 		eventID        string
 		roomID         string
+		query          string
 		noUserContext  bool
 		fakeErr        error
 		wantStatus     int
@@ -1406,8 +3159,64 @@ func TestScheduleController_DeleteEventRoom(t *testing.T) {
 				assert.Equal(t, "ev-1", fake.lastDeleteEventRoomEventID)
 				assert.Equal(t, "room-1", fake.lastDeleteEventRoomRoomID)
 				assert.Equal(t, "user-123", fake.lastDeleteEventRoomOwnerID)
+				assert.Empty(t, fake.lastDeleteEventRoomMode)
+				assert.Empty(t, fake.lastDeleteEventRoomTarget)
+			},
+		},
+		{
+			name:       "reassign passes mode and target",
+			eventID:    "ev-1",
+			roomID:     "room-1",
+			query:      "?mode=reassign&target_room_id=room-2",
+			wantStatus: http.StatusOK,
+			checkCall: func(t *testing.T, fake *fakeEventService) {
+				assert.Equal(t, domain.RoomDeleteReassign, fake.lastDeleteEventRoomMode)
+				assert.Equal(t, "room-2", fake.lastDeleteEventRoomTarget)
+			},
+		},
+		{
+			name:       "unschedule",
+			eventID:    "ev-1",
+			roomID:     "room-1",
+			query:      "?mode=unschedule",
+			wantStatus: http.StatusOK,
+			checkCall: func(t *testing.T, fake *fakeEventService) {
+				assert.Equal(t, domain.RoomDeleteUnschedule, fake.lastDeleteEventRoomMode)
 			},
 		},
+		{
+			name:           "unknown mode",
+			eventID:        "ev-1",
+			roomID:         "room-1",
+			query:          "?mode=archive",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "mode must be cascade, reassign or unschedule",
+		},
+		{
+			name:           "reassign without target",
+			eventID:        "ev-1",
+			roomID:         "room-1",
+			query:          "?mode=reassign",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "target_room_id is required",
+		},
+		{
+			name:           "target without reassign",
+			eventID:        "ev-1",
+			roomID:         "room-1",
+			query:          "?mode=unschedule&target_room_id=room-2",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "target_room_id is only allowed",
+		},
+		{
+			name:           "invalid target room",
+			eventID:        "ev-1",
+			roomID:         "room-1",
+			query:          "?mode=reassign&target_room_id=room-1",
+			fakeErr:        fmt.Errorf("%w: target room must differ from the deleted room", domain.ErrInvalidInput),
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "target room must differ",
+		},
 		{
 			name:           "missing eventID",
 			eventID:        "",
@@ -1451,7 +3260,7 @@ func TestScheduleController_DeleteEventRoom(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			fake := &fakeEventService{deleteEventRoomErr: tt.fakeErr}
 			ctrl := NewScheduleController(testLogger, fake)
-			path := "http://test/events/" + tt.eventID + "/rooms/" + tt.roomID
+			path := "http://test/events/" + tt.eventID + "/rooms/" + tt.roomID + tt.query
 			req := httptest.NewRequest(http.MethodDelete, path, nil)
 			if tt.eventID != "" {
 				req.SetPathValue("eventID", tt.eventID)
@@ -2640,15 +4449,23 @@ func TestScheduleController_CreateEventSpeaker(t *testing.T) {
 		{
 			name:       "success",
 			eventID:    "ev-1",
-			body:       `{"first_name":"Jane","last_name":"Doe","bio":"Bio","is_top_speaker":true}`,
+			body:       `{"first_name":"Jane","last_name":"Doe","email":" jane@example.com ","bio":"Bio","is_top_speaker":true}`,
 			wantStatus: http.StatusCreated,
 			checkCall: func(t *testing.T, fake *fakeEventService) {
 				assert.Equal(t, "ev-1", fake.lastCreateEventSpeakerEventID)
 				assert.Equal(t, "user-123", fake.lastCreateEventSpeakerOwnerID)
 				assert.Equal(t, "Jane", fake.lastCreateEventSpeakerFirstName)
 				assert.Equal(t, "Doe", fake.lastCreateEventSpeakerLastName)
+				assert.Equal(t, "jane@example.com", fake.lastCreateEventSpeakerEmail)
 			},
 		},
+		{
+			name:           "validation invalid email",
+			eventID:        "ev-1",
+			body:           `{"first_name":"Jane","email":"jane"}`,
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "email must be a valid email address",
+		},
 		{
 			name:           "missing eventID",
 			eventID:        "",
@@ -2962,6 +4779,14 @@ func TestScheduleController_CreateEventSession(t *testing.T) {
 			wantStatus:     http.StatusBadRequest,
 			wantBodySubstr: "end_time must be after start_time",
 		},
+		{
+			name:           "breaks schedule rules",
+			eventID:        "ev-1",
+			body:           `{"room_id":"room-1","title":"Talk","start_time":"2025-03-01T03:00:00Z","end_time":"2025-03-01T04:00:00Z"}`,
+			fakeErr:        fmt.Errorf("session starts at 03:00 but may not start before 09:00: %w", domain.ErrScheduleRuleViolation),
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "may not start before 09:00",
+		},
 		{
 			name:           "service error",
 			eventID:        "ev-1",
@@ -3009,6 +4834,117 @@ func TestScheduleController_CreateEventSession(t *testing.T) {
 	}
 }
 
+func TestScheduleController_ListSessionHistory(t *testing.T) {
+	tests := []struct {
+		name           string
+		sessionID      string
+		noUserContext  bool
+		fakeErr        error
+		wantStatus     int
+		wantBodySubstr string
+	}{
+		{name: "success", sessionID: "sess-1", wantStatus: http.StatusOK},
+		{name: "missing sessionID", wantStatus: http.StatusBadRequest, wantBodySubstr: "missing eventID or sessionID"},
+		{name: "no user in context", sessionID: "sess-1", noUserContext: true, wantStatus: http.StatusUnauthorized, wantBodySubstr: "unauthorized"},
+		{name: "not found", sessionID: "sess-1", fakeErr: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantBodySubstr: "event or session not found"},
+		{name: "forbidden", sessionID: "sess-1", fakeErr: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantBodySubstr: "forbidden"},
+		{name: "service error", sessionID: "sess-1", fakeErr: errors.New("db error"), wantStatus: http.StatusInternalServerError, wantBodySubstr: "db error"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{
+				sessionHistoryErr: tt.fakeErr,
+				sessionHistory: []*domain.SessionChange{
+					{ID: "c1", SessionID: "sess-1", FromRoomID: "room-1", FromRoomName: "Room A", ToRoomID: "room-2", ToRoomName: "Room B"},
+				},
+			}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(http.MethodGet, "http://test/events/ev-1/sessions/"+tt.sessionID+"/history", nil)
+			req.SetPathValue("eventID", "ev-1")
+			if tt.sessionID != "" {
+				req.SetPathValue("sessionID", tt.sessionID)
+			}
+			if !tt.noUserContext {
+				req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			}
+			rr := httptest.NewRecorder()
+			ctrl.ListSessionHistory(rr, req)
+			require.Equal(t, tt.wantStatus, rr.Code)
+
+			if tt.wantStatus != http.StatusOK {
+				var envelope helpers.APIResponse
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(&envelope))
+				require.NotNil(t, envelope.Error)
+				assert.Contains(t, envelope.Error.Message, tt.wantBodySubstr)
+				return
+			}
+			var resp ListSessionHistorySuccessResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+			assert.Equal(t, "sess-1", fake.lastSessionHistorySessionID)
+			require.Len(t, resp.Data, 1)
+			assert.Equal(t, "Room A", resp.Data[0].FromRoomName)
+			assert.Equal(t, "Room B", resp.Data[0].ToRoomName)
+		})
+	}
+}
+
+func TestScheduleController_GetEventScheduleAsOf(t *testing.T) {
+	asOf := time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name           string
+		query          string
+		noUserContext  bool
+		fakeErr        error
+		wantStatus     int
+		wantBodySubstr string
+	}{
+		{name: "as of", query: "?as_of=2025-03-01T09:30:00Z", wantStatus: http.StatusOK},
+		{name: "invalid as_of", query: "?as_of=yesterday", wantStatus: http.StatusBadRequest, wantBodySubstr: "as_of must be an RFC 3339 time"},
+		{name: "no user in context", noUserContext: true, wantStatus: http.StatusUnauthorized, wantBodySubstr: "unauthorized"},
+		{name: "in the future", query: "?as_of=2999-01-01T00:00:00Z", fakeErr: domain.ErrInvalidInput, wantStatus: http.StatusBadRequest},
+		{name: "not owner", fakeErr: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantBodySubstr: "forbidden"},
+		{name: "event not found", fakeErr: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantBodySubstr: "event not found"},
+		{name: "service error", fakeErr: errors.New("db error"), wantStatus: http.StatusInternalServerError, wantBodySubstr: "db error"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{
+				scheduleSnapshotErr: tt.fakeErr,
+				scheduleSnapshot: &domain.ScheduleSnapshot{
+					Event:             &domain.Event{ID: "ev-1"},
+					AsOf:              asOf,
+					Partial:           true,
+					DeletedSessionIDs: []string{"sess-2"},
+					EditedSessionIDs:  []string{},
+				},
+			}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(http.MethodGet, "http://test/events/ev-1/schedule"+tt.query, nil)
+			req.SetPathValue("eventID", "ev-1")
+			if !tt.noUserContext {
+				req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			}
+			rr := httptest.NewRecorder()
+			ctrl.GetEventScheduleAsOf(rr, req)
+			require.Equal(t, tt.wantStatus, rr.Code)
+
+			if tt.wantStatus != http.StatusOK {
+				var envelope helpers.APIResponse
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(&envelope))
+				require.NotNil(t, envelope.Error)
+				assert.Contains(t, envelope.Error.Message, tt.wantBodySubstr)
+				return
+			}
+			var resp ScheduleSnapshotSuccessResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+			assert.True(t, fake.lastScheduleAsOf.Equal(asOf))
+			require.NotNil(t, resp.Data)
+			assert.True(t, resp.Data.Partial)
+			assert.Equal(t, []string{"sess-2"}, resp.Data.DeletedSessionIDs)
+		})
+	}
+}
+
 func TestScheduleController_DeleteEventSession(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -3153,177 +5089,89 @@ func TestScheduleController_UpdateSessionContent(t *testing.T) {
 		},
 		{
 			name:           "missing sessionID",
-			eventID:        "ev-1",
-			sessionID:      "",
-			body:           `{"title":"X"}`,
-			wantStatus:     http.StatusBadRequest,
-			wantBodySubstr: "missing eventID or sessionID",
-		},
-		{
-			name:           "empty title",
-			eventID:        "ev-1",
-			sessionID:      "sess-1",
-			body:           `{"title":""}`,
-			wantStatus:     http.StatusBadRequest,
-			wantBodySubstr: "title cannot be empty",
-		},
-		{
-			name:           "no user in context",
-			eventID:        "ev-1",
-			sessionID:      "sess-1",
-			body:           `{"title":"X"}`,
-			noUserContext:  true,
-			wantStatus:     http.StatusUnauthorized,
-			wantBodySubstr: "unauthorized",
-		},
-		{
-			name:           "not found",
-			eventID:        "ev-missing",
-			sessionID:      "sess-1",
-			body:           `{"title":"X"}`,
-			fakeErr:        domain.ErrNotFound,
-			wantStatus:     http.StatusNotFound,
-			wantBodySubstr: "event or session not found",
-		},
-		{
-			name:           "forbidden",
-			eventID:        "ev-1",
-			sessionID:      "sess-1",
-			body:           `{"title":"X"}`,
-			fakeErr:        domain.ErrForbidden,
-			wantStatus:     http.StatusForbidden,
-			wantBodySubstr: "forbidden",
-		},
-		{
-			name:           "service error",
-			eventID:        "ev-1",
-			sessionID:      "sess-1",
-			body:           `{"title":"X"}`,
-			fakeErr:        errors.New("db error"),
-			wantStatus:     http.StatusInternalServerError,
-			wantBodySubstr: "db error",
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			fake := &fakeEventService{updateSessionContentErr: tt.fakeErr, updateSessionContentResult: tt.fakeResult}
-			ctrl := NewScheduleController(testLogger, fake)
-			path := "http://test/events/" + tt.eventID + "/sessions/" + tt.sessionID + "/content"
-			req := httptest.NewRequest(http.MethodPatch, path, bytes.NewBufferString(tt.body))
-			req.Header.Set("Content-Type", "application/json")
-			if tt.eventID != "" {
-				req.SetPathValue("eventID", tt.eventID)
-			}
-			if tt.sessionID != "" {
-				req.SetPathValue("sessionID", tt.sessionID)
-			}
-			if !tt.noUserContext {
-				req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
-			}
-			rr := httptest.NewRecorder()
-			ctrl.UpdateSessionContent(rr, req)
-			require.Equal(t, tt.wantStatus, rr.Code)
-			var envelope helpers.APIResponse
-			require.NoError(t, json.NewDecoder(rr.Body).Decode(&envelope))
-			if tt.wantStatus == http.StatusOK && tt.checkCall != nil {
-				require.Nil(t, envelope.Error)
-				tt.checkCall(t, fake)
-				var data domain.Session
-				dataBytes, _ := json.Marshal(envelope.Data)
-				require.NoError(t, json.Unmarshal(dataBytes, &data))
-				assert.Equal(t, "New Title", data.Title)
-				assert.Equal(t, "New desc", data.Description)
-			}
-			if tt.wantBodySubstr != "" && envelope.Error != nil {
-				assert.Contains(t, envelope.Error.Message, tt.wantBodySubstr)
-			}
-		})
-	}
-}
-
-func TestScheduleController_DeleteEvent(t *testing.T) {
-	tests := []struct {
-		name           string
-		eventID        string
-		noUserContext  bool
-		fakeErr        error
-		wantStatus     int
-		wantBodySubstr string
-		checkCall      func(t *testing.T, fake *fakeEventService)
-	}{
-		{
-			name:       "success",
-			eventID:    "ev-123",
-			wantStatus: http.StatusOK,
-			checkCall: func(t *testing.T, fake *fakeEventService) {
-				assert.Equal(t, "ev-123", fake.lastDeleteEventID)
-				assert.Equal(t, "user-123", fake.lastDeleteOwnerID)
-			},
+			eventID:        "ev-1",
+			sessionID:      "",
+			body:           `{"title":"X"}`,
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "missing eventID or sessionID",
 		},
 		{
-			name:           "missing eventID",
-			eventID:        "",
+			name:           "empty title",
+			eventID:        "ev-1",
+			sessionID:      "sess-1",
+			body:           `{"title":""}`,
 			wantStatus:     http.StatusBadRequest,
-			wantBodySubstr: "missing eventID",
+			wantBodySubstr: "title cannot be empty",
 		},
 		{
 			name:           "no user in context",
-			eventID:        "ev-123",
+			eventID:        "ev-1",
+			sessionID:      "sess-1",
+			body:           `{"title":"X"}`,
 			noUserContext:  true,
 			wantStatus:     http.StatusUnauthorized,
 			wantBodySubstr: "unauthorized",
 		},
 		{
-			name:           "event not found",
+			name:           "not found",
 			eventID:        "ev-missing",
+			sessionID:      "sess-1",
+			body:           `{"title":"X"}`,
 			fakeErr:        domain.ErrNotFound,
 			wantStatus:     http.StatusNotFound,
-			wantBodySubstr: "event not found",
+			wantBodySubstr: "event or session not found",
 		},
 		{
-			name:           "forbidden not owner",
-			eventID:        "ev-123",
+			name:           "forbidden",
+			eventID:        "ev-1",
+			sessionID:      "sess-1",
+			body:           `{"title":"X"}`,
 			fakeErr:        domain.ErrForbidden,
 			wantStatus:     http.StatusForbidden,
 			wantBodySubstr: "forbidden",
 		},
 		{
 			name:           "service error",
-			eventID:        "ev-123",
+			eventID:        "ev-1",
+			sessionID:      "sess-1",
+			body:           `{"title":"X"}`,
 			fakeErr:        errors.New("db error"),
 			wantStatus:     http.StatusInternalServerError,
 			wantBodySubstr: "db error",
 		},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			fake := &fakeEventService{deleteEventErr: tt.fakeErr}
+			fake := &fakeEventService{updateSessionContentErr: tt.fakeErr, updateSessionContentResult: tt.fakeResult}
 			ctrl := NewScheduleController(testLogger, fake)
-			req := httptest.NewRequest(http.MethodDelete, "http://test/events/"+tt.eventID, nil)
+			path := "http://test/events/" + tt.eventID + "/sessions/" + tt.sessionID + "/content"
+			req := httptest.NewRequest(http.MethodPatch, path, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
 			if tt.eventID != "" {
 				req.SetPathValue("eventID", tt.eventID)
 			}
+			if tt.sessionID != "" {
+				req.SetPathValue("sessionID", tt.sessionID)
+			}
 			if !tt.noUserContext {
 				req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
 			}
 			rr := httptest.NewRecorder()
-			ctrl.DeleteEvent(rr, req)
-
-			require.Equal(t, tt.wantStatus, rr.Code, "status code")
+			ctrl.UpdateSessionContent(rr, req)
+			require.Equal(t, tt.wantStatus, rr.Code)
 			var envelope helpers.APIResponse
-			require.NoError(t, json.NewDecoder(rr.Body).Decode(&envelope), "response must be valid JSON envelope")
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&envelope))
 			if tt.wantStatus == http.StatusOK && tt.checkCall != nil {
-				require.Nil(t, envelope.Error, "success response must have error nil")
+				require.Nil(t, envelope.Error)
 				tt.checkCall(t, fake)
-				var data DeleteEventResponse
+				var data domain.Session
 				dataBytes, _ := json.Marshal(envelope.Data)
 				require.NoError(t, json.Unmarshal(dataBytes, &data))
-				assert.Equal(t, "deleted", data.Status)
+				assert.Equal(t, "New Title", data.Title)
+				assert.Equal(t, "New desc", data.Description)
 			}
-			if tt.wantStatus != http.StatusOK && tt.wantBodySubstr != "" {
-				require.NotNil(t, envelope.Error, "error response must have error set")
-				assert.Contains(t, envelope.Error.Message, tt.wantBodySubstr, "error message")
+			if tt.wantBodySubstr != "" && envelope.Error != nil {
+				assert.Contains(t, envelope.Error.Message, tt.wantBodySubstr)
 			}
 		})
 	}
@@ -3460,6 +5308,7 @@ func TestScheduleController_AddEventTeamMember(t *testing.T) {
 		fakeResult     *domain.EventTeamMember
 		wantStatus     int
 		wantBodySubstr string
+		wantErrCode    string
 		noUserContext  bool
 	}{
 		{
@@ -3504,6 +5353,7 @@ func TestScheduleController_AddEventTeamMember(t *testing.T) {
 			fakeErr:        domain.ErrUserNotFound,
 			wantStatus:     http.StatusNotFound,
 			wantBodySubstr: "no user with that email",
+			wantErrCode:    helpers.ErrCodeUserNotFound,
 		},
 		{
 			name:           "event not found",
@@ -3512,6 +5362,7 @@ func TestScheduleController_AddEventTeamMember(t *testing.T) {
 			fakeErr:        domain.ErrNotFound,
 			wantStatus:     http.StatusNotFound,
 			wantBodySubstr: "event not found",
+			wantErrCode:    helpers.ErrCodeEventNotFound,
 		},
 		{
 			name:       "forbidden",
@@ -3521,11 +5372,12 @@ func TestScheduleController_AddEventTeamMember(t *testing.T) {
 			wantStatus: http.StatusForbidden,
 		},
 		{
-			name:       "conflict already member",
-			eventID:    "ev-1",
-			body:       `{"email":"teammate@example.com"}`,
-			fakeErr:    domain.ErrAlreadyMember,
-			wantStatus: http.StatusConflict,
+			name:        "conflict already member",
+			eventID:     "ev-1",
+			body:        `{"email":"teammate@example.com"}`,
+			fakeErr:     domain.ErrAlreadyMember,
+			wantStatus:  http.StatusConflict,
+			wantErrCode: helpers.ErrCodeAlreadyMember,
 		},
 	}
 
@@ -3558,6 +5410,111 @@ func TestScheduleController_AddEventTeamMember(t *testing.T) {
 			if tt.wantBodySubstr != "" && envelope.Error != nil {
 				assert.Contains(t, envelope.Error.Message, tt.wantBodySubstr)
 			}
+			if tt.wantErrCode != "" {
+				require.NotNil(t, envelope.Error)
+				assert.Equal(t, tt.wantErrCode, envelope.Error.Code)
+			}
+		})
+	}
+}
+
+func TestScheduleController_PromoteInvitation(t *testing.T) {
+	tests := []struct {
+		name          string
+		invitationID  string
+		body          string
+		fakeErr       error
+		noUserContext bool
+		wantStatus    int
+		wantErrCode   string
+		wantRole      string
+	}{
+		{name: "success default role", invitationID: "inv-1", body: `{}`, wantStatus: http.StatusCreated},
+		{name: "success team_member role", invitationID: "inv-1", body: `{"role":"team_member"}`, wantStatus: http.StatusCreated, wantRole: "team_member"},
+		{name: "unknown role", invitationID: "inv-1", body: `{"role":"admin"}`, wantStatus: http.StatusBadRequest, wantErrCode: helpers.ErrCodeBadRequest},
+		{name: "missing invitationID", body: `{}`, wantStatus: http.StatusBadRequest, wantErrCode: helpers.ErrCodeBadRequest},
+		{name: "no user in context", invitationID: "inv-1", body: `{}`, noUserContext: true, wantStatus: http.StatusUnauthorized},
+		{name: "invitation not found", invitationID: "inv-1", body: `{}`, fakeErr: domain.ErrInvitationNotFound, wantStatus: http.StatusNotFound, wantErrCode: helpers.ErrCodeInvitationNotFound},
+		{name: "invitee has no account", invitationID: "inv-1", body: `{}`, fakeErr: domain.ErrUserNotFound, wantStatus: http.StatusNotFound, wantErrCode: helpers.ErrCodeUserNotFound},
+		{name: "event not found", invitationID: "inv-1", body: `{}`, fakeErr: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantErrCode: helpers.ErrCodeEventNotFound},
+		{name: "forbidden", invitationID: "inv-1", body: `{}`, fakeErr: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantErrCode: helpers.ErrCodeForbidden},
+		{name: "already member", invitationID: "inv-1", body: `{}`, fakeErr: domain.ErrAlreadyMember, wantStatus: http.StatusConflict, wantErrCode: helpers.ErrCodeAlreadyMember},
+		{name: "invitee is the owner", invitationID: "inv-1", body: `{}`, fakeErr: domain.ErrInvalidInput, wantStatus: http.StatusConflict, wantErrCode: helpers.ErrCodeConflict},
+		{name: "service error", invitationID: "inv-1", body: `{}`, fakeErr: errors.New("db down"), wantStatus: http.StatusInternalServerError, wantErrCode: helpers.ErrCodeInternalError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{promoteInvitationErr: tt.fakeErr}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(http.MethodPost, "http://test/events/ev-1/invitations/"+tt.invitationID+"/promote", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.SetPathValue("eventID", "ev-1")
+			if tt.invitationID != "" {
+				req.SetPathValue("invitationID", tt.invitationID)
+			}
+			if !tt.noUserContext {
+				req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			}
+			rr := httptest.NewRecorder()
+			ctrl.PromoteInvitation(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			var envelope helpers.APIResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&envelope))
+			if tt.wantStatus == http.StatusCreated {
+				require.Nil(t, envelope.Error)
+				assert.Equal(t, tt.invitationID, fake.lastPromoteInvitationID)
+				assert.Equal(t, tt.wantRole, fake.lastPromoteRole)
+				return
+			}
+			if tt.wantErrCode != "" {
+				require.NotNil(t, envelope.Error)
+				assert.Equal(t, tt.wantErrCode, envelope.Error.Code)
+			}
+		})
+	}
+}
+
+func TestScheduleController_LeaveEvent(t *testing.T) {
+	tests := []struct {
+		name          string
+		fakeErr       error
+		noUserContext bool
+		wantStatus    int
+		wantErrCode   string
+	}{
+		{name: "success", wantStatus: http.StatusOK},
+		{name: "no user in context", noUserContext: true, wantStatus: http.StatusUnauthorized, wantErrCode: helpers.ErrCodeUnauthorized},
+		{name: "not a member", fakeErr: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantErrCode: helpers.ErrCodeNotFound},
+		{name: "owner", fakeErr: fmt.Errorf("the owner cannot leave their own event: %w", domain.ErrInvalidInput), wantStatus: http.StatusBadRequest, wantErrCode: helpers.ErrCodeBadRequest},
+		{name: "service error", fakeErr: errors.New("db down"), wantStatus: http.StatusInternalServerError, wantErrCode: helpers.ErrCodeInternalError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{leaveEventErr: tt.fakeErr}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(http.MethodDelete, "/events/ev-1/team-members/me", nil)
+			req.SetPathValue("eventID", "ev-1")
+			if !tt.noUserContext {
+				req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			}
+			rr := httptest.NewRecorder()
+			ctrl.LeaveEvent(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			var envelope helpers.APIResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&envelope))
+			if tt.wantStatus == http.StatusOK {
+				require.Nil(t, envelope.Error)
+				assert.Equal(t, map[string]any{"status": "left"}, envelope.Data)
+				assert.Equal(t, "ev-1", fake.lastLeaveEventID)
+				assert.Equal(t, "user-123", fake.lastLeaveUserID)
+				return
+			}
+			require.NotNil(t, envelope.Error)
+			assert.Equal(t, tt.wantErrCode, envelope.Error.Code)
 		})
 	}
 }
@@ -3787,17 +5744,217 @@ func TestScheduleController_ListEventInvitations(t *testing.T) {
 			fakeResult: []*domain.EventInvitation{{ID: "inv-1", EventID: "ev-1", Email: "alice@example.com"}},
 			fakeTotal:  1,
 			wantStatus: http.StatusOK,
-			checkCall: func(t *testing.T, fake *fakeEventService) {
-				assert.Equal(t, "alice", fake.lastListInvitationsSearch)
+			checkCall: func(t *testing.T, fake *fakeEventService) {
+				assert.Equal(t, "alice", fake.lastListInvitationsSearch)
+			},
+			checkData: func(t *testing.T, data ListEventInvitationsResponse) {
+				require.Len(t, data.Items, 1)
+				assert.Equal(t, "alice@example.com", data.Items[0].Email)
+			},
+		},
+		{
+			name:           "missing eventID",
+			eventID:        "",
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "missing eventID",
+		},
+		{
+			name:           "no user in context",
+			eventID:        "ev-1",
+			noUserContext:  true,
+			wantStatus:     http.StatusUnauthorized,
+			wantBodySubstr: "unauthorized",
+		},
+		{
+			name:           "event not found",
+			eventID:        "ev-1",
+			fakeErr:        domain.ErrNotFound,
+			wantStatus:     http.StatusNotFound,
+			wantBodySubstr: "event not found",
+		},
+		{
+			name:           "forbidden",
+			eventID:        "ev-1",
+			fakeErr:        domain.ErrForbidden,
+			wantStatus:     http.StatusForbidden,
+			wantBodySubstr: "forbidden",
+		},
+		{
+			name:           "service error",
+			eventID:        "ev-1",
+			fakeErr:        errors.New("db error"),
+			wantStatus:     http.StatusInternalServerError,
+			wantBodySubstr: "db error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{
+				listEventInvitationsErr:    tt.fakeErr,
+				listEventInvitationsResult: tt.fakeResult,
+				listEventInvitationsTotal:  tt.fakeTotal,
+			}
+			ctrl := NewScheduleController(testLogger, fake)
+			url := "http://test/events/" + tt.eventID + "/invitations"
+			if tt.query != "" {
+				url += tt.query
+			}
+			req := httptest.NewRequest(http.MethodGet, url, nil)
+			if tt.eventID != "" {
+				req.SetPathValue("eventID", tt.eventID)
+			}
+			if !tt.noUserContext {
+				req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			}
+			rr := httptest.NewRecorder()
+			ctrl.ListEventInvitations(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+			var envelope helpers.APIResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&envelope))
+			if tt.wantStatus == http.StatusOK {
+				require.Nil(t, envelope.Error)
+				if tt.checkCall != nil {
+					tt.checkCall(t, fake)
+				}
+				if tt.checkData != nil {
+					dataBytes, err := json.Marshal(envelope.Data)
+					require.NoError(t, err)
+					var data ListEventInvitationsResponse
+					require.NoError(t, json.Unmarshal(dataBytes, &data))
+					tt.checkData(t, data)
+				}
+			}
+			if tt.wantBodySubstr != "" && envelope.Error != nil {
+				assert.Contains(t, envelope.Error.Message, tt.wantBodySubstr)
+			}
+		})
+	}
+}
+
+func TestScheduleController_ListEventInvitations_NDJSON(t *testing.T) {
+	invs := []*domain.EventInvitation{
+		{ID: "inv-1", EventID: "ev-1", Email: "a@example.com"},
+		{ID: "inv-2", EventID: "ev-1", Email: "b@example.com"},
+	}
+	tests := []struct {
+		name           string
+		fakeErr        error
+		fakeErrAfter   int
+		wantStatus     int
+		wantType       string
+		wantLines      int
+		wantBodySubstr string
+	}{
+		{
+			name:       "streams every row",
+			wantStatus: http.StatusOK,
+			wantType:   helpers.MediaTypeNDJSON,
+			wantLines:  2,
+		},
+		{
+			name:           "not found before first row",
+			fakeErr:        domain.ErrNotFound,
+			wantStatus:     http.StatusNotFound,
+			wantType:       "application/json",
+			wantBodySubstr: "event not found",
+		},
+		{
+			name:           "forbidden before first row",
+			fakeErr:        domain.ErrForbidden,
+			wantStatus:     http.StatusForbidden,
+			wantType:       "application/json",
+			wantBodySubstr: "forbidden",
+		},
+		{
+			name:           "error mid-stream ends with error line",
+			fakeErr:        errors.New("cursor closed"),
+			fakeErrAfter:   1,
+			wantStatus:     http.StatusOK,
+			wantType:       helpers.MediaTypeNDJSON,
+			wantLines:      2,
+			wantBodySubstr: `{"error":{"code":"internal_error","message":"cursor closed"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{
+				listEventInvitationsResult: invs,
+				streamErr:                  tt.fakeErr,
+				streamErrAfter:             tt.fakeErrAfter,
+			}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(http.MethodGet, "http://test/events/ev-1/invitations?search=a&page=3", nil)
+			req.Header.Set("Accept", helpers.MediaTypeNDJSON)
+			req.SetPathValue("eventID", "ev-1")
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			rr := httptest.NewRecorder()
+			ctrl.ListEventInvitations(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+			assert.Equal(t, tt.wantType, rr.Header().Get("Content-Type"))
+			assert.Equal(t, "a", fake.lastStreamInvitationsSearch)
+			assert.Equal(t, "user-123", fake.lastStreamInvitationsCallerID)
+			if tt.wantLines > 0 {
+				lines := strings.Split(strings.TrimSpace(rr.Body.String()), "\n")
+				require.Len(t, lines, tt.wantLines)
+				var inv domain.EventInvitation
+				require.NoError(t, json.Unmarshal([]byte(lines[0]), &inv))
+				assert.Equal(t, "inv-1", inv.ID)
+			}
+			if tt.wantBodySubstr != "" {
+				assert.Contains(t, rr.Body.String(), tt.wantBodySubstr)
+			}
+		})
+	}
+}
+
+func TestScheduleController_ListEventSessions(t *testing.T) {
+	sessions := []*domain.Session{
+		{ID: "sess-1", RoomID: "room-1", Title: "Opening", Tags: []*domain.Tag{{ID: "tag-1", Name: "go"}}, SpeakerIDs: []string{"sp-1"}},
+		{ID: "sess-2", RoomID: "room-2", Title: "Closing"},
+	}
+	tests := []struct {
+		name           string
+		eventID        string
+		accept         string
+		fakeErr        error
+		noUserContext  bool
+		wantStatus     int
+		wantBodySubstr string
+		check          func(t *testing.T, rr *httptest.ResponseRecorder)
+	}{
+		{
+			name:       "json lists all sessions",
+			eventID:    "ev-1",
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, rr *httptest.ResponseRecorder) {
+				var resp ListEventSessionsSuccessResponse
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+				require.Len(t, resp.Data, 2)
+				assert.Equal(t, "sess-1", resp.Data[0].ID)
+				require.Len(t, resp.Data[0].Tags, 1)
+				assert.Equal(t, []string{"sp-1"}, resp.Data[0].SpeakerIDs)
 			},
-			checkData: func(t *testing.T, data ListEventInvitationsResponse) {
-				require.Len(t, data.Items, 1)
-				assert.Equal(t, "alice@example.com", data.Items[0].Email)
+		},
+		{
+			name:       "ndjson streams one session per line",
+			eventID:    "ev-1",
+			accept:     helpers.MediaTypeNDJSON,
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, rr *httptest.ResponseRecorder) {
+				assert.Equal(t, helpers.MediaTypeNDJSON, rr.Header().Get("Content-Type"))
+				lines := strings.Split(strings.TrimSpace(rr.Body.String()), "\n")
+				require.Len(t, lines, 2)
+				var sess domain.Session
+				require.NoError(t, json.Unmarshal([]byte(lines[1]), &sess))
+				assert.Equal(t, "sess-2", sess.ID)
 			},
 		},
 		{
 			name:           "missing eventID",
-			eventID:        "",
 			wantStatus:     http.StatusBadRequest,
 			wantBodySubstr: "missing eventID",
 		},
@@ -3818,6 +5975,7 @@ func TestScheduleController_ListEventInvitations(t *testing.T) {
 		{
 			name:           "forbidden",
 			eventID:        "ev-1",
+			accept:         helpers.MediaTypeNDJSON,
 			fakeErr:        domain.ErrForbidden,
 			wantStatus:     http.StatusForbidden,
 			wantBodySubstr: "forbidden",
@@ -3833,17 +5991,12 @@ func TestScheduleController_ListEventInvitations(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			fake := &fakeEventService{
-				listEventInvitationsErr:    tt.fakeErr,
-				listEventInvitationsResult: tt.fakeResult,
-				listEventInvitationsTotal:  tt.fakeTotal,
-			}
+			fake := &fakeEventService{streamEventSessionsResult: sessions, streamErr: tt.fakeErr}
 			ctrl := NewScheduleController(testLogger, fake)
-			url := "http://test/events/" + tt.eventID + "/invitations"
-			if tt.query != "" {
-				url += tt.query
+			req := httptest.NewRequest(http.MethodGet, "http://test/events/"+tt.eventID+"/sessions", nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
 			}
-			req := httptest.NewRequest(http.MethodGet, url, nil)
 			if tt.eventID != "" {
 				req.SetPathValue("eventID", tt.eventID)
 			}
@@ -3851,25 +6004,17 @@ func TestScheduleController_ListEventInvitations(t *testing.T) {
 				req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
 			}
 			rr := httptest.NewRecorder()
-			ctrl.ListEventInvitations(rr, req)
+			ctrl.ListEventSessions(rr, req)
 
 			require.Equal(t, tt.wantStatus, rr.Code)
-			var envelope helpers.APIResponse
-			require.NoError(t, json.NewDecoder(rr.Body).Decode(&envelope))
-			if tt.wantStatus == http.StatusOK {
-				require.Nil(t, envelope.Error)
-				if tt.checkCall != nil {
-					tt.checkCall(t, fake)
-				}
-				if tt.checkData != nil {
-					dataBytes, err := json.Marshal(envelope.Data)
-					require.NoError(t, err)
-					var data ListEventInvitationsResponse
-					require.NoError(t, json.Unmarshal(dataBytes, &data))
-					tt.checkData(t, data)
-				}
+			if tt.check != nil {
+				assert.Equal(t, "user-123", fake.lastStreamSessionsOwnerID)
+				tt.check(t, rr)
 			}
-			if tt.wantBodySubstr != "" && envelope.Error != nil {
+			if tt.wantBodySubstr != "" {
+				var envelope helpers.APIResponse
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(&envelope))
+				require.NotNil(t, envelope.Error)
 				assert.Contains(t, envelope.Error.Message, tt.wantBodySubstr)
 			}
 		})
@@ -3884,6 +6029,7 @@ func TestScheduleController_SendEventInvitations(t *testing.T) {
 		fakeErr        error
 		fakeSent       int
 		fakeFailed     []string
+		fakeSkipped    []string
 		wantStatus     int
 		wantBodySubstr string
 		noUserContext  bool
@@ -3922,6 +6068,18 @@ func TestScheduleController_SendEventInvitations(t *testing.T) {
 				assert.Equal(t, "fail@x.com", data.Failed[0])
 			},
 		},
+		{
+			name:        "skipped by domain rules",
+			eventID:     "ev-1",
+			body:        `{"emails":"a@acme.com b@gmail.com"}`,
+			fakeSent:    1,
+			fakeSkipped: []string{"b@gmail.com"},
+			wantStatus:  http.StatusOK,
+			checkData: func(t *testing.T, data SendEventInvitationsResponse) {
+				assert.Equal(t, 1, data.Sent)
+				assert.Equal(t, []string{"b@gmail.com"}, data.Skipped)
+			},
+		},
 		{
 			name:           "missing eventID",
 			eventID:        "",
@@ -3972,9 +6130,10 @@ func TestScheduleController_SendEventInvitations(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			fake := &fakeEventService{
-				sendEventInvitationsErr:    tt.fakeErr,
-				sendEventInvitationsSent:   tt.fakeSent,
-				sendEventInvitationsFailed: tt.fakeFailed,
+				sendEventInvitationsErr:     tt.fakeErr,
+				sendEventInvitationsSent:    tt.fakeSent,
+				sendEventInvitationsFailed:  tt.fakeFailed,
+				sendEventInvitationsSkipped: tt.fakeSkipped,
 			}
 			ctrl := NewScheduleController(testLogger, fake)
 			req := httptest.NewRequest(http.MethodPost, "http://test/events/"+tt.eventID+"/invitations", bytes.NewBufferString(tt.body))
@@ -4010,3 +6169,404 @@ func TestScheduleController_SendEventInvitations(t *testing.T) {
 		})
 	}
 }
+
+func TestScheduleController_CreateCustomField(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		fakeErr        error
+		wantStatus     int
+		wantBodySubstr string
+	}{
+		{name: "success", body: `{"name":"Paper DOI","type":"url","applies_to":"session","public":true}`, wantStatus: http.StatusCreated, wantBodySubstr: "field-1"},
+		{name: "missing type", body: `{"name":"Paper DOI","applies_to":"session"}`, wantStatus: http.StatusBadRequest, wantBodySubstr: "type is required"},
+		{
+			name:           "unknown type",
+			body:           `{"name":"Color","type":"color","applies_to":"session"}`,
+			fakeErr:        fmt.Errorf("custom field type must be text, number, boolean, date or url: %w", domain.ErrInvalidInput),
+			wantStatus:     http.StatusBadRequest,
+			wantBodySubstr: "must be text",
+		},
+		{name: "duplicate name", body: `{"name":"Paper DOI","type":"url","applies_to":"session"}`, fakeErr: domain.ErrDuplicateCustomField, wantStatus: http.StatusConflict, wantBodySubstr: helpers.ErrCodeConflict},
+		{name: "not owner", body: `{"name":"Paper DOI","type":"url","applies_to":"session"}`, fakeErr: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantBodySubstr: helpers.ErrCodeForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{customFieldErr: tt.fakeErr}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(http.MethodPost, "http://test/events/ev-1/custom-fields", strings.NewReader(tt.body))
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			req.SetPathValue("eventID", "ev-1")
+			rr := httptest.NewRecorder()
+
+			ctrl.CreateCustomField(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			assert.Contains(t, rr.Body.String(), tt.wantBodySubstr)
+			if tt.name == "success" {
+				assert.Equal(t, &domain.CustomField{ID: "field-1", EventID: "ev-1", Name: "Paper DOI", Type: domain.CustomFieldURL, AppliesTo: domain.CustomFieldSession, Public: true}, fake.lastCustomField)
+			}
+		})
+	}
+}
+
+func TestScheduleController_SetSessionCustomFields(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		fakeErr    error
+		wantStatus int
+		wantValues map[string]any
+	}{
+		{name: "success", body: `{"values":{"field-1":"https://doi.org/10.1000/182","field-2":null,"field-3":4}}`, wantStatus: http.StatusOK,
+			wantValues: map[string]any{"field-1": "https://doi.org/10.1000/182", "field-2": nil, "field-3": 4.0}},
+		{name: "missing values", body: `{}`, wantStatus: http.StatusBadRequest},
+		{name: "invalid value", body: `{"values":{"field-1":true}}`, fakeErr: fmt.Errorf("custom field %q needs a string: %w", "Paper DOI", domain.ErrInvalidInput), wantStatus: http.StatusBadRequest},
+		{name: "session not found", body: `{"values":{}}`, fakeErr: domain.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{customFieldErr: tt.fakeErr}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(http.MethodPut, "http://test/events/ev-1/sessions/sess-1/custom-fields", strings.NewReader(tt.body))
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			req.SetPathValue("eventID", "ev-1")
+			req.SetPathValue("sessionID", "sess-1")
+			rr := httptest.NewRecorder()
+
+			ctrl.SetSessionCustomFields(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			if tt.wantValues != nil {
+				assert.Equal(t, tt.wantValues, fake.lastCustomFieldValues)
+			}
+		})
+	}
+}
+
+func TestScheduleController_BulkUpdateSpeakers(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		fakeResult     *domain.SpeakerBulkUpdateResult
+		fakeErr        error
+		wantStatus     int
+		wantBodySubstr string
+	}{
+		{
+			name:           "applied",
+			body:           `{"updates":[{"speaker_id":"sp-1","is_top_speaker":true},{"speaker_id":"sp-2","tag_line":""}]}`,
+			fakeResult:     &domain.SpeakerBulkUpdateResult{Applied: true, Results: []*domain.SpeakerBulkItemResult{{SpeakerID: "sp-1"}, {SpeakerID: "sp-2"}}},
+			wantStatus:     http.StatusOK,
+			wantBodySubstr: `"applied":true`,
+		},
+		{
+			name:           "rejected item",
+			body:           `{"updates":[{"speaker_id":"sp-9","bio":"x"}]}`,
+			fakeResult:     &domain.SpeakerBulkUpdateResult{Results: []*domain.SpeakerBulkItemResult{{SpeakerID: "sp-9", Error: "speaker not found"}}},
+			wantStatus:     http.StatusOK,
+			wantBodySubstr: "speaker not found",
+		},
+		{name: "no updates", body: `{"updates":[]}`, wantStatus: http.StatusBadRequest, wantBodySubstr: "updates is required"},
+		{name: "null update", body: `{"updates":[null]}`, wantStatus: http.StatusBadRequest, wantBodySubstr: "must not contain null"},
+		{name: "not owner", body: `{"updates":[{"speaker_id":"sp-1","bio":""}]}`, fakeErr: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantBodySubstr: helpers.ErrCodeForbidden},
+		{name: "event not found", body: `{"updates":[{"speaker_id":"sp-1","bio":""}]}`, fakeErr: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantBodySubstr: helpers.ErrCodeEventNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{bulkSpeakersResult: tt.fakeResult, bulkSpeakersErr: tt.fakeErr}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(http.MethodPatch, "http://test/events/ev-1/speakers/bulk", strings.NewReader(tt.body))
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			req.SetPathValue("eventID", "ev-1")
+			rr := httptest.NewRecorder()
+
+			ctrl.BulkUpdateSpeakers(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			assert.Contains(t, rr.Body.String(), tt.wantBodySubstr)
+			if tt.name == "applied" {
+				require.Len(t, fake.lastBulkSpeakerUpdates, 2)
+				require.NotNil(t, fake.lastBulkSpeakerUpdates[0].IsTopSpeaker)
+				assert.True(t, *fake.lastBulkSpeakerUpdates[0].IsTopSpeaker)
+				require.NotNil(t, fake.lastBulkSpeakerUpdates[1].TagLine)
+				assert.Empty(t, *fake.lastBulkSpeakerUpdates[1].TagLine)
+				assert.Nil(t, fake.lastBulkSpeakerUpdates[1].Bio)
+			}
+		})
+	}
+}
+
+func TestScheduleController_RemindEventInvitations(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		fakeErr        error
+		wantStatus     int
+		wantInterval   time.Duration
+		wantBodySubstr string
+	}{
+		{name: "defaults", body: `{}`, wantStatus: http.StatusOK, wantBodySubstr: `"throttled":1`},
+		{name: "custom interval", body: `{"min_interval_hours":48}`, wantStatus: http.StatusOK, wantInterval: 48 * time.Hour, wantBodySubstr: "bad@example.com"},
+		{name: "interval too short", body: `{"min_interval_hours":2}`, wantStatus: http.StatusBadRequest, wantBodySubstr: "at least 24"},
+		{name: "not owner", body: `{}`, fakeErr: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantBodySubstr: helpers.ErrCodeForbidden},
+		{name: "event not found", body: `{}`, fakeErr: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantBodySubstr: helpers.ErrCodeEventNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{remindInvitationsErr: tt.fakeErr}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(http.MethodPost, "http://test/events/ev-1/invitations/remind", strings.NewReader(tt.body))
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			req.SetPathValue("eventID", "ev-1")
+			rr := httptest.NewRecorder()
+
+			ctrl.RemindEventInvitations(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			assert.Contains(t, rr.Body.String(), tt.wantBodySubstr)
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, tt.wantInterval, fake.lastRemindInterval)
+			}
+		})
+	}
+}
+
+func TestScheduleController_ListEventEmails(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		fakeErr        error
+		wantStatus     int
+		wantFilter     domain.EventEmailFilter
+		wantBodySubstr string
+	}{
+		{name: "all", wantStatus: http.StatusOK, wantBodySubstr: `"total":1`},
+		{
+			name: "filters", query: "?to=%20alice%20&kind=event_invitation&status=failed", wantStatus: http.StatusOK,
+			wantFilter: domain.EventEmailFilter{To: "alice", Kind: domain.EventEmailInvitation, Status: domain.EventEmailFailed},
+		},
+		{name: "unknown kind", query: "?kind=welcome", wantStatus: http.StatusBadRequest, wantBodySubstr: "kind must be one of"},
+		{name: "unknown status", query: "?status=bounced", wantStatus: http.StatusBadRequest, wantBodySubstr: "status must be sent, failed or skipped"},
+		{name: "not owner", fakeErr: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantBodySubstr: helpers.ErrCodeForbidden},
+		{name: "event not found", fakeErr: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantBodySubstr: helpers.ErrCodeEventNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{eventEmailsErr: tt.fakeErr}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(http.MethodGet, "http://test/events/ev-1/emails"+tt.query, nil)
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			req.SetPathValue("eventID", "ev-1")
+			rr := httptest.NewRecorder()
+
+			ctrl.ListEventEmails(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			assert.Contains(t, rr.Body.String(), tt.wantBodySubstr)
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, tt.wantFilter, fake.lastEventEmailFilter)
+			}
+		})
+	}
+}
+
+func TestScheduleController_ResendEventEmail(t *testing.T) {
+	tests := []struct {
+		name           string
+		fakeErr        error
+		wantStatus     int
+		wantBodySubstr string
+	}{
+		{name: "failed send is returned", wantStatus: http.StatusOK, wantBodySubstr: `"resend_of":"em-1"`},
+		{name: "email not found", fakeErr: domain.ErrEventEmailNotFound, wantStatus: http.StatusNotFound, wantBodySubstr: helpers.ErrCodeEmailNotFound},
+		{name: "event not found", fakeErr: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantBodySubstr: helpers.ErrCodeEventNotFound},
+		{name: "not owner", fakeErr: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantBodySubstr: helpers.ErrCodeForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewScheduleController(testLogger, &fakeEventService{eventEmailsErr: tt.fakeErr})
+			req := httptest.NewRequest(http.MethodPost, "http://test/events/ev-1/emails/em-1/resend", nil)
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			req.SetPathValue("eventID", "ev-1")
+			req.SetPathValue("emailID", "em-1")
+			rr := httptest.NewRecorder()
+
+			ctrl.ResendEventEmail(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			assert.Contains(t, rr.Body.String(), tt.wantBodySubstr)
+		})
+	}
+}
+
+func TestScheduleController_UpdateInvitationDomainRules(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		fakeErr        error
+		wantStatus     int
+		wantBodySubstr string
+	}{
+		{name: "success", body: `{"allowed_domains":["acme.com"],"blocked_domains":["@Gmail.com"]}`, wantStatus: http.StatusOK},
+		{name: "empty rules", body: `{}`, wantStatus: http.StatusOK},
+		{name: "malformed domain", body: `{"blocked_domains":["not a domain"]}`, wantStatus: http.StatusBadRequest, wantBodySubstr: "blocked_domains entries must be domain names"},
+		{name: "allowed and blocked", body: `{"allowed_domains":["acme.com"],"blocked_domains":["ACME.com"]}`, wantStatus: http.StatusBadRequest, wantBodySubstr: "both allowed and blocked"},
+		{name: "not owner", body: `{}`, fakeErr: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantBodySubstr: helpers.ErrCodeForbidden},
+		{name: "event not found", body: `{}`, fakeErr: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantBodySubstr: helpers.ErrCodeEventNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{invitationDomainRulesErr: tt.fakeErr}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(http.MethodPut, "http://test/events/ev-1/invitations/domain-rules", strings.NewReader(tt.body))
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			req.SetPathValue("eventID", "ev-1")
+			rr := httptest.NewRecorder()
+
+			ctrl.UpdateInvitationDomainRules(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			assert.Contains(t, rr.Body.String(), tt.wantBodySubstr)
+			if tt.name == "success" {
+				require.NotNil(t, fake.lastInvitationDomainRules)
+				assert.Equal(t, []string{"acme.com"}, fake.lastInvitationDomainRules.AllowedDomains)
+				assert.Equal(t, []string{"@Gmail.com"}, fake.lastInvitationDomainRules.BlockedDomains)
+			}
+			if tt.wantStatus == http.StatusBadRequest {
+				assert.Nil(t, fake.lastInvitationDomainRules, "service must not be called")
+			}
+		})
+	}
+}
+
+
+func TestScheduleController_AnonymizeEvent(t *testing.T) {
+	tests := []struct {
+		name           string
+		fakeErr        error
+		wantStatus     int
+		wantBodySubstr string
+	}{
+		{name: "success", wantStatus: http.StatusOK, wantBodySubstr: `"invitations_hashed":10`},
+		{name: "future event", fakeErr: fmt.Errorf("event has not taken place yet: %w", domain.ErrInvalidInput), wantStatus: http.StatusBadRequest, wantBodySubstr: "not taken place"},
+		{name: "not owner", fakeErr: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantBodySubstr: helpers.ErrCodeForbidden},
+		{name: "event not found", fakeErr: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantBodySubstr: helpers.ErrCodeEventNotFound},
+		{name: "service error", fakeErr: errors.New("db down"), wantStatus: http.StatusInternalServerError, wantBodySubstr: "db down"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewScheduleController(testLogger, &fakeEventService{anonymizeEventErr: tt.fakeErr})
+			req := httptest.NewRequest(http.MethodPost, "http://test/events/ev-1/anonymize", nil)
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			req.SetPathValue("eventID", "ev-1")
+			rr := httptest.NewRecorder()
+
+			ctrl.AnonymizeEvent(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			assert.Contains(t, rr.Body.String(), tt.wantBodySubstr)
+		})
+	}
+}
+
+func TestScheduleController_RegenerateEventCode(t *testing.T) {
+	tests := []struct {
+		name           string
+		fakeErr        error
+		wantStatus     int
+		wantBodySubstr string
+	}{
+		{name: "success", wantStatus: http.StatusOK, wantBodySubstr: `"previous_code":"abc1"`},
+		{name: "not owner", fakeErr: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantBodySubstr: helpers.ErrCodeForbidden},
+		{name: "event not found", fakeErr: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantBodySubstr: helpers.ErrCodeEventNotFound},
+		{name: "every code taken", fakeErr: fmt.Errorf("update event code: %w", domain.ErrDuplicateEventCode), wantStatus: http.StatusConflict, wantBodySubstr: helpers.ErrCodeConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewScheduleController(testLogger, &fakeEventService{regenerateCodeErr: tt.fakeErr})
+			req := httptest.NewRequest(http.MethodPost, "http://test/events/ev-1/regenerate-code", nil)
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			req.SetPathValue("eventID", "ev-1")
+			rr := httptest.NewRecorder()
+
+			ctrl.RegenerateEventCode(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			assert.Contains(t, rr.Body.String(), tt.wantBodySubstr)
+		})
+	}
+}
+
+func TestScheduleController_DryRun(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		pathValues     map[string]string
+		handler        func(*ScheduleController) http.HandlerFunc
+		wantStatus     int
+		wantDryRun     bool
+		wantBodySubstr string
+	}{
+		{
+			name: "anonymize", method: http.MethodPost, target: "/events/ev-1/anonymize?dry_run=true",
+			pathValues: map[string]string{"eventID": "ev-1"},
+			handler:    func(c *ScheduleController) http.HandlerFunc { return c.AnonymizeEvent },
+			wantStatus: http.StatusOK, wantDryRun: true, wantBodySubstr: `"dry_run":true`,
+		},
+		{
+			name: "bulk speakers", method: http.MethodPatch, target: "/events/ev-1/speakers/bulk?dry_run=1",
+			body:       `{"updates":[{"speaker_id":"sp-1","bio":""}]}`,
+			pathValues: map[string]string{"eventID": "ev-1"},
+			handler:    func(c *ScheduleController) http.HandlerFunc { return c.BulkUpdateSpeakers },
+			wantStatus: http.StatusOK, wantDryRun: true,
+		},
+		{
+			name: "room deletion", method: http.MethodDelete, target: "/events/ev-1/rooms/room-1?mode=reassign&target_room_id=room-2&dry_run=true",
+			pathValues: map[string]string{"eventID": "ev-1", "roomID": "room-1"},
+			handler:    func(c *ScheduleController) http.HandlerFunc { return c.DeleteEventRoom },
+			wantStatus: http.StatusOK, wantDryRun: true, wantBodySubstr: `"action":"move"`,
+		},
+		{
+			name: "sessionize import", method: http.MethodPost, target: "/events/ev-1/import/sessionize/abc123?dry_run=true",
+			pathValues: map[string]string{"eventID": "ev-1", "sessionizeID": "abc123"},
+			handler:    func(c *ScheduleController) http.HandlerFunc { return c.ImportSessionize },
+			wantStatus: http.StatusOK, wantDryRun: true, wantBodySubstr: `"action":"create"`,
+		},
+		{
+			name: "dry_run=false applies", method: http.MethodPost, target: "/events/ev-1/anonymize?dry_run=false",
+			pathValues: map[string]string{"eventID": "ev-1"},
+			handler:    func(c *ScheduleController) http.HandlerFunc { return c.AnonymizeEvent },
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "invalid value", method: http.MethodDelete, target: "/events/ev-1/rooms/room-1?dry_run=maybe",
+			pathValues: map[string]string{"eventID": "ev-1", "roomID": "room-1"},
+			handler:    func(c *ScheduleController) http.HandlerFunc { return c.DeleteEventRoom },
+			wantStatus: http.StatusBadRequest, wantBodySubstr: "dry_run must be true or false",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEventService{}
+			ctrl := NewScheduleController(testLogger, fake)
+			req := httptest.NewRequest(tt.method, "http://test"+tt.target, strings.NewReader(tt.body))
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-123"))
+			for k, v := range tt.pathValues {
+				req.SetPathValue(k, v)
+			}
+			rr := httptest.NewRecorder()
+
+			tt.handler(ctrl)(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			assert.Equal(t, tt.wantDryRun, fake.lastDryRun)
+			assert.Contains(t, rr.Body.String(), tt.wantBodySubstr)
+			if tt.wantDryRun {
+				assert.Empty(t, fake.lastDeleteEventRoomRoomID, "a dry run must not delete")
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+// EventDeletionController serves the two-step event deletion and the admins' forced deletion.
+type EventDeletionController struct {
+	Logger  *slog.Logger
+	Service domain.EventDeletionService
+}
+
+func NewEventDeletionController(logger *slog.Logger, svc domain.EventDeletionService) *EventDeletionController {
+	return &EventDeletionController{
+		Logger:  logger,
+		Service: svc,
+	}
+}
+
+// ConfirmEventDeletionRequest is the request body for POST /events/{eventID}/deletion/confirm.
+type ConfirmEventDeletionRequest struct {
+	// Token is the confirmation token emailed to the owner.
+	Token string `json:"token"`
+}
+
+// Validate implements Validator.
+func (c ConfirmEventDeletionRequest) Validate() []string {
+	if c.Token == "" {
+		return []string{"token is required"}
+	}
+	return nil
+}
+
+// PendingEventDeletionSuccessResponse is the success response envelope for DELETE /events/{eventID} (202).
+type PendingEventDeletionSuccessResponse struct {
+	Data  domain.PendingEventDeletion `json:"data"`
+	Error *helpers.APIError           `json:"error"`
+}
+
+// RequestEventDeletion godoc
+// @Summary Request deleting an event
+// @ID DeleteEvent
+// @Description Starts deleting an event and all its associated data (rooms, sessions). Nothing is deleted yet: the owner is emailed a confirmation token, and POST /events/{eventID}/deletion/confirm with it deletes the event within 30 minutes. Requesting again replaces the earlier token. An event attendees have registered for is only deleted with force=true, which removes their registrations too; otherwise it fails with event_has_registrations. Only the event owner can delete. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param force query bool false "Also delete attendee registrations"
+// @Success 202 {object} controllers.PendingEventDeletionSuccessResponse "data is the pending deletion"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 409 {object} helpers.APIResponse "error.code: event_has_registrations"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID} [delete]
+func (c *EventDeletionController) RequestEventDeletion(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if !uuidRegex.MatchString(eventID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID")
+		return
+	}
+	force := false
+	if s := r.URL.Query().Get("force"); s != "" {
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "force must be true or false")
+			return
+		}
+		force = v
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	pending, err := c.Service.RequestEventDeletion(r.Context(), eventID, userID, force)
+	if err != nil {
+		c.writeEventDeletionError(w, r, err)
+		return
+	}
+	c.Logger.InfoContext(r.Context(), "event deletion requested", "audit", "event.delete_request",
+		"event_id", eventID, "user_id", userID, "force", force)
+	helpers.WriteJSONSuccess(w, http.StatusAccepted, pending)
+}
+
+// ConfirmEventDeletion godoc
+// @Summary Confirm deleting an event
+// @ID ConfirmEventDeletion
+// @Description Deletes the event with the token DELETE /events/{eventID} emailed to the owner. A token works once and only for 30 minutes; a wrong, used or expired one gets invalid_confirmation. Only the event owner can confirm. Requires authentication.
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param body body controllers.ConfirmEventDeletionRequest true "Confirmation token"
+// @Success 200 {object} controllers.DeleteEventSuccessResponse "data contains status"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request, invalid_confirmation"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 409 {object} helpers.APIResponse "error.code: event_has_registrations"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/deletion/confirm [post]
+func (c *EventDeletionController) ConfirmEventDeletion(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if !uuidRegex.MatchString(eventID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID")
+		return
+	}
+	var req ConfirmEventDeletionRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	if err := c.Service.ConfirmEventDeletion(r.Context(), eventID, userID, req.Token); err != nil {
+		c.writeEventDeletionError(w, r, err)
+		return
+	}
+	c.Logger.InfoContext(r.Context(), "event deleted", "audit", "event.delete",
+		"event_id", eventID, "user_id", userID)
+	helpers.WriteJSONSuccess(w, http.StatusOK, DeleteEventResponse{Status: "deleted"})
+}
+
+// ForceDeleteEvent godoc
+// @Summary Delete any event at once (admin)
+// @ID ForceDeleteEvent
+// @Description Deletes the event, everything in it and its attendee registrations without the owner's email confirmation. Only admins may call this. Requires authentication.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Success 200 {object} controllers.DeleteEventSuccessResponse "data contains status"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not an admin)"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /admin/events/{eventID} [delete]
+func (c *EventDeletionController) ForceDeleteEvent(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if !uuidRegex.MatchString(eventID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID")
+		return
+	}
+	adminID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	if err := c.Service.ForceDeleteEvent(r.Context(), eventID, adminID); err != nil {
+		c.writeEventDeletionError(w, r, err)
+		return
+	}
+	c.Logger.InfoContext(r.Context(), "event force deleted", "audit", "event.force_delete",
+		"event_id", eventID, "admin_id", adminID)
+	helpers.WriteJSONSuccess(w, http.StatusOK, DeleteEventResponse{Status: "deleted"})
+}
+
+func (c *EventDeletionController) writeEventDeletionError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, domain.ErrNotFound) {
+		helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+		return
+	}
+	if errors.Is(err, domain.ErrForbidden) {
+		helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+		return
+	}
+	if errors.Is(err, domain.ErrEventHasRegistrations) {
+		helpers.WriteJSONError(w, http.StatusConflict, helpers.ErrCodeEventHasRegistrations, err.Error())
+		return
+	}
+	if errors.Is(err, domain.ErrInvalidConfirmation) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeInvalidConfirmation, err.Error())
+		return
+	}
+	helpers.WriteServiceError(w, r, c.Logger, err)
+}
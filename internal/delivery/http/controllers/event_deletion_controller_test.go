@@ -0,0 +1,114 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+type mockEventDeletionService struct {
+	err       error
+	lastForce bool
+	lastToken string
+}
+
+func (m *mockEventDeletionService) RequestEventDeletion(ctx context.Context, eventID, ownerID string, force bool) (*domain.PendingEventDeletion, error) {
+	m.lastForce = force
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.PendingEventDeletion{EventID: eventID, Force: force, ExpiresAt: time.Now().Add(domain.EventDeletionExpiry)}, nil
+}
+
+func (m *mockEventDeletionService) ConfirmEventDeletion(ctx context.Context, eventID, ownerID, token string) error {
+	m.lastToken = token
+	return m.err
+}
+
+func (m *mockEventDeletionService) ForceDeleteEvent(ctx context.Context, eventID, adminID string) error {
+	return m.err
+}
+
+const deletionEventID = "00000000-0000-4000-8000-000000000001"
+
+func TestEventDeletionController_RequestEventDeletion(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	tests := []struct {
+		name       string
+		query      string
+		err        error
+		wantStatus int
+		wantForce  bool
+	}{
+		{name: "requested", wantStatus: http.StatusAccepted},
+		{name: "force", query: "?force=true", wantStatus: http.StatusAccepted, wantForce: true},
+		{name: "invalid force", query: "?force=maybe", wantStatus: http.StatusBadRequest},
+		{name: "event has registrations", err: fmt.Errorf("2 attendees are registered: %w", domain.ErrEventHasRegistrations), wantStatus: http.StatusConflict},
+		{name: "not the owner", err: domain.ErrForbidden, wantStatus: http.StatusForbidden},
+		{name: "event not found", err: domain.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &mockEventDeletionService{err: tt.err}
+			ctrl := NewEventDeletionController(logger, svc)
+
+			req := httptest.NewRequest(http.MethodDelete, "/events/"+deletionEventID+tt.query, nil)
+			req.SetPathValue("eventID", deletionEventID)
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-1"))
+			w := httptest.NewRecorder()
+			ctrl.RequestEventDeletion(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if svc.lastForce != tt.wantForce {
+				t.Fatalf("expected force %v, got %v", tt.wantForce, svc.lastForce)
+			}
+		})
+	}
+}
+
+func TestEventDeletionController_ConfirmEventDeletion(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantStatus int
+	}{
+		{name: "deleted", body: `{"token":"abc"}`, wantStatus: http.StatusOK},
+		{name: "missing token", body: `{}`, wantStatus: http.StatusBadRequest},
+		{name: "wrong or expired token", body: `{"token":"abc"}`, err: domain.ErrInvalidConfirmation, wantStatus: http.StatusBadRequest},
+		{name: "not the owner", body: `{"token":"abc"}`, err: domain.ErrForbidden, wantStatus: http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &mockEventDeletionService{err: tt.err}
+			ctrl := NewEventDeletionController(logger, svc)
+
+			req := httptest.NewRequest(http.MethodPost, "/events/"+deletionEventID+"/deletion/confirm", bytes.NewBufferString(tt.body))
+			req.SetPathValue("eventID", deletionEventID)
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-1"))
+			w := httptest.NewRecorder()
+			ctrl.ConfirmEventDeletion(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if tt.wantStatus == http.StatusOK && svc.lastToken != "abc" {
+				t.Fatalf("unexpected token %q", svc.lastToken)
+			}
+		})
+	}
+}
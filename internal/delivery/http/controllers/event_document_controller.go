@@ -0,0 +1,376 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+// EventDocumentController serves the documents, such as a code of conduct, that attendees accept to
+// register: publishing and reports for the event owner, reading and accepting for attendees.
+type EventDocumentController struct {
+	Logger  *slog.Logger
+	Service domain.EventDocumentService
+}
+
+func NewEventDocumentController(logger *slog.Logger, svc domain.EventDocumentService) *EventDocumentController {
+	return &EventDocumentController{
+		Logger:  logger,
+		Service: svc,
+	}
+}
+
+// PublishEventDocumentRequest is the request body for PUT /events/{eventID}/documents/{kind}.
+type PublishEventDocumentRequest struct {
+	Title string `json:"title"`
+	// Body is the document's text, shown to attendees as is.
+	Body string `json:"body"`
+}
+
+// Validate implements Validator.
+func (c PublishEventDocumentRequest) Validate() []string {
+	var errs []string
+	if strings.TrimSpace(c.Title) == "" {
+		errs = append(errs, "title is required")
+	}
+	if strings.TrimSpace(c.Body) == "" {
+		errs = append(errs, "body is required")
+	}
+	return errs
+}
+
+// AcceptEventDocumentsRequest is the request body for POST /attendee/events/{eventID}/documents/accept.
+type AcceptEventDocumentsRequest struct {
+	AcceptedDocuments []domain.DocumentVersion `json:"accepted_documents"`
+}
+
+// Validate implements Validator.
+func (c AcceptEventDocumentsRequest) Validate() []string {
+	if len(c.AcceptedDocuments) == 0 {
+		return []string{"accepted_documents is required"}
+	}
+	return validateDocumentVersions(c.AcceptedDocuments)
+}
+
+// validateDocumentVersions checks the accepted_documents of a request.
+func validateDocumentVersions(versions []domain.DocumentVersion) []string {
+	var errs []string
+	for i, v := range versions {
+		if !slices.Contains(domain.DocumentKinds, v.Kind) {
+			errs = append(errs, fmt.Sprintf("accepted_documents[%d].kind must be one of %s", i, strings.Join(domain.DocumentKinds, ", ")))
+		}
+		if v.Version < 1 {
+			errs = append(errs, fmt.Sprintf("accepted_documents[%d].version must be at least 1", i))
+		}
+	}
+	return errs
+}
+
+// EventDocumentSuccessResponse is the success response envelope for PUT /events/{eventID}/documents/{kind} (200, 201).
+type EventDocumentSuccessResponse struct {
+	Data  *domain.EventDocument `json:"data"`
+	Error *helpers.APIError     `json:"error"`
+}
+
+// EventDocumentListSuccessResponse is the success response envelope for GET /events/{eventID}/documents and GET /events/{eventID}/documents/{kind}/versions (200).
+type EventDocumentListSuccessResponse struct {
+	Data  []*domain.EventDocument `json:"data"`
+	Error *helpers.APIError       `json:"error"`
+}
+
+// DocumentAcceptanceReportSuccessResponse is the success response envelope for GET /events/{eventID}/documents/{kind}/acceptances (200).
+type DocumentAcceptanceReportSuccessResponse struct {
+	Data  *domain.DocumentAcceptanceReport `json:"data"`
+	Error *helpers.APIError                `json:"error"`
+}
+
+// AttendeeDocumentsSuccessResponse is the success response envelope for GET /attendee/events/{eventID}/documents and POST /attendee/events/{eventID}/documents/accept (200).
+type AttendeeDocumentsSuccessResponse struct {
+	Data  []*domain.AttendeeDocument `json:"data"`
+	Error *helpers.APIError          `json:"error"`
+}
+
+// ListEventDocuments godoc
+// @Summary List an event's documents
+// @ID ListEventDocuments
+// @Description Returns the current version of each document, such as the code of conduct, that registering for the event needs accepted, in kind order. Withdrawn documents are left out. Only the event owner can list. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Success 200 {object} controllers.EventDocumentListSuccessResponse "data is the list of documents"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/documents [get]
+func (c *EventDocumentController) ListEventDocuments(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if !uuidRegex.MatchString(eventID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID")
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	docs, err := c.Service.ListDocuments(r.Context(), eventID, userID)
+	if err != nil {
+		c.writeEventDocumentError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, docs)
+}
+
+// PublishEventDocument godoc
+// @Summary Publish an event document
+// @ID PublishEventDocument
+// @Description Makes title and body the current version of the event's document of kind (code_of_conduct, photo_policy or terms). New attendees must accept it to register; attendees already registered keep their place and are asked to accept the new version (pending_documents of POST /events/join, needs_acceptance of GET /attendee/events/{eventID}/documents). Earlier versions and who accepted them are kept. Publishing a withdrawn document makes it current again. Returns 201 when a new version is published, 200 when title and body equal the current version's and nothing changed. Only the event owner can publish. Requires authentication.
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param kind path string true "Document kind" Enums(code_of_conduct, photo_policy, terms)
+// @Param body body controllers.PublishEventDocumentRequest true "Document"
+// @Success 200 {object} controllers.EventDocumentSuccessResponse "Unchanged; data is the current version"
+// @Success 201 {object} controllers.EventDocumentSuccessResponse "data is the new version"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/documents/{kind} [put]
+func (c *EventDocumentController) PublishEventDocument(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if !uuidRegex.MatchString(eventID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID")
+		return
+	}
+	var req PublishEventDocumentRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	doc, published, err := c.Service.PublishDocument(r.Context(), eventID, userID, r.PathValue("kind"), req.Title, req.Body)
+	if err != nil {
+		c.writeEventDocumentError(w, r, err)
+		return
+	}
+	if !published {
+		helpers.WriteJSONSuccess(w, http.StatusOK, doc)
+		return
+	}
+	c.Logger.InfoContext(r.Context(), "event document published", "audit", "event_document.publish",
+		"event_id", eventID, "user_id", userID, "kind", doc.Kind, "version", doc.Version)
+	helpers.WriteJSONSuccess(w, http.StatusCreated, doc)
+}
+
+// WithdrawEventDocument godoc
+// @Summary Withdraw an event document
+// @ID WithdrawEventDocument
+// @Description Stops asking attendees to accept the event's document of kind. Its versions and who accepted them stay on record, and publishing it again makes it current once more. Only the event owner can withdraw. Requires authentication.
+// @Tags events
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param kind path string true "Document kind" Enums(code_of_conduct, photo_policy, terms)
+// @Success 204 "Withdrawn"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found (no such event or current document)"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/documents/{kind} [delete]
+func (c *EventDocumentController) WithdrawEventDocument(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if !uuidRegex.MatchString(eventID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID")
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	kind := r.PathValue("kind")
+	if err := c.Service.WithdrawDocument(r.Context(), eventID, userID, kind); err != nil {
+		c.writeEventDocumentError(w, r, err)
+		return
+	}
+	c.Logger.InfoContext(r.Context(), "event document withdrawn", "audit", "event_document.withdraw",
+		"event_id", eventID, "user_id", userID, "kind", kind)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListEventDocumentVersions godoc
+// @Summary List the versions of an event document
+// @ID ListEventDocumentVersions
+// @Description Returns every version of the event's document of kind, newest first, including those of a withdrawn document. Only the event owner can list. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param kind path string true "Document kind" Enums(code_of_conduct, photo_policy, terms)
+// @Success 200 {object} controllers.EventDocumentListSuccessResponse "data is the list of versions"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found (no such event or document)"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/documents/{kind}/versions [get]
+func (c *EventDocumentController) ListEventDocumentVersions(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if !uuidRegex.MatchString(eventID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID")
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	versions, err := c.Service.ListDocumentVersions(r.Context(), eventID, userID, r.PathValue("kind"))
+	if err != nil {
+		c.writeEventDocumentError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, versions)
+}
+
+// GetDocumentAcceptanceReport godoc
+// @Summary Report who accepted an event document
+// @ID GetDocumentAcceptanceReport
+// @Description Returns each registered attendee, by email, with the latest version of the event's document of kind they accepted and when, and counts of those who accepted the current version, only an older one, or none, such as attendees registered before the document was published. Only the event owner can read it. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param kind path string true "Document kind" Enums(code_of_conduct, photo_policy, terms)
+// @Success 200 {object} controllers.DocumentAcceptanceReportSuccessResponse "data is the report"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found (no such event or current document)"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/documents/{kind}/acceptances [get]
+func (c *EventDocumentController) GetDocumentAcceptanceReport(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if !uuidRegex.MatchString(eventID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID")
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	report, err := c.Service.GetAcceptanceReport(r.Context(), eventID, userID, r.PathValue("kind"))
+	if err != nil {
+		c.writeEventDocumentError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, report)
+}
+
+// ListMyEventDocuments godoc
+// @Summary List an event's documents with what I accepted
+// @ID ListMyEventDocuments
+// @Description Returns the current version of each of the event's documents with the latest version the caller accepted. needs_acceptance is set on those to accept before registering, or again after the owner changed them. Any signed-in user can read them. Requires authentication.
+// @Tags attendee
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Success 200 {object} controllers.AttendeeDocumentsSuccessResponse "data is the list of documents"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /attendee/events/{eventID}/documents [get]
+func (c *EventDocumentController) ListMyEventDocuments(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if !uuidRegex.MatchString(eventID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID")
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	docs, err := c.Service.ListMyDocuments(r.Context(), eventID, userID)
+	if err != nil {
+		c.writeEventDocumentError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, docs)
+}
+
+// AcceptEventDocuments godoc
+// @Summary Accept an event's documents
+// @ID AcceptEventDocuments
+// @Description Records that the caller accepts the given versions of the event's documents, with the time. Each must be the current version; accepting one again keeps the first record. Use it before POST /attendee/events/{eventID}/registrations, or when an attendee accepts a changed document. Any signed-in user can accept. Requires authentication.
+// @Tags attendee
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param body body controllers.AcceptEventDocumentsRequest true "Versions accepted"
+// @Success 200 {object} controllers.AttendeeDocumentsSuccessResponse "data is the list of documents"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request (unknown kind or not the current version)"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /attendee/events/{eventID}/documents/accept [post]
+func (c *EventDocumentController) AcceptEventDocuments(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if !uuidRegex.MatchString(eventID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID")
+		return
+	}
+	var req AcceptEventDocumentsRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	docs, err := c.Service.AcceptDocuments(r.Context(), eventID, userID, req.AcceptedDocuments)
+	if err != nil {
+		c.writeEventDocumentError(w, r, err)
+		return
+	}
+	c.Logger.InfoContext(r.Context(), "event documents accepted", "audit", "event_document.accept",
+		"event_id", eventID, "user_id", userID, "documents", len(req.AcceptedDocuments))
+	helpers.WriteJSONSuccess(w, http.StatusOK, docs)
+}
+
+func (c *EventDocumentController) writeEventDocumentError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, domain.ErrNotFound) {
+		helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "not found")
+		return
+	}
+	if errors.Is(err, domain.ErrForbidden) {
+		helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+		return
+	}
+	if errors.Is(err, domain.ErrInvalidInput) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
+		return
+	}
+	helpers.WriteServiceError(w, r, c.Logger, err)
+}
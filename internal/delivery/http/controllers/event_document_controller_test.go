@@ -0,0 +1,136 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+type mockEventDocumentService struct {
+	err          error
+	published    bool
+	lastKind     string
+	lastAccepted []domain.DocumentVersion
+}
+
+func (m *mockEventDocumentService) PublishDocument(ctx context.Context, eventID, ownerID, kind, title, body string) (*domain.EventDocument, bool, error) {
+	m.lastKind = kind
+	if m.err != nil {
+		return nil, false, m.err
+	}
+	return &domain.EventDocument{EventID: eventID, Kind: kind, Version: 1, Title: title, Body: body}, m.published, nil
+}
+
+func (m *mockEventDocumentService) ListDocuments(ctx context.Context, eventID, ownerID string) ([]*domain.EventDocument, error) {
+	return []*domain.EventDocument{}, m.err
+}
+
+func (m *mockEventDocumentService) ListDocumentVersions(ctx context.Context, eventID, ownerID, kind string) ([]*domain.EventDocument, error) {
+	return []*domain.EventDocument{}, m.err
+}
+
+func (m *mockEventDocumentService) WithdrawDocument(ctx context.Context, eventID, ownerID, kind string) error {
+	m.lastKind = kind
+	return m.err
+}
+
+func (m *mockEventDocumentService) GetAcceptanceReport(ctx context.Context, eventID, ownerID, kind string) (*domain.DocumentAcceptanceReport, error) {
+	return &domain.DocumentAcceptanceReport{EventID: eventID, Kind: kind}, m.err
+}
+
+func (m *mockEventDocumentService) ListMyDocuments(ctx context.Context, eventID, userID string) ([]*domain.AttendeeDocument, error) {
+	return []*domain.AttendeeDocument{}, m.err
+}
+
+func (m *mockEventDocumentService) AcceptDocuments(ctx context.Context, eventID, userID string, accepted []domain.DocumentVersion) ([]*domain.AttendeeDocument, error) {
+	m.lastAccepted = accepted
+	return []*domain.AttendeeDocument{}, m.err
+}
+
+func TestEventDocumentController_PublishEventDocument(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	eventID := "550e8400-e29b-41d4-a716-446655440000"
+
+	tests := []struct {
+		name        string
+		body        string
+		svc         *mockEventDocumentService
+		wantStatus  int
+		wantErrCode string
+	}{
+		{name: "new version", body: `{"title":"Code of conduct","body":"Be kind."}`, svc: &mockEventDocumentService{published: true}, wantStatus: http.StatusCreated},
+		{name: "unchanged", body: `{"title":"Code of conduct","body":"Be kind."}`, svc: &mockEventDocumentService{}, wantStatus: http.StatusOK},
+		{name: "missing body", body: `{"title":"Code of conduct"}`, svc: &mockEventDocumentService{}, wantStatus: http.StatusBadRequest, wantErrCode: helpers.ErrCodeBadRequest},
+		{name: "unknown kind", body: `{"title":"Privacy","body":"Nothing kept."}`, svc: &mockEventDocumentService{err: domain.ErrInvalidInput}, wantStatus: http.StatusBadRequest, wantErrCode: helpers.ErrCodeBadRequest},
+		{name: "not owner", body: `{"title":"Code of conduct","body":"Be kind."}`, svc: &mockEventDocumentService{err: domain.ErrForbidden}, wantStatus: http.StatusForbidden, wantErrCode: helpers.ErrCodeForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewEventDocumentController(logger, tt.svc)
+			req := httptest.NewRequest(http.MethodPut, "/events/"+eventID+"/documents/code_of_conduct", bytes.NewReader([]byte(tt.body)))
+			req.Header.Set("Content-Type", "application/json")
+			req.SetPathValue("eventID", eventID)
+			req.SetPathValue("kind", domain.DocumentKindCodeOfConduct)
+			req = req.WithContext(middleware.SetUserID(req.Context(), "owner"))
+			w := httptest.NewRecorder()
+
+			ctrl.PublishEventDocument(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status: want %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			var resp helpers.APIResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("unmarshal response: %v", err)
+			}
+			if tt.wantErrCode != "" && (resp.Error == nil || resp.Error.Code != tt.wantErrCode) {
+				t.Errorf("error code: want %q, got %v", tt.wantErrCode, resp.Error)
+			}
+		})
+	}
+}
+
+func TestEventDocumentController_AcceptEventDocuments(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	eventID := "550e8400-e29b-41d4-a716-446655440000"
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+	}{
+		{name: "accepted", body: `{"accepted_documents":[{"kind":"code_of_conduct","version":2}]}`, wantStatus: http.StatusOK},
+		{name: "nothing accepted", body: `{"accepted_documents":[]}`, wantStatus: http.StatusBadRequest},
+		{name: "unknown kind", body: `{"accepted_documents":[{"kind":"privacy","version":1}]}`, wantStatus: http.StatusBadRequest},
+		{name: "no version", body: `{"accepted_documents":[{"kind":"terms"}]}`, wantStatus: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &mockEventDocumentService{}
+			ctrl := NewEventDocumentController(logger, svc)
+			req := httptest.NewRequest(http.MethodPost, "/attendee/events/"+eventID+"/documents/accept", bytes.NewReader([]byte(tt.body)))
+			req.Header.Set("Content-Type", "application/json")
+			req.SetPathValue("eventID", eventID)
+			req = req.WithContext(middleware.SetUserID(req.Context(), "u1"))
+			w := httptest.NewRecorder()
+
+			ctrl.AcceptEventDocuments(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status: want %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if tt.wantStatus == http.StatusOK && (len(svc.lastAccepted) != 1 || svc.lastAccepted[0].Version != 2) {
+				t.Errorf("accepted: got %+v", svc.lastAccepted)
+			}
+		})
+	}
+}
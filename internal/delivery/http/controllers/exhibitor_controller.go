@@ -0,0 +1,534 @@
+package controllers
+
+import (
+	"encoding/csv"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+// ExhibitorController serves exhibitor management for event teams, lead consent for attendees and
+// lead scanning for booth staff.
+type ExhibitorController struct {
+	Logger  *slog.Logger
+	Service domain.ExhibitorService
+}
+
+func NewExhibitorController(logger *slog.Logger, svc domain.ExhibitorService) *ExhibitorController {
+	return &ExhibitorController{
+		Logger:  logger,
+		Service: svc,
+	}
+}
+
+// CreateExhibitorRequest is the request body for POST /events/{eventID}/exhibitors.
+type CreateExhibitorRequest struct {
+	Name string `json:"name"`
+	// BoothNumber is optional; when set it must be unique within the event.
+	BoothNumber string `json:"booth_number"`
+}
+
+// Validate implements Validator.
+func (c CreateExhibitorRequest) Validate() []string {
+	if strings.TrimSpace(c.Name) == "" {
+		return []string{"name is required"}
+	}
+	return nil
+}
+
+// ExhibitorSuccessResponse is the success response envelope for POST /events/{eventID}/exhibitors (201).
+type ExhibitorSuccessResponse struct {
+	Data  domain.Exhibitor  `json:"data"`
+	Error *helpers.APIError `json:"error"`
+}
+
+// ExhibitorsSuccessResponse is the success response envelope for GET /events/{eventID}/exhibitors and GET /exhibitor/booths (200).
+type ExhibitorsSuccessResponse struct {
+	Data  []*domain.Exhibitor `json:"data"`
+	Error *helpers.APIError   `json:"error"`
+}
+
+// AddExhibitorStaffRequest is the request body for POST /events/{eventID}/exhibitors/{exhibitorID}/staff.
+type AddExhibitorStaffRequest struct {
+	Email string `json:"email"`
+}
+
+// Validate implements Validator.
+func (c AddExhibitorStaffRequest) Validate() []string {
+	if strings.TrimSpace(c.Email) == "" {
+		return []string{"email is required"}
+	}
+	return nil
+}
+
+// ExhibitorStaffSuccessResponse is the success response envelope for POST /events/{eventID}/exhibitors/{exhibitorID}/staff (201).
+type ExhibitorStaffSuccessResponse struct {
+	Data  domain.ExhibitorStaff `json:"data"`
+	Error *helpers.APIError     `json:"error"`
+}
+
+// SetLeadConsentRequest is the request body for PUT /attendee/events/{eventID}/lead-consent.
+type SetLeadConsentRequest struct {
+	Consent *bool `json:"consent"`
+}
+
+// Validate implements Validator.
+func (c SetLeadConsentRequest) Validate() []string {
+	if c.Consent == nil {
+		return []string{"consent is required"}
+	}
+	return nil
+}
+
+// LeadConsentSuccessResponse is the success response envelope for GET and PUT /attendee/events/{eventID}/lead-consent (200).
+type LeadConsentSuccessResponse struct {
+	Data  domain.LeadConsent `json:"data"`
+	Error *helpers.APIError  `json:"error"`
+}
+
+// ScanLeadRequest is the request body for POST /exhibitors/{exhibitorID}/leads.
+type ScanLeadRequest struct {
+	// Code is the attendee's lead code, read from the QR code their app shows.
+	Code string `json:"code"`
+	// Notes replace the lead's notes when not empty.
+	Notes string `json:"notes"`
+}
+
+// Validate implements Validator.
+func (c ScanLeadRequest) Validate() []string {
+	if strings.TrimSpace(c.Code) == "" {
+		return []string{"code is required"}
+	}
+	return nil
+}
+
+// LeadSuccessResponse is the success response envelope for POST /exhibitors/{exhibitorID}/leads (200, 201).
+type LeadSuccessResponse struct {
+	Data  domain.Lead       `json:"data"`
+	Error *helpers.APIError `json:"error"`
+}
+
+// CreateExhibitor godoc
+// @Summary Add an exhibitor to an event
+// @ID CreateExhibitor
+// @Description Adds a sponsor or vendor with a booth at the event. The booth number is optional and unique within the event. Add booth staff with POST /events/{eventID}/exhibitors/{exhibitorID}/staff. The event owner and team members can add exhibitors. Requires authentication.
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param body body controllers.CreateExhibitorRequest true "Exhibitor"
+// @Success 201 {object} controllers.ExhibitorSuccessResponse "data is the exhibitor"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner or team member)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 409 {object} helpers.APIResponse "error.code: conflict (booth number taken)"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/exhibitors [post]
+func (c *ExhibitorController) CreateExhibitor(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if !uuidRegex.MatchString(eventID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID")
+		return
+	}
+	var req CreateExhibitorRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	exhibitor, err := c.Service.CreateExhibitor(r.Context(), eventID, userID, req.Name, req.BoothNumber)
+	if err != nil {
+		c.writeExhibitorError(w, r, err)
+		return
+	}
+	c.Logger.InfoContext(r.Context(), "exhibitor created", "audit", "exhibitor.create",
+		"event_id", eventID, "user_id", userID, "exhibitor_id", exhibitor.ID)
+	helpers.WriteJSONSuccess(w, http.StatusCreated, exhibitor)
+}
+
+// ListExhibitors godoc
+// @Summary List an event's exhibitors
+// @ID ListExhibitors
+// @Description Returns the event's exhibitors by name, each with its booth staff. The event owner and team members can list. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Success 200 {object} controllers.ExhibitorsSuccessResponse "data is the list of exhibitors"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner or team member)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/exhibitors [get]
+func (c *ExhibitorController) ListExhibitors(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if !uuidRegex.MatchString(eventID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID")
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	exhibitors, err := c.Service.ListExhibitors(r.Context(), eventID, userID)
+	if err != nil {
+		c.writeExhibitorError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, exhibitors)
+}
+
+// DeleteExhibitor godoc
+// @Summary Delete an exhibitor
+// @ID DeleteExhibitor
+// @Description Deletes the exhibitor with its booth staff and every lead it scanned. The event owner and team members can delete. Requires authentication.
+// @Tags events
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param exhibitorID path string true "Exhibitor ID (UUID)"
+// @Success 204 "No Content"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner or team member)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/exhibitors/{exhibitorID} [delete]
+func (c *ExhibitorController) DeleteExhibitor(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	exhibitorID := r.PathValue("exhibitorID")
+	if !uuidRegex.MatchString(eventID) || !uuidRegex.MatchString(exhibitorID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID or exhibitorID")
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	if err := c.Service.DeleteExhibitor(r.Context(), eventID, exhibitorID, userID); err != nil {
+		c.writeExhibitorError(w, r, err)
+		return
+	}
+	c.Logger.InfoContext(r.Context(), "exhibitor deleted", "audit", "exhibitor.delete",
+		"event_id", eventID, "user_id", userID, "exhibitor_id", exhibitorID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AddExhibitorStaff godoc
+// @Summary Add booth staff to an exhibitor
+// @ID AddExhibitorStaff
+// @Description Lets the user with the email scan leads for the exhibitor and export them after the event. The user must already have an account. Adding someone who already staffs the booth changes nothing. The event owner and team members can add staff. Requires authentication.
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param exhibitorID path string true "Exhibitor ID (UUID)"
+// @Param body body controllers.AddExhibitorStaffRequest true "Staff member's email"
+// @Success 201 {object} controllers.ExhibitorStaffSuccessResponse "data is the staff member"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner or team member)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found or user_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/exhibitors/{exhibitorID}/staff [post]
+func (c *ExhibitorController) AddExhibitorStaff(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	exhibitorID := r.PathValue("exhibitorID")
+	if !uuidRegex.MatchString(eventID) || !uuidRegex.MatchString(exhibitorID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID or exhibitorID")
+		return
+	}
+	var req AddExhibitorStaffRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	staff, err := c.Service.AddExhibitorStaff(r.Context(), eventID, exhibitorID, userID, req.Email)
+	if err != nil {
+		c.writeExhibitorError(w, r, err)
+		return
+	}
+	c.Logger.InfoContext(r.Context(), "exhibitor staff added", "audit", "exhibitor.staff_add",
+		"event_id", eventID, "user_id", userID, "exhibitor_id", exhibitorID, "staff_id", staff.UserID)
+	helpers.WriteJSONSuccess(w, http.StatusCreated, staff)
+}
+
+// RemoveExhibitorStaff godoc
+// @Summary Remove booth staff from an exhibitor
+// @ID RemoveExhibitorStaff
+// @Description Stops the user from scanning and exporting the exhibitor's leads. Leads they scanned stay. The event owner and team members can remove staff. Requires authentication.
+// @Tags events
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param exhibitorID path string true "Exhibitor ID (UUID)"
+// @Param userID path string true "Staff member's user ID (UUID)"
+// @Success 204 "No Content"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner or team member)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/exhibitors/{exhibitorID}/staff/{userID} [delete]
+func (c *ExhibitorController) RemoveExhibitorStaff(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	exhibitorID := r.PathValue("exhibitorID")
+	staffID := r.PathValue("userID")
+	if !uuidRegex.MatchString(eventID) || !uuidRegex.MatchString(exhibitorID) || !uuidRegex.MatchString(staffID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID, exhibitorID or userID")
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	if err := c.Service.RemoveExhibitorStaff(r.Context(), eventID, exhibitorID, userID, staffID); err != nil {
+		c.writeExhibitorError(w, r, err)
+		return
+	}
+	c.Logger.InfoContext(r.Context(), "exhibitor staff removed", "audit", "exhibitor.staff_remove",
+		"event_id", eventID, "user_id", userID, "exhibitor_id", exhibitorID, "staff_id", staffID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetLeadConsent godoc
+// @Summary Get my lead sharing consent for an event
+// @ID GetLeadConsent
+// @Description Returns whether the caller shares their name and email with the exhibitors whose booth staff scan their lead code, and the code itself while they do. Apps show the code as a QR code. Only attendees registered for the event have one. Requires authentication.
+// @Tags attendee
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Success 200 {object} controllers.LeadConsentSuccessResponse "data is the consent"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not registered)"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /attendee/events/{eventID}/lead-consent [get]
+func (c *ExhibitorController) GetLeadConsent(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if !uuidRegex.MatchString(eventID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID")
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	consent, err := c.Service.GetLeadConsent(r.Context(), eventID, userID)
+	if err != nil {
+		c.writeExhibitorError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, consent)
+}
+
+// SetLeadConsent godoc
+// @Summary Give or withdraw my lead sharing consent for an event
+// @ID SetLeadConsent
+// @Description With consent true, the caller agrees to share their name and email with the exhibitors whose booth staff scan their lead code, and gets the code; consenting again keeps it. With consent false the code stops working, and consenting later gives a new one. Leads already scanned stay with their exhibitors. Only attendees registered for the event can consent. Requires authentication.
+// @Tags attendee
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param body body controllers.SetLeadConsentRequest true "Consent"
+// @Success 200 {object} controllers.LeadConsentSuccessResponse "data is the consent"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not registered)"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /attendee/events/{eventID}/lead-consent [put]
+func (c *ExhibitorController) SetLeadConsent(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if !uuidRegex.MatchString(eventID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID")
+		return
+	}
+	var req SetLeadConsentRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	consent, err := c.Service.SetLeadConsent(r.Context(), eventID, userID, *req.Consent)
+	if err != nil {
+		c.writeExhibitorError(w, r, err)
+		return
+	}
+	c.Logger.InfoContext(r.Context(), "lead consent set", "audit", "lead_consent.set",
+		"event_id", eventID, "user_id", userID, "consent", consent.Consent)
+	helpers.WriteJSONSuccess(w, http.StatusOK, consent)
+}
+
+// ListMyBooths godoc
+// @Summary List the booths I staff
+// @ID ListMyBooths
+// @Description Returns the exhibitors the caller is booth staff of, across events, each with its event's name. Requires authentication.
+// @Tags exhibitor
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} controllers.ExhibitorsSuccessResponse "data is the list of exhibitors"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /exhibitor/booths [get]
+func (c *ExhibitorController) ListMyBooths(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	exhibitors, err := c.Service.ListMyBooths(r.Context(), userID)
+	if err != nil {
+		c.writeExhibitorError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, exhibitors)
+}
+
+// ScanLead godoc
+// @Summary Scan an attendee's lead code
+// @ID ScanLead
+// @Description Records the attendee whose lead code was scanned as a lead of the exhibitor and returns their name and email. Only attendees of the exhibitor's event who consented have a code. Scanning the same attendee again returns the existing lead with 200, replacing its notes when notes are given. Booth staff of the exhibitor can scan. Requires authentication.
+// @Tags exhibitor
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param exhibitorID path string true "Exhibitor ID (UUID)"
+// @Param body body controllers.ScanLeadRequest true "Lead code and notes"
+// @Success 200 {object} controllers.LeadSuccessResponse "data is the existing lead"
+// @Success 201 {object} controllers.LeadSuccessResponse "data is the new lead"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not booth staff)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found (unknown exhibitor or code)"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /exhibitors/{exhibitorID}/leads [post]
+func (c *ExhibitorController) ScanLead(w http.ResponseWriter, r *http.Request) {
+	exhibitorID := r.PathValue("exhibitorID")
+	if !uuidRegex.MatchString(exhibitorID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid exhibitorID")
+		return
+	}
+	var req ScanLeadRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	lead, created, err := c.Service.ScanLead(r.Context(), exhibitorID, userID, req.Code, req.Notes)
+	if err != nil {
+		c.writeExhibitorError(w, r, err)
+		return
+	}
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+		c.Logger.InfoContext(r.Context(), "lead scanned", "audit", "lead.scan",
+			"event_id", lead.EventID, "user_id", userID, "exhibitor_id", exhibitorID, "lead_id", lead.ID)
+	}
+	helpers.WriteJSONSuccess(w, status, lead)
+}
+
+// ExportLeads godoc
+// @Summary Export an exhibitor's leads
+// @ID ExportLeads
+// @Description Returns the exhibitor's leads as CSV, oldest scan first, with a header row: name, last_name, email, notes, scanned_at (RFC 3339, UTC). Available once the event has ended: after the last operating day closes, else after the last session, else the day after the event's date. Booth staff of the exhibitor can export. Requires authentication. Errors are the usual JSON envelope.
+// @Tags exhibitor
+// @Produce text/csv
+// @Security BearerAuth
+// @Param exhibitorID path string true "Exhibitor ID (UUID)"
+// @Success 200 {file} binary "CSV of the leads"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not booth staff)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 409 {object} helpers.APIResponse "error.code: conflict (event not over)"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /exhibitors/{exhibitorID}/leads/export [get]
+func (c *ExhibitorController) ExportLeads(w http.ResponseWriter, r *http.Request) {
+	exhibitorID := r.PathValue("exhibitorID")
+	if !uuidRegex.MatchString(exhibitorID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid exhibitorID")
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	leads, err := c.Service.ExportLeads(r.Context(), exhibitorID, userID)
+	if err != nil {
+		c.writeExhibitorError(w, r, err)
+		return
+	}
+	c.Logger.InfoContext(r.Context(), "leads exported", "audit", "lead.export",
+		"user_id", userID, "exhibitor_id", exhibitorID, "leads", len(leads))
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="leads-`+exhibitorID+`.csv"`)
+	w.WriteHeader(http.StatusOK)
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"name", "last_name", "email", "notes", "scanned_at"})
+	for _, l := range leads {
+		_ = cw.Write([]string{csvCell(l.Name), csvCell(l.LastName), csvCell(l.Email), csvCell(l.Notes), l.ScannedAt.UTC().Format(time.RFC3339)})
+	}
+	cw.Flush()
+}
+
+// csvCell keeps a spreadsheet from reading s as a formula: values typed by users that start with
+// one of =+-@ get a leading apostrophe.
+func csvCell(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+func (c *ExhibitorController) writeExhibitorError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, domain.ErrUserNotFound) {
+		helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeUserNotFound, "user not found")
+		return
+	}
+	if errors.Is(err, domain.ErrNotFound) {
+		helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "not found")
+		return
+	}
+	if errors.Is(err, domain.ErrForbidden) {
+		helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+		return
+	}
+	if errors.Is(err, domain.ErrInvalidInput) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
+		return
+	}
+	if errors.Is(err, domain.ErrDuplicateBooth) || errors.Is(err, domain.ErrEventNotOver) {
+		helpers.WriteJSONError(w, http.StatusConflict, helpers.ErrCodeConflict, err.Error())
+		return
+	}
+	helpers.WriteServiceError(w, r, c.Logger, err)
+}
@@ -0,0 +1,196 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+type mockExhibitorService struct {
+	err     error
+	created bool
+	leads   []*domain.Lead
+}
+
+func (m *mockExhibitorService) CreateExhibitor(ctx context.Context, eventID, callerID, name, boothNumber string) (*domain.Exhibitor, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.Exhibitor{ID: "ex-1", EventID: eventID, Name: name, BoothNumber: boothNumber}, nil
+}
+
+func (m *mockExhibitorService) ListExhibitors(ctx context.Context, eventID, callerID string) ([]*domain.Exhibitor, error) {
+	return []*domain.Exhibitor{}, m.err
+}
+
+func (m *mockExhibitorService) DeleteExhibitor(ctx context.Context, eventID, exhibitorID, callerID string) error {
+	return m.err
+}
+
+func (m *mockExhibitorService) AddExhibitorStaff(ctx context.Context, eventID, exhibitorID, callerID, email string) (*domain.ExhibitorStaff, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.ExhibitorStaff{ExhibitorID: exhibitorID, UserID: "u2", Email: email}, nil
+}
+
+func (m *mockExhibitorService) RemoveExhibitorStaff(ctx context.Context, eventID, exhibitorID, callerID, userID string) error {
+	return m.err
+}
+
+func (m *mockExhibitorService) GetLeadConsent(ctx context.Context, eventID, userID string) (*domain.LeadConsent, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.LeadConsent{EventID: eventID}, nil
+}
+
+func (m *mockExhibitorService) SetLeadConsent(ctx context.Context, eventID, userID string, consent bool) (*domain.LeadConsent, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.LeadConsent{EventID: eventID, Consent: consent}, nil
+}
+
+func (m *mockExhibitorService) ListMyBooths(ctx context.Context, userID string) ([]*domain.Exhibitor, error) {
+	return []*domain.Exhibitor{}, m.err
+}
+
+func (m *mockExhibitorService) ScanLead(ctx context.Context, exhibitorID, staffID, code, notes string) (*domain.Lead, bool, error) {
+	if m.err != nil {
+		return nil, false, m.err
+	}
+	return &domain.Lead{ID: "lead-1", ExhibitorID: exhibitorID, ScannedBy: staffID, Notes: notes}, m.created, nil
+}
+
+func (m *mockExhibitorService) ExportLeads(ctx context.Context, exhibitorID, staffID string) ([]*domain.Lead, error) {
+	return m.leads, m.err
+}
+
+func TestExhibitorController_ScanLead(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	const path = "/exhibitors/11111111-1111-1111-1111-111111111111/leads"
+
+	tests := []struct {
+		name       string
+		body       string
+		created    bool
+		err        error
+		wantStatus int
+	}{
+		{name: "new lead", body: `{"code":"abc"}`, created: true, wantStatus: http.StatusCreated},
+		{name: "scanned before", body: `{"code":"abc"}`, wantStatus: http.StatusOK},
+		{name: "missing code", body: `{"notes":"hi"}`, wantStatus: http.StatusBadRequest},
+		{name: "not booth staff", body: `{"code":"abc"}`, err: domain.ErrForbidden, wantStatus: http.StatusForbidden},
+		{name: "unknown code", body: `{"code":"abc"}`, err: domain.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewExhibitorController(logger, &mockExhibitorService{err: tt.err, created: tt.created})
+			mux := http.NewServeMux()
+			mux.HandleFunc("POST /exhibitors/{exhibitorID}/leads", ctrl.ScanLead)
+
+			req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(tt.body))
+			req = req.WithContext(middleware.SetUserID(req.Context(), "u1"))
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestExhibitorController_ExportLeads(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	const path = "/exhibitors/11111111-1111-1111-1111-111111111111/leads/export"
+	at := time.Date(2026, 5, 14, 11, 0, 0, 0, time.FixedZone("CEST", 2*3600))
+
+	t.Run("csv", func(t *testing.T) {
+		ctrl := NewExhibitorController(logger, &mockExhibitorService{leads: []*domain.Lead{
+			{Name: "Ann", LastName: "Lee", Email: "ann@example.com", Notes: "wants a demo, soon", ScannedAt: at},
+			{Name: "=cmd", Email: "eve@example.com", ScannedAt: at},
+		}})
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /exhibitors/{exhibitorID}/leads/export", ctrl.ExportLeads)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req = req.WithContext(middleware.SetUserID(req.Context(), "u1"))
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+		}
+		if ct := w.Header().Get("Content-Type"); ct != "text/csv; charset=utf-8" {
+			t.Fatalf("unexpected content type %q", ct)
+		}
+		want := strings.Join([]string{
+			"name,last_name,email,notes,scanned_at",
+			`Ann,Lee,ann@example.com,"wants a demo, soon",2026-05-14T09:00:00Z`,
+			"'=cmd,,eve@example.com,,2026-05-14T09:00:00Z",
+			"",
+		}, "\n")
+		if got := w.Body.String(); got != want {
+			t.Fatalf("unexpected csv:\n%s", got)
+		}
+	})
+
+	t.Run("event not over", func(t *testing.T) {
+		ctrl := NewExhibitorController(logger, &mockExhibitorService{err: domain.ErrEventNotOver})
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /exhibitors/{exhibitorID}/leads/export", ctrl.ExportLeads)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req = req.WithContext(middleware.SetUserID(req.Context(), "u1"))
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, req)
+
+		if w.Code != http.StatusConflict {
+			t.Fatalf("expected status 409, got %d: %s", w.Code, w.Body.String())
+		}
+	})
+}
+
+func TestExhibitorController_SetLeadConsent(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	const path = "/attendee/events/11111111-1111-1111-1111-111111111111/lead-consent"
+
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantStatus int
+	}{
+		{name: "consent", body: `{"consent":true}`, wantStatus: http.StatusOK},
+		{name: "withdraw", body: `{"consent":false}`, wantStatus: http.StatusOK},
+		{name: "missing consent", body: `{}`, wantStatus: http.StatusBadRequest},
+		{name: "not registered", body: `{"consent":true}`, err: domain.ErrForbidden, wantStatus: http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewExhibitorController(logger, &mockExhibitorService{err: tt.err})
+			mux := http.NewServeMux()
+			mux.HandleFunc("PUT /attendee/events/{eventID}/lead-consent", ctrl.SetLeadConsent)
+
+			req := httptest.NewRequest(http.MethodPut, path, bytes.NewBufferString(tt.body))
+			req = req.WithContext(middleware.SetUserID(req.Context(), "u1"))
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+		})
+	}
+}
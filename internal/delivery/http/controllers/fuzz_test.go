@@ -0,0 +1,123 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// requestDTOs returns a fresh value of every request body type decoded with helpers.DecodeAndValidate.
+func requestDTOs() []any {
+	return []any{
+		&CreateEventRequest{},
+		&UpdateEventRequest{},
+		&UpdateImportMappingRequest{},
+		&ResolveSpeakerMergeRequest{},
+		&CreateRoomRequest{},
+		&UpdateRoomRequest{},
+		&CreateSpeakerRequest{},
+		&AddEventTeamMemberRequest{},
+		&SendEventInvitationsRequest{},
+		&CreateSessionRequest{},
+		&UpdateSessionScheduleRequest{},
+		&UpdateSessionContentRequest{},
+		&AddEventTagsRequest{},
+		&UpdateEventTagRequest{},
+		&AddSessionTagRequest{},
+		&AddSessionSpeakerRequest{},
+		&RegisterForEventByCodeRequest{},
+		&RequestLoginCodeRequest{},
+		&VerifyLoginCodeRequest{},
+		&UpdateUserRequest{},
+	}
+}
+
+// FuzzDecodeAndValidate feeds arbitrary bodies to every request DTO. Decoding and Validate must
+// never panic, and a rejected body must produce a 400 bad_request envelope.
+func FuzzDecodeAndValidate(f *testing.F) {
+	seeds := []string{
+		``,
+		`{}`,
+		`null`,
+		`[]`,
+		`{"name":"Conf"}`,
+		`{"name":"` + strings.Repeat("a", 300) + `"}`,
+		`{"email":"ä@exämple.com","code":"１２３４５６"}`,
+		`{"emails":"a@example.com, b@example.com\n\u0000"}`,
+		`{"tags":["go",null,""]}`,
+		`{"start_time":"2026-13-45T99:00:00Z","end_time":""}`,
+		`{"capacity":-1,"location_lat":1e400}`,
+		`{"unknown":1}`,
+		`{"name":"\ud800"}`,
+		"{\"name\":\"\xff\xfe\"}",
+		`{"name":"a"}{"name":"b"}`,
+	}
+	for _, s := range seeds {
+		f.Add([]byte(s))
+	}
+	f.Fuzz(func(t *testing.T, body []byte) {
+		for _, dest := range requestDTOs() {
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(body)))
+			rec := httptest.NewRecorder()
+			if helpers.DecodeAndValidate(rec, req, dest) {
+				continue
+			}
+			require.Equal(t, http.StatusBadRequest, rec.Code)
+			var env helpers.APIResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &env), "%T: error response must be JSON", dest)
+			require.NotNil(t, env.Error)
+			assert.Equal(t, helpers.ErrCodeBadRequest, env.Error.Code)
+		}
+	})
+}
+
+func TestDecodeAndValidate_HugeBody(t *testing.T) {
+	body := `{"name":"` + strings.Repeat("a", helpers.MaxRequestBodyBytes) + `"}`
+	req := httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	ok := helpers.DecodeAndValidate(rec, req, &CreateEventRequest{})
+
+	require.False(t, ok)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	assert.Contains(t, rec.Body.String(), helpers.ErrCodePayloadTooLarge)
+}
+
+// FuzzParseEmailsFromString checks that every returned address is lower-cased, matches
+// emailRegex, contains no separators, and appears once.
+func FuzzParseEmailsFromString(f *testing.F) {
+	seeds := []string{
+		"",
+		"a@example.com",
+		"A@Example.com, a@example.com b@example.com",
+		"not-an-email,,, ,@,a@b,a@b.c",
+		"ä@exämple.com ö@exämple.com",
+		"İ@EXAMPLE.COM",
+		"a@b.c\x00d@e.f",
+		"\xff\xfe@example.com",
+		strings.Repeat("x@y.z,", 1000),
+	}
+	for _, s := range seeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, raw string) {
+		out := parseEmailsFromString(raw)
+		seen := make(map[string]bool, len(out))
+		for _, email := range out {
+			assert.Equal(t, strings.ToLower(email), email)
+			assert.True(t, emailRegex.MatchString(email), "%q does not match emailRegex", email)
+			assert.NotContains(t, email, ",")
+			assert.Len(t, strings.Fields(email), 1, "%q contains whitespace", email)
+			assert.False(t, seen[email], "duplicate %q", email)
+			seen[email] = true
+		}
+		assert.LessOrEqual(t, len(out), len(strings.Fields(strings.ReplaceAll(raw, ",", " "))))
+	})
+}
@@ -0,0 +1,338 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+// InvitationWarmupController serves invitation warm-ups: large invitation sends spread over days.
+type InvitationWarmupController struct {
+	Logger  *slog.Logger
+	Service domain.InvitationWarmupService
+}
+
+func NewInvitationWarmupController(logger *slog.Logger, svc domain.InvitationWarmupService) *InvitationWarmupController {
+	return &InvitationWarmupController{
+		Logger:  logger,
+		Service: svc,
+	}
+}
+
+// CreateInvitationWarmupRequest is the request body for POST /events/{eventID}/invitations/warmups.
+// Give days or daily_volumes, or neither for the default ramp.
+type CreateInvitationWarmupRequest struct {
+	// Emails is a long string of emails separated by commas or spaces.
+	Emails string `json:"emails"`
+	// Days spreads the send over that many days, doubling the volume every day.
+	Days int `json:"days"`
+	// DailyVolumes is the most invitations each day sends; the last volume repeats until all are sent.
+	DailyVolumes []int `json:"daily_volumes"`
+}
+
+// Validate implements Validator.
+func (c CreateInvitationWarmupRequest) Validate() []string {
+	var errs []string
+	if strings.TrimSpace(c.Emails) == "" {
+		errs = append(errs, "emails is required")
+	}
+	if c.Days < 0 || c.Days > domain.MaxWarmupDays {
+		errs = append(errs, fmt.Sprintf("days must be 1 to %d", domain.MaxWarmupDays))
+	}
+	if c.Days > 0 && len(c.DailyVolumes) > 0 {
+		errs = append(errs, "give days or daily_volumes, not both")
+	}
+	if len(c.DailyVolumes) > domain.MaxWarmupDays {
+		errs = append(errs, fmt.Sprintf("at most %d daily_volumes", domain.MaxWarmupDays))
+	}
+	for _, v := range c.DailyVolumes {
+		if v < 1 || v > domain.MaxWarmupDailyVolume {
+			errs = append(errs, fmt.Sprintf("daily_volumes must be 1 to %d", domain.MaxWarmupDailyVolume))
+			break
+		}
+	}
+	return errs
+}
+
+// InvitationWarmupSuccessResponse is the success response envelope for a single invitation warm-up.
+type InvitationWarmupSuccessResponse struct {
+	Data  domain.InvitationWarmup `json:"data"`
+	Error *helpers.APIError       `json:"error"`
+}
+
+// InvitationWarmupsSuccessResponse is the success response envelope for GET /events/{eventID}/invitations/warmups (200).
+type InvitationWarmupsSuccessResponse struct {
+	Data  []*domain.InvitationWarmup `json:"data"`
+	Error *helpers.APIError          `json:"error"`
+}
+
+// CreateInvitationWarmup godoc
+// @Summary Start an invitation warm-up
+// @ID CreateInvitationWarmup
+// @Description Queues the emails to be invited over several days, so that a new sending domain builds its reputation before sending in volume. days spreads the send over that many days, doubling the volume every day; daily_volumes names each day's volume instead, the last repeating until every address is invited; with neither the default ramp (50, 100, 250, 500, 1000, 2500, then 5000 a day) applies. Each day's volume is spread over its 24 hours. Invitations go out as with POST /events/{eventID}/invitations, so the event's domain rules apply when each address's turn comes. With dry_run=true nothing is queued and data previews the schedule. Only the event owner can start a warm-up. Requires authentication.
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param dry_run query bool false "Preview the schedule without queueing anything"
+// @Param body body controllers.CreateInvitationWarmupRequest true "Emails and the daily volumes"
+// @Success 200 {object} controllers.InvitationWarmupSuccessResponse "data is the previewed warm-up (dry_run=true)"
+// @Success 201 {object} controllers.InvitationWarmupSuccessResponse "data is the warm-up with its schedule"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request (no valid emails, or bad days or daily_volumes)"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/invitations/warmups [post]
+func (c *InvitationWarmupController) CreateInvitationWarmup(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if !uuidRegex.MatchString(eventID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID")
+		return
+	}
+	dryRun, ok := helpers.ParseDryRun(w, r)
+	if !ok {
+		return
+	}
+	var req CreateInvitationWarmupRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	emails := parseEmailsFromString(req.Emails)
+	if len(emails) == 0 {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "no valid emails found")
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+
+	warmup, err := c.Service.CreateWarmup(r.Context(), eventID, ownerID, emails, req.Days, req.DailyVolumes, dryRun)
+	if err != nil {
+		c.writeInvitationWarmupError(w, r, err)
+		return
+	}
+	if dryRun {
+		helpers.WriteJSONSuccess(w, http.StatusOK, warmup)
+		return
+	}
+	c.Logger.InfoContext(r.Context(), "invitation warm-up started", "audit", "invitation_warmup.create",
+		"event_id", eventID, "warmup_id", warmup.ID, "user_id", ownerID, "total", warmup.Total, "days", len(warmup.Schedule))
+	helpers.WriteJSONSuccess(w, http.StatusCreated, warmup)
+}
+
+// ListInvitationWarmups godoc
+// @Summary List an event's invitation warm-ups
+// @ID ListInvitationWarmups
+// @Description Returns the event's invitation warm-ups, newest first, each with its progress and schedule. Only the event owner can list. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Success 200 {object} controllers.InvitationWarmupsSuccessResponse "data is the list of warm-ups"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/invitations/warmups [get]
+func (c *InvitationWarmupController) ListInvitationWarmups(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if !uuidRegex.MatchString(eventID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID")
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	warmups, err := c.Service.ListWarmups(r.Context(), eventID, ownerID)
+	if err != nil {
+		c.writeInvitationWarmupError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, warmups)
+}
+
+// GetInvitationWarmup godoc
+// @Summary Get an invitation warm-up
+// @ID GetInvitationWarmup
+// @Description Returns the warm-up with its progress and schedule. Only the event owner can get it. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param warmupID path string true "Warm-up ID (UUID)"
+// @Success 200 {object} controllers.InvitationWarmupSuccessResponse "data is the warm-up"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/invitations/warmups/{warmupID} [get]
+func (c *InvitationWarmupController) GetInvitationWarmup(w http.ResponseWriter, r *http.Request) {
+	eventID, warmupID, ownerID, ok := c.warmupRequest(w, r)
+	if !ok {
+		return
+	}
+	warmup, err := c.Service.GetWarmup(r.Context(), eventID, warmupID, ownerID)
+	if err != nil {
+		c.writeInvitationWarmupError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, warmup)
+}
+
+// PauseInvitationWarmup godoc
+// @Summary Pause an invitation warm-up
+// @ID PauseInvitationWarmup
+// @Description Stops sending the warm-up's invitations until it is resumed. Pausing a paused warm-up changes nothing. Only the event owner can pause. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param warmupID path string true "Warm-up ID (UUID)"
+// @Success 200 {object} controllers.InvitationWarmupSuccessResponse "data is the paused warm-up"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 409 {object} helpers.APIResponse "error.code: conflict (warm-up already completed)"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/invitations/warmups/{warmupID}/pause [post]
+func (c *InvitationWarmupController) PauseInvitationWarmup(w http.ResponseWriter, r *http.Request) {
+	eventID, warmupID, ownerID, ok := c.warmupRequest(w, r)
+	if !ok {
+		return
+	}
+	warmup, err := c.Service.PauseWarmup(r.Context(), eventID, warmupID, ownerID)
+	if err != nil {
+		c.writeInvitationWarmupError(w, r, err)
+		return
+	}
+	c.Logger.InfoContext(r.Context(), "invitation warm-up paused", "audit", "invitation_warmup.pause",
+		"event_id", eventID, "warmup_id", warmupID, "user_id", ownerID)
+	helpers.WriteJSONSuccess(w, http.StatusOK, warmup)
+}
+
+// ResumeInvitationWarmup godoc
+// @Summary Resume an invitation warm-up
+// @ID ResumeInvitationWarmup
+// @Description Resumes sending a paused warm-up where it left off: its schedule moves later by the time it was paused, so no day's volume is skipped or doubled. Resuming an active warm-up changes nothing. Only the event owner can resume. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param warmupID path string true "Warm-up ID (UUID)"
+// @Success 200 {object} controllers.InvitationWarmupSuccessResponse "data is the resumed warm-up with its new schedule"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 409 {object} helpers.APIResponse "error.code: conflict (warm-up already completed)"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/invitations/warmups/{warmupID}/resume [post]
+func (c *InvitationWarmupController) ResumeInvitationWarmup(w http.ResponseWriter, r *http.Request) {
+	eventID, warmupID, ownerID, ok := c.warmupRequest(w, r)
+	if !ok {
+		return
+	}
+	warmup, err := c.Service.ResumeWarmup(r.Context(), eventID, warmupID, ownerID)
+	if err != nil {
+		c.writeInvitationWarmupError(w, r, err)
+		return
+	}
+	c.Logger.InfoContext(r.Context(), "invitation warm-up resumed", "audit", "invitation_warmup.resume",
+		"event_id", eventID, "warmup_id", warmupID, "user_id", ownerID)
+	helpers.WriteJSONSuccess(w, http.StatusOK, warmup)
+}
+
+// EmailDomainReportSuccessResponse is the success response envelope for GET /events/{eventID}/invitations/email-domain (200).
+type EmailDomainReportSuccessResponse struct {
+	Data  domain.EmailDomainReport `json:"data"`
+	Error *helpers.APIError        `json:"error"`
+}
+
+// CheckEmailDomain godoc
+// @Summary Check the invitations' sending domain
+// @ID CheckEmailDomain
+// @Description Looks up the SPF, DKIM and DMARC records of the domain the event's invitations are sent from and reports each one that is missing or wrong, so deliverability problems show up before a large send. Every event is sent from the platform sender for now. Reports are cached for 10 minutes; refresh=true looks the records up again once the report is a minute old. Only the event owner can check. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param refresh query bool false "Look the records up again instead of using the cached report"
+// @Success 200 {object} controllers.EmailDomainReportSuccessResponse "data is the report; data.ok is false when a record is missing or invalid"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found (no event, or the server does not send through SES)"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/invitations/email-domain [get]
+func (c *InvitationWarmupController) CheckEmailDomain(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if !uuidRegex.MatchString(eventID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID")
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	report, err := c.Service.CheckEmailDomain(r.Context(), eventID, ownerID, r.URL.Query().Get("refresh") == "true")
+	if err != nil {
+		c.writeInvitationWarmupError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, report)
+}
+
+// warmupRequest returns the path's eventID and warmupID and the caller, or writes the error response.
+func (c *InvitationWarmupController) warmupRequest(w http.ResponseWriter, r *http.Request) (eventID, warmupID, ownerID string, ok bool) {
+	eventID, warmupID = r.PathValue("eventID"), r.PathValue("warmupID")
+	if !uuidRegex.MatchString(eventID) || !uuidRegex.MatchString(warmupID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID or warmupID")
+		return "", "", "", false
+	}
+	ownerID, ok = middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return "", "", "", false
+	}
+	return eventID, warmupID, ownerID, true
+}
+
+func (c *InvitationWarmupController) writeInvitationWarmupError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, domain.ErrEmailDomainNotChecked) {
+		helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, err.Error())
+		return
+	}
+	if errors.Is(err, domain.ErrNotFound) {
+		helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event or warm-up not found")
+		return
+	}
+	if errors.Is(err, domain.ErrForbidden) {
+		helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+		return
+	}
+	if errors.Is(err, domain.ErrInvalidInput) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
+		return
+	}
+	if errors.Is(err, domain.ErrWarmupCompleted) {
+		helpers.WriteJSONError(w, http.StatusConflict, helpers.ErrCodeConflict, err.Error())
+		return
+	}
+	helpers.WriteServiceError(w, r, c.Logger, err)
+}
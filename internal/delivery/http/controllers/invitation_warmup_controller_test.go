@@ -0,0 +1,167 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+type mockInvitationWarmupService struct {
+	err    error
+	emails []string
+	dryRun bool
+}
+
+func (m *mockInvitationWarmupService) CreateWarmup(ctx context.Context, eventID, ownerID string, emails []string, days int, dailyVolumes []int, dryRun bool) (*domain.InvitationWarmup, error) {
+	m.emails, m.dryRun = emails, dryRun
+	if m.err != nil {
+		return nil, m.err
+	}
+	w := &domain.InvitationWarmup{EventID: eventID, DryRun: dryRun, Status: domain.WarmupStatusActive, Total: len(emails)}
+	if !dryRun {
+		w.ID = "33333333-3333-3333-3333-333333333333"
+	}
+	return w, nil
+}
+
+func (m *mockInvitationWarmupService) ListWarmups(ctx context.Context, eventID, ownerID string) ([]*domain.InvitationWarmup, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return []*domain.InvitationWarmup{}, nil
+}
+
+func (m *mockInvitationWarmupService) GetWarmup(ctx context.Context, eventID, warmupID, ownerID string) (*domain.InvitationWarmup, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.InvitationWarmup{ID: warmupID, EventID: eventID, Status: domain.WarmupStatusActive}, nil
+}
+
+func (m *mockInvitationWarmupService) PauseWarmup(ctx context.Context, eventID, warmupID, ownerID string) (*domain.InvitationWarmup, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.InvitationWarmup{ID: warmupID, EventID: eventID, Status: domain.WarmupStatusPaused}, nil
+}
+
+func (m *mockInvitationWarmupService) ResumeWarmup(ctx context.Context, eventID, warmupID, ownerID string) (*domain.InvitationWarmup, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.InvitationWarmup{ID: warmupID, EventID: eventID, Status: domain.WarmupStatusActive}, nil
+}
+
+func (m *mockInvitationWarmupService) CheckEmailDomain(ctx context.Context, eventID, ownerID string, refresh bool) (*domain.EmailDomainReport, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.EmailDomainReport{Domain: "example.com", OK: true}, nil
+}
+
+func (m *mockInvitationWarmupService) SendDue(ctx context.Context) (*domain.WarmupRun, error) {
+	return &domain.WarmupRun{}, m.err
+}
+
+func TestInvitationWarmupController_CreateInvitationWarmup(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	const path = "/events/11111111-1111-1111-1111-111111111111/invitations/warmups"
+
+	tests := []struct {
+		name       string
+		query      string
+		body       string
+		err        error
+		wantStatus int
+		wantEmails int
+		wantDryRun bool
+	}{
+		{name: "default ramp", body: `{"emails":"a@example.com, b@example.com not-an-email"}`, wantStatus: http.StatusCreated, wantEmails: 2},
+		{name: "days", body: `{"emails":"a@example.com","days":5}`, wantStatus: http.StatusCreated, wantEmails: 1},
+		{name: "daily volumes", body: `{"emails":"a@example.com","daily_volumes":[10,20]}`, wantStatus: http.StatusCreated, wantEmails: 1},
+		{name: "dry run", query: "?dry_run=true", body: `{"emails":"a@example.com"}`, wantStatus: http.StatusOK, wantEmails: 1, wantDryRun: true},
+		{name: "bad dry run", query: "?dry_run=maybe", body: `{"emails":"a@example.com"}`, wantStatus: http.StatusBadRequest},
+		{name: "no emails", body: `{"emails":""}`, wantStatus: http.StatusBadRequest},
+		{name: "no valid emails", body: `{"emails":"nope"}`, wantStatus: http.StatusBadRequest},
+		{name: "days and volumes", body: `{"emails":"a@example.com","days":3,"daily_volumes":[10]}`, wantStatus: http.StatusBadRequest},
+		{name: "too many days", body: `{"emails":"a@example.com","days":31}`, wantStatus: http.StatusBadRequest},
+		{name: "zero volume", body: `{"emails":"a@example.com","daily_volumes":[0]}`, wantStatus: http.StatusBadRequest},
+		{name: "not owner", body: `{"emails":"a@example.com"}`, err: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantEmails: 1},
+		{name: "unknown event", body: `{"emails":"a@example.com"}`, err: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantEmails: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &mockInvitationWarmupService{err: tt.err}
+			ctrl := NewInvitationWarmupController(logger, svc)
+			mux := http.NewServeMux()
+			mux.HandleFunc("POST /events/{eventID}/invitations/warmups", ctrl.CreateInvitationWarmup)
+
+			req := httptest.NewRequest(http.MethodPost, path+tt.query, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req = req.WithContext(middleware.SetUserID(req.Context(), "owner-1"))
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if len(svc.emails) != tt.wantEmails {
+				t.Fatalf("expected %d emails passed to the service, got %v", tt.wantEmails, svc.emails)
+			}
+			if svc.dryRun != tt.wantDryRun {
+				t.Fatalf("expected dry run %v, got %v", tt.wantDryRun, svc.dryRun)
+			}
+		})
+	}
+}
+
+func TestInvitationWarmupController_PauseResume(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	const path = "/events/11111111-1111-1111-1111-111111111111/invitations/warmups/33333333-3333-3333-3333-333333333333"
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		err        error
+		wantStatus int
+	}{
+		{name: "get", method: http.MethodGet, path: path, wantStatus: http.StatusOK},
+		{name: "list", method: http.MethodGet, path: "/events/11111111-1111-1111-1111-111111111111/invitations/warmups", wantStatus: http.StatusOK},
+		{name: "pause", method: http.MethodPost, path: path + "/pause", wantStatus: http.StatusOK},
+		{name: "resume", method: http.MethodPost, path: path + "/resume", wantStatus: http.StatusOK},
+		{name: "pause completed", method: http.MethodPost, path: path + "/pause", err: domain.ErrWarmupCompleted, wantStatus: http.StatusConflict},
+		{name: "resume not owner", method: http.MethodPost, path: path + "/resume", err: domain.ErrForbidden, wantStatus: http.StatusForbidden},
+		{name: "unknown warm-up", method: http.MethodGet, path: path, err: domain.ErrNotFound, wantStatus: http.StatusNotFound},
+		{name: "email domain", method: http.MethodGet, path: "/events/11111111-1111-1111-1111-111111111111/invitations/email-domain?refresh=true", wantStatus: http.StatusOK},
+		{name: "email domain not checked", method: http.MethodGet, path: "/events/11111111-1111-1111-1111-111111111111/invitations/email-domain", err: domain.ErrEmailDomainNotChecked, wantStatus: http.StatusNotFound},
+		{name: "invalid warmupID", method: http.MethodPost, path: "/events/11111111-1111-1111-1111-111111111111/invitations/warmups/nope/pause", wantStatus: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewInvitationWarmupController(logger, &mockInvitationWarmupService{err: tt.err})
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /events/{eventID}/invitations/warmups", ctrl.ListInvitationWarmups)
+			mux.HandleFunc("GET /events/{eventID}/invitations/warmups/{warmupID}", ctrl.GetInvitationWarmup)
+			mux.HandleFunc("POST /events/{eventID}/invitations/warmups/{warmupID}/pause", ctrl.PauseInvitationWarmup)
+			mux.HandleFunc("POST /events/{eventID}/invitations/warmups/{warmupID}/resume", ctrl.ResumeInvitationWarmup)
+			mux.HandleFunc("GET /events/{eventID}/invitations/email-domain", ctrl.CheckEmailDomain)
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req = req.WithContext(middleware.SetUserID(req.Context(), "owner-1"))
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+		})
+	}
+}
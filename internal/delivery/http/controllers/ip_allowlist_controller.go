@@ -0,0 +1,288 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+// IPAllowlistController serves the IP allowlists of accounts and machine clients.
+type IPAllowlistController struct {
+	Logger  *slog.Logger
+	Service domain.IPAllowlistService
+}
+
+func NewIPAllowlistController(logger *slog.Logger, svc domain.IPAllowlistService) *IPAllowlistController {
+	return &IPAllowlistController{
+		Logger:  logger,
+		Service: svc,
+	}
+}
+
+// IPAllowlistRequest is the request body for PUT /users/me/ip-allowlist,
+// PUT /admin/users/{userID}/ip-allowlist and PUT /admin/machine-clients/{clientID}/ip-allowlist.
+type IPAllowlistRequest struct {
+	// Ranges are CIDR ranges (e.g. 10.0.0.0/8) or single addresses; an empty list removes the allowlist.
+	Ranges []string `json:"ranges"`
+}
+
+// Validate implements Validator.
+func (c IPAllowlistRequest) Validate() []string {
+	if len(c.Ranges) > domain.MaxIPAllowlistRanges {
+		return []string{fmt.Sprintf("ranges must have at most %d entries", domain.MaxIPAllowlistRanges)}
+	}
+	return nil
+}
+
+// IPAllowlistSuccessResponse is the success response envelope for the IP allowlist endpoints (200).
+type IPAllowlistSuccessResponse struct {
+	Data  domain.IPAllowlist `json:"data"`
+	Error *helpers.APIError  `json:"error"`
+}
+
+// GetMyIPAllowlist godoc
+// @Summary Get my IP allowlist
+// @ID GetMyIPAllowlist
+// @Description Returns the address ranges the caller's authenticated requests must come from. An empty list allows any address. Requires authentication.
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} controllers.IPAllowlistSuccessResponse "data is the allowlist"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: ip_not_allowed"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /users/me/ip-allowlist [get]
+func (c *IPAllowlistController) GetMyIPAllowlist(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	list, err := c.Service.GetIPAllowlist(r.Context(), userID)
+	if err != nil {
+		c.writeIPAllowlistError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, list)
+}
+
+// UpdateMyIPAllowlist godoc
+// @Summary Replace my IP allowlist
+// @ID UpdateMyIPAllowlist
+// @Description Replaces the address ranges the caller's authenticated requests must come from; every other address gets 403 ip_not_allowed. The list must include the address the change is made from, so nobody locks themselves out; an empty list removes it. Changes can take up to 30 seconds to reach every server. Requires authentication.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body controllers.IPAllowlistRequest true "Ranges"
+// @Success 200 {object} controllers.IPAllowlistSuccessResponse "data is the normalized allowlist"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request (malformed range, or the current address left out)"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: ip_not_allowed"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /users/me/ip-allowlist [put]
+func (c *IPAllowlistController) UpdateMyIPAllowlist(w http.ResponseWriter, r *http.Request) {
+	var req IPAllowlistRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	list, err := c.Service.SetIPAllowlist(r.Context(), userID, helpers.ClientIP(r), req.Ranges)
+	if err != nil {
+		c.writeIPAllowlistError(w, r, err)
+		return
+	}
+	middleware.LogSecurityEvent(c.Logger, r, "ip allowlist updated", middleware.SecurityEvent{
+		Type: middleware.SecurityIPAllowlistUpdate, Outcome: middleware.SecurityOutcomeSuccess, UserID: userID,
+		TargetType: "user", TargetID: userID,
+		Details: []slog.Attr{slog.Any("ranges", list.Ranges)},
+	})
+	helpers.WriteJSONSuccess(w, http.StatusOK, list)
+}
+
+// GetUserIPAllowlist godoc
+// @Summary Get a user's IP allowlist
+// @ID GetUserIPAllowlist
+// @Description Returns the address ranges the user's authenticated requests must come from. Only platform admins can read it. Requires authentication.
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Param userID path string true "User ID (UUID)"
+// @Success 200 {object} controllers.IPAllowlistSuccessResponse "data is the allowlist"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not admin) or ip_not_allowed"
+// @Failure 404 {object} helpers.APIResponse "error.code: user_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /admin/users/{userID}/ip-allowlist [get]
+func (c *IPAllowlistController) GetUserIPAllowlist(w http.ResponseWriter, r *http.Request) {
+	userID := r.PathValue("userID")
+	if !uuidRegex.MatchString(userID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid userID")
+		return
+	}
+	adminID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	list, err := c.Service.GetUserIPAllowlist(r.Context(), adminID, userID)
+	if err != nil {
+		c.writeIPAllowlistError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, list)
+}
+
+// UpdateUserIPAllowlist godoc
+// @Summary Replace a user's IP allowlist
+// @ID UpdateUserIPAllowlist
+// @Description Replaces the address ranges the user's authenticated requests must come from, e.g. to let a locked-out user back in; an empty list removes it. Only platform admins can update. Requires authentication.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param userID path string true "User ID (UUID)"
+// @Param body body controllers.IPAllowlistRequest true "Ranges"
+// @Success 200 {object} controllers.IPAllowlistSuccessResponse "data is the normalized allowlist"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not admin) or ip_not_allowed"
+// @Failure 404 {object} helpers.APIResponse "error.code: user_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /admin/users/{userID}/ip-allowlist [put]
+func (c *IPAllowlistController) UpdateUserIPAllowlist(w http.ResponseWriter, r *http.Request) {
+	userID := r.PathValue("userID")
+	if !uuidRegex.MatchString(userID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid userID")
+		return
+	}
+	var req IPAllowlistRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	adminID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	list, err := c.Service.SetUserIPAllowlist(r.Context(), adminID, userID, req.Ranges)
+	if err != nil {
+		c.writeIPAllowlistError(w, r, err)
+		return
+	}
+	middleware.LogSecurityEvent(c.Logger, r, "ip allowlist updated", middleware.SecurityEvent{
+		Type: middleware.SecurityIPAllowlistUpdate, Outcome: middleware.SecurityOutcomeSuccess, UserID: adminID,
+		TargetType: "user", TargetID: userID,
+		Details: []slog.Attr{slog.Any("ranges", list.Ranges)},
+	})
+	helpers.WriteJSONSuccess(w, http.StatusOK, list)
+}
+
+// GetMachineClientIPAllowlist godoc
+// @Summary Get a machine client's IP allowlist
+// @ID GetMachineClientIPAllowlist
+// @Description Returns the address ranges the machine client's requests to /machine routes must come from. An empty list allows any address. Only platform admins can read it. Requires authentication.
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Param clientID path string true "Client ID (UUID)"
+// @Success 200 {object} controllers.IPAllowlistSuccessResponse "data is the allowlist"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not admin) or ip_not_allowed"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /admin/machine-clients/{clientID}/ip-allowlist [get]
+func (c *IPAllowlistController) GetMachineClientIPAllowlist(w http.ResponseWriter, r *http.Request) {
+	clientID := r.PathValue("clientID")
+	if !uuidRegex.MatchString(clientID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid clientID")
+		return
+	}
+	adminID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	list, err := c.Service.GetMachineClientIPAllowlist(r.Context(), adminID, clientID)
+	if err != nil {
+		c.writeIPAllowlistError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, list)
+}
+
+// UpdateMachineClientIPAllowlist godoc
+// @Summary Replace a machine client's IP allowlist
+// @ID UpdateMachineClientIPAllowlist
+// @Description Replaces the address ranges the machine client's requests to /machine routes must come from; a request from any other address gets 403 ip_not_allowed, even with a valid token. An empty list removes it. Changes can take up to 30 seconds to reach every server. Only platform admins can update. Requires authentication.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param clientID path string true "Client ID (UUID)"
+// @Param body body controllers.IPAllowlistRequest true "Ranges"
+// @Success 200 {object} controllers.IPAllowlistSuccessResponse "data is the normalized allowlist"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not admin) or ip_not_allowed"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /admin/machine-clients/{clientID}/ip-allowlist [put]
+func (c *IPAllowlistController) UpdateMachineClientIPAllowlist(w http.ResponseWriter, r *http.Request) {
+	clientID := r.PathValue("clientID")
+	if !uuidRegex.MatchString(clientID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid clientID")
+		return
+	}
+	var req IPAllowlistRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	adminID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	list, err := c.Service.SetMachineClientIPAllowlist(r.Context(), adminID, clientID, req.Ranges)
+	if err != nil {
+		c.writeIPAllowlistError(w, r, err)
+		return
+	}
+	middleware.LogSecurityEvent(c.Logger, r, "ip allowlist updated", middleware.SecurityEvent{
+		Type: middleware.SecurityIPAllowlistUpdate, Outcome: middleware.SecurityOutcomeSuccess, UserID: adminID,
+		TargetType: "machine_client", TargetID: clientID,
+		Details: []slog.Attr{slog.Any("ranges", list.Ranges)},
+	})
+	helpers.WriteJSONSuccess(w, http.StatusOK, list)
+}
+
+func (c *IPAllowlistController) writeIPAllowlistError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, domain.ErrUserNotFound) {
+		helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeUserNotFound, "user not found")
+		return
+	}
+	if errors.Is(err, domain.ErrNotFound) {
+		helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "machine client not found")
+		return
+	}
+	if errors.Is(err, domain.ErrForbidden) {
+		helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+		return
+	}
+	if errors.Is(err, domain.ErrInvalidInput) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
+		return
+	}
+	helpers.WriteServiceError(w, r, c.Logger, err)
+}
@@ -0,0 +1,179 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+type mockIPAllowlistService struct {
+	err          error
+	lastUserID   string
+	lastClientIP string
+	lastRanges   []string
+}
+
+func (m *mockIPAllowlistService) CheckAddress(ctx context.Context, userID, ip string) (*domain.IPAllowlist, error) {
+	return &domain.IPAllowlist{UserID: userID}, m.err
+}
+
+func (m *mockIPAllowlistService) GetIPAllowlist(ctx context.Context, userID string) (*domain.IPAllowlist, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.IPAllowlist{UserID: userID, Ranges: []string{}}, nil
+}
+
+func (m *mockIPAllowlistService) SetIPAllowlist(ctx context.Context, userID, clientIP string, ranges []string) (*domain.IPAllowlist, error) {
+	m.lastUserID, m.lastClientIP, m.lastRanges = userID, clientIP, ranges
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.IPAllowlist{UserID: userID, Ranges: ranges}, nil
+}
+
+func (m *mockIPAllowlistService) GetUserIPAllowlist(ctx context.Context, adminID, userID string) (*domain.IPAllowlist, error) {
+	return m.GetIPAllowlist(ctx, userID)
+}
+
+func (m *mockIPAllowlistService) SetUserIPAllowlist(ctx context.Context, adminID, userID string, ranges []string) (*domain.IPAllowlist, error) {
+	return m.SetIPAllowlist(ctx, userID, "", ranges)
+}
+
+func (m *mockIPAllowlistService) CheckMachineAddress(ctx context.Context, clientID, ip string) (*domain.IPAllowlist, error) {
+	return &domain.IPAllowlist{ClientID: clientID}, m.err
+}
+
+func (m *mockIPAllowlistService) GetMachineClientIPAllowlist(ctx context.Context, adminID, clientID string) (*domain.IPAllowlist, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.IPAllowlist{ClientID: clientID, Ranges: []string{}}, nil
+}
+
+func (m *mockIPAllowlistService) SetMachineClientIPAllowlist(ctx context.Context, adminID, clientID string, ranges []string) (*domain.IPAllowlist, error) {
+	m.lastRanges = ranges
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.IPAllowlist{ClientID: clientID, Ranges: ranges}, nil
+}
+
+func TestIPAllowlistController_UpdateMyIPAllowlist(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantStatus int
+	}{
+		{name: "updated", body: `{"ranges":["10.0.0.0/8"]}`, wantStatus: http.StatusOK},
+		{name: "cleared", body: `{"ranges":[]}`, wantStatus: http.StatusOK},
+		{name: "unknown field", body: `{"cidrs":["10.0.0.0/8"]}`, wantStatus: http.StatusBadRequest},
+		{name: "locks the caller out", body: `{"ranges":["10.0.0.0/8"]}`, err: domain.ErrInvalidInput, wantStatus: http.StatusBadRequest},
+		{name: "store failed", body: `{"ranges":["10.0.0.0/8"]}`, err: context.DeadlineExceeded, wantStatus: http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &mockIPAllowlistService{err: tt.err}
+			ctrl := NewIPAllowlistController(logger, svc)
+
+			req := httptest.NewRequest(http.MethodPut, "/users/me/ip-allowlist", bytes.NewBufferString(tt.body))
+			req.RemoteAddr = "10.1.2.3:1234"
+			// A caller cannot vouch for its own address: the header only counts behind a trusted proxy.
+			req.Header.Set("X-Forwarded-For", "10.9.9.9")
+			req = req.WithContext(middleware.SetUserID(req.Context(), "user-1"))
+			w := httptest.NewRecorder()
+			ctrl.UpdateMyIPAllowlist(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if tt.wantStatus == http.StatusOK && (svc.lastUserID != "user-1" || svc.lastClientIP != "10.1.2.3") {
+				t.Fatalf("unexpected call: %q %q", svc.lastUserID, svc.lastClientIP)
+			}
+		})
+	}
+}
+
+func TestIPAllowlistController_UpdateUserIPAllowlist(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	const id = "11111111-1111-1111-1111-111111111111"
+
+	tests := []struct {
+		name       string
+		path       string
+		err        error
+		wantStatus int
+	}{
+		{name: "updated", path: "/admin/users/" + id + "/ip-allowlist", wantStatus: http.StatusOK},
+		{name: "bad user id", path: "/admin/users/x/ip-allowlist", wantStatus: http.StatusBadRequest},
+		{name: "not admin", path: "/admin/users/" + id + "/ip-allowlist", err: domain.ErrForbidden, wantStatus: http.StatusForbidden},
+		{name: "unknown user", path: "/admin/users/" + id + "/ip-allowlist", err: domain.ErrUserNotFound, wantStatus: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &mockIPAllowlistService{err: tt.err}
+			ctrl := NewIPAllowlistController(logger, svc)
+			mux := http.NewServeMux()
+			mux.HandleFunc("PUT /admin/users/{userID}/ip-allowlist", ctrl.UpdateUserIPAllowlist)
+
+			req := httptest.NewRequest(http.MethodPut, tt.path, bytes.NewBufferString(`{"ranges":["192.0.2.0/24"]}`))
+			req = req.WithContext(middleware.SetUserID(req.Context(), "admin-1"))
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if tt.wantStatus == http.StatusOK && svc.lastUserID != id {
+				t.Fatalf("unexpected user: %q", svc.lastUserID)
+			}
+		})
+	}
+}
+
+func TestIPAllowlistController_UpdateMachineClientIPAllowlist(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	const id = "22222222-2222-2222-2222-222222222222"
+
+	tests := []struct {
+		name       string
+		path       string
+		err        error
+		wantStatus int
+	}{
+		{name: "updated", path: "/admin/machine-clients/" + id + "/ip-allowlist", wantStatus: http.StatusOK},
+		{name: "bad client id", path: "/admin/machine-clients/x/ip-allowlist", wantStatus: http.StatusBadRequest},
+		{name: "not admin", path: "/admin/machine-clients/" + id + "/ip-allowlist", err: domain.ErrForbidden, wantStatus: http.StatusForbidden},
+		{name: "unknown client", path: "/admin/machine-clients/" + id + "/ip-allowlist", err: domain.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &mockIPAllowlistService{err: tt.err}
+			ctrl := NewIPAllowlistController(logger, svc)
+			mux := http.NewServeMux()
+			mux.HandleFunc("PUT /admin/machine-clients/{clientID}/ip-allowlist", ctrl.UpdateMachineClientIPAllowlist)
+
+			req := httptest.NewRequest(http.MethodPut, tt.path, bytes.NewBufferString(`{"ranges":["192.0.2.0/24"]}`))
+			req = req.WithContext(middleware.SetUserID(req.Context(), "admin-1"))
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if tt.wantStatus == http.StatusOK && len(svc.lastRanges) != 1 {
+				t.Fatalf("unexpected ranges: %v", svc.lastRanges)
+			}
+		})
+	}
+}
@@ -0,0 +1,315 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+// MachineClientController serves the machine clients' token exchange and their management by admins.
+type MachineClientController struct {
+	Logger  *slog.Logger
+	Service domain.MachineClientService
+}
+
+func NewMachineClientController(logger *slog.Logger, svc domain.MachineClientService) *MachineClientController {
+	return &MachineClientController{
+		Logger:  logger,
+		Service: svc,
+	}
+}
+
+// MachineTokenRequest is the request body for POST /auth/machine-token.
+type MachineTokenRequest struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+}
+
+// Validate implements Validator.
+func (c MachineTokenRequest) Validate() []string {
+	var errs []string
+	if c.ClientID == "" {
+		errs = append(errs, "client_id is required")
+	}
+	if c.ClientSecret == "" {
+		errs = append(errs, "client_secret is required")
+	}
+	return errs
+}
+
+// CreateMachineClientRequest is the request body for POST /admin/machine-clients.
+type CreateMachineClientRequest struct {
+	Name string `json:"name"`
+	// EventID is the event the client is bound to. Omit it for a sandbox:write client.
+	EventID string `json:"event_id"`
+	// Scopes are what the client's tokens may do, e.g. ["schedule:read"].
+	Scopes []string `json:"scopes"`
+}
+
+// Validate implements Validator.
+func (c CreateMachineClientRequest) Validate() []string {
+	var errs []string
+	if strings.TrimSpace(c.Name) == "" {
+		errs = append(errs, "name is required")
+	} else if len(c.Name) > domain.MaxMachineClientNameLength {
+		errs = append(errs, fmt.Sprintf("name must be at most %d characters", domain.MaxMachineClientNameLength))
+	}
+	if slices.Contains(c.Scopes, domain.ScopeSandboxWrite) {
+		if c.EventID != "" {
+			errs = append(errs, "event_id must be omitted for the sandbox:write scope")
+		}
+	} else if !uuidRegex.MatchString(c.EventID) {
+		errs = append(errs, "event_id must be a UUID")
+	}
+	if len(c.Scopes) == 0 {
+		errs = append(errs, "scopes is required")
+	}
+	return errs
+}
+
+// CreateSandboxEventRequest is the request body for POST /machine/sandbox-events.
+type CreateSandboxEventRequest struct {
+	Name string `json:"name"`
+}
+
+// Validate implements Validator.
+func (c CreateSandboxEventRequest) Validate() []string {
+	var errs []string
+	if strings.TrimSpace(c.Name) == "" {
+		errs = append(errs, "name is required")
+	} else if len(c.Name) > domain.MaxEventNameLength {
+		errs = append(errs, fmt.Sprintf("name must be at most %d characters", domain.MaxEventNameLength))
+	}
+	return errs
+}
+
+// MachineTokenSuccessResponse is the success response envelope for POST /auth/machine-token (200).
+type MachineTokenSuccessResponse struct {
+	Data  domain.MachineToken `json:"data"`
+	Error *helpers.APIError   `json:"error"`
+}
+
+// MachineClientSuccessResponse is the success response envelope for POST /admin/machine-clients/{clientID}/revoke (200).
+type MachineClientSuccessResponse struct {
+	Data  domain.MachineClient `json:"data"`
+	Error *helpers.APIError    `json:"error"`
+}
+
+// MachineClientCredentialsSuccessResponse is the success response envelope for POST /admin/machine-clients (201).
+type MachineClientCredentialsSuccessResponse struct {
+	Data  domain.MachineClientCredentials `json:"data"`
+	Error *helpers.APIError               `json:"error"`
+}
+
+// ListMachineClientsSuccessResponse is the success response envelope for GET /admin/machine-clients (200).
+type ListMachineClientsSuccessResponse struct {
+	Data  []domain.MachineClient `json:"data"`
+	Error *helpers.APIError      `json:"error"`
+}
+
+// IssueMachineToken godoc
+// @Summary Get a machine access token
+// @ID IssueMachineToken
+// @Description Exchanges a machine client's ID and secret for an access token carrying the client's scopes, valid for 15 minutes. Send it as "Authorization: Bearer" to the /machine routes; it is not accepted anywhere a user's token is, nor the other way round.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param body body controllers.MachineTokenRequest true "Client credentials"
+// @Success 200 {object} controllers.MachineTokenSuccessResponse "data is the access token"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: invalid_client (unknown client, wrong secret, or revoked)"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /auth/machine-token [post]
+func (c *MachineClientController) IssueMachineToken(w http.ResponseWriter, r *http.Request) {
+	var req MachineTokenRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	if !uuidRegex.MatchString(req.ClientID) {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeInvalidClient, "invalid client credentials")
+		return
+	}
+	token, err := c.Service.IssueMachineToken(r.Context(), req.ClientID, req.ClientSecret)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidClient) {
+			middleware.LogSecurityEvent(c.Logger, r, "machine token refused", middleware.SecurityEvent{
+				Type: middleware.SecurityMachineToken, Outcome: middleware.SecurityOutcomeFailure, ClientID: req.ClientID,
+			})
+		}
+		c.writeMachineClientError(w, r, err)
+		return
+	}
+	middleware.LogSecurityEvent(c.Logger, r, "machine token issued", middleware.SecurityEvent{
+		Type: middleware.SecurityMachineToken, Outcome: middleware.SecurityOutcomeSuccess, ClientID: req.ClientID,
+	})
+	helpers.WriteJSONSuccess(w, http.StatusOK, token)
+}
+
+// ListMachineClients godoc
+// @Summary List machine clients
+// @ID ListMachineClients
+// @Description Returns every machine client, newest first, revoked ones included. Secrets are never returned. Only platform admins can list. Requires authentication.
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} controllers.ListMachineClientsSuccessResponse "data is the clients"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not admin) or ip_not_allowed"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /admin/machine-clients [get]
+func (c *MachineClientController) ListMachineClients(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	clients, err := c.Service.ListMachineClients(r.Context(), adminID)
+	if err != nil {
+		c.writeMachineClientError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, clients)
+}
+
+// CreateMachineClient godoc
+// @Summary Create a machine client
+// @ID CreateMachineClient
+// @Description Registers an internal service (badge printer, signage) bound to one event with the given scopes. A client with the sandbox:write scope takes no event and no other scope; it creates sandbox events owned by the admin creating it. The response holds the client secret; it is shown only this once. Only platform admins can create. Requires authentication.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body controllers.CreateMachineClientRequest true "Client"
+// @Success 201 {object} controllers.MachineClientCredentialsSuccessResponse "data is the client with its secret"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request (e.g. unknown scope)"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not admin) or ip_not_allowed"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /admin/machine-clients [post]
+func (c *MachineClientController) CreateMachineClient(w http.ResponseWriter, r *http.Request) {
+	var req CreateMachineClientRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	adminID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	creds, err := c.Service.CreateMachineClient(r.Context(), adminID, req.Name, req.EventID, req.Scopes)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		c.writeMachineClientError(w, r, err)
+		return
+	}
+	middleware.LogSecurityEvent(c.Logger, r, "machine client created", middleware.SecurityEvent{
+		Type: middleware.SecurityMachineClientCreate, Outcome: middleware.SecurityOutcomeSuccess, UserID: adminID,
+		EventID: creds.EventID, TargetType: "machine_client", TargetID: creds.ID,
+		Details: []slog.Attr{slog.Any("scopes", creds.Scopes)},
+	})
+	helpers.WriteJSONSuccess(w, http.StatusCreated, creds)
+}
+
+// RevokeMachineClient godoc
+// @Summary Revoke a machine client
+// @ID RevokeMachineClient
+// @Description Stops the client from getting new tokens; tokens it already has stay valid until they expire, within 15 minutes. The client is kept for the record. Only platform admins can revoke. Requires authentication.
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Param clientID path string true "Client ID (UUID)"
+// @Success 200 {object} controllers.MachineClientSuccessResponse "data is the revoked client"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not admin) or ip_not_allowed"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /admin/machine-clients/{clientID}/revoke [post]
+func (c *MachineClientController) RevokeMachineClient(w http.ResponseWriter, r *http.Request) {
+	clientID := r.PathValue("clientID")
+	if !uuidRegex.MatchString(clientID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid clientID")
+		return
+	}
+	adminID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	client, err := c.Service.RevokeMachineClient(r.Context(), adminID, clientID)
+	if err != nil {
+		c.writeMachineClientError(w, r, err)
+		return
+	}
+	middleware.LogSecurityEvent(c.Logger, r, "machine client revoked", middleware.SecurityEvent{
+		Type: middleware.SecurityMachineClientRevoke, Outcome: middleware.SecurityOutcomeSuccess, UserID: adminID,
+		TargetType: "machine_client", TargetID: client.ID,
+	})
+	helpers.WriteJSONSuccess(w, http.StatusOK, client)
+}
+
+// CreateSandboxEvent godoc
+// @Summary Create a sandbox event
+// @ID CreateSandboxEvent
+// @Description Creates a disposable event for an integrator to test against, owned by the admin who created the machine client. Sandbox events work like any other event, except that their emails are archived as skipped instead of sent, they are left out of the business metrics, and they are deleted with everything in them RETENTION_SANDBOX_EVENTS_DAYS (default 7) after creation. Requires a machine access token (POST /auth/machine-token) with the sandbox:write scope.
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body controllers.CreateSandboxEventRequest true "Event"
+// @Success 201 {object} controllers.CreateEventSuccessResponse "data is the sandbox event, with its code"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized, or invalid_client (client revoked)"
+// @Failure 403 {object} helpers.APIResponse "error.code: insufficient_scope"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /machine/sandbox-events [post]
+func (c *MachineClientController) CreateSandboxEvent(w http.ResponseWriter, r *http.Request) {
+	var req CreateSandboxEventRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	client, ok := middleware.MachineFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+	event, err := c.Service.CreateSandboxEvent(r.Context(), client.ClientID, req.Name)
+	if err != nil {
+		c.writeMachineClientError(w, r, err)
+		return
+	}
+	c.Logger.InfoContext(r.Context(), "sandbox event created", "audit", "event.sandbox_create", "client_id", client.ClientID, "event_id", event.ID)
+	// The event's links are user routes a machine token cannot open, so none are offered.
+	helpers.WriteJSONSuccess(w, http.StatusCreated, event)
+}
+
+func (c *MachineClientController) writeMachineClientError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, domain.ErrInvalidClient) {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeInvalidClient, "invalid client credentials")
+		return
+	}
+	if errors.Is(err, domain.ErrNotFound) {
+		helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "machine client not found")
+		return
+	}
+	if errors.Is(err, domain.ErrForbidden) {
+		helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+		return
+	}
+	if errors.Is(err, domain.ErrInvalidInput) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
+		return
+	}
+	helpers.WriteServiceError(w, r, c.Logger, err)
+}
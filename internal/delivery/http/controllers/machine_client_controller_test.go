@@ -0,0 +1,156 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+type mockMachineClientService struct {
+	err        error
+	lastScopes []string
+}
+
+func (m *mockMachineClientService) CreateMachineClient(ctx context.Context, adminID, name, eventID string, scopes []string) (*domain.MachineClientCredentials, error) {
+	m.lastScopes = scopes
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.MachineClientCredentials{
+		MachineClient: domain.MachineClient{ID: "client-1", Name: name, EventID: eventID, Scopes: scopes},
+		ClientSecret:  "secret",
+	}, nil
+}
+
+func (m *mockMachineClientService) ListMachineClients(ctx context.Context, adminID string) ([]*domain.MachineClient, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return []*domain.MachineClient{}, nil
+}
+
+func (m *mockMachineClientService) RevokeMachineClient(ctx context.Context, adminID, clientID string) (*domain.MachineClient, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.MachineClient{ID: clientID}, nil
+}
+
+func (m *mockMachineClientService) IssueMachineToken(ctx context.Context, clientID, secret string) (*domain.MachineToken, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.MachineToken{AccessToken: "token", TokenType: "Bearer", ExpiresIn: 900, Scope: domain.ScopeScheduleRead}, nil
+}
+
+func (m *mockMachineClientService) CreateSandboxEvent(ctx context.Context, clientID, name string) (*domain.Event, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.Event{ID: "event-1", Name: name, OwnerID: "admin-1", Sandbox: true}, nil
+}
+
+func TestMachineClientController_IssueMachineToken(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	const clientID = "00000000-0000-4000-8000-000000000001"
+
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantStatus int
+	}{
+		{name: "issued", body: `{"client_id":"` + clientID + `","client_secret":"s"}`, wantStatus: http.StatusOK},
+		{name: "missing secret", body: `{"client_id":"` + clientID + `"}`, wantStatus: http.StatusBadRequest},
+		{name: "client id not a uuid", body: `{"client_id":"printer","client_secret":"s"}`, wantStatus: http.StatusUnauthorized},
+		{name: "wrong secret", body: `{"client_id":"` + clientID + `","client_secret":"s"}`, err: domain.ErrInvalidClient, wantStatus: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewMachineClientController(logger, &mockMachineClientService{err: tt.err})
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/machine-token", bytes.NewBufferString(tt.body))
+			w := httptest.NewRecorder()
+			ctrl.IssueMachineToken(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestMachineClientController_CreateMachineClient(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	const body = `{"name":"Signage","event_id":"00000000-0000-4000-8000-000000000001","scopes":["schedule:read"]}`
+
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantStatus int
+	}{
+		{name: "created", body: body, wantStatus: http.StatusCreated},
+		{name: "no scopes", body: `{"name":"Signage","event_id":"00000000-0000-4000-8000-000000000001","scopes":[]}`, wantStatus: http.StatusBadRequest},
+		{name: "no event", body: `{"name":"Signage","scopes":["schedule:read"]}`, wantStatus: http.StatusBadRequest},
+		{name: "sandbox client with an event", body: `{"name":"CI","event_id":"00000000-0000-4000-8000-000000000001","scopes":["sandbox:write"]}`, wantStatus: http.StatusBadRequest},
+		{name: "not an admin", body: body, err: domain.ErrForbidden, wantStatus: http.StatusForbidden},
+		{name: "unknown event", body: body, err: domain.ErrNotFound, wantStatus: http.StatusNotFound},
+		{name: "unknown scope", body: body, err: domain.ErrInvalidInput, wantStatus: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &mockMachineClientService{err: tt.err}
+			ctrl := NewMachineClientController(logger, svc)
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/machine-clients", bytes.NewBufferString(tt.body))
+			req = req.WithContext(middleware.SetUserID(req.Context(), "admin-1"))
+			w := httptest.NewRecorder()
+			ctrl.CreateMachineClient(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if tt.wantStatus == http.StatusCreated && (len(svc.lastScopes) != 1 || svc.lastScopes[0] != domain.ScopeScheduleRead) {
+				t.Fatalf("unexpected scopes: %v", svc.lastScopes)
+			}
+		})
+	}
+}
+
+func TestMachineClientController_CreateSandboxEvent(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	client := &domain.MachinePrincipal{ClientID: "client-1", Scopes: []string{domain.ScopeSandboxWrite}}
+
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantStatus int
+	}{
+		{name: "created", body: `{"name":"Test conf"}`, wantStatus: http.StatusCreated},
+		{name: "no name", body: `{"name":" "}`, wantStatus: http.StatusBadRequest},
+		{name: "revoked client", body: `{"name":"Test conf"}`, err: domain.ErrInvalidClient, wantStatus: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewMachineClientController(logger, &mockMachineClientService{err: tt.err})
+
+			req := httptest.NewRequest(http.MethodPost, "/machine/sandbox-events", bytes.NewBufferString(tt.body))
+			req = req.WithContext(middleware.SetMachinePrincipal(req.Context(), client))
+			w := httptest.NewRecorder()
+			ctrl.CreateSandboxEvent(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+		})
+	}
+}
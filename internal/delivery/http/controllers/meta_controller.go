@@ -0,0 +1,95 @@
+package controllers
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/domain"
+)
+
+// readinessTimeout bounds all dependency checks of one GET /readyz call.
+const readinessTimeout = 2 * time.Second
+
+// MetaController serves API metadata that clients use to integrate with the API, and the
+// readiness probe built from Checks.
+type MetaController struct {
+	Logger *slog.Logger
+	Checks []domain.ReadinessChecker
+}
+
+func NewMetaController(logger *slog.Logger, checks ...domain.ReadinessChecker) *MetaController {
+	return &MetaController{
+		Logger: logger,
+		Checks: checks,
+	}
+}
+
+// ListErrorCodesSuccessResponse is the success response envelope for GET /meta/error-codes (200).
+type ListErrorCodesSuccessResponse struct {
+	Data  []helpers.ErrorCodeInfo `json:"data"`
+	Error *helpers.APIError       `json:"error"`
+}
+
+// ListErrorCodes godoc
+// @Summary List error codes
+// @ID ListErrorCodes
+// @Description Returns the catalog of machine-readable error codes the API can return in error.code, with the HTTP status each is sent with and a short description.
+// @Tags meta
+// @Produce json
+// @Success 200 {object} controllers.ListErrorCodesSuccessResponse "data is an array of error code entries"
+// @Router /meta/error-codes [get]
+func (c *MetaController) ListErrorCodes(w http.ResponseWriter, r *http.Request) {
+	helpers.WriteJSONSuccess(w, http.StatusOK, helpers.ErrorCatalog())
+}
+
+// ReadyzResponse is the data payload for GET /readyz (200).
+type ReadyzResponse struct {
+	// Status is "ready", or "degraded" when a non-critical dependency is failing.
+	Status       string                    `json:"status"`
+	Dependencies []domain.DependencyHealth `json:"dependencies"`
+}
+
+// ReadyzSuccessResponse is the success response envelope for GET /readyz (200).
+type ReadyzSuccessResponse struct {
+	Data  ReadyzResponse    `json:"data"`
+	Error *helpers.APIError `json:"error"`
+}
+
+// Readyz godoc
+// @Summary Readiness probe
+// @ID Readyz
+// @Description Checks the database and external providers. Returns 200 while every critical dependency is up (status "degraded" if a non-critical one such as Sessionize is failing) and 503 when a critical one is down.
+// @Tags meta
+// @Produce json
+// @Success 200 {object} controllers.ReadyzSuccessResponse "data contains overall status and per-dependency health"
+// @Failure 503 {object} helpers.APIResponse "error.code: not_ready"
+// @Router /readyz [get]
+func (c *MetaController) Readyz(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), readinessTimeout)
+	defer cancel()
+
+	resp := ReadyzResponse{Status: "ready", Dependencies: make([]domain.DependencyHealth, 0, len(c.Checks))}
+	var down []string // critical dependency names; details are logged, not exposed on this public route
+	for _, check := range c.Checks {
+		h := check.CheckReadiness(ctx)
+		resp.Dependencies = append(resp.Dependencies, h)
+		if h.Status == domain.DependencyUp {
+			continue
+		}
+		if h.Critical && h.Status == domain.DependencyDown {
+			c.Logger.WarnContext(r.Context(), "dependency down", "dependency", h.Name, "detail", h.Detail)
+			down = append(down, h.Name)
+			continue
+		}
+		resp.Status = "degraded"
+	}
+	if len(down) > 0 {
+		helpers.WriteJSONError(w, http.StatusServiceUnavailable, helpers.ErrCodeNotReady, "not ready: "+strings.Join(down, ", ")+" down")
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, resp)
+}
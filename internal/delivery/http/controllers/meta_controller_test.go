@@ -0,0 +1,98 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetaController_ListErrorCodes(t *testing.T) {
+	ctrl := NewMetaController(testLogger)
+	req := httptest.NewRequest(http.MethodGet, "http://test/meta/error-codes", nil)
+	rr := httptest.NewRecorder()
+
+	ctrl.ListErrorCodes(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var envelope ListErrorCodesSuccessResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&envelope))
+	require.Nil(t, envelope.Error)
+
+	byCode := make(map[string]helpers.ErrorCodeInfo, len(envelope.Data))
+	for _, info := range envelope.Data {
+		byCode[info.Code] = info
+	}
+	for _, tc := range []struct {
+		code   string
+		status int
+	}{
+		{helpers.ErrCodeBadRequest, http.StatusBadRequest},
+		{helpers.ErrCodeEventNotFound, http.StatusNotFound},
+		{helpers.ErrCodeUserNotFound, http.StatusNotFound},
+		{helpers.ErrCodeAlreadyMember, http.StatusConflict},
+		{helpers.ErrCodeDuplicateEmail, http.StatusConflict},
+		{helpers.ErrCodeInternalError, http.StatusInternalServerError},
+	} {
+		info, ok := byCode[tc.code]
+		if assert.True(t, ok, "missing code %q", tc.code) {
+			assert.Equal(t, tc.status, info.Status)
+			assert.NotEmpty(t, info.Description)
+		}
+	}
+}
+
+type stubReadinessChecker domain.DependencyHealth
+
+func (s stubReadinessChecker) CheckReadiness(ctx context.Context) domain.DependencyHealth {
+	return domain.DependencyHealth(s)
+}
+
+func TestMetaController_Readyz(t *testing.T) {
+	db := stubReadinessChecker{Name: "database", Status: domain.DependencyUp, Critical: true}
+	dbDown := stubReadinessChecker{Name: "database", Status: domain.DependencyDown, Critical: true, Detail: "dial tcp 10.0.0.5:5432: connection refused"}
+	provider := stubReadinessChecker{Name: "sessionize", Status: domain.DependencyUp}
+	providerDown := stubReadinessChecker{Name: "sessionize", Status: domain.DependencyDown, Detail: "circuit open"}
+
+	tests := []struct {
+		name           string
+		checks         []domain.ReadinessChecker
+		wantStatus     int
+		wantReady      string
+		wantBodySubstr string
+	}{
+		{name: "no checks", wantStatus: http.StatusOK, wantReady: "ready"},
+		{name: "all up", checks: []domain.ReadinessChecker{db, provider}, wantStatus: http.StatusOK, wantReady: "ready"},
+		{name: "provider down is degraded", checks: []domain.ReadinessChecker{db, providerDown}, wantStatus: http.StatusOK, wantReady: "degraded"},
+		{name: "database down", checks: []domain.ReadinessChecker{dbDown, provider}, wantStatus: http.StatusServiceUnavailable, wantBodySubstr: "not ready: database down"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewMetaController(testLogger, tt.checks...)
+			rr := httptest.NewRecorder()
+
+			ctrl.Readyz(rr, httptest.NewRequest(http.MethodGet, "http://test/readyz", nil))
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+			if tt.wantStatus != http.StatusOK {
+				var envelope helpers.APIResponse
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(&envelope))
+				require.NotNil(t, envelope.Error)
+				assert.Equal(t, helpers.ErrCodeNotReady, envelope.Error.Code)
+				assert.Equal(t, tt.wantBodySubstr, envelope.Error.Message, "details stay in the logs")
+				return
+			}
+			var envelope ReadyzSuccessResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&envelope))
+			assert.Equal(t, tt.wantReady, envelope.Data.Status)
+			assert.Len(t, envelope.Data.Dependencies, len(tt.checks))
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+// RoomOccupancyController takes room occupancy counts from an event's door sensors and clickers.
+type RoomOccupancyController struct {
+	Logger  *slog.Logger
+	Service domain.RoomOccupancyService
+}
+
+func NewRoomOccupancyController(logger *slog.Logger, svc domain.RoomOccupancyService) *RoomOccupancyController {
+	return &RoomOccupancyController{
+		Logger:  logger,
+		Service: svc,
+	}
+}
+
+// UpdateRoomOccupancyRequest is the request body for POST /machine/events/{eventID}/rooms/{roomID}/occupancy.
+// Send delta for people entering (1) or leaving (-1), or count to set the number in the room.
+type UpdateRoomOccupancyRequest struct {
+	Delta *int `json:"delta"`
+	Count *int `json:"count"`
+}
+
+// Validate implements Validator.
+func (c UpdateRoomOccupancyRequest) Validate() []string {
+	if (c.Delta == nil) == (c.Count == nil) {
+		return []string{"give delta or count"}
+	}
+	return nil
+}
+
+// RoomOccupancySuccessResponse is the success response envelope for POST /machine/events/{eventID}/rooms/{roomID}/occupancy (200).
+type RoomOccupancySuccessResponse struct {
+	Data  domain.RoomOccupancy `json:"data"`
+	Error *helpers.APIError    `json:"error"`
+}
+
+// UpdateRoomOccupancy godoc
+// @Summary Report a room's occupancy
+// @ID UpdateRoomOccupancy
+// @Description Records people entering or leaving a room, for door sensors and manual clickers: delta adds to the count (1 in, -1 out; the count never goes below 0) and count sets it, e.g. after a head count. When a room with a capacity fills to 90% of it, the event owner is emailed once; they are alerted again only after it has emptied below 75%. The count shows in the rooms of the event's schedule. Requires a machine access token (POST /auth/machine-token) with the occupancy:write scope, issued to a client of this event.
+// @Tags attendee
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param roomID path string true "Room ID (UUID)"
+// @Param body body controllers.UpdateRoomOccupancyRequest true "delta or count"
+// @Success 200 {object} controllers.RoomOccupancySuccessResponse "data is the room's occupancy"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: insufficient_scope, or forbidden (client of another event)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /machine/events/{eventID}/rooms/{roomID}/occupancy [post]
+func (c *RoomOccupancyController) UpdateRoomOccupancy(w http.ResponseWriter, r *http.Request) {
+	eventID, roomID := r.PathValue("eventID"), r.PathValue("roomID")
+	if !uuidRegex.MatchString(eventID) || !uuidRegex.MatchString(roomID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID or roomID")
+		return
+	}
+	var req UpdateRoomOccupancyRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	client, ok := middleware.MachineFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+
+	delta := 0
+	if req.Delta != nil {
+		delta = *req.Delta
+	}
+	occupancy, err := c.Service.UpdateOccupancy(r.Context(), client, eventID, roomID, delta, req.Count)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "room not found")
+			return
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+			return
+		}
+		if errors.Is(err, domain.ErrInvalidInput) {
+			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, occupancy)
+}
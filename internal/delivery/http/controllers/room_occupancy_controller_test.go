@@ -0,0 +1,79 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+type mockRoomOccupancyService struct {
+	err   error
+	delta int
+	count *int
+}
+
+func (m *mockRoomOccupancyService) UpdateOccupancy(ctx context.Context, client *domain.MachinePrincipal, eventID, roomID string, delta int, count *int) (*domain.RoomOccupancy, error) {
+	m.delta, m.count = delta, count
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.RoomOccupancy{RoomID: roomID, Count: 1}, nil
+}
+
+func TestRoomOccupancyController_UpdateRoomOccupancy(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	const path = "/machine/events/11111111-1111-1111-1111-111111111111/rooms/22222222-2222-2222-2222-222222222222/occupancy"
+
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantStatus int
+		wantDelta  int
+		wantCount  int
+	}{
+		{name: "in", body: `{"delta":1}`, wantStatus: http.StatusOK, wantDelta: 1, wantCount: -1},
+		{name: "out", body: `{"delta":-1}`, wantStatus: http.StatusOK, wantDelta: -1, wantCount: -1},
+		{name: "absolute", body: `{"count":42}`, wantStatus: http.StatusOK, wantCount: 42},
+		{name: "neither", body: `{}`, wantStatus: http.StatusBadRequest, wantCount: -1},
+		{name: "both", body: `{"delta":1,"count":42}`, wantStatus: http.StatusBadRequest, wantCount: -1},
+		{name: "out of range", body: `{"delta":5000}`, err: domain.ErrInvalidInput, wantStatus: http.StatusBadRequest, wantDelta: 5000, wantCount: -1},
+		{name: "client of another event", body: `{"delta":1}`, err: domain.ErrForbidden, wantStatus: http.StatusForbidden, wantDelta: 1, wantCount: -1},
+		{name: "unknown room", body: `{"delta":1}`, err: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantDelta: 1, wantCount: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &mockRoomOccupancyService{err: tt.err}
+			ctrl := NewRoomOccupancyController(logger, svc)
+			mux := http.NewServeMux()
+			mux.HandleFunc("POST /machine/events/{eventID}/rooms/{roomID}/occupancy", ctrl.UpdateRoomOccupancy)
+
+			req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req = req.WithContext(middleware.SetMachinePrincipal(req.Context(), &domain.MachinePrincipal{ClientID: "clicker-1", EventID: "11111111-1111-1111-1111-111111111111"}))
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if svc.delta != tt.wantDelta {
+				t.Fatalf("expected delta %d, got %d", tt.wantDelta, svc.delta)
+			}
+			gotCount := -1 // no count sent
+			if svc.count != nil {
+				gotCount = *svc.count
+			}
+			if gotCount != tt.wantCount {
+				t.Fatalf("expected count %d, got %d", tt.wantCount, gotCount)
+			}
+		})
+	}
+}
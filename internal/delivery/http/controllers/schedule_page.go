@@ -0,0 +1,146 @@
+package controllers
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"embed"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"html/template"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/domain"
+)
+
+//go:embed templates/schedule.html
+var schedulePageFS embed.FS
+
+var schedulePageTemplate = template.Must(template.ParseFS(schedulePageFS, "templates/schedule.html"))
+
+// schedulePage is the data of templates/schedule.html.
+type schedulePage struct {
+	Event *domain.Event
+	Theme *domain.EventTheme
+	Days  []scheduleDay
+}
+
+// scheduleDay is one day of the page, its sessions in start time order.
+type scheduleDay struct {
+	Date  time.Time
+	Slots []scheduleSlot
+}
+
+type scheduleSlot struct {
+	Session  *domain.Session
+	Room     string
+	Speakers []string
+}
+
+// newSchedulePage lays the schedule out by day, in UTC, each day's sessions by start time and then
+// in room order.
+func newSchedulePage(ps *domain.PublicSchedule) *schedulePage {
+	speakers := make(map[string]string, len(ps.Schedule.Speakers))
+	for _, sp := range ps.Schedule.Speakers {
+		speakers[sp.ID] = strings.TrimSpace(sp.FirstName + " " + sp.LastName)
+	}
+	var slots []scheduleSlot
+	for _, room := range ps.Schedule.Rooms {
+		for _, sess := range room.Sessions {
+			s := *sess
+			s.StartTime, s.EndTime = s.StartTime.UTC(), s.EndTime.UTC()
+			slot := scheduleSlot{Session: &s, Room: room.Room.Name}
+			for _, id := range sess.SpeakerIDs {
+				if name, ok := speakers[id]; ok {
+					slot.Speakers = append(slot.Speakers, name)
+				}
+			}
+			slots = append(slots, slot)
+		}
+	}
+	// The stable sort keeps room order among sessions starting together.
+	slices.SortStableFunc(slots, func(a, b scheduleSlot) int { return a.Session.StartTime.Compare(b.Session.StartTime) })
+
+	page := &schedulePage{Event: ps.Schedule.Event, Theme: ps.Theme}
+	for _, slot := range slots {
+		y, m, d := slot.Session.StartTime.Date()
+		date := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+		if n := len(page.Days); n == 0 || !page.Days[n-1].Date.Equal(date) {
+			page.Days = append(page.Days, scheduleDay{Date: date})
+		}
+		day := &page.Days[len(page.Days)-1]
+		day.Slots = append(day.Slots, slot)
+	}
+	return page
+}
+
+// renderSchedulePage returns the page and the Content-Security-Policy that lets it load its own
+// inline style and nothing else.
+func renderSchedulePage(ps *domain.PublicSchedule) ([]byte, string, error) {
+	var buf bytes.Buffer
+	if err := schedulePageTemplate.Execute(&buf, newSchedulePage(ps)); err != nil {
+		return nil, "", err
+	}
+	body := buf.Bytes()
+	_, rest, _ := bytes.Cut(body, []byte("<style>"))
+	style, _, _ := bytes.Cut(rest, []byte("</style>"))
+	sum := sha256.Sum256(style)
+	csp := "default-src 'none'; style-src 'sha256-" + base64.StdEncoding.EncodeToString(sum[:]) + "'; frame-ancestors 'none'"
+	return body, csp, nil
+}
+
+// GetPublicSchedulePage godoc
+// @Summary Get the printable schedule page of an event
+// @ID GetPublicSchedulePage
+// @Description Returns a minimal HTML page of the event's schedule, for organizers to share before they have a site of their own: the listed sessions by day and start time, with their room and speakers, in the event's theme colors and fonts. It prints cleanly. Times are in UTC. The ETag covers the page; send it as If-None-Match to get 304 when nothing changed. Responses carry Surrogate-Key event-{eventID}, which is purged from the CDN whenever the event changes. No login is needed; the event_code identifies the event. Errors are the usual JSON envelope.
+// @Tags attendee
+// @Produce html
+// @Param eventCode path string true "Event code (4 lowercase letters or digits)"
+// @Param If-None-Match header string false "ETag of the page the client already has"
+// @Success 200 {string} string "HTML page"
+// @Success 304 "the client's page is current"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /public/events/{eventCode}/schedule.html [get]
+func (c *AttendeeController) GetPublicSchedulePage(w http.ResponseWriter, r *http.Request) {
+	eventCode := strings.ToLower(r.PathValue("eventCode"))
+	if !eventCodeRegex.MatchString(eventCode) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventCode")
+		return
+	}
+	schedule, err := c.Service.GetPublicSchedule(r.Context(), eventCode)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	body, csp, err := renderSchedulePage(schedule)
+	if err != nil {
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Surrogate-Key", domain.EventSurrogateKey(schedule.EventID))
+	w.Header().Set("Surrogate-Control", "max-age=86400")
+	if strings.TrimPrefix(r.Header.Get("If-None-Match"), "W/") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Security-Policy", csp)
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
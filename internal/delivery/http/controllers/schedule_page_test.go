@@ -0,0 +1,118 @@
+package controllers
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPublicSchedule() *domain.PublicSchedule {
+	day1 := time.Date(2026, 5, 14, 9, 0, 0, 0, time.UTC)
+	day2 := time.Date(2026, 5, 15, 9, 0, 0, 0, time.UTC)
+	return &domain.PublicSchedule{
+		EventID: "ev-1",
+		Schedule: &domain.OfflineSchedule{
+			Event: &domain.Event{ID: "ev-1", Name: "GopherConf", EventCode: "ab12"},
+			Rooms: []*domain.RoomWithSessions{
+				{Room: &domain.Room{ID: "r1", Name: "Main Hall"}, Sessions: []*domain.Session{
+					{ID: "s2", Title: "Closing", StartTime: day2, EndTime: day2.Add(time.Hour)},
+					{ID: "s1", Title: "Keynote <b>live</b>", StartTime: day1, EndTime: day1.Add(time.Hour), SpeakerIDs: []string{"sp1", "sp2"}},
+				}},
+				{Room: &domain.Room{ID: "r2", Name: "Workshop"}, Sessions: []*domain.Session{
+					{ID: "s3", Title: "Hands-on", StartTime: day1.Add(-time.Hour), EndTime: day1},
+				}},
+			},
+			Speakers: []*domain.OfflineBundleSpeaker{{ID: "sp1", FirstName: "Ada", LastName: "Lovelace"}, {ID: "sp2", FirstName: "Alan", LastName: "Turing"}},
+		},
+		Theme: &domain.EventTheme{EventID: "ev-1", PrimaryColor: "#112233", FontFamily: "Inter", FooterText: "See you there"},
+	}
+}
+
+func TestAttendeeController_GetPublicSchedulePage(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	ctrl := NewAttendeeController(logger, &mockAttendeeService{publicSchedule: testPublicSchedule()})
+	req := httptest.NewRequest(http.MethodGet, "/public/events/AB12/schedule.html", nil)
+	req.SetPathValue("eventCode", "AB12")
+	w := httptest.NewRecorder()
+
+	ctrl.GetPublicSchedulePage(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(t, domain.EventSurrogateKey("ev-1"), w.Header().Get("Surrogate-Key"))
+	page := w.Body.String()
+
+	assert.Contains(t, page, "<h1>GopherConf</h1>")
+	assert.Contains(t, page, "Keynote &lt;b&gt;live&lt;/b&gt;", "titles are escaped")
+	assert.Contains(t, page, "Ada Lovelace, Alan Turing")
+	assert.Contains(t, page, "See you there")
+	assert.Contains(t, page, "color: #112233")
+	// Days in order, and within a day sessions by start time across rooms.
+	assert.Less(t, strings.Index(page, "Thursday, 14 May 2026"), strings.Index(page, "Hands-on"))
+	assert.Less(t, strings.Index(page, "Hands-on"), strings.Index(page, "Keynote"))
+	assert.Less(t, strings.Index(page, "Keynote"), strings.Index(page, "Friday, 15 May 2026"))
+	assert.Less(t, strings.Index(page, "Friday, 15 May 2026"), strings.Index(page, "Closing"))
+
+	// The policy allows exactly the page's inline style.
+	_, rest, _ := strings.Cut(page, "<style>")
+	style, _, _ := strings.Cut(rest, "</style>")
+	sum := sha256.Sum256([]byte(style))
+	assert.Contains(t, w.Header().Get("Content-Security-Policy"), "style-src 'sha256-"+base64.StdEncoding.EncodeToString(sum[:])+"'")
+	assert.Contains(t, w.Header().Get("Content-Security-Policy"), "default-src 'none'")
+
+	etag := w.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+	req = httptest.NewRequest(http.MethodGet, "/public/events/ab12/schedule.html", nil)
+	req.SetPathValue("eventCode", "ab12")
+	req.Header.Set("If-None-Match", etag)
+	w = httptest.NewRecorder()
+	ctrl.GetPublicSchedulePage(w, req)
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Empty(t, w.Body.String())
+}
+
+func TestAttendeeController_GetPublicSchedulePage_Errors(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	tests := []struct {
+		name       string
+		code       string
+		svc        *mockAttendeeService
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "invalid code", code: "ab-1", svc: &mockAttendeeService{}, wantStatus: http.StatusBadRequest, wantCode: helpers.ErrCodeBadRequest},
+		{name: "event not found", code: "zz99", svc: &mockAttendeeService{publicScheduleErr: domain.ErrNotFound}, wantStatus: http.StatusNotFound, wantCode: helpers.ErrCodeEventNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/public/events/"+tt.code+"/schedule.html", nil)
+			req.SetPathValue("eventCode", tt.code)
+			w := httptest.NewRecorder()
+
+			NewAttendeeController(logger, tt.svc).GetPublicSchedulePage(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Contains(t, w.Body.String(), `"code":"`+tt.wantCode+`"`)
+		})
+	}
+}
+
+func TestNewSchedulePage_Empty(t *testing.T) {
+	ps := testPublicSchedule()
+	ps.Schedule.Rooms = nil
+	body, _, err := renderSchedulePage(ps)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "No sessions are scheduled yet.")
+}
@@ -0,0 +1,275 @@
+package controllers
+
+import (
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/domain"
+)
+
+// PageMetadata is what a public page puts in its head for search engines.
+type PageMetadata struct {
+	ID string `json:"id"`
+	// CanonicalURL is the page's preferred URL; empty when the page is not served anywhere.
+	CanonicalURL string `json:"canonical_url"`
+	Title        string `json:"title"`
+	Description  string `json:"description"`
+	// JSONLD is the page's schema.org structured data, for a <script type="application/ld+json">.
+	JSONLD map[string]any `json:"json_ld" swaggertype:"object"`
+}
+
+// EventMetadata is the metadata of an event's page and of the pages of its listed sessions and
+// speakers.
+type EventMetadata struct {
+	Event    *PageMetadata   `json:"event"`
+	Sessions []*PageMetadata `json:"sessions"`
+	Speakers []*PageMetadata `json:"speakers"`
+}
+
+// EventMetadataSuccessResponse is the success response envelope for GET /public/events/{eventCode}/metadata (200).
+type EventMetadataSuccessResponse struct {
+	Data  *EventMetadata    `json:"data"`
+	Error *helpers.APIError `json:"error"`
+}
+
+// eventPageURL returns the canonical URL of the event's page: on the public site when there is one,
+// else the server's schedule page.
+func (c *AttendeeController) eventPageURL(eventCode string) string {
+	if c.PublicSiteURL != "" {
+		return c.PublicSiteURL + "/events/" + eventCode
+	}
+	return strings.TrimRight(c.PublicBaseURL, "/") + "/public/events/" + eventCode + "/schedule.html"
+}
+
+// sessionPageURL returns the canonical URL of the session's page, or "" without a public site.
+func (c *AttendeeController) sessionPageURL(eventCode, sessionID string) string {
+	if c.PublicSiteURL == "" {
+		return ""
+	}
+	return c.PublicSiteURL + "/events/" + eventCode + "/sessions/" + sessionID
+}
+
+// speakerPageURL returns the canonical URL of the speaker's page, or "" without a public site.
+func (c *AttendeeController) speakerPageURL(eventCode, speakerID string) string {
+	if c.PublicSiteURL == "" {
+		return ""
+	}
+	return c.PublicSiteURL + "/events/" + eventCode + "/speakers/" + speakerID
+}
+
+// jsonLD is a schema.org object; set leaves out empty values.
+type jsonLD map[string]any
+
+func (ld jsonLD) set(key string, value any) {
+	switch v := value.(type) {
+	case string:
+		if v == "" {
+			return
+		}
+	case time.Time:
+		if v.IsZero() {
+			return
+		}
+		value = v.UTC().Format(time.RFC3339)
+	case []any:
+		if len(v) == 0 {
+			return
+		}
+	}
+	ld[key] = value
+}
+
+// newEventMetadata describes the event's page and the pages of its sessions and speakers. The
+// event's dates span its sessions, or are its date when it has none.
+func (c *AttendeeController) newEventMetadata(ps *domain.PublicSchedule) *EventMetadata {
+	event := ps.Schedule.Event
+	description := ""
+	if event.Description != nil {
+		description = *event.Description
+	}
+	eventURL := c.eventPageURL(event.EventCode)
+	superEvent := jsonLD{"@type": "Event", "name": event.Name}
+	superEvent.set("url", eventURL)
+
+	speakers := make(map[string]*domain.OfflineBundleSpeaker, len(ps.Schedule.Speakers))
+	performsIn := make(map[string][]any, len(ps.Schedule.Speakers))
+	for _, sp := range ps.Schedule.Speakers {
+		speakers[sp.ID] = sp
+	}
+	meta := &EventMetadata{Sessions: []*PageMetadata{}, Speakers: []*PageMetadata{}}
+	var start, end time.Time
+	var subEvents []any
+	for _, room := range ps.Schedule.Rooms {
+		for _, sess := range room.Sessions {
+			if start.IsZero() || sess.StartTime.Before(start) {
+				start = sess.StartTime
+			}
+			if sess.EndTime.After(end) {
+				end = sess.EndTime
+			}
+			url := c.sessionPageURL(event.EventCode, sess.ID)
+			ref := jsonLD{"@type": "Event", "name": sess.Title}
+			ref.set("url", url)
+			ref.set("startDate", sess.StartTime)
+			subEvents = append(subEvents, ref)
+
+			var performers []any
+			for _, id := range sess.SpeakerIDs {
+				if sp, ok := speakers[id]; ok {
+					performer := jsonLD{"@type": "Person", "name": speakerName(sp)}
+					performer.set("url", c.speakerPageURL(event.EventCode, sp.ID))
+					performers = append(performers, performer)
+					performsIn[id] = append(performsIn[id], ref)
+				}
+			}
+			ld := jsonLD{"@context": "https://schema.org", "@type": "Event", "name": sess.Title}
+			ld.set("description", sess.Description)
+			ld.set("url", url)
+			ld.set("startDate", sess.StartTime)
+			ld.set("endDate", sess.EndTime)
+			ld.set("eventAttendanceMode", "https://schema.org/OfflineEventAttendanceMode")
+			ld.set("location", jsonLD{"@type": "Place", "name": room.Room.Name})
+			ld.set("performer", performers)
+			ld.set("superEvent", superEvent)
+			meta.Sessions = append(meta.Sessions, &PageMetadata{
+				ID: sess.ID, CanonicalURL: url, Title: sess.Title, Description: sess.Description, JSONLD: ld,
+			})
+		}
+	}
+	if start.IsZero() && event.Date != nil {
+		start = *event.Date
+	}
+
+	ld := jsonLD{"@context": "https://schema.org", "@type": "Event", "name": event.Name}
+	ld.set("description", description)
+	ld.set("url", eventURL)
+	ld.set("startDate", start)
+	ld.set("endDate", end)
+	ld.set("eventAttendanceMode", "https://schema.org/OfflineEventAttendanceMode")
+	if event.LocationLat != nil && event.LocationLng != nil {
+		ld.set("location", jsonLD{"@type": "Place", "name": event.Name, "geo": jsonLD{
+			"@type": "GeoCoordinates", "latitude": *event.LocationLat, "longitude": *event.LocationLng,
+		}})
+	}
+	ld.set("subEvent", subEvents)
+	meta.Event = &PageMetadata{ID: event.ID, CanonicalURL: eventURL, Title: event.Name, Description: description, JSONLD: ld}
+
+	for _, sp := range ps.Schedule.Speakers {
+		url := c.speakerPageURL(event.EventCode, sp.ID)
+		ld := jsonLD{"@context": "https://schema.org", "@type": "Person", "name": speakerName(sp)}
+		ld.set("description", sp.Bio)
+		ld.set("jobTitle", sp.TagLine)
+		ld.set("url", url)
+		ld.set("performerIn", performsIn[sp.ID])
+		meta.Speakers = append(meta.Speakers, &PageMetadata{
+			ID: sp.ID, CanonicalURL: url, Title: speakerName(sp), Description: sp.TagLine, JSONLD: ld,
+		})
+	}
+	return meta
+}
+
+func speakerName(sp *domain.OfflineBundleSpeaker) string {
+	return strings.TrimSpace(sp.FirstName + " " + sp.LastName)
+}
+
+// GetPublicEventMetadata godoc
+// @Summary Get the SEO metadata of an event's public pages
+// @ID GetPublicEventMetadata
+// @Description Returns the canonical URL, title, description and schema.org JSON-LD of the event's page and of a page per listed session and speaker, for public pages to put in their heads. The event is a schema.org Event with the sessions as subEvent; sessions are Events with their speakers as performer; speakers are Persons. Canonical URLs are on the server's PUBLIC_SITE_URL; without one the event's URL is its schedule page and sessions and speakers have none. Times are in UTC. Responses carry Surrogate-Key event-{eventID}, which is purged from the CDN whenever the event changes. No login is needed; the event_code identifies the event.
+// @Tags attendee
+// @Produce json
+// @Param eventCode path string true "Event code (4 lowercase letters or digits)"
+// @Success 200 {object} controllers.EventMetadataSuccessResponse "data contains the metadata"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 404 {object} helpers.APIResponse "error.code: event_not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /public/events/{eventCode}/metadata [get]
+func (c *AttendeeController) GetPublicEventMetadata(w http.ResponseWriter, r *http.Request) {
+	eventCode := strings.ToLower(r.PathValue("eventCode"))
+	if !eventCodeRegex.MatchString(eventCode) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventCode")
+		return
+	}
+	schedule, err := c.Service.GetPublicSchedule(r.Context(), eventCode)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeEventNotFound, "event not found")
+			return
+		}
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	w.Header().Set("Surrogate-Key", domain.EventSurrogateKey(schedule.EventID))
+	w.Header().Set("Surrogate-Control", "max-age=86400")
+	helpers.WriteJSONSuccess(w, http.StatusOK, c.newEventMetadata(schedule))
+}
+
+// sitemapURLSet is a sitemap in the sitemaps.org 0.9 format.
+type sitemapURLSet struct {
+	XMLName xml.Name     `xml:"urlset"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	URLs    []sitemapURL `xml:"url"`
+}
+
+type sitemapURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// newSitemap lists the canonical URLs of the pages; pages without one are left out.
+func (c *AttendeeController) newSitemap(pages []*domain.PublicPage) *sitemapURLSet {
+	set := &sitemapURLSet{Xmlns: "http://www.sitemaps.org/schemas/sitemap/0.9", URLs: []sitemapURL{}}
+	for _, p := range pages {
+		var loc string
+		switch p.Kind {
+		case domain.PublicPageEvent:
+			loc = c.eventPageURL(p.EventCode)
+		case domain.PublicPageSession:
+			loc = c.sessionPageURL(p.EventCode, p.ID)
+		case domain.PublicPageSpeaker:
+			loc = c.speakerPageURL(p.EventCode, p.ID)
+		}
+		if loc == "" {
+			continue
+		}
+		u := sitemapURL{Loc: loc}
+		if !p.UpdatedAt.IsZero() {
+			u.LastMod = p.UpdatedAt.UTC().Format(time.RFC3339)
+		}
+		set.URLs = append(set.URLs, u)
+	}
+	return set
+}
+
+// GetSitemap godoc
+// @Summary Get the sitemap of the public pages
+// @ID GetSitemap
+// @Description Returns a sitemaps.org XML sitemap of every event's page and, when the server's PUBLIC_SITE_URL is set, the pages of the sessions in bookable rooms and of their speakers, with when each last changed. Unlisted content is left out. Without PUBLIC_SITE_URL the event pages are the server's schedule pages, absolute when PUBLIC_BASE_URL is set. At most 50,000 URLs are listed. No login is needed. Errors are the usual JSON envelope.
+// @Tags attendee
+// @Produce xml
+// @Success 200 {string} string "XML sitemap"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /public/sitemap.xml [get]
+func (c *AttendeeController) GetSitemap(w http.ResponseWriter, r *http.Request) {
+	pages, err := c.Service.ListPublicPages(r.Context())
+	if err != nil {
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	body, err := xml.Marshal(c.newSitemap(pages))
+	if err != nil {
+		helpers.WriteServiceError(w, r, c.Logger, err)
+		return
+	}
+	body = slices.Concat([]byte(xml.Header), body)
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,176 @@
+package controllers
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttendeeController_GetSitemap(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	updated := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	pages := []*domain.PublicPage{
+		{Kind: domain.PublicPageEvent, EventCode: "ab12", ID: "ev-1", UpdatedAt: updated},
+		{Kind: domain.PublicPageSession, EventCode: "ab12", ID: "s1", UpdatedAt: updated},
+		{Kind: domain.PublicPageSpeaker, EventCode: "ab12", ID: "sp1"},
+	}
+	tests := []struct {
+		name     string
+		baseURL  string
+		siteURL  string
+		wantLocs []string
+	}{
+		{
+			name: "public site", siteURL: "https://conf.example.com",
+			wantLocs: []string{"https://conf.example.com/events/ab12", "https://conf.example.com/events/ab12/sessions/s1", "https://conf.example.com/events/ab12/speakers/sp1"},
+		},
+		{
+			name: "schedule pages only", baseURL: "https://api.example.com",
+			wantLocs: []string{"https://api.example.com/public/events/ab12/schedule.html"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewAttendeeController(logger, &mockAttendeeService{publicPages: pages})
+			ctrl.PublicBaseURL, ctrl.PublicSiteURL = tt.baseURL, tt.siteURL
+			w := httptest.NewRecorder()
+
+			ctrl.GetSitemap(w, httptest.NewRequest(http.MethodGet, "/public/sitemap.xml", nil))
+
+			require.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, "application/xml; charset=utf-8", w.Header().Get("Content-Type"))
+			assert.Contains(t, w.Body.String(), `<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">`)
+			var set sitemapURLSet
+			require.NoError(t, xml.Unmarshal(w.Body.Bytes(), &set))
+			var locs []string
+			for _, u := range set.URLs {
+				locs = append(locs, u.Loc)
+			}
+			assert.Equal(t, tt.wantLocs, locs)
+			assert.Equal(t, "2026-05-01T12:00:00Z", set.URLs[0].LastMod)
+		})
+	}
+
+	w := httptest.NewRecorder()
+	NewAttendeeController(logger, &mockAttendeeService{publicPagesErr: errors.New("db down")}).
+		GetSitemap(w, httptest.NewRequest(http.MethodGet, "/public/sitemap.xml", nil))
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"`+helpers.ErrCodeInternalError+`"`)
+}
+
+func TestAttendeeController_GetPublicEventMetadata(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	ps := testPublicSchedule()
+	description := "The Go conference"
+	lat, lng := 52.37, 4.89
+	ps.Schedule.Event.Description, ps.Schedule.Event.LocationLat, ps.Schedule.Event.LocationLng = &description, &lat, &lng
+	ps.Schedule.Speakers[0].TagLine = "Analyst"
+	ctrl := NewAttendeeController(logger, &mockAttendeeService{publicSchedule: ps})
+	ctrl.PublicSiteURL = "https://conf.example.com"
+	req := httptest.NewRequest(http.MethodGet, "/public/events/ab12/metadata", nil)
+	req.SetPathValue("eventCode", "ab12")
+	w := httptest.NewRecorder()
+
+	ctrl.GetPublicEventMetadata(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, domain.EventSurrogateKey("ev-1"), w.Header().Get("Surrogate-Key"))
+	var resp struct {
+		Data struct {
+			Event    PageMetadata    `json:"event"`
+			Sessions []*PageMetadata `json:"sessions"`
+			Speakers []*PageMetadata `json:"speakers"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	meta := resp.Data
+
+	assert.Equal(t, "https://conf.example.com/events/ab12", meta.Event.CanonicalURL)
+	assert.Equal(t, "GopherConf", meta.Event.Title)
+	assert.Equal(t, description, meta.Event.Description)
+	ld := meta.Event.JSONLD
+	assert.Equal(t, "https://schema.org", ld["@context"])
+	assert.Equal(t, "Event", ld["@type"])
+	assert.Equal(t, "2026-05-14T08:00:00Z", ld["startDate"], "the event starts with its first session")
+	assert.Equal(t, "2026-05-15T10:00:00Z", ld["endDate"], "and ends with its last")
+	assert.Equal(t, 52.37, ld["location"].(map[string]any)["geo"].(map[string]any)["latitude"])
+	assert.Len(t, ld["subEvent"], 3)
+
+	require.Len(t, meta.Sessions, 3)
+	var keynote *PageMetadata
+	for _, s := range meta.Sessions {
+		if s.ID == "s1" {
+			keynote = s
+		}
+	}
+	require.NotNil(t, keynote)
+	assert.Equal(t, "https://conf.example.com/events/ab12/sessions/s1", keynote.CanonicalURL)
+	assert.Equal(t, "Main Hall", keynote.JSONLD["location"].(map[string]any)["name"])
+	performers := keynote.JSONLD["performer"].([]any)
+	require.Len(t, performers, 2)
+	assert.Equal(t, "Ada Lovelace", performers[0].(map[string]any)["name"])
+	assert.Equal(t, "https://conf.example.com/events/ab12", keynote.JSONLD["superEvent"].(map[string]any)["url"])
+
+	require.Len(t, meta.Speakers, 2)
+	ada := meta.Speakers[0]
+	assert.Equal(t, "https://conf.example.com/events/ab12/speakers/sp1", ada.CanonicalURL)
+	assert.Equal(t, "Person", ada.JSONLD["@type"])
+	assert.Equal(t, "Analyst", ada.JSONLD["jobTitle"])
+	assert.Len(t, ada.JSONLD["performerIn"], 1)
+}
+
+func TestAttendeeController_GetPublicEventMetadata_WithoutSite(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	ps := testPublicSchedule()
+	ps.Schedule.Rooms = nil
+	date := time.Date(2026, 5, 14, 0, 0, 0, 0, time.UTC)
+	ps.Schedule.Event.Date = &date
+	meta := NewAttendeeController(logger, &mockAttendeeService{}).newEventMetadata(ps)
+
+	assert.Equal(t, "/public/events/ab12/schedule.html", meta.Event.CanonicalURL)
+	assert.Equal(t, "2026-05-14T00:00:00Z", meta.Event.JSONLD["startDate"], "an event without sessions starts on its date")
+	assert.NotContains(t, meta.Event.JSONLD, "subEvent")
+	assert.NotContains(t, meta.Event.JSONLD, "location")
+	assert.Empty(t, meta.Sessions)
+	require.Len(t, meta.Speakers, 2)
+	assert.Empty(t, meta.Speakers[0].CanonicalURL)
+	assert.NotContains(t, meta.Speakers[0].JSONLD, "url")
+}
+
+func TestAttendeeController_GetPublicEventMetadata_Errors(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	tests := []struct {
+		name       string
+		code       string
+		svc        *mockAttendeeService
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "invalid code", code: "ab-1", svc: &mockAttendeeService{}, wantStatus: http.StatusBadRequest, wantCode: helpers.ErrCodeBadRequest},
+		{name: "event not found", code: "zz99", svc: &mockAttendeeService{publicScheduleErr: domain.ErrNotFound}, wantStatus: http.StatusNotFound, wantCode: helpers.ErrCodeEventNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/public/events/"+tt.code+"/metadata", nil)
+			req.SetPathValue("eventCode", tt.code)
+			w := httptest.NewRecorder()
+
+			NewAttendeeController(logger, tt.svc).GetPublicEventMetadata(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Contains(t, w.Body.String(), `"code":"`+tt.wantCode+`"`)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package controllers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/domain"
+)
+
+// MetaTag is one <meta property="..." content="..."> of a page's head.
+type MetaTag struct {
+	Property string `json:"property"`
+	Content  string `json:"content"`
+}
+
+// SessionCardMeta is what a page linking to a session puts in its head so the link previews well
+// when shared.
+type SessionCardMeta struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	// ImageURL is the session's preview image; absolute when the server knows its public URL.
+	ImageURL    string `json:"image_url"`
+	ImageWidth  int    `json:"image_width"`
+	ImageHeight int    `json:"image_height"`
+	// Tags are the Open Graph and Twitter card tags for the values above.
+	Tags []MetaTag `json:"tags"`
+}
+
+// SessionCardMetaSuccessResponse is the success response envelope for GET /public/sessions/{sessionID}/card (200).
+type SessionCardMetaSuccessResponse struct {
+	Data  *SessionCardMeta  `json:"data"`
+	Error *helpers.APIError `json:"error"`
+}
+
+// newSessionCardMeta describes the card, its image at imageURL.
+func newSessionCardMeta(card *domain.SessionCard, imageURL string) *SessionCardMeta {
+	var details []string
+	for _, d := range []string{card.When(), card.Room, strings.Join(card.Speakers, ", ")} {
+		if d != "" {
+			details = append(details, d)
+		}
+	}
+	meta := &SessionCardMeta{
+		Title:       card.Title,
+		Description: strings.Join(details, " · "),
+		ImageURL:    imageURL,
+		ImageWidth:  domain.SessionCardWidth,
+		ImageHeight: domain.SessionCardHeight,
+	}
+	meta.Tags = []MetaTag{
+		{Property: "og:type", Content: "website"},
+		{Property: "og:site_name", Content: card.EventName},
+		{Property: "og:title", Content: meta.Title},
+		{Property: "og:description", Content: meta.Description},
+		{Property: "og:image", Content: meta.ImageURL},
+		{Property: "og:image:type", Content: "image/png"},
+		{Property: "og:image:width", Content: strconv.Itoa(meta.ImageWidth)},
+		{Property: "og:image:height", Content: strconv.Itoa(meta.ImageHeight)},
+		{Property: "og:image:alt", Content: meta.Title + " at " + card.EventName},
+		{Property: "twitter:card", Content: "summary_large_image"},
+		{Property: "twitter:title", Content: meta.Title},
+		{Property: "twitter:description", Content: meta.Description},
+		{Property: "twitter:image", Content: meta.ImageURL},
+	}
+	return meta
+}
+
+// sessionCardError writes the response for an error of GetSessionCard or GetSessionCardImage.
+func (c *AttendeeController) sessionCardError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, domain.ErrNotFound) {
+		helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "session not found")
+		return
+	}
+	helpers.WriteServiceError(w, r, c.Logger, err)
+}
+
+// GetPublicSessionCardImage godoc
+// @Summary Get the preview image of a session
+// @ID GetPublicSessionCardImage
+// @Description Returns a 1200x630 PNG for social media to show with shared links to the session: its title, time in UTC, room and speakers under the event's name, in the event's theme colors. Only sessions on the public schedule have one. The ETag covers the image; send it as If-None-Match to get 304 when nothing changed. Responses carry Surrogate-Key event-{eventID}, which is purged from the CDN whenever the event changes. No login is needed. Errors are the usual JSON envelope.
+// @Tags attendee
+// @Produce png
+// @Param sessionID path string true "Session ID (UUID)"
+// @Param If-None-Match header string false "ETag of the image the client already has"
+// @Success 200 {file} binary "PNG image"
+// @Success 304 "the client's image is current"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /public/sessions/{sessionID}/card.png [get]
+func (c *AttendeeController) GetPublicSessionCardImage(w http.ResponseWriter, r *http.Request) {
+	sessionID := r.PathValue("sessionID")
+	if !uuidRegexAttendee.MatchString(sessionID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid sessionID")
+		return
+	}
+	img, err := c.Service.GetSessionCardImage(r.Context(), sessionID)
+	if err != nil {
+		c.sessionCardError(w, r, err)
+		return
+	}
+
+	sum := sha256.Sum256(img.PNG)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Surrogate-Key", domain.EventSurrogateKey(img.EventID))
+	w.Header().Set("Surrogate-Control", "max-age=86400")
+	if strings.TrimPrefix(r.Header.Get("If-None-Match"), "W/") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", "image/png")
+	w.Header().Set("Content-Length", strconv.Itoa(len(img.PNG)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(img.PNG)
+}
+
+// GetPublicSessionCard godoc
+// @Summary Get the link preview tags of a session
+// @ID GetPublicSessionCard
+// @Description Returns the title, description and preview image a page about the session puts in its head, and the matching Open Graph and Twitter card meta tags, so links to it look good when shared. The image is GET /public/sessions/{sessionID}/card.png, an absolute URL when the server's PUBLIC_BASE_URL is set. Only sessions on the public schedule have one. Responses carry Surrogate-Key event-{eventID}, which is purged from the CDN whenever the event changes. No login is needed.
+// @Tags attendee
+// @Produce json
+// @Param sessionID path string true "Session ID (UUID)"
+// @Success 200 {object} controllers.SessionCardMetaSuccessResponse "data contains the tags"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /public/sessions/{sessionID}/card [get]
+func (c *AttendeeController) GetPublicSessionCard(w http.ResponseWriter, r *http.Request) {
+	sessionID := r.PathValue("sessionID")
+	if !uuidRegexAttendee.MatchString(sessionID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid sessionID")
+		return
+	}
+	card, err := c.Service.GetSessionCard(r.Context(), sessionID)
+	if err != nil {
+		c.sessionCardError(w, r, err)
+		return
+	}
+	imageURL := strings.TrimRight(c.PublicBaseURL, "/") + "/public/sessions/" + card.SessionID + "/card.png"
+	w.Header().Set("Surrogate-Key", domain.EventSurrogateKey(card.EventID))
+	w.Header().Set("Surrogate-Control", "max-age=86400")
+	helpers.WriteJSONSuccess(w, http.StatusOK, newSessionCardMeta(card, imageURL))
+}
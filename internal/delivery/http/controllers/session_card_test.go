@@ -0,0 +1,122 @@
+package controllers
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSessionID = "7f1c2d3e-4a5b-4c6d-8e9f-0a1b2c3d4e5f"
+
+func TestAttendeeController_GetPublicSessionCardImage(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	png := []byte("\x89PNG\r\n\x1a\nimage")
+	ctrl := NewAttendeeController(logger, &mockAttendeeService{sessionCardImage: &domain.SessionCardImage{EventID: "ev-1", PNG: png}})
+	req := httptest.NewRequest(http.MethodGet, "/public/sessions/"+testSessionID+"/card.png", nil)
+	req.SetPathValue("sessionID", testSessionID)
+	w := httptest.NewRecorder()
+
+	ctrl.GetPublicSessionCardImage(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "image/png", w.Header().Get("Content-Type"))
+	assert.Equal(t, domain.EventSurrogateKey("ev-1"), w.Header().Get("Surrogate-Key"))
+	assert.Equal(t, png, w.Body.Bytes())
+
+	etag := w.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+	req = httptest.NewRequest(http.MethodGet, "/public/sessions/"+testSessionID+"/card.png", nil)
+	req.SetPathValue("sessionID", testSessionID)
+	req.Header.Set("If-None-Match", etag)
+	w = httptest.NewRecorder()
+	ctrl.GetPublicSessionCardImage(w, req)
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Empty(t, w.Body.Bytes())
+}
+
+func TestAttendeeController_GetPublicSessionCard(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	start := time.Date(2026, 5, 14, 9, 0, 0, 0, time.UTC)
+	card := &domain.SessionCard{
+		SessionID: testSessionID, EventID: "ev-1", EventName: "GopherConf", Title: "Keynote",
+		Speakers: []string{"Ada Lovelace", "Alan Turing"}, Room: "Main Hall", StartTime: start, EndTime: start.Add(time.Hour),
+	}
+	tests := []struct {
+		name     string
+		baseURL  string
+		wantLink string
+	}{
+		{name: "path", wantLink: "/public/sessions/" + testSessionID + "/card.png"},
+		{name: "absolute", baseURL: "https://api.example.com/", wantLink: "https://api.example.com/public/sessions/" + testSessionID + "/card.png"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewAttendeeController(logger, &mockAttendeeService{sessionCard: card})
+			ctrl.PublicBaseURL = tt.baseURL
+			req := httptest.NewRequest(http.MethodGet, "/public/sessions/"+testSessionID+"/card", nil)
+			req.SetPathValue("sessionID", testSessionID)
+			w := httptest.NewRecorder()
+
+			ctrl.GetPublicSessionCard(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, domain.EventSurrogateKey("ev-1"), w.Header().Get("Surrogate-Key"))
+			var resp SessionCardMetaSuccessResponse
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+			meta := resp.Data
+			assert.Equal(t, "Keynote", meta.Title)
+			assert.Equal(t, "Thu 14 May 2026, 09:00–10:00 UTC · Main Hall · Ada Lovelace, Alan Turing", meta.Description)
+			assert.Equal(t, tt.wantLink, meta.ImageURL)
+			assert.Equal(t, domain.SessionCardWidth, meta.ImageWidth)
+			tags := make(map[string]string, len(meta.Tags))
+			for _, tag := range meta.Tags {
+				tags[tag.Property] = tag.Content
+			}
+			assert.Equal(t, tt.wantLink, tags["og:image"])
+			assert.Equal(t, "630", tags["og:image:height"])
+			assert.Equal(t, "summary_large_image", tags["twitter:card"])
+			assert.Equal(t, "GopherConf", tags["og:site_name"])
+		})
+	}
+}
+
+func TestAttendeeController_GetPublicSessionCard_Errors(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	tests := []struct {
+		name       string
+		sessionID  string
+		svc        *mockAttendeeService
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "invalid id", sessionID: "not-a-uuid", svc: &mockAttendeeService{}, wantStatus: http.StatusBadRequest, wantCode: helpers.ErrCodeBadRequest},
+		{name: "not found", sessionID: testSessionID, svc: &mockAttendeeService{sessionCardErr: domain.ErrNotFound}, wantStatus: http.StatusNotFound, wantCode: helpers.ErrCodeNotFound},
+	}
+	for _, tt := range tests {
+		for path, handler := range map[string]func(*AttendeeController) http.HandlerFunc{
+			"card":     func(c *AttendeeController) http.HandlerFunc { return c.GetPublicSessionCard },
+			"card.png": func(c *AttendeeController) http.HandlerFunc { return c.GetPublicSessionCardImage },
+		} {
+			t.Run(tt.name+" "+path, func(t *testing.T) {
+				req := httptest.NewRequest(http.MethodGet, "/public/sessions/"+tt.sessionID+"/"+path, nil)
+				req.SetPathValue("sessionID", tt.sessionID)
+				w := httptest.NewRecorder()
+
+				handler(NewAttendeeController(logger, tt.svc))(w, req)
+
+				assert.Equal(t, tt.wantStatus, w.Code)
+				assert.Contains(t, w.Body.String(), `"code":"`+tt.wantCode+`"`)
+			})
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+// SessionStreamController manages the caption and translation streams of a session.
+type SessionStreamController struct {
+	Logger  *slog.Logger
+	Service domain.SessionStreamService
+}
+
+func NewSessionStreamController(logger *slog.Logger, svc domain.SessionStreamService) *SessionStreamController {
+	return &SessionStreamController{
+		Logger:  logger,
+		Service: svc,
+	}
+}
+
+// SetSessionStreamsRequest is the request body for PUT /events/{eventID}/sessions/{sessionID}/content/streams.
+// links replaces all of the session's links; send an empty list to remove them.
+type SetSessionStreamsRequest struct {
+	Links []*domain.SessionStreamLink `json:"links"`
+}
+
+// Validate implements Validator.
+func (s SetSessionStreamsRequest) Validate() []string {
+	if s.Links == nil {
+		return []string{"links is required"}
+	}
+	if len(s.Links) > domain.MaxSessionStreamLinks {
+		return []string{fmt.Sprintf("a session has at most %d stream links", domain.MaxSessionStreamLinks)}
+	}
+	return nil
+}
+
+// SessionStreamsSuccessResponse is the success response envelope for the session stream endpoints (200).
+type SessionStreamsSuccessResponse struct {
+	Data  []*domain.SessionStreamLink `json:"data"`
+	Error *helpers.APIError           `json:"error"`
+}
+
+// ListSessionStreams godoc
+// @Summary List a session's caption and translation streams
+// @ID ListSessionStreams
+// @Description Returns the session's stream links in order, including those outside their visibility window. Only the event owner can list. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param sessionID path string true "Session ID (UUID)"
+// @Success 200 {object} controllers.SessionStreamsSuccessResponse "data is the session's stream links"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/sessions/{sessionID}/content/streams [get]
+func (c *SessionStreamController) ListSessionStreams(w http.ResponseWriter, r *http.Request) {
+	eventID, sessionID, ownerID, ok := c.streamParams(w, r)
+	if !ok {
+		return
+	}
+	links, err := c.Service.ListSessionStreams(r.Context(), eventID, sessionID, ownerID)
+	if err != nil {
+		c.writeSessionStreamError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, links)
+}
+
+// SetSessionStreams godoc
+// @Summary Set a session's caption and translation streams
+// @ID SetSessionStreams
+// @Description Replaces the session's stream links, for hybrid events that caption or translate their sessions live. Each link has a kind (captions or translation), a language code such as en or pt-BR, an http or https url, and an optional visibility window: attendees get the link in the session of their schedule only from visible_from until visible_until, and either may be left open. A session has at most 20 links and one per kind and language. Only the event owner can set them. Requires authentication.
+// @Tags events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param sessionID path string true "Session ID (UUID)"
+// @Param body body controllers.SetSessionStreamsRequest true "All of the session's links"
+// @Success 200 {object} controllers.SessionStreamsSuccessResponse "data is the session's stream links"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/sessions/{sessionID}/content/streams [put]
+func (c *SessionStreamController) SetSessionStreams(w http.ResponseWriter, r *http.Request) {
+	eventID, sessionID, ownerID, ok := c.streamParams(w, r)
+	if !ok {
+		return
+	}
+	var req SetSessionStreamsRequest
+	if !helpers.DecodeAndValidate(w, r, &req) {
+		return
+	}
+	links, err := c.Service.SetSessionStreams(r.Context(), eventID, sessionID, ownerID, req.Links)
+	if err != nil {
+		c.writeSessionStreamError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, links)
+}
+
+func (c *SessionStreamController) streamParams(w http.ResponseWriter, r *http.Request) (eventID, sessionID, ownerID string, ok bool) {
+	eventID, sessionID = r.PathValue("eventID"), r.PathValue("sessionID")
+	if !uuidRegex.MatchString(eventID) || !uuidRegex.MatchString(sessionID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID or sessionID")
+		return "", "", "", false
+	}
+	ownerID, ok = middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return "", "", "", false
+	}
+	return eventID, sessionID, ownerID, true
+}
+
+func (c *SessionStreamController) writeSessionStreamError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, domain.ErrNotFound) {
+		helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event or session not found")
+		return
+	}
+	if errors.Is(err, domain.ErrForbidden) {
+		helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+		return
+	}
+	if errors.Is(err, domain.ErrInvalidInput) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
+		return
+	}
+	helpers.WriteServiceError(w, r, c.Logger, err)
+}
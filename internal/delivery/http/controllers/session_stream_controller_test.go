@@ -0,0 +1,77 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+type mockSessionStreamService struct {
+	err error
+}
+
+func (m *mockSessionStreamService) ListSessionStreams(ctx context.Context, eventID, sessionID, ownerID string) ([]*domain.SessionStreamLink, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return []*domain.SessionStreamLink{}, nil
+}
+
+func (m *mockSessionStreamService) SetSessionStreams(ctx context.Context, eventID, sessionID, ownerID string, links []*domain.SessionStreamLink) ([]*domain.SessionStreamLink, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return links, nil
+}
+
+func TestSessionStreamController_SetSessionStreams(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	const path = "/events/11111111-1111-1111-1111-111111111111/sessions/22222222-2222-2222-2222-222222222222/content/streams"
+	const captions = `{"links":[{"kind":"captions","language":"en","url":"https://captions.example/en"}]}`
+
+	tests := []struct {
+		name       string
+		path       string
+		body       string
+		err        error
+		wantStatus int
+	}{
+		{name: "set", body: captions, wantStatus: http.StatusOK},
+		{name: "cleared", body: `{"links":[]}`, wantStatus: http.StatusOK},
+		{name: "links missing", body: `{}`, wantStatus: http.StatusBadRequest},
+		{name: "too many links", body: `{"links":[` + strings.Repeat(`{},`, domain.MaxSessionStreamLinks) + `{}]}`, wantStatus: http.StatusBadRequest},
+		{name: "invalid link", body: captions, err: fmt.Errorf("stream link 1: bad: %w", domain.ErrInvalidInput), wantStatus: http.StatusBadRequest},
+		{name: "not owner", body: captions, err: domain.ErrForbidden, wantStatus: http.StatusForbidden},
+		{name: "other event's session", body: captions, err: domain.ErrNotFound, wantStatus: http.StatusNotFound},
+		{name: "invalid session ID", path: "/events/11111111-1111-1111-1111-111111111111/sessions/nope/content/streams", body: captions, wantStatus: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewSessionStreamController(logger, &mockSessionStreamService{err: tt.err})
+			mux := http.NewServeMux()
+			mux.HandleFunc("PUT /events/{eventID}/sessions/{sessionID}/content/streams", ctrl.SetSessionStreams)
+
+			p := tt.path
+			if p == "" {
+				p = path
+			}
+			req := httptest.NewRequest(http.MethodPut, p, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req = req.WithContext(middleware.SetUserID(req.Context(), "owner-1"))
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+		})
+	}
+}
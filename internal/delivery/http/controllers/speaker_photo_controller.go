@@ -0,0 +1,189 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+// SpeakerPhotoController serves zips of an event's speaker photos, for printed programs.
+type SpeakerPhotoController struct {
+	Logger  *slog.Logger
+	Service domain.SpeakerPhotoService
+}
+
+func NewSpeakerPhotoController(logger *slog.Logger, svc domain.SpeakerPhotoService) *SpeakerPhotoController {
+	return &SpeakerPhotoController{
+		Logger:  logger,
+		Service: svc,
+	}
+}
+
+// SpeakerPhotoArchiveSuccessResponse is the success response envelope for a speaker photo archive.
+type SpeakerPhotoArchiveSuccessResponse struct {
+	Data  domain.SpeakerPhotoArchive `json:"data"`
+	Error *helpers.APIError          `json:"error"`
+}
+
+// zipResponseWriter sends the zip headers with the first byte of the archive, so that errors
+// before it can still be answered with JSON.
+type zipResponseWriter struct {
+	w        http.ResponseWriter
+	filename string
+	started  bool
+}
+
+func (z *zipResponseWriter) Write(p []byte) (int, error) {
+	if !z.started {
+		z.started = true
+		z.w.Header().Set("Content-Type", "application/zip")
+		z.w.Header().Set("Content-Disposition", `attachment; filename="`+z.filename+`"`)
+		z.w.WriteHeader(http.StatusOK)
+	}
+	return z.w.Write(p)
+}
+
+// ExportSpeakerPhotos godoc
+// @Summary Download the photos of an event's speakers
+// @ID ExportSpeakerPhotos
+// @Description Returns a zip of the speakers' photos at their original size, each named after its speaker ("Ada Lovelace.jpg", with " (2)" for a name already taken). Photos that cannot be downloaded are listed in missing.txt with their URL. Events with more than 50 speakers with a photo get 202 instead: the archive is built in the background, and data is the pending archive to poll at the Location header until its status is ready or failed. Asking again while it is pending returns the same archive. Only the event owner can export. Requires authentication.
+// @Tags events
+// @Produce application/zip
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Success 200 {file} binary "zip archive"
+// @Success 202 {object} controllers.SpeakerPhotoArchiveSuccessResponse "data is the pending archive"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/speakers/photos.zip [get]
+func (c *SpeakerPhotoController) ExportSpeakerPhotos(w http.ResponseWriter, r *http.Request) {
+	eventID := r.PathValue("eventID")
+	if !uuidRegex.MatchString(eventID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID")
+		return
+	}
+	ownerID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return
+	}
+
+	zw := &zipResponseWriter{w: w, filename: "speaker-photos-" + eventID + ".zip"}
+	archive, err := c.Service.ExportSpeakerPhotos(r.Context(), eventID, ownerID, zw)
+	if err != nil {
+		if zw.started {
+			// The status is sent; the client sees a truncated archive.
+			c.Logger.ErrorContext(r.Context(), "speaker photo export interrupted", "path", r.URL.Path, "method", r.Method, "err", err)
+			return
+		}
+		c.writeSpeakerPhotoError(w, r, err)
+		return
+	}
+	if archive != nil {
+		c.Logger.InfoContext(r.Context(), "speaker photo archive requested", "audit", "speaker_photos.archive",
+			"event_id", eventID, "archive_id", archive.ID, "user_id", ownerID, "speakers", archive.Speakers)
+		w.Header().Set("Location", "/events/"+eventID+"/speakers/photos/archives/"+archive.ID)
+		helpers.WriteJSONSuccess(w, http.StatusAccepted, archive)
+	}
+}
+
+// GetSpeakerPhotoArchive godoc
+// @Summary Get a speaker photo archive
+// @ID GetSpeakerPhotoArchive
+// @Description Returns the archive's status: pending while it is built, then ready with the number of photos and the speakers whose photo could not be downloaded, or failed with the error. Only the event owner can read it. Requires authentication.
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param archiveID path string true "Archive ID (UUID)"
+// @Success 200 {object} controllers.SpeakerPhotoArchiveSuccessResponse "data is the archive"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/speakers/photos/archives/{archiveID} [get]
+func (c *SpeakerPhotoController) GetSpeakerPhotoArchive(w http.ResponseWriter, r *http.Request) {
+	eventID, archiveID, ownerID, ok := c.archiveParams(w, r)
+	if !ok {
+		return
+	}
+	archive, err := c.Service.GetSpeakerPhotoArchive(r.Context(), eventID, archiveID, ownerID)
+	if err != nil {
+		c.writeSpeakerPhotoError(w, r, err)
+		return
+	}
+	helpers.WriteJSONSuccess(w, http.StatusOK, archive)
+}
+
+// DownloadSpeakerPhotoArchive godoc
+// @Summary Download a speaker photo archive
+// @ID DownloadSpeakerPhotoArchive
+// @Description Returns the zip of a ready archive, laid out as GET /events/{eventID}/speakers/photos.zip. Archives are kept for a day (RETENTION_SPEAKER_PHOTO_ARCHIVES_DAYS). Only the event owner can download it. Requires authentication.
+// @Tags events
+// @Produce application/zip
+// @Security BearerAuth
+// @Param eventID path string true "Event ID (UUID)"
+// @Param archiveID path string true "Archive ID (UUID)"
+// @Success 200 {file} binary "zip archive"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
+// @Failure 403 {object} helpers.APIResponse "error.code: forbidden (not owner)"
+// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 409 {object} helpers.APIResponse "error.code: conflict (the archive is pending or failed)"
+// @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
+// @Router /events/{eventID}/speakers/photos/archives/{archiveID}/download [get]
+func (c *SpeakerPhotoController) DownloadSpeakerPhotoArchive(w http.ResponseWriter, r *http.Request) {
+	eventID, archiveID, ownerID, ok := c.archiveParams(w, r)
+	if !ok {
+		return
+	}
+	zip, err := c.Service.DownloadSpeakerPhotoArchive(r.Context(), eventID, archiveID, ownerID)
+	if err != nil {
+		c.writeSpeakerPhotoError(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", `attachment; filename="speaker-photos-`+eventID+`.zip"`)
+	w.Header().Set("Content-Length", strconv.Itoa(len(zip)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(zip)
+}
+
+func (c *SpeakerPhotoController) archiveParams(w http.ResponseWriter, r *http.Request) (eventID, archiveID, ownerID string, ok bool) {
+	eventID, archiveID = r.PathValue("eventID"), r.PathValue("archiveID")
+	if !uuidRegex.MatchString(eventID) || !uuidRegex.MatchString(archiveID) {
+		helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, "invalid eventID or archiveID")
+		return "", "", "", false
+	}
+	ownerID, ok = middleware.UserIDFromContext(r.Context())
+	if !ok {
+		helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "unauthorized")
+		return "", "", "", false
+	}
+	return eventID, archiveID, ownerID, true
+}
+
+func (c *SpeakerPhotoController) writeSpeakerPhotoError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, domain.ErrNotFound) {
+		helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "event or archive not found")
+		return
+	}
+	if errors.Is(err, domain.ErrForbidden) {
+		helpers.WriteJSONError(w, http.StatusForbidden, helpers.ErrCodeForbidden, "forbidden")
+		return
+	}
+	if errors.Is(err, domain.ErrArchiveNotReady) {
+		helpers.WriteJSONError(w, http.StatusConflict, helpers.ErrCodeConflict, err.Error())
+		return
+	}
+	helpers.WriteServiceError(w, r, c.Logger, err)
+}
@@ -0,0 +1,134 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+)
+
+type mockSpeakerPhotoService struct {
+	err     error
+	archive *domain.SpeakerPhotoArchive
+	// written is what ExportSpeakerPhotos writes before returning err.
+	written string
+}
+
+func (m *mockSpeakerPhotoService) ExportSpeakerPhotos(ctx context.Context, eventID, ownerID string, w io.Writer) (*domain.SpeakerPhotoArchive, error) {
+	if m.written != "" {
+		_, _ = io.WriteString(w, m.written)
+	}
+	return m.archive, m.err
+}
+
+func (m *mockSpeakerPhotoService) GetSpeakerPhotoArchive(ctx context.Context, eventID, archiveID, ownerID string) (*domain.SpeakerPhotoArchive, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.SpeakerPhotoArchive{ID: archiveID, EventID: eventID, Status: domain.PhotoArchivePending}, nil
+}
+
+func (m *mockSpeakerPhotoService) DownloadSpeakerPhotoArchive(ctx context.Context, eventID, archiveID, ownerID string) ([]byte, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return []byte("PK"), nil
+}
+
+func (m *mockSpeakerPhotoService) BuildPendingArchives(ctx context.Context) (int, error) {
+	return 0, m.err
+}
+
+func TestSpeakerPhotoController_ExportSpeakerPhotos(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	const eventID = "11111111-1111-1111-1111-111111111111"
+
+	tests := []struct {
+		name         string
+		path         string
+		svc          *mockSpeakerPhotoService
+		wantStatus   int
+		wantType     string
+		wantLocation string
+	}{
+		{name: "streamed", svc: &mockSpeakerPhotoService{written: "PK"}, wantStatus: http.StatusOK, wantType: "application/zip"},
+		{
+			name:         "queued",
+			svc:          &mockSpeakerPhotoService{archive: &domain.SpeakerPhotoArchive{ID: "33333333-3333-3333-3333-333333333333", Status: domain.PhotoArchivePending}},
+			wantStatus:   http.StatusAccepted,
+			wantType:     "application/json",
+			wantLocation: "/events/" + eventID + "/speakers/photos/archives/33333333-3333-3333-3333-333333333333",
+		},
+		{name: "interrupted after the first byte", svc: &mockSpeakerPhotoService{written: "PK", err: errors.New("broken pipe")}, wantStatus: http.StatusOK, wantType: "application/zip"},
+		{name: "not owner", svc: &mockSpeakerPhotoService{err: domain.ErrForbidden}, wantStatus: http.StatusForbidden, wantType: "application/json"},
+		{name: "unknown event", svc: &mockSpeakerPhotoService{err: domain.ErrNotFound}, wantStatus: http.StatusNotFound, wantType: "application/json"},
+		{name: "invalid event ID", path: "/events/nope/speakers/photos.zip", svc: &mockSpeakerPhotoService{}, wantStatus: http.StatusBadRequest, wantType: "application/json"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewSpeakerPhotoController(logger, tt.svc)
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /events/{eventID}/speakers/photos.zip", ctrl.ExportSpeakerPhotos)
+
+			path := tt.path
+			if path == "" {
+				path = "/events/" + eventID + "/speakers/photos.zip"
+			}
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			req = req.WithContext(middleware.SetUserID(req.Context(), "owner-1"))
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if got := w.Header().Get("Content-Type"); got != tt.wantType {
+				t.Fatalf("expected Content-Type %q, got %q", tt.wantType, got)
+			}
+			if got := w.Header().Get("Location"); got != tt.wantLocation {
+				t.Fatalf("expected Location %q, got %q", tt.wantLocation, got)
+			}
+		})
+	}
+}
+
+func TestSpeakerPhotoController_DownloadSpeakerPhotoArchive(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	const path = "/events/11111111-1111-1111-1111-111111111111/speakers/photos/archives/33333333-3333-3333-3333-333333333333/download"
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "ready", wantStatus: http.StatusOK},
+		{name: "pending", err: domain.ErrArchiveNotReady, wantStatus: http.StatusConflict},
+		{name: "other event's archive", err: domain.ErrNotFound, wantStatus: http.StatusNotFound},
+		{name: "not owner", err: domain.ErrForbidden, wantStatus: http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := NewSpeakerPhotoController(logger, &mockSpeakerPhotoService{err: tt.err})
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /events/{eventID}/speakers/photos/archives/{archiveID}/download", ctrl.DownloadSpeakerPhotoArchive)
+
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			req = req.WithContext(middleware.SetUserID(req.Context(), "owner-1"))
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if tt.wantStatus == http.StatusOK && w.Body.String() != "PK" {
+				t.Fatalf("expected the zip, got %q", w.Body.String())
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"net/url"
 	"regexp"
 	"strings"
 
@@ -73,11 +74,24 @@ type LoginResponse struct {
 type UpdateUserRequest struct {
 	Name     *string `json:"name"`
 	LastName *string `json:"last_name"`
+	// AvatarURL is an http(s) URL of the profile picture; an empty string removes it.
+	AvatarURL *string `json:"avatar_url"`
 }
 
 // Validate implements Validator.
 func (u UpdateUserRequest) Validate() []string {
-	return nil
+	var errs []string
+	if u.AvatarURL != nil {
+		if s := strings.TrimSpace(*u.AvatarURL); s != "" {
+			parsed, err := url.Parse(s)
+			if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+				errs = append(errs, "avatar_url must be an http or https URL")
+			} else if len(s) > 2048 {
+				errs = append(errs, "avatar_url must be at most 2048 characters")
+			}
+		}
+	}
+	return errs
 }
 
 // LoginSuccessResponse is the success response envelope for POST /auth/login/verify (200).
@@ -114,13 +128,14 @@ func NewUserController(logger *slog.Logger, svc domain.UserService) *UserControl
 
 // RequestLoginCode godoc
 // @Summary Request a login code
-// @Description Send a one-time login code to the given email. The code expires after a short period.
+// @ID RequestLoginCode
+// @Description Send a one-time login code to the given email. The code expires after a short period. Requests may be screened for bots (see the README).
 // @Tags auth
 // @Accept json
 // @Produce json
 // @Param body body RequestLoginCodeRequest true "Email to receive the code"
 // @Success 200 {object} helpers.APIResponse "success"
-// @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
+// @Failure 400 {object} helpers.APIResponse "error.code: bad_request, bot_detected or captcha_failed"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
 // @Router /auth/login/request [post]
 func (c *UserController) RequestLoginCode(w http.ResponseWriter, r *http.Request) {
@@ -135,15 +150,19 @@ func (c *UserController) RequestLoginCode(w http.ResponseWriter, r *http.Request
 			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
 			return
 		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		helpers.WriteServiceError(w, r, c.Logger, err)
 		return
 	}
+	middleware.LogSecurityEvent(c.Logger, r, "login code requested", middleware.SecurityEvent{
+		Type: middleware.SecurityLoginCodeRequest, Outcome: middleware.SecurityOutcomeSuccess,
+		Details: []slog.Attr{slog.String("email", email)},
+	})
 	helpers.WriteJSONSuccess(w, http.StatusOK, nil)
 }
 
 // VerifyLoginCode godoc
 // @Summary Verify login code and get token
+// @ID VerifyLoginCode
 // @Description Exchange the one-time code for a JWT and user. Creates the user on first successful login. Returns token, token_type, and user.
 // @Tags auth
 // @Accept json
@@ -164,6 +183,10 @@ func (c *UserController) VerifyLoginCode(w http.ResponseWriter, r *http.Request)
 	token, user, err := c.Service.VerifyLoginCode(r.Context(), email, code)
 	if err != nil {
 		if strings.Contains(err.Error(), "invalid or expired code") {
+			middleware.LogSecurityEvent(c.Logger, r, "login failed", middleware.SecurityEvent{
+				Type: middleware.SecurityLogin, Outcome: middleware.SecurityOutcomeFailure,
+				Details: []slog.Attr{slog.String("email", email), slog.String("reason", "invalid_or_expired_code")},
+			})
 			helpers.WriteJSONError(w, http.StatusUnauthorized, helpers.ErrCodeUnauthorized, "invalid or expired code")
 			return
 		}
@@ -171,22 +194,26 @@ func (c *UserController) VerifyLoginCode(w http.ResponseWriter, r *http.Request)
 			helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
 			return
 		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		helpers.WriteServiceError(w, r, c.Logger, err)
 		return
 	}
+	middleware.LogSecurityEvent(c.Logger, r, "login succeeded", middleware.SecurityEvent{
+		Type: middleware.SecurityLogin, Outcome: middleware.SecurityOutcomeSuccess, UserID: user.ID,
+		Details: []slog.Attr{slog.String("email", email)},
+	})
 	helpers.WriteJSONSuccess(w, http.StatusOK, LoginResponse{Token: token, TokenType: "Bearer", User: user})
 }
 
 // GetMe godoc
 // @Summary Get current user
+// @ID GetMe
 // @Description Returns the authenticated user's profile (id, email, name, created_at, updated_at). Requires Bearer token.
 // @Tags users
 // @Produce json
 // @Security BearerAuth
 // @Success 200 {object} controllers.GetMeSuccessResponse "data contains the user"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
-// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
+// @Failure 404 {object} helpers.APIResponse "error.code: user_not_found"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
 // @Router /users/me [get]
 func (c *UserController) GetMe(w http.ResponseWriter, r *http.Request) {
@@ -198,11 +225,10 @@ func (c *UserController) GetMe(w http.ResponseWriter, r *http.Request) {
 	user, err := c.Service.GetByID(r.Context(), userID)
 	if err != nil {
 		if errors.Is(err, domain.ErrUserNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "user not found")
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeUserNotFound, "user not found")
 			return
 		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		helpers.WriteServiceError(w, r, c.Logger, err)
 		return
 	}
 	helpers.WriteJSONSuccess(w, http.StatusOK, user)
@@ -210,17 +236,18 @@ func (c *UserController) GetMe(w http.ResponseWriter, r *http.Request) {
 
 // UpdateMe godoc
 // @Summary Update current user
-// @Description Update the authenticated user's profile. Accepts optional name and/or last_name only; email cannot be updated. Requires Bearer token.
+// @ID UpdateMe
+// @Description Update the authenticated user's profile. Accepts optional name, last_name and avatar_url (an empty avatar_url removes it); email cannot be updated. Requires Bearer token.
 // @Tags users
 // @Accept json
 // @Produce json
 // @Security BearerAuth
-// @Param body body UpdateUserRequest true "Fields to update (name and/or last_name, both optional)"
+// @Param body body UpdateUserRequest true "Fields to update (name, last_name and avatar_url, all optional)"
 // @Success 200 {object} controllers.UpdateUserSuccessResponse "data contains the updated user"
 // @Failure 400 {object} helpers.APIResponse "error.code: bad_request"
 // @Failure 401 {object} helpers.APIResponse "error.code: unauthorized"
-// @Failure 404 {object} helpers.APIResponse "error.code: not_found"
-// @Failure 409 {object} helpers.APIResponse "error.code: conflict"
+// @Failure 404 {object} helpers.APIResponse "error.code: user_not_found"
+// @Failure 409 {object} helpers.APIResponse "error.code: duplicate_email"
 // @Failure 500 {object} helpers.APIResponse "error.code: internal_error"
 // @Router /users/me [patch]
 func (c *UserController) UpdateMe(w http.ResponseWriter, r *http.Request) {
@@ -236,11 +263,10 @@ func (c *UserController) UpdateMe(w http.ResponseWriter, r *http.Request) {
 	user, err := c.Service.GetByID(r.Context(), userID)
 	if err != nil {
 		if errors.Is(err, domain.ErrUserNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "user not found")
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeUserNotFound, "user not found")
 			return
 		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		helpers.WriteServiceError(w, r, c.Logger, err)
 		return
 	}
 	if req.Name != nil {
@@ -249,17 +275,19 @@ func (c *UserController) UpdateMe(w http.ResponseWriter, r *http.Request) {
 	if req.LastName != nil {
 		user.LastName = strings.TrimSpace(*req.LastName)
 	}
+	if req.AvatarURL != nil {
+		user.AvatarURL = strings.TrimSpace(*req.AvatarURL)
+	}
 	if err := c.Service.Update(r.Context(), user); err != nil {
 		if errors.Is(err, domain.ErrDuplicateEmail) {
-			helpers.WriteJSONError(w, http.StatusConflict, helpers.ErrCodeConflict, "email already in use")
+			helpers.WriteJSONError(w, http.StatusConflict, helpers.ErrCodeDuplicateEmail, "email already in use")
 			return
 		}
 		if errors.Is(err, domain.ErrUserNotFound) {
-			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "user not found")
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeUserNotFound, "user not found")
 			return
 		}
-		c.Logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
-		helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+		helpers.WriteServiceError(w, r, c.Logger, err)
 		return
 	}
 	helpers.WriteJSONSuccess(w, http.StatusOK, user)
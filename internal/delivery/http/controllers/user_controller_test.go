@@ -89,7 +89,7 @@ func TestUserController_GetMe(t *testing.T) {
 			contextUserID: "user-123",
 			fakeErr:       domain.ErrUserNotFound,
 			wantStatus:    http.StatusNotFound,
-			wantBodyCode:  helpers.ErrCodeNotFound,
+			wantBodyCode:  helpers.ErrCodeUserNotFound,
 		},
 		{
 			name:          "service error",
@@ -153,6 +153,22 @@ func TestUserController_UpdateMe(t *testing.T) {
 			fakeUser:      &domain.User{ID: "user-123", Email: "a@b.com", Name: "Alice", CreatedAt: now, UpdatedAt: now},
 			wantStatus:    http.StatusOK,
 		},
+		{
+			name:          "success update avatar",
+			contextUserID: "user-123",
+			body:          `{"avatar_url":"https://cdn.example.com/alice.png"}`,
+			fakeUser:      &domain.User{ID: "user-123", Email: "a@b.com", Name: "Alice", CreatedAt: now, UpdatedAt: now},
+			wantStatus:    http.StatusOK,
+		},
+		{
+			name:           "avatar must be http url",
+			contextUserID:  "user-123",
+			body:           `{"avatar_url":"javascript:alert(1)"}`,
+			fakeUser:       &domain.User{ID: "user-123", Email: "a@b.com", Name: "Alice", CreatedAt: now, UpdatedAt: now},
+			wantStatus:     http.StatusBadRequest,
+			wantBodyCode:   helpers.ErrCodeBadRequest,
+			wantBodySubstr: "avatar_url",
+		},
 		{
 			name:           "email in body rejected",
 			contextUserID:  "user-123",
@@ -194,7 +210,7 @@ func TestUserController_UpdateMe(t *testing.T) {
 			fakeUser:      nil,
 			fakeUpdateErr: nil,
 			wantStatus:    http.StatusNotFound,
-			wantBodyCode:  helpers.ErrCodeNotFound,
+			wantBodyCode:  helpers.ErrCodeUserNotFound,
 		},
 	}
 
@@ -0,0 +1,91 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/domain"
+)
+
+// NewDebugHandler returns the operator-only endpoints served on PPROF_ADDR: the pprof profiles
+// under /debug/pprof/, the repository latency report at GET /debug/queries and what the next
+// retention purge would delete at GET /debug/retention. When mailbox is not nil (the email sandbox
+// is on), GET /debug/mailbox lists the recorded emails, optionally only those to ?to=, and
+// DELETE /debug/mailbox empties it. When emailDomain is not nil, GET /debug/email-domain reports the
+// sending domain's SPF, DKIM and DMARC records (?refresh=true skips the cached report). When
+// challenges is not nil, GET /debug/bot-challenges counts the passed and rejected bot challenges
+// per endpoint. When panics is not nil, GET /debug/panics counts the recovered panics per route and
+// shows the last one with its stack. When metrics is not nil, GET /metrics serves the business
+// metrics for Prometheus to scrape. When faults is not nil (FAULT_INJECTION is on), GET
+// /debug/faults lists the injected faults and PUT /debug/faults/{dependency} sets one from a
+// {"latency_ms", "error_rate"} body; a zero body clears it.
+func NewDebugHandler(queries domain.QueryReporter, retention domain.RetentionService, mailbox domain.Mailbox, emailDomain domain.EmailDomainChecker, challenges domain.BotChallengeReporter, panics domain.PanicReporter, metrics domain.BusinessMetricsReporter, faults domain.FaultInjector) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/debug/pprof/", NewPprofHandler())
+	mux.HandleFunc("GET /debug/queries", func(w http.ResponseWriter, r *http.Request) {
+		helpers.WriteJSONSuccess(w, http.StatusOK, queries.QueryReport())
+	})
+	mux.HandleFunc("GET /debug/retention", func(w http.ResponseWriter, r *http.Request) {
+		report, err := retention.Report(r.Context())
+		if err != nil {
+			helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+			return
+		}
+		helpers.WriteJSONSuccess(w, http.StatusOK, report)
+	})
+	if mailbox != nil {
+		mux.HandleFunc("GET /debug/mailbox", func(w http.ResponseWriter, r *http.Request) {
+			helpers.WriteJSONSuccess(w, http.StatusOK, mailbox.SentEmails(r.URL.Query().Get("to")))
+		})
+		mux.HandleFunc("DELETE /debug/mailbox", func(w http.ResponseWriter, r *http.Request) {
+			mailbox.ClearSentEmails()
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+	if emailDomain != nil {
+		mux.HandleFunc("GET /debug/email-domain", func(w http.ResponseWriter, r *http.Request) {
+			report, err := emailDomain.CheckEmailDomain(r.Context(), r.URL.Query().Get("refresh") == "true")
+			if err != nil {
+				helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+				return
+			}
+			helpers.WriteJSONSuccess(w, http.StatusOK, report)
+		})
+	}
+	if challenges != nil {
+		mux.HandleFunc("GET /debug/bot-challenges", func(w http.ResponseWriter, r *http.Request) {
+			helpers.WriteJSONSuccess(w, http.StatusOK, challenges.BotChallengeReport())
+		})
+	}
+	if panics != nil {
+		mux.HandleFunc("GET /debug/panics", func(w http.ResponseWriter, r *http.Request) {
+			helpers.WriteJSONSuccess(w, http.StatusOK, panics.PanicReport())
+		})
+	}
+	if metrics != nil {
+		mux.Handle("GET /metrics", NewMetricsHandler(metrics))
+	}
+	if faults != nil {
+		mux.HandleFunc("GET /debug/faults", func(w http.ResponseWriter, r *http.Request) {
+			helpers.WriteJSONSuccess(w, http.StatusOK, faults.Faults())
+		})
+		mux.HandleFunc("PUT /debug/faults/{dependency}", func(w http.ResponseWriter, r *http.Request) {
+			var f domain.Fault
+			if !helpers.DecodeAndValidate(w, r, &f) {
+				return
+			}
+			f.Dependency = r.PathValue("dependency")
+			if err := faults.SetFault(f); err != nil {
+				if errors.Is(err, domain.ErrInvalidInput) {
+					helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
+					return
+				}
+				helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+				return
+			}
+			helpers.WriteJSONSuccess(w, http.StatusOK, f)
+		})
+	}
+	return mux
+}
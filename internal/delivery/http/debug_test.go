@@ -0,0 +1,258 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubQueryReporter struct{}
+
+func (stubQueryReporter) QueryReport() domain.QueryReport {
+	return domain.QueryReport{
+		Methods: []domain.MethodLatency{{Method: "EventRepository.GetByID", Calls: 3}},
+		Slowest: []domain.SlowQuery{},
+	}
+}
+
+func TestNewDebugHandler(t *testing.T) {
+	h := NewDebugHandler(stubQueryReporter{}, &stubRetentionService{}, nil, nil, nil, nil, nil, nil)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/queries", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp struct {
+		Data domain.QueryReport `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp.Data.Methods, 1)
+	assert.Equal(t, int64(3), resp.Data.Methods[0].Calls)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/pprof/", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/mailbox", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code, "no mailbox without the email sandbox")
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/email-domain", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code, "no domain check without a sender")
+}
+
+type stubRetentionService struct {
+	err error
+}
+
+func (s *stubRetentionService) Report(ctx context.Context) (*domain.RetentionReport, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &domain.RetentionReport{Classes: []*domain.RetentionClassReport{{DataClass: domain.RetentionLoginCodes, RetentionDays: 7, Rows: 4}}}, nil
+}
+
+func (s *stubRetentionService) Purge(ctx context.Context) (*domain.RetentionReport, error) {
+	return nil, errors.New("the debug listener must not purge")
+}
+
+func TestNewDebugHandler_Retention(t *testing.T) {
+	rec := httptest.NewRecorder()
+	NewDebugHandler(stubQueryReporter{}, &stubRetentionService{}, nil, nil, nil, nil, nil, nil).
+		ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/retention", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp struct {
+		Data domain.RetentionReport `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp.Data.Classes, 1)
+	assert.Equal(t, int64(4), resp.Data.Classes[0].Rows)
+
+	rec = httptest.NewRecorder()
+	NewDebugHandler(stubQueryReporter{}, &stubRetentionService{err: errors.New("db down")}, nil, nil, nil, nil, nil, nil).
+		ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/retention", nil))
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+type stubMailbox struct {
+	sent    []domain.SentEmail
+	lastTo  string
+	cleared bool
+}
+
+func (m *stubMailbox) SentEmails(to string) []domain.SentEmail {
+	m.lastTo = to
+	return m.sent
+}
+
+func (m *stubMailbox) ClearSentEmails() { m.cleared = true }
+
+func TestNewDebugHandler_Mailbox(t *testing.T) {
+	mailbox := &stubMailbox{sent: []domain.SentEmail{{To: "a@example.com", Subject: "Your login code"}}}
+	h := NewDebugHandler(stubQueryReporter{}, &stubRetentionService{}, mailbox, nil, nil, nil, nil, nil)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/mailbox?to=a@example.com", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp struct {
+		Data []domain.SentEmail `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp.Data, 1)
+	assert.Equal(t, "Your login code", resp.Data[0].Subject)
+	assert.Equal(t, "a@example.com", mailbox.lastTo)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/debug/mailbox", nil))
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.True(t, mailbox.cleared)
+}
+
+type stubEmailDomainChecker struct {
+	lastRefresh bool
+	err         error
+}
+
+func (c *stubEmailDomainChecker) CheckEmailDomain(ctx context.Context, refresh bool) (*domain.EmailDomainReport, error) {
+	c.lastRefresh = refresh
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &domain.EmailDomainReport{Domain: "mail.example.com", Records: []*domain.EmailDomainRecord{
+		{Kind: domain.EmailRecordDMARC, Name: "_dmarc.mail.example.com", Status: domain.EmailRecordMissing},
+	}}, nil
+}
+
+func TestNewDebugHandler_EmailDomain(t *testing.T) {
+	checker := &stubEmailDomainChecker{}
+	h := NewDebugHandler(stubQueryReporter{}, &stubRetentionService{}, nil, checker, nil, nil, nil, nil)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/email-domain?refresh=true", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp struct {
+		Data domain.EmailDomainReport `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, "mail.example.com", resp.Data.Domain)
+	require.Len(t, resp.Data.Records, 1)
+	assert.Equal(t, domain.EmailRecordMissing, resp.Data.Records[0].Status)
+	assert.True(t, checker.lastRefresh)
+
+	checker.err = errors.New("i/o timeout")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/email-domain", nil))
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.False(t, checker.lastRefresh)
+}
+
+type stubChallengeReporter struct{}
+
+func (stubChallengeReporter) BotChallengeReport() []domain.BotChallengeStats {
+	return []domain.BotChallengeStats{{Endpoint: domain.ChallengeEndpointContact, Passed: 3, Honeypot: 2}}
+}
+
+func TestNewDebugHandler_BotChallenges(t *testing.T) {
+	rec := httptest.NewRecorder()
+	NewDebugHandler(stubQueryReporter{}, &stubRetentionService{}, nil, nil, stubChallengeReporter{}, nil, nil, nil).
+		ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/bot-challenges", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp struct {
+		Data []domain.BotChallengeStats `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp.Data, 1)
+	assert.Equal(t, int64(2), resp.Data[0].Honeypot)
+}
+
+type stubPanicReporter struct{}
+
+func (stubPanicReporter) PanicReport() domain.PanicStats {
+	return domain.PanicStats{Total: 2, Routes: []domain.RoutePanics{{Route: "GET /events", Count: 2}}}
+}
+
+func TestNewDebugHandler_Panics(t *testing.T) {
+	rec := httptest.NewRecorder()
+	NewDebugHandler(stubQueryReporter{}, &stubRetentionService{}, nil, nil, nil, stubPanicReporter{}, nil, nil).
+		ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/panics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp struct {
+		Data domain.PanicStats `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, int64(2), resp.Data.Total)
+	require.Len(t, resp.Data.Routes, 1)
+
+	rec = httptest.NewRecorder()
+	NewDebugHandler(stubQueryReporter{}, &stubRetentionService{}, nil, nil, nil, nil, nil, nil).
+		ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/panics", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+type stubFaultInjector struct{ set []domain.Fault }
+
+func (s *stubFaultInjector) Faults() []domain.Fault {
+	return []domain.Fault{{Dependency: domain.FaultDependencyEmail, LatencyMs: 100}}
+}
+
+func (s *stubFaultInjector) SetFault(f domain.Fault) error {
+	if f.Dependency != domain.FaultDependencyEmail {
+		return domain.ErrInvalidInput
+	}
+	s.set = append(s.set, f)
+	return nil
+}
+
+func TestNewDebugHandler_Faults(t *testing.T) {
+	injector := &stubFaultInjector{}
+	h := NewDebugHandler(stubQueryReporter{}, &stubRetentionService{}, nil, nil, nil, nil, nil, injector)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/faults", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var list struct {
+		Data []domain.Fault `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&list))
+	require.Len(t, list.Data, 1)
+	assert.Equal(t, int64(100), list.Data[0].LatencyMs)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/debug/faults/email", strings.NewReader(`{"latency_ms":250,"error_rate":0.5}`)))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, []domain.Fault{{Dependency: domain.FaultDependencyEmail, LatencyMs: 250, ErrorRate: 0.5}}, injector.set)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/debug/faults/blob", strings.NewReader(`{"error_rate":1}`)))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/debug/faults/email", strings.NewReader(`{"rate":1}`)))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Len(t, injector.set, 1)
+
+	rec = httptest.NewRecorder()
+	NewDebugHandler(stubQueryReporter{}, &stubRetentionService{}, nil, nil, nil, nil, nil, nil).
+		ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/faults", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestNewRouter_DoesNotExposeQueryReport(t *testing.T) {
+	router := newContractRouter(&stubEventService{}, &stubUserService{}, &stubAttendeeService{}, &stubAnnouncementService{}, &stubContactService{}, &stubAbuseReportService{}, &stubIPAllowlistService{}, &stubMachineClientService{}, &stubActivityService{}, &stubEventDeletionService{}, &stubExhibitorService{}, &stubEnrichmentService{}, &stubInvitationWarmupService{}, &stubRoomOccupancyService{}, &stubEventDocumentService{}, &stubSpeakerPhotoService{}, &stubSessionStreamService{})
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/queries", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/mailbox", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
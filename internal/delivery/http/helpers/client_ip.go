@@ -0,0 +1,78 @@
+package helpers
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+type clientIPKey struct{}
+
+// WithClientIP returns a copy of r whose ClientIP is ip.
+func WithClientIP(r *http.Request, ip string) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), clientIPKey{}, ip))
+}
+
+// ClientIP returns the address a request came from, as resolved by ResolveClientIP when the request
+// went through the middleware that calls it; otherwise the connection's remote address.
+func ClientIP(r *http.Request) string {
+	if ip, ok := r.Context().Value(clientIPKey{}).(string); ok {
+		return ip
+	}
+	return remoteHost(r)
+}
+
+// ResolveClientIP returns the address r came from. X-Forwarded-For is only read when the connection
+// comes from one of the trusted proxies: its entries are walked from the right, past every trusted
+// proxy, to the first address a proxy did not add itself. Entries left of that are client-supplied
+// and ignored. When the connection is not from a trusted proxy the header may be forged, so the
+// connection's address is used.
+func ResolveClientIP(r *http.Request, trusted []netip.Prefix) string {
+	ip := remoteHost(r)
+	if !isTrusted(ip, trusted) {
+		return ip
+	}
+	entries := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(entries) - 1; i >= 0; i-- {
+		entry := strings.TrimSpace(entries[i])
+		if entry == "" {
+			continue
+		}
+		addr, err := netip.ParseAddr(entry)
+		if err != nil {
+			// A malformed entry means the chain cannot be followed further; the nearest proxy
+			// is the last address known to be genuine.
+			return ip
+		}
+		ip = addr.Unmap().String()
+		if !isTrusted(ip, trusted) {
+			return ip
+		}
+	}
+	return ip
+}
+
+func isTrusted(ip string, trusted []netip.Prefix) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, p := range trusted {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// remoteHost returns the host of the connection's remote address.
+func remoteHost(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,47 @@
+package helpers
+
+import (
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveClientIP(t *testing.T) {
+	trusted := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("192.0.2.1/32")}
+	tests := []struct {
+		name    string
+		remote  string
+		xff     []string
+		trusted []netip.Prefix
+		want    string
+	}{
+		{name: "remote address", remote: "198.51.100.9:1234", trusted: trusted, want: "198.51.100.9"},
+		{name: "no trusted proxies ignores the header", remote: "192.0.2.1:1234", xff: []string{"203.0.113.7"}, want: "192.0.2.1"},
+		{name: "untrusted connection ignores the header", remote: "198.51.100.9:1234", xff: []string{"203.0.113.7"}, trusted: trusted, want: "198.51.100.9"},
+		{name: "balancer entry", remote: "192.0.2.1:1234", xff: []string{"203.0.113.7"}, trusted: trusted, want: "203.0.113.7"},
+		{name: "spoofed entries are skipped", remote: "192.0.2.1:1234", xff: []string{"10.0.0.1, 198.51.100.2"}, trusted: trusted, want: "198.51.100.2"},
+		{name: "trusted hops are skipped", remote: "192.0.2.1:1234", xff: []string{"6.6.6.6, 203.0.113.7, 10.1.2.3"}, trusted: trusted, want: "203.0.113.7"},
+		{name: "repeated headers", remote: "192.0.2.1:1234", xff: []string{"6.6.6.6", "203.0.113.7"}, trusted: trusted, want: "203.0.113.7"},
+		{name: "malformed entry stops at the proxy", remote: "192.0.2.1:1234", xff: []string{"203.0.113.7, nonsense"}, trusted: trusted, want: "192.0.2.1"},
+		{name: "only trusted hops", remote: "192.0.2.1:1234", xff: []string{"10.0.0.5"}, trusted: trusted, want: "10.0.0.5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/", nil)
+			req.RemoteAddr = tt.remote
+			for _, v := range tt.xff {
+				req.Header.Add("X-Forwarded-For", v)
+			}
+			assert.Equal(t, tt.want, ResolveClientIP(req, tt.trusted))
+		})
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	req := httptest.NewRequest("POST", "/", nil)
+	req.Header.Set("X-Forwarded-For", "203.0.113.7")
+	assert.Equal(t, "192.0.2.1", ClientIP(req), "without the middleware the header is not read")
+	assert.Equal(t, "203.0.113.7", ClientIP(WithClientIP(req, "203.0.113.7")))
+}
@@ -0,0 +1,21 @@
+package helpers
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// ParseDryRun reads the dry_run query parameter the destructive bulk endpoints share; it is false
+// when absent. When the value is not a boolean it writes a 400 error and returns ok false.
+func ParseDryRun(w http.ResponseWriter, r *http.Request) (dryRun bool, ok bool) {
+	s := r.URL.Query().Get("dry_run")
+	if s == "" {
+		return false, true
+	}
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		WriteJSONError(w, http.StatusBadRequest, ErrCodeBadRequest, "dry_run must be true or false")
+		return false, false
+	}
+	return v, true
+}
@@ -0,0 +1,160 @@
+package helpers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"multitrackticketing/internal/domain"
+)
+
+// Domain-specific error codes. Prefer these over the generic codes in response.go
+// when the handler knows which resource or rule caused the failure.
+const (
+	ErrCodeEventNotFound         = "event_not_found"
+	ErrCodeUserNotFound          = "user_not_found"
+	ErrCodeAlreadyMember         = "already_member"
+	ErrCodeDuplicateEmail        = "duplicate_email"
+	ErrCodeImportUnavailable     = "import_unavailable"
+	ErrCodeEnrichmentUnavailable = "enrichment_unavailable"
+	ErrCodeNotReady              = "not_ready"
+	ErrCodeScheduleRuleViolation = "schedule_rule_violation"
+	ErrCodeInvitationNotFound    = "invitation_not_found"
+	ErrCodeEventHasRegistrations = "event_has_registrations"
+	ErrCodeEmailNotFound         = "email_not_found"
+	ErrCodeCaptchaFailed         = "captcha_failed"
+	ErrCodeBotDetected           = "bot_detected"
+	ErrCodeIPNotAllowed          = "ip_not_allowed"
+	ErrCodeRateLimited           = "rate_limited"
+	ErrCodeInvalidClient         = "invalid_client"
+	ErrCodeInsufficientScope     = "insufficient_scope"
+	ErrCodeInvalidConfirmation   = "invalid_confirmation"
+	ErrCodeSchemaTooNew          = "schema_too_new"
+	ErrCodeDocumentsNotAccepted  = "documents_not_accepted"
+	ErrCodeSessionTimeConflict   = "session_time_conflict"
+	ErrCodeQuotaExceeded         = "quota_exceeded"
+)
+
+// ErrorCodeInfo describes one machine-readable error code: the value sent in
+// error.code, the HTTP status it is returned with, and a short description.
+// swagger:model ErrorCodeInfo
+type ErrorCodeInfo struct {
+	Code        string `json:"code"`
+	Status      int    `json:"status"`
+	Description string `json:"description"`
+}
+
+// errorCatalog lists every error code the API can return. Keep in sync with the constants above
+// and in response.go; GET /meta/error-codes serves this list for client SDK generation.
+var errorCatalog = []ErrorCodeInfo{
+	{Code: ErrCodeBadRequest, Status: http.StatusBadRequest, Description: "The request body, query, or path parameters are invalid."},
+	{Code: ErrCodeUnauthorized, Status: http.StatusUnauthorized, Description: "Authentication is missing, invalid, or expired."},
+	{Code: ErrCodeInvalidClient, Status: http.StatusUnauthorized, Description: "The machine client ID and secret do not match an active client."},
+	{Code: ErrCodeForbidden, Status: http.StatusForbidden, Description: "The caller is authenticated but not allowed to perform the action."},
+	{Code: ErrCodeIPNotAllowed, Status: http.StatusForbidden, Description: "The account has an IP allowlist and the request comes from an address outside it. The allowed ranges are not disclosed."},
+	{Code: ErrCodeInsufficientScope, Status: http.StatusForbidden, Description: "The machine token does not carry the scope the route requires."},
+	{Code: ErrCodeNotFound, Status: http.StatusNotFound, Description: "One of the referenced resources does not exist."},
+	{Code: ErrCodeEventNotFound, Status: http.StatusNotFound, Description: "The event does not exist. Routes that also look up other resources answer not_found instead."},
+	{Code: ErrCodeUserNotFound, Status: http.StatusNotFound, Description: "No user matches the given ID or email."},
+	{Code: ErrCodeInvitationNotFound, Status: http.StatusNotFound, Description: "The invitation does not exist in this event."},
+	{Code: ErrCodeEmailNotFound, Status: http.StatusNotFound, Description: "The sent email does not exist in this event."},
+	{Code: ErrCodeInvalidConfirmation, Status: http.StatusBadRequest, Description: "The event deletion token is wrong, was already used, or has expired; request the deletion again."},
+	{Code: ErrCodeScheduleRuleViolation, Status: http.StatusBadRequest, Description: "The session's time slot breaks the event's schedule rules; the message lists each broken rule."},
+	{Code: ErrCodeCaptchaFailed, Status: http.StatusBadRequest, Description: "The captcha token is missing or the captcha provider rejected it."},
+	{Code: ErrCodeBotDetected, Status: http.StatusBadRequest, Description: "A form field people never see was filled in; the request looks automated."},
+	{Code: ErrCodeConflict, Status: http.StatusConflict, Description: "The request conflicts with the current state of the resource."},
+	{Code: ErrCodeAlreadyMember, Status: http.StatusConflict, Description: "The user is already a team member of the event."},
+	{Code: ErrCodeDuplicateEmail, Status: http.StatusConflict, Description: "The email address is already in use by another user."},
+	{Code: ErrCodeEventHasRegistrations, Status: http.StatusConflict, Description: "The event has attendee registrations; delete it with force=true to remove them too."},
+	{Code: ErrCodeSessionTimeConflict, Status: http.StatusConflict, Description: "The session's time slot overlaps another session in the same room; the message names it."},
+	{Code: ErrCodeQuotaExceeded, Status: http.StatusConflict, Description: "The event already has as many of this resource as it may, such as 50 custom fields."},
+	{Code: ErrCodeDocumentsNotAccepted, Status: http.StatusConflict, Description: "The event's documents, such as its code of conduct, must be accepted to register; the message names the versions to accept."},
+	{Code: ErrCodePayloadTooLarge, Status: http.StatusRequestEntityTooLarge, Description: "The request body is larger than the server accepts."},
+	{Code: ErrCodeUnsupportedMediaType, Status: http.StatusUnsupportedMediaType, Description: "A request with a body did not send Content-Type: application/json."},
+	{Code: ErrCodeRateLimited, Status: http.StatusTooManyRequests, Description: "Too many requests of this kind were sent recently; retry later."},
+	{Code: ErrCodeInternalError, Status: http.StatusInternalServerError, Description: "An unexpected server error occurred."},
+	{Code: ErrCodeImportUnavailable, Status: http.StatusServiceUnavailable, Description: "The schedule provider (e.g. Sessionize) is failing; retry the import later."},
+	{Code: ErrCodeEnrichmentUnavailable, Status: http.StatusServiceUnavailable, Description: "Session suggestions are not configured on this server, or their provider is failing; retry later."},
+	{Code: ErrCodeNotReady, Status: http.StatusServiceUnavailable, Description: "A critical dependency such as the database is down."},
+	{Code: ErrCodeSchemaTooNew, Status: http.StatusServiceUnavailable, Description: "The database was migrated past what this server supports, as during a rolling deploy; writes are refused until a newer server takes over. Retry shortly."},
+	{Code: ErrCodeTimeout, Status: http.StatusGatewayTimeout, Description: "The request took longer than its route's deadline; it may be retried."},
+}
+
+// ErrorCatalog returns a copy of all error codes the API can return.
+func ErrorCatalog() []ErrorCodeInfo {
+	out := make([]ErrorCodeInfo, len(errorCatalog))
+	copy(out, errorCatalog)
+	return out
+}
+
+// domainErrorCodes maps domain sentinel errors to catalog codes. Order matters: more specific
+// sentinels come first so errors.Is matches them before the generic ones. event_not_found is not
+// mapped here: services return ErrNotFound for a missing event and for any other missing resource
+// alike, so only a handler whose sole lookup is the event can tell and answers it itself.
+var domainErrorCodes = []struct {
+	err  error
+	code string
+}{
+	{domain.ErrUserNotFound, ErrCodeUserNotFound},
+	{domain.ErrInvitationNotFound, ErrCodeInvitationNotFound},
+	{domain.ErrEventEmailNotFound, ErrCodeEmailNotFound},
+	{domain.ErrDuplicateEmail, ErrCodeDuplicateEmail},
+	{domain.ErrAlreadyMember, ErrCodeAlreadyMember},
+	{domain.ErrEventHasRegistrations, ErrCodeEventHasRegistrations},
+	{domain.ErrDocumentsNotAccepted, ErrCodeDocumentsNotAccepted},
+	{domain.ErrCaptchaFailed, ErrCodeCaptchaFailed},
+	{domain.ErrBotDetected, ErrCodeBotDetected},
+	{domain.ErrRateLimited, ErrCodeRateLimited},
+	{domain.ErrIPNotAllowed, ErrCodeIPNotAllowed},
+	{domain.ErrInvalidClient, ErrCodeInvalidClient},
+	{domain.ErrInsufficientScope, ErrCodeInsufficientScope},
+	{domain.ErrInvalidConfirmation, ErrCodeInvalidConfirmation},
+	{domain.ErrNotFound, ErrCodeNotFound},
+	{domain.ErrEmailDomainNotChecked, ErrCodeNotFound},
+	{domain.ErrForbidden, ErrCodeForbidden},
+	{domain.ErrScheduleRuleViolation, ErrCodeScheduleRuleViolation},
+	{domain.ErrSessionTimeConflict, ErrCodeSessionTimeConflict},
+	{domain.ErrQuotaExceeded, ErrCodeQuotaExceeded},
+	{domain.ErrInvalidInput, ErrCodeBadRequest},
+	{domain.ErrProviderUnavailable, ErrCodeImportUnavailable},
+	{domain.ErrEnrichmentUnavailable, ErrCodeEnrichmentUnavailable},
+	{domain.ErrSchemaTooNew, ErrCodeSchemaTooNew},
+	{domain.ErrDuplicateEventCode, ErrCodeConflict},
+	{domain.ErrAlreadyResolved, ErrCodeConflict},
+	{domain.ErrDuplicateCustomField, ErrCodeConflict},
+	{domain.ErrDuplicateBooth, ErrCodeConflict},
+	{domain.ErrEventNotOver, ErrCodeConflict},
+	{domain.ErrWarmupCompleted, ErrCodeConflict},
+	{domain.ErrArchiveNotReady, ErrCodeConflict},
+}
+
+// CodeForError returns the catalog entry for the first domain sentinel err matches.
+// Errors that match no sentinel map to internal_error.
+func CodeForError(err error) ErrorCodeInfo {
+	for _, m := range domainErrorCodes {
+		if errors.Is(err, m.err) {
+			return lookupCode(m.code)
+		}
+	}
+	return lookupCode(ErrCodeInternalError)
+}
+
+// WriteServiceError answers a service error the handler has no specific response for with the
+// catalog code of the domain sentinel it matches and its message. Errors that match none are
+// logged and answered with internal_error.
+func WriteServiceError(w http.ResponseWriter, r *http.Request, logger *slog.Logger, err error) {
+	info := CodeForError(err)
+	if info.Code == ErrCodeInternalError {
+		logger.ErrorContext(r.Context(), "request failed", "path", r.URL.Path, "method", r.Method, "err", err)
+	}
+	WriteJSONError(w, info.Status, info.Code, err.Error())
+}
+
+func lookupCode(code string) ErrorCodeInfo {
+	for _, info := range errorCatalog {
+		if info.Code == code {
+			return info
+		}
+	}
+	return ErrorCodeInfo{Code: code, Status: http.StatusInternalServerError}
+}
@@ -0,0 +1,136 @@
+package helpers
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// MediaTypeJSONAPI is the Accept media type that opts a client into hypermedia output. The
+// envelope is APIResponse's with links added, not a full JSON:API document.
+const MediaTypeJSONAPI = "application/vnd.api+json"
+
+// MediaTypeHypermedia is this API's own name for the same output, accepted as well.
+const MediaTypeHypermedia = "application/vnd.m3t+json"
+
+// Links maps link relation names (e.g. self, event, rooms, sessions) to resource paths. Every path
+// is a GET route the caller of the linking route may follow.
+// swagger:model Links
+type Links map[string]string
+
+// HypermediaResponse is the envelope returned instead of APIResponse when the client
+// sends Accept: application/vnd.api+json. Data is unchanged; Links holds related resources.
+// swagger:model HypermediaResponse
+type HypermediaResponse struct {
+	Data  any       `json:"data"`
+	Links Links     `json:"links"`
+	Error *APIError `json:"error"`
+}
+
+// WantsHypermedia reports whether the request's Accept header lists MediaTypeJSONAPI or
+// MediaTypeHypermedia.
+func WantsHypermedia(r *http.Request) bool {
+	return hypermediaType(r) != ""
+}
+
+// hypermediaType returns the hypermedia media type the request's Accept header lists, preferring
+// MediaTypeJSONAPI, or "" when it lists neither.
+func hypermediaType(r *http.Request) string {
+	for _, mt := range []string{MediaTypeJSONAPI, MediaTypeHypermedia} {
+		if acceptsMediaType(r, mt) {
+			return mt
+		}
+	}
+	return ""
+}
+
+// acceptsMediaType reports whether the request's Accept header lists mediaType explicitly.
+func acceptsMediaType(r *http.Request, mediaType string) bool {
+	for _, part := range strings.Split(r.Header.Get("Accept"), ",") {
+		mt, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		if mt == mediaType {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteJSONSuccessWithLinks writes data with the given links when the client asked for
+// hypermedia output (see WantsHypermedia); otherwise it behaves exactly like WriteJSONSuccess.
+func WriteJSONSuccessWithLinks(w http.ResponseWriter, r *http.Request, statusCode int, data any, links Links) {
+	mediaType := hypermediaType(r)
+	if mediaType == "" {
+		WriteJSONSuccess(w, statusCode, data)
+		return
+	}
+	w.Header().Set("Content-Type", mediaType)
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(HypermediaResponse{Data: data, Links: links, Error: nil})
+}
+
+// EventLinks returns the link relations for an event resource.
+func EventLinks(eventID string) Links {
+	base := "/events/" + eventID
+	return Links{
+		"self":         base,
+		"rooms":        base + "/rooms",
+		"sessions":     base + "/sessions",
+		"speakers":     base + "/speakers",
+		"tags":         base + "/tags",
+		"team_members": base + "/team-members",
+		"invitations":  base + "/invitations",
+	}
+}
+
+// RoomLinks returns the link relations for a room resource.
+func RoomLinks(eventID, roomID string) Links {
+	return Links{
+		"self":  "/events/" + eventID + "/rooms/" + roomID,
+		"event": "/events/" + eventID,
+		"rooms": "/events/" + eventID + "/rooms",
+	}
+}
+
+// RoomsLinks returns the link relations for the room collection of an event.
+func RoomsLinks(eventID string) Links {
+	return Links{
+		"self":  "/events/" + eventID + "/rooms",
+		"event": "/events/" + eventID,
+	}
+}
+
+// SessionLinks returns the link relations for a session resource. roomID may be empty. A single
+// session has no GET route, so there is no self link; sessions is the event's list it appears in.
+func SessionLinks(eventID, sessionID, roomID string) Links {
+	links := Links{
+		"event":    "/events/" + eventID,
+		"sessions": "/events/" + eventID + "/sessions",
+		"speakers": "/events/" + eventID + "/sessions/" + sessionID + "/speakers",
+		"history":  "/events/" + eventID + "/sessions/" + sessionID + "/history",
+	}
+	if roomID != "" {
+		links["room"] = "/events/" + eventID + "/rooms/" + roomID
+	}
+	return links
+}
+
+// SpeakerLinks returns the link relations for a speaker resource.
+func SpeakerLinks(eventID, speakerID string) Links {
+	return Links{
+		"self":     "/events/" + eventID + "/speakers/" + speakerID,
+		"event":    "/events/" + eventID,
+		"speakers": "/events/" + eventID + "/speakers",
+	}
+}
+
+// SpeakersLinks returns the link relations for the speaker collection of an event.
+func SpeakersLinks(eventID string) Links {
+	return Links{
+		"self":  "/events/" + eventID + "/speakers",
+		"event": "/events/" + eventID,
+	}
+}
@@ -0,0 +1,79 @@
+package helpers
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// MediaTypeNDJSON is the Accept media type that opts a client into streamed list output:
+// one JSON document per line instead of a single buffered envelope.
+const MediaTypeNDJSON = "application/x-ndjson"
+
+// ndjsonFlushEvery is how many rows are written between flushes to the client.
+const ndjsonFlushEvery = 100
+
+// WantsNDJSON reports whether the request's Accept header lists MediaTypeNDJSON.
+func WantsNDJSON(r *http.Request) bool {
+	return acceptsMediaType(r, MediaTypeNDJSON)
+}
+
+// NDJSONWriter streams list items as newline-delimited JSON. The 200 status and Content-Type
+// are sent with the first row, so a handler can still answer with WriteJSONError while
+// Started reports false. Once rows have been sent, Fail appends a final {"error":{...}} line.
+type NDJSONWriter struct {
+	w       http.ResponseWriter
+	enc     *json.Encoder
+	flusher http.Flusher
+	rows    int
+}
+
+// NewNDJSONWriter returns an NDJSONWriter that writes to w.
+func NewNDJSONWriter(w http.ResponseWriter) *NDJSONWriter {
+	flusher, _ := w.(http.Flusher)
+	return &NDJSONWriter{w: w, enc: json.NewEncoder(w), flusher: flusher}
+}
+
+// Started reports whether the response header has been sent.
+func (n *NDJSONWriter) Started() bool {
+	return n.rows > 0
+}
+
+// Write encodes v as one line, sending the header first if needed and flushing periodically.
+func (n *NDJSONWriter) Write(v any) error {
+	if n.rows == 0 {
+		n.start()
+	}
+	if err := n.enc.Encode(v); err != nil {
+		return err
+	}
+	n.rows++
+	if n.flusher != nil && n.rows%ndjsonFlushEvery == 0 {
+		n.flusher.Flush()
+	}
+	return nil
+}
+
+// Close finishes the stream. An empty stream still gets the 200 header and no body.
+func (n *NDJSONWriter) Close() {
+	if n.rows == 0 {
+		n.start()
+	}
+	if n.flusher != nil {
+		n.flusher.Flush()
+	}
+}
+
+// Fail ends a started stream with an error line so clients can tell it was cut short.
+func (n *NDJSONWriter) Fail(code, message string) {
+	_ = n.enc.Encode(struct {
+		Error *APIError `json:"error"`
+	}{Error: &APIError{Code: code, Message: message}})
+	if n.flusher != nil {
+		n.flusher.Flush()
+	}
+}
+
+func (n *NDJSONWriter) start() {
+	n.w.Header().Set("Content-Type", MediaTypeNDJSON)
+	n.w.WriteHeader(http.StatusOK)
+}
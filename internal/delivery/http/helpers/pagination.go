@@ -50,7 +50,10 @@ type PaginationMeta struct {
 func NewPaginationMeta(page, pageSize, total int) PaginationMeta {
 	totalPages := 0
 	if pageSize > 0 {
-		totalPages = (total + pageSize - 1) / pageSize
+		totalPages = total / pageSize
+		if total%pageSize != 0 {
+			totalPages++
+		}
 	}
 	return PaginationMeta{
 		Page:       page,
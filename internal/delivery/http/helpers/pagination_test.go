@@ -0,0 +1,50 @@
+package helpers
+
+import (
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// FuzzParsePagination checks that any page/page_size query yields params within the documented
+// bounds, and that NewPaginationMeta never reports fewer pages than the requested window needs.
+func FuzzParsePagination(f *testing.F) {
+	seeds := [][2]string{
+		{"", ""},
+		{"2", "20"},
+		{"0", "0"},
+		{"-1", "-5"},
+		{"999999999999999999999", "101"},
+		{"1e3", "0x10"},
+		{" 3", "٣"},
+		{"3\x00", "\xff"},
+	}
+	for _, s := range seeds {
+		f.Add(s[0], s[1], 45)
+	}
+	f.Add("1", "7", math.MaxInt)
+	f.Fuzz(func(t *testing.T, page, pageSize string, total int) {
+		q := url.Values{"page": {page}, "page_size": {pageSize}}
+		r := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+
+		p := ParsePagination(r)
+		assert.GreaterOrEqual(t, p.Page, 1)
+		assert.GreaterOrEqual(t, p.PageSize, 1)
+		assert.LessOrEqual(t, p.PageSize, MaxPageSize)
+
+		if total < 0 {
+			return
+		}
+		meta := NewPaginationMeta(p.Page, p.PageSize, total)
+		assert.GreaterOrEqual(t, meta.TotalPages, 0)
+		if total > 1<<40 {
+			return // keep the products below from overflowing
+		}
+		assert.GreaterOrEqual(t, meta.TotalPages*meta.PageSize, total)
+		assert.Less(t, (meta.TotalPages-1)*meta.PageSize, max(total, 1))
+	})
+}
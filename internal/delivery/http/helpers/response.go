@@ -13,6 +13,12 @@ const (
 	ErrCodeNotFound      = "not_found"
 	ErrCodeConflict      = "conflict"
 	ErrCodeInternalError = "internal_error"
+	// ErrCodePayloadTooLarge and ErrCodeUnsupportedMediaType are sent by the request middleware
+	// before any handler runs.
+	ErrCodePayloadTooLarge      = "payload_too_large"
+	ErrCodeUnsupportedMediaType = "unsupported_media_type"
+	// ErrCodeTimeout is sent by the deadline middleware when a request outlives its route's deadline.
+	ErrCodeTimeout = "timeout"
 )
 
 // APIError is the error object in the standardized API response envelope.
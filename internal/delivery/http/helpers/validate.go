@@ -1,11 +1,39 @@
 package helpers
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"strings"
 )
 
+// MaxRequestBodyBytes caps JSON request bodies read by DecodeAndValidate when the route sets no
+// limit of its own.
+const MaxRequestBodyBytes = 1 << 20
+
+type bodyLimitKey struct{}
+
+// WithBodyLimit returns a copy of r whose body may be up to n bytes long, in place of MaxRequestBodyBytes.
+func WithBodyLimit(r *http.Request, n int64) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), bodyLimitKey{}, n))
+}
+
+// BodyLimit returns the largest body r may carry: the route's limit, or MaxRequestBodyBytes.
+func BodyLimit(r *http.Request) int64 {
+	if n, ok := r.Context().Value(bodyLimitKey{}).(int64); ok {
+		return n
+	}
+	return MaxRequestBodyBytes
+}
+
+// WritePayloadTooLarge writes the 413 payload_too_large error for a body over limit bytes.
+func WritePayloadTooLarge(w http.ResponseWriter, limit int64) {
+	WriteJSONError(w, http.StatusRequestEntityTooLarge, ErrCodePayloadTooLarge,
+		fmt.Sprintf("request body must be at most %d bytes", limit))
+}
+
 // Validator is implemented by request DTOs that support validation.
 // Validate returns a slice of error messages; nil or empty means valid.
 type Validator interface {
@@ -13,13 +41,19 @@ type Validator interface {
 }
 
 // DecodeAndValidate decodes the request body into dest (with DisallowUnknownFields)
-// and, if dest implements Validator, runs Validate(). On decode or validation failure
-// it writes a 400 JSON error and returns false; otherwise returns true.
-// Callers should return immediately when DecodeAndValidate returns false.
+// and, if dest implements Validator, runs Validate(). Bodies larger than BodyLimit get a 413
+// JSON error; on decode or validation failure it writes a 400 JSON error. Either way it returns
+// false, and callers should return immediately; otherwise it returns true.
 func DecodeAndValidate(w http.ResponseWriter, r *http.Request, dest any) bool {
-	dec := json.NewDecoder(r.Body)
+	limit := BodyLimit(r)
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, limit))
 	dec.DisallowUnknownFields()
 	if err := dec.Decode(dest); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			WritePayloadTooLarge(w, limit)
+			return false
+		}
 		WriteJSONError(w, http.StatusBadRequest, ErrCodeBadRequest, err.Error())
 		return false
 	}
@@ -0,0 +1,41 @@
+package http
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/domain"
+)
+
+// metricsContentType is version 0.0.4 of the Prometheus text exposition format.
+const metricsContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// NewMetricsHandler returns the business metrics in the Prometheus text format, each labelled by
+// event_id. It is served on the operator-only listener (see NewDebugHandler).
+func NewMetricsHandler(reporter domain.BusinessMetricsReporter) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report, err := reporter.BusinessMetricsReport(r.Context())
+		if err != nil {
+			helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+			return
+		}
+		w.Header().Set("Content-Type", metricsContentType)
+		bw := bufio.NewWriter(w)
+		writeEventMetric(bw, "m3t_invitations_sent_total", "counter", "Invitation emails sent, per event.", report.InvitationsSent)
+		writeEventMetric(bw, "m3t_registrations_total", "counter", "New event registrations (bookings), per event.", report.Registrations)
+		writeEventMetric(bw, "m3t_registrations_active", "gauge", "Registrations of the events that are undated or dated today or later.", report.ActiveRegistrations)
+		bw.Flush()
+	})
+}
+
+func writeEventMetric(w *bufio.Writer, name, kind, help string, counts []domain.EventCount) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+	for _, c := range counts {
+		fmt.Fprintf(w, "%s{event_id=\"%s\"} %d\n", name, labelEscaper.Replace(c.EventID), c.Count)
+	}
+}
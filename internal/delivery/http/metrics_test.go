@@ -0,0 +1,57 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubMetricsReporter struct {
+	err error
+}
+
+func (s stubMetricsReporter) BusinessMetricsReport(ctx context.Context) (*domain.BusinessMetricsReport, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &domain.BusinessMetricsReport{
+		InvitationsSent:     []domain.EventCount{{EventID: "ev-1", Count: 12}, {EventID: domain.OtherEventsLabel, Count: 3}},
+		Registrations:       []domain.EventCount{{EventID: "ev-1", Count: 4}},
+		ActiveRegistrations: []domain.EventCount{},
+	}, nil
+}
+
+func TestNewMetricsHandler(t *testing.T) {
+	rec := httptest.NewRecorder()
+	NewDebugHandler(stubQueryReporter{}, &stubRetentionService{}, nil, nil, nil, nil, stubMetricsReporter{}, nil).
+		ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, metricsContentType, rec.Header().Get("Content-Type"))
+	assert.Equal(t, `# HELP m3t_invitations_sent_total Invitation emails sent, per event.
+# TYPE m3t_invitations_sent_total counter
+m3t_invitations_sent_total{event_id="ev-1"} 12
+m3t_invitations_sent_total{event_id="other"} 3
+# HELP m3t_registrations_total New event registrations (bookings), per event.
+# TYPE m3t_registrations_total counter
+m3t_registrations_total{event_id="ev-1"} 4
+# HELP m3t_registrations_active Registrations of the events that are undated or dated today or later.
+# TYPE m3t_registrations_active gauge
+`, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	NewDebugHandler(stubQueryReporter{}, &stubRetentionService{}, nil, nil, nil, nil, stubMetricsReporter{err: errors.New("db down")}, nil).
+		ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	rec = httptest.NewRecorder()
+	NewDebugHandler(stubQueryReporter{}, &stubRetentionService{}, nil, nil, nil, nil, nil, nil).
+		ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
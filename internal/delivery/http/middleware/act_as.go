@@ -0,0 +1,143 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"regexp"
+
+	h "multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/domain"
+)
+
+// ActAsHeader names the user an admin acts as. The request then runs as that user, and is logged
+// and shown in that user's activity feed with the admin as actor.
+const ActAsHeader = "X-Act-As"
+
+const actingKey contextKey = "acting"
+
+var actAsUUID = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// acting records who acts as whom for one request. LoggingMiddleware puts an empty one in the
+// context so its request line can name the actor that ActAs fills in further down.
+type acting struct {
+	actorID string
+	userID  string
+}
+
+func withActing(ctx context.Context) (context.Context, *acting) {
+	if a, ok := ctx.Value(actingKey).(*acting); ok {
+		return ctx, a
+	}
+	a := &acting{}
+	return context.WithValue(ctx, actingKey, a), a
+}
+
+// ActorFromContext returns the admin acting as the request's user, if the request was made with
+// the X-Act-As header.
+func ActorFromContext(ctx context.Context) (string, bool) {
+	a, ok := ctx.Value(actingKey).(*acting)
+	if !ok || a.actorID == "" {
+		return "", false
+	}
+	return a.actorID, true
+}
+
+// actingAttrs returns the log attributes naming the actor, or nil when nobody acts as another user.
+func actingAttrs(ctx context.Context) []slog.Attr {
+	a, ok := ctx.Value(actingKey).(*acting)
+	if !ok || a.actorID == "" {
+		return nil
+	}
+	return []slog.Attr{slog.String("actor_id", a.actorID), slog.String("acting_as", a.userID)}
+}
+
+// ActAs returns a wrapper that lets admins act as another user by sending that user's ID in the
+// X-Act-As header. It must run after RequireAuth. The request's user ID becomes the target's, the
+// admin is kept as the actor, and the request is recorded in the target's activity feed and logged
+// as an audit entry once it completes. Callers who are not admins get 403 and are logged too.
+func ActAs(svc domain.ActivityService, logger *slog.Logger) func(http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			targetID := r.Header.Get(ActAsHeader)
+			actorID, ok := UserIDFromContext(r.Context())
+			if targetID == "" || !ok {
+				next(w, r)
+				return
+			}
+			if !actAsUUID.MatchString(targetID) {
+				h.WriteJSONError(w, http.StatusBadRequest, h.ErrCodeBadRequest, ActAsHeader+" must be a user ID")
+				return
+			}
+			err := svc.AuthorizeActAs(r.Context(), actorID, targetID)
+			switch {
+			case errors.Is(err, domain.ErrForbidden):
+				LogSecurityEvent(logger, r, "act as refused", SecurityEvent{
+					Type: SecurityActAsDeny, Outcome: SecurityOutcomeDenied, UserID: actorID, TargetType: "user", TargetID: targetID,
+					Details: []slog.Attr{slog.String("method", r.Method), slog.String("path", r.URL.Path)},
+				})
+				h.WriteJSONError(w, http.StatusForbidden, h.ErrCodeForbidden, "only admins may act as another user")
+				return
+			case errors.Is(err, domain.ErrUserNotFound):
+				h.WriteJSONError(w, http.StatusNotFound, h.ErrCodeUserNotFound, "user not found")
+				return
+			case errors.Is(err, domain.ErrInvalidInput):
+				h.WriteJSONError(w, http.StatusBadRequest, h.ErrCodeBadRequest, err.Error())
+				return
+			case err != nil:
+				logger.ErrorContext(r.Context(), "act as check failed", "actor_id", actorID, "err", err)
+				h.WriteJSONError(w, http.StatusInternalServerError, h.ErrCodeInternalError, "could not check act as")
+				return
+			}
+
+			ctx, a := withActing(r.Context())
+			a.actorID, a.userID = actorID, targetID
+			ctx = SetUserID(ctx, targetID)
+			wrapped := &responseWriter{ResponseWriter: w, status: http.StatusOK}
+			r = r.WithContext(ctx)
+			next(wrapped, r)
+
+			LogSecurityEvent(logger, r, "request made acting as user", SecurityEvent{
+				Type: SecurityActAsRequest, Outcome: SecurityOutcomeSuccess, UserID: actorID, TargetType: "user", TargetID: targetID,
+				Details: []slog.Attr{slog.String("method", r.Method), slog.String("path", r.URL.Path), slog.Int("status", wrapped.status)},
+			})
+			activity := &domain.UserActivity{UserID: targetID, ActorID: actorID, Method: r.Method, Path: r.URL.Path, Status: wrapped.status}
+			if err := svc.RecordActivity(context.WithoutCancel(ctx), activity); err != nil {
+				logger.ErrorContext(ctx, "record act as activity failed", "err", err)
+			}
+		}
+	}
+}
+
+// actorHandler adds actor_id and acting_as to every record logged with the context of a request
+// made with X-Act-As.
+type actorHandler struct {
+	next slog.Handler
+}
+
+// ActorLogHandler wraps next so that every line logged with the context of a request made with
+// X-Act-As names the admin (actor_id) and the user acted as (acting_as).
+func ActorLogHandler(next slog.Handler) slog.Handler {
+	return actorHandler{next: next}
+}
+
+func (h actorHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h actorHandler) Handle(ctx context.Context, r slog.Record) error {
+	if attrs := actingAttrs(ctx); attrs != nil {
+		r = r.Clone()
+		r.AddAttrs(attrs...)
+	}
+	return h.next.Handle(ctx, r)
+}
+
+func (h actorHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return actorHandler{next: h.next.WithAttrs(attrs)}
+}
+
+func (h actorHandler) WithGroup(name string) slog.Handler {
+	return actorHandler{next: h.next.WithGroup(name)}
+}
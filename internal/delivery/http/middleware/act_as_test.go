@@ -0,0 +1,128 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	actAsAdmin = "00000000-0000-4000-8000-00000000000a"
+	actAsOwner = "00000000-0000-4000-8000-00000000000b"
+)
+
+// fakeActivityService lets actAsAdmin act as actAsOwner and keeps the recorded activity.
+type fakeActivityService struct {
+	recorded []*domain.UserActivity
+}
+
+func (f *fakeActivityService) AuthorizeActAs(ctx context.Context, actorID, userID string) error {
+	if actorID != actAsAdmin {
+		return domain.ErrForbidden
+	}
+	if userID != actAsOwner {
+		return domain.ErrUserNotFound
+	}
+	return nil
+}
+
+func (f *fakeActivityService) RecordActivity(ctx context.Context, a *domain.UserActivity) error {
+	f.recorded = append(f.recorded, a)
+	return nil
+}
+
+func (f *fakeActivityService) ListMyActivity(ctx context.Context, userID string, limit int) ([]*domain.UserActivity, error) {
+	return f.recorded, nil
+}
+
+func TestActAs(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	tests := []struct {
+		name       string
+		callerID   string
+		header     string
+		wantStatus int
+		wantCode   string
+		wantUserID string
+	}{
+		{name: "no header", callerID: actAsOwner, wantStatus: http.StatusOK, wantUserID: actAsOwner},
+		{name: "admin acts as owner", callerID: actAsAdmin, header: actAsOwner, wantStatus: http.StatusOK, wantUserID: actAsOwner},
+		{name: "not an admin", callerID: actAsOwner, header: actAsAdmin, wantStatus: http.StatusForbidden, wantCode: helpers.ErrCodeForbidden},
+		{name: "unknown user", callerID: actAsAdmin, header: "00000000-0000-4000-8000-0000000000ff", wantStatus: http.StatusNotFound, wantCode: helpers.ErrCodeUserNotFound},
+		{name: "not a user ID", callerID: actAsAdmin, header: "owner", wantStatus: http.StatusBadRequest, wantCode: helpers.ErrCodeBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &fakeActivityService{}
+			var gotUserID string
+			handler := ActAs(svc, logger)(func(w http.ResponseWriter, r *http.Request) {
+				gotUserID, _ = UserIDFromContext(r.Context())
+				w.WriteHeader(http.StatusOK)
+			})
+			req := httptest.NewRequest(http.MethodPatch, "/events/e1", nil)
+			if tt.header != "" {
+				req.Header.Set(ActAsHeader, tt.header)
+			}
+			req = req.WithContext(SetUserID(req.Context(), tt.callerID))
+			rec := httptest.NewRecorder()
+			handler(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+			if tt.wantCode != "" {
+				var body struct {
+					Error helpers.APIError `json:"error"`
+				}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+				assert.Equal(t, tt.wantCode, body.Error.Code)
+				assert.Empty(t, svc.recorded)
+				return
+			}
+			assert.Equal(t, tt.wantUserID, gotUserID)
+			if tt.header == "" {
+				assert.Empty(t, svc.recorded)
+				return
+			}
+			require.Len(t, svc.recorded, 1)
+			assert.Equal(t, domain.UserActivity{UserID: actAsOwner, ActorID: actAsAdmin, Method: http.MethodPatch, Path: "/events/e1", Status: http.StatusOK}, *svc.recorded[0])
+		})
+	}
+}
+
+func TestActorLogHandler(t *testing.T) {
+	var cap capturingHandler
+	logger := slog.New(ActorLogHandler(&cap))
+	svc := &fakeActivityService{}
+
+	handler := LoggingMiddleware(logger, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r = r.WithContext(SetUserID(r.Context(), actAsAdmin))
+		ActAs(svc, logger)(func(w http.ResponseWriter, r *http.Request) {
+			actorID, ok := ActorFromContext(r.Context())
+			assert.True(t, ok)
+			assert.Equal(t, actAsAdmin, actorID)
+			w.WriteHeader(http.StatusNoContent)
+		})(w, r)
+	}))
+	req := httptest.NewRequest(http.MethodGet, "/events/me", nil)
+	req.Header.Set(ActAsHeader, actAsOwner)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	require.Equal(t, "request", cap.record.Message)
+	attrs := make(map[string]string)
+	cap.record.Attrs(func(a slog.Attr) bool {
+		attrs[a.Key] = a.Value.String()
+		return true
+	})
+	assert.Equal(t, actAsAdmin, attrs["actor_id"], "the request line names the admin")
+	assert.Equal(t, actAsOwner, attrs["acting_as"])
+}
@@ -30,19 +30,9 @@ func UserIDFromContext(ctx context.Context) (string, bool) {
 func RequireAuth(verifier domain.TokenVerifier, logger *slog.Logger) func(http.HandlerFunc) http.HandlerFunc {
 	return func(next http.HandlerFunc) http.HandlerFunc {
 		return func(w http.ResponseWriter, r *http.Request) {
-			auth := r.Header.Get("Authorization")
-			if auth == "" {
-				h.WriteJSONError(w, http.StatusUnauthorized, h.ErrCodeUnauthorized, "missing authorization header")
-				return
-			}
-			const prefix = "Bearer "
-			if !strings.HasPrefix(auth, prefix) {
-				h.WriteJSONError(w, http.StatusUnauthorized, h.ErrCodeUnauthorized, "invalid authorization format")
-				return
-			}
-			token := strings.TrimSpace(auth[len(prefix):])
-			if token == "" {
-				h.WriteJSONError(w, http.StatusUnauthorized, h.ErrCodeUnauthorized, "missing token")
+			token, problem := bearerToken(r)
+			if problem != "" {
+				h.WriteJSONError(w, http.StatusUnauthorized, h.ErrCodeUnauthorized, problem)
 				return
 			}
 			userID, err := verifier.Verify(token)
@@ -55,3 +45,21 @@ func RequireAuth(verifier domain.TokenVerifier, logger *slog.Logger) func(http.H
 		}
 	}
 }
+
+// bearerToken returns the token of the request's "Authorization: Bearer" header, or a message
+// saying what is wrong with the header.
+func bearerToken(r *http.Request) (token, problem string) {
+	auth := r.Header.Get("Authorization")
+	if auth == "" {
+		return "", "missing authorization header"
+	}
+	const prefix = "Bearer "
+	if !strings.HasPrefix(auth, prefix) {
+		return "", "invalid authorization format"
+	}
+	token = strings.TrimSpace(auth[len(prefix):])
+	if token == "" {
+		return "", "missing token"
+	}
+	return token, ""
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"net/http"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+)
+
+// LimitBody returns a wrapper that caps request bodies at the limit of the named route class in
+// limits. A body that declares a larger Content-Length gets 413 payload_too_large before the
+// handler runs; one without a declared length fails when the handler reads past the limit, and
+// DecodeAndValidate answers that with the same error. Classes missing from limits keep
+// helpers.MaxRequestBodyBytes.
+func LimitBody(limits map[string]int64) func(class string, next http.HandlerFunc) http.HandlerFunc {
+	return func(class string, next http.HandlerFunc) http.HandlerFunc {
+		limit, ok := limits[class]
+		if !ok || limit <= 0 {
+			limit = helpers.MaxRequestBodyBytes
+		}
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.ContentLength > limit {
+				helpers.WritePayloadTooLarge(w, limit)
+				return
+			}
+			r = helpers.WithBodyLimit(r, limit)
+			r.Body = http.MaxBytesReader(w, r.Body, limit)
+			next(w, r)
+		}
+	}
+}
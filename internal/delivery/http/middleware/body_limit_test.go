@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimitBody(t *testing.T) {
+	limitBody := LimitBody(map[string]int64{"default": 16, "bulk": 64})
+	tests := []struct {
+		name       string
+		class      string
+		body       string
+		chunked    bool
+		wantStatus int
+	}{
+		{name: "within default", class: "default", body: `{"name":"a"}`, wantStatus: http.StatusOK},
+		{name: "over default", class: "default", body: `{"name":"` + strings.Repeat("a", 20) + `"}`, wantStatus: http.StatusRequestEntityTooLarge},
+		{name: "chunked over default", class: "default", body: `{"name":"` + strings.Repeat("a", 20) + `"}`, chunked: true, wantStatus: http.StatusRequestEntityTooLarge},
+		{name: "within bulk", class: "bulk", body: `{"name":"` + strings.Repeat("a", 20) + `"}`, wantStatus: http.StatusOK},
+		{name: "over bulk", class: "bulk", body: `{"name":"` + strings.Repeat("a", 60) + `"}`, wantStatus: http.StatusRequestEntityTooLarge},
+		{name: "unknown class keeps the default cap", class: "other", body: `{"name":"` + strings.Repeat("a", 60) + `"}`, wantStatus: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := limitBody(tt.class, func(w http.ResponseWriter, r *http.Request) {
+				var req struct {
+					Name string `json:"name"`
+				}
+				if !helpers.DecodeAndValidate(w, r, &req) {
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			})
+			req := httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(tt.body))
+			if tt.chunked {
+				req.ContentLength = -1
+			}
+			rec := httptest.NewRecorder()
+			handler(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+			if tt.wantStatus == http.StatusRequestEntityTooLarge {
+				var resp helpers.APIResponse
+				require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+				require.NotNil(t, resp.Error)
+				assert.Equal(t, helpers.ErrCodePayloadTooLarge, resp.Error.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"sort"
+	"sync"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/domain"
+)
+
+// CaptchaTokenHeader carries the captcha token when the client would rather not put it in the body.
+const CaptchaTokenHeader = "X-Captcha-Token"
+
+// captchaTokenField is the body field the captcha token may be sent in.
+const captchaTokenField = "captcha_token"
+
+// Challenge outcomes, as counted by ChallengeRecorder.
+const (
+	challengePassed          = "passed"
+	challengeHoneypot        = "honeypot"
+	challengeCaptchaMissing  = "captcha_missing"
+	challengeCaptchaRejected = "captcha_rejected"
+	challengeCaptchaError    = "captcha_error"
+)
+
+// ChallengeRecorder counts bot challenge outcomes per endpoint. It implements
+// domain.BotChallengeReporter and is safe for concurrent use.
+type ChallengeRecorder struct {
+	mu    sync.Mutex
+	stats map[string]*domain.BotChallengeStats
+}
+
+// NewChallengeRecorder returns an empty ChallengeRecorder.
+func NewChallengeRecorder() *ChallengeRecorder {
+	return &ChallengeRecorder{stats: make(map[string]*domain.BotChallengeStats)}
+}
+
+func (c *ChallengeRecorder) record(endpoint string, policy domain.ChallengePolicy, outcome string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	st, ok := c.stats[endpoint]
+	if !ok {
+		st = &domain.BotChallengeStats{Endpoint: endpoint}
+		c.stats[endpoint] = st
+	}
+	st.Policy = policy
+	switch outcome {
+	case challengePassed:
+		st.Passed++
+	case challengeHoneypot:
+		st.Honeypot++
+	case challengeCaptchaMissing:
+		st.CaptchaMissing++
+	case challengeCaptchaRejected:
+		st.CaptchaRejected++
+	case challengeCaptchaError:
+		st.CaptchaErrors++
+	}
+}
+
+// BotChallengeReport implements domain.BotChallengeReporter, sorted by endpoint.
+func (c *ChallengeRecorder) BotChallengeReport() []domain.BotChallengeStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	out := make([]domain.BotChallengeStats, 0, len(c.stats))
+	for _, st := range c.stats {
+		out = append(out, *st)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Endpoint < out[j].Endpoint })
+	return out
+}
+
+// BotChallenge returns a wrapper that screens requests to a named endpoint for bots according to
+// its policy. A request that fills in honeypotField gets 400 bot_detected; when the policy asks
+// for a captcha, a request without a token (in the CaptchaTokenHeader header or the captcha_token
+// body field) or with one the verifier refuses gets 400 captcha_failed. Both fields are removed
+// from the body before next reads it, so handlers that reject unknown fields are unaffected.
+// Endpoints without a policy, and captcha checks when verifier is nil, are passed through. rec may
+// be nil.
+func BotChallenge(policies map[string]domain.ChallengePolicy, honeypotField string, verifier domain.CaptchaVerifier, rec *ChallengeRecorder, logger *slog.Logger) func(endpoint string, next http.HandlerFunc) http.HandlerFunc {
+	return func(endpoint string, next http.HandlerFunc) http.HandlerFunc {
+		policy := policies[endpoint]
+		if verifier == nil {
+			policy.Captcha = false
+		}
+		if honeypotField == "" {
+			policy.Honeypot = false
+		}
+		if !policy.Honeypot && !policy.Captcha {
+			return next
+		}
+		outcome := func(r *http.Request, result string) {
+			if rec != nil {
+				rec.record(endpoint, policy, result)
+			}
+			if result != challengePassed {
+				logger.InfoContext(r.Context(), "bot challenge failed", "endpoint", endpoint, "outcome", result, "ip", helpers.ClientIP(r))
+			}
+		}
+		return func(w http.ResponseWriter, r *http.Request) {
+			limit := helpers.BodyLimit(r)
+			raw, err := io.ReadAll(http.MaxBytesReader(w, r.Body, limit))
+			if err != nil {
+				var tooLarge *http.MaxBytesError
+				if errors.As(err, &tooLarge) {
+					helpers.WritePayloadTooLarge(w, limit)
+					return
+				}
+				helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBadRequest, err.Error())
+				return
+			}
+			// A body that is not a JSON object is left for the handler to reject.
+			var fields map[string]json.RawMessage
+			if json.Unmarshal(raw, &fields) != nil {
+				fields = nil
+			}
+
+			if policy.Honeypot && filledIn(fields[honeypotField]) {
+				outcome(r, challengeHoneypot)
+				helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeBotDetected, "request looks automated")
+				return
+			}
+			if policy.Captcha {
+				token := r.Header.Get(CaptchaTokenHeader)
+				if token == "" {
+					_ = json.Unmarshal(fields[captchaTokenField], &token)
+				}
+				if token == "" {
+					outcome(r, challengeCaptchaMissing)
+					helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeCaptchaFailed, "captcha token is required")
+					return
+				}
+				ok, err := verifier.Verify(r.Context(), token, helpers.ClientIP(r))
+				if err != nil {
+					outcome(r, challengeCaptchaError)
+					logger.ErrorContext(r.Context(), "captcha verification failed", "endpoint", endpoint, "err", err)
+					helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, "captcha verification failed")
+					return
+				}
+				if !ok {
+					outcome(r, challengeCaptchaRejected)
+					helpers.WriteJSONError(w, http.StatusBadRequest, helpers.ErrCodeCaptchaFailed, "captcha verification failed")
+					return
+				}
+			}
+			outcome(r, challengePassed)
+
+			_, hasHoneypot := fields[honeypotField]
+			_, hasToken := fields[captchaTokenField]
+			if hasHoneypot || hasToken {
+				delete(fields, honeypotField)
+				delete(fields, captchaTokenField)
+				if raw, err = json.Marshal(fields); err != nil {
+					helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, err.Error())
+					return
+				}
+			}
+			r.Body = io.NopCloser(bytes.NewReader(raw))
+			r.ContentLength = int64(len(raw))
+			next(w, r)
+		}
+	}
+}
+
+// filledIn reports whether a honeypot value holds anything: an absent field, null, an empty
+// string and false all count as left alone.
+func filledIn(v json.RawMessage) bool {
+	switch string(bytes.TrimSpace(v)) {
+	case "", "null", `""`, "false":
+		return false
+	}
+	return true
+}
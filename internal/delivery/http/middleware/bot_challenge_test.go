@@ -0,0 +1,108 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCaptcha accepts the token "good" and fails with err when it is set.
+type fakeCaptcha struct {
+	err error
+}
+
+func (f *fakeCaptcha) Verify(_ context.Context, token, _ string) (bool, error) {
+	if f.err != nil {
+		return false, f.err
+	}
+	return token == "good", nil
+}
+
+func TestBotChallenge(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	policies := map[string]domain.ChallengePolicy{
+		"contact": {Honeypot: true, Captcha: true},
+		"login":   {Honeypot: true},
+	}
+
+	tests := []struct {
+		name       string
+		endpoint   string
+		body       string
+		header     string
+		captchaErr error
+		wantStatus int
+		wantCode   string
+		wantBody   string
+	}{
+		{name: "passes and strips fields", endpoint: "contact", body: `{"name":"Ada","website":"","captcha_token":"good"}`, wantStatus: http.StatusOK, wantBody: `{"name":"Ada"}`},
+		{name: "token in header", endpoint: "contact", body: `{"name":"Ada"}`, header: "good", wantStatus: http.StatusOK, wantBody: `{"name":"Ada"}`},
+		{name: "honeypot filled in", endpoint: "contact", body: `{"name":"Ada","website":"http://spam.example","captcha_token":"good"}`, wantStatus: http.StatusBadRequest, wantCode: helpers.ErrCodeBotDetected},
+		{name: "token missing", endpoint: "contact", body: `{"name":"Ada"}`, wantStatus: http.StatusBadRequest, wantCode: helpers.ErrCodeCaptchaFailed},
+		{name: "token rejected", endpoint: "contact", body: `{"name":"Ada","captcha_token":"bad"}`, wantStatus: http.StatusBadRequest, wantCode: helpers.ErrCodeCaptchaFailed},
+		{name: "provider down", endpoint: "contact", body: `{"name":"Ada","captcha_token":"good"}`, captchaErr: errors.New("timeout"), wantStatus: http.StatusInternalServerError, wantCode: helpers.ErrCodeInternalError},
+		{name: "honeypot only", endpoint: "login", body: `{"email":"ada@example.com"}`, wantStatus: http.StatusOK, wantBody: `{"email":"ada@example.com"}`},
+		{name: "no policy", endpoint: "report", body: `{"website":"x"}`, wantStatus: http.StatusOK, wantBody: `{"website":"x"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := NewChallengeRecorder()
+			wrap := BotChallenge(policies, "website", &fakeCaptcha{err: tt.captchaErr}, rec, logger)
+			var got string
+			handler := wrap(tt.endpoint, func(w http.ResponseWriter, r *http.Request) {
+				b, _ := io.ReadAll(r.Body)
+				got = string(b)
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tt.body))
+			if tt.header != "" {
+				req.Header.Set(CaptchaTokenHeader, tt.header)
+			}
+			w := httptest.NewRecorder()
+			handler(w, req)
+
+			require.Equal(t, tt.wantStatus, w.Code, w.Body.String())
+			if tt.wantCode != "" {
+				var resp helpers.APIResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				require.NotNil(t, resp.Error)
+				assert.Equal(t, tt.wantCode, resp.Error.Code)
+				assert.Empty(t, got, "handler must not run")
+			} else {
+				assert.JSONEq(t, tt.wantBody, got)
+			}
+		})
+	}
+
+	t.Run("counts outcomes", func(t *testing.T) {
+		rec := NewChallengeRecorder()
+		handler := BotChallenge(policies, "website", &fakeCaptcha{}, rec, logger)("contact", func(w http.ResponseWriter, r *http.Request) {})
+		for _, body := range []string{`{"captcha_token":"good"}`, `{"website":"x"}`, `{}`, `{"captcha_token":"bad"}`} {
+			handler(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+		}
+		assert.Equal(t, []domain.BotChallengeStats{{
+			Endpoint: "contact", Policy: policies["contact"],
+			Passed: 1, Honeypot: 1, CaptchaMissing: 1, CaptchaRejected: 1,
+		}}, rec.BotChallengeReport())
+	})
+
+	t.Run("captcha off without a verifier", func(t *testing.T) {
+		handler := BotChallenge(policies, "website", nil, nil, logger)("contact", func(w http.ResponseWriter, r *http.Request) {})
+		w := httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name":"Ada"}`)))
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+// purgeTimeout bounds one CDN purge. Purges run after the response, so they never delay it.
+const purgeTimeout = 10 * time.Second
+
+// PurgeEventCache returns a wrapper that, after a successful request to a route with an {eventID},
+// purges the event's surrogate key so a CDN stops serving stale public responses. The purge runs in
+// the background and failures are logged. With a nil purger the handler is returned as is.
+func PurgeEventCache(purger domain.CachePurger, logger *slog.Logger) func(http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		if purger == nil {
+			return next
+		}
+		return func(w http.ResponseWriter, r *http.Request) {
+			wrapped := &responseWriter{ResponseWriter: w, status: http.StatusOK}
+			next(wrapped, r)
+			eventID := r.PathValue("eventID")
+			if eventID == "" || wrapped.status >= http.StatusBadRequest {
+				return
+			}
+			go func() {
+				ctx, cancel := context.WithTimeout(context.WithoutCancel(r.Context()), purgeTimeout)
+				defer cancel()
+				if err := purger.Purge(ctx, domain.EventSurrogateKey(eventID)); err != nil {
+					logger.ErrorContext(ctx, "cdn purge failed", "event_id", eventID, "err", err)
+				}
+			}()
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// chanPurger sends the keys of every purge on keys.
+type chanPurger struct {
+	keys chan []string
+}
+
+func (p *chanPurger) Purge(_ context.Context, keys ...string) error {
+	p.keys <- keys
+	return nil
+}
+
+func TestPurgeEventCache(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	tests := []struct {
+		name      string
+		pattern   string
+		path      string
+		status    int
+		wantPurge bool
+	}{
+		{"event changed", "PATCH /events/{eventID}", "/events/ev-1", http.StatusOK, true},
+		{"nested route", "DELETE /events/{eventID}/rooms/{roomID}", "/events/ev-1/rooms/r-1", http.StatusNoContent, true},
+		{"failed request", "PATCH /events/{eventID}", "/events/ev-1", http.StatusForbidden, false},
+		{"no event", "PATCH /users/me", "/users/me", http.StatusOK, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			purger := &chanPurger{keys: make(chan []string, 1)}
+			mux := http.NewServeMux()
+			mux.HandleFunc(tt.pattern, PurgeEventCache(purger, logger)(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			method, _, _ := strings.Cut(tt.pattern, " ")
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(method, tt.path, nil))
+			require.Equal(t, tt.status, rec.Code)
+
+			select {
+			case keys := <-purger.keys:
+				require.True(t, tt.wantPurge, "unexpected purge of %v", keys)
+				require.Equal(t, []string{"event-ev-1"}, keys)
+			case <-time.After(100 * time.Millisecond):
+				require.False(t, tt.wantPurge, "expected a purge")
+			}
+		})
+	}
+}
+
+func TestPurgeEventCache_NilPurger(t *testing.T) {
+	called := false
+	handler := PurgeEventCache(nil, nil)(func(w http.ResponseWriter, r *http.Request) { called = true })
+	handler(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/events", nil))
+	require.True(t, called)
+}
@@ -0,0 +1,17 @@
+package middleware
+
+import (
+	"net/http"
+	"net/netip"
+
+	h "multitrackticketing/internal/delivery/http/helpers"
+)
+
+// ResolveClientIP works out the address each request came from, reading X-Forwarded-For only on
+// connections from the trusted proxies, and stores it for helpers.ClientIP. Rate limits, IP
+// allowlists, bot checks and security logs all key on that address, so it must wrap the router.
+func ResolveClientIP(trusted []netip.Prefix, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, h.WithClientIP(r, h.ResolveClientIP(r, trusted)))
+	})
+}
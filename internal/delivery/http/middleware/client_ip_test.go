@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	h "multitrackticketing/internal/delivery/http/helpers"
+)
+
+func TestResolveClientIP(t *testing.T) {
+	var got string
+	handler := ResolveClientIP([]netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = h.ClientIP(r)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "10.0.0.2:1234"
+	req.Header.Set("X-Forwarded-For", "6.6.6.6, 203.0.113.7")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, "203.0.113.7", got, "the balancer's entry")
+
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "198.51.100.9:1234"
+	req.Header.Set("X-Forwarded-For", "203.0.113.7")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, "198.51.100.9", got, "a direct caller cannot pick its address")
+}
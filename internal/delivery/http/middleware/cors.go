@@ -7,8 +7,10 @@ import (
 
 const (
 	corsAllowMethods = "GET, POST, PATCH, PUT, DELETE, OPTIONS"
-	corsAllowHeaders = "Authorization, Content-Type, Accept"
+	corsAllowHeaders = "Authorization, Content-Type, Accept, X-Captcha-Token, X-Act-As"
 	corsMaxAge       = "86400"
+	// corsExposeHeaders are the response headers browser clients may read.
+	corsExposeHeaders = RequestIDHeader
 )
 
 // CORS returns a handler that adds CORS headers for allowed origins and
@@ -57,5 +59,18 @@ type corsResponseWriter struct {
 func (w *corsResponseWriter) WriteHeader(code int) {
 	w.ResponseWriter.Header().Set("Access-Control-Allow-Origin", w.origin)
 	w.ResponseWriter.Header().Set("Access-Control-Allow-Credentials", "true")
+	w.ResponseWriter.Header().Set("Access-Control-Expose-Headers", corsExposeHeaders)
 	w.ResponseWriter.WriteHeader(code)
 }
+
+// Flush lets streaming handlers (NDJSON lists) flush through the wrapper.
+func (w *corsResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (w *corsResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+)
+
+// RequestDeadline returns a wrapper that gives the request context the deadline of the named route
+// class in deadlines. Services and repositories derive their contexts from the request's, so a slow
+// query is canceled at the deadline instead of holding the request open. A handler that then fails
+// with a 5xx, or writes nothing, answers 504 timeout instead. Classes missing from deadlines, or
+// with a deadline <= 0, get none.
+func RequestDeadline(deadlines map[string]time.Duration, logger *slog.Logger) func(class string, next http.HandlerFunc) http.HandlerFunc {
+	return func(class string, next http.HandlerFunc) http.HandlerFunc {
+		timeout := deadlines[class]
+		if timeout <= 0 {
+			return next
+		}
+		return func(w http.ResponseWriter, r *http.Request) {
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
+			defer cancel()
+			dw := &deadlineWriter{ResponseWriter: w, ctx: ctx}
+			next(dw, r.WithContext(ctx))
+			if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return
+			}
+			if !dw.wroteHeader {
+				dw.WriteHeader(http.StatusGatewayTimeout)
+			}
+			if dw.timedOut {
+				logger.WarnContext(r.Context(), "request deadline exceeded",
+					"method", r.Method,
+					"path", r.URL.Path,
+					"timeout", timeout.String(),
+				)
+			}
+		}
+	}
+}
+
+// deadlineWriter replaces a 5xx written after the request's deadline with 504 timeout and drops
+// the handler's own body; any other response passes through.
+type deadlineWriter struct {
+	http.ResponseWriter
+	ctx         context.Context
+	wroteHeader bool
+	timedOut    bool
+}
+
+func (w *deadlineWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	if code >= http.StatusInternalServerError && errors.Is(w.ctx.Err(), context.DeadlineExceeded) {
+		w.timedOut = true
+		// The handler may have marked the response cacheable; a timeout must not be cached.
+		w.Header().Set("Cache-Control", "no-store")
+		helpers.WriteJSONError(w.ResponseWriter, http.StatusGatewayTimeout, helpers.ErrCodeTimeout,
+			"the request took too long; retry it later")
+		return
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *deadlineWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.timedOut {
+		return len(b), nil
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush lets streaming handlers (NDJSON lists) flush through the wrapper.
+func (w *deadlineWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok && !w.timedOut {
+		f.Flush()
+	}
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (w *deadlineWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
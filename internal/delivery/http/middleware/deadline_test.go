@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestDeadline(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	withDeadline := RequestDeadline(map[string]time.Duration{"default": 20 * time.Millisecond, "long": time.Minute}, logger)
+
+	// slowQuery stands in for a repository call: it waits for the context like database/sql does.
+	slowQuery := func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+			helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, r.Context().Err().Error())
+		case <-time.After(200 * time.Millisecond):
+			helpers.WriteJSONSuccess(w, http.StatusOK, "done")
+		}
+	}
+	tests := []struct {
+		name       string
+		class      string
+		handler    http.HandlerFunc
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "slow query times out", class: "default", handler: slowQuery, wantStatus: http.StatusGatewayTimeout, wantCode: helpers.ErrCodeTimeout},
+		{name: "long class waits", class: "long", handler: slowQuery, wantStatus: http.StatusOK},
+		{name: "unknown class has no deadline", class: "other", handler: slowQuery, wantStatus: http.StatusOK},
+		{name: "handler writing nothing times out", class: "default", handler: func(w http.ResponseWriter, r *http.Request) {
+			<-r.Context().Done()
+		}, wantStatus: http.StatusGatewayTimeout, wantCode: helpers.ErrCodeTimeout},
+		{name: "client error passes through", class: "default", handler: func(w http.ResponseWriter, r *http.Request) {
+			<-r.Context().Done()
+			helpers.WriteJSONError(w, http.StatusNotFound, helpers.ErrCodeNotFound, "not found")
+		}, wantStatus: http.StatusNotFound, wantCode: helpers.ErrCodeNotFound},
+		{name: "fast request keeps its response", class: "default", handler: func(w http.ResponseWriter, r *http.Request) {
+			helpers.WriteJSONSuccess(w, http.StatusOK, "done")
+		}, wantStatus: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/events", nil)
+			rec := httptest.NewRecorder()
+			withDeadline(tt.class, tt.handler)(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+			var resp helpers.APIResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp), "body must be a single envelope")
+			if tt.wantCode == "" {
+				assert.Nil(t, resp.Error)
+				return
+			}
+			require.NotNil(t, resp.Error)
+			assert.Equal(t, tt.wantCode, resp.Error.Code)
+		})
+	}
+}
+
+func TestRequestDeadline_Streaming(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	withDeadline := RequestDeadline(map[string]time.Duration{"long": time.Minute}, logger)
+
+	var flushed, hasDeadline bool
+	handler := withDeadline("long", func(w http.ResponseWriter, r *http.Request) {
+		_, hasDeadline = r.Context().Deadline()
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("{}\n"))
+		f, ok := w.(http.Flusher)
+		if ok {
+			f.Flush()
+		}
+		flushed = ok
+	})
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/events", nil).WithContext(context.Background()))
+
+	assert.True(t, hasDeadline)
+	assert.True(t, flushed)
+	assert.True(t, rec.Flushed)
+	assert.Equal(t, "{}\n", rec.Body.String())
+}
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	h "multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/domain"
+)
+
+// RequireAllowedIP returns a wrapper that refuses requests from addresses outside the
+// authenticated account's IP allowlist with 403 ip_not_allowed, and logs each refusal as an audit
+// entry. The response does not name the allowed ranges: whoever holds a stolen token should not learn
+// where to use it from. It must run after RequireAuth; requests without a user ID are passed through.
+func RequireAllowedIP(checker domain.IPAllowlistChecker, logger *slog.Logger) func(http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			userID, ok := UserIDFromContext(r.Context())
+			if !ok {
+				next(w, r)
+				return
+			}
+			_, err := checker.CheckAddress(r.Context(), userID, h.ClientIP(r))
+			if errors.Is(err, domain.ErrIPNotAllowed) {
+				LogSecurityEvent(logger, r, "request outside ip allowlist", SecurityEvent{
+					Type: SecurityIPAllowlistDeny, Outcome: SecurityOutcomeDenied, UserID: userID,
+					Details: []slog.Attr{slog.String("method", r.Method), slog.String("path", r.URL.Path)},
+				})
+				h.WriteJSONError(w, http.StatusForbidden, h.ErrCodeIPNotAllowed, "requests from this address are not allowed for this account")
+				return
+			}
+			if err != nil {
+				logger.ErrorContext(r.Context(), "ip allowlist check failed", "user_id", userID, "err", err)
+				h.WriteJSONError(w, http.StatusInternalServerError, h.ErrCodeInternalError, "could not check the ip allowlist")
+				return
+			}
+			next(w, r)
+		}
+	}
+}
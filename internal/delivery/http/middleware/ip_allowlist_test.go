@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAllowlistChecker allows only the address allowed, or fails with err.
+type fakeAllowlistChecker struct {
+	allowed string
+	err     error
+}
+
+func (f *fakeAllowlistChecker) CheckAddress(_ context.Context, userID, ip string) (*domain.IPAllowlist, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	list := &domain.IPAllowlist{UserID: userID, Ranges: []string{f.allowed + "/32"}}
+	if ip != f.allowed {
+		return list, domain.ErrIPNotAllowed
+	}
+	return list, nil
+}
+
+func (f *fakeAllowlistChecker) CheckMachineAddress(_ context.Context, clientID, ip string) (*domain.IPAllowlist, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	list := &domain.IPAllowlist{ClientID: clientID, Ranges: []string{f.allowed + "/32"}}
+	if ip != f.allowed {
+		return list, domain.ErrIPNotAllowed
+	}
+	return list, nil
+}
+
+func TestRequireAllowedIP(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	tests := []struct {
+		name       string
+		userID     string
+		ip         string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "allowed", userID: "user-1", ip: "203.0.113.7", wantStatus: http.StatusOK},
+		{name: "outside allowlist", userID: "user-1", ip: "198.51.100.2", wantStatus: http.StatusForbidden, wantCode: helpers.ErrCodeIPNotAllowed},
+		{name: "lookup failed", userID: "user-1", ip: "203.0.113.7", err: errors.New("db down"), wantStatus: http.StatusInternalServerError, wantCode: helpers.ErrCodeInternalError},
+		{name: "no user", ip: "198.51.100.2", wantStatus: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := &fakeAllowlistChecker{allowed: "203.0.113.7", err: tt.err}
+			handler := RequireAllowedIP(checker, logger)(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+			req := httptest.NewRequest(http.MethodGet, "/events/me", nil)
+			req.RemoteAddr = tt.ip + ":1234"
+			if tt.userID != "" {
+				req = req.WithContext(SetUserID(req.Context(), tt.userID))
+			}
+			w := httptest.NewRecorder()
+			handler(w, req)
+
+			require.Equal(t, tt.wantStatus, w.Code, w.Body.String())
+			if tt.wantCode != "" {
+				var resp helpers.APIResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				require.NotNil(t, resp.Error)
+				assert.Equal(t, tt.wantCode, resp.Error.Code)
+				if tt.wantCode == helpers.ErrCodeIPNotAllowed {
+					assert.NotContains(t, resp.Error.Message, "203.0.113.7", "the allowed ranges are not disclosed")
+				}
+			}
+		})
+	}
+}
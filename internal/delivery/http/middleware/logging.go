@@ -4,6 +4,8 @@ import (
 	"log/slog"
 	"net/http"
 	"time"
+
+	"multitrackticketing/internal/domain"
 )
 
 // responseWriter wraps http.ResponseWriter to capture status code and bytes written.
@@ -24,19 +26,43 @@ func (w *responseWriter) Write(b []byte) (n int, err error) {
 	return n, err
 }
 
-// LoggingMiddleware logs each request with method, path, status, and duration.
-// It does not log request or response bodies.
+// Flush lets streaming handlers (NDJSON lists) flush through the wrapper.
+func (w *responseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// LoggingMiddleware logs each request with method, path, status, and duration, and its correlation
+// ID when RequestID runs first. It does not log request or response bodies. The line is logged with
+// the request's context, so a logger using ActorLogHandler names the admin of a request made with
+// X-Act-As. Each best-effort failure a service reported while serving the request is logged as a
+// warning after it.
 func LoggingMiddleware(logger *slog.Logger, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
 		wrapped := &responseWriter{ResponseWriter: w, status: http.StatusOK}
-		next.ServeHTTP(wrapped, r)
+		ctx, _ := withActing(r.Context())
+		ctx, failures := domain.WithBestEffortFailures(ctx)
+		next.ServeHTTP(wrapped, r.WithContext(ctx))
 		duration := time.Since(start)
-		logger.Info("request",
+		attrs := []any{
 			"method", r.Method,
 			"path", r.URL.Path,
 			"status", wrapped.status,
 			"duration_ms", duration.Milliseconds(),
-		)
+		}
+		if id := RequestIDFromContext(ctx); id != "" {
+			attrs = append(attrs, "request_id", id)
+		}
+		logger.InfoContext(ctx, "request", attrs...)
+		for _, err := range failures.Errors() {
+			logger.WarnContext(ctx, "best-effort step failed", "path", r.URL.Path, "method", r.Method, "err", err)
+		}
 	})
 }
@@ -1,11 +1,17 @@
 package middleware
 
 import (
+	"bufio"
 	"context"
+	"errors"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/domain"
 
 	"github.com/stretchr/testify/require"
 )
@@ -70,3 +76,90 @@ func TestLoggingMiddleware(t *testing.T) {
 		})
 	}
 }
+
+// recordingHandler records every log record.
+type recordingHandler struct {
+	records []slog.Record
+}
+
+func (h *recordingHandler) Enabled(_ context.Context, _ slog.Level) bool { return true }
+
+func (h *recordingHandler) Handle(_ context.Context, r slog.Record) error {
+	h.records = append(h.records, r.Clone())
+	return nil
+}
+
+func (h *recordingHandler) WithAttrs(_ []slog.Attr) slog.Handler { return h }
+
+func (h *recordingHandler) WithGroup(_ string) slog.Handler { return h }
+
+func TestLoggingMiddleware_BestEffortFailures(t *testing.T) {
+	var rec recordingHandler
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		domain.ReportBestEffort(r.Context(), errors.New("cdn down"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	handler := LoggingMiddleware(slog.New(&rec), next)
+	rr := httptest.NewRecorder()
+
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPut, "http://test/events/ev-1", nil))
+
+	require.Equal(t, http.StatusNoContent, rr.Code)
+	require.Len(t, rec.records, 2)
+	require.Equal(t, "request", rec.records[0].Message)
+	warn := rec.records[1]
+	require.Equal(t, slog.LevelWarn, warn.Level)
+	attrs := make(map[string]slog.Value)
+	warn.Attrs(func(a slog.Attr) bool {
+		attrs[a.Key] = a.Value
+		return true
+	})
+	require.Equal(t, "/events/ev-1", attrs["path"].String())
+	require.Equal(t, "cdn down", attrs["err"].String())
+}
+
+func TestLoggingMiddleware_StreamsThroughChain(t *testing.T) {
+	firstBatch := make(chan struct{})
+	release := make(chan struct{})
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		stream := helpers.NewNDJSONWriter(w)
+		for i := range 200 {
+			require.NoError(t, stream.Write(map[string]int{"row": i}))
+			if i == 99 {
+				close(firstBatch)
+				select {
+				case <-release:
+				case <-r.Context().Done():
+					return
+				}
+			}
+		}
+		stream.Close()
+	})
+	handler := CORS([]string{"https://admin.example.com"}, SecurityHeaders(RequestID(LoggingMiddleware(slog.New(&recordingHandler{}), next))))
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/events/ev-1/sessions", nil)
+	require.NoError(t, err)
+	req.Header.Set("Origin", "https://admin.example.com")
+	client := srv.Client()
+	client.Timeout = 5 * time.Second
+	res, err := client.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	<-firstBatch
+
+	// The first 100 rows must reach the client while the handler is still blocked.
+	lines := bufio.NewScanner(res.Body)
+	for range 100 {
+		require.True(t, lines.Scan())
+	}
+	close(release)
+	rest := 0
+	for lines.Scan() {
+		rest++
+	}
+	require.Equal(t, 100, rest)
+	require.Equal(t, "https://admin.example.com", res.Header.Get("Access-Control-Allow-Origin"))
+}
@@ -0,0 +1,104 @@
+package middleware
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeMachineVerifier accepts "machine-token" as a token of its principal.
+type fakeMachineVerifier struct {
+	principal *domain.MachinePrincipal
+}
+
+func (f *fakeMachineVerifier) VerifyMachine(token string) (*domain.MachinePrincipal, error) {
+	if token != "machine-token" {
+		return nil, errors.New("invalid token")
+	}
+	return f.principal, nil
+}
+
+func TestRequireScope(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	principal := &domain.MachinePrincipal{ClientID: "client-1", EventID: "event-1", Scopes: []string{domain.ScopeScheduleRead}}
+	requireScope := RequireScope(&fakeMachineVerifier{principal: principal}, &fakeAllowlistChecker{allowed: "203.0.113.7"}, logger)
+
+	tests := []struct {
+		name       string
+		authHeader string
+		ip         string
+		xff        string
+		scope      string
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "token with the scope", authHeader: "Bearer machine-token", scope: domain.ScopeScheduleRead, wantStatus: http.StatusOK},
+		{name: "token without the scope", authHeader: "Bearer machine-token", scope: "checkins:write", wantStatus: http.StatusForbidden, wantCode: helpers.ErrCodeInsufficientScope},
+		{name: "outside the client's allowlist", authHeader: "Bearer machine-token", ip: "198.51.100.2", scope: domain.ScopeScheduleRead, wantStatus: http.StatusForbidden, wantCode: helpers.ErrCodeIPNotAllowed},
+		{name: "forged forwarded address", authHeader: "Bearer machine-token", ip: "198.51.100.2", xff: "203.0.113.7", scope: domain.ScopeScheduleRead, wantStatus: http.StatusForbidden, wantCode: helpers.ErrCodeIPNotAllowed},
+		{name: "user token", authHeader: "Bearer user-token", scope: domain.ScopeScheduleRead, wantStatus: http.StatusUnauthorized, wantCode: helpers.ErrCodeUnauthorized},
+		{name: "no token", scope: domain.ScopeScheduleRead, wantStatus: http.StatusUnauthorized, wantCode: helpers.ErrCodeUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *domain.MachinePrincipal
+			handler := requireScope(tt.scope, func(w http.ResponseWriter, r *http.Request) {
+				got, _ = MachineFromContext(r.Context())
+				w.WriteHeader(http.StatusOK)
+			})
+			req := httptest.NewRequest(http.MethodGet, "/machine/events/event-1/schedule", nil)
+			req.RemoteAddr = "203.0.113.7:1234"
+			if tt.ip != "" {
+				req.RemoteAddr = tt.ip + ":1234"
+			}
+			if tt.xff != "" {
+				req.Header.Set("X-Forwarded-For", tt.xff)
+			}
+			if tt.authHeader != "" {
+				req.Header.Set("Authorization", tt.authHeader)
+			}
+			rec := httptest.NewRecorder()
+			ResolveClientIP(nil, handler).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantCode == "" {
+				assert.Equal(t, principal, got)
+				return
+			}
+			assert.Nil(t, got, "next must not run")
+			var resp helpers.APIResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			require.NotNil(t, resp.Error)
+			assert.Equal(t, tt.wantCode, resp.Error.Code)
+			assert.NotContains(t, resp.Error.Message, "203.0.113.7", "the allowed ranges are not disclosed")
+		})
+	}
+
+	t.Run("revoked client", func(t *testing.T) {
+		revoked := RequireScope(&fakeMachineVerifier{principal: principal}, &fakeAllowlistChecker{allowed: "203.0.113.7", err: domain.ErrInvalidClient}, logger)
+		handler := revoked(domain.ScopeScheduleRead, func(w http.ResponseWriter, r *http.Request) {
+			t.Fatal("next must not run")
+		})
+		req := httptest.NewRequest(http.MethodGet, "/machine/events/event-1/schedule", nil)
+		req.RemoteAddr = "203.0.113.7:1234"
+		req.Header.Set("Authorization", "Bearer machine-token")
+		rec := httptest.NewRecorder()
+		ResolveClientIP(nil, handler).ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusUnauthorized, rec.Code, "a still valid token of a revoked client is refused")
+		var resp helpers.APIResponse
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+		require.NotNil(t, resp.Error)
+		assert.Equal(t, helpers.ErrCodeUnauthorized, resp.Error.Code)
+	})
+}
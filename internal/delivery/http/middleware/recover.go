@@ -0,0 +1,148 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+	"sort"
+	"sync"
+	"time"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/domain"
+)
+
+// unmatchedRoute stands in for the route of a panic raised before the router picked one.
+const unmatchedRoute = "(none)"
+
+// PanicRecorder counts recovered panics per route and keeps the last one. It implements
+// domain.PanicReporter and is safe for concurrent use.
+type PanicRecorder struct {
+	mu     sync.Mutex
+	total  int64
+	routes map[string]int64
+	last   *domain.PanicReport
+}
+
+// NewPanicRecorder returns an empty PanicRecorder.
+func NewPanicRecorder() *PanicRecorder {
+	return &PanicRecorder{routes: make(map[string]int64)}
+}
+
+func (p *PanicRecorder) record(report *domain.PanicReport) {
+	route := report.Route
+	if route == "" {
+		route = unmatchedRoute
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.total++
+	p.routes[route]++
+	p.last = report
+}
+
+// PanicReport implements domain.PanicReporter, with routes sorted by pattern.
+func (p *PanicRecorder) PanicReport() domain.PanicStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	stats := domain.PanicStats{Total: p.total, Routes: make([]domain.RoutePanics, 0, len(p.routes))}
+	for route, n := range p.routes {
+		stats.Routes = append(stats.Routes, domain.RoutePanics{Route: route, Count: n})
+	}
+	sort.Slice(stats.Routes, func(i, j int) bool { return stats.Routes[i].Route < stats.Routes[j].Route })
+	if p.last != nil {
+		last := *p.last
+		stats.Last = &last
+	}
+	return stats
+}
+
+// Recover returns a handler that turns a panic in next into a 500 internal_error envelope, unless
+// the response had already started. The panic is logged with its stack and the request's
+// correlation ID, counted in rec and, when reporter is set, sent to it in the background. rec and
+// reporter may be nil. http.ErrAbortHandler is re-raised, as net/http expects.
+func Recover(logger *slog.Logger, rec *PanicRecorder, reporter domain.ErrorReporter, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rw := &recoverWriter{ResponseWriter: w}
+		defer func() {
+			v := recover()
+			if v == nil {
+				return
+			}
+			if v == http.ErrAbortHandler {
+				panic(v)
+			}
+			report := &domain.PanicReport{
+				RequestID: RequestIDFromContext(r.Context()),
+				Method:    r.Method,
+				Path:      r.URL.Path,
+				// The router sets Pattern on the request it was handed, which is r.
+				Route: r.Pattern,
+				Value: fmt.Sprint(v),
+				Stack: string(debug.Stack()),
+				At:    time.Now(),
+			}
+			logger.ErrorContext(r.Context(), "panic recovered",
+				"request_id", report.RequestID,
+				"method", report.Method,
+				"path", report.Path,
+				"route", report.Route,
+				"panic", report.Value,
+				"stack", report.Stack,
+			)
+			if rec != nil {
+				rec.record(report)
+			}
+			if reporter != nil {
+				ctx := context.WithoutCancel(r.Context())
+				go func() {
+					if err := reporter.ReportPanic(ctx, report); err != nil {
+						logger.WarnContext(ctx, "panic report failed", "request_id", report.RequestID, "err", err)
+					}
+				}()
+			}
+			if rw.wroteHeader {
+				return
+			}
+			// Headers the handler set before panicking may mark the response cacheable.
+			w.Header().Set("Cache-Control", "no-store")
+			msg := "an unexpected error occurred"
+			if report.RequestID != "" {
+				msg += "; quote request ID " + report.RequestID + " when reporting it"
+			}
+			helpers.WriteJSONError(w, http.StatusInternalServerError, helpers.ErrCodeInternalError, msg)
+		}()
+		next.ServeHTTP(rw, r)
+	})
+}
+
+// recoverWriter notes whether the response has started, after which a 500 can no longer be sent.
+type recoverWriter struct {
+	http.ResponseWriter
+	wroteHeader bool
+}
+
+func (w *recoverWriter) WriteHeader(code int) {
+	w.wroteHeader = true
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *recoverWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush lets streaming handlers (NDJSON lists) flush through the wrapper.
+func (w *recoverWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		w.wroteHeader = true
+		f.Flush()
+	}
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (w *recoverWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,98 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeErrorReporter struct {
+	reports chan *domain.PanicReport
+}
+
+func (f *fakeErrorReporter) ReportPanic(ctx context.Context, report *domain.PanicReport) error {
+	f.reports <- report
+	return errors.New("tracker down")
+}
+
+func TestRecover(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	rec := NewPanicRecorder()
+	reporter := &fakeErrorReporter{reports: make(chan *domain.PanicReport, 1)}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /events/{eventID}", func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	})
+	handler := RequestID(Recover(logger, rec, reporter, mux))
+
+	req := httptest.NewRequest(http.MethodGet, "/events/e1", nil)
+	req.Header.Set(RequestIDHeader, "req-1")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, "req-1", w.Header().Get(RequestIDHeader))
+	assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+	var resp helpers.APIResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, helpers.ErrCodeInternalError, resp.Error.Code)
+	assert.Contains(t, resp.Error.Message, "req-1")
+
+	select {
+	case report := <-reporter.reports:
+		assert.Equal(t, "req-1", report.RequestID)
+		assert.Equal(t, "GET /events/{eventID}", report.Route)
+		assert.Equal(t, "boom", report.Value)
+		assert.Contains(t, report.Stack, "goroutine")
+	case <-time.After(time.Second):
+		t.Fatal("panic was not reported")
+	}
+
+	stats := rec.PanicReport()
+	assert.Equal(t, int64(1), stats.Total)
+	assert.Equal(t, []domain.RoutePanics{{Route: "GET /events/{eventID}", Count: 1}}, stats.Routes)
+	require.NotNil(t, stats.Last)
+	assert.Equal(t, "/events/e1", stats.Last.Path)
+}
+
+func TestRecover_ResponseStarted(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	rec := NewPanicRecorder()
+	handler := Recover(logger, rec, nil, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("{}\n"))
+		panic("mid-stream")
+	}))
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/events", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "{}\n", w.Body.String())
+	stats := rec.PanicReport()
+	assert.Equal(t, []domain.RoutePanics{{Route: unmatchedRoute, Count: 1}}, stats.Routes)
+}
+
+func TestRecover_AbortHandler(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	rec := NewPanicRecorder()
+	handler := Recover(logger, rec, nil, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic(http.ErrAbortHandler)
+	}))
+	assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/events", nil))
+	})
+	assert.Zero(t, rec.PanicReport().Total)
+}
@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+)
+
+// RequestIDHeader carries a request's correlation ID. Clients may send their own; the server
+// sends back the one it used.
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLen is the longest client-sent ID kept; longer ones are replaced.
+const maxRequestIDLen = 64
+
+type requestIDKey struct{}
+
+// RequestID gives every request a correlation ID: the client's X-Request-ID when it is at most 64
+// letters, digits, '-', '_' or '.', otherwise a random one. The ID is set on the response header
+// and the request context, where RequestIDFromContext reads it for logs and error reports.
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIDHeader)
+		if !validRequestID(id) {
+			id = newRequestID()
+		}
+		w.Header().Set(RequestIDHeader, id)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestIDKey{}, id)))
+	})
+}
+
+// RequestIDFromContext returns the request's correlation ID, or "" outside a request.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLen {
+		return false
+	}
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-', c == '_', c == '.':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestID(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		keep   bool
+	}{
+		{name: "client id kept", header: "req-1.a_B", keep: true},
+		{name: "missing id generated", header: ""},
+		{name: "invalid characters replaced", header: "req 1\n"},
+		{name: "too long replaced", header: strings.Repeat("a", maxRequestIDLen+1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var seen string
+			handler := RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				seen = RequestIDFromContext(r.Context())
+			}))
+			req := httptest.NewRequest(http.MethodGet, "/events", nil)
+			if tt.header != "" {
+				req.Header.Set(RequestIDHeader, tt.header)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			assert.Equal(t, seen, w.Header().Get(RequestIDHeader))
+			if tt.keep {
+				assert.Equal(t, tt.header, seen)
+			} else {
+				assert.Len(t, seen, 32)
+			}
+		})
+	}
+}
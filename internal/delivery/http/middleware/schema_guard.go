@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/domain"
+)
+
+// GuardSchema returns a handler that refuses writes (any method but GET, HEAD and OPTIONS) with
+// 503 schema_too_new while guard reports the database schema newer than this build supports.
+// Reads still go through: columns and tables a migration adds do not break the queries this build
+// already runs, but its writes could leave new columns unset.
+func GuardSchema(guard domain.SchemaGuard, logger *slog.Logger, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			next.ServeHTTP(w, r)
+			return
+		}
+		if err := guard.AllowWrites(r.Context()); errors.Is(err, domain.ErrSchemaTooNew) {
+			logger.WarnContext(r.Context(), "write refused: database schema is newer than this server", "method", r.Method, "path", r.URL.Path)
+			helpers.WriteJSONError(w, http.StatusServiceUnavailable, helpers.ErrCodeSchemaTooNew,
+				"the server is being upgraded; retry shortly")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
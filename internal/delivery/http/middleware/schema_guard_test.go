@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubSchemaGuard struct {
+	err error
+}
+
+func (s stubSchemaGuard) AllowWrites(ctx context.Context) error { return s.err }
+
+func (s stubSchemaGuard) CheckReadiness(ctx context.Context) domain.DependencyHealth {
+	return domain.DependencyHealth{Name: "schema", Status: domain.DependencyUp}
+}
+
+func TestGuardSchema(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	tests := []struct {
+		name       string
+		method     string
+		err        error
+		wantStatus int
+	}{
+		{name: "write allowed", method: http.MethodPost, wantStatus: http.StatusOK},
+		{name: "write refused", method: http.MethodPost, err: domain.ErrSchemaTooNew, wantStatus: http.StatusServiceUnavailable},
+		{name: "delete refused", method: http.MethodDelete, err: domain.ErrSchemaTooNew, wantStatus: http.StatusServiceUnavailable},
+		{name: "read allowed on newer schema", method: http.MethodGet, err: domain.ErrSchemaTooNew, wantStatus: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := GuardSchema(stubSchemaGuard{err: tt.err}, logger, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequest(tt.method, "/events", nil))
+
+			require.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusServiceUnavailable {
+				var resp helpers.APIResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				require.NotNil(t, resp.Error)
+				assert.Equal(t, helpers.ErrCodeSchemaTooNew, resp.Error.Code)
+			}
+		})
+	}
+}
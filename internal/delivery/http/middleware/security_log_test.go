@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogSecurityEvent(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	handler := RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r = r.WithContext(SetUserID(r.Context(), "owner-1"))
+		LogSecurityEvent(logger, r, "team member removal refused", SecurityEvent{
+			Type: SecurityTeamMemberRemove, Outcome: SecurityOutcomeDenied,
+			EventID: "ev-1", TargetType: "user", TargetID: "user-2",
+			Details: []slog.Attr{slog.String("reason", "not_owner")},
+		})
+	}))
+	req := httptest.NewRequest(http.MethodDelete, "/events/ev-1/team-members/user-2", nil)
+	req.Header.Set(RequestIDHeader, "req-1")
+	req.RemoteAddr = "203.0.113.9:1234"
+	req.Header.Set("User-Agent", "curl/8.0")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	var line map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &line))
+	assert.Equal(t, map[string]any{
+		"time":           line["time"],
+		"level":          "WARN",
+		"msg":            "team member removal refused",
+		"channel":        "security",
+		"schema_version": float64(1),
+		"audit":          "team_member.remove",
+		"outcome":        "denied",
+		"user_id":        "owner-1",
+		"event_id":       "ev-1",
+		"target_type":    "user",
+		"target_id":      "user-2",
+		"request_id":     "req-1",
+		"client_ip":      "203.0.113.9",
+		"user_agent":     "curl/8.0",
+		"details":        map[string]any{"reason": "not_owner"},
+	}, line)
+}
+
+func TestLogSecurityEvent_LeavesOutEmptyFields(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/machine-token", nil)
+	LogSecurityEvent(logger, req, "machine token issued", SecurityEvent{
+		Type: SecurityMachineToken, Outcome: SecurityOutcomeSuccess, ClientID: "client-1",
+	})
+
+	var line map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &line))
+	assert.Equal(t, "INFO", line["level"])
+	assert.Equal(t, "client-1", line["client_id"])
+	for _, key := range []string{"user_id", "event_id", "target_type", "target_id", "request_id", "user_agent", "details"} {
+		assert.NotContains(t, line, key)
+	}
+}
@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"multitrackticketing/internal/delivery/http/helpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecurityHeaders(t *testing.T) {
+	handler := SecurityHeaders(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/events/me", nil))
+	assert.Equal(t, "nosniff", rec.Header().Get("X-Content-Type-Options"))
+	assert.Equal(t, "no-referrer", rec.Header().Get("Referrer-Policy"))
+	assert.Equal(t, "DENY", rec.Header().Get("X-Frame-Options"))
+	assert.Equal(t, apiContentSecurityPolicy, rec.Header().Get("Content-Security-Policy"))
+	assert.Empty(t, rec.Header().Get("Strict-Transport-Security"), "plain HTTP")
+
+	req := httptest.NewRequest(http.MethodGet, "/swagger/index.html", nil)
+	req.Header.Set("X-Forwarded-Proto", "https")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Empty(t, rec.Header().Get("Content-Security-Policy"), "the Swagger UI runs scripts")
+	assert.Equal(t, hstsMaxAge, rec.Header().Get("Strict-Transport-Security"))
+}
+
+func TestRequireJSON(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		body        string
+		contentType string
+		chunked     bool
+		wantStatus  int
+		wantCode    string
+	}{
+		{name: "json", method: http.MethodPost, body: `{"a":1}`, contentType: "application/json", wantStatus: http.StatusOK},
+		{name: "json with charset", method: http.MethodPatch, body: `{"a":1}`, contentType: "application/json; charset=utf-8", wantStatus: http.StatusOK},
+		{name: "no body", method: http.MethodPost, wantStatus: http.StatusOK},
+		{name: "get with body", method: http.MethodGet, body: `x`, contentType: "text/plain", wantStatus: http.StatusOK},
+		{name: "form", method: http.MethodPut, body: `a=1`, contentType: "application/x-www-form-urlencoded", wantStatus: http.StatusUnsupportedMediaType, wantCode: helpers.ErrCodeUnsupportedMediaType},
+		{name: "missing content type", method: http.MethodPost, body: `{"a":1}`, wantStatus: http.StatusUnsupportedMediaType, wantCode: helpers.ErrCodeUnsupportedMediaType},
+		{name: "too large", method: http.MethodPost, body: strings.Repeat("a", 33), contentType: "application/json", wantStatus: http.StatusRequestEntityTooLarge, wantCode: helpers.ErrCodePayloadTooLarge},
+		{name: "chunked and too large", method: http.MethodPost, body: strings.Repeat("a", 33), contentType: "application/json", chunked: true, wantStatus: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := RequireJSON(32, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if _, err := io.ReadAll(r.Body); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			var body io.Reader
+			if tt.body != "" {
+				body = strings.NewReader(tt.body)
+			}
+			req := httptest.NewRequest(tt.method, "/events", body)
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+			if tt.chunked {
+				req.ContentLength = -1
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+			if tt.wantCode != "" {
+				var resp helpers.APIResponse
+				require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+				require.NotNil(t, resp.Error)
+				assert.Equal(t, tt.wantCode, resp.Error.Code)
+			}
+		})
+	}
+}
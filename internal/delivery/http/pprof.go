@@ -0,0 +1,18 @@
+package http
+
+import (
+	"net/http"
+	"net/http/pprof"
+)
+
+// NewPprofHandler returns the runtime profiling endpoints under /debug/pprof/. It is served on
+// the separate operator-only listener (see NewDebugHandler), never on the public API router.
+func NewPprofHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	return mux
+}
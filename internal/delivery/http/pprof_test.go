@@ -0,0 +1,36 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPprofHandler(t *testing.T) {
+	h := NewPprofHandler()
+	tests := []struct {
+		path       string
+		wantStatus int
+	}{
+		{path: "/debug/pprof/", wantStatus: http.StatusOK},
+		{path: "/debug/pprof/heap?debug=1", wantStatus: http.StatusOK},
+		{path: "/debug/pprof/allocs?debug=1", wantStatus: http.StatusOK},
+		{path: "/events/me", wantStatus: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestNewRouter_DoesNotExposePprof(t *testing.T) {
+	router := newContractRouter(&stubEventService{}, &stubUserService{}, &stubAttendeeService{}, &stubAnnouncementService{}, &stubContactService{}, &stubAbuseReportService{}, &stubIPAllowlistService{}, &stubMachineClientService{}, &stubActivityService{}, &stubEventDeletionService{}, &stubExhibitorService{}, &stubEnrichmentService{}, &stubInvitationWarmupService{}, &stubRoomOccupancyService{}, &stubEventDocumentService{}, &stubSpeakerPhotoService{}, &stubSessionStreamService{})
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/pprof/", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -2,8 +2,10 @@ package http
 
 import (
 	"net/http"
+	"strings"
 
 	"multitrackticketing/internal/delivery/http/controllers"
+	"multitrackticketing/internal/domain"
 
 	httpSwagger "github.com/swaggo/http-swagger"
 )
@@ -11,67 +13,348 @@ import (
 // AuthWrap is a function that wraps a handler to require authentication.
 type AuthWrap func(http.HandlerFunc) http.HandlerFunc
 
+// PurgeWrap is a function that wraps a handler to purge the CDN cache of the event it changed.
+type PurgeWrap func(http.HandlerFunc) http.HandlerFunc
+
+// ChallengeWrap is a function that wraps a handler to screen requests to the named endpoint for bots.
+type ChallengeWrap func(endpoint string, next http.HandlerFunc) http.HandlerFunc
+
+// ScopeWrap is a function that wraps a handler to require a machine client's token with the named scope.
+type ScopeWrap func(scope string, next http.HandlerFunc) http.HandlerFunc
+
+// BodyLimitWrap is a function that wraps a handler to cap its request body at the limit of the
+// named route class.
+type BodyLimitWrap func(class string, next http.HandlerFunc) http.HandlerFunc
+
+// Route classes of request body limits. Most routes take small JSON bodies; imports and bulk
+// edits carry whole lists and get a larger limit.
+const (
+	BodyClassDefault = "default"
+	BodyClassBulk    = "bulk"
+)
+
+// DeadlineWrap is a function that wraps a handler to give its request context the deadline of the
+// named route class.
+type DeadlineWrap func(class string, next http.HandlerFunc) http.HandlerFunc
+
+// Route classes of request deadlines. Most routes answer from a few queries; imports, bulk mail and
+// the lists that stream every record get a longer deadline.
+const (
+	DeadlineClassDefault = "default"
+	DeadlineClassLong    = "long"
+)
+
+// privateCache is the Cache-Control of protected routes: their responses are per user, so neither a
+// CDN nor a shared proxy may keep them.
+const privateCache = "private, no-store"
+
+// route is one application endpoint. Routes are wrapped with requireAuth unless Public or Scope is
+// set; Scope makes it a machine route, wrapped with requireScope to take machine client tokens only.
+// Cache is the route's Cache-Control header; public routes must set it, protected ones default to
+// privateCache. A handler may still override it. Challenge names the bot challenge endpoint
+// (a domain.ChallengeEndpoint*) whose policy screens the route's requests. Body is the route
+// class (a BodyClass*) whose limit caps its request body; it defaults to BodyClassDefault. Deadline
+// is the route class (a DeadlineClass*) whose deadline bounds the request, auth included; it
+// defaults to DeadlineClassDefault.
+type route struct {
+	Pattern   string
+	Handler   http.HandlerFunc
+	Public    bool
+	Cache     string
+	Challenge string
+	Body      string
+	Deadline  string
+	Scope     string
+}
+
 // NewRouter initializes the HTTP router with all application routes.
 func NewRouter(
 	scheduleController *controllers.ScheduleController,
 	userController *controllers.UserController,
 	attendeeController *controllers.AttendeeController,
+	metaController *controllers.MetaController,
+	announcementController *controllers.AnnouncementController,
+	contactController *controllers.ContactController,
+	abuseReportController *controllers.AbuseReportController,
+	ipAllowlistController *controllers.IPAllowlistController,
+	machineClientController *controllers.MachineClientController,
+	activityController *controllers.ActivityController,
+	eventDeletionController *controllers.EventDeletionController,
+	exhibitorController *controllers.ExhibitorController,
+	enrichmentController *controllers.EnrichmentController,
+	warmupController *controllers.InvitationWarmupController,
+	occupancyController *controllers.RoomOccupancyController,
+	documentController *controllers.EventDocumentController,
+	speakerPhotoController *controllers.SpeakerPhotoController,
+	streamController *controllers.SessionStreamController,
 	requireAuth AuthWrap,
+	requireScope ScopeWrap,
+	purgeCache PurgeWrap,
+	challenge ChallengeWrap,
+	limitBody BodyLimitWrap,
+	withDeadline DeadlineWrap,
 ) *http.ServeMux {
 	mux := http.NewServeMux()
 
-	// Event management (protected)
-	mux.HandleFunc("GET /events/me", requireAuth(scheduleController.ListMyEvents))
-	mux.HandleFunc("GET /events/{eventID}", requireAuth(scheduleController.GetEventByID))
-	mux.HandleFunc("PATCH /events/{eventID}", requireAuth(scheduleController.UpdateEvent))
-	mux.HandleFunc("POST /events", requireAuth(scheduleController.CreateEvent))
-	mux.HandleFunc("POST /events/{eventID}/rooms", requireAuth(scheduleController.CreateEventRoom))
-	mux.HandleFunc("DELETE /events/{eventID}", requireAuth(scheduleController.DeleteEvent))
-	mux.HandleFunc("PATCH /events/{eventID}/rooms/{roomID}/not-bookable", requireAuth(scheduleController.ToggleRoomNotBookable))
-	mux.HandleFunc("GET /events/{eventID}/rooms", requireAuth(scheduleController.ListEventRooms))
-	mux.HandleFunc("GET /events/{eventID}/rooms/{roomID}", requireAuth(scheduleController.GetEventRoom))
-	mux.HandleFunc("PATCH /events/{eventID}/rooms/{roomID}", requireAuth(scheduleController.UpdateEventRoom))
-	mux.HandleFunc("DELETE /events/{eventID}/rooms/{roomID}", requireAuth(scheduleController.DeleteEventRoom))
-	mux.HandleFunc("GET /events/{eventID}/speakers", requireAuth(scheduleController.ListEventSpeakers))
-	mux.HandleFunc("GET /events/{eventID}/speakers/{speakerID}", requireAuth(scheduleController.GetEventSpeaker))
-	mux.HandleFunc("DELETE /events/{eventID}/speakers/{speakerID}", requireAuth(scheduleController.DeleteEventSpeaker))
-	mux.HandleFunc("POST /events/{eventID}/speakers", requireAuth(scheduleController.CreateEventSpeaker))
-	mux.HandleFunc("GET /events/{eventID}/tags", requireAuth(scheduleController.ListEventTags))
-	mux.HandleFunc("POST /events/{eventID}/tags", requireAuth(scheduleController.AddEventTags))
-	mux.HandleFunc("PATCH /events/{eventID}/tags/{tagID}", requireAuth(scheduleController.UpdateEventTag))
-	mux.HandleFunc("DELETE /events/{eventID}/tags/{tagID}", requireAuth(scheduleController.RemoveEventTag))
-	mux.HandleFunc("POST /events/{eventID}/sessions/{sessionID}/tags", requireAuth(scheduleController.AddSessionTag))
-	mux.HandleFunc("DELETE /events/{eventID}/sessions/{sessionID}/tags/{tagID}", requireAuth(scheduleController.RemoveSessionTag))
-	mux.HandleFunc("GET /events/{eventID}/sessions/{sessionID}/speakers", requireAuth(scheduleController.ListSessionSpeakers))
-	mux.HandleFunc("POST /events/{eventID}/sessions/{sessionID}/speakers", requireAuth(scheduleController.AddSessionSpeaker))
-	mux.HandleFunc("DELETE /events/{eventID}/sessions/{sessionID}/speakers/{speakerID}", requireAuth(scheduleController.RemoveSessionSpeaker))
-	mux.HandleFunc("POST /events/{eventID}/sessions", requireAuth(scheduleController.CreateEventSession))
-	mux.HandleFunc("PATCH /events/{eventID}/sessions/{sessionID}", requireAuth(scheduleController.UpdateSessionSchedule))
-	mux.HandleFunc("PATCH /events/{eventID}/sessions/{sessionID}/content", requireAuth(scheduleController.UpdateSessionContent))
-	mux.HandleFunc("DELETE /events/{eventID}/sessions/{sessionID}", requireAuth(scheduleController.DeleteEventSession))
-	mux.HandleFunc("POST /events/{eventID}/import/sessionize/{sessionizeID}", requireAuth(scheduleController.ImportSessionize))
-	mux.HandleFunc("POST /events/{eventID}/team-members", requireAuth(scheduleController.AddEventTeamMember))
-	mux.HandleFunc("GET /events/{eventID}/team-members", requireAuth(scheduleController.ListEventTeamMembers))
-	mux.HandleFunc("DELETE /events/{eventID}/team-members/{userID}", requireAuth(scheduleController.RemoveEventTeamMember))
-	mux.HandleFunc("GET /events/{eventID}/invitations", requireAuth(scheduleController.ListEventInvitations))
-	mux.HandleFunc("POST /events/{eventID}/invitations", requireAuth(scheduleController.SendEventInvitations))
-
-	// Attendee-facing (protected)
-	mux.HandleFunc("POST /attendee/registrations", requireAuth(attendeeController.RegisterForEventByCode))
-	mux.HandleFunc("POST /attendee/events/{eventID}/registrations", requireAuth(attendeeController.RegisterForEvent))
-	mux.HandleFunc("GET /attendee/events", requireAuth(attendeeController.ListMyRegisteredEvents))
-	mux.HandleFunc("GET /attendee/events/{eventID}/schedule", requireAuth(attendeeController.GetEventSchedule))
-
-	// Auth (passwordless: request code then verify)
-	mux.HandleFunc("POST /auth/login/request", userController.RequestLoginCode)
-	mux.HandleFunc("POST /auth/login/verify", userController.VerifyLoginCode)
-
-	// Users (protected)
-	mux.HandleFunc("GET /users/me", requireAuth(userController.GetMe))
-	mux.HandleFunc("PATCH /users/me", requireAuth(userController.UpdateMe))
+	for _, rt := range routes(scheduleController, userController, attendeeController, metaController, announcementController, contactController, abuseReportController, ipAllowlistController, machineClientController, activityController, eventDeletionController, exhibitorController, enrichmentController, warmupController, occupancyController, documentController, speakerPhotoController, streamController) {
+		handler := rt.Handler
+		// Any change to an event may show in its public responses, so writes purge the event's key.
+		if purgeCache != nil && changesEvent(rt.Pattern) {
+			handler = purgeCache(handler)
+		}
+		if challenge != nil && rt.Challenge != "" {
+			handler = challenge(rt.Challenge, handler)
+		}
+		if limitBody != nil {
+			class := rt.Body
+			if class == "" {
+				class = BodyClassDefault
+			}
+			handler = limitBody(class, handler)
+		}
+		switch {
+		case rt.Scope != "":
+			handler = requireScope(rt.Scope, handler)
+		case !rt.Public:
+			handler = requireAuth(handler)
+		}
+		if withDeadline != nil {
+			class := rt.Deadline
+			if class == "" {
+				class = DeadlineClassDefault
+			}
+			handler = withDeadline(class, handler)
+		}
+		cache := rt.Cache
+		if cache == "" {
+			cache = privateCache
+		}
+		mux.HandleFunc(rt.Pattern, withCacheControl(cache, handler))
+	}
 
 	// Swagger
 	mux.Handle("/swagger/", httpSwagger.WrapHandler)
 
 	return mux
 }
+
+// changesEvent reports whether pattern is a write to an event or something in it.
+func changesEvent(pattern string) bool {
+	method, path, _ := strings.Cut(pattern, " ")
+	return method != http.MethodGet && method != http.MethodHead && strings.Contains(path, "{eventID}")
+}
+
+// withCacheControl sets the Cache-Control header before calling next.
+func withCacheControl(cache string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", cache)
+		next(w, r)
+	}
+}
+
+// routes lists every application route. The router contract tests walk this table, so each
+// handler registered here is checked against the response envelope conventions.
+func routes(
+	scheduleController *controllers.ScheduleController,
+	userController *controllers.UserController,
+	attendeeController *controllers.AttendeeController,
+	metaController *controllers.MetaController,
+	announcementController *controllers.AnnouncementController,
+	contactController *controllers.ContactController,
+	abuseReportController *controllers.AbuseReportController,
+	ipAllowlistController *controllers.IPAllowlistController,
+	machineClientController *controllers.MachineClientController,
+	activityController *controllers.ActivityController,
+	eventDeletionController *controllers.EventDeletionController,
+	exhibitorController *controllers.ExhibitorController,
+	enrichmentController *controllers.EnrichmentController,
+	warmupController *controllers.InvitationWarmupController,
+	occupancyController *controllers.RoomOccupancyController,
+	documentController *controllers.EventDocumentController,
+	speakerPhotoController *controllers.SpeakerPhotoController,
+	streamController *controllers.SessionStreamController,
+) []route {
+	return []route{
+		// Event management (protected)
+		{Pattern: "GET /events/me", Handler: scheduleController.ListMyEvents},
+		{Pattern: "GET /events/search", Handler: scheduleController.SearchEvents},
+		{Pattern: "GET /events/joined", Handler: scheduleController.ListJoinedEvents},
+		{Pattern: "GET /events/{eventID}", Handler: scheduleController.GetEventByID},
+		{Pattern: "PATCH /events/{eventID}", Handler: scheduleController.UpdateEvent},
+		{Pattern: "POST /events/{eventID}/regenerate-code", Handler: scheduleController.RegenerateEventCode, Deadline: DeadlineClassLong},
+		{Pattern: "POST /events", Handler: scheduleController.CreateEvent},
+		{Pattern: "POST /events/{eventID}/rooms", Handler: scheduleController.CreateEventRoom},
+		{Pattern: "DELETE /events/{eventID}", Handler: eventDeletionController.RequestEventDeletion},
+		{Pattern: "POST /events/{eventID}/deletion/confirm", Handler: eventDeletionController.ConfirmEventDeletion},
+		{Pattern: "PATCH /events/{eventID}/rooms/{roomID}/not-bookable", Handler: scheduleController.ToggleRoomNotBookable},
+		{Pattern: "GET /events/{eventID}/rooms", Handler: scheduleController.ListEventRooms},
+		{Pattern: "PUT /events/{eventID}/rooms/order", Handler: scheduleController.ReorderEventRooms},
+		{Pattern: "GET /events/{eventID}/tracks", Handler: scheduleController.ListEventTracks},
+		{Pattern: "PUT /events/{eventID}/tracks/order", Handler: scheduleController.ReorderEventTracks},
+		{Pattern: "GET /events/{eventID}/rooms/{roomID}", Handler: scheduleController.GetEventRoom},
+		{Pattern: "PATCH /events/{eventID}/rooms/{roomID}", Handler: scheduleController.UpdateEventRoom},
+		{Pattern: "DELETE /events/{eventID}/rooms/{roomID}", Handler: scheduleController.DeleteEventRoom},
+		{Pattern: "GET /events/{eventID}/speakers", Handler: scheduleController.ListEventSpeakers},
+		{Pattern: "GET /events/{eventID}/speakers/{speakerID}", Handler: scheduleController.GetEventSpeaker},
+		{Pattern: "DELETE /events/{eventID}/speakers/{speakerID}", Handler: scheduleController.DeleteEventSpeaker},
+		{Pattern: "POST /events/{eventID}/speakers", Handler: scheduleController.CreateEventSpeaker},
+		{Pattern: "PATCH /events/{eventID}/speakers/bulk", Handler: scheduleController.BulkUpdateSpeakers, Body: BodyClassBulk},
+		{Pattern: "GET /events/{eventID}/speakers/merge-candidates", Handler: scheduleController.ListSpeakerMergeCandidates},
+		{Pattern: "GET /events/{eventID}/speakers/photos.zip", Handler: speakerPhotoController.ExportSpeakerPhotos, Deadline: DeadlineClassLong},
+		{Pattern: "GET /events/{eventID}/speakers/photos/archives/{archiveID}", Handler: speakerPhotoController.GetSpeakerPhotoArchive},
+		{Pattern: "GET /events/{eventID}/speakers/photos/archives/{archiveID}/download", Handler: speakerPhotoController.DownloadSpeakerPhotoArchive, Deadline: DeadlineClassLong},
+		{Pattern: "POST /events/{eventID}/speakers/merge-candidates/{candidateID}", Handler: scheduleController.ResolveSpeakerMergeCandidate},
+		{Pattern: "GET /events/{eventID}/tags", Handler: scheduleController.ListEventTags},
+		{Pattern: "POST /events/{eventID}/tags", Handler: scheduleController.AddEventTags},
+		{Pattern: "PATCH /events/{eventID}/tags/{tagID}", Handler: scheduleController.UpdateEventTag},
+		{Pattern: "DELETE /events/{eventID}/tags/{tagID}", Handler: scheduleController.RemoveEventTag},
+		{Pattern: "POST /events/{eventID}/sessions/{sessionID}/tags", Handler: scheduleController.AddSessionTag},
+		{Pattern: "DELETE /events/{eventID}/sessions/{sessionID}/tags/{tagID}", Handler: scheduleController.RemoveSessionTag},
+		{Pattern: "GET /events/{eventID}/sessions/{sessionID}/speakers", Handler: scheduleController.ListSessionSpeakers},
+		{Pattern: "POST /events/{eventID}/sessions/{sessionID}/speakers", Handler: scheduleController.AddSessionSpeaker},
+		{Pattern: "DELETE /events/{eventID}/sessions/{sessionID}/speakers/{speakerID}", Handler: scheduleController.RemoveSessionSpeaker},
+		{Pattern: "GET /events/{eventID}/sessions", Handler: scheduleController.ListEventSessions, Deadline: DeadlineClassLong},
+		{Pattern: "POST /events/{eventID}/sessions", Handler: scheduleController.CreateEventSession},
+		{Pattern: "PATCH /events/{eventID}/sessions/{sessionID}", Handler: scheduleController.UpdateSessionSchedule},
+		{Pattern: "PATCH /events/{eventID}/sessions/{sessionID}/content", Handler: scheduleController.UpdateSessionContent},
+		{Pattern: "GET /events/{eventID}/sessions/{sessionID}/content/streams", Handler: streamController.ListSessionStreams},
+		{Pattern: "PUT /events/{eventID}/sessions/{sessionID}/content/streams", Handler: streamController.SetSessionStreams},
+		{Pattern: "DELETE /events/{eventID}/sessions/{sessionID}", Handler: scheduleController.DeleteEventSession},
+		{Pattern: "GET /events/{eventID}/sessions/{sessionID}/history", Handler: scheduleController.ListSessionHistory},
+		{Pattern: "POST /events/{eventID}/import/sessionize/{sessionizeID}", Handler: scheduleController.ImportSessionize, Body: BodyClassBulk, Deadline: DeadlineClassLong},
+		{Pattern: "GET /events/{eventID}/import/mapping", Handler: scheduleController.GetImportMapping},
+		{Pattern: "PUT /events/{eventID}/import/mapping", Handler: scheduleController.UpdateImportMapping, Body: BodyClassBulk},
+		{Pattern: "GET /events/{eventID}/schedule-rules", Handler: scheduleController.GetScheduleRules},
+		{Pattern: "PUT /events/{eventID}/schedule-rules", Handler: scheduleController.UpdateScheduleRules},
+		{Pattern: "GET /events/{eventID}/theme", Handler: scheduleController.GetEventTheme},
+		{Pattern: "PUT /events/{eventID}/theme", Handler: scheduleController.UpdateEventTheme},
+		{Pattern: "GET /events/{eventID}/operating-hours", Handler: scheduleController.GetOperatingHours},
+		{Pattern: "PUT /events/{eventID}/operating-hours", Handler: scheduleController.UpdateOperatingHours},
+		{Pattern: "GET /events/{eventID}/integrity-report", Handler: scheduleController.GetIntegrityReport},
+		{Pattern: "POST /events/{eventID}/integrity-report/cleanup", Handler: scheduleController.CleanupIntegrityIssues},
+		{Pattern: "POST /events/{eventID}/schedule/validate", Handler: scheduleController.ValidateSchedule},
+		{Pattern: "GET /events/{eventID}/schedule", Handler: scheduleController.GetEventScheduleAsOf},
+		{Pattern: "GET /events/{eventID}/schedule/grid", Handler: scheduleController.GetScheduleGrid},
+		{Pattern: "GET /events/{eventID}/schedule/overlaps", Handler: scheduleController.GetScheduleOverlaps},
+		{Pattern: "GET /events/{eventID}/checklist", Handler: scheduleController.GetChecklist},
+		{Pattern: "PUT /events/{eventID}/checklist", Handler: scheduleController.UpdateChecklist},
+		{Pattern: "GET /events/{eventID}/checklist/progress", Handler: scheduleController.GetChecklistProgress},
+		{Pattern: "PUT /events/{eventID}/sessions/{sessionID}/checklist/{itemID}", Handler: scheduleController.SetSessionChecklistItem},
+		{Pattern: "GET /events/{eventID}/custom-fields", Handler: scheduleController.ListCustomFields},
+		{Pattern: "POST /events/{eventID}/custom-fields", Handler: scheduleController.CreateCustomField},
+		{Pattern: "PATCH /events/{eventID}/custom-fields/{fieldID}", Handler: scheduleController.UpdateCustomField},
+		{Pattern: "DELETE /events/{eventID}/custom-fields/{fieldID}", Handler: scheduleController.DeleteCustomField},
+		{Pattern: "PUT /events/{eventID}/sessions/{sessionID}/custom-fields", Handler: scheduleController.SetSessionCustomFields},
+		{Pattern: "PUT /events/{eventID}/speakers/{speakerID}/custom-fields", Handler: scheduleController.SetSpeakerCustomFields},
+		{Pattern: "POST /events/{eventID}/team-members", Handler: scheduleController.AddEventTeamMember},
+		{Pattern: "GET /events/{eventID}/team-members", Handler: scheduleController.ListEventTeamMembers},
+		{Pattern: "DELETE /events/{eventID}/team-members/me", Handler: scheduleController.LeaveEvent},
+		{Pattern: "DELETE /events/{eventID}/team-members/{userID}", Handler: scheduleController.RemoveEventTeamMember},
+		{Pattern: "GET /events/{eventID}/invitations", Handler: scheduleController.ListEventInvitations, Deadline: DeadlineClassLong},
+		{Pattern: "POST /events/{eventID}/anonymize", Handler: scheduleController.AnonymizeEvent},
+		{Pattern: "POST /events/{eventID}/invitations", Handler: scheduleController.SendEventInvitations, Body: BodyClassBulk, Deadline: DeadlineClassLong},
+		{Pattern: "GET /events/{eventID}/invitations/domain-rules", Handler: scheduleController.GetInvitationDomainRules},
+		{Pattern: "PUT /events/{eventID}/invitations/domain-rules", Handler: scheduleController.UpdateInvitationDomainRules},
+		{Pattern: "POST /events/{eventID}/invitations/remind", Handler: scheduleController.RemindEventInvitations, Deadline: DeadlineClassLong},
+		{Pattern: "GET /events/{eventID}/invitations/warmups", Handler: warmupController.ListInvitationWarmups},
+		{Pattern: "POST /events/{eventID}/invitations/warmups", Handler: warmupController.CreateInvitationWarmup, Body: BodyClassBulk},
+		{Pattern: "GET /events/{eventID}/invitations/warmups/{warmupID}", Handler: warmupController.GetInvitationWarmup},
+		{Pattern: "POST /events/{eventID}/invitations/warmups/{warmupID}/pause", Handler: warmupController.PauseInvitationWarmup},
+		{Pattern: "POST /events/{eventID}/invitations/warmups/{warmupID}/resume", Handler: warmupController.ResumeInvitationWarmup},
+		{Pattern: "GET /events/{eventID}/invitations/email-domain", Handler: warmupController.CheckEmailDomain},
+		{Pattern: "GET /events/{eventID}/emails", Handler: scheduleController.ListEventEmails},
+		{Pattern: "POST /events/{eventID}/emails/{emailID}/resend", Handler: scheduleController.ResendEventEmail},
+		{Pattern: "POST /events/{eventID}/invitations/{invitationID}/promote", Handler: scheduleController.PromoteInvitation},
+
+		// Attendee-facing (protected)
+		{Pattern: "POST /attendee/registrations", Handler: attendeeController.RegisterForEventByCode, Challenge: domain.ChallengeEndpointRegistration},
+		{Pattern: "POST /events/join", Handler: attendeeController.JoinEvent, Challenge: domain.ChallengeEndpointRegistration},
+		{Pattern: "POST /attendee/events/{eventID}/registrations", Handler: attendeeController.RegisterForEvent},
+		{Pattern: "GET /attendee/events", Handler: attendeeController.ListMyRegisteredEvents},
+		{Pattern: "GET /attendee/events/{eventID}/schedule", Handler: attendeeController.GetEventSchedule},
+		{Pattern: "GET /attendee/events/{eventID}/schedule/changes", Handler: attendeeController.ListScheduleChanges},
+		{Pattern: "GET /events/{eventID}/sync", Handler: attendeeController.SyncEvent},
+
+		// Attendee-facing (public)
+		// Apps revalidate the bundle with its ETag and refetch the theme every few minutes; a CDN
+		// keeps both until the event changes (see the handlers' Surrogate-Control).
+		{Pattern: "GET /public/events/{eventCode}/offline-bundle", Handler: attendeeController.GetOfflineBundle, Public: true, Cache: "public, no-cache", Deadline: DeadlineClassLong},
+		{Pattern: "GET /public/events/{eventCode}/theme", Handler: attendeeController.GetPublicEventTheme, Public: true, Cache: "public, max-age=300"},
+		{Pattern: "GET /public/events/{eventCode}/documents", Handler: attendeeController.GetPublicEventDocuments, Public: true, Cache: "public, max-age=300"},
+		{Pattern: "GET /public/events/{eventCode}/schedule.html", Handler: attendeeController.GetPublicSchedulePage, Public: true, Cache: "public, max-age=300"},
+		{Pattern: "GET /public/events/{eventCode}/metadata", Handler: attendeeController.GetPublicEventMetadata, Public: true, Cache: "public, max-age=300"},
+		{Pattern: "GET /public/sitemap.xml", Handler: attendeeController.GetSitemap, Public: true, Cache: "public, max-age=3600"},
+		{Pattern: "GET /public/sessions/{sessionID}/card", Handler: attendeeController.GetPublicSessionCard, Public: true, Cache: "public, max-age=300"},
+		{Pattern: "GET /public/sessions/{sessionID}/card.png", Handler: attendeeController.GetPublicSessionCardImage, Public: true, Cache: "public, max-age=300"},
+		{Pattern: "POST /public/events/{eventCode}/contact", Handler: contactController.ContactOrganizers, Public: true, Cache: "no-store", Challenge: domain.ChallengeEndpointContact},
+		{Pattern: "POST /public/reports", Handler: abuseReportController.ReportAbuse, Public: true, Cache: "no-store", Challenge: domain.ChallengeEndpointReport},
+
+		// Machine clients (machine token with the route's scope)
+		{Pattern: "GET /machine/events/{eventID}/schedule", Handler: attendeeController.GetMachineEventSchedule, Scope: domain.ScopeScheduleRead},
+		{Pattern: "POST /machine/events/{eventID}/rooms/{roomID}/occupancy", Handler: occupancyController.UpdateRoomOccupancy, Scope: domain.ScopeOccupancyWrite},
+		{Pattern: "POST /machine/sandbox-events", Handler: machineClientController.CreateSandboxEvent, Scope: domain.ScopeSandboxWrite},
+
+		// Contact inbox (protected; owner and team members)
+		{Pattern: "GET /events/{eventID}/contact-threads", Handler: contactController.ListContactThreads},
+		{Pattern: "GET /events/{eventID}/contact-threads/{threadID}", Handler: contactController.GetContactThread},
+		{Pattern: "POST /events/{eventID}/contact-threads/{threadID}/replies", Handler: contactController.ReplyToContactThread},
+
+		// Event documents (protected; the owner publishes them, attendees accept them to register)
+		{Pattern: "GET /events/{eventID}/documents", Handler: documentController.ListEventDocuments},
+		{Pattern: "PUT /events/{eventID}/documents/{kind}", Handler: documentController.PublishEventDocument},
+		{Pattern: "DELETE /events/{eventID}/documents/{kind}", Handler: documentController.WithdrawEventDocument},
+		{Pattern: "GET /events/{eventID}/documents/{kind}/versions", Handler: documentController.ListEventDocumentVersions},
+		{Pattern: "GET /events/{eventID}/documents/{kind}/acceptances", Handler: documentController.GetDocumentAcceptanceReport},
+		{Pattern: "GET /attendee/events/{eventID}/documents", Handler: documentController.ListMyEventDocuments},
+		{Pattern: "POST /attendee/events/{eventID}/documents/accept", Handler: documentController.AcceptEventDocuments},
+
+		// Exhibitors (protected; the team manages them, booth staff scan and export leads, attendees consent)
+		{Pattern: "GET /events/{eventID}/exhibitors", Handler: exhibitorController.ListExhibitors},
+		{Pattern: "POST /events/{eventID}/exhibitors", Handler: exhibitorController.CreateExhibitor},
+		{Pattern: "DELETE /events/{eventID}/exhibitors/{exhibitorID}", Handler: exhibitorController.DeleteExhibitor},
+		{Pattern: "POST /events/{eventID}/exhibitors/{exhibitorID}/staff", Handler: exhibitorController.AddExhibitorStaff},
+		{Pattern: "DELETE /events/{eventID}/exhibitors/{exhibitorID}/staff/{userID}", Handler: exhibitorController.RemoveExhibitorStaff},
+		{Pattern: "GET /attendee/events/{eventID}/lead-consent", Handler: exhibitorController.GetLeadConsent},
+		{Pattern: "PUT /attendee/events/{eventID}/lead-consent", Handler: exhibitorController.SetLeadConsent},
+		{Pattern: "GET /exhibitor/booths", Handler: exhibitorController.ListMyBooths},
+		{Pattern: "POST /exhibitors/{exhibitorID}/leads", Handler: exhibitorController.ScanLead},
+		{Pattern: "GET /exhibitors/{exhibitorID}/leads/export", Handler: exhibitorController.ExportLeads},
+		{Pattern: "GET /events/{eventID}/sessions/{sessionID}/suggestions", Handler: enrichmentController.SuggestSessionEnrichment, Deadline: DeadlineClassLong},
+		{Pattern: "POST /events/{eventID}/sessions/{sessionID}/suggestions/apply", Handler: enrichmentController.ApplySessionSuggestion},
+
+		// Auth (passwordless: request code then verify)
+		{Pattern: "POST /auth/login/request", Handler: userController.RequestLoginCode, Public: true, Cache: "no-store", Challenge: domain.ChallengeEndpointLogin},
+		{Pattern: "POST /auth/login/verify", Handler: userController.VerifyLoginCode, Public: true, Cache: "no-store"},
+		{Pattern: "POST /auth/machine-token", Handler: machineClientController.IssueMachineToken, Public: true, Cache: "no-store"},
+
+		// Users (protected)
+		{Pattern: "GET /users/me", Handler: userController.GetMe},
+		{Pattern: "PATCH /users/me", Handler: userController.UpdateMe},
+		{Pattern: "GET /users/me/ip-allowlist", Handler: ipAllowlistController.GetMyIPAllowlist},
+		{Pattern: "PUT /users/me/ip-allowlist", Handler: ipAllowlistController.UpdateMyIPAllowlist},
+		{Pattern: "GET /users/me/activity", Handler: activityController.ListMyActivity},
+		{Pattern: "GET /admin/users/{userID}/ip-allowlist", Handler: ipAllowlistController.GetUserIPAllowlist},
+		{Pattern: "PUT /admin/users/{userID}/ip-allowlist", Handler: ipAllowlistController.UpdateUserIPAllowlist},
+
+		// Product changelog (protected; managing it needs the admin role)
+		{Pattern: "GET /changelog", Handler: announcementController.GetChangelog},
+		{Pattern: "POST /changelog/read", Handler: announcementController.MarkAnnouncementsRead},
+		{Pattern: "GET /admin/announcements", Handler: announcementController.ListAnnouncements},
+		{Pattern: "POST /admin/announcements", Handler: announcementController.CreateAnnouncement},
+		{Pattern: "PUT /admin/announcements/{announcementID}", Handler: announcementController.UpdateAnnouncement},
+		{Pattern: "DELETE /admin/announcements/{announcementID}", Handler: announcementController.DeleteAnnouncement},
+		{Pattern: "GET /admin/moderation-queue", Handler: abuseReportController.ListModerationQueue},
+		{Pattern: "GET /admin/moderation-queue/{targetType}/{targetID}", Handler: abuseReportController.GetModerationItem},
+		{Pattern: "POST /admin/moderation-queue/{targetType}/{targetID}/resolve", Handler: abuseReportController.ResolveModerationItem},
+		{Pattern: "DELETE /admin/events/{eventID}", Handler: eventDeletionController.ForceDeleteEvent},
+		{Pattern: "GET /admin/machine-clients", Handler: machineClientController.ListMachineClients},
+		{Pattern: "POST /admin/machine-clients", Handler: machineClientController.CreateMachineClient},
+		{Pattern: "POST /admin/machine-clients/{clientID}/revoke", Handler: machineClientController.RevokeMachineClient},
+		{Pattern: "GET /admin/machine-clients/{clientID}/ip-allowlist", Handler: ipAllowlistController.GetMachineClientIPAllowlist},
+		{Pattern: "PUT /admin/machine-clients/{clientID}/ip-allowlist", Handler: ipAllowlistController.UpdateMachineClientIPAllowlist},
+
+		// API metadata (public)
+		{Pattern: "GET /meta/error-codes", Handler: metaController.ListErrorCodes, Public: true, Cache: "public, max-age=3600"},
+		{Pattern: "GET /readyz", Handler: metaController.Readyz, Public: true, Cache: "no-store"},
+	}
+}
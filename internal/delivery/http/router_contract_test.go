@@ -0,0 +1,1643 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/delivery/http/controllers"
+	"multitrackticketing/internal/delivery/http/helpers"
+	"multitrackticketing/internal/delivery/http/middleware"
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The contract tests serve every route in routes() through NewRouter with stub services and
+// check the response envelope conventions: {data, error} bodies, error codes from the catalog
+// with the catalog's status, and 401 for protected routes called without a token.
+
+var contractLogger = slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+
+const (
+	contractToken = "valid-token"
+	contractUUID  = "00000000-0000-4000-8000-000000000001"
+	// contractEventCode fills {eventCode}, contractTargetType {targetType} and contractDocumentKind
+	// {kind}; every other path parameter is contractUUID.
+	contractEventCode    = "ab12"
+	contractTargetType   = domain.AbuseTargetSession
+	contractDocumentKind = domain.DocumentKindCodeOfConduct
+	// contractInvitationTotal is the total the stub reports for ListEventInvitations.
+	contractInvitationTotal = 45
+)
+
+// contractCase describes how to call one route: a valid request body (if any) and the domain
+// sentinel errors its handler is expected to map to a specific status. contentType is set for
+// routes whose success body is a file rather than the JSON envelope; errors are still enveloped.
+type contractCase struct {
+	body        string
+	errs        []error
+	contentType string
+}
+
+var ownerErrs = []error{domain.ErrNotFound, domain.ErrForbidden}
+
+var contractCases = map[string]contractCase{
+	"GET /events/me":                         {},
+	"GET /events/search":                     {errs: []error{domain.ErrInvalidInput}},
+	"GET /events/joined":                     {},
+	"GET /events/{eventID}":                  {errs: []error{domain.ErrNotFound}},
+	"PATCH /events/{eventID}":                {body: `{}`, errs: ownerErrs},
+	"POST /events/{eventID}/regenerate-code": {errs: ownerErrs},
+	"POST /events":                           {body: `{"name":"Conf"}`},
+	"POST /events/{eventID}/rooms": {
+		body: `{"name":"Room A"}`, errs: ownerErrs,
+	},
+	"DELETE /events/{eventID}":                            {errs: append(ownerErrs, domain.ErrEventHasRegistrations)},
+	"PATCH /events/{eventID}/rooms/{roomID}/not-bookable": {errs: ownerErrs},
+	"GET /events/{eventID}/rooms":                         {errs: ownerErrs},
+	"PUT /events/{eventID}/rooms/order":                   {body: `{"room_ids":["` + contractUUID + `"]}`, errs: append(ownerErrs, domain.ErrInvalidInput)},
+	"GET /events/{eventID}/tracks":                        {errs: ownerErrs},
+	"PUT /events/{eventID}/tracks/order":                  {body: `{"tracks":["Backend"]}`, errs: append(ownerErrs, domain.ErrInvalidInput)},
+	"GET /events/{eventID}/rooms/{roomID}":                {errs: ownerErrs},
+	"PATCH /events/{eventID}/rooms/{roomID}":              {body: `{}`, errs: ownerErrs},
+	"DELETE /events/{eventID}/rooms/{roomID}":             {errs: append(ownerErrs, domain.ErrInvalidInput)},
+	"GET /events/{eventID}/speakers":                      {errs: ownerErrs},
+	"GET /events/{eventID}/speakers/{speakerID}":          {errs: ownerErrs},
+	"DELETE /events/{eventID}/speakers/{speakerID}":       {errs: ownerErrs},
+	"POST /events/{eventID}/speakers":                     {body: `{"first_name":"Ada"}`, errs: ownerErrs},
+	"PATCH /events/{eventID}/speakers/bulk":               {body: `{"updates":[{"speaker_id":"` + contractUUID + `","is_top_speaker":true}]}`, errs: append(ownerErrs, domain.ErrInvalidInput)},
+	"GET /events/{eventID}/speakers/merge-candidates":     {errs: ownerErrs},
+	"POST /events/{eventID}/speakers/merge-candidates/{candidateID}": {
+		body: `{"action":"merge"}`,
+		errs: append(ownerErrs, domain.ErrAlreadyResolved),
+	},
+	"GET /events/{eventID}/tags":                                         {errs: ownerErrs},
+	"POST /events/{eventID}/tags":                                        {body: `{"tags":["go"]}`, errs: ownerErrs},
+	"PATCH /events/{eventID}/tags/{tagID}":                               {body: `{"name":"go"}`, errs: append(ownerErrs, domain.ErrInvalidInput)},
+	"DELETE /events/{eventID}/tags/{tagID}":                              {errs: ownerErrs},
+	"POST /events/{eventID}/sessions/{sessionID}/tags":                   {body: `{"tag_id":"` + contractUUID + `"}`, errs: ownerErrs},
+	"DELETE /events/{eventID}/sessions/{sessionID}/tags/{tagID}":         {errs: ownerErrs},
+	"GET /events/{eventID}/sessions/{sessionID}/speakers":                {errs: ownerErrs},
+	"POST /events/{eventID}/sessions/{sessionID}/speakers":               {body: `{"speaker_id":"` + contractUUID + `"}`, errs: ownerErrs},
+	"DELETE /events/{eventID}/sessions/{sessionID}/speakers/{speakerID}": {errs: ownerErrs},
+	"GET /events/{eventID}/sessions":                                     {errs: ownerErrs},
+	"POST /events/{eventID}/sessions": {
+		body: `{"room_id":"` + contractUUID + `","title":"T","start_time":"2026-01-01T10:00:00Z","end_time":"2026-01-01T11:00:00Z"}`,
+		errs: append(ownerErrs, domain.ErrInvalidInput, domain.ErrScheduleRuleViolation, domain.ErrSessionTimeConflict),
+	},
+	"PATCH /events/{eventID}/sessions/{sessionID}":                  {body: `{}`, errs: append(ownerErrs, domain.ErrInvalidInput, domain.ErrScheduleRuleViolation, domain.ErrSessionTimeConflict)},
+	"PATCH /events/{eventID}/sessions/{sessionID}/content":          {body: `{"title":"T"}`, errs: ownerErrs},
+	"GET /events/{eventID}/sessions/{sessionID}/content/streams":    {errs: ownerErrs},
+	"PUT /events/{eventID}/sessions/{sessionID}/content/streams":    {body: `{"links":[]}`, errs: append(ownerErrs, domain.ErrInvalidInput)},
+	"DELETE /events/{eventID}/sessions/{sessionID}":                 {errs: ownerErrs},
+	"GET /events/{eventID}/sessions/{sessionID}/history":            {errs: ownerErrs},
+	"POST /events/{eventID}/import/sessionize/{sessionizeID}":       {errs: []error{domain.ErrProviderUnavailable}},
+	"GET /events/{eventID}/import/mapping":                          {errs: ownerErrs},
+	"PUT /events/{eventID}/import/mapping":                          {body: `{"tag_categories":["Topics"]}`, errs: ownerErrs},
+	"GET /events/{eventID}/schedule-rules":                          {errs: ownerErrs},
+	"PUT /events/{eventID}/schedule-rules":                          {body: `{"allowed_durations":[30,60]}`, errs: ownerErrs},
+	"GET /events/{eventID}/theme":                                   {errs: ownerErrs},
+	"PUT /events/{eventID}/theme":                                   {body: `{"primary_color":"#112233"}`, errs: ownerErrs},
+	"GET /events/{eventID}/operating-hours":                         {errs: ownerErrs},
+	"PUT /events/{eventID}/operating-hours":                         {body: `{"days":[{"day":"2026-05-14","opens_at":"2026-05-14T08:00:00Z","closes_at":"2026-05-14T19:00:00Z"}]}`, errs: append(ownerErrs, domain.ErrInvalidInput)},
+	"GET /events/{eventID}/integrity-report":                        {errs: ownerErrs},
+	"POST /events/{eventID}/integrity-report/cleanup":               {errs: ownerErrs},
+	"POST /events/{eventID}/schedule/validate":                      {errs: ownerErrs},
+	"GET /events/{eventID}/schedule/grid":                           {errs: ownerErrs},
+	"GET /events/{eventID}/schedule/overlaps":                       {errs: append(ownerErrs, domain.ErrInvalidInput)},
+	"GET /events/{eventID}/checklist":                               {errs: ownerErrs},
+	"PUT /events/{eventID}/checklist":                               {body: `{"items":["Slides received"]}`, errs: append(ownerErrs, domain.ErrInvalidInput)},
+	"GET /events/{eventID}/checklist/progress":                      {errs: ownerErrs},
+	"PUT /events/{eventID}/sessions/{sessionID}/checklist/{itemID}": {body: `{"done":true}`, errs: ownerErrs},
+	"GET /events/{eventID}/custom-fields":                           {errs: ownerErrs},
+	"POST /events/{eventID}/custom-fields": {
+		body: `{"name":"Paper DOI","type":"url","applies_to":"session"}`,
+		errs: append(ownerErrs, domain.ErrInvalidInput, domain.ErrDuplicateCustomField, domain.ErrQuotaExceeded),
+	},
+	"PATCH /events/{eventID}/custom-fields/{fieldID}":          {body: `{"public":true}`, errs: append(ownerErrs, domain.ErrDuplicateCustomField)},
+	"DELETE /events/{eventID}/custom-fields/{fieldID}":         {errs: ownerErrs},
+	"PUT /events/{eventID}/sessions/{sessionID}/custom-fields": {body: `{"values":{}}`, errs: append(ownerErrs, domain.ErrInvalidInput)},
+	"PUT /events/{eventID}/speakers/{speakerID}/custom-fields": {body: `{"values":{}}`, errs: append(ownerErrs, domain.ErrInvalidInput)},
+	"POST /events/{eventID}/team-members": {
+		body: `{"email":"teammate@example.com"}`,
+		errs: append(ownerErrs, domain.ErrUserNotFound, domain.ErrAlreadyMember),
+	},
+	"GET /events/{eventID}/team-members":             {errs: ownerErrs},
+	"DELETE /events/{eventID}/team-members/me":       {errs: []error{domain.ErrNotFound, domain.ErrInvalidInput}},
+	"DELETE /events/{eventID}/team-members/{userID}": {errs: ownerErrs},
+	"GET /events/{eventID}/invitations":              {errs: ownerErrs},
+	"POST /events/{eventID}/invitations":             {body: `{"emails":"a@example.com"}`, errs: ownerErrs},
+	"POST /events/{eventID}/invitations/remind":      {body: `{}`, errs: append(ownerErrs, domain.ErrInvalidInput)},
+	"POST /events/{eventID}/anonymize":               {errs: append(ownerErrs, domain.ErrInvalidInput)},
+	"GET /events/{eventID}/emails":                   {errs: ownerErrs},
+	"POST /events/{eventID}/emails/{emailID}/resend": {errs: append(ownerErrs, domain.ErrEventEmailNotFound)},
+	"GET /events/{eventID}/invitations/domain-rules": {errs: ownerErrs},
+	"PUT /events/{eventID}/invitations/domain-rules": {body: `{"blocked_domains":["gmail.com"]}`, errs: ownerErrs},
+	"POST /events/{eventID}/invitations/{invitationID}/promote": {
+		body: `{"role":"team_member"}`,
+		errs: append(ownerErrs, domain.ErrInvitationNotFound, domain.ErrUserNotFound, domain.ErrAlreadyMember),
+	},
+	"POST /attendee/registrations":                                 {body: `{"event_code":"ab12"}`, errs: []error{domain.ErrNotFound, domain.ErrInvalidInput, domain.ErrDocumentsNotAccepted}},
+	"POST /events/join":                                            {body: `{"code":"ab12"}`, errs: []error{domain.ErrNotFound, domain.ErrDocumentsNotAccepted}},
+	"POST /attendee/events/{eventID}/registrations":                {errs: []error{domain.ErrNotFound, domain.ErrInvalidInput, domain.ErrDocumentsNotAccepted}},
+	"GET /attendee/events":                                         {},
+	"GET /attendee/events/{eventID}/schedule":                      {errs: ownerErrs},
+	"GET /attendee/events/{eventID}/schedule/changes":              {errs: append(ownerErrs, domain.ErrInvalidInput)},
+	"GET /public/events/{eventCode}/offline-bundle":                {errs: []error{domain.ErrNotFound}, contentType: "application/zip"},
+	"GET /public/events/{eventCode}/theme":                         {errs: []error{domain.ErrNotFound}},
+	"GET /public/events/{eventCode}/documents":                     {errs: []error{domain.ErrNotFound}},
+	"GET /public/events/{eventCode}/schedule.html":                 {errs: []error{domain.ErrNotFound}, contentType: "text/html; charset=utf-8"},
+	"GET /public/events/{eventCode}/metadata":                      {errs: []error{domain.ErrNotFound}},
+	"GET /public/sitemap.xml":                                      {contentType: "application/xml; charset=utf-8"},
+	"GET /public/sessions/{sessionID}/card":                        {errs: []error{domain.ErrNotFound}},
+	"GET /public/sessions/{sessionID}/card.png":                    {errs: []error{domain.ErrNotFound}, contentType: "image/png"},
+	"GET /events/{eventID}/sync":                                   {errs: append(ownerErrs, domain.ErrInvalidInput)},
+	"GET /events/{eventID}/schedule":                               {errs: append(ownerErrs, domain.ErrInvalidInput)},
+	"POST /auth/login/request":                                     {body: `{"email":"a@example.com"}`},
+	"POST /auth/login/verify":                                      {body: `{"email":"a@example.com","code":"123456"}`},
+	"GET /users/me":                                                {errs: []error{domain.ErrUserNotFound}},
+	"PATCH /users/me":                                              {body: `{"name":"A"}`, errs: []error{domain.ErrUserNotFound, domain.ErrDuplicateEmail}},
+	"POST /public/events/{eventCode}/contact":                      {body: `{"name":"Ada","email":"ada@example.com","subject":"Parking","message":"Is there parking?"}`, errs: []error{domain.ErrInvalidInput, domain.ErrRateLimited}},
+	"GET /events/{eventID}/contact-threads":                        {errs: ownerErrs},
+	"GET /events/{eventID}/contact-threads/{threadID}":             {errs: ownerErrs},
+	"POST /events/{eventID}/contact-threads/{threadID}/replies":    {body: `{"body":"Yes, level -1."}`, errs: append(ownerErrs, domain.ErrInvalidInput)},
+	"GET /changelog":                                               {},
+	"POST /changelog/read":                                         {body: `{"announcement_ids":[]}`},
+	"GET /admin/announcements":                                     {errs: []error{domain.ErrForbidden}},
+	"POST /admin/announcements":                                    {body: `{"title":"Dark mode","category":"feature"}`, errs: []error{domain.ErrForbidden, domain.ErrInvalidInput}},
+	"PUT /admin/announcements/{announcementID}":                    {body: `{"title":"Dark mode","category":"feature"}`, errs: []error{domain.ErrForbidden, domain.ErrNotFound, domain.ErrInvalidInput}},
+	"DELETE /admin/announcements/{announcementID}":                 {errs: []error{domain.ErrForbidden, domain.ErrNotFound}},
+	"POST /public/reports":                                         {body: `{"event_code":"ab12","target_type":"session","target_id":"00000000-0000-4000-8000-000000000001","reason":"spam"}`, errs: []error{domain.ErrNotFound, domain.ErrInvalidInput}},
+	"GET /admin/moderation-queue":                                  {errs: []error{domain.ErrForbidden}},
+	"GET /admin/moderation-queue/{targetType}/{targetID}":          {errs: []error{domain.ErrForbidden, domain.ErrNotFound}},
+	"POST /admin/moderation-queue/{targetType}/{targetID}/resolve": {body: `{"action":"dismiss"}`, errs: []error{domain.ErrForbidden, domain.ErrNotFound, domain.ErrInvalidInput}},
+	"GET /users/me/ip-allowlist":                                   {},
+	"PUT /users/me/ip-allowlist":                                   {body: `{"ranges":["10.0.0.0/8"]}`, errs: []error{domain.ErrInvalidInput}},
+	"GET /admin/users/{userID}/ip-allowlist":                       {errs: []error{domain.ErrForbidden, domain.ErrUserNotFound}},
+	"PUT /admin/users/{userID}/ip-allowlist":                       {body: `{"ranges":["10.0.0.0/8"]}`, errs: []error{domain.ErrForbidden, domain.ErrUserNotFound, domain.ErrInvalidInput}},
+	"GET /admin/machine-clients/{clientID}/ip-allowlist":           {errs: []error{domain.ErrForbidden, domain.ErrNotFound}},
+	"PUT /admin/machine-clients/{clientID}/ip-allowlist":           {body: `{"ranges":["10.0.0.0/8"]}`, errs: []error{domain.ErrForbidden, domain.ErrNotFound, domain.ErrInvalidInput}},
+	"GET /machine/events/{eventID}/schedule":                       {errs: ownerErrs},
+	"POST /auth/machine-token":                                     {body: `{"client_id":"00000000-0000-4000-8000-000000000001","client_secret":"s3cret"}`, errs: []error{domain.ErrInvalidClient}},
+	"GET /users/me/activity":                                       {},
+	"POST /events/{eventID}/deletion/confirm":                      {body: `{"token":"abc"}`, errs: append(ownerErrs, domain.ErrInvalidConfirmation, domain.ErrEventHasRegistrations)},
+	"DELETE /admin/events/{eventID}":                               {errs: []error{domain.ErrForbidden, domain.ErrNotFound}},
+	"GET /admin/machine-clients":                                   {errs: []error{domain.ErrForbidden}},
+	"POST /admin/machine-clients":                                  {body: `{"name":"Signage","event_id":"00000000-0000-4000-8000-000000000001","scopes":["schedule:read"]}`, errs: []error{domain.ErrForbidden, domain.ErrNotFound, domain.ErrInvalidInput}},
+	"POST /admin/machine-clients/{clientID}/revoke":                {errs: []error{domain.ErrForbidden, domain.ErrNotFound}},
+	"GET /meta/error-codes":                                        {},
+	"GET /readyz":                                                  {},
+
+	"GET /events/{eventID}/exhibitors":                                 {errs: ownerErrs},
+	"POST /events/{eventID}/exhibitors":                                {body: `{"name":"Acme","booth_number":"A12"}`, errs: append(ownerErrs, domain.ErrInvalidInput, domain.ErrDuplicateBooth)},
+	"DELETE /events/{eventID}/exhibitors/{exhibitorID}":                {errs: ownerErrs},
+	"POST /events/{eventID}/exhibitors/{exhibitorID}/staff":            {body: `{"email":"sam@example.com"}`, errs: append(ownerErrs, domain.ErrUserNotFound, domain.ErrInvalidInput)},
+	"DELETE /events/{eventID}/exhibitors/{exhibitorID}/staff/{userID}": {errs: ownerErrs},
+	"GET /attendee/events/{eventID}/lead-consent":                      {errs: []error{domain.ErrForbidden}},
+	"PUT /attendee/events/{eventID}/lead-consent":                      {body: `{"consent":true}`, errs: []error{domain.ErrForbidden}},
+	"GET /exhibitor/booths":                                            {},
+	"POST /exhibitors/{exhibitorID}/leads":                             {body: `{"code":"0123abcd"}`, errs: append(ownerErrs, domain.ErrInvalidInput)},
+	"GET /exhibitors/{exhibitorID}/leads/export":                       {errs: append(ownerErrs, domain.ErrEventNotOver), contentType: "text/csv; charset=utf-8"},
+
+	"GET /events/{eventID}/sessions/{sessionID}/suggestions":        {errs: append(ownerErrs, domain.ErrEnrichmentUnavailable)},
+	"POST /events/{eventID}/sessions/{sessionID}/suggestions/apply": {body: `{"tags":["Go"],"summary":"About Go."}`, errs: append(ownerErrs, domain.ErrInvalidInput)},
+
+	"GET /events/{eventID}/invitations/warmups":                    {errs: ownerErrs},
+	"POST /events/{eventID}/invitations/warmups":                   {body: `{"emails":"a@example.com","days":3}`, errs: append(ownerErrs, domain.ErrInvalidInput)},
+	"GET /events/{eventID}/invitations/warmups/{warmupID}":         {errs: ownerErrs},
+	"POST /events/{eventID}/invitations/warmups/{warmupID}/pause":  {errs: append(ownerErrs, domain.ErrWarmupCompleted)},
+	"POST /events/{eventID}/invitations/warmups/{warmupID}/resume": {errs: append(ownerErrs, domain.ErrWarmupCompleted)},
+	"GET /events/{eventID}/invitations/email-domain":               {errs: append(ownerErrs, domain.ErrEmailDomainNotChecked)},
+
+	"POST /machine/events/{eventID}/rooms/{roomID}/occupancy": {body: `{"delta":1}`, errs: append(ownerErrs, domain.ErrInvalidInput)},
+	"POST /machine/sandbox-events":                            {body: `{"name":"Test conf"}`, errs: []error{domain.ErrInvalidClient, domain.ErrInvalidInput}},
+
+	"GET /events/{eventID}/documents":                    {errs: ownerErrs},
+	"PUT /events/{eventID}/documents/{kind}":             {body: `{"title":"Code of conduct","body":"Be kind."}`, errs: append(ownerErrs, domain.ErrInvalidInput)},
+	"DELETE /events/{eventID}/documents/{kind}":          {errs: append(ownerErrs, domain.ErrInvalidInput)},
+	"GET /events/{eventID}/documents/{kind}/versions":    {errs: append(ownerErrs, domain.ErrInvalidInput)},
+	"GET /events/{eventID}/documents/{kind}/acceptances": {errs: append(ownerErrs, domain.ErrInvalidInput)},
+	"GET /attendee/events/{eventID}/documents":           {errs: []error{domain.ErrNotFound}},
+	"POST /attendee/events/{eventID}/documents/accept":   {body: `{"accepted_documents":[{"kind":"code_of_conduct","version":1}]}`, errs: []error{domain.ErrNotFound, domain.ErrInvalidInput}},
+
+	"GET /events/{eventID}/speakers/photos.zip":                           {errs: ownerErrs, contentType: "application/zip"},
+	"GET /events/{eventID}/speakers/photos/archives/{archiveID}":          {errs: ownerErrs},
+	"GET /events/{eventID}/speakers/photos/archives/{archiveID}/download": {errs: append(ownerErrs, domain.ErrArchiveNotReady), contentType: "application/zip"},
+}
+
+func TestContractCases_CoverEveryRoute(t *testing.T) {
+	patterns := make(map[string]bool)
+	for _, rt := range contractRoutes(&stubEventService{}, &stubUserService{}, &stubAttendeeService{}, &stubAnnouncementService{}, &stubContactService{}, &stubAbuseReportService{}, &stubIPAllowlistService{}, &stubMachineClientService{}, &stubActivityService{}, &stubEventDeletionService{}, &stubExhibitorService{}, &stubEnrichmentService{}, &stubInvitationWarmupService{}, &stubRoomOccupancyService{}, &stubEventDocumentService{}, &stubSpeakerPhotoService{}, &stubSessionStreamService{}) {
+		patterns[rt.Pattern] = true
+		_, ok := contractCases[rt.Pattern]
+		assert.True(t, ok, "route %q has no contract case", rt.Pattern)
+	}
+	for pattern := range contractCases {
+		assert.True(t, patterns[pattern], "contract case %q matches no route", pattern)
+	}
+}
+
+func TestRouter_CacheControl(t *testing.T) {
+	router := newContractRouter(&stubEventService{}, &stubUserService{}, &stubAttendeeService{}, &stubAnnouncementService{}, &stubContactService{}, &stubAbuseReportService{}, &stubIPAllowlistService{}, &stubMachineClientService{}, &stubActivityService{}, &stubEventDeletionService{}, &stubExhibitorService{}, &stubEnrichmentService{}, &stubInvitationWarmupService{}, &stubRoomOccupancyService{}, &stubEventDocumentService{}, &stubSpeakerPhotoService{}, &stubSessionStreamService{})
+	for _, rt := range contractRoutes(&stubEventService{}, &stubUserService{}, &stubAttendeeService{}, &stubAnnouncementService{}, &stubContactService{}, &stubAbuseReportService{}, &stubIPAllowlistService{}, &stubMachineClientService{}, &stubActivityService{}, &stubEventDeletionService{}, &stubExhibitorService{}, &stubEnrichmentService{}, &stubInvitationWarmupService{}, &stubRoomOccupancyService{}, &stubEventDocumentService{}, &stubSpeakerPhotoService{}, &stubSessionStreamService{}) {
+		t.Run(rt.Pattern, func(t *testing.T) {
+			want := privateCache
+			if rt.Public {
+				require.NotEmpty(t, rt.Cache, "public route %q has no cache policy", rt.Pattern)
+				want = rt.Cache
+			}
+			rec := serveContract(router, rt.Pattern, contractCases[rt.Pattern].body, contractToken)
+			assert.Equal(t, want, rec.Header().Get("Cache-Control"))
+		})
+	}
+}
+
+func TestRouter_ProtectedRoutesRequireAuth(t *testing.T) {
+	router := newContractRouter(&stubEventService{}, &stubUserService{}, &stubAttendeeService{}, &stubAnnouncementService{}, &stubContactService{}, &stubAbuseReportService{}, &stubIPAllowlistService{}, &stubMachineClientService{}, &stubActivityService{}, &stubEventDeletionService{}, &stubExhibitorService{}, &stubEnrichmentService{}, &stubInvitationWarmupService{}, &stubRoomOccupancyService{}, &stubEventDocumentService{}, &stubSpeakerPhotoService{}, &stubSessionStreamService{})
+	for _, rt := range contractRoutes(&stubEventService{}, &stubUserService{}, &stubAttendeeService{}, &stubAnnouncementService{}, &stubContactService{}, &stubAbuseReportService{}, &stubIPAllowlistService{}, &stubMachineClientService{}, &stubActivityService{}, &stubEventDeletionService{}, &stubExhibitorService{}, &stubEnrichmentService{}, &stubInvitationWarmupService{}, &stubRoomOccupancyService{}, &stubEventDocumentService{}, &stubSpeakerPhotoService{}, &stubSessionStreamService{}) {
+		if rt.Public {
+			continue
+		}
+		t.Run(rt.Pattern, func(t *testing.T) {
+			rec := serveContract(router, rt.Pattern, contractCases[rt.Pattern].body, "")
+			require.Equal(t, http.StatusUnauthorized, rec.Code)
+			env := decodeEnvelope(t, rec)
+			require.NotNil(t, env.Error)
+			assert.Equal(t, helpers.ErrCodeUnauthorized, env.Error.Code)
+		})
+	}
+}
+
+func TestRouter_SuccessEnvelope(t *testing.T) {
+	router := newContractRouter(&stubEventService{}, &stubUserService{}, &stubAttendeeService{}, &stubAnnouncementService{}, &stubContactService{}, &stubAbuseReportService{}, &stubIPAllowlistService{}, &stubMachineClientService{}, &stubActivityService{}, &stubEventDeletionService{}, &stubExhibitorService{}, &stubEnrichmentService{}, &stubInvitationWarmupService{}, &stubRoomOccupancyService{}, &stubEventDocumentService{}, &stubSpeakerPhotoService{}, &stubSessionStreamService{})
+	for _, rt := range contractRoutes(&stubEventService{}, &stubUserService{}, &stubAttendeeService{}, &stubAnnouncementService{}, &stubContactService{}, &stubAbuseReportService{}, &stubIPAllowlistService{}, &stubMachineClientService{}, &stubActivityService{}, &stubEventDeletionService{}, &stubExhibitorService{}, &stubEnrichmentService{}, &stubInvitationWarmupService{}, &stubRoomOccupancyService{}, &stubEventDocumentService{}, &stubSpeakerPhotoService{}, &stubSessionStreamService{}) {
+		t.Run(rt.Pattern, func(t *testing.T) {
+			rec := serveContract(router, rt.Pattern, contractCases[rt.Pattern].body, contractToken)
+			require.GreaterOrEqual(t, rec.Code, 200, rec.Body.String())
+			require.Less(t, rec.Code, 300, rec.Body.String())
+			if rec.Code == http.StatusNoContent {
+				assert.Empty(t, rec.Body.String())
+				return
+			}
+			if ct := contractCases[rt.Pattern].contentType; ct != "" {
+				assert.Equal(t, ct, rec.Header().Get("Content-Type"))
+				return
+			}
+			assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+			var raw map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &raw))
+			assert.Len(t, raw, 2, "envelope must only have data and error")
+			assert.Contains(t, raw, "data")
+			assert.Equal(t, "null", string(raw["error"]))
+		})
+	}
+}
+
+func TestRouter_PaginationMeta(t *testing.T) {
+	router := newContractRouter(&stubEventService{}, &stubUserService{}, &stubAttendeeService{}, &stubAnnouncementService{}, &stubContactService{}, &stubAbuseReportService{}, &stubIPAllowlistService{}, &stubMachineClientService{}, &stubActivityService{}, &stubEventDeletionService{}, &stubExhibitorService{}, &stubEnrichmentService{}, &stubInvitationWarmupService{}, &stubRoomOccupancyService{}, &stubEventDocumentService{}, &stubSpeakerPhotoService{}, &stubSessionStreamService{})
+	req := httptest.NewRequest(http.MethodGet, "/events/"+contractUUID+"/invitations?page=2&page_size=20", nil)
+	req.Header.Set("Authorization", "Bearer "+contractToken)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var env struct {
+		Data struct {
+			Items      []json.RawMessage      `json:"items"`
+			Pagination helpers.PaginationMeta `json:"pagination"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &env))
+	assert.NotNil(t, env.Data.Items)
+	assert.Equal(t, helpers.PaginationMeta{Page: 2, PageSize: 20, Total: contractInvitationTotal, TotalPages: 3}, env.Data.Pagination)
+}
+
+func TestRouter_ErrorCodesMatchCatalog(t *testing.T) {
+	catalog := make(map[string]int)
+	for _, info := range helpers.ErrorCatalog() {
+		catalog[info.Code] = info.Status
+	}
+	for _, rt := range contractRoutes(&stubEventService{}, &stubUserService{}, &stubAttendeeService{}, &stubAnnouncementService{}, &stubContactService{}, &stubAbuseReportService{}, &stubIPAllowlistService{}, &stubMachineClientService{}, &stubActivityService{}, &stubEventDeletionService{}, &stubExhibitorService{}, &stubEnrichmentService{}, &stubInvitationWarmupService{}, &stubRoomOccupancyService{}, &stubEventDocumentService{}, &stubSpeakerPhotoService{}, &stubSessionStreamService{}) {
+		cc := contractCases[rt.Pattern]
+		for _, sentinel := range cc.errs {
+			t.Run(rt.Pattern+"/"+sentinel.Error(), func(t *testing.T) {
+				router := newContractRouter(&stubEventService{err: sentinel}, &stubUserService{err: sentinel}, &stubAttendeeService{err: sentinel}, &stubAnnouncementService{err: sentinel}, &stubContactService{err: sentinel}, &stubAbuseReportService{err: sentinel}, &stubIPAllowlistService{err: sentinel}, &stubMachineClientService{err: sentinel}, &stubActivityService{err: sentinel}, &stubEventDeletionService{err: sentinel}, &stubExhibitorService{err: sentinel}, &stubEnrichmentService{err: sentinel}, &stubInvitationWarmupService{err: sentinel}, &stubRoomOccupancyService{err: sentinel}, &stubEventDocumentService{err: sentinel}, &stubSpeakerPhotoService{err: sentinel}, &stubSessionStreamService{err: sentinel})
+				rec := serveContract(router, rt.Pattern, cc.body, contractToken)
+				assert.Equal(t, helpers.CodeForError(sentinel).Status, rec.Code, rec.Body.String())
+				env := decodeEnvelope(t, rec)
+				require.NotNil(t, env.Error)
+				status, ok := catalog[env.Error.Code]
+				require.True(t, ok, "code %q is not in the error catalog", env.Error.Code)
+				assert.Equal(t, status, rec.Code, "status of code %q", env.Error.Code)
+			})
+		}
+	}
+}
+
+func TestRouter_UnexpectedErrorIsInternal(t *testing.T) {
+	boom := errors.New("database is down")
+	router := newContractRouter(&stubEventService{err: boom}, &stubUserService{err: boom}, &stubAttendeeService{err: boom}, &stubAnnouncementService{err: boom}, &stubContactService{err: boom}, &stubAbuseReportService{err: boom}, &stubIPAllowlistService{err: boom}, &stubMachineClientService{err: boom}, &stubActivityService{err: boom}, &stubEventDeletionService{err: boom}, &stubExhibitorService{err: boom}, &stubEnrichmentService{err: boom}, &stubInvitationWarmupService{err: boom}, &stubRoomOccupancyService{err: boom}, &stubEventDocumentService{err: boom}, &stubSpeakerPhotoService{err: boom}, &stubSessionStreamService{err: boom})
+	for _, rt := range contractRoutes(&stubEventService{}, &stubUserService{}, &stubAttendeeService{}, &stubAnnouncementService{}, &stubContactService{}, &stubAbuseReportService{}, &stubIPAllowlistService{}, &stubMachineClientService{}, &stubActivityService{}, &stubEventDeletionService{}, &stubExhibitorService{}, &stubEnrichmentService{}, &stubInvitationWarmupService{}, &stubRoomOccupancyService{}, &stubEventDocumentService{}, &stubSpeakerPhotoService{}, &stubSessionStreamService{}) {
+		if rt.Pattern == "GET /meta/error-codes" || rt.Pattern == "GET /readyz" {
+			continue // served without calling a service
+		}
+		t.Run(rt.Pattern, func(t *testing.T) {
+			rec := serveContract(router, rt.Pattern, contractCases[rt.Pattern].body, contractToken)
+			require.Equal(t, http.StatusInternalServerError, rec.Code, rec.Body.String())
+			env := decodeEnvelope(t, rec)
+			require.NotNil(t, env.Error)
+			assert.Equal(t, helpers.ErrCodeInternalError, env.Error.Code)
+		})
+	}
+}
+
+type contractEnvelope struct {
+	Data  json.RawMessage   `json:"data"`
+	Error *helpers.APIError `json:"error"`
+}
+
+func decodeEnvelope(t *testing.T, rec *httptest.ResponseRecorder) contractEnvelope {
+	t.Helper()
+	var env contractEnvelope
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &env), rec.Body.String())
+	return env
+}
+
+func newContractControllers(events domain.EventService, users domain.UserService, attendees domain.AttendeeService, announcements domain.AnnouncementService, contacts domain.ContactService, reports domain.AbuseReportService, allowlists domain.IPAllowlistService, machines domain.MachineClientService, activity domain.ActivityService, deletions domain.EventDeletionService, exhibitors domain.ExhibitorService, enrichments domain.EnrichmentService, warmups domain.InvitationWarmupService, occupancy domain.RoomOccupancyService, documents domain.EventDocumentService, speakerPhotos domain.SpeakerPhotoService, streams domain.SessionStreamService) (*controllers.ScheduleController, *controllers.UserController, *controllers.AttendeeController, *controllers.MetaController, *controllers.AnnouncementController, *controllers.ContactController, *controllers.AbuseReportController, *controllers.IPAllowlistController, *controllers.MachineClientController, *controllers.ActivityController, *controllers.EventDeletionController, *controllers.ExhibitorController, *controllers.EnrichmentController, *controllers.InvitationWarmupController, *controllers.RoomOccupancyController, *controllers.EventDocumentController, *controllers.SpeakerPhotoController, *controllers.SessionStreamController) {
+	return controllers.NewScheduleController(contractLogger, events),
+		controllers.NewUserController(contractLogger, users),
+		controllers.NewAttendeeController(contractLogger, attendees),
+		controllers.NewMetaController(contractLogger),
+		controllers.NewAnnouncementController(contractLogger, announcements),
+		controllers.NewContactController(contractLogger, contacts),
+		controllers.NewAbuseReportController(contractLogger, reports),
+		controllers.NewIPAllowlistController(contractLogger, allowlists),
+		controllers.NewMachineClientController(contractLogger, machines),
+		controllers.NewActivityController(contractLogger, activity),
+		controllers.NewEventDeletionController(contractLogger, deletions),
+		controllers.NewExhibitorController(contractLogger, exhibitors),
+		controllers.NewEnrichmentController(contractLogger, enrichments),
+		controllers.NewInvitationWarmupController(contractLogger, warmups),
+		controllers.NewRoomOccupancyController(contractLogger, occupancy),
+		controllers.NewEventDocumentController(contractLogger, documents),
+		controllers.NewSpeakerPhotoController(contractLogger, speakerPhotos),
+		controllers.NewSessionStreamController(contractLogger, streams)
+}
+
+func contractRoutes(events domain.EventService, users domain.UserService, attendees domain.AttendeeService, announcements domain.AnnouncementService, contacts domain.ContactService, reports domain.AbuseReportService, allowlists domain.IPAllowlistService, machines domain.MachineClientService, activity domain.ActivityService, deletions domain.EventDeletionService, exhibitors domain.ExhibitorService, enrichments domain.EnrichmentService, warmups domain.InvitationWarmupService, occupancy domain.RoomOccupancyService, documents domain.EventDocumentService, speakerPhotos domain.SpeakerPhotoService, streams domain.SessionStreamService) []route {
+	return routes(newContractControllers(events, users, attendees, announcements, contacts, reports, allowlists, machines, activity, deletions, exhibitors, enrichments, warmups, occupancy, documents, speakerPhotos, streams))
+}
+
+func newContractRouter(events domain.EventService, users domain.UserService, attendees domain.AttendeeService, announcements domain.AnnouncementService, contacts domain.ContactService, reports domain.AbuseReportService, allowlists domain.IPAllowlistService, machines domain.MachineClientService, activity domain.ActivityService, deletions domain.EventDeletionService, exhibitors domain.ExhibitorService, enrichments domain.EnrichmentService, warmups domain.InvitationWarmupService, occupancy domain.RoomOccupancyService, documents domain.EventDocumentService, speakerPhotos domain.SpeakerPhotoService, streams domain.SessionStreamService) *http.ServeMux {
+	schedule, user, attendee, meta, announcement, contact, report, allowlist, machine, activityCtrl, deletion, exhibitor, enrichment, warmup, occupancyCtrl, document, speakerPhoto, stream := newContractControllers(events, users, attendees, announcements, contacts, reports, allowlists, machines, activity, deletions, exhibitors, enrichments, warmups, occupancy, documents, speakerPhotos, streams)
+	return NewRouter(schedule, user, attendee, meta, announcement, contact, report, allowlist, machine, activityCtrl, deletion, exhibitor, enrichment, warmup, occupancyCtrl, document, speakerPhoto, stream, middleware.RequireAuth(stubVerifier{}, contractLogger), middleware.RequireScope(stubVerifier{}, &stubIPAllowlistService{}, contractLogger), nil, nil, nil, nil)
+}
+
+// serveContract sends a request for pattern with its path parameters filled in (see contractUUID).
+func serveContract(router http.Handler, pattern, body, token string) *httptest.ResponseRecorder {
+	method, path, _ := strings.Cut(pattern, " ")
+	segments := strings.Split(path, "/")
+	for i, seg := range segments {
+		if seg == "{eventCode}" {
+			segments[i] = contractEventCode
+		} else if seg == "{targetType}" {
+			segments[i] = contractTargetType
+		} else if seg == "{kind}" {
+			segments[i] = contractDocumentKind
+		} else if strings.HasPrefix(seg, "{") {
+			segments[i] = contractUUID
+		}
+	}
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	req := httptest.NewRequest(method, strings.Join(segments, "/"), reader)
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+type stubVerifier struct{}
+
+func (stubVerifier) Verify(token string) (string, error) {
+	if token != contractToken {
+		return "", errors.New("invalid token")
+	}
+	return "user-123", nil
+}
+
+// VerifyMachine accepts contractToken as a token of a client of every event with every scope.
+func (stubVerifier) VerifyMachine(token string) (*domain.MachinePrincipal, error) {
+	if token != contractToken {
+		return nil, errors.New("invalid token")
+	}
+	return &domain.MachinePrincipal{ClientID: contractUUID, EventID: contractUUID, Scopes: domain.MachineScopes}, nil
+}
+
+// stubEventService returns non-nil zero values, or err (wrapped, as services do) when set.
+type stubEventService struct {
+	err error
+}
+
+func (s *stubEventService) fail() error {
+	if s.err == nil {
+		return nil
+	}
+	return fmt.Errorf("stub: %w", s.err)
+}
+
+func (s *stubEventService) CreateEvent(ctx context.Context, event *domain.Event) error {
+	return s.fail()
+}
+
+func (s *stubEventService) GetEventByID(ctx context.Context, eventID string) (*domain.Event, []*domain.Room, []*domain.Session, error) {
+	if err := s.fail(); err != nil {
+		return nil, nil, nil, err
+	}
+	return &domain.Event{ID: eventID}, []*domain.Room{}, []*domain.Session{}, nil
+}
+
+func (s *stubEventService) UpdateEvent(ctx context.Context, eventID, ownerID string, date *time.Time, description *string, locationLat, locationLng *float64) (*domain.Event, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.Event{ID: eventID}, nil
+}
+
+func (s *stubEventService) RegenerateEventCode(ctx context.Context, eventID, ownerID string) (*domain.EventCodeChange, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.EventCodeChange{Event: &domain.Event{ID: eventID}, InvitationsFailed: []string{}}, nil
+}
+
+func (s *stubEventService) CreateEventRoom(ctx context.Context, eventID, ownerID, name string, capacity int, description, howToGetThere string, notBookable bool) (*domain.Room, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.Room{EventID: eventID, Name: name}, nil
+}
+
+func (s *stubEventService) CreateEventSession(ctx context.Context, eventID, ownerID, roomID, title, description string, startTime, endTime time.Time, tagNames, speakerIDs []string) (*domain.Session, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.Session{RoomID: roomID, Title: title}, nil
+}
+
+func (s *stubEventService) UpdateSessionSchedule(ctx context.Context, eventID, sessionID, ownerID string, roomID *string, startTime, endTime *time.Time) (*domain.Session, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.Session{ID: sessionID}, nil
+}
+
+func (s *stubEventService) UpdateSessionContent(ctx context.Context, eventID, sessionID, ownerID string, title *string, description *string) (*domain.Session, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.Session{ID: sessionID}, nil
+}
+
+func (s *stubEventService) ImportSessionizeData(ctx context.Context, eventID string, sessionizeID string, forceRefresh bool) error {
+	return s.fail()
+}
+
+func (s *stubEventService) PreviewSessionizeImport(ctx context.Context, eventID, ownerID, sessionizeID string, forceRefresh bool) (*domain.SessionizeImportPreview, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.SessionizeImportPreview{EventID: eventID, DryRun: true}, nil
+}
+
+func (s *stubEventService) GetImportMapping(ctx context.Context, eventID, ownerID string) (*domain.ImportMapping, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return domain.NewImportMapping(eventID), nil
+}
+
+func (s *stubEventService) GetScheduleRules(ctx context.Context, eventID, ownerID string) (*domain.ScheduleRules, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return domain.NewScheduleRules(eventID), nil
+}
+
+func (s *stubEventService) UpdateScheduleRules(ctx context.Context, eventID, ownerID string, rules *domain.ScheduleRules) (*domain.ScheduleRules, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (s *stubEventService) GetEventTheme(ctx context.Context, eventID, ownerID string) (*domain.EventTheme, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return domain.NewEventTheme(eventID), nil
+}
+
+func (s *stubEventService) UpdateEventTheme(ctx context.Context, eventID, ownerID string, theme *domain.EventTheme) (*domain.EventTheme, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	theme.EventID = eventID
+	return theme, nil
+}
+
+func (s *stubEventService) GetOperatingHours(ctx context.Context, eventID, ownerID string) ([]*domain.OperatingDay, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return []*domain.OperatingDay{}, nil
+}
+
+func (s *stubEventService) UpdateOperatingHours(ctx context.Context, eventID, ownerID string, days []*domain.OperatingDay) ([]*domain.OperatingDay, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return days, nil
+}
+
+func (s *stubEventService) UpdateImportMapping(ctx context.Context, eventID, ownerID string, mapping *domain.ImportMapping) (*domain.ImportMapping, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return mapping, nil
+}
+
+func (s *stubEventService) ListMyEvents(ctx context.Context, userID string, params domain.MyEventsParams) ([]*domain.Event, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return []*domain.Event{}, nil
+}
+
+func (s *stubEventService) SearchEvents(ctx context.Context, userID string, params domain.EventSearchParams) ([]*domain.EventSearchResult, int, error) {
+	if err := s.fail(); err != nil {
+		return nil, 0, err
+	}
+	return []*domain.EventSearchResult{}, 0, nil
+}
+
+func (s *stubEventService) ListJoinedEvents(ctx context.Context, userID string) ([]*domain.Event, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return []*domain.Event{}, nil
+}
+
+func (s *stubEventService) DeleteEvent(ctx context.Context, eventID string, ownerID string, force bool) error {
+	return s.fail()
+}
+
+func (s *stubEventService) ToggleRoomNotBookable(ctx context.Context, eventID, roomID, ownerID string) (*domain.Room, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.Room{ID: roomID, EventID: eventID}, nil
+}
+
+func (s *stubEventService) ListEventRooms(ctx context.Context, eventID, ownerID string) ([]*domain.Room, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return []*domain.Room{}, nil
+}
+
+func (s *stubEventService) ReorderEventRooms(ctx context.Context, eventID, ownerID string, roomIDs []string) ([]*domain.Room, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return []*domain.Room{}, nil
+}
+
+func (s *stubEventService) ListEventTracks(ctx context.Context, eventID, ownerID string) ([]string, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return []string{}, nil
+}
+
+func (s *stubEventService) ReorderEventTracks(ctx context.Context, eventID, ownerID string, tracks []string) ([]string, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return tracks, nil
+}
+
+func (s *stubEventService) GetEventRoom(ctx context.Context, eventID, roomID, ownerID string) (*domain.Room, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.Room{ID: roomID, EventID: eventID}, nil
+}
+
+func (s *stubEventService) UpdateEventRoom(ctx context.Context, eventID, roomID, ownerID string, name *string, capacity int, description, howToGetThere string, notBookable *bool) (*domain.Room, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.Room{ID: roomID, EventID: eventID}, nil
+}
+
+func (s *stubEventService) DeleteEventRoom(ctx context.Context, eventID, roomID, ownerID, mode, targetRoomID string) error {
+	return s.fail()
+}
+
+func (s *stubEventService) PreviewDeleteEventRoom(ctx context.Context, eventID, roomID, ownerID, mode, targetRoomID string) (*domain.RoomDeletionPreview, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.RoomDeletionPreview{EventID: eventID, RoomID: roomID, Mode: mode, DryRun: true}, nil
+}
+
+func (s *stubEventService) ListSessionHistory(ctx context.Context, eventID, sessionID, ownerID string) ([]*domain.SessionChange, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return []*domain.SessionChange{}, nil
+}
+
+func (s *stubEventService) GetEventScheduleAsOf(ctx context.Context, eventID, ownerID string, asOf time.Time) (*domain.ScheduleSnapshot, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.ScheduleSnapshot{Event: &domain.Event{}, Rooms: []*domain.RoomWithSessions{}, AsOf: asOf, DeletedSessionIDs: []string{}, EditedSessionIDs: []string{}}, nil
+}
+
+func (s *stubEventService) DeleteEventSession(ctx context.Context, eventID, sessionID, ownerID string) error {
+	return s.fail()
+}
+
+func (s *stubEventService) ListEventSpeakers(ctx context.Context, eventID, ownerID string) ([]*domain.Speaker, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return []*domain.Speaker{}, nil
+}
+
+func (s *stubEventService) GetEventSpeaker(ctx context.Context, eventID, speakerID, ownerID string) (*domain.Speaker, []*domain.Session, error) {
+	if err := s.fail(); err != nil {
+		return nil, nil, err
+	}
+	return &domain.Speaker{ID: speakerID, EventID: eventID}, []*domain.Session{}, nil
+}
+
+func (s *stubEventService) DeleteEventSpeaker(ctx context.Context, eventID, speakerID, ownerID string) error {
+	return s.fail()
+}
+
+func (s *stubEventService) CreateEventSpeaker(ctx context.Context, eventID, ownerID string, firstName, lastName, email, bio, tagLine, profilePicture string, isTopSpeaker bool) (*domain.Speaker, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.Speaker{EventID: eventID, FirstName: firstName}, nil
+}
+
+func (s *stubEventService) GetIntegrityReport(ctx context.Context, eventID, ownerID string) (*domain.IntegrityReport, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.IntegrityReport{EventID: eventID, Issues: []*domain.IntegrityIssue{}}, nil
+}
+
+func (s *stubEventService) CleanupIntegrityIssues(ctx context.Context, eventID, ownerID string, dryRun bool) (*domain.IntegrityCleanup, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.IntegrityCleanup{EventID: eventID, DryRun: dryRun, Issues: []*domain.IntegrityIssue{}}, nil
+}
+
+func (s *stubEventService) ValidateSchedule(ctx context.Context, eventID, ownerID string) (*domain.ScheduleValidation, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.ScheduleValidation{EventID: eventID, Valid: true, Categories: map[string][]*domain.ScheduleProblem{}}, nil
+}
+
+func (s *stubEventService) GetChecklist(ctx context.Context, eventID, callerID string) ([]*domain.ChecklistItem, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return []*domain.ChecklistItem{}, nil
+}
+
+func (s *stubEventService) UpdateChecklist(ctx context.Context, eventID, ownerID string, labels []string) ([]*domain.ChecklistItem, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return []*domain.ChecklistItem{}, nil
+}
+
+func (s *stubEventService) SetSessionChecklistItem(ctx context.Context, eventID, sessionID, itemID, userID string, done bool) (*domain.SessionChecklist, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.SessionChecklist{SessionID: sessionID, Items: []*domain.SessionChecklistItem{}}, nil
+}
+
+func (s *stubEventService) GetChecklistProgress(ctx context.Context, eventID, callerID string, ready *bool) (*domain.ChecklistProgress, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.ChecklistProgress{EventID: eventID, Items: []*domain.ChecklistItem{}, Members: []*domain.ChecklistMemberProgress{}, Sessions: []*domain.SessionChecklist{}}, nil
+}
+
+func (s *stubEventService) ListCustomFields(ctx context.Context, eventID, callerID string) ([]*domain.CustomField, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return []*domain.CustomField{}, nil
+}
+
+func (s *stubEventService) CreateCustomField(ctx context.Context, eventID, ownerID string, field *domain.CustomField) (*domain.CustomField, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return field, nil
+}
+
+func (s *stubEventService) UpdateCustomField(ctx context.Context, eventID, fieldID, ownerID string, name *string, public *bool) (*domain.CustomField, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.CustomField{ID: fieldID, EventID: eventID}, nil
+}
+
+func (s *stubEventService) DeleteCustomField(ctx context.Context, eventID, fieldID, ownerID string) error {
+	return s.fail()
+}
+
+func (s *stubEventService) SetSessionCustomFields(ctx context.Context, eventID, sessionID, ownerID string, values map[string]any) ([]*domain.CustomFieldValue, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return []*domain.CustomFieldValue{}, nil
+}
+
+func (s *stubEventService) SetSpeakerCustomFields(ctx context.Context, eventID, speakerID, ownerID string, values map[string]any) ([]*domain.CustomFieldValue, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return []*domain.CustomFieldValue{}, nil
+}
+
+func (s *stubEventService) BulkUpdateSpeakers(ctx context.Context, eventID, ownerID string, updates []*domain.SpeakerUpdate, dryRun bool) (*domain.SpeakerBulkUpdateResult, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.SpeakerBulkUpdateResult{Applied: true, Results: []*domain.SpeakerBulkItemResult{}}, nil
+}
+
+func (s *stubEventService) GetScheduleGrid(ctx context.Context, eventID, callerID string) (*domain.ScheduleGrid, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.ScheduleGrid{EventID: eventID, Rooms: []*domain.ScheduleGridRoom{}, Days: []*domain.ScheduleGridDay{}, Unscheduled: []*domain.ScheduleGridSession{}}, nil
+}
+
+func (s *stubEventService) GetScheduleOverlaps(ctx context.Context, eventID, callerID, groupBy string, bucketMinutes int) (*domain.ScheduleOverlaps, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.ScheduleOverlaps{EventID: eventID, GroupBy: groupBy, BucketMinutes: bucketMinutes, Buckets: []*domain.OverlapBucket{}}, nil
+}
+
+func (s *stubEventService) ListSpeakerMergeCandidates(ctx context.Context, eventID, ownerID string) ([]*domain.SpeakerMergeCandidate, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return []*domain.SpeakerMergeCandidate{}, nil
+}
+
+func (s *stubEventService) ResolveSpeakerMergeCandidate(ctx context.Context, eventID, candidateID, ownerID string, merge bool) (*domain.SpeakerMergeCandidate, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.SpeakerMergeCandidate{ID: candidateID, EventID: eventID, Status: domain.MergeStatusMerged}, nil
+}
+
+func (s *stubEventService) AddEventTeamMember(ctx context.Context, eventID, userIDToAdd, ownerID string) error {
+	return s.fail()
+}
+
+func (s *stubEventService) AddEventTeamMemberByEmail(ctx context.Context, eventID, email, ownerID string) (*domain.EventTeamMember, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.EventTeamMember{EventID: eventID, Email: email}, nil
+}
+
+func (s *stubEventService) ListEventTeamMembers(ctx context.Context, eventID, callerID string) ([]*domain.EventTeamMember, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return []*domain.EventTeamMember{}, nil
+}
+
+func (s *stubEventService) RemoveEventTeamMember(ctx context.Context, eventID, userIDToRemove, ownerID string) error {
+	return s.fail()
+}
+
+func (s *stubEventService) PromoteInvitation(ctx context.Context, eventID, invitationID, ownerID, role string) (*domain.EventTeamMember, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.EventTeamMember{EventID: eventID, UserID: contractUUID}, nil
+}
+
+func (s *stubEventService) LeaveEvent(ctx context.Context, eventID, userID string) (bool, error) {
+	if err := s.fail(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *stubEventService) ListEventEmails(ctx context.Context, eventID, ownerID string, filter domain.EventEmailFilter, params domain.PaginationParams) ([]*domain.EventEmail, int, error) {
+	if err := s.fail(); err != nil {
+		return nil, 0, err
+	}
+	return []*domain.EventEmail{}, 0, nil
+}
+
+func (s *stubEventService) ResendEventEmail(ctx context.Context, eventID, ownerID, emailID string) (*domain.EventEmail, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.EventEmail{ID: "em-2", ResendOf: emailID, Status: domain.EventEmailSent}, nil
+}
+
+func (s *stubEventService) RemindEventInvitations(ctx context.Context, eventID, ownerID string, minInterval time.Duration) (*domain.InvitationReminderResult, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.InvitationReminderResult{Failed: []string{}}, nil
+}
+
+func (s *stubEventService) SendEventInvitations(ctx context.Context, eventID, ownerID string, emails []string) (int, []string, []string, error) {
+	if err := s.fail(); err != nil {
+		return 0, nil, nil, err
+	}
+	return len(emails), []string{}, []string{}, nil
+}
+
+func (s *stubEventService) AnonymizeEvent(ctx context.Context, eventID, ownerID string, dryRun bool) (*domain.EventAnonymization, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.EventAnonymization{EventID: eventID, AnonymizedBy: ownerID}, nil
+}
+
+func (s *stubEventService) GetInvitationDomainRules(ctx context.Context, eventID, ownerID string) (*domain.InvitationDomainRules, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return domain.NewInvitationDomainRules(eventID), nil
+}
+
+func (s *stubEventService) UpdateInvitationDomainRules(ctx context.Context, eventID, ownerID string, rules *domain.InvitationDomainRules) (*domain.InvitationDomainRules, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	rules.EventID = eventID
+	return rules, nil
+}
+
+func (s *stubEventService) ListEventInvitations(ctx context.Context, eventID, callerID string, search string, params domain.PaginationParams) ([]*domain.EventInvitation, int, error) {
+	if err := s.fail(); err != nil {
+		return nil, 0, err
+	}
+	return []*domain.EventInvitation{}, contractInvitationTotal, nil
+}
+
+func (s *stubEventService) StreamEventInvitations(ctx context.Context, eventID, callerID string, search string, fn func(*domain.EventInvitation) error) error {
+	return s.fail()
+}
+
+func (s *stubEventService) StreamEventSessions(ctx context.Context, eventID, ownerID string, fn func(*domain.Session) error) error {
+	return s.fail()
+}
+
+func (s *stubEventService) ListEventTags(ctx context.Context, eventID, callerID string) ([]*domain.Tag, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return []*domain.Tag{}, nil
+}
+
+func (s *stubEventService) AddEventTags(ctx context.Context, eventID, ownerID string, tagNames []string) ([]*domain.Tag, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return []*domain.Tag{}, nil
+}
+
+func (s *stubEventService) AddSessionTag(ctx context.Context, eventID, sessionID, ownerID, tagID string) error {
+	return s.fail()
+}
+
+func (s *stubEventService) RemoveSessionTag(ctx context.Context, eventID, sessionID, ownerID, tagID string) error {
+	return s.fail()
+}
+
+func (s *stubEventService) AddSessionSpeaker(ctx context.Context, eventID, sessionID, ownerID, speakerID string) error {
+	return s.fail()
+}
+
+func (s *stubEventService) RemoveSessionSpeaker(ctx context.Context, eventID, sessionID, ownerID, speakerID string) error {
+	return s.fail()
+}
+
+func (s *stubEventService) ListSessionSpeakers(ctx context.Context, eventID, sessionID, callerID string) ([]*domain.Speaker, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return []*domain.Speaker{}, nil
+}
+
+func (s *stubEventService) UpdateEventTag(ctx context.Context, eventID, tagID, ownerID, name string) (*domain.Tag, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.Tag{ID: tagID, Name: name}, nil
+}
+
+func (s *stubEventService) RemoveEventTag(ctx context.Context, eventID, ownerID, tagID string) error {
+	return s.fail()
+}
+
+// stubUserService returns a user, or err when set. Only Update can report ErrDuplicateEmail.
+type stubUserService struct {
+	err error
+}
+
+func (s *stubUserService) RequestLoginCode(ctx context.Context, email string) error {
+	if s.err != nil {
+		return fmt.Errorf("stub: %w", s.err)
+	}
+	return nil
+}
+
+func (s *stubUserService) VerifyLoginCode(ctx context.Context, email, code string) (string, *domain.User, error) {
+	if s.err != nil {
+		return "", nil, fmt.Errorf("stub: %w", s.err)
+	}
+	return contractToken, &domain.User{ID: "user-123", Email: email}, nil
+}
+
+func (s *stubUserService) GetByID(ctx context.Context, id string) (*domain.User, error) {
+	if s.err != nil && !errors.Is(s.err, domain.ErrDuplicateEmail) {
+		return nil, fmt.Errorf("stub: %w", s.err)
+	}
+	return &domain.User{ID: id}, nil
+}
+
+func (s *stubUserService) Update(ctx context.Context, user *domain.User) error {
+	if s.err != nil {
+		return fmt.Errorf("stub: %w", s.err)
+	}
+	return nil
+}
+
+// stubAttendeeService returns a new registration, or err when set.
+type stubAttendeeService struct {
+	err error
+}
+
+func (s *stubAttendeeService) RegisterForEvent(ctx context.Context, eventID, userID string) (*domain.EventRegistration, bool, error) {
+	if s.err != nil {
+		return nil, false, fmt.Errorf("stub: %w", s.err)
+	}
+	return &domain.EventRegistration{EventID: eventID, UserID: userID}, true, nil
+}
+
+func (s *stubAttendeeService) RegisterForEventByCode(ctx context.Context, eventCode, userID string, accepted []domain.DocumentVersion) (*domain.EventRegistration, bool, error) {
+	if s.err != nil {
+		return nil, false, fmt.Errorf("stub: %w", s.err)
+	}
+	return &domain.EventRegistration{UserID: userID}, true, nil
+}
+
+func (s *stubAttendeeService) JoinEventByCode(ctx context.Context, eventCode, userID string, accepted []domain.DocumentVersion) (*domain.EventJoin, error) {
+	if s.err != nil {
+		return nil, fmt.Errorf("stub: %w", s.err)
+	}
+	return &domain.EventJoin{Registration: &domain.EventRegistration{UserID: userID}, Joined: true, Schedule: &domain.EventSchedule{}}, nil
+}
+
+func (s *stubAttendeeService) ListMyRegisteredEvents(ctx context.Context, userID string) ([]*domain.EventRegistrationWithEvent, error) {
+	if s.err != nil {
+		return nil, fmt.Errorf("stub: %w", s.err)
+	}
+	return []*domain.EventRegistrationWithEvent{}, nil
+}
+
+func (s *stubAttendeeService) ListScheduleChanges(ctx context.Context, eventID, userID string, since time.Time, limit int) ([]*domain.SessionChange, error) {
+	if s.err != nil {
+		return nil, fmt.Errorf("stub: %w", s.err)
+	}
+	return []*domain.SessionChange{}, nil
+}
+
+func (s *stubAttendeeService) SyncEvent(ctx context.Context, eventID, userID, since string, limit int) (*domain.SyncDelta, error) {
+	if s.err != nil {
+		return nil, fmt.Errorf("stub: %w", s.err)
+	}
+	return &domain.SyncDelta{EventID: eventID, Cursor: "0"}, nil
+}
+
+func (s *stubAttendeeService) GetOfflineBundle(ctx context.Context, eventCode, knownVersion string) (*domain.OfflineBundle, error) {
+	if s.err != nil {
+		return nil, fmt.Errorf("stub: %w", s.err)
+	}
+	return &domain.OfflineBundle{Manifest: &domain.OfflineBundleManifest{EventCode: eventCode, Version: "v1"}, Archive: []byte("PK")}, nil
+}
+
+func (s *stubAttendeeService) GetPublicSchedule(ctx context.Context, eventCode string) (*domain.PublicSchedule, error) {
+	if s.err != nil {
+		return nil, fmt.Errorf("stub: %w", s.err)
+	}
+	return &domain.PublicSchedule{
+		EventID:  contractUUID,
+		Schedule: &domain.OfflineSchedule{Event: &domain.Event{ID: contractUUID, Name: "Conf", EventCode: eventCode}},
+		Theme:    domain.NewEventTheme(contractUUID),
+	}, nil
+}
+
+func (s *stubAttendeeService) GetSessionCard(ctx context.Context, sessionID string) (*domain.SessionCard, error) {
+	if s.err != nil {
+		return nil, fmt.Errorf("stub: %w", s.err)
+	}
+	return &domain.SessionCard{SessionID: sessionID, EventID: contractUUID, EventName: "Conf", Title: "Talk", Speakers: []string{}}, nil
+}
+
+func (s *stubAttendeeService) GetSessionCardImage(ctx context.Context, sessionID string) (*domain.SessionCardImage, error) {
+	if s.err != nil {
+		return nil, fmt.Errorf("stub: %w", s.err)
+	}
+	return &domain.SessionCardImage{EventID: contractUUID, PNG: []byte("\x89PNG\r\n\x1a\n")}, nil
+}
+
+func (s *stubAttendeeService) ListPublicPages(ctx context.Context) ([]*domain.PublicPage, error) {
+	if s.err != nil {
+		return nil, fmt.Errorf("stub: %w", s.err)
+	}
+	return []*domain.PublicPage{{Kind: domain.PublicPageEvent, EventCode: contractEventCode, ID: contractUUID}}, nil
+}
+
+func (s *stubAttendeeService) GetEventTheme(ctx context.Context, eventCode string) (*domain.EventTheme, error) {
+	if s.err != nil {
+		return nil, fmt.Errorf("stub: %w", s.err)
+	}
+	return domain.NewEventTheme(contractUUID), nil
+}
+
+func (s *stubAttendeeService) GetPublicEventDocuments(ctx context.Context, eventCode string) (*domain.EventDocuments, error) {
+	if s.err != nil {
+		return nil, fmt.Errorf("stub: %w", s.err)
+	}
+	return &domain.EventDocuments{EventID: contractUUID, Documents: []*domain.EventDocument{}}, nil
+}
+
+func (s *stubAttendeeService) GetEventScheduleForClient(ctx context.Context, eventID string, client *domain.MachinePrincipal) (*domain.EventSchedule, error) {
+	return s.GetEventSchedule(ctx, eventID, client.ClientID)
+}
+
+func (s *stubAttendeeService) GetEventSchedule(ctx context.Context, eventID, userID string) (*domain.EventSchedule, error) {
+	if s.err != nil {
+		return nil, fmt.Errorf("stub: %w", s.err)
+	}
+	return &domain.EventSchedule{Event: &domain.Event{ID: eventID}, Rooms: []*domain.RoomWithSessions{}}, nil
+}
+
+type stubAnnouncementService struct {
+	err error
+}
+
+func (s *stubAnnouncementService) ListChangelog(ctx context.Context, userID string, params domain.PaginationParams) ([]*domain.Announcement, int, int, error) {
+	if s.err != nil {
+		return nil, 0, 0, fmt.Errorf("stub: %w", s.err)
+	}
+	return []*domain.Announcement{}, 0, 0, nil
+}
+
+func (s *stubAnnouncementService) MarkAnnouncementsRead(ctx context.Context, userID string, ids []string) error {
+	if s.err != nil {
+		return fmt.Errorf("stub: %w", s.err)
+	}
+	return nil
+}
+
+func (s *stubAnnouncementService) ListAnnouncements(ctx context.Context, adminID string, params domain.PaginationParams) ([]*domain.Announcement, int, error) {
+	if s.err != nil {
+		return nil, 0, fmt.Errorf("stub: %w", s.err)
+	}
+	return []*domain.Announcement{}, 0, nil
+}
+
+func (s *stubAnnouncementService) CreateAnnouncement(ctx context.Context, adminID string, a *domain.Announcement) (*domain.Announcement, error) {
+	if s.err != nil {
+		return nil, fmt.Errorf("stub: %w", s.err)
+	}
+	return a, nil
+}
+
+func (s *stubAnnouncementService) UpdateAnnouncement(ctx context.Context, adminID string, a *domain.Announcement) (*domain.Announcement, error) {
+	if s.err != nil {
+		return nil, fmt.Errorf("stub: %w", s.err)
+	}
+	return a, nil
+}
+
+func (s *stubAnnouncementService) DeleteAnnouncement(ctx context.Context, adminID, id string) error {
+	if s.err != nil {
+		return fmt.Errorf("stub: %w", s.err)
+	}
+	return nil
+}
+
+type stubContactService struct {
+	err error
+}
+
+func (s *stubContactService) ContactOrganizers(ctx context.Context, req *domain.ContactRequest) (*domain.ContactThread, error) {
+	if s.err != nil {
+		return nil, fmt.Errorf("stub: %w", s.err)
+	}
+	return &domain.ContactThread{ID: contractUUID}, nil
+}
+
+func (s *stubContactService) ListContactThreads(ctx context.Context, eventID, userID string, params domain.PaginationParams) ([]*domain.ContactThread, int, error) {
+	if s.err != nil {
+		return nil, 0, fmt.Errorf("stub: %w", s.err)
+	}
+	return []*domain.ContactThread{}, 0, nil
+}
+
+func (s *stubContactService) GetContactThread(ctx context.Context, eventID, userID, threadID string) (*domain.ContactThread, error) {
+	if s.err != nil {
+		return nil, fmt.Errorf("stub: %w", s.err)
+	}
+	return &domain.ContactThread{ID: threadID, EventID: eventID, Messages: []*domain.ContactMessage{}}, nil
+}
+
+func (s *stubContactService) ReplyToContactThread(ctx context.Context, eventID, userID, threadID, body string) (*domain.ContactMessage, error) {
+	if s.err != nil {
+		return nil, fmt.Errorf("stub: %w", s.err)
+	}
+	return &domain.ContactMessage{ThreadID: threadID, Author: domain.ContactAuthorTeam, UserID: userID, Body: body}, nil
+}
+
+type stubAbuseReportService struct {
+	err error
+}
+
+func (s *stubAbuseReportService) ReportAbuse(ctx context.Context, req *domain.AbuseReportRequest) (*domain.AbuseReportOutcome, error) {
+	if s.err != nil {
+		return nil, fmt.Errorf("stub: %w", s.err)
+	}
+	return &domain.AbuseReportOutcome{}, nil
+}
+
+func (s *stubAbuseReportService) ListModerationQueue(ctx context.Context, adminID string, params domain.PaginationParams) ([]*domain.ModerationItem, int, error) {
+	if s.err != nil {
+		return nil, 0, fmt.Errorf("stub: %w", s.err)
+	}
+	return []*domain.ModerationItem{}, 0, nil
+}
+
+func (s *stubAbuseReportService) GetModerationItem(ctx context.Context, adminID string, target domain.AbuseTarget) (*domain.ModerationItem, error) {
+	if s.err != nil {
+		return nil, fmt.Errorf("stub: %w", s.err)
+	}
+	return &domain.ModerationItem{TargetType: target.Type, TargetID: target.ID, Reports: []*domain.AbuseReport{}}, nil
+}
+
+func (s *stubAbuseReportService) ResolveModerationItem(ctx context.Context, adminID string, target domain.AbuseTarget, action string) error {
+	if s.err != nil {
+		return fmt.Errorf("stub: %w", s.err)
+	}
+	return nil
+}
+
+type stubIPAllowlistService struct {
+	err error
+}
+
+func (s *stubIPAllowlistService) CheckAddress(ctx context.Context, userID, ip string) (*domain.IPAllowlist, error) {
+	if s.err != nil {
+		return nil, fmt.Errorf("stub: %w", s.err)
+	}
+	return &domain.IPAllowlist{UserID: userID, Ranges: []string{}}, nil
+}
+
+func (s *stubIPAllowlistService) GetIPAllowlist(ctx context.Context, userID string) (*domain.IPAllowlist, error) {
+	return s.CheckAddress(ctx, userID, "")
+}
+
+func (s *stubIPAllowlistService) SetIPAllowlist(ctx context.Context, userID, clientIP string, ranges []string) (*domain.IPAllowlist, error) {
+	return s.CheckAddress(ctx, userID, clientIP)
+}
+
+func (s *stubIPAllowlistService) GetUserIPAllowlist(ctx context.Context, adminID, userID string) (*domain.IPAllowlist, error) {
+	return s.CheckAddress(ctx, userID, "")
+}
+
+func (s *stubIPAllowlistService) SetUserIPAllowlist(ctx context.Context, adminID, userID string, ranges []string) (*domain.IPAllowlist, error) {
+	return s.CheckAddress(ctx, userID, "")
+}
+
+func (s *stubIPAllowlistService) CheckMachineAddress(ctx context.Context, clientID, ip string) (*domain.IPAllowlist, error) {
+	if s.err != nil {
+		return nil, fmt.Errorf("stub: %w", s.err)
+	}
+	return &domain.IPAllowlist{ClientID: clientID, Ranges: []string{}}, nil
+}
+
+func (s *stubIPAllowlistService) GetMachineClientIPAllowlist(ctx context.Context, adminID, clientID string) (*domain.IPAllowlist, error) {
+	return s.CheckMachineAddress(ctx, clientID, "")
+}
+
+func (s *stubIPAllowlistService) SetMachineClientIPAllowlist(ctx context.Context, adminID, clientID string, ranges []string) (*domain.IPAllowlist, error) {
+	return s.CheckMachineAddress(ctx, clientID, "")
+}
+
+type stubMachineClientService struct {
+	err error
+}
+
+func (s *stubMachineClientService) fail() error {
+	if s.err == nil {
+		return nil
+	}
+	return fmt.Errorf("stub: %w", s.err)
+}
+
+func (s *stubMachineClientService) CreateMachineClient(ctx context.Context, adminID, name, eventID string, scopes []string) (*domain.MachineClientCredentials, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.MachineClientCredentials{MachineClient: domain.MachineClient{ID: contractUUID, Name: name, EventID: eventID, Scopes: scopes}, ClientSecret: "s3cret"}, nil
+}
+
+func (s *stubMachineClientService) ListMachineClients(ctx context.Context, adminID string) ([]*domain.MachineClient, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return []*domain.MachineClient{}, nil
+}
+
+func (s *stubMachineClientService) RevokeMachineClient(ctx context.Context, adminID, clientID string) (*domain.MachineClient, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.MachineClient{ID: clientID}, nil
+}
+
+func (s *stubMachineClientService) IssueMachineToken(ctx context.Context, clientID, secret string) (*domain.MachineToken, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.MachineToken{AccessToken: "token", TokenType: "Bearer", ExpiresIn: 900, Scope: domain.ScopeScheduleRead}, nil
+}
+
+func (s *stubMachineClientService) CreateSandboxEvent(ctx context.Context, clientID, name string) (*domain.Event, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.Event{ID: contractUUID, Name: name, Sandbox: true}, nil
+}
+
+type stubActivityService struct {
+	err error
+}
+
+func (s *stubActivityService) fail() error {
+	if s.err == nil {
+		return nil
+	}
+	return fmt.Errorf("stub: %w", s.err)
+}
+
+func (s *stubActivityService) AuthorizeActAs(ctx context.Context, actorID, userID string) error {
+	return s.fail()
+}
+
+func (s *stubActivityService) RecordActivity(ctx context.Context, a *domain.UserActivity) error {
+	return s.fail()
+}
+
+func (s *stubActivityService) ListMyActivity(ctx context.Context, userID string, limit int) ([]*domain.UserActivity, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return []*domain.UserActivity{}, nil
+}
+
+type stubEventDeletionService struct {
+	err error
+}
+
+func (s *stubEventDeletionService) fail() error {
+	if s.err == nil {
+		return nil
+	}
+	return fmt.Errorf("stub: %w", s.err)
+}
+
+func (s *stubEventDeletionService) RequestEventDeletion(ctx context.Context, eventID, ownerID string, force bool) (*domain.PendingEventDeletion, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.PendingEventDeletion{EventID: eventID, Force: force, ExpiresAt: time.Now().Add(domain.EventDeletionExpiry)}, nil
+}
+
+func (s *stubEventDeletionService) ConfirmEventDeletion(ctx context.Context, eventID, ownerID, token string) error {
+	return s.fail()
+}
+
+func (s *stubEventDeletionService) ForceDeleteEvent(ctx context.Context, eventID, adminID string) error {
+	return s.fail()
+}
+
+type stubExhibitorService struct {
+	err error
+}
+
+func (s *stubExhibitorService) fail() error {
+	if s.err == nil {
+		return nil
+	}
+	return fmt.Errorf("stub: %w", s.err)
+}
+
+func (s *stubExhibitorService) CreateExhibitor(ctx context.Context, eventID, callerID, name, boothNumber string) (*domain.Exhibitor, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.Exhibitor{ID: contractUUID, EventID: eventID, Name: name, BoothNumber: boothNumber, Staff: []*domain.ExhibitorStaff{}}, nil
+}
+
+func (s *stubExhibitorService) ListExhibitors(ctx context.Context, eventID, callerID string) ([]*domain.Exhibitor, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return []*domain.Exhibitor{}, nil
+}
+
+func (s *stubExhibitorService) DeleteExhibitor(ctx context.Context, eventID, exhibitorID, callerID string) error {
+	return s.fail()
+}
+
+func (s *stubExhibitorService) AddExhibitorStaff(ctx context.Context, eventID, exhibitorID, callerID, email string) (*domain.ExhibitorStaff, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.ExhibitorStaff{ExhibitorID: exhibitorID, UserID: contractUUID, Email: email}, nil
+}
+
+func (s *stubExhibitorService) RemoveExhibitorStaff(ctx context.Context, eventID, exhibitorID, callerID, userID string) error {
+	return s.fail()
+}
+
+func (s *stubExhibitorService) GetLeadConsent(ctx context.Context, eventID, userID string) (*domain.LeadConsent, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.LeadConsent{EventID: eventID}, nil
+}
+
+func (s *stubExhibitorService) SetLeadConsent(ctx context.Context, eventID, userID string, consent bool) (*domain.LeadConsent, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.LeadConsent{EventID: eventID, Consent: consent}, nil
+}
+
+func (s *stubExhibitorService) ListMyBooths(ctx context.Context, userID string) ([]*domain.Exhibitor, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return []*domain.Exhibitor{}, nil
+}
+
+func (s *stubExhibitorService) ScanLead(ctx context.Context, exhibitorID, staffID, code, notes string) (*domain.Lead, bool, error) {
+	if err := s.fail(); err != nil {
+		return nil, false, err
+	}
+	return &domain.Lead{ID: contractUUID, ExhibitorID: exhibitorID, ScannedBy: staffID, Notes: notes}, true, nil
+}
+
+func (s *stubExhibitorService) ExportLeads(ctx context.Context, exhibitorID, staffID string) ([]*domain.Lead, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return []*domain.Lead{}, nil
+}
+
+type stubEnrichmentService struct {
+	err error
+}
+
+func (s *stubEnrichmentService) fail() error {
+	if s.err == nil {
+		return nil
+	}
+	return fmt.Errorf("stub: %w", s.err)
+}
+
+func (s *stubEnrichmentService) SuggestSessionEnrichment(ctx context.Context, eventID, sessionID, ownerID string) (*domain.SessionSuggestion, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.SessionSuggestion{SessionID: sessionID, Tags: []*domain.SuggestedTag{}}, nil
+}
+
+func (s *stubEnrichmentService) ApplySessionEnrichment(ctx context.Context, eventID, sessionID, ownerID string, tags []string, summary string) (*domain.Session, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.Session{ID: sessionID, EventID: eventID, Description: summary, Tags: []*domain.Tag{}}, nil
+}
+
+type stubInvitationWarmupService struct {
+	err error
+}
+
+func (s *stubInvitationWarmupService) fail() error {
+	if s.err == nil {
+		return nil
+	}
+	return fmt.Errorf("stub: %w", s.err)
+}
+
+func (s *stubInvitationWarmupService) warmup(eventID, warmupID, status string) *domain.InvitationWarmup {
+	return &domain.InvitationWarmup{ID: warmupID, EventID: eventID, Status: status, DailyVolumes: []int{50}, Schedule: []*domain.WarmupDay{}}
+}
+
+func (s *stubInvitationWarmupService) CreateWarmup(ctx context.Context, eventID, ownerID string, emails []string, days int, dailyVolumes []int, dryRun bool) (*domain.InvitationWarmup, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return s.warmup(eventID, "33333333-3333-3333-3333-333333333333", domain.WarmupStatusActive), nil
+}
+
+func (s *stubInvitationWarmupService) ListWarmups(ctx context.Context, eventID, ownerID string) ([]*domain.InvitationWarmup, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return []*domain.InvitationWarmup{}, nil
+}
+
+func (s *stubInvitationWarmupService) GetWarmup(ctx context.Context, eventID, warmupID, ownerID string) (*domain.InvitationWarmup, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return s.warmup(eventID, warmupID, domain.WarmupStatusActive), nil
+}
+
+func (s *stubInvitationWarmupService) PauseWarmup(ctx context.Context, eventID, warmupID, ownerID string) (*domain.InvitationWarmup, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return s.warmup(eventID, warmupID, domain.WarmupStatusPaused), nil
+}
+
+func (s *stubInvitationWarmupService) ResumeWarmup(ctx context.Context, eventID, warmupID, ownerID string) (*domain.InvitationWarmup, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return s.warmup(eventID, warmupID, domain.WarmupStatusActive), nil
+}
+
+func (s *stubInvitationWarmupService) CheckEmailDomain(ctx context.Context, eventID, ownerID string, refresh bool) (*domain.EmailDomainReport, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &domain.EmailDomainReport{Domain: "example.com", OK: true, Records: []*domain.EmailDomainRecord{}}, nil
+}
+
+func (s *stubInvitationWarmupService) SendDue(ctx context.Context) (*domain.WarmupRun, error) {
+	return &domain.WarmupRun{}, s.fail()
+}
+
+type stubRoomOccupancyService struct {
+	err error
+}
+
+func (s *stubRoomOccupancyService) UpdateOccupancy(ctx context.Context, client *domain.MachinePrincipal, eventID, roomID string, delta int, count *int) (*domain.RoomOccupancy, error) {
+	if s.err != nil {
+		return nil, fmt.Errorf("stub: %w", s.err)
+	}
+	return &domain.RoomOccupancy{RoomID: roomID, Count: delta}, nil
+}
+
+type stubEventDocumentService struct {
+	err error
+}
+
+func (s *stubEventDocumentService) fail() error {
+	if s.err == nil {
+		return nil
+	}
+	return fmt.Errorf("stub: %w", s.err)
+}
+
+func (s *stubEventDocumentService) PublishDocument(ctx context.Context, eventID, ownerID, kind, title, body string) (*domain.EventDocument, bool, error) {
+	if err := s.fail(); err != nil {
+		return nil, false, err
+	}
+	return &domain.EventDocument{EventID: eventID, Kind: kind, Version: 1, Title: title, Body: body}, true, nil
+}
+
+func (s *stubEventDocumentService) ListDocuments(ctx context.Context, eventID, ownerID string) ([]*domain.EventDocument, error) {
+	return []*domain.EventDocument{}, s.fail()
+}
+
+func (s *stubEventDocumentService) ListDocumentVersions(ctx context.Context, eventID, ownerID, kind string) ([]*domain.EventDocument, error) {
+	return []*domain.EventDocument{{EventID: eventID, Kind: kind, Version: 1}}, s.fail()
+}
+
+func (s *stubEventDocumentService) WithdrawDocument(ctx context.Context, eventID, ownerID, kind string) error {
+	return s.fail()
+}
+
+func (s *stubEventDocumentService) GetAcceptanceReport(ctx context.Context, eventID, ownerID, kind string) (*domain.DocumentAcceptanceReport, error) {
+	return &domain.DocumentAcceptanceReport{EventID: eventID, Kind: kind, Version: 1, Attendees: []*domain.AttendeeAcceptance{}}, s.fail()
+}
+
+func (s *stubEventDocumentService) ListMyDocuments(ctx context.Context, eventID, userID string) ([]*domain.AttendeeDocument, error) {
+	return []*domain.AttendeeDocument{}, s.fail()
+}
+
+func (s *stubEventDocumentService) AcceptDocuments(ctx context.Context, eventID, userID string, accepted []domain.DocumentVersion) ([]*domain.AttendeeDocument, error) {
+	return []*domain.AttendeeDocument{}, s.fail()
+}
+
+type stubSpeakerPhotoService struct {
+	err error
+}
+
+func (s *stubSpeakerPhotoService) fail() error {
+	if s.err == nil {
+		return nil
+	}
+	return fmt.Errorf("stub: %w", s.err)
+}
+
+func (s *stubSpeakerPhotoService) ExportSpeakerPhotos(ctx context.Context, eventID, ownerID string, w io.Writer) (*domain.SpeakerPhotoArchive, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	_, err := w.Write([]byte("PK\x05\x06"))
+	return nil, err
+}
+
+func (s *stubSpeakerPhotoService) GetSpeakerPhotoArchive(ctx context.Context, eventID, archiveID, ownerID string) (*domain.SpeakerPhotoArchive, error) {
+	return &domain.SpeakerPhotoArchive{ID: archiveID, EventID: eventID, Status: domain.PhotoArchivePending, Missing: []string{}}, s.fail()
+}
+
+func (s *stubSpeakerPhotoService) DownloadSpeakerPhotoArchive(ctx context.Context, eventID, archiveID, ownerID string) ([]byte, error) {
+	return []byte("PK\x05\x06"), s.fail()
+}
+
+func (s *stubSpeakerPhotoService) BuildPendingArchives(ctx context.Context) (int, error) {
+	return 0, s.fail()
+}
+
+type stubSessionStreamService struct {
+	err error
+}
+
+func (s *stubSessionStreamService) fail() error {
+	if s.err == nil {
+		return nil
+	}
+	return fmt.Errorf("stub: %w", s.err)
+}
+
+func (s *stubSessionStreamService) ListSessionStreams(ctx context.Context, eventID, sessionID, ownerID string) ([]*domain.SessionStreamLink, error) {
+	return []*domain.SessionStreamLink{}, s.fail()
+}
+
+func (s *stubSessionStreamService) SetSessionStreams(ctx context.Context, eventID, sessionID, ownerID string, links []*domain.SessionStreamLink) ([]*domain.SessionStreamLink, error) {
+	return links, s.fail()
+}
@@ -0,0 +1,164 @@
+package doctor
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+
+	"multitrackticketing/config"
+	"multitrackticketing/internal/adapters/email"
+	"multitrackticketing/internal/adapters/sentry"
+	"multitrackticketing/internal/domain"
+)
+
+// ConfigCheck checks the loaded configuration for settings the server refuses or would run badly
+// with: it fails on what stops startup in production and warns on what only looks unintended.
+func ConfigCheck(cfg *config.Config) Check {
+	return Check{Name: "config", Run: func(ctx context.Context) (string, error) {
+		production := cfg.Environment == "production"
+		var problems, warnings []string
+		if cfg.JWTSecret == "" {
+			if production {
+				problems = append(problems, "JWT_SECRET is required in production")
+			} else {
+				warnings = append(warnings, "JWT_SECRET is unset; tokens are signed with the development secret")
+			}
+		}
+		if cfg.AnonymizationKey == "" && production {
+			problems = append(problems, "ANONYMIZATION_KEY is required in production")
+		}
+		switch cfg.Email.Provider {
+		case "ses":
+			if cfg.Email.FromAddress == "" {
+				problems = append(problems, "EMAIL_FROM_ADDRESS is required with EMAIL_PROVIDER=ses")
+			}
+			if cfg.Email.SES.Region == "" || cfg.Email.SES.AccessKeyID == "" || cfg.Email.SES.SecretAccessKey == "" {
+				problems = append(problems, "AWS_SES_REGION, AWS_SES_ACCESS_KEY_ID and AWS_SES_SECRET_ACCESS_KEY are required with EMAIL_PROVIDER=ses")
+			}
+			if cfg.Email.SES.InsecureSkipVerify && production {
+				warnings = append(warnings, "AWS_SES_INSECURE_SKIP_VERIFY is on in production")
+			}
+		case "sandbox":
+			if production {
+				problems = append(problems, "EMAIL_PROVIDER=sandbox is not allowed in production")
+			}
+		case "noop":
+			if production {
+				warnings = append(warnings, "EMAIL_PROVIDER=noop in production: no email is sent")
+			}
+		default:
+			warnings = append(warnings, fmt.Sprintf("unknown EMAIL_PROVIDER %q; no email is sent", cfg.Email.Provider))
+		}
+		if cfg.SentryDSN != "" {
+			if _, err := sentry.NewReporter(cfg.SentryDSN, cfg.Environment, nil); err != nil {
+				problems = append(problems, "SENTRY_DSN: "+err.Error())
+			}
+		}
+		if _, err := config.ParseTrustedProxies(cfg.TrustedProxies); err != nil {
+			problems = append(problems, err.Error())
+		}
+		if cfg.FaultInjection && production {
+			problems = append(problems, "FAULT_INJECTION is not allowed in production")
+		}
+		if cfg.CaptchaVerifyURL != "" && cfg.CaptchaSecret == "" {
+			warnings = append(warnings, "CAPTCHA_VERIFY_URL is set without CAPTCHA_SECRET")
+		}
+		if len(problems) > 0 {
+			return "", errors.New(strings.Join(append(problems, warnings...), "; "))
+		}
+		if len(warnings) > 0 {
+			return "", Warning("%s", strings.Join(warnings, "; "))
+		}
+		return fmt.Sprintf("environment %s, email provider %s, port %s", cfg.Environment, cfg.Email.Provider, cfg.Port), nil
+	}}
+}
+
+// DatabaseCheck checks that the database answers.
+func DatabaseCheck(db *sql.DB) Check {
+	return Check{Name: "database", Run: func(ctx context.Context) (string, error) {
+		if err := db.PingContext(ctx); err != nil {
+			return "", fmt.Errorf("ping: %w", err)
+		}
+		var version string
+		if err := db.QueryRowContext(ctx, `SHOW server_version`).Scan(&version); err != nil {
+			return "", fmt.Errorf("read server version: %w", err)
+		}
+		return "connected to PostgreSQL " + version, nil
+	}}
+}
+
+// MigrationsCheck checks that the database is at the newest migration in migrations (files named
+// like 000001_core_schema.up.sql) and that the last run finished, as recorded by migrate.
+func MigrationsCheck(schema domain.SchemaRepository, migrations fs.FS) Check {
+	return Check{Name: "migrations", Run: func(ctx context.Context) (string, error) {
+		latest, err := latestMigration(migrations)
+		if err != nil {
+			return "", err
+		}
+		s, err := schema.SchemaStatus(ctx)
+		if err != nil {
+			return "", err
+		}
+		switch {
+		case s.Version == 0:
+			return "", fmt.Errorf("no migration has run; the newest is %d (run make migrate-up)", latest)
+		case s.Dirty:
+			return "", fmt.Errorf("migration %d failed part way (dirty); fix the schema, then force the version with migrate", s.Version)
+		case s.Version < latest:
+			return "", fmt.Errorf("database is at %d, the newest migration is %d (run make migrate-up)", s.Version, latest)
+		case s.Version > latest:
+			return "", Warning("database is at %d, newer than this build's newest migration %d; the server will refuse writes", s.Version, latest)
+		}
+		return fmt.Sprintf("at %d, the newest migration", s.Version), nil
+	}}
+}
+
+// latestMigration returns the highest version among the .up.sql files in migrations.
+func latestMigration(migrations fs.FS) (int64, error) {
+	entries, err := fs.ReadDir(migrations, ".")
+	if err != nil {
+		return 0, fmt.Errorf("read migrations: %w", err)
+	}
+	var latest int64
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".up.sql") {
+			continue
+		}
+		prefix, _, _ := strings.Cut(name, "_")
+		v, err := strconv.ParseInt(prefix, 10, 64)
+		if err != nil {
+			continue
+		}
+		latest = max(latest, v)
+	}
+	if latest == 0 {
+		return 0, errors.New("no migrations found")
+	}
+	return latest, nil
+}
+
+// EmailCheck checks that the email provider accepts its credentials, without sending anything.
+func EmailCheck(cfg email.MailerConfig) Check {
+	return Check{Name: "email", Run: func(ctx context.Context) (string, error) {
+		if cfg.Provider != "ses" {
+			return "", Skipped("EMAIL_PROVIDER=%s needs no credentials", cfg.Provider)
+		}
+		if err := email.VerifyMailer(ctx, cfg); err != nil {
+			return "", err
+		}
+		return "SES in " + cfg.SES.Region + " accepts the credentials and sending is enabled", nil
+	}}
+}
+
+// BlobStorageCheck reports on file storage. The API keeps no files of its own: speaker photos are
+// downloaded from their source URLs when a thumbnail is needed.
+func BlobStorageCheck() Check {
+	return Check{Name: "blob storage", Run: func(ctx context.Context) (string, error) {
+		return "", Skipped("the API stores no files; pictures are fetched from their source URLs")
+	}}
+}
@@ -0,0 +1,110 @@
+// Package doctor checks that a deployment can run: its configuration, database, migrations and
+// email provider. Each check reports pass, warn, fail or skip; only failures make the run fail.
+package doctor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Status is the outcome of one check.
+type Status string
+
+const (
+	StatusPass Status = "PASS"
+	// StatusWarn is a working setup that is probably not what production wants.
+	StatusWarn Status = "WARN"
+	StatusFail Status = "FAIL"
+	// StatusSkip is a check that does not apply to this deployment.
+	StatusSkip Status = "SKIP"
+)
+
+// Check is one named check. Run returns what it found; a non-nil error fails the check unless it is
+// a Warning or a Skipped.
+type Check struct {
+	Name string
+	Run  func(ctx context.Context) (detail string, err error)
+}
+
+// Result is the outcome of one check.
+type Result struct {
+	Name   string
+	Status Status
+	Detail string
+}
+
+type warning struct{ msg string }
+
+func (w warning) Error() string { return w.msg }
+
+type skipped struct{ msg string }
+
+func (s skipped) Error() string { return s.msg }
+
+// Warning returns an error that makes a check warn instead of fail.
+func Warning(format string, args ...any) error {
+	return warning{msg: fmt.Sprintf(format, args...)}
+}
+
+// Skipped returns an error that marks a check as not applicable.
+func Skipped(format string, args ...any) error {
+	return skipped{msg: fmt.Sprintf(format, args...)}
+}
+
+// Run runs checks in order, each with at most timeout.
+func Run(ctx context.Context, checks []Check, timeout time.Duration) []Result {
+	results := make([]Result, 0, len(checks))
+	for _, c := range checks {
+		checkCtx, cancel := context.WithTimeout(ctx, timeout)
+		detail, err := c.Run(checkCtx)
+		cancel()
+		res := Result{Name: c.Name, Status: StatusPass, Detail: detail}
+		var w warning
+		var s skipped
+		switch {
+		case err == nil:
+		case errors.As(err, &w):
+			res.Status, res.Detail = StatusWarn, w.msg
+		case errors.As(err, &s):
+			res.Status, res.Detail = StatusSkip, s.msg
+		default:
+			res.Status, res.Detail = StatusFail, err.Error()
+		}
+		results = append(results, res)
+	}
+	return results
+}
+
+// Failed reports whether any result failed.
+func Failed(results []Result) bool {
+	for _, r := range results {
+		if r.Status == StatusFail {
+			return true
+		}
+	}
+	return false
+}
+
+// Print writes one line per result and a summary line.
+func Print(w io.Writer, results []Result) {
+	width := 0
+	for _, r := range results {
+		width = max(width, len(r.Name))
+	}
+	counts := make(map[Status]int)
+	for _, r := range results {
+		counts[r.Status]++
+		fmt.Fprintf(w, "%-4s  %-*s  %s\n", r.Status, width, r.Name, r.Detail)
+	}
+	var summary []string
+	for _, s := range []Status{StatusPass, StatusWarn, StatusFail, StatusSkip} {
+		if counts[s] > 0 {
+			summary = append(summary, fmt.Sprintf("%d %s", counts[s], strings.ToLower(string(s))))
+		}
+	}
+	fmt.Fprintln(w, strings.Join(summary, ", "))
+}
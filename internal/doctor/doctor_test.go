@@ -0,0 +1,188 @@
+package doctor
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"multitrackticketing/config"
+	"multitrackticketing/internal/repository/postgres"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	results := Run(context.Background(), []Check{
+		{Name: "ok", Run: func(ctx context.Context) (string, error) { return "fine", nil }},
+		{Name: "warn", Run: func(ctx context.Context) (string, error) { return "", Warning("odd %d", 1) }},
+		{Name: "fail", Run: func(ctx context.Context) (string, error) { return "", errors.New("broken") }},
+		{Name: "skip", Run: func(ctx context.Context) (string, error) { return "", Skipped("n/a") }},
+		{Name: "deadline", Run: func(ctx context.Context) (string, error) {
+			<-ctx.Done()
+			return "", ctx.Err()
+		}},
+	}, 10*time.Millisecond)
+
+	require.Len(t, results, 5)
+	assert.Equal(t, Result{Name: "ok", Status: StatusPass, Detail: "fine"}, results[0])
+	assert.Equal(t, Result{Name: "warn", Status: StatusWarn, Detail: "odd 1"}, results[1])
+	assert.Equal(t, Result{Name: "fail", Status: StatusFail, Detail: "broken"}, results[2])
+	assert.Equal(t, Result{Name: "skip", Status: StatusSkip, Detail: "n/a"}, results[3])
+	assert.Equal(t, StatusFail, results[4].Status)
+	assert.True(t, Failed(results))
+	assert.False(t, Failed(results[:2]))
+
+	var out bytes.Buffer
+	Print(&out, results)
+	assert.Contains(t, out.String(), "FAIL  fail      broken\n")
+	assert.Contains(t, out.String(), "1 pass, 1 warn, 2 fail, 1 skip\n")
+}
+
+func TestConfigCheck(t *testing.T) {
+	tests := []struct {
+		name       string
+		cfg        config.Config
+		wantStatus Status
+		wantDetail string
+	}{
+		{
+			name:       "development defaults",
+			cfg:        config.Config{Environment: "development", JWTSecret: "s", Port: "8080", Email: config.EmailConfig{Provider: "noop"}},
+			wantStatus: StatusPass,
+		},
+		{
+			name:       "missing secret in development",
+			cfg:        config.Config{Environment: "development", Email: config.EmailConfig{Provider: "noop"}},
+			wantStatus: StatusWarn,
+			wantDetail: "JWT_SECRET",
+		},
+		{
+			name:       "missing secret in production",
+			cfg:        config.Config{Environment: "production", Email: config.EmailConfig{Provider: "noop"}},
+			wantStatus: StatusFail,
+			wantDetail: "JWT_SECRET is required in production",
+		},
+		{
+			name:       "missing anonymization key in production",
+			cfg:        config.Config{Environment: "production", JWTSecret: "s", Email: config.EmailConfig{Provider: "noop"}},
+			wantStatus: StatusFail,
+			wantDetail: "ANONYMIZATION_KEY is required in production",
+		},
+		{
+			name:       "sandbox in production",
+			cfg:        config.Config{Environment: "production", JWTSecret: "s", Email: config.EmailConfig{Provider: "sandbox"}},
+			wantStatus: StatusFail,
+			wantDetail: "sandbox",
+		},
+		{
+			name:       "fault injection in production",
+			cfg:        config.Config{Environment: "production", JWTSecret: "s", Email: config.EmailConfig{Provider: "ses", FromAddress: "a@b.c", SES: config.SESConfig{Region: "r", AccessKeyID: "k", SecretAccessKey: "s"}}, FaultInjection: true},
+			wantStatus: StatusFail,
+			wantDetail: "FAULT_INJECTION",
+		},
+		{
+			name:       "ses without credentials",
+			cfg:        config.Config{Environment: "production", JWTSecret: "s", Email: config.EmailConfig{Provider: "ses", FromAddress: "a@b.c"}},
+			wantStatus: StatusFail,
+			wantDetail: "AWS_SES_REGION",
+		},
+		{
+			name:       "bad trusted proxy",
+			cfg:        config.Config{JWTSecret: "s", TrustedProxies: []string{"10.0.0.0/8", "lb"}, Email: config.EmailConfig{Provider: "noop"}},
+			wantStatus: StatusFail,
+			wantDetail: "TRUSTED_PROXIES",
+		},
+		{
+			name:       "bad sentry dsn",
+			cfg:        config.Config{JWTSecret: "s", SentryDSN: "not a dsn", Email: config.EmailConfig{Provider: "noop"}},
+			wantStatus: StatusFail,
+			wantDetail: "SENTRY_DSN",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := Run(context.Background(), []Check{ConfigCheck(&tt.cfg)}, time.Second)[0]
+			assert.Equal(t, tt.wantStatus, res.Status, res.Detail)
+			assert.Contains(t, res.Detail, tt.wantDetail)
+		})
+	}
+}
+
+func TestMigrationsCheck(t *testing.T) {
+	migrations := fstest.MapFS{
+		"000001_core_schema.up.sql":   {},
+		"000001_core_schema.down.sql": {},
+		"000002_more.up.sql":          {},
+		"000002_more.down.sql":        {},
+		"README.md":                   {},
+	}
+	tests := []struct {
+		name       string
+		setup      func(mock sqlmock.Sqlmock)
+		wantStatus Status
+		wantDetail string
+	}{
+		{
+			name: "up to date",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT version, dirty FROM schema_migrations`).WillReturnRows(sqlmock.NewRows([]string{"version", "dirty"}).AddRow(2, false))
+			},
+			wantStatus: StatusPass,
+			wantDetail: "at 2",
+		},
+		{
+			name: "behind",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT version, dirty FROM schema_migrations`).WillReturnRows(sqlmock.NewRows([]string{"version", "dirty"}).AddRow(1, false))
+			},
+			wantStatus: StatusFail,
+			wantDetail: "database is at 1, the newest migration is 2",
+		},
+		{
+			name: "dirty",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT version, dirty FROM schema_migrations`).WillReturnRows(sqlmock.NewRows([]string{"version", "dirty"}).AddRow(2, true))
+			},
+			wantStatus: StatusFail,
+			wantDetail: "dirty",
+		},
+		{
+			name: "ahead",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT version, dirty FROM schema_migrations`).WillReturnRows(sqlmock.NewRows([]string{"version", "dirty"}).AddRow(3, false))
+			},
+			wantStatus: StatusWarn,
+		},
+		{
+			name: "never migrated",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT version, dirty FROM schema_migrations`).WillReturnError(sql.ErrNoRows)
+			},
+			wantStatus: StatusFail,
+			wantDetail: "no migration has run",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+			tt.setup(mock)
+
+			res := Run(context.Background(), []Check{MigrationsCheck(postgres.NewSchemaRepository(db), migrations)}, time.Second)[0]
+			assert.Equal(t, tt.wantStatus, res.Status, res.Detail)
+			assert.Contains(t, res.Detail, tt.wantDetail)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+
+	res := Run(context.Background(), []Check{MigrationsCheck(nil, fstest.MapFS{})}, time.Second)[0]
+	assert.Equal(t, StatusFail, res.Status)
+	assert.Equal(t, "no migrations found", res.Detail)
+}
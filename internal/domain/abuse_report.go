@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Abuse report targets: the public content a report can flag.
+const (
+	AbuseTargetEvent   = "event"
+	AbuseTargetSession = "session"
+	AbuseTargetSpeaker = "speaker"
+)
+
+// Abuse report reasons.
+const (
+	AbuseReasonSpam          = "spam"
+	AbuseReasonOffensive     = "offensive"
+	AbuseReasonImpersonation = "impersonation"
+	AbuseReasonIllegal       = "illegal"
+	AbuseReasonOther         = "other"
+)
+
+// Abuse report statuses. Reports stay open until an admin resolves their target.
+const (
+	AbuseReportOpen      = "open"
+	AbuseReportDismissed = "dismissed"
+	AbuseReportActioned  = "actioned"
+)
+
+// Moderation actions an admin takes on a reported target.
+const (
+	// ModerationDismiss dismisses the open reports and lists the target again.
+	ModerationDismiss = "dismiss"
+	// ModerationUnlist keeps the target unlisted until an admin relists it.
+	ModerationUnlist = "unlist"
+)
+
+// MaxAbuseReportDetailsLength is the longest details text a report may carry, in characters.
+const MaxAbuseReportDetailsLength = 2000
+
+// AbuseTarget identifies a piece of public content.
+type AbuseTarget struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// AbuseReport is one report filed against a target. Reporters are anonymous; only a hash of their
+// address is kept so each counts once.
+// swagger:model AbuseReport
+type AbuseReport struct {
+	ID           string     `json:"id"`
+	EventID      string     `json:"event_id"`
+	TargetType   string     `json:"target_type"`
+	TargetID     string     `json:"target_id"`
+	Reason       string     `json:"reason"`
+	Details      string     `json:"details"`
+	ReporterHash string     `json:"-"`
+	Status       string     `json:"status"`
+	ResolvedBy   string     `json:"resolved_by,omitempty"`
+	ResolvedAt   *time.Time `json:"resolved_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// AbuseReportRequest is a report as filed through the public endpoint.
+type AbuseReportRequest struct {
+	EventCode  string
+	TargetType string
+	TargetID   string
+	Reason     string
+	Details    string
+	RemoteIP   string
+}
+
+// AbuseReportOutcome is what filing a report did to its target.
+type AbuseReportOutcome struct {
+	Target AbuseTarget
+	// Unlisted is set when the report brought the target to the moderation threshold and
+	// unlisted it; Reporters is then the number of distinct reporters.
+	Unlisted  bool
+	Reporters int
+}
+
+// ModerationPolicy controls automatic unlisting: once Threshold distinct reporters have open
+// reports on a target, it is unlisted for UnlistFor. A Threshold of 0 never unlists automatically.
+type ModerationPolicy struct {
+	Threshold int
+	UnlistFor time.Duration
+}
+
+// ModerationItem is a reported target in the moderation queue.
+// swagger:model ModerationItem
+type ModerationItem struct {
+	TargetType string `json:"target_type"`
+	TargetID   string `json:"target_id"`
+	EventID    string `json:"event_id"`
+	EventName  string `json:"event_name"`
+	// Title is the session title or speaker name; the event name for event targets. It is empty
+	// when the target has since been deleted.
+	Title           string    `json:"title"`
+	OpenReports     int       `json:"open_reports"`
+	FirstReportedAt time.Time `json:"first_reported_at"`
+	LastReportedAt  time.Time `json:"last_reported_at"`
+	// Unlisted reports whether the target is hidden from public pages now; UnlistedUntil is when a
+	// temporary unlisting ends.
+	Unlisted      bool           `json:"unlisted"`
+	UnlistedUntil *time.Time     `json:"unlisted_until,omitempty"`
+	Reports       []*AbuseReport `json:"reports,omitempty"`
+}
+
+// AbuseReportRepository stores abuse reports and unlisted content.
+type AbuseReportRepository interface {
+	// Create stores an open report. It returns false, storing nothing, when the same reporter
+	// already has an open report on the target.
+	Create(ctx context.Context, r *AbuseReport) (bool, error)
+	// CountOpenReporters returns how many distinct reporters have open reports on the target.
+	CountOpenReporters(ctx context.Context, target AbuseTarget) (int, error)
+	// Unlist hides the target until the given time. An existing unlisting is only extended, never
+	// shortened, and one without an end is left as is. It returns whether anything changed.
+	Unlist(ctx context.Context, target AbuseTarget, eventID string, until time.Time) (bool, error)
+	// ListUnlisted returns the event's targets unlisted at now.
+	ListUnlisted(ctx context.Context, eventID string, now time.Time) ([]AbuseTarget, error)
+	// ListQueue returns a page of the targets with open reports, most reported first.
+	ListQueue(ctx context.Context, now time.Time, params PaginationParams) ([]*ModerationItem, int, error)
+	// GetQueueItem returns the target with its open reports, oldest first. Returns ErrNotFound
+	// when it has no open reports.
+	GetQueueItem(ctx context.Context, target AbuseTarget, now time.Time) (*ModerationItem, error)
+	// Resolve marks the target's open reports with status and, in the same transaction, lists the
+	// target again (AbuseReportDismissed) or unlists it with no end (AbuseReportActioned). Returns
+	// ErrNotFound when the target has no open reports.
+	Resolve(ctx context.Context, target AbuseTarget, status, resolvedBy string, at time.Time) error
+}
+
+// AbuseReportService takes abuse reports from the public and lets platform admins moderate them.
+// The admin methods return ErrForbidden unless adminID has AdminRole.
+type AbuseReportService interface {
+	// ReportAbuse files a report. Reporting the same target twice from one address is accepted but
+	// counts once. Returns ErrNotFound when the event code is unknown or the target is not the event's.
+	ReportAbuse(ctx context.Context, req *AbuseReportRequest) (*AbuseReportOutcome, error)
+	ListModerationQueue(ctx context.Context, adminID string, params PaginationParams) ([]*ModerationItem, int, error)
+	GetModerationItem(ctx context.Context, adminID string, target AbuseTarget) (*ModerationItem, error)
+	// ResolveModerationItem applies ModerationDismiss or ModerationUnlist to the target.
+	ResolveModerationItem(ctx context.Context, adminID string, target AbuseTarget, action string) error
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// MaxUserActivity is the most activity entries returned by one feed request.
+const MaxUserActivity = 200
+
+// UserActivity is a request an admin made on a user's behalf with the X-Act-As header. Users see
+// these in their activity feed, so support access to an account is never silent.
+// swagger:model UserActivity
+type UserActivity struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+	// ActorID is the admin who acted as the user; empty once that admin's account is deleted.
+	ActorID   string `json:"actor_id,omitempty"`
+	ActorName string `json:"actor_name,omitempty"`
+	Method    string `json:"method"`
+	Path      string `json:"path"`
+	// Status is the HTTP status the request got.
+	Status    int       `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// UserActivityRepository stores the requests admins made acting as users.
+type UserActivityRepository interface {
+	Create(ctx context.Context, a *UserActivity) error
+	// ListByUser returns up to limit entries for the user, newest first, with the actor's name.
+	ListByUser(ctx context.Context, userID string, limit int) ([]*UserActivity, error)
+}
+
+// ActivityService lets admins act as other users and keeps the trail of what they did.
+type ActivityService interface {
+	// AuthorizeActAs returns ErrForbidden unless actorID has AdminRole, ErrUserNotFound when userID
+	// does not exist, and ErrInvalidInput when both are the same user.
+	AuthorizeActAs(ctx context.Context, actorID, userID string) error
+	// RecordActivity stores a request made acting as a.UserID.
+	RecordActivity(ctx context.Context, a *UserActivity) error
+	// ListMyActivity returns ErrInvalidInput unless 1 <= limit <= MaxUserActivity.
+	ListMyActivity(ctx context.Context, userID string, limit int) ([]*UserActivity, error)
+}
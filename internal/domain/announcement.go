@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// AdminRole is the role code of platform administrators (see RoleRepository).
+const AdminRole = "admin"
+
+// Announcement categories.
+const (
+	AnnouncementFeature     = "feature"
+	AnnouncementImprovement = "improvement"
+	AnnouncementFix         = "fix"
+)
+
+// MaxAnnouncementsMarkedRead is the most announcement IDs one mark-read call may list.
+const MaxAnnouncementsMarkedRead = 100
+
+// Announcement is a piece of product news for the apps' "what's new" panel. It is platform-wide:
+// every user sees the same published announcements.
+// swagger:model Announcement
+type Announcement struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	// Body is Markdown.
+	Body string `json:"body"`
+	// Category is one of feature, improvement and fix.
+	Category string `json:"category"`
+	// PublishedAt is when the announcement shows in the changelog. Nil keeps it a draft and a future
+	// time schedules it.
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	// Read reports whether the caller has read it; it is only set in the changelog.
+	Read bool `json:"read"`
+}
+
+// AnnouncementRepository stores announcements and which users have read them.
+type AnnouncementRepository interface {
+	// ListPublished returns a page of the announcements published by now, newest first, with Read
+	// set for userID, plus the total published and how many of them userID has not read.
+	ListPublished(ctx context.Context, userID string, now time.Time, params PaginationParams) (items []*Announcement, total, unread int, err error)
+	// ListAll returns a page of every announcement, drafts and scheduled ones first, then newest first.
+	ListAll(ctx context.Context, params PaginationParams) ([]*Announcement, int, error)
+	Create(ctx context.Context, a *Announcement, createdBy string) error
+	// Update replaces the announcement's title, body, category and publish time. Returns ErrNotFound.
+	Update(ctx context.Context, a *Announcement) error
+	// Delete returns ErrNotFound when there is no such announcement.
+	Delete(ctx context.Context, id string) error
+	// MarkRead marks the listed announcements read for userID, or every announcement published by
+	// now when ids is empty. IDs of unpublished or unknown announcements are ignored.
+	MarkRead(ctx context.Context, userID string, ids []string, now time.Time) error
+}
+
+// AnnouncementService serves the changelog to users and lets admins manage it. The admin methods
+// return ErrForbidden unless adminID has AdminRole.
+type AnnouncementService interface {
+	ListChangelog(ctx context.Context, userID string, params PaginationParams) (items []*Announcement, total, unread int, err error)
+	MarkAnnouncementsRead(ctx context.Context, userID string, ids []string) error
+	ListAnnouncements(ctx context.Context, adminID string, params PaginationParams) ([]*Announcement, int, error)
+	CreateAnnouncement(ctx context.Context, adminID string, a *Announcement) (*Announcement, error)
+	UpdateAnnouncement(ctx context.Context, adminID string, a *Announcement) (*Announcement, error)
+	DeleteAnnouncement(ctx context.Context, adminID, id string) error
+}
@@ -30,6 +30,9 @@ type EventRegistrationRepository interface {
 	Create(ctx context.Context, reg *EventRegistration) error
 	GetByEventAndUser(ctx context.Context, eventID, userID string) (*EventRegistration, error)
 	ListByUserID(ctx context.Context, userID string) ([]*EventRegistration, error)
+	// CountActiveByEvent returns the registration count of every event that is undated or dated
+	// today or later, largest first. Sandbox events are left out.
+	CountActiveByEvent(ctx context.Context) ([]EventCount, error)
 }
 
 // EventRegistrationWithEvent bundles a registration with its related event.
@@ -42,22 +45,94 @@ type EventRegistrationWithEvent struct {
 type RoomWithSessions struct {
 	Room     *Room     `json:"room"`
 	Sessions []*Session `json:"sessions"`
+	// Occupancy is how many people are in the room now, for signage; absent until a device of the
+	// event reports it.
+	Occupancy *RoomOccupancy `json:"occupancy,omitempty"`
 }
 
 // EventSchedule is the hierarchical schedule for an event: event plus bookable rooms each with nested sessions.
 type EventSchedule struct {
-	Event *Event             `json:"event"`
+	Event *Event              `json:"event"`
 	Rooms []*RoomWithSessions `json:"rooms"`
+	// Tracks are the tracks of the sessions, in the order the owner set.
+	Tracks []string `json:"tracks"`
+}
+
+// EventJoin is the outcome of joining an event by its code: the attendee's registration and the
+// schedule the app shows next.
+// swagger:model EventJoin
+type EventJoin struct {
+	Registration *EventRegistration `json:"registration"`
+	// Joined is set when this call registered the user; false when they already were.
+	Joined   bool           `json:"joined"`
+	Schedule *EventSchedule `json:"schedule"`
+	// PendingDocuments are the event's documents changed since the attendee accepted them, to
+	// prompt for accepting again; always empty when Joined.
+	PendingDocuments []*EventDocument `json:"pending_documents"`
+}
+
+// PublicSchedule is what anyone may see of an event's schedule, with the theme to show it in.
+type PublicSchedule struct {
+	// EventID is the event, used to tag responses for CDN purges.
+	EventID  string
+	Schedule *OfflineSchedule
+	Theme    *EventTheme
 }
 
 // AttendeeService defines attendee-facing operations such as event registration.
 type AttendeeService interface {
 	// RegisterForEvent registers the user for the event. Returns (reg, created, err): created is true if a new registration was created, false if already registered.
+	// A new registration needs the current version of each of the event's documents accepted
+	// beforehand; ErrDocumentsNotAccepted otherwise. An event unlisted after abuse reports takes no
+	// new registrations: ErrNotFound, as for an unknown event.
 	RegisterForEvent(ctx context.Context, eventID, userID string) (*EventRegistration, bool, error)
 	// RegisterForEventByCode registers the user for the event identified by event_code. Returns (reg, created, err): created is true if a new registration was created, false if already registered.
-	RegisterForEventByCode(ctx context.Context, eventCode, userID string) (*EventRegistration, bool, error)
+	// accepted are the versions of the event's documents the user accepts with it; a new
+	// registration returns ErrDocumentsNotAccepted while a current one is not accepted, and
+	// ErrNotFound when the event is unlisted, as RegisterForEvent does.
+	RegisterForEventByCode(ctx context.Context, eventCode, userID string, accepted []DocumentVersion) (*EventRegistration, bool, error)
+	// JoinEventByCode registers the user for the event with the given event_code, as
+	// RegisterForEventByCode does, and returns the schedule with it. Returns ErrNotFound if no event
+	// has the code, or if the event is unlisted and the user is not registered yet.
+	JoinEventByCode(ctx context.Context, eventCode, userID string, accepted []DocumentVersion) (*EventJoin, error)
 	ListMyRegisteredEvents(ctx context.Context, userID string) ([]*EventRegistrationWithEvent, error)
 	// GetEventSchedule returns the event schedule (event + bookable rooms with nested sessions) for a registered attendee or event owner. Returns ErrForbidden if caller is not registered and not owner, ErrNotFound if event does not exist.
 	GetEventSchedule(ctx context.Context, eventID, userID string) (*EventSchedule, error)
+	// GetEventScheduleForClient returns the same schedule to a machine client. The caller checks the
+	// client's scopes; ErrForbidden if the client is bound to another event, ErrNotFound if the event
+	// does not exist.
+	GetEventScheduleForClient(ctx context.Context, eventID string, client *MachinePrincipal) (*EventSchedule, error)
+	// ListScheduleChanges returns the room and time moves of the event's sessions made after since,
+	// newest first, at most limit (1 to MaxScheduleChanges) of them. Same access as GetEventSchedule.
+	ListScheduleChanges(ctx context.Context, eventID, userID string, since time.Time, limit int) ([]*SessionChange, error)
+	// SyncEvent returns the event's rooms, sessions and speakers changed after the cursor since, or
+	// all of them when since is empty, applying at most limit (1 to MaxSyncChanges) changes. Same
+	// access as GetEventSchedule; ErrInvalidInput when since is not a cursor SyncEvent returned.
+	SyncEvent(ctx context.Context, eventID, userID, since string, limit int) (*SyncDelta, error)
+	// GetOfflineBundle returns the attendee app's offline bundle of the event with the given
+	// event_code; no login is needed. When knownVersion is the current version the bundle has
+	// no Archive. Unlisted sessions and speakers are left out. Returns ErrNotFound if no event has
+	// the code or the event is unlisted.
+	GetOfflineBundle(ctx context.Context, eventCode, knownVersion string) (*OfflineBundle, error)
+	// GetEventTheme returns the theme of the event with the given event_code; no login is needed.
+	// Returns ErrNotFound if no event has the code or the event is unlisted.
+	GetEventTheme(ctx context.Context, eventCode string) (*EventTheme, error)
+	// GetPublicSchedule returns the schedule of the event with the given event_code as the offline
+	// bundle has it, without speaker photos, and the event's theme; no login is needed. Returns
+	// ErrNotFound if no event has the code or the event is unlisted.
+	GetPublicSchedule(ctx context.Context, eventCode string) (*PublicSchedule, error)
+	// GetPublicEventDocuments returns the documents the event with the given event_code asks
+	// attendees to accept, to show before registering; no login is needed. Returns ErrNotFound if
+	// no event has the code or the event is unlisted.
+	GetPublicEventDocuments(ctx context.Context, eventCode string) (*EventDocuments, error)
+	// GetSessionCard returns what a shared link to the session shows; no login is needed. Returns
+	// ErrNotFound if the session does not exist, is not in a bookable room, or it or its event is
+	// unlisted.
+	GetSessionCard(ctx context.Context, sessionID string) (*SessionCard, error)
+	// GetSessionCardImage returns the session's card rendered as a PNG. Same errors as GetSessionCard.
+	GetSessionCardImage(ctx context.Context, sessionID string) (*SessionCardImage, error)
+	// ListPublicPages returns the listed events, sessions and speakers of every event, for a
+	// sitemap; at most MaxSitemapPages of them.
+	ListPublicPages(ctx context.Context) ([]*PublicPage, error)
 }
 
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"context"
+	"sync"
+)
+
+// BestEffortFailures collects the failures of steps an operation carries on without, such as
+// recording a session's history after the move is saved. Services report them with
+// ReportBestEffort instead of logging; whoever started the operation (request logging, a
+// background job) logs them.
+type BestEffortFailures struct {
+	mu   sync.Mutex
+	errs []error
+}
+
+type bestEffortKey struct{}
+
+// WithBestEffortFailures returns a context collecting the best-effort failures reported with it.
+func WithBestEffortFailures(ctx context.Context) (context.Context, *BestEffortFailures) {
+	f := &BestEffortFailures{}
+	return context.WithValue(ctx, bestEffortKey{}, f), f
+}
+
+// ReportBestEffort adds err to the failures collected by ctx. Without a collector, err is dropped.
+func ReportBestEffort(ctx context.Context, err error) {
+	f, ok := ctx.Value(bestEffortKey{}).(*BestEffortFailures)
+	if !ok || err == nil {
+		return
+	}
+	f.mu.Lock()
+	f.errs = append(f.errs, err)
+	f.mu.Unlock()
+}
+
+// Errors returns the failures reported so far, oldest first.
+func (f *BestEffortFailures) Errors() []error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]error(nil), f.errs...)
+}
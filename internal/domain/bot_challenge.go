@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrCaptchaFailed is returned when a captcha token is missing or the captcha provider rejects it.
+var ErrCaptchaFailed = errors.New("captcha verification failed")
+
+// ErrBotDetected is returned when a request fills in a honeypot field, which people never see.
+var ErrBotDetected = errors.New("request looks automated")
+
+// Challenge endpoints: the public forms a ChallengePolicy can be set for.
+const (
+	ChallengeEndpointContact      = "contact"
+	ChallengeEndpointReport       = "report"
+	ChallengeEndpointLogin        = "login"
+	ChallengeEndpointRegistration = "registration"
+)
+
+// ChallengeEndpoints lists every challenge endpoint.
+var ChallengeEndpoints = []string{ChallengeEndpointContact, ChallengeEndpointReport, ChallengeEndpointLogin, ChallengeEndpointRegistration}
+
+// CaptchaVerifier checks the token a captcha widget gave the client. remoteIP may be empty.
+type CaptchaVerifier interface {
+	Verify(ctx context.Context, token, remoteIP string) (bool, error)
+}
+
+// ChallengePolicy is how requests to one endpoint are screened for bots. Honeypot rejects requests
+// that fill in the honeypot field; Captcha requires a token the CaptchaVerifier accepts.
+type ChallengePolicy struct {
+	Honeypot bool `json:"honeypot"`
+	Captcha  bool `json:"captcha"`
+}
+
+// BotChallengeStats counts the outcomes of one endpoint's challenges since the server started.
+type BotChallengeStats struct {
+	Endpoint string          `json:"endpoint"`
+	Policy   ChallengePolicy `json:"policy"`
+	Passed   int64           `json:"passed"`
+	// Honeypot counts requests that filled in the honeypot field.
+	Honeypot int64 `json:"honeypot"`
+	// CaptchaMissing counts requests without a token, CaptchaRejected those whose token the
+	// provider refused and CaptchaErrors those the provider could not be asked about.
+	CaptchaMissing  int64 `json:"captcha_missing"`
+	CaptchaRejected int64 `json:"captcha_rejected"`
+	CaptchaErrors   int64 `json:"captcha_errors"`
+}
+
+// BotChallengeReporter provides the current challenge counts, one entry per screened endpoint.
+type BotChallengeReporter interface {
+	BotChallengeReport() []BotChallengeStats
+}
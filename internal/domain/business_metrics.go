@@ -0,0 +1,45 @@
+package domain
+
+import "context"
+
+// OtherEventsLabel stands in for the event of the counts folded together once the per-event label
+// limit is reached.
+const OtherEventsLabel = "other"
+
+// BusinessMetrics counts what happens to events, for operators' dashboards and alerts. Counts are
+// kept per process; Prometheus sums them across replicas. Implementations must be safe for
+// concurrent use. Callers do not count sandbox events.
+type BusinessMetrics interface {
+	// InvitationSent counts one invitation email sent for the event.
+	InvitationSent(eventID string)
+	// Registered counts one new registration for the event.
+	Registered(eventID string)
+}
+
+// EventCount is a count for one event. EventID is OtherEventsLabel for the events past the label limit.
+type EventCount struct {
+	EventID string `json:"event_id"`
+	Count   int64  `json:"count"`
+}
+
+// BusinessMetricsReport is a point-in-time view of the business metrics, each sorted by event ID
+// with OtherEventsLabel last.
+type BusinessMetricsReport struct {
+	// InvitationsSent and Registrations are counters since the process started.
+	InvitationsSent []EventCount `json:"invitations_sent"`
+	Registrations   []EventCount `json:"registrations"`
+	// ActiveRegistrations is a gauge read from the database: the registrations of each event that
+	// is undated or dated today or later.
+	ActiveRegistrations []EventCount `json:"active_registrations"`
+}
+
+// BusinessMetricsReporter provides the current business metrics.
+type BusinessMetricsReporter interface {
+	BusinessMetricsReport(ctx context.Context) (*BusinessMetricsReport, error)
+}
+
+// BusinessMetricsRecorder both counts and reports the business metrics.
+type BusinessMetricsRecorder interface {
+	BusinessMetrics
+	BusinessMetricsReporter
+}
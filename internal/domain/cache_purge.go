@@ -0,0 +1,14 @@
+package domain
+
+import "context"
+
+// CachePurger evicts cached public responses from a CDN by surrogate key.
+type CachePurger interface {
+	Purge(ctx context.Context, keys ...string) error
+}
+
+// EventSurrogateKey tags every public response built from an event's data, so a single purge evicts
+// them all when the event changes.
+func EventSurrogateKey(eventID string) string {
+	return "event-" + eventID
+}
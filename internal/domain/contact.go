@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrRateLimited is returned when a caller has sent too many requests of a kind recently.
+var ErrRateLimited = errors.New("too many requests")
+
+// Authors of contact messages.
+const (
+	ContactAuthorSender = "sender"
+	ContactAuthorTeam   = "team"
+)
+
+// Limits on contact form input.
+const (
+	MaxContactSubjectLength = 200
+	MaxContactMessageLength = 5000
+)
+
+// ContactLimit caps how many contact threads one address, or one sender email, may start per window.
+type ContactLimit struct {
+	Max    int
+	Window time.Duration
+}
+
+// ContactRequest is a message sent to an event's organizers through the public contact form.
+type ContactRequest struct {
+	EventCode string
+	Name      string
+	Email     string
+	Subject   string
+	Message   string
+	RemoteIP  string
+}
+
+// ContactThread is a conversation between someone who used an event's contact form and the
+// event's team. The sender only sees the team's replies by email, never a team member's address.
+// swagger:model ContactThread
+type ContactThread struct {
+	ID          string `json:"id"`
+	EventID     string `json:"event_id"`
+	SenderName  string `json:"sender_name"`
+	SenderEmail string `json:"sender_email"`
+	Subject     string `json:"subject"`
+	// MessageCount is how many messages the thread has, the first one included.
+	MessageCount int       `json:"message_count"`
+	CreatedAt    time.Time `json:"created_at"`
+	// UpdatedAt is when the last message was added.
+	UpdatedAt time.Time `json:"updated_at"`
+	// Messages is the conversation, oldest first; it is only set when a single thread is fetched.
+	Messages []*ContactMessage `json:"messages,omitempty"`
+}
+
+// ContactMessage is one message in a contact thread.
+// swagger:model ContactMessage
+type ContactMessage struct {
+	ID       string `json:"id"`
+	ThreadID string `json:"thread_id"`
+	// Author is sender or team.
+	Author string `json:"author"`
+	// UserID is the team member who replied; empty for the sender's messages.
+	UserID    string    `json:"user_id,omitempty"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ContactRepository stores contact threads and their messages.
+type ContactRepository interface {
+	// CreateThread stores the thread with its first message, from remoteIP, and sets their IDs and times.
+	CreateThread(ctx context.Context, t *ContactThread, first *ContactMessage, remoteIP string) error
+	// CountRecentThreads returns how many threads were started since from remoteIP and from email
+	// (case-insensitive), across all events.
+	CountRecentThreads(ctx context.Context, remoteIP, email string, since time.Time) (byIP, byEmail int, err error)
+	// ListThreads returns a page of the event's threads, most recently active first, and the total.
+	ListThreads(ctx context.Context, eventID string, params PaginationParams) ([]*ContactThread, int, error)
+	// GetThread returns the thread with its messages. Returns ErrNotFound.
+	GetThread(ctx context.Context, threadID string) (*ContactThread, error)
+	// AddMessage appends m to its thread and bumps the thread's UpdatedAt.
+	AddMessage(ctx context.Context, m *ContactMessage) error
+}
+
+// ContactService relays contact form messages to an event's team and lets the team reply. The team
+// methods return ErrForbidden unless userID owns the event or is on its team.
+type ContactService interface {
+	// ContactOrganizers starts a thread and emails it to the event's owner and team members. It
+	// returns ErrRateLimited, ErrInvalidInput, or ErrNotFound for an unknown event code.
+	ContactOrganizers(ctx context.Context, req *ContactRequest) (*ContactThread, error)
+	ListContactThreads(ctx context.Context, eventID, userID string, params PaginationParams) ([]*ContactThread, int, error)
+	GetContactThread(ctx context.Context, eventID, userID, threadID string) (*ContactThread, error)
+	// ReplyToContactThread adds the team's reply and emails it to the sender.
+	ReplyToContactThread(ctx context.Context, eventID, userID, threadID, body string) (*ContactMessage, error)
+}
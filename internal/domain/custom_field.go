@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrDuplicateCustomField is returned when an event already has a field with the same name for the
+// same kind of entity.
+var ErrDuplicateCustomField = errors.New("custom field already exists")
+
+// Custom field types. Values are strings for text, url and date (YYYY-MM-DD), float64 for number
+// and bool for boolean.
+const (
+	CustomFieldText    = "text"
+	CustomFieldNumber  = "number"
+	CustomFieldBoolean = "boolean"
+	CustomFieldDate    = "date"
+	CustomFieldURL     = "url"
+)
+
+// Entities a custom field can apply to.
+const (
+	CustomFieldSession = "session"
+	CustomFieldSpeaker = "speaker"
+)
+
+// MaxCustomFields is the most custom fields an event can define.
+const MaxCustomFields = 50
+
+// CustomField is an extra field an event defines for its sessions or speakers, such as
+// "streaming consent" or "paper DOI".
+// swagger:model CustomField
+type CustomField struct {
+	ID      string `json:"id"`
+	EventID string `json:"event_id"`
+	Name    string `json:"name"`
+	// Type is one of text, number, boolean, date and url.
+	Type string `json:"type"`
+	// AppliesTo is session or speaker.
+	AppliesTo string `json:"applies_to"`
+	// Public fields are shown to attendees: in the schedule, delta sync and offline bundle.
+	Public    bool      `json:"public"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CustomFieldValue is a session's or speaker's value for a custom field.
+// swagger:model CustomFieldValue
+type CustomFieldValue struct {
+	FieldID string `json:"field_id"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Value   any    `json:"value"`
+}
+
+// CustomFieldRepository defines storage for custom field definitions and their values.
+type CustomFieldRepository interface {
+	// ListFields returns the event's fields, oldest first.
+	ListFields(ctx context.Context, eventID string) ([]*CustomField, error)
+	// GetField returns the field, or ErrNotFound.
+	GetField(ctx context.Context, fieldID string) (*CustomField, error)
+	// CreateField stores a new field and sets its ID and timestamps. Returns ErrDuplicateCustomField
+	// when the name is taken.
+	CreateField(ctx context.Context, field *CustomField) error
+	// UpdateField renames the field and/or changes whether it is public. Returns ErrNotFound or
+	// ErrDuplicateCustomField.
+	UpdateField(ctx context.Context, fieldID string, name *string, public *bool) (*CustomField, error)
+	// DeleteField deletes the field and every value of it. Returns ErrNotFound.
+	DeleteField(ctx context.Context, fieldID string) error
+	// SetValues stores the values of a session or speaker (appliesTo) in one transaction; a nil Value
+	// removes the entity's value for that field. It returns all the entity's values afterwards.
+	SetValues(ctx context.Context, appliesTo, entityID string, values []*CustomFieldValue) ([]*CustomFieldValue, error)
+	// ListValues returns the values of the event's sessions or speakers (appliesTo) by entity ID,
+	// only those of public fields when publicOnly is set.
+	ListValues(ctx context.Context, eventID, appliesTo string, publicOnly bool) (map[string][]*CustomFieldValue, error)
+}
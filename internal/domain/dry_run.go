@@ -0,0 +1,42 @@
+package domain
+
+// Dry-run actions say what a destructive bulk operation would do to a record. Every such endpoint
+// takes ?dry_run=true and then returns what it would change without changing anything.
+const (
+	DryRunCreate = "create"
+	DryRunUpdate = "update"
+	// DryRunMove is a session that would leave its room (to another room, or unscheduled).
+	DryRunMove   = "move"
+	DryRunDelete = "delete"
+)
+
+// DryRunChange is one record a dry run reports the operation would change.
+// swagger:model DryRunChange
+type DryRunChange struct {
+	// ID is the record's ID; empty for records the operation would create.
+	ID     string `json:"id,omitempty"`
+	Name   string `json:"name"`
+	Action string `json:"action"`
+}
+
+// SessionizeImportPreview is what a Sessionize import would do to the event's rooms, sessions and
+// speakers. Records the import no longer contains are deleted.
+// swagger:model SessionizeImportPreview
+type SessionizeImportPreview struct {
+	EventID  string          `json:"event_id"`
+	DryRun   bool            `json:"dry_run"`
+	Rooms    []*DryRunChange `json:"rooms"`
+	Sessions []*DryRunChange `json:"sessions"`
+	Speakers []*DryRunChange `json:"speakers"`
+}
+
+// RoomDeletionPreview is what deleting a room in Mode would do to the room and its sessions.
+// swagger:model RoomDeletionPreview
+type RoomDeletionPreview struct {
+	EventID string `json:"event_id"`
+	RoomID  string `json:"room_id"`
+	Mode    string `json:"mode"`
+	DryRun  bool   `json:"dry_run"`
+	// Sessions are the room's sessions: deleted in cascade mode, moved otherwise.
+	Sessions []*DryRunChange `json:"sessions"`
+}
@@ -1,12 +1,34 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // Mailer defines the contract for sending emails (infrastructure port).
 type Mailer interface {
 	Send(to, subject, html, text string) error
 }
 
+// SentEmail is a message a sandbox mailer recorded instead of sending.
+type SentEmail struct {
+	To      string    `json:"to"`
+	Subject string    `json:"subject"`
+	HTML    string    `json:"html"`
+	Text    string    `json:"text"`
+	SentAt  time.Time `json:"sent_at"`
+}
+
+// Mailbox reads the messages a sandbox mailer recorded, so staging environments and end-to-end
+// tests can check what would have been sent (e.g. a login code).
+type Mailbox interface {
+	// SentEmails returns the recorded messages, newest first; a non-empty to keeps only the
+	// messages to that address (case-insensitive).
+	SentEmails(to string) []SentEmail
+	// ClearSentEmails forgets every recorded message.
+	ClearSentEmails()
+}
+
 // EmailTemplateRenderer renders email content from a named template with the given data.
 type EmailTemplateRenderer interface {
 	Render(templateName string, data any) (subject, htmlBody, textBody string, err error)
@@ -27,17 +49,81 @@ type LoginCodeEmailData struct {
 	ExpiresInMinutes  int
 }
 
+// EventDeletionEmailData holds data for the email with the token that confirms an event deletion.
+type EventDeletionEmailData struct {
+	Email            string
+	OwnerName        string
+	EventName        string
+	Token            string
+	ExpiresInMinutes int
+}
+
 // EventInvitationEmailData holds data for the event invitation email.
 type EventInvitationEmailData struct {
+	// EventID files the sent email in the event's email archive.
+	EventID    string
 	Email      string
 	OwnerName  string
 	EventName  string
 	EventCode  string
 }
 
+// TeamMemberLeftEmailData holds data for the email telling an event owner a team member left.
+type TeamMemberLeftEmailData struct {
+	// EventID files the sent email in the event's email archive.
+	EventID     string
+	Email       string
+	OwnerName   string
+	MemberName  string
+	MemberEmail string
+	EventName   string
+}
+
+// ContactMessageEmailData holds data for the email relaying a contact form message to a member of
+// the event's team.
+type ContactMessageEmailData struct {
+	// EventID files the sent email in the event's email archive.
+	EventID       string
+	Email         string
+	RecipientName string
+	EventName     string
+	SenderName    string
+	Subject       string
+	Message       string
+	ThreadID      string
+}
+
+// ContactReplyEmailData holds data for the email sending the team's reply to whoever used the
+// contact form. It names the event, never the team member who replied.
+type ContactReplyEmailData struct {
+	// EventID files the sent email in the event's email archive.
+	EventID   string
+	Email     string
+	Name      string
+	EventName string
+	Subject   string
+	Reply     string
+}
+
 // EmailService defines the contract for sending domain-level emails.
 type EmailService interface {
 	SendWelcomeMessage(ctx context.Context, data *WelcomeMessageEmailData) error
 	SendLoginCode(ctx context.Context, data *LoginCodeEmailData) error
+	// SendEventDeletionConfirmation sends the owner the token that confirms deleting the event. It
+	// is not archived with the event's emails: the token is a secret, and the event is about to go.
+	SendEventDeletionConfirmation(ctx context.Context, data *EventDeletionEmailData) error
 	SendEventInvitation(ctx context.Context, data *EventInvitationEmailData) error
+	// SendEventInvitationReminder reminds an invitee who has not registered yet.
+	SendEventInvitationReminder(ctx context.Context, data *EventInvitationEmailData) error
+	SendTeamMemberLeft(ctx context.Context, data *TeamMemberLeftEmailData) error
+	SendContactMessage(ctx context.Context, data *ContactMessageEmailData) error
+	SendContactReply(ctx context.Context, data *ContactReplyEmailData) error
+	// SendRoomCapacityAlert alerts the event owner that a room is nearly full.
+	SendRoomCapacityAlert(ctx context.Context, data *RoomCapacityAlertEmailData) error
+	// ListEventEmails returns a page of the emails sent for the event, newest first, and the total.
+	ListEventEmails(ctx context.Context, eventID string, filter EventEmailFilter, params PaginationParams) ([]*EventEmail, int, error)
+	// ResendEventEmail sends one of the event's emails again, exactly as it was rendered, and
+	// returns the new attempt; a mailer failure is recorded in it rather than returned.
+	// ErrEventEmailNotFound when the email is not one of the event's.
+	ResendEventEmail(ctx context.Context, eventID, emailID string) (*EventEmail, error)
 }
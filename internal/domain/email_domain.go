@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrEmailDomainNotChecked is returned when the server has no sending domain to check, i.e. mail is
+// not sent through SES.
+var ErrEmailDomainNotChecked = errors.New("the sending domain is not checked on this server")
+
+// Kinds of DNS record receivers use to authenticate mail from a sending domain.
+const (
+	EmailRecordSPF   = "spf"
+	EmailRecordDKIM  = "dkim"
+	EmailRecordDMARC = "dmarc"
+)
+
+// Statuses of a checked email DNS record. Unchecked records are not configured to be checked (e.g.
+// no DKIM selector is set) and do not fail the report.
+const (
+	EmailRecordOK        = "ok"
+	EmailRecordMissing   = "missing"
+	EmailRecordInvalid   = "invalid"
+	EmailRecordUnchecked = "unchecked"
+)
+
+// EmailDomainReport is the outcome of checking the SPF, DKIM and DMARC records of the domain
+// platform email is sent from.
+type EmailDomainReport struct {
+	Domain    string    `json:"domain"`
+	CheckedAt time.Time `json:"checked_at"`
+	// OK is false when any record is missing or invalid.
+	OK      bool                 `json:"ok"`
+	Records []*EmailDomainRecord `json:"records"`
+}
+
+// EmailDomainRecord is one checked DNS record.
+type EmailDomainRecord struct {
+	Kind string `json:"kind"`
+	// Name is the DNS name that was looked up (e.g. _dmarc.example.com).
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	// Values are the TXT values found that look like this kind of record.
+	Values []string `json:"values"`
+	// Problem says what is missing or wrong; it may also warn about a weak but valid record.
+	Problem string `json:"problem,omitempty"`
+}
+
+// EmailDomainChecker looks up the sending domain's records. Reports are cached for a while; refresh
+// looks them up again.
+type EmailDomainChecker interface {
+	CheckEmailDomain(ctx context.Context, refresh bool) (*EmailDomainReport, error)
+}
@@ -12,6 +12,48 @@ var ErrNotFound = errors.New("not found")
 // ErrForbidden is returned when the user is not allowed to perform the action (e.g. not the event owner).
 var ErrForbidden = errors.New("forbidden")
 
+// ErrEventHasRegistrations is returned when deleting an event attendees have registered for without force.
+var ErrEventHasRegistrations = errors.New("event has registrations")
+
+// ErrDuplicateEventCode is returned by EventRepository when another event already has the code.
+var ErrDuplicateEventCode = errors.New("event code already in use")
+
+// ErrQuotaExceeded is returned when an event already has as many of something as it may (e.g.
+// MaxCustomFields custom fields).
+var ErrQuotaExceeded = errors.New("quota exceeded")
+
+// Event code limits. Codes are lowercase letters and digits, so they read the same in any case.
+const (
+	MinEventCodeLength = 4
+	MaxEventCodeLength = 16
+	// EventCodeAttempts is how many random codes are tried before a run of collisions is an error.
+	EventCodeAttempts = 5
+)
+
+// MaxEventNameLength is the longest name an event may have.
+const MaxEventNameLength = 255
+
+// EventCodeFormat is how new event codes are generated: Length characters drawn at random from
+// Alphabet. Codes already handed out keep working when it changes.
+type EventCodeFormat struct {
+	Length   int
+	Alphabet string
+}
+
+// DefaultEventCodeFormat is the format used when none is configured: 4 lowercase letters or digits.
+var DefaultEventCodeFormat = EventCodeFormat{Length: 4, Alphabet: "abcdefghijklmnopqrstuvwxyz0123456789"}
+
+// EventCodeChange is the outcome of giving an event a new code.
+// swagger:model EventCodeChange
+type EventCodeChange struct {
+	Event        *Event `json:"event"`
+	PreviousCode string `json:"previous_code"`
+	// InvitationsResent counts the invitees who had not registered yet and were emailed the new
+	// code; InvitationsFailed lists the addresses that could not be.
+	InvitationsResent int      `json:"invitations_resent"`
+	InvitationsFailed []string `json:"invitations_failed"`
+}
+
 // Event represents a conference event
 // swagger:model Event
 type Event struct {
@@ -25,6 +67,17 @@ type Event struct {
 	Description *string    `json:"description,omitempty"`
 	LocationLat *float64   `json:"location_lat,omitempty"`
 	LocationLng *float64   `json:"location_lng,omitempty"`
+	// OperatingHours lists the open and close times of each event day. It is only filled in
+	// where the full event is returned, such as GET /events/{eventID} and the attendee schedule.
+	OperatingHours []*OperatingDay `json:"operating_hours,omitempty"`
+	// Role is the caller's role in the event, owner or team_member. It is only set in the
+	// caller's event list, GET /events/me.
+	Role string `json:"role,omitempty"`
+	// Stats is only filled in when asked for, as in GET /events/me?include=stats.
+	Stats *EventStats `json:"stats,omitempty"`
+	// Sandbox marks a disposable test event created by a sandbox machine client. Sandbox events send
+	// no email, are left out of the business metrics and are deleted after RETENTION_SANDBOX_EVENTS_DAYS.
+	Sandbox bool `json:"sandbox,omitempty"`
 }
 
 // NewEvent returns a new Event with the given fields. ID is typically set by the repository on create.
@@ -43,29 +96,145 @@ type EventService interface {
 	CreateEvent(ctx context.Context, event *Event) error
 	GetEventByID(ctx context.Context, eventID string) (*Event, []*Room, []*Session, error)
 	UpdateEvent(ctx context.Context, eventID, ownerID string, date *time.Time, description *string, locationLat, locationLng *float64) (*Event, error)
+	// RegenerateEventCode gives the event a new random code, so the old one stops working, and
+	// emails it to the invitees who have not registered yet. Only the event owner can.
+	RegenerateEventCode(ctx context.Context, eventID, ownerID string) (*EventCodeChange, error)
 	CreateEventRoom(ctx context.Context, eventID, ownerID, name string, capacity int, description, howToGetThere string, notBookable bool) (*Room, error)
 	CreateEventSession(ctx context.Context, eventID, ownerID, roomID, title, description string, startTime, endTime time.Time, tagNames, speakerIDs []string) (*Session, error)
 	UpdateSessionSchedule(ctx context.Context, eventID, sessionID, ownerID string, roomID *string, startTime, endTime *time.Time) (*Session, error)
 	UpdateSessionContent(ctx context.Context, eventID, sessionID, ownerID string, title *string, description *string) (*Session, error)
-	ImportSessionizeData(ctx context.Context, eventID string, sessionizeID string) error
-	ListEventsByOwner(ctx context.Context, ownerID string) ([]*Event, error)
-	DeleteEvent(ctx context.Context, eventID string, ownerID string) error
+	ImportSessionizeData(ctx context.Context, eventID string, sessionizeID string, forceRefresh bool) error
+	// PreviewSessionizeImport fetches the Sessionize data and returns what ImportSessionizeData
+	// would change, without changing anything. Only the event owner can preview.
+	PreviewSessionizeImport(ctx context.Context, eventID, ownerID, sessionizeID string, forceRefresh bool) (*SessionizeImportPreview, error)
+	GetImportMapping(ctx context.Context, eventID, ownerID string) (*ImportMapping, error)
+	UpdateImportMapping(ctx context.Context, eventID, ownerID string, mapping *ImportMapping) (*ImportMapping, error)
+	GetScheduleRules(ctx context.Context, eventID, ownerID string) (*ScheduleRules, error)
+	// UpdateScheduleRules replaces the rules new and moved sessions are checked against.
+	UpdateScheduleRules(ctx context.Context, eventID, ownerID string, rules *ScheduleRules) (*ScheduleRules, error)
+	// GetEventTheme returns the event's theme; events without a saved theme get the default theme.
+	GetEventTheme(ctx context.Context, eventID, ownerID string) (*EventTheme, error)
+	// UpdateEventTheme replaces the theme attendee apps show the event with.
+	UpdateEventTheme(ctx context.Context, eventID, ownerID string, theme *EventTheme) (*EventTheme, error)
+	GetOperatingHours(ctx context.Context, eventID, ownerID string) ([]*OperatingDay, error)
+	// UpdateOperatingHours replaces the event's operating days; new and moved sessions must fit inside one of them.
+	UpdateOperatingHours(ctx context.Context, eventID, ownerID string, days []*OperatingDay) ([]*OperatingDay, error)
+	ListSpeakerMergeCandidates(ctx context.Context, eventID, ownerID string) ([]*SpeakerMergeCandidate, error)
+	// ResolveSpeakerMergeCandidate merges the two speakers when merge is true and otherwise rejects the match.
+	ResolveSpeakerMergeCandidate(ctx context.Context, eventID, candidateID, ownerID string, merge bool) (*SpeakerMergeCandidate, error)
+	GetIntegrityReport(ctx context.Context, eventID, ownerID string) (*IntegrityReport, error)
+	// CleanupIntegrityIssues fixes the event's fixable issues; with dryRun it only lists them.
+	CleanupIntegrityIssues(ctx context.Context, eventID, ownerID string, dryRun bool) (*IntegrityCleanup, error)
+	// ValidateSchedule runs every conflict and constraint check on the event's sessions and returns
+	// the problems found by category. It changes nothing.
+	ValidateSchedule(ctx context.Context, eventID, ownerID string) (*ScheduleValidation, error)
+	// GetScheduleGrid returns the event's schedule laid out as days × rooms × time slots; the owner
+	// and team members may read it.
+	GetScheduleGrid(ctx context.Context, eventID, callerID string) (*ScheduleGrid, error)
+	// GetScheduleOverlaps returns which sessions run in parallel in each bucket of bucketMinutes,
+	// grouped by groupBy (OverlapGroupByTag or OverlapGroupByTrack); the owner and team members may
+	// read it. Returns ErrInvalidInput for an unknown grouping or bucket size.
+	GetScheduleOverlaps(ctx context.Context, eventID, callerID, groupBy string, bucketMinutes int) (*ScheduleOverlaps, error)
+	// GetChecklist returns the event's checklist items; the owner and team members may read it.
+	GetChecklist(ctx context.Context, eventID, callerID string) ([]*ChecklistItem, error)
+	// UpdateChecklist replaces the event's checklist items. Kept labels keep their completions.
+	UpdateChecklist(ctx context.Context, eventID, ownerID string, labels []string) ([]*ChecklistItem, error)
+	// SetSessionChecklistItem ticks or unticks an item for a session on behalf of the owner or a team member.
+	SetSessionChecklistItem(ctx context.Context, eventID, sessionID, itemID, userID string, done bool) (*SessionChecklist, error)
+	// GetChecklistProgress returns every session's checklist; when ready is set only the sessions
+	// that are (or are not) ready are listed.
+	GetChecklistProgress(ctx context.Context, eventID, callerID string, ready *bool) (*ChecklistProgress, error)
+	// ListCustomFields returns the event's custom fields; the owner and team members may read them.
+	ListCustomFields(ctx context.Context, eventID, callerID string) ([]*CustomField, error)
+	CreateCustomField(ctx context.Context, eventID, ownerID string, field *CustomField) (*CustomField, error)
+	UpdateCustomField(ctx context.Context, eventID, fieldID, ownerID string, name *string, public *bool) (*CustomField, error)
+	// DeleteCustomField deletes the field together with every session's or speaker's value of it.
+	DeleteCustomField(ctx context.Context, eventID, fieldID, ownerID string) error
+	// SetSessionCustomFields sets the session's values by field ID; a nil value clears the field. It
+	// returns all of the session's values.
+	SetSessionCustomFields(ctx context.Context, eventID, sessionID, ownerID string, values map[string]any) ([]*CustomFieldValue, error)
+	// SetSpeakerCustomFields is SetSessionCustomFields for a speaker.
+	SetSpeakerCustomFields(ctx context.Context, eventID, speakerID, ownerID string, values map[string]any) ([]*CustomFieldValue, error)
+	// ListMyEvents returns the events userID owns or is a team member of, newest first, each with
+	// the user's Role in it.
+	ListMyEvents(ctx context.Context, userID string, params MyEventsParams) ([]*Event, error)
+	// SearchEvents returns the events the user owns, helps run or is registered for, and the total
+	// number of matches before pagination.
+	SearchEvents(ctx context.Context, userID string, params EventSearchParams) ([]*EventSearchResult, int, error)
+	// ListJoinedEvents returns the events the user is a team member of but does not own.
+	ListJoinedEvents(ctx context.Context, userID string) ([]*Event, error)
+	// DeleteEvent deletes the event and everything in it. Attendee registrations are purchase
+	// records, so an event with any fails with ErrEventHasRegistrations unless force is set.
+	DeleteEvent(ctx context.Context, eventID string, ownerID string, force bool) error
 	ToggleRoomNotBookable(ctx context.Context, eventID, roomID, ownerID string) (*Room, error)
 	ListEventRooms(ctx context.Context, eventID, ownerID string) ([]*Room, error)
+	// ReorderEventRooms sets the order rooms are shown in everywhere the schedule is. roomIDs must
+	// list every room of the event once, or ErrInvalidInput is returned. Returns the rooms in order.
+	ReorderEventRooms(ctx context.Context, eventID, ownerID string, roomIDs []string) ([]*Room, error)
+	// ListEventTracks returns the tracks of the event's sessions in display order: those in the
+	// saved order first, then the others by name.
+	ListEventTracks(ctx context.Context, eventID, ownerID string) ([]string, error)
+	// ReorderEventTracks sets the order tracks are shown in everywhere the schedule is. tracks must
+	// list every track of the event's sessions once, or ErrInvalidInput is returned. Returns the
+	// tracks in order.
+	ReorderEventTracks(ctx context.Context, eventID, ownerID string, tracks []string) ([]string, error)
 	GetEventRoom(ctx context.Context, eventID, roomID, ownerID string) (*Room, error)
 	UpdateEventRoom(ctx context.Context, eventID, roomID, ownerID string, name *string, capacity int, description, howToGetThere string, notBookable *bool) (*Room, error)
-	DeleteEventRoom(ctx context.Context, eventID, roomID, ownerID string) error
+	// DeleteEventRoom deletes a room; mode is one of the RoomDelete* modes and targetRoomID is the
+	// room that receives the sessions in RoomDeleteReassign mode.
+	DeleteEventRoom(ctx context.Context, eventID, roomID, ownerID, mode, targetRoomID string) error
+	// PreviewDeleteEventRoom runs DeleteEventRoom's checks and returns what it would do to the
+	// room's sessions, without changing anything.
+	PreviewDeleteEventRoom(ctx context.Context, eventID, roomID, ownerID, mode, targetRoomID string) (*RoomDeletionPreview, error)
+	// ListSessionHistory returns the session's room and time moves, oldest first.
+	ListSessionHistory(ctx context.Context, eventID, sessionID, ownerID string) ([]*SessionChange, error)
+	// GetEventScheduleAsOf returns the event schedule as attendees saw it at asOf, rebuilt from the
+	// room and time moves made since, with the deletions and edits it cannot undo. Only the event
+	// owner may read it; ErrInvalidInput when asOf is in the future.
+	GetEventScheduleAsOf(ctx context.Context, eventID, ownerID string, asOf time.Time) (*ScheduleSnapshot, error)
 	DeleteEventSession(ctx context.Context, eventID, sessionID, ownerID string) error
 	ListEventSpeakers(ctx context.Context, eventID, ownerID string) ([]*Speaker, error)
 	GetEventSpeaker(ctx context.Context, eventID, speakerID, ownerID string) (*Speaker, []*Session, error)
 	DeleteEventSpeaker(ctx context.Context, eventID, speakerID, ownerID string) error
-	CreateEventSpeaker(ctx context.Context, eventID, ownerID string, firstName, lastName, bio, tagLine, profilePicture string, isTopSpeaker bool) (*Speaker, error)
+	CreateEventSpeaker(ctx context.Context, eventID, ownerID string, firstName, lastName, email, bio, tagLine, profilePicture string, isTopSpeaker bool) (*Speaker, error)
+	// BulkUpdateSpeakers applies many speaker updates in one transaction. When any update is
+	// invalid nothing is changed and the result reports each update's error. With dryRun nothing is
+	// changed either and each valid result has the speaker as it would be.
+	BulkUpdateSpeakers(ctx context.Context, eventID, ownerID string, updates []*SpeakerUpdate, dryRun bool) (*SpeakerBulkUpdateResult, error)
 	AddEventTeamMember(ctx context.Context, eventID, userIDToAdd, ownerID string) error
 	AddEventTeamMemberByEmail(ctx context.Context, eventID, email, ownerID string) (*EventTeamMember, error)
 	ListEventTeamMembers(ctx context.Context, eventID, callerID string) ([]*EventTeamMember, error)
 	RemoveEventTeamMember(ctx context.Context, eventID, userIDToRemove, ownerID string) error
-	SendEventInvitations(ctx context.Context, eventID, ownerID string, emails []string) (sent int, failed []string, err error)
+	// LeaveEvent removes userID from the event's team and emails the owner; ownerNotified reports
+	// whether that email was sent. The owner cannot leave their own event.
+	LeaveEvent(ctx context.Context, eventID, userID string) (ownerNotified bool, err error)
+	// PromoteInvitation adds the user the invitation was sent to as a team member with role, which
+	// must be EventRoleTeamMember (or empty for it).
+	PromoteInvitation(ctx context.Context, eventID, invitationID, ownerID, role string) (*EventTeamMember, error)
+	// SendEventInvitations invites and emails each address. Addresses the event's domain rules do not
+	// permit are returned in skipped and get no invitation.
+	SendEventInvitations(ctx context.Context, eventID, ownerID string, emails []string) (sent int, failed, skipped []string, err error)
+	// AnonymizeEvent removes the personal data of an event that has taken place (see
+	// EventAnonymization). It returns ErrInvalidInput for events dated in the future. With dryRun
+	// nothing is changed and the record returned counts what would be removed.
+	AnonymizeEvent(ctx context.Context, eventID, ownerID string, dryRun bool) (*EventAnonymization, error)
+	// GetInvitationDomainRules returns the event's invitation domain rules; events without saved
+	// rules get rules allowing every domain.
+	GetInvitationDomainRules(ctx context.Context, eventID, ownerID string) (*InvitationDomainRules, error)
+	// UpdateInvitationDomainRules replaces the domain rules checked when invitations are sent.
+	UpdateInvitationDomainRules(ctx context.Context, eventID, ownerID string, rules *InvitationDomainRules) (*InvitationDomainRules, error)
+	// RemindEventInvitations emails every invitee who has not registered yet, skipping those emailed
+	// less than minInterval ago (0 means DefaultInvitationReminderInterval) or already reminded
+	// MaxInvitationReminders times.
+	RemindEventInvitations(ctx context.Context, eventID, ownerID string, minInterval time.Duration) (*InvitationReminderResult, error)
 	ListEventInvitations(ctx context.Context, eventID, callerID string, search string, params PaginationParams) ([]*EventInvitation, int, error)
+	// ListEventEmails returns a page of the emails sent for the event, newest first. Only the owner can list.
+	ListEventEmails(ctx context.Context, eventID, ownerID string, filter EventEmailFilter, params PaginationParams) ([]*EventEmail, int, error)
+	// ResendEventEmail sends one of the event's emails again and returns the new attempt, whose
+	// status says whether the mailer accepted it.
+	ResendEventEmail(ctx context.Context, eventID, ownerID, emailID string) (*EventEmail, error)
+	StreamEventInvitations(ctx context.Context, eventID, callerID string, search string, fn func(*EventInvitation) error) error
+	StreamEventSessions(ctx context.Context, eventID, ownerID string, fn func(*Session) error) error
 	ListEventTags(ctx context.Context, eventID, callerID string) ([]*Tag, error)
 	AddEventTags(ctx context.Context, eventID, ownerID string, tagNames []string) ([]*Tag, error)
 	AddSessionTag(ctx context.Context, eventID, sessionID, ownerID, tagID string) error
@@ -83,6 +252,31 @@ type EventRepository interface {
 	GetByID(ctx context.Context, id string) (*Event, error)
 	GetByEventCode(ctx context.Context, eventCode string) (*Event, error)
 	ListByOwnerID(ctx context.Context, ownerID string) ([]*Event, error)
+	// ListByOwnerOrTeamMemberID returns the events userID owns or is a team member of, newest first.
+	ListByOwnerOrTeamMemberID(ctx context.Context, userID string) ([]*Event, error)
+	// ListByTeamMemberID returns the events userID is a team member of, excluding those it owns,
+	// newest first.
+	ListByTeamMemberID(ctx context.Context, userID string) ([]*Event, error)
+	// Search returns one page of the events userID owns, is a team member of or is registered for,
+	// newest event date first, and the total number of matches.
+	Search(ctx context.Context, userID string, params EventSearchParams) ([]*EventSearchResult, int, error)
 	Update(ctx context.Context, eventID string, date *time.Time, description *string, locationLat, locationLng *float64) (*Event, error)
+	// UpdateEventCode gives the event a new code. Returns ErrNotFound, or ErrDuplicateEventCode when
+	// another event has it.
+	UpdateEventCode(ctx context.Context, eventID, code string) (*Event, error)
 	Delete(ctx context.Context, id string) error
+	// CountRegistrations returns how many attendees are registered for the event.
+	CountRegistrations(ctx context.Context, eventID string) (int, error)
+	// ListStats returns the stats of the given events by event ID, computed in one query.
+	ListStats(ctx context.Context, eventIDs []string) (map[string]*EventStats, error)
+	// Anonymize removes the event's personal data in one transaction and records it as done by
+	// anonymizedBy. An event anonymized before is left as is and its first record returned.
+	Anonymize(ctx context.Context, eventID, anonymizedBy string) (*EventAnonymization, error)
+	// PreviewAnonymize runs Anonymize in a transaction it rolls back and returns the record
+	// Anonymize would have returned.
+	PreviewAnonymize(ctx context.Context, eventID, anonymizedBy string) (*EventAnonymization, error)
+	// GetTheme returns ErrNotFound when the event has no saved theme.
+	GetTheme(ctx context.Context, eventID string) (*EventTheme, error)
+	// UpsertTheme creates or replaces the event's theme and sets UpdatedAt.
+	UpsertTheme(ctx context.Context, theme *EventTheme) error
 }
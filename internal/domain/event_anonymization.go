@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// AnonymizedEmailDomain is the domain of the hashed addresses anonymized invitations keep, so they
+// still count as sent and stay unique per event without identifying anyone.
+const AnonymizedEmailDomain = "anonymized.invalid"
+
+// IsAnonymizedEmail reports whether email is a hashed invitation address.
+func IsAnonymizedEmail(email string) bool {
+	return strings.HasSuffix(email, "@"+AnonymizedEmailDomain)
+}
+
+// EventAnonymization records that an event's personal data was removed: invitation emails are
+// replaced by keyed hashes, speaker contact emails are cleared, and registrations, document
+// acceptances and the values of private text and URL custom fields are deleted. The counts from
+// before are kept for the event's stats.
+// swagger:model EventAnonymization
+type EventAnonymization struct {
+	EventID      string    `json:"event_id"`
+	AnonymizedAt time.Time `json:"anonymized_at"`
+	// AnonymizedBy is the user who anonymized the event; it is empty when the retention policy did.
+	AnonymizedBy        string `json:"anonymized_by,omitempty"`
+	Registrations       int    `json:"registrations"`
+	InvitationsSent     int    `json:"invitations_sent"`
+	InvitationsAccepted int    `json:"invitations_accepted"`
+	// InvitationsHashed, SpeakerEmailsCleared and CustomFieldValuesCleared count what was removed.
+	InvitationsHashed        int `json:"invitations_hashed"`
+	SpeakerEmailsCleared     int `json:"speaker_emails_cleared"`
+	CustomFieldValuesCleared int `json:"custom_field_values_cleared"`
+	// DryRun is set on the preview of an anonymization; its counts are what would be removed.
+	DryRun bool `json:"dry_run,omitempty"`
+}
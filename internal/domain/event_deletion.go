@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrInvalidConfirmation is returned when an event deletion token is wrong, already used or expired.
+var ErrInvalidConfirmation = errors.New("invalid or expired confirmation token")
+
+// EventDeletionExpiry is how long the owner has to confirm an event deletion with the emailed token.
+const EventDeletionExpiry = 30 * time.Minute
+
+// PendingEventDeletion is a requested event deletion waiting for the owner to confirm it with the
+// token emailed to them.
+// swagger:model PendingEventDeletion
+type PendingEventDeletion struct {
+	EventID string `json:"event_id"`
+	// Force is whether confirming also deletes attendee registrations.
+	Force     bool      `json:"force"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// EventDeletionRepository stores pending event deletions. An event has at most one; requesting
+// again replaces it.
+type EventDeletionRepository interface {
+	// Create stores the event's pending deletion, replacing any earlier one.
+	Create(ctx context.Context, d *PendingEventDeletion, requestedBy, tokenHash string) error
+	// Consume deletes the event's pending deletion if tokenHash matches and it has not expired, and
+	// returns it; ok is false otherwise.
+	Consume(ctx context.Context, eventID, tokenHash string) (d *PendingEventDeletion, ok bool, err error)
+}
+
+// EventDeletionService deletes events in two steps: the owner requests the deletion and gets a
+// token by email, then confirms with it within EventDeletionExpiry. Admins may skip the email.
+type EventDeletionService interface {
+	// RequestEventDeletion emails the owner a confirmation token. It fails like DeleteEvent would:
+	// ErrNotFound, ErrForbidden unless ownerID owns the event, and ErrEventHasRegistrations
+	// unless force is set.
+	RequestEventDeletion(ctx context.Context, eventID, ownerID string, force bool) (*PendingEventDeletion, error)
+	// ConfirmEventDeletion deletes the event; ErrInvalidConfirmation when the token is wrong, used
+	// or expired.
+	ConfirmEventDeletion(ctx context.Context, eventID, ownerID, token string) error
+	// ForceDeleteEvent deletes the event and its registrations at once. ErrForbidden unless adminID
+	// has AdminRole.
+	ForceDeleteEvent(ctx context.Context, eventID, adminID string) error
+}
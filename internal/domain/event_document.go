@@ -0,0 +1,151 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrDocumentsNotAccepted is returned when a user registers for an event without having accepted
+// the current version of each of its documents.
+var ErrDocumentsNotAccepted = errors.New("event documents not accepted")
+
+// Kinds of event documents.
+const (
+	DocumentKindCodeOfConduct = "code_of_conduct"
+	DocumentKindPhotoPolicy   = "photo_policy"
+	DocumentKindTerms         = "terms"
+)
+
+// DocumentKinds lists every kind of event document.
+var DocumentKinds = []string{DocumentKindCodeOfConduct, DocumentKindPhotoPolicy, DocumentKindTerms}
+
+// Limits of event documents.
+const (
+	MaxDocumentTitleLength = 200
+	MaxDocumentBodyLength  = 100000
+)
+
+// EventDocument is one version of a document attendees of an event accept to register, such as its
+// code of conduct. Publishing changed text makes a new version; the old ones are kept.
+// swagger:model EventDocument
+type EventDocument struct {
+	EventID string `json:"event_id"`
+	Kind    string `json:"kind"`
+	// Version counts from 1 for each kind of document of the event.
+	Version     int       `json:"version"`
+	Title       string    `json:"title"`
+	Body        string    `json:"body"`
+	PublishedAt time.Time `json:"published_at"`
+}
+
+// DocumentVersion names a version of one of an event's documents, as a user accepts it.
+// swagger:model DocumentVersion
+type DocumentVersion struct {
+	Kind    string `json:"kind"`
+	Version int    `json:"version"`
+}
+
+// DocumentAcceptance records that a user accepted a version of an event's document.
+type DocumentAcceptance struct {
+	EventID    string
+	Kind       string
+	Version    int
+	UserID     string
+	AcceptedAt time.Time
+}
+
+// EventDocuments are the documents an event currently asks attendees to accept.
+// swagger:model EventDocuments
+type EventDocuments struct {
+	EventID   string           `json:"event_id"`
+	Documents []*EventDocument `json:"documents"`
+}
+
+// AttendeeDocument is an event's current document as one user sees it.
+// swagger:model AttendeeDocument
+type AttendeeDocument struct {
+	Document *EventDocument `json:"document"`
+	// AcceptedVersion is the latest version the user accepted; 0 when they never did.
+	AcceptedVersion int        `json:"accepted_version"`
+	AcceptedAt      *time.Time `json:"accepted_at,omitempty"`
+	// NeedsAcceptance is set until the user accepts the current version, such as after it changed.
+	NeedsAcceptance bool `json:"needs_acceptance"`
+}
+
+// AttendeeAcceptance is where one registered attendee stands with a document.
+// swagger:model AttendeeAcceptance
+type AttendeeAcceptance struct {
+	UserID   string `json:"user_id"`
+	Email    string `json:"email"`
+	Name     string `json:"name"`
+	LastName string `json:"last_name"`
+	// AcceptedVersion is the latest version the attendee accepted; 0 when they never did.
+	AcceptedVersion int        `json:"accepted_version"`
+	AcceptedAt      *time.Time `json:"accepted_at,omitempty"`
+}
+
+// DocumentAcceptanceReport is who of an event's registered attendees accepted which version of a
+// document.
+// swagger:model DocumentAcceptanceReport
+type DocumentAcceptanceReport struct {
+	EventID string `json:"event_id"`
+	Kind    string `json:"kind"`
+	// Version is the current version.
+	Version    int `json:"version"`
+	Registered int `json:"registered"`
+	// AcceptedCurrent counts the attendees who accepted the current version, AcceptedOlder those
+	// whose latest acceptance is of an older one, and NeverAccepted the rest, such as those who
+	// registered before the document was published.
+	AcceptedCurrent int                   `json:"accepted_current"`
+	AcceptedOlder   int                   `json:"accepted_older"`
+	NeverAccepted   int                   `json:"never_accepted"`
+	Attendees       []*AttendeeAcceptance `json:"attendees"`
+}
+
+// EventDocumentRepository stores event documents, their versions and who accepted them.
+type EventDocumentRepository interface {
+	// Publish stores doc as the next version of its kind for its event, setting Version and
+	// PublishedAt, and makes a withdrawn document current again.
+	Publish(ctx context.Context, doc *EventDocument) error
+	// ListCurrent returns the latest version of each of the event's documents that is not withdrawn,
+	// in kind order.
+	ListCurrent(ctx context.Context, eventID string) ([]*EventDocument, error)
+	// ListVersions returns every version of the event's document of kind, newest first; none when
+	// there is no such document.
+	ListVersions(ctx context.Context, eventID, kind string) ([]*EventDocument, error)
+	// Withdraw stops asking for the event's document of kind; its versions and acceptances are
+	// kept. ErrNotFound if there is no such document.
+	Withdraw(ctx context.Context, eventID, kind string) error
+	// Accept records that userID accepts the versions of the event's documents; accepting a version
+	// again keeps the first record.
+	Accept(ctx context.Context, eventID, userID string, versions []DocumentVersion) error
+	// ListAcceptances returns the latest version of each of the event's documents userID accepted.
+	ListAcceptances(ctx context.Context, eventID, userID string) ([]*DocumentAcceptance, error)
+	// ListAttendeeAcceptances returns each of the event's registered attendees with the latest
+	// version of the document of kind they accepted, in email order.
+	ListAttendeeAcceptances(ctx context.Context, eventID, kind string) ([]*AttendeeAcceptance, error)
+}
+
+// EventDocumentService manages the documents attendees accept to register for an event. Only the
+// event owner publishes, withdraws and reports on them; ErrForbidden for anyone else.
+type EventDocumentService interface {
+	// PublishDocument makes title and body the current version of the event's document of kind. When
+	// they equal the current version's, it is returned and nothing changes; published tells.
+	PublishDocument(ctx context.Context, eventID, ownerID, kind, title, body string) (doc *EventDocument, published bool, err error)
+	ListDocuments(ctx context.Context, eventID, ownerID string) ([]*EventDocument, error)
+	// ListDocumentVersions returns every version of the document, newest first. ErrNotFound if the
+	// event has never had one of kind.
+	ListDocumentVersions(ctx context.Context, eventID, ownerID, kind string) ([]*EventDocument, error)
+	// WithdrawDocument stops requiring the document; what was accepted stays on record.
+	WithdrawDocument(ctx context.Context, eventID, ownerID, kind string) error
+	// GetAcceptanceReport returns who of the registered attendees accepted which version of the
+	// current document of kind. ErrNotFound if the event has no such document.
+	GetAcceptanceReport(ctx context.Context, eventID, ownerID, kind string) (*DocumentAcceptanceReport, error)
+	// ListMyDocuments returns the event's current documents with the versions userID accepted. Any
+	// signed-in user may read them, to accept them before registering.
+	ListMyDocuments(ctx context.Context, eventID, userID string) ([]*AttendeeDocument, error)
+	// AcceptDocuments records that userID accepts the versions, which must be current
+	// (ErrInvalidInput otherwise), and returns the documents as ListMyDocuments does.
+	AcceptDocuments(ctx context.Context, eventID, userID string, accepted []DocumentVersion) ([]*AttendeeDocument, error)
+}
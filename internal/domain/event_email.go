@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrEventEmailNotFound is returned when a sent email does not exist or belongs to another event.
+var ErrEventEmailNotFound = errors.New("email not found")
+
+// Kinds of event emails, named after the template they are rendered from.
+const (
+	EventEmailInvitation         = "event_invitation"
+	EventEmailInvitationReminder = "event_invitation_reminder"
+	EventEmailTeamMemberLeft     = "team_member_left"
+	EventEmailContactMessage     = "contact_message"
+	EventEmailContactReply       = "contact_reply"
+	EventEmailRoomCapacityAlert  = "room_capacity_alert"
+)
+
+// Delivery statuses of an event email.
+const (
+	EventEmailSent   = "sent"
+	EventEmailFailed = "failed"
+	// EventEmailSkipped is an email for a sandbox event: it is rendered and kept, but never sent.
+	EventEmailSkipped = "skipped"
+)
+
+// EventEmail is a rendered email sent on behalf of an event. Every attempt is kept, failed ones
+// included, so organizers can check what a recipient was sent and send it again.
+// swagger:model EventEmail
+type EventEmail struct {
+	ID      string `json:"id"`
+	EventID string `json:"event_id"`
+	Kind    string `json:"kind"`
+	To      string `json:"to"`
+	Subject string `json:"subject"`
+	HTML    string `json:"html"`
+	Text    string `json:"text"`
+	Status  string `json:"status"`
+	// Error is why the mailer could not send the message; it is empty when it was sent.
+	Error string `json:"error,omitempty"`
+	// ResendOf is the ID of the email this one sent again, if it is a resend.
+	ResendOf  string    `json:"resend_of,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// EventEmailFilter narrows an event's sent emails; empty fields match every email.
+type EventEmailFilter struct {
+	// To keeps the emails whose recipient contains this string (case-insensitive).
+	To     string
+	Kind   string
+	Status string
+}
+
+// EventEmailRepository stores the emails sent on behalf of events.
+type EventEmailRepository interface {
+	Create(ctx context.Context, email *EventEmail) error
+	GetByID(ctx context.Context, id string) (*EventEmail, error)
+	// ListByEventID returns a page of the event's emails, newest first, and the total matching filter.
+	ListByEventID(ctx context.Context, eventID string, filter EventEmailFilter, params PaginationParams) ([]*EventEmail, int, error)
+}
@@ -2,9 +2,13 @@ package domain
 
 import (
 	"context"
+	"errors"
 	"time"
 )
 
+// ErrInvitationNotFound is returned when an invitation does not exist or belongs to another event.
+var ErrInvitationNotFound = errors.New("invitation not found")
+
 // EventInvitation represents an email invited to register for an event.
 // swagger:model EventInvitation
 type EventInvitation struct {
@@ -12,10 +16,65 @@ type EventInvitation struct {
 	EventID string    `json:"event_id"`
 	Email   string    `json:"email"`
 	SentAt  time.Time `json:"sent_at"`
+	// AcceptedBy is set once a user with the invited email has registered for the event.
+	AcceptedBy *InvitationAcceptance `json:"accepted_by,omitempty"`
+}
+
+// InvitationAcceptance is the user who accepted an invitation by registering for the event.
+// swagger:model InvitationAcceptance
+type InvitationAcceptance struct {
+	UserID    string `json:"user_id"`
+	Name      string `json:"name"`
+	LastName  string `json:"last_name"`
+	AvatarURL string `json:"avatar_url,omitempty"`
+	// AcceptedAt is when the user registered for the event.
+	AcceptedAt time.Time `json:"accepted_at"`
+}
+
+// Limits of invitation reminder campaigns.
+const (
+	// DefaultInvitationReminderInterval is how long after the last email an invitee may be reminded
+	// when the campaign does not say.
+	DefaultInvitationReminderInterval = 72 * time.Hour
+	// MinInvitationReminderInterval is the shortest interval a campaign may ask for.
+	MinInvitationReminderInterval = 24 * time.Hour
+	// MaxInvitationReminders is the most reminders one invitee receives.
+	MaxInvitationReminders = 3
+)
+
+// PendingInvitation is an invitation nobody has accepted yet, with when its invitee was last emailed.
+type PendingInvitation struct {
+	ID              string
+	Email           string
+	LastContactedAt time.Time
+	RemindersSent   int
+}
+
+// InvitationReminderResult is the outcome of a reminder campaign.
+// swagger:model InvitationReminderResult
+type InvitationReminderResult struct {
+	Sent   int      `json:"sent"`
+	Failed []string `json:"failed"`
+	// Throttled counts the invitees left alone because they were emailed within the interval or
+	// already got MaxInvitationReminders reminders.
+	Throttled int `json:"throttled"`
 }
 
 // EventInvitationRepository defines storage operations for event invitations.
 type EventInvitationRepository interface {
 	Create(ctx context.Context, inv *EventInvitation) error
+	// GetByID returns the invitation, or ErrNotFound.
+	GetByID(ctx context.Context, id string) (*EventInvitation, error)
 	ListByEventID(ctx context.Context, eventID string, search string, params PaginationParams) ([]*EventInvitation, int, error)
+	// StreamByEventID calls fn for every invitation matching search (newest first) as rows are read
+	// from the database cursor, without loading them all. It stops at the first error fn returns.
+	StreamByEventID(ctx context.Context, eventID string, search string, fn func(*EventInvitation) error) error
+	// ListPending returns the event's invitations nobody has accepted yet, oldest first.
+	ListPending(ctx context.Context, eventID string) ([]*PendingInvitation, error)
+	// MarkReminded records that a reminder was emailed for the invitation at at.
+	MarkReminded(ctx context.Context, id string, at time.Time) error
+	// GetDomainRules returns ErrNotFound when the event has no saved domain rules.
+	GetDomainRules(ctx context.Context, eventID string) (*InvitationDomainRules, error)
+	// UpsertDomainRules creates or replaces the event's domain rules and sets UpdatedAt.
+	UpsertDomainRules(ctx context.Context, rules *InvitationDomainRules) error
 }
@@ -0,0 +1,38 @@
+package domain
+
+import "time"
+
+// Roles a user can have in an event, as reported by event search and the user's event list.
+const (
+	EventRoleOwner      = "owner"
+	EventRoleTeamMember = "team_member"
+	EventRoleAttendee   = "attendee"
+)
+
+// EventSearchParams filters the events returned by EventService.SearchEvents. Zero fields do not filter.
+type EventSearchParams struct {
+	// Query matches the event name, code or description, case-insensitively.
+	Query string
+	// From and To limit results to events dated on or after From and before To. Events without a
+	// date are left out when either is set.
+	From *time.Time
+	To   *time.Time
+	// Roles limits results to events where the user has at least one of these roles.
+	Roles      []string
+	Pagination PaginationParams
+}
+
+// MyEventsParams selects what EventService.ListMyEvents returns.
+type MyEventsParams struct {
+	// OwnerOnly leaves out the events the user is only a team member of.
+	OwnerOnly bool
+	// WithStats fills in each event's Stats.
+	WithStats bool
+}
+
+// EventSearchResult is an event found by a search, with the roles the searching user has in it.
+// swagger:model EventSearchResult
+type EventSearchResult struct {
+	Event *Event   `json:"event"`
+	Roles []string `json:"roles"`
+}
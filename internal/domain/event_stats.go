@@ -0,0 +1,15 @@
+package domain
+
+// EventStats are the counts an organizer dashboard shows for an event.
+// swagger:model EventStats
+type EventStats struct {
+	Rooms    int `json:"rooms"`
+	Sessions int `json:"sessions"`
+	Speakers int `json:"speakers"`
+	// InvitationsSent is how many emails were invited to register; InvitationsAccepted is how
+	// many of them have registered since.
+	InvitationsSent     int `json:"invitations_sent"`
+	InvitationsAccepted int `json:"invitations_accepted"`
+	// TeamSize counts the owner and the team members.
+	TeamSize int `json:"team_size"`
+}
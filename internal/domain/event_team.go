@@ -19,6 +19,8 @@ type EventTeamMember struct {
 	Name     string `json:"name"`
 	LastName string `json:"last_name"`
 	Email    string `json:"email"`
+	// AvatarURL is the member's profile picture; empty when they have not set one.
+	AvatarURL string `json:"avatar_url,omitempty"`
 }
 
 // EventTeamMemberRepository defines the interface for event team member storage.
@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+// EventTheme is an event's branding for white-label attendee apps. Empty fields fall back to the
+// app's defaults, so the zero value is the default theme.
+// swagger:model EventTheme
+type EventTheme struct {
+	EventID string `json:"event_id"`
+	// Colors are hex colors as "#RRGGBB" or "#RRGGBBAA".
+	PrimaryColor    string `json:"primary_color"`
+	SecondaryColor  string `json:"secondary_color"`
+	BackgroundColor string `json:"background_color"`
+	TextColor       string `json:"text_color"`
+	// FontFamily and HeadingFontFamily are CSS font family names (e.g. "Inter"); apps load them
+	// themselves.
+	FontFamily        string    `json:"font_family"`
+	HeadingFontFamily string    `json:"heading_font_family"`
+	FooterText        string    `json:"footer_text"`
+	SupportEmail      string    `json:"support_email"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// NewEventTheme returns the theme of an event that has not saved one: every field uses the app's
+// default.
+func NewEventTheme(eventID string) *EventTheme {
+	return &EventTheme{EventID: eventID}
+}
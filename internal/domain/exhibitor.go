@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrDuplicateBooth is returned when another exhibitor of the event already has the booth number.
+var ErrDuplicateBooth = errors.New("booth number already taken")
+
+// ErrEventNotOver is returned when exporting an exhibitor's leads before the event has ended.
+var ErrEventNotOver = errors.New("event has not ended yet")
+
+// Exhibitor is a sponsor or vendor with a booth at an event.
+// swagger:model Exhibitor
+type Exhibitor struct {
+	ID      string `json:"id"`
+	EventID string `json:"event_id"`
+	// EventName is only set in the booths of the caller, GET /exhibitor/booths.
+	EventName string `json:"event_name,omitempty"`
+	Name      string `json:"name"`
+	// BoothNumber is unique within the event; empty while the booth is unassigned.
+	BoothNumber string            `json:"booth_number"`
+	Staff       []*ExhibitorStaff `json:"staff"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+// ExhibitorStaff is a user who works an exhibitor's booth and may scan leads for it.
+// swagger:model ExhibitorStaff
+type ExhibitorStaff struct {
+	ExhibitorID string `json:"exhibitor_id"`
+	UserID      string `json:"user_id"`
+	Name        string `json:"name"`
+	LastName    string `json:"last_name"`
+	Email       string `json:"email"`
+}
+
+// LeadConsent is an attendee's agreement to share their contact details with the exhibitors that
+// scan their lead code. Code is empty while the attendee has not agreed.
+// swagger:model LeadConsent
+type LeadConsent struct {
+	EventID string `json:"event_id"`
+	Consent bool   `json:"consent"`
+	// Code is what the attendee app shows as a QR code for booth staff to scan.
+	Code string `json:"code,omitempty"`
+}
+
+// Lead is an attendee whose lead code an exhibitor's staff scanned, with their contact details.
+// swagger:model Lead
+type Lead struct {
+	ID          string `json:"id"`
+	ExhibitorID string `json:"exhibitor_id"`
+	EventID     string `json:"event_id"`
+	UserID      string `json:"user_id"`
+	Name        string `json:"name"`
+	LastName    string `json:"last_name"`
+	Email       string `json:"email"`
+	Notes       string `json:"notes"`
+	// ScannedBy is the staff member who scanned the attendee first; empty once their account is gone.
+	ScannedBy string    `json:"scanned_by,omitempty"`
+	ScannedAt time.Time `json:"scanned_at"`
+}
+
+// MaxLeadNotesLength is the most characters of notes a lead may carry.
+const MaxLeadNotesLength = 2000
+
+// ExhibitorRepository stores exhibitors and their staff.
+type ExhibitorRepository interface {
+	// Create stores the exhibitor and sets its ID and timestamps. Returns ErrDuplicateBooth when the
+	// booth number is taken.
+	Create(ctx context.Context, e *Exhibitor) error
+	// GetByID returns the exhibitor with its staff.
+	GetByID(ctx context.Context, id string) (*Exhibitor, error)
+	// ListByEventID returns the event's exhibitors with their staff, by name.
+	ListByEventID(ctx context.Context, eventID string) ([]*Exhibitor, error)
+	// ListByStaffUserID returns the exhibitors userID staffs, with their event's name, newest first.
+	ListByStaffUserID(ctx context.Context, userID string) ([]*Exhibitor, error)
+	Delete(ctx context.Context, id string) error
+	// AddStaff makes userID staff of the exhibitor; adding them again changes nothing.
+	AddStaff(ctx context.Context, exhibitorID, userID string) error
+	// RemoveStaff returns ErrNotFound when userID is not staff of the exhibitor.
+	RemoveStaff(ctx context.Context, exhibitorID, userID string) error
+	IsStaff(ctx context.Context, exhibitorID, userID string) (bool, error)
+}
+
+// LeadRepository stores attendees' lead consents and the leads exhibitors scanned.
+type LeadRepository interface {
+	// GetConsentCode returns the attendee's lead code for the event, empty without consent.
+	GetConsentCode(ctx context.Context, eventID, userID string) (string, error)
+	// SetConsentCode stores the attendee's lead code; an empty code withdraws the consent.
+	SetConsentCode(ctx context.Context, eventID, userID, code string) error
+	// FindConsent returns the attendee with the lead code in the event, or ErrNotFound.
+	FindConsent(ctx context.Context, eventID, code string) (userID string, err error)
+	// UpsertLead stores the lead, or when the exhibitor already has the attendee replaces its notes
+	// with non-empty ones. It sets ID, ScannedBy, ScannedAt and Notes as stored and returns whether
+	// the lead is new.
+	UpsertLead(ctx context.Context, lead *Lead) (bool, error)
+	// ListLeads returns the exhibitor's leads with the attendees' contact details, oldest first.
+	ListLeads(ctx context.Context, exhibitorID string) ([]*Lead, error)
+}
+
+// ExhibitorService manages exhibitors, their booth staff and the leads they scan. The event owner
+// and team members manage exhibitors; ErrForbidden for anyone else.
+type ExhibitorService interface {
+	// CreateExhibitor adds an exhibitor; ErrInvalidInput without a name, ErrDuplicateBooth when the
+	// booth number is taken.
+	CreateExhibitor(ctx context.Context, eventID, callerID, name, boothNumber string) (*Exhibitor, error)
+	ListExhibitors(ctx context.Context, eventID, callerID string) ([]*Exhibitor, error)
+	// DeleteExhibitor deletes the exhibitor with its staff and leads.
+	DeleteExhibitor(ctx context.Context, eventID, exhibitorID, callerID string) error
+	// AddExhibitorStaff makes the user with the email staff of the exhibitor; ErrUserNotFound when
+	// there is no such user.
+	AddExhibitorStaff(ctx context.Context, eventID, exhibitorID, callerID, email string) (*ExhibitorStaff, error)
+	RemoveExhibitorStaff(ctx context.Context, eventID, exhibitorID, callerID, userID string) error
+	// GetLeadConsent returns whether the registered attendee shares their contact details with
+	// exhibitors at the event, and the code to show them. ErrForbidden when not registered.
+	GetLeadConsent(ctx context.Context, eventID, userID string) (*LeadConsent, error)
+	// SetLeadConsent gives or withdraws the attendee's consent. Giving it keeps an existing code;
+	// withdrawing retires the code, so a later consent gets a new one. Leads already scanned stay.
+	SetLeadConsent(ctx context.Context, eventID, userID string, consent bool) (*LeadConsent, error)
+	// ListMyBooths returns the exhibitors userID staffs.
+	ListMyBooths(ctx context.Context, userID string) ([]*Exhibitor, error)
+	// ScanLead records the attendee with the lead code as a lead of the exhibitor, and returns it and
+	// whether it is new. ErrForbidden unless staffID staffs the exhibitor; ErrNotFound when no
+	// attendee of the event consented with the code.
+	ScanLead(ctx context.Context, exhibitorID, staffID, code, notes string) (*Lead, bool, error)
+	// ExportLeads returns the exhibitor's leads to its staff once the event has ended; before that
+	// ErrEventNotOver.
+	ExportLeads(ctx context.Context, exhibitorID, staffID string) ([]*Lead, error)
+}
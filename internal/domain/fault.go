@@ -0,0 +1,33 @@
+package domain
+
+// Dependencies fault injection can slow down or fail.
+const (
+	FaultDependencyEmail      = "email"
+	FaultDependencySessionize = "sessionize"
+	// FaultDependencyImages is the download of speaker photos for offline bundle thumbnails, the
+	// only remote files the API reads.
+	FaultDependencyImages = "images"
+)
+
+// FaultDependencies lists the dependencies fault injection supports.
+var FaultDependencies = []string{FaultDependencyEmail, FaultDependencySessionize, FaultDependencyImages}
+
+// Fault is the latency and error rate injected into calls to one dependency. The zero Fault
+// injects nothing.
+type Fault struct {
+	Dependency string `json:"dependency"`
+	// LatencyMs is added before every call.
+	LatencyMs int64 `json:"latency_ms"`
+	// ErrorRate is the share of calls, from 0 to 1, that fail without reaching the dependency.
+	ErrorRate float64 `json:"error_rate"`
+}
+
+// FaultInjector holds the faults injected into dependency calls, for exercising retries, circuit
+// breakers and fallbacks in staging.
+type FaultInjector interface {
+	// Faults returns the fault of every supported dependency, in FaultDependencies order.
+	Faults() []Fault
+	// SetFault replaces the fault of f.Dependency. It returns ErrInvalidInput for an unknown
+	// dependency, a negative latency or an error rate outside 0 to 1.
+	SetFault(f Fault) error
+}
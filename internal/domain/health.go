@@ -0,0 +1,25 @@
+package domain
+
+import "context"
+
+// Dependency statuses reported by readiness checks.
+const (
+	DependencyUp       = "up"
+	DependencyDegraded = "degraded"
+	DependencyDown     = "down"
+)
+
+// DependencyHealth is the state of one dependency as reported by GET /readyz.
+// swagger:model DependencyHealth
+type DependencyHealth struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	// Critical dependencies make the API not ready when down; others only degrade some features.
+	Critical bool   `json:"critical"`
+	Detail   string `json:"detail,omitempty"`
+}
+
+// ReadinessChecker reports the current health of one dependency.
+type ReadinessChecker interface {
+	CheckReadiness(ctx context.Context) DependencyHealth
+}
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// ImportMapping configures how a Sessionize import maps categories and rooms onto an event.
+// Category titles are matched case-insensitively. The zero value keeps the default behavior:
+// every category item becomes a tag and rooms keep their Sessionize names.
+// swagger:model ImportMapping
+type ImportMapping struct {
+	EventID string `json:"event_id"`
+	// TagCategories lists the categories whose items become session tags. Empty means every
+	// category not used for the session type or track.
+	TagCategories []string `json:"tag_categories"`
+	// SessionTypeCategory is the category whose item sets Session.SessionType (e.g. "Tipo de sesión").
+	SessionTypeCategory string `json:"session_type_category"`
+	// TrackCategory is the category whose item sets Session.Track.
+	TrackCategory string `json:"track_category"`
+	// RoomNameOverrides renames imported rooms, keyed by the Sessionize room name.
+	RoomNameOverrides map[string]string `json:"room_name_overrides"`
+	UpdatedAt         time.Time         `json:"updated_at"`
+}
+
+// NewImportMapping returns the default mapping for an event, used until the owner saves one.
+func NewImportMapping(eventID string) *ImportMapping {
+	return &ImportMapping{
+		EventID:           eventID,
+		TagCategories:     []string{},
+		RoomNameOverrides: map[string]string{},
+	}
+}
+
+// ImportMappingRepository defines the interface for per-event import mapping storage.
+type ImportMappingRepository interface {
+	// GetByEventID returns ErrNotFound when the event has no saved mapping.
+	GetByEventID(ctx context.Context, eventID string) (*ImportMapping, error)
+	// Upsert creates or replaces the event's mapping and sets UpdatedAt.
+	Upsert(ctx context.Context, mapping *ImportMapping) error
+}
@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Integrity issue kinds. Only the fixable kinds are changed by a cleanup; the others need a
+// decision from the event owner and are only reported.
+const (
+	// IssueUnusedTag is an event tag no session of the event uses. Cleanup unlinks it from the event.
+	IssueUnusedTag = "unused_tag"
+	// IssueUndeclaredSessionTag is a session tag missing from the event's tags. Cleanup adds it to the event.
+	IssueUndeclaredSessionTag = "undeclared_session_tag"
+	// IssueCrossEventSpeaker is a session linked to another event's speaker. Cleanup removes the link.
+	IssueCrossEventSpeaker = "cross_event_speaker"
+	// IssueUnassignedSpeaker is a speaker without sessions, e.g. after their sessions or room were deleted.
+	IssueUnassignedSpeaker = "unassigned_speaker"
+	// IssueEmptyRoom is a room without sessions.
+	IssueEmptyRoom = "empty_room"
+	// IssueInvalidTimeRange is a session that does not end after it starts.
+	IssueInvalidTimeRange = "invalid_time_range"
+	// IssueRoomOverlap is a pair of sessions scheduled in the same room at overlapping times.
+	IssueRoomOverlap = "room_overlap"
+)
+
+// IsFixableIssue reports whether a cleanup resolves issues of the given kind.
+func IsFixableIssue(kind string) bool {
+	switch kind {
+	case IssueUnusedTag, IssueUndeclaredSessionTag, IssueCrossEventSpeaker:
+		return true
+	}
+	return false
+}
+
+// IntegrityIssue is one orphan or inconsistency found in an event's data.
+// swagger:model IntegrityIssue
+type IntegrityIssue struct {
+	Kind string `json:"kind"`
+	// EntityID is the tag, session, speaker or room the issue is about.
+	EntityID string `json:"entity_id"`
+	// RelatedID is the other side of a pair: the tag of an undeclared session tag, the speaker of a
+	// cross-event link or the second session of an overlap.
+	RelatedID string `json:"related_id,omitempty"`
+	// Detail is a human-readable name for the entity, such as the tag name or session title.
+	Detail  string `json:"detail"`
+	Fixable bool   `json:"fixable"`
+}
+
+// IntegrityReport lists the issues found in one event.
+// swagger:model IntegrityReport
+type IntegrityReport struct {
+	EventID     string            `json:"event_id"`
+	GeneratedAt time.Time         `json:"generated_at"`
+	Issues      []*IntegrityIssue `json:"issues"`
+	// Fixable is the number of issues a cleanup would resolve.
+	Fixable int `json:"fixable"`
+}
+
+// IntegrityCleanup is the outcome of a cleanup. In a dry run nothing is changed and Issues lists
+// what would be fixed.
+// swagger:model IntegrityCleanup
+type IntegrityCleanup struct {
+	EventID string            `json:"event_id"`
+	DryRun  bool              `json:"dry_run"`
+	Issues  []*IntegrityIssue `json:"issues"`
+	// Changed is the number of rows the cleanup inserted or deleted; always 0 in a dry run.
+	Changed int64 `json:"changed"`
+}
+
+// IntegritySweep is the outcome of a periodic check across all events.
+type IntegritySweep struct {
+	EventsChecked int
+	// Reports holds only the events that have issues.
+	Reports []*IntegrityReport
+	// OrphanTagsDeleted counts tags no event or session referenced any more.
+	OrphanTagsDeleted int64
+}
+
+// IntegrityChecker runs the periodic integrity check.
+type IntegrityChecker interface {
+	// CheckAll reports every event's issues and deletes tags nothing references. It does not change
+	// event data; owners run the cleanup themselves.
+	CheckAll(ctx context.Context) (*IntegritySweep, error)
+}
+
+// IntegrityRepository finds and fixes orphaned or inconsistent schedule data.
+type IntegrityRepository interface {
+	FindIssues(ctx context.Context, eventID string) ([]*IntegrityIssue, error)
+	// Cleanup fixes the event's fixable issues in one transaction and returns the number of rows changed.
+	Cleanup(ctx context.Context, eventID string) (int64, error)
+	ListEventIDs(ctx context.Context) ([]string, error)
+	// DeleteOrphanTags deletes tags linked to no event and no session.
+	DeleteOrphanTags(ctx context.Context) (int64, error)
+}
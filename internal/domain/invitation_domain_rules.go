@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// MaxInvitationDomains is the most domains each of an event's allow and block lists may hold.
+const MaxInvitationDomains = 100
+
+// InvitationDomainRules restricts the email domains an event's invitations can be sent to. A domain
+// also covers its subdomains. Blocked domains win over allowed ones; with no allowed domains, every
+// domain that is not blocked is allowed, so the zero value allows any address.
+// swagger:model InvitationDomainRules
+type InvitationDomainRules struct {
+	EventID string `json:"event_id"`
+	// AllowedDomains, when not empty, are the only domains invitations can be sent to (e.g. "acme.com").
+	AllowedDomains []string `json:"allowed_domains"`
+	// BlockedDomains are domains invitations are never sent to (e.g. "gmail.com").
+	BlockedDomains []string  `json:"blocked_domains"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// NewInvitationDomainRules returns the rules of an event that has not saved any: every domain is allowed.
+func NewInvitationDomainRules(eventID string) *InvitationDomainRules {
+	return &InvitationDomainRules{
+		EventID:        eventID,
+		AllowedDomains: []string{},
+		BlockedDomains: []string{},
+	}
+}
+
+// Permits reports whether an invitation can be sent to email under the rules.
+func (r *InvitationDomainRules) Permits(email string) bool {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return false
+	}
+	domain := NormalizeEmailDomain(email[at+1:])
+	if matchesAnyDomain(domain, r.BlockedDomains) {
+		return false
+	}
+	return len(r.AllowedDomains) == 0 || matchesAnyDomain(domain, r.AllowedDomains)
+}
+
+func matchesAnyDomain(domain string, list []string) bool {
+	for _, d := range list {
+		if domain == d || strings.HasSuffix(domain, "."+d) {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizeEmailDomain lowercases a domain and strips surrounding spaces, a leading "@" and a trailing dot.
+func NormalizeEmailDomain(d string) string {
+	d = strings.ToLower(strings.TrimSpace(d))
+	d = strings.TrimPrefix(d, "@")
+	return strings.TrimSuffix(d, ".")
+}
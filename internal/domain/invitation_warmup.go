@@ -0,0 +1,181 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrWarmupCompleted is returned when pausing or resuming a warm-up that has sent every invitation.
+var ErrWarmupCompleted = errors.New("warm-up already completed")
+
+// Invitation warm-up statuses.
+const (
+	WarmupStatusActive    = "active"
+	WarmupStatusPaused    = "paused"
+	WarmupStatusCompleted = "completed"
+)
+
+// Invitation warm-up recipient statuses.
+const (
+	WarmupRecipientQueued  = "queued"
+	WarmupRecipientSent    = "sent"
+	WarmupRecipientFailed  = "failed"
+	WarmupRecipientSkipped = "skipped"
+)
+
+// Limits of invitation warm-ups.
+const (
+	// MaxWarmupDays is the most days a warm-up may be spread over, and the most daily volumes it may
+	// list.
+	MaxWarmupDays = 30
+	// MaxWarmupDailyVolume is the most invitations one day of a warm-up may send.
+	MaxWarmupDailyVolume = 100000
+)
+
+// DefaultWarmupDailyVolumes are the daily volumes of a warm-up that names neither days nor volumes:
+// a ramp commonly recommended for a new sending domain.
+var DefaultWarmupDailyVolumes = []int{50, 100, 250, 500, 1000, 2500, 5000}
+
+// WarmupDay is one day of a warm-up's schedule.
+// swagger:model WarmupDay
+type WarmupDay struct {
+	// Day counts from 1.
+	Day int `json:"day"`
+	// StartsAt is when the day's sending begins; its invitations are spread over the next 24 hours.
+	StartsAt time.Time `json:"starts_at"`
+	Volume   int       `json:"volume"`
+}
+
+// InvitationWarmup spreads a large invitation send over days, so that a new sending domain builds
+// its reputation before sending in volume. Day n sends at most DailyVolumes[n-1] invitations; past
+// the last entry its volume repeats.
+// swagger:model InvitationWarmup
+type InvitationWarmup struct {
+	// ID is empty in a dry run.
+	ID           string `json:"id,omitempty"`
+	EventID      string `json:"event_id"`
+	DryRun       bool   `json:"dry_run"`
+	Status       string `json:"status"`
+	DailyVolumes []int  `json:"daily_volumes"`
+	// StartsAt is when day 1 began. Resuming a paused warm-up moves it later by the time paused, so
+	// no day's volume is lost or doubled.
+	StartsAt time.Time  `json:"starts_at"`
+	PausedAt *time.Time `json:"paused_at,omitempty"`
+	Total    int        `json:"total"`
+	Sent     int        `json:"sent"`
+	Failed   int        `json:"failed"`
+	// Skipped counts addresses the event's invitation domain rules did not permit when their turn came.
+	Skipped int `json:"skipped"`
+	Queued  int `json:"queued"`
+	// Schedule lists every day of the warm-up with its volume.
+	Schedule    []*WarmupDay `json:"schedule"`
+	CreatedAt   time.Time    `json:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+	CompletedAt *time.Time   `json:"completed_at,omitempty"`
+}
+
+// Processed counts the recipients whose turn has come.
+func (w *InvitationWarmup) Processed() int {
+	return w.Sent + w.Failed + w.Skipped
+}
+
+// volume returns day's volume, counting days from 0.
+func (w *InvitationWarmup) volume(day int) int {
+	if len(w.DailyVolumes) == 0 {
+		return 0
+	}
+	if day >= len(w.DailyVolumes) {
+		return w.DailyVolumes[len(w.DailyVolumes)-1]
+	}
+	return w.DailyVolumes[day]
+}
+
+// Due returns how many recipients should have had their turn by now: the volumes of the days gone
+// by, plus the share of the current day's volume its elapsed time stands for. Never more than
+// Total, and zero while paused.
+func (w *InvitationWarmup) Due(now time.Time) int {
+	if w.Status != WarmupStatusActive || now.Before(w.StartsAt) {
+		return 0
+	}
+	elapsed := now.Sub(w.StartsAt)
+	days := int(elapsed / (24 * time.Hour))
+	due := 0
+	for d := 0; d < days && due < w.Total; d++ {
+		due += w.volume(d)
+	}
+	into := elapsed - time.Duration(days)*24*time.Hour
+	due += int(float64(w.volume(days)) * float64(into) / float64(24*time.Hour))
+	return min(due, w.Total)
+}
+
+// PlanSchedule sets Schedule to the days it takes to send Total invitations from StartsAt.
+func (w *InvitationWarmup) PlanSchedule() {
+	w.Schedule = []*WarmupDay{}
+	for d, left := 0, w.Total; left > 0 && w.volume(d) > 0; d++ {
+		v := min(w.volume(d), left)
+		w.Schedule = append(w.Schedule, &WarmupDay{Day: d + 1, StartsAt: w.StartsAt.Add(time.Duration(d) * 24 * time.Hour), Volume: v})
+		left -= v
+	}
+}
+
+// RampWarmupVolumes returns daily volumes that send total invitations in days, doubling every day.
+func RampWarmupVolumes(total, days int) []int {
+	if total <= 0 || days <= 0 {
+		return nil
+	}
+	first := (total + (1<<days - 2)) / (1<<days - 1)
+	volumes := make([]int, days)
+	for d := range volumes {
+		volumes[d] = min(first<<d, MaxWarmupDailyVolume)
+	}
+	return volumes
+}
+
+// WarmupRun is the outcome of one pass of the warm-up sender over the active warm-ups.
+type WarmupRun struct {
+	Warmups   int
+	Sent      int
+	Failed    int
+	Skipped   int
+	Completed int
+}
+
+// InvitationWarmupRepository stores warm-ups and their queued recipients.
+type InvitationWarmupRepository interface {
+	// Create stores the warm-up, queueing emails in order, and sets its ID and timestamps.
+	Create(ctx context.Context, w *InvitationWarmup, emails []string) error
+	// GetByID returns the warm-up with its recipient counts, or ErrNotFound.
+	GetByID(ctx context.Context, id string) (*InvitationWarmup, error)
+	// ListByEventID returns the event's warm-ups, newest first.
+	ListByEventID(ctx context.Context, eventID string) ([]*InvitationWarmup, error)
+	// ListActive returns every active warm-up, of every event.
+	ListActive(ctx context.Context) ([]*InvitationWarmup, error)
+	// UpdateStatus saves the warm-up's Status, StartsAt, PausedAt and CompletedAt.
+	UpdateStatus(ctx context.Context, w *InvitationWarmup) error
+	// NextQueued returns up to limit queued emails, in the order they were queued.
+	NextQueued(ctx context.Context, warmupID string, limit int) ([]string, error)
+	// MarkRecipients sets the status of the warm-up's recipients with the emails.
+	MarkRecipients(ctx context.Context, warmupID string, emails []string, status string) error
+}
+
+// InvitationWarmupService sends large invitation batches over days. Only the event owner manages an
+// event's warm-ups; ErrForbidden for anyone else.
+type InvitationWarmupService interface {
+	// CreateWarmup queues the emails to be invited over days. days spreads them over that many days,
+	// doubling the volume daily; dailyVolumes names each day's volume instead; with neither,
+	// DefaultWarmupDailyVolumes apply. With dryRun nothing is stored and the schedule is previewed.
+	CreateWarmup(ctx context.Context, eventID, ownerID string, emails []string, days int, dailyVolumes []int, dryRun bool) (*InvitationWarmup, error)
+	ListWarmups(ctx context.Context, eventID, ownerID string) ([]*InvitationWarmup, error)
+	GetWarmup(ctx context.Context, eventID, warmupID, ownerID string) (*InvitationWarmup, error)
+	// PauseWarmup stops sending until the warm-up is resumed; ErrWarmupCompleted once it is done.
+	PauseWarmup(ctx context.Context, eventID, warmupID, ownerID string) (*InvitationWarmup, error)
+	ResumeWarmup(ctx context.Context, eventID, warmupID, ownerID string) (*InvitationWarmup, error)
+	// CheckEmailDomain reports the SPF, DKIM and DMARC records of the domain the event's invitations
+	// are sent from; refresh skips the cached report once it is a minute old. ErrEmailDomainNotChecked
+	// when the server does not send through SES.
+	CheckEmailDomain(ctx context.Context, eventID, ownerID string, refresh bool) (*EmailDomainReport, error)
+	// SendDue sends every active warm-up's invitations that are due, and completes the warm-ups
+	// with none left. Run periodically.
+	SendDue(ctx context.Context) (*WarmupRun, error)
+}
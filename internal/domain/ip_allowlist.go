@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrIPNotAllowed is returned when a request comes from an address outside the IP allowlist of its
+// account or machine client.
+var ErrIPNotAllowed = errors.New("address not in the IP allowlist")
+
+// MaxIPAllowlistRanges is how many ranges one account's or machine client's allowlist may hold.
+const MaxIPAllowlistRanges = 50
+
+// IPAllowlist is the address ranges an account's authenticated requests, or a machine client's,
+// must come from. An empty list allows any address.
+// swagger:model IPAllowlist
+type IPAllowlist struct {
+	// UserID is set on an account's list, ClientID on a machine client's.
+	UserID   string `json:"user_id,omitempty"`
+	ClientID string `json:"client_id,omitempty"`
+	// Ranges are CIDR ranges; single addresses are kept as /32 (IPv4) or /128 (IPv6) ranges.
+	Ranges []string `json:"ranges"`
+}
+
+// IPAllowlistRepository stores each account's and each machine client's IP allowlist.
+type IPAllowlistRepository interface {
+	// Get returns the user's ranges, empty when the user has none.
+	Get(ctx context.Context, userID string) ([]string, error)
+	// Replace replaces the user's ranges in one transaction; an empty list removes them all.
+	Replace(ctx context.Context, userID string, ranges []string) error
+	// GetMachineClient and ReplaceMachineClient do the same for a machine client's ranges.
+	GetMachineClient(ctx context.Context, clientID string) ([]string, error)
+	ReplaceMachineClient(ctx context.Context, clientID string, ranges []string) error
+}
+
+// IPAllowlistChecker decides whether an account may be used from an address.
+type IPAllowlistChecker interface {
+	// CheckAddress returns ErrIPNotAllowed when ip is outside the user's allowlist, along with the
+	// allowlist so the caller can explain the refusal.
+	CheckAddress(ctx context.Context, userID, ip string) (*IPAllowlist, error)
+	// CheckMachineAddress does the same for a machine client, and returns ErrInvalidClient when the
+	// client has been revoked or deleted.
+	CheckMachineAddress(ctx context.Context, clientID, ip string) (*IPAllowlist, error)
+}
+
+// IPAllowlistService manages IP allowlists. Ranges are CIDR ranges or single addresses; malformed
+// ones, or more than MaxIPAllowlistRanges, return ErrInvalidInput.
+type IPAllowlistService interface {
+	IPAllowlistChecker
+	GetIPAllowlist(ctx context.Context, userID string) (*IPAllowlist, error)
+	// SetIPAllowlist replaces the user's own allowlist. It returns ErrInvalidInput when the new list
+	// leaves out clientIP, the address the change is made from, so nobody locks themselves out.
+	SetIPAllowlist(ctx context.Context, userID, clientIP string, ranges []string) (*IPAllowlist, error)
+	// GetUserIPAllowlist and SetUserIPAllowlist read and replace another account's allowlist, e.g.
+	// to let a locked-out user back in. They return ErrForbidden unless adminID has AdminRole and
+	// ErrUserNotFound when there is no such user.
+	GetUserIPAllowlist(ctx context.Context, adminID, userID string) (*IPAllowlist, error)
+	SetUserIPAllowlist(ctx context.Context, adminID, userID string, ranges []string) (*IPAllowlist, error)
+	// GetMachineClientIPAllowlist and SetMachineClientIPAllowlist read and replace a machine
+	// client's allowlist. They return ErrForbidden unless adminID has AdminRole and ErrNotFound
+	// when there is no such client.
+	GetMachineClientIPAllowlist(ctx context.Context, adminID, clientID string) (*IPAllowlist, error)
+	SetMachineClientIPAllowlist(ctx context.Context, adminID, clientID string, ranges []string) (*IPAllowlist, error)
+}
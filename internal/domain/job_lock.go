@@ -0,0 +1,12 @@
+package domain
+
+import "context"
+
+// JobLocker lets one API replica at a time run a periodic job, so jobs do not run twice when the
+// API is scaled out.
+type JobLocker interface {
+	// TryLock takes the named lock without waiting. acquired is false when another replica holds it.
+	// Otherwise release must be called once the job is done; the lock is also dropped if the
+	// holder dies.
+	TryLock(ctx context.Context, name string) (release func(), acquired bool, err error)
+}
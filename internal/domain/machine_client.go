@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrInvalidClient is returned when a machine client's ID and secret do not match an active client.
+var ErrInvalidClient = errors.New("invalid client credentials")
+
+// ErrInsufficientScope is returned when a machine token lacks the scope a route requires.
+var ErrInsufficientScope = errors.New("token lacks the required scope")
+
+// Machine token scopes. Each scope opens a narrow set of /machine routes.
+const (
+	// ScopeScheduleRead reads the schedule of the client's event, e.g. for signage and badge printers.
+	ScopeScheduleRead = "schedule:read"
+	// ScopeOccupancyWrite reports how many people are in the rooms of the client's event, e.g. for
+	// door sensors and manual clickers.
+	ScopeOccupancyWrite = "occupancy:write"
+	// ScopeSandboxWrite creates sandbox events for integrators to test against. A client with it is
+	// not bound to an event and may have no other scope.
+	ScopeSandboxWrite = "sandbox:write"
+)
+
+// MachineScopes lists every scope a machine client may be given.
+var MachineScopes = []string{ScopeScheduleRead, ScopeOccupancyWrite, ScopeSandboxWrite}
+
+// MachineTokenExpiry is how long an access token issued to a machine client is valid. Revoking a
+// client stops new tokens at once; tokens already issued run out within this time.
+const MachineTokenExpiry = 15 * time.Minute
+
+// MaxMachineClientNameLength is the longest name a machine client may have.
+const MaxMachineClientNameLength = 100
+
+// MachineClient is an internal service (badge printer, signage) that calls the API with its own
+// credentials instead of a user's. It is bound to one event and limited to its scopes.
+// swagger:model MachineClient
+type MachineClient struct {
+	// ID is the client ID sent with the secret to get a token.
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// EventID is empty for sandbox clients, which create their own events.
+	EventID string   `json:"event_id,omitempty"`
+	Scopes  []string `json:"scopes"`
+	// CreatedBy is the admin who created the client.
+	CreatedBy  string     `json:"created_by,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+// MachineClientCredentials is a newly created client with its secret. The secret is only ever
+// returned here; the server keeps a hash of it.
+// swagger:model MachineClientCredentials
+type MachineClientCredentials struct {
+	MachineClient
+	ClientSecret string `json:"client_secret"`
+}
+
+// MachineToken is an access token issued to a machine client, sent as "Authorization: Bearer".
+// swagger:model MachineToken
+type MachineToken struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	// ExpiresIn is the token's lifetime in seconds.
+	ExpiresIn int `json:"expires_in"`
+	// Scope is the token's scopes, space-separated.
+	Scope string `json:"scope"`
+}
+
+// MachinePrincipal is the machine client a verified access token was issued to.
+type MachinePrincipal struct {
+	ClientID string
+	EventID  string
+	Scopes   []string
+}
+
+// MachineClientRepository stores machine clients and their secret hashes.
+type MachineClientRepository interface {
+	Create(ctx context.Context, c *MachineClient, secretHash string) error
+	// GetByID returns the client and its secret hash, or ErrNotFound.
+	GetByID(ctx context.Context, id string) (*MachineClient, string, error)
+	// List returns every client, newest first.
+	List(ctx context.Context) ([]*MachineClient, error)
+	// Revoke sets the client's revoked_at if it is not revoked yet. Returns ErrNotFound.
+	Revoke(ctx context.Context, id string, at time.Time) (*MachineClient, error)
+	TouchLastUsed(ctx context.Context, id string, at time.Time) error
+}
+
+// MachineTokenIssuer signs access tokens for machine clients. They are not accepted where a
+// user's token is expected, nor the other way round.
+type MachineTokenIssuer interface {
+	IssueMachine(p *MachinePrincipal, expiry time.Duration) (string, error)
+}
+
+// MachineTokenVerifier verifies a machine access token and returns the client it was issued to.
+type MachineTokenVerifier interface {
+	VerifyMachine(token string) (*MachinePrincipal, error)
+}
+
+// MachineClientService manages machine clients and exchanges their credentials for tokens. The
+// admin methods return ErrForbidden unless adminID has AdminRole.
+type MachineClientService interface {
+	// CreateMachineClient returns ErrInvalidInput for an empty name or an unknown or missing scope,
+	// and ErrNotFound when the event does not exist. Clients with ScopeSandboxWrite take no event.
+	CreateMachineClient(ctx context.Context, adminID, name, eventID string, scopes []string) (*MachineClientCredentials, error)
+	ListMachineClients(ctx context.Context, adminID string) ([]*MachineClient, error)
+	// RevokeMachineClient returns ErrNotFound when there is no such client.
+	RevokeMachineClient(ctx context.Context, adminID, clientID string) (*MachineClient, error)
+	// IssueMachineToken returns ErrInvalidClient unless clientID and secret match a client that is
+	// not revoked.
+	IssueMachineToken(ctx context.Context, clientID, secret string) (*MachineToken, error)
+	// CreateSandboxEvent creates a sandbox event owned by the admin who created the client. Returns
+	// ErrInvalidInput for an empty name and ErrInvalidClient when the client is revoked or its
+	// creator's account is gone.
+	CreateSandboxEvent(ctx context.Context, clientID, name string) (*Event, error)
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// OfflineBundle is a zip archive of everything the attendee app shows for an event, so it can be
+// prefetched before arriving at a venue with bad connectivity.
+type OfflineBundle struct {
+	// EventID is the bundled event, used to tag the response for CDN purges.
+	EventID  string
+	Manifest *OfflineBundleManifest
+	// Archive is the zip file. It is nil when the caller already has this version.
+	Archive []byte
+}
+
+// OfflineBundleManifest describes a bundle; it is stored in the archive as manifest.json.
+// swagger:model OfflineBundleManifest
+type OfflineBundleManifest struct {
+	EventCode string `json:"event_code"`
+	// Version changes whenever the schedule, a speaker or a speaker photo URL changes; apps send it
+	// back as If-None-Match to skip unchanged downloads.
+	Version     string               `json:"version"`
+	GeneratedAt time.Time            `json:"generated_at"`
+	Files       []*OfflineBundleFile `json:"files"`
+}
+
+// OfflineBundleFile is one file of a bundle besides the manifest.
+// swagger:model OfflineBundleFile
+type OfflineBundleFile struct {
+	Path   string `json:"path"`
+	Size   int    `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// OfflineSchedule is the bundle's schedule.json: the attendee schedule with the speakers of its
+// sessions. The event's location and each room's directions are the bundle's venue maps.
+// swagger:model OfflineSchedule
+type OfflineSchedule struct {
+	Event    *Event                  `json:"event"`
+	Rooms    []*RoomWithSessions     `json:"rooms"`
+	Tracks   []string                `json:"tracks"`
+	Speakers []*OfflineBundleSpeaker `json:"speakers"`
+}
+
+// OfflineBundleSpeaker is the public profile of a speaker in a bundle.
+// swagger:model OfflineBundleSpeaker
+type OfflineBundleSpeaker struct {
+	ID           string `json:"id"`
+	FirstName    string `json:"first_name"`
+	LastName     string `json:"last_name"`
+	Bio          string `json:"bio"`
+	TagLine      string `json:"tag_line"`
+	IsTopSpeaker bool   `json:"is_top_speaker"`
+	// Photo is the path of the speaker's thumbnail in the archive, or empty when there is none.
+	Photo string `json:"photo"`
+	// CustomFields are the speaker's values for the event's public custom fields.
+	CustomFields []*CustomFieldValue `json:"custom_fields,omitempty"`
+}
+
+// ThumbnailFetcher downloads a picture and returns a small JPEG of it.
+type ThumbnailFetcher interface {
+	FetchThumbnail(ctx context.Context, url string) ([]byte, error)
+}
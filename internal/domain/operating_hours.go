@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// OperatingDay is when the venue is open on one day of an event: doors open at OpensAt and the
+// last session ends by ClosesAt. ClosesAt may fall after midnight for late programmes.
+// swagger:model OperatingDay
+type OperatingDay struct {
+	// Day is the event day, as "YYYY-MM-DD".
+	Day      string    `json:"day"`
+	OpensAt  time.Time `json:"opens_at"`
+	ClosesAt time.Time `json:"closes_at"`
+}
+
+// OperatingHoursRepository defines the interface for per-event operating hours storage.
+type OperatingHoursRepository interface {
+	// ListByEventID returns the event's operating days ordered by day; empty when none are set.
+	ListByEventID(ctx context.Context, eventID string) ([]*OperatingDay, error)
+	// Replace sets the event's operating days, removing any not in days.
+	Replace(ctx context.Context, eventID string, days []*OperatingDay) error
+}
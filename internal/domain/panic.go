@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// PanicReport is a panic recovered while serving a request.
+type PanicReport struct {
+	// RequestID is the request's correlation ID, as sent back in X-Request-ID.
+	RequestID string `json:"request_id"`
+	Method    string `json:"method"`
+	Path      string `json:"path"`
+	// Route is the pattern of the route that panicked; empty when no route matched.
+	Route string `json:"route,omitempty"`
+	// Value is the value passed to panic, formatted as text.
+	Value string    `json:"value"`
+	Stack string    `json:"stack"`
+	At    time.Time `json:"at"`
+}
+
+// ErrorReporter sends recovered panics to an error tracker such as Sentry.
+type ErrorReporter interface {
+	ReportPanic(ctx context.Context, report *PanicReport) error
+}
+
+// RoutePanics counts the panics recovered on one route.
+type RoutePanics struct {
+	Route string `json:"route"`
+	Count int64  `json:"count"`
+}
+
+// PanicStats counts the panics recovered since the server started.
+type PanicStats struct {
+	Total  int64         `json:"total"`
+	Routes []RoutePanics `json:"routes"`
+	// Last is the most recent panic, stack included.
+	Last *PanicReport `json:"last,omitempty"`
+}
+
+// PanicReporter provides the current panic counts.
+type PanicReporter interface {
+	PanicReport() PanicStats
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Kinds of public page: an event's schedule, and a page per session and per speaker.
+const (
+	PublicPageEvent   = "event"
+	PublicPageSession = "session"
+	PublicPageSpeaker = "speaker"
+)
+
+// MaxSitemapPages is the most URLs one sitemap may list under the sitemaps.org protocol.
+const MaxSitemapPages = 50000
+
+// PublicPage is a page of public content search engines may index.
+type PublicPage struct {
+	Kind      string
+	EventCode string
+	// ID is the event's, session's or speaker's ID.
+	ID string
+	// UpdatedAt is when the content last changed; zero when unknown.
+	UpdatedAt time.Time
+}
+
+// PublicPageRepository lists the content anyone may see across events.
+type PublicPageRepository interface {
+	// ListPublicPages returns the events, the sessions in their bookable rooms and the speakers of
+	// those sessions, leaving out what is unlisted at now. Events come first, then sessions, then
+	// speakers, each by event code; at most limit pages.
+	ListPublicPages(ctx context.Context, now time.Time, limit int) ([]*PublicPage, error)
+}
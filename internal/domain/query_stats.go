@@ -0,0 +1,46 @@
+package domain
+
+import "time"
+
+// QueryReport is a point-in-time view of repository call latencies, used by operators to find
+// slow queries without database access.
+type QueryReport struct {
+	GeneratedAt time.Time       `json:"generated_at"`
+	Methods     []MethodLatency `json:"methods"`
+	Slowest     []SlowQuery     `json:"slowest"`
+}
+
+// MethodLatency summarizes every call of one repository method (e.g. "SessionRepository.ListSessionsByEventID").
+// Percentiles are bucket upper bounds, so they over-estimate by at most one bucket.
+type MethodLatency struct {
+	Method  string          `json:"method"`
+	Calls   int64           `json:"calls"`
+	Errors  int64           `json:"errors"`
+	MeanMs  float64         `json:"mean_ms"`
+	P50Ms   float64         `json:"p50_ms"`
+	P95Ms   float64         `json:"p95_ms"`
+	P99Ms   float64         `json:"p99_ms"`
+	MaxMs   float64         `json:"max_ms"`
+	Buckets []LatencyBucket `json:"buckets"`
+}
+
+// LatencyBucket counts calls that took at most LeMs milliseconds and more than the previous
+// bucket's bound. The last bucket has LeMs 0 and counts everything slower.
+type LatencyBucket struct {
+	LeMs  float64 `json:"le_ms"`
+	Count int64   `json:"count"`
+}
+
+// SlowQuery is one recent repository call that took at least the slow-query threshold.
+// Arguments are not recorded since they may contain personal data.
+type SlowQuery struct {
+	Method     string    `json:"method"`
+	DurationMs float64   `json:"duration_ms"`
+	At         time.Time `json:"at"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// QueryReporter provides the current QueryReport.
+type QueryReporter interface {
+	QueryReport() QueryReport
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Data classes with a retention policy. Each names a kind of row the purge deletes once it is older
+// than the class's retention.
+const (
+	// RetentionSessionChanges is the session move history (audit data), aged by when the move happened.
+	RetentionSessionChanges = "session_changes"
+	// RetentionLoginCodes are passwordless login codes, aged by when they expired.
+	RetentionLoginCodes = "login_codes"
+	// RetentionPendingInvitations are invitations nobody registered with, aged by the last email sent.
+	RetentionPendingInvitations = "pending_invitations"
+	// RetentionResolvedSpeakerMatches are reviewed speaker match candidates, aged by when they were resolved.
+	RetentionResolvedSpeakerMatches = "resolved_speaker_matches"
+	// RetentionEventPersonalData are events not yet anonymized, aged by the event date. Purging them
+	// anonymizes them (see EventAnonymization) rather than deleting them.
+	RetentionEventPersonalData = "event_personal_data"
+	// RetentionEventEmails is the archive of emails sent on behalf of events, aged by when they were sent.
+	RetentionEventEmails = "event_emails"
+	// RetentionSandboxEvents are sandbox events and everything in them, aged by when they were created.
+	RetentionSandboxEvents = "sandbox_events"
+	// RetentionSpeakerPhotoArchives are speaker photo archives and their zips, aged by when they were
+	// requested.
+	RetentionSpeakerPhotoArchives = "speaker_photo_archives"
+)
+
+// RetentionPolicy keeps a data class's rows for Retention; older rows are purged.
+type RetentionPolicy struct {
+	DataClass string
+	Retention time.Duration
+}
+
+// RetentionClassReport is one data class in a RetentionReport.
+type RetentionClassReport struct {
+	DataClass     string `json:"data_class"`
+	RetentionDays int    `json:"retention_days"`
+	// Cutoff is the age limit: rows older than it are purged.
+	Cutoff time.Time `json:"cutoff"`
+	// Rows counts the rows older than Cutoff: due for the next purge in a report, deleted in a purge result.
+	Rows int64 `json:"rows"`
+}
+
+// RetentionReport covers every data class with a retention policy.
+type RetentionReport struct {
+	GeneratedAt time.Time               `json:"generated_at"`
+	Classes     []*RetentionClassReport `json:"classes"`
+}
+
+// RetentionRepository counts and deletes a data class's rows older than a cutoff.
+type RetentionRepository interface {
+	CountExpired(ctx context.Context, dataClass string, cutoff time.Time) (int64, error)
+	PurgeExpired(ctx context.Context, dataClass string, cutoff time.Time) (int64, error)
+}
+
+// RetentionService applies the configured retention policies.
+type RetentionService interface {
+	// Report returns what a purge run now would delete, without deleting anything.
+	Report(ctx context.Context) (*RetentionReport, error)
+	// Purge deletes every data class's expired rows and reports how many were deleted.
+	Purge(ctx context.Context) (*RetentionReport, error)
+}
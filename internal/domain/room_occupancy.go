@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Limits of room occupancy updates.
+const (
+	// MaxOccupancyDelta is the most people one update may add or remove, for sensors that report
+	// in batches.
+	MaxOccupancyDelta = 1000
+	// MaxOccupancy is the highest count a room may be set to.
+	MaxOccupancy = 100000
+)
+
+// Capacity alert thresholds, in percent of a room's capacity. The event owner is alerted once when
+// a room fills to CapacityAlertPercent, and can be alerted again after it has emptied below
+// CapacityRearmPercent, so a count wavering around the threshold does not alert every time.
+const (
+	CapacityAlertPercent = 90
+	CapacityRearmPercent = 75
+)
+
+// RoomOccupancy is how many people are in a room, as its door sensors and clickers last reported.
+// swagger:model RoomOccupancy
+type RoomOccupancy struct {
+	RoomID string `json:"room_id"`
+	Count  int    `json:"count"`
+	// Capacity is the room's capacity; 0 when it has none.
+	Capacity int `json:"capacity"`
+	// NearCapacity is set once Count reaches CapacityAlertPercent of Capacity.
+	NearCapacity bool      `json:"near_capacity"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	// AlertedAt is when the owner was alerted the room is near capacity; cleared once it empties
+	// below CapacityRearmPercent.
+	AlertedAt *time.Time `json:"alerted_at,omitempty"`
+}
+
+// SetCapacity sets Capacity and NearCapacity.
+func (o *RoomOccupancy) SetCapacity(capacity int) {
+	o.Capacity = capacity
+	o.NearCapacity = capacity > 0 && o.Count*100 >= capacity*CapacityAlertPercent
+}
+
+// Rearmed reports whether the room has emptied enough for its capacity alert to be sent again.
+func (o *RoomOccupancy) Rearmed() bool {
+	return o.Capacity == 0 || o.Count*100 < o.Capacity*CapacityRearmPercent
+}
+
+// RoomOccupancyRepository stores the current occupancy of rooms.
+type RoomOccupancyRepository interface {
+	// Add adds delta to the room's count, never going below zero, and returns the new occupancy.
+	// A room without occupancy yet starts at zero.
+	Add(ctx context.Context, eventID, roomID string, delta int) (*RoomOccupancy, error)
+	// Set sets the room's count and returns the new occupancy.
+	Set(ctx context.Context, eventID, roomID string, count int) (*RoomOccupancy, error)
+	// ListByEventID returns the occupancy of the event's rooms that have one.
+	ListByEventID(ctx context.Context, eventID string) ([]*RoomOccupancy, error)
+	// MarkAlerted sets the room's alerted_at to at unless it is set already, and reports whether it
+	// did, so that concurrent updates alert the owner once.
+	MarkAlerted(ctx context.Context, roomID string, at time.Time) (bool, error)
+	// ClearAlert clears the room's alerted_at.
+	ClearAlert(ctx context.Context, roomID string) error
+}
+
+// RoomCapacityAlertEmailData holds data for the email alerting the event owner that a room is
+// nearly full.
+type RoomCapacityAlertEmailData struct {
+	// EventID files the sent email in the event's email archive.
+	EventID   string
+	Email     string
+	OwnerName string
+	EventName string
+	RoomName  string
+	Count     int
+	Capacity  int
+}
+
+// RoomOccupancyService records the occupancy of rooms reported by the devices of an event.
+type RoomOccupancyService interface {
+	// UpdateOccupancy adds delta to the room's count, or sets it to count when count is not nil,
+	// and alerts the event owner when the room becomes nearly full. Returns ErrForbidden when the
+	// client is bound to another event, ErrNotFound when the room is not the event's, and
+	// ErrInvalidInput for a zero or out-of-range delta or count.
+	UpdateOccupancy(ctx context.Context, client *MachinePrincipal, eventID, roomID string, delta int, count *int) (*RoomOccupancy, error)
+}
@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// ScheduleGrid is an event's schedule laid out as days × rooms × time slots, ready to render. The
+// server rebuilds it whenever sessions, rooms, schedule rules or operating hours change, so clients
+// do not have to.
+// swagger:model ScheduleGrid
+type ScheduleGrid struct {
+	EventID string    `json:"event_id"`
+	BuiltAt time.Time `json:"built_at"`
+	// Timezone is the IANA time zone days are read in: the schedule rules' time zone, or UTC.
+	Timezone string              `json:"timezone"`
+	Rooms    []*ScheduleGridRoom `json:"rooms"`
+	// Tracks are the tracks of the sessions, in the order the owner set.
+	Tracks []string           `json:"tracks"`
+	Days   []*ScheduleGridDay `json:"days"`
+	// Unscheduled lists the sessions without a room, ordered by start time.
+	Unscheduled []*ScheduleGridSession `json:"unscheduled"`
+}
+
+// ScheduleGridRoom is a column of the grid. Every day has a column for every room, in this order.
+// swagger:model ScheduleGridRoom
+type ScheduleGridRoom struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Capacity    int    `json:"capacity"`
+	NotBookable bool   `json:"not_bookable"`
+}
+
+// ScheduleGridDay is one event day of the grid. A session belongs to the operating day it starts in,
+// or else to the date it starts on in the grid's time zone.
+// swagger:model ScheduleGridDay
+type ScheduleGridDay struct {
+	// Day is the date as YYYY-MM-DD.
+	Day string `json:"day"`
+	// Start and End bound the day's rows: the operating hours when set, widened to fit every session.
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+	// Slots are the times a row begins: Start, every session start and end, and End, in order.
+	Slots   []time.Time           `json:"slots"`
+	Columns []*ScheduleGridColumn `json:"columns"`
+}
+
+// ScheduleGridColumn is one room on one day.
+// swagger:model ScheduleGridColumn
+type ScheduleGridColumn struct {
+	RoomID   string                 `json:"room_id"`
+	Sessions []*ScheduleGridSession `json:"sessions"`
+	// Gaps are the free stretches of the room between the day's Start and End.
+	Gaps []*ScheduleGridGap `json:"gaps"`
+	// Overlaps are the pairs of sessions in the room that run at the same time.
+	Overlaps []*ScheduleGridOverlap `json:"overlaps"`
+}
+
+// ScheduleGridSession is a session placed in the grid.
+// swagger:model ScheduleGridSession
+type ScheduleGridSession struct {
+	SessionID string    `json:"session_id"`
+	Title     string    `json:"title"`
+	Track     string    `json:"track"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	// Slot is the index in the day's Slots the session starts at and Span the number of slots it
+	// covers. Both are 0 for unscheduled sessions; Span is also 0 for a session that does not end
+	// after it starts.
+	Slot int `json:"slot"`
+	Span int `json:"span"`
+	// Lane is 0 unless the session overlaps an earlier one in the room; overlapping sessions are
+	// placed side by side in lanes 0, 1, ...
+	Lane int `json:"lane"`
+}
+
+// ScheduleGridGap is a stretch of time a room has no session.
+// swagger:model ScheduleGridGap
+type ScheduleGridGap struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// ScheduleGridOverlap is two sessions in the same room at the same time.
+// swagger:model ScheduleGridOverlap
+type ScheduleGridOverlap struct {
+	SessionID        string    `json:"session_id"`
+	RelatedSessionID string    `json:"related_session_id"`
+	Start            time.Time `json:"start"`
+	End              time.Time `json:"end"`
+}
+
+// ScheduleGridRepository stores the schedule grid of each event.
+type ScheduleGridRepository interface {
+	// Get returns the event's stored grid, or ErrNotFound when none is stored.
+	Get(ctx context.Context, eventID string) (*ScheduleGrid, error)
+	// Save stores the grid, replacing the event's previous one.
+	Save(ctx context.Context, grid *ScheduleGrid) error
+	// Delete removes the event's stored grid; deleting a missing grid does nothing.
+	Delete(ctx context.Context, eventID string) error
+}
@@ -0,0 +1,63 @@
+package domain
+
+import "time"
+
+// Groupings of GET /events/{eventID}/schedule/overlaps.
+const (
+	OverlapGroupByTag   = "tag"
+	OverlapGroupByTrack = "track"
+)
+
+// Bucket sizes of GET /events/{eventID}/schedule/overlaps, in minutes. The size must divide a
+// day, so buckets line up with the hour.
+const (
+	DefaultOverlapBucketMinutes = 30
+	MinOverlapBucketMinutes     = 5
+	MaxOverlapBucketMinutes     = 240
+)
+
+// ScheduleOverlaps is which sessions of an event run in parallel, cut into time buckets and
+// grouped by tag or track, so program chairs can spot two talks on one topic at once.
+// swagger:model ScheduleOverlaps
+type ScheduleOverlaps struct {
+	EventID string `json:"event_id"`
+	// GroupBy is tag or track.
+	GroupBy       string `json:"group_by"`
+	BucketMinutes int    `json:"bucket_minutes"`
+	// Buckets are the buckets with at least one session running, in time order.
+	Buckets []*OverlapBucket `json:"buckets"`
+}
+
+// OverlapBucket is one stretch of time and the sessions running in it.
+// swagger:model OverlapBucket
+type OverlapBucket struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+	// SessionCount is how many sessions run in the bucket; a session counts once even when it is
+	// in several groups.
+	SessionCount int `json:"session_count"`
+	// Groups are the groups with a session in the bucket, the most sessions first; the sessions
+	// without a tag or track come last.
+	Groups []*OverlapGroup `json:"groups"`
+}
+
+// OverlapGroup is the sessions of one tag or track running in a bucket.
+// swagger:model OverlapGroup
+type OverlapGroup struct {
+	// Key is the tag name or track; empty for the sessions without one. A session with several
+	// tags is in the group of each.
+	Key      string            `json:"key"`
+	Sessions []*OverlapSession `json:"sessions"`
+	// Clash is set when more than one session of a named group runs in the bucket.
+	Clash bool `json:"clash"`
+}
+
+// OverlapSession is a session running in a bucket.
+// swagger:model OverlapSession
+type OverlapSession struct {
+	SessionID string    `json:"session_id"`
+	Title     string    `json:"title"`
+	RoomID    string    `json:"room_id"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrScheduleRuleViolation is returned when a session's time slot breaks the event's schedule rules.
+var ErrScheduleRuleViolation = errors.New("schedule rule violation")
+
+// ErrSessionTimeConflict is returned when a session's time slot overlaps another session in its room.
+var ErrSessionTimeConflict = errors.New("session time conflict")
+
+// ScheduleRules restricts when an event's sessions can be scheduled. Empty fields impose no limit,
+// so the zero value allows any time slot.
+// swagger:model ScheduleRules
+type ScheduleRules struct {
+	EventID string `json:"event_id"`
+	// AllowedDurations lists the allowed session lengths in minutes (e.g. 30, 45, 60).
+	AllowedDurations []int `json:"allowed_durations"`
+	// EarliestStart is the earliest time of day a session may start, as "HH:MM".
+	EarliestStart string `json:"earliest_start"`
+	// LatestEnd is the latest time of day a session may end, as "HH:MM".
+	LatestEnd string `json:"latest_end"`
+	// AllowedDays lists the dates sessions may take place on, as "YYYY-MM-DD".
+	AllowedDays []string `json:"allowed_days"`
+	// Timezone is the IANA time zone times of day and dates are read in (e.g. "Europe/Madrid"); empty means UTC.
+	Timezone  string    `json:"timezone"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewScheduleRules returns the rules of an event that has not saved any: every time slot is allowed.
+func NewScheduleRules(eventID string) *ScheduleRules {
+	return &ScheduleRules{
+		EventID:          eventID,
+		AllowedDurations: []int{},
+		AllowedDays:      []string{},
+	}
+}
+
+// ScheduleRulesRepository defines the interface for per-event schedule rules storage.
+type ScheduleRulesRepository interface {
+	// GetByEventID returns ErrNotFound when the event has no saved rules.
+	GetByEventID(ctx context.Context, eventID string) (*ScheduleRules, error)
+	// Upsert creates or replaces the event's rules and sets UpdatedAt.
+	Upsert(ctx context.Context, rules *ScheduleRules) error
+}
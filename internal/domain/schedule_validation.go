@@ -0,0 +1,52 @@
+package domain
+
+import "time"
+
+// Schedule validation categories. A validation reports every category, empty when it passed.
+const (
+	// ValidationRoomOverlap is a pair of sessions in the same room at overlapping times.
+	ValidationRoomOverlap = "room_overlap"
+	// ValidationSpeakerClash is a speaker in two sessions at overlapping times.
+	ValidationSpeakerClash = "speaker_clash"
+	// ValidationCapacity is a room holding sessions without a capacity set.
+	ValidationCapacity = "capacity"
+	// ValidationOperatingHours is a session outside the event's operating hours.
+	ValidationOperatingHours = "operating_hours"
+	// ValidationScheduleRules is a session breaking the event's schedule rules or ending before it starts.
+	ValidationScheduleRules = "schedule_rules"
+)
+
+// ValidationCategories lists the schedule validation categories in report order.
+var ValidationCategories = []string{
+	ValidationRoomOverlap,
+	ValidationSpeakerClash,
+	ValidationCapacity,
+	ValidationOperatingHours,
+	ValidationScheduleRules,
+}
+
+// ScheduleProblem is one conflict or broken constraint in an event's schedule.
+// swagger:model ScheduleProblem
+type ScheduleProblem struct {
+	// SessionID is the session the problem is about; empty for a room's capacity.
+	SessionID string `json:"session_id,omitempty"`
+	// RelatedSessionID is the second session of an overlap or clash.
+	RelatedSessionID string `json:"related_session_id,omitempty"`
+	RoomID           string `json:"room_id,omitempty"`
+	SpeakerID        string `json:"speaker_id,omitempty"`
+	Message          string `json:"message"`
+}
+
+// ScheduleValidation is the consolidated result of every conflict and constraint check on an
+// event's schedule.
+// swagger:model ScheduleValidation
+type ScheduleValidation struct {
+	EventID     string    `json:"event_id"`
+	GeneratedAt time.Time `json:"generated_at"`
+	// Valid is true when no check found a problem.
+	Valid           bool `json:"valid"`
+	SessionsChecked int  `json:"sessions_checked"`
+	ProblemCount    int  `json:"problem_count"`
+	// Categories maps every category in ValidationCategories to its problems.
+	Categories map[string][]*ScheduleProblem `json:"categories"`
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrSchemaTooNew is returned for writes while the database schema is newer than this build
+// supports, as during a rolling deploy whose migrations ran before every old server was replaced.
+var ErrSchemaTooNew = errors.New("database schema is newer than this server supports")
+
+// SchemaStatus is the database's migration state, as recorded by migrate.
+type SchemaStatus struct {
+	Version int64
+	// Dirty is set while a migration runs, and stays set when one fails part way.
+	Dirty bool
+}
+
+// SchemaRepository reads the database's migration state.
+type SchemaRepository interface {
+	SchemaStatus(ctx context.Context) (*SchemaStatus, error)
+}
+
+// SchemaGuard keeps this build's writes away from a schema it was not written for. As a readiness
+// check it reports the schema as degraded while writes are refused.
+type SchemaGuard interface {
+	// AllowWrites returns ErrSchemaTooNew while the database is past the supported version.
+	AllowWrites(ctx context.Context) error
+	ReadinessChecker
+}
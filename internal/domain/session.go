@@ -6,20 +6,23 @@ import (
 	"time"
 )
 
-// Room represents a physical room or track at the event
+// Room represents a physical room at the event; tracks are the sessions' Track field
 // swagger:model Room
 type Room struct {
-	ID              string    `json:"id"`
-	EventID         string    `json:"event_id"`
-	Name            string    `json:"name"`
-	SourceSessionID int       `json:"source_session_id"`
-	Source          string    `json:"source"`
-	NotBookable     bool      `json:"not_bookable"`
-	Capacity        int       `json:"capacity"`
-	Description     string    `json:"description"`
-	HowToGetThere   string    `json:"how_to_get_there"`
-	CreatedAt       time.Time `json:"created_at"`
-	UpdatedAt       time.Time `json:"updated_at"`
+	ID              string `json:"id"`
+	EventID         string `json:"event_id"`
+	Name            string `json:"name"`
+	SourceSessionID int    `json:"source_session_id"`
+	Source          string `json:"source"`
+	NotBookable     bool   `json:"not_bookable"`
+	Capacity        int    `json:"capacity"`
+	Description     string `json:"description"`
+	HowToGetThere   string `json:"how_to_get_there"`
+	// DisplayOrder is the room's position in the event's schedules, starting at 1; rooms are listed
+	// by it, then by name. New rooms go last.
+	DisplayOrder int       `json:"display_order"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
 }
 
 // NewRoom returns a new Room with the given fields. ID is typically set by the repository on create.
@@ -39,10 +42,22 @@ func NewRoom(eventID, name string, sourceSessionID int, source string, notBookab
 	}
 }
 
+// Room deletion modes decide what happens to the sessions of a deleted room.
+const (
+	// RoomDeleteCascade deletes the sessions together with the room.
+	RoomDeleteCascade = "cascade"
+	// RoomDeleteReassign moves the sessions to another room of the event.
+	RoomDeleteReassign = "reassign"
+	// RoomDeleteUnschedule keeps the sessions without a room.
+	RoomDeleteUnschedule = "unschedule"
+)
+
 // Session represents a conference session or talk
 // swagger:model Session
 type Session struct {
-	ID              string    `json:"id"`
+	ID      string `json:"id"`
+	EventID string `json:"event_id"`
+	// RoomID is empty while the session is unscheduled (e.g. its room was deleted).
 	RoomID          string    `json:"room_id"`
 	SourceSessionID string    `json:"source_session_id"`
 	Source          string    `json:"source"`
@@ -50,17 +65,25 @@ type Session struct {
 	StartTime       time.Time `json:"start_time"`
 	EndTime         time.Time `json:"end_time"`
 	Description     string    `json:"description"`
+	SessionType     string    `json:"session_type"`
+	Track           string    `json:"track"`
 	// Tags are the tags associated with this session. Each tag includes both its ID and name.
 	Tags       []*Tag   `json:"tags"`
 	SpeakerIDs []string `json:"speaker_ids"`
-	CreatedAt  time.Time `json:"created_at"`
-	UpdatedAt  time.Time `json:"updated_at"`
+	// CustomFields are the session's values for the event's custom fields; attendees only get
+	// those of public fields.
+	CustomFields []*CustomFieldValue `json:"custom_fields,omitempty"`
+	// StreamLinks are the session's caption and translation streams; attendees get those visible
+	// at the time of the request.
+	StreamLinks []*SessionStreamLink `json:"stream_links,omitempty"`
+	CreatedAt   time.Time            `json:"created_at"`
+	UpdatedAt   time.Time            `json:"updated_at"`
 }
 
 // NewSession returns a new Session with the given fields. ID is typically set by the repository on create.
 // Tag names may be provided; the repository and tag repository are responsible for persisting tag links and
 // hydrating full Tag objects (including IDs) when sessions are loaded from the database.
-func NewSession(roomID, sourceSessionID, source, title, description string, startTime, endTime time.Time, tags []string, createdAt, updatedAt time.Time) *Session {
+func NewSession(eventID, roomID, sourceSessionID, source, title, description string, startTime, endTime time.Time, tags []string, createdAt, updatedAt time.Time) *Session {
 	var tagObjs []*Tag
 	for _, name := range tags {
 		name = strings.TrimSpace(name)
@@ -70,6 +93,7 @@ func NewSession(roomID, sourceSessionID, source, title, description string, star
 		tagObjs = append(tagObjs, &Tag{Name: name})
 	}
 	return &Session{
+		EventID:         eventID,
 		RoomID:          roomID,
 		SourceSessionID: sourceSessionID,
 		Source:          source,
@@ -91,12 +115,35 @@ type SessionRepository interface {
 	CreateSpeaker(ctx context.Context, speaker *Speaker) error
 	CreateSessionSpeaker(ctx context.Context, sessionID, speakerID string) error
 	DeleteSessionSpeaker(ctx context.Context, sessionID, speakerID string) error
-	DeleteScheduleByEventID(ctx context.Context, eventID string) error
-	DeleteSpeakersByEventID(ctx context.Context, eventID string) error
+	// SetSessionSpeakers replaces the session's speaker links with speakerIDs.
+	SetSessionSpeakers(ctx context.Context, sessionID string, speakerIDs []string) error
+	// PruneScheduleByEventID deletes the event's rooms and sessions whose IDs are not in keepRoomIDs
+	// and keepSessionIDs. Imports call it after upserting so unchanged rows keep their IDs.
+	PruneScheduleByEventID(ctx context.Context, eventID string, keepRoomIDs, keepSessionIDs []string) error
+	// PruneSpeakersByEventID deletes the event's speakers whose IDs are not in keepSpeakerIDs.
+	PruneSpeakersByEventID(ctx context.Context, eventID string, keepSpeakerIDs []string) error
+	// UpdateSpeakerSource changes the external source and ID a speaker is matched by on import.
+	UpdateSpeakerSource(ctx context.Context, speakerID, source, sourceSessionID string) error
+	// MergeSpeakers moves dropID's session links to keepID, gives keepID dropID's external source
+	// and profile, and deletes dropID.
+	MergeSpeakers(ctx context.Context, keepID, dropID string) error
+	// UpdateSpeakers applies the updates in one transaction and returns the updated speakers in the
+	// same order. It returns ErrNotFound, and changes nothing, when a speaker does not exist.
+	UpdateSpeakers(ctx context.Context, updates []*SpeakerUpdate) ([]*Speaker, error)
 	GetSessionByID(ctx context.Context, sessionID string) (*Session, error)
 	GetRoomByID(ctx context.Context, roomID string) (*Room, error)
+	// ListRoomsByEventID returns the event's rooms in display order, then by name.
 	ListRoomsByEventID(ctx context.Context, eventID string) ([]*Room, error)
+	// ReorderRooms sets the display order of the event's rooms to their position in roomIDs.
+	ReorderRooms(ctx context.Context, eventID string, roomIDs []string) error
+	// ListTrackOrder returns the track names last given to ReorderTracks for the event, in order.
+	ListTrackOrder(ctx context.Context, eventID string) ([]string, error)
+	// ReorderTracks replaces the event's track order with tracks.
+	ReorderTracks(ctx context.Context, eventID string, tracks []string) error
 	ListSessionsByEventID(ctx context.Context, eventID string) ([]*Session, error)
+	// StreamSessionsByEventID calls fn for every session of the event (with tags and speaker IDs) as
+	// rows are read from the database cursor. It stops at the first error fn returns.
+	StreamSessionsByEventID(ctx context.Context, eventID string, fn func(*Session) error) error
 	ListSpeakerIDsBySessionIDs(ctx context.Context, sessionIDs []string) (map[string][]string, error)
 	GetSpeakerByID(ctx context.Context, speakerID string) (*Speaker, error)
 	ListSpeakersByEventID(ctx context.Context, eventID string) ([]*Speaker, error)
@@ -106,7 +153,11 @@ type SessionRepository interface {
 	DeleteSpeaker(ctx context.Context, speakerID string) error
 	SetRoomNotBookable(ctx context.Context, roomID string, notBookable bool) (*Room, error)
 	UpdateRoomDetails(ctx context.Context, roomID string, name string, capacity int, description, howToGetThere string, notBookable bool) (*Room, error)
+	// DeleteRoom deletes the room and, by cascade, its sessions.
 	DeleteRoom(ctx context.Context, roomID string) error
+	// DeleteRoomKeepSessions moves the room's sessions to targetRoomID, or unschedules them when it
+	// is nil, and deletes the room in one transaction.
+	DeleteRoomKeepSessions(ctx context.Context, roomID string, targetRoomID *string) error
 	DeleteSession(ctx context.Context, sessionID string) error
 	UpdateSessionSchedule(ctx context.Context, sessionID string, roomID *string, startTime, endTime *time.Time) (*Session, error)
 	UpdateSessionContent(ctx context.Context, sessionID string, title *string, description *string) (*Session, error)
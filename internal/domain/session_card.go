@@ -0,0 +1,46 @@
+package domain
+
+import "time"
+
+// Size of session preview images in pixels: the 1.91:1 Open Graph recommends.
+const (
+	SessionCardWidth  = 1200
+	SessionCardHeight = 630
+)
+
+// SessionCard is what a shared link to a session shows: its title, time, room and speakers, and
+// the event with its theme.
+type SessionCard struct {
+	SessionID string
+	EventID   string
+	EventName string
+	EventCode string
+	Title     string
+	// Speakers are the full names of the session's listed speakers.
+	Speakers  []string
+	Room      string
+	StartTime time.Time
+	EndTime   time.Time
+	Theme     *EventTheme
+}
+
+// SessionCardImage is a session's rendered preview image.
+type SessionCardImage struct {
+	// EventID is the session's event, used to tag the response for CDN purges.
+	EventID string
+	PNG     []byte
+}
+
+// SessionCardRenderer draws a session's preview image, SessionCardWidth by SessionCardHeight.
+type SessionCardRenderer interface {
+	RenderSessionCard(card *SessionCard) ([]byte, error)
+}
+
+// When returns the session's time in UTC, e.g. "Thu 14 May 2026, 09:00–10:00 UTC", or "" while it
+// has none.
+func (c *SessionCard) When() string {
+	if c.StartTime.IsZero() {
+		return ""
+	}
+	return c.StartTime.UTC().Format("Mon 2 Jan 2006, 15:04") + "–" + c.EndTime.UTC().Format("15:04") + " UTC"
+}
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// MaxScheduleChanges is the most schedule changes returned by one feed request.
+const MaxScheduleChanges = 200
+
+// SessionChange records a session moving to another room or time slot. Room names are the names
+// at the time of the move; a room ID is empty while the session is unscheduled.
+// swagger:model SessionChange
+type SessionChange struct {
+	ID        string `json:"id"`
+	EventID   string `json:"event_id"`
+	SessionID string `json:"session_id"`
+	// SessionTitle is the session's current title, filled in when changes are listed.
+	SessionTitle  string    `json:"session_title"`
+	FromRoomID    string    `json:"from_room_id"`
+	FromRoomName  string    `json:"from_room_name"`
+	ToRoomID      string    `json:"to_room_id"`
+	ToRoomName    string    `json:"to_room_name"`
+	FromStartTime time.Time `json:"from_start_time"`
+	FromEndTime   time.Time `json:"from_end_time"`
+	ToStartTime   time.Time `json:"to_start_time"`
+	ToEndTime     time.Time `json:"to_end_time"`
+	ChangedAt     time.Time `json:"changed_at"`
+}
+
+// RoomChanged reports whether the session moved to another room.
+func (c *SessionChange) RoomChanged() bool {
+	return c.FromRoomID != c.ToRoomID
+}
+
+// TimeChanged reports whether the session moved to another time slot.
+func (c *SessionChange) TimeChanged() bool {
+	return !c.FromStartTime.Equal(c.ToStartTime) || !c.FromEndTime.Equal(c.ToEndTime)
+}
+
+// ScheduleSnapshot is an event's schedule rebuilt as attendees saw it at AsOf, laid out as
+// EventSchedule. The history holds room and time moves only, so what it cannot undo is listed
+// instead and Partial is set.
+// swagger:model ScheduleSnapshot
+type ScheduleSnapshot struct {
+	Event  *Event              `json:"event"`
+	Rooms  []*RoomWithSessions `json:"rooms"`
+	Tracks []string            `json:"tracks"`
+	AsOf   time.Time           `json:"as_of"`
+	// Partial is set when the snapshot may differ from what attendees saw: some session was deleted
+	// or edited since AsOf.
+	Partial bool `json:"partial"`
+	// DeletedSessionIDs are the sessions deleted since AsOf. Those that existed then are missing.
+	DeletedSessionIDs []string `json:"deleted_session_ids"`
+	// EditedSessionIDs are the sessions whose last update since AsOf was not a recorded move, e.g. a
+	// new title or a Sessionize import, which saves every session again. They are in their rewound
+	// room and time slot with their current fields.
+	EditedSessionIDs []string `json:"edited_session_ids"`
+}
+
+// SessionChangeRepository defines storage for session room and time moves.
+type SessionChangeRepository interface {
+	// Create stores the change and sets its ID and ChangedAt.
+	Create(ctx context.Context, change *SessionChange) error
+	// ListBySessionID returns the session's changes, oldest first.
+	ListBySessionID(ctx context.Context, sessionID string) ([]*SessionChange, error)
+	// ListByEventID returns the event's changes made after since, newest first, at most limit of them.
+	ListByEventID(ctx context.Context, eventID string, since time.Time, limit int) ([]*SessionChange, error)
+	// ListAfter returns all of the event's changes made after after, oldest first.
+	ListAfter(ctx context.Context, eventID string, after time.Time) ([]*SessionChange, error)
+	// ListDeletedSessionIDs returns the IDs of the event's sessions deleted after after, oldest
+	// deletion first.
+	ListDeletedSessionIDs(ctx context.Context, eventID string, after time.Time) ([]string, error)
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// MaxChecklistItems is the most checklist items an event can have.
+const MaxChecklistItems = 50
+
+// ChecklistItem is one thing every session of an event needs before it is ready, such as
+// "Slides received".
+// swagger:model ChecklistItem
+type ChecklistItem struct {
+	ID       string `json:"id"`
+	EventID  string `json:"event_id"`
+	Label    string `json:"label"`
+	Position int    `json:"position"`
+}
+
+// ChecklistCompletion records that a team member ticked an item for a session.
+type ChecklistCompletion struct {
+	SessionID string
+	ItemID    string
+	// CompletedBy is the user who ticked the item; empty once that user is deleted.
+	CompletedBy string
+	CompletedAt time.Time
+}
+
+// SessionChecklistItem is an item of one session's checklist.
+// swagger:model SessionChecklistItem
+type SessionChecklistItem struct {
+	ItemID string `json:"item_id"`
+	Label  string `json:"label"`
+	Done   bool   `json:"done"`
+	// CompletedBy and CompletedByName are the team member who ticked the item.
+	CompletedBy     string     `json:"completed_by,omitempty"`
+	CompletedByName string     `json:"completed_by_name,omitempty"`
+	CompletedAt     *time.Time `json:"completed_at,omitempty"`
+}
+
+// SessionChecklist is one session's checklist. A session is ready when every item is done.
+// swagger:model SessionChecklist
+type SessionChecklist struct {
+	SessionID string                  `json:"session_id"`
+	Title     string                  `json:"title"`
+	RoomID    string                  `json:"room_id"`
+	StartTime time.Time               `json:"start_time"`
+	Done      int                     `json:"done"`
+	Total     int                     `json:"total"`
+	Ready     bool                    `json:"ready"`
+	Items     []*SessionChecklistItem `json:"items"`
+}
+
+// ChecklistMemberProgress counts the items a team member ticked across the event.
+// swagger:model ChecklistMemberProgress
+type ChecklistMemberProgress struct {
+	UserID    string `json:"user_id"`
+	Name      string `json:"name"`
+	Completed int    `json:"completed"`
+}
+
+// ChecklistProgress is the checklist progress of an event's sessions.
+// swagger:model ChecklistProgress
+type ChecklistProgress struct {
+	EventID       string                     `json:"event_id"`
+	Items         []*ChecklistItem           `json:"items"`
+	SessionsTotal int                        `json:"sessions_total"`
+	SessionsReady int                        `json:"sessions_ready"`
+	Members       []*ChecklistMemberProgress `json:"members"`
+	// Sessions are ordered by start time and may be filtered by readiness; the totals above are not.
+	Sessions []*SessionChecklist `json:"sessions"`
+}
+
+// ChecklistRepository defines storage for event checklists and their completions.
+type ChecklistRepository interface {
+	// ListItems returns the event's checklist items in order.
+	ListItems(ctx context.Context, eventID string) ([]*ChecklistItem, error)
+	// ReplaceItems sets the event's items to labels, in order. Items whose label is kept keep their
+	// ID and completions; the others are deleted with theirs.
+	ReplaceItems(ctx context.Context, eventID string, labels []string) ([]*ChecklistItem, error)
+	// ListCompletions returns the completions of every item of the event.
+	ListCompletions(ctx context.Context, eventID string) ([]*ChecklistCompletion, error)
+	// SetCompletion ticks the item for the session. An item already ticked keeps who ticked it first.
+	SetCompletion(ctx context.Context, completion *ChecklistCompletion) error
+	// DeleteCompletion unticks the item for the session; unticking an open item does nothing.
+	DeleteCompletion(ctx context.Context, sessionID, itemID string) error
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrEnrichmentUnavailable is returned when no enrichment provider is configured, or the
+// configured one fails.
+var ErrEnrichmentUnavailable = errors.New("session suggestions unavailable")
+
+// MaxSuggestedTags is the most tags suggested for one session. MaxSuggestedSummaryLength is the
+// longest summary suggested, in characters.
+const (
+	MaxSuggestedTags          = 5
+	MaxSuggestedSummaryLength = 500
+)
+
+// EnrichmentInput is what a SessionEnricher is told about a session. EventTags are the tags the
+// event already uses, which it should prefer over new ones. WantTags and WantSummary say what to
+// suggest.
+type EnrichmentInput struct {
+	EventName   string
+	Title       string
+	Description string
+	SessionType string
+	Track       string
+	EventTags   []string
+	WantTags    bool
+	WantSummary bool
+}
+
+// EnrichmentOutput is what a SessionEnricher suggests. Either field may be empty.
+type EnrichmentOutput struct {
+	Tags    []string
+	Summary string
+}
+
+// SessionEnricher suggests tags and a short summary for a session, typically with a language
+// model.
+type SessionEnricher interface {
+	EnrichSession(ctx context.Context, in *EnrichmentInput) (*EnrichmentOutput, error)
+}
+
+// SuggestedTag is a suggested tag name, with the ID of the event's tag of that name when it
+// already has one.
+type SuggestedTag struct {
+	Name  string `json:"name"`
+	TagID string `json:"tag_id,omitempty"`
+}
+
+// SessionSuggestion is what is suggested for a session lacking tags or a description. Nothing is
+// saved until the owner applies it.
+type SessionSuggestion struct {
+	SessionID string          `json:"session_id"`
+	Tags      []*SuggestedTag `json:"tags"`
+	Summary   string          `json:"summary"`
+}
+
+// EnrichmentService suggests tags and summaries for the event owner's sessions, and applies the
+// ones they approve. ErrForbidden for anyone but the owner.
+type EnrichmentService interface {
+	// SuggestSessionEnrichment asks the provider for tags when the session has none and for a
+	// summary when it has no description. ErrEnrichmentUnavailable when there is no provider or it
+	// fails. Nothing is saved.
+	SuggestSessionEnrichment(ctx context.Context, eventID, sessionID, ownerID string) (*SessionSuggestion, error)
+	// ApplySessionEnrichment adds the tags to the event and the session, and sets the summary as the
+	// session's description. ErrInvalidInput when a summary is given but the session already has a
+	// description.
+	ApplySessionEnrichment(ctx context.Context, eventID, sessionID, ownerID string, tags []string, summary string) (*Session, error)
+}
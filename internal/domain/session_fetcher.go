@@ -2,12 +2,20 @@ package domain
 
 import (
 	"context"
+	"errors"
 	"time"
 )
 
+// ErrProviderUnavailable is returned when an external schedule provider keeps failing or its
+// circuit breaker is open. The caller should try again later.
+var ErrProviderUnavailable = errors.New("import temporarily unavailable")
+
 // SessionFetcher fetches schedule data from Sessionize (or a test double).
+// Implementations may answer from a cache revalidated with the provider; forceRefresh skips
+// the cache and downloads the full payload. The returned data may be shared with the cache and
+// must not be modified.
 type SessionFetcher interface {
-	Fetch(ctx context.Context, sessionizeID string) (SessionFetcherResponse, error)
+	Fetch(ctx context.Context, sessionizeID string, forceRefresh bool) (SessionFetcherResponse, error)
 }
 
 // SessionFetcherResponse is the Sessionize All API response shape.
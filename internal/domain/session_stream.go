@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Session stream kinds.
+const (
+	StreamKindCaptions    = "captions"
+	StreamKindTranslation = "translation"
+)
+
+// MaxSessionStreamLinks is the most stream links a session can have.
+const MaxSessionStreamLinks = 20
+
+// SessionStreamLink is a caption or translation stream of a hybrid event's session. Attendees get
+// it in the session while it is visible.
+// swagger:model SessionStreamLink
+type SessionStreamLink struct {
+	// Kind is captions or translation.
+	Kind string `json:"kind"`
+	// Language is the stream's language code, such as "en" or "pt-BR".
+	Language string `json:"language"`
+	URL      string `json:"url"`
+	// VisibleFrom and VisibleUntil bound when attendees get the link; either may be left open.
+	VisibleFrom  *time.Time `json:"visible_from,omitempty"`
+	VisibleUntil *time.Time `json:"visible_until,omitempty"`
+}
+
+// VisibleAt reports whether attendees get the link at t.
+func (l *SessionStreamLink) VisibleAt(t time.Time) bool {
+	if l.VisibleFrom != nil && t.Before(*l.VisibleFrom) {
+		return false
+	}
+	return l.VisibleUntil == nil || t.Before(*l.VisibleUntil)
+}
+
+// SessionStreamRepository stores the stream links of sessions, in the order they were given.
+type SessionStreamRepository interface {
+	// ListBySessionID returns the session's links.
+	ListBySessionID(ctx context.Context, sessionID string) ([]*SessionStreamLink, error)
+	// ListByEventID returns the links of the event's sessions by session ID.
+	ListByEventID(ctx context.Context, eventID string) (map[string][]*SessionStreamLink, error)
+	// Replace sets the session's links to links, in one transaction.
+	Replace(ctx context.Context, eventID, sessionID string, links []*SessionStreamLink) error
+}
+
+// SessionStreamService manages the caption and translation streams of sessions. Only the event
+// owner may manage them; anyone else gets ErrForbidden.
+type SessionStreamService interface {
+	// ListSessionStreams returns the session's links, including those not visible yet.
+	ListSessionStreams(ctx context.Context, eventID, sessionID, ownerID string) ([]*SessionStreamLink, error)
+	// SetSessionStreams replaces the session's links and returns them. Invalid links are an
+	// ErrInvalidInput error.
+	SetSessionStreams(ctx context.Context, eventID, sessionID, ownerID string, links []*SessionStreamLink) ([]*SessionStreamLink, error)
+}
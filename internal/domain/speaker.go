@@ -11,10 +11,15 @@ type Speaker struct {
 	Source           string    `json:"source"`
 	FirstName        string    `json:"first_name"`
 	LastName         string    `json:"last_name"`
+	// Email is optional. Imports use it together with the name to recognize the same speaker.
+	Email            string    `json:"email"`
 	Bio              string    `json:"bio"`
 	TagLine          string    `json:"tag_line"`
 	ProfilePicture   string    `json:"profile_picture"`
 	IsTopSpeaker     bool      `json:"is_top_speaker"`
+	// CustomFields are the speaker's values for the event's custom fields; attendees only get
+	// those of public fields.
+	CustomFields     []*CustomFieldValue `json:"custom_fields,omitempty"`
 	CreatedAt        time.Time `json:"created_at"`
 	UpdatedAt        time.Time `json:"updated_at"`
 }
@@ -0,0 +1,43 @@
+package domain
+
+// MaxSpeakerBulkUpdates is the most speakers one bulk update may change.
+const MaxSpeakerBulkUpdates = 500
+
+// SpeakerUpdate changes some profile fields of one speaker. Nil fields are kept; an empty string
+// clears a text field.
+// swagger:model SpeakerUpdate
+type SpeakerUpdate struct {
+	SpeakerID      string  `json:"speaker_id"`
+	FirstName      *string `json:"first_name,omitempty" extensions:"x-nullable"`
+	LastName       *string `json:"last_name,omitempty" extensions:"x-nullable"`
+	Email          *string `json:"email,omitempty" extensions:"x-nullable"`
+	Bio            *string `json:"bio,omitempty" extensions:"x-nullable"`
+	TagLine        *string `json:"tag_line,omitempty" extensions:"x-nullable"`
+	ProfilePicture *string `json:"profile_picture,omitempty" extensions:"x-nullable"`
+	IsTopSpeaker   *bool   `json:"is_top_speaker,omitempty" extensions:"x-nullable"`
+}
+
+// Empty reports whether the update changes no field.
+func (u *SpeakerUpdate) Empty() bool {
+	return u.FirstName == nil && u.LastName == nil && u.Email == nil && u.Bio == nil &&
+		u.TagLine == nil && u.ProfilePicture == nil && u.IsTopSpeaker == nil
+}
+
+// SpeakerBulkItemResult is the outcome of one update of a bulk update, in request order.
+// swagger:model SpeakerBulkItemResult
+type SpeakerBulkItemResult struct {
+	SpeakerID string `json:"speaker_id"`
+	// Error says why the update was rejected; it is empty for valid updates.
+	Error string `json:"error,omitempty"`
+	// Speaker is the updated speaker, set when the bulk update was applied or, in a dry run, valid.
+	Speaker *Speaker `json:"speaker,omitempty"`
+}
+
+// SpeakerBulkUpdateResult is the outcome of a bulk speaker update. The updates are applied in one
+// transaction: when any of them is rejected, none is applied. A dry run applies none either.
+// swagger:model SpeakerBulkUpdateResult
+type SpeakerBulkUpdateResult struct {
+	Applied bool                     `json:"applied"`
+	DryRun  bool                     `json:"dry_run"`
+	Results []*SpeakerBulkItemResult `json:"results"`
+}
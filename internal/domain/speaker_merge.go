@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrAlreadyResolved is returned when a speaker merge candidate was already merged or rejected.
+var ErrAlreadyResolved = errors.New("merge candidate already resolved")
+
+// Speaker merge candidate statuses.
+const (
+	MergeStatusPending  = "pending"
+	MergeStatusMerged   = "merged"
+	MergeStatusRejected = "rejected"
+)
+
+// SpeakerMergeCandidate records an imported speaker that may duplicate an existing one. The import
+// keeps both until the event owner merges them (the existing speaker absorbs the imported one) or
+// rejects the match.
+// swagger:model SpeakerMergeCandidate
+type SpeakerMergeCandidate struct {
+	ID      string `json:"id"`
+	EventID string `json:"event_id"`
+	// SpeakerID is the speaker created by the import.
+	SpeakerID string `json:"speaker_id"`
+	// CandidateID is the existing speaker it may duplicate.
+	CandidateID string     `json:"candidate_id"`
+	Reason      string     `json:"reason"`
+	Status      string     `json:"status"`
+	CreatedAt   time.Time  `json:"created_at"`
+	ResolvedAt  *time.Time `json:"resolved_at,omitempty"`
+	// Speaker and Candidate are filled when listing candidates for review.
+	Speaker   *Speaker `json:"speaker,omitempty"`
+	Candidate *Speaker `json:"candidate,omitempty"`
+}
+
+// SpeakerMergeRepository defines the interface for speaker merge candidate storage.
+type SpeakerMergeRepository interface {
+	// Create stores a pending candidate. A pair that was already recorded is left unchanged.
+	Create(ctx context.Context, candidate *SpeakerMergeCandidate) error
+	// GetByID returns ErrNotFound when the candidate does not exist.
+	GetByID(ctx context.Context, id string) (*SpeakerMergeCandidate, error)
+	ListPendingByEventID(ctx context.Context, eventID string) ([]*SpeakerMergeCandidate, error)
+	// Resolve sets a pending candidate's status. It returns ErrAlreadyResolved when the candidate
+	// is no longer pending.
+	Resolve(ctx context.Context, id string, status string) (*SpeakerMergeCandidate, error)
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+// ErrArchiveNotReady is returned when a speaker photo archive is downloaded before it is built.
+var ErrArchiveNotReady = errors.New("archive is not ready")
+
+// Speaker photo archive statuses.
+const (
+	PhotoArchivePending = "pending"
+	PhotoArchiveReady   = "ready"
+	PhotoArchiveFailed  = "failed"
+)
+
+// MaxStreamedSpeakerPhotos is the most photos an export streams in its response. Events with more
+// get an archive built in the background, which keeps requests short.
+const MaxStreamedSpeakerPhotos = 50
+
+// SpeakerPhotoArchive is a zip of the photos of an event's speakers, built in the background for
+// events with more than MaxStreamedSpeakerPhotos photos. Each photo is named after its speaker.
+// swagger:model SpeakerPhotoArchive
+type SpeakerPhotoArchive struct {
+	ID      string `json:"id"`
+	EventID string `json:"event_id"`
+	// Status is pending until the archive is built, then ready or failed.
+	Status string `json:"status"`
+	// Speakers counts the speakers with a photo when the archive was requested.
+	Speakers int `json:"speakers"`
+	// Photos counts the photos in the ready archive.
+	Photos int `json:"photos"`
+	// Missing names the speakers whose photo could not be downloaded; the archive lists them in
+	// missing.txt.
+	Missing []string `json:"missing"`
+	// Error says why a failed archive could not be built.
+	Error       string     `json:"error,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// PictureFetcher downloads a picture at its original size. format is its image format, such as
+// "jpeg" or "png"; anything that is not a picture is an error.
+type PictureFetcher interface {
+	FetchPicture(ctx context.Context, url string) (data []byte, format string, err error)
+}
+
+// SpeakerPhotoArchiveRepository stores speaker photo archives and their zip files.
+type SpeakerPhotoArchiveRepository interface {
+	// Create stores a as the event's pending archive and sets its ID and CreatedAt, unless the event
+	// has one pending already; then it fills a with that one and created is false.
+	Create(ctx context.Context, a *SpeakerPhotoArchive) (created bool, err error)
+	// GetByID returns the archive without its zip; ErrNotFound when there is none.
+	GetByID(ctx context.Context, archiveID string) (*SpeakerPhotoArchive, error)
+	// ListPending returns up to limit pending archives, oldest first.
+	ListPending(ctx context.Context, limit int) ([]*SpeakerPhotoArchive, error)
+	// Complete stores the archive's status, counts and error, with its zip when it is ready, and
+	// sets CompletedAt.
+	Complete(ctx context.Context, a *SpeakerPhotoArchive, zip []byte) error
+	// GetZip returns a ready archive's zip file; ErrNotFound when there is none.
+	GetZip(ctx context.Context, archiveID string) ([]byte, error)
+}
+
+// SpeakerPhotoService exports the photos of an event's speakers for printed programs. Only the
+// event owner may export them; anyone else gets ErrForbidden.
+type SpeakerPhotoService interface {
+	// ExportSpeakerPhotos writes a zip of the photos to w and returns nil when the event has at most
+	// MaxStreamedSpeakerPhotos speakers with a photo. Otherwise it writes nothing and returns the
+	// pending archive that will hold them, reusing the one already pending.
+	ExportSpeakerPhotos(ctx context.Context, eventID, ownerID string, w io.Writer) (*SpeakerPhotoArchive, error)
+	// GetSpeakerPhotoArchive returns the event's archive; ErrNotFound for another event's.
+	GetSpeakerPhotoArchive(ctx context.Context, eventID, archiveID, ownerID string) (*SpeakerPhotoArchive, error)
+	// DownloadSpeakerPhotoArchive returns the archive's zip file, or ErrArchiveNotReady while it is
+	// pending or when it failed.
+	DownloadSpeakerPhotoArchive(ctx context.Context, eventID, archiveID, ownerID string) ([]byte, error)
+	// BuildPendingArchives builds the pending archives and returns how many it completed. It runs in
+	// the background, on one API replica at a time.
+	BuildPendingArchives(ctx context.Context) (int, error)
+}
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"context"
+)
+
+// Entity types a sync change can refer to.
+const (
+	SyncEntityRoom    = "room"
+	SyncEntitySession = "session"
+	SyncEntitySpeaker = "speaker"
+)
+
+// MaxSyncChanges is the most changes returned by one sync request.
+const MaxSyncChanges = 500
+
+// SyncChange is the latest change to one room, session or speaker of an event. Every change takes a
+// new, increasing version, so an entity changed twice since a cursor is reported once. TxID is the
+// database transaction that made it.
+type SyncChange struct {
+	EntityType string
+	EntityID   string
+	TxID       int64
+	Version    int64
+	// Created is true when the entity did not exist yet at the cursor.
+	Created bool
+	// Deleted is true when the entity no longer exists.
+	Deleted bool
+}
+
+// SyncCursor is where a sync left off: the transaction and version of the last change it returned.
+// Changes are ordered by transaction, then version. A transaction can commit a version below one
+// already returned, but never behind a cursor: only changes of transactions older than every one
+// still running are listed. The zero cursor is a first sync.
+type SyncCursor struct {
+	TxID    int64
+	Version int64
+}
+
+// SyncRepository reads the version counters of rooms, sessions and speakers.
+type SyncRepository interface {
+	// ListChanges returns the event's changes after since, in cursor order, at most limit of them.
+	// Changes of transactions that started before a still-running one are left out until it ends.
+	// Deletions are left out of a first sync, as it has nothing to drop.
+	ListChanges(ctx context.Context, eventID string, since SyncCursor, limit int) ([]*SyncChange, error)
+}
+
+// SyncRoomChanges are the rooms created, updated and deleted since the cursor.
+// swagger:model SyncRoomChanges
+type SyncRoomChanges struct {
+	Created []*Room  `json:"created"`
+	Updated []*Room  `json:"updated"`
+	Deleted []string `json:"deleted"`
+}
+
+// SyncSessionChanges are the sessions created, updated and deleted since the cursor. Sessions
+// include their tags and speaker IDs.
+// swagger:model SyncSessionChanges
+type SyncSessionChanges struct {
+	Created []*Session `json:"created"`
+	Updated []*Session `json:"updated"`
+	Deleted []string   `json:"deleted"`
+}
+
+// SyncSpeakerChanges are the speakers created, updated and deleted since the cursor.
+// swagger:model SyncSpeakerChanges
+type SyncSpeakerChanges struct {
+	Created []*Speaker `json:"created"`
+	Updated []*Speaker `json:"updated"`
+	Deleted []string   `json:"deleted"`
+}
+
+// SyncDelta is what changed in an event's rooms, sessions and speakers since a cursor. Rooms that
+// are not bookable are included with not_bookable set; apps hide them like the schedule does.
+// swagger:model SyncDelta
+type SyncDelta struct {
+	EventID string `json:"event_id"`
+	// Cursor is passed as since on the next request. It equals the request's cursor when nothing changed.
+	Cursor string `json:"cursor"`
+	// HasMore is true when more changes are waiting; request again with Cursor right away.
+	HasMore  bool               `json:"has_more"`
+	Rooms    SyncRoomChanges    `json:"rooms"`
+	Sessions SyncSessionChanges `json:"sessions"`
+	Speakers SyncSpeakerChanges `json:"speakers"`
+}
@@ -19,6 +19,7 @@ type User struct {
 	Email     string    `json:"email"`
 	Name      string    `json:"name"`
 	LastName  string    `json:"last_name"`
+	AvatarURL string    `json:"avatar_url,omitempty"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
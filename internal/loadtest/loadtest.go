@@ -0,0 +1,198 @@
+// Package loadtest runs HTTP load scenarios against a running API and checks the results
+// against performance budgets. It is used by cmd/loadtest to catch latency regressions
+// (e.g. from repository query changes) in CI.
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Target is the API under test and the fixtures scenarios need.
+type Target struct {
+	// BaseURL is the API origin, e.g. "http://localhost:8080".
+	BaseURL string
+	// Token is a bearer token of a user that owns EventID and is registered for it.
+	Token string
+	// EventID is an existing event, ideally seeded with a realistic schedule.
+	EventID string
+}
+
+// Budget is the performance budget of a scenario. Zero fields are not checked.
+type Budget struct {
+	P95          time.Duration
+	P99          time.Duration
+	MaxErrorRate float64
+	MinRPS       float64
+}
+
+// Scenario is one request shape replayed by every worker for the duration of a run.
+type Scenario struct {
+	Name   string
+	Method string
+	// Path may contain {eventID}, replaced with Target.EventID.
+	Path string
+	// Body returns the JSON body of the i-th request; nil for requests without a body.
+	Body   func(i int64) string
+	Budget Budget
+}
+
+// Options controls how long and how hard a scenario is run.
+type Options struct {
+	Duration    time.Duration
+	Concurrency int
+	// Warmup requests are sent one at a time before measuring and are not counted.
+	Warmup int
+}
+
+// Result is the outcome of running one scenario.
+type Result struct {
+	Scenario string
+	Requests int
+	Errors   int
+	// Statuses counts responses by HTTP status; transport errors are counted under 0.
+	Statuses map[int]int
+	Elapsed  time.Duration
+	RPS      float64
+	P50      time.Duration
+	P95      time.Duration
+	P99      time.Duration
+	Max      time.Duration
+	Budget   Budget
+	// Failures lists the exceeded budget limits; empty means the scenario passed.
+	Failures []string
+	latency  []time.Duration
+}
+
+// ErrorRate is the share of requests that failed (transport error or non-2xx status).
+func (r Result) ErrorRate() float64 {
+	if r.Requests == 0 {
+		return 0
+	}
+	return float64(r.Errors) / float64(r.Requests)
+}
+
+// Check compares r with its budget and returns one message per exceeded limit.
+func (r Result) Check() []string {
+	var out []string
+	b := r.Budget
+	if r.Requests == 0 {
+		return []string{"no requests completed"}
+	}
+	if b.P95 > 0 && r.P95 > b.P95 {
+		out = append(out, fmt.Sprintf("p95 %s exceeds budget %s", r.P95, b.P95))
+	}
+	if b.P99 > 0 && r.P99 > b.P99 {
+		out = append(out, fmt.Sprintf("p99 %s exceeds budget %s", r.P99, b.P99))
+	}
+	if r.ErrorRate() > b.MaxErrorRate {
+		out = append(out, fmt.Sprintf("error rate %.2f%% exceeds budget %.2f%%", r.ErrorRate()*100, b.MaxErrorRate*100))
+	}
+	if b.MinRPS > 0 && r.RPS < b.MinRPS {
+		out = append(out, fmt.Sprintf("throughput %.1f rps below budget %.1f rps", r.RPS, b.MinRPS))
+	}
+	return out
+}
+
+// Run replays sc against target with opts.Concurrency closed-loop workers until opts.Duration
+// elapses or ctx is done, and returns the measured result with Failures filled from the budget.
+func Run(ctx context.Context, client *http.Client, target Target, sc Scenario, opts Options) Result {
+	if opts.Concurrency < 1 {
+		opts.Concurrency = 1
+	}
+	url := strings.TrimRight(target.BaseURL, "/") + strings.ReplaceAll(sc.Path, "{eventID}", target.EventID)
+
+	var seq atomic.Int64
+	send := func(ctx context.Context) (int, error) {
+		var body io.Reader
+		if sc.Body != nil {
+			body = strings.NewReader(sc.Body(seq.Add(1)))
+		}
+		req, err := http.NewRequestWithContext(ctx, sc.Method, url, body)
+		if err != nil {
+			return 0, err
+		}
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		if target.Token != "" {
+			req.Header.Set("Authorization", "Bearer "+target.Token)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return 0, err
+		}
+		// Drain so the connection is reused and the timing includes the full body.
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		return resp.StatusCode, nil
+	}
+
+	for range opts.Warmup {
+		_, _ = send(ctx)
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, opts.Duration)
+	defer cancel()
+
+	var (
+		mu  sync.Mutex
+		res = Result{Scenario: sc.Name, Statuses: make(map[int]int), Budget: sc.Budget}
+		wg  sync.WaitGroup
+	)
+	start := time.Now()
+	for range opts.Concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for runCtx.Err() == nil {
+				t0 := time.Now()
+				status, err := send(runCtx)
+				d := time.Since(t0)
+				if runCtx.Err() != nil {
+					return // request cut short by the end of the run; not a real sample
+				}
+				mu.Lock()
+				res.Requests++
+				res.latency = append(res.latency, d)
+				res.Statuses[status]++
+				if err != nil || status < 200 || status > 299 {
+					res.Errors++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	res.Elapsed = time.Since(start)
+
+	if res.Elapsed > 0 {
+		res.RPS = float64(res.Requests) / res.Elapsed.Seconds()
+	}
+	slices.Sort(res.latency)
+	res.P50 = percentile(res.latency, 0.50)
+	res.P95 = percentile(res.latency, 0.95)
+	res.P99 = percentile(res.latency, 0.99)
+	if n := len(res.latency); n > 0 {
+		res.Max = res.latency[n-1]
+	}
+	res.Failures = res.Check()
+	return res
+}
+
+// percentile returns the nearest-rank percentile p (0..1] of sorted.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(p*float64(len(sorted))+0.999999) - 1
+	rank = max(0, min(rank, len(sorted)-1))
+	return sorted[rank]
+}
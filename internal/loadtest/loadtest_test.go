@@ -0,0 +1,178 @@
+package loadtest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		bodies []string
+		auth   []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		bodies = append(bodies, string(b))
+		auth = append(auth, r.Header.Get("Authorization"))
+		mu.Unlock()
+		if r.URL.Path != "/events/ev-1/invitations" {
+			http.NotFound(w, r)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	sc := Scenario{
+		Name:   "send",
+		Method: http.MethodPost,
+		Path:   "/events/{eventID}/invitations",
+		Body:   func(i int64) string { return fmt.Sprintf(`{"n":%d}`, i) },
+		Budget: Budget{P95: time.Second, MaxErrorRate: 0},
+	}
+	res := Run(context.Background(), srv.Client(), Target{BaseURL: srv.URL + "/", Token: "tok", EventID: "ev-1"}, sc, Options{
+		Duration:    100 * time.Millisecond,
+		Concurrency: 4,
+		Warmup:      2,
+	})
+
+	require.Positive(t, res.Requests)
+	assert.Equal(t, 0, res.Errors)
+	assert.Equal(t, res.Requests, res.Statuses[http.StatusOK])
+	assert.Empty(t, res.Failures)
+	assert.Positive(t, res.RPS)
+	assert.LessOrEqual(t, res.P50, res.P95)
+	assert.LessOrEqual(t, res.P95, res.P99)
+	assert.LessOrEqual(t, res.P99, res.Max)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.GreaterOrEqual(t, len(bodies), res.Requests+2, "warmup requests are sent but not counted")
+	assert.True(t, strings.HasPrefix(bodies[0], `{"n":`))
+	assert.Equal(t, "Bearer tok", auth[0])
+}
+
+func TestRun_CountsErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	sc := Scenario{Name: "get", Method: http.MethodGet, Path: "/events/{eventID}", Budget: Budget{MaxErrorRate: 0.01}}
+	res := Run(context.Background(), srv.Client(), Target{BaseURL: srv.URL, EventID: "ev-1"}, sc, Options{Duration: 50 * time.Millisecond})
+
+	require.Positive(t, res.Requests)
+	assert.Equal(t, res.Requests, res.Errors)
+	require.Len(t, res.Failures, 1)
+	assert.Contains(t, res.Failures[0], "error rate 100.00% exceeds budget 1.00%")
+}
+
+func TestResult_Check(t *testing.T) {
+	tests := []struct {
+		name   string
+		result Result
+		want   []string
+	}{
+		{
+			name:   "within budget",
+			result: Result{Requests: 100, Errors: 0, P95: 90 * time.Millisecond, P99: 120 * time.Millisecond, RPS: 50, Budget: Budget{P95: 100 * time.Millisecond, P99: 200 * time.Millisecond, MinRPS: 10}},
+		},
+		{
+			name:   "no requests",
+			result: Result{Budget: Budget{P95: time.Second}},
+			want:   []string{"no requests completed"},
+		},
+		{
+			name:   "every limit exceeded",
+			result: Result{Requests: 100, Errors: 5, P95: 150 * time.Millisecond, P99: 300 * time.Millisecond, RPS: 5, Budget: Budget{P95: 100 * time.Millisecond, P99: 200 * time.Millisecond, MaxErrorRate: 0.01, MinRPS: 10}},
+			want: []string{
+				"p95 150ms exceeds budget 100ms",
+				"p99 300ms exceeds budget 200ms",
+				"error rate 5.00% exceeds budget 1.00%",
+				"throughput 5.0 rps below budget 10.0 rps",
+			},
+		},
+		{
+			name:   "zero budget fields are not checked",
+			result: Result{Requests: 10, P95: time.Hour, P99: time.Hour},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.result.Check())
+		})
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	sorted := make([]time.Duration, 100)
+	for i := range sorted {
+		sorted[i] = time.Duration(i+1) * time.Millisecond
+	}
+	assert.Equal(t, time.Duration(0), percentile(nil, 0.95))
+	assert.Equal(t, 50*time.Millisecond, percentile(sorted, 0.50))
+	assert.Equal(t, 95*time.Millisecond, percentile(sorted, 0.95))
+	assert.Equal(t, 100*time.Millisecond, percentile(sorted, 1))
+	assert.Equal(t, 7*time.Millisecond, percentile(sorted[6:7], 0.99))
+}
+
+func TestLoadBudgets(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "budgets.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"get-event": {"p95": "100ms", "p99": "1s", "max_error_rate": 0.01}}`), 0o600))
+
+	budgets, err := LoadBudgets(path)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]Budget{"get-event": {P95: 100 * time.Millisecond, P99: time.Second, MaxErrorRate: 0.01}}, budgets)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"get-event": {"p95": "fast"}}`), 0o600))
+	_, err = LoadBudgets(path)
+	assert.ErrorContains(t, err, "budget get-event: p95")
+}
+
+func TestWriteJSON(t *testing.T) {
+	var b strings.Builder
+	results := []Result{
+		{Scenario: "get-event", Requests: 10, Statuses: map[int]int{200: 10}, P95: 1500 * time.Microsecond},
+		{Scenario: "send-invitations", Requests: 10, Errors: 1, Statuses: map[int]int{200: 9, 500: 1}, Failures: []string{"error rate 10.00% exceeds budget 0.10%"}},
+	}
+	require.NoError(t, WriteJSON(&b, results))
+
+	var out []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(b.String()), &out))
+	require.Len(t, out, 2)
+	assert.Equal(t, true, out[0]["passed"])
+	assert.Equal(t, 1.5, out[0]["p95_ms"])
+	assert.Equal(t, []any{}, out[0]["failures"])
+	assert.Equal(t, false, out[1]["passed"])
+	assert.Equal(t, 0.1, out[1]["error_rate"])
+}
+
+func TestDefaultScenarios(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, sc := range DefaultScenarios() {
+		assert.False(t, seen[sc.Name], "duplicate scenario %s", sc.Name)
+		seen[sc.Name] = true
+		assert.True(t, strings.HasPrefix(sc.Path, "/"), sc.Name)
+		assert.Positive(t, sc.Budget.P95, sc.Name)
+		if sc.Body != nil {
+			assert.True(t, json.Valid([]byte(sc.Body(1))), sc.Name)
+			assert.NotEqual(t, sc.Body(1), sc.Body(2), "%s bodies must be unique per request", sc.Name)
+		}
+	}
+}
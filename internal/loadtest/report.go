@@ -0,0 +1,130 @@
+package loadtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+)
+
+// budgetJSON is the on-disk form of a Budget, with durations as Go duration strings ("150ms").
+type budgetJSON struct {
+	P95          string  `json:"p95,omitempty"`
+	P99          string  `json:"p99,omitempty"`
+	MaxErrorRate float64 `json:"max_error_rate"`
+	MinRPS       float64 `json:"min_rps,omitempty"`
+}
+
+// LoadBudgets reads a JSON object of scenario name to budget, e.g.
+//
+//	{"get-event": {"p95": "100ms", "p99": "250ms", "max_error_rate": 0.001}}
+//
+// and returns the parsed budgets. Scenarios missing from the file keep their default budget.
+func LoadBudgets(path string) (map[string]Budget, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read budgets: %w", err)
+	}
+	var raw map[string]budgetJSON
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, fmt.Errorf("parse budgets: %w", err)
+	}
+	out := make(map[string]Budget, len(raw))
+	for name, rb := range raw {
+		budget := Budget{MaxErrorRate: rb.MaxErrorRate, MinRPS: rb.MinRPS}
+		if budget.P95, err = parseOptionalDuration(rb.P95); err != nil {
+			return nil, fmt.Errorf("budget %s: p95: %w", name, err)
+		}
+		if budget.P99, err = parseOptionalDuration(rb.P99); err != nil {
+			return nil, fmt.Errorf("budget %s: p99: %w", name, err)
+		}
+		out[name] = budget
+	}
+	return out, nil
+}
+
+func parseOptionalDuration(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(s)
+}
+
+// resultJSON is the machine-readable form of a Result, with latencies in milliseconds.
+type resultJSON struct {
+	Scenario  string      `json:"scenario"`
+	Passed    bool        `json:"passed"`
+	Requests  int         `json:"requests"`
+	Errors    int         `json:"errors"`
+	ErrorRate float64     `json:"error_rate"`
+	Statuses  map[int]int `json:"statuses"`
+	RPS       float64     `json:"rps"`
+	P50Ms     float64     `json:"p50_ms"`
+	P95Ms     float64     `json:"p95_ms"`
+	P99Ms     float64     `json:"p99_ms"`
+	MaxMs     float64     `json:"max_ms"`
+	Failures  []string    `json:"failures"`
+}
+
+// WriteJSON writes results as a JSON array, for CI artifacts and dashboards.
+func WriteJSON(w io.Writer, results []Result) error {
+	out := make([]resultJSON, 0, len(results))
+	for _, r := range results {
+		failures := r.Failures
+		if failures == nil {
+			failures = []string{}
+		}
+		out = append(out, resultJSON{
+			Scenario:  r.Scenario,
+			Passed:    len(r.Failures) == 0,
+			Requests:  r.Requests,
+			Errors:    r.Errors,
+			ErrorRate: r.ErrorRate(),
+			Statuses:  r.Statuses,
+			RPS:       r.RPS,
+			P50Ms:     ms(r.P50),
+			P95Ms:     ms(r.P95),
+			P99Ms:     ms(r.P99),
+			MaxMs:     ms(r.Max),
+			Failures:  failures,
+		})
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+// WriteText writes a human-readable summary table followed by any budget failures.
+func WriteText(w io.Writer, results []Result) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "SCENARIO\tREQUESTS\tERRORS\tRPS\tP50\tP95\tP99\tMAX\tRESULT")
+	for _, r := range results {
+		verdict := "ok"
+		if len(r.Failures) > 0 {
+			verdict = "FAIL"
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%.1f\t%s\t%s\t%s\t%s\t%s\n",
+			r.Scenario, r.Requests, r.Errors, r.RPS, round(r.P50), round(r.P95), round(r.P99), round(r.Max), verdict)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	for _, r := range results {
+		for _, f := range r.Failures {
+			if _, err := fmt.Fprintf(w, "%s: %s\n", r.Scenario, f); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func ms(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+func round(d time.Duration) time.Duration {
+	return d.Round(100 * time.Microsecond)
+}
@@ -0,0 +1,37 @@
+package loadtest
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultScenarios are the hot endpoints guarded by budgets. Budgets assume the API and
+// Postgres run on the same host with the event seeded with a few hundred sessions; override
+// them with a budgets file for other environments.
+func DefaultScenarios() []Scenario {
+	return []Scenario{
+		{
+			Name:   "get-event",
+			Method: "GET",
+			Path:   "/events/{eventID}",
+			Budget: Budget{P95: 150 * time.Millisecond, P99: 300 * time.Millisecond, MaxErrorRate: 0.001},
+		},
+		{
+			Name:   "event-schedule",
+			Method: "GET",
+			Path:   "/attendee/events/{eventID}/schedule",
+			Budget: Budget{P95: 150 * time.Millisecond, P99: 300 * time.Millisecond, MaxErrorRate: 0.001},
+		},
+		{
+			// Each request invites a new address, so run it against an API with EMAIL_PROVIDER=noop
+			// and a throwaway database: it inserts one invitation row per request.
+			Name:   "send-invitations",
+			Method: "POST",
+			Path:   "/events/{eventID}/invitations",
+			Body: func(i int64) string {
+				return fmt.Sprintf(`{"emails":"loadtest+%d-%d@example.com"}`, time.Now().UnixNano(), i)
+			},
+			Budget: Budget{P95: 250 * time.Millisecond, P99: 500 * time.Millisecond, MaxErrorRate: 0.001},
+		},
+	}
+}
@@ -0,0 +1,175 @@
+// Package instrumented wraps domain repositories with decorators that record per-method
+// latency histograms and the slowest recent calls in a shared Recorder.
+package instrumented
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+// Defaults for NewRecorder.
+const (
+	DefaultSlowThreshold = 100 * time.Millisecond
+	DefaultSlowCapacity  = 50
+)
+
+// bucketBounds are the histogram upper bounds; calls slower than the last go in an overflow bucket.
+var bucketBounds = []time.Duration{
+	time.Millisecond,
+	2 * time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+}
+
+type methodStats struct {
+	calls   int64
+	errors  int64
+	total   time.Duration
+	max     time.Duration
+	buckets []int64 // len(bucketBounds)+1
+}
+
+// Recorder collects repository call latencies. It is safe for concurrent use and implements
+// domain.QueryReporter.
+type Recorder struct {
+	slowThreshold time.Duration
+	now           func() time.Time
+
+	mu       sync.Mutex
+	methods  map[string]*methodStats
+	slow     []domain.SlowQuery // ring buffer of the most recent slow calls
+	slowNext int
+	slowFull bool
+}
+
+// NewRecorder returns a Recorder that keeps the last slowCapacity calls taking at least
+// slowThreshold. Non-positive values fall back to the defaults.
+func NewRecorder(slowThreshold time.Duration, slowCapacity int) *Recorder {
+	if slowThreshold <= 0 {
+		slowThreshold = DefaultSlowThreshold
+	}
+	if slowCapacity <= 0 {
+		slowCapacity = DefaultSlowCapacity
+	}
+	return &Recorder{
+		slowThreshold: slowThreshold,
+		now:           time.Now,
+		methods:       make(map[string]*methodStats),
+		slow:          make([]domain.SlowQuery, slowCapacity),
+	}
+}
+
+// Observe records one call of method that took d and returned err.
+func (r *Recorder) Observe(method string, d time.Duration, err error) {
+	bucket := sort.Search(len(bucketBounds), func(i int) bool { return d <= bucketBounds[i] })
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	st := r.methods[method]
+	if st == nil {
+		st = &methodStats{buckets: make([]int64, len(bucketBounds)+1)}
+		r.methods[method] = st
+	}
+	st.calls++
+	if err != nil {
+		st.errors++
+	}
+	st.total += d
+	if d > st.max {
+		st.max = d
+	}
+	st.buckets[bucket]++
+
+	if d >= r.slowThreshold {
+		q := domain.SlowQuery{Method: method, DurationMs: ms(d), At: r.now()}
+		if err != nil {
+			q.Error = err.Error()
+		}
+		r.slow[r.slowNext] = q
+		r.slowNext = (r.slowNext + 1) % len(r.slow)
+		if r.slowNext == 0 {
+			r.slowFull = true
+		}
+	}
+}
+
+// observe is deferred by the decorators: defer r.observe("Repo.Method", time.Now(), &err).
+func (r *Recorder) observe(method string, start time.Time, err *error) {
+	r.Observe(method, time.Since(start), *err)
+}
+
+// QueryReport returns methods sorted by name and the recent slow calls, slowest first.
+func (r *Recorder) QueryReport() domain.QueryReport {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	report := domain.QueryReport{
+		GeneratedAt: r.now(),
+		Methods:     make([]domain.MethodLatency, 0, len(r.methods)),
+	}
+	for name, st := range r.methods {
+		m := domain.MethodLatency{
+			Method:  name,
+			Calls:   st.calls,
+			Errors:  st.errors,
+			MeanMs:  ms(st.total) / float64(st.calls),
+			MaxMs:   ms(st.max),
+			Buckets: make([]domain.LatencyBucket, len(st.buckets)),
+		}
+		for i, n := range st.buckets {
+			if i < len(bucketBounds) {
+				m.Buckets[i].LeMs = ms(bucketBounds[i])
+			}
+			m.Buckets[i].Count = n
+		}
+		m.P50Ms = st.percentile(0.50)
+		m.P95Ms = st.percentile(0.95)
+		m.P99Ms = st.percentile(0.99)
+		report.Methods = append(report.Methods, m)
+	}
+	sort.Slice(report.Methods, func(i, j int) bool { return report.Methods[i].Method < report.Methods[j].Method })
+
+	n := r.slowNext
+	if r.slowFull {
+		n = len(r.slow)
+	}
+	report.Slowest = make([]domain.SlowQuery, n)
+	copy(report.Slowest, r.slow[:n])
+	sort.SliceStable(report.Slowest, func(i, j int) bool { return report.Slowest[i].DurationMs > report.Slowest[j].DurationMs })
+	return report
+}
+
+// percentile returns the upper bound of the bucket holding the nearest-rank p-th call, capped at
+// the slowest call seen (which also stands in for the overflow bucket).
+func (st *methodStats) percentile(p float64) float64 {
+	rank := int64(math.Ceil(p * float64(st.calls)))
+	if rank < 1 {
+		rank = 1
+	}
+	var seen int64
+	for i, n := range st.buckets {
+		seen += n
+		if seen >= rank && i < len(bucketBounds) && bucketBounds[i] < st.max {
+			return ms(bucketBounds[i])
+		}
+		if seen >= rank {
+			break
+		}
+	}
+	return ms(st.max)
+}
+
+func ms(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
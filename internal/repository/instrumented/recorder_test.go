@@ -0,0 +1,90 @@
+package instrumented
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder_QueryReport(t *testing.T) {
+	rec := NewRecorder(50*time.Millisecond, 2)
+	fixed := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	rec.now = func() time.Time { return fixed }
+
+	for i := 0; i < 98; i++ {
+		rec.Observe("SessionRepository.ListSessionsByEventID", 3*time.Millisecond, nil)
+	}
+	rec.Observe("SessionRepository.ListSessionsByEventID", 60*time.Millisecond, nil)
+	rec.Observe("SessionRepository.ListSessionsByEventID", 4*time.Second, errors.New("timeout"))
+	rec.Observe("EventRepository.GetByID", 70*time.Millisecond, nil)
+
+	report := rec.QueryReport()
+
+	assert.Equal(t, fixed, report.GeneratedAt)
+	require.Len(t, report.Methods, 2)
+	assert.Equal(t, "EventRepository.GetByID", report.Methods[0].Method)
+
+	m := report.Methods[1]
+	assert.Equal(t, int64(100), m.Calls)
+	assert.Equal(t, int64(1), m.Errors)
+	assert.Equal(t, 5.0, m.P50Ms, "3ms falls in the 5ms bucket")
+	assert.Equal(t, 5.0, m.P95Ms)
+	assert.Equal(t, 100.0, m.P99Ms, "60ms falls in the 100ms bucket")
+	assert.Equal(t, 4000.0, m.MaxMs)
+	assert.InDelta(t, (98*3+60+4000)/100.0, m.MeanMs, 0.001)
+	require.Len(t, m.Buckets, len(bucketBounds)+1)
+	assert.Equal(t, int64(98), m.Buckets[2].Count)
+	assert.Equal(t, 0.0, m.Buckets[len(bucketBounds)].LeMs)
+	assert.Equal(t, int64(1), m.Buckets[len(bucketBounds)].Count)
+
+	// Capacity 2 keeps only the two most recent slow calls, slowest first.
+	require.Len(t, report.Slowest, 2)
+	assert.Equal(t, "SessionRepository.ListSessionsByEventID", report.Slowest[0].Method)
+	assert.Equal(t, 4000.0, report.Slowest[0].DurationMs)
+	assert.Equal(t, "timeout", report.Slowest[0].Error)
+	assert.Equal(t, "EventRepository.GetByID", report.Slowest[1].Method)
+}
+
+func TestRecorder_QueryReport_Empty(t *testing.T) {
+	report := NewRecorder(0, 0).QueryReport()
+	assert.Empty(t, report.Methods)
+	assert.NotNil(t, report.Slowest)
+	assert.Empty(t, report.Slowest)
+}
+
+type stubEventRepo struct {
+	domain.EventRepository
+	err error
+}
+
+func (s stubEventRepo) GetByID(ctx context.Context, id string) (*domain.Event, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &domain.Event{ID: id}, nil
+}
+
+func TestNewEventRepository_RecordsCalls(t *testing.T) {
+	rec := NewRecorder(time.Hour, 1)
+	repo := NewEventRepository(stubEventRepo{}, rec)
+	failing := NewEventRepository(stubEventRepo{err: domain.ErrNotFound}, rec)
+
+	ev, err := repo.GetByID(context.Background(), "ev-1")
+	require.NoError(t, err)
+	assert.Equal(t, "ev-1", ev.ID)
+	_, err = failing.GetByID(context.Background(), "ev-2")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	report := rec.QueryReport()
+	require.Len(t, report.Methods, 1)
+	assert.Equal(t, "EventRepository.GetByID", report.Methods[0].Method)
+	assert.Equal(t, int64(2), report.Methods[0].Calls)
+	assert.Equal(t, int64(1), report.Methods[0].Errors)
+	assert.Empty(t, report.Slowest)
+}
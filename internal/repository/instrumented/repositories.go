@@ -0,0 +1,1355 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+type eventRegistrationRepository struct {
+	next domain.EventRegistrationRepository
+	rec  *Recorder
+}
+
+// NewEventRegistrationRepository returns next with every call recorded in rec under "EventRegistrationRepository.<Method>".
+func NewEventRegistrationRepository(next domain.EventRegistrationRepository, rec *Recorder) domain.EventRegistrationRepository {
+	return &eventRegistrationRepository{next: next, rec: rec}
+}
+
+func (r *eventRegistrationRepository) Create(ctx context.Context, reg *domain.EventRegistration) (err error) {
+	defer r.rec.observe("EventRegistrationRepository.Create", time.Now(), &err)
+	return r.next.Create(ctx, reg)
+}
+
+func (r *eventRegistrationRepository) GetByEventAndUser(ctx context.Context, eventID, userID string) (res *domain.EventRegistration, err error) {
+	defer r.rec.observe("EventRegistrationRepository.GetByEventAndUser", time.Now(), &err)
+	return r.next.GetByEventAndUser(ctx, eventID, userID)
+}
+
+func (r *eventRegistrationRepository) ListByUserID(ctx context.Context, userID string) (res []*domain.EventRegistration, err error) {
+	defer r.rec.observe("EventRegistrationRepository.ListByUserID", time.Now(), &err)
+	return r.next.ListByUserID(ctx, userID)
+}
+
+func (r *eventRegistrationRepository) CountActiveByEvent(ctx context.Context) (res []domain.EventCount, err error) {
+	defer r.rec.observe("EventRegistrationRepository.CountActiveByEvent", time.Now(), &err)
+	return r.next.CountActiveByEvent(ctx)
+}
+
+type eventRepository struct {
+	next domain.EventRepository
+	rec  *Recorder
+}
+
+// NewEventRepository returns next with every call recorded in rec under "EventRepository.<Method>".
+func NewEventRepository(next domain.EventRepository, rec *Recorder) domain.EventRepository {
+	return &eventRepository{next: next, rec: rec}
+}
+
+func (r *eventRepository) Create(ctx context.Context, event *domain.Event) (err error) {
+	defer r.rec.observe("EventRepository.Create", time.Now(), &err)
+	return r.next.Create(ctx, event)
+}
+
+func (r *eventRepository) GetByID(ctx context.Context, id string) (res *domain.Event, err error) {
+	defer r.rec.observe("EventRepository.GetByID", time.Now(), &err)
+	return r.next.GetByID(ctx, id)
+}
+
+func (r *eventRepository) GetByEventCode(ctx context.Context, eventCode string) (res *domain.Event, err error) {
+	defer r.rec.observe("EventRepository.GetByEventCode", time.Now(), &err)
+	return r.next.GetByEventCode(ctx, eventCode)
+}
+
+func (r *eventRepository) ListByOwnerID(ctx context.Context, ownerID string) (res []*domain.Event, err error) {
+	defer r.rec.observe("EventRepository.ListByOwnerID", time.Now(), &err)
+	return r.next.ListByOwnerID(ctx, ownerID)
+}
+
+func (r *eventRepository) ListByOwnerOrTeamMemberID(ctx context.Context, userID string) (res []*domain.Event, err error) {
+	defer r.rec.observe("EventRepository.ListByOwnerOrTeamMemberID", time.Now(), &err)
+	return r.next.ListByOwnerOrTeamMemberID(ctx, userID)
+}
+
+func (r *eventRepository) ListByTeamMemberID(ctx context.Context, userID string) (res []*domain.Event, err error) {
+	defer r.rec.observe("EventRepository.ListByTeamMemberID", time.Now(), &err)
+	return r.next.ListByTeamMemberID(ctx, userID)
+}
+
+func (r *eventRepository) Search(ctx context.Context, userID string, params domain.EventSearchParams) (res []*domain.EventSearchResult, total int, err error) {
+	defer r.rec.observe("EventRepository.Search", time.Now(), &err)
+	return r.next.Search(ctx, userID, params)
+}
+
+func (r *eventRepository) Update(ctx context.Context, eventID string, date *time.Time, description *string, locationLat, locationLng *float64) (res *domain.Event, err error) {
+	defer r.rec.observe("EventRepository.Update", time.Now(), &err)
+	return r.next.Update(ctx, eventID, date, description, locationLat, locationLng)
+}
+
+func (r *eventRepository) UpdateEventCode(ctx context.Context, eventID, code string) (res *domain.Event, err error) {
+	defer r.rec.observe("EventRepository.UpdateEventCode", time.Now(), &err)
+	return r.next.UpdateEventCode(ctx, eventID, code)
+}
+
+func (r *eventRepository) CountRegistrations(ctx context.Context, eventID string) (n int, err error) {
+	defer r.rec.observe("EventRepository.CountRegistrations", time.Now(), &err)
+	return r.next.CountRegistrations(ctx, eventID)
+}
+
+func (r *eventRepository) ListStats(ctx context.Context, eventIDs []string) (res map[string]*domain.EventStats, err error) {
+	defer r.rec.observe("EventRepository.ListStats", time.Now(), &err)
+	return r.next.ListStats(ctx, eventIDs)
+}
+
+func (r *eventRepository) Anonymize(ctx context.Context, eventID, anonymizedBy string) (res *domain.EventAnonymization, err error) {
+	defer r.rec.observe("EventRepository.Anonymize", time.Now(), &err)
+	return r.next.Anonymize(ctx, eventID, anonymizedBy)
+}
+
+func (r *eventRepository) PreviewAnonymize(ctx context.Context, eventID, anonymizedBy string) (res *domain.EventAnonymization, err error) {
+	defer r.rec.observe("EventRepository.PreviewAnonymize", time.Now(), &err)
+	return r.next.PreviewAnonymize(ctx, eventID, anonymizedBy)
+}
+
+func (r *eventRepository) GetTheme(ctx context.Context, eventID string) (res *domain.EventTheme, err error) {
+	defer r.rec.observe("EventRepository.GetTheme", time.Now(), &err)
+	return r.next.GetTheme(ctx, eventID)
+}
+
+func (r *eventRepository) UpsertTheme(ctx context.Context, theme *domain.EventTheme) (err error) {
+	defer r.rec.observe("EventRepository.UpsertTheme", time.Now(), &err)
+	return r.next.UpsertTheme(ctx, theme)
+}
+
+func (r *eventRepository) Delete(ctx context.Context, id string) (err error) {
+	defer r.rec.observe("EventRepository.Delete", time.Now(), &err)
+	return r.next.Delete(ctx, id)
+}
+
+type eventInvitationRepository struct {
+	next domain.EventInvitationRepository
+	rec  *Recorder
+}
+
+// NewEventInvitationRepository returns next with every call recorded in rec under "EventInvitationRepository.<Method>".
+func NewEventInvitationRepository(next domain.EventInvitationRepository, rec *Recorder) domain.EventInvitationRepository {
+	return &eventInvitationRepository{next: next, rec: rec}
+}
+
+func (r *eventInvitationRepository) Create(ctx context.Context, inv *domain.EventInvitation) (err error) {
+	defer r.rec.observe("EventInvitationRepository.Create", time.Now(), &err)
+	return r.next.Create(ctx, inv)
+}
+
+func (r *eventInvitationRepository) GetByID(ctx context.Context, id string) (res *domain.EventInvitation, err error) {
+	defer r.rec.observe("EventInvitationRepository.GetByID", time.Now(), &err)
+	return r.next.GetByID(ctx, id)
+}
+
+func (r *eventInvitationRepository) ListByEventID(ctx context.Context, eventID string, search string, params domain.PaginationParams) (res []*domain.EventInvitation, total int, err error) {
+	defer r.rec.observe("EventInvitationRepository.ListByEventID", time.Now(), &err)
+	return r.next.ListByEventID(ctx, eventID, search, params)
+}
+
+// The recorded latency includes the time fn spends writing each row to the client.
+func (r *eventInvitationRepository) StreamByEventID(ctx context.Context, eventID string, search string, fn func(*domain.EventInvitation) error) (err error) {
+	defer r.rec.observe("EventInvitationRepository.StreamByEventID", time.Now(), &err)
+	return r.next.StreamByEventID(ctx, eventID, search, fn)
+}
+
+func (r *eventInvitationRepository) ListPending(ctx context.Context, eventID string) (res []*domain.PendingInvitation, err error) {
+	defer r.rec.observe("EventInvitationRepository.ListPending", time.Now(), &err)
+	return r.next.ListPending(ctx, eventID)
+}
+
+func (r *eventInvitationRepository) MarkReminded(ctx context.Context, id string, at time.Time) (err error) {
+	defer r.rec.observe("EventInvitationRepository.MarkReminded", time.Now(), &err)
+	return r.next.MarkReminded(ctx, id, at)
+}
+
+func (r *eventInvitationRepository) GetDomainRules(ctx context.Context, eventID string) (res *domain.InvitationDomainRules, err error) {
+	defer r.rec.observe("EventInvitationRepository.GetDomainRules", time.Now(), &err)
+	return r.next.GetDomainRules(ctx, eventID)
+}
+
+func (r *eventInvitationRepository) UpsertDomainRules(ctx context.Context, rules *domain.InvitationDomainRules) (err error) {
+	defer r.rec.observe("EventInvitationRepository.UpsertDomainRules", time.Now(), &err)
+	return r.next.UpsertDomainRules(ctx, rules)
+}
+
+type eventTeamMemberRepository struct {
+	next domain.EventTeamMemberRepository
+	rec  *Recorder
+}
+
+// NewEventTeamMemberRepository returns next with every call recorded in rec under "EventTeamMemberRepository.<Method>".
+func NewEventTeamMemberRepository(next domain.EventTeamMemberRepository, rec *Recorder) domain.EventTeamMemberRepository {
+	return &eventTeamMemberRepository{next: next, rec: rec}
+}
+
+func (r *eventTeamMemberRepository) Add(ctx context.Context, eventID, userID string) (err error) {
+	defer r.rec.observe("EventTeamMemberRepository.Add", time.Now(), &err)
+	return r.next.Add(ctx, eventID, userID)
+}
+
+func (r *eventTeamMemberRepository) ListByEventID(ctx context.Context, eventID string) (res []*domain.EventTeamMember, err error) {
+	defer r.rec.observe("EventTeamMemberRepository.ListByEventID", time.Now(), &err)
+	return r.next.ListByEventID(ctx, eventID)
+}
+
+func (r *eventTeamMemberRepository) Remove(ctx context.Context, eventID, userID string) (err error) {
+	defer r.rec.observe("EventTeamMemberRepository.Remove", time.Now(), &err)
+	return r.next.Remove(ctx, eventID, userID)
+}
+
+type sessionRepository struct {
+	next domain.SessionRepository
+	rec  *Recorder
+}
+
+// NewSessionRepository returns next with every call recorded in rec under "SessionRepository.<Method>".
+func NewSessionRepository(next domain.SessionRepository, rec *Recorder) domain.SessionRepository {
+	return &sessionRepository{next: next, rec: rec}
+}
+
+func (r *sessionRepository) CreateRoom(ctx context.Context, room *domain.Room) (err error) {
+	defer r.rec.observe("SessionRepository.CreateRoom", time.Now(), &err)
+	return r.next.CreateRoom(ctx, room)
+}
+
+func (r *sessionRepository) CreateSession(ctx context.Context, session *domain.Session) (err error) {
+	defer r.rec.observe("SessionRepository.CreateSession", time.Now(), &err)
+	return r.next.CreateSession(ctx, session)
+}
+
+func (r *sessionRepository) CreateSpeaker(ctx context.Context, speaker *domain.Speaker) (err error) {
+	defer r.rec.observe("SessionRepository.CreateSpeaker", time.Now(), &err)
+	return r.next.CreateSpeaker(ctx, speaker)
+}
+
+func (r *sessionRepository) CreateSessionSpeaker(ctx context.Context, sessionID, speakerID string) (err error) {
+	defer r.rec.observe("SessionRepository.CreateSessionSpeaker", time.Now(), &err)
+	return r.next.CreateSessionSpeaker(ctx, sessionID, speakerID)
+}
+
+func (r *sessionRepository) DeleteSessionSpeaker(ctx context.Context, sessionID, speakerID string) (err error) {
+	defer r.rec.observe("SessionRepository.DeleteSessionSpeaker", time.Now(), &err)
+	return r.next.DeleteSessionSpeaker(ctx, sessionID, speakerID)
+}
+
+func (r *sessionRepository) SetSessionSpeakers(ctx context.Context, sessionID string, speakerIDs []string) (err error) {
+	defer r.rec.observe("SessionRepository.SetSessionSpeakers", time.Now(), &err)
+	return r.next.SetSessionSpeakers(ctx, sessionID, speakerIDs)
+}
+
+func (r *sessionRepository) PruneScheduleByEventID(ctx context.Context, eventID string, keepRoomIDs, keepSessionIDs []string) (err error) {
+	defer r.rec.observe("SessionRepository.PruneScheduleByEventID", time.Now(), &err)
+	return r.next.PruneScheduleByEventID(ctx, eventID, keepRoomIDs, keepSessionIDs)
+}
+
+func (r *sessionRepository) PruneSpeakersByEventID(ctx context.Context, eventID string, keepSpeakerIDs []string) (err error) {
+	defer r.rec.observe("SessionRepository.PruneSpeakersByEventID", time.Now(), &err)
+	return r.next.PruneSpeakersByEventID(ctx, eventID, keepSpeakerIDs)
+}
+
+func (r *sessionRepository) UpdateSpeakerSource(ctx context.Context, speakerID, source, sourceSessionID string) (err error) {
+	defer r.rec.observe("SessionRepository.UpdateSpeakerSource", time.Now(), &err)
+	return r.next.UpdateSpeakerSource(ctx, speakerID, source, sourceSessionID)
+}
+
+func (r *sessionRepository) MergeSpeakers(ctx context.Context, keepID, dropID string) (err error) {
+	defer r.rec.observe("SessionRepository.MergeSpeakers", time.Now(), &err)
+	return r.next.MergeSpeakers(ctx, keepID, dropID)
+}
+
+func (r *sessionRepository) UpdateSpeakers(ctx context.Context, updates []*domain.SpeakerUpdate) (res []*domain.Speaker, err error) {
+	defer r.rec.observe("SessionRepository.UpdateSpeakers", time.Now(), &err)
+	return r.next.UpdateSpeakers(ctx, updates)
+}
+
+func (r *sessionRepository) GetSessionByID(ctx context.Context, sessionID string) (res *domain.Session, err error) {
+	defer r.rec.observe("SessionRepository.GetSessionByID", time.Now(), &err)
+	return r.next.GetSessionByID(ctx, sessionID)
+}
+
+func (r *sessionRepository) GetRoomByID(ctx context.Context, roomID string) (res *domain.Room, err error) {
+	defer r.rec.observe("SessionRepository.GetRoomByID", time.Now(), &err)
+	return r.next.GetRoomByID(ctx, roomID)
+}
+
+func (r *sessionRepository) ListRoomsByEventID(ctx context.Context, eventID string) (res []*domain.Room, err error) {
+	defer r.rec.observe("SessionRepository.ListRoomsByEventID", time.Now(), &err)
+	return r.next.ListRoomsByEventID(ctx, eventID)
+}
+
+func (r *sessionRepository) ReorderRooms(ctx context.Context, eventID string, roomIDs []string) (err error) {
+	defer r.rec.observe("SessionRepository.ReorderRooms", time.Now(), &err)
+	return r.next.ReorderRooms(ctx, eventID, roomIDs)
+}
+
+func (r *sessionRepository) ListTrackOrder(ctx context.Context, eventID string) (res []string, err error) {
+	defer r.rec.observe("SessionRepository.ListTrackOrder", time.Now(), &err)
+	return r.next.ListTrackOrder(ctx, eventID)
+}
+
+func (r *sessionRepository) ReorderTracks(ctx context.Context, eventID string, tracks []string) (err error) {
+	defer r.rec.observe("SessionRepository.ReorderTracks", time.Now(), &err)
+	return r.next.ReorderTracks(ctx, eventID, tracks)
+}
+
+func (r *sessionRepository) ListSessionsByEventID(ctx context.Context, eventID string) (res []*domain.Session, err error) {
+	defer r.rec.observe("SessionRepository.ListSessionsByEventID", time.Now(), &err)
+	return r.next.ListSessionsByEventID(ctx, eventID)
+}
+
+// The recorded latency includes the time fn spends writing each row to the client.
+func (r *sessionRepository) StreamSessionsByEventID(ctx context.Context, eventID string, fn func(*domain.Session) error) (err error) {
+	defer r.rec.observe("SessionRepository.StreamSessionsByEventID", time.Now(), &err)
+	return r.next.StreamSessionsByEventID(ctx, eventID, fn)
+}
+
+func (r *sessionRepository) ListSpeakerIDsBySessionIDs(ctx context.Context, sessionIDs []string) (res map[string][]string, err error) {
+	defer r.rec.observe("SessionRepository.ListSpeakerIDsBySessionIDs", time.Now(), &err)
+	return r.next.ListSpeakerIDsBySessionIDs(ctx, sessionIDs)
+}
+
+func (r *sessionRepository) GetSpeakerByID(ctx context.Context, speakerID string) (res *domain.Speaker, err error) {
+	defer r.rec.observe("SessionRepository.GetSpeakerByID", time.Now(), &err)
+	return r.next.GetSpeakerByID(ctx, speakerID)
+}
+
+func (r *sessionRepository) ListSpeakersByEventID(ctx context.Context, eventID string) (res []*domain.Speaker, err error) {
+	defer r.rec.observe("SessionRepository.ListSpeakersByEventID", time.Now(), &err)
+	return r.next.ListSpeakersByEventID(ctx, eventID)
+}
+
+func (r *sessionRepository) ListSpeakersBySessionID(ctx context.Context, sessionID string) (res []*domain.Speaker, err error) {
+	defer r.rec.observe("SessionRepository.ListSpeakersBySessionID", time.Now(), &err)
+	return r.next.ListSpeakersBySessionID(ctx, sessionID)
+}
+
+func (r *sessionRepository) ListSessionIDsBySpeakerID(ctx context.Context, speakerID string) (res []string, err error) {
+	defer r.rec.observe("SessionRepository.ListSessionIDsBySpeakerID", time.Now(), &err)
+	return r.next.ListSessionIDsBySpeakerID(ctx, speakerID)
+}
+
+func (r *sessionRepository) ListSessionsByIDs(ctx context.Context, sessionIDs []string) (res []*domain.Session, err error) {
+	defer r.rec.observe("SessionRepository.ListSessionsByIDs", time.Now(), &err)
+	return r.next.ListSessionsByIDs(ctx, sessionIDs)
+}
+
+func (r *sessionRepository) DeleteSpeaker(ctx context.Context, speakerID string) (err error) {
+	defer r.rec.observe("SessionRepository.DeleteSpeaker", time.Now(), &err)
+	return r.next.DeleteSpeaker(ctx, speakerID)
+}
+
+func (r *sessionRepository) SetRoomNotBookable(ctx context.Context, roomID string, notBookable bool) (res *domain.Room, err error) {
+	defer r.rec.observe("SessionRepository.SetRoomNotBookable", time.Now(), &err)
+	return r.next.SetRoomNotBookable(ctx, roomID, notBookable)
+}
+
+func (r *sessionRepository) UpdateRoomDetails(ctx context.Context, roomID string, name string, capacity int, description, howToGetThere string, notBookable bool) (res *domain.Room, err error) {
+	defer r.rec.observe("SessionRepository.UpdateRoomDetails", time.Now(), &err)
+	return r.next.UpdateRoomDetails(ctx, roomID, name, capacity, description, howToGetThere, notBookable)
+}
+
+func (r *sessionRepository) DeleteRoom(ctx context.Context, roomID string) (err error) {
+	defer r.rec.observe("SessionRepository.DeleteRoom", time.Now(), &err)
+	return r.next.DeleteRoom(ctx, roomID)
+}
+
+func (r *sessionRepository) DeleteRoomKeepSessions(ctx context.Context, roomID string, targetRoomID *string) (err error) {
+	defer r.rec.observe("SessionRepository.DeleteRoomKeepSessions", time.Now(), &err)
+	return r.next.DeleteRoomKeepSessions(ctx, roomID, targetRoomID)
+}
+
+func (r *sessionRepository) DeleteSession(ctx context.Context, sessionID string) (err error) {
+	defer r.rec.observe("SessionRepository.DeleteSession", time.Now(), &err)
+	return r.next.DeleteSession(ctx, sessionID)
+}
+
+func (r *sessionRepository) UpdateSessionSchedule(ctx context.Context, sessionID string, roomID *string, startTime, endTime *time.Time) (res *domain.Session, err error) {
+	defer r.rec.observe("SessionRepository.UpdateSessionSchedule", time.Now(), &err)
+	return r.next.UpdateSessionSchedule(ctx, sessionID, roomID, startTime, endTime)
+}
+
+func (r *sessionRepository) UpdateSessionContent(ctx context.Context, sessionID string, title *string, description *string) (res *domain.Session, err error) {
+	defer r.rec.observe("SessionRepository.UpdateSessionContent", time.Now(), &err)
+	return r.next.UpdateSessionContent(ctx, sessionID, title, description)
+}
+
+type tagRepository struct {
+	next domain.TagRepository
+	rec  *Recorder
+}
+
+// NewTagRepository returns next with every call recorded in rec under "TagRepository.<Method>".
+func NewTagRepository(next domain.TagRepository, rec *Recorder) domain.TagRepository {
+	return &tagRepository{next: next, rec: rec}
+}
+
+func (r *tagRepository) EnsureTagForEvent(ctx context.Context, eventID, tagName string) (tagID string, err error) {
+	defer r.rec.observe("TagRepository.EnsureTagForEvent", time.Now(), &err)
+	return r.next.EnsureTagForEvent(ctx, eventID, tagName)
+}
+
+func (r *tagRepository) SetSessionTags(ctx context.Context, sessionID string, tagIDs []string) (err error) {
+	defer r.rec.observe("TagRepository.SetSessionTags", time.Now(), &err)
+	return r.next.SetSessionTags(ctx, sessionID, tagIDs)
+}
+
+func (r *tagRepository) ListTagsByEventID(ctx context.Context, eventID string) (res []*domain.Tag, err error) {
+	defer r.rec.observe("TagRepository.ListTagsByEventID", time.Now(), &err)
+	return r.next.ListTagsByEventID(ctx, eventID)
+}
+
+func (r *tagRepository) AddSessionTag(ctx context.Context, sessionID, tagID string) (err error) {
+	defer r.rec.observe("TagRepository.AddSessionTag", time.Now(), &err)
+	return r.next.AddSessionTag(ctx, sessionID, tagID)
+}
+
+func (r *tagRepository) RemoveSessionTag(ctx context.Context, sessionID, tagID string) (err error) {
+	defer r.rec.observe("TagRepository.RemoveSessionTag", time.Now(), &err)
+	return r.next.RemoveSessionTag(ctx, sessionID, tagID)
+}
+
+func (r *tagRepository) RemoveEventTag(ctx context.Context, eventID, tagID string) (err error) {
+	defer r.rec.observe("TagRepository.RemoveEventTag", time.Now(), &err)
+	return r.next.RemoveEventTag(ctx, eventID, tagID)
+}
+
+func (r *tagRepository) UpdateTagName(ctx context.Context, tagID, name string) (err error) {
+	defer r.rec.observe("TagRepository.UpdateTagName", time.Now(), &err)
+	return r.next.UpdateTagName(ctx, tagID, name)
+}
+
+func (r *tagRepository) GetTagByID(ctx context.Context, tagID string) (res *domain.Tag, err error) {
+	defer r.rec.observe("TagRepository.GetTagByID", time.Now(), &err)
+	return r.next.GetTagByID(ctx, tagID)
+}
+
+type userRepository struct {
+	next domain.UserRepository
+	rec  *Recorder
+}
+
+// NewUserRepository returns next with every call recorded in rec under "UserRepository.<Method>".
+func NewUserRepository(next domain.UserRepository, rec *Recorder) domain.UserRepository {
+	return &userRepository{next: next, rec: rec}
+}
+
+func (r *userRepository) Create(ctx context.Context, user *domain.User) (err error) {
+	defer r.rec.observe("UserRepository.Create", time.Now(), &err)
+	return r.next.Create(ctx, user)
+}
+
+func (r *userRepository) GetByEmail(ctx context.Context, email string) (res *domain.User, err error) {
+	defer r.rec.observe("UserRepository.GetByEmail", time.Now(), &err)
+	return r.next.GetByEmail(ctx, email)
+}
+
+func (r *userRepository) GetByID(ctx context.Context, id string) (res *domain.User, err error) {
+	defer r.rec.observe("UserRepository.GetByID", time.Now(), &err)
+	return r.next.GetByID(ctx, id)
+}
+
+func (r *userRepository) Update(ctx context.Context, user *domain.User) (err error) {
+	defer r.rec.observe("UserRepository.Update", time.Now(), &err)
+	return r.next.Update(ctx, user)
+}
+
+func (r *userRepository) AssignRole(ctx context.Context, userID, roleID string) (err error) {
+	defer r.rec.observe("UserRepository.AssignRole", time.Now(), &err)
+	return r.next.AssignRole(ctx, userID, roleID)
+}
+
+type loginCodeRepository struct {
+	next domain.LoginCodeRepository
+	rec  *Recorder
+}
+
+// NewLoginCodeRepository returns next with every call recorded in rec under "LoginCodeRepository.<Method>".
+func NewLoginCodeRepository(next domain.LoginCodeRepository, rec *Recorder) domain.LoginCodeRepository {
+	return &loginCodeRepository{next: next, rec: rec}
+}
+
+func (r *loginCodeRepository) Create(ctx context.Context, email, codeHash string, expiresAt time.Time) (err error) {
+	defer r.rec.observe("LoginCodeRepository.Create", time.Now(), &err)
+	return r.next.Create(ctx, email, codeHash, expiresAt)
+}
+
+func (r *loginCodeRepository) Consume(ctx context.Context, email, codeHash string) (consumed bool, err error) {
+	defer r.rec.observe("LoginCodeRepository.Consume", time.Now(), &err)
+	return r.next.Consume(ctx, email, codeHash)
+}
+
+type roleRepository struct {
+	next domain.RoleRepository
+	rec  *Recorder
+}
+
+// NewRoleRepository returns next with every call recorded in rec under "RoleRepository.<Method>".
+func NewRoleRepository(next domain.RoleRepository, rec *Recorder) domain.RoleRepository {
+	return &roleRepository{next: next, rec: rec}
+}
+
+func (r *roleRepository) GetByCode(ctx context.Context, code string) (res *domain.Role, err error) {
+	defer r.rec.observe("RoleRepository.GetByCode", time.Now(), &err)
+	return r.next.GetByCode(ctx, code)
+}
+
+func (r *roleRepository) ListByUserID(ctx context.Context, userID string) (res []*domain.Role, err error) {
+	defer r.rec.observe("RoleRepository.ListByUserID", time.Now(), &err)
+	return r.next.ListByUserID(ctx, userID)
+}
+
+type importMappingRepository struct {
+	next domain.ImportMappingRepository
+	rec  *Recorder
+}
+
+// NewImportMappingRepository returns next with every call recorded in rec under "ImportMappingRepository.<Method>".
+func NewImportMappingRepository(next domain.ImportMappingRepository, rec *Recorder) domain.ImportMappingRepository {
+	return &importMappingRepository{next: next, rec: rec}
+}
+
+func (r *importMappingRepository) GetByEventID(ctx context.Context, eventID string) (res *domain.ImportMapping, err error) {
+	defer r.rec.observe("ImportMappingRepository.GetByEventID", time.Now(), &err)
+	return r.next.GetByEventID(ctx, eventID)
+}
+
+func (r *importMappingRepository) Upsert(ctx context.Context, mapping *domain.ImportMapping) (err error) {
+	defer r.rec.observe("ImportMappingRepository.Upsert", time.Now(), &err)
+	return r.next.Upsert(ctx, mapping)
+}
+
+type scheduleRulesRepository struct {
+	next domain.ScheduleRulesRepository
+	rec  *Recorder
+}
+
+// NewScheduleRulesRepository returns next with every call recorded in rec under "ScheduleRulesRepository.<Method>".
+func NewScheduleRulesRepository(next domain.ScheduleRulesRepository, rec *Recorder) domain.ScheduleRulesRepository {
+	return &scheduleRulesRepository{next: next, rec: rec}
+}
+
+func (r *scheduleRulesRepository) GetByEventID(ctx context.Context, eventID string) (res *domain.ScheduleRules, err error) {
+	defer r.rec.observe("ScheduleRulesRepository.GetByEventID", time.Now(), &err)
+	return r.next.GetByEventID(ctx, eventID)
+}
+
+func (r *scheduleRulesRepository) Upsert(ctx context.Context, rules *domain.ScheduleRules) (err error) {
+	defer r.rec.observe("ScheduleRulesRepository.Upsert", time.Now(), &err)
+	return r.next.Upsert(ctx, rules)
+}
+
+type operatingHoursRepository struct {
+	next domain.OperatingHoursRepository
+	rec  *Recorder
+}
+
+// NewOperatingHoursRepository returns next with every call recorded in rec under "OperatingHoursRepository.<Method>".
+func NewOperatingHoursRepository(next domain.OperatingHoursRepository, rec *Recorder) domain.OperatingHoursRepository {
+	return &operatingHoursRepository{next: next, rec: rec}
+}
+
+func (r *operatingHoursRepository) ListByEventID(ctx context.Context, eventID string) (res []*domain.OperatingDay, err error) {
+	defer r.rec.observe("OperatingHoursRepository.ListByEventID", time.Now(), &err)
+	return r.next.ListByEventID(ctx, eventID)
+}
+
+func (r *operatingHoursRepository) Replace(ctx context.Context, eventID string, days []*domain.OperatingDay) (err error) {
+	defer r.rec.observe("OperatingHoursRepository.Replace", time.Now(), &err)
+	return r.next.Replace(ctx, eventID, days)
+}
+
+type speakerMergeRepository struct {
+	next domain.SpeakerMergeRepository
+	rec  *Recorder
+}
+
+// NewSpeakerMergeRepository returns next with every call recorded in rec under "SpeakerMergeRepository.<Method>".
+func NewSpeakerMergeRepository(next domain.SpeakerMergeRepository, rec *Recorder) domain.SpeakerMergeRepository {
+	return &speakerMergeRepository{next: next, rec: rec}
+}
+
+func (r *speakerMergeRepository) Create(ctx context.Context, candidate *domain.SpeakerMergeCandidate) (err error) {
+	defer r.rec.observe("SpeakerMergeRepository.Create", time.Now(), &err)
+	return r.next.Create(ctx, candidate)
+}
+
+func (r *speakerMergeRepository) GetByID(ctx context.Context, id string) (res *domain.SpeakerMergeCandidate, err error) {
+	defer r.rec.observe("SpeakerMergeRepository.GetByID", time.Now(), &err)
+	return r.next.GetByID(ctx, id)
+}
+
+func (r *speakerMergeRepository) ListPendingByEventID(ctx context.Context, eventID string) (res []*domain.SpeakerMergeCandidate, err error) {
+	defer r.rec.observe("SpeakerMergeRepository.ListPendingByEventID", time.Now(), &err)
+	return r.next.ListPendingByEventID(ctx, eventID)
+}
+
+func (r *speakerMergeRepository) Resolve(ctx context.Context, id string, status string) (res *domain.SpeakerMergeCandidate, err error) {
+	defer r.rec.observe("SpeakerMergeRepository.Resolve", time.Now(), &err)
+	return r.next.Resolve(ctx, id, status)
+}
+
+type integrityRepository struct {
+	next domain.IntegrityRepository
+	rec  *Recorder
+}
+
+// NewIntegrityRepository returns next with every call recorded in rec under "IntegrityRepository.<Method>".
+func NewIntegrityRepository(next domain.IntegrityRepository, rec *Recorder) domain.IntegrityRepository {
+	return &integrityRepository{next: next, rec: rec}
+}
+
+func (r *integrityRepository) FindIssues(ctx context.Context, eventID string) (res []*domain.IntegrityIssue, err error) {
+	defer r.rec.observe("IntegrityRepository.FindIssues", time.Now(), &err)
+	return r.next.FindIssues(ctx, eventID)
+}
+
+func (r *integrityRepository) Cleanup(ctx context.Context, eventID string) (res int64, err error) {
+	defer r.rec.observe("IntegrityRepository.Cleanup", time.Now(), &err)
+	return r.next.Cleanup(ctx, eventID)
+}
+
+func (r *integrityRepository) ListEventIDs(ctx context.Context) (res []string, err error) {
+	defer r.rec.observe("IntegrityRepository.ListEventIDs", time.Now(), &err)
+	return r.next.ListEventIDs(ctx)
+}
+
+func (r *integrityRepository) DeleteOrphanTags(ctx context.Context) (res int64, err error) {
+	defer r.rec.observe("IntegrityRepository.DeleteOrphanTags", time.Now(), &err)
+	return r.next.DeleteOrphanTags(ctx)
+}
+
+type sessionChangeRepository struct {
+	next domain.SessionChangeRepository
+	rec  *Recorder
+}
+
+// NewSessionChangeRepository returns next with every call recorded in rec under "SessionChangeRepository.<Method>".
+func NewSessionChangeRepository(next domain.SessionChangeRepository, rec *Recorder) domain.SessionChangeRepository {
+	return &sessionChangeRepository{next: next, rec: rec}
+}
+
+func (r *sessionChangeRepository) Create(ctx context.Context, change *domain.SessionChange) (err error) {
+	defer r.rec.observe("SessionChangeRepository.Create", time.Now(), &err)
+	return r.next.Create(ctx, change)
+}
+
+func (r *sessionChangeRepository) ListBySessionID(ctx context.Context, sessionID string) (res []*domain.SessionChange, err error) {
+	defer r.rec.observe("SessionChangeRepository.ListBySessionID", time.Now(), &err)
+	return r.next.ListBySessionID(ctx, sessionID)
+}
+
+func (r *sessionChangeRepository) ListByEventID(ctx context.Context, eventID string, since time.Time, limit int) (res []*domain.SessionChange, err error) {
+	defer r.rec.observe("SessionChangeRepository.ListByEventID", time.Now(), &err)
+	return r.next.ListByEventID(ctx, eventID, since, limit)
+}
+
+func (r *sessionChangeRepository) ListAfter(ctx context.Context, eventID string, after time.Time) (res []*domain.SessionChange, err error) {
+	defer r.rec.observe("SessionChangeRepository.ListAfter", time.Now(), &err)
+	return r.next.ListAfter(ctx, eventID, after)
+}
+
+func (r *sessionChangeRepository) ListDeletedSessionIDs(ctx context.Context, eventID string, after time.Time) (ids []string, err error) {
+	defer r.rec.observe("SessionChangeRepository.ListDeletedSessionIDs", time.Now(), &err)
+	return r.next.ListDeletedSessionIDs(ctx, eventID, after)
+}
+
+type checklistRepository struct {
+	next domain.ChecklistRepository
+	rec  *Recorder
+}
+
+// NewChecklistRepository returns next with every call recorded in rec under "ChecklistRepository.<Method>".
+func NewChecklistRepository(next domain.ChecklistRepository, rec *Recorder) domain.ChecklistRepository {
+	return &checklistRepository{next: next, rec: rec}
+}
+
+func (r *checklistRepository) ListItems(ctx context.Context, eventID string) (res []*domain.ChecklistItem, err error) {
+	defer r.rec.observe("ChecklistRepository.ListItems", time.Now(), &err)
+	return r.next.ListItems(ctx, eventID)
+}
+
+func (r *checklistRepository) ReplaceItems(ctx context.Context, eventID string, labels []string) (res []*domain.ChecklistItem, err error) {
+	defer r.rec.observe("ChecklistRepository.ReplaceItems", time.Now(), &err)
+	return r.next.ReplaceItems(ctx, eventID, labels)
+}
+
+func (r *checklistRepository) ListCompletions(ctx context.Context, eventID string) (res []*domain.ChecklistCompletion, err error) {
+	defer r.rec.observe("ChecklistRepository.ListCompletions", time.Now(), &err)
+	return r.next.ListCompletions(ctx, eventID)
+}
+
+func (r *checklistRepository) SetCompletion(ctx context.Context, completion *domain.ChecklistCompletion) (err error) {
+	defer r.rec.observe("ChecklistRepository.SetCompletion", time.Now(), &err)
+	return r.next.SetCompletion(ctx, completion)
+}
+
+func (r *checklistRepository) DeleteCompletion(ctx context.Context, sessionID, itemID string) (err error) {
+	defer r.rec.observe("ChecklistRepository.DeleteCompletion", time.Now(), &err)
+	return r.next.DeleteCompletion(ctx, sessionID, itemID)
+}
+
+type scheduleGridRepository struct {
+	next domain.ScheduleGridRepository
+	rec  *Recorder
+}
+
+// NewScheduleGridRepository returns next with every call recorded in rec under "ScheduleGridRepository.<Method>".
+func NewScheduleGridRepository(next domain.ScheduleGridRepository, rec *Recorder) domain.ScheduleGridRepository {
+	return &scheduleGridRepository{next: next, rec: rec}
+}
+
+func (r *scheduleGridRepository) Get(ctx context.Context, eventID string) (res *domain.ScheduleGrid, err error) {
+	defer r.rec.observe("ScheduleGridRepository.Get", time.Now(), &err)
+	return r.next.Get(ctx, eventID)
+}
+
+func (r *scheduleGridRepository) Save(ctx context.Context, grid *domain.ScheduleGrid) (err error) {
+	defer r.rec.observe("ScheduleGridRepository.Save", time.Now(), &err)
+	return r.next.Save(ctx, grid)
+}
+
+func (r *scheduleGridRepository) Delete(ctx context.Context, eventID string) (err error) {
+	defer r.rec.observe("ScheduleGridRepository.Delete", time.Now(), &err)
+	return r.next.Delete(ctx, eventID)
+}
+
+type syncRepository struct {
+	next domain.SyncRepository
+	rec  *Recorder
+}
+
+// NewSyncRepository returns next with every call recorded in rec under "SyncRepository.<Method>".
+func NewSyncRepository(next domain.SyncRepository, rec *Recorder) domain.SyncRepository {
+	return &syncRepository{next: next, rec: rec}
+}
+
+func (r *syncRepository) ListChanges(ctx context.Context, eventID string, since domain.SyncCursor, limit int) (res []*domain.SyncChange, err error) {
+	defer r.rec.observe("SyncRepository.ListChanges", time.Now(), &err)
+	return r.next.ListChanges(ctx, eventID, since, limit)
+}
+
+type customFieldRepository struct {
+	next domain.CustomFieldRepository
+	rec  *Recorder
+}
+
+// NewCustomFieldRepository returns next with every call recorded in rec under "CustomFieldRepository.<Method>".
+func NewCustomFieldRepository(next domain.CustomFieldRepository, rec *Recorder) domain.CustomFieldRepository {
+	return &customFieldRepository{next: next, rec: rec}
+}
+
+func (r *customFieldRepository) ListFields(ctx context.Context, eventID string) (res []*domain.CustomField, err error) {
+	defer r.rec.observe("CustomFieldRepository.ListFields", time.Now(), &err)
+	return r.next.ListFields(ctx, eventID)
+}
+
+func (r *customFieldRepository) GetField(ctx context.Context, fieldID string) (res *domain.CustomField, err error) {
+	defer r.rec.observe("CustomFieldRepository.GetField", time.Now(), &err)
+	return r.next.GetField(ctx, fieldID)
+}
+
+func (r *customFieldRepository) CreateField(ctx context.Context, field *domain.CustomField) (err error) {
+	defer r.rec.observe("CustomFieldRepository.CreateField", time.Now(), &err)
+	return r.next.CreateField(ctx, field)
+}
+
+func (r *customFieldRepository) UpdateField(ctx context.Context, fieldID string, name *string, public *bool) (res *domain.CustomField, err error) {
+	defer r.rec.observe("CustomFieldRepository.UpdateField", time.Now(), &err)
+	return r.next.UpdateField(ctx, fieldID, name, public)
+}
+
+func (r *customFieldRepository) DeleteField(ctx context.Context, fieldID string) (err error) {
+	defer r.rec.observe("CustomFieldRepository.DeleteField", time.Now(), &err)
+	return r.next.DeleteField(ctx, fieldID)
+}
+
+func (r *customFieldRepository) SetValues(ctx context.Context, appliesTo, entityID string, values []*domain.CustomFieldValue) (res []*domain.CustomFieldValue, err error) {
+	defer r.rec.observe("CustomFieldRepository.SetValues", time.Now(), &err)
+	return r.next.SetValues(ctx, appliesTo, entityID, values)
+}
+
+func (r *customFieldRepository) ListValues(ctx context.Context, eventID, appliesTo string, publicOnly bool) (res map[string][]*domain.CustomFieldValue, err error) {
+	defer r.rec.observe("CustomFieldRepository.ListValues", time.Now(), &err)
+	return r.next.ListValues(ctx, eventID, appliesTo, publicOnly)
+}
+
+type retentionRepository struct {
+	next domain.RetentionRepository
+	rec  *Recorder
+}
+
+// NewRetentionRepository returns next with every call recorded in rec under "RetentionRepository.<Method>".
+func NewRetentionRepository(next domain.RetentionRepository, rec *Recorder) domain.RetentionRepository {
+	return &retentionRepository{next: next, rec: rec}
+}
+
+func (r *retentionRepository) CountExpired(ctx context.Context, dataClass string, cutoff time.Time) (res int64, err error) {
+	defer r.rec.observe("RetentionRepository.CountExpired", time.Now(), &err)
+	return r.next.CountExpired(ctx, dataClass, cutoff)
+}
+
+func (r *retentionRepository) PurgeExpired(ctx context.Context, dataClass string, cutoff time.Time) (res int64, err error) {
+	defer r.rec.observe("RetentionRepository.PurgeExpired", time.Now(), &err)
+	return r.next.PurgeExpired(ctx, dataClass, cutoff)
+}
+
+type eventEmailRepository struct {
+	next domain.EventEmailRepository
+	rec  *Recorder
+}
+
+// NewEventEmailRepository returns next with every call recorded in rec under "EventEmailRepository.<Method>".
+func NewEventEmailRepository(next domain.EventEmailRepository, rec *Recorder) domain.EventEmailRepository {
+	return &eventEmailRepository{next: next, rec: rec}
+}
+
+func (r *eventEmailRepository) Create(ctx context.Context, email *domain.EventEmail) (err error) {
+	defer r.rec.observe("EventEmailRepository.Create", time.Now(), &err)
+	return r.next.Create(ctx, email)
+}
+
+func (r *eventEmailRepository) GetByID(ctx context.Context, id string) (res *domain.EventEmail, err error) {
+	defer r.rec.observe("EventEmailRepository.GetByID", time.Now(), &err)
+	return r.next.GetByID(ctx, id)
+}
+
+func (r *eventEmailRepository) ListByEventID(ctx context.Context, eventID string, filter domain.EventEmailFilter, params domain.PaginationParams) (res []*domain.EventEmail, total int, err error) {
+	defer r.rec.observe("EventEmailRepository.ListByEventID", time.Now(), &err)
+	return r.next.ListByEventID(ctx, eventID, filter, params)
+}
+
+type announcementRepository struct {
+	next domain.AnnouncementRepository
+	rec  *Recorder
+}
+
+// NewAnnouncementRepository returns next with every call recorded in rec under "AnnouncementRepository.<Method>".
+func NewAnnouncementRepository(next domain.AnnouncementRepository, rec *Recorder) domain.AnnouncementRepository {
+	return &announcementRepository{next: next, rec: rec}
+}
+
+func (r *announcementRepository) ListPublished(ctx context.Context, userID string, now time.Time, params domain.PaginationParams) (items []*domain.Announcement, total, unread int, err error) {
+	defer r.rec.observe("AnnouncementRepository.ListPublished", time.Now(), &err)
+	return r.next.ListPublished(ctx, userID, now, params)
+}
+
+func (r *announcementRepository) ListAll(ctx context.Context, params domain.PaginationParams) (res []*domain.Announcement, total int, err error) {
+	defer r.rec.observe("AnnouncementRepository.ListAll", time.Now(), &err)
+	return r.next.ListAll(ctx, params)
+}
+
+func (r *announcementRepository) Create(ctx context.Context, a *domain.Announcement, createdBy string) (err error) {
+	defer r.rec.observe("AnnouncementRepository.Create", time.Now(), &err)
+	return r.next.Create(ctx, a, createdBy)
+}
+
+func (r *announcementRepository) Update(ctx context.Context, a *domain.Announcement) (err error) {
+	defer r.rec.observe("AnnouncementRepository.Update", time.Now(), &err)
+	return r.next.Update(ctx, a)
+}
+
+func (r *announcementRepository) Delete(ctx context.Context, id string) (err error) {
+	defer r.rec.observe("AnnouncementRepository.Delete", time.Now(), &err)
+	return r.next.Delete(ctx, id)
+}
+
+func (r *announcementRepository) MarkRead(ctx context.Context, userID string, ids []string, now time.Time) (err error) {
+	defer r.rec.observe("AnnouncementRepository.MarkRead", time.Now(), &err)
+	return r.next.MarkRead(ctx, userID, ids, now)
+}
+
+type contactRepository struct {
+	next domain.ContactRepository
+	rec  *Recorder
+}
+
+// NewContactRepository returns next with every call recorded in rec under "ContactRepository.<Method>".
+func NewContactRepository(next domain.ContactRepository, rec *Recorder) domain.ContactRepository {
+	return &contactRepository{next: next, rec: rec}
+}
+
+func (r *contactRepository) CreateThread(ctx context.Context, t *domain.ContactThread, first *domain.ContactMessage, remoteIP string) (err error) {
+	defer r.rec.observe("ContactRepository.CreateThread", time.Now(), &err)
+	return r.next.CreateThread(ctx, t, first, remoteIP)
+}
+
+func (r *contactRepository) CountRecentThreads(ctx context.Context, remoteIP, email string, since time.Time) (byIP, byEmail int, err error) {
+	defer r.rec.observe("ContactRepository.CountRecentThreads", time.Now(), &err)
+	return r.next.CountRecentThreads(ctx, remoteIP, email, since)
+}
+
+func (r *contactRepository) ListThreads(ctx context.Context, eventID string, params domain.PaginationParams) (res []*domain.ContactThread, total int, err error) {
+	defer r.rec.observe("ContactRepository.ListThreads", time.Now(), &err)
+	return r.next.ListThreads(ctx, eventID, params)
+}
+
+func (r *contactRepository) GetThread(ctx context.Context, threadID string) (res *domain.ContactThread, err error) {
+	defer r.rec.observe("ContactRepository.GetThread", time.Now(), &err)
+	return r.next.GetThread(ctx, threadID)
+}
+
+func (r *contactRepository) AddMessage(ctx context.Context, m *domain.ContactMessage) (err error) {
+	defer r.rec.observe("ContactRepository.AddMessage", time.Now(), &err)
+	return r.next.AddMessage(ctx, m)
+}
+
+type abuseReportRepository struct {
+	next domain.AbuseReportRepository
+	rec  *Recorder
+}
+
+// NewAbuseReportRepository returns next with every call recorded in rec under "AbuseReportRepository.<Method>".
+func NewAbuseReportRepository(next domain.AbuseReportRepository, rec *Recorder) domain.AbuseReportRepository {
+	return &abuseReportRepository{next: next, rec: rec}
+}
+
+func (r *abuseReportRepository) Create(ctx context.Context, rep *domain.AbuseReport) (created bool, err error) {
+	defer r.rec.observe("AbuseReportRepository.Create", time.Now(), &err)
+	return r.next.Create(ctx, rep)
+}
+
+func (r *abuseReportRepository) CountOpenReporters(ctx context.Context, target domain.AbuseTarget) (n int, err error) {
+	defer r.rec.observe("AbuseReportRepository.CountOpenReporters", time.Now(), &err)
+	return r.next.CountOpenReporters(ctx, target)
+}
+
+func (r *abuseReportRepository) Unlist(ctx context.Context, target domain.AbuseTarget, eventID string, until time.Time) (changed bool, err error) {
+	defer r.rec.observe("AbuseReportRepository.Unlist", time.Now(), &err)
+	return r.next.Unlist(ctx, target, eventID, until)
+}
+
+func (r *abuseReportRepository) ListUnlisted(ctx context.Context, eventID string, now time.Time) (res []domain.AbuseTarget, err error) {
+	defer r.rec.observe("AbuseReportRepository.ListUnlisted", time.Now(), &err)
+	return r.next.ListUnlisted(ctx, eventID, now)
+}
+
+func (r *abuseReportRepository) ListQueue(ctx context.Context, now time.Time, params domain.PaginationParams) (res []*domain.ModerationItem, total int, err error) {
+	defer r.rec.observe("AbuseReportRepository.ListQueue", time.Now(), &err)
+	return r.next.ListQueue(ctx, now, params)
+}
+
+func (r *abuseReportRepository) GetQueueItem(ctx context.Context, target domain.AbuseTarget, now time.Time) (res *domain.ModerationItem, err error) {
+	defer r.rec.observe("AbuseReportRepository.GetQueueItem", time.Now(), &err)
+	return r.next.GetQueueItem(ctx, target, now)
+}
+
+func (r *abuseReportRepository) Resolve(ctx context.Context, target domain.AbuseTarget, status, resolvedBy string, at time.Time) (err error) {
+	defer r.rec.observe("AbuseReportRepository.Resolve", time.Now(), &err)
+	return r.next.Resolve(ctx, target, status, resolvedBy, at)
+}
+
+type ipAllowlistRepository struct {
+	next domain.IPAllowlistRepository
+	rec  *Recorder
+}
+
+// NewIPAllowlistRepository returns next with every call recorded in rec under "IPAllowlistRepository.<Method>".
+func NewIPAllowlistRepository(next domain.IPAllowlistRepository, rec *Recorder) domain.IPAllowlistRepository {
+	return &ipAllowlistRepository{next: next, rec: rec}
+}
+
+func (r *ipAllowlistRepository) Get(ctx context.Context, userID string) (ranges []string, err error) {
+	defer r.rec.observe("IPAllowlistRepository.Get", time.Now(), &err)
+	return r.next.Get(ctx, userID)
+}
+
+func (r *ipAllowlistRepository) Replace(ctx context.Context, userID string, ranges []string) (err error) {
+	defer r.rec.observe("IPAllowlistRepository.Replace", time.Now(), &err)
+	return r.next.Replace(ctx, userID, ranges)
+}
+
+func (r *ipAllowlistRepository) GetMachineClient(ctx context.Context, clientID string) (ranges []string, err error) {
+	defer r.rec.observe("IPAllowlistRepository.GetMachineClient", time.Now(), &err)
+	return r.next.GetMachineClient(ctx, clientID)
+}
+
+func (r *ipAllowlistRepository) ReplaceMachineClient(ctx context.Context, clientID string, ranges []string) (err error) {
+	defer r.rec.observe("IPAllowlistRepository.ReplaceMachineClient", time.Now(), &err)
+	return r.next.ReplaceMachineClient(ctx, clientID, ranges)
+}
+
+type machineClientRepository struct {
+	next domain.MachineClientRepository
+	rec  *Recorder
+}
+
+// NewMachineClientRepository returns next with every call recorded in rec under "MachineClientRepository.<Method>".
+func NewMachineClientRepository(next domain.MachineClientRepository, rec *Recorder) domain.MachineClientRepository {
+	return &machineClientRepository{next: next, rec: rec}
+}
+
+func (r *machineClientRepository) Create(ctx context.Context, c *domain.MachineClient, secretHash string) (err error) {
+	defer r.rec.observe("MachineClientRepository.Create", time.Now(), &err)
+	return r.next.Create(ctx, c, secretHash)
+}
+
+func (r *machineClientRepository) GetByID(ctx context.Context, id string) (c *domain.MachineClient, secretHash string, err error) {
+	defer r.rec.observe("MachineClientRepository.GetByID", time.Now(), &err)
+	return r.next.GetByID(ctx, id)
+}
+
+func (r *machineClientRepository) List(ctx context.Context) (clients []*domain.MachineClient, err error) {
+	defer r.rec.observe("MachineClientRepository.List", time.Now(), &err)
+	return r.next.List(ctx)
+}
+
+func (r *machineClientRepository) Revoke(ctx context.Context, id string, at time.Time) (c *domain.MachineClient, err error) {
+	defer r.rec.observe("MachineClientRepository.Revoke", time.Now(), &err)
+	return r.next.Revoke(ctx, id, at)
+}
+
+func (r *machineClientRepository) TouchLastUsed(ctx context.Context, id string, at time.Time) (err error) {
+	defer r.rec.observe("MachineClientRepository.TouchLastUsed", time.Now(), &err)
+	return r.next.TouchLastUsed(ctx, id, at)
+}
+
+type userActivityRepository struct {
+	next domain.UserActivityRepository
+	rec  *Recorder
+}
+
+// NewUserActivityRepository returns next with every call recorded in rec under "UserActivityRepository.<Method>".
+func NewUserActivityRepository(next domain.UserActivityRepository, rec *Recorder) domain.UserActivityRepository {
+	return &userActivityRepository{next: next, rec: rec}
+}
+
+func (r *userActivityRepository) Create(ctx context.Context, a *domain.UserActivity) (err error) {
+	defer r.rec.observe("UserActivityRepository.Create", time.Now(), &err)
+	return r.next.Create(ctx, a)
+}
+
+func (r *userActivityRepository) ListByUser(ctx context.Context, userID string, limit int) (activity []*domain.UserActivity, err error) {
+	defer r.rec.observe("UserActivityRepository.ListByUser", time.Now(), &err)
+	return r.next.ListByUser(ctx, userID, limit)
+}
+
+type eventDeletionRepository struct {
+	next domain.EventDeletionRepository
+	rec  *Recorder
+}
+
+// NewEventDeletionRepository returns next with every call recorded in rec under "EventDeletionRepository.<Method>".
+func NewEventDeletionRepository(next domain.EventDeletionRepository, rec *Recorder) domain.EventDeletionRepository {
+	return &eventDeletionRepository{next: next, rec: rec}
+}
+
+func (r *eventDeletionRepository) Create(ctx context.Context, d *domain.PendingEventDeletion, requestedBy, tokenHash string) (err error) {
+	defer r.rec.observe("EventDeletionRepository.Create", time.Now(), &err)
+	return r.next.Create(ctx, d, requestedBy, tokenHash)
+}
+
+func (r *eventDeletionRepository) Consume(ctx context.Context, eventID, tokenHash string) (d *domain.PendingEventDeletion, ok bool, err error) {
+	defer r.rec.observe("EventDeletionRepository.Consume", time.Now(), &err)
+	return r.next.Consume(ctx, eventID, tokenHash)
+}
+
+type schemaRepository struct {
+	next domain.SchemaRepository
+	rec  *Recorder
+}
+
+// NewSchemaRepository returns next with every call recorded in rec under "SchemaRepository.<Method>".
+func NewSchemaRepository(next domain.SchemaRepository, rec *Recorder) domain.SchemaRepository {
+	return &schemaRepository{next: next, rec: rec}
+}
+
+func (r *schemaRepository) SchemaStatus(ctx context.Context) (s *domain.SchemaStatus, err error) {
+	defer r.rec.observe("SchemaRepository.SchemaStatus", time.Now(), &err)
+	return r.next.SchemaStatus(ctx)
+}
+
+type publicPageRepository struct {
+	next domain.PublicPageRepository
+	rec  *Recorder
+}
+
+// NewPublicPageRepository returns next with every call recorded in rec under "PublicPageRepository.<Method>".
+func NewPublicPageRepository(next domain.PublicPageRepository, rec *Recorder) domain.PublicPageRepository {
+	return &publicPageRepository{next: next, rec: rec}
+}
+
+func (r *publicPageRepository) ListPublicPages(ctx context.Context, now time.Time, limit int) (res []*domain.PublicPage, err error) {
+	defer r.rec.observe("PublicPageRepository.ListPublicPages", time.Now(), &err)
+	return r.next.ListPublicPages(ctx, now, limit)
+}
+
+type exhibitorRepository struct {
+	next domain.ExhibitorRepository
+	rec  *Recorder
+}
+
+// NewExhibitorRepository returns next with every call recorded in rec under "ExhibitorRepository.<Method>".
+func NewExhibitorRepository(next domain.ExhibitorRepository, rec *Recorder) domain.ExhibitorRepository {
+	return &exhibitorRepository{next: next, rec: rec}
+}
+
+func (r *exhibitorRepository) Create(ctx context.Context, e *domain.Exhibitor) (err error) {
+	defer r.rec.observe("ExhibitorRepository.Create", time.Now(), &err)
+	return r.next.Create(ctx, e)
+}
+
+func (r *exhibitorRepository) GetByID(ctx context.Context, id string) (e *domain.Exhibitor, err error) {
+	defer r.rec.observe("ExhibitorRepository.GetByID", time.Now(), &err)
+	return r.next.GetByID(ctx, id)
+}
+
+func (r *exhibitorRepository) ListByEventID(ctx context.Context, eventID string) (res []*domain.Exhibitor, err error) {
+	defer r.rec.observe("ExhibitorRepository.ListByEventID", time.Now(), &err)
+	return r.next.ListByEventID(ctx, eventID)
+}
+
+func (r *exhibitorRepository) ListByStaffUserID(ctx context.Context, userID string) (res []*domain.Exhibitor, err error) {
+	defer r.rec.observe("ExhibitorRepository.ListByStaffUserID", time.Now(), &err)
+	return r.next.ListByStaffUserID(ctx, userID)
+}
+
+func (r *exhibitorRepository) Delete(ctx context.Context, id string) (err error) {
+	defer r.rec.observe("ExhibitorRepository.Delete", time.Now(), &err)
+	return r.next.Delete(ctx, id)
+}
+
+func (r *exhibitorRepository) AddStaff(ctx context.Context, exhibitorID, userID string) (err error) {
+	defer r.rec.observe("ExhibitorRepository.AddStaff", time.Now(), &err)
+	return r.next.AddStaff(ctx, exhibitorID, userID)
+}
+
+func (r *exhibitorRepository) RemoveStaff(ctx context.Context, exhibitorID, userID string) (err error) {
+	defer r.rec.observe("ExhibitorRepository.RemoveStaff", time.Now(), &err)
+	return r.next.RemoveStaff(ctx, exhibitorID, userID)
+}
+
+func (r *exhibitorRepository) IsStaff(ctx context.Context, exhibitorID, userID string) (ok bool, err error) {
+	defer r.rec.observe("ExhibitorRepository.IsStaff", time.Now(), &err)
+	return r.next.IsStaff(ctx, exhibitorID, userID)
+}
+
+type leadRepository struct {
+	next domain.LeadRepository
+	rec  *Recorder
+}
+
+// NewLeadRepository returns next with every call recorded in rec under "LeadRepository.<Method>".
+func NewLeadRepository(next domain.LeadRepository, rec *Recorder) domain.LeadRepository {
+	return &leadRepository{next: next, rec: rec}
+}
+
+func (r *leadRepository) GetConsentCode(ctx context.Context, eventID, userID string) (code string, err error) {
+	defer r.rec.observe("LeadRepository.GetConsentCode", time.Now(), &err)
+	return r.next.GetConsentCode(ctx, eventID, userID)
+}
+
+func (r *leadRepository) SetConsentCode(ctx context.Context, eventID, userID, code string) (err error) {
+	defer r.rec.observe("LeadRepository.SetConsentCode", time.Now(), &err)
+	return r.next.SetConsentCode(ctx, eventID, userID, code)
+}
+
+func (r *leadRepository) FindConsent(ctx context.Context, eventID, code string) (userID string, err error) {
+	defer r.rec.observe("LeadRepository.FindConsent", time.Now(), &err)
+	return r.next.FindConsent(ctx, eventID, code)
+}
+
+func (r *leadRepository) UpsertLead(ctx context.Context, lead *domain.Lead) (created bool, err error) {
+	defer r.rec.observe("LeadRepository.UpsertLead", time.Now(), &err)
+	return r.next.UpsertLead(ctx, lead)
+}
+
+func (r *leadRepository) ListLeads(ctx context.Context, exhibitorID string) (res []*domain.Lead, err error) {
+	defer r.rec.observe("LeadRepository.ListLeads", time.Now(), &err)
+	return r.next.ListLeads(ctx, exhibitorID)
+}
+
+type invitationWarmupRepository struct {
+	next domain.InvitationWarmupRepository
+	rec  *Recorder
+}
+
+// NewInvitationWarmupRepository returns next with every call recorded in rec under
+// "InvitationWarmupRepository.<Method>".
+func NewInvitationWarmupRepository(next domain.InvitationWarmupRepository, rec *Recorder) domain.InvitationWarmupRepository {
+	return &invitationWarmupRepository{next: next, rec: rec}
+}
+
+func (r *invitationWarmupRepository) Create(ctx context.Context, w *domain.InvitationWarmup, emails []string) (err error) {
+	defer r.rec.observe("InvitationWarmupRepository.Create", time.Now(), &err)
+	return r.next.Create(ctx, w, emails)
+}
+
+func (r *invitationWarmupRepository) GetByID(ctx context.Context, id string) (res *domain.InvitationWarmup, err error) {
+	defer r.rec.observe("InvitationWarmupRepository.GetByID", time.Now(), &err)
+	return r.next.GetByID(ctx, id)
+}
+
+func (r *invitationWarmupRepository) ListByEventID(ctx context.Context, eventID string) (res []*domain.InvitationWarmup, err error) {
+	defer r.rec.observe("InvitationWarmupRepository.ListByEventID", time.Now(), &err)
+	return r.next.ListByEventID(ctx, eventID)
+}
+
+func (r *invitationWarmupRepository) ListActive(ctx context.Context) (res []*domain.InvitationWarmup, err error) {
+	defer r.rec.observe("InvitationWarmupRepository.ListActive", time.Now(), &err)
+	return r.next.ListActive(ctx)
+}
+
+func (r *invitationWarmupRepository) UpdateStatus(ctx context.Context, w *domain.InvitationWarmup) (err error) {
+	defer r.rec.observe("InvitationWarmupRepository.UpdateStatus", time.Now(), &err)
+	return r.next.UpdateStatus(ctx, w)
+}
+
+func (r *invitationWarmupRepository) NextQueued(ctx context.Context, warmupID string, limit int) (res []string, err error) {
+	defer r.rec.observe("InvitationWarmupRepository.NextQueued", time.Now(), &err)
+	return r.next.NextQueued(ctx, warmupID, limit)
+}
+
+func (r *invitationWarmupRepository) MarkRecipients(ctx context.Context, warmupID string, emails []string, status string) (err error) {
+	defer r.rec.observe("InvitationWarmupRepository.MarkRecipients", time.Now(), &err)
+	return r.next.MarkRecipients(ctx, warmupID, emails, status)
+}
+
+type roomOccupancyRepository struct {
+	next domain.RoomOccupancyRepository
+	rec  *Recorder
+}
+
+// NewRoomOccupancyRepository returns next with every call recorded in rec under
+// "RoomOccupancyRepository.<Method>".
+func NewRoomOccupancyRepository(next domain.RoomOccupancyRepository, rec *Recorder) domain.RoomOccupancyRepository {
+	return &roomOccupancyRepository{next: next, rec: rec}
+}
+
+func (r *roomOccupancyRepository) Add(ctx context.Context, eventID, roomID string, delta int) (res *domain.RoomOccupancy, err error) {
+	defer r.rec.observe("RoomOccupancyRepository.Add", time.Now(), &err)
+	return r.next.Add(ctx, eventID, roomID, delta)
+}
+
+func (r *roomOccupancyRepository) Set(ctx context.Context, eventID, roomID string, count int) (res *domain.RoomOccupancy, err error) {
+	defer r.rec.observe("RoomOccupancyRepository.Set", time.Now(), &err)
+	return r.next.Set(ctx, eventID, roomID, count)
+}
+
+func (r *roomOccupancyRepository) ListByEventID(ctx context.Context, eventID string) (res []*domain.RoomOccupancy, err error) {
+	defer r.rec.observe("RoomOccupancyRepository.ListByEventID", time.Now(), &err)
+	return r.next.ListByEventID(ctx, eventID)
+}
+
+func (r *roomOccupancyRepository) MarkAlerted(ctx context.Context, roomID string, at time.Time) (res bool, err error) {
+	defer r.rec.observe("RoomOccupancyRepository.MarkAlerted", time.Now(), &err)
+	return r.next.MarkAlerted(ctx, roomID, at)
+}
+
+func (r *roomOccupancyRepository) ClearAlert(ctx context.Context, roomID string) (err error) {
+	defer r.rec.observe("RoomOccupancyRepository.ClearAlert", time.Now(), &err)
+	return r.next.ClearAlert(ctx, roomID)
+}
+
+type eventDocumentRepository struct {
+	next domain.EventDocumentRepository
+	rec  *Recorder
+}
+
+// NewEventDocumentRepository returns next with every call recorded in rec under
+// "EventDocumentRepository.<Method>".
+func NewEventDocumentRepository(next domain.EventDocumentRepository, rec *Recorder) domain.EventDocumentRepository {
+	return &eventDocumentRepository{next: next, rec: rec}
+}
+
+func (r *eventDocumentRepository) Publish(ctx context.Context, doc *domain.EventDocument) (err error) {
+	defer r.rec.observe("EventDocumentRepository.Publish", time.Now(), &err)
+	return r.next.Publish(ctx, doc)
+}
+
+func (r *eventDocumentRepository) ListCurrent(ctx context.Context, eventID string) (res []*domain.EventDocument, err error) {
+	defer r.rec.observe("EventDocumentRepository.ListCurrent", time.Now(), &err)
+	return r.next.ListCurrent(ctx, eventID)
+}
+
+func (r *eventDocumentRepository) ListVersions(ctx context.Context, eventID, kind string) (res []*domain.EventDocument, err error) {
+	defer r.rec.observe("EventDocumentRepository.ListVersions", time.Now(), &err)
+	return r.next.ListVersions(ctx, eventID, kind)
+}
+
+func (r *eventDocumentRepository) Withdraw(ctx context.Context, eventID, kind string) (err error) {
+	defer r.rec.observe("EventDocumentRepository.Withdraw", time.Now(), &err)
+	return r.next.Withdraw(ctx, eventID, kind)
+}
+
+func (r *eventDocumentRepository) Accept(ctx context.Context, eventID, userID string, versions []domain.DocumentVersion) (err error) {
+	defer r.rec.observe("EventDocumentRepository.Accept", time.Now(), &err)
+	return r.next.Accept(ctx, eventID, userID, versions)
+}
+
+func (r *eventDocumentRepository) ListAcceptances(ctx context.Context, eventID, userID string) (res []*domain.DocumentAcceptance, err error) {
+	defer r.rec.observe("EventDocumentRepository.ListAcceptances", time.Now(), &err)
+	return r.next.ListAcceptances(ctx, eventID, userID)
+}
+
+func (r *eventDocumentRepository) ListAttendeeAcceptances(ctx context.Context, eventID, kind string) (res []*domain.AttendeeAcceptance, err error) {
+	defer r.rec.observe("EventDocumentRepository.ListAttendeeAcceptances", time.Now(), &err)
+	return r.next.ListAttendeeAcceptances(ctx, eventID, kind)
+}
+
+type speakerPhotoArchiveRepository struct {
+	next domain.SpeakerPhotoArchiveRepository
+	rec  *Recorder
+}
+
+// NewSpeakerPhotoArchiveRepository returns next with every call recorded in rec under
+// "SpeakerPhotoArchiveRepository.<Method>".
+func NewSpeakerPhotoArchiveRepository(next domain.SpeakerPhotoArchiveRepository, rec *Recorder) domain.SpeakerPhotoArchiveRepository {
+	return &speakerPhotoArchiveRepository{next: next, rec: rec}
+}
+
+func (r *speakerPhotoArchiveRepository) Create(ctx context.Context, a *domain.SpeakerPhotoArchive) (created bool, err error) {
+	defer r.rec.observe("SpeakerPhotoArchiveRepository.Create", time.Now(), &err)
+	return r.next.Create(ctx, a)
+}
+
+func (r *speakerPhotoArchiveRepository) GetByID(ctx context.Context, archiveID string) (res *domain.SpeakerPhotoArchive, err error) {
+	defer r.rec.observe("SpeakerPhotoArchiveRepository.GetByID", time.Now(), &err)
+	return r.next.GetByID(ctx, archiveID)
+}
+
+func (r *speakerPhotoArchiveRepository) ListPending(ctx context.Context, limit int) (res []*domain.SpeakerPhotoArchive, err error) {
+	defer r.rec.observe("SpeakerPhotoArchiveRepository.ListPending", time.Now(), &err)
+	return r.next.ListPending(ctx, limit)
+}
+
+func (r *speakerPhotoArchiveRepository) Complete(ctx context.Context, a *domain.SpeakerPhotoArchive, zip []byte) (err error) {
+	defer r.rec.observe("SpeakerPhotoArchiveRepository.Complete", time.Now(), &err)
+	return r.next.Complete(ctx, a, zip)
+}
+
+func (r *speakerPhotoArchiveRepository) GetZip(ctx context.Context, archiveID string) (res []byte, err error) {
+	defer r.rec.observe("SpeakerPhotoArchiveRepository.GetZip", time.Now(), &err)
+	return r.next.GetZip(ctx, archiveID)
+}
+
+type sessionStreamRepository struct {
+	next domain.SessionStreamRepository
+	rec  *Recorder
+}
+
+// NewSessionStreamRepository returns next with every call recorded in rec under
+// "SessionStreamRepository.<Method>".
+func NewSessionStreamRepository(next domain.SessionStreamRepository, rec *Recorder) domain.SessionStreamRepository {
+	return &sessionStreamRepository{next: next, rec: rec}
+}
+
+func (r *sessionStreamRepository) ListBySessionID(ctx context.Context, sessionID string) (res []*domain.SessionStreamLink, err error) {
+	defer r.rec.observe("SessionStreamRepository.ListBySessionID", time.Now(), &err)
+	return r.next.ListBySessionID(ctx, sessionID)
+}
+
+func (r *sessionStreamRepository) ListByEventID(ctx context.Context, eventID string) (res map[string][]*domain.SessionStreamLink, err error) {
+	defer r.rec.observe("SessionStreamRepository.ListByEventID", time.Now(), &err)
+	return r.next.ListByEventID(ctx, eventID)
+}
+
+func (r *sessionStreamRepository) Replace(ctx context.Context, eventID, sessionID string, links []*domain.SessionStreamLink) (err error) {
+	defer r.rec.observe("SessionStreamRepository.Replace", time.Now(), &err)
+	return r.next.Replace(ctx, eventID, sessionID, links)
+}
@@ -0,0 +1,229 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+type abuseReportRepository struct {
+	DB *sql.DB
+}
+
+func NewAbuseReportRepository(db *sql.DB) domain.AbuseReportRepository {
+	return &abuseReportRepository{
+		DB: db,
+	}
+}
+
+// moderationItemSelect reads the targets with open reports; $1 is the time unlistings are checked
+// at. Callers add conditions, then moderationItemGroupBy.
+const moderationItemSelect = `
+	SELECT r.target_type, r.target_id, r.event_id, e.name,
+		CASE r.target_type
+			WHEN 'event' THEN e.name
+			WHEN 'session' THEN COALESCE(s.title, '')
+			ELSE COALESCE(TRIM(sp.first_name || ' ' || sp.last_name), '')
+		END,
+		COUNT(*), MIN(r.created_at), MAX(r.created_at), u.target_id IS NOT NULL, u.unlisted_until
+	FROM abuse_reports r
+	JOIN events e ON e.id = r.event_id
+	LEFT JOIN sessions s ON r.target_type = 'session' AND s.id = r.target_id
+	LEFT JOIN speakers sp ON r.target_type = 'speaker' AND sp.id = r.target_id
+	LEFT JOIN content_unlistings u ON u.target_type = r.target_type AND u.target_id = r.target_id
+		AND (u.unlisted_until IS NULL OR u.unlisted_until > $1)
+	WHERE r.status = 'open'`
+
+const moderationItemGroupBy = `
+	GROUP BY r.target_type, r.target_id, r.event_id, e.name, s.title, sp.first_name, sp.last_name, u.target_id, u.unlisted_until`
+
+func scanModerationItem(row rowScanner) (*domain.ModerationItem, error) {
+	it := &domain.ModerationItem{}
+	var unlistedUntil sql.NullTime
+	err := row.Scan(&it.TargetType, &it.TargetID, &it.EventID, &it.EventName, &it.Title,
+		&it.OpenReports, &it.FirstReportedAt, &it.LastReportedAt, &it.Unlisted, &unlistedUntil)
+	if err != nil {
+		return nil, err
+	}
+	if unlistedUntil.Valid {
+		it.UnlistedUntil = &unlistedUntil.Time
+	}
+	return it, nil
+}
+
+func (r *abuseReportRepository) Create(ctx context.Context, rep *domain.AbuseReport) (bool, error) {
+	err := r.DB.QueryRowContext(ctx, `
+		INSERT INTO abuse_reports (event_id, target_type, target_id, reason, details, reporter_hash)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (target_type, target_id, reporter_hash) WHERE status = 'open' DO NOTHING
+		RETURNING id, status, created_at
+	`, rep.EventID, rep.TargetType, rep.TargetID, rep.Reason, rep.Details, rep.ReporterHash).Scan(&rep.ID, &rep.Status, &rep.CreatedAt)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *abuseReportRepository) CountOpenReporters(ctx context.Context, target domain.AbuseTarget) (int, error) {
+	var n int
+	err := r.DB.QueryRowContext(ctx, `
+		SELECT COUNT(DISTINCT reporter_hash)
+		FROM abuse_reports
+		WHERE target_type = $1 AND target_id = $2 AND status = 'open'
+	`, target.Type, target.ID).Scan(&n)
+	if err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+func (r *abuseReportRepository) Unlist(ctx context.Context, target domain.AbuseTarget, eventID string, until time.Time) (bool, error) {
+	res, err := r.DB.ExecContext(ctx, `
+		INSERT INTO content_unlistings (target_type, target_id, event_id, unlisted_until)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (target_type, target_id) DO UPDATE SET unlisted_until = EXCLUDED.unlisted_until
+		WHERE content_unlistings.unlisted_until IS NOT NULL AND content_unlistings.unlisted_until < EXCLUDED.unlisted_until
+	`, target.Type, target.ID, eventID, until)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (r *abuseReportRepository) ListUnlisted(ctx context.Context, eventID string, now time.Time) ([]domain.AbuseTarget, error) {
+	rows, err := r.DB.QueryContext(ctx, `
+		SELECT target_type, target_id
+		FROM content_unlistings
+		WHERE event_id = $1 AND (unlisted_until IS NULL OR unlisted_until > $2)
+	`, eventID, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var targets []domain.AbuseTarget
+	for rows.Next() {
+		var t domain.AbuseTarget
+		if err := rows.Scan(&t.Type, &t.ID); err != nil {
+			return nil, err
+		}
+		targets = append(targets, t)
+	}
+	return targets, rows.Err()
+}
+
+func (r *abuseReportRepository) ListQueue(ctx context.Context, now time.Time, params domain.PaginationParams) ([]*domain.ModerationItem, int, error) {
+	var total int
+	err := r.DB.QueryRowContext(ctx, `
+		SELECT COUNT(DISTINCT (target_type, target_id)) FROM abuse_reports WHERE status = 'open'
+	`).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.DB.QueryContext(ctx, moderationItemSelect+moderationItemGroupBy+`
+		ORDER BY COUNT(*) DESC, MAX(r.created_at) DESC, r.target_id
+		LIMIT $2 OFFSET $3
+	`, now, params.PageSize, params.Offset())
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	items := []*domain.ModerationItem{}
+	for rows.Next() {
+		it, err := scanModerationItem(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		items = append(items, it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return items, total, nil
+}
+
+func (r *abuseReportRepository) GetQueueItem(ctx context.Context, target domain.AbuseTarget, now time.Time) (*domain.ModerationItem, error) {
+	it, err := scanModerationItem(r.DB.QueryRowContext(ctx, moderationItemSelect+`
+		AND r.target_type = $2 AND r.target_id = $3`+moderationItemGroupBy, now, target.Type, target.ID))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.DB.QueryContext(ctx, `
+		SELECT id, event_id, target_type, target_id, reason, details, status, created_at
+		FROM abuse_reports
+		WHERE target_type = $1 AND target_id = $2 AND status = 'open'
+		ORDER BY created_at, id
+	`, target.Type, target.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	it.Reports = []*domain.AbuseReport{}
+	for rows.Next() {
+		rep := &domain.AbuseReport{}
+		if err := rows.Scan(&rep.ID, &rep.EventID, &rep.TargetType, &rep.TargetID, &rep.Reason, &rep.Details, &rep.Status, &rep.CreatedAt); err != nil {
+			return nil, err
+		}
+		it.Reports = append(it.Reports, rep)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+func (r *abuseReportRepository) Resolve(ctx context.Context, target domain.AbuseTarget, status, resolvedBy string, at time.Time) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE abuse_reports SET status = $3, resolved_by = NULLIF($4, '')::uuid, resolved_at = $5
+		WHERE target_type = $1 AND target_id = $2 AND status = 'open'
+	`, target.Type, target.ID, status, resolvedBy, at)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domain.ErrNotFound
+	}
+
+	if status == domain.AbuseReportActioned {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO content_unlistings (target_type, target_id, event_id, unlisted_until)
+			SELECT target_type, target_id, event_id, NULL
+			FROM abuse_reports
+			WHERE target_type = $1 AND target_id = $2
+			LIMIT 1
+			ON CONFLICT (target_type, target_id) DO UPDATE SET unlisted_until = NULL
+		`, target.Type, target.ID)
+	} else {
+		_, err = tx.ExecContext(ctx, `DELETE FROM content_unlistings WHERE target_type = $1 AND target_id = $2`, target.Type, target.ID)
+	}
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
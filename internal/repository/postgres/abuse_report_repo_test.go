@@ -0,0 +1,169 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+var moderationItemCols = []string{"target_type", "target_id", "event_id", "event_name", "title", "count", "min", "max", "unlisted", "unlisted_until"}
+
+func TestAbuseReportRepository_Create(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("new report", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`INSERT INTO abuse_reports .* ON CONFLICT \(target_type, target_id, reporter_hash\) WHERE status = 'open' DO NOTHING`).
+			WithArgs("ev-1", domain.AbuseTargetSession, "s-1", domain.AbuseReasonSpam, "", "hash").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "status", "created_at"}).AddRow("r-1", domain.AbuseReportOpen, now))
+
+		rep := &domain.AbuseReport{EventID: "ev-1", TargetType: domain.AbuseTargetSession, TargetID: "s-1", Reason: domain.AbuseReasonSpam, ReporterHash: "hash"}
+		created, err := NewAbuseReportRepository(db).Create(ctx, rep)
+		require.NoError(t, err)
+		require.True(t, created)
+		require.Equal(t, "r-1", rep.ID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("same reporter again", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`INSERT INTO abuse_reports`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "status", "created_at"}))
+
+		created, err := NewAbuseReportRepository(db).Create(ctx, &domain.AbuseReport{EventID: "ev-1", TargetType: domain.AbuseTargetEvent, TargetID: "ev-1", Reason: domain.AbuseReasonSpam, ReporterHash: "hash"})
+		require.NoError(t, err)
+		require.False(t, created)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAbuseReportRepository_Unlist(t *testing.T) {
+	ctx := context.Background()
+	until := time.Date(2025, 3, 4, 9, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec(`INSERT INTO content_unlistings .* ON CONFLICT \(target_type, target_id\) DO UPDATE SET unlisted_until = EXCLUDED.unlisted_until\s+WHERE content_unlistings.unlisted_until IS NOT NULL`).
+		WithArgs(domain.AbuseTargetSpeaker, "sp-1", "ev-1", until).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	changed, err := NewAbuseReportRepository(db).Unlist(ctx, domain.AbuseTarget{Type: domain.AbuseTargetSpeaker, ID: "sp-1"}, "ev-1", until)
+	require.NoError(t, err)
+	require.False(t, changed, "an unlisting without an end is left as is")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAbuseReportRepository_GetQueueItem(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	target := domain.AbuseTarget{Type: domain.AbuseTargetSession, ID: "s-1"}
+
+	t.Run("with reports", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`WHERE r.status = 'open'\s+AND r.target_type = \$2 AND r.target_id = \$3\s+GROUP BY`).
+			WithArgs(now, domain.AbuseTargetSession, "s-1").
+			WillReturnRows(sqlmock.NewRows(moderationItemCols).AddRow(domain.AbuseTargetSession, "s-1", "ev-1", "GopherCon", "Buy cheap watches", 2, now, now, true, now.Add(time.Hour)))
+		mock.ExpectQuery(`FROM abuse_reports\s+WHERE target_type = \$1 AND target_id = \$2 AND status = 'open'\s+ORDER BY created_at, id`).
+			WithArgs(domain.AbuseTargetSession, "s-1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "event_id", "target_type", "target_id", "reason", "details", "status", "created_at"}).
+				AddRow("r-1", "ev-1", domain.AbuseTargetSession, "s-1", domain.AbuseReasonSpam, "", domain.AbuseReportOpen, now).
+				AddRow("r-2", "ev-1", domain.AbuseTargetSession, "s-1", domain.AbuseReasonOther, "ads", domain.AbuseReportOpen, now))
+
+		it, err := NewAbuseReportRepository(db).GetQueueItem(ctx, target, now)
+		require.NoError(t, err)
+		require.Equal(t, "Buy cheap watches", it.Title)
+		require.True(t, it.Unlisted)
+		require.NotNil(t, it.UnlistedUntil)
+		require.Len(t, it.Reports, 2)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("no open reports", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM abuse_reports r`).
+			WithArgs(now, domain.AbuseTargetSession, "s-1").
+			WillReturnError(sql.ErrNoRows)
+
+		_, err = NewAbuseReportRepository(db).GetQueueItem(ctx, target, now)
+		require.ErrorIs(t, err, domain.ErrNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAbuseReportRepository_Resolve(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2025, 3, 2, 9, 0, 0, 0, time.UTC)
+	target := domain.AbuseTarget{Type: domain.AbuseTargetSession, ID: "s-1"}
+
+	t.Run("actioned keeps it unlisted", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE abuse_reports SET status = \$3`).
+			WithArgs(domain.AbuseTargetSession, "s-1", domain.AbuseReportActioned, "admin-1", at).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(`INSERT INTO content_unlistings .* DO UPDATE SET unlisted_until = NULL`).
+			WithArgs(domain.AbuseTargetSession, "s-1").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		require.NoError(t, NewAbuseReportRepository(db).Resolve(ctx, target, domain.AbuseReportActioned, "admin-1", at))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("dismissed lists it again", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE abuse_reports SET status = \$3`).
+			WithArgs(domain.AbuseTargetSession, "s-1", domain.AbuseReportDismissed, "admin-1", at).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM content_unlistings WHERE target_type = \$1 AND target_id = \$2`).
+			WithArgs(domain.AbuseTargetSession, "s-1").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		require.NoError(t, NewAbuseReportRepository(db).Resolve(ctx, target, domain.AbuseReportDismissed, "admin-1", at))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("nothing open", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE abuse_reports SET status = \$3`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		err = NewAbuseReportRepository(db).Resolve(ctx, target, domain.AbuseReportDismissed, "admin-1", at)
+		require.ErrorIs(t, err, domain.ErrNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/lib/pq"
+)
+
+type announcementRepository struct {
+	DB *sql.DB
+}
+
+func NewAnnouncementRepository(db *sql.DB) domain.AnnouncementRepository {
+	return &announcementRepository{
+		DB: db,
+	}
+}
+
+const announcementColumns = `a.id, a.title, a.body, a.category, a.published_at, a.created_at, a.updated_at`
+
+func scanAnnouncement(row rowScanner, extra ...any) (*domain.Announcement, error) {
+	a := &domain.Announcement{}
+	var publishedAt sql.NullTime
+	err := row.Scan(append([]any{&a.ID, &a.Title, &a.Body, &a.Category, &publishedAt, &a.CreatedAt, &a.UpdatedAt}, extra...)...)
+	if err != nil {
+		return nil, err
+	}
+	if publishedAt.Valid {
+		a.PublishedAt = &publishedAt.Time
+	}
+	return a, nil
+}
+
+func (r *announcementRepository) ListPublished(ctx context.Context, userID string, now time.Time, params domain.PaginationParams) ([]*domain.Announcement, int, int, error) {
+	var total, unread int
+	err := r.DB.QueryRowContext(ctx, `
+		SELECT COUNT(*), COUNT(*) FILTER (WHERE ar.user_id IS NULL)
+		FROM announcements a
+		LEFT JOIN announcement_reads ar ON ar.announcement_id = a.id AND ar.user_id = $1
+		WHERE a.published_at <= $2
+	`, userID, now).Scan(&total, &unread)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	rows, err := r.DB.QueryContext(ctx, `
+		SELECT `+announcementColumns+`, ar.user_id IS NOT NULL
+		FROM announcements a
+		LEFT JOIN announcement_reads ar ON ar.announcement_id = a.id AND ar.user_id = $1
+		WHERE a.published_at <= $2
+		ORDER BY a.published_at DESC, a.id
+		LIMIT $3 OFFSET $4
+	`, userID, now, params.PageSize, params.Offset())
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	defer rows.Close()
+
+	items := []*domain.Announcement{}
+	for rows.Next() {
+		var read bool
+		a, err := scanAnnouncement(rows, &read)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		a.Read = read
+		items = append(items, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, 0, err
+	}
+	return items, total, unread, nil
+}
+
+func (r *announcementRepository) ListAll(ctx context.Context, params domain.PaginationParams) ([]*domain.Announcement, int, error) {
+	var total int
+	if err := r.DB.QueryRowContext(ctx, `SELECT COUNT(*) FROM announcements`).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.DB.QueryContext(ctx, `
+		SELECT `+announcementColumns+`
+		FROM announcements a
+		ORDER BY a.published_at DESC NULLS FIRST, a.created_at DESC
+		LIMIT $1 OFFSET $2
+	`, params.PageSize, params.Offset())
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	items := []*domain.Announcement{}
+	for rows.Next() {
+		a, err := scanAnnouncement(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		items = append(items, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return items, total, nil
+}
+
+func (r *announcementRepository) Create(ctx context.Context, a *domain.Announcement, createdBy string) error {
+	query := `
+		INSERT INTO announcements (title, body, category, published_at, created_by)
+		VALUES ($1, $2, $3, $4, NULLIF($5, '')::uuid)
+		RETURNING id, created_at, updated_at
+	`
+	return r.DB.QueryRowContext(ctx, query, a.Title, a.Body, a.Category, a.PublishedAt, createdBy).
+		Scan(&a.ID, &a.CreatedAt, &a.UpdatedAt)
+}
+
+func (r *announcementRepository) Update(ctx context.Context, a *domain.Announcement) error {
+	query := `
+		UPDATE announcements
+		SET title = $2, body = $3, category = $4, published_at = $5, updated_at = NOW()
+		WHERE id = $1
+		RETURNING created_at, updated_at
+	`
+	err := r.DB.QueryRowContext(ctx, query, a.ID, a.Title, a.Body, a.Category, a.PublishedAt).Scan(&a.CreatedAt, &a.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return domain.ErrNotFound
+	}
+	return err
+}
+
+func (r *announcementRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.DB.ExecContext(ctx, `DELETE FROM announcements WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *announcementRepository) MarkRead(ctx context.Context, userID string, ids []string, now time.Time) error {
+	// An empty list marks every published announcement read; a nil one would be sent as NULL.
+	if ids == nil {
+		ids = []string{}
+	}
+	_, err := r.DB.ExecContext(ctx, `
+		INSERT INTO announcement_reads (user_id, announcement_id, read_at)
+		SELECT $1, a.id, $2
+		FROM announcements a
+		WHERE a.published_at <= $2 AND (cardinality($3::uuid[]) = 0 OR a.id = ANY($3::uuid[]))
+		ON CONFLICT (user_id, announcement_id) DO NOTHING
+	`, userID, now, pq.Array(ids))
+	return err
+}
@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+var announcementCols = []string{"id", "title", "body", "category", "published_at", "created_at", "updated_at"}
+
+func TestAnnouncementRepository_ListPublished(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	publishedAt := now.Add(-time.Hour)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\), COUNT\(\*\) FILTER \(WHERE ar.user_id IS NULL\)`).
+		WithArgs("user-1", now).
+		WillReturnRows(sqlmock.NewRows([]string{"total", "unread"}).AddRow(2, 1))
+	mock.ExpectQuery(`LEFT JOIN announcement_reads ar ON ar.announcement_id = a.id AND ar.user_id = \$1\s+WHERE a.published_at <= \$2\s+ORDER BY a.published_at DESC`).
+		WithArgs("user-1", now, 20, 0).
+		WillReturnRows(sqlmock.NewRows(append(announcementCols, "read")).
+			AddRow("an-2", "Dark mode", "Finally.", domain.AnnouncementFeature, publishedAt, now, now, false).
+			AddRow("an-1", "Faster sync", "", domain.AnnouncementImprovement, publishedAt, now, now, true))
+
+	items, total, unread, err := NewAnnouncementRepository(db).ListPublished(ctx, "user-1", now, domain.PaginationParams{Page: 1, PageSize: 20})
+	require.NoError(t, err)
+	require.Equal(t, 2, total)
+	require.Equal(t, 1, unread)
+	require.Equal(t, []*domain.Announcement{
+		{ID: "an-2", Title: "Dark mode", Body: "Finally.", Category: domain.AnnouncementFeature, PublishedAt: &publishedAt, CreatedAt: now, UpdatedAt: now},
+		{ID: "an-1", Title: "Faster sync", Category: domain.AnnouncementImprovement, PublishedAt: &publishedAt, CreatedAt: now, UpdatedAt: now, Read: true},
+	}, items)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAnnouncementRepository_ListAll(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM announcements`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(`ORDER BY a.published_at DESC NULLS FIRST, a.created_at DESC\s+LIMIT \$1 OFFSET \$2`).
+		WithArgs(20, 20).
+		WillReturnRows(sqlmock.NewRows(announcementCols).AddRow("an-3", "Draft", "", domain.AnnouncementFix, nil, now, now))
+
+	items, total, err := NewAnnouncementRepository(db).ListAll(ctx, domain.PaginationParams{Page: 2, PageSize: 20})
+	require.NoError(t, err)
+	require.Equal(t, 1, total)
+	require.Equal(t, []*domain.Announcement{{ID: "an-3", Title: "Draft", Category: domain.AnnouncementFix, CreatedAt: now, UpdatedAt: now}}, items)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAnnouncementRepository_Update(t *testing.T) {
+	ctx := context.Background()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`UPDATE announcements\s+SET title = \$2`).
+		WithArgs("missing", "T", "", domain.AnnouncementFix, nil).
+		WillReturnError(sql.ErrNoRows)
+
+	err = NewAnnouncementRepository(db).Update(ctx, &domain.Announcement{ID: "missing", Title: "T", Category: domain.AnnouncementFix})
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAnnouncementRepository_MarkRead(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		ids     []string
+		wantArg any
+	}{
+		{name: "listed announcements", ids: []string{"an-1", "an-2"}, wantArg: pq.Array([]string{"an-1", "an-2"})},
+		{name: "nil marks every published announcement", ids: nil, wantArg: pq.Array([]string{})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectExec(`INSERT INTO announcement_reads .* WHERE a.published_at <= \$2 AND \(cardinality\(\$3::uuid\[\]\) = 0 OR a.id = ANY\(\$3::uuid\[\]\)\)\s+ON CONFLICT`).
+				WithArgs("user-1", now, tt.wantArg).
+				WillReturnResult(sqlmock.NewResult(0, 2))
+
+			require.NoError(t, NewAnnouncementRepository(db).MarkRead(ctx, "user-1", tt.ids, now))
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/lib/pq"
+)
+
+type checklistRepository struct {
+	DB *sql.DB
+}
+
+func NewChecklistRepository(db *sql.DB) domain.ChecklistRepository {
+	return &checklistRepository{
+		DB: db,
+	}
+}
+
+func (r *checklistRepository) ListItems(ctx context.Context, eventID string) ([]*domain.ChecklistItem, error) {
+	query := `
+		SELECT id, event_id, label, position
+		FROM event_checklist_items
+		WHERE event_id = $1
+		ORDER BY position, label
+	`
+	rows, err := r.DB.QueryContext(ctx, query, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := []*domain.ChecklistItem{}
+	for rows.Next() {
+		item := &domain.ChecklistItem{}
+		if err := rows.Scan(&item.ID, &item.EventID, &item.Label, &item.Position); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (r *checklistRepository) ReplaceItems(ctx context.Context, eventID string, labels []string) ([]*domain.ChecklistItem, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM event_checklist_items WHERE event_id = $1 AND NOT (label = ANY($2))`,
+		eventID, pq.StringArray(labels))
+	if err != nil {
+		return nil, err
+	}
+	for i, label := range labels {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO event_checklist_items (event_id, label, position)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (event_id, label) DO UPDATE SET position = EXCLUDED.position
+		`, eventID, label, i)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return r.ListItems(ctx, eventID)
+}
+
+func (r *checklistRepository) ListCompletions(ctx context.Context, eventID string) ([]*domain.ChecklistCompletion, error) {
+	query := `
+		SELECT c.session_id, c.item_id, COALESCE(c.completed_by::text, ''), c.completed_at
+		FROM session_checklist_completions c
+		JOIN event_checklist_items i ON i.id = c.item_id
+		WHERE i.event_id = $1
+	`
+	rows, err := r.DB.QueryContext(ctx, query, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	completions := []*domain.ChecklistCompletion{}
+	for rows.Next() {
+		c := &domain.ChecklistCompletion{}
+		if err := rows.Scan(&c.SessionID, &c.ItemID, &c.CompletedBy, &c.CompletedAt); err != nil {
+			return nil, err
+		}
+		completions = append(completions, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return completions, nil
+}
+
+func (r *checklistRepository) SetCompletion(ctx context.Context, c *domain.ChecklistCompletion) error {
+	_, err := r.DB.ExecContext(ctx, `
+		INSERT INTO session_checklist_completions (session_id, item_id, completed_by, completed_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (session_id, item_id) DO NOTHING
+	`, c.SessionID, c.ItemID, c.CompletedBy, c.CompletedAt)
+	return err
+}
+
+func (r *checklistRepository) DeleteCompletion(ctx context.Context, sessionID, itemID string) error {
+	_, err := r.DB.ExecContext(ctx, `DELETE FROM session_checklist_completions WHERE session_id = $1 AND item_id = $2`, sessionID, itemID)
+	return err
+}
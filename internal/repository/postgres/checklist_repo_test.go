@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+var checklistItemCols = []string{"id", "event_id", "label", "position"}
+
+func TestChecklistRepository_ReplaceItems(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	labels := []string{"Slides received", "AV check"}
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM event_checklist_items WHERE event_id = \$1 AND NOT \(label = ANY\(\$2\)\)`).
+		WithArgs("ev-1", pq.StringArray(labels)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	for i, label := range labels {
+		mock.ExpectExec(`INSERT INTO event_checklist_items .*ON CONFLICT \(event_id, label\) DO UPDATE SET position`).
+			WithArgs("ev-1", label, i).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectCommit()
+	mock.ExpectQuery(`FROM event_checklist_items\s+WHERE event_id = \$1\s+ORDER BY position`).
+		WithArgs("ev-1").
+		WillReturnRows(sqlmock.NewRows(checklistItemCols).
+			AddRow("item-1", "ev-1", "Slides received", 0).
+			AddRow("item-2", "ev-1", "AV check", 1))
+
+	got, err := NewChecklistRepository(db).ReplaceItems(ctx, "ev-1", labels)
+	require.NoError(t, err)
+	require.Equal(t, []*domain.ChecklistItem{
+		{ID: "item-1", EventID: "ev-1", Label: "Slides received", Position: 0},
+		{ID: "item-2", EventID: "ev-1", Label: "AV check", Position: 1},
+	}, got)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChecklistRepository_ListCompletions(t *testing.T) {
+	ctx := context.Background()
+	completedAt := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM session_checklist_completions c\s+JOIN event_checklist_items i ON i.id = c.item_id\s+WHERE i.event_id = \$1`).
+		WithArgs("ev-1").
+		WillReturnRows(sqlmock.NewRows([]string{"session_id", "item_id", "completed_by", "completed_at"}).
+			AddRow("sess-1", "item-1", "user-1", completedAt).
+			AddRow("sess-2", "item-1", "", completedAt))
+
+	got, err := NewChecklistRepository(db).ListCompletions(ctx, "ev-1")
+	require.NoError(t, err)
+	require.Equal(t, []*domain.ChecklistCompletion{
+		{SessionID: "sess-1", ItemID: "item-1", CompletedBy: "user-1", CompletedAt: completedAt},
+		{SessionID: "sess-2", ItemID: "item-1", CompletedAt: completedAt},
+	}, got)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChecklistRepository_SetAndDeleteCompletion(t *testing.T) {
+	ctx := context.Background()
+	completedAt := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec(`INSERT INTO session_checklist_completions .*ON CONFLICT \(session_id, item_id\) DO NOTHING`).
+		WithArgs("sess-1", "item-1", "user-1", completedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM session_checklist_completions WHERE session_id = \$1 AND item_id = \$2`).
+		WithArgs("sess-1", "item-1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := NewChecklistRepository(db)
+	require.NoError(t, repo.SetCompletion(ctx, &domain.ChecklistCompletion{SessionID: "sess-1", ItemID: "item-1", CompletedBy: "user-1", CompletedAt: completedAt}))
+	require.NoError(t, repo.DeleteCompletion(ctx, "sess-1", "item-1"))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,165 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+type contactRepository struct {
+	DB *sql.DB
+}
+
+func NewContactRepository(db *sql.DB) domain.ContactRepository {
+	return &contactRepository{
+		DB: db,
+	}
+}
+
+const contactThreadColumns = `t.id, t.event_id, t.sender_name, t.sender_email, t.subject,
+	(SELECT COUNT(*) FROM contact_messages m WHERE m.thread_id = t.id), t.created_at, t.updated_at`
+
+func scanContactThread(row rowScanner) (*domain.ContactThread, error) {
+	t := &domain.ContactThread{}
+	err := row.Scan(&t.ID, &t.EventID, &t.SenderName, &t.SenderEmail, &t.Subject, &t.MessageCount, &t.CreatedAt, &t.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (r *contactRepository) CreateThread(ctx context.Context, t *domain.ContactThread, first *domain.ContactMessage, remoteIP string) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO contact_threads (event_id, sender_name, sender_email, subject, remote_ip)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at, updated_at
+	`, t.EventID, t.SenderName, t.SenderEmail, t.Subject, remoteIP).Scan(&t.ID, &t.CreatedAt, &t.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	first.ThreadID = t.ID
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO contact_messages (thread_id, author, body)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at
+	`, first.ThreadID, first.Author, first.Body).Scan(&first.ID, &first.CreatedAt)
+	if err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	t.MessageCount = 1
+	t.Messages = []*domain.ContactMessage{first}
+	return nil
+}
+
+func (r *contactRepository) CountRecentThreads(ctx context.Context, remoteIP, email string, since time.Time) (int, int, error) {
+	var byIP, byEmail int
+	err := r.DB.QueryRowContext(ctx, `
+		SELECT
+			COUNT(*) FILTER (WHERE remote_ip = $1 AND $1 <> ''),
+			COUNT(*) FILTER (WHERE LOWER(sender_email) = LOWER($2))
+		FROM contact_threads
+		WHERE created_at >= $3 AND ((remote_ip = $1 AND $1 <> '') OR LOWER(sender_email) = LOWER($2))
+	`, remoteIP, email, since).Scan(&byIP, &byEmail)
+	if err != nil {
+		return 0, 0, err
+	}
+	return byIP, byEmail, nil
+}
+
+func (r *contactRepository) ListThreads(ctx context.Context, eventID string, params domain.PaginationParams) ([]*domain.ContactThread, int, error) {
+	var total int
+	if err := r.DB.QueryRowContext(ctx, `SELECT COUNT(*) FROM contact_threads WHERE event_id = $1`, eventID).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.DB.QueryContext(ctx, `
+		SELECT `+contactThreadColumns+`
+		FROM contact_threads t
+		WHERE t.event_id = $1
+		ORDER BY t.updated_at DESC, t.id
+		LIMIT $2 OFFSET $3
+	`, eventID, params.PageSize, params.Offset())
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	threads := []*domain.ContactThread{}
+	for rows.Next() {
+		t, err := scanContactThread(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		threads = append(threads, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return threads, total, nil
+}
+
+func (r *contactRepository) GetThread(ctx context.Context, threadID string) (*domain.ContactThread, error) {
+	t, err := scanContactThread(r.DB.QueryRowContext(ctx, `SELECT `+contactThreadColumns+` FROM contact_threads t WHERE t.id = $1`, threadID))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.DB.QueryContext(ctx, `
+		SELECT id, thread_id, author, COALESCE(user_id::text, ''), body, created_at
+		FROM contact_messages
+		WHERE thread_id = $1
+		ORDER BY created_at, id
+	`, threadID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	t.Messages = []*domain.ContactMessage{}
+	for rows.Next() {
+		m := &domain.ContactMessage{}
+		if err := rows.Scan(&m.ID, &m.ThreadID, &m.Author, &m.UserID, &m.Body, &m.CreatedAt); err != nil {
+			return nil, err
+		}
+		t.Messages = append(t.Messages, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (r *contactRepository) AddMessage(ctx context.Context, m *domain.ContactMessage) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO contact_messages (thread_id, author, user_id, body)
+		VALUES ($1, $2, NULLIF($3, '')::uuid, $4)
+		RETURNING id, created_at
+	`, m.ThreadID, m.Author, m.UserID, m.Body).Scan(&m.ID, &m.CreatedAt)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE contact_threads SET updated_at = $2 WHERE id = $1`, m.ThreadID, m.CreatedAt); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
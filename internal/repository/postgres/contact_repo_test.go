@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+var contactThreadCols = []string{"id", "event_id", "sender_name", "sender_email", "subject", "count", "created_at", "updated_at"}
+
+func TestContactRepository_CreateThread(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO contact_threads \(event_id, sender_name, sender_email, subject, remote_ip\)`).
+		WithArgs("ev-1", "Ada", "ada@example.com", "Parking", "203.0.113.7").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow("th-1", now, now))
+	mock.ExpectQuery(`INSERT INTO contact_messages \(thread_id, author, body\)`).
+		WithArgs("th-1", domain.ContactAuthorSender, "Is there parking?").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow("m-1", now))
+	mock.ExpectCommit()
+
+	thread := &domain.ContactThread{EventID: "ev-1", SenderName: "Ada", SenderEmail: "ada@example.com", Subject: "Parking"}
+	first := &domain.ContactMessage{Author: domain.ContactAuthorSender, Body: "Is there parking?"}
+	require.NoError(t, NewContactRepository(db).CreateThread(ctx, thread, first, "203.0.113.7"))
+	require.Equal(t, "th-1", thread.ID)
+	require.Equal(t, 1, thread.MessageCount)
+	require.Equal(t, []*domain.ContactMessage{{ID: "m-1", ThreadID: "th-1", Author: domain.ContactAuthorSender, Body: "Is there parking?", CreatedAt: now}}, thread.Messages)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContactRepository_CountRecentThreads(t *testing.T) {
+	ctx := context.Background()
+	since := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM contact_threads\s+WHERE created_at >= \$3`).
+		WithArgs("203.0.113.7", "ada@example.com", since).
+		WillReturnRows(sqlmock.NewRows([]string{"by_ip", "by_email"}).AddRow(3, 1))
+
+	byIP, byEmail, err := NewContactRepository(db).CountRecentThreads(ctx, "203.0.113.7", "ada@example.com", since)
+	require.NoError(t, err)
+	require.Equal(t, 3, byIP)
+	require.Equal(t, 1, byEmail)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContactRepository_GetThread(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("with messages", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM contact_threads t WHERE t.id = \$1`).
+			WithArgs("th-1").
+			WillReturnRows(sqlmock.NewRows(contactThreadCols).AddRow("th-1", "ev-1", "Ada", "ada@example.com", "Parking", 2, now, now))
+		mock.ExpectQuery(`FROM contact_messages\s+WHERE thread_id = \$1\s+ORDER BY created_at, id`).
+			WithArgs("th-1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "thread_id", "author", "user_id", "body", "created_at"}).
+				AddRow("m-1", "th-1", domain.ContactAuthorSender, "", "Is there parking?", now).
+				AddRow("m-2", "th-1", domain.ContactAuthorTeam, "user-1", "Yes, level -1.", now))
+
+		thread, err := NewContactRepository(db).GetThread(ctx, "th-1")
+		require.NoError(t, err)
+		require.Equal(t, 2, thread.MessageCount)
+		require.Len(t, thread.Messages, 2)
+		require.Equal(t, "user-1", thread.Messages[1].UserID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM contact_threads t WHERE t.id = \$1`).
+			WithArgs("missing").
+			WillReturnError(sql.ErrNoRows)
+
+		_, err = NewContactRepository(db).GetThread(ctx, "missing")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestContactRepository_AddMessage(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO contact_messages \(thread_id, author, user_id, body\)`).
+		WithArgs("th-1", domain.ContactAuthorTeam, "user-1", "Yes, level -1.").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow("m-2", now))
+	mock.ExpectExec(`UPDATE contact_threads SET updated_at = \$2 WHERE id = \$1`).
+		WithArgs("th-1", now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	m := &domain.ContactMessage{ThreadID: "th-1", Author: domain.ContactAuthorTeam, UserID: "user-1", Body: "Yes, level -1."}
+	require.NoError(t, NewContactRepository(db).AddMessage(ctx, m))
+	require.Equal(t, "m-2", m.ID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
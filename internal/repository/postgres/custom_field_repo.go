@@ -0,0 +1,259 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/lib/pq"
+)
+
+type customFieldRepository struct {
+	DB *sql.DB
+}
+
+func NewCustomFieldRepository(db *sql.DB) domain.CustomFieldRepository {
+	return &customFieldRepository{
+		DB: db,
+	}
+}
+
+const customFieldColumns = `id, event_id, name, field_type, applies_to, is_public, created_at, updated_at`
+
+func scanCustomField(row rowScanner) (*domain.CustomField, error) {
+	f := &domain.CustomField{}
+	err := row.Scan(&f.ID, &f.EventID, &f.Name, &f.Type, &f.AppliesTo, &f.Public, &f.CreatedAt, &f.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func isUniqueViolation(err error) bool {
+	var perr *pq.Error
+	return errors.As(err, &perr) && perr.Code == "23505"
+}
+
+func (r *customFieldRepository) ListFields(ctx context.Context, eventID string) ([]*domain.CustomField, error) {
+	query := `SELECT ` + customFieldColumns + `
+		FROM event_custom_fields
+		WHERE event_id = $1
+		ORDER BY created_at, name
+	`
+	rows, err := r.DB.QueryContext(ctx, query, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	fields := []*domain.CustomField{}
+	for rows.Next() {
+		f, err := scanCustomField(rows)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, f)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+func (r *customFieldRepository) GetField(ctx context.Context, fieldID string) (*domain.CustomField, error) {
+	query := `SELECT ` + customFieldColumns + ` FROM event_custom_fields WHERE id = $1`
+	f, err := scanCustomField(r.DB.QueryRowContext(ctx, query, fieldID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	return f, nil
+}
+
+func (r *customFieldRepository) CreateField(ctx context.Context, field *domain.CustomField) error {
+	query := `
+		INSERT INTO event_custom_fields (event_id, name, field_type, applies_to, is_public)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at, updated_at
+	`
+	err := r.DB.QueryRowContext(ctx, query, field.EventID, field.Name, field.Type, field.AppliesTo, field.Public).
+		Scan(&field.ID, &field.CreatedAt, &field.UpdatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return domain.ErrDuplicateCustomField
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *customFieldRepository) UpdateField(ctx context.Context, fieldID string, name *string, public *bool) (*domain.CustomField, error) {
+	query := `
+		UPDATE event_custom_fields
+		SET name = COALESCE($2, name), is_public = COALESCE($3, is_public), updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + customFieldColumns
+	f, err := scanCustomField(r.DB.QueryRowContext(ctx, query, fieldID, name, public))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrNotFound
+		}
+		if isUniqueViolation(err) {
+			return nil, domain.ErrDuplicateCustomField
+		}
+		return nil, err
+	}
+	return f, nil
+}
+
+func (r *customFieldRepository) DeleteField(ctx context.Context, fieldID string) error {
+	result, err := r.DB.ExecContext(ctx, `DELETE FROM event_custom_fields WHERE id = $1`, fieldID)
+	if err != nil {
+		return err
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// customFieldValueTable returns the value table and its entity column for appliesTo.
+func customFieldValueTable(appliesTo string) (table, column string, err error) {
+	switch appliesTo {
+	case domain.CustomFieldSession:
+		return "session_custom_field_values", "session_id", nil
+	case domain.CustomFieldSpeaker:
+		return "speaker_custom_field_values", "speaker_id", nil
+	}
+	return "", "", fmt.Errorf("unknown custom field entity %q", appliesTo)
+}
+
+// customFieldColumnValues spreads a value over the typed columns value_text, value_number,
+// value_boolean and value_date; the columns of other types stay NULL.
+func customFieldColumnValues(v *domain.CustomFieldValue) ([]any, error) {
+	cols := make([]any, 4)
+	var ok bool
+	switch v.Type {
+	case domain.CustomFieldText, domain.CustomFieldURL:
+		cols[0], ok = v.Value.(string)
+	case domain.CustomFieldNumber:
+		cols[1], ok = v.Value.(float64)
+	case domain.CustomFieldBoolean:
+		cols[2], ok = v.Value.(bool)
+	case domain.CustomFieldDate:
+		cols[3], ok = v.Value.(string)
+	}
+	if !ok {
+		return nil, fmt.Errorf("custom field %s: %T is not a %s value", v.FieldID, v.Value, v.Type)
+	}
+	return cols, nil
+}
+
+func (r *customFieldRepository) SetValues(ctx context.Context, appliesTo, entityID string, values []*domain.CustomFieldValue) ([]*domain.CustomFieldValue, error) {
+	table, column, err := customFieldValueTable(appliesTo)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	for _, v := range values {
+		if v.Value == nil {
+			_, err := tx.ExecContext(ctx, `DELETE FROM `+table+` WHERE `+column+` = $1 AND field_id = $2`, entityID, v.FieldID)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		cols, err := customFieldColumnValues(v)
+		if err != nil {
+			return nil, err
+		}
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO `+table+` (`+column+`, field_id, value_text, value_number, value_boolean, value_date)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT (`+column+`, field_id) DO UPDATE SET
+				value_text = EXCLUDED.value_text, value_number = EXCLUDED.value_number,
+				value_boolean = EXCLUDED.value_boolean, value_date = EXCLUDED.value_date, updated_at = NOW()
+		`, append([]any{entityID, v.FieldID}, cols...)...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	byEntity, err := r.listValues(ctx, table, column, `v.`+column+` = $1`, entityID)
+	if err != nil {
+		return nil, err
+	}
+	if byEntity[entityID] == nil {
+		return []*domain.CustomFieldValue{}, nil
+	}
+	return byEntity[entityID], nil
+}
+
+func (r *customFieldRepository) ListValues(ctx context.Context, eventID, appliesTo string, publicOnly bool) (map[string][]*domain.CustomFieldValue, error) {
+	table, column, err := customFieldValueTable(appliesTo)
+	if err != nil {
+		return nil, err
+	}
+	return r.listValues(ctx, table, column, `f.event_id = $1 AND (f.is_public OR NOT $2)`, eventID, publicOnly)
+}
+
+// listValues returns the values matching where by entity ID, in field order.
+func (r *customFieldRepository) listValues(ctx context.Context, table, column, where string, args ...any) (map[string][]*domain.CustomFieldValue, error) {
+	query := `
+		SELECT v.` + column + `, f.id, f.name, f.field_type,
+			v.value_text, v.value_number, v.value_boolean, to_char(v.value_date, 'YYYY-MM-DD')
+		FROM ` + table + ` v
+		JOIN event_custom_fields f ON f.id = v.field_id
+		WHERE ` + where + `
+		ORDER BY f.created_at, f.name
+	`
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	byEntity := make(map[string][]*domain.CustomFieldValue)
+	for rows.Next() {
+		var (
+			entityID string
+			v        domain.CustomFieldValue
+			text     sql.NullString
+			number   sql.NullFloat64
+			boolean  sql.NullBool
+			date     sql.NullString
+		)
+		if err := rows.Scan(&entityID, &v.FieldID, &v.Name, &v.Type, &text, &number, &boolean, &date); err != nil {
+			return nil, err
+		}
+		switch {
+		case text.Valid:
+			v.Value = text.String
+		case number.Valid:
+			v.Value = number.Float64
+		case boolean.Valid:
+			v.Value = boolean.Bool
+		case date.Valid:
+			v.Value = date.String
+		}
+		byEntity[entityID] = append(byEntity[entityID], &v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return byEntity, nil
+}
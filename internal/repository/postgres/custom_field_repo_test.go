@@ -0,0 +1,122 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+var customFieldValueCols = []string{"session_id", "id", "name", "field_type", "value_text", "value_number", "value_boolean", "to_char"}
+
+func TestCustomFieldRepository_CreateField(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		mockErr error
+		wantErr error
+	}{
+		{name: "created"},
+		{name: "duplicate name", mockErr: &pq.Error{Code: "23505"}, wantErr: domain.ErrDuplicateCustomField},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			exp := mock.ExpectQuery(`INSERT INTO event_custom_fields \(event_id, name, field_type, applies_to, is_public\)`).
+				WithArgs("ev-1", "Paper DOI", domain.CustomFieldURL, domain.CustomFieldSession, true)
+			if tt.mockErr != nil {
+				exp.WillReturnError(tt.mockErr)
+			} else {
+				exp.WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow("field-1", now, now))
+			}
+
+			field := &domain.CustomField{EventID: "ev-1", Name: "Paper DOI", Type: domain.CustomFieldURL, AppliesTo: domain.CustomFieldSession, Public: true}
+			err = NewCustomFieldRepository(db).CreateField(ctx, field)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "field-1", field.ID)
+				require.Equal(t, now, field.CreatedAt)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCustomFieldRepository_SetValues(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO session_custom_field_values \(session_id, field_id, value_text, value_number, value_boolean, value_date\)`).
+		WithArgs("sess-1", "field-1", nil, 42.0, nil, nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM session_custom_field_values WHERE session_id = \$1 AND field_id = \$2`).
+		WithArgs("sess-1", "field-2").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery(`FROM session_custom_field_values v\s+JOIN event_custom_fields f ON f.id = v.field_id\s+WHERE v.session_id = \$1`).
+		WithArgs("sess-1").
+		WillReturnRows(sqlmock.NewRows(customFieldValueCols).
+			AddRow("sess-1", "field-1", "Attendance", domain.CustomFieldNumber, nil, 42.0, nil, nil).
+			AddRow("sess-1", "field-3", "Recorded on", domain.CustomFieldDate, nil, nil, nil, "2026-05-01"))
+
+	got, err := NewCustomFieldRepository(db).SetValues(ctx, domain.CustomFieldSession, "sess-1", []*domain.CustomFieldValue{
+		{FieldID: "field-1", Type: domain.CustomFieldNumber, Value: 42.0},
+		{FieldID: "field-2", Type: domain.CustomFieldText, Value: nil},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*domain.CustomFieldValue{
+		{FieldID: "field-1", Name: "Attendance", Type: domain.CustomFieldNumber, Value: 42.0},
+		{FieldID: "field-3", Name: "Recorded on", Type: domain.CustomFieldDate, Value: "2026-05-01"},
+	}, got)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCustomFieldRepository_SetValues_WrongType(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	_, err = NewCustomFieldRepository(db).SetValues(context.Background(), domain.CustomFieldSpeaker, "sp-1", []*domain.CustomFieldValue{
+		{FieldID: "field-1", Type: domain.CustomFieldBoolean, Value: "yes"},
+	})
+	require.Error(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCustomFieldRepository_ListValues_PublicOnly(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM speaker_custom_field_values v\s+JOIN event_custom_fields f ON f.id = v.field_id\s+WHERE f.event_id = \$1 AND \(f.is_public OR NOT \$2\)`).
+		WithArgs("ev-1", true).
+		WillReturnRows(sqlmock.NewRows(customFieldValueCols).
+			AddRow("sp-1", "field-1", "Streaming consent", domain.CustomFieldBoolean, nil, nil, true, nil).
+			AddRow("sp-2", "field-1", "Streaming consent", domain.CustomFieldBoolean, nil, nil, false, nil))
+
+	got, err := NewCustomFieldRepository(db).ListValues(context.Background(), "ev-1", domain.CustomFieldSpeaker, true)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]*domain.CustomFieldValue{
+		"sp-1": {{FieldID: "field-1", Name: "Streaming consent", Type: domain.CustomFieldBoolean, Value: true}},
+		"sp-2": {{FieldID: "field-1", Name: "Streaming consent", Type: domain.CustomFieldBoolean, Value: false}},
+	}, got)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
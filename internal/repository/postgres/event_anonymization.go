@@ -0,0 +1,162 @@
+package postgres
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/lib/pq"
+
+	"multitrackticketing/internal/domain"
+)
+
+const eventAnonymizationColumns = `event_id, anonymized_at, COALESCE(anonymized_by::text, ''), registrations,
+	invitations_sent, invitations_accepted, invitations_hashed, speaker_emails_cleared, custom_field_values_cleared`
+
+func scanEventAnonymization(row rowScanner) (*domain.EventAnonymization, error) {
+	a := &domain.EventAnonymization{}
+	err := row.Scan(&a.EventID, &a.AnonymizedAt, &a.AnonymizedBy, &a.Registrations,
+		&a.InvitationsSent, &a.InvitationsAccepted, &a.InvitationsHashed, &a.SpeakerEmailsCleared, &a.CustomFieldValuesCleared)
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// anonymizeEvent removes an event's personal data in one transaction and records it. Invitation
+// emails are replaced by their HMAC under key, so they stay unique per event but cannot be matched
+// against a list of known addresses without it. anonymizedBy is empty when the retention policy
+// anonymizes the event. An event anonymized before is left as
+// is and its record returned. With dryRun the transaction is rolled back, so the record only says
+// what would be removed.
+func anonymizeEvent(ctx context.Context, db *sql.DB, key []byte, eventID, anonymizedBy string, dryRun bool) (*domain.EventAnonymization, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// The counts are taken before the invitation emails stop matching the users who registered.
+	var created bool
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO event_anonymizations (event_id, anonymized_by, registrations, invitations_sent, invitations_accepted)
+		SELECT $1, NULLIF($2, '')::uuid,
+			(SELECT COUNT(*) FROM event_registrations WHERE event_id = $1),
+			(SELECT COUNT(*) FROM event_invitations WHERE event_id = $1),
+			(SELECT COUNT(er.user_id)
+				FROM event_invitations i
+				JOIN users u ON u.email = i.email
+				JOIN event_registrations er ON er.event_id = i.event_id AND er.user_id = u.id
+				WHERE i.event_id = $1)
+		ON CONFLICT (event_id) DO NOTHING
+		RETURNING true
+	`, eventID, anonymizedBy).Scan(&created)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	if created {
+		var hashed, speakers, values int64
+		if hashed, err = hashInvitationEmails(ctx, tx, key, eventID); err != nil {
+			return nil, err
+		}
+		if speakers, err = execRows(ctx, tx, `UPDATE speakers SET email = '' WHERE event_id = $1 AND email <> ''`, eventID); err != nil {
+			return nil, err
+		}
+		for _, table := range []string{"session_custom_field_values", "speaker_custom_field_values"} {
+			n, err := execRows(ctx, tx, `
+				DELETE FROM `+table+` v
+				USING event_custom_fields f
+				WHERE f.id = v.field_id AND f.event_id = $1 AND NOT f.is_public AND f.field_type IN ($2, $3)
+			`, eventID, domain.CustomFieldText, domain.CustomFieldURL)
+			if err != nil {
+				return nil, err
+			}
+			values += n
+		}
+		// Archived emails and contact threads quote people's addresses and names, exhibitors' leads and
+		// lead codes tie attendees to booths, invitation warm-ups queue addresses, and registrations and
+		// document acceptances tie attendees' accounts to the event; they are deleted outright, the
+		// registration count having been kept above.
+		for _, table := range []string{"event_emails", "contact_threads", "exhibitor_leads", "lead_consents", "invitation_warmups", "event_document_acceptances", "event_registrations"} {
+			if _, err := tx.ExecContext(ctx, `DELETE FROM `+table+` WHERE event_id = $1`, eventID); err != nil {
+				return nil, err
+			}
+		}
+		_, err = tx.ExecContext(ctx, `
+			UPDATE event_anonymizations
+			SET invitations_hashed = $2, speaker_emails_cleared = $3, custom_field_values_cleared = $4
+			WHERE event_id = $1
+		`, eventID, hashed, speakers, values)
+		if err != nil {
+			return nil, err
+		}
+	}
+	a, err := scanEventAnonymization(tx.QueryRowContext(ctx, `SELECT `+eventAnonymizationColumns+` FROM event_anonymizations WHERE event_id = $1`, eventID))
+	if err != nil {
+		return nil, err
+	}
+	if dryRun {
+		a.DryRun = true
+		return a, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// hashInvitationEmails replaces the event's invitation emails by their anonymized form and returns
+// how many it replaced.
+func hashInvitationEmails(ctx context.Context, tx *sql.Tx, key []byte, eventID string) (int64, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT id, email FROM event_invitations
+		WHERE event_id = $1 AND email NOT LIKE '%@`+domain.AnonymizedEmailDomain+`'
+		FOR UPDATE
+	`, eventID)
+	if err != nil {
+		return 0, err
+	}
+	var ids, emails []string
+	for rows.Next() {
+		var id, email string
+		if err := rows.Scan(&id, &email); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		ids = append(ids, id)
+		emails = append(emails, anonymizedEmail(key, email))
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	return execRows(ctx, tx, `
+		UPDATE event_invitations i SET email = v.email
+		FROM (SELECT unnest($1::uuid[]) AS id, unnest($2::text[]) AS email) v
+		WHERE i.id = v.id
+	`, pq.Array(ids), pq.Array(emails))
+}
+
+// anonymizedEmail returns the address an invitation to email keeps: the first 128 bits of the
+// HMAC-SHA256 of the lowercased address under key, at AnonymizedEmailDomain.
+func anonymizedEmail(key []byte, email string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(strings.ToLower(email)))
+	return "anon-" + hex.EncodeToString(mac.Sum(nil)[:16]) + "@" + domain.AnonymizedEmailDomain
+}
+
+// execRows runs query in tx and returns how many rows it changed.
+func execRows(ctx context.Context, tx *sql.Tx, query string, args ...any) (int64, error) {
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,133 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+var eventAnonymizationCols = []string{"event_id", "anonymized_at", "anonymized_by", "registrations", "invitations_sent",
+	"invitations_accepted", "invitations_hashed", "speaker_emails_cleared", "custom_field_values_cleared"}
+
+var testAnonymizationKey = []byte("test-anonymization-key")
+
+func TestEventRepository_Anonymize(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("anonymizes", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO event_anonymizations .*ON CONFLICT \(event_id\) DO NOTHING`).
+			WithArgs("ev-1", "user-1").
+			WillReturnRows(sqlmock.NewRows([]string{"bool"}).AddRow(true))
+		mock.ExpectQuery(`SELECT id, email FROM event_invitations\s+WHERE event_id = \$1 AND email NOT LIKE '%@anonymized.invalid'\s+FOR UPDATE`).
+			WithArgs("ev-1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "email"}).AddRow("inv-1", "Ana@Example.com").AddRow("inv-2", "bo@example.com"))
+		mock.ExpectExec(`UPDATE event_invitations i SET email = v.email\s+FROM \(SELECT unnest\(\$1::uuid\[\]\) AS id, unnest\(\$2::text\[\]\) AS email\) v`).
+			WithArgs(pq.Array([]string{"inv-1", "inv-2"}), pq.Array([]string{anonymizedEmail(testAnonymizationKey, "ana@example.com"), anonymizedEmail(testAnonymizationKey, "bo@example.com")})).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(`UPDATE speakers SET email = ''`).
+			WithArgs("ev-1").
+			WillReturnResult(sqlmock.NewResult(0, 4))
+		mock.ExpectExec(`DELETE FROM session_custom_field_values v\s+USING event_custom_fields f\s+WHERE .*NOT f.is_public`).
+			WithArgs("ev-1", domain.CustomFieldText, domain.CustomFieldURL).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM speaker_custom_field_values v`).
+			WithArgs("ev-1", domain.CustomFieldText, domain.CustomFieldURL).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(`DELETE FROM event_emails WHERE event_id = \$1`).
+			WithArgs("ev-1").
+			WillReturnResult(sqlmock.NewResult(0, 5))
+		mock.ExpectExec(`DELETE FROM contact_threads WHERE event_id = \$1`).
+			WithArgs("ev-1").
+			WillReturnResult(sqlmock.NewResult(0, 5))
+		mock.ExpectExec(`DELETE FROM exhibitor_leads WHERE event_id = \$1`).
+			WithArgs("ev-1").
+			WillReturnResult(sqlmock.NewResult(0, 7))
+		mock.ExpectExec(`DELETE FROM lead_consents WHERE event_id = \$1`).
+			WithArgs("ev-1").
+			WillReturnResult(sqlmock.NewResult(0, 9))
+		mock.ExpectExec(`DELETE FROM invitation_warmups WHERE event_id = \$1`).
+			WithArgs("ev-1").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM event_document_acceptances WHERE event_id = \$1`).
+			WithArgs("ev-1").
+			WillReturnResult(sqlmock.NewResult(0, 25))
+		mock.ExpectExec(`DELETE FROM event_registrations WHERE event_id = \$1`).
+			WithArgs("ev-1").
+			WillReturnResult(sqlmock.NewResult(0, 30))
+		mock.ExpectExec(`UPDATE event_anonymizations\s+SET invitations_hashed = \$2, speaker_emails_cleared = \$3, custom_field_values_cleared = \$4`).
+			WithArgs("ev-1", int64(2), int64(4), int64(3)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`FROM event_anonymizations WHERE event_id = \$1`).
+			WithArgs("ev-1").
+			WillReturnRows(sqlmock.NewRows(eventAnonymizationCols).AddRow("ev-1", at, "user-1", 30, 12, 8, 2, 4, 3))
+		mock.ExpectCommit()
+
+		got, err := NewEventRepository(db, testAnonymizationKey).Anonymize(ctx, "ev-1", "user-1")
+		require.NoError(t, err)
+		require.Equal(t, &domain.EventAnonymization{
+			EventID: "ev-1", AnonymizedAt: at, AnonymizedBy: "user-1", Registrations: 30, InvitationsSent: 12,
+			InvitationsAccepted: 8, InvitationsHashed: 2, SpeakerEmailsCleared: 4, CustomFieldValuesCleared: 3,
+		}, got)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("already anonymized", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO event_anonymizations`).
+			WithArgs("ev-1", "user-1").
+			WillReturnRows(sqlmock.NewRows([]string{"bool"}))
+		mock.ExpectQuery(`FROM event_anonymizations WHERE event_id = \$1`).
+			WithArgs("ev-1").
+			WillReturnRows(sqlmock.NewRows(eventAnonymizationCols).AddRow("ev-1", at, "", 30, 12, 8, 10, 4, 3))
+		mock.ExpectCommit()
+
+		got, err := NewEventRepository(db, nil).Anonymize(ctx, "ev-1", "user-1")
+		require.NoError(t, err)
+		require.Empty(t, got.AnonymizedBy, "the first record is kept")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("preview rolls back", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO event_anonymizations`).
+			WithArgs("ev-1", "user-1").
+			WillReturnRows(sqlmock.NewRows([]string{"bool"}))
+		mock.ExpectQuery(`FROM event_anonymizations WHERE event_id = \$1`).
+			WithArgs("ev-1").
+			WillReturnRows(sqlmock.NewRows(eventAnonymizationCols).AddRow("ev-1", at, "user-1", 30, 12, 8, 10, 4, 3))
+		mock.ExpectRollback()
+
+		got, err := NewEventRepository(db, nil).PreviewAnonymize(ctx, "ev-1", "user-1")
+		require.NoError(t, err)
+		require.True(t, got.DryRun)
+		require.Equal(t, 10, got.InvitationsHashed)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAnonymizedEmail(t *testing.T) {
+	got := anonymizedEmail(testAnonymizationKey, "Ana@Example.com")
+	require.Equal(t, got, anonymizedEmail(testAnonymizationKey, "ana@example.com"), "case does not matter")
+	require.True(t, domain.IsAnonymizedEmail(got))
+	require.Len(t, got, len("anon-")+32+len("@"+domain.AnonymizedEmailDomain))
+	require.NotEqual(t, got, anonymizedEmail([]byte("another-key"), "ana@example.com"), "the hash depends on the key")
+}
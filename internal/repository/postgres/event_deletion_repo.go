@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"multitrackticketing/internal/domain"
+)
+
+type eventDeletionRepository struct {
+	DB *sql.DB
+}
+
+func NewEventDeletionRepository(db *sql.DB) domain.EventDeletionRepository {
+	return &eventDeletionRepository{
+		DB: db,
+	}
+}
+
+func (r *eventDeletionRepository) Create(ctx context.Context, d *domain.PendingEventDeletion, requestedBy, tokenHash string) error {
+	query := `
+		INSERT INTO event_deletion_requests (event_id, requested_by, token_hash, force, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (event_id) DO UPDATE SET
+			requested_by = EXCLUDED.requested_by,
+			token_hash = EXCLUDED.token_hash,
+			force = EXCLUDED.force,
+			expires_at = EXCLUDED.expires_at,
+			created_at = NOW()
+	`
+	_, err := r.DB.ExecContext(ctx, query, d.EventID, requestedBy, tokenHash, d.Force, d.ExpiresAt)
+	return err
+}
+
+func (r *eventDeletionRepository) Consume(ctx context.Context, eventID, tokenHash string) (*domain.PendingEventDeletion, bool, error) {
+	d := &domain.PendingEventDeletion{}
+	err := r.DB.QueryRowContext(ctx, `
+		DELETE FROM event_deletion_requests
+		WHERE event_id = $1 AND token_hash = $2 AND expires_at > NOW()
+		RETURNING event_id, force, expires_at
+	`, eventID, tokenHash).Scan(&d.EventID, &d.Force, &d.ExpiresAt)
+	if err == sql.ErrNoRows {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return d, true, nil
+}
@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventDeletionRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	expires := time.Date(2026, 5, 1, 9, 30, 0, 0, time.UTC)
+	mock.ExpectExec(`INSERT INTO event_deletion_requests .+ ON CONFLICT \(event_id\) DO UPDATE`).
+		WithArgs("event-1", "owner-1", "hash", true, expires).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	d := &domain.PendingEventDeletion{EventID: "event-1", Force: true, ExpiresAt: expires}
+	require.NoError(t, NewEventDeletionRepository(db).Create(context.Background(), d, "owner-1", "hash"))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEventDeletionRepository_Consume(t *testing.T) {
+	t.Run("matching token", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		expires := time.Date(2026, 5, 1, 9, 30, 0, 0, time.UTC)
+		mock.ExpectQuery(`DELETE FROM event_deletion_requests\s+WHERE event_id = \$1 AND token_hash = \$2 AND expires_at > NOW\(\)`).
+			WithArgs("event-1", "hash").
+			WillReturnRows(sqlmock.NewRows([]string{"event_id", "force", "expires_at"}).AddRow("event-1", true, expires))
+
+		d, ok, err := NewEventDeletionRepository(db).Consume(context.Background(), "event-1", "hash")
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.True(t, d.Force)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("wrong or expired token", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`DELETE FROM event_deletion_requests`).
+			WithArgs("event-1", "wrong").
+			WillReturnRows(sqlmock.NewRows([]string{"event_id", "force", "expires_at"}))
+
+		_, ok, err := NewEventDeletionRepository(db).Consume(context.Background(), "event-1", "wrong")
+		require.NoError(t, err)
+		assert.False(t, ok)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,189 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+
+	"multitrackticketing/internal/domain"
+)
+
+type eventDocumentRepository struct {
+	DB *sql.DB
+}
+
+func NewEventDocumentRepository(db *sql.DB) domain.EventDocumentRepository {
+	return &eventDocumentRepository{
+		DB: db,
+	}
+}
+
+func (r *eventDocumentRepository) Publish(ctx context.Context, doc *domain.EventDocument) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// The upsert locks the document's row, so concurrent publishes number their versions in turn.
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO event_documents (event_id, kind)
+		VALUES ($1, $2)
+		ON CONFLICT (event_id, kind) DO UPDATE SET withdrawn_at = NULL
+	`, doc.EventID, doc.Kind)
+	if err != nil {
+		return err
+	}
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO event_document_versions (event_id, kind, version, title, body)
+		SELECT $1, $2, COALESCE(MAX(version), 0) + 1, $3, $4
+		FROM event_document_versions
+		WHERE event_id = $1 AND kind = $2
+		RETURNING version, published_at
+	`, doc.EventID, doc.Kind, doc.Title, doc.Body).Scan(&doc.Version, &doc.PublishedAt)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *eventDocumentRepository) ListCurrent(ctx context.Context, eventID string) ([]*domain.EventDocument, error) {
+	return r.list(ctx, `
+		SELECT DISTINCT ON (v.kind) v.event_id, v.kind, v.version, v.title, v.body, v.published_at
+		FROM event_document_versions v
+		JOIN event_documents d ON d.event_id = v.event_id AND d.kind = v.kind
+		WHERE v.event_id = $1 AND d.withdrawn_at IS NULL
+		ORDER BY v.kind, v.version DESC
+	`, eventID)
+}
+
+func (r *eventDocumentRepository) ListVersions(ctx context.Context, eventID, kind string) ([]*domain.EventDocument, error) {
+	return r.list(ctx, `
+		SELECT event_id, kind, version, title, body, published_at
+		FROM event_document_versions
+		WHERE event_id = $1 AND kind = $2
+		ORDER BY version DESC
+	`, eventID, kind)
+}
+
+func (r *eventDocumentRepository) list(ctx context.Context, query string, args ...any) ([]*domain.EventDocument, error) {
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	docs := []*domain.EventDocument{}
+	for rows.Next() {
+		doc := &domain.EventDocument{}
+		if err := rows.Scan(&doc.EventID, &doc.Kind, &doc.Version, &doc.Title, &doc.Body, &doc.PublishedAt); err != nil {
+			return nil, err
+		}
+		docs = append(docs, doc)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+func (r *eventDocumentRepository) Withdraw(ctx context.Context, eventID, kind string) error {
+	res, err := r.DB.ExecContext(ctx, `
+		UPDATE event_documents SET withdrawn_at = NOW()
+		WHERE event_id = $1 AND kind = $2 AND withdrawn_at IS NULL
+	`, eventID, kind)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *eventDocumentRepository) Accept(ctx context.Context, eventID, userID string, versions []domain.DocumentVersion) error {
+	if len(versions) == 0 {
+		return nil
+	}
+	kinds := make(pq.StringArray, len(versions))
+	numbers := make(pq.Int64Array, len(versions))
+	for i, v := range versions {
+		kinds[i], numbers[i] = v.Kind, int64(v.Version)
+	}
+	_, err := r.DB.ExecContext(ctx, `
+		INSERT INTO event_document_acceptances (event_id, kind, version, user_id)
+		SELECT $1, unnest($2::text[]), unnest($3::int[]), $4
+		ON CONFLICT (event_id, kind, user_id, version) DO NOTHING
+	`, eventID, kinds, numbers, userID)
+	return err
+}
+
+func (r *eventDocumentRepository) ListAcceptances(ctx context.Context, eventID, userID string) ([]*domain.DocumentAcceptance, error) {
+	rows, err := r.DB.QueryContext(ctx, `
+		SELECT DISTINCT ON (kind) kind, version, accepted_at
+		FROM event_document_acceptances
+		WHERE event_id = $1 AND user_id = $2
+		ORDER BY kind, version DESC
+	`, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	acceptances := []*domain.DocumentAcceptance{}
+	for rows.Next() {
+		a := &domain.DocumentAcceptance{EventID: eventID, UserID: userID}
+		if err := rows.Scan(&a.Kind, &a.Version, &a.AcceptedAt); err != nil {
+			return nil, err
+		}
+		acceptances = append(acceptances, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return acceptances, nil
+}
+
+func (r *eventDocumentRepository) ListAttendeeAcceptances(ctx context.Context, eventID, kind string) ([]*domain.AttendeeAcceptance, error) {
+	rows, err := r.DB.QueryContext(ctx, `
+		SELECT u.id, u.email, u.name, u.last_name, a.version, a.accepted_at
+		FROM event_registrations r
+		JOIN users u ON u.id = r.user_id
+		LEFT JOIN LATERAL (
+			SELECT version, accepted_at
+			FROM event_document_acceptances
+			WHERE event_id = r.event_id AND kind = $2 AND user_id = r.user_id
+			ORDER BY version DESC
+			LIMIT 1
+		) a ON true
+		WHERE r.event_id = $1
+		ORDER BY u.email
+	`, eventID, kind)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	attendees := []*domain.AttendeeAcceptance{}
+	for rows.Next() {
+		a := &domain.AttendeeAcceptance{}
+		var name, lastName sql.NullString
+		var version sql.NullInt64
+		var acceptedAt sql.NullTime
+		if err := rows.Scan(&a.UserID, &a.Email, &name, &lastName, &version, &acceptedAt); err != nil {
+			return nil, err
+		}
+		a.Name, a.LastName = name.String, lastName.String
+		a.AcceptedVersion = int(version.Int64)
+		if acceptedAt.Valid {
+			a.AcceptedAt = &acceptedAt.Time
+		}
+		attendees = append(attendees, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return attendees, nil
+}
@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	"multitrackticketing/internal/domain"
+)
+
+func TestEventDocumentRepository_Publish(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO event_documents \(event_id, kind\)\s+VALUES \(\$1, \$2\)\s+ON CONFLICT \(event_id, kind\) DO UPDATE SET withdrawn_at = NULL`).
+		WithArgs("ev-1", domain.DocumentKindCodeOfConduct).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`INSERT INTO event_document_versions \(event_id, kind, version, title, body\)\s+SELECT \$1, \$2, COALESCE\(MAX\(version\), 0\) \+ 1, \$3, \$4`).
+		WithArgs("ev-1", domain.DocumentKindCodeOfConduct, "Code of conduct", "Be kind.").
+		WillReturnRows(sqlmock.NewRows([]string{"version", "published_at"}).AddRow(2, at))
+	mock.ExpectCommit()
+
+	doc := &domain.EventDocument{EventID: "ev-1", Kind: domain.DocumentKindCodeOfConduct, Title: "Code of conduct", Body: "Be kind."}
+	require.NoError(t, NewEventDocumentRepository(db).Publish(ctx, doc))
+	require.Equal(t, 2, doc.Version)
+	require.Equal(t, at, doc.PublishedAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEventDocumentRepository_ListCurrent(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT DISTINCT ON \(v.kind\) .* FROM event_document_versions v\s+JOIN event_documents d .*WHERE v.event_id = \$1 AND d.withdrawn_at IS NULL`).
+		WithArgs("ev-1").
+		WillReturnRows(sqlmock.NewRows([]string{"event_id", "kind", "version", "title", "body", "published_at"}).
+			AddRow("ev-1", domain.DocumentKindCodeOfConduct, 2, "Code of conduct", "Be kind.", at))
+
+	got, err := NewEventDocumentRepository(db).ListCurrent(ctx, "ev-1")
+	require.NoError(t, err)
+	require.Equal(t, []*domain.EventDocument{
+		{EventID: "ev-1", Kind: domain.DocumentKindCodeOfConduct, Version: 2, Title: "Code of conduct", Body: "Be kind.", PublishedAt: at},
+	}, got)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEventDocumentRepository_Withdraw(t *testing.T) {
+	ctx := context.Background()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec(`UPDATE event_documents SET withdrawn_at = NOW\(\)\s+WHERE event_id = \$1 AND kind = \$2 AND withdrawn_at IS NULL`).
+		WithArgs("ev-1", domain.DocumentKindTerms).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE event_documents SET withdrawn_at`).
+		WithArgs("ev-1", domain.DocumentKindTerms).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repo := NewEventDocumentRepository(db)
+	require.NoError(t, repo.Withdraw(ctx, "ev-1", domain.DocumentKindTerms))
+	require.ErrorIs(t, repo.Withdraw(ctx, "ev-1", domain.DocumentKindTerms), domain.ErrNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEventDocumentRepository_Acceptances(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec(`INSERT INTO event_document_acceptances \(event_id, kind, version, user_id\)\s+SELECT \$1, unnest\(\$2::text\[\]\), unnest\(\$3::int\[\]\), \$4\s+ON CONFLICT .* DO NOTHING`).
+		WithArgs("ev-1", pq.StringArray{domain.DocumentKindCodeOfConduct, domain.DocumentKindPhotoPolicy}, pq.Int64Array{2, 1}, "user-1").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectQuery(`SELECT DISTINCT ON \(kind\) kind, version, accepted_at\s+FROM event_document_acceptances\s+WHERE event_id = \$1 AND user_id = \$2`).
+		WithArgs("ev-1", "user-1").
+		WillReturnRows(sqlmock.NewRows([]string{"kind", "version", "accepted_at"}).AddRow(domain.DocumentKindCodeOfConduct, 2, at))
+	mock.ExpectQuery(`FROM event_registrations r\s+JOIN users u ON u.id = r.user_id\s+LEFT JOIN LATERAL`).
+		WithArgs("ev-1", domain.DocumentKindCodeOfConduct).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email", "name", "last_name", "version", "accepted_at"}).
+			AddRow("user-1", "ada@example.com", "Ada", "Lovelace", 2, at).
+			AddRow("user-2", "bob@example.com", nil, nil, nil, nil))
+
+	repo := NewEventDocumentRepository(db)
+	require.NoError(t, repo.Accept(ctx, "ev-1", "user-1", []domain.DocumentVersion{
+		{Kind: domain.DocumentKindCodeOfConduct, Version: 2},
+		{Kind: domain.DocumentKindPhotoPolicy, Version: 1},
+	}))
+	require.NoError(t, repo.Accept(ctx, "ev-1", "user-1", nil), "nothing to accept runs no query")
+
+	accepted, err := repo.ListAcceptances(ctx, "ev-1", "user-1")
+	require.NoError(t, err)
+	require.Equal(t, []*domain.DocumentAcceptance{
+		{EventID: "ev-1", Kind: domain.DocumentKindCodeOfConduct, Version: 2, UserID: "user-1", AcceptedAt: at},
+	}, accepted)
+
+	attendees, err := repo.ListAttendeeAcceptances(ctx, "ev-1", domain.DocumentKindCodeOfConduct)
+	require.NoError(t, err)
+	require.Equal(t, []*domain.AttendeeAcceptance{
+		{UserID: "user-1", Email: "ada@example.com", Name: "Ada", LastName: "Lovelace", AcceptedVersion: 2, AcceptedAt: &at},
+		{UserID: "user-2", Email: "bob@example.com"},
+	}, attendees)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
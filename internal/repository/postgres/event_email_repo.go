@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"multitrackticketing/internal/domain"
+)
+
+type eventEmailRepository struct {
+	DB *sql.DB
+}
+
+func NewEventEmailRepository(db *sql.DB) domain.EventEmailRepository {
+	return &eventEmailRepository{
+		DB: db,
+	}
+}
+
+const eventEmailColumns = `id, event_id, kind, recipient, subject, html_body, text_body, status, error,
+	COALESCE(resend_of::text, ''), created_at`
+
+func scanEventEmail(row rowScanner) (*domain.EventEmail, error) {
+	e := &domain.EventEmail{}
+	err := row.Scan(&e.ID, &e.EventID, &e.Kind, &e.To, &e.Subject, &e.HTML, &e.Text, &e.Status, &e.Error, &e.ResendOf, &e.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *eventEmailRepository) Create(ctx context.Context, email *domain.EventEmail) error {
+	query := `
+		INSERT INTO event_emails (event_id, kind, recipient, subject, html_body, text_body, status, error, resend_of)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NULLIF($9, '')::uuid)
+		RETURNING id, created_at
+	`
+	return r.DB.QueryRowContext(ctx, query, email.EventID, email.Kind, email.To, email.Subject, email.HTML, email.Text,
+		email.Status, email.Error, email.ResendOf).Scan(&email.ID, &email.CreatedAt)
+}
+
+func (r *eventEmailRepository) GetByID(ctx context.Context, id string) (*domain.EventEmail, error) {
+	e, err := scanEventEmail(r.DB.QueryRowContext(ctx, `SELECT `+eventEmailColumns+` FROM event_emails WHERE id = $1`, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *eventEmailRepository) ListByEventID(ctx context.Context, eventID string, filter domain.EventEmailFilter, params domain.PaginationParams) ([]*domain.EventEmail, int, error) {
+	conditions := []string{"event_id = $1"}
+	args := []any{eventID}
+	if filter.To != "" {
+		args = append(args, "%"+escapeILIKE(filter.To)+"%")
+		conditions = append(conditions, fmt.Sprintf("recipient ILIKE $%d", len(args)))
+	}
+	if filter.Kind != "" {
+		args = append(args, filter.Kind)
+		conditions = append(conditions, fmt.Sprintf("kind = $%d", len(args)))
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		conditions = append(conditions, fmt.Sprintf("status = $%d", len(args)))
+	}
+	where := strings.Join(conditions, " AND ")
+
+	var total int
+	if err := r.DB.QueryRowContext(ctx, `SELECT COUNT(*) FROM event_emails WHERE `+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM event_emails
+		WHERE %s
+		ORDER BY created_at DESC, id
+		LIMIT $%d OFFSET $%d
+	`, eventEmailColumns, where, len(args)+1, len(args)+2)
+	rows, err := r.DB.QueryContext(ctx, query, append(args, params.PageSize, params.Offset())...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	emails := []*domain.EventEmail{}
+	for rows.Next() {
+		e, err := scanEventEmail(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		emails = append(emails, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return emails, total, nil
+}
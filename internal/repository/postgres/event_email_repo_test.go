@@ -0,0 +1,150 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+var eventEmailCols = []string{"id", "event_id", "kind", "recipient", "subject", "html_body", "text_body", "status", "error", "resend_of", "created_at"}
+
+func TestEventEmailRepository_Create(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`INSERT INTO event_emails .* NULLIF\(\$9, ''\)::uuid`).
+		WithArgs("ev-1", domain.EventEmailInvitation, "alice@example.com", "You're invited", "<p>Hi</p>", "Hi",
+			domain.EventEmailFailed, "smtp down", "").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow("em-1", createdAt))
+
+	email := &domain.EventEmail{
+		EventID: "ev-1", Kind: domain.EventEmailInvitation, To: "alice@example.com", Subject: "You're invited",
+		HTML: "<p>Hi</p>", Text: "Hi", Status: domain.EventEmailFailed, Error: "smtp down",
+	}
+	require.NoError(t, NewEventEmailRepository(db).Create(ctx, email))
+	require.Equal(t, "em-1", email.ID)
+	require.Equal(t, createdAt, email.CreatedAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEventEmailRepository_GetByID(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM event_emails WHERE id = \$1`).
+			WithArgs("em-2").
+			WillReturnRows(sqlmock.NewRows(eventEmailCols).
+				AddRow("em-2", "ev-1", domain.EventEmailInvitation, "alice@example.com", "You're invited", "<p>Hi</p>", "Hi", domain.EventEmailSent, "", "em-1", createdAt))
+
+		got, err := NewEventEmailRepository(db).GetByID(ctx, "em-2")
+		require.NoError(t, err)
+		require.Equal(t, &domain.EventEmail{
+			ID: "em-2", EventID: "ev-1", Kind: domain.EventEmailInvitation, To: "alice@example.com", Subject: "You're invited",
+			HTML: "<p>Hi</p>", Text: "Hi", Status: domain.EventEmailSent, ResendOf: "em-1", CreatedAt: createdAt,
+		}, got)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM event_emails WHERE id = \$1`).
+			WithArgs("missing").
+			WillReturnError(sql.ErrNoRows)
+
+		_, err = NewEventEmailRepository(db).GetByID(ctx, "missing")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func TestEventEmailRepository_ListByEventID(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	params := domain.PaginationParams{Page: 2, PageSize: 10}
+
+	tests := []struct {
+		name      string
+		filter    domain.EventEmailFilter
+		mock      func(mock sqlmock.Sqlmock)
+		want      []*domain.EventEmail
+		wantTotal int
+		wantErr   bool
+	}{
+		{
+			name: "all emails of the event",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM event_emails WHERE event_id = \$1$`).
+					WithArgs("ev-1").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(11))
+				mock.ExpectQuery(`FROM event_emails\s+WHERE event_id = \$1\s+ORDER BY created_at DESC, id\s+LIMIT \$2 OFFSET \$3`).
+					WithArgs("ev-1", 10, 10).
+					WillReturnRows(sqlmock.NewRows(eventEmailCols).
+						AddRow("em-1", "ev-1", domain.EventEmailInvitationReminder, "bob@example.com", "Reminder", "<p>Hi</p>", "Hi", domain.EventEmailSent, "", "", createdAt))
+			},
+			want: []*domain.EventEmail{
+				{
+					ID: "em-1", EventID: "ev-1", Kind: domain.EventEmailInvitationReminder, To: "bob@example.com", Subject: "Reminder",
+					HTML: "<p>Hi</p>", Text: "Hi", Status: domain.EventEmailSent, CreatedAt: createdAt,
+				},
+			},
+			wantTotal: 11,
+		},
+		{
+			name:   "filters",
+			filter: domain.EventEmailFilter{To: "ali_", Kind: domain.EventEmailInvitation, Status: domain.EventEmailFailed},
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM event_emails WHERE event_id = \$1 AND recipient ILIKE \$2 AND kind = \$3 AND status = \$4`).
+					WithArgs("ev-1", `%ali\_%`, domain.EventEmailInvitation, domain.EventEmailFailed).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectQuery(`LIMIT \$5 OFFSET \$6`).
+					WithArgs("ev-1", `%ali\_%`, domain.EventEmailInvitation, domain.EventEmailFailed, 10, 10).
+					WillReturnRows(sqlmock.NewRows(eventEmailCols))
+			},
+			want: []*domain.EventEmail{},
+		},
+		{
+			name: "db error",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT COUNT\(\*\)`).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			tt.mock(mock)
+			got, total, err := NewEventEmailRepository(db).ListByEventID(ctx, "ev-1", tt.filter, params)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.wantTotal, total)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -3,9 +3,13 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"strings"
+	"time"
 
 	"multitrackticketing/internal/domain"
+
+	"github.com/lib/pq"
 )
 
 type eventInvitationRepository struct {
@@ -26,16 +30,55 @@ func escapeILIKE(s string) string {
 	return s
 }
 
+// invitationSelect selects invitations with the user who accepted them, if any: the user with
+// the invited email, once registered for the event.
+const invitationSelect = `
+	SELECT i.id, i.event_id, i.email, i.sent_at, u.id, u.name, u.last_name, u.avatar_url, er.created_at
+	FROM event_invitations i
+	LEFT JOIN users u ON u.email = i.email
+	LEFT JOIN event_registrations er ON er.event_id = i.event_id AND er.user_id = u.id
+`
+
+func scanInvitation(row rowScanner) (*domain.EventInvitation, error) {
+	inv := &domain.EventInvitation{}
+	var userID, name, lastName, avatarURL sql.NullString
+	var acceptedAt sql.NullTime
+	if err := row.Scan(&inv.ID, &inv.EventID, &inv.Email, &inv.SentAt, &userID, &name, &lastName, &avatarURL, &acceptedAt); err != nil {
+		return nil, err
+	}
+	if acceptedAt.Valid {
+		inv.AcceptedBy = &domain.InvitationAcceptance{
+			UserID:     userID.String,
+			Name:       name.String,
+			LastName:   lastName.String,
+			AvatarURL:  avatarURL.String,
+			AcceptedAt: acceptedAt.Time,
+		}
+	}
+	return inv, nil
+}
+
 func (r *eventInvitationRepository) Create(ctx context.Context, inv *domain.EventInvitation) error {
 	query := `
-		INSERT INTO event_invitations (event_id, email, sent_at)
-		VALUES ($1, $2, $3)
+		INSERT INTO event_invitations (event_id, email, sent_at, last_contacted_at)
+		VALUES ($1, $2, $3, $3)
 		RETURNING id
 	`
 	return r.DB.QueryRowContext(ctx, query, inv.EventID, inv.Email, inv.SentAt).
 		Scan(&inv.ID)
 }
 
+func (r *eventInvitationRepository) GetByID(ctx context.Context, id string) (*domain.EventInvitation, error) {
+	inv, err := scanInvitation(r.DB.QueryRowContext(ctx, invitationSelect+`WHERE i.id = $1`, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	return inv, nil
+}
+
 func (r *eventInvitationRepository) ListByEventID(ctx context.Context, eventID string, search string, params domain.PaginationParams) ([]*domain.EventInvitation, int, error) {
 	var total int
 	if search != "" {
@@ -63,20 +106,16 @@ func (r *eventInvitationRepository) ListByEventID(ctx context.Context, eventID s
 	var args []any
 	if search != "" {
 		pattern := "%" + escapeILIKE(search) + "%"
-		query = `
-			SELECT id, event_id, email, sent_at
-			FROM event_invitations
-			WHERE event_id = $1 AND email ILIKE $2
-			ORDER BY sent_at DESC
+		query = invitationSelect + `
+			WHERE i.event_id = $1 AND i.email ILIKE $2
+			ORDER BY i.sent_at DESC
 			LIMIT $3 OFFSET $4
 		`
 		args = []any{eventID, pattern, params.PageSize, params.Offset()}
 	} else {
-		query = `
-			SELECT id, event_id, email, sent_at
-			FROM event_invitations
-			WHERE event_id = $1
-			ORDER BY sent_at DESC
+		query = invitationSelect + `
+			WHERE i.event_id = $1
+			ORDER BY i.sent_at DESC
 			LIMIT $2 OFFSET $3
 		`
 		args = []any{eventID, params.PageSize, params.Offset()}
@@ -90,8 +129,8 @@ func (r *eventInvitationRepository) ListByEventID(ctx context.Context, eventID s
 
 	var invs []*domain.EventInvitation
 	for rows.Next() {
-		inv := &domain.EventInvitation{}
-		if err := rows.Scan(&inv.ID, &inv.EventID, &inv.Email, &inv.SentAt); err != nil {
+		inv, err := scanInvitation(rows)
+		if err != nil {
 			return nil, 0, err
 		}
 		invs = append(invs, inv)
@@ -104,3 +143,120 @@ func (r *eventInvitationRepository) ListByEventID(ctx context.Context, eventID s
 	}
 	return invs, total, nil
 }
+
+func (r *eventInvitationRepository) StreamByEventID(ctx context.Context, eventID string, search string, fn func(*domain.EventInvitation) error) error {
+	query := invitationSelect + `
+		WHERE i.event_id = $1
+		ORDER BY i.sent_at DESC
+	`
+	args := []any{eventID}
+	if search != "" {
+		query = invitationSelect + `
+			WHERE i.event_id = $1 AND i.email ILIKE $2
+			ORDER BY i.sent_at DESC
+		`
+		args = append(args, "%"+escapeILIKE(search)+"%")
+	}
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		inv, err := scanInvitation(rows)
+		if err != nil {
+			return err
+		}
+		if err := fn(inv); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func (r *eventInvitationRepository) ListPending(ctx context.Context, eventID string) ([]*domain.PendingInvitation, error) {
+	query := `
+		SELECT i.id, i.email, i.last_contacted_at, i.reminders_sent
+		FROM event_invitations i
+		LEFT JOIN users u ON u.email = i.email
+		LEFT JOIN event_registrations er ON er.event_id = i.event_id AND er.user_id = u.id
+		WHERE i.event_id = $1 AND er.user_id IS NULL AND i.email NOT LIKE '%@` + domain.AnonymizedEmailDomain + `'
+		ORDER BY i.sent_at, i.email
+	`
+	rows, err := r.DB.QueryContext(ctx, query, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	pending := []*domain.PendingInvitation{}
+	for rows.Next() {
+		p := &domain.PendingInvitation{}
+		if err := rows.Scan(&p.ID, &p.Email, &p.LastContactedAt, &p.RemindersSent); err != nil {
+			return nil, err
+		}
+		pending = append(pending, p)
+	}
+	return pending, rows.Err()
+}
+
+func (r *eventInvitationRepository) MarkReminded(ctx context.Context, id string, at time.Time) error {
+	result, err := r.DB.ExecContext(ctx, `
+		UPDATE event_invitations
+		SET last_contacted_at = $2, reminders_sent = reminders_sent + 1
+		WHERE id = $1
+	`, id, at)
+	if err != nil {
+		return err
+	}
+	n, _ := result.RowsAffected()
+	if n == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *eventInvitationRepository) GetDomainRules(ctx context.Context, eventID string) (*domain.InvitationDomainRules, error) {
+	query := `
+		SELECT event_id, allowed_domains, blocked_domains, updated_at
+		FROM event_invitation_domain_rules
+		WHERE event_id = $1
+	`
+	rules := &domain.InvitationDomainRules{}
+	var allowed, blocked pq.StringArray
+	err := r.DB.QueryRowContext(ctx, query, eventID).Scan(&rules.EventID, &allowed, &blocked, &rules.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	rules.AllowedDomains = []string(allowed)
+	if rules.AllowedDomains == nil {
+		rules.AllowedDomains = []string{}
+	}
+	rules.BlockedDomains = []string(blocked)
+	if rules.BlockedDomains == nil {
+		rules.BlockedDomains = []string{}
+	}
+	return rules, nil
+}
+
+func (r *eventInvitationRepository) UpsertDomainRules(ctx context.Context, rules *domain.InvitationDomainRules) error {
+	allowed, blocked := rules.AllowedDomains, rules.BlockedDomains
+	if allowed == nil {
+		allowed = []string{}
+	}
+	if blocked == nil {
+		blocked = []string{}
+	}
+	query := `
+		INSERT INTO event_invitation_domain_rules (event_id, allowed_domains, blocked_domains, updated_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (event_id) DO UPDATE SET
+			allowed_domains = EXCLUDED.allowed_domains,
+			blocked_domains = EXCLUDED.blocked_domains,
+			updated_at = EXCLUDED.updated_at
+		RETURNING updated_at
+	`
+	return r.DB.QueryRowContext(ctx, query, rules.EventID, pq.Array(allowed), pq.Array(blocked)).Scan(&rules.UpdatedAt)
+}
@@ -0,0 +1,251 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+var invitationCols = []string{"id", "event_id", "email", "sent_at", "user_id", "name", "last_name", "avatar_url", "accepted_at"}
+
+func TestEventInvitationRepository_ListByEventID(t *testing.T) {
+	ctx := context.Background()
+	sentAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	acceptedAt := time.Date(2025, 3, 2, 10, 0, 0, 0, time.UTC)
+	params := domain.PaginationParams{Page: 1, PageSize: 20}
+
+	tests := []struct {
+		name      string
+		search    string
+		mock      func(mock sqlmock.Sqlmock)
+		want      []*domain.EventInvitation
+		wantTotal int
+		wantErr   bool
+	}{
+		{
+			name: "joins the accepting user",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT COUNT\(\*\)\s+FROM event_invitations\s+WHERE event_id = \$1`).
+					WithArgs("ev-1").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+				mock.ExpectQuery(`LEFT JOIN users u ON u.email = i.email\s+LEFT JOIN event_registrations er ON er.event_id = i.event_id AND er.user_id = u.id\s+WHERE i.event_id = \$1\s+ORDER BY i.sent_at DESC`).
+					WithArgs("ev-1", 20, 0).
+					WillReturnRows(sqlmock.NewRows(invitationCols).
+						AddRow("inv-1", "ev-1", "alice@example.com", sentAt, "user-a", "Alice", "A", "https://cdn.example.com/alice.png", acceptedAt).
+						AddRow("inv-2", "ev-1", "bob@example.com", sentAt, "user-b", "Bob", nil, nil, nil))
+			},
+			want: []*domain.EventInvitation{
+				{
+					ID: "inv-1", EventID: "ev-1", Email: "alice@example.com", SentAt: sentAt,
+					AcceptedBy: &domain.InvitationAcceptance{
+						UserID: "user-a", Name: "Alice", LastName: "A", AvatarURL: "https://cdn.example.com/alice.png", AcceptedAt: acceptedAt,
+					},
+				},
+				{ID: "inv-2", EventID: "ev-1", Email: "bob@example.com", SentAt: sentAt},
+			},
+			wantTotal: 2,
+		},
+		{
+			name:   "search",
+			search: "ali",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT COUNT\(\*\)`).
+					WithArgs("ev-1", "%ali%").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectQuery(`WHERE i.event_id = \$1 AND i.email ILIKE \$2`).
+					WithArgs("ev-1", "%ali%", 20, 0).
+					WillReturnRows(sqlmock.NewRows(invitationCols))
+			},
+			want: []*domain.EventInvitation{},
+		},
+		{
+			name: "db error",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT COUNT\(\*\)`).
+					WithArgs("ev-1").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectQuery(`FROM event_invitations i`).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			tt.mock(mock)
+			repo := NewEventInvitationRepository(db)
+			got, total, err := repo.ListByEventID(ctx, "ev-1", tt.search, params)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.wantTotal, total)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestEventInvitationRepository_StreamByEventID(t *testing.T) {
+	ctx := context.Background()
+	sentAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	acceptedAt := time.Date(2025, 3, 2, 10, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM event_invitations i\s+LEFT JOIN users u.*WHERE i.event_id = \$1\s+ORDER BY i.sent_at DESC`).
+		WithArgs("ev-1").
+		WillReturnRows(sqlmock.NewRows(invitationCols).
+			AddRow("inv-1", "ev-1", "alice@example.com", sentAt, "user-a", "Alice", "A", nil, acceptedAt).
+			AddRow("inv-2", "ev-1", "carol@example.com", sentAt, nil, nil, nil, nil, nil))
+
+	var got []*domain.EventInvitation
+	err = NewEventInvitationRepository(db).StreamByEventID(ctx, "ev-1", "", func(inv *domain.EventInvitation) error {
+		got = append(got, inv)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, &domain.InvitationAcceptance{UserID: "user-a", Name: "Alice", LastName: "A", AcceptedAt: acceptedAt}, got[0].AcceptedBy)
+	require.Nil(t, got[1].AcceptedBy)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEventInvitationRepository_GetByID(t *testing.T) {
+	ctx := context.Background()
+	sentAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM event_invitations i.*WHERE i.id = \$1`).
+			WithArgs("inv-1").
+			WillReturnRows(sqlmock.NewRows(invitationCols).
+				AddRow("inv-1", "ev-1", "alice@example.com", sentAt, nil, nil, nil, nil, nil))
+		got, err := NewEventInvitationRepository(db).GetByID(ctx, "inv-1")
+		require.NoError(t, err)
+		require.Equal(t, &domain.EventInvitation{ID: "inv-1", EventID: "ev-1", Email: "alice@example.com", SentAt: sentAt}, got)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`WHERE i.id = \$1`).
+			WithArgs("inv-9").
+			WillReturnRows(sqlmock.NewRows(invitationCols))
+		_, err = NewEventInvitationRepository(db).GetByID(ctx, "inv-9")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestEventInvitationRepository_ListPending(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	contacted := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`WHERE i.event_id = \$1 AND er.user_id IS NULL`).
+		WithArgs("ev-1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email", "last_contacted_at", "reminders_sent"}).
+			AddRow("inv-1", "alice@example.com", contacted, 2))
+	got, err := NewEventInvitationRepository(db).ListPending(context.Background(), "ev-1")
+	require.NoError(t, err)
+	require.Equal(t, []*domain.PendingInvitation{{ID: "inv-1", Email: "alice@example.com", LastContactedAt: contacted, RemindersSent: 2}}, got)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEventInvitationRepository_MarkReminded(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2025, 3, 5, 9, 0, 0, 0, time.UTC)
+
+	t.Run("updated", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(`SET last_contacted_at = \$2, reminders_sent = reminders_sent \+ 1\s+WHERE id = \$1`).
+			WithArgs("inv-1", at).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		require.NoError(t, NewEventInvitationRepository(db).MarkReminded(ctx, "inv-1", at))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(`UPDATE event_invitations`).
+			WithArgs("inv-9", at).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		require.ErrorIs(t, NewEventInvitationRepository(db).MarkReminded(ctx, "inv-9", at), domain.ErrNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestEventInvitationRepository_DomainRules(t *testing.T) {
+	ctx := context.Background()
+	updatedAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("get", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM event_invitation_domain_rules\s+WHERE event_id = \$1`).
+			WithArgs("ev-1").
+			WillReturnRows(sqlmock.NewRows([]string{"event_id", "allowed_domains", "blocked_domains", "updated_at"}).
+				AddRow("ev-1", "{acme.com}", "{}", updatedAt))
+		got, err := NewEventInvitationRepository(db).GetDomainRules(ctx, "ev-1")
+		require.NoError(t, err)
+		require.Equal(t, &domain.InvitationDomainRules{EventID: "ev-1", AllowedDomains: []string{"acme.com"}, BlockedDomains: []string{}, UpdatedAt: updatedAt}, got)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not saved", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM event_invitation_domain_rules`).
+			WithArgs("ev-1").
+			WillReturnError(sql.ErrNoRows)
+		_, err = NewEventInvitationRepository(db).GetDomainRules(ctx, "ev-1")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("upsert", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`INSERT INTO event_invitation_domain_rules .*ON CONFLICT \(event_id\) DO UPDATE`).
+			WithArgs("ev-1", `{}`, `{"gmail.com"}`).
+			WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(updatedAt))
+		rules := &domain.InvitationDomainRules{EventID: "ev-1", BlockedDomains: []string{"gmail.com"}}
+		require.NoError(t, NewEventInvitationRepository(db).UpsertDomainRules(ctx, rules))
+		require.Equal(t, updatedAt, rules.UpdatedAt)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
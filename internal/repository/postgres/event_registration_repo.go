@@ -76,3 +76,28 @@ func (r *eventRegistrationRepository) ListByUserID(ctx context.Context, userID s
 	return regs, nil
 }
 
+func (r *eventRegistrationRepository) CountActiveByEvent(ctx context.Context) ([]domain.EventCount, error) {
+	query := `
+		SELECT er.event_id, COUNT(*)
+		FROM event_registrations er
+		JOIN events e ON e.id = er.event_id
+		WHERE NOT e.sandbox AND (e.date IS NULL OR e.date >= date_trunc('day', now()))
+		GROUP BY er.event_id
+		ORDER BY COUNT(*) DESC, er.event_id
+	`
+	rows, err := r.DB.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var counts []domain.EventCount
+	for rows.Next() {
+		var c domain.EventCount
+		if err := rows.Scan(&c.EventID, &c.Count); err != nil {
+			return nil, err
+		}
+		counts = append(counts, c)
+	}
+	return counts, rows.Err()
+}
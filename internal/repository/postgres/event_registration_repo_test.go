@@ -0,0 +1,26 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventRegistrationRepository_CountActiveByEvent(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT er.event_id, COUNT\(\*\)\s+FROM event_registrations er\s+JOIN events e ON e.id = er.event_id\s+WHERE NOT e.sandbox AND \(e.date IS NULL OR e.date >= date_trunc\('day', now\(\)\)\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"event_id", "count"}).AddRow("ev-1", 40).AddRow("ev-2", 3))
+
+	got, err := NewEventRegistrationRepository(db).CountActiveByEvent(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []domain.EventCount{{EventID: "ev-1", Count: 40}, {EventID: "ev-2", Count: 3}}, got)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
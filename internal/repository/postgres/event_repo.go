@@ -8,31 +8,67 @@ import (
 	"strings"
 	"time"
 
+	"github.com/lib/pq"
+
 	"multitrackticketing/internal/domain"
 )
 
 type eventRepository struct {
 	DB *sql.DB
+	// AnonymizationKey keys the hashes anonymized invitation emails are replaced by.
+	AnonymizationKey []byte
 }
 
-func NewEventRepository(db *sql.DB) domain.EventRepository {
+// NewEventRepository returns the event repository. anonymizationKey is the server secret anonymized
+// invitation emails are hashed with.
+func NewEventRepository(db *sql.DB, anonymizationKey []byte) domain.EventRepository {
 	return &eventRepository{
-		DB: db,
+		DB:               db,
+		AnonymizationKey: anonymizationKey,
 	}
 }
 
 func (r *eventRepository) Create(ctx context.Context, e *domain.Event) error {
 	query := `
-		INSERT INTO events (name, event_code, owner_id, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO events (name, event_code, owner_id, created_at, updated_at, sandbox)
+		VALUES ($1, $2, $3, $4, $5, $6)
 		RETURNING id
 	`
-	return r.DB.QueryRowContext(ctx, query, e.Name, e.EventCode, e.OwnerID, e.CreatedAt, e.UpdatedAt).Scan(&e.ID)
+	err := r.DB.QueryRowContext(ctx, query, e.Name, e.EventCode, e.OwnerID, e.CreatedAt, e.UpdatedAt, e.Sandbox).Scan(&e.ID)
+	if isDuplicateEventCode(err) {
+		return domain.ErrDuplicateEventCode
+	}
+	return err
+}
+
+// isDuplicateEventCode reports whether err is a violation of the unique event_code constraint.
+func isDuplicateEventCode(err error) bool {
+	var perr *pq.Error
+	return errors.As(err, &perr) && perr.Code == "23505" && perr.Constraint == "events_event_code_key"
+}
+
+func (r *eventRepository) UpdateEventCode(ctx context.Context, eventID, code string) (*domain.Event, error) {
+	query := `
+		UPDATE events SET event_code = $2, updated_at = NOW()
+		WHERE id = $1
+		RETURNING id, name, event_code, owner_id, created_at, updated_at, date, description, location_lat, location_lng
+	`
+	e, err := scanEvent(r.DB.QueryRowContext(ctx, query, eventID, code))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		if isDuplicateEventCode(err) {
+			return nil, domain.ErrDuplicateEventCode
+		}
+		return nil, err
+	}
+	return e, nil
 }
 
 func (r *eventRepository) GetByID(ctx context.Context, id string) (*domain.Event, error) {
 	query := `
-		SELECT id, name, event_code, owner_id, created_at, updated_at, date, description, location_lat, location_lng
+		SELECT id, name, event_code, owner_id, created_at, updated_at, date, description, location_lat, location_lng, sandbox
 		FROM events
 		WHERE id = $1
 	`
@@ -42,7 +78,7 @@ func (r *eventRepository) GetByID(ctx context.Context, id string) (*domain.Event
 	var latNull, lngNull sql.NullFloat64
 	err := r.DB.QueryRowContext(ctx, query, id).Scan(
 		&e.ID, &e.Name, &e.EventCode, &e.OwnerID, &e.CreatedAt, &e.UpdatedAt,
-		&dateNull, &descNull, &latNull, &lngNull,
+		&dateNull, &descNull, &latNull, &lngNull, &e.Sandbox,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -68,7 +104,7 @@ func (r *eventRepository) GetByID(ctx context.Context, id string) (*domain.Event
 func (r *eventRepository) GetByEventCode(ctx context.Context, eventCode string) (*domain.Event, error) {
 	code := strings.ToLower(strings.TrimSpace(eventCode))
 	query := `
-		SELECT id, name, event_code, owner_id, created_at, updated_at, date, description, location_lat, location_lng
+		SELECT id, name, event_code, owner_id, created_at, updated_at, date, description, location_lat, location_lng, sandbox
 		FROM events
 		WHERE event_code = $1
 	`
@@ -78,7 +114,7 @@ func (r *eventRepository) GetByEventCode(ctx context.Context, eventCode string)
 	var latNull, lngNull sql.NullFloat64
 	err := r.DB.QueryRowContext(ctx, query, code).Scan(
 		&e.ID, &e.Name, &e.EventCode, &e.OwnerID, &e.CreatedAt, &e.UpdatedAt,
-		&dateNull, &descNull, &latNull, &lngNull,
+		&dateNull, &descNull, &latNull, &lngNull, &e.Sandbox,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -139,6 +175,254 @@ func (r *eventRepository) ListByOwnerID(ctx context.Context, ownerID string) ([]
 	return events, rows.Err()
 }
 
+// scanEvent scans the event columns, in the order GetByID selects them, followed by extra.
+func scanEvent(row rowScanner, extra ...any) (*domain.Event, error) {
+	e := &domain.Event{}
+	var dateNull sql.NullTime
+	var descNull sql.NullString
+	var latNull, lngNull sql.NullFloat64
+	dest := append([]any{&e.ID, &e.Name, &e.EventCode, &e.OwnerID, &e.CreatedAt, &e.UpdatedAt, &dateNull, &descNull, &latNull, &lngNull}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	if dateNull.Valid {
+		e.Date = &dateNull.Time
+	}
+	if descNull.Valid {
+		e.Description = &descNull.String
+	}
+	if latNull.Valid {
+		e.LocationLat = &latNull.Float64
+	}
+	if lngNull.Valid {
+		e.LocationLng = &lngNull.Float64
+	}
+	return e, nil
+}
+
+func (r *eventRepository) ListByOwnerOrTeamMemberID(ctx context.Context, userID string) ([]*domain.Event, error) {
+	query := `
+		SELECT e.id, e.name, e.event_code, e.owner_id, e.created_at, e.updated_at, e.date, e.description, e.location_lat, e.location_lng
+		FROM events e
+		WHERE e.owner_id = $1
+			OR EXISTS (SELECT 1 FROM event_team_members tm WHERE tm.event_id = e.id AND tm.user_id = $1)
+		ORDER BY e.created_at DESC
+	`
+	rows, err := r.DB.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	events := make([]*domain.Event, 0)
+	for rows.Next() {
+		e, err := scanEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
+
+func (r *eventRepository) ListByTeamMemberID(ctx context.Context, userID string) ([]*domain.Event, error) {
+	query := `
+		SELECT e.id, e.name, e.event_code, e.owner_id, e.created_at, e.updated_at, e.date, e.description, e.location_lat, e.location_lng
+		FROM events e
+		JOIN event_team_members tm ON tm.event_id = e.id
+		WHERE tm.user_id = $1 AND e.owner_id <> $1
+		ORDER BY e.created_at DESC
+	`
+	rows, err := r.DB.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	events := make([]*domain.Event, 0)
+	for rows.Next() {
+		e, err := scanEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
+
+// eventRolesCTE collects the roles of the user in $1 per event.
+const eventRolesCTE = `
+	WITH roles AS (
+		SELECT id AS event_id, 'owner' AS role FROM events WHERE owner_id = $1
+		UNION
+		SELECT event_id, 'team_member' FROM event_team_members WHERE user_id = $1
+		UNION
+		SELECT event_id, 'attendee' FROM event_registrations WHERE user_id = $1
+	), my_events AS (
+		SELECT event_id, array_agg(role ORDER BY role) AS roles
+		FROM roles
+		GROUP BY event_id
+	)
+`
+
+func (r *eventRepository) Search(ctx context.Context, userID string, params domain.EventSearchParams) ([]*domain.EventSearchResult, int, error) {
+	conditions := []string{"TRUE"}
+	args := []any{userID}
+	if params.Query != "" {
+		args = append(args, "%"+escapeILIKE(params.Query)+"%")
+		conditions = append(conditions, fmt.Sprintf("(e.name ILIKE $%[1]d OR e.event_code ILIKE $%[1]d OR e.description ILIKE $%[1]d)", len(args)))
+	}
+	if params.From != nil {
+		args = append(args, *params.From)
+		conditions = append(conditions, fmt.Sprintf("e.date >= $%d", len(args)))
+	}
+	if params.To != nil {
+		args = append(args, *params.To)
+		conditions = append(conditions, fmt.Sprintf("e.date < $%d", len(args)))
+	}
+	if len(params.Roles) > 0 {
+		args = append(args, pq.Array(params.Roles))
+		conditions = append(conditions, fmt.Sprintf("m.roles && $%d::text[]", len(args)))
+	}
+	where := strings.Join(conditions, " AND ")
+
+	var total int
+	countQuery := eventRolesCTE + `
+		SELECT COUNT(*)
+		FROM events e
+		JOIN my_events m ON m.event_id = e.id
+		WHERE ` + where
+	if err := r.DB.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := eventRolesCTE + fmt.Sprintf(`
+		SELECT e.id, e.name, e.event_code, e.owner_id, e.created_at, e.updated_at, e.date, e.description, e.location_lat, e.location_lng, m.roles
+		FROM events e
+		JOIN my_events m ON m.event_id = e.id
+		WHERE %s
+		ORDER BY e.date DESC NULLS LAST, e.created_at DESC
+		LIMIT $%d OFFSET $%d
+	`, where, len(args)+1, len(args)+2)
+	rows, err := r.DB.QueryContext(ctx, query, append(args, params.Pagination.PageSize, params.Pagination.Offset())...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+	results := make([]*domain.EventSearchResult, 0)
+	for rows.Next() {
+		var roles pq.StringArray
+		e, err := scanEvent(rows, &roles)
+		if err != nil {
+			return nil, 0, err
+		}
+		results = append(results, &domain.EventSearchResult{Event: e, Roles: []string(roles)})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return results, total, nil
+}
+
+func (r *eventRepository) CountRegistrations(ctx context.Context, eventID string) (int, error) {
+	var n int
+	err := r.DB.QueryRowContext(ctx, `SELECT COUNT(*) FROM event_registrations WHERE event_id = $1`, eventID).Scan(&n)
+	return n, err
+}
+
+func (r *eventRepository) ListStats(ctx context.Context, eventIDs []string) (map[string]*domain.EventStats, error) {
+	stats := make(map[string]*domain.EventStats, len(eventIDs))
+	if len(eventIDs) == 0 {
+		return stats, nil
+	}
+	// Each count is grouped in its own subquery so the joins do not multiply each other's rows.
+	query := `
+		SELECT e.id, COALESCE(ro.n, 0), COALESCE(se.n, 0), COALESCE(sp.n, 0),
+			COALESCE(inv.sent, 0), COALESCE(an.invitations_accepted, inv.accepted, 0), 1 + COALESCE(tm.n, 0)
+		FROM events e
+		LEFT JOIN (SELECT event_id, COUNT(*) AS n FROM rooms WHERE event_id = ANY($1) GROUP BY event_id) ro ON ro.event_id = e.id
+		LEFT JOIN (SELECT event_id, COUNT(*) AS n FROM sessions WHERE event_id = ANY($1) GROUP BY event_id) se ON se.event_id = e.id
+		LEFT JOIN (SELECT event_id, COUNT(*) AS n FROM speakers WHERE event_id = ANY($1) GROUP BY event_id) sp ON sp.event_id = e.id
+		LEFT JOIN (
+			SELECT i.event_id, COUNT(*) AS sent, COUNT(er.user_id) AS accepted
+			FROM event_invitations i
+			LEFT JOIN users u ON u.email = i.email
+			LEFT JOIN event_registrations er ON er.event_id = i.event_id AND er.user_id = u.id
+			WHERE i.event_id = ANY($1)
+			GROUP BY i.event_id
+		) inv ON inv.event_id = e.id
+		LEFT JOIN (SELECT event_id, COUNT(*) AS n FROM event_team_members WHERE event_id = ANY($1) GROUP BY event_id) tm ON tm.event_id = e.id
+		-- Anonymized invitations no longer match their users, so the count from before is used.
+		LEFT JOIN event_anonymizations an ON an.event_id = e.id
+		WHERE e.id = ANY($1)
+	`
+	rows, err := r.DB.QueryContext(ctx, query, pq.Array(eventIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id string
+		st := &domain.EventStats{}
+		if err := rows.Scan(&id, &st.Rooms, &st.Sessions, &st.Speakers, &st.InvitationsSent, &st.InvitationsAccepted, &st.TeamSize); err != nil {
+			return nil, err
+		}
+		stats[id] = st
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+func (r *eventRepository) Anonymize(ctx context.Context, eventID, anonymizedBy string) (*domain.EventAnonymization, error) {
+	return anonymizeEvent(ctx, r.DB, r.AnonymizationKey, eventID, anonymizedBy, false)
+}
+
+func (r *eventRepository) PreviewAnonymize(ctx context.Context, eventID, anonymizedBy string) (*domain.EventAnonymization, error) {
+	return anonymizeEvent(ctx, r.DB, r.AnonymizationKey, eventID, anonymizedBy, true)
+}
+
+func (r *eventRepository) GetTheme(ctx context.Context, eventID string) (*domain.EventTheme, error) {
+	query := `
+		SELECT event_id, primary_color, secondary_color, background_color, text_color,
+			font_family, heading_font_family, footer_text, support_email, updated_at
+		FROM event_themes
+		WHERE event_id = $1
+	`
+	t := &domain.EventTheme{}
+	err := r.DB.QueryRowContext(ctx, query, eventID).Scan(
+		&t.EventID, &t.PrimaryColor, &t.SecondaryColor, &t.BackgroundColor, &t.TextColor,
+		&t.FontFamily, &t.HeadingFontFamily, &t.FooterText, &t.SupportEmail, &t.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	return t, nil
+}
+
+func (r *eventRepository) UpsertTheme(ctx context.Context, t *domain.EventTheme) error {
+	query := `
+		INSERT INTO event_themes (event_id, primary_color, secondary_color, background_color, text_color,
+			font_family, heading_font_family, footer_text, support_email, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW())
+		ON CONFLICT (event_id) DO UPDATE SET
+			primary_color = EXCLUDED.primary_color,
+			secondary_color = EXCLUDED.secondary_color,
+			background_color = EXCLUDED.background_color,
+			text_color = EXCLUDED.text_color,
+			font_family = EXCLUDED.font_family,
+			heading_font_family = EXCLUDED.heading_font_family,
+			footer_text = EXCLUDED.footer_text,
+			support_email = EXCLUDED.support_email,
+			updated_at = EXCLUDED.updated_at
+		RETURNING updated_at
+	`
+	return r.DB.QueryRowContext(ctx, query, t.EventID, t.PrimaryColor, t.SecondaryColor, t.BackgroundColor, t.TextColor,
+		t.FontFamily, t.HeadingFontFamily, t.FooterText, t.SupportEmail).Scan(&t.UpdatedAt)
+}
+
 func (r *eventRepository) Delete(ctx context.Context, id string) error {
 	query := `DELETE FROM events WHERE id = $1`
 	result, err := r.DB.ExecContext(ctx, query, id)
@@ -10,6 +10,7 @@ import (
 	"multitrackticketing/internal/domain"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 )
 
@@ -21,7 +22,7 @@ func TestEventRepository_Create(t *testing.T) {
 		event   *domain.Event
 		mock    func(mock sqlmock.Sqlmock)
 		wantID  string
-		wantErr bool
+		wantErr error
 	}{
 		{
 			name: "success",
@@ -33,12 +34,11 @@ func TestEventRepository_Create(t *testing.T) {
 				UpdatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 			},
 			mock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`INSERT INTO events \(name, event_code, owner_id, created_at, updated_at\)`).
-					WithArgs("Conf 2025", "ABCD", "user-uuid-1", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)).
+				mock.ExpectQuery(`INSERT INTO events \(name, event_code, owner_id, created_at, updated_at, sandbox\)`).
+					WithArgs("Conf 2025", "ABCD", "user-uuid-1", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), false).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("ev-uuid-1"))
 			},
-			wantID:  "ev-uuid-1",
-			wantErr: false,
+			wantID: "ev-uuid-1",
 		},
 		{
 			name: "db error",
@@ -53,8 +53,16 @@ func TestEventRepository_Create(t *testing.T) {
 				mock.ExpectQuery(`INSERT INTO events`).
 					WillReturnError(sql.ErrConnDone)
 			},
-			wantID:  "",
-			wantErr: true,
+			wantErr: sql.ErrConnDone,
+		},
+		{
+			name:  "code taken",
+			event: &domain.Event{Name: "Conf", EventCode: "wxyz", OwnerID: "user-1"},
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`INSERT INTO events`).
+					WillReturnError(&pq.Error{Code: "23505", Constraint: "events_event_code_key"})
+			},
+			wantErr: domain.ErrDuplicateEventCode,
 		},
 	}
 
@@ -65,10 +73,10 @@ func TestEventRepository_Create(t *testing.T) {
 			defer db.Close()
 
 			tt.mock(mock)
-			repo := NewEventRepository(db)
+			repo := NewEventRepository(db, nil)
 			err = repo.Create(ctx, tt.event)
-			if tt.wantErr {
-				require.Error(t, err)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
 				return
 			}
 			require.NoError(t, err)
@@ -82,7 +90,7 @@ func TestEventRepository_GetByID(t *testing.T) {
 	ctx := context.Background()
 	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
 	updatedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
-	cols := []string{"id", "name", "event_code", "owner_id", "created_at", "updated_at", "date", "description", "location_lat", "location_lng"}
+	cols := []string{"id", "name", "event_code", "owner_id", "created_at", "updated_at", "date", "description", "location_lat", "location_lng", "sandbox"}
 
 	tests := []struct {
 		name    string
@@ -95,10 +103,10 @@ func TestEventRepository_GetByID(t *testing.T) {
 			name: "success",
 			id:   "ev-1",
 			mock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, name, event_code, owner_id, created_at, updated_at, date, description, location_lat, location_lng`).
+				mock.ExpectQuery(`SELECT id, name, event_code, owner_id, created_at, updated_at, date, description, location_lat, location_lng, sandbox`).
 					WithArgs("ev-1").
 					WillReturnRows(sqlmock.NewRows(cols).
-						AddRow("ev-1", "Conf", "ABCD", "user-1", createdAt, updatedAt, nil, nil, nil, nil))
+						AddRow("ev-1", "Conf", "ABCD", "user-1", createdAt, updatedAt, nil, nil, nil, nil, false))
 			},
 			want: &domain.Event{
 				ID:        "ev-1",
@@ -110,11 +118,30 @@ func TestEventRepository_GetByID(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "sandbox event",
+			id:   "ev-2",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT id, name, event_code, owner_id, created_at, updated_at, date, description, location_lat, location_lng, sandbox`).
+					WithArgs("ev-2").
+					WillReturnRows(sqlmock.NewRows(cols).
+						AddRow("ev-2", "Test", "wxyz", "user-1", createdAt, updatedAt, nil, nil, nil, nil, true))
+			},
+			want: &domain.Event{
+				ID:        "ev-2",
+				Name:      "Test",
+				EventCode: "wxyz",
+				OwnerID:   "user-1",
+				CreatedAt: createdAt,
+				UpdatedAt: updatedAt,
+				Sandbox:   true,
+			},
+		},
 		{
 			name: "not found",
 			id:   "ev-missing",
 			mock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, name, event_code, owner_id, created_at, updated_at, date, description, location_lat, location_lng`).
+				mock.ExpectQuery(`SELECT id, name, event_code, owner_id, created_at, updated_at, date, description, location_lat, location_lng, sandbox`).
 					WithArgs("ev-missing").
 					WillReturnError(sql.ErrNoRows)
 			},
@@ -130,7 +157,7 @@ func TestEventRepository_GetByID(t *testing.T) {
 			defer db.Close()
 
 			tt.mock(mock)
-			repo := NewEventRepository(db)
+			repo := NewEventRepository(db, nil)
 			got, err := repo.GetByID(ctx, tt.id)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -149,7 +176,7 @@ func TestEventRepository_GetByEventCode(t *testing.T) {
 	ctx := context.Background()
 	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
 	updatedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
-	cols := []string{"id", "name", "event_code", "owner_id", "created_at", "updated_at", "date", "description", "location_lat", "location_lng"}
+	cols := []string{"id", "name", "event_code", "owner_id", "created_at", "updated_at", "date", "description", "location_lat", "location_lng", "sandbox"}
 
 	tests := []struct {
 		name      string
@@ -163,10 +190,10 @@ func TestEventRepository_GetByEventCode(t *testing.T) {
 			name:      "success",
 			eventCode: "abcd",
 			mock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, name, event_code, owner_id, created_at, updated_at, date, description, location_lat, location_lng`).
+				mock.ExpectQuery(`SELECT id, name, event_code, owner_id, created_at, updated_at, date, description, location_lat, location_lng, sandbox`).
 					WithArgs("abcd").
 					WillReturnRows(sqlmock.NewRows(cols).
-						AddRow("ev-1", "Conf", "abcd", "user-1", createdAt, updatedAt, nil, nil, nil, nil))
+						AddRow("ev-1", "Conf", "abcd", "user-1", createdAt, updatedAt, nil, nil, nil, nil, false))
 			},
 			want: &domain.Event{
 				ID:        "ev-1",
@@ -182,10 +209,10 @@ func TestEventRepository_GetByEventCode(t *testing.T) {
 			name:      "success normalizes to lowercase",
 			eventCode: "ABCD",
 			mock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, name, event_code, owner_id, created_at, updated_at, date, description, location_lat, location_lng`).
+				mock.ExpectQuery(`SELECT id, name, event_code, owner_id, created_at, updated_at, date, description, location_lat, location_lng, sandbox`).
 					WithArgs("abcd").
 					WillReturnRows(sqlmock.NewRows(cols).
-						AddRow("ev-1", "Conf", "abcd", "user-1", createdAt, updatedAt, nil, nil, nil, nil))
+						AddRow("ev-1", "Conf", "abcd", "user-1", createdAt, updatedAt, nil, nil, nil, nil, false))
 			},
 			want: &domain.Event{
 				ID:        "ev-1",
@@ -201,7 +228,7 @@ func TestEventRepository_GetByEventCode(t *testing.T) {
 			name:      "not found",
 			eventCode: "none",
 			mock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, name, event_code, owner_id, created_at, updated_at, date, description, location_lat, location_lng`).
+				mock.ExpectQuery(`SELECT id, name, event_code, owner_id, created_at, updated_at, date, description, location_lat, location_lng, sandbox`).
 					WithArgs("none").
 					WillReturnError(sql.ErrNoRows)
 			},
@@ -213,7 +240,7 @@ func TestEventRepository_GetByEventCode(t *testing.T) {
 			name:      "db error",
 			eventCode: "abcd",
 			mock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, name, event_code, owner_id, created_at, updated_at, date, description, location_lat, location_lng`).
+				mock.ExpectQuery(`SELECT id, name, event_code, owner_id, created_at, updated_at, date, description, location_lat, location_lng, sandbox`).
 					WithArgs("abcd").
 					WillReturnError(sql.ErrConnDone)
 			},
@@ -229,7 +256,7 @@ func TestEventRepository_GetByEventCode(t *testing.T) {
 			defer db.Close()
 
 			tt.mock(mock)
-			repo := NewEventRepository(db)
+			repo := NewEventRepository(db, nil)
 			got, err := repo.GetByEventCode(ctx, tt.eventCode)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -310,7 +337,7 @@ func TestEventRepository_ListByOwnerID(t *testing.T) {
 			defer db.Close()
 
 			tt.mock(mock)
-			repo := NewEventRepository(db)
+			repo := NewEventRepository(db, nil)
 			got, err := repo.ListByOwnerID(ctx, tt.ownerID)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -325,6 +352,46 @@ func TestEventRepository_ListByOwnerID(t *testing.T) {
 	}
 }
 
+func TestEventRepository_CountRegistrations(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM event_registrations WHERE event_id = \$1`).
+		WithArgs("ev-1").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(4))
+	n, err := NewEventRepository(db, nil).CountRegistrations(ctx, "ev-1")
+	require.NoError(t, err)
+	require.Equal(t, 4, n)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEventRepository_ListStats(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT e.id, COALESCE\(ro.n, 0\).*FROM events e.*WHERE e.id = ANY\(\$1\)`).
+		WithArgs(pq.Array([]string{"ev-1", "ev-2"})).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "rooms", "sessions", "speakers", "sent", "accepted", "team_size"}).
+			AddRow("ev-1", 3, 12, 9, 40, 25, 4).
+			AddRow("ev-2", 0, 0, 0, 0, 0, 1))
+	stats, err := NewEventRepository(db, nil).ListStats(ctx, []string{"ev-1", "ev-2"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]*domain.EventStats{
+		"ev-1": {Rooms: 3, Sessions: 12, Speakers: 9, InvitationsSent: 40, InvitationsAccepted: 25, TeamSize: 4},
+		"ev-2": {TeamSize: 1},
+	}, stats)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// No events need no query.
+	stats, err = NewEventRepository(db, nil).ListStats(ctx, nil)
+	require.NoError(t, err)
+	require.Empty(t, stats)
+}
+
 func TestEventRepository_Delete(t *testing.T) {
 	ctx := context.Background()
 
@@ -377,7 +444,7 @@ func TestEventRepository_Delete(t *testing.T) {
 			defer db.Close()
 
 			tt.mock(mock)
-			repo := NewEventRepository(db)
+			repo := NewEventRepository(db, nil)
 			err = repo.Delete(ctx, tt.id)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -393,6 +460,61 @@ func TestEventRepository_Delete(t *testing.T) {
 	}
 }
 
+func TestEventRepository_UpdateEventCode(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	cols := []string{"id", "name", "event_code", "owner_id", "created_at", "updated_at", "date", "description", "location_lat", "location_lng"}
+
+	tests := []struct {
+		name     string
+		mock     func(mock sqlmock.Sqlmock)
+		wantCode string
+		wantErr  error
+	}{
+		{
+			name: "success",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`UPDATE events SET event_code = \$2, updated_at = NOW\(\)`).
+					WithArgs("ev-1", "k7q2").
+					WillReturnRows(sqlmock.NewRows(cols).AddRow("ev-1", "Conf", "k7q2", "user-1", createdAt, createdAt, nil, nil, nil, nil))
+			},
+			wantCode: "k7q2",
+		},
+		{
+			name: "not found",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`UPDATE events SET event_code`).WillReturnError(sql.ErrNoRows)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name: "code taken",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`UPDATE events SET event_code`).
+					WillReturnError(&pq.Error{Code: "23505", Constraint: "events_event_code_key"})
+			},
+			wantErr: domain.ErrDuplicateEventCode,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			tt.mock(mock)
+			got, err := NewEventRepository(db, nil).UpdateEventCode(ctx, "ev-1", "k7q2")
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantCode, got.EventCode)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestEventRepository_Update(t *testing.T) {
 	ctx := context.Background()
 	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
@@ -495,10 +617,10 @@ func TestEventRepository_Update(t *testing.T) {
 			locationLat: nil,
 			locationLng: nil,
 			mock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, name, event_code, owner_id, created_at, updated_at, date, description, location_lat, location_lng`).
+				mock.ExpectQuery(`SELECT id, name, event_code, owner_id, created_at, updated_at, date, description, location_lat, location_lng, sandbox`).
 					WithArgs("ev-1").
-					WillReturnRows(sqlmock.NewRows(cols).
-						AddRow("ev-1", "Conf", "ABCD", "user-1", createdAt, updatedAt, nil, nil, nil, nil))
+					WillReturnRows(sqlmock.NewRows(append(cols, "sandbox")).
+						AddRow("ev-1", "Conf", "ABCD", "user-1", createdAt, updatedAt, nil, nil, nil, nil, false))
 			},
 			want: &domain.Event{
 				ID:        "ev-1",
@@ -535,7 +657,7 @@ func TestEventRepository_Update(t *testing.T) {
 			defer db.Close()
 
 			tt.mock(mock)
-			repo := NewEventRepository(db)
+			repo := NewEventRepository(db, nil)
 			got, err := repo.Update(ctx, tt.eventID, tt.date, tt.description, tt.locationLat, tt.locationLng)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -552,3 +674,179 @@ func TestEventRepository_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestEventRepository_ListByTeamMemberID(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	cols := []string{"id", "name", "event_code", "owner_id", "created_at", "updated_at", "date", "description", "location_lat", "location_lng"}
+
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`JOIN event_team_members tm ON tm.event_id = e.id\s+WHERE tm.user_id = \$1 AND e.owner_id <> \$1`).
+			WithArgs("user-2").
+			WillReturnRows(sqlmock.NewRows(cols).AddRow("ev-1", "Conf A", "ABCD", "user-1", createdAt, createdAt, nil, "Talks", nil, nil))
+		got, err := NewEventRepository(db, nil).ListByTeamMemberID(ctx, "user-2")
+		require.NoError(t, err)
+		desc := "Talks"
+		require.Equal(t, []*domain.Event{
+			{ID: "ev-1", Name: "Conf A", EventCode: "ABCD", OwnerID: "user-1", CreatedAt: createdAt, UpdatedAt: createdAt, Description: &desc},
+		}, got)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM events e`).WithArgs("user-2").WillReturnError(sql.ErrConnDone)
+		got, err := NewEventRepository(db, nil).ListByTeamMemberID(ctx, "user-2")
+		require.Error(t, err)
+		require.Nil(t, got)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestEventRepository_ListByOwnerOrTeamMemberID(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	cols := []string{"id", "name", "event_code", "owner_id", "created_at", "updated_at", "date", "description", "location_lat", "location_lng"}
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`WHERE e.owner_id = \$1\s+OR EXISTS \(SELECT 1 FROM event_team_members tm WHERE tm.event_id = e.id AND tm.user_id = \$1\)`).
+		WithArgs("user-2").
+		WillReturnRows(sqlmock.NewRows(cols).
+			AddRow("ev-2", "Mine", "WXYZ", "user-2", createdAt, createdAt, nil, nil, nil, nil).
+			AddRow("ev-1", "Theirs", "ABCD", "user-1", createdAt, createdAt, nil, nil, nil, nil))
+	got, err := NewEventRepository(db, nil).ListByOwnerOrTeamMemberID(ctx, "user-2")
+	require.NoError(t, err)
+	require.Equal(t, []*domain.Event{
+		{ID: "ev-2", Name: "Mine", EventCode: "WXYZ", OwnerID: "user-2", CreatedAt: createdAt, UpdatedAt: createdAt},
+		{ID: "ev-1", Name: "Theirs", EventCode: "ABCD", OwnerID: "user-1", CreatedAt: createdAt, UpdatedAt: createdAt},
+	}, got)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEventRepository_Search(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	date := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	cols := []string{"id", "name", "event_code", "owner_id", "created_at", "updated_at", "date", "description", "location_lat", "location_lng", "roles"}
+
+	t.Run("no filters", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT COUNT\(\*\)\s+FROM events e\s+JOIN my_events m ON m.event_id = e.id\s+WHERE TRUE$`).
+			WithArgs("user-1").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+		mock.ExpectQuery(`WHERE TRUE\s+ORDER BY e.date DESC NULLS LAST, e.created_at DESC\s+LIMIT \$2 OFFSET \$3`).
+			WithArgs("user-1", 2, 2).
+			WillReturnRows(sqlmock.NewRows(cols).
+				AddRow("ev-1", "Conf A", "ABCD", "user-1", createdAt, createdAt, date, nil, nil, nil, "{attendee,owner}"))
+		got, total, err := NewEventRepository(db, nil).Search(ctx, "user-1", domain.EventSearchParams{
+			Pagination: domain.PaginationParams{Page: 2, PageSize: 2},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 3, total)
+		require.Equal(t, []*domain.EventSearchResult{{
+			Event: &domain.Event{ID: "ev-1", Name: "Conf A", EventCode: "ABCD", OwnerID: "user-1", CreatedAt: createdAt, UpdatedAt: createdAt, Date: &date},
+			Roles: []string{"attendee", "owner"},
+		}}, got)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("all filters", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		from, to := date, date.AddDate(0, 1, 0)
+		where := `WHERE TRUE AND \(e.name ILIKE \$2 OR e.event_code ILIKE \$2 OR e.description ILIKE \$2\) AND e.date >= \$3 AND e.date < \$4 AND m.roles && \$5::text\[\]`
+		mock.ExpectQuery(`SELECT COUNT\(\*\).*` + where).
+			WithArgs("user-1", `%go\_conf%`, from, to, pq.Array([]string{"team_member"})).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery(where + `.*LIMIT \$6 OFFSET \$7`).
+			WithArgs("user-1", `%go\_conf%`, from, to, pq.Array([]string{"team_member"}), 20, 0).
+			WillReturnRows(sqlmock.NewRows(cols))
+		got, total, err := NewEventRepository(db, nil).Search(ctx, "user-1", domain.EventSearchParams{
+			Query:      "go_conf",
+			From:       &from,
+			To:         &to,
+			Roles:      []string{"team_member"},
+			Pagination: domain.PaginationParams{Page: 1, PageSize: 20},
+		})
+		require.NoError(t, err)
+		require.Zero(t, total)
+		require.Equal(t, []*domain.EventSearchResult{}, got)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("count error", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT COUNT`).WillReturnError(sql.ErrConnDone)
+		got, _, err := NewEventRepository(db, nil).Search(ctx, "user-1", domain.EventSearchParams{Pagination: domain.PaginationParams{Page: 1, PageSize: 20}})
+		require.Error(t, err)
+		require.Nil(t, got)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestEventRepository_Theme(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("get", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM event_themes\s+WHERE event_id = \$1`).
+			WithArgs("ev-1").
+			WillReturnRows(sqlmock.NewRows([]string{"event_id", "primary_color", "secondary_color", "background_color", "text_color",
+				"font_family", "heading_font_family", "footer_text", "support_email", "updated_at"}).
+				AddRow("ev-1", "#112233", "", "#ffffff", "", "Inter", "", "© Conf", "help@conf.example", at))
+		got, err := NewEventRepository(db, nil).GetTheme(ctx, "ev-1")
+		require.NoError(t, err)
+		require.Equal(t, &domain.EventTheme{
+			EventID: "ev-1", PrimaryColor: "#112233", BackgroundColor: "#ffffff", FontFamily: "Inter",
+			FooterText: "© Conf", SupportEmail: "help@conf.example", UpdatedAt: at,
+		}, got)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("get without a saved theme", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM event_themes`).WithArgs("ev-1").WillReturnError(sql.ErrNoRows)
+		_, err = NewEventRepository(db, nil).GetTheme(ctx, "ev-1")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("upsert", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		theme := &domain.EventTheme{EventID: "ev-1", PrimaryColor: "#112233", FontFamily: "Inter", SupportEmail: "help@conf.example"}
+		mock.ExpectQuery(`INSERT INTO event_themes .*ON CONFLICT \(event_id\) DO UPDATE SET`).
+			WithArgs("ev-1", "#112233", "", "", "", "Inter", "", "", "help@conf.example").
+			WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(at))
+		require.NoError(t, NewEventRepository(db, nil).UpsertTheme(ctx, theme))
+		require.Equal(t, at, theme.UpdatedAt)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
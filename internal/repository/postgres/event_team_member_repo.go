@@ -36,7 +36,7 @@ func (r *eventTeamMemberRepository) Add(ctx context.Context, eventID, userID str
 
 func (r *eventTeamMemberRepository) ListByEventID(ctx context.Context, eventID string) ([]*domain.EventTeamMember, error) {
 	query := `
-		SELECT e.event_id, e.user_id, u.name, u.last_name, u.email
+		SELECT e.event_id, e.user_id, u.name, u.last_name, u.email, u.avatar_url
 		FROM event_team_members e
 		JOIN users u ON u.id = e.user_id
 		WHERE e.event_id = $1
@@ -50,12 +50,13 @@ func (r *eventTeamMemberRepository) ListByEventID(ctx context.Context, eventID s
 	members := make([]*domain.EventTeamMember, 0)
 	for rows.Next() {
 		m := &domain.EventTeamMember{}
-		var name, lastName sql.NullString
-		if err := rows.Scan(&m.EventID, &m.UserID, &name, &lastName, &m.Email); err != nil {
+		var name, lastName, avatarURL sql.NullString
+		if err := rows.Scan(&m.EventID, &m.UserID, &name, &lastName, &m.Email, &avatarURL); err != nil {
 			return nil, err
 		}
 		m.Name = name.String
 		m.LastName = lastName.String
+		m.AvatarURL = avatarURL.String
 		members = append(members, m)
 	}
 	return members, rows.Err()
@@ -78,14 +78,14 @@ func TestEventTeamMemberRepository_ListByEventID(t *testing.T) {
 			name:    "success returns members",
 			eventID: "ev-1",
 			mock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT e.event_id, e.user_id, u.name, u.last_name, u.email`).
+				mock.ExpectQuery(`SELECT e.event_id, e.user_id, u.name, u.last_name, u.email, u.avatar_url`).
 					WithArgs("ev-1").
-					WillReturnRows(sqlmock.NewRows([]string{"event_id", "user_id", "name", "last_name", "email"}).
-						AddRow("ev-1", "user-a", "Alice", "A", "alice@example.com").
-						AddRow("ev-1", "user-b", "Bob", "B", "bob@example.com"))
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "user_id", "name", "last_name", "email", "avatar_url"}).
+						AddRow("ev-1", "user-a", "Alice", "A", "alice@example.com", "https://cdn.example.com/alice.png").
+						AddRow("ev-1", "user-b", "Bob", "B", "bob@example.com", nil))
 			},
 			want: []*domain.EventTeamMember{
-				{EventID: "ev-1", UserID: "user-a", Name: "Alice", LastName: "A", Email: "alice@example.com"},
+				{EventID: "ev-1", UserID: "user-a", Name: "Alice", LastName: "A", Email: "alice@example.com", AvatarURL: "https://cdn.example.com/alice.png"},
 				{EventID: "ev-1", UserID: "user-b", Name: "Bob", LastName: "B", Email: "bob@example.com"},
 			},
 			wantErr: false,
@@ -94,9 +94,9 @@ func TestEventTeamMemberRepository_ListByEventID(t *testing.T) {
 			name:    "success empty",
 			eventID: "ev-1",
 			mock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT e.event_id, e.user_id, u.name, u.last_name, u.email`).
+				mock.ExpectQuery(`SELECT e.event_id, e.user_id, u.name, u.last_name, u.email, u.avatar_url`).
 					WithArgs("ev-1").
-					WillReturnRows(sqlmock.NewRows([]string{"event_id", "user_id", "name", "last_name", "email"}))
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "user_id", "name", "last_name", "email", "avatar_url"}))
 			},
 			want:    []*domain.EventTeamMember{},
 			wantErr: false,
@@ -0,0 +1,189 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+
+	"multitrackticketing/internal/domain"
+)
+
+type exhibitorRepository struct {
+	DB *sql.DB
+}
+
+func NewExhibitorRepository(db *sql.DB) domain.ExhibitorRepository {
+	return &exhibitorRepository{
+		DB: db,
+	}
+}
+
+const exhibitorColumns = `x.id, x.event_id, x.name, x.booth_number, x.created_at, x.updated_at`
+
+func scanExhibitor(row rowScanner) (*domain.Exhibitor, error) {
+	e := &domain.Exhibitor{Staff: []*domain.ExhibitorStaff{}}
+	if err := row.Scan(&e.ID, &e.EventID, &e.Name, &e.BoothNumber, &e.CreatedAt, &e.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *exhibitorRepository) Create(ctx context.Context, e *domain.Exhibitor) error {
+	query := `
+		INSERT INTO exhibitors (event_id, name, booth_number)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at, updated_at
+	`
+	err := r.DB.QueryRowContext(ctx, query, e.EventID, e.Name, e.BoothNumber).Scan(&e.ID, &e.CreatedAt, &e.UpdatedAt)
+	if isUniqueViolation(err) {
+		return domain.ErrDuplicateBooth
+	}
+	if err != nil {
+		return err
+	}
+	e.Staff = []*domain.ExhibitorStaff{}
+	return nil
+}
+
+func (r *exhibitorRepository) GetByID(ctx context.Context, id string) (*domain.Exhibitor, error) {
+	e, err := scanExhibitor(r.DB.QueryRowContext(ctx, `SELECT `+exhibitorColumns+` FROM exhibitors x WHERE x.id = $1`, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	if err := r.loadStaff(ctx, []*domain.Exhibitor{e}); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *exhibitorRepository) ListByEventID(ctx context.Context, eventID string) ([]*domain.Exhibitor, error) {
+	query := `SELECT ` + exhibitorColumns + `
+		FROM exhibitors x
+		WHERE x.event_id = $1
+		ORDER BY x.name, x.id
+	`
+	return r.list(ctx, query, eventID, false)
+}
+
+func (r *exhibitorRepository) ListByStaffUserID(ctx context.Context, userID string) ([]*domain.Exhibitor, error) {
+	query := `SELECT ` + exhibitorColumns + `, ev.name
+		FROM exhibitors x
+		JOIN exhibitor_staff s ON s.exhibitor_id = x.id
+		JOIN events ev ON ev.id = x.event_id
+		WHERE s.user_id = $1
+		ORDER BY ev.date DESC NULLS LAST, x.created_at DESC
+	`
+	return r.list(ctx, query, userID, true)
+}
+
+// list runs query, whose rows are exhibitorColumns followed by the event's name when withEvent,
+// and loads the exhibitors' staff.
+func (r *exhibitorRepository) list(ctx context.Context, query, arg string, withEvent bool) ([]*domain.Exhibitor, error) {
+	rows, err := r.DB.QueryContext(ctx, query, arg)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	exhibitors := []*domain.Exhibitor{}
+	for rows.Next() {
+		e := &domain.Exhibitor{Staff: []*domain.ExhibitorStaff{}}
+		dest := []any{&e.ID, &e.EventID, &e.Name, &e.BoothNumber, &e.CreatedAt, &e.UpdatedAt}
+		if withEvent {
+			dest = append(dest, &e.EventName)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		exhibitors = append(exhibitors, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := r.loadStaff(ctx, exhibitors); err != nil {
+		return nil, err
+	}
+	return exhibitors, nil
+}
+
+// loadStaff sets the Staff of the exhibitors in one query.
+func (r *exhibitorRepository) loadStaff(ctx context.Context, exhibitors []*domain.Exhibitor) error {
+	if len(exhibitors) == 0 {
+		return nil
+	}
+	byID := make(map[string]*domain.Exhibitor, len(exhibitors))
+	ids := make([]string, 0, len(exhibitors))
+	for _, e := range exhibitors {
+		byID[e.ID] = e
+		ids = append(ids, e.ID)
+	}
+	query := `
+		SELECT s.exhibitor_id, s.user_id, u.name, u.last_name, u.email
+		FROM exhibitor_staff s
+		JOIN users u ON u.id = s.user_id
+		WHERE s.exhibitor_id = ANY($1)
+		ORDER BY s.created_at, s.user_id
+	`
+	rows, err := r.DB.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		m := &domain.ExhibitorStaff{}
+		var name, lastName sql.NullString
+		if err := rows.Scan(&m.ExhibitorID, &m.UserID, &name, &lastName, &m.Email); err != nil {
+			return err
+		}
+		m.Name = name.String
+		m.LastName = lastName.String
+		if e := byID[m.ExhibitorID]; e != nil {
+			e.Staff = append(e.Staff, m)
+		}
+	}
+	return rows.Err()
+}
+
+func (r *exhibitorRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.DB.ExecContext(ctx, `DELETE FROM exhibitors WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *exhibitorRepository) AddStaff(ctx context.Context, exhibitorID, userID string) error {
+	query := `
+		INSERT INTO exhibitor_staff (exhibitor_id, user_id)
+		VALUES ($1, $2)
+		ON CONFLICT (exhibitor_id, user_id) DO NOTHING
+	`
+	_, err := r.DB.ExecContext(ctx, query, exhibitorID, userID)
+	return err
+}
+
+func (r *exhibitorRepository) RemoveStaff(ctx context.Context, exhibitorID, userID string) error {
+	result, err := r.DB.ExecContext(ctx, `DELETE FROM exhibitor_staff WHERE exhibitor_id = $1 AND user_id = $2`, exhibitorID, userID)
+	if err != nil {
+		return err
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *exhibitorRepository) IsStaff(ctx context.Context, exhibitorID, userID string) (bool, error) {
+	var ok bool
+	err := r.DB.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM exhibitor_staff WHERE exhibitor_id = $1 AND user_id = $2)`, exhibitorID, userID).Scan(&ok)
+	return ok, err
+}
@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	"multitrackticketing/internal/domain"
+)
+
+var exhibitorCols = []string{"id", "event_id", "name", "booth_number", "created_at", "updated_at"}
+
+var exhibitorStaffCols = []string{"exhibitor_id", "user_id", "name", "last_name", "email"}
+
+func TestExhibitorRepository_Create(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`INSERT INTO exhibitors \(event_id, name, booth_number\)`).
+			WithArgs("ev-1", "Acme", "A12").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow("ex-1", at, at))
+
+		e := &domain.Exhibitor{EventID: "ev-1", Name: "Acme", BoothNumber: "A12"}
+		require.NoError(t, NewExhibitorRepository(db).Create(ctx, e))
+		require.Equal(t, &domain.Exhibitor{ID: "ex-1", EventID: "ev-1", Name: "Acme", BoothNumber: "A12", Staff: []*domain.ExhibitorStaff{}, CreatedAt: at, UpdatedAt: at}, e)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("booth taken", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`INSERT INTO exhibitors`).
+			WithArgs("ev-1", "Acme", "A12").
+			WillReturnError(&pq.Error{Code: "23505"})
+
+		err = NewExhibitorRepository(db).Create(ctx, &domain.Exhibitor{EventID: "ev-1", Name: "Acme", BoothNumber: "A12"})
+		require.ErrorIs(t, err, domain.ErrDuplicateBooth)
+	})
+}
+
+func TestExhibitorRepository_GetByID(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("with staff", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM exhibitors x WHERE x.id = \$1`).
+			WithArgs("ex-1").
+			WillReturnRows(sqlmock.NewRows(exhibitorCols).AddRow("ex-1", "ev-1", "Acme", "A12", at, at))
+		mock.ExpectQuery(`FROM exhibitor_staff s\s+JOIN users u ON u.id = s.user_id\s+WHERE s.exhibitor_id = ANY\(\$1\)`).
+			WithArgs(pq.Array([]string{"ex-1"})).
+			WillReturnRows(sqlmock.NewRows(exhibitorStaffCols).
+				AddRow("ex-1", "user-1", "Ann", nil, "ann@example.com"))
+
+		got, err := NewExhibitorRepository(db).GetByID(ctx, "ex-1")
+		require.NoError(t, err)
+		require.Equal(t, &domain.Exhibitor{
+			ID: "ex-1", EventID: "ev-1", Name: "Acme", BoothNumber: "A12", CreatedAt: at, UpdatedAt: at,
+			Staff: []*domain.ExhibitorStaff{{ExhibitorID: "ex-1", UserID: "user-1", Name: "Ann", Email: "ann@example.com"}},
+		}, got)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM exhibitors x WHERE x.id = \$1`).
+			WithArgs("ex-1").
+			WillReturnRows(sqlmock.NewRows(exhibitorCols))
+
+		_, err = NewExhibitorRepository(db).GetByID(ctx, "ex-1")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func TestExhibitorRepository_ListByStaffUserID(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`JOIN exhibitor_staff s ON s.exhibitor_id = x.id\s+JOIN events ev ON ev.id = x.event_id\s+WHERE s.user_id = \$1`).
+		WithArgs("user-1").
+		WillReturnRows(sqlmock.NewRows(append(exhibitorCols, "name")).
+			AddRow("ex-1", "ev-1", "Acme", "A12", at, at, "GopherCon").
+			AddRow("ex-2", "ev-2", "Acme", "", at, at, "DevFest"))
+	mock.ExpectQuery(`WHERE s.exhibitor_id = ANY\(\$1\)`).
+		WithArgs(pq.Array([]string{"ex-1", "ex-2"})).
+		WillReturnRows(sqlmock.NewRows(exhibitorStaffCols).
+			AddRow("ex-1", "user-1", "Ann", "Lee", "ann@example.com").
+			AddRow("ex-2", "user-1", "Ann", "Lee", "ann@example.com").
+			AddRow("ex-2", "user-2", "Bob", "Ray", "bob@example.com"))
+
+	got, err := NewExhibitorRepository(db).ListByStaffUserID(ctx, "user-1")
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, "GopherCon", got[0].EventName)
+	require.Len(t, got[0].Staff, 1)
+	require.Equal(t, "DevFest", got[1].EventName)
+	require.Len(t, got[1].Staff, 2)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExhibitorRepository_RemoveStaff(t *testing.T) {
+	ctx := context.Background()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec(`DELETE FROM exhibitor_staff WHERE exhibitor_id = \$1 AND user_id = \$2`).
+		WithArgs("ex-1", "user-1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = NewExhibitorRepository(db).RemoveStaff(ctx, "ex-1", "user-1")
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
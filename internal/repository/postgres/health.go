@@ -0,0 +1,27 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"multitrackticketing/internal/domain"
+)
+
+type dbReadinessChecker struct {
+	DB *sql.DB
+}
+
+// NewReadinessChecker returns a domain.ReadinessChecker that pings the database. The API cannot
+// serve requests without it, so it is reported as critical.
+func NewReadinessChecker(db *sql.DB) domain.ReadinessChecker {
+	return &dbReadinessChecker{DB: db}
+}
+
+func (c *dbReadinessChecker) CheckReadiness(ctx context.Context) domain.DependencyHealth {
+	h := domain.DependencyHealth{Name: "database", Status: domain.DependencyUp, Critical: true}
+	if err := c.DB.PingContext(ctx); err != nil {
+		h.Status = domain.DependencyDown
+		h.Detail = err.Error()
+	}
+	return h
+}
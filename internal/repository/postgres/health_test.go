@@ -0,0 +1,38 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadinessChecker_CheckReadiness(t *testing.T) {
+	tests := []struct {
+		name       string
+		pingErr    error
+		wantStatus string
+	}{
+		{name: "ping ok", wantStatus: domain.DependencyUp},
+		{name: "ping fails", pingErr: sql.ErrConnDone, wantStatus: domain.DependencyDown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+			require.NoError(t, err)
+			defer db.Close()
+			mock.ExpectPing().WillReturnError(tt.pingErr)
+
+			h := NewReadinessChecker(db).CheckReadiness(context.Background())
+
+			require.Equal(t, "database", h.Name)
+			require.True(t, h.Critical)
+			require.Equal(t, tt.wantStatus, h.Status)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/lib/pq"
+
+	"multitrackticketing/internal/domain"
+)
+
+type importMappingRepository struct {
+	DB *sql.DB
+}
+
+func NewImportMappingRepository(db *sql.DB) domain.ImportMappingRepository {
+	return &importMappingRepository{
+		DB: db,
+	}
+}
+
+func (r *importMappingRepository) GetByEventID(ctx context.Context, eventID string) (*domain.ImportMapping, error) {
+	query := `
+		SELECT event_id, tag_categories, session_type_category, track_category, room_name_overrides, updated_at
+		FROM event_import_mappings
+		WHERE event_id = $1
+	`
+	m := &domain.ImportMapping{}
+	var tagCategories pq.StringArray
+	var overrides []byte
+	err := r.DB.QueryRowContext(ctx, query, eventID).Scan(
+		&m.EventID, &tagCategories, &m.SessionTypeCategory, &m.TrackCategory, &overrides, &m.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	m.TagCategories = []string(tagCategories)
+	if m.TagCategories == nil {
+		m.TagCategories = []string{}
+	}
+	m.RoomNameOverrides = map[string]string{}
+	if len(overrides) > 0 {
+		if err := json.Unmarshal(overrides, &m.RoomNameOverrides); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+func (r *importMappingRepository) Upsert(ctx context.Context, m *domain.ImportMapping) error {
+	overrides := m.RoomNameOverrides
+	if overrides == nil {
+		overrides = map[string]string{}
+	}
+	overridesJSON, err := json.Marshal(overrides)
+	if err != nil {
+		return err
+	}
+	tagCategories := m.TagCategories
+	if tagCategories == nil {
+		tagCategories = []string{}
+	}
+	query := `
+		INSERT INTO event_import_mappings (event_id, tag_categories, session_type_category, track_category, room_name_overrides, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		ON CONFLICT (event_id) DO UPDATE SET
+			tag_categories = EXCLUDED.tag_categories,
+			session_type_category = EXCLUDED.session_type_category,
+			track_category = EXCLUDED.track_category,
+			room_name_overrides = EXCLUDED.room_name_overrides,
+			updated_at = EXCLUDED.updated_at
+		RETURNING updated_at
+	`
+	return r.DB.QueryRowContext(ctx, query, m.EventID, pq.Array(tagCategories), m.SessionTypeCategory, m.TrackCategory, overridesJSON).
+		Scan(&m.UpdatedAt)
+}
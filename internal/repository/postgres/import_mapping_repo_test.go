@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"multitrackticketing/internal/domain"
+)
+
+func TestImportMappingRepository_GetByEventID(t *testing.T) {
+	ctx := context.Background()
+	updatedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	columns := []string{"event_id", "tag_categories", "session_type_category", "track_category", "room_name_overrides", "updated_at"}
+
+	tests := []struct {
+		name    string
+		mock    func(mock sqlmock.Sqlmock)
+		want    *domain.ImportMapping
+		wantErr error
+	}{
+		{
+			name: "found",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT event_id, tag_categories, session_type_category, track_category, room_name_overrides, updated_at`).
+					WithArgs("ev-1").
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow("ev-1", "{Topics,Level}", "Session format", "Track", []byte(`{"Room 1":"Main Hall"}`), updatedAt))
+			},
+			want: &domain.ImportMapping{
+				EventID:             "ev-1",
+				TagCategories:       []string{"Topics", "Level"},
+				SessionTypeCategory: "Session format",
+				TrackCategory:       "Track",
+				RoomNameOverrides:   map[string]string{"Room 1": "Main Hall"},
+				UpdatedAt:           updatedAt,
+			},
+		},
+		{
+			name: "empty columns become empty collections",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT event_id, tag_categories`).
+					WithArgs("ev-1").
+					WillReturnRows(sqlmock.NewRows(columns).AddRow("ev-1", "{}", "", "", []byte(`{}`), updatedAt))
+			},
+			want: &domain.ImportMapping{
+				EventID:           "ev-1",
+				TagCategories:     []string{},
+				RoomNameOverrides: map[string]string{},
+				UpdatedAt:         updatedAt,
+			},
+		},
+		{
+			name: "not found",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT event_id, tag_categories`).
+					WithArgs("ev-1").
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+			tt.mock(mock)
+			repo := NewImportMappingRepository(db)
+
+			got, err := repo.GetByEventID(ctx, "ev-1")
+
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "got %v", err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestImportMappingRepository_Upsert(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	updatedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`INSERT INTO event_import_mappings`).
+		WithArgs("ev-1", pq.Array([]string{}), "Session format", "", []byte(`{"Room 1":"Main Hall"}`)).
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(updatedAt))
+	repo := NewImportMappingRepository(db)
+	m := &domain.ImportMapping{
+		EventID:             "ev-1",
+		SessionTypeCategory: "Session format",
+		RoomNameOverrides:   map[string]string{"Room 1": "Main Hall"},
+	}
+
+	err = repo.Upsert(context.Background(), m)
+
+	require.NoError(t, err)
+	assert.Equal(t, updatedAt, m.UpdatedAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,164 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"multitrackticketing/internal/domain"
+)
+
+type integrityRepository struct {
+	DB *sql.DB
+}
+
+// NewIntegrityRepository returns a domain.IntegrityRepository implemented with Postgres.
+func NewIntegrityRepository(db *sql.DB) domain.IntegrityRepository {
+	return &integrityRepository{DB: db}
+}
+
+// findIssuesQuery checks every issue kind in one round trip. Each branch yields
+// (kind, entity_id, related_id, detail); $1 is the event ID.
+const findIssuesQuery = `
+	SELECT 'unused_tag', t.id::text, '', t.name
+	FROM event_tags et
+	JOIN tags t ON t.id = et.tag_id
+	WHERE et.event_id = $1 AND NOT EXISTS (
+		SELECT 1 FROM session_tags st
+		JOIN sessions s ON s.id = st.session_id
+		WHERE st.tag_id = et.tag_id AND s.event_id = $1
+	)
+	UNION ALL
+	SELECT 'undeclared_session_tag', s.id::text, t.id::text, t.name
+	FROM session_tags st
+	JOIN sessions s ON s.id = st.session_id
+	JOIN tags t ON t.id = st.tag_id
+	WHERE s.event_id = $1 AND NOT EXISTS (
+		SELECT 1 FROM event_tags et WHERE et.event_id = $1 AND et.tag_id = st.tag_id
+	)
+	UNION ALL
+	SELECT 'cross_event_speaker', s.id::text, sp.id::text, s.title
+	FROM session_speakers ss
+	JOIN sessions s ON s.id = ss.session_id
+	JOIN speakers sp ON sp.id = ss.speaker_id
+	WHERE s.event_id = $1 AND sp.event_id <> $1
+	UNION ALL
+	SELECT 'unassigned_speaker', sp.id::text, '', TRIM(sp.first_name || ' ' || sp.last_name)
+	FROM speakers sp
+	WHERE sp.event_id = $1 AND NOT EXISTS (
+		SELECT 1 FROM session_speakers ss WHERE ss.speaker_id = sp.id
+	)
+	UNION ALL
+	SELECT 'empty_room', r.id::text, '', r.name
+	FROM rooms r
+	WHERE r.event_id = $1 AND NOT EXISTS (
+		SELECT 1 FROM sessions s WHERE s.room_id = r.id
+	)
+	UNION ALL
+	SELECT 'invalid_time_range', s.id::text, '', s.title
+	FROM sessions s
+	WHERE s.event_id = $1 AND s.end_time <= s.start_time
+	UNION ALL
+	SELECT 'room_overlap', a.id::text, b.id::text, r.name
+	FROM sessions a
+	JOIN sessions b ON b.room_id = a.room_id AND a.id < b.id
+		AND a.start_time < b.end_time AND b.start_time < a.end_time
+	JOIN rooms r ON r.id = a.room_id
+	WHERE r.event_id = $1
+	ORDER BY 1, 4, 2, 3
+`
+
+func (r *integrityRepository) FindIssues(ctx context.Context, eventID string) ([]*domain.IntegrityIssue, error) {
+	rows, err := r.DB.QueryContext(ctx, findIssuesQuery, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var issues []*domain.IntegrityIssue
+	for rows.Next() {
+		issue := &domain.IntegrityIssue{}
+		if err := rows.Scan(&issue.Kind, &issue.EntityID, &issue.RelatedID, &issue.Detail); err != nil {
+			return nil, err
+		}
+		issue.Fixable = domain.IsFixableIssue(issue.Kind)
+		issues = append(issues, issue)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return issues, nil
+}
+
+func (r *integrityRepository) Cleanup(ctx context.Context, eventID string) (int64, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	// Declare session tags first so the unused-tag delete below sees them as used.
+	statements := []string{
+		`DELETE FROM session_speakers ss
+		USING sessions s, speakers sp
+		WHERE ss.session_id = s.id AND sp.id = ss.speaker_id
+			AND s.event_id = $1 AND sp.event_id <> $1`,
+		`INSERT INTO event_tags (event_id, tag_id)
+		SELECT DISTINCT s.event_id, st.tag_id
+		FROM session_tags st
+		JOIN sessions s ON s.id = st.session_id
+		WHERE s.event_id = $1
+		ON CONFLICT (event_id, tag_id) DO NOTHING`,
+		`DELETE FROM event_tags et
+		WHERE et.event_id = $1 AND NOT EXISTS (
+			SELECT 1 FROM session_tags st
+			JOIN sessions s ON s.id = st.session_id
+			WHERE st.tag_id = et.tag_id AND s.event_id = $1
+		)`,
+	}
+	var changed int64
+	for _, stmt := range statements {
+		result, err := tx.ExecContext(ctx, stmt, eventID)
+		if err != nil {
+			return 0, err
+		}
+		n, _ := result.RowsAffected()
+		changed += n
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return changed, nil
+}
+
+func (r *integrityRepository) ListEventIDs(ctx context.Context) ([]string, error) {
+	rows, err := r.DB.QueryContext(ctx, `SELECT id FROM events ORDER BY created_at, id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (r *integrityRepository) DeleteOrphanTags(ctx context.Context) (int64, error) {
+	result, err := r.DB.ExecContext(ctx, `
+		DELETE FROM tags t
+		WHERE NOT EXISTS (SELECT 1 FROM event_tags et WHERE et.tag_id = t.id)
+			AND NOT EXISTS (SELECT 1 FROM session_tags st WHERE st.tag_id = t.id)
+	`)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"multitrackticketing/internal/domain"
+)
+
+func TestIntegrityRepository_FindIssues(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	mock.ExpectQuery(`SELECT 'unused_tag', t\.id::text.*UNION ALL.*'room_overlap'`).
+		WithArgs("ev-1").
+		WillReturnRows(sqlmock.NewRows([]string{"kind", "entity_id", "related_id", "detail"}).
+			AddRow("empty_room", "room-1", "", "Room A").
+			AddRow("unused_tag", "tag-1", "", "go"))
+
+	got, err := NewIntegrityRepository(db).FindIssues(context.Background(), "ev-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, []*domain.IntegrityIssue{
+		{Kind: domain.IssueEmptyRoom, EntityID: "room-1", Detail: "Room A"},
+		{Kind: domain.IssueUnusedTag, EntityID: "tag-1", Detail: "go", Fixable: true},
+	}, got)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIntegrityRepository_Cleanup(t *testing.T) {
+	tests := []struct {
+		name        string
+		mock        func(mock sqlmock.Sqlmock)
+		wantChanged int64
+		wantErr     bool
+	}{
+		{
+			name: "success",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`DELETE FROM session_speakers ss\s+USING sessions s, speakers sp`).
+					WithArgs("ev-1").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`INSERT INTO event_tags \(event_id, tag_id\)\s+SELECT DISTINCT`).
+					WithArgs("ev-1").
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec(`DELETE FROM event_tags et`).
+					WithArgs("ev-1").
+					WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectCommit()
+			},
+			wantChanged: 6,
+		},
+		{
+			name: "rolls back on error",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`DELETE FROM session_speakers`).
+					WithArgs("ev-1").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`INSERT INTO event_tags`).
+					WithArgs("ev-1").
+					WillReturnError(sql.ErrConnDone)
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+			tt.mock(mock)
+
+			got, err := NewIntegrityRepository(db).Cleanup(context.Background(), "ev-1")
+
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantChanged, got)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestIntegrityRepository_ListEventIDs(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	mock.ExpectQuery(`SELECT id FROM events ORDER BY created_at, id`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("ev-1").AddRow("ev-2"))
+
+	got, err := NewIntegrityRepository(db).ListEventIDs(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ev-1", "ev-2"}, got)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIntegrityRepository_DeleteOrphanTags(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	mock.ExpectExec(`DELETE FROM tags t\s+WHERE NOT EXISTS \(SELECT 1 FROM event_tags et WHERE et\.tag_id = t\.id\)`).
+		WillReturnResult(sqlmock.NewResult(0, 4))
+
+	got, err := NewIntegrityRepository(db).DeleteOrphanTags(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), got)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,179 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+
+	"multitrackticketing/internal/domain"
+)
+
+type invitationWarmupRepository struct {
+	DB *sql.DB
+}
+
+func NewInvitationWarmupRepository(db *sql.DB) domain.InvitationWarmupRepository {
+	return &invitationWarmupRepository{
+		DB: db,
+	}
+}
+
+// warmupSelect selects a warm-up with its recipient counts; callers add the WHERE and ORDER BY.
+const warmupSelect = `
+	SELECT w.id, w.event_id, w.status, w.daily_volumes, w.starts_at, w.paused_at, w.completed_at, w.created_at, w.updated_at,
+		c.total, c.sent, c.failed, c.skipped
+	FROM invitation_warmups w
+	CROSS JOIN LATERAL (
+		SELECT COUNT(*) AS total,
+			COUNT(*) FILTER (WHERE r.status = 'sent') AS sent,
+			COUNT(*) FILTER (WHERE r.status = 'failed') AS failed,
+			COUNT(*) FILTER (WHERE r.status = 'skipped') AS skipped
+		FROM invitation_warmup_recipients r
+		WHERE r.warmup_id = w.id
+	) c
+`
+
+func scanWarmup(row rowScanner) (*domain.InvitationWarmup, error) {
+	w := &domain.InvitationWarmup{}
+	var volumes pq.Int64Array
+	var pausedAt, completedAt sql.NullTime
+	err := row.Scan(&w.ID, &w.EventID, &w.Status, &volumes, &w.StartsAt, &pausedAt, &completedAt, &w.CreatedAt, &w.UpdatedAt,
+		&w.Total, &w.Sent, &w.Failed, &w.Skipped)
+	if err != nil {
+		return nil, err
+	}
+	w.DailyVolumes = make([]int, len(volumes))
+	for i, v := range volumes {
+		w.DailyVolumes[i] = int(v)
+	}
+	if pausedAt.Valid {
+		w.PausedAt = &pausedAt.Time
+	}
+	if completedAt.Valid {
+		w.CompletedAt = &completedAt.Time
+	}
+	w.Queued = w.Total - w.Processed()
+	return w, nil
+}
+
+func (r *invitationWarmupRepository) Create(ctx context.Context, w *domain.InvitationWarmup, emails []string) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	volumes := make(pq.Int64Array, len(w.DailyVolumes))
+	for i, v := range w.DailyVolumes {
+		volumes[i] = int64(v)
+	}
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO invitation_warmups (event_id, status, daily_volumes, starts_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at, updated_at
+	`, w.EventID, w.Status, volumes, w.StartsAt).Scan(&w.ID, &w.CreatedAt, &w.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO invitation_warmup_recipients (warmup_id, position, email)
+		SELECT $1, generate_subscripts($2::text[], 1), unnest($2::text[])
+		ON CONFLICT (warmup_id, email) DO NOTHING
+	`, w.ID, pq.StringArray(emails))
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *invitationWarmupRepository) GetByID(ctx context.Context, id string) (*domain.InvitationWarmup, error) {
+	w, err := scanWarmup(r.DB.QueryRowContext(ctx, warmupSelect+` WHERE w.id = $1`, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	return w, nil
+}
+
+func (r *invitationWarmupRepository) ListByEventID(ctx context.Context, eventID string) ([]*domain.InvitationWarmup, error) {
+	return r.list(ctx, warmupSelect+` WHERE w.event_id = $1 ORDER BY w.created_at DESC, w.id`, eventID)
+}
+
+func (r *invitationWarmupRepository) ListActive(ctx context.Context) ([]*domain.InvitationWarmup, error) {
+	return r.list(ctx, warmupSelect+` WHERE w.status = 'active' ORDER BY w.starts_at, w.id`)
+}
+
+func (r *invitationWarmupRepository) list(ctx context.Context, query string, args ...any) ([]*domain.InvitationWarmup, error) {
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	warmups := []*domain.InvitationWarmup{}
+	for rows.Next() {
+		w, err := scanWarmup(rows)
+		if err != nil {
+			return nil, err
+		}
+		warmups = append(warmups, w)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return warmups, nil
+}
+
+func (r *invitationWarmupRepository) UpdateStatus(ctx context.Context, w *domain.InvitationWarmup) error {
+	query := `
+		UPDATE invitation_warmups
+		SET status = $2, starts_at = $3, paused_at = $4, completed_at = $5, updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at
+	`
+	err := r.DB.QueryRowContext(ctx, query, w.ID, w.Status, w.StartsAt, w.PausedAt, w.CompletedAt).Scan(&w.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrNotFound
+	}
+	return err
+}
+
+func (r *invitationWarmupRepository) NextQueued(ctx context.Context, warmupID string, limit int) ([]string, error) {
+	rows, err := r.DB.QueryContext(ctx, `
+		SELECT email FROM invitation_warmup_recipients
+		WHERE warmup_id = $1 AND status = 'queued'
+		ORDER BY position
+		LIMIT $2
+	`, warmupID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	emails := []string{}
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return nil, err
+		}
+		emails = append(emails, email)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return emails, nil
+}
+
+func (r *invitationWarmupRepository) MarkRecipients(ctx context.Context, warmupID string, emails []string, status string) error {
+	if len(emails) == 0 {
+		return nil
+	}
+	_, err := r.DB.ExecContext(ctx, `
+		UPDATE invitation_warmup_recipients
+		SET status = $3, processed_at = NOW()
+		WHERE warmup_id = $1 AND email = ANY($2) AND status = 'queued'
+	`, warmupID, pq.StringArray(emails), status)
+	return err
+}
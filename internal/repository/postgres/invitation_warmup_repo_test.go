@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	"multitrackticketing/internal/domain"
+)
+
+var warmupCols = []string{"id", "event_id", "status", "daily_volumes", "starts_at", "paused_at", "completed_at", "created_at", "updated_at", "total", "sent", "failed", "skipped"}
+
+func TestInvitationWarmupRepository_Create(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO invitation_warmups \(event_id, status, daily_volumes, starts_at\)`).
+		WithArgs("ev-1", domain.WarmupStatusActive, pq.Int64Array{50, 100}, at).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow("wu-1", at, at))
+	mock.ExpectExec(`INSERT INTO invitation_warmup_recipients \(warmup_id, position, email\)\s+SELECT \$1, generate_subscripts\(\$2::text\[\], 1\), unnest\(\$2::text\[\]\)`).
+		WithArgs("wu-1", pq.StringArray{"a@example.com", "b@example.com"}).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	w := &domain.InvitationWarmup{EventID: "ev-1", Status: domain.WarmupStatusActive, DailyVolumes: []int{50, 100}, StartsAt: at}
+	require.NoError(t, NewInvitationWarmupRepository(db).Create(ctx, w, []string{"a@example.com", "b@example.com"}))
+	require.Equal(t, "wu-1", w.ID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInvitationWarmupRepository_GetByID(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("with counts", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM invitation_warmups w\s+CROSS JOIN LATERAL .*WHERE w.id = \$1`).
+			WithArgs("wu-1").
+			WillReturnRows(sqlmock.NewRows(warmupCols).
+				AddRow("wu-1", "ev-1", domain.WarmupStatusPaused, "{50,100}", at, at.Add(time.Hour), nil, at, at, 10, 4, 1, 2))
+
+		got, err := NewInvitationWarmupRepository(db).GetByID(ctx, "wu-1")
+		require.NoError(t, err)
+		paused := at.Add(time.Hour)
+		require.Equal(t, &domain.InvitationWarmup{
+			ID: "wu-1", EventID: "ev-1", Status: domain.WarmupStatusPaused, DailyVolumes: []int{50, 100}, StartsAt: at, PausedAt: &paused,
+			Total: 10, Sent: 4, Failed: 1, Skipped: 2, Queued: 3, CreatedAt: at, UpdatedAt: at,
+		}, got)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM invitation_warmups w`).
+			WithArgs("wu-1").
+			WillReturnRows(sqlmock.NewRows(warmupCols))
+
+		_, err = NewInvitationWarmupRepository(db).GetByID(ctx, "wu-1")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func TestInvitationWarmupRepository_Recipients(t *testing.T) {
+	ctx := context.Background()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT email FROM invitation_warmup_recipients\s+WHERE warmup_id = \$1 AND status = 'queued'\s+ORDER BY position\s+LIMIT \$2`).
+		WithArgs("wu-1", 2).
+		WillReturnRows(sqlmock.NewRows([]string{"email"}).AddRow("a@example.com").AddRow("b@example.com"))
+	mock.ExpectExec(`UPDATE invitation_warmup_recipients\s+SET status = \$3, processed_at = NOW\(\)\s+WHERE warmup_id = \$1 AND email = ANY\(\$2\) AND status = 'queued'`).
+		WithArgs("wu-1", pq.StringArray{"a@example.com"}, domain.WarmupRecipientSent).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := NewInvitationWarmupRepository(db)
+	emails, err := repo.NextQueued(ctx, "wu-1", 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a@example.com", "b@example.com"}, emails)
+	require.NoError(t, repo.MarkRecipients(ctx, "wu-1", []string{"a@example.com"}, domain.WarmupRecipientSent))
+	require.NoError(t, repo.MarkRecipients(ctx, "wu-1", nil, domain.WarmupRecipientFailed))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
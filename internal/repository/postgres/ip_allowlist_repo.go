@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/lib/pq"
+)
+
+type ipAllowlistRepository struct {
+	DB *sql.DB
+}
+
+func NewIPAllowlistRepository(db *sql.DB) domain.IPAllowlistRepository {
+	return &ipAllowlistRepository{
+		DB: db,
+	}
+}
+
+func (r *ipAllowlistRepository) Get(ctx context.Context, userID string) ([]string, error) {
+	return r.get(ctx, `
+		SELECT ip_range::text FROM user_ip_allowlists WHERE user_id = $1 ORDER BY ip_range
+	`, userID)
+}
+
+func (r *ipAllowlistRepository) Replace(ctx context.Context, userID string, ranges []string) error {
+	return r.replace(ctx, `DELETE FROM user_ip_allowlists WHERE user_id = $1`, `
+		INSERT INTO user_ip_allowlists (user_id, ip_range)
+		SELECT $1, unnest($2::cidr[])
+		ON CONFLICT (user_id, ip_range) DO NOTHING
+	`, userID, ranges)
+}
+
+func (r *ipAllowlistRepository) GetMachineClient(ctx context.Context, clientID string) ([]string, error) {
+	return r.get(ctx, `
+		SELECT ip_range::text FROM machine_client_ip_allowlists WHERE client_id = $1 ORDER BY ip_range
+	`, clientID)
+}
+
+func (r *ipAllowlistRepository) ReplaceMachineClient(ctx context.Context, clientID string, ranges []string) error {
+	return r.replace(ctx, `DELETE FROM machine_client_ip_allowlists WHERE client_id = $1`, `
+		INSERT INTO machine_client_ip_allowlists (client_id, ip_range)
+		SELECT $1, unnest($2::cidr[])
+		ON CONFLICT (client_id, ip_range) DO NOTHING
+	`, clientID, ranges)
+}
+
+// get runs query, which selects the ranges of the account or client id.
+func (r *ipAllowlistRepository) get(ctx context.Context, query, id string) ([]string, error) {
+	rows, err := r.DB.QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ranges := []string{}
+	for rows.Next() {
+		var ipRange string
+		if err := rows.Scan(&ipRange); err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, ipRange)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return ranges, nil
+}
+
+// replace runs del and then, unless ranges is empty, insert for the account or client id in one
+// transaction.
+func (r *ipAllowlistRepository) replace(ctx context.Context, del, insert, id string, ranges []string) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, del, id); err != nil {
+		return err
+	}
+	if len(ranges) > 0 {
+		if _, err := tx.ExecContext(ctx, insert, id, pq.StringArray(ranges)); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
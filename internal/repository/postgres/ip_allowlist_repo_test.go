@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIPAllowlistRepository_Get(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT ip_range::text FROM user_ip_allowlists WHERE user_id = \$1`).
+		WithArgs("user-1").
+		WillReturnRows(sqlmock.NewRows([]string{"ip_range"}).AddRow("10.0.0.0/8").AddRow("203.0.113.7/32"))
+
+	ranges, err := NewIPAllowlistRepository(db).Get(context.Background(), "user-1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"10.0.0.0/8", "203.0.113.7/32"}, ranges)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIPAllowlistRepository_Replace(t *testing.T) {
+	t.Run("replaces the ranges", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`DELETE FROM user_ip_allowlists WHERE user_id = \$1`).
+			WithArgs("user-1").
+			WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectExec(`INSERT INTO user_ip_allowlists \(user_id, ip_range\)\s+SELECT \$1, unnest\(\$2::cidr\[\]\)`).
+			WithArgs("user-1", pq.StringArray{"10.0.0.0/8"}).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		require.NoError(t, NewIPAllowlistRepository(db).Replace(context.Background(), "user-1", []string{"10.0.0.0/8"}))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("empty list clears it", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`DELETE FROM user_ip_allowlists`).
+			WithArgs("user-1").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		require.NoError(t, NewIPAllowlistRepository(db).Replace(context.Background(), "user-1", nil))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestIPAllowlistRepository_MachineClient(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT ip_range::text FROM machine_client_ip_allowlists WHERE client_id = \$1`).
+		WithArgs("client-1").
+		WillReturnRows(sqlmock.NewRows([]string{"ip_range"}).AddRow("192.0.2.0/24"))
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM machine_client_ip_allowlists WHERE client_id = \$1`).
+		WithArgs("client-1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO machine_client_ip_allowlists \(client_id, ip_range\)\s+SELECT \$1, unnest\(\$2::cidr\[\]\)`).
+		WithArgs("client-1", pq.StringArray{"10.0.0.0/8"}).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := NewIPAllowlistRepository(db)
+	ranges, err := repo.GetMachineClient(context.Background(), "client-1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"192.0.2.0/24"}, ranges)
+	require.NoError(t, repo.ReplaceMachineClient(context.Background(), "client-1", []string{"10.0.0.0/8"}))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
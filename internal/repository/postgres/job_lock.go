@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"hash/fnv"
+
+	"multitrackticketing/internal/domain"
+)
+
+type advisoryLocker struct {
+	DB *sql.DB
+}
+
+// NewJobLocker returns a domain.JobLocker backed by Postgres session advisory locks. Each held lock
+// pins one pooled connection; if that connection drops, Postgres releases the lock.
+func NewJobLocker(db *sql.DB) domain.JobLocker {
+	return &advisoryLocker{DB: db}
+}
+
+// advisoryLockKey maps a lock name to the 64-bit key Postgres advisory locks take.
+func advisoryLockKey(name string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(name))
+	return int64(h.Sum64())
+}
+
+func (l *advisoryLocker) TryLock(ctx context.Context, name string) (func(), bool, error) {
+	conn, err := l.DB.Conn(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	key := advisoryLockKey(name)
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock($1)`, key).Scan(&acquired); err != nil {
+		conn.Close()
+		return nil, false, err
+	}
+	if !acquired {
+		conn.Close()
+		return nil, false, nil
+	}
+	release := func() {
+		// The job's context may be done by now; unlocking must still reach the database.
+		_, _ = conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, key)
+		conn.Close()
+	}
+	return release, true, nil
+}
@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobLocker_TryLock(t *testing.T) {
+	ctx := context.Background()
+	key := advisoryLockKey("integrity-check")
+
+	t.Run("acquired and released", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT pg_try_advisory_lock\(\$1\)`).
+			WithArgs(key).
+			WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(true))
+		mock.ExpectExec(`SELECT pg_advisory_unlock\(\$1\)`).
+			WithArgs(key).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		release, acquired, err := NewJobLocker(db).TryLock(ctx, "integrity-check")
+		require.NoError(t, err)
+		require.True(t, acquired)
+		release()
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("held by another replica", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT pg_try_advisory_lock\(\$1\)`).
+			WithArgs(key).
+			WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(false))
+
+		release, acquired, err := NewJobLocker(db).TryLock(ctx, "integrity-check")
+		require.NoError(t, err)
+		require.False(t, acquired)
+		require.Nil(t, release)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAdvisoryLockKey(t *testing.T) {
+	require.Equal(t, advisoryLockKey("integrity-check"), advisoryLockKey("integrity-check"))
+	require.NotEqual(t, advisoryLockKey("integrity-check"), advisoryLockKey("reminders"))
+}
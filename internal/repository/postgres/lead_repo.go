@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"multitrackticketing/internal/domain"
+)
+
+type leadRepository struct {
+	DB *sql.DB
+}
+
+func NewLeadRepository(db *sql.DB) domain.LeadRepository {
+	return &leadRepository{
+		DB: db,
+	}
+}
+
+func (r *leadRepository) GetConsentCode(ctx context.Context, eventID, userID string) (string, error) {
+	var code string
+	err := r.DB.QueryRowContext(ctx, `SELECT code FROM lead_consents WHERE event_id = $1 AND user_id = $2`, eventID, userID).Scan(&code)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	return code, err
+}
+
+func (r *leadRepository) SetConsentCode(ctx context.Context, eventID, userID, code string) error {
+	if code == "" {
+		_, err := r.DB.ExecContext(ctx, `DELETE FROM lead_consents WHERE event_id = $1 AND user_id = $2`, eventID, userID)
+		return err
+	}
+	query := `
+		INSERT INTO lead_consents (event_id, user_id, code)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (event_id, user_id) DO UPDATE SET code = EXCLUDED.code
+	`
+	_, err := r.DB.ExecContext(ctx, query, eventID, userID, code)
+	return err
+}
+
+func (r *leadRepository) FindConsent(ctx context.Context, eventID, code string) (string, error) {
+	var userID string
+	err := r.DB.QueryRowContext(ctx, `SELECT user_id FROM lead_consents WHERE event_id = $1 AND code = $2`, eventID, code).Scan(&userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", domain.ErrNotFound
+	}
+	return userID, err
+}
+
+func scanLead(row rowScanner) (*domain.Lead, error) {
+	l := &domain.Lead{}
+	var name, lastName sql.NullString
+	if err := row.Scan(&l.ID, &l.ExhibitorID, &l.EventID, &l.UserID, &name, &lastName, &l.Email, &l.Notes, &l.ScannedBy, &l.ScannedAt); err != nil {
+		return nil, err
+	}
+	l.Name = name.String
+	l.LastName = lastName.String
+	return l, nil
+}
+
+func (r *leadRepository) UpsertLead(ctx context.Context, lead *domain.Lead) (bool, error) {
+	// xmax is 0 only for a row this statement inserted rather than updated.
+	query := `
+		WITH l AS (
+			INSERT INTO exhibitor_leads (exhibitor_id, event_id, user_id, scanned_by, notes)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (exhibitor_id, user_id) DO UPDATE
+			SET notes = CASE WHEN EXCLUDED.notes <> '' THEN EXCLUDED.notes ELSE exhibitor_leads.notes END
+			RETURNING id, exhibitor_id, event_id, user_id, notes, COALESCE(scanned_by::text, '') AS scanned_by, scanned_at, xmax = 0 AS created
+		)
+		SELECT l.id, l.exhibitor_id, l.event_id, l.user_id, u.name, u.last_name, u.email, l.notes, l.scanned_by, l.scanned_at, l.created
+		FROM l
+		JOIN users u ON u.id = l.user_id
+	`
+	var name, lastName sql.NullString
+	var created bool
+	err := r.DB.QueryRowContext(ctx, query, lead.ExhibitorID, lead.EventID, lead.UserID, lead.ScannedBy, lead.Notes).
+		Scan(&lead.ID, &lead.ExhibitorID, &lead.EventID, &lead.UserID, &name, &lastName, &lead.Email, &lead.Notes, &lead.ScannedBy, &lead.ScannedAt, &created)
+	if err != nil {
+		return false, err
+	}
+	lead.Name = name.String
+	lead.LastName = lastName.String
+	return created, nil
+}
+
+func (r *leadRepository) ListLeads(ctx context.Context, exhibitorID string) ([]*domain.Lead, error) {
+	query := `
+		SELECT l.id, l.exhibitor_id, l.event_id, l.user_id, u.name, u.last_name, u.email, l.notes, COALESCE(l.scanned_by::text, ''), l.scanned_at
+		FROM exhibitor_leads l
+		JOIN users u ON u.id = l.user_id
+		WHERE l.exhibitor_id = $1
+		ORDER BY l.scanned_at, l.id
+	`
+	rows, err := r.DB.QueryContext(ctx, query, exhibitorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	leads := []*domain.Lead{}
+	for rows.Next() {
+		l, err := scanLead(rows)
+		if err != nil {
+			return nil, err
+		}
+		leads = append(leads, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return leads, nil
+}
@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"multitrackticketing/internal/domain"
+)
+
+func TestLeadRepository_ConsentCode(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("no consent", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT code FROM lead_consents WHERE event_id = \$1 AND user_id = \$2`).
+			WithArgs("ev-1", "user-1").
+			WillReturnRows(sqlmock.NewRows([]string{"code"}))
+
+		code, err := NewLeadRepository(db).GetConsentCode(ctx, "ev-1", "user-1")
+		require.NoError(t, err)
+		require.Empty(t, code)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(`INSERT INTO lead_consents \(event_id, user_id, code\).*ON CONFLICT \(event_id, user_id\) DO UPDATE SET code = EXCLUDED.code`).
+			WithArgs("ev-1", "user-1", "abc").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		require.NoError(t, NewLeadRepository(db).SetConsentCode(ctx, "ev-1", "user-1", "abc"))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("withdraw", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(`DELETE FROM lead_consents WHERE event_id = \$1 AND user_id = \$2`).
+			WithArgs("ev-1", "user-1").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		require.NoError(t, NewLeadRepository(db).SetConsentCode(ctx, "ev-1", "user-1", ""))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("unknown code", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT user_id FROM lead_consents WHERE event_id = \$1 AND code = \$2`).
+			WithArgs("ev-1", "abc").
+			WillReturnRows(sqlmock.NewRows([]string{"user_id"}))
+
+		_, err = NewLeadRepository(db).FindConsent(ctx, "ev-1", "abc")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func TestLeadRepository_UpsertLead(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2026, 5, 14, 11, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`INSERT INTO exhibitor_leads \(exhibitor_id, event_id, user_id, scanned_by, notes\).*ON CONFLICT \(exhibitor_id, user_id\) DO UPDATE.*JOIN users u ON u.id = l.user_id`).
+		WithArgs("ex-1", "ev-1", "user-1", "staff-1", "").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "exhibitor_id", "event_id", "user_id", "name", "last_name", "email", "notes", "scanned_by", "scanned_at", "created"}).
+			AddRow("lead-1", "ex-1", "ev-1", "user-1", "Ann", "Lee", "ann@example.com", "wants a demo", "staff-2", at, false))
+
+	lead := &domain.Lead{ExhibitorID: "ex-1", EventID: "ev-1", UserID: "user-1", ScannedBy: "staff-1"}
+	created, err := NewLeadRepository(db).UpsertLead(ctx, lead)
+	require.NoError(t, err)
+	require.False(t, created)
+	require.Equal(t, &domain.Lead{
+		ID: "lead-1", ExhibitorID: "ex-1", EventID: "ev-1", UserID: "user-1", Name: "Ann", LastName: "Lee",
+		Email: "ann@example.com", Notes: "wants a demo", ScannedBy: "staff-2", ScannedAt: at,
+	}, lead)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLeadRepository_ListLeads(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2026, 5, 14, 11, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM exhibitor_leads l\s+JOIN users u ON u.id = l.user_id\s+WHERE l.exhibitor_id = \$1`).
+		WithArgs("ex-1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "exhibitor_id", "event_id", "user_id", "name", "last_name", "email", "notes", "scanned_by", "scanned_at"}).
+			AddRow("lead-1", "ex-1", "ev-1", "user-1", "Ann", nil, "ann@example.com", "", "", at))
+
+	got, err := NewLeadRepository(db).ListLeads(ctx, "ex-1")
+	require.NoError(t, err)
+	require.Equal(t, []*domain.Lead{{
+		ID: "lead-1", ExhibitorID: "ex-1", EventID: "ev-1", UserID: "user-1", Name: "Ann", Email: "ann@example.com", ScannedAt: at,
+	}}, got)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
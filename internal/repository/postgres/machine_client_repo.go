@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/lib/pq"
+)
+
+type machineClientRepository struct {
+	DB *sql.DB
+}
+
+func NewMachineClientRepository(db *sql.DB) domain.MachineClientRepository {
+	return &machineClientRepository{
+		DB: db,
+	}
+}
+
+const machineClientColumns = `id, name, COALESCE(event_id::text, ''), scopes, COALESCE(created_by::text, ''), created_at, last_used_at, revoked_at`
+
+func scanMachineClient(row rowScanner, extra ...any) (*domain.MachineClient, error) {
+	c := &domain.MachineClient{}
+	var scopes pq.StringArray
+	var lastUsedAt, revokedAt sql.NullTime
+	err := row.Scan(append([]any{&c.ID, &c.Name, &c.EventID, &scopes, &c.CreatedBy, &c.CreatedAt, &lastUsedAt, &revokedAt}, extra...)...)
+	if err != nil {
+		return nil, err
+	}
+	c.Scopes = []string(scopes)
+	if lastUsedAt.Valid {
+		c.LastUsedAt = &lastUsedAt.Time
+	}
+	if revokedAt.Valid {
+		c.RevokedAt = &revokedAt.Time
+	}
+	return c, nil
+}
+
+func (r *machineClientRepository) Create(ctx context.Context, c *domain.MachineClient, secretHash string) error {
+	query := `
+		INSERT INTO machine_clients (name, event_id, scopes, secret_hash, created_by)
+		VALUES ($1, NULLIF($2, '')::uuid, $3, $4, NULLIF($5, '')::uuid)
+		RETURNING id, created_at
+	`
+	return r.DB.QueryRowContext(ctx, query, c.Name, c.EventID, pq.StringArray(c.Scopes), secretHash, c.CreatedBy).
+		Scan(&c.ID, &c.CreatedAt)
+}
+
+func (r *machineClientRepository) GetByID(ctx context.Context, id string) (*domain.MachineClient, string, error) {
+	var secretHash string
+	c, err := scanMachineClient(r.DB.QueryRowContext(ctx, `
+		SELECT `+machineClientColumns+`, secret_hash FROM machine_clients WHERE id = $1
+	`, id), &secretHash)
+	if err == sql.ErrNoRows {
+		return nil, "", domain.ErrNotFound
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	return c, secretHash, nil
+}
+
+func (r *machineClientRepository) List(ctx context.Context) ([]*domain.MachineClient, error) {
+	rows, err := r.DB.QueryContext(ctx, `
+		SELECT `+machineClientColumns+` FROM machine_clients ORDER BY created_at DESC, id
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	clients := []*domain.MachineClient{}
+	for rows.Next() {
+		c, err := scanMachineClient(rows)
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return clients, nil
+}
+
+func (r *machineClientRepository) Revoke(ctx context.Context, id string, at time.Time) (*domain.MachineClient, error) {
+	c, err := scanMachineClient(r.DB.QueryRowContext(ctx, `
+		UPDATE machine_clients SET revoked_at = COALESCE(revoked_at, $2)
+		WHERE id = $1
+		RETURNING `+machineClientColumns+`
+	`, id, at))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrNotFound
+	}
+	return c, err
+}
+
+func (r *machineClientRepository) TouchLastUsed(ctx context.Context, id string, at time.Time) error {
+	_, err := r.DB.ExecContext(ctx, `UPDATE machine_clients SET last_used_at = $2 WHERE id = $1`, id, at)
+	return err
+}
@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var machineClientRowColumns = []string{"id", "name", "event_id", "scopes", "created_by", "created_at", "last_used_at", "revoked_at"}
+
+func TestMachineClientRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	created := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`INSERT INTO machine_clients \(name, event_id, scopes, secret_hash, created_by\)`).
+		WithArgs("Signage", "event-1", pq.StringArray{domain.ScopeScheduleRead}, "hash", "admin-1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow("client-1", created))
+
+	c := &domain.MachineClient{Name: "Signage", EventID: "event-1", Scopes: []string{domain.ScopeScheduleRead}, CreatedBy: "admin-1"}
+	require.NoError(t, NewMachineClientRepository(db).Create(context.Background(), c, "hash"))
+	assert.Equal(t, "client-1", c.ID)
+	assert.Equal(t, created, c.CreatedAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMachineClientRepository_GetByID(t *testing.T) {
+	t.Run("found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		created := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+		mock.ExpectQuery(`SELECT .+, secret_hash FROM machine_clients WHERE id = \$1`).
+			WithArgs("client-1").
+			WillReturnRows(sqlmock.NewRows(append(machineClientRowColumns, "secret_hash")).
+				AddRow("client-1", "Signage", "event-1", "{schedule:read}", "admin-1", created, nil, nil, "hash"))
+
+		c, hash, err := NewMachineClientRepository(db).GetByID(context.Background(), "client-1")
+		require.NoError(t, err)
+		assert.Equal(t, "hash", hash)
+		assert.Equal(t, []string{domain.ScopeScheduleRead}, c.Scopes)
+		assert.Nil(t, c.RevokedAt)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM machine_clients WHERE id = \$1`).
+			WithArgs("client-1").
+			WillReturnRows(sqlmock.NewRows(append(machineClientRowColumns, "secret_hash")))
+
+		_, _, err = NewMachineClientRepository(db).GetByID(context.Background(), "client-1")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func TestMachineClientRepository_Revoke(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	created := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	revoked := time.Date(2026, 5, 2, 9, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`UPDATE machine_clients SET revoked_at = COALESCE\(revoked_at, \$2\)`).
+		WithArgs("client-1", revoked).
+		WillReturnRows(sqlmock.NewRows(machineClientRowColumns).
+			AddRow("client-1", "Signage", "event-1", "{schedule:read}", "", created, nil, revoked))
+
+	c, err := NewMachineClientRepository(db).Revoke(context.Background(), "client-1", revoked)
+	require.NoError(t, err)
+	require.NotNil(t, c.RevokedAt)
+	assert.Equal(t, revoked, *c.RevokedAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
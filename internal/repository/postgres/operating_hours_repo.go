@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"multitrackticketing/internal/domain"
+)
+
+type operatingHoursRepository struct {
+	DB *sql.DB
+}
+
+func NewOperatingHoursRepository(db *sql.DB) domain.OperatingHoursRepository {
+	return &operatingHoursRepository{
+		DB: db,
+	}
+}
+
+func (r *operatingHoursRepository) ListByEventID(ctx context.Context, eventID string) ([]*domain.OperatingDay, error) {
+	query := `
+		SELECT to_char(day, 'YYYY-MM-DD'), opens_at, closes_at
+		FROM event_operating_hours
+		WHERE event_id = $1
+		ORDER BY day
+	`
+	rows, err := r.DB.QueryContext(ctx, query, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	days := []*domain.OperatingDay{}
+	for rows.Next() {
+		day := &domain.OperatingDay{}
+		if err := rows.Scan(&day.Day, &day.OpensAt, &day.ClosesAt); err != nil {
+			return nil, err
+		}
+		days = append(days, day)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return days, nil
+}
+
+func (r *operatingHoursRepository) Replace(ctx context.Context, eventID string, days []*domain.OperatingDay) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM event_operating_hours WHERE event_id = $1`, eventID); err != nil {
+		return err
+	}
+	for _, day := range days {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO event_operating_hours (event_id, day, opens_at, closes_at)
+			VALUES ($1, $2, $3, $4)
+		`, eventID, day.Day, day.OpensAt, day.ClosesAt)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
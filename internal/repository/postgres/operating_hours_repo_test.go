@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"multitrackticketing/internal/domain"
+)
+
+func TestOperatingHoursRepository_ListByEventID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	opens := time.Date(2026, 5, 14, 8, 0, 0, 0, time.UTC)
+	closes := time.Date(2026, 5, 14, 23, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`SELECT to_char\(day, 'YYYY-MM-DD'\), opens_at, closes_at FROM event_operating_hours WHERE event_id = \$1 ORDER BY day`).
+		WithArgs("ev-1").
+		WillReturnRows(sqlmock.NewRows([]string{"day", "opens_at", "closes_at"}).AddRow("2026-05-14", opens, closes))
+	repo := NewOperatingHoursRepository(db)
+
+	got, err := repo.ListByEventID(context.Background(), "ev-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, []*domain.OperatingDay{{Day: "2026-05-14", OpensAt: opens, ClosesAt: closes}}, got)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOperatingHoursRepository_ListByEventID_Empty(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	mock.ExpectQuery(`FROM event_operating_hours`).
+		WithArgs("ev-1").
+		WillReturnRows(sqlmock.NewRows([]string{"day", "opens_at", "closes_at"}))
+	repo := NewOperatingHoursRepository(db)
+
+	got, err := repo.ListByEventID(context.Background(), "ev-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, []*domain.OperatingDay{}, got)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOperatingHoursRepository_Replace(t *testing.T) {
+	opens := time.Date(2026, 5, 14, 8, 0, 0, 0, time.UTC)
+	closes := time.Date(2026, 5, 14, 23, 0, 0, 0, time.UTC)
+	days := []*domain.OperatingDay{
+		{Day: "2026-05-14", OpensAt: opens, ClosesAt: closes},
+		{Day: "2026-05-15", OpensAt: opens.AddDate(0, 0, 1), ClosesAt: closes.AddDate(0, 0, 1)},
+	}
+
+	t.Run("deletes then inserts every day", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		mock.ExpectBegin()
+		mock.ExpectExec(`DELETE FROM event_operating_hours WHERE event_id = \$1`).
+			WithArgs("ev-1").
+			WillReturnResult(sqlmock.NewResult(0, 3))
+		for _, day := range days {
+			mock.ExpectExec(`INSERT INTO event_operating_hours \(event_id, day, opens_at, closes_at\)`).
+				WithArgs("ev-1", day.Day, day.OpensAt, day.ClosesAt).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+		mock.ExpectCommit()
+		repo := NewOperatingHoursRepository(db)
+
+		require.NoError(t, repo.Replace(context.Background(), "ev-1", days))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rolls back when an insert fails", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		mock.ExpectBegin()
+		mock.ExpectExec(`DELETE FROM event_operating_hours`).
+			WithArgs("ev-1").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`INSERT INTO event_operating_hours`).
+			WillReturnError(errors.New("constraint violation"))
+		mock.ExpectRollback()
+		repo := NewOperatingHoursRepository(db)
+
+		err = repo.Replace(context.Background(), "ev-1", days)
+		require.Error(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
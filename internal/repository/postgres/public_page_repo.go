@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+type publicPageRepository struct {
+	DB *sql.DB
+}
+
+func NewPublicPageRepository(db *sql.DB) domain.PublicPageRepository {
+	return &publicPageRepository{
+		DB: db,
+	}
+}
+
+func (r *publicPageRepository) ListPublicPages(ctx context.Context, now time.Time, limit int) ([]*domain.PublicPage, error) {
+	query := `
+		WITH unlisted AS (
+			SELECT target_type, target_id FROM content_unlistings
+			WHERE unlisted_until IS NULL OR unlisted_until > $1
+		), listed_sessions AS (
+			SELECT s.id, s.event_id, s.updated_at
+			FROM sessions s
+			JOIN rooms r ON r.id = s.room_id AND NOT r.not_bookable
+			WHERE NOT EXISTS (SELECT 1 FROM unlisted u WHERE u.target_type = 'session' AND u.target_id = s.id)
+		)
+		SELECT kind, event_code, id, updated_at FROM (
+			SELECT 1 AS rank, 'event' AS kind, e.event_code, e.id::text AS id, e.updated_at, e.id AS event_id
+			FROM events e
+			UNION ALL
+			SELECT 2, 'session', e.event_code, s.id::text, s.updated_at, e.id
+			FROM listed_sessions s JOIN events e ON e.id = s.event_id
+			UNION ALL
+			SELECT 3, 'speaker', e.event_code, sp.id::text, sp.updated_at, e.id
+			FROM speakers sp JOIN events e ON e.id = sp.event_id
+			WHERE EXISTS (
+				SELECT 1 FROM session_speakers ss JOIN listed_sessions s ON s.id = ss.session_id
+				WHERE ss.speaker_id = sp.id
+			)
+			AND NOT EXISTS (SELECT 1 FROM unlisted u WHERE u.target_type = 'speaker' AND u.target_id = sp.id)
+		) p
+		WHERE NOT EXISTS (SELECT 1 FROM unlisted u WHERE u.target_type = 'event' AND u.target_id = p.event_id)
+		ORDER BY rank, event_code, id
+		LIMIT $2
+	`
+	rows, err := r.DB.QueryContext(ctx, query, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	pages := []*domain.PublicPage{}
+	for rows.Next() {
+		p := &domain.PublicPage{}
+		var updatedAt sql.NullTime
+		if err := rows.Scan(&p.Kind, &p.EventCode, &p.ID, &updatedAt); err != nil {
+			return nil, err
+		}
+		if updatedAt.Valid {
+			p.UpdatedAt = updatedAt.Time
+		}
+		pages = append(pages, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return pages, nil
+}
@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublicPageRepository_ListPublicPages(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	updated := now.Add(-time.Hour)
+	mock.ExpectQuery(`WHERE NOT EXISTS \(SELECT 1 FROM unlisted u WHERE u.target_type = 'event' AND u.target_id = p.event_id\)\s+ORDER BY rank, event_code, id\s+LIMIT \$2`).
+		WithArgs(now, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"kind", "event_code", "id", "updated_at"}).
+			AddRow("event", "ab12", "ev-1", updated).
+			AddRow("session", "ab12", "sess-1", updated).
+			AddRow("speaker", "ab12", "spk-1", nil))
+
+	got, err := NewPublicPageRepository(db).ListPublicPages(context.Background(), now, 10)
+	require.NoError(t, err)
+	require.Equal(t, []*domain.PublicPage{
+		{Kind: domain.PublicPageEvent, EventCode: "ab12", ID: "ev-1", UpdatedAt: updated},
+		{Kind: domain.PublicPageSession, EventCode: "ab12", ID: "sess-1", UpdatedAt: updated},
+		{Kind: domain.PublicPageSpeaker, EventCode: "ab12", ID: "spk-1"},
+	}, got)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+type retentionRepository struct {
+	DB *sql.DB
+	// AnonymizationKey keys the hashes anonymized invitation emails are replaced by, as in the event
+	// repository.
+	AnonymizationKey []byte
+}
+
+func NewRetentionRepository(db *sql.DB, anonymizationKey []byte) domain.RetentionRepository {
+	return &retentionRepository{
+		DB:               db,
+		AnonymizationKey: anonymizationKey,
+	}
+}
+
+// retentionTarget returns the table a data class lives in and the condition matching its rows older
+// than the cutoff $1.
+func retentionTarget(dataClass string) (table, where string, err error) {
+	switch dataClass {
+	case domain.RetentionSessionChanges:
+		return "session_changes", "changed_at < $1", nil
+	case domain.RetentionLoginCodes:
+		return "login_codes", "expires_at < $1", nil
+	case domain.RetentionPendingInvitations:
+		return "event_invitations i", `i.last_contacted_at < $1 AND NOT EXISTS (
+			SELECT 1 FROM users u
+			JOIN event_registrations er ON er.user_id = u.id AND er.event_id = i.event_id
+			WHERE u.email = i.email
+		)`, nil
+	case domain.RetentionResolvedSpeakerMatches:
+		return "speaker_merge_candidates", "status <> 'pending' AND resolved_at < $1", nil
+	case domain.RetentionEventEmails:
+		return "event_emails", "created_at < $1", nil
+	case domain.RetentionSandboxEvents:
+		return "events e", "e.sandbox AND e.created_at < $1", nil
+	case domain.RetentionSpeakerPhotoArchives:
+		return "speaker_photo_archives", "created_at < $1", nil
+	case domain.RetentionEventPersonalData:
+		return "events e", "e.date < $1 AND NOT EXISTS (SELECT 1 FROM event_anonymizations a WHERE a.event_id = e.id)", nil
+	}
+	return "", "", fmt.Errorf("unknown retention data class %q", dataClass)
+}
+
+func (r *retentionRepository) CountExpired(ctx context.Context, dataClass string, cutoff time.Time) (int64, error) {
+	table, where, err := retentionTarget(dataClass)
+	if err != nil {
+		return 0, err
+	}
+	var n int64
+	err = r.DB.QueryRowContext(ctx, `SELECT COUNT(*) FROM `+table+` WHERE `+where, cutoff).Scan(&n)
+	return n, err
+}
+
+func (r *retentionRepository) PurgeExpired(ctx context.Context, dataClass string, cutoff time.Time) (int64, error) {
+	table, where, err := retentionTarget(dataClass)
+	if err != nil {
+		return 0, err
+	}
+	if dataClass == domain.RetentionEventPersonalData {
+		return r.anonymizeEvents(ctx, table, where, cutoff)
+	}
+	result, err := r.DB.ExecContext(ctx, `DELETE FROM `+table+` WHERE `+where, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// anonymizeEvents anonymizes each expired event in its own transaction and returns how many were.
+func (r *retentionRepository) anonymizeEvents(ctx context.Context, table, where string, cutoff time.Time) (int64, error) {
+	rows, err := r.DB.QueryContext(ctx, `SELECT e.id FROM `+table+` WHERE `+where, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	var eventIDs []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		eventIDs = append(eventIDs, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	var n int64
+	for _, id := range eventIDs {
+		if _, err := anonymizeEvent(ctx, r.DB, r.AnonymizationKey, id, "", false); err != nil {
+			return n, fmt.Errorf("anonymize event %s: %w", id, err)
+		}
+		n++
+	}
+	return n, nil
+}
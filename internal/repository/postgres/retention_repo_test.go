@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetentionRepository(t *testing.T) {
+	ctx := context.Background()
+	cutoff := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("count", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM session_changes WHERE changed_at < \$1`).
+			WithArgs(cutoff).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(12))
+		n, err := NewRetentionRepository(db, nil).CountExpired(ctx, domain.RetentionSessionChanges, cutoff)
+		require.NoError(t, err)
+		require.Equal(t, int64(12), n)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("purge keeps accepted invitations", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(`DELETE FROM event_invitations i WHERE i.last_contacted_at < \$1 AND NOT EXISTS`).
+			WithArgs(cutoff).
+			WillReturnResult(sqlmock.NewResult(0, 3))
+		n, err := NewRetentionRepository(db, nil).PurgeExpired(ctx, domain.RetentionPendingInvitations, cutoff)
+		require.NoError(t, err)
+		require.Equal(t, int64(3), n)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("purge sent emails", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(`DELETE FROM event_emails WHERE created_at < \$1`).
+			WithArgs(cutoff).
+			WillReturnResult(sqlmock.NewResult(0, 40))
+		n, err := NewRetentionRepository(db, nil).PurgeExpired(ctx, domain.RetentionEventEmails, cutoff)
+		require.NoError(t, err)
+		require.Equal(t, int64(40), n)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("purge sandbox events", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(`DELETE FROM events e WHERE e.sandbox AND e.created_at < \$1`).
+			WithArgs(cutoff).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		n, err := NewRetentionRepository(db, nil).PurgeExpired(ctx, domain.RetentionSandboxEvents, cutoff)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), n)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("purge speaker photo archives", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(`DELETE FROM speaker_photo_archives WHERE created_at < \$1`).
+			WithArgs(cutoff).
+			WillReturnResult(sqlmock.NewResult(0, 3))
+		n, err := NewRetentionRepository(db, nil).PurgeExpired(ctx, domain.RetentionSpeakerPhotoArchives, cutoff)
+		require.NoError(t, err)
+		require.Equal(t, int64(3), n)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("events are anonymized, not deleted", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT e.id FROM events e WHERE e.date < \$1 AND NOT EXISTS`).
+			WithArgs(cutoff).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("ev-1"))
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO event_anonymizations`).
+			WithArgs("ev-1", "").
+			WillReturnRows(sqlmock.NewRows([]string{"bool"}))
+		mock.ExpectQuery(`FROM event_anonymizations WHERE event_id = \$1`).
+			WithArgs("ev-1").
+			WillReturnRows(sqlmock.NewRows(eventAnonymizationCols).AddRow("ev-1", cutoff, "", 0, 0, 0, 0, 0, 0))
+		mock.ExpectCommit()
+		n, err := NewRetentionRepository(db, nil).PurgeExpired(ctx, domain.RetentionEventPersonalData, cutoff)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), n)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("unknown data class", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		_, err = NewRetentionRepository(db, nil).PurgeExpired(ctx, "audit_logs", cutoff)
+		require.Error(t, err)
+	})
+}
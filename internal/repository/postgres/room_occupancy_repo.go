@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+type roomOccupancyRepository struct {
+	DB *sql.DB
+}
+
+func NewRoomOccupancyRepository(db *sql.DB) domain.RoomOccupancyRepository {
+	return &roomOccupancyRepository{
+		DB: db,
+	}
+}
+
+func scanRoomOccupancy(row rowScanner) (*domain.RoomOccupancy, error) {
+	o := &domain.RoomOccupancy{}
+	var alertedAt sql.NullTime
+	if err := row.Scan(&o.RoomID, &o.Count, &alertedAt, &o.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if alertedAt.Valid {
+		o.AlertedAt = &alertedAt.Time
+	}
+	return o, nil
+}
+
+func (r *roomOccupancyRepository) Add(ctx context.Context, eventID, roomID string, delta int) (*domain.RoomOccupancy, error) {
+	return scanRoomOccupancy(r.DB.QueryRowContext(ctx, `
+		INSERT INTO room_occupancy (room_id, event_id, count)
+		VALUES ($1, $2, GREATEST($3, 0))
+		ON CONFLICT (room_id) DO UPDATE SET count = GREATEST(room_occupancy.count + $3, 0), updated_at = NOW()
+		RETURNING room_id, count, alerted_at, updated_at
+	`, roomID, eventID, delta))
+}
+
+func (r *roomOccupancyRepository) Set(ctx context.Context, eventID, roomID string, count int) (*domain.RoomOccupancy, error) {
+	return scanRoomOccupancy(r.DB.QueryRowContext(ctx, `
+		INSERT INTO room_occupancy (room_id, event_id, count)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (room_id) DO UPDATE SET count = $3, updated_at = NOW()
+		RETURNING room_id, count, alerted_at, updated_at
+	`, roomID, eventID, count))
+}
+
+func (r *roomOccupancyRepository) ListByEventID(ctx context.Context, eventID string) ([]*domain.RoomOccupancy, error) {
+	rows, err := r.DB.QueryContext(ctx, `
+		SELECT room_id, count, alerted_at, updated_at
+		FROM room_occupancy
+		WHERE event_id = $1
+	`, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	occupancy := []*domain.RoomOccupancy{}
+	for rows.Next() {
+		o, err := scanRoomOccupancy(rows)
+		if err != nil {
+			return nil, err
+		}
+		occupancy = append(occupancy, o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return occupancy, nil
+}
+
+func (r *roomOccupancyRepository) MarkAlerted(ctx context.Context, roomID string, at time.Time) (bool, error) {
+	res, err := r.DB.ExecContext(ctx, `
+		UPDATE room_occupancy SET alerted_at = $2
+		WHERE room_id = $1 AND alerted_at IS NULL
+	`, roomID, at)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (r *roomOccupancyRepository) ClearAlert(ctx context.Context, roomID string) error {
+	_, err := r.DB.ExecContext(ctx, `UPDATE room_occupancy SET alerted_at = NULL WHERE room_id = $1`, roomID)
+	return err
+}
@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"multitrackticketing/internal/domain"
+)
+
+var roomOccupancyCols = []string{"room_id", "count", "alerted_at", "updated_at"}
+
+func TestRoomOccupancyRepository_AddSet(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`INSERT INTO room_occupancy \(room_id, event_id, count\)\s+VALUES \(\$1, \$2, GREATEST\(\$3, 0\)\)\s+ON CONFLICT \(room_id\) DO UPDATE SET count = GREATEST\(room_occupancy.count \+ \$3, 0\)`).
+		WithArgs("room-1", "ev-1", -1).
+		WillReturnRows(sqlmock.NewRows(roomOccupancyCols).AddRow("room-1", 0, nil, at))
+	mock.ExpectQuery(`INSERT INTO room_occupancy \(room_id, event_id, count\)\s+VALUES \(\$1, \$2, \$3\)\s+ON CONFLICT \(room_id\) DO UPDATE SET count = \$3`).
+		WithArgs("room-1", "ev-1", 42).
+		WillReturnRows(sqlmock.NewRows(roomOccupancyCols).AddRow("room-1", 42, at, at))
+
+	repo := NewRoomOccupancyRepository(db)
+	got, err := repo.Add(ctx, "ev-1", "room-1", -1)
+	require.NoError(t, err)
+	require.Equal(t, &domain.RoomOccupancy{RoomID: "room-1", Count: 0, UpdatedAt: at}, got)
+
+	got, err = repo.Set(ctx, "ev-1", "room-1", 42)
+	require.NoError(t, err)
+	require.Equal(t, &domain.RoomOccupancy{RoomID: "room-1", Count: 42, UpdatedAt: at, AlertedAt: &at}, got)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRoomOccupancyRepository_Alerts(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec(`UPDATE room_occupancy SET alerted_at = \$2\s+WHERE room_id = \$1 AND alerted_at IS NULL`).
+		WithArgs("room-1", at).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE room_occupancy SET alerted_at = \$2`).
+		WithArgs("room-1", at).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`UPDATE room_occupancy SET alerted_at = NULL WHERE room_id = \$1`).
+		WithArgs("room-1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := NewRoomOccupancyRepository(db)
+	marked, err := repo.MarkAlerted(ctx, "room-1", at)
+	require.NoError(t, err)
+	require.True(t, marked)
+	marked, err = repo.MarkAlerted(ctx, "room-1", at)
+	require.NoError(t, err)
+	require.False(t, marked, "already alerted")
+	require.NoError(t, repo.ClearAlert(ctx, "room-1"))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
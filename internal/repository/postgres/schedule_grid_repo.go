@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"multitrackticketing/internal/domain"
+)
+
+type scheduleGridRepository struct {
+	DB *sql.DB
+}
+
+func NewScheduleGridRepository(db *sql.DB) domain.ScheduleGridRepository {
+	return &scheduleGridRepository{
+		DB: db,
+	}
+}
+
+func (r *scheduleGridRepository) Get(ctx context.Context, eventID string) (*domain.ScheduleGrid, error) {
+	var raw []byte
+	err := r.DB.QueryRowContext(ctx, `SELECT grid FROM event_schedule_grids WHERE event_id = $1`, eventID).Scan(&raw)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	grid := &domain.ScheduleGrid{}
+	if err := json.Unmarshal(raw, grid); err != nil {
+		return nil, err
+	}
+	return grid, nil
+}
+
+func (r *scheduleGridRepository) Save(ctx context.Context, grid *domain.ScheduleGrid) error {
+	raw, err := json.Marshal(grid)
+	if err != nil {
+		return err
+	}
+	_, err = r.DB.ExecContext(ctx, `
+		INSERT INTO event_schedule_grids (event_id, grid, built_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (event_id) DO UPDATE SET grid = EXCLUDED.grid, built_at = EXCLUDED.built_at
+	`, grid.EventID, raw, grid.BuiltAt)
+	return err
+}
+
+func (r *scheduleGridRepository) Delete(ctx context.Context, eventID string) error {
+	_, err := r.DB.ExecContext(ctx, `DELETE FROM event_schedule_grids WHERE event_id = $1`, eventID)
+	return err
+}
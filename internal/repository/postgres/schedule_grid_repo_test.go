@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleGridRepository_Get(t *testing.T) {
+	ctx := context.Background()
+	builtAt := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	grid := &domain.ScheduleGrid{
+		EventID:     "ev-1",
+		BuiltAt:     builtAt,
+		Timezone:    "UTC",
+		Rooms:       []*domain.ScheduleGridRoom{{ID: "room-1", Name: "Room A"}},
+		Days:        []*domain.ScheduleGridDay{},
+		Unscheduled: []*domain.ScheduleGridSession{},
+	}
+	raw, err := json.Marshal(grid)
+	require.NoError(t, err)
+
+	t.Run("found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT grid FROM event_schedule_grids WHERE event_id = \$1`).
+			WithArgs("ev-1").
+			WillReturnRows(sqlmock.NewRows([]string{"grid"}).AddRow(raw))
+		got, err := NewScheduleGridRepository(db).Get(ctx, "ev-1")
+		require.NoError(t, err)
+		require.Equal(t, grid, got)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM event_schedule_grids`).
+			WithArgs("ev-9").
+			WillReturnRows(sqlmock.NewRows([]string{"grid"}))
+		_, err = NewScheduleGridRepository(db).Get(ctx, "ev-9")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestScheduleGridRepository_SaveAndDelete(t *testing.T) {
+	ctx := context.Background()
+	builtAt := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	grid := &domain.ScheduleGrid{EventID: "ev-1", BuiltAt: builtAt, Timezone: "UTC"}
+	raw, err := json.Marshal(grid)
+	require.NoError(t, err)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec(`INSERT INTO event_schedule_grids .*ON CONFLICT \(event_id\) DO UPDATE`).
+		WithArgs("ev-1", raw, builtAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM event_schedule_grids WHERE event_id = \$1`).
+		WithArgs("ev-1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := NewScheduleGridRepository(db)
+	require.NoError(t, repo.Save(ctx, grid))
+	require.NoError(t, repo.Delete(ctx, "ev-1"))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+
+	"multitrackticketing/internal/domain"
+)
+
+type scheduleRulesRepository struct {
+	DB *sql.DB
+}
+
+func NewScheduleRulesRepository(db *sql.DB) domain.ScheduleRulesRepository {
+	return &scheduleRulesRepository{
+		DB: db,
+	}
+}
+
+func (r *scheduleRulesRepository) GetByEventID(ctx context.Context, eventID string) (*domain.ScheduleRules, error) {
+	query := `
+		SELECT event_id, allowed_durations, earliest_start, latest_end, allowed_days, timezone, updated_at
+		FROM event_schedule_rules
+		WHERE event_id = $1
+	`
+	rules := &domain.ScheduleRules{}
+	var durations pq.Int64Array
+	var days pq.StringArray
+	err := r.DB.QueryRowContext(ctx, query, eventID).Scan(
+		&rules.EventID, &durations, &rules.EarliestStart, &rules.LatestEnd, &days, &rules.Timezone, &rules.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	rules.AllowedDurations = make([]int, len(durations))
+	for i, d := range durations {
+		rules.AllowedDurations[i] = int(d)
+	}
+	rules.AllowedDays = []string(days)
+	if rules.AllowedDays == nil {
+		rules.AllowedDays = []string{}
+	}
+	return rules, nil
+}
+
+func (r *scheduleRulesRepository) Upsert(ctx context.Context, rules *domain.ScheduleRules) error {
+	durations := make(pq.Int64Array, len(rules.AllowedDurations))
+	for i, d := range rules.AllowedDurations {
+		durations[i] = int64(d)
+	}
+	days := rules.AllowedDays
+	if days == nil {
+		days = []string{}
+	}
+	query := `
+		INSERT INTO event_schedule_rules (event_id, allowed_durations, earliest_start, latest_end, allowed_days, timezone, updated_at)
+		VALUES ($1, $2, $3, $4, $5::date[], $6, NOW())
+		ON CONFLICT (event_id) DO UPDATE SET
+			allowed_durations = EXCLUDED.allowed_durations,
+			earliest_start = EXCLUDED.earliest_start,
+			latest_end = EXCLUDED.latest_end,
+			allowed_days = EXCLUDED.allowed_days,
+			timezone = EXCLUDED.timezone,
+			updated_at = EXCLUDED.updated_at
+		RETURNING updated_at
+	`
+	return r.DB.QueryRowContext(ctx, query, rules.EventID, durations, rules.EarliestStart, rules.LatestEnd, pq.Array(days), rules.Timezone).
+		Scan(&rules.UpdatedAt)
+}
@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"multitrackticketing/internal/domain"
+)
+
+func TestScheduleRulesRepository_GetByEventID(t *testing.T) {
+	ctx := context.Background()
+	updatedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	columns := []string{"event_id", "allowed_durations", "earliest_start", "latest_end", "allowed_days", "timezone", "updated_at"}
+
+	tests := []struct {
+		name    string
+		mock    func(mock sqlmock.Sqlmock)
+		want    *domain.ScheduleRules
+		wantErr error
+	}{
+		{
+			name: "found",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT event_id, allowed_durations, earliest_start, latest_end, allowed_days, timezone, updated_at`).
+					WithArgs("ev-1").
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow("ev-1", "{30,45}", "09:00", "19:30", "{2026-05-14,2026-05-15}", "Europe/Madrid", updatedAt))
+			},
+			want: &domain.ScheduleRules{
+				EventID:          "ev-1",
+				AllowedDurations: []int{30, 45},
+				EarliestStart:    "09:00",
+				LatestEnd:        "19:30",
+				AllowedDays:      []string{"2026-05-14", "2026-05-15"},
+				Timezone:         "Europe/Madrid",
+				UpdatedAt:        updatedAt,
+			},
+		},
+		{
+			name: "empty columns become empty collections",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT event_id, allowed_durations`).
+					WithArgs("ev-1").
+					WillReturnRows(sqlmock.NewRows(columns).AddRow("ev-1", "{}", "", "", "{}", "", updatedAt))
+			},
+			want: &domain.ScheduleRules{
+				EventID:          "ev-1",
+				AllowedDurations: []int{},
+				AllowedDays:      []string{},
+				UpdatedAt:        updatedAt,
+			},
+		},
+		{
+			name: "not found",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT event_id, allowed_durations`).
+					WithArgs("ev-1").
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+			tt.mock(mock)
+			repo := NewScheduleRulesRepository(db)
+
+			got, err := repo.GetByEventID(ctx, "ev-1")
+
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "got %v", err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestScheduleRulesRepository_Upsert(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	updatedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`INSERT INTO event_schedule_rules`).
+		WithArgs("ev-1", pq.Int64Array{30, 60}, "09:00", "", pq.Array([]string{}), "").
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(updatedAt))
+	repo := NewScheduleRulesRepository(db)
+	rules := &domain.ScheduleRules{
+		EventID:          "ev-1",
+		AllowedDurations: []int{30, 60},
+		EarliestStart:    "09:00",
+	}
+
+	err = repo.Upsert(context.Background(), rules)
+
+	require.NoError(t, err)
+	assert.Equal(t, updatedAt, rules.UpdatedAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
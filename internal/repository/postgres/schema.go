@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"multitrackticketing/internal/domain"
+)
+
+// SchemaVersion is the newest migration the queries in this package are written against. Raise it
+// with every migration; TestSchemaRegistry fails until it matches the migrations directory.
+const SchemaVersion = 36
+
+// schemaTables registers every table the queries in this package use, with the migration that
+// created it; 0 marks tables migrate itself manages. TestSchemaRegistry checks each query's tables
+// against it, so a query cannot ship before the migration it needs.
+var schemaTables = map[string]int64{
+	"schema_migrations":             0,
+	"users":                         1,
+	"roles":                         1,
+	"user_roles":                    1,
+	"events":                        1,
+	"rooms":                         1,
+	"sessions":                      1,
+	"tags":                          1,
+	"event_tags":                    1,
+	"session_tags":                  1,
+	"event_team_members":            1,
+	"event_registrations":           1,
+	"speakers":                      1,
+	"session_speakers":              1,
+	"login_codes":                   1,
+	"event_invitations":             1,
+	"event_import_mappings":         2,
+	"speaker_merge_candidates":      3,
+	"event_schedule_rules":          5,
+	"event_operating_hours":         6,
+	"session_changes":               8,
+	"event_checklist_items":         9,
+	"session_checklist_completions": 9,
+	"event_schedule_grids":          10,
+	"sync_tombstones":               11,
+	"event_custom_fields":           12,
+	"session_custom_field_values":   12,
+	"speaker_custom_field_values":   12,
+	"event_invitation_domain_rules": 14,
+	"event_anonymizations":          15,
+	"event_themes":                  16,
+	"event_emails":                  17,
+	"announcements":                 18,
+	"announcement_reads":            18,
+	"contact_threads":               19,
+	"contact_messages":              19,
+	"abuse_reports":                 20,
+	"content_unlistings":            20,
+	"user_ip_allowlists":            21,
+	"machine_clients":               22,
+	"user_activity":                 23,
+	"event_deletion_requests":       24,
+	"exhibitors":                    25,
+	"exhibitor_staff":               25,
+	"lead_consents":                 25,
+	"exhibitor_leads":               25,
+	"invitation_warmups":            26,
+	"invitation_warmup_recipients":  26,
+	"room_occupancy":                27,
+	"event_documents":               29,
+	"event_document_versions":       29,
+	"event_document_acceptances":    29,
+	"speaker_photo_archives":        32,
+	"session_stream_links":          33,
+	"machine_client_ip_allowlists":  34,
+	"event_tracks":                  35,
+}
+
+type schemaRepository struct {
+	DB *sql.DB
+}
+
+// NewSchemaRepository returns a domain.SchemaRepository that reads migrate's schema_migrations.
+func NewSchemaRepository(db *sql.DB) domain.SchemaRepository {
+	return &schemaRepository{DB: db}
+}
+
+func (r *schemaRepository) SchemaStatus(ctx context.Context) (*domain.SchemaStatus, error) {
+	var s domain.SchemaStatus
+	err := r.DB.QueryRowContext(ctx, `SELECT version, dirty FROM schema_migrations LIMIT 1`).Scan(&s.Version, &s.Dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &domain.SchemaStatus{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read schema_migrations: %w", err)
+	}
+	return &s, nil
+}
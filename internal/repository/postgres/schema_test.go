@@ -0,0 +1,142 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	createTableRe = regexp.MustCompile(`(?i)CREATE TABLE (?:IF NOT EXISTS )?([a-z_]+)`)
+	tableRefRe    = regexp.MustCompile(`(?i)\b(?:FROM|JOIN|INTO|UPDATE)\s+([a-z_]+)`)
+	cteRe         = regexp.MustCompile(`(?i)\b([a-z_]+)\s+AS\s*\(`)
+	sqlCommentRe  = regexp.MustCompile(`--[^\n]*`)
+	// sqlKeywords follow FROM, JOIN or UPDATE without naming a table.
+	sqlKeywords = map[string]bool{"set": true, "lateral": true}
+)
+
+// TestSchemaRegistry is the CI check behind SchemaVersion: it fails when SchemaVersion is not the
+// newest migration, when a registered table is not created by its migration, and when a query
+// uses a table missing from schemaTables.
+func TestSchemaRegistry(t *testing.T) {
+	migrations, err := filepath.Glob(filepath.Join("..", "..", "..", "migrations", "*.up.sql"))
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+
+	created := make(map[string]int64)
+	var newest int64
+	for _, path := range migrations {
+		prefix, _, _ := strings.Cut(filepath.Base(path), "_")
+		version, err := strconv.ParseInt(prefix, 10, 64)
+		require.NoError(t, err, path)
+		newest = max(newest, version)
+		body, err := os.ReadFile(path)
+		require.NoError(t, err)
+		for _, m := range createTableRe.FindAllStringSubmatch(string(body), -1) {
+			created[strings.ToLower(m[1])] = version
+		}
+	}
+	assert.Equal(t, newest, int64(SchemaVersion), "set SchemaVersion to the newest migration")
+
+	for table, version := range schemaTables {
+		assert.LessOrEqual(t, version, int64(SchemaVersion), table)
+		if version == 0 {
+			continue
+		}
+		assert.Equal(t, created[table], version, "table %s is not created by migration %d", table, version)
+	}
+
+	for table := range queriedTables(t) {
+		_, ok := schemaTables[table]
+		assert.True(t, ok, "table %s is queried but not registered in schemaTables", table)
+	}
+}
+
+// queriedTables returns the tables named in the string literals of this package's non-test files.
+// Queries are sometimes built from several literals, so CTE names are collected package-wide.
+func queriedTables(t *testing.T) map[string]bool {
+	files, err := filepath.Glob("*.go")
+	require.NoError(t, err)
+	var queries []string
+	fset := token.NewFileSet()
+	for _, path := range files {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		require.NoError(t, err)
+		ast.Inspect(f, func(n ast.Node) bool {
+			if lit, ok := n.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+				if query, err := strconv.Unquote(lit.Value); err == nil {
+					queries = append(queries, sqlCommentRe.ReplaceAllString(query, ""))
+				}
+			}
+			return true
+		})
+	}
+	ctes := make(map[string]bool)
+	for _, query := range queries {
+		for _, m := range cteRe.FindAllStringSubmatch(query, -1) {
+			ctes[strings.ToLower(m[1])] = true
+		}
+	}
+	tables := make(map[string]bool)
+	for _, query := range queries {
+		for _, m := range tableRefRe.FindAllStringSubmatch(query, -1) {
+			if name := strings.ToLower(m[1]); !sqlKeywords[name] && !ctes[name] {
+				tables[name] = true
+			}
+		}
+	}
+	return tables
+}
+
+func TestSchemaRepository_SchemaStatus(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(mock sqlmock.Sqlmock)
+		want  *domain.SchemaStatus
+	}{
+		{
+			name: "migrated",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT version, dirty FROM schema_migrations`).WillReturnRows(sqlmock.NewRows([]string{"version", "dirty"}).AddRow(24, false))
+			},
+			want: &domain.SchemaStatus{Version: 24},
+		},
+		{
+			name: "never migrated",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT version, dirty FROM schema_migrations`).WillReturnError(sql.ErrNoRows)
+			},
+			want: &domain.SchemaStatus{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+			tt.setup(mock)
+
+			got, err := NewSchemaRepository(db).SchemaStatus(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+type sessionChangeRepository struct {
+	DB *sql.DB
+}
+
+func NewSessionChangeRepository(db *sql.DB) domain.SessionChangeRepository {
+	return &sessionChangeRepository{
+		DB: db,
+	}
+}
+
+// sessionChangeSelect reads changes with the session's current title; callers append WHERE and ORDER BY.
+const sessionChangeSelect = `
+	SELECT c.id, c.event_id, c.session_id, s.title,
+		COALESCE(c.from_room_id::text, ''), c.from_room_name, COALESCE(c.to_room_id::text, ''), c.to_room_name,
+		c.from_start_time, c.from_end_time, c.to_start_time, c.to_end_time, c.changed_at
+	FROM session_changes c
+	JOIN sessions s ON s.id = c.session_id
+`
+
+func (r *sessionChangeRepository) Create(ctx context.Context, c *domain.SessionChange) error {
+	query := `
+		INSERT INTO session_changes (event_id, session_id, from_room_id, from_room_name, to_room_id, to_room_name,
+			from_start_time, from_end_time, to_start_time, to_end_time)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id, changed_at
+	`
+	return r.DB.QueryRowContext(ctx, query, c.EventID, c.SessionID,
+		nullableRoomID(c.FromRoomID), c.FromRoomName, nullableRoomID(c.ToRoomID), c.ToRoomName,
+		c.FromStartTime, c.FromEndTime, c.ToStartTime, c.ToEndTime).Scan(&c.ID, &c.ChangedAt)
+}
+
+func (r *sessionChangeRepository) ListBySessionID(ctx context.Context, sessionID string) ([]*domain.SessionChange, error) {
+	return r.list(ctx, sessionChangeSelect+`WHERE c.session_id = $1 ORDER BY c.changed_at, c.id`, sessionID)
+}
+
+func (r *sessionChangeRepository) ListByEventID(ctx context.Context, eventID string, since time.Time, limit int) ([]*domain.SessionChange, error) {
+	return r.list(ctx, sessionChangeSelect+`WHERE c.event_id = $1 AND c.changed_at > $2 ORDER BY c.changed_at DESC, c.id LIMIT $3`, eventID, since, limit)
+}
+
+func (r *sessionChangeRepository) ListAfter(ctx context.Context, eventID string, after time.Time) ([]*domain.SessionChange, error) {
+	return r.list(ctx, sessionChangeSelect+`WHERE c.event_id = $1 AND c.changed_at > $2 ORDER BY c.changed_at, c.id`, eventID, after)
+}
+
+func (r *sessionChangeRepository) ListDeletedSessionIDs(ctx context.Context, eventID string, after time.Time) ([]string, error) {
+	rows, err := r.DB.QueryContext(ctx, `
+		SELECT entity_id FROM sync_tombstones
+		WHERE event_id = $1 AND entity_type = 'session' AND deleted_at > $2
+		ORDER BY deleted_at, entity_id
+	`, eventID, after)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (r *sessionChangeRepository) list(ctx context.Context, query string, args ...any) ([]*domain.SessionChange, error) {
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	changes := []*domain.SessionChange{}
+	for rows.Next() {
+		c := &domain.SessionChange{}
+		if err := rows.Scan(&c.ID, &c.EventID, &c.SessionID, &c.SessionTitle,
+			&c.FromRoomID, &c.FromRoomName, &c.ToRoomID, &c.ToRoomName,
+			&c.FromStartTime, &c.FromEndTime, &c.ToStartTime, &c.ToEndTime, &c.ChangedAt); err != nil {
+			return nil, err
+		}
+		changes = append(changes, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+var sessionChangeCols = []string{"id", "event_id", "session_id", "title", "from_room_id", "from_room_name", "to_room_id", "to_room_name",
+	"from_start_time", "from_end_time", "to_start_time", "to_end_time", "changed_at"}
+
+func TestSessionChangeRepository_Create(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	changedAt := time.Date(2025, 2, 20, 8, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// An unscheduled session has no room; its ID is stored as NULL.
+	mock.ExpectQuery(`INSERT INTO session_changes`).
+		WithArgs("ev-1", "sess-1", "room-1", "Room A", nil, "", start, start.Add(time.Hour), start, start.Add(time.Hour)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "changed_at"}).AddRow("change-1", changedAt))
+
+	c := &domain.SessionChange{
+		EventID: "ev-1", SessionID: "sess-1", FromRoomID: "room-1", FromRoomName: "Room A",
+		FromStartTime: start, FromEndTime: start.Add(time.Hour), ToStartTime: start, ToEndTime: start.Add(time.Hour),
+	}
+	require.NoError(t, NewSessionChangeRepository(db).Create(ctx, c))
+	require.Equal(t, "change-1", c.ID)
+	require.Equal(t, changedAt, c.ChangedAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSessionChangeRepository_ListBySessionID(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	changedAt := time.Date(2025, 2, 20, 8, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM session_changes c\s+JOIN sessions s ON s.id = c.session_id\s+WHERE c.session_id = \$1 ORDER BY c.changed_at, c.id`).
+		WithArgs("sess-1").
+		WillReturnRows(sqlmock.NewRows(sessionChangeCols).
+			AddRow("change-1", "ev-1", "sess-1", "Keynote", "room-1", "Room A", "room-2", "Room B",
+				start, start.Add(time.Hour), start, start.Add(time.Hour), changedAt))
+
+	got, err := NewSessionChangeRepository(db).ListBySessionID(ctx, "sess-1")
+	require.NoError(t, err)
+	require.Equal(t, []*domain.SessionChange{{
+		ID: "change-1", EventID: "ev-1", SessionID: "sess-1", SessionTitle: "Keynote",
+		FromRoomID: "room-1", FromRoomName: "Room A", ToRoomID: "room-2", ToRoomName: "Room B",
+		FromStartTime: start, FromEndTime: start.Add(time.Hour), ToStartTime: start, ToEndTime: start.Add(time.Hour),
+		ChangedAt: changedAt,
+	}}, got)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSessionChangeRepository_ListByEventID(t *testing.T) {
+	ctx := context.Background()
+	since := time.Date(2025, 2, 20, 0, 0, 0, 0, time.UTC)
+
+	t.Run("empty", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`WHERE c.event_id = \$1 AND c.changed_at > \$2 ORDER BY c.changed_at DESC, c.id LIMIT \$3`).
+			WithArgs("ev-1", since, 50).
+			WillReturnRows(sqlmock.NewRows(sessionChangeCols))
+		got, err := NewSessionChangeRepository(db).ListByEventID(ctx, "ev-1", since, 50)
+		require.NoError(t, err)
+		require.Equal(t, []*domain.SessionChange{}, got)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM session_changes c`).WillReturnError(sql.ErrConnDone)
+		_, err = NewSessionChangeRepository(db).ListByEventID(ctx, "ev-1", since, 50)
+		require.Error(t, err)
+	})
+}
+
+func TestSessionChangeRepository_ListAfter(t *testing.T) {
+	ctx := context.Background()
+	after := time.Date(2025, 2, 20, 0, 0, 0, 0, time.UTC)
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`WHERE c.event_id = \$1 AND c.changed_at > \$2 ORDER BY c.changed_at, c.id$`).
+		WithArgs("ev-1", after).
+		WillReturnRows(sqlmock.NewRows(sessionChangeCols).
+			AddRow("change-1", "ev-1", "sess-1", "Keynote", "room-1", "Room A", "room-2", "Room B", after, after, after, after, after.Add(time.Hour)))
+	got, err := NewSessionChangeRepository(db).ListAfter(ctx, "ev-1", after)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, "room-1", got[0].FromRoomID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSessionChangeRepository_ListDeletedSessionIDs(t *testing.T) {
+	ctx := context.Background()
+	after := time.Date(2025, 2, 20, 0, 0, 0, 0, time.UTC)
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM sync_tombstones\s+WHERE event_id = \$1 AND entity_type = 'session' AND deleted_at > \$2`).
+		WithArgs("ev-1", after).
+		WillReturnRows(sqlmock.NewRows([]string{"entity_id"}).AddRow("sess-1").AddRow("sess-2"))
+	got, err := NewSessionChangeRepository(db).ListDeletedSessionIDs(ctx, "ev-1", after)
+	require.NoError(t, err)
+	require.Equal(t, []string{"sess-1", "sess-2"}, got)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
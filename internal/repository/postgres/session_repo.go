@@ -21,36 +21,36 @@ func NewSessionRepository(db *sql.DB) domain.SessionRepository {
 
 func (r *SessionRepository) CreateRoom(ctx context.Context, room *domain.Room) error {
 	query := `
-		INSERT INTO rooms (event_id, name, source_session_id, source, not_bookable, capacity, description, how_to_get_there, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		INSERT INTO rooms (event_id, name, source_session_id, source, not_bookable, capacity, description, how_to_get_there, created_at, updated_at, display_order)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, (SELECT COALESCE(MAX(display_order), 0) + 1 FROM rooms WHERE event_id = $1))
 		ON CONFLICT (event_id, source_session_id) DO UPDATE 
 		SET name = EXCLUDED.name, source = EXCLUDED.source, not_bookable = EXCLUDED.not_bookable, capacity = EXCLUDED.capacity, description = EXCLUDED.description, how_to_get_there = EXCLUDED.how_to_get_there, updated_at = EXCLUDED.updated_at
-		RETURNING id
+		RETURNING id, display_order
 	`
-	return r.DB.QueryRowContext(ctx, query, room.EventID, room.Name, room.SourceSessionID, room.Source, room.NotBookable, room.Capacity, room.Description, room.HowToGetThere, room.CreatedAt, room.UpdatedAt).Scan(&room.ID)
+	return r.DB.QueryRowContext(ctx, query, room.EventID, room.Name, room.SourceSessionID, room.Source, room.NotBookable, room.Capacity, room.Description, room.HowToGetThere, room.CreatedAt, room.UpdatedAt).Scan(&room.ID, &room.DisplayOrder)
 }
 
 func (r *SessionRepository) CreateSession(ctx context.Context, s *domain.Session) error {
 	query := `
-		INSERT INTO sessions (room_id, source_session_id, source, title, start_time, end_time, description, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-		ON CONFLICT (room_id, source_session_id) DO UPDATE 
-		SET source = EXCLUDED.source, title = EXCLUDED.title, start_time = EXCLUDED.start_time, end_time = EXCLUDED.end_time, description = EXCLUDED.description, updated_at = EXCLUDED.updated_at
+		INSERT INTO sessions (event_id, room_id, source_session_id, source, title, start_time, end_time, description, session_type, track, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (event_id, source_session_id) DO UPDATE 
+		SET room_id = EXCLUDED.room_id, source = EXCLUDED.source, title = EXCLUDED.title, start_time = EXCLUDED.start_time, end_time = EXCLUDED.end_time, description = EXCLUDED.description, session_type = EXCLUDED.session_type, track = EXCLUDED.track, updated_at = EXCLUDED.updated_at
 		RETURNING id
 	`
-	return r.DB.QueryRowContext(ctx, query, s.RoomID, s.SourceSessionID, s.Source, s.Title, s.StartTime, s.EndTime, s.Description, s.CreatedAt, s.UpdatedAt).Scan(&s.ID)
+	return r.DB.QueryRowContext(ctx, query, s.EventID, nullableRoomID(s.RoomID), s.SourceSessionID, s.Source, s.Title, s.StartTime, s.EndTime, s.Description, s.SessionType, s.Track, s.CreatedAt, s.UpdatedAt).Scan(&s.ID)
 }
 
 func (r *SessionRepository) CreateSpeaker(ctx context.Context, speaker *domain.Speaker) error {
 	query := `
-		INSERT INTO speakers (event_id, source_session_id, source, first_name, last_name, bio, tag_line, profile_picture, is_top_speaker, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		INSERT INTO speakers (event_id, source_session_id, source, first_name, last_name, email, bio, tag_line, profile_picture, is_top_speaker, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 		ON CONFLICT (event_id, source_session_id) DO UPDATE
 		SET source = EXCLUDED.source, first_name = EXCLUDED.first_name, last_name = EXCLUDED.last_name, bio = EXCLUDED.bio, tag_line = EXCLUDED.tag_line, profile_picture = EXCLUDED.profile_picture, is_top_speaker = EXCLUDED.is_top_speaker, updated_at = EXCLUDED.updated_at
 		RETURNING id
 	`
 	return r.DB.QueryRowContext(ctx, query,
-		speaker.EventID, speaker.SourceSessionID, speaker.Source, speaker.FirstName, speaker.LastName,
+		speaker.EventID, speaker.SourceSessionID, speaker.Source, speaker.FirstName, speaker.LastName, speaker.Email,
 		speaker.Bio, speaker.TagLine, speaker.ProfilePicture, speaker.IsTopSpeaker, speaker.CreatedAt, speaker.UpdatedAt,
 	).Scan(&speaker.ID)
 }
@@ -66,26 +66,155 @@ func (r *SessionRepository) DeleteSessionSpeaker(ctx context.Context, sessionID,
 	return err
 }
 
-func (r *SessionRepository) DeleteScheduleByEventID(ctx context.Context, eventID string) error {
-	query := `DELETE FROM rooms WHERE event_id = $1`
-	_, err := r.DB.ExecContext(ctx, query, eventID)
+func (r *SessionRepository) SetSessionSpeakers(ctx context.Context, sessionID string, speakerIDs []string) error {
+	if _, err := r.DB.ExecContext(ctx, `DELETE FROM session_speakers WHERE session_id = $1`, sessionID); err != nil {
+		return err
+	}
+	for _, speakerID := range speakerIDs {
+		if _, err := r.DB.ExecContext(ctx, `INSERT INTO session_speakers (session_id, speaker_id) VALUES ($1, $2) ON CONFLICT (session_id, speaker_id) DO NOTHING`, sessionID, speakerID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nullableRoomID stores an unscheduled session's empty room ID as NULL.
+func nullableRoomID(roomID string) any {
+	if roomID == "" {
+		return nil
+	}
+	return roomID
+}
+
+// keepIDs returns ids as a uuid[] parameter. A nil slice would be sent as NULL, and
+// NOT (id = ANY(NULL)) matches no rows, so it is sent as an empty array instead.
+func keepIDs(ids []string) any {
+	if ids == nil {
+		ids = []string{}
+	}
+	return pq.Array(ids)
+}
+
+func (r *SessionRepository) PruneScheduleByEventID(ctx context.Context, eventID string, keepRoomIDs, keepSessionIDs []string) error {
+	query := `DELETE FROM sessions WHERE event_id = $1 AND NOT (id = ANY($2::uuid[]))`
+	if _, err := r.DB.ExecContext(ctx, query, eventID, keepIDs(keepSessionIDs)); err != nil {
+		return err
+	}
+	query = `DELETE FROM rooms WHERE event_id = $1 AND NOT (id = ANY($2::uuid[]))`
+	_, err := r.DB.ExecContext(ctx, query, eventID, keepIDs(keepRoomIDs))
 	return err
 }
 
-func (r *SessionRepository) DeleteSpeakersByEventID(ctx context.Context, eventID string) error {
-	query := `DELETE FROM speakers WHERE event_id = $1`
-	_, err := r.DB.ExecContext(ctx, query, eventID)
+func (r *SessionRepository) PruneSpeakersByEventID(ctx context.Context, eventID string, keepSpeakerIDs []string) error {
+	query := `DELETE FROM speakers WHERE event_id = $1 AND NOT (id = ANY($2::uuid[]))`
+	_, err := r.DB.ExecContext(ctx, query, eventID, keepIDs(keepSpeakerIDs))
 	return err
 }
 
+func (r *SessionRepository) UpdateSpeakerSource(ctx context.Context, speakerID, source, sourceSessionID string) error {
+	result, err := r.DB.ExecContext(ctx,
+		`UPDATE speakers SET source = $2, source_session_id = $3, updated_at = NOW() WHERE id = $1`,
+		speakerID, source, sourceSessionID)
+	if err != nil {
+		return err
+	}
+	n, _ := result.RowsAffected()
+	if n == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *SessionRepository) MergeSpeakers(ctx context.Context, keepID, dropID string) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Move the links before deleting dropID, which cascades to its session_speakers rows.
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO session_speakers (session_id, speaker_id)
+		SELECT session_id, $1 FROM session_speakers WHERE speaker_id = $2
+		ON CONFLICT (session_id, speaker_id) DO NOTHING
+	`, keepID, dropID); err != nil {
+		return err
+	}
+	dropped := &domain.Speaker{}
+	err = tx.QueryRowContext(ctx, `
+		DELETE FROM speakers WHERE id = $1
+		RETURNING source, source_session_id, first_name, last_name, email, bio, tag_line, profile_picture, is_top_speaker
+	`, dropID).Scan(
+		&dropped.Source, &dropped.SourceSessionID, &dropped.FirstName, &dropped.LastName, &dropped.Email,
+		&dropped.Bio, &dropped.TagLine, &dropped.ProfilePicture, &dropped.IsTopSpeaker,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.ErrNotFound
+		}
+		return err
+	}
+	result, err := tx.ExecContext(ctx, `
+		UPDATE speakers
+		SET source = $2, source_session_id = $3, first_name = $4, last_name = $5,
+			email = CASE WHEN email = '' THEN $6 ELSE email END,
+			bio = $7, tag_line = $8, profile_picture = $9, is_top_speaker = $10, updated_at = NOW()
+		WHERE id = $1
+	`, keepID, dropped.Source, dropped.SourceSessionID, dropped.FirstName, dropped.LastName, dropped.Email,
+		dropped.Bio, dropped.TagLine, dropped.ProfilePicture, dropped.IsTopSpeaker)
+	if err != nil {
+		return err
+	}
+	n, _ := result.RowsAffected()
+	if n == 0 {
+		return domain.ErrNotFound
+	}
+	return tx.Commit()
+}
+
+func (r *SessionRepository) UpdateSpeakers(ctx context.Context, updates []*domain.SpeakerUpdate) ([]*domain.Speaker, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE speakers
+		SET first_name = COALESCE($2, first_name), last_name = COALESCE($3, last_name), email = COALESCE($4, email),
+			bio = COALESCE($5, bio), tag_line = COALESCE($6, tag_line), profile_picture = COALESCE($7, profile_picture),
+			is_top_speaker = COALESCE($8, is_top_speaker), updated_at = NOW()
+		WHERE id = $1
+		RETURNING id, event_id, source_session_id, source, first_name, last_name, email, bio, tag_line, profile_picture, is_top_speaker, created_at, updated_at
+	`
+	speakers := make([]*domain.Speaker, 0, len(updates))
+	for _, u := range updates {
+		sp := &domain.Speaker{}
+		err := tx.QueryRowContext(ctx, query,
+			u.SpeakerID, u.FirstName, u.LastName, u.Email, u.Bio, u.TagLine, u.ProfilePicture, u.IsTopSpeaker,
+		).Scan(&sp.ID, &sp.EventID, &sp.SourceSessionID, &sp.Source, &sp.FirstName, &sp.LastName, &sp.Email, &sp.Bio, &sp.TagLine, &sp.ProfilePicture, &sp.IsTopSpeaker, &sp.CreatedAt, &sp.UpdatedAt)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, domain.ErrNotFound
+			}
+			return nil, err
+		}
+		speakers = append(speakers, sp)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return speakers, nil
+}
+
 func (r *SessionRepository) GetRoomByID(ctx context.Context, roomID string) (*domain.Room, error) {
 	query := `
-		SELECT id, event_id, name, source_session_id, source, not_bookable, capacity, description, how_to_get_there, created_at, updated_at
+		SELECT id, event_id, name, source_session_id, source, not_bookable, capacity, description, how_to_get_there, created_at, updated_at, display_order
 		FROM rooms
 		WHERE id = $1
 	`
 	room := &domain.Room{}
-	err := r.DB.QueryRowContext(ctx, query, roomID).Scan(&room.ID, &room.EventID, &room.Name, &room.SourceSessionID, &room.Source, &room.NotBookable, &room.Capacity, &room.Description, &room.HowToGetThere, &room.CreatedAt, &room.UpdatedAt)
+	err := r.DB.QueryRowContext(ctx, query, roomID).Scan(&room.ID, &room.EventID, &room.Name, &room.SourceSessionID, &room.Source, &room.NotBookable, &room.Capacity, &room.Description, &room.HowToGetThere, &room.CreatedAt, &room.UpdatedAt, &room.DisplayOrder)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, domain.ErrNotFound
@@ -97,10 +226,10 @@ func (r *SessionRepository) GetRoomByID(ctx context.Context, roomID string) (*do
 
 func (r *SessionRepository) ListRoomsByEventID(ctx context.Context, eventID string) ([]*domain.Room, error) {
 	query := `
-		SELECT id, event_id, name, source_session_id, source, not_bookable, capacity, description, how_to_get_there, created_at, updated_at
+		SELECT id, event_id, name, source_session_id, source, not_bookable, capacity, description, how_to_get_there, created_at, updated_at, display_order
 		FROM rooms
 		WHERE event_id = $1
-		ORDER BY name
+		ORDER BY display_order, name
 	`
 	rows, err := r.DB.QueryContext(ctx, query, eventID)
 	if err != nil {
@@ -110,7 +239,7 @@ func (r *SessionRepository) ListRoomsByEventID(ctx context.Context, eventID stri
 	var rooms []*domain.Room
 	for rows.Next() {
 		room := &domain.Room{}
-		if err := rows.Scan(&room.ID, &room.EventID, &room.Name, &room.SourceSessionID, &room.Source, &room.NotBookable, &room.Capacity, &room.Description, &room.HowToGetThere, &room.CreatedAt, &room.UpdatedAt); err != nil {
+		if err := rows.Scan(&room.ID, &room.EventID, &room.Name, &room.SourceSessionID, &room.Source, &room.NotBookable, &room.Capacity, &room.Description, &room.HowToGetThere, &room.CreatedAt, &room.UpdatedAt, &room.DisplayOrder); err != nil {
 			return nil, err
 		}
 		rooms = append(rooms, room)
@@ -118,15 +247,61 @@ func (r *SessionRepository) ListRoomsByEventID(ctx context.Context, eventID stri
 	return rooms, rows.Err()
 }
 
+func (r *SessionRepository) ReorderRooms(ctx context.Context, eventID string, roomIDs []string) error {
+	query := `
+		UPDATE rooms SET display_order = array_position($2::uuid[], id), updated_at = NOW()
+		WHERE event_id = $1 AND id = ANY($2::uuid[])
+	`
+	_, err := r.DB.ExecContext(ctx, query, eventID, pq.Array(roomIDs))
+	return err
+}
+
+func (r *SessionRepository) ListTrackOrder(ctx context.Context, eventID string) ([]string, error) {
+	rows, err := r.DB.QueryContext(ctx, `SELECT name FROM event_tracks WHERE event_id = $1 ORDER BY display_order`, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	tracks := []string{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		tracks = append(tracks, name)
+	}
+	return tracks, rows.Err()
+}
+
+func (r *SessionRepository) ReorderTracks(ctx context.Context, eventID string, tracks []string) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM event_tracks WHERE event_id = $1`, eventID); err != nil {
+		return err
+	}
+	query := `
+		INSERT INTO event_tracks (event_id, name, display_order)
+		SELECT $1, unnest($2::text[]), generate_subscripts($2::text[], 1)
+	`
+	if _, err := tx.ExecContext(ctx, query, eventID, pq.Array(tracks)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
 func (r *SessionRepository) SetRoomNotBookable(ctx context.Context, roomID string, notBookable bool) (*domain.Room, error) {
 	query := `
 		UPDATE rooms
 		SET not_bookable = $2, updated_at = NOW()
 		WHERE id = $1
-		RETURNING id, event_id, name, source_session_id, source, not_bookable, capacity, description, how_to_get_there, created_at, updated_at
+		RETURNING id, event_id, name, source_session_id, source, not_bookable, capacity, description, how_to_get_there, created_at, updated_at, display_order
 	`
 	room := &domain.Room{}
-	err := r.DB.QueryRowContext(ctx, query, roomID, notBookable).Scan(&room.ID, &room.EventID, &room.Name, &room.SourceSessionID, &room.Source, &room.NotBookable, &room.Capacity, &room.Description, &room.HowToGetThere, &room.CreatedAt, &room.UpdatedAt)
+	err := r.DB.QueryRowContext(ctx, query, roomID, notBookable).Scan(&room.ID, &room.EventID, &room.Name, &room.SourceSessionID, &room.Source, &room.NotBookable, &room.Capacity, &room.Description, &room.HowToGetThere, &room.CreatedAt, &room.UpdatedAt, &room.DisplayOrder)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, domain.ErrNotFound
@@ -141,10 +316,10 @@ func (r *SessionRepository) UpdateRoomDetails(ctx context.Context, roomID string
 		UPDATE rooms
 		SET name = $2, capacity = $3, description = $4, how_to_get_there = $5, not_bookable = $6, updated_at = NOW()
 		WHERE id = $1
-		RETURNING id, event_id, name, source_session_id, source, not_bookable, capacity, description, how_to_get_there, created_at, updated_at
+		RETURNING id, event_id, name, source_session_id, source, not_bookable, capacity, description, how_to_get_there, created_at, updated_at, display_order
 	`
 	room := &domain.Room{}
-	err := r.DB.QueryRowContext(ctx, query, roomID, name, capacity, description, howToGetThere, notBookable).Scan(&room.ID, &room.EventID, &room.Name, &room.SourceSessionID, &room.Source, &room.NotBookable, &room.Capacity, &room.Description, &room.HowToGetThere, &room.CreatedAt, &room.UpdatedAt)
+	err := r.DB.QueryRowContext(ctx, query, roomID, name, capacity, description, howToGetThere, notBookable).Scan(&room.ID, &room.EventID, &room.Name, &room.SourceSessionID, &room.Source, &room.NotBookable, &room.Capacity, &room.Description, &room.HowToGetThere, &room.CreatedAt, &room.UpdatedAt, &room.DisplayOrder)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, domain.ErrNotFound
@@ -166,6 +341,27 @@ func (r *SessionRepository) DeleteRoom(ctx context.Context, roomID string) error
 	return nil
 }
 
+func (r *SessionRepository) DeleteRoomKeepSessions(ctx context.Context, roomID string, targetRoomID *string) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `UPDATE sessions SET room_id = $2, updated_at = NOW() WHERE room_id = $1`, roomID, targetRoomID); err != nil {
+		return err
+	}
+	result, err := tx.ExecContext(ctx, `DELETE FROM rooms WHERE id = $1`, roomID)
+	if err != nil {
+		return err
+	}
+	n, _ := result.RowsAffected()
+	if n == 0 {
+		return domain.ErrNotFound
+	}
+	return tx.Commit()
+}
+
 func (r *SessionRepository) DeleteSession(ctx context.Context, sessionID string) error {
 	result, err := r.DB.ExecContext(ctx, `DELETE FROM sessions WHERE id = $1`, sessionID)
 	if err != nil {
@@ -180,13 +376,14 @@ func (r *SessionRepository) DeleteSession(ctx context.Context, sessionID string)
 
 func (r *SessionRepository) GetSessionByID(ctx context.Context, sessionID string) (*domain.Session, error) {
 	query := `
-		SELECT id, room_id, source_session_id, source, title, start_time, end_time, description, created_at, updated_at
+		SELECT id, event_id, COALESCE(room_id::text, ''), source_session_id, source, title, start_time, end_time, description, session_type, track, created_at, updated_at
 		FROM sessions
 		WHERE id = $1
 	`
 	sess := &domain.Session{}
 	err := r.DB.QueryRowContext(ctx, query, sessionID).Scan(
 		&sess.ID,
+		&sess.EventID,
 		&sess.RoomID,
 		&sess.SourceSessionID,
 		&sess.Source,
@@ -194,6 +391,8 @@ func (r *SessionRepository) GetSessionByID(ctx context.Context, sessionID string
 		&sess.StartTime,
 		&sess.EndTime,
 		&sess.Description,
+		&sess.SessionType,
+		&sess.Track,
 		&sess.CreatedAt,
 		&sess.UpdatedAt,
 	)
@@ -232,10 +431,9 @@ func (r *SessionRepository) GetSessionByID(ctx context.Context, sessionID string
 
 func (r *SessionRepository) ListSessionsByEventID(ctx context.Context, eventID string) ([]*domain.Session, error) {
 	query := `
-		SELECT s.id, s.room_id, s.source_session_id, s.source, s.title, s.start_time, s.end_time, s.description, s.created_at, s.updated_at
+		SELECT s.id, s.event_id, COALESCE(s.room_id::text, ''), s.source_session_id, s.source, s.title, s.start_time, s.end_time, s.description, s.session_type, s.track, s.created_at, s.updated_at
 		FROM sessions s
-		INNER JOIN rooms r ON r.id = s.room_id
-		WHERE r.event_id = $1
+		WHERE s.event_id = $1
 		ORDER BY s.start_time, s.room_id
 	`
 	rows, err := r.DB.QueryContext(ctx, query, eventID)
@@ -247,7 +445,7 @@ func (r *SessionRepository) ListSessionsByEventID(ctx context.Context, eventID s
 	var sessionIDs []string
 	for rows.Next() {
 		sess := &domain.Session{}
-		if err := rows.Scan(&sess.ID, &sess.RoomID, &sess.SourceSessionID, &sess.Source, &sess.Title, &sess.StartTime, &sess.EndTime, &sess.Description, &sess.CreatedAt, &sess.UpdatedAt); err != nil {
+		if err := rows.Scan(&sess.ID, &sess.EventID, &sess.RoomID, &sess.SourceSessionID, &sess.Source, &sess.Title, &sess.StartTime, &sess.EndTime, &sess.Description, &sess.SessionType, &sess.Track, &sess.CreatedAt, &sess.UpdatedAt); err != nil {
 			return nil, err
 		}
 		sess.Tags = []*domain.Tag{}
@@ -261,28 +459,89 @@ func (r *SessionRepository) ListSessionsByEventID(ctx context.Context, eventID s
 	if len(sessionIDs) == 0 {
 		return sessions, nil
 	}
-	tagRows, err := r.DB.QueryContext(ctx, `SELECT st.session_id, t.id, t.name FROM session_tags st JOIN tags t ON t.id = st.tag_id WHERE st.session_id = ANY($1)`, pq.Array(sessionIDs))
-	if err != nil {
+	if err := r.loadSessionTags(ctx, sessions, sessionIDs); err != nil {
 		return nil, err
 	}
-	defer tagRows.Close()
-	tagsBySession := make(map[string][]*domain.Tag)
-	for tagRows.Next() {
-		var sid, tagID, tagName string
-		if err := tagRows.Scan(&sid, &tagID, &tagName); err != nil {
-			return nil, err
+	return sessions, nil
+}
+
+func (r *SessionRepository) StreamSessionsByEventID(ctx context.Context, eventID string, fn func(*domain.Session) error) error {
+	// Tags and speakers are aggregated per row so each session is complete when it is read.
+	query := `
+		SELECT s.id, s.event_id, COALESCE(s.room_id::text, ''), s.source_session_id, s.source, s.title, s.start_time, s.end_time, s.description, s.session_type, s.track, s.created_at, s.updated_at,
+			COALESCE(tg.ids, '{}'), COALESCE(tg.names, '{}'), COALESCE(sp.ids, '{}')
+		FROM sessions s
+		LEFT JOIN LATERAL (
+			SELECT array_agg(t.id::text ORDER BY t.name) AS ids, array_agg(t.name ORDER BY t.name) AS names
+			FROM session_tags st JOIN tags t ON t.id = st.tag_id
+			WHERE st.session_id = s.id
+		) tg ON true
+		LEFT JOIN LATERAL (
+			SELECT array_agg(ss.speaker_id::text ORDER BY ss.speaker_id) AS ids
+			FROM session_speakers ss
+			WHERE ss.session_id = s.id
+		) sp ON true
+		WHERE s.event_id = $1
+		ORDER BY s.start_time, s.room_id
+	`
+	rows, err := r.DB.QueryContext(ctx, query, eventID)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		sess := &domain.Session{}
+		var tagIDs, tagNames, speakerIDs pq.StringArray
+		if err := rows.Scan(&sess.ID, &sess.EventID, &sess.RoomID, &sess.SourceSessionID, &sess.Source, &sess.Title, &sess.StartTime, &sess.EndTime, &sess.Description, &sess.SessionType, &sess.Track, &sess.CreatedAt, &sess.UpdatedAt, &tagIDs, &tagNames, &speakerIDs); err != nil {
+			return err
+		}
+		sess.Tags = make([]*domain.Tag, len(tagIDs))
+		for i := range tagIDs {
+			sess.Tags[i] = &domain.Tag{ID: tagIDs[i], Name: tagNames[i]}
+		}
+		sess.SpeakerIDs = []string(speakerIDs)
+		if sess.SpeakerIDs == nil {
+			sess.SpeakerIDs = []string{}
+		}
+		if err := fn(sess); err != nil {
+			return err
 		}
-		tagsBySession[sid] = append(tagsBySession[sid], &domain.Tag{ID: tagID, Name: tagName})
 	}
-	if err := tagRows.Err(); err != nil {
-		return nil, err
+	return rows.Err()
+}
+
+// loadSessionTags sets Tags on each session in one query. Each session gets its own *domain.Tag, so
+// changing one session's tag leaves the others alone, but tags seen before reuse their ID and name
+// strings and row keys are scanned as sql.RawBytes; schedules of large events repeat a handful of
+// tags across hundreds of sessions.
+func (r *SessionRepository) loadSessionTags(ctx context.Context, sessions []*domain.Session, sessionIDs []string) error {
+	rows, err := r.DB.QueryContext(ctx, `SELECT st.session_id, t.id, t.name FROM session_tags st JOIN tags t ON t.id = st.tag_id WHERE st.session_id = ANY($1)`, pq.Array(sessionIDs))
+	if err != nil {
+		return err
 	}
+	defer rows.Close()
+	byID := make(map[string]*domain.Session, len(sessions))
 	for _, sess := range sessions {
-		if t := tagsBySession[sess.ID]; t != nil {
-			sess.Tags = t
+		byID[sess.ID] = sess
+	}
+	tags := make(map[string]domain.Tag)
+	var sid, tagID, tagName sql.RawBytes
+	for rows.Next() {
+		if err := rows.Scan(&sid, &tagID, &tagName); err != nil {
+			return err
+		}
+		sess, ok := byID[string(sid)]
+		if !ok {
+			continue
 		}
+		tag, ok := tags[string(tagID)]
+		if !ok {
+			tag = domain.Tag{ID: string(tagID), Name: string(tagName)}
+			tags[tag.ID] = tag
+		}
+		sess.Tags = append(sess.Tags, &tag)
 	}
-	return sessions, nil
+	return rows.Err()
 }
 
 // ListSpeakerIDsBySessionIDs returns for each session ID the list of speaker IDs (order preserved).
@@ -295,13 +554,23 @@ func (r *SessionRepository) ListSpeakerIDsBySessionIDs(ctx context.Context, sess
 		return nil, err
 	}
 	defer rows.Close()
-	out := make(map[string][]string)
+	// Key the map with the caller's ID strings so only speaker IDs are allocated per row.
+	keys := make(map[string]string, len(sessionIDs))
+	for _, id := range sessionIDs {
+		keys[id] = id
+	}
+	out := make(map[string][]string, len(sessionIDs))
+	var sessionID sql.RawBytes
 	for rows.Next() {
-		var sessionID, speakerID string
+		var speakerID string
 		if err := rows.Scan(&sessionID, &speakerID); err != nil {
 			return nil, err
 		}
-		out[sessionID] = append(out[sessionID], speakerID)
+		key, ok := keys[string(sessionID)]
+		if !ok {
+			continue
+		}
+		out[key] = append(out[key], speakerID)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -311,7 +580,7 @@ func (r *SessionRepository) ListSpeakerIDsBySessionIDs(ctx context.Context, sess
 
 func (r *SessionRepository) GetSpeakerByID(ctx context.Context, speakerID string) (*domain.Speaker, error) {
 	query := `
-		SELECT id, event_id, source_session_id, source, first_name, last_name, bio, tag_line, profile_picture, is_top_speaker, created_at, updated_at
+		SELECT id, event_id, source_session_id, source, first_name, last_name, email, bio, tag_line, profile_picture, is_top_speaker, created_at, updated_at
 		FROM speakers
 		WHERE id = $1
 	`
@@ -323,6 +592,7 @@ func (r *SessionRepository) GetSpeakerByID(ctx context.Context, speakerID string
 		&sp.Source,
 		&sp.FirstName,
 		&sp.LastName,
+		&sp.Email,
 		&sp.Bio,
 		&sp.TagLine,
 		&sp.ProfilePicture,
@@ -341,7 +611,7 @@ func (r *SessionRepository) GetSpeakerByID(ctx context.Context, speakerID string
 
 func (r *SessionRepository) ListSpeakersByEventID(ctx context.Context, eventID string) ([]*domain.Speaker, error) {
 	query := `
-		SELECT id, event_id, source_session_id, source, first_name, last_name, bio, tag_line, profile_picture, is_top_speaker, created_at, updated_at
+		SELECT id, event_id, source_session_id, source, first_name, last_name, email, bio, tag_line, profile_picture, is_top_speaker, created_at, updated_at
 		FROM speakers
 		WHERE event_id = $1
 		ORDER BY first_name, last_name, id
@@ -354,7 +624,7 @@ func (r *SessionRepository) ListSpeakersByEventID(ctx context.Context, eventID s
 	var speakers []*domain.Speaker
 	for rows.Next() {
 		sp := &domain.Speaker{}
-		if err := rows.Scan(&sp.ID, &sp.EventID, &sp.SourceSessionID, &sp.Source, &sp.FirstName, &sp.LastName, &sp.Bio, &sp.TagLine, &sp.ProfilePicture, &sp.IsTopSpeaker, &sp.CreatedAt, &sp.UpdatedAt); err != nil {
+		if err := rows.Scan(&sp.ID, &sp.EventID, &sp.SourceSessionID, &sp.Source, &sp.FirstName, &sp.LastName, &sp.Email, &sp.Bio, &sp.TagLine, &sp.ProfilePicture, &sp.IsTopSpeaker, &sp.CreatedAt, &sp.UpdatedAt); err != nil {
 			return nil, err
 		}
 		speakers = append(speakers, sp)
@@ -364,7 +634,7 @@ func (r *SessionRepository) ListSpeakersByEventID(ctx context.Context, eventID s
 
 func (r *SessionRepository) ListSpeakersBySessionID(ctx context.Context, sessionID string) ([]*domain.Speaker, error) {
 	rows, err := r.DB.QueryContext(ctx, `
-		SELECT s.id, s.event_id, s.source_session_id, s.source, s.first_name, s.last_name, s.bio, s.tag_line, s.profile_picture, s.is_top_speaker, s.created_at, s.updated_at
+		SELECT s.id, s.event_id, s.source_session_id, s.source, s.first_name, s.last_name, s.email, s.bio, s.tag_line, s.profile_picture, s.is_top_speaker, s.created_at, s.updated_at
 		FROM speakers s
 		INNER JOIN session_speakers ss ON ss.speaker_id = s.id
 		WHERE ss.session_id = $1
@@ -377,7 +647,7 @@ func (r *SessionRepository) ListSpeakersBySessionID(ctx context.Context, session
 	var speakers []*domain.Speaker
 	for rows.Next() {
 		sp := &domain.Speaker{}
-		if err := rows.Scan(&sp.ID, &sp.EventID, &sp.SourceSessionID, &sp.Source, &sp.FirstName, &sp.LastName, &sp.Bio, &sp.TagLine, &sp.ProfilePicture, &sp.IsTopSpeaker, &sp.CreatedAt, &sp.UpdatedAt); err != nil {
+		if err := rows.Scan(&sp.ID, &sp.EventID, &sp.SourceSessionID, &sp.Source, &sp.FirstName, &sp.LastName, &sp.Email, &sp.Bio, &sp.TagLine, &sp.ProfilePicture, &sp.IsTopSpeaker, &sp.CreatedAt, &sp.UpdatedAt); err != nil {
 			return nil, err
 		}
 		speakers = append(speakers, sp)
@@ -407,7 +677,7 @@ func (r *SessionRepository) ListSessionsByIDs(ctx context.Context, sessionIDs []
 		return []*domain.Session{}, nil
 	}
 	query := `
-		SELECT id, room_id, source_session_id, source, title, start_time, end_time, description, created_at, updated_at
+		SELECT id, event_id, COALESCE(room_id::text, ''), source_session_id, source, title, start_time, end_time, description, session_type, track, created_at, updated_at
 		FROM sessions
 		WHERE id = ANY($1)
 		ORDER BY start_time, id
@@ -420,7 +690,7 @@ func (r *SessionRepository) ListSessionsByIDs(ctx context.Context, sessionIDs []
 	var sessions []*domain.Session
 	for rows.Next() {
 		sess := &domain.Session{}
-		if err := rows.Scan(&sess.ID, &sess.RoomID, &sess.SourceSessionID, &sess.Source, &sess.Title, &sess.StartTime, &sess.EndTime, &sess.Description, &sess.CreatedAt, &sess.UpdatedAt); err != nil {
+		if err := rows.Scan(&sess.ID, &sess.EventID, &sess.RoomID, &sess.SourceSessionID, &sess.Source, &sess.Title, &sess.StartTime, &sess.EndTime, &sess.Description, &sess.SessionType, &sess.Track, &sess.CreatedAt, &sess.UpdatedAt); err != nil {
 			return nil, err
 		}
 		sess.Tags = []*domain.Tag{}
@@ -430,20 +700,7 @@ func (r *SessionRepository) ListSessionsByIDs(ctx context.Context, sessionIDs []
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
-	tagRows, err := r.DB.QueryContext(ctx, `SELECT st.session_id, t.id, t.name FROM session_tags st JOIN tags t ON t.id = st.tag_id WHERE st.session_id = ANY($1)`, pq.Array(sessionIDs))
-	if err != nil {
-		return nil, err
-	}
-	defer tagRows.Close()
-	tagsBySession := make(map[string][]*domain.Tag)
-	for tagRows.Next() {
-		var sid, tagID, tagName string
-		if err := tagRows.Scan(&sid, &tagID, &tagName); err != nil {
-			return nil, err
-		}
-		tagsBySession[sid] = append(tagsBySession[sid], &domain.Tag{ID: tagID, Name: tagName})
-	}
-	if err := tagRows.Err(); err != nil {
+	if err := r.loadSessionTags(ctx, sessions, sessionIDs); err != nil {
 		return nil, err
 	}
 	speakerMap, err := r.ListSpeakerIDsBySessionIDs(ctx, sessionIDs)
@@ -451,9 +708,6 @@ func (r *SessionRepository) ListSessionsByIDs(ctx context.Context, sessionIDs []
 		return nil, err
 	}
 	for _, sess := range sessions {
-		if t := tagsBySession[sess.ID]; t != nil {
-			sess.Tags = t
-		}
 		if ids := speakerMap[sess.ID]; len(ids) > 0 {
 			sess.SpeakerIDs = ids
 		}
@@ -482,11 +736,12 @@ func (r *SessionRepository) UpdateSessionSchedule(ctx context.Context, sessionID
 			end_time = COALESCE($4, end_time),
 			updated_at = NOW()
 		WHERE id = $1
-		RETURNING id, room_id, source_session_id, source, title, start_time, end_time, description, created_at, updated_at
+		RETURNING id, event_id, COALESCE(room_id::text, ''), source_session_id, source, title, start_time, end_time, description, session_type, track, created_at, updated_at
 	`
 	sess := &domain.Session{}
 	err := r.DB.QueryRowContext(ctx, query, sessionID, roomID, startTime, endTime).Scan(
 		&sess.ID,
+		&sess.EventID,
 		&sess.RoomID,
 		&sess.SourceSessionID,
 		&sess.Source,
@@ -494,6 +749,8 @@ func (r *SessionRepository) UpdateSessionSchedule(ctx context.Context, sessionID
 		&sess.StartTime,
 		&sess.EndTime,
 		&sess.Description,
+		&sess.SessionType,
+		&sess.Track,
 		&sess.CreatedAt,
 		&sess.UpdatedAt,
 	)
@@ -532,11 +789,12 @@ func (r *SessionRepository) UpdateSessionContent(ctx context.Context, sessionID
 			description = COALESCE($3, description),
 			updated_at = NOW()
 		WHERE id = $1
-		RETURNING id, room_id, source_session_id, source, title, start_time, end_time, description, created_at, updated_at
+		RETURNING id, event_id, COALESCE(room_id::text, ''), source_session_id, source, title, start_time, end_time, description, session_type, track, created_at, updated_at
 	`
 	sess := &domain.Session{}
 	err := r.DB.QueryRowContext(ctx, query, sessionID, title, description).Scan(
 		&sess.ID,
+		&sess.EventID,
 		&sess.RoomID,
 		&sess.SourceSessionID,
 		&sess.Source,
@@ -544,6 +802,8 @@ func (r *SessionRepository) UpdateSessionContent(ctx context.Context, sessionID
 		&sess.StartTime,
 		&sess.EndTime,
 		&sess.Description,
+		&sess.SessionType,
+		&sess.Track,
 		&sess.CreatedAt,
 		&sess.UpdatedAt,
 	)
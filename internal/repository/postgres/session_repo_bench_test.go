@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+// BenchmarkSessionRepository_ListSessionsByEventID scans a 500-session event where every session
+// carries one to three of the event's few tags, the shape of a large Sessionize import.
+func BenchmarkSessionRepository_ListSessionsByEventID(b *testing.B) {
+	const sessions = 500
+	start := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	tagNames := []string{"go", "cloud", "ai"}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		b.StopTimer()
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherFunc(func(string, string) error { return nil })))
+		if err != nil {
+			b.Fatal(err)
+		}
+		rows := sqlmock.NewRows([]string{"id", "room_id", "source_session_id", "source", "title", "start_time", "end_time", "description", "session_type", "track", "created_at", "updated_at"})
+		tagRows := sqlmock.NewRows([]string{"session_id", "id", "name"})
+		for i := range sessions {
+			id := fmt.Sprintf("session-%d", i)
+			rows.AddRow(id, fmt.Sprintf("room-%d", i%20), id, "sessionize", "Talk", start, start.Add(time.Hour), "Desc", "", "", start, start)
+			for t := 0; t <= i%3; t++ {
+				tagRows.AddRow(id, fmt.Sprintf("tag-%d", t), tagNames[t])
+			}
+		}
+		mock.ExpectQuery("").WillReturnRows(rows)
+		mock.ExpectQuery("").WillReturnRows(tagRows)
+		repo := NewSessionRepository(db)
+		b.StartTimer()
+
+		got, err := repo.ListSessionsByEventID(context.Background(), "ev-1")
+		if err != nil || len(got) != sessions {
+			b.Fatalf("got %d sessions, err %v", len(got), err)
+		}
+
+		b.StopTimer()
+		db.Close()
+		b.StartTimer()
+	}
+}
@@ -43,7 +43,7 @@ func TestSessionRepository_CreateRoom(t *testing.T) {
 			mock: func(mock sqlmock.Sqlmock) {
 				mock.ExpectQuery(`INSERT INTO rooms`).
 					WithArgs("ev-1", "Room A", 1, "sessionize", false, 0, "", "", createdAt, updatedAt).
-					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("room-uuid-1"))
+					WillReturnRows(sqlmock.NewRows([]string{"id", "display_order"}).AddRow("room-uuid-1", 3))
 			},
 			wantID:  "room-uuid-1",
 			wantErr: false,
@@ -108,6 +108,7 @@ func TestSessionRepository_CreateSession(t *testing.T) {
 		{
 			name: "success",
 			session: &domain.Session{
+				EventID:         "ev-1",
 				RoomID:          "room-1",
 				SourceSessionID: "sess-1",
 				Source:          "sessionize",
@@ -121,7 +122,7 @@ func TestSessionRepository_CreateSession(t *testing.T) {
 			},
 			mock: func(mock sqlmock.Sqlmock) {
 				mock.ExpectQuery(`INSERT INTO sessions`).
-					WithArgs("room-1", "sess-1", "sessionize", "Talk 1", startTime, endTime, "A talk", createdAt, updatedAt).
+					WithArgs("ev-1", "room-1", "sess-1", "sessionize", "Talk 1", startTime, endTime, "A talk", "", "", createdAt, updatedAt).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("session-uuid-1"))
 			},
 			wantID:  "session-uuid-1",
@@ -130,6 +131,7 @@ func TestSessionRepository_CreateSession(t *testing.T) {
 		{
 			name: "success with tags",
 			session: &domain.Session{
+				EventID:         "ev-1",
 				RoomID:          "room-1",
 				SourceSessionID: "sess-tags",
 				Source:          "sessionize",
@@ -143,7 +145,7 @@ func TestSessionRepository_CreateSession(t *testing.T) {
 			},
 			mock: func(mock sqlmock.Sqlmock) {
 				mock.ExpectQuery(`INSERT INTO sessions`).
-					WithArgs("room-1", "sess-tags", "sessionize", "Talk with tags", startTime, endTime, "", createdAt, updatedAt).
+					WithArgs("ev-1", "room-1", "sess-tags", "sessionize", "Talk with tags", startTime, endTime, "", "", "", createdAt, updatedAt).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("session-uuid-2"))
 			},
 			wantID:  "session-uuid-2",
@@ -152,6 +154,7 @@ func TestSessionRepository_CreateSession(t *testing.T) {
 		{
 			name: "db error",
 			session: &domain.Session{
+				EventID:         "ev-1",
 				RoomID:          "room-1",
 				SourceSessionID: "sess-2",
 				Title:           "Talk 2",
@@ -191,31 +194,45 @@ func TestSessionRepository_CreateSession(t *testing.T) {
 	}
 }
 
-func TestSessionRepository_DeleteScheduleByEventID(t *testing.T) {
+func TestSessionRepository_PruneScheduleByEventID(t *testing.T) {
 	ctx := context.Background()
 
 	tests := []struct {
-		name    string
-		eventID string
-		mock    func(mock sqlmock.Sqlmock)
-		wantErr bool
+		name           string
+		keepRoomIDs    []string
+		keepSessionIDs []string
+		mock           func(mock sqlmock.Sqlmock)
+		wantErr        bool
 	}{
 		{
-			name:    "success",
-			eventID: "ev-1",
+			name:           "success",
+			keepRoomIDs:    []string{"room-1"},
+			keepSessionIDs: []string{"sess-1", "sess-2"},
 			mock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`DELETE FROM rooms WHERE event_id`).
-					WithArgs("ev-1").
+				mock.ExpectExec(`DELETE FROM sessions WHERE event_id = \$1 AND NOT \(id = ANY\(\$2::uuid\[\]\)\)`).
+					WithArgs("ev-1", pq.Array([]string{"sess-1", "sess-2"})).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`DELETE FROM rooms WHERE event_id = \$1 AND NOT \(id = ANY\(\$2::uuid\[\]\)\)`).
+					WithArgs("ev-1", pq.Array([]string{"room-1"})).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+			},
+		},
+		{
+			name: "nil keeps nothing",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`DELETE FROM sessions WHERE event_id`).
+					WithArgs("ev-1", pq.Array([]string{})).
 					WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectExec(`DELETE FROM rooms`).
+					WithArgs("ev-1", pq.Array([]string{})).
+					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
-			wantErr: false,
 		},
 		{
-			name:    "db error",
-			eventID: "ev-2",
+			name: "db error",
 			mock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`DELETE FROM rooms WHERE event_id`).
-					WithArgs("ev-2").
+				mock.ExpectExec(`DELETE FROM sessions WHERE event_id`).
+					WithArgs("ev-1", pq.Array([]string{})).
 					WillReturnError(sql.ErrConnDone)
 			},
 			wantErr: true,
@@ -230,7 +247,7 @@ func TestSessionRepository_DeleteScheduleByEventID(t *testing.T) {
 
 			tt.mock(mock)
 			repo := NewSessionRepository(db)
-			err = repo.DeleteScheduleByEventID(ctx, tt.eventID)
+			err = repo.PruneScheduleByEventID(ctx, "ev-1", tt.keepRoomIDs, tt.keepSessionIDs)
 			if tt.wantErr {
 				require.Error(t, err)
 			} else {
@@ -241,6 +258,213 @@ func TestSessionRepository_DeleteScheduleByEventID(t *testing.T) {
 	}
 }
 
+func TestSessionRepository_PruneSpeakersByEventID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	mock.ExpectExec(`DELETE FROM speakers WHERE event_id = \$1 AND NOT \(id = ANY\(\$2::uuid\[\]\)\)`).
+		WithArgs("ev-1", pq.Array([]string{"sp-1"})).
+		WillReturnResult(sqlmock.NewResult(0, 4))
+
+	err = NewSessionRepository(db).PruneSpeakersByEventID(context.Background(), "ev-1", []string{"sp-1"})
+
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSessionRepository_SetSessionSpeakers(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	mock.ExpectExec(`DELETE FROM session_speakers WHERE session_id = \$1`).
+		WithArgs("sess-1").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`INSERT INTO session_speakers`).
+		WithArgs("sess-1", "sp-1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO session_speakers`).
+		WithArgs("sess-1", "sp-2").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = NewSessionRepository(db).SetSessionSpeakers(context.Background(), "sess-1", []string{"sp-1", "sp-2"})
+
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSessionRepository_UpdateSpeakerSource(t *testing.T) {
+	tests := []struct {
+		name    string
+		rows    int64
+		wantErr error
+	}{
+		{name: "success", rows: 1},
+		{name: "not found", rows: 0, wantErr: domain.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+			mock.ExpectExec(`UPDATE speakers SET source = \$2, source_session_id = \$3`).
+				WithArgs("sp-1", "sessionize", "new-id").
+				WillReturnResult(sqlmock.NewResult(0, tt.rows))
+
+			err = NewSessionRepository(db).UpdateSpeakerSource(context.Background(), "sp-1", "sessionize", "new-id")
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSessionRepository_MergeSpeakers(t *testing.T) {
+	droppedColumns := []string{"source", "source_session_id", "first_name", "last_name", "email", "bio", "tag_line", "profile_picture", "is_top_speaker"}
+
+	tests := []struct {
+		name    string
+		mock    func(mock sqlmock.Sqlmock)
+		wantErr error
+	}{
+		{
+			name: "success",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`INSERT INTO session_speakers \(session_id, speaker_id\)\s+SELECT session_id, \$1`).
+					WithArgs("keep", "drop").
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectQuery(`DELETE FROM speakers WHERE id = \$1\s+RETURNING source`).
+					WithArgs("drop").
+					WillReturnRows(sqlmock.NewRows(droppedColumns).
+						AddRow("sessionize", "new-id", "John", "Doe", "", "Bio", "", "", false))
+				mock.ExpectExec(`UPDATE speakers`).
+					WithArgs("keep", "sessionize", "new-id", "John", "Doe", "", "Bio", "", "", false).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "dropped speaker missing",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`INSERT INTO session_speakers`).
+					WithArgs("keep", "drop").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`DELETE FROM speakers`).
+					WithArgs("drop").
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name: "kept speaker missing",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`INSERT INTO session_speakers`).
+					WithArgs("keep", "drop").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`DELETE FROM speakers`).
+					WithArgs("drop").
+					WillReturnRows(sqlmock.NewRows(droppedColumns).
+						AddRow("sessionize", "new-id", "John", "Doe", "", "", "", "", false))
+				mock.ExpectExec(`UPDATE speakers`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			wantErr: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+			tt.mock(mock)
+
+			err = NewSessionRepository(db).MergeSpeakers(context.Background(), "keep", "drop")
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSessionRepository_UpdateSpeakers(t *testing.T) {
+	columns := []string{"id", "event_id", "source_session_id", "source", "first_name", "last_name", "email", "bio", "tag_line", "profile_picture", "is_top_speaker", "created_at", "updated_at"}
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	empty, top := "", true
+	updates := []*domain.SpeakerUpdate{
+		{SpeakerID: "sp-1", IsTopSpeaker: &top},
+		{SpeakerID: "sp-2", TagLine: &empty},
+	}
+
+	tests := []struct {
+		name    string
+		mock    func(mock sqlmock.Sqlmock)
+		wantLen int
+		wantErr error
+	}{
+		{
+			name: "success",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`UPDATE speakers\s+SET first_name = COALESCE\(\$2, first_name\)`).
+					WithArgs("sp-1", nil, nil, nil, nil, nil, nil, &top).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow("sp-1", "ev-1", "s1", "sessionize", "Ada", "", "", "", "", "", true, now, now))
+				mock.ExpectQuery(`UPDATE speakers`).
+					WithArgs("sp-2", nil, nil, nil, nil, &empty, nil, nil).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow("sp-2", "ev-1", "s2", "sessionize", "Alan", "", "", "", "", "", false, now, now))
+				mock.ExpectCommit()
+			},
+			wantLen: 2,
+		},
+		{
+			name: "speaker missing rolls back",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`UPDATE speakers`).
+					WithArgs("sp-1", nil, nil, nil, nil, nil, nil, &top).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow("sp-1", "ev-1", "s1", "sessionize", "Ada", "", "", "", "", "", true, now, now))
+				mock.ExpectQuery(`UPDATE speakers`).
+					WithArgs("sp-2", nil, nil, nil, nil, &empty, nil, nil).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			wantErr: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+			tt.mock(mock)
+
+			speakers, err := NewSessionRepository(db).UpdateSpeakers(context.Background(), updates)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, speakers, tt.wantLen)
+				require.True(t, speakers[0].IsTopSpeaker)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestSessionRepository_ListRoomsByEventID(t *testing.T) {
 	ctx := context.Background()
 	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
@@ -257,10 +481,10 @@ func TestSessionRepository_ListRoomsByEventID(t *testing.T) {
 			name:    "success two rooms",
 			eventID: "ev-1",
 			mock: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "event_id", "name", "source_session_id", "source", "not_bookable", "capacity", "description", "how_to_get_there", "created_at", "updated_at"}).
-					AddRow("room-1", "ev-1", "Room A", 1, "sessionize", false, 0, "", "", createdAt, updatedAt).
-					AddRow("room-2", "ev-1", "Room B", 2, "sessionize", true, 0, "", "", createdAt, updatedAt)
-				mock.ExpectQuery(`SELECT id, event_id, name, source_session_id, source, not_bookable, capacity, description, how_to_get_there, created_at, updated_at`).
+				rows := sqlmock.NewRows([]string{"id", "event_id", "name", "source_session_id", "source", "not_bookable", "capacity", "description", "how_to_get_there", "created_at", "updated_at", "display_order"}).
+					AddRow("room-1", "ev-1", "Room A", 1, "sessionize", false, 0, "", "", createdAt, updatedAt, 1).
+					AddRow("room-2", "ev-1", "Room B", 2, "sessionize", true, 0, "", "", createdAt, updatedAt, 2)
+				mock.ExpectQuery(`SELECT id, event_id, name, source_session_id, source, not_bookable, capacity, description, how_to_get_there, created_at, updated_at, display_order`).
 					WithArgs("ev-1").
 					WillReturnRows(rows)
 			},
@@ -271,9 +495,9 @@ func TestSessionRepository_ListRoomsByEventID(t *testing.T) {
 			name:    "success empty",
 			eventID: "ev-2",
 			mock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, event_id, name, source_session_id, source, not_bookable, capacity, description, how_to_get_there, created_at, updated_at`).
+				mock.ExpectQuery(`SELECT id, event_id, name, source_session_id, source, not_bookable, capacity, description, how_to_get_there, created_at, updated_at, display_order`).
 					WithArgs("ev-2").
-					WillReturnRows(sqlmock.NewRows([]string{"id", "event_id", "name", "source_session_id", "source", "not_bookable", "capacity", "description", "how_to_get_there", "created_at", "updated_at"}))
+					WillReturnRows(sqlmock.NewRows([]string{"id", "event_id", "name", "source_session_id", "source", "not_bookable", "capacity", "description", "how_to_get_there", "created_at", "updated_at", "display_order"}))
 			},
 			wantLen: 0,
 			wantErr: false,
@@ -282,7 +506,7 @@ func TestSessionRepository_ListRoomsByEventID(t *testing.T) {
 			name:    "db error",
 			eventID: "ev-1",
 			mock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, event_id, name, source_session_id, source, not_bookable, capacity, description, how_to_get_there, created_at, updated_at`).
+				mock.ExpectQuery(`SELECT id, event_id, name, source_session_id, source, not_bookable, capacity, description, how_to_get_there, created_at, updated_at, display_order`).
 					WithArgs("ev-1").
 					WillReturnError(sql.ErrConnDone)
 			},
@@ -326,9 +550,9 @@ func TestSessionRepository_GetRoomByID(t *testing.T) {
 			name:   "success",
 			roomID: "room-1",
 			mock: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "event_id", "name", "source_session_id", "source", "not_bookable", "capacity", "description", "how_to_get_there", "created_at", "updated_at"}).
-					AddRow("room-1", "ev-1", "Room A", 1, "sessionize", false, 0, "", "", createdAt, updatedAt)
-				mock.ExpectQuery(`SELECT id, event_id, name, source_session_id, source, not_bookable, capacity, description, how_to_get_there, created_at, updated_at`).
+				rows := sqlmock.NewRows([]string{"id", "event_id", "name", "source_session_id", "source", "not_bookable", "capacity", "description", "how_to_get_there", "created_at", "updated_at", "display_order"}).
+					AddRow("room-1", "ev-1", "Room A", 1, "sessionize", false, 0, "", "", createdAt, updatedAt, 1)
+				mock.ExpectQuery(`SELECT id, event_id, name, source_session_id, source, not_bookable, capacity, description, how_to_get_there, created_at, updated_at, display_order`).
 					WithArgs("room-1").
 					WillReturnRows(rows)
 			},
@@ -342,6 +566,7 @@ func TestSessionRepository_GetRoomByID(t *testing.T) {
 				Capacity:         0,
 				Description:      "",
 				HowToGetThere:    "",
+				DisplayOrder:     1,
 				CreatedAt:        createdAt,
 				UpdatedAt:        updatedAt,
 			},
@@ -351,7 +576,7 @@ func TestSessionRepository_GetRoomByID(t *testing.T) {
 			name:   "not found",
 			roomID: "room-missing",
 			mock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, event_id, name, source_session_id, source, not_bookable, capacity, description, how_to_get_there, created_at, updated_at`).
+				mock.ExpectQuery(`SELECT id, event_id, name, source_session_id, source, not_bookable, capacity, description, how_to_get_there, created_at, updated_at, display_order`).
 					WithArgs("room-missing").
 					WillReturnError(sql.ErrNoRows)
 			},
@@ -399,8 +624,8 @@ func TestSessionRepository_SetRoomNotBookable(t *testing.T) {
 			roomID:      "room-1",
 			notBookable: true,
 			mock: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "event_id", "name", "source_session_id", "source", "not_bookable", "capacity", "description", "how_to_get_there", "created_at", "updated_at"}).
-					AddRow("room-1", "ev-1", "Room A", 1, "sessionize", true, 0, "", "", createdAt, updatedAt)
+				rows := sqlmock.NewRows([]string{"id", "event_id", "name", "source_session_id", "source", "not_bookable", "capacity", "description", "how_to_get_there", "created_at", "updated_at", "display_order"}).
+					AddRow("room-1", "ev-1", "Room A", 1, "sessionize", true, 0, "", "", createdAt, updatedAt, 1)
 				mock.ExpectQuery(`UPDATE rooms`).
 					WithArgs("room-1", true).
 					WillReturnRows(rows)
@@ -414,8 +639,8 @@ func TestSessionRepository_SetRoomNotBookable(t *testing.T) {
 			roomID:      "room-1",
 			notBookable: false,
 			mock: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "event_id", "name", "source_session_id", "source", "not_bookable", "capacity", "description", "how_to_get_there", "created_at", "updated_at"}).
-					AddRow("room-1", "ev-1", "Room A", 1, "sessionize", false, 0, "", "", createdAt, updatedAt)
+				rows := sqlmock.NewRows([]string{"id", "event_id", "name", "source_session_id", "source", "not_bookable", "capacity", "description", "how_to_get_there", "created_at", "updated_at", "display_order"}).
+					AddRow("room-1", "ev-1", "Room A", 1, "sessionize", false, 0, "", "", createdAt, updatedAt, 1)
 				mock.ExpectQuery(`UPDATE rooms`).
 					WithArgs("room-1", false).
 					WillReturnRows(rows)
@@ -488,8 +713,8 @@ func TestSessionRepository_UpdateRoomDetails(t *testing.T) {
 			howToGetThere: "Turn left at entrance",
 			notBookable:   true,
 			mock: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "event_id", "name", "source_session_id", "source", "not_bookable", "capacity", "description", "how_to_get_there", "created_at", "updated_at"}).
-					AddRow("room-1", "ev-1", "Room A", 1, "sessionize", true, 50, "Main hall", "Turn left at entrance", createdAt, updatedAt)
+				rows := sqlmock.NewRows([]string{"id", "event_id", "name", "source_session_id", "source", "not_bookable", "capacity", "description", "how_to_get_there", "created_at", "updated_at", "display_order"}).
+					AddRow("room-1", "ev-1", "Room A", 1, "sessionize", true, 50, "Main hall", "Turn left at entrance", createdAt, updatedAt, 1)
 				mock.ExpectQuery(`UPDATE rooms`).
 					WithArgs("room-1", "Room A", 50, "Main hall", "Turn left at entrance", true).
 					WillReturnRows(rows)
@@ -504,6 +729,7 @@ func TestSessionRepository_UpdateRoomDetails(t *testing.T) {
 				Capacity:         50,
 				Description:      "Main hall",
 				HowToGetThere:    "Turn left at entrance",
+				DisplayOrder:     1,
 				CreatedAt:        createdAt,
 				UpdatedAt:        updatedAt,
 			},
@@ -518,8 +744,8 @@ func TestSessionRepository_UpdateRoomDetails(t *testing.T) {
 			howToGetThere: "Turn left at entrance",
 			notBookable:   true,
 			mock: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "event_id", "name", "source_session_id", "source", "not_bookable", "capacity", "description", "how_to_get_there", "created_at", "updated_at"}).
-					AddRow("room-1", "ev-1", "Main Hall", 1, "sessionize", true, 50, "Main hall", "Turn left at entrance", createdAt, updatedAt)
+				rows := sqlmock.NewRows([]string{"id", "event_id", "name", "source_session_id", "source", "not_bookable", "capacity", "description", "how_to_get_there", "created_at", "updated_at", "display_order"}).
+					AddRow("room-1", "ev-1", "Main Hall", 1, "sessionize", true, 50, "Main hall", "Turn left at entrance", createdAt, updatedAt, 1)
 				mock.ExpectQuery(`UPDATE rooms`).
 					WithArgs("room-1", "Main Hall", 50, "Main hall", "Turn left at entrance", true).
 					WillReturnRows(rows)
@@ -534,6 +760,7 @@ func TestSessionRepository_UpdateRoomDetails(t *testing.T) {
 				Capacity:         50,
 				Description:      "Main hall",
 				HowToGetThere:    "Turn left at entrance",
+				DisplayOrder:     1,
 				CreatedAt:        createdAt,
 				UpdatedAt:        updatedAt,
 			},
@@ -658,6 +885,92 @@ func TestSessionRepository_DeleteRoom(t *testing.T) {
 	}
 }
 
+func TestSessionRepository_DeleteRoomKeepSessions(t *testing.T) {
+	ctx := context.Background()
+	target := "room-2"
+
+	tests := []struct {
+		name         string
+		targetRoomID *string
+		mock         func(mock sqlmock.Sqlmock)
+		wantErr      bool
+		wantNotFound bool
+	}{
+		{
+			name:         "reassign",
+			targetRoomID: &target,
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE sessions SET room_id = \$2, updated_at = NOW\(\) WHERE room_id = \$1`).
+					WithArgs("room-1", "room-2").
+					WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectExec(`DELETE FROM rooms WHERE id`).
+					WithArgs("room-1").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "unschedule",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE sessions SET room_id`).
+					WithArgs("room-1", nil).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectExec(`DELETE FROM rooms WHERE id`).
+					WithArgs("room-1").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "room not found rolls back",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE sessions SET room_id`).
+					WithArgs("room-1", nil).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(`DELETE FROM rooms WHERE id`).
+					WithArgs("room-1").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			wantErr:      true,
+			wantNotFound: true,
+		},
+		{
+			name: "update error rolls back",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE sessions SET room_id`).
+					WillReturnError(sql.ErrConnDone)
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+			tt.mock(mock)
+			repo := NewSessionRepository(db)
+			err = repo.DeleteRoomKeepSessions(ctx, "room-1", tt.targetRoomID)
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.wantNotFound {
+					require.True(t, errors.Is(err, domain.ErrNotFound))
+				}
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestSessionRepository_DeleteSession(t *testing.T) {
 	ctx := context.Background()
 
@@ -802,10 +1115,10 @@ func TestSessionRepository_ListSpeakersBySessionID(t *testing.T) {
 			sessionID: "sess-1",
 			mock: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{
-					"id", "event_id", "source_session_id", "source", "first_name", "last_name", "bio", "tag_line", "profile_picture", "is_top_speaker", "created_at", "updated_at",
+					"id", "event_id", "source_session_id", "source", "first_name", "last_name", "email", "bio", "tag_line", "profile_picture", "is_top_speaker", "created_at", "updated_at",
 				}).
-					AddRow("sp-1", "ev-1", "src-1", "admin_app", "Alice", "A", "bio", "tag", "pic", false, createdAt, updatedAt).
-					AddRow("sp-2", "ev-1", "src-2", "admin_app", "Bob", "B", "bio2", "tag2", "pic2", true, createdAt, updatedAt)
+					AddRow("sp-1", "ev-1", "src-1", "admin_app", "Alice", "A", "alice@example.com", "bio", "tag", "pic", false, createdAt, updatedAt).
+					AddRow("sp-2", "ev-1", "src-2", "admin_app", "Bob", "B", "", "bio2", "tag2", "pic2", true, createdAt, updatedAt)
 				mock.ExpectQuery(`FROM speakers s\s+INNER JOIN session_speakers ss ON ss\.speaker_id = s\.id\s+WHERE ss\.session_id = \$1`).
 					WithArgs("sess-1").
 					WillReturnRows(rows)
@@ -862,9 +1175,9 @@ func TestSessionRepository_ListSessionsByEventID(t *testing.T) {
 			name:    "success one session",
 			eventID: "ev-1",
 			mock: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "room_id", "source_session_id", "source", "title", "start_time", "end_time", "description", "created_at", "updated_at"}).
-					AddRow("sess-1", "room-1", "s1", "sessionize", "Talk 1", startTime, endTime, "Desc", createdAt, updatedAt)
-				mock.ExpectQuery(`SELECT s.id, s.room_id, s.source_session_id, s.source, s.title, s.start_time, s.end_time, s.description, s.created_at, s.updated_at`).
+				rows := sqlmock.NewRows([]string{"id", "event_id", "room_id", "source_session_id", "source", "title", "start_time", "end_time", "description", "session_type", "track", "created_at", "updated_at"}).
+					AddRow("sess-1", "ev-1", "room-1", "s1", "sessionize", "Talk 1", startTime, endTime, "Desc", "", "", createdAt, updatedAt)
+				mock.ExpectQuery(`SELECT s.id, s.event_id, COALESCE\(s.room_id::text, ''\), s.source_session_id, s.source, s.title, s.start_time, s.end_time, s.description, s.session_type, s.track, s.created_at, s.updated_at`).
 					WithArgs("ev-1").
 					WillReturnRows(rows)
 				tagRows := sqlmock.NewRows([]string{"session_id", "id", "name"}).
@@ -882,9 +1195,9 @@ func TestSessionRepository_ListSessionsByEventID(t *testing.T) {
 			name:    "success empty",
 			eventID: "ev-2",
 			mock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT s.id, s.room_id, s.source_session_id, s.source, s.title, s.start_time, s.end_time, s.description, s.created_at, s.updated_at`).
+				mock.ExpectQuery(`SELECT s.id, s.event_id, COALESCE\(s.room_id::text, ''\), s.source_session_id, s.source, s.title, s.start_time, s.end_time, s.description, s.session_type, s.track, s.created_at, s.updated_at`).
 					WithArgs("ev-2").
-					WillReturnRows(sqlmock.NewRows([]string{"id", "room_id", "source_session_id", "source", "title", "start_time", "end_time", "description", "created_at", "updated_at"}))
+					WillReturnRows(sqlmock.NewRows([]string{"id", "event_id", "room_id", "source_session_id", "source", "title", "start_time", "end_time", "description", "session_type", "track", "created_at", "updated_at"}))
 			},
 			wantLen: 0,
 			wantErr: false,
@@ -893,7 +1206,7 @@ func TestSessionRepository_ListSessionsByEventID(t *testing.T) {
 			name:    "db error",
 			eventID: "ev-1",
 			mock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT s.id, s.room_id, s.source_session_id, s.source, s.title, s.start_time, s.end_time, s.description, s.created_at, s.updated_at`).
+				mock.ExpectQuery(`SELECT s.id, s.event_id, COALESCE\(s.room_id::text, ''\), s.source_session_id, s.source, s.title, s.start_time, s.end_time, s.description, s.session_type, s.track, s.created_at, s.updated_at`).
 					WithArgs("ev-1").
 					WillReturnError(sql.ErrConnDone)
 			},
@@ -928,6 +1241,119 @@ func TestSessionRepository_ListSessionsByEventID(t *testing.T) {
 	}
 }
 
+func TestSessionRepository_ListSessionsByEventID_TagsNotShared(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	start := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	rows := sqlmock.NewRows([]string{"id", "event_id", "room_id", "source_session_id", "source", "title", "start_time", "end_time", "description", "session_type", "track", "created_at", "updated_at"}).
+		AddRow("sess-1", "ev-1", "room-1", "s1", "sessionize", "Talk 1", start, start.Add(time.Hour), "", "", "", start, start).
+		AddRow("sess-2", "ev-1", "room-1", "s2", "sessionize", "Talk 2", start, start.Add(time.Hour), "", "", "", start, start)
+	mock.ExpectQuery(`SELECT s.id, s.event_id, COALESCE\(s.room_id::text, ''\)`).WithArgs("ev-1").WillReturnRows(rows)
+	mock.ExpectQuery(`SELECT st.session_id, t.id, t.name FROM session_tags st JOIN tags t ON t.id = st.tag_id WHERE st.session_id = ANY`).
+		WithArgs(pq.Array([]string{"sess-1", "sess-2"})).
+		WillReturnRows(sqlmock.NewRows([]string{"session_id", "id", "name"}).AddRow("sess-1", "tag-ai", "ai").AddRow("sess-2", "tag-ai", "ai"))
+
+	sessions, err := NewSessionRepository(db).ListSessionsByEventID(context.Background(), "ev-1")
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	require.Len(t, sessions[1].Tags, 1)
+	sessions[0].Tags[0].Name = "AI (localized)"
+	require.Equal(t, "ai", sessions[1].Tags[0].Name, "changing one session's tag leaves the others alone")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSessionRepository_StreamSessionsByEventID(t *testing.T) {
+	ctx := context.Background()
+	startTime := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	endTime := time.Date(2025, 3, 1, 11, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	columns := []string{"id", "event_id", "room_id", "source_session_id", "source", "title", "start_time", "end_time", "description", "session_type", "track", "created_at", "updated_at", "tag_ids", "tag_names", "speaker_ids"}
+	stopErr := errors.New("stop")
+
+	tests := []struct {
+		name    string
+		mock    func(mock sqlmock.Sqlmock)
+		fnErr   error
+		wantIDs []string
+		wantErr error
+		check   func(t *testing.T, got []*domain.Session)
+	}{
+		{
+			name: "success with tags and speakers",
+			mock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow("sess-1", "ev-1", "room-1", "s1", "sessionize", "Talk 1", startTime, endTime, "Desc", "", "", createdAt, createdAt, "{tag-ai,tag-web}", "{ai,web}", "{sp-1}").
+					AddRow("sess-2", "ev-1", "room-2", "s2", "sessionize", "Talk 2", startTime, endTime, "", "", "", createdAt, createdAt, "{}", "{}", "{}")
+				mock.ExpectQuery(`SELECT s.id, s.event_id, .* FROM sessions s`).
+					WithArgs("ev-1").
+					WillReturnRows(rows)
+			},
+			wantIDs: []string{"sess-1", "sess-2"},
+			check: func(t *testing.T, got []*domain.Session) {
+				require.Len(t, got[0].Tags, 2)
+				require.Equal(t, "tag-web", got[0].Tags[1].ID)
+				require.Equal(t, "web", got[0].Tags[1].Name)
+				require.Equal(t, []string{"sp-1"}, got[0].SpeakerIDs)
+				require.Empty(t, got[1].Tags)
+				require.NotNil(t, got[1].SpeakerIDs)
+			},
+		},
+		{
+			name: "callback error stops the stream",
+			mock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow("sess-1", "ev-1", "room-1", "s1", "sessionize", "Talk 1", startTime, endTime, "Desc", "", "", createdAt, createdAt, "{}", "{}", "{}").
+					AddRow("sess-2", "ev-1", "room-2", "s2", "sessionize", "Talk 2", startTime, endTime, "", "", "", createdAt, createdAt, "{}", "{}", "{}")
+				mock.ExpectQuery(`SELECT s.id, s.event_id, .* FROM sessions s`).
+					WithArgs("ev-1").
+					WillReturnRows(rows)
+			},
+			fnErr:   stopErr,
+			wantIDs: []string{"sess-1"},
+			wantErr: stopErr,
+		},
+		{
+			name: "db error",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT s.id, s.event_id, .* FROM sessions s`).
+					WithArgs("ev-1").
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+			tt.mock(mock)
+			repo := NewSessionRepository(db)
+			var got []*domain.Session
+			err = repo.StreamSessionsByEventID(ctx, "ev-1", func(sess *domain.Session) error {
+				got = append(got, sess)
+				return tt.fnErr
+			})
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			var gotIDs []string
+			for _, sess := range got {
+				gotIDs = append(gotIDs, sess.ID)
+			}
+			require.Equal(t, tt.wantIDs, gotIDs)
+			if tt.check != nil {
+				tt.check(t, got)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestSessionRepository_UpdateSessionContent(t *testing.T) {
 	ctx := context.Background()
 	startTime := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
@@ -952,8 +1378,8 @@ func TestSessionRepository_UpdateSessionContent(t *testing.T) {
 			title:       strPtr("New Title"),
 			description: strPtr("New description"),
 			mock: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "room_id", "source_session_id", "source", "title", "start_time", "end_time", "description", "created_at", "updated_at"}).
-					AddRow("sess-1", "room-1", "src-1", "sessionize", "New Title", startTime, endTime, "New description", createdAt, updatedAt)
+				rows := sqlmock.NewRows([]string{"id", "event_id", "room_id", "source_session_id", "source", "title", "start_time", "end_time", "description", "session_type", "track", "created_at", "updated_at"}).
+					AddRow("sess-1", "ev-1", "room-1", "src-1", "sessionize", "New Title", startTime, endTime, "New description", "", "", createdAt, updatedAt)
 				mock.ExpectQuery(`UPDATE sessions`).
 					WithArgs("sess-1", "New Title", "New description").
 					WillReturnRows(rows)
@@ -969,8 +1395,8 @@ func TestSessionRepository_UpdateSessionContent(t *testing.T) {
 			sessionID: "sess-1",
 			title:  strPtr("Only Title"),
 			mock: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "room_id", "source_session_id", "source", "title", "start_time", "end_time", "description", "created_at", "updated_at"}).
-					AddRow("sess-1", "room-1", "src-1", "sessionize", "Only Title", startTime, endTime, "unchanged", createdAt, updatedAt)
+				rows := sqlmock.NewRows([]string{"id", "event_id", "room_id", "source_session_id", "source", "title", "start_time", "end_time", "description", "session_type", "track", "created_at", "updated_at"}).
+					AddRow("sess-1", "ev-1", "room-1", "src-1", "sessionize", "Only Title", startTime, endTime, "unchanged", "", "", createdAt, updatedAt)
 				mock.ExpectQuery(`UPDATE sessions`).
 					WithArgs("sess-1", "Only Title", nil).
 					WillReturnRows(rows)
@@ -986,8 +1412,8 @@ func TestSessionRepository_UpdateSessionContent(t *testing.T) {
 			sessionID:   "sess-1",
 			description: strPtr("Only description"),
 			mock: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "room_id", "source_session_id", "source", "title", "start_time", "end_time", "description", "created_at", "updated_at"}).
-					AddRow("sess-1", "room-1", "src-1", "sessionize", "Old Title", startTime, endTime, "Only description", createdAt, updatedAt)
+				rows := sqlmock.NewRows([]string{"id", "event_id", "room_id", "source_session_id", "source", "title", "start_time", "end_time", "description", "session_type", "track", "created_at", "updated_at"}).
+					AddRow("sess-1", "ev-1", "room-1", "src-1", "sessionize", "Old Title", startTime, endTime, "Only description", "", "", createdAt, updatedAt)
 				mock.ExpectQuery(`UPDATE sessions`).
 					WithArgs("sess-1", nil, "Only description").
 					WillReturnRows(rows)
@@ -1050,3 +1476,52 @@ func TestSessionRepository_UpdateSessionContent(t *testing.T) {
 func strPtr(s string) *string {
 	return &s
 }
+
+func TestSessionRepository_ReorderRooms(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec(`UPDATE rooms SET display_order = array_position\(\$2::uuid\[\], id\), updated_at = NOW\(\)\s+WHERE event_id = \$1 AND id = ANY\(\$2::uuid\[\]\)`).
+		WithArgs("ev-1", pq.Array([]string{"room-2", "room-1"})).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectQuery(`FROM rooms\s+WHERE event_id = \$1\s+ORDER BY display_order, name`).
+		WithArgs("ev-1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "event_id", "name", "source_session_id", "source", "not_bookable", "capacity", "description", "how_to_get_there", "created_at", "updated_at", "display_order"}).
+			AddRow("room-2", "ev-1", "Main Stage", 2, "sessionize", false, 0, "", "", time.Time{}, time.Time{}, 1).
+			AddRow("room-1", "ev-1", "Annex", 1, "sessionize", false, 0, "", "", time.Time{}, time.Time{}, 2))
+
+	repo := NewSessionRepository(db)
+	require.NoError(t, repo.ReorderRooms(context.Background(), "ev-1", []string{"room-2", "room-1"}))
+	rooms, err := repo.ListRoomsByEventID(context.Background(), "ev-1")
+	require.NoError(t, err)
+	require.Len(t, rooms, 2)
+	require.Equal(t, "Main Stage", rooms[0].Name)
+	require.Equal(t, 2, rooms[1].DisplayOrder)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSessionRepository_ReorderTracks(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM event_tracks WHERE event_id = \$1`).
+		WithArgs("ev-1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO event_tracks \(event_id, name, display_order\)\s+SELECT \$1, unnest\(\$2::text\[\]\), generate_subscripts\(\$2::text\[\], 1\)`).
+		WithArgs("ev-1", pq.Array([]string{"Frontend", "Backend"})).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+	mock.ExpectQuery(`SELECT name FROM event_tracks WHERE event_id = \$1 ORDER BY display_order`).
+		WithArgs("ev-1").
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("Frontend").AddRow("Backend"))
+
+	repo := NewSessionRepository(db)
+	require.NoError(t, repo.ReorderTracks(context.Background(), "ev-1", []string{"Frontend", "Backend"}))
+	tracks, err := repo.ListTrackOrder(context.Background(), "ev-1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"Frontend", "Backend"}, tracks)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
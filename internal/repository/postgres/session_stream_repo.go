@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"multitrackticketing/internal/domain"
+)
+
+type sessionStreamRepository struct {
+	DB *sql.DB
+}
+
+func NewSessionStreamRepository(db *sql.DB) domain.SessionStreamRepository {
+	return &sessionStreamRepository{
+		DB: db,
+	}
+}
+
+func (r *sessionStreamRepository) ListBySessionID(ctx context.Context, sessionID string) ([]*domain.SessionStreamLink, error) {
+	bySession, err := r.list(ctx, `session_id = $1`, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if bySession[sessionID] == nil {
+		return []*domain.SessionStreamLink{}, nil
+	}
+	return bySession[sessionID], nil
+}
+
+func (r *sessionStreamRepository) ListByEventID(ctx context.Context, eventID string) (map[string][]*domain.SessionStreamLink, error) {
+	return r.list(ctx, `event_id = $1`, eventID)
+}
+
+// list returns the links matching where by session ID, in order.
+func (r *sessionStreamRepository) list(ctx context.Context, where string, args ...any) (map[string][]*domain.SessionStreamLink, error) {
+	query := `
+		SELECT session_id, kind, language, url, visible_from, visible_until
+		FROM session_stream_links
+		WHERE ` + where + `
+		ORDER BY session_id, position
+	`
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	bySession := make(map[string][]*domain.SessionStreamLink)
+	for rows.Next() {
+		var (
+			sessionID   string
+			l           domain.SessionStreamLink
+			from, until sql.NullTime
+		)
+		if err := rows.Scan(&sessionID, &l.Kind, &l.Language, &l.URL, &from, &until); err != nil {
+			return nil, err
+		}
+		if from.Valid {
+			l.VisibleFrom = &from.Time
+		}
+		if until.Valid {
+			l.VisibleUntil = &until.Time
+		}
+		bySession[sessionID] = append(bySession[sessionID], &l)
+	}
+	return bySession, rows.Err()
+}
+
+func (r *sessionStreamRepository) Replace(ctx context.Context, eventID, sessionID string, links []*domain.SessionStreamLink) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM session_stream_links WHERE session_id = $1`, sessionID); err != nil {
+		return err
+	}
+	for i, l := range links {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO session_stream_links (session_id, event_id, kind, language, url, visible_from, visible_until, position)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		`, sessionID, eventID, l.Kind, l.Language, l.URL, l.VisibleFrom, l.VisibleUntil, i)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
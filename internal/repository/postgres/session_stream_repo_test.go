@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"multitrackticketing/internal/domain"
+)
+
+var sessionStreamCols = []string{"session_id", "kind", "language", "url", "visible_from", "visible_until"}
+
+func TestSessionStreamRepository_ListBySessionID(t *testing.T) {
+	ctx := context.Background()
+	from := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("in order", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM session_stream_links\s+WHERE session_id = \$1\s+ORDER BY session_id, position`).
+			WithArgs("sess-1").
+			WillReturnRows(sqlmock.NewRows(sessionStreamCols).
+				AddRow("sess-1", "captions", "en", "https://captions.example/en", from, nil).
+				AddRow("sess-1", "translation", "pt-BR", "https://live.example/pt", nil, nil))
+
+		got, err := NewSessionStreamRepository(db).ListBySessionID(ctx, "sess-1")
+		require.NoError(t, err)
+		require.Equal(t, []*domain.SessionStreamLink{
+			{Kind: domain.StreamKindCaptions, Language: "en", URL: "https://captions.example/en", VisibleFrom: &from},
+			{Kind: domain.StreamKindTranslation, Language: "pt-BR", URL: "https://live.example/pt"},
+		}, got)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM session_stream_links`).
+			WithArgs("sess-1").
+			WillReturnRows(sqlmock.NewRows(sessionStreamCols))
+
+		got, err := NewSessionStreamRepository(db).ListBySessionID(ctx, "sess-1")
+		require.NoError(t, err)
+		require.Equal(t, []*domain.SessionStreamLink{}, got)
+	})
+}
+
+func TestSessionStreamRepository_ListByEventID(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM session_stream_links\s+WHERE event_id = \$1`).
+		WithArgs("ev-1").
+		WillReturnRows(sqlmock.NewRows(sessionStreamCols).
+			AddRow("sess-1", "captions", "en", "https://captions.example/1", nil, nil).
+			AddRow("sess-2", "captions", "en", "https://captions.example/2", nil, nil))
+
+	got, err := NewSessionStreamRepository(db).ListByEventID(ctx, "ev-1")
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, "https://captions.example/2", got["sess-2"][0].URL)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSessionStreamRepository_Replace(t *testing.T) {
+	ctx := context.Background()
+	until := time.Date(2026, 5, 1, 18, 0, 0, 0, time.UTC)
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	links := []*domain.SessionStreamLink{
+		{Kind: domain.StreamKindCaptions, Language: "en", URL: "https://captions.example/en", VisibleUntil: &until},
+		{Kind: domain.StreamKindTranslation, Language: "es", URL: "https://live.example/es"},
+	}
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM session_stream_links WHERE session_id = \$1`).
+		WithArgs("sess-1").
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(`INSERT INTO session_stream_links \(session_id, event_id, kind, language, url, visible_from, visible_until, position\)`).
+		WithArgs("sess-1", "ev-1", "captions", "en", "https://captions.example/en", nil, &until, 0).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO session_stream_links`).
+		WithArgs("sess-1", "ev-1", "translation", "es", "https://live.example/es", nil, nil, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, NewSessionStreamRepository(db).Replace(ctx, "ev-1", "sess-1", links))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"multitrackticketing/internal/domain"
+)
+
+type speakerMergeRepository struct {
+	DB *sql.DB
+}
+
+func NewSpeakerMergeRepository(db *sql.DB) domain.SpeakerMergeRepository {
+	return &speakerMergeRepository{
+		DB: db,
+	}
+}
+
+const speakerMergeColumns = `id, event_id, speaker_id, candidate_id, reason, status, created_at, resolved_at`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSpeakerMergeCandidate(row rowScanner) (*domain.SpeakerMergeCandidate, error) {
+	c := &domain.SpeakerMergeCandidate{}
+	var resolvedAt sql.NullTime
+	if err := row.Scan(&c.ID, &c.EventID, &c.SpeakerID, &c.CandidateID, &c.Reason, &c.Status, &c.CreatedAt, &resolvedAt); err != nil {
+		return nil, err
+	}
+	if resolvedAt.Valid {
+		c.ResolvedAt = &resolvedAt.Time
+	}
+	return c, nil
+}
+
+func (r *speakerMergeRepository) Create(ctx context.Context, c *domain.SpeakerMergeCandidate) error {
+	// The no-op update makes RETURNING report the existing row when the pair is already recorded.
+	query := `
+		INSERT INTO speaker_merge_candidates (event_id, speaker_id, candidate_id, reason, status)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (speaker_id, candidate_id) DO UPDATE SET reason = speaker_merge_candidates.reason
+		RETURNING id, status, created_at
+	`
+	return r.DB.QueryRowContext(ctx, query, c.EventID, c.SpeakerID, c.CandidateID, c.Reason, domain.MergeStatusPending).
+		Scan(&c.ID, &c.Status, &c.CreatedAt)
+}
+
+func (r *speakerMergeRepository) GetByID(ctx context.Context, id string) (*domain.SpeakerMergeCandidate, error) {
+	c, err := scanSpeakerMergeCandidate(r.DB.QueryRowContext(ctx,
+		`SELECT `+speakerMergeColumns+` FROM speaker_merge_candidates WHERE id = $1`, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+func (r *speakerMergeRepository) ListPendingByEventID(ctx context.Context, eventID string) ([]*domain.SpeakerMergeCandidate, error) {
+	rows, err := r.DB.QueryContext(ctx,
+		`SELECT `+speakerMergeColumns+` FROM speaker_merge_candidates WHERE event_id = $1 AND status = $2 ORDER BY created_at, id`,
+		eventID, domain.MergeStatusPending)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []*domain.SpeakerMergeCandidate
+	for rows.Next() {
+		c, err := scanSpeakerMergeCandidate(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
+
+func (r *speakerMergeRepository) Resolve(ctx context.Context, id string, status string) (*domain.SpeakerMergeCandidate, error) {
+	c, err := scanSpeakerMergeCandidate(r.DB.QueryRowContext(ctx, `
+		UPDATE speaker_merge_candidates SET status = $2, resolved_at = NOW()
+		WHERE id = $1 AND status = $3
+		RETURNING `+speakerMergeColumns,
+		id, status, domain.MergeStatusPending))
+	if err == nil {
+		return c, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	// Nothing was updated: tell a missing candidate apart from one already resolved.
+	if _, err := r.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return nil, domain.ErrAlreadyResolved
+}
@@ -0,0 +1,156 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"multitrackticketing/internal/domain"
+)
+
+var speakerMergeTestColumns = []string{"id", "event_id", "speaker_id", "candidate_id", "reason", "status", "created_at", "resolved_at"}
+
+func TestSpeakerMergeRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	createdAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`INSERT INTO speaker_merge_candidates .*ON CONFLICT \(speaker_id, candidate_id\) DO UPDATE`).
+		WithArgs("ev-1", "sp-new", "sp-old", "similar name", domain.MergeStatusPending).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "status", "created_at"}).AddRow("mc-1", domain.MergeStatusPending, createdAt))
+
+	c := &domain.SpeakerMergeCandidate{EventID: "ev-1", SpeakerID: "sp-new", CandidateID: "sp-old", Reason: "similar name"}
+	err = NewSpeakerMergeRepository(db).Create(context.Background(), c)
+
+	require.NoError(t, err)
+	assert.Equal(t, "mc-1", c.ID)
+	assert.Equal(t, domain.MergeStatusPending, c.Status)
+	assert.Equal(t, createdAt, c.CreatedAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSpeakerMergeRepository_GetByID(t *testing.T) {
+	createdAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		mock.ExpectQuery(`SELECT id, event_id, speaker_id, candidate_id, reason, status, created_at, resolved_at FROM speaker_merge_candidates WHERE id = \$1`).
+			WithArgs("mc-1").
+			WillReturnRows(sqlmock.NewRows(speakerMergeTestColumns).
+				AddRow("mc-1", "ev-1", "sp-new", "sp-old", "same name", domain.MergeStatusRejected, createdAt, createdAt))
+
+		got, err := NewSpeakerMergeRepository(db).GetByID(context.Background(), "mc-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.MergeStatusRejected, got.Status)
+		require.NotNil(t, got.ResolvedAt)
+		assert.Equal(t, createdAt, *got.ResolvedAt)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		mock.ExpectQuery(`FROM speaker_merge_candidates WHERE id = \$1`).
+			WithArgs("mc-1").
+			WillReturnError(sql.ErrNoRows)
+
+		_, err = NewSpeakerMergeRepository(db).GetByID(context.Background(), "mc-1")
+
+		require.ErrorIs(t, err, domain.ErrNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestSpeakerMergeRepository_ListPendingByEventID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	createdAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`FROM speaker_merge_candidates WHERE event_id = \$1 AND status = \$2 ORDER BY created_at, id`).
+		WithArgs("ev-1", domain.MergeStatusPending).
+		WillReturnRows(sqlmock.NewRows(speakerMergeTestColumns).
+			AddRow("mc-1", "ev-1", "sp-a", "sp-b", "same name", domain.MergeStatusPending, createdAt, nil).
+			AddRow("mc-2", "ev-1", "sp-c", "sp-d", "similar name", domain.MergeStatusPending, createdAt, nil))
+
+	got, err := NewSpeakerMergeRepository(db).ListPendingByEventID(context.Background(), "ev-1")
+
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, "mc-2", got[1].ID)
+	assert.Nil(t, got[0].ResolvedAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSpeakerMergeRepository_Resolve(t *testing.T) {
+	createdAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		mock    func(mock sqlmock.Sqlmock)
+		wantErr error
+	}{
+		{
+			name: "pending",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`UPDATE speaker_merge_candidates SET status = \$2, resolved_at = NOW\(\)\s+WHERE id = \$1 AND status = \$3`).
+					WithArgs("mc-1", domain.MergeStatusRejected, domain.MergeStatusPending).
+					WillReturnRows(sqlmock.NewRows(speakerMergeTestColumns).
+						AddRow("mc-1", "ev-1", "sp-new", "sp-old", "same name", domain.MergeStatusRejected, createdAt, createdAt))
+			},
+		},
+		{
+			name: "already resolved",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`UPDATE speaker_merge_candidates`).
+					WithArgs("mc-1", domain.MergeStatusRejected, domain.MergeStatusPending).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(`FROM speaker_merge_candidates WHERE id = \$1`).
+					WithArgs("mc-1").
+					WillReturnRows(sqlmock.NewRows(speakerMergeTestColumns).
+						AddRow("mc-1", "ev-1", "sp-new", "sp-old", "same name", domain.MergeStatusRejected, createdAt, createdAt))
+			},
+			wantErr: domain.ErrAlreadyResolved,
+		},
+		{
+			name: "not found",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`UPDATE speaker_merge_candidates`).
+					WithArgs("mc-1", domain.MergeStatusRejected, domain.MergeStatusPending).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(`FROM speaker_merge_candidates WHERE id = \$1`).
+					WithArgs("mc-1").
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+			tt.mock(mock)
+
+			got, err := NewSpeakerMergeRepository(db).Resolve(context.Background(), "mc-1", domain.MergeStatusRejected)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, domain.MergeStatusRejected, got.Status)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
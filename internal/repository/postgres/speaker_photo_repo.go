@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"multitrackticketing/internal/domain"
+)
+
+type speakerPhotoArchiveRepository struct {
+	DB *sql.DB
+}
+
+func NewSpeakerPhotoArchiveRepository(db *sql.DB) domain.SpeakerPhotoArchiveRepository {
+	return &speakerPhotoArchiveRepository{
+		DB: db,
+	}
+}
+
+const speakerPhotoArchiveColumns = `id, event_id, status, speakers, photos, missing, error, created_at, completed_at`
+
+func scanSpeakerPhotoArchive(row rowScanner, a *domain.SpeakerPhotoArchive) error {
+	var completedAt sql.NullTime
+	var missing pq.StringArray
+	if err := row.Scan(&a.ID, &a.EventID, &a.Status, &a.Speakers, &a.Photos, &missing, &a.Error, &a.CreatedAt, &completedAt); err != nil {
+		return err
+	}
+	a.Missing = []string(missing)
+	if a.Missing == nil {
+		a.Missing = []string{}
+	}
+	a.CompletedAt = nil
+	if completedAt.Valid {
+		a.CompletedAt = &completedAt.Time
+	}
+	return nil
+}
+
+func (r *speakerPhotoArchiveRepository) Create(ctx context.Context, a *domain.SpeakerPhotoArchive) (bool, error) {
+	// The partial unique index allows one pending archive per event, so the insert returns no row
+	// while there is one. That one may complete before it is read, so the pair is retried.
+	for range 3 {
+		err := scanSpeakerPhotoArchive(r.DB.QueryRowContext(ctx, `
+			INSERT INTO speaker_photo_archives (event_id, status, speakers)
+			VALUES ($1, 'pending', $2)
+			ON CONFLICT (event_id) WHERE status = 'pending' DO NOTHING
+			RETURNING `+speakerPhotoArchiveColumns,
+			a.EventID, a.Speakers), a)
+		if err == nil {
+			return true, nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return false, err
+		}
+		err = scanSpeakerPhotoArchive(r.DB.QueryRowContext(ctx, `
+			SELECT `+speakerPhotoArchiveColumns+`
+			FROM speaker_photo_archives
+			WHERE event_id = $1 AND status = 'pending'
+		`, a.EventID), a)
+		if err == nil {
+			return false, nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return false, err
+		}
+	}
+	return false, fmt.Errorf("create speaker photo archive for event %s: pending archive kept changing", a.EventID)
+}
+
+func (r *speakerPhotoArchiveRepository) GetByID(ctx context.Context, archiveID string) (*domain.SpeakerPhotoArchive, error) {
+	a := &domain.SpeakerPhotoArchive{}
+	err := scanSpeakerPhotoArchive(r.DB.QueryRowContext(ctx, `
+		SELECT `+speakerPhotoArchiveColumns+`
+		FROM speaker_photo_archives
+		WHERE id = $1
+	`, archiveID), a)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (r *speakerPhotoArchiveRepository) ListPending(ctx context.Context, limit int) ([]*domain.SpeakerPhotoArchive, error) {
+	rows, err := r.DB.QueryContext(ctx, `
+		SELECT `+speakerPhotoArchiveColumns+`
+		FROM speaker_photo_archives
+		WHERE status = 'pending'
+		ORDER BY created_at, id
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	archives := []*domain.SpeakerPhotoArchive{}
+	for rows.Next() {
+		a := &domain.SpeakerPhotoArchive{}
+		if err := scanSpeakerPhotoArchive(rows, a); err != nil {
+			return nil, err
+		}
+		archives = append(archives, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return archives, nil
+}
+
+func (r *speakerPhotoArchiveRepository) Complete(ctx context.Context, a *domain.SpeakerPhotoArchive, zip []byte) error {
+	var completedAt sql.NullTime
+	err := r.DB.QueryRowContext(ctx, `
+		UPDATE speaker_photo_archives
+		SET status = $2, photos = $3, missing = $4, error = $5, archive = $6, completed_at = NOW()
+		WHERE id = $1
+		RETURNING completed_at
+	`, a.ID, a.Status, a.Photos, pq.Array(a.Missing), a.Error, zip).Scan(&completedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+	a.CompletedAt = &completedAt.Time
+	return nil
+}
+
+func (r *speakerPhotoArchiveRepository) GetZip(ctx context.Context, archiveID string) ([]byte, error) {
+	var zip []byte
+	err := r.DB.QueryRowContext(ctx, `
+		SELECT archive FROM speaker_photo_archives WHERE id = $1 AND archive IS NOT NULL
+	`, archiveID).Scan(&zip)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return zip, nil
+}
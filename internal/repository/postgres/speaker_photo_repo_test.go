@@ -0,0 +1,167 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	"multitrackticketing/internal/domain"
+)
+
+var speakerPhotoArchiveCols = []string{"id", "event_id", "status", "speakers", "photos", "missing", "error", "created_at", "completed_at"}
+
+func TestSpeakerPhotoArchiveRepository_Create(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("created", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`INSERT INTO speaker_photo_archives \(event_id, status, speakers\)\s+VALUES \(\$1, 'pending', \$2\)\s+ON CONFLICT \(event_id\) WHERE status = 'pending' DO NOTHING`).
+			WithArgs("ev-1", 60).
+			WillReturnRows(sqlmock.NewRows(speakerPhotoArchiveCols).AddRow("ar-1", "ev-1", "pending", 60, 0, "{}", "", at, nil))
+
+		a := &domain.SpeakerPhotoArchive{EventID: "ev-1", Speakers: 60}
+		created, err := NewSpeakerPhotoArchiveRepository(db).Create(ctx, a)
+		require.NoError(t, err)
+		require.True(t, created)
+		require.Equal(t, &domain.SpeakerPhotoArchive{ID: "ar-1", EventID: "ev-1", Status: domain.PhotoArchivePending, Speakers: 60, Missing: []string{}, CreatedAt: at}, a)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("already pending", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`INSERT INTO speaker_photo_archives`).
+			WithArgs("ev-1", 61).
+			WillReturnRows(sqlmock.NewRows(speakerPhotoArchiveCols))
+		mock.ExpectQuery(`FROM speaker_photo_archives\s+WHERE event_id = \$1 AND status = 'pending'`).
+			WithArgs("ev-1").
+			WillReturnRows(sqlmock.NewRows(speakerPhotoArchiveCols).AddRow("ar-1", "ev-1", "pending", 60, 0, "{}", "", at, nil))
+
+		a := &domain.SpeakerPhotoArchive{EventID: "ev-1", Speakers: 61}
+		created, err := NewSpeakerPhotoArchiveRepository(db).Create(ctx, a)
+		require.NoError(t, err)
+		require.False(t, created)
+		require.Equal(t, "ar-1", a.ID)
+		require.Equal(t, 60, a.Speakers)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestSpeakerPhotoArchiveRepository_GetByID(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM speaker_photo_archives\s+WHERE id = \$1`).
+			WithArgs("ar-1").
+			WillReturnRows(sqlmock.NewRows(speakerPhotoArchiveCols).
+				AddRow("ar-1", "ev-1", "ready", 60, 59, "{\"Ada Lovelace\"}", "", at, at.Add(time.Minute)))
+
+		got, err := NewSpeakerPhotoArchiveRepository(db).GetByID(ctx, "ar-1")
+		require.NoError(t, err)
+		done := at.Add(time.Minute)
+		require.Equal(t, &domain.SpeakerPhotoArchive{
+			ID: "ar-1", EventID: "ev-1", Status: domain.PhotoArchiveReady, Speakers: 60, Photos: 59,
+			Missing: []string{"Ada Lovelace"}, CreatedAt: at, CompletedAt: &done,
+		}, got)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM speaker_photo_archives`).
+			WithArgs("ar-1").
+			WillReturnRows(sqlmock.NewRows(speakerPhotoArchiveCols))
+
+		_, err = NewSpeakerPhotoArchiveRepository(db).GetByID(ctx, "ar-1")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func TestSpeakerPhotoArchiveRepository_ListPending(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`WHERE status = 'pending'\s+ORDER BY created_at, id\s+LIMIT \$1`).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows(speakerPhotoArchiveCols).
+			AddRow("ar-1", "ev-1", "pending", 60, 0, "{}", "", at, nil).
+			AddRow("ar-2", "ev-2", "pending", 80, 0, "{}", "", at, nil))
+
+	got, err := NewSpeakerPhotoArchiveRepository(db).ListPending(ctx, 5)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, "ar-2", got[1].ID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSpeakerPhotoArchiveRepository_Complete(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	zip := []byte("PK")
+	mock.ExpectQuery(`UPDATE speaker_photo_archives\s+SET status = \$2, photos = \$3, missing = \$4, error = \$5, archive = \$6, completed_at = NOW\(\)\s+WHERE id = \$1`).
+		WithArgs("ar-1", domain.PhotoArchiveReady, 59, pq.StringArray{"Ada Lovelace"}, "", zip).
+		WillReturnRows(sqlmock.NewRows([]string{"completed_at"}).AddRow(at))
+
+	a := &domain.SpeakerPhotoArchive{ID: "ar-1", Status: domain.PhotoArchiveReady, Photos: 59, Missing: []string{"Ada Lovelace"}}
+	require.NoError(t, NewSpeakerPhotoArchiveRepository(db).Complete(ctx, a, zip))
+	require.Equal(t, &at, a.CompletedAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSpeakerPhotoArchiveRepository_GetZip(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ready", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT archive FROM speaker_photo_archives WHERE id = \$1 AND archive IS NOT NULL`).
+			WithArgs("ar-1").
+			WillReturnRows(sqlmock.NewRows([]string{"archive"}).AddRow([]byte("PK")))
+
+		got, err := NewSpeakerPhotoArchiveRepository(db).GetZip(ctx, "ar-1")
+		require.NoError(t, err)
+		require.Equal(t, []byte("PK"), got)
+	})
+
+	t.Run("not built", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT archive FROM speaker_photo_archives`).
+			WithArgs("ar-1").
+			WillReturnRows(sqlmock.NewRows([]string{"archive"}))
+
+		_, err = NewSpeakerPhotoArchiveRepository(db).GetZip(ctx, "ar-1")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"multitrackticketing/internal/domain"
+)
+
+type syncRepository struct {
+	DB *sql.DB
+}
+
+func NewSyncRepository(db *sql.DB) domain.SyncRepository {
+	return &syncRepository{
+		DB: db,
+	}
+}
+
+func (r *syncRepository) ListChanges(ctx context.Context, eventID string, since domain.SyncCursor, limit int) ([]*domain.SyncChange, error) {
+	// sync_version, sync_xid and the tombstones are maintained by triggers (migrations 000011 and
+	// 000036). A transaction below the snapshot's xmin has ended, and every one still running has an
+	// ID at or above it, so whatever commits later sorts after the changes listed here.
+	query := `
+		SELECT entity_type, entity_id, xid::text::bigint, version, created, deleted FROM (
+			SELECT 'room' AS entity_type, id::text AS entity_id, sync_xid AS xid, sync_version AS version,
+				(sync_created_xid, sync_created_version) > ($2::xid8, $3) AS created, false AS deleted
+			FROM rooms WHERE event_id = $1 AND (sync_xid, sync_version) > ($2::xid8, $3)
+			UNION ALL
+			SELECT 'session', id::text, sync_xid, sync_version, (sync_created_xid, sync_created_version) > ($2::xid8, $3), false
+			FROM sessions WHERE event_id = $1 AND (sync_xid, sync_version) > ($2::xid8, $3)
+			UNION ALL
+			SELECT 'speaker', id::text, sync_xid, sync_version, (sync_created_xid, sync_created_version) > ($2::xid8, $3), false
+			FROM speakers WHERE event_id = $1 AND (sync_xid, sync_version) > ($2::xid8, $3)
+			UNION ALL
+			SELECT entity_type, entity_id::text, xid, version, false, true
+			FROM sync_tombstones WHERE event_id = $1 AND (xid, version) > ($2::xid8, $3) AND $3 > 0
+		) c
+		WHERE xid < pg_snapshot_xmin(pg_current_snapshot())
+		ORDER BY xid, version
+		LIMIT $4
+	`
+	rows, err := r.DB.QueryContext(ctx, query, eventID, since.TxID, since.Version, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	changes := []*domain.SyncChange{}
+	for rows.Next() {
+		c := &domain.SyncChange{}
+		if err := rows.Scan(&c.EntityType, &c.EntityID, &c.TxID, &c.Version, &c.Created, &c.Deleted); err != nil {
+			return nil, err
+		}
+		changes = append(changes, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
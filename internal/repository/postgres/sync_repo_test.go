@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncRepository_ListChanges(t *testing.T) {
+	ctx := context.Background()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM sync_tombstones WHERE event_id = \$1 AND \(xid, version\) > \(\$2::xid8, \$3\) AND \$3 > 0\s+\) c\s+`+
+		`WHERE xid < pg_snapshot_xmin\(pg_current_snapshot\(\)\)\s+ORDER BY xid, version\s+LIMIT \$4`).
+		WithArgs("ev-1", int64(700), int64(40), 3).
+		WillReturnRows(sqlmock.NewRows([]string{"entity_type", "entity_id", "xid", "version", "created", "deleted"}).
+			AddRow("room", "room-1", int64(700), int64(41), true, false).
+			AddRow("speaker", "spk-1", int64(702), int64(39), false, true).
+			AddRow("session", "sess-1", int64(702), int64(45), false, false))
+
+	got, err := NewSyncRepository(db).ListChanges(ctx, "ev-1", domain.SyncCursor{TxID: 700, Version: 40}, 3)
+	require.NoError(t, err)
+	require.Equal(t, []*domain.SyncChange{
+		{EntityType: domain.SyncEntityRoom, EntityID: "room-1", TxID: 700, Version: 41, Created: true},
+		{EntityType: domain.SyncEntitySpeaker, EntityID: "spk-1", TxID: 702, Version: 39, Deleted: true},
+		{EntityType: domain.SyncEntitySession, EntityID: "sess-1", TxID: 702, Version: 45},
+	}, got)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSyncRepository_ListChanges_Empty(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM rooms WHERE event_id = \$1 AND \(sync_xid, sync_version\) > \(\$2::xid8, \$3\)`).
+		WithArgs("ev-1", int64(0), int64(0), 10).
+		WillReturnRows(sqlmock.NewRows([]string{"entity_type", "entity_id", "xid", "version", "created", "deleted"}))
+
+	got, err := NewSyncRepository(db).ListChanges(context.Background(), "ev-1", domain.SyncCursor{}, 10)
+	require.NoError(t, err)
+	require.Empty(t, got)
+	require.NotNil(t, got)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -87,7 +87,7 @@ func (r *tagRepository) RemoveSessionTag(ctx context.Context, sessionID, tagID s
 
 func (r *tagRepository) RemoveEventTag(ctx context.Context, eventID, tagID string) error {
 	_, err := r.DB.ExecContext(ctx,
-		`DELETE FROM session_tags WHERE tag_id = $1 AND session_id IN (SELECT s.id FROM sessions s JOIN rooms r ON s.room_id = r.id WHERE r.event_id = $2)`,
+		`DELETE FROM session_tags WHERE tag_id = $1 AND session_id IN (SELECT id FROM sessions WHERE event_id = $2)`,
 		tagID, eventID)
 	if err != nil {
 		return err
@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"multitrackticketing/internal/domain"
+)
+
+type userActivityRepository struct {
+	DB *sql.DB
+}
+
+func NewUserActivityRepository(db *sql.DB) domain.UserActivityRepository {
+	return &userActivityRepository{
+		DB: db,
+	}
+}
+
+func (r *userActivityRepository) Create(ctx context.Context, a *domain.UserActivity) error {
+	query := `
+		INSERT INTO user_activity (user_id, actor_id, method, path, status)
+		VALUES ($1, NULLIF($2, '')::uuid, $3, $4, $5)
+		RETURNING id, created_at
+	`
+	return r.DB.QueryRowContext(ctx, query, a.UserID, a.ActorID, a.Method, a.Path, a.Status).
+		Scan(&a.ID, &a.CreatedAt)
+}
+
+func (r *userActivityRepository) ListByUser(ctx context.Context, userID string, limit int) ([]*domain.UserActivity, error) {
+	rows, err := r.DB.QueryContext(ctx, `
+		SELECT a.id, a.user_id, COALESCE(a.actor_id::text, ''),
+			TRIM(COALESCE(u.name, '') || ' ' || COALESCE(u.last_name, '')),
+			a.method, a.path, a.status, a.created_at
+		FROM user_activity a
+		LEFT JOIN users u ON u.id = a.actor_id
+		WHERE a.user_id = $1
+		ORDER BY a.created_at DESC, a.id
+		LIMIT $2
+	`, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	activity := []*domain.UserActivity{}
+	for rows.Next() {
+		a := &domain.UserActivity{}
+		if err := rows.Scan(&a.ID, &a.UserID, &a.ActorID, &a.ActorName, &a.Method, &a.Path, &a.Status, &a.CreatedAt); err != nil {
+			return nil, err
+		}
+		activity = append(activity, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return activity, nil
+}
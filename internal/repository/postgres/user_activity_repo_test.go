@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserActivityRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	created := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`INSERT INTO user_activity \(user_id, actor_id, method, path, status\)`).
+		WithArgs("user-1", "admin-1", "PATCH", "/events/e1", 200).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow("activity-1", created))
+
+	a := &domain.UserActivity{UserID: "user-1", ActorID: "admin-1", Method: "PATCH", Path: "/events/e1", Status: 200}
+	require.NoError(t, NewUserActivityRepository(db).Create(context.Background(), a))
+	assert.Equal(t, "activity-1", a.ID)
+	assert.Equal(t, created, a.CreatedAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserActivityRepository_ListByUser(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	created := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`FROM user_activity a\s+LEFT JOIN users u ON u.id = a.actor_id\s+WHERE a.user_id = \$1`).
+		WithArgs("user-1", 50).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "actor_id", "actor_name", "method", "path", "status", "created_at"}).
+			AddRow("activity-2", "user-1", "admin-1", "Grace Hopper", "PATCH", "/events/e1", 200, created).
+			AddRow("activity-1", "user-1", "", "", "GET", "/events/me", 200, created.Add(-time.Minute)))
+
+	activity, err := NewUserActivityRepository(db).ListByUser(context.Background(), "user-1", 50)
+	require.NoError(t, err)
+	require.Len(t, activity, 2)
+	assert.Equal(t, "Grace Hopper", activity[0].ActorName)
+	assert.Empty(t, activity[1].ActorID, "the actor's account was deleted")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -33,7 +33,23 @@ func TestUserRepository_Update(t *testing.T) {
 			},
 			mock: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec(`UPDATE users`).
-					WithArgs("Alice", "", "alice@example.com", time.Date(2025, 2, 1, 12, 0, 0, 0, time.UTC), "user-uuid-1").
+					WithArgs("Alice", "", nil, "alice@example.com", time.Date(2025, 2, 1, 12, 0, 0, 0, time.UTC), "user-uuid-1").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+		{
+			name: "success with avatar",
+			user: &domain.User{
+				ID:        "user-uuid-1",
+				Email:     "alice@example.com",
+				Name:      "Alice",
+				AvatarURL: "https://cdn.example.com/alice.png",
+				UpdatedAt: time.Date(2025, 2, 1, 12, 0, 0, 0, time.UTC),
+			},
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE users\s+SET name = \$1, last_name = \$2, avatar_url = \$3`).
+					WithArgs("Alice", "", "https://cdn.example.com/alice.png", "alice@example.com", time.Date(2025, 2, 1, 12, 0, 0, 0, time.UTC), "user-uuid-1").
 					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
 			wantErr: false,
@@ -48,7 +64,7 @@ func TestUserRepository_Update(t *testing.T) {
 			},
 			mock: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec(`UPDATE users`).
-					WithArgs("A", "", "a@b.com", sqlmock.AnyArg(), "nonexistent").
+					WithArgs("A", "", nil, "a@b.com", sqlmock.AnyArg(), "nonexistent").
 					WillReturnResult(sqlmock.NewResult(0, 0))
 			},
 			wantErr: true,
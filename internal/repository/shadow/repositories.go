@@ -0,0 +1,210 @@
+package shadow
+
+import (
+	"context"
+
+	"multitrackticketing/internal/domain"
+)
+
+// eventRepository embeds the primary, so every method it does not override, including all writes,
+// goes to the primary alone.
+type eventRepository struct {
+	domain.EventRepository
+	shadow domain.EventRepository
+	c      *Comparer
+}
+
+// NewEventRepository returns primary with its reads compared against shadow under
+// "EventRepository.<Method>". Writes go to primary only.
+func NewEventRepository(primary, shadow domain.EventRepository, c *Comparer) domain.EventRepository {
+	return &eventRepository{EventRepository: primary, shadow: shadow, c: c}
+}
+
+func (r *eventRepository) GetByID(ctx context.Context, id string) (*domain.Event, error) {
+	return compare(ctx, r.c, "EventRepository.GetByID",
+		func(ctx context.Context) (*domain.Event, error) { return r.EventRepository.GetByID(ctx, id) },
+		func(ctx context.Context) (*domain.Event, error) { return r.shadow.GetByID(ctx, id) })
+}
+
+func (r *eventRepository) GetByEventCode(ctx context.Context, eventCode string) (*domain.Event, error) {
+	return compare(ctx, r.c, "EventRepository.GetByEventCode",
+		func(ctx context.Context) (*domain.Event, error) {
+			return r.EventRepository.GetByEventCode(ctx, eventCode)
+		},
+		func(ctx context.Context) (*domain.Event, error) { return r.shadow.GetByEventCode(ctx, eventCode) })
+}
+
+func (r *eventRepository) ListByOwnerID(ctx context.Context, ownerID string) ([]*domain.Event, error) {
+	return compare(ctx, r.c, "EventRepository.ListByOwnerID",
+		func(ctx context.Context) ([]*domain.Event, error) {
+			return r.EventRepository.ListByOwnerID(ctx, ownerID)
+		},
+		func(ctx context.Context) ([]*domain.Event, error) { return r.shadow.ListByOwnerID(ctx, ownerID) })
+}
+
+func (r *eventRepository) ListByOwnerOrTeamMemberID(ctx context.Context, userID string) ([]*domain.Event, error) {
+	return compare(ctx, r.c, "EventRepository.ListByOwnerOrTeamMemberID",
+		func(ctx context.Context) ([]*domain.Event, error) {
+			return r.EventRepository.ListByOwnerOrTeamMemberID(ctx, userID)
+		},
+		func(ctx context.Context) ([]*domain.Event, error) {
+			return r.shadow.ListByOwnerOrTeamMemberID(ctx, userID)
+		})
+}
+
+func (r *eventRepository) ListByTeamMemberID(ctx context.Context, userID string) ([]*domain.Event, error) {
+	return compare(ctx, r.c, "EventRepository.ListByTeamMemberID",
+		func(ctx context.Context) ([]*domain.Event, error) {
+			return r.EventRepository.ListByTeamMemberID(ctx, userID)
+		},
+		func(ctx context.Context) ([]*domain.Event, error) { return r.shadow.ListByTeamMemberID(ctx, userID) })
+}
+
+// searchPage holds Search's two results so they are compared together.
+type searchPage struct {
+	Results []*domain.EventSearchResult
+	Total   int
+}
+
+func (r *eventRepository) Search(ctx context.Context, userID string, params domain.EventSearchParams) ([]*domain.EventSearchResult, int, error) {
+	page, err := compare(ctx, r.c, "EventRepository.Search",
+		func(ctx context.Context) (searchPage, error) {
+			res, total, err := r.EventRepository.Search(ctx, userID, params)
+			return searchPage{res, total}, err
+		},
+		func(ctx context.Context) (searchPage, error) {
+			res, total, err := r.shadow.Search(ctx, userID, params)
+			return searchPage{res, total}, err
+		})
+	return page.Results, page.Total, err
+}
+
+func (r *eventRepository) CountRegistrations(ctx context.Context, eventID string) (int, error) {
+	return compare(ctx, r.c, "EventRepository.CountRegistrations",
+		func(ctx context.Context) (int, error) { return r.EventRepository.CountRegistrations(ctx, eventID) },
+		func(ctx context.Context) (int, error) { return r.shadow.CountRegistrations(ctx, eventID) })
+}
+
+func (r *eventRepository) ListStats(ctx context.Context, eventIDs []string) (map[string]*domain.EventStats, error) {
+	return compare(ctx, r.c, "EventRepository.ListStats",
+		func(ctx context.Context) (map[string]*domain.EventStats, error) {
+			return r.EventRepository.ListStats(ctx, eventIDs)
+		},
+		func(ctx context.Context) (map[string]*domain.EventStats, error) {
+			return r.shadow.ListStats(ctx, eventIDs)
+		})
+}
+
+func (r *eventRepository) GetTheme(ctx context.Context, eventID string) (*domain.EventTheme, error) {
+	return compare(ctx, r.c, "EventRepository.GetTheme",
+		func(ctx context.Context) (*domain.EventTheme, error) { return r.EventRepository.GetTheme(ctx, eventID) },
+		func(ctx context.Context) (*domain.EventTheme, error) { return r.shadow.GetTheme(ctx, eventID) })
+}
+
+// sessionRepository embeds the primary like eventRepository. StreamSessionsByEventID is not
+// shadowed: its rows go to the caller as they are read.
+type sessionRepository struct {
+	domain.SessionRepository
+	shadow domain.SessionRepository
+	c      *Comparer
+}
+
+// NewSessionRepository returns primary with its reads compared against shadow under
+// "SessionRepository.<Method>". Writes go to primary only.
+func NewSessionRepository(primary, shadow domain.SessionRepository, c *Comparer) domain.SessionRepository {
+	return &sessionRepository{SessionRepository: primary, shadow: shadow, c: c}
+}
+
+func (r *sessionRepository) GetSessionByID(ctx context.Context, sessionID string) (*domain.Session, error) {
+	return compare(ctx, r.c, "SessionRepository.GetSessionByID",
+		func(ctx context.Context) (*domain.Session, error) {
+			return r.SessionRepository.GetSessionByID(ctx, sessionID)
+		},
+		func(ctx context.Context) (*domain.Session, error) { return r.shadow.GetSessionByID(ctx, sessionID) })
+}
+
+func (r *sessionRepository) GetRoomByID(ctx context.Context, roomID string) (*domain.Room, error) {
+	return compare(ctx, r.c, "SessionRepository.GetRoomByID",
+		func(ctx context.Context) (*domain.Room, error) { return r.SessionRepository.GetRoomByID(ctx, roomID) },
+		func(ctx context.Context) (*domain.Room, error) { return r.shadow.GetRoomByID(ctx, roomID) })
+}
+
+func (r *sessionRepository) ListRoomsByEventID(ctx context.Context, eventID string) ([]*domain.Room, error) {
+	return compare(ctx, r.c, "SessionRepository.ListRoomsByEventID",
+		func(ctx context.Context) ([]*domain.Room, error) {
+			return r.SessionRepository.ListRoomsByEventID(ctx, eventID)
+		},
+		func(ctx context.Context) ([]*domain.Room, error) { return r.shadow.ListRoomsByEventID(ctx, eventID) })
+}
+
+func (r *sessionRepository) ListTrackOrder(ctx context.Context, eventID string) ([]string, error) {
+	return compare(ctx, r.c, "SessionRepository.ListTrackOrder",
+		func(ctx context.Context) ([]string, error) { return r.SessionRepository.ListTrackOrder(ctx, eventID) },
+		func(ctx context.Context) ([]string, error) { return r.shadow.ListTrackOrder(ctx, eventID) })
+}
+
+func (r *sessionRepository) ListSessionsByEventID(ctx context.Context, eventID string) ([]*domain.Session, error) {
+	return compare(ctx, r.c, "SessionRepository.ListSessionsByEventID",
+		func(ctx context.Context) ([]*domain.Session, error) {
+			return r.SessionRepository.ListSessionsByEventID(ctx, eventID)
+		},
+		func(ctx context.Context) ([]*domain.Session, error) {
+			return r.shadow.ListSessionsByEventID(ctx, eventID)
+		})
+}
+
+func (r *sessionRepository) ListSpeakerIDsBySessionIDs(ctx context.Context, sessionIDs []string) (map[string][]string, error) {
+	return compare(ctx, r.c, "SessionRepository.ListSpeakerIDsBySessionIDs",
+		func(ctx context.Context) (map[string][]string, error) {
+			return r.SessionRepository.ListSpeakerIDsBySessionIDs(ctx, sessionIDs)
+		},
+		func(ctx context.Context) (map[string][]string, error) {
+			return r.shadow.ListSpeakerIDsBySessionIDs(ctx, sessionIDs)
+		})
+}
+
+func (r *sessionRepository) GetSpeakerByID(ctx context.Context, speakerID string) (*domain.Speaker, error) {
+	return compare(ctx, r.c, "SessionRepository.GetSpeakerByID",
+		func(ctx context.Context) (*domain.Speaker, error) {
+			return r.SessionRepository.GetSpeakerByID(ctx, speakerID)
+		},
+		func(ctx context.Context) (*domain.Speaker, error) { return r.shadow.GetSpeakerByID(ctx, speakerID) })
+}
+
+func (r *sessionRepository) ListSpeakersByEventID(ctx context.Context, eventID string) ([]*domain.Speaker, error) {
+	return compare(ctx, r.c, "SessionRepository.ListSpeakersByEventID",
+		func(ctx context.Context) ([]*domain.Speaker, error) {
+			return r.SessionRepository.ListSpeakersByEventID(ctx, eventID)
+		},
+		func(ctx context.Context) ([]*domain.Speaker, error) {
+			return r.shadow.ListSpeakersByEventID(ctx, eventID)
+		})
+}
+
+func (r *sessionRepository) ListSpeakersBySessionID(ctx context.Context, sessionID string) ([]*domain.Speaker, error) {
+	return compare(ctx, r.c, "SessionRepository.ListSpeakersBySessionID",
+		func(ctx context.Context) ([]*domain.Speaker, error) {
+			return r.SessionRepository.ListSpeakersBySessionID(ctx, sessionID)
+		},
+		func(ctx context.Context) ([]*domain.Speaker, error) {
+			return r.shadow.ListSpeakersBySessionID(ctx, sessionID)
+		})
+}
+
+func (r *sessionRepository) ListSessionIDsBySpeakerID(ctx context.Context, speakerID string) ([]string, error) {
+	return compare(ctx, r.c, "SessionRepository.ListSessionIDsBySpeakerID",
+		func(ctx context.Context) ([]string, error) {
+			return r.SessionRepository.ListSessionIDsBySpeakerID(ctx, speakerID)
+		},
+		func(ctx context.Context) ([]string, error) { return r.shadow.ListSessionIDsBySpeakerID(ctx, speakerID) })
+}
+
+func (r *sessionRepository) ListSessionsByIDs(ctx context.Context, sessionIDs []string) ([]*domain.Session, error) {
+	return compare(ctx, r.c, "SessionRepository.ListSessionsByIDs",
+		func(ctx context.Context) ([]*domain.Session, error) {
+			return r.SessionRepository.ListSessionsByIDs(ctx, sessionIDs)
+		},
+		func(ctx context.Context) ([]*domain.Session, error) {
+			return r.shadow.ListSessionsByIDs(ctx, sessionIDs)
+		})
+}
@@ -0,0 +1,101 @@
+// Package shadow runs repository reads against a second implementation alongside the one in
+// service and logs where their results differ, so a replacement (a cache, another database, a
+// rewritten query) can be checked on production traffic before it is swapped in. Writes only go to
+// the primary, and callers always get the primary's result.
+package shadow
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+// DefaultTimeout bounds each shadow read when NewComparer gets a non-positive timeout.
+const DefaultTimeout = 2 * time.Second
+
+// Comparer decides which reads are shadowed and logs the divergences. It is safe for concurrent use.
+type Comparer struct {
+	logger  *slog.Logger
+	rate    float64
+	timeout time.Duration
+	random  func() float64
+}
+
+// NewComparer returns a Comparer that shadows rate of the reads, from 0 (none) to 1 (all). A sampled
+// read waits for its shadow for at most timeout, so only sampled requests pay for it.
+func NewComparer(logger *slog.Logger, rate float64, timeout time.Duration) *Comparer {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &Comparer{logger: logger, rate: rate, timeout: timeout, random: rand.Float64}
+}
+
+// compare returns primary's result. For a sampled share of calls it runs shadow at the same time and
+// logs under method when the two results differ.
+func compare[T any](ctx context.Context, c *Comparer, method string, primary, shadow func(ctx context.Context) (T, error)) (T, error) {
+	if c.rate <= 0 || c.random() >= c.rate {
+		return primary(ctx)
+	}
+	type result struct {
+		v   T
+		err error
+	}
+	done := make(chan result, 1)
+	sctx, cancel := context.WithTimeout(ctx, c.timeout)
+	go func() {
+		defer cancel()
+		v, err := shadow(sctx)
+		done <- result{v, err}
+	}()
+	v, err := primary(ctx)
+	s := <-done
+	if ctx.Err() == nil {
+		c.check(ctx, method, v, err, s.v, s.err)
+	}
+	return v, err
+}
+
+// check logs when exactly one side failed, when only one side found nothing, or when both succeeded
+// with different results. Results are compared as JSON, so unexported fields are ignored and times
+// compare by their encoded form. Only digests of the results are logged, never their content.
+func (c *Comparer) check(ctx context.Context, method string, pv any, perr error, sv any, serr error) {
+	if perr != nil || serr != nil {
+		if perr != nil && serr != nil && errors.Is(perr, domain.ErrNotFound) == errors.Is(serr, domain.ErrNotFound) {
+			return
+		}
+		c.logger.WarnContext(ctx, "shadow read diverged", "method", method, "primary_err", errString(perr), "shadow_err", errString(serr))
+		return
+	}
+	pj, pmErr := json.Marshal(pv)
+	sj, smErr := json.Marshal(sv)
+	if pmErr != nil || smErr != nil {
+		c.logger.WarnContext(ctx, "shadow read not compared", "method", method, "err", errors.Join(pmErr, smErr))
+		return
+	}
+	if bytes.Equal(pj, sj) {
+		return
+	}
+	c.logger.WarnContext(ctx, "shadow read diverged", "method", method,
+		"primary_digest", digest(pj), "primary_bytes", len(pj),
+		"shadow_digest", digest(sj), "shadow_bytes", len(sj))
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+func digest(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:6])
+}
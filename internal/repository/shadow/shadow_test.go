@@ -0,0 +1,114 @@
+package shadow
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeEventRepo serves GetByID from events and counts the calls; other methods are not used.
+type fakeEventRepo struct {
+	domain.EventRepository
+	events map[string]*domain.Event
+	err    error
+	calls  int
+}
+
+func (f *fakeEventRepo) GetByID(ctx context.Context, id string) (*domain.Event, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	e, ok := f.events[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return e, nil
+}
+
+func (f *fakeEventRepo) Delete(ctx context.Context, id string) error {
+	f.calls++
+	return nil
+}
+
+func newTestComparer(rate float64) (*Comparer, *bytes.Buffer) {
+	var buf bytes.Buffer
+	c := NewComparer(slog.New(slog.NewTextHandler(&buf, nil)), rate, time.Second)
+	c.random = func() float64 { return 0.5 }
+	return c, &buf
+}
+
+func TestEventRepository_GetByID(t *testing.T) {
+	event := &domain.Event{ID: "e1", Name: "GopherCon"}
+	tests := []struct {
+		name       string
+		rate       float64
+		shadow     *fakeEventRepo
+		id         string
+		wantShadow int
+		wantLog    string
+	}{
+		{name: "not sampled", rate: 0.1, shadow: &fakeEventRepo{events: map[string]*domain.Event{"e1": {ID: "e1"}}}, id: "e1"},
+		{name: "same result", rate: 1, shadow: &fakeEventRepo{events: map[string]*domain.Event{"e1": {ID: "e1", Name: "GopherCon"}}}, id: "e1", wantShadow: 1},
+		{name: "different result", rate: 1, shadow: &fakeEventRepo{events: map[string]*domain.Event{"e1": {ID: "e1", Name: "Gophercon"}}}, id: "e1", wantShadow: 1, wantLog: "primary_digest="},
+		{name: "both not found", rate: 1, shadow: &fakeEventRepo{}, id: "missing", wantShadow: 1},
+		{name: "only shadow not found", rate: 1, shadow: &fakeEventRepo{}, id: "e1", wantShadow: 1, wantLog: "shadow_err=\"not found\""},
+		{name: "only shadow failed", rate: 1, shadow: &fakeEventRepo{err: errors.New("connection refused")}, id: "e1", wantShadow: 1, wantLog: "connection refused"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, logs := newTestComparer(tt.rate)
+			primary := &fakeEventRepo{events: map[string]*domain.Event{"e1": event}}
+			repo := NewEventRepository(primary, tt.shadow, c)
+
+			got, err := repo.GetByID(context.Background(), tt.id)
+
+			if tt.id == "e1" {
+				require.NoError(t, err)
+				assert.Same(t, event, got)
+			} else {
+				assert.ErrorIs(t, err, domain.ErrNotFound)
+			}
+			assert.Equal(t, 1, primary.calls)
+			assert.Equal(t, tt.wantShadow, tt.shadow.calls)
+			if tt.wantLog == "" {
+				assert.Empty(t, logs.String())
+			} else {
+				assert.Contains(t, logs.String(), "shadow read diverged")
+				assert.Contains(t, logs.String(), "method=EventRepository.GetByID")
+				assert.Contains(t, logs.String(), tt.wantLog)
+				assert.NotContains(t, logs.String(), "GopherCon")
+			}
+		})
+	}
+}
+
+func TestEventRepository_WritesGoToPrimaryOnly(t *testing.T) {
+	c, _ := newTestComparer(1)
+	primary, shadow := &fakeEventRepo{}, &fakeEventRepo{}
+
+	require.NoError(t, NewEventRepository(primary, shadow, c).Delete(context.Background(), "e1"))
+
+	assert.Equal(t, 1, primary.calls)
+	assert.Zero(t, shadow.calls)
+}
+
+func TestCompare_CanceledRequestIsNotLogged(t *testing.T) {
+	c, logs := newTestComparer(1)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	_, err := compare(ctx, c, "Test.Read",
+		func(ctx context.Context) (int, error) { cancel(); return 0, ctx.Err() },
+		func(ctx context.Context) (int, error) { return 1, nil })
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, logs.String())
+}
@@ -0,0 +1,345 @@
+package sdkgen
+
+import (
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+)
+
+// GenerateGo returns the source of a Go client package named pkg for spec.
+// The output is gofmt-formatted.
+func GenerateGo(spec *Spec, pkg string) ([]byte, error) {
+	a, err := normalize(spec)
+	if err != nil {
+		return nil, err
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "// Code generated by sdkgen from docs/swagger.json. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "// Package %s is a typed client for the Multi-Track Ticketing API.\n", pkg)
+	fmt.Fprintf(&b, "package %s\n", pkg)
+	b.WriteString("\nimport (\n")
+	for _, imp := range goImports(a) {
+		fmt.Fprintf(&b, "\t%q\n", imp)
+	}
+	b.WriteString(")\n")
+	b.WriteString(goRuntime)
+
+	hasPagination := false
+	for _, m := range a.Models {
+		writeGoModel(&b, a, m)
+		if m.Name == "PaginationMeta" {
+			hasPagination = true
+		}
+	}
+	if hasPagination {
+		b.WriteString(goPaginationHelpers)
+	}
+	for _, ep := range a.Endpoints {
+		writeGoEndpoint(&b, a, ep)
+	}
+
+	src, err := format.Source([]byte(b.String()))
+	if err != nil {
+		return nil, fmt.Errorf("format generated go: %w", err)
+	}
+	return src, nil
+}
+
+func writeGoModel(b *strings.Builder, a *api, m model) {
+	if m.Schema.Description != "" {
+		fmt.Fprintf(b, "\n// %s %s\n", m.Name, m.Schema.Description)
+	} else {
+		fmt.Fprintf(b, "\n// %s mirrors the %s schema.\n", m.Name, m.Key)
+	}
+	if len(m.Schema.Properties) == 0 {
+		fmt.Fprintf(b, "type %s map[string]any\n", m.Name)
+		return
+	}
+	fmt.Fprintf(b, "type %s struct {\n", m.Name)
+	for _, prop := range sortedKeys(m.Schema.Properties) {
+		s := m.Schema.Properties[prop]
+		typ := goType(a, s)
+		tag := prop
+		if m.IsRequest || s.Nullable {
+			// Request bodies only send the fields the caller set, so PATCH endpoints can
+			// tell "not provided" from a zero value.
+			if !strings.HasPrefix(typ, "[]") && !strings.HasPrefix(typ, "map[") && !strings.HasPrefix(typ, "*") {
+				typ = "*" + typ
+			}
+			tag += ",omitempty"
+		}
+		fmt.Fprintf(b, "\t%s %s `json:%q`\n", goName(prop), typ, tag)
+	}
+	b.WriteString("}\n")
+}
+
+func goType(a *api, s *Schema) string {
+	if s == nil {
+		return "any"
+	}
+	if s.Ref != "" {
+		return "*" + a.refName(s.Ref)
+	}
+	switch s.Type {
+	case "string":
+		return "string"
+	case "integer":
+		return "int"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "array":
+		return "[]" + strings.TrimPrefix(goType(a, s.Items), "*")
+	case "object":
+		return "map[string]any"
+	}
+	return "any"
+}
+
+func writeGoEndpoint(b *strings.Builder, a *api, ep endpoint) {
+	hasQuery := len(ep.QueryParams) > 0
+	if hasQuery {
+		fmt.Fprintf(b, "\n// %sParams holds the optional query parameters of %s. Zero values are omitted.\n", ep.Name, ep.Name)
+		fmt.Fprintf(b, "type %sParams struct {\n", ep.Name)
+		for _, q := range ep.QueryParams {
+			fmt.Fprintf(b, "\t%s %s\n", goName(q.Name), goType(a, &Schema{Type: q.Type}))
+		}
+		b.WriteString("}\n")
+	}
+
+	args := []string{"ctx context.Context"}
+	for _, p := range ep.PathParams {
+		args = append(args, p+" string")
+	}
+	if hasQuery {
+		args = append(args, fmt.Sprintf("params *%sParams", ep.Name))
+	}
+	if ep.Body != nil {
+		args = append(args, "body "+strings.TrimPrefix(goType(a, ep.Body), "*"))
+	}
+	result := ""
+	if ep.Result != nil {
+		result = goType(a, ep.Result)
+	}
+
+	fmt.Fprintf(b, "\n// %s calls %s %s.", ep.Name, ep.Method, ep.Path)
+	if ep.Summary != "" {
+		fmt.Fprintf(b, " %s.", strings.TrimSuffix(ep.Summary, "."))
+	}
+	b.WriteString("\n")
+	if result != "" {
+		fmt.Fprintf(b, "func (c *Client) %s(%s) (%s, error) {\n", ep.Name, strings.Join(args, ", "), result)
+	} else {
+		fmt.Fprintf(b, "func (c *Client) %s(%s) error {\n", ep.Name, strings.Join(args, ", "))
+	}
+
+	var path []string
+	for _, seg := range pathSegments(ep.Path) {
+		if strings.HasPrefix(seg, "{") {
+			path = append(path, "url.PathEscape("+strings.Trim(seg, "{}")+")")
+		} else {
+			path = append(path, fmt.Sprintf("%q", seg))
+		}
+	}
+	fmt.Fprintf(b, "\tpath := %s\n", strings.Join(path, " + "))
+
+	query := "nil"
+	if hasQuery {
+		query = "q"
+		b.WriteString("\tq := url.Values{}\n\tif params != nil {\n")
+		for _, p := range ep.QueryParams {
+			field := "params." + goName(p.Name)
+			switch goType(a, &Schema{Type: p.Type}) {
+			case "int":
+				fmt.Fprintf(b, "\t\tif %s != 0 {\n\t\t\tq.Set(%q, strconv.Itoa(%s))\n\t\t}\n", field, p.Name, field)
+			case "bool":
+				fmt.Fprintf(b, "\t\tif %s {\n\t\t\tq.Set(%q, \"true\")\n\t\t}\n", field, p.Name)
+			case "float64":
+				fmt.Fprintf(b, "\t\tif %s != 0 {\n\t\t\tq.Set(%q, strconv.FormatFloat(%s, 'f', -1, 64))\n\t\t}\n", field, p.Name, field)
+			default:
+				fmt.Fprintf(b, "\t\tif %s != \"\" {\n\t\t\tq.Set(%q, %s)\n\t\t}\n", field, p.Name, field)
+			}
+		}
+		b.WriteString("\t}\n")
+	}
+	body := "nil"
+	if ep.Body != nil {
+		body = "body"
+	}
+	if result != "" {
+		fmt.Fprintf(b, "\tvar out %s\n", result)
+		fmt.Fprintf(b, "\terr := c.do(ctx, %q, path, %s, %t, %s, &out)\n", ep.Method, query, ep.Auth, body)
+		b.WriteString("\treturn out, err\n}\n")
+	} else {
+		fmt.Fprintf(b, "\treturn c.do(ctx, %q, path, %s, %t, %s, nil)\n}\n", ep.Method, query, ep.Auth, body)
+	}
+}
+
+// goImports returns the imports of the generated file; strconv is only needed for numeric query parameters.
+func goImports(a *api) []string {
+	imports := []string{"bytes", "context", "encoding/json", "fmt", "io", "net/http", "net/url", "strings", "sync"}
+	for _, ep := range a.Endpoints {
+		for _, q := range ep.QueryParams {
+			if q.Type == "integer" || q.Type == "number" {
+				imports = append(imports, "strconv")
+				sort.Strings(imports)
+				return imports
+			}
+		}
+	}
+	return imports
+}
+
+func sortedKeys(m map[string]*Schema) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// goRuntime is the hand-written part of the Go client: envelope, errors, auth, and transport.
+const goRuntime = `
+// Envelope is the body of every API response: Data on success, Error on failure.
+type Envelope[T any] struct {
+	Data  T         ` + "`json:\"data\"`" + `
+	Error *APIError ` + "`json:\"error\"`" + `
+}
+
+// ResponseError is returned by Client methods when the API responds with a non-2xx status.
+type ResponseError struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("api error %d %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// TokenSource returns the bearer token to send with authenticated requests.
+type TokenSource func(ctx context.Context) (string, error)
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient sets the underlying HTTP client. Defaults to http.DefaultClient.
+func WithHTTPClient(h *http.Client) Option {
+	return func(c *Client) { c.httpClient = h }
+}
+
+// WithToken sets a static bearer token (e.g. from VerifyLoginCode).
+func WithToken(token string) Option {
+	return func(c *Client) { c.SetToken(token) }
+}
+
+// WithTokenSource sets a function that supplies the bearer token per request.
+func WithTokenSource(ts TokenSource) Option {
+	return func(c *Client) { c.tokenSource = ts }
+}
+
+// Client calls the API. It is safe for concurrent use.
+type Client struct {
+	baseURL     string
+	httpClient  *http.Client
+	mu          sync.RWMutex
+	tokenSource TokenSource
+}
+
+// NewClient returns a Client for the API at baseURL (e.g. "https://api.example.com").
+func NewClient(baseURL string, opts ...Option) *Client {
+	c := &Client{baseURL: strings.TrimRight(baseURL, "/"), httpClient: http.DefaultClient}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// SetToken replaces the bearer token used for authenticated requests.
+func (c *Client) SetToken(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tokenSource = func(context.Context) (string, error) { return token, nil }
+}
+
+func (c *Client) token(ctx context.Context) (string, error) {
+	c.mu.RLock()
+	ts := c.tokenSource
+	c.mu.RUnlock()
+	if ts == nil {
+		return "", nil
+	}
+	return ts(ctx)
+}
+
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, auth bool, body, out any) error {
+	u := c.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("encode request: %w", err)
+		}
+		reqBody = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if auth {
+		token, err := c.token(ctx)
+		if err != nil {
+			return fmt.Errorf("token: %w", err)
+		}
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var env Envelope[json.RawMessage]
+		if err := json.NewDecoder(resp.Body).Decode(&env); err == nil && env.Error != nil {
+			return &ResponseError{StatusCode: resp.StatusCode, Code: env.Error.Code, Message: env.Error.Message}
+		}
+		return &ResponseError{StatusCode: resp.StatusCode, Message: http.StatusText(resp.StatusCode)}
+	}
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	env := Envelope[any]{Data: out}
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
+`
+
+const goPaginationHelpers = `
+// HasNextPage reports whether more pages follow p.
+func (p *PaginationMeta) HasNextPage() bool {
+	return p != nil && p.Page < p.TotalPages
+}
+
+// NextPage returns the page number after p, or 0 when p is the last page.
+func (p *PaginationMeta) NextPage() int {
+	if !p.HasNextPage() {
+		return 0
+	}
+	return p.Page + 1
+}
+`
@@ -0,0 +1,188 @@
+package sdkgen
+
+import (
+	"encoding/json"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSpec = `{
+  "basePath": "/",
+  "paths": {
+    "/events/{eventID}/invitations": {
+      "get": {
+        "operationId": "ListEventInvitations",
+        "summary": "List invitations",
+        "security": [{"BearerAuth": []}],
+        "parameters": [
+          {"name": "eventID", "in": "path", "type": "string", "required": true},
+          {"name": "page", "in": "query", "type": "integer"},
+          {"name": "search", "in": "query", "type": "string"}
+        ],
+        "responses": {"200": {"schema": {"$ref": "#/definitions/controllers.ListEventInvitationsSuccessResponse"}}}
+      }
+    },
+    "/events/{eventID}/tags/{tagID}": {
+      "patch": {
+        "operationId": "UpdateEventTag",
+        "security": [{"BearerAuth": []}],
+        "parameters": [
+          {"name": "eventID", "in": "path", "type": "string", "required": true},
+          {"name": "tagID", "in": "path", "type": "string", "required": true},
+          {"name": "body", "in": "body", "required": true, "schema": {"$ref": "#/definitions/controllers.UpdateEventTagRequest"}}
+        ],
+        "responses": {"200": {"schema": {"$ref": "#/definitions/controllers.UpdateEventTagSuccessResponse"}}}
+      },
+      "delete": {
+        "operationId": "RemoveEventTag",
+        "security": [{"BearerAuth": []}],
+        "parameters": [
+          {"name": "eventID", "in": "path", "type": "string", "required": true},
+          {"name": "tagID", "in": "path", "type": "string", "required": true}
+        ],
+        "responses": {"204": {"description": "No Content"}}
+      }
+    },
+    "/public/events/{eventCode}/offline-bundle": {
+      "get": {
+        "operationId": "GetOfflineBundle",
+        "produces": ["application/zip"],
+        "parameters": [{"name": "eventCode", "in": "path", "type": "string", "required": true}],
+        "responses": {"200": {"schema": {"type": "file"}}}
+      }
+    },
+    "/auth/login/request": {
+      "post": {
+        "operationId": "RequestLoginCode",
+        "parameters": [{"name": "body", "in": "body", "schema": {"$ref": "#/definitions/controllers.RequestLoginCodeRequest"}}],
+        "responses": {"200": {"schema": {"$ref": "#/definitions/helpers.APIResponse"}}}
+      }
+    }
+  },
+  "definitions": {
+    "controllers.ListEventInvitationsResponse": {"type": "object", "properties": {
+      "items": {"type": "array", "items": {"$ref": "#/definitions/domain.EventInvitation"}},
+      "pagination": {"$ref": "#/definitions/helpers.PaginationMeta"}
+    }},
+    "controllers.ListEventInvitationsSuccessResponse": {"type": "object", "properties": {
+      "data": {"$ref": "#/definitions/controllers.ListEventInvitationsResponse"},
+      "error": {"$ref": "#/definitions/helpers.APIError"}
+    }},
+    "controllers.RequestLoginCodeRequest": {"type": "object", "properties": {"email": {"type": "string"}}},
+    "controllers.UpdateEventTagRequest": {"type": "object", "properties": {"name": {"type": "string"}}},
+    "controllers.UpdateEventTagSuccessResponse": {"type": "object", "properties": {
+      "data": {"$ref": "#/definitions/domain.Tag"},
+      "error": {"$ref": "#/definitions/helpers.APIError"}
+    }},
+    "domain.EventInvitation": {"type": "object", "properties": {"id": {"type": "string"}, "email": {"type": "string"}, "note": {"type": "string", "x-nullable": true}}},
+    "domain.Tag": {"type": "object", "properties": {"id": {"type": "string"}, "name": {"type": "string"}}},
+    "helpers.APIError": {"type": "object", "properties": {"code": {"type": "string"}, "message": {"type": "string"}}},
+    "helpers.APIResponse": {"type": "object", "properties": {"data": {}, "error": {"$ref": "#/definitions/helpers.APIError"}}},
+    "helpers.PaginationMeta": {"type": "object", "properties": {
+      "page": {"type": "integer"}, "page_size": {"type": "integer"}, "total": {"type": "integer"}, "total_pages": {"type": "integer"}
+    }}
+  }
+}`
+
+func loadTestSpec(t *testing.T) *Spec {
+	t.Helper()
+	var spec Spec
+	require.NoError(t, json.Unmarshal([]byte(testSpec), &spec))
+	return &spec
+}
+
+func TestGenerateGo(t *testing.T) {
+	src, err := GenerateGo(loadTestSpec(t), "client")
+	require.NoError(t, err)
+
+	_, err = parser.ParseFile(token.NewFileSet(), "client.go", src, parser.AllErrors)
+	require.NoError(t, err, "generated Go must parse")
+
+	out := string(src)
+	assert.Contains(t, out, "package client")
+	assert.Contains(t, out, "type Envelope[T any] struct")
+	assert.Contains(t, out, "func (c *Client) ListEventInvitations(ctx context.Context, eventID string, params *ListEventInvitationsParams) (*ListEventInvitationsResponse, error)")
+	assert.Contains(t, out, "func (c *Client) UpdateEventTag(ctx context.Context, eventID string, tagID string, body UpdateEventTagRequest) (*Tag, error)")
+	assert.Contains(t, out, "func (c *Client) RemoveEventTag(ctx context.Context, eventID string, tagID string) error")
+	assert.Contains(t, out, "func (c *Client) RequestLoginCode(ctx context.Context, body RequestLoginCodeRequest) error")
+	assert.Contains(t, out, `path := "/events/" + url.PathEscape(eventID) + "/tags/" + url.PathEscape(tagID)`)
+	assert.Contains(t, out, "func (p *PaginationMeta) HasNextPage() bool")
+	assert.Contains(t, out, "Name *string `json:\"name,omitempty\"`", "request body fields are optional pointers")
+	assert.Contains(t, out, "PageSize   int `json:\"page_size\"`")
+	assert.Contains(t, out, "Note  *string `json:\"note,omitempty\"`", "x-nullable properties are optional pointers")
+	assert.NotContains(t, out, "UpdateEventTagSuccessResponse", "envelope definitions are replaced by Envelope[T]")
+	assert.NotContains(t, out, "GetOfflineBundle", "file downloads are not generated")
+}
+
+func TestGenerateTypeScript(t *testing.T) {
+	src, err := GenerateTypeScript(loadTestSpec(t))
+	require.NoError(t, err)
+
+	out := string(src)
+	assert.Contains(t, out, "export interface Envelope<T>")
+	assert.Contains(t, out, "export interface UpdateEventTagRequest {\n  name?: string;\n}")
+	assert.Contains(t, out, "  note?: string;\n")
+	assert.Contains(t, out, "listEventInvitations(eventID: string, params: ListEventInvitationsParams = {}): Promise<ListEventInvitationsResponse>")
+	assert.Contains(t, out, "`/events/${encodeURIComponent(eventID)}/tags/${encodeURIComponent(tagID)}`")
+	assert.Contains(t, out, "removeEventTag(eventID: string, tagID: string): Promise<void>")
+	assert.Contains(t, out, `this.request<void>("POST", `+"`/auth/login/request`"+`, { auth: false, body })`)
+	assert.Contains(t, out, "export function hasNextPage(")
+	assert.NotContains(t, out, "UpdateEventTagSuccessResponse")
+	assert.NotContains(t, out, "getOfflineBundle")
+}
+
+func TestNormalize_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(*Spec)
+		wantErr string
+	}{
+		{
+			name: "missing operationId",
+			mutate: func(s *Spec) {
+				s.Paths["/events/{eventID}/tags/{tagID}"]["delete"].OperationID = ""
+			},
+			wantErr: "missing operationId",
+		},
+		{
+			name: "duplicate operationId",
+			mutate: func(s *Spec) {
+				s.Paths["/events/{eventID}/tags/{tagID}"]["delete"].OperationID = "UpdateEventTag"
+			},
+			wantErr: "duplicate operationId",
+		},
+		{
+			name: "type name collision",
+			mutate: func(s *Spec) {
+				s.Definitions["controllers.Tag"] = &Schema{Type: "object"}
+			},
+			wantErr: "both map to type Tag",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := loadTestSpec(t)
+			tt.mutate(spec)
+			_, err := normalize(spec)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestGoName(t *testing.T) {
+	tests := map[string]string{
+		"room_id":          "RoomID",
+		"speaker_ids":      "SpeakerIDs",
+		"how_to_get_there": "HowToGetThere",
+		"page_size":        "PageSize",
+		"profile_picture":  "ProfilePicture",
+	}
+	for in, want := range tests {
+		assert.Equal(t, want, goName(in), in)
+	}
+}
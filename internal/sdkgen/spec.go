@@ -0,0 +1,292 @@
+// Package sdkgen generates typed API clients (Go and TypeScript) from the
+// Swagger 2.0 document produced by swag in docs/swagger.json.
+package sdkgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Spec is the subset of a Swagger 2.0 document the generators need.
+type Spec struct {
+	BasePath    string                           `json:"basePath"`
+	Paths       map[string]map[string]*Operation `json:"paths"`
+	Definitions map[string]*Schema               `json:"definitions"`
+}
+
+// Operation is a single HTTP operation (method + path).
+type Operation struct {
+	OperationID string                `json:"operationId"`
+	Summary     string                `json:"summary"`
+	Produces    []string              `json:"produces"`
+	Parameters  []Parameter           `json:"parameters"`
+	Responses   map[string]Response   `json:"responses"`
+	Security    []map[string][]string `json:"security"`
+}
+
+// Parameter is a path, query, or body parameter of an operation.
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Type     string  `json:"type"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+}
+
+// Response is one documented response of an operation.
+type Response struct {
+	Description string  `json:"description"`
+	Schema      *Schema `json:"schema"`
+}
+
+// Schema is the subset of JSON Schema used by swag output.
+type Schema struct {
+	Type        string             `json:"type"`
+	Ref         string             `json:"$ref"`
+	Items       *Schema            `json:"items"`
+	Properties  map[string]*Schema `json:"properties"`
+	Description string             `json:"description"`
+	// Nullable marks an optional property of a nested request model, such as a field a PATCH item
+	// may leave out. Set with the struct tag extensions:"x-nullable".
+	Nullable bool `json:"x-nullable"`
+}
+
+// LoadSpec reads and parses a Swagger 2.0 JSON document.
+func LoadSpec(path string) (*Spec, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read spec: %w", err)
+	}
+	var spec Spec
+	if err := json.Unmarshal(b, &spec); err != nil {
+		return nil, fmt.Errorf("parse spec: %w", err)
+	}
+	return &spec, nil
+}
+
+// endpoint is an operation normalized for code generation.
+type endpoint struct {
+	Name        string
+	Method      string
+	Path        string
+	Summary     string
+	PathParams  []string
+	QueryParams []Parameter
+	Body        *Schema
+	Result      *Schema
+	Auth        bool
+}
+
+// model is a named definition emitted as a type in the generated clients.
+type model struct {
+	Key       string
+	Name      string
+	Schema    *Schema
+	IsRequest bool
+}
+
+// api is the normalized view of a Spec shared by the Go and TypeScript generators.
+type api struct {
+	Endpoints []endpoint
+	Models    []model
+	// typeNames maps a definition key (e.g. "domain.Event") to its generated type name ("Event").
+	typeNames map[string]string
+}
+
+// normalize validates spec and builds the generator view: endpoints sorted by path and method,
+// envelope definitions ({data, error}) dropped in favor of the generic Envelope type, and
+// definitions renamed without their Go package prefix. Operations that only produce files (not
+// JSON) are left out; the clients decode every response as an envelope.
+func normalize(spec *Spec) (*api, error) {
+	a := &api{typeNames: make(map[string]string)}
+
+	owners := make(map[string]string)
+	for key := range spec.Definitions {
+		name := key[strings.LastIndex(key, ".")+1:]
+		if prev, ok := owners[name]; ok {
+			return nil, fmt.Errorf("definitions %q and %q both map to type %s", prev, key, name)
+		}
+		owners[name] = key
+		a.typeNames[key] = name
+	}
+
+	requestBodies := make(map[string]bool)
+	paths := make([]string, 0, len(spec.Paths))
+	for p := range spec.Paths {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	seen := make(map[string]bool)
+	for _, p := range paths {
+		methods := make([]string, 0, len(spec.Paths[p]))
+		for m := range spec.Paths[p] {
+			methods = append(methods, m)
+		}
+		sort.Strings(methods)
+		for _, m := range methods {
+			op := spec.Paths[p][m]
+			if op.OperationID == "" {
+				return nil, fmt.Errorf("%s %s: missing operationId (add @ID to the handler godoc)", strings.ToUpper(m), p)
+			}
+			if seen[op.OperationID] {
+				return nil, fmt.Errorf("duplicate operationId %q", op.OperationID)
+			}
+			seen[op.OperationID] = true
+			if len(op.Produces) > 0 && !slices.Contains(op.Produces, "application/json") {
+				continue
+			}
+			ep := endpoint{
+				Name:    op.OperationID,
+				Method:  strings.ToUpper(m),
+				Path:    p,
+				Summary: op.Summary,
+				Auth:    len(op.Security) > 0,
+			}
+			for _, prm := range op.Parameters {
+				switch prm.In {
+				case "path":
+					ep.PathParams = append(ep.PathParams, prm.Name)
+				case "query":
+					ep.QueryParams = append(ep.QueryParams, prm)
+				case "body":
+					ep.Body = prm.Schema
+					if prm.Schema != nil && prm.Schema.Ref != "" {
+						requestBodies[refKey(prm.Schema.Ref)] = true
+					}
+				}
+			}
+			ep.Result = resultSchema(spec, op)
+			a.Endpoints = append(a.Endpoints, ep)
+		}
+	}
+
+	keys := make([]string, 0, len(spec.Definitions))
+	for k := range spec.Definitions {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return a.typeNames[keys[i]] < a.typeNames[keys[j]] })
+	for _, k := range keys {
+		s := spec.Definitions[k]
+		if isEnvelope(s) {
+			continue
+		}
+		a.Models = append(a.Models, model{Key: k, Name: a.typeNames[k], Schema: s, IsRequest: requestBodies[k]})
+	}
+	return a, nil
+}
+
+// resultSchema returns the schema of the data field of the first 2xx response, or nil when the
+// operation returns no data (204, or an untyped envelope).
+func resultSchema(spec *Spec, op *Operation) *Schema {
+	codes := make([]string, 0, len(op.Responses))
+	for code := range op.Responses {
+		if strings.HasPrefix(code, "2") {
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		s := op.Responses[code].Schema
+		if s == nil || s.Ref == "" {
+			continue
+		}
+		def := spec.Definitions[refKey(s.Ref)]
+		if def == nil || !isEnvelope(def) {
+			return s
+		}
+		data := def.Properties["data"]
+		if data == nil || (data.Type == "" && data.Ref == "") {
+			return nil
+		}
+		return data
+	}
+	return nil
+}
+
+func isEnvelope(s *Schema) bool {
+	if len(s.Properties) != 2 {
+		return false
+	}
+	_, hasData := s.Properties["data"]
+	_, hasError := s.Properties["error"]
+	return hasData && hasError
+}
+
+func refKey(ref string) string {
+	return strings.TrimPrefix(ref, "#/definitions/")
+}
+
+func (a *api) refName(ref string) string {
+	if name, ok := a.typeNames[refKey(ref)]; ok {
+		return name
+	}
+	return refKey(ref)
+}
+
+// commonInitialisms are upper-cased whole when they appear as a word in a Go identifier.
+var commonInitialisms = map[string]bool{
+	"api": true, "id": true, "ids": true, "url": true, "http": true, "json": true, "uuid": true,
+}
+
+// goName converts a snake_case or camelCase identifier to an exported Go name (e.g. room_id -> RoomID).
+func goName(s string) string {
+	var b strings.Builder
+	for _, word := range splitWords(s) {
+		lower := strings.ToLower(word)
+		if commonInitialisms[lower] {
+			if lower == "ids" {
+				b.WriteString("IDs")
+			} else {
+				b.WriteString(strings.ToUpper(lower))
+			}
+			continue
+		}
+		r := []rune(word)
+		r[0] = unicode.ToUpper(r[0])
+		b.WriteString(string(r))
+	}
+	return b.String()
+}
+
+// lowerFirst lower-cases the first rune, for TypeScript method names.
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
+
+func splitWords(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool { return r == '_' || r == '-' || r == ' ' })
+}
+
+// pathSegments splits a path template into literal and parameter parts, e.g.
+// "/events/{eventID}/rooms" -> ["/events/", "{eventID}", "/rooms"].
+func pathSegments(path string) []string {
+	var out []string
+	for path != "" {
+		i := strings.Index(path, "{")
+		if i < 0 {
+			out = append(out, path)
+			break
+		}
+		if i > 0 {
+			out = append(out, path[:i])
+		}
+		j := strings.Index(path[i:], "}")
+		if j < 0 {
+			out = append(out, path[i:])
+			break
+		}
+		out = append(out, path[i:i+j+1])
+		path = path[i+j+1:]
+	}
+	return out
+}
@@ -0,0 +1,236 @@
+package sdkgen
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GenerateTypeScript returns the source of a dependency-free TypeScript client for spec.
+// The client uses the global fetch (or one passed in ClientOptions).
+func GenerateTypeScript(spec *Spec) ([]byte, error) {
+	a, err := normalize(spec)
+	if err != nil {
+		return nil, err
+	}
+	var b strings.Builder
+	b.WriteString("// Code generated by sdkgen from docs/swagger.json. DO NOT EDIT.\n")
+
+	hasPagination := false
+	for _, m := range a.Models {
+		writeTSModel(&b, a, m)
+		if m.Name == "PaginationMeta" {
+			hasPagination = true
+		}
+	}
+	b.WriteString(tsRuntime)
+	if hasPagination {
+		b.WriteString(tsPaginationHelpers)
+	}
+	for _, ep := range a.Endpoints {
+		if len(ep.QueryParams) > 0 {
+			fmt.Fprintf(&b, "\n/** Optional query parameters of %s. */\nexport interface %sParams {\n", lowerFirst(ep.Name), ep.Name)
+			for _, q := range ep.QueryParams {
+				fmt.Fprintf(&b, "  %s?: %s;\n", q.Name, tsType(a, &Schema{Type: q.Type}))
+			}
+			b.WriteString("}\n")
+		}
+	}
+
+	b.WriteString("\nexport class Client {\n")
+	b.WriteString(tsClientBody)
+	for _, ep := range a.Endpoints {
+		writeTSEndpoint(&b, a, ep)
+	}
+	b.WriteString("}\n")
+	return []byte(b.String()), nil
+}
+
+func writeTSModel(b *strings.Builder, a *api, m model) {
+	if m.Schema.Description != "" {
+		fmt.Fprintf(b, "\n/** %s */\n", m.Schema.Description)
+	} else {
+		fmt.Fprintf(b, "\n/** Mirrors the %s schema. */\n", m.Key)
+	}
+	if len(m.Schema.Properties) == 0 {
+		fmt.Fprintf(b, "export type %s = Record<string, unknown>;\n", m.Name)
+		return
+	}
+	fmt.Fprintf(b, "export interface %s {\n", m.Name)
+	opt := ""
+	if m.IsRequest {
+		opt = "?"
+	}
+	for _, prop := range sortedKeys(m.Schema.Properties) {
+		s := m.Schema.Properties[prop]
+		if s.Description != "" {
+			fmt.Fprintf(b, "  /** %s */\n", s.Description)
+		}
+		typ := tsType(a, s)
+		if s.Ref != "" && !m.IsRequest {
+			typ += " | null"
+		}
+		propOpt := opt
+		if s.Nullable {
+			propOpt = "?"
+		}
+		fmt.Fprintf(b, "  %s%s: %s;\n", prop, propOpt, typ)
+	}
+	b.WriteString("}\n")
+}
+
+func tsType(a *api, s *Schema) string {
+	if s == nil {
+		return "unknown"
+	}
+	if s.Ref != "" {
+		return a.refName(s.Ref)
+	}
+	switch s.Type {
+	case "string":
+		return "string"
+	case "integer", "number":
+		return "number"
+	case "boolean":
+		return "boolean"
+	case "array":
+		return tsType(a, s.Items) + "[]"
+	case "object":
+		return "Record<string, unknown>"
+	}
+	return "unknown"
+}
+
+func writeTSEndpoint(b *strings.Builder, a *api, ep endpoint) {
+	var args []string
+	for _, p := range ep.PathParams {
+		args = append(args, p+": string")
+	}
+	if len(ep.QueryParams) > 0 {
+		args = append(args, fmt.Sprintf("params: %sParams = {}", ep.Name))
+	}
+	if ep.Body != nil {
+		args = append(args, "body: "+tsType(a, ep.Body))
+	}
+	result := "void"
+	if ep.Result != nil {
+		result = tsType(a, ep.Result)
+	}
+
+	var path strings.Builder
+	path.WriteString("`")
+	for _, seg := range pathSegments(ep.Path) {
+		if strings.HasPrefix(seg, "{") {
+			fmt.Fprintf(&path, "${encodeURIComponent(%s)}", strings.Trim(seg, "{}"))
+		} else {
+			path.WriteString(seg)
+		}
+	}
+	path.WriteString("`")
+
+	opts := []string{fmt.Sprintf("auth: %t", ep.Auth)}
+	if len(ep.QueryParams) > 0 {
+		opts = append(opts, "query: params")
+	}
+	if ep.Body != nil {
+		opts = append(opts, "body")
+	}
+
+	fmt.Fprintf(b, "\n  /** %s %s", ep.Method, ep.Path)
+	if ep.Summary != "" {
+		fmt.Fprintf(b, ": %s", ep.Summary)
+	}
+	b.WriteString(" */\n")
+	fmt.Fprintf(b, "  %s(%s): Promise<%s> {\n", lowerFirst(ep.Name), strings.Join(args, ", "), result)
+	fmt.Fprintf(b, "    return this.request<%s>(%q, %s, { %s });\n  }\n", result, ep.Method, path.String(), strings.Join(opts, ", "))
+}
+
+// tsRuntime is the hand-written part of the TypeScript client: envelope, errors, and auth.
+const tsRuntime = `
+/** Body of every API response: data on success, error on failure. */
+export interface Envelope<T> {
+  data: T;
+  error: APIError | null;
+}
+
+/** Thrown by Client methods when the API responds with a non-2xx status. */
+export class ResponseError extends Error {
+  constructor(
+    readonly status: number,
+    readonly code: string,
+    message: string,
+  ) {
+    super(message);
+    this.name = "ResponseError";
+  }
+}
+
+/** Supplies the bearer token for authenticated requests. */
+export type TokenSource = () => string | undefined | Promise<string | undefined>;
+
+export interface ClientOptions {
+  /** API origin, e.g. "https://api.example.com". */
+  baseUrl: string;
+  /** Static token (e.g. from verifyLoginCode) or a function returning one per request. */
+  token?: string | TokenSource;
+  /** fetch implementation; defaults to the global fetch. */
+  fetch?: typeof fetch;
+}
+
+interface RequestOptions {
+  auth: boolean;
+  query?: object;
+  body?: unknown;
+}
+`
+
+const tsPaginationHelpers = `
+/** Reports whether more pages follow p. */
+export function hasNextPage(p: PaginationMeta | null | undefined): boolean {
+  return !!p && p.page < p.total_pages;
+}
+`
+
+const tsClientBody = `  private readonly baseUrl: string;
+  private readonly fetchImpl: typeof fetch;
+  private token?: string | TokenSource;
+
+  constructor(options: ClientOptions) {
+    this.baseUrl = options.baseUrl.replace(/\/+$/, "");
+    this.fetchImpl = options.fetch ?? fetch.bind(globalThis);
+    this.token = options.token;
+  }
+
+  /** Replaces the bearer token used for authenticated requests. */
+  setToken(token: string | TokenSource | undefined): void {
+    this.token = token;
+  }
+
+  private async request<T>(method: string, path: string, opts: RequestOptions): Promise<T> {
+    let url = this.baseUrl + path;
+    if (opts.query) {
+      const q = new URLSearchParams();
+      for (const [k, v] of Object.entries(opts.query)) {
+        if (v !== undefined && v !== "") q.set(k, String(v));
+      }
+      const qs = q.toString();
+      if (qs) url += "?" + qs;
+    }
+    const headers: Record<string, string> = { Accept: "application/json" };
+    if (opts.body !== undefined) headers["Content-Type"] = "application/json";
+    if (opts.auth) {
+      const token = typeof this.token === "function" ? await this.token() : this.token;
+      if (token) headers["Authorization"] = "Bearer " + token;
+    }
+    const res = await this.fetchImpl(url, {
+      method,
+      headers,
+      body: opts.body !== undefined ? JSON.stringify(opts.body) : undefined,
+    });
+    if (res.status === 204) return undefined as T;
+    const env = (await res.json().catch(() => null)) as Envelope<T> | null;
+    if (!res.ok) {
+      throw new ResponseError(res.status, env?.error?.code ?? "", env?.error?.message ?? res.statusText);
+    }
+    return (env ? env.data : undefined) as T;
+  }
+`
@@ -0,0 +1,214 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"multitrackticketing/internal/domain"
+)
+
+type abuseReportService struct {
+	eventRepo      domain.EventRepository
+	sessionRepo    domain.SessionRepository
+	abuseRepo      domain.AbuseReportRepository
+	roleRepo       domain.RoleRepository
+	purger         domain.CachePurger
+	policy         domain.ModerationPolicy
+	contextTimeout time.Duration
+}
+
+// NewAbuseReportService returns a domain.AbuseReportService. A nil purger leaves CDN caches alone
+// when content is unlisted or relisted.
+func NewAbuseReportService(eventRepo domain.EventRepository, sessionRepo domain.SessionRepository, abuseRepo domain.AbuseReportRepository, roleRepo domain.RoleRepository, purger domain.CachePurger, policy domain.ModerationPolicy, timeout time.Duration) domain.AbuseReportService {
+	return &abuseReportService{
+		eventRepo:      eventRepo,
+		sessionRepo:    sessionRepo,
+		abuseRepo:      abuseRepo,
+		roleRepo:       roleRepo,
+		purger:         purger,
+		policy:         policy,
+		contextTimeout: timeout,
+	}
+}
+
+func (s *abuseReportService) ReportAbuse(ctx context.Context, req *domain.AbuseReportRequest) (*domain.AbuseReportOutcome, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	reason := strings.TrimSpace(strings.ToLower(req.Reason))
+	details := strings.TrimSpace(req.Details)
+	switch reason {
+	case domain.AbuseReasonSpam, domain.AbuseReasonOffensive, domain.AbuseReasonImpersonation, domain.AbuseReasonIllegal, domain.AbuseReasonOther:
+	default:
+		return nil, fmt.Errorf("reason must be one of spam, offensive, impersonation, illegal and other: %w", domain.ErrInvalidInput)
+	}
+	if utf8.RuneCountInString(details) > domain.MaxAbuseReportDetailsLength {
+		return nil, fmt.Errorf("details must be at most %d characters: %w", domain.MaxAbuseReportDetailsLength, domain.ErrInvalidInput)
+	}
+
+	event, err := s.eventRepo.GetByEventCode(ctx, strings.ToLower(strings.TrimSpace(req.EventCode)))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event by code: %w", err)
+	}
+	target := domain.AbuseTarget{Type: req.TargetType, ID: req.TargetID}
+	if err := s.checkTarget(ctx, event, &target); err != nil {
+		return nil, err
+	}
+
+	// Only a hash of the address is stored: enough to count each reporter once.
+	sum := sha256.Sum256([]byte(req.RemoteIP))
+	report := &domain.AbuseReport{
+		EventID:      event.ID,
+		TargetType:   target.Type,
+		TargetID:     target.ID,
+		Reason:       reason,
+		Details:      details,
+		ReporterHash: hex.EncodeToString(sum[:]),
+	}
+	created, err := s.abuseRepo.Create(ctx, report)
+	if err != nil {
+		return nil, fmt.Errorf("create abuse report: %w", err)
+	}
+	outcome := &domain.AbuseReportOutcome{Target: target}
+	if !created || s.policy.Threshold <= 0 {
+		return outcome, nil
+	}
+
+	reporters, err := s.abuseRepo.CountOpenReporters(ctx, target)
+	if err != nil {
+		return nil, fmt.Errorf("count abuse reporters: %w", err)
+	}
+	if reporters < s.policy.Threshold {
+		return outcome, nil
+	}
+	changed, err := s.abuseRepo.Unlist(ctx, target, event.ID, time.Now().Add(s.policy.UnlistFor))
+	if err != nil {
+		return nil, fmt.Errorf("unlist reported content: %w", err)
+	}
+	if changed {
+		outcome.Unlisted, outcome.Reporters = true, reporters
+		s.purgeEvent(ctx, event.ID)
+	}
+	return outcome, nil
+}
+
+func (s *abuseReportService) ListModerationQueue(ctx context.Context, adminID string, params domain.PaginationParams) ([]*domain.ModerationItem, int, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := requireAdmin(ctx, s.roleRepo, adminID); err != nil {
+		return nil, 0, err
+	}
+	items, total, err := s.abuseRepo.ListQueue(ctx, time.Now(), params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list moderation queue: %w", err)
+	}
+	return items, total, nil
+}
+
+func (s *abuseReportService) GetModerationItem(ctx context.Context, adminID string, target domain.AbuseTarget) (*domain.ModerationItem, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := requireAdmin(ctx, s.roleRepo, adminID); err != nil {
+		return nil, err
+	}
+	item, err := s.abuseRepo.GetQueueItem(ctx, target, time.Now())
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get moderation item: %w", err)
+	}
+	return item, nil
+}
+
+func (s *abuseReportService) ResolveModerationItem(ctx context.Context, adminID string, target domain.AbuseTarget, action string) error {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := requireAdmin(ctx, s.roleRepo, adminID); err != nil {
+		return err
+	}
+	var status string
+	switch action {
+	case domain.ModerationDismiss:
+		status = domain.AbuseReportDismissed
+	case domain.ModerationUnlist:
+		status = domain.AbuseReportActioned
+	default:
+		return fmt.Errorf("action must be dismiss or unlist: %w", domain.ErrInvalidInput)
+	}
+	item, err := s.abuseRepo.GetQueueItem(ctx, target, time.Now())
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("get moderation item: %w", err)
+	}
+	if err := s.abuseRepo.Resolve(ctx, target, status, adminID, time.Now()); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("resolve abuse reports: %w", err)
+	}
+	s.purgeEvent(ctx, item.EventID)
+	return nil
+}
+
+// checkTarget returns ErrNotFound unless the target is the event or one of its sessions or
+// speakers. An event target may leave its ID empty; it is set to the event's.
+func (s *abuseReportService) checkTarget(ctx context.Context, event *domain.Event, target *domain.AbuseTarget) error {
+	var eventID string
+	switch target.Type {
+	case domain.AbuseTargetEvent:
+		if target.ID == "" {
+			target.ID = event.ID
+		}
+		eventID = target.ID
+	case domain.AbuseTargetSession:
+		sess, err := s.sessionRepo.GetSessionByID(ctx, target.ID)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return domain.ErrNotFound
+			}
+			return fmt.Errorf("get session: %w", err)
+		}
+		eventID = sess.EventID
+	case domain.AbuseTargetSpeaker:
+		sp, err := s.sessionRepo.GetSpeakerByID(ctx, target.ID)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return domain.ErrNotFound
+			}
+			return fmt.Errorf("get speaker: %w", err)
+		}
+		eventID = sp.EventID
+	default:
+		return fmt.Errorf("target_type must be one of event, session and speaker: %w", domain.ErrInvalidInput)
+	}
+	if eventID != event.ID {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// purgeEvent evicts the event's public responses from the CDN so an unlisting or relisting shows
+// at once. Failures are reported as best effort; the responses expire on their own.
+func (s *abuseReportService) purgeEvent(ctx context.Context, eventID string) {
+	if s.purger == nil {
+		return
+	}
+	if err := s.purger.Purge(ctx, domain.EventSurrogateKey(eventID)); err != nil {
+		domain.ReportBestEffort(ctx, fmt.Errorf("cdn purge for event %s: %w", eventID, err))
+	}
+}
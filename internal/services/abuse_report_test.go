@@ -0,0 +1,235 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAbuseRepo is an in-memory AbuseReportRepository.
+type fakeAbuseRepo struct {
+	reports []*domain.AbuseReport
+	// unlisted maps each unlisted target to its event; unlistings here never end.
+	unlisted map[domain.AbuseTarget]string
+	unlists  int
+}
+
+func newFakeAbuseRepo() *fakeAbuseRepo {
+	return &fakeAbuseRepo{unlisted: make(map[domain.AbuseTarget]string)}
+}
+
+func (f *fakeAbuseRepo) open(target domain.AbuseTarget) []*domain.AbuseReport {
+	var out []*domain.AbuseReport
+	for _, r := range f.reports {
+		if r.TargetType == target.Type && r.TargetID == target.ID && r.Status == domain.AbuseReportOpen {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+func (f *fakeAbuseRepo) Create(ctx context.Context, rep *domain.AbuseReport) (bool, error) {
+	for _, r := range f.open(domain.AbuseTarget{Type: rep.TargetType, ID: rep.TargetID}) {
+		if r.ReporterHash == rep.ReporterHash {
+			return false, nil
+		}
+	}
+	rep.ID, rep.Status = "r-"+rep.ReporterHash[:8], domain.AbuseReportOpen
+	f.reports = append(f.reports, rep)
+	return true, nil
+}
+
+func (f *fakeAbuseRepo) CountOpenReporters(ctx context.Context, target domain.AbuseTarget) (int, error) {
+	return len(f.open(target)), nil
+}
+
+func (f *fakeAbuseRepo) Unlist(ctx context.Context, target domain.AbuseTarget, eventID string, until time.Time) (bool, error) {
+	if _, ok := f.unlisted[target]; ok {
+		return false, nil
+	}
+	f.unlisted[target] = eventID
+	f.unlists++
+	return true, nil
+}
+
+func (f *fakeAbuseRepo) ListUnlisted(ctx context.Context, eventID string, now time.Time) ([]domain.AbuseTarget, error) {
+	var out []domain.AbuseTarget
+	for t, ev := range f.unlisted {
+		if ev == eventID {
+			out = append(out, t)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeAbuseRepo) ListQueue(ctx context.Context, now time.Time, params domain.PaginationParams) ([]*domain.ModerationItem, int, error) {
+	items := []*domain.ModerationItem{}
+	seen := make(map[domain.AbuseTarget]bool)
+	for _, r := range f.reports {
+		t := domain.AbuseTarget{Type: r.TargetType, ID: r.TargetID}
+		if r.Status != domain.AbuseReportOpen || seen[t] {
+			continue
+		}
+		seen[t] = true
+		it, _ := f.GetQueueItem(ctx, t, now)
+		it.Reports = nil
+		items = append(items, it)
+	}
+	return items, len(items), nil
+}
+
+func (f *fakeAbuseRepo) GetQueueItem(ctx context.Context, target domain.AbuseTarget, now time.Time) (*domain.ModerationItem, error) {
+	open := f.open(target)
+	if len(open) == 0 {
+		return nil, domain.ErrNotFound
+	}
+	_, unlisted := f.unlisted[target]
+	return &domain.ModerationItem{TargetType: target.Type, TargetID: target.ID, EventID: open[0].EventID, OpenReports: len(open), Unlisted: unlisted, Reports: open}, nil
+}
+
+func (f *fakeAbuseRepo) Resolve(ctx context.Context, target domain.AbuseTarget, status, resolvedBy string, at time.Time) error {
+	open := f.open(target)
+	if len(open) == 0 {
+		return domain.ErrNotFound
+	}
+	for _, r := range open {
+		r.Status, r.ResolvedBy, r.ResolvedAt = status, resolvedBy, &at
+	}
+	if status == domain.AbuseReportActioned {
+		f.unlisted[target] = open[0].EventID
+	} else {
+		delete(f.unlisted, target)
+	}
+	return nil
+}
+
+// fakePurger records the surrogate keys purged.
+type fakePurger struct {
+	keys []string
+	err  error // if set, Purge returns this error
+}
+
+func (f *fakePurger) Purge(ctx context.Context, keys ...string) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.keys = append(f.keys, keys...)
+	return nil
+}
+
+func TestAbuseReportService(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func() (domain.AbuseReportService, *fakeAbuseRepo, *fakePurger) {
+		eventRepo := newFakeEventRepo()
+		eventRepo.byID["ev-1"] = &domain.Event{ID: "ev-1", Name: "GopherCon", EventCode: "gc25", OwnerID: "user-owner"}
+		eventRepo.byID["ev-2"] = &domain.Event{ID: "ev-2", Name: "RustConf", EventCode: "rc25", OwnerID: "user-owner"}
+		sessions := newFakeSessionRepo()
+		sessions.sessions = []*domain.Session{{ID: "s-1", EventID: "ev-1"}, {ID: "s-2", EventID: "ev-2"}}
+		sessions.speakers = []*domain.Speaker{{ID: "sp-1", EventID: "ev-1"}}
+		roles := newFakeRoleRepo()
+		roles.listByUID["admin-1"] = []*domain.Role{{ID: "r-2", Code: domain.AdminRole}}
+		abuse := newFakeAbuseRepo()
+		purger := &fakePurger{}
+		svc := NewAbuseReportService(eventRepo, sessions, abuse, roles, purger, domain.ModerationPolicy{Threshold: 2, UnlistFor: 72 * time.Hour}, 5*time.Second)
+		return svc, abuse, purger
+	}
+	report := func(targetType, targetID, ip string) *domain.AbuseReportRequest {
+		return &domain.AbuseReportRequest{EventCode: "gc25", TargetType: targetType, TargetID: targetID, Reason: "Spam", RemoteIP: ip}
+	}
+	file := func(svc domain.AbuseReportService, req *domain.AbuseReportRequest) error {
+		_, err := svc.ReportAbuse(ctx, req)
+		return err
+	}
+
+	t.Run("unlists at the threshold of distinct reporters", func(t *testing.T) {
+		svc, abuse, purger := setup()
+		require.NoError(t, file(svc, report(domain.AbuseTargetSession, "s-1", "203.0.113.7")))
+		require.NoError(t, file(svc, report(domain.AbuseTargetSession, "s-1", "203.0.113.7")), "a repeat is accepted")
+		assert.Len(t, abuse.reports, 1, "but counts once")
+		assert.Empty(t, abuse.unlisted)
+
+		outcome, err := svc.ReportAbuse(ctx, report(domain.AbuseTargetSession, "s-1", "198.51.100.2"))
+		require.NoError(t, err)
+		target := domain.AbuseTarget{Type: domain.AbuseTargetSession, ID: "s-1"}
+		assert.Equal(t, &domain.AbuseReportOutcome{Target: target, Unlisted: true, Reporters: 2}, outcome)
+		assert.Equal(t, "ev-1", abuse.unlisted[target])
+		assert.Equal(t, []string{domain.EventSurrogateKey("ev-1")}, purger.keys)
+		assert.Equal(t, domain.AbuseReasonSpam, abuse.reports[0].Reason)
+		assert.NotContains(t, abuse.reports[0].ReporterHash, "203.0.113.7")
+	})
+
+	t.Run("purge failure does not fail the report", func(t *testing.T) {
+		svc, abuse, purger := setup()
+		purger.err = errors.New("cdn down")
+		ctx, failures := domain.WithBestEffortFailures(ctx)
+		require.NoError(t, file(svc, report(domain.AbuseTargetSession, "s-1", "203.0.113.7")))
+		_, err := svc.ReportAbuse(ctx, report(domain.AbuseTargetSession, "s-1", "198.51.100.2"))
+		require.NoError(t, err)
+		assert.Len(t, abuse.unlisted, 1)
+		require.Len(t, failures.Errors(), 1, "the failure is reported instead")
+		assert.ErrorIs(t, failures.Errors()[0], purger.err)
+	})
+
+	t.Run("event target defaults to the event", func(t *testing.T) {
+		svc, abuse, _ := setup()
+		require.NoError(t, file(svc, report(domain.AbuseTargetEvent, "", "203.0.113.7")))
+		require.Len(t, abuse.reports, 1)
+		assert.Equal(t, "ev-1", abuse.reports[0].TargetID)
+	})
+
+	t.Run("target must be the event's", func(t *testing.T) {
+		svc, _, _ := setup()
+		require.ErrorIs(t, file(svc, report(domain.AbuseTargetSession, "s-2", "203.0.113.7")), domain.ErrNotFound)
+		require.ErrorIs(t, file(svc, report(domain.AbuseTargetSpeaker, "sp-missing", "203.0.113.7")), domain.ErrNotFound)
+		require.ErrorIs(t, file(svc, report(domain.AbuseTargetEvent, "ev-2", "203.0.113.7")), domain.ErrNotFound)
+		req := report(domain.AbuseTargetSpeaker, "sp-1", "203.0.113.7")
+		req.EventCode = "zzzz"
+		require.ErrorIs(t, file(svc, req), domain.ErrNotFound)
+	})
+
+	t.Run("rejects bad input", func(t *testing.T) {
+		svc, _, _ := setup()
+		require.ErrorIs(t, file(svc, report("room", "s-1", "203.0.113.7")), domain.ErrInvalidInput)
+		req := report(domain.AbuseTargetSession, "s-1", "203.0.113.7")
+		req.Reason = "boring"
+		require.ErrorIs(t, file(svc, req), domain.ErrInvalidInput)
+		req = report(domain.AbuseTargetSession, "s-1", "203.0.113.7")
+		req.Details = strings.Repeat("a", domain.MaxAbuseReportDetailsLength+1)
+		require.ErrorIs(t, file(svc, req), domain.ErrInvalidInput)
+	})
+
+	t.Run("admins moderate", func(t *testing.T) {
+		svc, abuse, purger := setup()
+		target := domain.AbuseTarget{Type: domain.AbuseTargetSpeaker, ID: "sp-1"}
+		require.NoError(t, file(svc, report(target.Type, target.ID, "203.0.113.7")))
+		require.NoError(t, file(svc, report(target.Type, target.ID, "198.51.100.2")))
+
+		_, _, err := svc.ListModerationQueue(ctx, "user-owner", domain.PaginationParams{Page: 1, PageSize: 20})
+		require.ErrorIs(t, err, domain.ErrForbidden)
+		items, total, err := svc.ListModerationQueue(ctx, "admin-1", domain.PaginationParams{Page: 1, PageSize: 20})
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+		assert.True(t, items[0].Unlisted)
+
+		item, err := svc.GetModerationItem(ctx, "admin-1", target)
+		require.NoError(t, err)
+		assert.Len(t, item.Reports, 2)
+
+		require.ErrorIs(t, svc.ResolveModerationItem(ctx, "admin-1", target, "ban"), domain.ErrInvalidInput)
+		require.NoError(t, svc.ResolveModerationItem(ctx, "admin-1", target, domain.ModerationDismiss))
+		assert.Empty(t, abuse.unlisted, "dismissing lists it again")
+		assert.Len(t, purger.keys, 2)
+		assert.Equal(t, "admin-1", abuse.reports[0].ResolvedBy)
+		require.ErrorIs(t, svc.ResolveModerationItem(ctx, "admin-1", target, domain.ModerationUnlist), domain.ErrNotFound, "nothing is open any more")
+		_, err = svc.GetModerationItem(ctx, "admin-1", target)
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
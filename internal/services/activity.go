@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+type activityService struct {
+	activityRepo   domain.UserActivityRepository
+	userRepo       domain.UserRepository
+	roleRepo       domain.RoleRepository
+	contextTimeout time.Duration
+}
+
+// NewActivityService returns a domain.ActivityService.
+func NewActivityService(activityRepo domain.UserActivityRepository, userRepo domain.UserRepository, roleRepo domain.RoleRepository, timeout time.Duration) domain.ActivityService {
+	return &activityService{
+		activityRepo:   activityRepo,
+		userRepo:       userRepo,
+		roleRepo:       roleRepo,
+		contextTimeout: timeout,
+	}
+}
+
+func (s *activityService) AuthorizeActAs(ctx context.Context, actorID, userID string) error {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if actorID == userID {
+		return fmt.Errorf("cannot act as yourself: %w", domain.ErrInvalidInput)
+	}
+	if err := requireAdmin(ctx, s.roleRepo, actorID); err != nil {
+		return err
+	}
+	if _, err := s.userRepo.GetByID(ctx, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, domain.ErrUserNotFound) {
+			return domain.ErrUserNotFound
+		}
+		return fmt.Errorf("get user: %w", err)
+	}
+	return nil
+}
+
+func (s *activityService) RecordActivity(ctx context.Context, a *domain.UserActivity) error {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := s.activityRepo.Create(ctx, a); err != nil {
+		return fmt.Errorf("create user activity: %w", err)
+	}
+	return nil
+}
+
+func (s *activityService) ListMyActivity(ctx context.Context, userID string, limit int) ([]*domain.UserActivity, error) {
+	if limit < 1 || limit > domain.MaxUserActivity {
+		return nil, fmt.Errorf("limit must be between 1 and %d: %w", domain.MaxUserActivity, domain.ErrInvalidInput)
+	}
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	activity, err := s.activityRepo.ListByUser(ctx, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list user activity: %w", err)
+	}
+	return activity, nil
+}
@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeUserActivityRepo is an in-memory UserActivityRepository.
+type fakeUserActivityRepo struct {
+	entries []*domain.UserActivity
+}
+
+func (f *fakeUserActivityRepo) Create(ctx context.Context, a *domain.UserActivity) error {
+	a.CreatedAt = time.Now()
+	f.entries = append(f.entries, a)
+	return nil
+}
+
+func (f *fakeUserActivityRepo) ListByUser(ctx context.Context, userID string, limit int) ([]*domain.UserActivity, error) {
+	out := []*domain.UserActivity{}
+	for i := len(f.entries) - 1; i >= 0 && len(out) < limit; i-- {
+		if f.entries[i].UserID == userID {
+			out = append(out, f.entries[i])
+		}
+	}
+	return out, nil
+}
+
+func TestActivityService(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func() (domain.ActivityService, *fakeUserActivityRepo) {
+		repo := &fakeUserActivityRepo{}
+		users := newFakeUserRepoForSchedule()
+		users.addUser("owner@example.com", "owner-1")
+		users.addUser("admin@example.com", "admin-1")
+		roles := newFakeRoleRepo()
+		roles.listByUID["admin-1"] = []*domain.Role{{ID: "r-2", Code: domain.AdminRole}}
+		return NewActivityService(repo, users, roles, 5*time.Second), repo
+	}
+
+	t.Run("only admins act as others", func(t *testing.T) {
+		svc, _ := setup()
+		require.NoError(t, svc.AuthorizeActAs(ctx, "admin-1", "owner-1"))
+		require.ErrorIs(t, svc.AuthorizeActAs(ctx, "owner-1", "admin-1"), domain.ErrForbidden)
+		require.ErrorIs(t, svc.AuthorizeActAs(ctx, "admin-1", "user-missing"), domain.ErrUserNotFound)
+		require.ErrorIs(t, svc.AuthorizeActAs(ctx, "admin-1", "admin-1"), domain.ErrInvalidInput)
+	})
+
+	t.Run("lists the user's activity newest first", func(t *testing.T) {
+		svc, _ := setup()
+		for _, path := range []string{"/events/me", "/users/me"} {
+			require.NoError(t, svc.RecordActivity(ctx, &domain.UserActivity{UserID: "owner-1", ActorID: "admin-1", Method: "GET", Path: path, Status: 200}))
+		}
+		require.NoError(t, svc.RecordActivity(ctx, &domain.UserActivity{UserID: "other-1", ActorID: "admin-1", Method: "GET", Path: "/events/me", Status: 200}))
+
+		activity, err := svc.ListMyActivity(ctx, "owner-1", 50)
+		require.NoError(t, err)
+		require.Len(t, activity, 2)
+		assert.Equal(t, "/users/me", activity[0].Path)
+
+		_, err = svc.ListMyActivity(ctx, "owner-1", domain.MaxUserActivity+1)
+		require.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+}
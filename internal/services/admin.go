@@ -0,0 +1,23 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"multitrackticketing/internal/domain"
+)
+
+// requireAdmin returns ErrForbidden unless userID has the admin role. Roles are read from the
+// database rather than the token, so revoking the role takes effect at once.
+func requireAdmin(ctx context.Context, roleRepo domain.RoleRepository, userID string) error {
+	roles, err := roleRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("list user roles: %w", err)
+	}
+	for _, r := range roles {
+		if r.Code == domain.AdminRole {
+			return nil
+		}
+	}
+	return domain.ErrForbidden
+}
@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+type announcementService struct {
+	announcementRepo domain.AnnouncementRepository
+	roleRepo         domain.RoleRepository
+	contextTimeout   time.Duration
+}
+
+// NewAnnouncementService returns a domain.AnnouncementService. Admins are the users with
+// domain.AdminRole in roleRepo.
+func NewAnnouncementService(announcementRepo domain.AnnouncementRepository, roleRepo domain.RoleRepository, timeout time.Duration) domain.AnnouncementService {
+	return &announcementService{
+		announcementRepo: announcementRepo,
+		roleRepo:         roleRepo,
+		contextTimeout:   timeout,
+	}
+}
+
+func (s *announcementService) ListChangelog(ctx context.Context, userID string, params domain.PaginationParams) ([]*domain.Announcement, int, int, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	items, total, unread, err := s.announcementRepo.ListPublished(ctx, userID, time.Now(), params)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("list published announcements: %w", err)
+	}
+	return items, total, unread, nil
+}
+
+func (s *announcementService) MarkAnnouncementsRead(ctx context.Context, userID string, ids []string) error {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if len(ids) > domain.MaxAnnouncementsMarkedRead {
+		return fmt.Errorf("at most %d announcements can be marked read at once: %w", domain.MaxAnnouncementsMarkedRead, domain.ErrInvalidInput)
+	}
+	if err := s.announcementRepo.MarkRead(ctx, userID, ids, time.Now()); err != nil {
+		return fmt.Errorf("mark announcements read: %w", err)
+	}
+	return nil
+}
+
+func (s *announcementService) ListAnnouncements(ctx context.Context, adminID string, params domain.PaginationParams) ([]*domain.Announcement, int, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := requireAdmin(ctx, s.roleRepo, adminID); err != nil {
+		return nil, 0, err
+	}
+	items, total, err := s.announcementRepo.ListAll(ctx, params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list announcements: %w", err)
+	}
+	return items, total, nil
+}
+
+func (s *announcementService) CreateAnnouncement(ctx context.Context, adminID string, a *domain.Announcement) (*domain.Announcement, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := requireAdmin(ctx, s.roleRepo, adminID); err != nil {
+		return nil, err
+	}
+	if err := normalizeAnnouncement(a); err != nil {
+		return nil, err
+	}
+	if err := s.announcementRepo.Create(ctx, a, adminID); err != nil {
+		return nil, fmt.Errorf("create announcement: %w", err)
+	}
+	return a, nil
+}
+
+func (s *announcementService) UpdateAnnouncement(ctx context.Context, adminID string, a *domain.Announcement) (*domain.Announcement, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := requireAdmin(ctx, s.roleRepo, adminID); err != nil {
+		return nil, err
+	}
+	if err := normalizeAnnouncement(a); err != nil {
+		return nil, err
+	}
+	if err := s.announcementRepo.Update(ctx, a); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("update announcement: %w", err)
+	}
+	return a, nil
+}
+
+func (s *announcementService) DeleteAnnouncement(ctx context.Context, adminID, id string) error {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := requireAdmin(ctx, s.roleRepo, adminID); err != nil {
+		return err
+	}
+	if err := s.announcementRepo.Delete(ctx, id); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("delete announcement: %w", err)
+	}
+	return nil
+}
+
+// normalizeAnnouncement trims the text fields and checks the title and category.
+func normalizeAnnouncement(a *domain.Announcement) error {
+	a.Title = strings.TrimSpace(a.Title)
+	a.Body = strings.TrimSpace(a.Body)
+	a.Category = strings.TrimSpace(strings.ToLower(a.Category))
+	if a.Title == "" {
+		return fmt.Errorf("title is required: %w", domain.ErrInvalidInput)
+	}
+	switch a.Category {
+	case domain.AnnouncementFeature, domain.AnnouncementImprovement, domain.AnnouncementFix:
+	default:
+		return fmt.Errorf("category must be one of feature, improvement and fix: %w", domain.ErrInvalidInput)
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAnnouncementRepo is an in-memory AnnouncementRepository.
+type fakeAnnouncementRepo struct {
+	byID  map[string]*domain.Announcement
+	reads map[string]map[string]bool // user ID -> announcement IDs read
+}
+
+func newFakeAnnouncementRepo() *fakeAnnouncementRepo {
+	return &fakeAnnouncementRepo{byID: make(map[string]*domain.Announcement), reads: make(map[string]map[string]bool)}
+}
+
+func (f *fakeAnnouncementRepo) published(now time.Time) []*domain.Announcement {
+	var out []*domain.Announcement
+	for _, a := range f.byID {
+		if a.PublishedAt != nil && !a.PublishedAt.After(now) {
+			out = append(out, a)
+		}
+	}
+	return out
+}
+
+func (f *fakeAnnouncementRepo) ListPublished(ctx context.Context, userID string, now time.Time, params domain.PaginationParams) ([]*domain.Announcement, int, int, error) {
+	items := []*domain.Announcement{}
+	unread := 0
+	for _, a := range f.published(now) {
+		c := *a
+		c.Read = f.reads[userID][a.ID]
+		if !c.Read {
+			unread++
+		}
+		items = append(items, &c)
+	}
+	return items, len(items), unread, nil
+}
+
+func (f *fakeAnnouncementRepo) ListAll(ctx context.Context, params domain.PaginationParams) ([]*domain.Announcement, int, error) {
+	items := []*domain.Announcement{}
+	for _, a := range f.byID {
+		items = append(items, a)
+	}
+	return items, len(items), nil
+}
+
+func (f *fakeAnnouncementRepo) Create(ctx context.Context, a *domain.Announcement, createdBy string) error {
+	a.ID = "an-" + a.Title
+	f.byID[a.ID] = a
+	return nil
+}
+
+func (f *fakeAnnouncementRepo) Update(ctx context.Context, a *domain.Announcement) error {
+	if _, ok := f.byID[a.ID]; !ok {
+		return domain.ErrNotFound
+	}
+	f.byID[a.ID] = a
+	return nil
+}
+
+func (f *fakeAnnouncementRepo) Delete(ctx context.Context, id string) error {
+	if _, ok := f.byID[id]; !ok {
+		return domain.ErrNotFound
+	}
+	delete(f.byID, id)
+	return nil
+}
+
+func (f *fakeAnnouncementRepo) MarkRead(ctx context.Context, userID string, ids []string, now time.Time) error {
+	if f.reads[userID] == nil {
+		f.reads[userID] = make(map[string]bool)
+	}
+	for _, a := range f.published(now) {
+		if len(ids) == 0 || slices.Contains(ids, a.ID) {
+			f.reads[userID][a.ID] = true
+		}
+	}
+	return nil
+}
+
+func TestAnnouncementService(t *testing.T) {
+	ctx := context.Background()
+	roles := newFakeRoleRepo()
+	roles.listByUID["admin-1"] = []*domain.Role{{ID: "r-1", Code: defaultRole}, {ID: "r-2", Code: domain.AdminRole}}
+	repo := newFakeAnnouncementRepo()
+	svc := NewAnnouncementService(repo, roles, 5*time.Second)
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(24 * time.Hour)
+
+	_, err := svc.CreateAnnouncement(ctx, "user-1", &domain.Announcement{Title: "Nope", Category: domain.AnnouncementFix})
+	require.ErrorIs(t, err, domain.ErrForbidden, "only admins manage announcements")
+
+	created, err := svc.CreateAnnouncement(ctx, "admin-1", &domain.Announcement{Title: " Dark mode ", Body: "Finally.", Category: "Feature", PublishedAt: &past})
+	require.NoError(t, err)
+	assert.Equal(t, "Dark mode", created.Title)
+	assert.Equal(t, domain.AnnouncementFeature, created.Category)
+	_, err = svc.CreateAnnouncement(ctx, "admin-1", &domain.Announcement{Title: "Scheduled", Category: domain.AnnouncementImprovement, PublishedAt: &future})
+	require.NoError(t, err)
+	_, err = svc.CreateAnnouncement(ctx, "admin-1", &domain.Announcement{Title: "Draft", Category: domain.AnnouncementFix})
+	require.NoError(t, err)
+	_, err = svc.CreateAnnouncement(ctx, "admin-1", &domain.Announcement{Title: "Bad", Category: "rumor"})
+	require.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	items, total, unread, err := svc.ListChangelog(ctx, "user-1", domain.PaginationParams{Page: 1, PageSize: 20})
+	require.NoError(t, err)
+	assert.Equal(t, 1, total, "drafts and scheduled announcements are not in the changelog")
+	assert.Equal(t, 1, unread)
+	require.Len(t, items, 1)
+	assert.Equal(t, "Dark mode", items[0].Title)
+
+	require.NoError(t, svc.MarkAnnouncementsRead(ctx, "user-1", nil))
+	items, _, unread, err = svc.ListChangelog(ctx, "user-1", domain.PaginationParams{Page: 1, PageSize: 20})
+	require.NoError(t, err)
+	assert.Equal(t, 0, unread)
+	assert.True(t, items[0].Read)
+	_, _, unread, err = svc.ListChangelog(ctx, "user-2", domain.PaginationParams{Page: 1, PageSize: 20})
+	require.NoError(t, err)
+	assert.Equal(t, 1, unread, "reads are per user")
+
+	err = svc.MarkAnnouncementsRead(ctx, "user-1", make([]string, domain.MaxAnnouncementsMarkedRead+1))
+	require.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	all, total, err := svc.ListAnnouncements(ctx, "admin-1", domain.PaginationParams{Page: 1, PageSize: 20})
+	require.NoError(t, err)
+	assert.Equal(t, 3, total)
+	assert.Len(t, all, 3)
+	_, _, err = svc.ListAnnouncements(ctx, "user-1", domain.PaginationParams{Page: 1, PageSize: 20})
+	require.ErrorIs(t, err, domain.ErrForbidden)
+
+	_, err = svc.UpdateAnnouncement(ctx, "admin-1", &domain.Announcement{ID: "missing", Title: "T", Category: domain.AnnouncementFix})
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	require.NoError(t, svc.DeleteAnnouncement(ctx, "admin-1", created.ID))
+	require.ErrorIs(t, svc.DeleteAnnouncement(ctx, "admin-1", created.ID), domain.ErrNotFound)
+	require.ErrorIs(t, svc.DeleteAnnouncement(ctx, "user-1", "an-Draft"), domain.ErrForbidden)
+}
@@ -4,34 +4,80 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"multitrackticketing/internal/domain"
 )
 
 type attendeeService struct {
-	eventRepo        domain.EventRepository
-	registrationRepo domain.EventRegistrationRepository
-	sessionRepo      domain.SessionRepository
+	eventRepo          domain.EventRepository
+	registrationRepo   domain.EventRegistrationRepository
+	sessionRepo        domain.SessionRepository
+	operatingHoursRepo domain.OperatingHoursRepository
+	sessionChangeRepo  domain.SessionChangeRepository
+	syncRepo           domain.SyncRepository
+	customFieldRepo    domain.CustomFieldRepository
+	thumbnails         domain.ThumbnailFetcher
+	abuseRepo          domain.AbuseReportRepository
+	metrics            domain.BusinessMetrics
+	cards              domain.SessionCardRenderer
+	publicPageRepo     domain.PublicPageRepository
+	occupancyRepo      domain.RoomOccupancyRepository
+	documentRepo       domain.EventDocumentRepository
+	streamRepo         domain.SessionStreamRepository
+	bundles            offlineBundleCache
 }
 
-// NewAttendeeService creates an AttendeeService with the given repositories.
+// NewAttendeeService creates an AttendeeService with the given repositories. Public responses leave
+// out the content unlisted in abuseRepo; a nil abuseRepo unlists nothing. New registrations are
+// counted in metrics; a nil metrics counts nothing. cards renders session preview images.
+// publicPageRepo lists the pages of the sitemap. New registrations need the event documents in
+// documentRepo accepted; a nil documentRepo asks for none. Sessions get their visible stream links
+// from streamRepo; a nil streamRepo gives them none.
 func NewAttendeeService(
 	eventRepo domain.EventRepository,
 	registrationRepo domain.EventRegistrationRepository,
 	sessionRepo domain.SessionRepository,
+	operatingHoursRepo domain.OperatingHoursRepository,
+	sessionChangeRepo domain.SessionChangeRepository,
+	syncRepo domain.SyncRepository,
+	customFieldRepo domain.CustomFieldRepository,
+	thumbnails domain.ThumbnailFetcher,
+	abuseRepo domain.AbuseReportRepository,
+	metrics domain.BusinessMetrics,
+	cards domain.SessionCardRenderer,
+	publicPageRepo domain.PublicPageRepository,
+	occupancyRepo domain.RoomOccupancyRepository,
+	documentRepo domain.EventDocumentRepository,
+	streamRepo domain.SessionStreamRepository,
 ) domain.AttendeeService {
 	return &attendeeService{
-		eventRepo:        eventRepo,
-		registrationRepo: registrationRepo,
-		sessionRepo:      sessionRepo,
+		eventRepo:          eventRepo,
+		registrationRepo:   registrationRepo,
+		sessionRepo:        sessionRepo,
+		operatingHoursRepo: operatingHoursRepo,
+		sessionChangeRepo:  sessionChangeRepo,
+		syncRepo:           syncRepo,
+		customFieldRepo:    customFieldRepo,
+		thumbnails:         thumbnails,
+		abuseRepo:          abuseRepo,
+		metrics:            metrics,
+		cards:              cards,
+		publicPageRepo:     publicPageRepo,
+		occupancyRepo:      occupancyRepo,
+		documentRepo:       documentRepo,
+		streamRepo:         streamRepo,
 	}
 }
 
 func (s *attendeeService) RegisterForEvent(ctx context.Context, eventID, userID string) (*domain.EventRegistration, bool, error) {
 	// Ensure the event exists.
-	if _, err := s.eventRepo.GetByID(ctx, eventID); err != nil {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
 		if err == domain.ErrNotFound {
 			return nil, false, domain.ErrNotFound
 		}
@@ -45,15 +91,14 @@ func (s *attendeeService) RegisterForEvent(ctx context.Context, eventID, userID
 		return nil, false, fmt.Errorf("get event registration: %w", err)
 	}
 
-	now := time.Now()
-	reg := domain.NewEventRegistration(eventID, userID, now, now)
-	if err := s.registrationRepo.Create(ctx, reg); err != nil {
-		return nil, false, fmt.Errorf("create event registration: %w", err)
+	reg, err := s.register(ctx, event, userID, nil)
+	if err != nil {
+		return nil, false, err
 	}
 	return reg, true, nil
 }
 
-func (s *attendeeService) RegisterForEventByCode(ctx context.Context, eventCode, userID string) (*domain.EventRegistration, bool, error) {
+func (s *attendeeService) RegisterForEventByCode(ctx context.Context, eventCode, userID string, accepted []domain.DocumentVersion) (*domain.EventRegistration, bool, error) {
 	code := strings.ToLower(strings.TrimSpace(eventCode))
 	event, err := s.eventRepo.GetByEventCode(ctx, code)
 	if err != nil {
@@ -69,12 +114,92 @@ func (s *attendeeService) RegisterForEventByCode(ctx context.Context, eventCode,
 		return nil, false, fmt.Errorf("get event registration: %w", err)
 	}
 
+	reg, err := s.register(ctx, event, userID, accepted)
+	if err != nil {
+		return nil, false, err
+	}
+	return reg, true, nil
+}
+
+func (s *attendeeService) JoinEventByCode(ctx context.Context, eventCode, userID string, accepted []domain.DocumentVersion) (*domain.EventJoin, error) {
+	code := strings.ToLower(strings.TrimSpace(eventCode))
+	event, err := s.eventRepo.GetByEventCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event by code: %w", err)
+	}
+
+	join := &domain.EventJoin{}
+	join.Registration, err = s.registrationRepo.GetByEventAndUser(ctx, event.ID, userID)
+	if errors.Is(err, domain.ErrNotFound) {
+		join.Registration, err = s.register(ctx, event, userID, accepted)
+		if err != nil {
+			return nil, err
+		}
+		join.Joined, join.PendingDocuments = true, []*domain.EventDocument{}
+	} else if err != nil {
+		return nil, fmt.Errorf("get event registration: %w", err)
+	} else {
+		// Those already registered keep their place when a document changes; they are prompted to
+		// accept it again instead.
+		var accepting []domain.DocumentVersion
+		join.PendingDocuments, accepting, err = pendingDocuments(ctx, s.documentRepo, event.ID, userID, accepted)
+		if err != nil {
+			return nil, err
+		}
+		if err := acceptDocuments(ctx, s.documentRepo, event.ID, userID, accepting); err != nil {
+			return nil, err
+		}
+	}
+
+	join.Schedule, err = s.liveSchedule(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+	return join, nil
+}
+
+// register creates userID's registration for the event, which they are not registered for yet. Every
+// way of registering goes through it: an event unlisted after abuse reports takes no new attendees
+// (ErrNotFound, as for an unknown event), though those already in keep it, and the event's current
+// documents must be accepted first (ErrDocumentsNotAccepted).
+func (s *attendeeService) register(ctx context.Context, event *domain.Event, userID string, accepted []domain.DocumentVersion) (*domain.EventRegistration, error) {
+	unlisted, err := s.unlistedContent(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+	if unlisted[domain.AbuseTarget{Type: domain.AbuseTargetEvent, ID: event.ID}] {
+		return nil, domain.ErrNotFound
+	}
+	pending, accepting, err := pendingDocuments(ctx, s.documentRepo, event.ID, userID, accepted)
+	if err != nil {
+		return nil, err
+	}
+	if len(pending) > 0 {
+		return nil, documentsNotAccepted(pending)
+	}
 	now := time.Now()
 	reg := domain.NewEventRegistration(event.ID, userID, now, now)
 	if err := s.registrationRepo.Create(ctx, reg); err != nil {
-		return nil, false, fmt.Errorf("create event registration: %w", err)
+		return nil, fmt.Errorf("create event registration: %w", err)
+	}
+	s.countRegistration(event)
+	// Accepted only once the registration exists, so a refused or failed one records no consent.
+	// Should recording fail, the documents stay pending and the attendee is asked to accept them
+	// again, as when a document changes.
+	if err := acceptDocuments(ctx, s.documentRepo, event.ID, userID, accepting); err != nil {
+		domain.ReportBestEffort(ctx, fmt.Errorf("documents accepted with registration for event %s: %w", event.ID, err))
+	}
+	return reg, nil
+}
+
+// countRegistration counts a new registration for the event; sandbox events are not counted.
+func (s *attendeeService) countRegistration(event *domain.Event) {
+	if s.metrics != nil && !event.Sandbox {
+		s.metrics.Registered(event.ID)
 	}
-	return reg, true, nil
 }
 
 func (s *attendeeService) ListMyRegisteredEvents(ctx context.Context, userID string) ([]*domain.EventRegistrationWithEvent, error) {
@@ -134,57 +259,675 @@ func (s *attendeeService) GetEventSchedule(ctx context.Context, eventID, userID
 			return nil, fmt.Errorf("get event registration: %w", err)
 		}
 	}
+	return s.liveSchedule(ctx, event)
+}
 
-	rooms, err := s.sessionRepo.ListRoomsByEventID(ctx, eventID)
+func (s *attendeeService) GetEventScheduleForClient(ctx context.Context, eventID string, client *domain.MachinePrincipal) (*domain.EventSchedule, error) {
+	if client.EventID != eventID {
+		return nil, domain.ErrForbidden
+	}
+	event, err := s.eventRepo.GetByID(ctx, eventID)
 	if err != nil {
-		return nil, fmt.Errorf("list rooms: %w", err)
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
 	}
+	return s.liveSchedule(ctx, event)
+}
 
-	// Filter to bookable rooms only (not_bookable == false).
-	var bookableRooms []*domain.Room
-	bookableIDs := make(map[string]struct{})
-	for _, r := range rooms {
-		if !r.NotBookable {
-			bookableRooms = append(bookableRooms, r)
-			bookableIDs[r.ID] = struct{}{}
+// liveSchedule returns eventSchedule with each room's current occupancy, which signage shows next
+// to what is on in it, and each session's stream links visible now. Public schedules and the
+// offline bundle leave both out: they change by the minute, without a change to the event.
+func (s *attendeeService) liveSchedule(ctx context.Context, event *domain.Event) (*domain.EventSchedule, error) {
+	schedule, err := s.eventSchedule(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+	occupancy, err := s.occupancyRepo.ListByEventID(ctx, event.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list room occupancy: %w", err)
+	}
+	byRoom := make(map[string]*domain.RoomOccupancy, len(occupancy))
+	for _, o := range occupancy {
+		byRoom[o.RoomID] = o
+	}
+	for _, room := range schedule.Rooms {
+		if o, ok := byRoom[room.Room.ID]; ok {
+			o.SetCapacity(room.Room.Capacity)
+			room.Occupancy = o
 		}
 	}
-	if bookableRooms == nil {
-		bookableRooms = []*domain.Room{}
+	if s.streamRepo == nil {
+		return schedule, nil
+	}
+	links, err := s.streamRepo.ListByEventID(ctx, event.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list session streams: %w", err)
+	}
+	now := time.Now()
+	for _, room := range schedule.Rooms {
+		for _, sess := range room.Sessions {
+			sess.StreamLinks = visibleStreamLinks(links[sess.ID], now)
+		}
+	}
+	return schedule, nil
+}
+
+// eventSchedule returns the event with its operating hours and bookable rooms, each with its
+// sessions. Unscheduled sessions have no room yet and are left out.
+func (s *attendeeService) eventSchedule(ctx context.Context, event *domain.Event) (*domain.EventSchedule, error) {
+	rooms, sessions, trackOrder, err := scheduleEntities(ctx, s.sessionRepo, s.customFieldRepo, s.operatingHoursRepo, event)
+	if err != nil {
+		return nil, err
+	}
+	return groupSchedule(event, rooms, sessions, trackOrder), nil
+}
+
+// scheduleEntities returns the event's rooms, its sessions with their public custom fields and its
+// saved track order, and sets the event's operating hours.
+func scheduleEntities(ctx context.Context, sessionRepo domain.SessionRepository, customFieldRepo domain.CustomFieldRepository, operatingHoursRepo domain.OperatingHoursRepository, event *domain.Event) ([]*domain.Room, []*domain.Session, []string, error) {
+	eventID := event.ID
+	rooms, err := sessionRepo.ListRoomsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("list rooms: %w", err)
 	}
 
-	sessions, err := s.sessionRepo.ListSessionsByEventID(ctx, eventID)
+	sessions, err := sessionRepo.ListSessionsByEventID(ctx, eventID)
 	if err != nil {
-		return nil, fmt.Errorf("list sessions: %w", err)
+		return nil, nil, nil, fmt.Errorf("list sessions: %w", err)
 	}
 	if sessions == nil {
 		sessions = []*domain.Session{}
 	}
+	values, err := customFieldRepo.ListValues(ctx, eventID, domain.CustomFieldSession, true)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("list session custom fields: %w", err)
+	}
+	for _, sess := range sessions {
+		sess.CustomFields = values[sess.ID]
+	}
+	trackOrder, err := sessionRepo.ListTrackOrder(ctx, eventID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("list track order: %w", err)
+	}
+
+	// Operating hours give apps the bounds of each day's grid.
+	hours, err := operatingHoursRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("list operating hours: %w", err)
+	}
+	event.OperatingHours = hours
+	return rooms, sessions, trackOrder, nil
+}
 
-	// Group sessions by room_id; only include sessions for bookable rooms.
-	sessionsByRoom := make(map[string][]*domain.Session)
+// groupSchedule returns the event with its bookable rooms, each with its sessions, and the tracks
+// of those sessions in trackOrder.
+func groupSchedule(event *domain.Event, rooms []*domain.Room, sessions []*domain.Session, trackOrder []string) *domain.EventSchedule {
+	// Filter to bookable rooms only (not_bookable == false).
+	bookableRooms := make([]*domain.Room, 0, len(rooms))
+	bookableIDs := make(map[string]struct{}, len(rooms))
+	for _, r := range rooms {
+		if !r.NotBookable {
+			bookableRooms = append(bookableRooms, r)
+			bookableIDs[r.ID] = struct{}{}
+		}
+	}
+
+	// Group sessions by room_id; only include sessions for bookable rooms. Unscheduled sessions
+	// have no room yet and are left out.
+	sessionsByRoom := make(map[string][]*domain.Session, len(bookableRooms))
+	listed := make([]*domain.Session, 0, len(sessions))
 	for _, sess := range sessions {
 		if _, ok := bookableIDs[sess.RoomID]; ok {
 			sessionsByRoom[sess.RoomID] = append(sessionsByRoom[sess.RoomID], sess)
+			listed = append(listed, sess)
 		}
 	}
 
 	// Build hierarchical result: event + rooms (bookable only), each with nested sessions.
-	roomWithSessions := make([]*domain.RoomWithSessions, 0, len(bookableRooms))
-	for _, room := range bookableRooms {
+	// Entries share one backing array instead of one allocation per room.
+	entries := make([]domain.RoomWithSessions, len(bookableRooms))
+	roomWithSessions := make([]*domain.RoomWithSessions, len(bookableRooms))
+	for i, room := range bookableRooms {
 		sessList := sessionsByRoom[room.ID]
 		if sessList == nil {
 			sessList = []*domain.Session{}
 		}
-		roomWithSessions = append(roomWithSessions, &domain.RoomWithSessions{
-			Room:     room,
-			Sessions: sessList,
-		})
+		entries[i] = domain.RoomWithSessions{Room: room, Sessions: sessList}
+		roomWithSessions[i] = &entries[i]
 	}
 
 	return &domain.EventSchedule{
-		Event: event,
-		Rooms: roomWithSessions,
-	}, nil
+		Event:  event,
+		Rooms:  roomWithSessions,
+		Tracks: orderTracks(listed, trackOrder),
+	}
+}
+
+// orderTracks returns the distinct tracks of the sessions, those in order first and in it, then the
+// others by name. Sessions without a track are skipped.
+func orderTracks(sessions []*domain.Session, order []string) []string {
+	position := make(map[string]int, len(order))
+	for i, name := range order {
+		position[name] = i
+	}
+	tracks := []string{}
+	for _, sess := range sessions {
+		if sess.Track != "" && !slices.Contains(tracks, sess.Track) {
+			tracks = append(tracks, sess.Track)
+		}
+	}
+	slices.SortFunc(tracks, func(a, b string) int {
+		pa, oka := position[a]
+		pb, okb := position[b]
+		switch {
+		case oka && okb:
+			return pa - pb
+		case oka != okb:
+			if oka {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a, b)
+	})
+	return tracks
+}
+
+func (s *attendeeService) ListScheduleChanges(ctx context.Context, eventID, userID string, since time.Time, limit int) ([]*domain.SessionChange, error) {
+	if limit < 1 || limit > domain.MaxScheduleChanges {
+		return nil, fmt.Errorf("limit must be between 1 and %d: %w", domain.MaxScheduleChanges, domain.ErrInvalidInput)
+	}
+	if err := s.checkScheduleAudience(ctx, eventID, userID); err != nil {
+		return nil, err
+	}
+	changes, err := s.sessionChangeRepo.ListByEventID(ctx, eventID, since, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list schedule changes: %w", err)
+	}
+	return changes, nil
+}
+
+func (s *attendeeService) SyncEvent(ctx context.Context, eventID, userID, since string, limit int) (*domain.SyncDelta, error) {
+	if limit < 1 || limit > domain.MaxSyncChanges {
+		return nil, fmt.Errorf("limit must be between 1 and %d: %w", domain.MaxSyncChanges, domain.ErrInvalidInput)
+	}
+	cursor, err := parseSyncCursor(since)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkScheduleAudience(ctx, eventID, userID); err != nil {
+		return nil, err
+	}
+
+	// One extra change tells whether another page is waiting.
+	changes, err := s.syncRepo.ListChanges(ctx, eventID, cursor, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("list sync changes: %w", err)
+	}
+	delta := &domain.SyncDelta{
+		EventID:  eventID,
+		Rooms:    domain.SyncRoomChanges{Created: []*domain.Room{}, Updated: []*domain.Room{}, Deleted: []string{}},
+		Sessions: domain.SyncSessionChanges{Created: []*domain.Session{}, Updated: []*domain.Session{}, Deleted: []string{}},
+		Speakers: domain.SyncSpeakerChanges{Created: []*domain.Speaker{}, Updated: []*domain.Speaker{}, Deleted: []string{}},
+	}
+	if len(changes) > limit {
+		changes, delta.HasMore = changes[:limit], true
+	}
+	if len(changes) > 0 {
+		last := changes[len(changes)-1]
+		cursor = domain.SyncCursor{TxID: last.TxID, Version: last.Version}
+	}
+	delta.Cursor = formatSyncCursor(cursor)
+
+	var sessionIDs []string
+	var needRooms, needSpeakers bool
+	for _, c := range changes {
+		switch {
+		case c.Deleted:
+		case c.EntityType == domain.SyncEntityRoom:
+			needRooms = true
+		case c.EntityType == domain.SyncEntitySession:
+			sessionIDs = append(sessionIDs, c.EntityID)
+		case c.EntityType == domain.SyncEntitySpeaker:
+			needSpeakers = true
+		}
+	}
+	rooms := map[string]*domain.Room{}
+	if needRooms {
+		list, err := s.sessionRepo.ListRoomsByEventID(ctx, eventID)
+		if err != nil {
+			return nil, fmt.Errorf("list rooms: %w", err)
+		}
+		for _, r := range list {
+			rooms[r.ID] = r
+		}
+	}
+	sessions := map[string]*domain.Session{}
+	if len(sessionIDs) > 0 {
+		list, err := s.sessionRepo.ListSessionsByIDs(ctx, sessionIDs)
+		if err != nil {
+			return nil, fmt.Errorf("list sessions: %w", err)
+		}
+		values, err := s.customFieldRepo.ListValues(ctx, eventID, domain.CustomFieldSession, true)
+		if err != nil {
+			return nil, fmt.Errorf("list session custom fields: %w", err)
+		}
+		for _, sess := range list {
+			sess.CustomFields = values[sess.ID]
+			sessions[sess.ID] = sess
+		}
+	}
+	speakers := map[string]*domain.Speaker{}
+	if needSpeakers {
+		list, err := s.sessionRepo.ListSpeakersByEventID(ctx, eventID)
+		if err != nil {
+			return nil, fmt.Errorf("list speakers: %w", err)
+		}
+		values, err := s.customFieldRepo.ListValues(ctx, eventID, domain.CustomFieldSpeaker, true)
+		if err != nil {
+			return nil, fmt.Errorf("list speaker custom fields: %w", err)
+		}
+		for _, sp := range list {
+			sp.CustomFields = values[sp.ID]
+			speakers[sp.ID] = sp
+		}
+	}
+
+	// An entity deleted after the changes were listed is skipped; its deletion has a later version
+	// and comes with the next sync.
+	for _, c := range changes {
+		switch c.EntityType {
+		case domain.SyncEntityRoom:
+			delta.Rooms.Created, delta.Rooms.Updated, delta.Rooms.Deleted = applySyncChange(c, rooms, delta.Rooms.Created, delta.Rooms.Updated, delta.Rooms.Deleted)
+		case domain.SyncEntitySession:
+			delta.Sessions.Created, delta.Sessions.Updated, delta.Sessions.Deleted = applySyncChange(c, sessions, delta.Sessions.Created, delta.Sessions.Updated, delta.Sessions.Deleted)
+		case domain.SyncEntitySpeaker:
+			delta.Speakers.Created, delta.Speakers.Updated, delta.Speakers.Deleted = applySyncChange(c, speakers, delta.Speakers.Created, delta.Speakers.Updated, delta.Speakers.Deleted)
+		}
+	}
+	return delta, nil
 }
 
+func (s *attendeeService) GetOfflineBundle(ctx context.Context, eventCode, knownVersion string) (*domain.OfflineBundle, error) {
+	code := strings.ToLower(strings.TrimSpace(eventCode))
+	event, offline, photoURLs, err := s.publicSchedule(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	// The version covers the photo URLs rather than the photos, so checking it downloads nothing.
+	version, err := offlineBundleVersion(offline, photoURLs)
+	if err != nil {
+		return nil, err
+	}
+	if knownVersion == version {
+		return &domain.OfflineBundle{EventID: event.ID, Manifest: &domain.OfflineBundleManifest{EventCode: code, Version: version}}, nil
+	}
+	if bundle := s.bundles.get(event.ID, version); bundle != nil {
+		return bundle, nil
+	}
+
+	photos := s.fetchThumbnails(ctx, photoURLs)
+	for _, sp := range offline.Speakers {
+		if _, ok := photos[sp.ID]; !ok {
+			sp.Photo = ""
+		}
+	}
+	bundle, err := buildOfflineBundle(code, version, offline, photos)
+	if err != nil {
+		return nil, err
+	}
+	bundle.EventID = event.ID
+	// A bundle missing photos is rebuilt next time, in case their host is back.
+	if len(photos) == len(photoURLs) {
+		s.bundles.put(event.ID, bundle)
+	}
+	return bundle, nil
+}
+
+// publicSchedule returns the event with the given code and what anyone may see of it: the listed
+// sessions by bookable room with their listed speakers, and the speakers' photo URLs by speaker ID.
+// The event carries no owner. Returns ErrNotFound if no event has the code or the event is unlisted.
+func (s *attendeeService) publicSchedule(ctx context.Context, code string) (*domain.Event, *domain.OfflineSchedule, map[string]string, error) {
+	event, err := s.eventRepo.GetByEventCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, nil, nil, domain.ErrNotFound
+		}
+		return nil, nil, nil, fmt.Errorf("get event by code: %w", err)
+	}
+	unlisted, err := s.unlistedContent(ctx, event.ID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if unlisted[domain.AbuseTarget{Type: domain.AbuseTargetEvent, ID: event.ID}] {
+		return nil, nil, nil, domain.ErrNotFound
+	}
+	schedule, err := s.eventSchedule(ctx, event)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for _, room := range schedule.Rooms {
+		room.Sessions = slices.DeleteFunc(room.Sessions, func(sess *domain.Session) bool {
+			return unlisted[domain.AbuseTarget{Type: domain.AbuseTargetSession, ID: sess.ID}]
+		})
+	}
+
+	// The schedule leaves speaker IDs out; public pages need them to show who gives each session.
+	var sessionIDs []string
+	for _, room := range schedule.Rooms {
+		for _, sess := range room.Sessions {
+			sessionIDs = append(sessionIDs, sess.ID)
+		}
+	}
+	linked := make(map[string]bool)
+	if len(sessionIDs) > 0 {
+		speakerIDs, err := s.sessionRepo.ListSpeakerIDsBySessionIDs(ctx, sessionIDs)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("list session speakers: %w", err)
+		}
+		for _, room := range schedule.Rooms {
+			for _, sess := range room.Sessions {
+				if ids := speakerIDs[sess.ID]; len(ids) > 0 {
+					sess.SpeakerIDs = ids
+				}
+				sess.SpeakerIDs = slices.DeleteFunc(sess.SpeakerIDs, func(id string) bool {
+					return unlisted[domain.AbuseTarget{Type: domain.AbuseTargetSpeaker, ID: id}]
+				})
+				for _, id := range sess.SpeakerIDs {
+					linked[id] = true
+				}
+			}
+		}
+	}
+	speakers, err := s.sessionRepo.ListSpeakersByEventID(ctx, event.ID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("list speakers: %w", err)
+	}
+	speakerValues, err := s.customFieldRepo.ListValues(ctx, event.ID, domain.CustomFieldSpeaker, true)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("list speaker custom fields: %w", err)
+	}
+
+	// The schedule is public, so it carries no owner or speaker e-mail.
+	publicEvent := *event
+	publicEvent.OwnerID = ""
+	offline := &domain.OfflineSchedule{Event: &publicEvent, Rooms: schedule.Rooms, Tracks: schedule.Tracks, Speakers: []*domain.OfflineBundleSpeaker{}}
+	photoURLs := make(map[string]string)
+	for _, sp := range speakers {
+		if !linked[sp.ID] {
+			continue
+		}
+		bs := &domain.OfflineBundleSpeaker{
+			ID: sp.ID, FirstName: sp.FirstName, LastName: sp.LastName,
+			Bio: sp.Bio, TagLine: sp.TagLine, IsTopSpeaker: sp.IsTopSpeaker,
+			CustomFields: speakerValues[sp.ID],
+		}
+		if sp.ProfilePicture != "" {
+			bs.Photo = offlineBundlePhotoPath(sp.ID)
+			photoURLs[sp.ID] = sp.ProfilePicture
+		}
+		offline.Speakers = append(offline.Speakers, bs)
+	}
+	return event, offline, photoURLs, nil
+}
+
+func (s *attendeeService) GetEventTheme(ctx context.Context, eventCode string) (*domain.EventTheme, error) {
+	event, err := s.eventRepo.GetByEventCode(ctx, strings.ToLower(strings.TrimSpace(eventCode)))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event by code: %w", err)
+	}
+	unlisted, err := s.unlistedContent(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+	if unlisted[domain.AbuseTarget{Type: domain.AbuseTargetEvent, ID: event.ID}] {
+		return nil, domain.ErrNotFound
+	}
+	return eventThemeFor(ctx, s.eventRepo, event.ID)
+}
+
+func (s *attendeeService) GetPublicSchedule(ctx context.Context, eventCode string) (*domain.PublicSchedule, error) {
+	event, offline, _, err := s.publicSchedule(ctx, strings.ToLower(strings.TrimSpace(eventCode)))
+	if err != nil {
+		return nil, err
+	}
+	// Photo is a path inside the bundle's archive, which a page cannot link to.
+	for _, sp := range offline.Speakers {
+		sp.Photo = ""
+	}
+	theme, err := eventThemeFor(ctx, s.eventRepo, event.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.PublicSchedule{EventID: event.ID, Schedule: offline, Theme: theme}, nil
+}
+
+func (s *attendeeService) GetPublicEventDocuments(ctx context.Context, eventCode string) (*domain.EventDocuments, error) {
+	event, err := s.eventRepo.GetByEventCode(ctx, strings.ToLower(strings.TrimSpace(eventCode)))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event by code: %w", err)
+	}
+	unlisted, err := s.unlistedContent(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+	if unlisted[domain.AbuseTarget{Type: domain.AbuseTargetEvent, ID: event.ID}] {
+		return nil, domain.ErrNotFound
+	}
+	docs := &domain.EventDocuments{EventID: event.ID, Documents: []*domain.EventDocument{}}
+	if s.documentRepo == nil {
+		return docs, nil
+	}
+	current, err := s.documentRepo.ListCurrent(ctx, event.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list documents: %w", err)
+	}
+	docs.Documents = append(docs.Documents, current...)
+	return docs, nil
+}
+
+func (s *attendeeService) GetSessionCard(ctx context.Context, sessionID string) (*domain.SessionCard, error) {
+	sess, err := s.sessionRepo.GetSessionByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get session: %w", err)
+	}
+	event, err := s.eventRepo.GetByID(ctx, sess.EventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	unlisted, err := s.unlistedContent(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+	if unlisted[domain.AbuseTarget{Type: domain.AbuseTargetEvent, ID: event.ID}] ||
+		unlisted[domain.AbuseTarget{Type: domain.AbuseTargetSession, ID: sess.ID}] {
+		return nil, domain.ErrNotFound
+	}
+	// Only sessions on the public schedule get a card.
+	if sess.RoomID == "" {
+		return nil, domain.ErrNotFound
+	}
+	room, err := s.sessionRepo.GetRoomByID(ctx, sess.RoomID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get room: %w", err)
+	}
+	if room.NotBookable {
+		return nil, domain.ErrNotFound
+	}
+	speakers, err := s.sessionRepo.ListSpeakersBySessionID(ctx, sess.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list session speakers: %w", err)
+	}
+	theme, err := eventThemeFor(ctx, s.eventRepo, event.ID)
+	if err != nil {
+		return nil, err
+	}
+	card := &domain.SessionCard{
+		SessionID: sess.ID,
+		EventID:   event.ID,
+		EventName: event.Name,
+		EventCode: event.EventCode,
+		Title:     sess.Title,
+		Speakers:  []string{},
+		Room:      room.Name,
+		StartTime: sess.StartTime,
+		EndTime:   sess.EndTime,
+		Theme:     theme,
+	}
+	for _, sp := range speakers {
+		if unlisted[domain.AbuseTarget{Type: domain.AbuseTargetSpeaker, ID: sp.ID}] {
+			continue
+		}
+		card.Speakers = append(card.Speakers, strings.TrimSpace(sp.FirstName+" "+sp.LastName))
+	}
+	return card, nil
+}
+
+func (s *attendeeService) GetSessionCardImage(ctx context.Context, sessionID string) (*domain.SessionCardImage, error) {
+	card, err := s.GetSessionCard(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	png, err := s.cards.RenderSessionCard(card)
+	if err != nil {
+		return nil, fmt.Errorf("render session card: %w", err)
+	}
+	return &domain.SessionCardImage{EventID: card.EventID, PNG: png}, nil
+}
+
+func (s *attendeeService) ListPublicPages(ctx context.Context) ([]*domain.PublicPage, error) {
+	pages, err := s.publicPageRepo.ListPublicPages(ctx, time.Now(), domain.MaxSitemapPages)
+	if err != nil {
+		return nil, fmt.Errorf("list public pages: %w", err)
+	}
+	return pages, nil
+}
+
+// unlistedContent returns the event's content unlisted after abuse reports, to leave out of public
+// responses.
+func (s *attendeeService) unlistedContent(ctx context.Context, eventID string) (map[domain.AbuseTarget]bool, error) {
+	unlisted := make(map[domain.AbuseTarget]bool)
+	if s.abuseRepo == nil {
+		return unlisted, nil
+	}
+	targets, err := s.abuseRepo.ListUnlisted(ctx, eventID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("list unlisted content: %w", err)
+	}
+	for _, t := range targets {
+		unlisted[t] = true
+	}
+	return unlisted, nil
+}
+
+// fetchThumbnails downloads the speakers' photos as thumbnails, a few at a time. Photos that fail
+// are reported as best effort and left out.
+func (s *attendeeService) fetchThumbnails(ctx context.Context, photoURLs map[string]string) map[string][]byte {
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		photos = make(map[string][]byte, len(photoURLs))
+		slots  = make(chan struct{}, maxConcurrentThumbnails)
+	)
+	for speakerID, url := range photoURLs {
+		wg.Go(func() {
+			slots <- struct{}{}
+			defer func() { <-slots }()
+			thumb, err := s.thumbnails.FetchThumbnail(ctx, url)
+			if err != nil {
+				domain.ReportBestEffort(ctx, fmt.Errorf("thumbnail of speaker %s: %w", speakerID, err))
+				return
+			}
+			mu.Lock()
+			photos[speakerID] = thumb
+			mu.Unlock()
+		})
+	}
+	wg.Wait()
+	return photos
+}
+
+// parseSyncCursor reads a cursor written by formatSyncCursor. Empty is a first sync. A bare version,
+// as cursors were before they carried the transaction, is read with transaction 0: the changes
+// after it are all listed, along with some the client already has.
+func parseSyncCursor(since string) (domain.SyncCursor, error) {
+	if since == "" {
+		return domain.SyncCursor{}, nil
+	}
+	txPart, versionPart, found := strings.Cut(since, ".")
+	if !found {
+		txPart, versionPart = "0", since
+	}
+	tx, err := strconv.ParseInt(txPart, 10, 64)
+	if err != nil || tx < 0 {
+		return domain.SyncCursor{}, fmt.Errorf("since is not a sync cursor: %w", domain.ErrInvalidInput)
+	}
+	version, err := strconv.ParseInt(versionPart, 10, 64)
+	if err != nil || version < 0 {
+		return domain.SyncCursor{}, fmt.Errorf("since is not a sync cursor: %w", domain.ErrInvalidInput)
+	}
+	return domain.SyncCursor{TxID: tx, Version: version}, nil
+}
+
+// formatSyncCursor writes c as "<transaction>.<version>", or the bare version for transaction 0.
+func formatSyncCursor(c domain.SyncCursor) string {
+	if c.TxID == 0 {
+		return strconv.FormatInt(c.Version, 10)
+	}
+	return strconv.FormatInt(c.TxID, 10) + "." + strconv.FormatInt(c.Version, 10)
+}
+
+// applySyncChange appends the change to the list it belongs in: the entity to created or updated,
+// or its ID to deleted.
+func applySyncChange[T any](c *domain.SyncChange, entities map[string]*T, created, updated []*T, deleted []string) ([]*T, []*T, []string) {
+	if c.Deleted {
+		return created, updated, append(deleted, c.EntityID)
+	}
+	e, ok := entities[c.EntityID]
+	if !ok {
+		return created, updated, deleted
+	}
+	if c.Created {
+		return append(created, e), updated, deleted
+	}
+	return created, append(updated, e), deleted
+}
+
+// checkScheduleAudience returns nil when the user may read the event's schedule: the event owner
+// or a registered attendee.
+func (s *attendeeService) checkScheduleAudience(ctx context.Context, eventID, userID string) error {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != userID {
+		if _, err := s.registrationRepo.GetByEventAndUser(ctx, eventID, userID); err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return domain.ErrForbidden
+			}
+			return fmt.Errorf("get event registration: %w", err)
+		}
+	}
+	return nil
+}
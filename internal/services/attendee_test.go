@@ -1,8 +1,19 @@
 package services
 
 import (
+	"archive/zip"
+	"bytes"
+	"cmp"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"io"
+	"math"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -13,10 +24,11 @@ type mockEventRegistrationRepository struct {
 	regsByUser         map[string][]*domain.EventRegistration
 	regByEventAndUser  map[string]*domain.EventRegistration
 	err                error
+	createErr          error
 }
 
 func (m *mockEventRegistrationRepository) Create(ctx context.Context, reg *domain.EventRegistration) error {
-	return nil
+	return m.createErr
 }
 
 func (m *mockEventRegistrationRepository) GetByEventAndUser(ctx context.Context, eventID, userID string) (*domain.EventRegistration, error) {
@@ -36,9 +48,14 @@ func (m *mockEventRegistrationRepository) ListByUserID(ctx context.Context, user
 	return m.regsByUser[userID], nil
 }
 
+func (m *mockEventRegistrationRepository) CountActiveByEvent(ctx context.Context) ([]domain.EventCount, error) {
+	return nil, m.err
+}
+
 type mockEventRepository struct {
 	events       map[string]*domain.Event
 	eventsByCode map[string]*domain.Event
+	themes       map[string]*domain.EventTheme
 	err          error
 }
 
@@ -75,6 +92,45 @@ func (m *mockEventRepository) ListByOwnerID(ctx context.Context, ownerID string)
 	return nil, nil
 }
 
+func (m *mockEventRepository) ListByOwnerOrTeamMemberID(ctx context.Context, userID string) ([]*domain.Event, error) {
+	return nil, nil
+}
+
+func (m *mockEventRepository) ListByTeamMemberID(ctx context.Context, userID string) ([]*domain.Event, error) {
+	return nil, nil
+}
+
+func (m *mockEventRepository) Search(ctx context.Context, userID string, params domain.EventSearchParams) ([]*domain.EventSearchResult, int, error) {
+	return nil, 0, nil
+}
+
+func (m *mockEventRepository) ListStats(ctx context.Context, eventIDs []string) (map[string]*domain.EventStats, error) {
+	return map[string]*domain.EventStats{}, nil
+}
+
+func (m *mockEventRepository) Anonymize(ctx context.Context, eventID, anonymizedBy string) (*domain.EventAnonymization, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockEventRepository) PreviewAnonymize(ctx context.Context, eventID, anonymizedBy string) (*domain.EventAnonymization, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockEventRepository) GetTheme(ctx context.Context, eventID string) (*domain.EventTheme, error) {
+	if t, ok := m.themes[eventID]; ok {
+		return t, nil
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (m *mockEventRepository) UpsertTheme(ctx context.Context, theme *domain.EventTheme) error {
+	return errors.New("not implemented")
+}
+
+func (m *mockEventRepository) CountRegistrations(ctx context.Context, eventID string) (int, error) {
+	return 0, nil
+}
+
 func (m *mockEventRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
@@ -90,9 +146,16 @@ func (m *mockEventRepository) Update(ctx context.Context, eventID string, date *
 	return ev, nil
 }
 
+func (m *mockEventRepository) UpdateEventCode(ctx context.Context, eventID, code string) (*domain.Event, error) {
+	return nil, errors.New("not implemented")
+}
+
 type mockSessionRepository struct {
 	roomsByEvent    map[string][]*domain.Room
 	sessionsByEvent map[string][]*domain.Session
+	speakersByEvent map[string][]*domain.Speaker
+	speakerIDs      map[string][]string
+	trackOrder      map[string][]string
 	err             error
 }
 
@@ -109,13 +172,32 @@ func (m *mockSessionRepository) CreateSessionSpeaker(ctx context.Context, sessio
 func (m *mockSessionRepository) DeleteSessionSpeaker(ctx context.Context, sessionID, speakerID string) error {
 	return nil
 }
-func (m *mockSessionRepository) DeleteScheduleByEventID(ctx context.Context, eventID string) error {
+func (m *mockSessionRepository) SetSessionSpeakers(ctx context.Context, sessionID string, speakerIDs []string) error {
+	return nil
+}
+func (m *mockSessionRepository) PruneScheduleByEventID(ctx context.Context, eventID string, keepRoomIDs, keepSessionIDs []string) error {
 	return nil
 }
-func (m *mockSessionRepository) DeleteSpeakersByEventID(ctx context.Context, eventID string) error {
+func (m *mockSessionRepository) PruneSpeakersByEventID(ctx context.Context, eventID string, keepSpeakerIDs []string) error {
 	return nil
 }
+func (m *mockSessionRepository) UpdateSpeakerSource(ctx context.Context, speakerID, source, sourceSessionID string) error {
+	return nil
+}
+func (m *mockSessionRepository) MergeSpeakers(ctx context.Context, keepID, dropID string) error {
+	return nil
+}
+func (m *mockSessionRepository) UpdateSpeakers(ctx context.Context, updates []*domain.SpeakerUpdate) ([]*domain.Speaker, error) {
+	return nil, domain.ErrNotFound
+}
 func (m *mockSessionRepository) GetRoomByID(ctx context.Context, roomID string) (*domain.Room, error) {
+	for _, rooms := range m.roomsByEvent {
+		for _, room := range rooms {
+			if room.ID == roomID {
+				return room, nil
+			}
+		}
+	}
 	return nil, domain.ErrNotFound
 }
 func (m *mockSessionRepository) ListRoomsByEventID(ctx context.Context, eventID string) ([]*domain.Room, error) {
@@ -127,6 +209,19 @@ func (m *mockSessionRepository) ListRoomsByEventID(ctx context.Context, eventID
 	}
 	return nil, nil
 }
+
+func (m *mockSessionRepository) ReorderRooms(ctx context.Context, eventID string, roomIDs []string) error {
+	return m.err
+}
+func (m *mockSessionRepository) ListTrackOrder(ctx context.Context, eventID string) ([]string, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.trackOrder[eventID], nil
+}
+func (m *mockSessionRepository) ReorderTracks(ctx context.Context, eventID string, tracks []string) error {
+	return m.err
+}
 func (m *mockSessionRepository) ListSessionsByEventID(ctx context.Context, eventID string) ([]*domain.Session, error) {
 	if m.err != nil {
 		return nil, m.err
@@ -136,23 +231,64 @@ func (m *mockSessionRepository) ListSessionsByEventID(ctx context.Context, event
 	}
 	return nil, nil
 }
+
+func (m *mockSessionRepository) StreamSessionsByEventID(ctx context.Context, eventID string, fn func(*domain.Session) error) error {
+	sessions, err := m.ListSessionsByEventID(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	for _, s := range sessions {
+		if err := fn(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 func (m *mockSessionRepository) ListSpeakerIDsBySessionIDs(ctx context.Context, sessionIDs []string) (map[string][]string, error) {
-	return nil, nil
+	out := make(map[string][]string)
+	for _, id := range sessionIDs {
+		if ids, ok := m.speakerIDs[id]; ok {
+			out[id] = ids
+		}
+	}
+	return out, nil
 }
 func (m *mockSessionRepository) GetSpeakerByID(ctx context.Context, speakerID string) (*domain.Speaker, error) {
 	return nil, domain.ErrNotFound
 }
 func (m *mockSessionRepository) ListSpeakersByEventID(ctx context.Context, eventID string) ([]*domain.Speaker, error) {
-	return nil, nil
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.speakersByEvent[eventID], nil
 }
 func (m *mockSessionRepository) ListSpeakersBySessionID(ctx context.Context, sessionID string) ([]*domain.Speaker, error) {
-	return nil, nil
+	var speakers []*domain.Speaker
+	for _, list := range m.speakersByEvent {
+		for _, sp := range list {
+			if slices.Contains(m.speakerIDs[sessionID], sp.ID) {
+				speakers = append(speakers, sp)
+			}
+		}
+	}
+	return speakers, nil
 }
 func (m *mockSessionRepository) ListSessionIDsBySpeakerID(ctx context.Context, speakerID string) ([]string, error) {
 	return nil, nil
 }
 func (m *mockSessionRepository) ListSessionsByIDs(ctx context.Context, sessionIDs []string) ([]*domain.Session, error) {
-	return nil, nil
+	if m.err != nil {
+		return nil, m.err
+	}
+	var sessions []*domain.Session
+	for _, list := range m.sessionsByEvent {
+		for _, sess := range list {
+			if slices.Contains(sessionIDs, sess.ID) {
+				sessions = append(sessions, sess)
+			}
+		}
+	}
+	return sessions, nil
 }
 func (m *mockSessionRepository) DeleteSpeaker(ctx context.Context, speakerID string) error {
 	return nil
@@ -164,11 +300,21 @@ func (m *mockSessionRepository) UpdateRoomDetails(ctx context.Context, roomID st
 	return nil, nil
 }
 func (m *mockSessionRepository) DeleteRoom(ctx context.Context, roomID string) error { return nil }
+func (m *mockSessionRepository) DeleteRoomKeepSessions(ctx context.Context, roomID string, targetRoomID *string) error {
+	return nil
+}
 func (m *mockSessionRepository) DeleteSession(ctx context.Context, sessionID string) error {
 	return nil
 }
 
 func (m *mockSessionRepository) GetSessionByID(ctx context.Context, sessionID string) (*domain.Session, error) {
+	for _, list := range m.sessionsByEvent {
+		for _, sess := range list {
+			if sess.ID == sessionID {
+				return sess, nil
+			}
+		}
+	}
 	return nil, domain.ErrNotFound
 }
 
@@ -180,6 +326,22 @@ func (m *mockSessionRepository) UpdateSessionContent(ctx context.Context, sessio
 	return nil, nil
 }
 
+// mockOperatingHoursRepository implements domain.OperatingHoursRepository for tests.
+type mockOperatingHoursRepository struct {
+	daysByEvent map[string][]*domain.OperatingDay
+}
+
+func (m *mockOperatingHoursRepository) ListByEventID(ctx context.Context, eventID string) ([]*domain.OperatingDay, error) {
+	if days, ok := m.daysByEvent[eventID]; ok {
+		return days, nil
+	}
+	return []*domain.OperatingDay{}, nil
+}
+
+func (m *mockOperatingHoursRepository) Replace(ctx context.Context, eventID string, days []*domain.OperatingDay) error {
+	return nil
+}
+
 func TestAttendeeService_ListMyRegisteredEvents(t *testing.T) {
 	now := time.Now()
 	event1 := &domain.Event{ID: "e1", Name: "Event 1"}
@@ -331,12 +493,14 @@ func TestAttendeeService_RegisterForEventByCode(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			metrics := &fakeBusinessMetrics{}
 			svc := &attendeeService{
 				eventRepo:        tt.eventRepo,
 				registrationRepo: tt.regRepo,
 				sessionRepo:      &mockSessionRepository{},
+				metrics:          metrics,
 			}
-			got, created, err := svc.RegisterForEventByCode(context.Background(), tt.eventCode, tt.userID)
+			got, created, err := svc.RegisterForEventByCode(context.Background(), tt.eventCode, tt.userID, nil)
 			if (err != nil) != tt.wantErr {
 				t.Fatalf("expected error=%v, got=%v (err=%v)", tt.wantErr, err != nil, err)
 			}
@@ -356,6 +520,13 @@ func TestAttendeeService_RegisterForEventByCode(t *testing.T) {
 			if tt.wantID == "r1" && created {
 				t.Error("expected created=false when already registered")
 			}
+			wantCounted := 0
+			if created {
+				wantCounted = 1
+			}
+			if len(metrics.registrations) != wantCounted {
+				t.Errorf("expected %d registrations counted, got %v", wantCounted, metrics.registrations)
+			}
 			if got.EventID != "" && got.EventID != "e1" && tt.wantID == "r1" {
 				t.Errorf("expected EventID e1, got %s", got.EventID)
 			}
@@ -371,6 +542,9 @@ func TestAttendeeService_GetEventSchedule(t *testing.T) {
 	sess1 := &domain.Session{ID: "s1", RoomID: "r1", Title: "Talk 1", StartTime: now, EndTime: now.Add(time.Hour)}
 	sess2 := &domain.Session{ID: "s2", RoomID: "r1", Title: "Talk 2", StartTime: now.Add(2 * time.Hour), EndTime: now.Add(3 * time.Hour)}
 	sess3 := &domain.Session{ID: "s3", RoomID: "r2", Title: "Talk in non-bookable", StartTime: now, EndTime: now.Add(time.Hour)}
+	hours := &mockOperatingHoursRepository{daysByEvent: map[string][]*domain.OperatingDay{
+		"e1": {{Day: now.Format(time.DateOnly), OpensAt: now.Add(-time.Hour), ClosesAt: now.Add(8 * time.Hour)}},
+	}}
 
 	tests := []struct {
 		name           string
@@ -482,9 +656,12 @@ func TestAttendeeService_GetEventSchedule(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			svc := &attendeeService{
-				eventRepo:        tt.eventRepo,
-				registrationRepo: tt.regRepo,
-				sessionRepo:      tt.sessionRepo,
+				eventRepo:          tt.eventRepo,
+				registrationRepo:   tt.regRepo,
+				sessionRepo:        tt.sessionRepo,
+				operatingHoursRepo: hours,
+				customFieldRepo:    newFakeCustomFieldRepo(),
+				occupancyRepo:      newFakeRoomOccupancyRepo(),
 			}
 			got, err := svc.GetEventSchedule(context.Background(), tt.eventID, tt.userID)
 			if (err != nil) != tt.wantErr {
@@ -505,6 +682,9 @@ func TestAttendeeService_GetEventSchedule(t *testing.T) {
 			if got.Event == nil || got.Event.ID != tt.eventID {
 				t.Errorf("expected event ID %q, got %v", tt.eventID, got.Event)
 			}
+			if got.Event != nil && len(got.Event.OperatingHours) != 1 {
+				t.Errorf("expected 1 operating day, got %d", len(got.Event.OperatingHours))
+			}
 			if len(got.Rooms) != tt.wantRoomCount {
 				t.Errorf("expected %d rooms, got %d", tt.wantRoomCount, len(got.Rooms))
 			}
@@ -520,3 +700,758 @@ func TestAttendeeService_GetEventSchedule(t *testing.T) {
 	}
 }
 
+
+func TestAttendeeService_ListScheduleChanges(t *testing.T) {
+	ctx := context.Background()
+	since := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	events := &mockEventRepository{events: map[string]*domain.Event{"e1": {ID: "e1", OwnerID: "owner1"}}}
+	regs := &mockEventRegistrationRepository{regByEventAndUser: map[string]*domain.EventRegistration{
+		"e1:attendee1": {ID: "reg1", EventID: "e1", UserID: "attendee1"},
+	}}
+	changes := newFakeSessionChangeRepo()
+	changes.changes = []*domain.SessionChange{
+		{ID: "c1", EventID: "e1", ChangedAt: since.Add(-time.Hour)},
+		{ID: "c2", EventID: "e1", ChangedAt: since.Add(time.Hour)},
+		{ID: "c3", EventID: "e1", ChangedAt: since.Add(2 * time.Hour)},
+		{ID: "c4", EventID: "e2", ChangedAt: since.Add(2 * time.Hour)},
+	}
+	svc := &attendeeService{eventRepo: events, registrationRepo: regs, sessionChangeRepo: changes}
+
+	tests := []struct {
+		name    string
+		eventID string
+		userID  string
+		limit   int
+		wantIDs []string
+		wantErr error
+	}{
+		{name: "attendee gets newest first", eventID: "e1", userID: "attendee1", limit: 50, wantIDs: []string{"c3", "c2"}},
+		{name: "owner", eventID: "e1", userID: "owner1", limit: 1, wantIDs: []string{"c3"}},
+		{name: "not registered", eventID: "e1", userID: "stranger", limit: 50, wantErr: domain.ErrForbidden},
+		{name: "event not found", eventID: "e9", userID: "owner1", limit: 50, wantErr: domain.ErrNotFound},
+		{name: "limit too large", eventID: "e1", userID: "owner1", limit: domain.MaxScheduleChanges + 1, wantErr: domain.ErrInvalidInput},
+		{name: "limit zero", eventID: "e1", userID: "owner1", limit: 0, wantErr: domain.ErrInvalidInput},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := svc.ListScheduleChanges(ctx, tt.eventID, tt.userID, since, tt.limit)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var ids []string
+			for _, c := range got {
+				ids = append(ids, c.ID)
+			}
+			if len(ids) != len(tt.wantIDs) {
+				t.Fatalf("ids = %v, want %v", ids, tt.wantIDs)
+			}
+			for i := range ids {
+				if ids[i] != tt.wantIDs[i] {
+					t.Fatalf("ids = %v, want %v", ids, tt.wantIDs)
+				}
+			}
+		})
+	}
+}
+
+// fakeSyncRepo returns the changes after since in cursor order, like the repository. Changes of
+// running transactions, and of any transaction after the oldest running one, are not listed yet.
+type fakeSyncRepo struct {
+	changes []*domain.SyncChange
+	running map[int64]bool
+}
+
+func (f *fakeSyncRepo) ListChanges(ctx context.Context, eventID string, since domain.SyncCursor, limit int) ([]*domain.SyncChange, error) {
+	xmin := int64(math.MaxInt64)
+	for tx := range f.running {
+		xmin = min(xmin, tx)
+	}
+	sorted := slices.Clone(f.changes)
+	slices.SortFunc(sorted, func(a, b *domain.SyncChange) int {
+		return cmp.Or(cmp.Compare(a.TxID, b.TxID), cmp.Compare(a.Version, b.Version))
+	})
+	out := []*domain.SyncChange{}
+	for _, c := range sorted {
+		after := c.TxID > since.TxID || (c.TxID == since.TxID && c.Version > since.Version)
+		if after && c.TxID < xmin && (since.Version > 0 || !c.Deleted) && len(out) < limit {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
+func TestAttendeeService_SyncEvent(t *testing.T) {
+	ctx := context.Background()
+	events := &mockEventRepository{events: map[string]*domain.Event{"e1": {ID: "e1", OwnerID: "owner1"}}}
+	regs := &mockEventRegistrationRepository{regByEventAndUser: map[string]*domain.EventRegistration{
+		"e1:attendee1": {ID: "reg1", EventID: "e1", UserID: "attendee1"},
+	}}
+	sessions := &mockSessionRepository{
+		roomsByEvent:    map[string][]*domain.Room{"e1": {{ID: "r1", EventID: "e1"}, {ID: "r2", EventID: "e1", NotBookable: true}}},
+		sessionsByEvent: map[string][]*domain.Session{"e1": {{ID: "s1", EventID: "e1", RoomID: "r1"}}},
+		speakersByEvent: map[string][]*domain.Speaker{"e1": {{ID: "sp1", EventID: "e1"}}},
+	}
+	syncRepo := &fakeSyncRepo{changes: []*domain.SyncChange{
+		{EntityType: domain.SyncEntityRoom, EntityID: "r1", Version: 3, Created: true},
+		{EntityType: domain.SyncEntitySpeaker, EntityID: "sp9", Version: 4, Deleted: true},
+		{EntityType: domain.SyncEntityRoom, EntityID: "r2", Version: 5, Created: true},
+		{EntityType: domain.SyncEntitySession, EntityID: "s1", Version: 7},
+		// Deleted after the changes were listed: skipped until its tombstone arrives.
+		{EntityType: domain.SyncEntitySpeaker, EntityID: "sp2", Version: 8},
+		{EntityType: domain.SyncEntitySpeaker, EntityID: "sp1", Version: 9},
+	}}
+	svc := &attendeeService{eventRepo: events, registrationRepo: regs, sessionRepo: sessions, syncRepo: syncRepo, customFieldRepo: newFakeCustomFieldRepo()}
+
+	ids := func(v any) []string {
+		var out []string
+		switch list := v.(type) {
+		case []*domain.Room:
+			for _, e := range list {
+				out = append(out, e.ID)
+			}
+		case []*domain.Session:
+			for _, e := range list {
+				out = append(out, e.ID)
+			}
+		case []*domain.Speaker:
+			for _, e := range list {
+				out = append(out, e.ID)
+			}
+		case []string:
+			out = list
+		}
+		return out
+	}
+
+	t.Run("first sync pages through everything", func(t *testing.T) {
+		got, err := svc.SyncEvent(ctx, "e1", "attendee1", "", 2)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// The deletion is left out of a first sync.
+		if got.Cursor != "5" || !got.HasMore {
+			t.Fatalf("cursor/has_more = %q/%v, want 5/true", got.Cursor, got.HasMore)
+		}
+		if r := ids(got.Rooms.Created); !slices.Equal(r, []string{"r1", "r2"}) {
+			t.Errorf("rooms.created = %v", r)
+		}
+		if got.Sessions.Updated == nil || got.Speakers.Deleted == nil {
+			t.Error("empty lists must not be nil")
+		}
+
+		got, err = svc.SyncEvent(ctx, "e1", "attendee1", got.Cursor, 10)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Cursor != "9" || got.HasMore {
+			t.Fatalf("cursor/has_more = %q/%v, want 9/false", got.Cursor, got.HasMore)
+		}
+		if s := ids(got.Sessions.Updated); !slices.Equal(s, []string{"s1"}) {
+			t.Errorf("sessions.updated = %v", s)
+		}
+		if sp := ids(got.Speakers.Updated); !slices.Equal(sp, []string{"sp1"}) {
+			t.Errorf("speakers.updated = %v", sp)
+		}
+	})
+
+	t.Run("deletions since a cursor", func(t *testing.T) {
+		got, err := svc.SyncEvent(ctx, "e1", "owner1", "3", 1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if d := ids(got.Speakers.Deleted); !slices.Equal(d, []string{"sp9"}) || got.Cursor != "4" {
+			t.Errorf("speakers.deleted = %v, cursor = %q", d, got.Cursor)
+		}
+	})
+
+	t.Run("nothing changed keeps the cursor", func(t *testing.T) {
+		got, err := svc.SyncEvent(ctx, "e1", "attendee1", "9", 10)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Cursor != "9" || got.HasMore {
+			t.Errorf("cursor/has_more = %q/%v, want 9/false", got.Cursor, got.HasMore)
+		}
+	})
+
+	errTests := []struct {
+		name    string
+		eventID string
+		userID  string
+		since   string
+		limit   int
+		wantErr error
+	}{
+		{name: "not registered", eventID: "e1", userID: "stranger", limit: 10, wantErr: domain.ErrForbidden},
+		{name: "event not found", eventID: "e9", userID: "owner1", limit: 10, wantErr: domain.ErrNotFound},
+		{name: "invalid cursor", eventID: "e1", userID: "owner1", since: "abc", limit: 10, wantErr: domain.ErrInvalidInput},
+		{name: "negative cursor", eventID: "e1", userID: "owner1", since: "-1", limit: 10, wantErr: domain.ErrInvalidInput},
+		{name: "invalid cursor version", eventID: "e1", userID: "owner1", since: "12.x", limit: 10, wantErr: domain.ErrInvalidInput},
+		{name: "limit too large", eventID: "e1", userID: "owner1", limit: domain.MaxSyncChanges + 1, wantErr: domain.ErrInvalidInput},
+	}
+	for _, tt := range errTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := svc.SyncEvent(ctx, tt.eventID, tt.userID, tt.since, tt.limit); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAttendeeService_SyncEvent_OutOfOrderCommits(t *testing.T) {
+	ctx := context.Background()
+	events := &mockEventRepository{events: map[string]*domain.Event{"e1": {ID: "e1", OwnerID: "owner1"}}}
+	sessions := &mockSessionRepository{
+		sessionsByEvent: map[string][]*domain.Session{"e1": {{ID: "s1", EventID: "e1"}, {ID: "s2", EventID: "e1"}}},
+	}
+	// An import (transaction 10) writes s1 at version 5 and is still running when an edit
+	// (transaction 11) writes s2 at version 6 and commits.
+	syncRepo := &fakeSyncRepo{
+		changes: []*domain.SyncChange{
+			{EntityType: domain.SyncEntitySession, EntityID: "s2", TxID: 11, Version: 6, Created: true},
+		},
+		running: map[int64]bool{10: true},
+	}
+	svc := &attendeeService{eventRepo: events, sessionRepo: sessions, syncRepo: syncRepo, customFieldRepo: newFakeCustomFieldRepo()}
+
+	got, err := svc.SyncEvent(ctx, "e1", "owner1", "", 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got.Sessions.Created) != 0 || got.Cursor != "0" {
+		t.Fatalf("sessions.created = %d, cursor = %q; want nothing while the import runs", len(got.Sessions.Created), got.Cursor)
+	}
+
+	// The import commits version 5 after version 6 was written; the next sync gets both.
+	syncRepo.changes = append(syncRepo.changes, &domain.SyncChange{EntityType: domain.SyncEntitySession, EntityID: "s1", TxID: 10, Version: 5, Created: true})
+	syncRepo.running = nil
+	got, err = svc.SyncEvent(ctx, "e1", "owner1", got.Cursor, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var ids []string
+	for _, sess := range got.Sessions.Created {
+		ids = append(ids, sess.ID)
+	}
+	if !slices.Equal(ids, []string{"s1", "s2"}) || got.Cursor != "11.6" {
+		t.Fatalf("sessions.created = %v, cursor = %q; want [s1 s2], 11.6", ids, got.Cursor)
+	}
+}
+
+// fakeThumbnails returns the thumbnail for each known URL and an error for the others.
+type fakeThumbnails struct {
+	mu     sync.Mutex
+	thumbs map[string][]byte
+	calls  int
+}
+
+func (f *fakeThumbnails) FetchThumbnail(ctx context.Context, url string) ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	if thumb, ok := f.thumbs[url]; ok {
+		return thumb, nil
+	}
+	return nil, errors.New("host unreachable")
+}
+
+func TestAttendeeService_GetOfflineBundle(t *testing.T) {
+	ctx := context.Background()
+	event := &domain.Event{ID: "e1", Name: "Conf", EventCode: "ab12", OwnerID: "owner1"}
+	events := &mockEventRepository{eventsByCode: map[string]*domain.Event{"ab12": event}}
+	sessions := &mockSessionRepository{
+		roomsByEvent: map[string][]*domain.Room{"e1": {
+			{ID: "r1", EventID: "e1", HowToGetThere: "First floor"},
+			{ID: "r2", EventID: "e1", NotBookable: true},
+		}},
+		sessionsByEvent: map[string][]*domain.Session{"e1": {
+			{ID: "s1", EventID: "e1", RoomID: "r1", SpeakerIDs: []string{}},
+			{ID: "s2", EventID: "e1", RoomID: "r2", SpeakerIDs: []string{}},
+		}},
+		speakerIDs: map[string][]string{"s1": {"sp1", "sp2"}, "s2": {"sp3"}},
+		speakersByEvent: map[string][]*domain.Speaker{"e1": {
+			{ID: "sp1", FirstName: "Ada", Email: "ada@example.com", ProfilePicture: "https://img.example.com/ada.png"},
+			{ID: "sp2", FirstName: "Bob", ProfilePicture: "https://img.example.com/bob.png"},
+			// Only speaks in a room that is not bookable.
+			{ID: "sp3", FirstName: "Cy", ProfilePicture: "https://img.example.com/cy.png"},
+		}},
+	}
+	thumbs := &fakeThumbnails{thumbs: map[string][]byte{"https://img.example.com/ada.png": []byte("ada-jpeg")}}
+	svc := &attendeeService{eventRepo: events, sessionRepo: sessions, operatingHoursRepo: &mockOperatingHoursRepository{}, customFieldRepo: newFakeCustomFieldRepo(), thumbnails: thumbs}
+
+	buildCtx, failures := domain.WithBestEffortFailures(ctx)
+	bundle, err := svc.GetOfflineBundle(buildCtx, " AB12 ", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if errs := failures.Errors(); len(errs) != 1 || !strings.Contains(errs[0].Error(), "sp2") {
+		t.Errorf("expected the failed photo of sp2 to be reported, got %v", errs)
+	}
+	if bundle.EventID != "e1" || bundle.Manifest.EventCode != "ab12" || bundle.Manifest.Version == "" {
+		t.Fatalf("unexpected bundle of event %q: %+v", bundle.EventID, bundle.Manifest)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(bundle.Archive), int64(len(bundle.Archive)))
+	if err != nil {
+		t.Fatalf("open archive: %v", err)
+	}
+	files := make(map[string][]byte)
+	var names []string
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", f.Name, err)
+		}
+		data, _ := io.ReadAll(rc)
+		rc.Close()
+		files[f.Name] = data
+		names = append(names, f.Name)
+	}
+	if want := []string{"manifest.json", "schedule.json", "photos/sp1.jpg"}; !slices.Equal(names, want) {
+		t.Fatalf("files = %v, want %v", names, want)
+	}
+	var manifest domain.OfflineBundleManifest
+	if err := json.Unmarshal(files["manifest.json"], &manifest); err != nil {
+		t.Fatalf("decode manifest: %v", err)
+	}
+	for _, f := range manifest.Files {
+		sum := sha256.Sum256(files[f.Path])
+		if f.SHA256 != hex.EncodeToString(sum[:]) || f.Size != len(files[f.Path]) {
+			t.Errorf("manifest entry %s does not match the file", f.Path)
+		}
+	}
+	if string(files["photos/sp1.jpg"]) != "ada-jpeg" {
+		t.Errorf("photo: got %q", files["photos/sp1.jpg"])
+	}
+	schedule := string(files["schedule.json"])
+	for _, leak := range []string{"ada@example.com", "owner1", "img.example.com"} {
+		if strings.Contains(schedule, leak) {
+			t.Errorf("schedule.json contains %q", leak)
+		}
+	}
+	var offline domain.OfflineSchedule
+	if err := json.Unmarshal(files["schedule.json"], &offline); err != nil {
+		t.Fatalf("decode schedule: %v", err)
+	}
+	if len(offline.Rooms) != 1 || offline.Rooms[0].Room.HowToGetThere != "First floor" {
+		t.Errorf("rooms: got %+v", offline.Rooms)
+	}
+	if got := offline.Rooms[0].Sessions[0].SpeakerIDs; !slices.Equal(got, []string{"sp1", "sp2"}) {
+		t.Errorf("speaker_ids: got %v", got)
+	}
+	if len(offline.Speakers) != 2 || offline.Speakers[0].Photo != "photos/sp1.jpg" || offline.Speakers[1].Photo != "" {
+		t.Errorf("speakers: got %+v %+v", offline.Speakers[0], offline.Speakers)
+	}
+
+	t.Run("known version is not rebuilt", func(t *testing.T) {
+		calls := thumbs.calls
+		got, err := svc.GetOfflineBundle(ctx, "ab12", bundle.Manifest.Version)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Archive != nil || got.EventID != "e1" || got.Manifest.Version != bundle.Manifest.Version || thumbs.calls != calls {
+			t.Errorf("expected no archive and no photo downloads, got %d bytes and %d downloads", len(got.Archive), thumbs.calls-calls)
+		}
+	})
+
+	t.Run("missing photos are retried, complete bundles cached", func(t *testing.T) {
+		calls := thumbs.calls
+		if _, err := svc.GetOfflineBundle(ctx, "ab12", ""); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if thumbs.calls != calls+2 {
+			t.Fatalf("expected both photos fetched again, got %d downloads", thumbs.calls-calls)
+		}
+		thumbs.thumbs["https://img.example.com/bob.png"] = []byte("bob-jpeg")
+		first, err := svc.GetOfflineBundle(ctx, "ab12", "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		calls = thumbs.calls
+		second, err := svc.GetOfflineBundle(ctx, "ab12", "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if thumbs.calls != calls || second != first {
+			t.Errorf("expected the cached bundle, got %d downloads", thumbs.calls-calls)
+		}
+	})
+
+	t.Run("unknown code", func(t *testing.T) {
+		if _, err := svc.GetOfflineBundle(ctx, "zz99", ""); !errors.Is(err, domain.ErrNotFound) {
+			t.Fatalf("err = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("unlisted content is left out", func(t *testing.T) {
+		abuse := newFakeAbuseRepo()
+		abuse.unlisted[domain.AbuseTarget{Type: domain.AbuseTargetSpeaker, ID: "sp2"}] = "e1"
+		svc.abuseRepo = abuse
+		got, err := svc.GetOfflineBundle(ctx, "ab12", "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Manifest.Version == bundle.Manifest.Version {
+			t.Fatal("expected a new version without the unlisted speaker")
+		}
+		zr, err := zip.NewReader(bytes.NewReader(got.Archive), int64(len(got.Archive)))
+		if err != nil {
+			t.Fatalf("open archive: %v", err)
+		}
+		rc, err := zr.Open("schedule.json")
+		if err != nil {
+			t.Fatalf("open schedule.json: %v", err)
+		}
+		var offline domain.OfflineSchedule
+		err = json.NewDecoder(rc).Decode(&offline)
+		rc.Close()
+		if err != nil {
+			t.Fatalf("decode schedule: %v", err)
+		}
+		if ids := offline.Rooms[0].Sessions[0].SpeakerIDs; !slices.Equal(ids, []string{"sp1"}) {
+			t.Errorf("speaker_ids: got %v", ids)
+		}
+		if len(offline.Speakers) != 1 || offline.Speakers[0].ID != "sp1" {
+			t.Errorf("speakers: got %+v", offline.Speakers)
+		}
+
+		abuse.unlisted[domain.AbuseTarget{Type: domain.AbuseTargetEvent, ID: "e1"}] = "e1"
+		if _, err := svc.GetOfflineBundle(ctx, "ab12", ""); !errors.Is(err, domain.ErrNotFound) {
+			t.Fatalf("unlisted event: err = %v, want ErrNotFound", err)
+		}
+	})
+}
+
+func TestAttendeeService_GetEventSchedule_PublicCustomFields(t *testing.T) {
+	ctx := context.Background()
+	events := &mockEventRepository{events: map[string]*domain.Event{"e1": {ID: "e1", OwnerID: "owner1"}}}
+	sessions := &mockSessionRepository{
+		roomsByEvent:    map[string][]*domain.Room{"e1": {{ID: "r1", EventID: "e1"}}},
+		sessionsByEvent: map[string][]*domain.Session{"e1": {{ID: "s1", EventID: "e1", RoomID: "r1"}}},
+	}
+	fields := newFakeCustomFieldRepo()
+	doi := &domain.CustomField{EventID: "e1", Name: "Paper DOI", Type: domain.CustomFieldURL, AppliesTo: domain.CustomFieldSession, Public: true}
+	notes := &domain.CustomField{EventID: "e1", Name: "AV notes", Type: domain.CustomFieldText, AppliesTo: domain.CustomFieldSession}
+	_ = fields.CreateField(ctx, doi)
+	_ = fields.CreateField(ctx, notes)
+	_, _ = fields.SetValues(ctx, domain.CustomFieldSession, "s1", []*domain.CustomFieldValue{
+		{FieldID: doi.ID, Name: doi.Name, Type: doi.Type, Value: "https://doi.org/10.1000/182"},
+		{FieldID: notes.ID, Name: notes.Name, Type: notes.Type, Value: "Needs two handheld mics"},
+	})
+	svc := &attendeeService{eventRepo: events, sessionRepo: sessions, operatingHoursRepo: &mockOperatingHoursRepository{}, customFieldRepo: fields, occupancyRepo: newFakeRoomOccupancyRepo()}
+
+	schedule, err := svc.GetEventSchedule(ctx, "e1", "owner1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := schedule.Rooms[0].Sessions[0].CustomFields
+	if len(got) != 1 || got[0].Name != "Paper DOI" {
+		t.Errorf("custom fields: got %+v, want only the public Paper DOI", got)
+	}
+}
+
+func TestAttendeeService_GetEventScheduleForClient(t *testing.T) {
+	ctx := context.Background()
+	events := &mockEventRepository{events: map[string]*domain.Event{"e1": {ID: "e1", OwnerID: "owner1"}}}
+	sessions := &mockSessionRepository{
+		roomsByEvent:    map[string][]*domain.Room{"e1": {{ID: "r1", EventID: "e1", Capacity: 10}}},
+		sessionsByEvent: map[string][]*domain.Session{"e1": {{ID: "s1", EventID: "e1", RoomID: "r1"}}},
+	}
+	occupancy := newFakeRoomOccupancyRepo()
+	if _, err := occupancy.Set(ctx, "e1", "r1", 9); err != nil {
+		t.Fatal(err)
+	}
+	svc := &attendeeService{eventRepo: events, sessionRepo: sessions, operatingHoursRepo: &mockOperatingHoursRepository{}, customFieldRepo: newFakeCustomFieldRepo(), occupancyRepo: occupancy}
+
+	schedule, err := svc.GetEventScheduleForClient(ctx, "e1", &domain.MachinePrincipal{ClientID: "c1", EventID: "e1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(schedule.Rooms) != 1 || len(schedule.Rooms[0].Sessions) != 1 {
+		t.Errorf("schedule: got %+v, want one room with one session", schedule.Rooms)
+	}
+	if o := schedule.Rooms[0].Occupancy; o == nil || o.Count != 9 || o.Capacity != 10 || !o.NearCapacity {
+		t.Errorf("occupancy: got %+v, want 9 of 10, near capacity", o)
+	}
+	if _, err := svc.GetEventScheduleForClient(ctx, "e1", &domain.MachinePrincipal{ClientID: "c1", EventID: "e2"}); !errors.Is(err, domain.ErrForbidden) {
+		t.Errorf("other event's client: err = %v, want ErrForbidden", err)
+	}
+	if _, err := svc.GetEventScheduleForClient(ctx, "e9", &domain.MachinePrincipal{ClientID: "c1", EventID: "e9"}); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("missing event: err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestAttendeeService_GetEventSchedule_StreamLinks(t *testing.T) {
+	ctx := context.Background()
+	events := &mockEventRepository{events: map[string]*domain.Event{"e1": {ID: "e1", OwnerID: "owner1"}}}
+	sessions := &mockSessionRepository{
+		roomsByEvent:    map[string][]*domain.Room{"e1": {{ID: "r1", EventID: "e1"}}},
+		sessionsByEvent: map[string][]*domain.Session{"e1": {{ID: "s1", EventID: "e1", RoomID: "r1"}, {ID: "s2", EventID: "e1", RoomID: "r1"}}},
+	}
+	past, future := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+	live := &domain.SessionStreamLink{Kind: domain.StreamKindCaptions, Language: "en", URL: "https://captions.example/en", VisibleFrom: &past}
+	later := &domain.SessionStreamLink{Kind: domain.StreamKindTranslation, Language: "es", URL: "https://live.example/es", VisibleFrom: &future}
+	streams := newFakeSessionStreamRepo()
+	_ = streams.Replace(ctx, "e1", "s1", []*domain.SessionStreamLink{live, later})
+	_ = streams.Replace(ctx, "e1", "s2", []*domain.SessionStreamLink{later})
+	svc := &attendeeService{eventRepo: events, sessionRepo: sessions, operatingHoursRepo: &mockOperatingHoursRepository{}, customFieldRepo: newFakeCustomFieldRepo(), occupancyRepo: newFakeRoomOccupancyRepo(), streamRepo: streams}
+
+	schedule, err := svc.GetEventSchedule(ctx, "e1", "owner1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := schedule.Rooms[0].Sessions
+	if len(got[0].StreamLinks) != 1 || got[0].StreamLinks[0] != live {
+		t.Errorf("s1 stream links: got %+v, want only the live captions", got[0].StreamLinks)
+	}
+	if got[1].StreamLinks != nil {
+		t.Errorf("s2 stream links: got %+v, want none before the window opens", got[1].StreamLinks)
+	}
+}
+
+func TestAttendeeService_GetEventSchedule_TrackOrder(t *testing.T) {
+	ctx := context.Background()
+	events := &mockEventRepository{events: map[string]*domain.Event{"e1": {ID: "e1", OwnerID: "owner1"}}}
+	sessions := &mockSessionRepository{
+		roomsByEvent: map[string][]*domain.Room{"e1": {{ID: "r1", EventID: "e1"}, {ID: "r2", EventID: "e1", NotBookable: true}}},
+		sessionsByEvent: map[string][]*domain.Session{"e1": {
+			{ID: "s1", EventID: "e1", RoomID: "r1", Track: "Backend"},
+			{ID: "s2", EventID: "e1", RoomID: "r1", Track: "Data"},
+			{ID: "s3", EventID: "e1", RoomID: "r1", Track: "Frontend"},
+			{ID: "s4", EventID: "e1", RoomID: "r2", Track: "Hallway"},
+		}},
+		trackOrder: map[string][]string{"e1": {"Frontend", "Backend"}},
+	}
+	svc := &attendeeService{eventRepo: events, sessionRepo: sessions, operatingHoursRepo: &mockOperatingHoursRepository{}, customFieldRepo: newFakeCustomFieldRepo(), occupancyRepo: newFakeRoomOccupancyRepo()}
+
+	schedule, err := svc.GetEventSchedule(ctx, "e1", "owner1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Ordered tracks come first, then the rest by name; tracks only in unbookable rooms are left out.
+	if want := []string{"Frontend", "Backend", "Data"}; !slices.Equal(schedule.Tracks, want) {
+		t.Errorf("tracks: got %v, want %v", schedule.Tracks, want)
+	}
+}
+
+func TestAttendeeService_JoinEventByCode(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	event := &domain.Event{ID: "e1", EventCode: "ab12", OwnerID: "owner1"}
+	events := &mockEventRepository{events: map[string]*domain.Event{"e1": event}, eventsByCode: map[string]*domain.Event{"ab12": event}}
+	sessions := &mockSessionRepository{
+		roomsByEvent:    map[string][]*domain.Room{"e1": {{ID: "r1", EventID: "e1"}}},
+		sessionsByEvent: map[string][]*domain.Session{"e1": {{ID: "s1", EventID: "e1", RoomID: "r1"}}},
+	}
+	regs := &mockEventRegistrationRepository{regByEventAndUser: map[string]*domain.EventRegistration{
+		"e1:u2": {ID: "reg-2", EventID: "e1", UserID: "u2", CreatedAt: now, UpdatedAt: now},
+	}}
+	metrics := &fakeBusinessMetrics{}
+	abuse := newFakeAbuseRepo()
+	svc := &attendeeService{eventRepo: events, registrationRepo: regs, sessionRepo: sessions, operatingHoursRepo: &mockOperatingHoursRepository{}, customFieldRepo: newFakeCustomFieldRepo(), occupancyRepo: newFakeRoomOccupancyRepo(), abuseRepo: abuse, metrics: metrics}
+
+	join, err := svc.JoinEventByCode(ctx, " AB12 ", "u1", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !join.Joined || join.Registration.EventID != "e1" || join.Registration.UserID != "u1" {
+		t.Errorf("new attendee: got joined=%v, registration %+v", join.Joined, join.Registration)
+	}
+	if len(join.Schedule.Rooms) != 1 || len(join.Schedule.Rooms[0].Sessions) != 1 {
+		t.Errorf("schedule: got %+v, want one room with one session", join.Schedule.Rooms)
+	}
+	if len(metrics.registrations) != 1 {
+		t.Errorf("expected the registration counted, got %v", metrics.registrations)
+	}
+
+	join, err = svc.JoinEventByCode(ctx, "ab12", "u2", nil)
+	if err != nil || join.Joined || join.Registration.ID != "reg-2" {
+		t.Errorf("already registered: got %+v, %v", join, err)
+	}
+
+	if _, err := svc.JoinEventByCode(ctx, "zz99", "u1", nil); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("unknown code: want ErrNotFound, got %v", err)
+	}
+
+	abuse.unlisted[domain.AbuseTarget{Type: domain.AbuseTargetEvent, ID: "e1"}] = "e1"
+	if _, err := svc.JoinEventByCode(ctx, "ab12", "u3", nil); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("unlisted event: want ErrNotFound, got %v", err)
+	}
+	if join, err := svc.JoinEventByCode(ctx, "ab12", "u2", nil); err != nil || join.Schedule == nil {
+		t.Errorf("unlisted event, registered attendee: got %+v, %v", join, err)
+	}
+	// Every way of registering refuses new attendees alike.
+	if _, _, err := svc.RegisterForEvent(ctx, "e1", "u3"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("unlisted event, RegisterForEvent: want ErrNotFound, got %v", err)
+	}
+	if _, _, err := svc.RegisterForEventByCode(ctx, "ab12", "u3", nil); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("unlisted event, RegisterForEventByCode: want ErrNotFound, got %v", err)
+	}
+	if _, created, err := svc.RegisterForEventByCode(ctx, "ab12", "u2", nil); err != nil || created {
+		t.Errorf("unlisted event, registered attendee: got created=%v, %v", created, err)
+	}
+	if len(metrics.registrations) != 1 {
+		t.Errorf("expected one registration counted, got %v", metrics.registrations)
+	}
+}
+
+func TestAttendeeService_GetEventTheme(t *testing.T) {
+	ctx := context.Background()
+	event := &domain.Event{ID: "e1", EventCode: "ab12", OwnerID: "owner1"}
+	events := &mockEventRepository{eventsByCode: map[string]*domain.Event{"ab12": event}}
+	svc := &attendeeService{eventRepo: events}
+
+	theme, err := svc.GetEventTheme(ctx, " AB12 ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *theme != *domain.NewEventTheme("e1") {
+		t.Errorf("unsaved theme: got %+v, want the default theme", theme)
+	}
+
+	events.themes = map[string]*domain.EventTheme{"e1": {EventID: "e1", PrimaryColor: "#112233"}}
+	theme, err = svc.GetEventTheme(ctx, "ab12")
+	if err != nil || theme.PrimaryColor != "#112233" {
+		t.Errorf("saved theme: got %+v, %v", theme, err)
+	}
+
+	if _, err := svc.GetEventTheme(ctx, "zz99"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("unknown code: want ErrNotFound, got %v", err)
+	}
+
+	abuse := newFakeAbuseRepo()
+	abuse.unlisted[domain.AbuseTarget{Type: domain.AbuseTargetEvent, ID: "e1"}] = "e1"
+	svc.abuseRepo = abuse
+	if _, err := svc.GetEventTheme(ctx, "ab12"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("unlisted event: want ErrNotFound, got %v", err)
+	}
+}
+
+func TestAttendeeService_GetPublicSchedule(t *testing.T) {
+	ctx := context.Background()
+	event := &domain.Event{ID: "e1", Name: "Conf", EventCode: "ab12", OwnerID: "owner1"}
+	events := &mockEventRepository{
+		eventsByCode: map[string]*domain.Event{"ab12": event},
+		themes:       map[string]*domain.EventTheme{"e1": {EventID: "e1", PrimaryColor: "#112233"}},
+	}
+	sessions := &mockSessionRepository{
+		roomsByEvent:    map[string][]*domain.Room{"e1": {{ID: "r1", EventID: "e1"}}},
+		sessionsByEvent: map[string][]*domain.Session{"e1": {{ID: "s1", EventID: "e1", RoomID: "r1", SpeakerIDs: []string{}}}},
+		speakerIDs:      map[string][]string{"s1": {"sp1"}},
+		speakersByEvent: map[string][]*domain.Speaker{"e1": {{ID: "sp1", FirstName: "Ada", ProfilePicture: "https://img.example.com/ada.png"}}},
+	}
+	thumbs := &fakeThumbnails{}
+	svc := &attendeeService{eventRepo: events, sessionRepo: sessions, operatingHoursRepo: &mockOperatingHoursRepository{}, customFieldRepo: newFakeCustomFieldRepo(), thumbnails: thumbs}
+
+	ps, err := svc.GetPublicSchedule(ctx, " AB12 ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ps.EventID != "e1" || ps.Theme.PrimaryColor != "#112233" || ps.Schedule.Event.OwnerID != "" {
+		t.Errorf("unexpected page data: event %q, theme %+v, owner %q", ps.EventID, ps.Theme, ps.Schedule.Event.OwnerID)
+	}
+	if len(ps.Schedule.Rooms) != 1 || len(ps.Schedule.Rooms[0].Sessions) != 1 || !slices.Equal(ps.Schedule.Rooms[0].Sessions[0].SpeakerIDs, []string{"sp1"}) {
+		t.Fatalf("unexpected rooms: %+v", ps.Schedule.Rooms)
+	}
+	if len(ps.Schedule.Speakers) != 1 || ps.Schedule.Speakers[0].Photo != "" {
+		t.Errorf("speakers: got %+v, want Ada without a photo path", ps.Schedule.Speakers)
+	}
+
+	abuse := newFakeAbuseRepo()
+	abuse.unlisted[domain.AbuseTarget{Type: domain.AbuseTargetEvent, ID: "e1"}] = "e1"
+	svc.abuseRepo = abuse
+	if _, err := svc.GetPublicSchedule(ctx, "ab12"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("unlisted event: want ErrNotFound, got %v", err)
+	}
+}
+
+// fakeCardRenderer records the card it renders and returns its title as the image.
+type fakeCardRenderer struct {
+	card *domain.SessionCard
+}
+
+func (f *fakeCardRenderer) RenderSessionCard(card *domain.SessionCard) ([]byte, error) {
+	f.card = card
+	return []byte(card.Title), nil
+}
+
+func TestAttendeeService_GetSessionCard(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2026, 5, 14, 9, 0, 0, 0, time.UTC)
+	events := &mockEventRepository{
+		events: map[string]*domain.Event{"e1": {ID: "e1", Name: "Conf", EventCode: "ab12"}},
+		themes: map[string]*domain.EventTheme{"e1": {EventID: "e1", PrimaryColor: "#112233"}},
+	}
+	sessions := &mockSessionRepository{
+		roomsByEvent: map[string][]*domain.Room{"e1": {{ID: "r1", EventID: "e1", Name: "Main Hall"}, {ID: "r2", EventID: "e1", NotBookable: true}}},
+		sessionsByEvent: map[string][]*domain.Session{"e1": {
+			{ID: "s1", EventID: "e1", RoomID: "r1", Title: "Keynote", StartTime: start, EndTime: start.Add(time.Hour)},
+			{ID: "s2", EventID: "e1", RoomID: "r2", Title: "Lunch"},
+			{ID: "s3", EventID: "e1", Title: "Unscheduled"},
+		}},
+		speakerIDs:      map[string][]string{"s1": {"sp1", "sp2"}},
+		speakersByEvent: map[string][]*domain.Speaker{"e1": {{ID: "sp1", FirstName: "Ada", LastName: "Lovelace"}, {ID: "sp2", FirstName: "Alan", LastName: "Turing"}}},
+	}
+	cards := &fakeCardRenderer{}
+	abuse := newFakeAbuseRepo()
+	abuse.unlisted[domain.AbuseTarget{Type: domain.AbuseTargetSpeaker, ID: "sp2"}] = "e1"
+	svc := &attendeeService{eventRepo: events, sessionRepo: sessions, abuseRepo: abuse, cards: cards}
+
+	card, err := svc.GetSessionCard(ctx, "s1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if card.EventName != "Conf" || card.EventCode != "ab12" || card.Room != "Main Hall" || !card.StartTime.Equal(start) || card.Theme.PrimaryColor != "#112233" {
+		t.Errorf("unexpected card: %+v", card)
+	}
+	if !slices.Equal(card.Speakers, []string{"Ada Lovelace"}) {
+		t.Errorf("speakers: got %v, want only the listed Ada Lovelace", card.Speakers)
+	}
+
+	img, err := svc.GetSessionCardImage(ctx, "s1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if img.EventID != "e1" || string(img.PNG) != "Keynote" || cards.card.SessionID != "s1" {
+		t.Errorf("unexpected image: %+v", img)
+	}
+
+	for _, id := range []string{"missing", "s2", "s3"} {
+		if _, err := svc.GetSessionCard(ctx, id); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("session %s: want ErrNotFound, got %v", id, err)
+		}
+	}
+	abuse.unlisted[domain.AbuseTarget{Type: domain.AbuseTargetSession, ID: "s1"}] = "e1"
+	if _, err := svc.GetSessionCardImage(ctx, "s1"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("unlisted session: want ErrNotFound, got %v", err)
+	}
+}
+
+// fakePublicPageRepo returns pages and records the limit asked for.
+type fakePublicPageRepo struct {
+	pages []*domain.PublicPage
+	limit int
+}
+
+func (f *fakePublicPageRepo) ListPublicPages(ctx context.Context, now time.Time, limit int) ([]*domain.PublicPage, error) {
+	f.limit = limit
+	return f.pages, nil
+}
+
+func TestAttendeeService_ListPublicPages(t *testing.T) {
+	repo := &fakePublicPageRepo{pages: []*domain.PublicPage{{Kind: domain.PublicPageEvent, EventCode: "ab12", ID: "e1"}}}
+	svc := &attendeeService{publicPageRepo: repo}
+
+	pages, err := svc.ListPublicPages(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pages) != 1 || repo.limit != domain.MaxSitemapPages {
+		t.Errorf("got %d pages with limit %d, want 1 with limit %d", len(pages), repo.limit, domain.MaxSitemapPages)
+	}
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+type businessMetrics struct {
+	registrations  domain.EventRegistrationRepository
+	maxEvents      int
+	contextTimeout time.Duration
+
+	mu              sync.Mutex
+	invitationsSent *eventCounter
+	registered      *eventCounter
+}
+
+// NewBusinessMetrics returns a recorder that counts invitations and registrations per event and
+// reads the active registrations from registrations when reported. At most maxEvents events get
+// their own label per metric; the counts of the rest are folded into domain.OtherEventsLabel, so a
+// busy instance cannot flood Prometheus with series. maxEvents 0 folds every event together.
+func NewBusinessMetrics(registrations domain.EventRegistrationRepository, maxEvents int, timeout time.Duration) domain.BusinessMetricsRecorder {
+	maxEvents = max(maxEvents, 0)
+	return &businessMetrics{
+		registrations:   registrations,
+		maxEvents:       maxEvents,
+		contextTimeout:  timeout,
+		invitationsSent: newEventCounter(maxEvents),
+		registered:      newEventCounter(maxEvents),
+	}
+}
+
+func (m *businessMetrics) InvitationSent(eventID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.invitationsSent.add(eventID)
+}
+
+func (m *businessMetrics) Registered(eventID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.registered.add(eventID)
+}
+
+func (m *businessMetrics) BusinessMetricsReport(ctx context.Context) (*domain.BusinessMetricsReport, error) {
+	ctx, cancel := withTimeout(ctx, m.contextTimeout)
+	defer cancel()
+
+	active, err := m.registrations.CountActiveByEvent(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("count active registrations: %w", err)
+	}
+	// The repository returns the largest events first, so those keep their labels.
+	gauge := make([]domain.EventCount, 0, min(len(active), m.maxEvents+1))
+	var other int64
+	for i, c := range active {
+		if i < m.maxEvents {
+			gauge = append(gauge, c)
+			continue
+		}
+		other += c.Count
+	}
+	if other > 0 {
+		gauge = append(gauge, domain.EventCount{EventID: domain.OtherEventsLabel, Count: other})
+	}
+	sortEventCounts(gauge)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return &domain.BusinessMetricsReport{
+		InvitationsSent:     m.invitationsSent.snapshot(),
+		Registrations:       m.registered.snapshot(),
+		ActiveRegistrations: gauge,
+	}, nil
+}
+
+// eventCounter counts per event for the first max events it sees and folds later ones into other.
+// The caller serializes access.
+type eventCounter struct {
+	max    int
+	counts map[string]int64
+	other  int64
+}
+
+func newEventCounter(max int) *eventCounter {
+	return &eventCounter{max: max, counts: make(map[string]int64)}
+}
+
+func (c *eventCounter) add(eventID string) {
+	if _, ok := c.counts[eventID]; ok || len(c.counts) < c.max {
+		c.counts[eventID]++
+		return
+	}
+	c.other++
+}
+
+func (c *eventCounter) snapshot() []domain.EventCount {
+	counts := make([]domain.EventCount, 0, len(c.counts)+1)
+	for id, n := range c.counts {
+		counts = append(counts, domain.EventCount{EventID: id, Count: n})
+	}
+	if c.other > 0 {
+		counts = append(counts, domain.EventCount{EventID: domain.OtherEventsLabel, Count: c.other})
+	}
+	sortEventCounts(counts)
+	return counts
+}
+
+// sortEventCounts sorts by event ID with domain.OtherEventsLabel last.
+func sortEventCounts(counts []domain.EventCount) {
+	sort.Slice(counts, func(i, j int) bool {
+		a, b := counts[i].EventID, counts[j].EventID
+		if (a == domain.OtherEventsLabel) != (b == domain.OtherEventsLabel) {
+			return b == domain.OtherEventsLabel
+		}
+		return a < b
+	})
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubActiveRegistrations struct {
+	mockEventRegistrationRepository
+	counts []domain.EventCount
+}
+
+func (s *stubActiveRegistrations) CountActiveByEvent(ctx context.Context) ([]domain.EventCount, error) {
+	return s.counts, s.err
+}
+
+func TestBusinessMetrics_LimitsEventLabels(t *testing.T) {
+	repo := &stubActiveRegistrations{counts: []domain.EventCount{
+		{EventID: "ev-big", Count: 50}, {EventID: "ev-mid", Count: 20}, {EventID: "ev-small", Count: 5}, {EventID: "ev-tiny", Count: 1},
+	}}
+	m := NewBusinessMetrics(repo, 2, time.Second)
+	for _, id := range []string{"ev-b", "ev-a", "ev-b", "ev-c", "ev-d", "ev-a"} {
+		m.InvitationSent(id)
+	}
+	m.Registered("ev-a")
+
+	report, err := m.BusinessMetricsReport(context.Background())
+	require.NoError(t, err)
+	// ev-c and ev-d came after the first two events took the labels.
+	assert.Equal(t, []domain.EventCount{{EventID: "ev-a", Count: 2}, {EventID: "ev-b", Count: 2}, {EventID: domain.OtherEventsLabel, Count: 2}}, report.InvitationsSent)
+	assert.Equal(t, []domain.EventCount{{EventID: "ev-a", Count: 1}}, report.Registrations)
+	// The largest events keep their labels.
+	assert.Equal(t, []domain.EventCount{{EventID: "ev-big", Count: 50}, {EventID: "ev-mid", Count: 20}, {EventID: domain.OtherEventsLabel, Count: 6}}, report.ActiveRegistrations)
+}
+
+func TestBusinessMetrics_NoEventLabels(t *testing.T) {
+	m := NewBusinessMetrics(&stubActiveRegistrations{counts: []domain.EventCount{{EventID: "ev-1", Count: 3}}}, 0, time.Second)
+	m.Registered("ev-1")
+
+	report, err := m.BusinessMetricsReport(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, report.InvitationsSent)
+	assert.Equal(t, []domain.EventCount{{EventID: domain.OtherEventsLabel, Count: 1}}, report.Registrations)
+	assert.Equal(t, []domain.EventCount{{EventID: domain.OtherEventsLabel, Count: 3}}, report.ActiveRegistrations)
+}
+
+func TestBusinessMetrics_RepositoryError(t *testing.T) {
+	repo := &stubActiveRegistrations{}
+	repo.err = errors.New("db down")
+	_, err := NewBusinessMetrics(repo, 10, time.Second).BusinessMetricsReport(context.Background())
+	assert.ErrorIs(t, err, repo.err)
+}
@@ -0,0 +1,125 @@
+package services
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+// normalizeChecklistLabels trims the labels and returns an ErrInvalidInput error when one is empty,
+// too long or repeated, or when there are too many.
+func normalizeChecklistLabels(labels []string) ([]string, error) {
+	if len(labels) > domain.MaxChecklistItems {
+		return nil, fmt.Errorf("a checklist has at most %d items: %w", domain.MaxChecklistItems, domain.ErrInvalidInput)
+	}
+	out := make([]string, 0, len(labels))
+	seen := make(map[string]bool, len(labels))
+	for _, label := range labels {
+		label = strings.TrimSpace(label)
+		if label == "" {
+			return nil, fmt.Errorf("checklist items must not be empty: %w", domain.ErrInvalidInput)
+		}
+		if len(label) > 255 {
+			return nil, fmt.Errorf("checklist item %q is longer than 255 characters: %w", label[:32]+"...", domain.ErrInvalidInput)
+		}
+		key := strings.ToLower(label)
+		if seen[key] {
+			return nil, fmt.Errorf("checklist item %q is listed more than once: %w", label, domain.ErrInvalidInput)
+		}
+		seen[key] = true
+		out = append(out, label)
+	}
+	return out, nil
+}
+
+// checklistState indexes an event's checklist completions by session and item.
+type checklistState struct {
+	items []*domain.ChecklistItem
+	done  map[string]map[string]*domain.ChecklistCompletion
+	names map[string]string
+}
+
+// newChecklistState indexes completions; names maps user IDs to display names.
+func newChecklistState(items []*domain.ChecklistItem, completions []*domain.ChecklistCompletion, names map[string]string) *checklistState {
+	st := &checklistState{items: items, done: make(map[string]map[string]*domain.ChecklistCompletion), names: names}
+	for _, c := range completions {
+		if st.done[c.SessionID] == nil {
+			st.done[c.SessionID] = make(map[string]*domain.ChecklistCompletion)
+		}
+		st.done[c.SessionID][c.ItemID] = c
+	}
+	return st
+}
+
+// sessionChecklist returns the session's checklist, with items in checklist order.
+func (st *checklistState) sessionChecklist(sess *domain.Session) *domain.SessionChecklist {
+	sc := &domain.SessionChecklist{
+		SessionID: sess.ID,
+		Title:     sess.Title,
+		RoomID:    sess.RoomID,
+		StartTime: sess.StartTime,
+		Total:     len(st.items),
+		Items:     make([]*domain.SessionChecklistItem, len(st.items)),
+	}
+	for i, item := range st.items {
+		entry := &domain.SessionChecklistItem{ItemID: item.ID, Label: item.Label}
+		if c := st.done[sess.ID][item.ID]; c != nil {
+			completedAt := c.CompletedAt
+			entry.Done = true
+			entry.CompletedBy = c.CompletedBy
+			entry.CompletedByName = st.names[c.CompletedBy]
+			entry.CompletedAt = &completedAt
+			sc.Done++
+		}
+		sc.Items[i] = entry
+	}
+	sc.Ready = sc.Done == sc.Total
+	return sc
+}
+
+// progress returns the checklist progress of sessions, keeping only the sessions whose readiness
+// matches ready when it is set.
+func (st *checklistState) progress(eventID string, sessions []*domain.Session, ready *bool) *domain.ChecklistProgress {
+	p := &domain.ChecklistProgress{
+		EventID:       eventID,
+		Items:         st.items,
+		SessionsTotal: len(sessions),
+		Members:       []*domain.ChecklistMemberProgress{},
+		Sessions:      []*domain.SessionChecklist{},
+	}
+	sessions = slices.Clone(sessions)
+	slices.SortFunc(sessions, func(a, b *domain.Session) int {
+		return cmp.Or(a.StartTime.Compare(b.StartTime), strings.Compare(a.ID, b.ID))
+	})
+	counts := make(map[string]int)
+	for _, sess := range sessions {
+		sc := st.sessionChecklist(sess)
+		if sc.Ready {
+			p.SessionsReady++
+		}
+		for _, item := range sc.Items {
+			if item.Done && item.CompletedBy != "" {
+				counts[item.CompletedBy]++
+			}
+		}
+		if ready == nil || *ready == sc.Ready {
+			p.Sessions = append(p.Sessions, sc)
+		}
+	}
+	for userID, n := range counts {
+		p.Members = append(p.Members, &domain.ChecklistMemberProgress{UserID: userID, Name: st.names[userID], Completed: n})
+	}
+	slices.SortFunc(p.Members, func(a, b *domain.ChecklistMemberProgress) int {
+		return cmp.Or(cmp.Compare(b.Completed, a.Completed), strings.Compare(a.Name, b.Name), strings.Compare(a.UserID, b.UserID))
+	})
+	return p
+}
+
+// checklistCompletion is a completion of item for the session by userID at now.
+func checklistCompletion(sessionID, itemID, userID string, now time.Time) *domain.ChecklistCompletion {
+	return &domain.ChecklistCompletion{SessionID: sessionID, ItemID: itemID, CompletedBy: userID, CompletedAt: now}
+}
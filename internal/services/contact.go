@@ -0,0 +1,210 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"multitrackticketing/internal/domain"
+)
+
+type contactService struct {
+	eventRepo      domain.EventRepository
+	teamRepo       domain.EventTeamMemberRepository
+	userRepo       domain.UserRepository
+	contactRepo    domain.ContactRepository
+	emailService   domain.EmailService
+	limit          domain.ContactLimit
+	contextTimeout time.Duration
+}
+
+// NewContactService returns a domain.ContactService. A limit with Max 0 does not rate-limit the
+// contact form.
+func NewContactService(eventRepo domain.EventRepository, teamRepo domain.EventTeamMemberRepository, userRepo domain.UserRepository, contactRepo domain.ContactRepository, emailService domain.EmailService, limit domain.ContactLimit, timeout time.Duration) domain.ContactService {
+	return &contactService{
+		eventRepo:      eventRepo,
+		teamRepo:       teamRepo,
+		userRepo:       userRepo,
+		contactRepo:    contactRepo,
+		emailService:   emailService,
+		limit:          limit,
+		contextTimeout: timeout,
+	}
+}
+
+func (s *contactService) ContactOrganizers(ctx context.Context, req *domain.ContactRequest) (*domain.ContactThread, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	name := strings.TrimSpace(req.Name)
+	email := strings.TrimSpace(strings.ToLower(req.Email))
+	subject := strings.TrimSpace(req.Subject)
+	message := strings.TrimSpace(req.Message)
+	switch {
+	case name == "" || utf8.RuneCountInString(name) > 255:
+		return nil, fmt.Errorf("name must be 1 to 255 characters: %w", domain.ErrInvalidInput)
+	case len(email) > 255 || !emailRegexp.MatchString(email):
+		return nil, fmt.Errorf("invalid email format: %w", domain.ErrInvalidInput)
+	case subject == "" || utf8.RuneCountInString(subject) > domain.MaxContactSubjectLength:
+		return nil, fmt.Errorf("subject must be 1 to %d characters: %w", domain.MaxContactSubjectLength, domain.ErrInvalidInput)
+	case message == "" || utf8.RuneCountInString(message) > domain.MaxContactMessageLength:
+		return nil, fmt.Errorf("message must be 1 to %d characters: %w", domain.MaxContactMessageLength, domain.ErrInvalidInput)
+	}
+
+	event, err := s.eventRepo.GetByEventCode(ctx, req.EventCode)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event by code: %w", err)
+	}
+
+	if s.limit.Max > 0 {
+		byIP, byEmail, err := s.contactRepo.CountRecentThreads(ctx, req.RemoteIP, email, time.Now().Add(-s.limit.Window))
+		if err != nil {
+			return nil, fmt.Errorf("count recent contact threads: %w", err)
+		}
+		if byIP >= s.limit.Max || byEmail >= s.limit.Max {
+			return nil, domain.ErrRateLimited
+		}
+	}
+
+	thread := &domain.ContactThread{EventID: event.ID, SenderName: name, SenderEmail: email, Subject: subject}
+	first := &domain.ContactMessage{Author: domain.ContactAuthorSender, Body: message}
+	if err := s.contactRepo.CreateThread(ctx, thread, first, req.RemoteIP); err != nil {
+		return nil, fmt.Errorf("create contact thread: %w", err)
+	}
+
+	// The message is stored either way; relaying it is best effort, and failed sends are in the
+	// event's email archive to resend.
+	data := domain.ContactMessageEmailData{
+		EventID:    event.ID,
+		EventName:  event.Name,
+		SenderName: name,
+		Subject:    subject,
+		Message:    message,
+		ThreadID:   thread.ID,
+	}
+	if owner, err := s.userRepo.GetByID(ctx, event.OwnerID); err != nil {
+		domain.ReportBestEffort(ctx, fmt.Errorf("owner of event %s for contact thread %s: %w", event.ID, thread.ID, err))
+	} else {
+		d := data
+		d.Email, d.RecipientName = owner.Email, displayName(owner)
+		if err := s.emailService.SendContactMessage(ctx, &d); err != nil {
+			domain.ReportBestEffort(ctx, fmt.Errorf("relay contact thread %s to the owner: %w", thread.ID, err))
+		}
+	}
+	members, err := s.teamRepo.ListByEventID(ctx, event.ID)
+	if err != nil {
+		domain.ReportBestEffort(ctx, fmt.Errorf("team of event %s for contact thread %s: %w", event.ID, thread.ID, err))
+	}
+	for _, m := range members {
+		d := data
+		d.Email, d.RecipientName = m.Email, displayName(&domain.User{Name: m.Name, LastName: m.LastName, Email: m.Email})
+		if err := s.emailService.SendContactMessage(ctx, &d); err != nil {
+			domain.ReportBestEffort(ctx, fmt.Errorf("relay contact thread %s to team member %s: %w", thread.ID, m.UserID, err))
+		}
+	}
+	return thread, nil
+}
+
+func (s *contactService) ListContactThreads(ctx context.Context, eventID, userID string, params domain.PaginationParams) ([]*domain.ContactThread, int, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if _, err := s.teamEvent(ctx, eventID, userID); err != nil {
+		return nil, 0, err
+	}
+	threads, total, err := s.contactRepo.ListThreads(ctx, eventID, params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list contact threads: %w", err)
+	}
+	return threads, total, nil
+}
+
+func (s *contactService) GetContactThread(ctx context.Context, eventID, userID, threadID string) (*domain.ContactThread, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if _, err := s.teamEvent(ctx, eventID, userID); err != nil {
+		return nil, err
+	}
+	return s.eventThread(ctx, eventID, threadID)
+}
+
+func (s *contactService) ReplyToContactThread(ctx context.Context, eventID, userID, threadID, body string) (*domain.ContactMessage, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	body = strings.TrimSpace(body)
+	if body == "" || utf8.RuneCountInString(body) > domain.MaxContactMessageLength {
+		return nil, fmt.Errorf("body must be 1 to %d characters: %w", domain.MaxContactMessageLength, domain.ErrInvalidInput)
+	}
+	event, err := s.teamEvent(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	thread, err := s.eventThread(ctx, eventID, threadID)
+	if err != nil {
+		return nil, err
+	}
+	reply := &domain.ContactMessage{ThreadID: thread.ID, Author: domain.ContactAuthorTeam, UserID: userID, Body: body}
+	if err := s.contactRepo.AddMessage(ctx, reply); err != nil {
+		return nil, fmt.Errorf("add contact reply: %w", err)
+	}
+
+	// As with relayed messages, a failed send is archived to resend rather than failing the reply.
+	if err := s.emailService.SendContactReply(ctx, &domain.ContactReplyEmailData{
+		EventID:   event.ID,
+		Email:     thread.SenderEmail,
+		Name:      thread.SenderName,
+		EventName: event.Name,
+		Subject:   thread.Subject,
+		Reply:     body,
+	}); err != nil {
+		domain.ReportBestEffort(ctx, fmt.Errorf("send reply to contact thread %s: %w", thread.ID, err))
+	}
+	return reply, nil
+}
+
+// teamEvent returns the event when userID owns it or is on its team, ErrNotFound when it does not
+// exist and ErrForbidden otherwise.
+func (s *contactService) teamEvent(ctx context.Context, eventID, userID string) (*domain.Event, error) {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID == userID {
+		return event, nil
+	}
+	members, err := s.teamRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list team members: %w", err)
+	}
+	if !slices.ContainsFunc(members, func(m *domain.EventTeamMember) bool { return m.UserID == userID }) {
+		return nil, domain.ErrForbidden
+	}
+	return event, nil
+}
+
+// eventThread returns the thread with its messages, or ErrNotFound when it is not one of the event's.
+func (s *contactService) eventThread(ctx context.Context, eventID, threadID string) (*domain.ContactThread, error) {
+	thread, err := s.contactRepo.GetThread(ctx, threadID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get contact thread: %w", err)
+	}
+	if thread.EventID != eventID {
+		return nil, domain.ErrNotFound
+	}
+	return thread, nil
+}
@@ -0,0 +1,175 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeContactRepo is an in-memory ContactRepository.
+type fakeContactRepo struct {
+	threads map[string]*domain.ContactThread
+	// recent is returned by CountRecentThreads for every address and email.
+	recent int
+}
+
+func newFakeContactRepo() *fakeContactRepo {
+	return &fakeContactRepo{threads: make(map[string]*domain.ContactThread)}
+}
+
+func (f *fakeContactRepo) CreateThread(ctx context.Context, t *domain.ContactThread, first *domain.ContactMessage, remoteIP string) error {
+	t.ID = "th-" + t.Subject
+	first.ID, first.ThreadID = t.ID+"-m1", t.ID
+	t.MessageCount = 1
+	t.Messages = []*domain.ContactMessage{first}
+	f.threads[t.ID] = t
+	return nil
+}
+
+func (f *fakeContactRepo) CountRecentThreads(ctx context.Context, remoteIP, email string, since time.Time) (int, int, error) {
+	return f.recent, f.recent, nil
+}
+
+func (f *fakeContactRepo) ListThreads(ctx context.Context, eventID string, params domain.PaginationParams) ([]*domain.ContactThread, int, error) {
+	out := []*domain.ContactThread{}
+	for _, t := range f.threads {
+		if t.EventID == eventID {
+			out = append(out, t)
+		}
+	}
+	return out, len(out), nil
+}
+
+func (f *fakeContactRepo) GetThread(ctx context.Context, threadID string) (*domain.ContactThread, error) {
+	if t, ok := f.threads[threadID]; ok {
+		return t, nil
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (f *fakeContactRepo) AddMessage(ctx context.Context, m *domain.ContactMessage) error {
+	t, ok := f.threads[m.ThreadID]
+	if !ok {
+		return domain.ErrNotFound
+	}
+	t.Messages = append(t.Messages, m)
+	t.MessageCount++
+	return nil
+}
+
+func TestContactService(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func() (domain.ContactService, *fakeContactRepo, *fakeEmailService) {
+		eventRepo := newFakeEventRepo()
+		eventRepo.byID["ev-1"] = &domain.Event{ID: "ev-1", Name: "GopherCon", EventCode: "gc25", OwnerID: "user-owner"}
+		userRepo := newFakeUserRepoForSchedule()
+		userRepo.addUserWithName("owner@example.com", "user-owner", "Olive", "Owner")
+		teamRepo := newFakeEventTeamMemberRepo()
+		require.NoError(t, teamRepo.Add(ctx, "ev-1", "user-ada"))
+		contacts := newFakeContactRepo()
+		emails := newFakeEmailService()
+		svc := NewContactService(eventRepo, teamRepo, userRepo, contacts, emails, domain.ContactLimit{Max: 3, Window: time.Hour}, 5*time.Second)
+		return svc, contacts, emails
+	}
+	valid := func() *domain.ContactRequest {
+		return &domain.ContactRequest{EventCode: "gc25", Name: " Ada ", Email: "Ada@Example.com", Subject: "Parking", Message: "Is there parking?", RemoteIP: "203.0.113.7"}
+	}
+
+	t.Run("relays to the owner and team", func(t *testing.T) {
+		svc, _, emails := setup()
+		thread, err := svc.ContactOrganizers(ctx, valid())
+		require.NoError(t, err)
+		assert.Equal(t, "ev-1", thread.EventID)
+		assert.Equal(t, "Ada", thread.SenderName)
+		assert.Equal(t, "ada@example.com", thread.SenderEmail)
+		require.Len(t, emails.sentContactMessages, 2, "the owner and one team member")
+		assert.Equal(t, &domain.ContactMessageEmailData{
+			EventID: "ev-1", Email: "owner@example.com", RecipientName: "Olive Owner", EventName: "GopherCon",
+			SenderName: "Ada", Subject: "Parking", Message: "Is there parking?", ThreadID: thread.ID,
+		}, emails.sentContactMessages[0])
+	})
+
+	t.Run("failed relays are reported", func(t *testing.T) {
+		svc, _, emails := setup()
+		emails.sendContactErr = errors.New("ses down")
+		reqCtx, failures := domain.WithBestEffortFailures(ctx)
+		thread, err := svc.ContactOrganizers(reqCtx, valid())
+		require.NoError(t, err, "the message is stored either way")
+		require.Len(t, failures.Errors(), 2, "the owner and one team member")
+		assert.ErrorContains(t, failures.Errors()[0], "relay contact thread "+thread.ID+" to the owner: ses down")
+
+		_, err = svc.ReplyToContactThread(reqCtx, "ev-1", "user-ada", thread.ID, "Yes.")
+		require.NoError(t, err)
+		require.Len(t, failures.Errors(), 3)
+		assert.ErrorContains(t, failures.Errors()[2], "send reply to contact thread "+thread.ID)
+	})
+
+	t.Run("rejects bad input", func(t *testing.T) {
+		svc, _, _ := setup()
+		for _, mutate := range []func(*domain.ContactRequest){
+			func(r *domain.ContactRequest) { r.Name = " " },
+			func(r *domain.ContactRequest) { r.Email = "nope" },
+			func(r *domain.ContactRequest) { r.Subject = "" },
+			func(r *domain.ContactRequest) { r.Message = strings.Repeat("a", domain.MaxContactMessageLength+1) },
+		} {
+			req := valid()
+			mutate(req)
+			_, err := svc.ContactOrganizers(ctx, req)
+			require.ErrorIs(t, err, domain.ErrInvalidInput)
+		}
+	})
+
+	t.Run("unknown event code", func(t *testing.T) {
+		svc, _, _ := setup()
+		req := valid()
+		req.EventCode = "zzzz"
+		_, err := svc.ContactOrganizers(ctx, req)
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		svc, contacts, _ := setup()
+		contacts.recent = 3
+		_, err := svc.ContactOrganizers(ctx, valid())
+		require.ErrorIs(t, err, domain.ErrRateLimited)
+		assert.Empty(t, contacts.threads)
+	})
+
+	t.Run("team reads and replies", func(t *testing.T) {
+		svc, _, emails := setup()
+		thread, err := svc.ContactOrganizers(ctx, valid())
+		require.NoError(t, err)
+
+		_, _, err = svc.ListContactThreads(ctx, "ev-1", "user-stranger", domain.PaginationParams{Page: 1, PageSize: 20})
+		require.ErrorIs(t, err, domain.ErrForbidden)
+		threads, total, err := svc.ListContactThreads(ctx, "ev-1", "user-ada", domain.PaginationParams{Page: 1, PageSize: 20})
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+		assert.Len(t, threads, 1)
+
+		reply, err := svc.ReplyToContactThread(ctx, "ev-1", "user-ada", thread.ID, " Yes, level -1. ")
+		require.NoError(t, err)
+		assert.Equal(t, domain.ContactAuthorTeam, reply.Author)
+		assert.Equal(t, "user-ada", reply.UserID)
+		require.Len(t, emails.sentContactReplies, 1)
+		assert.Equal(t, &domain.ContactReplyEmailData{
+			EventID: "ev-1", Email: "ada@example.com", Name: "Ada", EventName: "GopherCon", Subject: "Parking", Reply: "Yes, level -1.",
+		}, emails.sentContactReplies[0])
+
+		got, err := svc.GetContactThread(ctx, "ev-1", "user-owner", thread.ID)
+		require.NoError(t, err)
+		assert.Len(t, got.Messages, 2)
+		_, err = svc.GetContactThread(ctx, "ev-1", "user-owner", "th-missing")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+		_, err = svc.ReplyToContactThread(ctx, "ev-1", "user-owner", thread.ID, "")
+		require.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+}
@@ -0,0 +1,17 @@
+package services
+
+import (
+	"context"
+	"time"
+)
+
+// withTimeout bounds ctx by timeout unless it already has a deadline. HTTP requests carry the
+// deadline of their route class, which then bounds the whole call: a long route is not cut short
+// by the service's default, and a slow query fails with the request's deadline rather than a
+// later one of its own.
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
@@ -0,0 +1,29 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithTimeout(t *testing.T) {
+	t.Run("bounds a context without a deadline", func(t *testing.T) {
+		ctx, cancel := withTimeout(context.Background(), time.Second)
+		defer cancel()
+		deadline, ok := ctx.Deadline()
+		assert.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(time.Second), deadline, 100*time.Millisecond)
+	})
+	t.Run("keeps the request's deadline", func(t *testing.T) {
+		want := time.Now().Add(time.Minute)
+		parent, cancelParent := context.WithDeadline(context.Background(), want)
+		defer cancelParent()
+		ctx, cancel := withTimeout(parent, time.Second)
+		defer cancel()
+		deadline, ok := ctx.Deadline()
+		assert.True(t, ok)
+		assert.Equal(t, want, deadline)
+	})
+}
@@ -0,0 +1,126 @@
+package services
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+// maxCustomFieldText bounds text and URL values.
+const maxCustomFieldText = 2000
+
+// normalizeCustomFieldName trims the name and returns an ErrInvalidInput error when it is empty or
+// longer than 100 characters.
+func normalizeCustomFieldName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", fmt.Errorf("custom field name is required: %w", domain.ErrInvalidInput)
+	}
+	if len(name) > 100 {
+		return "", fmt.Errorf("custom field name is longer than 100 characters: %w", domain.ErrInvalidInput)
+	}
+	return name, nil
+}
+
+// validateCustomField normalizes a new field's name and checks its type and entity.
+func validateCustomField(field *domain.CustomField) error {
+	name, err := normalizeCustomFieldName(field.Name)
+	if err != nil {
+		return err
+	}
+	field.Name = name
+	switch field.Type {
+	case domain.CustomFieldText, domain.CustomFieldNumber, domain.CustomFieldBoolean, domain.CustomFieldDate, domain.CustomFieldURL:
+	default:
+		return fmt.Errorf("custom field type must be text, number, boolean, date or url: %w", domain.ErrInvalidInput)
+	}
+	if field.AppliesTo != domain.CustomFieldSession && field.AppliesTo != domain.CustomFieldSpeaker {
+		return fmt.Errorf("custom field applies_to must be session or speaker: %w", domain.ErrInvalidInput)
+	}
+	return nil
+}
+
+// customFieldValue checks a value decoded from JSON against the field's type and returns it as
+// stored: trimmed text, a float64, a bool, a YYYY-MM-DD date or an http(s) URL. Empty text and nil
+// return nil, which clears the field.
+func customFieldValue(field *domain.CustomField, raw any) (any, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	invalid := func(want string) error {
+		return fmt.Errorf("custom field %q needs %s: %w", field.Name, want, domain.ErrInvalidInput)
+	}
+	if field.Type == domain.CustomFieldNumber {
+		n, ok := raw.(float64)
+		if !ok {
+			return nil, invalid("a number")
+		}
+		return n, nil
+	}
+	if field.Type == domain.CustomFieldBoolean {
+		b, ok := raw.(bool)
+		if !ok {
+			return nil, invalid("true or false")
+		}
+		return b, nil
+	}
+	str, ok := raw.(string)
+	if !ok {
+		return nil, invalid("a string")
+	}
+	str = strings.TrimSpace(str)
+	if str == "" {
+		return nil, nil
+	}
+	if len(str) > maxCustomFieldText {
+		return nil, invalid(fmt.Sprintf("at most %d characters", maxCustomFieldText))
+	}
+	switch field.Type {
+	case domain.CustomFieldDate:
+		if _, err := time.Parse(time.DateOnly, str); err != nil {
+			return nil, invalid("a date as YYYY-MM-DD")
+		}
+	case domain.CustomFieldURL:
+		u, err := url.Parse(str)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, invalid("an http or https URL")
+		}
+	}
+	return str, nil
+}
+
+// customFieldValues checks values, keyed by field ID, against the event's fields for appliesTo and
+// returns them in field order. A value for an unknown field or one of the other entity is an
+// ErrInvalidInput error.
+func customFieldValues(fields []*domain.CustomField, appliesTo string, values map[string]any) ([]*domain.CustomFieldValue, error) {
+	byID := make(map[string]*domain.CustomField, len(fields))
+	for _, f := range fields {
+		if f.AppliesTo == appliesTo {
+			byID[f.ID] = f
+		}
+	}
+	ids := make([]string, 0, len(values))
+	for id := range values {
+		if byID[id] == nil {
+			return nil, fmt.Errorf("no %s custom field %q in this event: %w", appliesTo, id, domain.ErrInvalidInput)
+		}
+		ids = append(ids, id)
+	}
+	slices.SortFunc(ids, func(a, b string) int {
+		return slices.Index(fields, byID[a]) - slices.Index(fields, byID[b])
+	})
+	out := make([]*domain.CustomFieldValue, 0, len(ids))
+	for _, id := range ids {
+		f := byID[id]
+		v, err := customFieldValue(f, values[id])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, &domain.CustomFieldValue{FieldID: f.ID, Name: f.Name, Type: f.Type, Value: v})
+	}
+	return out, nil
+}
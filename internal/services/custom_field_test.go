@@ -0,0 +1,43 @@
+package services
+
+import (
+	"testing"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomFieldValue(t *testing.T) {
+	tests := []struct {
+		name      string
+		fieldType string
+		raw       any
+		want      any
+		wantErr   bool
+	}{
+		{name: "text is trimmed", fieldType: domain.CustomFieldText, raw: "  yes ", want: "yes"},
+		{name: "empty text clears", fieldType: domain.CustomFieldText, raw: "   ", want: nil},
+		{name: "null clears", fieldType: domain.CustomFieldNumber, raw: nil, want: nil},
+		{name: "number", fieldType: domain.CustomFieldNumber, raw: 1.5, want: 1.5},
+		{name: "number as string", fieldType: domain.CustomFieldNumber, raw: "1.5", wantErr: true},
+		{name: "boolean", fieldType: domain.CustomFieldBoolean, raw: false, want: false},
+		{name: "boolean as string", fieldType: domain.CustomFieldBoolean, raw: "true", wantErr: true},
+		{name: "date", fieldType: domain.CustomFieldDate, raw: "2026-05-14", want: "2026-05-14"},
+		{name: "date with time", fieldType: domain.CustomFieldDate, raw: "2026-05-14T09:00:00Z", wantErr: true},
+		{name: "url", fieldType: domain.CustomFieldURL, raw: "https://doi.org/10.1000/182", want: "https://doi.org/10.1000/182"},
+		{name: "url without scheme", fieldType: domain.CustomFieldURL, raw: "doi.org/10.1000/182", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := customFieldValue(&domain.CustomField{Name: "Field", Type: tt.fieldType}, tt.raw)
+			if tt.wantErr {
+				require.ErrorIs(t, err, domain.ErrInvalidInput)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 
@@ -9,13 +10,59 @@ import (
 )
 
 type emailService struct {
-	mailer   domain.Mailer
-	renderer domain.EmailTemplateRenderer
+	mailer    domain.Mailer
+	renderer  domain.EmailTemplateRenderer
+	archive   domain.EventEmailRepository
+	metrics   domain.BusinessMetrics
+	eventRepo domain.EventRepository
 }
 
-// NewEmailService returns an EmailService that uses the given Mailer and template renderer.
-func NewEmailService(mailer domain.Mailer, renderer domain.EmailTemplateRenderer) domain.EmailService {
-	return &emailService{mailer: mailer, renderer: renderer}
+// NewEmailService returns an EmailService that uses the given Mailer and template renderer. Emails
+// sent on behalf of an event are kept in archive; a nil archive keeps none. Invitations sent are
+// counted in metrics; a nil metrics counts nothing. Emails for sandbox events, looked up in
+// eventRepo, are archived as skipped instead of sent; a nil eventRepo sends them all.
+func NewEmailService(mailer domain.Mailer, renderer domain.EmailTemplateRenderer, archive domain.EventEmailRepository, metrics domain.BusinessMetrics, eventRepo domain.EventRepository) domain.EmailService {
+	return &emailService{mailer: mailer, renderer: renderer, archive: archive, metrics: metrics, eventRepo: eventRepo}
+}
+
+// sendEventEmail sends a rendered event email and archives the attempt, sent, failed or skipped.
+// It reports whether the email was sent; a skipped email is not. Archiving is best effort: it
+// never fails the send.
+func (s *emailService) sendEventEmail(ctx context.Context, email *domain.EventEmail) (bool, error) {
+	var err error
+	if s.isSandboxEvent(ctx, email.EventID) {
+		email.Status = domain.EventEmailSkipped
+		log.Printf("[EMAIL] Skipped %s email to %s: sandbox event %s", email.Kind, email.To, email.EventID)
+	} else {
+		err = s.mailer.Send(email.To, email.Subject, email.HTML, email.Text)
+		email.Status = domain.EventEmailSent
+		if err != nil {
+			email.Status = domain.EventEmailFailed
+			email.Error = err.Error()
+		}
+	}
+	if s.archive != nil && email.EventID != "" {
+		if aerr := s.archive.Create(ctx, email); aerr != nil {
+			log.Printf("[EMAIL] Failed to archive %s email to %s: %v", email.Kind, email.To, aerr)
+		}
+	}
+	return email.Status == domain.EventEmailSent, err
+}
+
+// isSandboxEvent reports whether eventID is a sandbox event. When the event cannot be read the
+// email is sent: a lookup failure must not swallow real mail.
+func (s *emailService) isSandboxEvent(ctx context.Context, eventID string) bool {
+	if s.eventRepo == nil || eventID == "" {
+		return false
+	}
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if !errors.Is(err, domain.ErrNotFound) {
+			log.Printf("[EMAIL] Failed to check whether event %s is a sandbox: %v", eventID, err)
+		}
+		return false
+	}
+	return event.Sandbox
 }
 
 // SendWelcomeMessage sends a welcome email using the "welcome" template and the given data.
@@ -50,6 +97,22 @@ func (s *emailService) SendLoginCode(ctx context.Context, data *domain.LoginCode
 	return nil
 }
 
+// SendEventDeletionConfirmation sends the event deletion token using the "event_deletion" template.
+func (s *emailService) SendEventDeletionConfirmation(ctx context.Context, data *domain.EventDeletionEmailData) error {
+	if data == nil {
+		return fmt.Errorf("event deletion email data is nil")
+	}
+	subject, htmlBody, textBody, err := s.renderer.Render("event_deletion", data)
+	if err != nil {
+		return fmt.Errorf("failed to render event_deletion template: %w", err)
+	}
+	if err := s.mailer.Send(data.Email, subject, htmlBody, textBody); err != nil {
+		return fmt.Errorf("failed to send event deletion email: %w", err)
+	}
+	log.Printf("[EMAIL] Event deletion confirmation sent to %s", data.Email)
+	return nil
+}
+
 // SendEventInvitation sends the event invitation email using the "event_invitation" template.
 func (s *emailService) SendEventInvitation(ctx context.Context, data *domain.EventInvitationEmailData) error {
 	if data == nil {
@@ -59,9 +122,168 @@ func (s *emailService) SendEventInvitation(ctx context.Context, data *domain.Eve
 	if err != nil {
 		return fmt.Errorf("failed to render event_invitation template: %w", err)
 	}
-	if err := s.mailer.Send(data.Email, subject, htmlBody, textBody); err != nil {
+	email := &domain.EventEmail{EventID: data.EventID, Kind: domain.EventEmailInvitation, To: data.Email, Subject: subject, HTML: htmlBody, Text: textBody}
+	sent, err := s.sendEventEmail(ctx, email)
+	if err != nil {
 		return fmt.Errorf("failed to send event invitation email: %w", err)
 	}
+	if !sent {
+		return nil
+	}
+	if s.metrics != nil {
+		s.metrics.InvitationSent(data.EventID)
+	}
 	log.Printf("[EMAIL] Event invitation sent to %s", data.Email)
 	return nil
 }
+
+// SendEventInvitationReminder sends the reminder email using the "event_invitation_reminder" template.
+func (s *emailService) SendEventInvitationReminder(ctx context.Context, data *domain.EventInvitationEmailData) error {
+	if data == nil {
+		return fmt.Errorf("event invitation email data is nil")
+	}
+	subject, htmlBody, textBody, err := s.renderer.Render("event_invitation_reminder", data)
+	if err != nil {
+		return fmt.Errorf("failed to render event_invitation_reminder template: %w", err)
+	}
+	email := &domain.EventEmail{EventID: data.EventID, Kind: domain.EventEmailInvitationReminder, To: data.Email, Subject: subject, HTML: htmlBody, Text: textBody}
+	sent, err := s.sendEventEmail(ctx, email)
+	if err != nil {
+		return fmt.Errorf("failed to send event invitation reminder email: %w", err)
+	}
+	if !sent {
+		return nil
+	}
+	log.Printf("[EMAIL] Event invitation reminder sent to %s", data.Email)
+	return nil
+}
+
+// SendTeamMemberLeft tells the event owner a team member left, using the "team_member_left" template.
+func (s *emailService) SendTeamMemberLeft(ctx context.Context, data *domain.TeamMemberLeftEmailData) error {
+	if data == nil {
+		return fmt.Errorf("team member left email data is nil")
+	}
+	subject, htmlBody, textBody, err := s.renderer.Render("team_member_left", data)
+	if err != nil {
+		return fmt.Errorf("failed to render team_member_left template: %w", err)
+	}
+	email := &domain.EventEmail{EventID: data.EventID, Kind: domain.EventEmailTeamMemberLeft, To: data.Email, Subject: subject, HTML: htmlBody, Text: textBody}
+	sent, err := s.sendEventEmail(ctx, email)
+	if err != nil {
+		return fmt.Errorf("failed to send team member left email: %w", err)
+	}
+	if !sent {
+		return nil
+	}
+	log.Printf("[EMAIL] Team member left notice sent to %s", data.Email)
+	return nil
+}
+
+// SendContactMessage relays a contact form message to a team member, using the "contact_message" template.
+func (s *emailService) SendContactMessage(ctx context.Context, data *domain.ContactMessageEmailData) error {
+	if data == nil {
+		return fmt.Errorf("contact message email data is nil")
+	}
+	subject, htmlBody, textBody, err := s.renderer.Render("contact_message", data)
+	if err != nil {
+		return fmt.Errorf("failed to render contact_message template: %w", err)
+	}
+	email := &domain.EventEmail{EventID: data.EventID, Kind: domain.EventEmailContactMessage, To: data.Email, Subject: subject, HTML: htmlBody, Text: textBody}
+	sent, err := s.sendEventEmail(ctx, email)
+	if err != nil {
+		return fmt.Errorf("failed to send contact message email: %w", err)
+	}
+	if !sent {
+		return nil
+	}
+	log.Printf("[EMAIL] Contact message relayed to %s", data.Email)
+	return nil
+}
+
+// SendContactReply sends the team's reply to a contact form sender, using the "contact_reply" template.
+func (s *emailService) SendContactReply(ctx context.Context, data *domain.ContactReplyEmailData) error {
+	if data == nil {
+		return fmt.Errorf("contact reply email data is nil")
+	}
+	subject, htmlBody, textBody, err := s.renderer.Render("contact_reply", data)
+	if err != nil {
+		return fmt.Errorf("failed to render contact_reply template: %w", err)
+	}
+	email := &domain.EventEmail{EventID: data.EventID, Kind: domain.EventEmailContactReply, To: data.Email, Subject: subject, HTML: htmlBody, Text: textBody}
+	sent, err := s.sendEventEmail(ctx, email)
+	if err != nil {
+		return fmt.Errorf("failed to send contact reply email: %w", err)
+	}
+	if !sent {
+		return nil
+	}
+	log.Printf("[EMAIL] Contact reply sent to %s", data.Email)
+	return nil
+}
+
+// SendRoomCapacityAlert alerts the event owner that a room is nearly full, using the "room_capacity_alert" template.
+func (s *emailService) SendRoomCapacityAlert(ctx context.Context, data *domain.RoomCapacityAlertEmailData) error {
+	if data == nil {
+		return fmt.Errorf("room capacity alert email data is nil")
+	}
+	subject, htmlBody, textBody, err := s.renderer.Render("room_capacity_alert", data)
+	if err != nil {
+		return fmt.Errorf("failed to render room_capacity_alert template: %w", err)
+	}
+	email := &domain.EventEmail{EventID: data.EventID, Kind: domain.EventEmailRoomCapacityAlert, To: data.Email, Subject: subject, HTML: htmlBody, Text: textBody}
+	sent, err := s.sendEventEmail(ctx, email)
+	if err != nil {
+		return fmt.Errorf("failed to send room capacity alert email: %w", err)
+	}
+	if !sent {
+		return nil
+	}
+	log.Printf("[EMAIL] Room capacity alert sent to %s", data.Email)
+	return nil
+}
+
+// ListEventEmails returns a page of the event's archived emails, newest first.
+func (s *emailService) ListEventEmails(ctx context.Context, eventID string, filter domain.EventEmailFilter, params domain.PaginationParams) ([]*domain.EventEmail, int, error) {
+	if s.archive == nil {
+		return []*domain.EventEmail{}, 0, nil
+	}
+	emails, total, err := s.archive.ListByEventID(ctx, eventID, filter, params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list event emails: %w", err)
+	}
+	if emails == nil {
+		emails = []*domain.EventEmail{}
+	}
+	return emails, total, nil
+}
+
+// ResendEventEmail sends an archived email again as it was rendered; the new attempt is archived
+// with ResendOf set to the original.
+func (s *emailService) ResendEventEmail(ctx context.Context, eventID, emailID string) (*domain.EventEmail, error) {
+	if s.archive == nil {
+		return nil, domain.ErrEventEmailNotFound
+	}
+	original, err := s.archive.GetByID(ctx, emailID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrEventEmailNotFound
+		}
+		return nil, fmt.Errorf("get event email: %w", err)
+	}
+	if original.EventID != eventID {
+		return nil, domain.ErrEventEmailNotFound
+	}
+	email := &domain.EventEmail{
+		EventID:  original.EventID,
+		Kind:     original.Kind,
+		To:       original.To,
+		Subject:  original.Subject,
+		HTML:     original.HTML,
+		Text:     original.Text,
+		ResendOf: original.ID,
+	}
+	if sent, err := s.sendEventEmail(ctx, email); err == nil && sent {
+		log.Printf("[EMAIL] Resent %s email to %s", email.Kind, email.To)
+	}
+	return email, nil
+}
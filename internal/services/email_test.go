@@ -0,0 +1,187 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"os"
+	"testing"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeMailer records sent messages and fails for the addresses in failTo.
+type fakeMailer struct {
+	sentTo []string
+	failTo map[string]bool
+}
+
+func (m *fakeMailer) Send(to, subject, html, text string) error {
+	if m.failTo[to] {
+		return errors.New("smtp down")
+	}
+	m.sentTo = append(m.sentTo, to)
+	return nil
+}
+
+// fakeTemplateRenderer renders every template as its name.
+type fakeTemplateRenderer struct{}
+
+func (fakeTemplateRenderer) Render(templateName string, data any) (string, string, string, error) {
+	return "subject " + templateName, "<p>" + templateName + "</p>", templateName, nil
+}
+
+// fakeEventEmailRepo is an in-memory EventEmailRepository.
+type fakeEventEmailRepo struct {
+	emails    []*domain.EventEmail
+	createErr error
+}
+
+func (r *fakeEventEmailRepo) Create(ctx context.Context, email *domain.EventEmail) error {
+	if r.createErr != nil {
+		return r.createErr
+	}
+	email.ID = "em-" + string(rune('1'+len(r.emails)))
+	r.emails = append(r.emails, email)
+	return nil
+}
+
+func (r *fakeEventEmailRepo) GetByID(ctx context.Context, id string) (*domain.EventEmail, error) {
+	for _, e := range r.emails {
+		if e.ID == id {
+			return e, nil
+		}
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (r *fakeEventEmailRepo) ListByEventID(ctx context.Context, eventID string, filter domain.EventEmailFilter, params domain.PaginationParams) ([]*domain.EventEmail, int, error) {
+	var out []*domain.EventEmail
+	for _, e := range r.emails {
+		if e.EventID == eventID {
+			out = append(out, e)
+		}
+	}
+	return out, len(out), nil
+}
+
+func TestEmailService_ArchivesEventEmails(t *testing.T) {
+	ctx := context.Background()
+	mailer := &fakeMailer{failTo: map[string]bool{"bounce@example.com": true}}
+	archive := &fakeEventEmailRepo{}
+	svc := NewEmailService(mailer, fakeTemplateRenderer{}, archive, nil, nil)
+
+	require.NoError(t, svc.SendEventInvitation(ctx, &domain.EventInvitationEmailData{EventID: "ev-1", Email: "alice@example.com"}))
+	require.Error(t, svc.SendEventInvitationReminder(ctx, &domain.EventInvitationEmailData{EventID: "ev-1", Email: "bounce@example.com"}))
+	require.NoError(t, svc.SendLoginCode(ctx, &domain.LoginCodeEmailData{Email: "alice@example.com"}))
+	require.NoError(t, svc.SendEventDeletionConfirmation(ctx, &domain.EventDeletionEmailData{Email: "owner@example.com", Token: "secret"}))
+
+	require.Len(t, archive.emails, 2, "only event emails are archived")
+	assert.Equal(t, &domain.EventEmail{
+		ID: "em-1", EventID: "ev-1", Kind: domain.EventEmailInvitation, To: "alice@example.com",
+		Subject: "subject event_invitation", HTML: "<p>event_invitation</p>", Text: "event_invitation", Status: domain.EventEmailSent,
+	}, archive.emails[0])
+	assert.Equal(t, domain.EventEmailFailed, archive.emails[1].Status)
+	assert.Equal(t, "smtp down", archive.emails[1].Error)
+
+	emails, total, err := svc.ListEventEmails(ctx, "ev-1", domain.EventEmailFilter{}, domain.PaginationParams{Page: 1, PageSize: 20})
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	assert.Len(t, emails, 2)
+	emails, _, err = svc.ListEventEmails(ctx, "ev-2", domain.EventEmailFilter{}, domain.PaginationParams{Page: 1, PageSize: 20})
+	require.NoError(t, err)
+	assert.Equal(t, []*domain.EventEmail{}, emails)
+}
+
+type fakeBusinessMetrics struct {
+	invitations   []string
+	registrations []string
+}
+
+func (m *fakeBusinessMetrics) InvitationSent(eventID string) {
+	m.invitations = append(m.invitations, eventID)
+}
+func (m *fakeBusinessMetrics) Registered(eventID string) {
+	m.registrations = append(m.registrations, eventID)
+}
+
+func TestEmailService_CountsSentInvitations(t *testing.T) {
+	ctx := context.Background()
+	metrics := &fakeBusinessMetrics{}
+	svc := NewEmailService(&fakeMailer{failTo: map[string]bool{"bounce@example.com": true}}, fakeTemplateRenderer{}, nil, metrics, nil)
+
+	require.NoError(t, svc.SendEventInvitation(ctx, &domain.EventInvitationEmailData{EventID: "ev-1", Email: "alice@example.com"}))
+	require.Error(t, svc.SendEventInvitation(ctx, &domain.EventInvitationEmailData{EventID: "ev-1", Email: "bounce@example.com"}))
+	require.NoError(t, svc.SendEventInvitationReminder(ctx, &domain.EventInvitationEmailData{EventID: "ev-1", Email: "alice@example.com"}))
+
+	assert.Equal(t, []string{"ev-1"}, metrics.invitations, "only invitations that went out are counted")
+}
+
+func TestEmailService_SkipsSandboxEvents(t *testing.T) {
+	ctx := context.Background()
+	mailer := &fakeMailer{}
+	archive := &fakeEventEmailRepo{}
+	metrics := &fakeBusinessMetrics{}
+	events := newFakeEventRepo()
+	events.byID["ev-1"] = &domain.Event{ID: "ev-1"}
+	events.byID["ev-sandbox"] = &domain.Event{ID: "ev-sandbox", Sandbox: true}
+	svc := NewEmailService(mailer, fakeTemplateRenderer{}, archive, metrics, events)
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	t.Cleanup(func() { log.SetOutput(os.Stderr) })
+
+	require.NoError(t, svc.SendEventInvitation(ctx, &domain.EventInvitationEmailData{EventID: "ev-sandbox", Email: "alice@example.com"}))
+	require.NoError(t, svc.SendEventInvitation(ctx, &domain.EventInvitationEmailData{EventID: "ev-1", Email: "bob@example.com"}))
+
+	assert.Contains(t, logs.String(), "Skipped event_invitation email to alice@example.com")
+	assert.NotContains(t, logs.String(), "sent to alice@example.com", "skipped emails are not logged as sent")
+	assert.Contains(t, logs.String(), "Event invitation sent to bob@example.com")
+
+	assert.Equal(t, []string{"bob@example.com"}, mailer.sentTo, "sandbox emails are not sent")
+	assert.Equal(t, []string{"ev-1"}, metrics.invitations, "sandbox invitations are not counted")
+	require.Len(t, archive.emails, 2)
+	assert.Equal(t, domain.EventEmailSkipped, archive.emails[0].Status, "sandbox emails are still archived")
+	assert.Equal(t, domain.EventEmailSent, archive.emails[1].Status)
+}
+
+func TestEmailService_ResendEventEmail(t *testing.T) {
+	ctx := context.Background()
+	mailer := &fakeMailer{}
+	archive := &fakeEventEmailRepo{}
+	svc := NewEmailService(mailer, fakeTemplateRenderer{}, archive, nil, nil)
+	require.NoError(t, svc.SendTeamMemberLeft(ctx, &domain.TeamMemberLeftEmailData{EventID: "ev-1", Email: "owner@example.com"}))
+
+	resent, err := svc.ResendEventEmail(ctx, "ev-1", "em-1")
+	require.NoError(t, err)
+	assert.Equal(t, "em-2", resent.ID)
+	assert.Equal(t, "em-1", resent.ResendOf)
+	assert.Equal(t, domain.EventEmailTeamMemberLeft, resent.Kind)
+	assert.Equal(t, archive.emails[0].HTML, resent.HTML)
+	assert.Equal(t, domain.EventEmailSent, resent.Status)
+	assert.Equal(t, []string{"owner@example.com", "owner@example.com"}, mailer.sentTo)
+
+	t.Run("mailer failure is recorded, not returned", func(t *testing.T) {
+		mailer.failTo = map[string]bool{"owner@example.com": true}
+		resent, err := svc.ResendEventEmail(ctx, "ev-1", "em-1")
+		require.NoError(t, err)
+		assert.Equal(t, domain.EventEmailFailed, resent.Status)
+		assert.Len(t, archive.emails, 3)
+	})
+
+	t.Run("another event's email", func(t *testing.T) {
+		_, err := svc.ResendEventEmail(ctx, "ev-2", "em-1")
+		require.ErrorIs(t, err, domain.ErrEventEmailNotFound)
+		_, err = svc.ResendEventEmail(ctx, "ev-1", "em-missing")
+		require.ErrorIs(t, err, domain.ErrEventEmailNotFound)
+	})
+
+	t.Run("archive failure does not fail the send", func(t *testing.T) {
+		mailer.failTo = nil
+		archive.createErr = errors.New("db down")
+		require.NoError(t, svc.SendEventInvitation(ctx, &domain.EventInvitationEmailData{EventID: "ev-1", Email: "bob@example.com"}))
+	})
+}
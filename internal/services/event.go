@@ -1,6 +1,7 @@
 package services
 
 import (
+	"cmp"
 	"context"
 	"crypto/rand"
 	"database/sql"
@@ -8,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 	"strings"
 	"time"
 
@@ -21,8 +23,19 @@ type eventService struct {
 	eventTeamMemberRepo domain.EventTeamMemberRepository
 	userRepo            domain.UserRepository
 	invitationRepo      domain.EventInvitationRepository
+	importMappingRepo   domain.ImportMappingRepository
+	speakerMergeRepo    domain.SpeakerMergeRepository
+	integrityRepo       domain.IntegrityRepository
+	scheduleRulesRepo   domain.ScheduleRulesRepository
+	operatingHoursRepo  domain.OperatingHoursRepository
+	sessionChangeRepo   domain.SessionChangeRepository
+	checklistRepo       domain.ChecklistRepository
+	scheduleGridRepo    domain.ScheduleGridRepository
+	customFieldRepo     domain.CustomFieldRepository
 	emailService        domain.EmailService
 	sf                  domain.SessionFetcher
+	policy              SchedulePolicy
+	codeFormat          domain.EventCodeFormat
 	contextTimeout      time.Duration
 }
 
@@ -32,8 +45,19 @@ func NewEventService(eventRepo domain.EventRepository,
 	eventTeamMemberRepo domain.EventTeamMemberRepository,
 	userRepo domain.UserRepository,
 	invitationRepo domain.EventInvitationRepository,
+	importMappingRepo domain.ImportMappingRepository,
+	speakerMergeRepo domain.SpeakerMergeRepository,
+	integrityRepo domain.IntegrityRepository,
+	scheduleRulesRepo domain.ScheduleRulesRepository,
+	operatingHoursRepo domain.OperatingHoursRepository,
+	sessionChangeRepo domain.SessionChangeRepository,
+	checklistRepo domain.ChecklistRepository,
+	scheduleGridRepo domain.ScheduleGridRepository,
+	customFieldRepo domain.CustomFieldRepository,
 	emailService domain.EmailService,
 	sessionFetcher domain.SessionFetcher,
+	policy SchedulePolicy,
+	codeFormat domain.EventCodeFormat,
 	timeout time.Duration,
 ) domain.EventService {
 	return &eventService{
@@ -43,14 +67,25 @@ func NewEventService(eventRepo domain.EventRepository,
 		eventTeamMemberRepo: eventTeamMemberRepo,
 		userRepo:            userRepo,
 		invitationRepo:      invitationRepo,
+		importMappingRepo:   importMappingRepo,
+		speakerMergeRepo:    speakerMergeRepo,
+		integrityRepo:       integrityRepo,
+		scheduleRulesRepo:   scheduleRulesRepo,
+		operatingHoursRepo:  operatingHoursRepo,
+		sessionChangeRepo:   sessionChangeRepo,
+		checklistRepo:       checklistRepo,
+		scheduleGridRepo:    scheduleGridRepo,
+		customFieldRepo:     customFieldRepo,
 		emailService:        emailService,
 		sf:                  sessionFetcher,
+		policy:              policy,
+		codeFormat:          codeFormat,
 		contextTimeout:      timeout,
 	}
 }
 
 func (s *eventService) CreateEvent(ctx context.Context, event *domain.Event) error {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	if event.OwnerID == "" {
@@ -60,36 +95,102 @@ func (s *eventService) CreateEvent(ctx context.Context, event *domain.Event) err
 	event.CreatedAt = time.Now()
 	event.UpdatedAt = time.Now()
 
-	if event.EventCode == "" {
-		code, err := generateEventCode()
+	if event.EventCode != "" {
+		return s.eventRepo.Create(ctx, event)
+	}
+	return createWithEventCode(ctx, s.eventRepo, s.codeFormat, event)
+}
+
+// createWithEventCode creates the event with a random code in format. A random code can be taken
+// already; the unique constraint catches it and another is drawn.
+func createWithEventCode(ctx context.Context, eventRepo domain.EventRepository, format domain.EventCodeFormat, event *domain.Event) error {
+	for attempt := 1; ; attempt++ {
+		code, err := generateEventCode(format)
 		if err != nil {
 			return fmt.Errorf("generate event code: %w", err)
 		}
 		event.EventCode = code
+		err = eventRepo.Create(ctx, event)
+		if !errors.Is(err, domain.ErrDuplicateEventCode) || attempt == domain.EventCodeAttempts {
+			return err
+		}
 	}
-
-	return s.eventRepo.Create(ctx, event)
 }
 
-const eventCodeLength = 4
-
-var eventCodeAlphabet = []rune("abcdefghijklmnopqrstuvwxyz0123456789")
-
-func generateEventCode() (string, error) {
-	b := make([]rune, eventCodeLength)
-	max := big.NewInt(int64(len(eventCodeAlphabet)))
-	for i := 0; i < eventCodeLength; i++ {
+// generateEventCode returns a code of format.Length characters drawn at random from format.Alphabet.
+func generateEventCode(format domain.EventCodeFormat) (string, error) {
+	alphabet := []rune(format.Alphabet)
+	b := make([]rune, format.Length)
+	max := big.NewInt(int64(len(alphabet)))
+	for i := range b {
 		n, err := rand.Int(rand.Reader, max)
 		if err != nil {
 			return "", err
 		}
-		b[i] = eventCodeAlphabet[n.Int64()]
+		b[i] = alphabet[n.Int64()]
 	}
 	return string(b), nil
 }
 
+func (s *eventService) RegenerateEventCode(ctx context.Context, eventID, ownerID string) (*domain.EventCodeChange, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	previous := event.EventCode
+	var updated *domain.Event
+	for attempt := 1; updated == nil; attempt++ {
+		code, err := generateEventCode(s.codeFormat)
+		if err != nil {
+			return nil, fmt.Errorf("generate event code: %w", err)
+		}
+		if code != previous {
+			updated, err = s.eventRepo.UpdateEventCode(ctx, eventID, code)
+			if err != nil && !errors.Is(err, domain.ErrDuplicateEventCode) {
+				return nil, fmt.Errorf("update event code: %w", err)
+			}
+		}
+		if updated == nil && attempt == domain.EventCodeAttempts {
+			return nil, fmt.Errorf("update event code: %w", domain.ErrDuplicateEventCode)
+		}
+	}
+	change := &domain.EventCodeChange{Event: updated, PreviousCode: previous, InvitationsFailed: []string{}}
+
+	// The invitations already sent carry the old code, so the invitees who have not registered
+	// yet get the new one. The code has changed either way; failures are reported, not returned.
+	pending, err := s.invitationRepo.ListPending(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list pending invitations: %w", err)
+	}
+	ownerName := s.inviterName(ctx, ownerID)
+	for _, inv := range pending {
+		data := &domain.EventInvitationEmailData{
+			EventID:   eventID,
+			Email:     inv.Email,
+			OwnerName: ownerName,
+			EventName: updated.Name,
+			EventCode: updated.EventCode,
+		}
+		if err := s.emailService.SendEventInvitation(ctx, data); err != nil {
+			change.InvitationsFailed = append(change.InvitationsFailed, inv.Email)
+			continue
+		}
+		change.InvitationsResent++
+	}
+	return change, nil
+}
+
 func (s *eventService) GetEventByID(ctx context.Context, eventID string) (*domain.Event, []*domain.Room, []*domain.Session, error) {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -116,6 +217,12 @@ func (s *eventService) GetEventByID(ctx context.Context, eventID string) (*domai
 		sessions = []*domain.Session{}
 	}
 
+	hours, err := s.operatingHoursRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("list operating hours: %w", err)
+	}
+	event.OperatingHours = hours
+
 	// Enrich sessions with speaker IDs for GET event response
 	if len(sessions) > 0 {
 		sessionIDs := make([]string, 0, len(sessions))
@@ -139,7 +246,7 @@ func (s *eventService) GetEventByID(ctx context.Context, eventID string) (*domai
 }
 
 func (s *eventService) UpdateEvent(ctx context.Context, eventID, ownerID string, date *time.Time, description *string, locationLat, locationLng *float64) (*domain.Event, error) {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -162,82 +269,151 @@ func (s *eventService) UpdateEvent(ctx context.Context, eventID, ownerID string,
 	return updated, nil
 }
 
-// buildCategoryItemIDToName flattens All API categories into categoryItemID -> name.
-func buildCategoryItemIDToName(categories []domain.SessionFetcherCategory) map[int]string {
-	m := make(map[int]string)
+// categoryItem is a flattened All API category item together with the title of its category.
+type categoryItem struct {
+	name     string
+	category string // normalized with normalizeCategory
+}
+
+// normalizeCategory returns the form used to match category titles against an import mapping.
+func normalizeCategory(title string) string {
+	return strings.ToLower(strings.TrimSpace(title))
+}
+
+// buildCategoryItems flattens All API categories into categoryItemID -> item.
+func buildCategoryItems(categories []domain.SessionFetcherCategory) map[int]categoryItem {
+	m := make(map[int]categoryItem)
 	for _, cat := range categories {
+		category := normalizeCategory(cat.Title)
 		for _, item := range cat.Items {
 			if item.Name != "" {
-				m[item.ID] = item.Name
+				m[item.ID] = categoryItem{name: item.Name, category: category}
 			}
 		}
 	}
 	return m
 }
 
-// deriveTagsFromCategoryItems returns tag names for the given category item IDs (All API).
-func deriveTagsFromCategoryItems(categoryItemIDs []int, idToName map[int]string) []string {
+// mapCategoryItems splits a session's category items (All API) into tag names, session type and
+// track according to mapping. The first item found in the session type or track category wins.
+func mapCategoryItems(categoryItemIDs []int, items map[int]categoryItem, mapping *domain.ImportMapping) (tags []string, sessionType, track string) {
+	typeCategory := normalizeCategory(mapping.SessionTypeCategory)
+	trackCategory := normalizeCategory(mapping.TrackCategory)
+	tagCategories := make(map[string]struct{}, len(mapping.TagCategories))
+	for _, c := range mapping.TagCategories {
+		tagCategories[normalizeCategory(c)] = struct{}{}
+	}
 	seen := make(map[string]struct{})
-	var out []string
 	for _, id := range categoryItemIDs {
-		name := idToName[id]
-		if name == "" {
+		item, ok := items[id]
+		if !ok {
+			continue
+		}
+		switch {
+		case typeCategory != "" && item.category == typeCategory:
+			if sessionType == "" {
+				sessionType = item.name
+			}
+			continue
+		case trackCategory != "" && item.category == trackCategory:
+			if track == "" {
+				track = item.name
+			}
 			continue
 		}
-		if _, ok := seen[name]; ok {
+		if len(tagCategories) > 0 {
+			if _, ok := tagCategories[item.category]; !ok {
+				continue
+			}
+		}
+		if _, ok := seen[item.name]; ok {
 			continue
 		}
-		seen[name] = struct{}{}
-		out = append(out, name)
+		seen[item.name] = struct{}{}
+		tags = append(tags, item.name)
+	}
+	return tags, sessionType, track
+}
+
+// importMappingFor returns the event's saved import mapping, or the default mapping when none is saved.
+func (s *eventService) importMappingFor(ctx context.Context, eventID string) (*domain.ImportMapping, error) {
+	mapping, err := s.importMappingRepo.GetByEventID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewImportMapping(eventID), nil
+		}
+		return nil, fmt.Errorf("get import mapping: %w", err)
 	}
-	return out
+	return mapping, nil
 }
 
-func (s *eventService) ImportSessionizeData(ctx context.Context, eventID string, sourceID string) error {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+func (s *eventService) ImportSessionizeData(ctx context.Context, eventID string, sourceID string, forceRefresh bool) error {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	// 1. Fetch data from Sessionize All API
-	sessionData, err := s.sf.Fetch(ctx, sourceID)
+	sessionData, err := s.sf.Fetch(ctx, sourceID, forceRefresh)
 	if err != nil {
 		return err
 	}
 
-	// 2. Clear existing schedule and speakers
-	if err := s.sessionRepo.DeleteScheduleByEventID(ctx, eventID); err != nil {
-		return fmt.Errorf("failed to delete existing schedule: %w", err)
+	mapping, err := s.importMappingFor(ctx, eventID)
+	if err != nil {
+		return err
 	}
-	if err := s.sessionRepo.DeleteSpeakersByEventID(ctx, eventID); err != nil {
-		return fmt.Errorf("failed to delete existing speakers: %w", err)
+	prevRooms, prevSessions, err := s.scheduleBeforeImport(ctx, eventID)
+	if err != nil {
+		return err
 	}
+	// The import saves as it goes, so the grid is refreshed even when it stops part way.
+	defer s.refreshScheduleGrid(ctx, eventID)
 
-	// 3. Insert rooms from flat list
-	roomMap := make(map[int]string) // Sessionize room ID -> domain room ID
+	// 2. Upsert rooms by Sessionize room ID so unchanged rooms keep their IDs
+	roomMap := make(map[int]string)      // Sessionize room ID -> domain room ID
+	roomNames := make(map[string]string) // domain room ID -> name after the import
+	var keepRoomIDs []string
 	for _, room := range sessionData.Rooms {
+		name := room.Name
+		if override, ok := mapping.RoomNameOverrides[room.Name]; ok {
+			name = override
+		}
 		now := time.Now()
-		r := domain.NewRoom(eventID, room.Name, room.ID, "sessionize", false, 0, "", "", now, now)
+		r := domain.NewRoom(eventID, name, room.ID, "sessionize", false, 0, "", "", now, now)
 		if err := s.sessionRepo.CreateRoom(ctx, r); err != nil {
-			return fmt.Errorf("failed to create room %s: %w", room.Name, err)
+			return fmt.Errorf("failed to create room %s: %w", name, err)
 		}
 		roomMap[room.ID] = r.ID
+		roomNames[r.ID] = name
+		keepRoomIDs = append(keepRoomIDs, r.ID)
 	}
 
-	// 4. Build category item ID -> name for tag derivation
-	categoryIDToName := buildCategoryItemIDToName(sessionData.Categories)
+	// 3. Build category item ID -> item for tag, session type and track derivation
+	categoryItems := buildCategoryItems(sessionData.Categories)
 
-	// 5. Insert sessions
+	// 4. Upsert sessions by room and Sessionize session ID
 	sessionMap := make(map[string]string) // Sessionize session ID -> domain session ID
+	var keepSessionIDs []string
 	for _, sess := range sessionData.Sessions {
 		domainRoomID, ok := roomMap[sess.RoomID]
 		if !ok {
 			continue // Skip session if room not found
 		}
-		tagNames := deriveTagsFromCategoryItems(sess.CategoryItems, categoryIDToName)
+		tagNames, sessionType, track := mapCategoryItems(sess.CategoryItems, categoryItems, mapping)
 		now := time.Now()
-		domainSess := domain.NewSession(domainRoomID, sess.ID, "sessionize", sess.Title, sess.Description, sess.StartsAt, sess.EndsAt, tagNames, now, now)
+		domainSess := domain.NewSession(eventID, domainRoomID, sess.ID, "sessionize", sess.Title, sess.Description, s.policy.normalize(sess.StartsAt), s.policy.normalize(sess.EndsAt), tagNames, now, now)
+		domainSess.SessionType = sessionType
+		domainSess.Track = track
 		if err := s.sessionRepo.CreateSession(ctx, domainSess); err != nil {
 			return fmt.Errorf("failed to create session %s: %w", sess.Title, err)
 		}
+		if prev, ok := prevSessions[sess.ID]; ok {
+			var fromRoom *domain.Room
+			if prev.RoomID != "" {
+				fromRoom = &domain.Room{ID: prev.RoomID, Name: prevRooms[prev.RoomID]}
+			}
+			toRoom := &domain.Room{ID: domainRoomID, Name: roomNames[domainRoomID]}
+			s.recordSessionChange(ctx, newSessionChange(&prev, fromRoom, toRoom, domainSess.StartTime, domainSess.EndTime))
+		}
 		var tagIDs []string
 		for _, tagName := range tagNames {
 			if tagName == "" {
@@ -253,39 +429,287 @@ func (s *eventService) ImportSessionizeData(ctx context.Context, eventID string,
 			return fmt.Errorf("failed to set session tags: %w", err)
 		}
 		sessionMap[sess.ID] = domainSess.ID
+		keepSessionIDs = append(keepSessionIDs, domainSess.ID)
 	}
 
-	// 6. Insert speakers
-	speakerMap := make(map[string]string) // Sessionize speaker UUID -> domain speaker ID
-	for _, sp := range sessionData.Speakers {
-		now := time.Now()
-		domainSp := domain.NewSpeaker(eventID, sp.ID, "sessionize", sp.FirstName, sp.LastName, sp.Bio, sp.TagLine, sp.ProfilePicture, sp.IsTopSpeaker, now, now)
-		if err := s.sessionRepo.CreateSpeaker(ctx, domainSp); err != nil {
-			return fmt.Errorf("failed to create speaker %s %s: %w", sp.FirstName, sp.LastName, err)
-		}
-		speakerMap[sp.ID] = domainSp.ID
+	// 5. Upsert speakers, reusing the existing speaker for each one already known
+	speakerMap, keepSpeakerIDs, candidates, err := s.importSpeakers(ctx, eventID, sessionData.Speakers)
+	if err != nil {
+		return err
 	}
 
-	// 7. Link sessions to speakers
+	// 6. Link sessions to speakers
+	sessionSpeakers := make(map[string][]string) // domain session ID -> domain speaker IDs
 	for _, sess := range sessionData.Sessions {
 		domainSessionID, ok := sessionMap[sess.ID]
 		if !ok {
 			continue
 		}
+		if _, ok := sessionSpeakers[domainSessionID]; !ok {
+			sessionSpeakers[domainSessionID] = []string{}
+		}
 		for _, speakerUUID := range sess.Speakers {
-			domainSpeakerID, ok := speakerMap[speakerUUID]
-			if !ok {
-				continue
-			}
-			if err := s.sessionRepo.CreateSessionSpeaker(ctx, domainSessionID, domainSpeakerID); err != nil {
-				return fmt.Errorf("failed to link session to speaker: %w", err)
+			if domainSpeakerID, ok := speakerMap[speakerUUID]; ok {
+				sessionSpeakers[domainSessionID] = append(sessionSpeakers[domainSessionID], domainSpeakerID)
 			}
 		}
 	}
+	for domainSessionID, speakerIDs := range sessionSpeakers {
+		if err := s.sessionRepo.SetSessionSpeakers(ctx, domainSessionID, speakerIDs); err != nil {
+			return fmt.Errorf("failed to link session to speakers: %w", err)
+		}
+	}
 
+	// 7. Remove rooms, sessions and speakers the import no longer contains
+	if err := s.sessionRepo.PruneScheduleByEventID(ctx, eventID, keepRoomIDs, keepSessionIDs); err != nil {
+		return fmt.Errorf("failed to delete stale schedule: %w", err)
+	}
+	if err := s.sessionRepo.PruneSpeakersByEventID(ctx, eventID, keepSpeakerIDs); err != nil {
+		return fmt.Errorf("failed to delete stale speakers: %w", err)
+	}
+
+	// 8. Queue possible duplicates for the owner to review
+	for _, c := range candidates {
+		if err := s.speakerMergeRepo.Create(ctx, c); err != nil {
+			return fmt.Errorf("failed to record speaker merge candidate: %w", err)
+		}
+	}
 	return nil
 }
 
+// scheduleBeforeImport returns the event's room names by room ID and copies of its Sessionize
+// sessions by Sessionize ID, taken before an import changes them, so the moves it makes can be
+// recorded.
+func (s *eventService) scheduleBeforeImport(ctx context.Context, eventID string) (map[string]string, map[string]domain.Session, error) {
+	rooms, err := s.sessionRepo.ListRoomsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list rooms: %w", err)
+	}
+	sessions, err := s.sessionRepo.ListSessionsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list sessions: %w", err)
+	}
+	roomNames := make(map[string]string, len(rooms))
+	for _, r := range rooms {
+		roomNames[r.ID] = r.Name
+	}
+	bySource := make(map[string]domain.Session, len(sessions))
+	for _, sess := range sessions {
+		if sess.Source == "sessionize" {
+			bySource[sess.SourceSessionID] = *sess
+		}
+	}
+	return roomNames, bySource, nil
+}
+
+func (s *eventService) PreviewSessionizeImport(ctx context.Context, eventID, ownerID, sourceID string, forceRefresh bool) (*domain.SessionizeImportPreview, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	sessionData, err := s.sf.Fetch(ctx, sourceID, forceRefresh)
+	if err != nil {
+		return nil, err
+	}
+	mapping, err := s.importMappingFor(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	rooms, err := s.sessionRepo.ListRoomsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list rooms: %w", err)
+	}
+	sessions, err := s.sessionRepo.ListSessionsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list sessions: %w", err)
+	}
+	preview := &domain.SessionizeImportPreview{EventID: eventID, DryRun: true}
+
+	// Rooms and sessions are upserted by their Sessionize ID; the rest are pruned.
+	roomsBySource := make(map[int]*domain.Room, len(rooms))
+	for _, r := range rooms {
+		roomsBySource[r.SourceSessionID] = r
+	}
+	imported := make(map[int]bool, len(sessionData.Rooms))
+	keep := make(map[string]bool)
+	for _, room := range sessionData.Rooms {
+		name := room.Name
+		if override, ok := mapping.RoomNameOverrides[room.Name]; ok {
+			name = override
+		}
+		imported[room.ID] = true
+		preview.Rooms = append(preview.Rooms, importChange(roomsBySource[room.ID], func(r *domain.Room) string { return r.ID }, name, keep))
+	}
+	preview.Rooms = appendPruned(preview.Rooms, rooms, keep, func(r *domain.Room) (string, string) { return r.ID, r.Name })
+
+	sessionsBySource := make(map[string]*domain.Session, len(sessions))
+	for _, sess := range sessions {
+		sessionsBySource[sess.SourceSessionID] = sess
+	}
+	for _, sess := range sessionData.Sessions {
+		if !imported[sess.RoomID] {
+			continue // the import skips sessions without a room
+		}
+		preview.Sessions = append(preview.Sessions, importChange(sessionsBySource[sess.ID], func(s *domain.Session) string { return s.ID }, sess.Title, keep))
+	}
+	preview.Sessions = appendPruned(preview.Sessions, sessions, keep, func(s *domain.Session) (string, string) { return s.ID, s.Title })
+
+	speakers, err := s.previewSpeakerImport(ctx, eventID, sessionData.Speakers)
+	if err != nil {
+		return nil, err
+	}
+	preview.Speakers = speakers
+	return preview, nil
+}
+
+// previewSpeakerImport returns what importSpeakers and the prune after it would do to the event's
+// speakers, matching them the same way.
+func (s *eventService) previewSpeakerImport(ctx context.Context, eventID string, speakers []domain.SessionFetcherSpeaker) ([]*domain.DryRunChange, error) {
+	existing, err := s.sessionRepo.ListSpeakersByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list existing speakers: %w", err)
+	}
+	pending, err := s.speakerMergeRepo.ListPendingByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list speaker merge candidates: %w", err)
+	}
+	keep := make(map[string]bool)
+	for _, c := range pending {
+		keep[c.CandidateID] = true
+	}
+	bySourceID := make(map[string]*domain.Speaker)
+	for _, sp := range existing {
+		if sp.Source == "sessionize" {
+			bySourceID[sp.SourceSessionID] = sp
+		}
+	}
+	claimed := make(map[string]bool)
+	for _, sp := range speakers {
+		if e, ok := bySourceID[sp.ID]; ok {
+			claimed[e.ID] = true
+		}
+	}
+
+	var changes []*domain.DryRunChange
+	for _, sp := range speakers {
+		name := strings.TrimSpace(sp.FirstName + " " + sp.LastName)
+		match, known := bySourceID[sp.ID]
+		if !known {
+			imported := domain.NewSpeaker(eventID, sp.ID, "sessionize", sp.FirstName, sp.LastName, sp.Bio, sp.TagLine, sp.ProfilePicture, sp.IsTopSpeaker, time.Time{}, time.Time{})
+			var possible []speakerCandidate
+			match, possible = findSpeakerMatch(imported, existing, claimed)
+			if match != nil {
+				claimed[match.ID] = true
+			}
+			for _, c := range possible {
+				keep[c.speaker.ID] = true
+			}
+		}
+		changes = append(changes, importChange(match, func(sp *domain.Speaker) string { return sp.ID }, name, keep))
+	}
+	return appendPruned(changes, existing, keep, func(sp *domain.Speaker) (string, string) {
+		return sp.ID, strings.TrimSpace(sp.FirstName + " " + sp.LastName)
+	}), nil
+}
+
+// importChange returns the preview of importing a record named name: an update of existing, or a
+// create when existing is nil. An updated record is added to keep.
+func importChange[T any](existing *T, id func(*T) string, name string, keep map[string]bool) *domain.DryRunChange {
+	if existing == nil {
+		return &domain.DryRunChange{Name: name, Action: domain.DryRunCreate}
+	}
+	keep[id(existing)] = true
+	return &domain.DryRunChange{ID: id(existing), Name: name, Action: domain.DryRunUpdate}
+}
+
+// appendPruned appends a delete to changes for every record not in keep. It never returns nil, so
+// the preview lists are empty rather than null.
+func appendPruned[T any](changes []*domain.DryRunChange, records []*T, keep map[string]bool, idName func(*T) (string, string)) []*domain.DryRunChange {
+	if changes == nil {
+		changes = []*domain.DryRunChange{}
+	}
+	for _, r := range records {
+		if id, name := idName(r); !keep[id] {
+			changes = append(changes, &domain.DryRunChange{ID: id, Name: name, Action: domain.DryRunDelete})
+		}
+	}
+	return changes
+}
+
+// importSpeakers upserts the imported speakers. An existing speaker is reused when it has the same
+// Sessionize ID, or when findSpeakerMatch is certain it is the same person under a new ID; it is
+// then re-keyed to the new ID. Possible matches are created as new speakers and returned as merge
+// candidates. keepSpeakerIDs lists every speaker the import must not prune: the imported ones and
+// the existing speakers awaiting a merge review.
+func (s *eventService) importSpeakers(ctx context.Context, eventID string, speakers []domain.SessionFetcherSpeaker) (speakerMap map[string]string, keepSpeakerIDs []string, candidates []*domain.SpeakerMergeCandidate, err error) {
+	existing, err := s.sessionRepo.ListSpeakersByEventID(ctx, eventID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("list existing speakers: %w", err)
+	}
+	pending, err := s.speakerMergeRepo.ListPendingByEventID(ctx, eventID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("list speaker merge candidates: %w", err)
+	}
+	for _, c := range pending {
+		keepSpeakerIDs = append(keepSpeakerIDs, c.CandidateID)
+	}
+
+	bySourceID := make(map[string]*domain.Speaker)
+	for _, sp := range existing {
+		if sp.Source == "sessionize" {
+			bySourceID[sp.SourceSessionID] = sp
+		}
+	}
+	claimed := make(map[string]bool) // existing speaker IDs this import reuses
+	for _, sp := range speakers {
+		if e, ok := bySourceID[sp.ID]; ok {
+			claimed[e.ID] = true
+		}
+	}
+
+	speakerMap = make(map[string]string) // Sessionize speaker UUID -> domain speaker ID
+	for _, sp := range speakers {
+		now := time.Now()
+		domainSp := domain.NewSpeaker(eventID, sp.ID, "sessionize", sp.FirstName, sp.LastName, sp.Bio, sp.TagLine, sp.ProfilePicture, sp.IsTopSpeaker, now, now)
+		var possible []speakerCandidate
+		if _, known := bySourceID[sp.ID]; !known {
+			var same *domain.Speaker
+			same, possible = findSpeakerMatch(domainSp, existing, claimed)
+			if same != nil {
+				if err := s.sessionRepo.UpdateSpeakerSource(ctx, same.ID, "sessionize", sp.ID); err != nil {
+					return nil, nil, nil, fmt.Errorf("re-key speaker %s %s: %w", sp.FirstName, sp.LastName, err)
+				}
+				claimed[same.ID] = true
+				bySourceID[sp.ID] = same
+			}
+		}
+		if err := s.sessionRepo.CreateSpeaker(ctx, domainSp); err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to create speaker %s %s: %w", sp.FirstName, sp.LastName, err)
+		}
+		speakerMap[sp.ID] = domainSp.ID
+		keepSpeakerIDs = append(keepSpeakerIDs, domainSp.ID)
+		for _, c := range possible {
+			keepSpeakerIDs = append(keepSpeakerIDs, c.speaker.ID)
+			candidates = append(candidates, &domain.SpeakerMergeCandidate{
+				EventID:     eventID,
+				SpeakerID:   domainSp.ID,
+				CandidateID: c.speaker.ID,
+				Reason:      c.reason,
+			})
+		}
+	}
+	return speakerMap, keepSpeakerIDs, candidates, nil
+}
+
 func generateManualSessionID() (string, error) {
 	b := make([]byte, 16)
 	if _, err := rand.Read(b); err != nil {
@@ -300,7 +724,7 @@ func (s *eventService) CreateEventSession(
 	startTime, endTime time.Time,
 	tagNames, speakerIDs []string,
 ) (*domain.Session, error) {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -325,8 +749,12 @@ func (s *eventService) CreateEventSession(
 		return nil, domain.ErrNotFound
 	}
 
-	if !endTime.After(startTime) {
-		return nil, fmt.Errorf("end_time must be after start_time: %w", domain.ErrInvalidInput)
+	startTime, endTime = s.policy.normalize(startTime), s.policy.normalize(endTime)
+	if err := s.checkSessionSlot(ctx, eventID, startTime, endTime); err != nil {
+		return nil, err
+	}
+	if err := s.checkRoomFree(ctx, eventID, roomID, "", startTime, endTime); err != nil {
+		return nil, err
 	}
 
 	sourceSessionID, err := generateManualSessionID()
@@ -335,7 +763,7 @@ func (s *eventService) CreateEventSession(
 	}
 
 	now := time.Now()
-	sess := domain.NewSession(roomID, sourceSessionID, "admin_app", title, description, startTime, endTime, nil, now, now)
+	sess := domain.NewSession(eventID, roomID, sourceSessionID, "admin_app", title, description, startTime, endTime, nil, now, now)
 	if err := s.sessionRepo.CreateSession(ctx, sess); err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
 			return nil, domain.ErrNotFound
@@ -388,12 +816,13 @@ func (s *eventService) CreateEventSession(
 		}
 		return nil, fmt.Errorf("get session: %w", err)
 	}
+	s.refreshScheduleGrid(ctx, eventID)
 
 	return created, nil
 }
 
 func (s *eventService) UpdateSessionSchedule(ctx context.Context, eventID, sessionID, ownerID string, roomID *string, startTime, endTime *time.Time) (*domain.Session, error) {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -415,23 +844,16 @@ func (s *eventService) UpdateSessionSchedule(ctx context.Context, eventID, sessi
 		return nil, fmt.Errorf("get session: %w", err)
 	}
 
-	// Ensure current session belongs to this event via its room.
-	currentRoom, err := s.sessionRepo.GetRoomByID(ctx, sess.RoomID)
-	if err != nil {
-		if errors.Is(err, domain.ErrNotFound) {
-			return nil, domain.ErrNotFound
-		}
-		return nil, fmt.Errorf("get room: %w", err)
-	}
-	if currentRoom.EventID != eventID {
+	if sess.EventID != eventID {
 		return nil, domain.ErrNotFound
 	}
 
 	newRoomID := sess.RoomID
+	var newRoom *domain.Room
 	if roomID != nil {
 		newRoomID = *roomID
 		// Validate new room belongs to the same event.
-		newRoom, err := s.sessionRepo.GetRoomByID(ctx, newRoomID)
+		newRoom, err = s.sessionRepo.GetRoomByID(ctx, newRoomID)
 		if err != nil {
 			if errors.Is(err, domain.ErrNotFound) {
 				return nil, domain.ErrNotFound
@@ -445,15 +867,26 @@ func (s *eventService) UpdateSessionSchedule(ctx context.Context, eventID, sessi
 
 	newStart := sess.StartTime
 	if startTime != nil {
-		newStart = *startTime
+		newStart = s.policy.normalize(*startTime)
 	}
 	newEnd := sess.EndTime
 	if endTime != nil {
-		newEnd = *endTime
+		newEnd = s.policy.normalize(*endTime)
 	}
 
-	if !newEnd.After(newStart) {
-		return nil, domain.ErrInvalidInput
+	// Only a new time slot is checked against the rules, so moving a session to another room keeps
+	// working after the rules change.
+	if startTime != nil || endTime != nil {
+		if err := s.checkSessionSlot(ctx, eventID, newStart, newEnd); err != nil {
+			return nil, err
+		}
+	} else if err := s.policy.checkOrder(newStart, newEnd); err != nil {
+		return nil, err
+	}
+	if roomID != nil || startTime != nil || endTime != nil {
+		if err := s.checkRoomFree(ctx, eventID, newRoomID, sessionID, newStart, newEnd); err != nil {
+			return nil, err
+		}
 	}
 
 	var roomIDArg *string
@@ -469,6 +902,20 @@ func (s *eventService) UpdateSessionSchedule(ctx context.Context, eventID, sessi
 		endArg = &newEnd
 	}
 
+	// Describe the move before saving it; the room names are the ones shown at the time.
+	var oldRoom *domain.Room
+	if sess.RoomID != "" {
+		if newRoom != nil && newRoom.ID == sess.RoomID {
+			oldRoom = newRoom
+		} else if oldRoom, err = s.sessionRepo.GetRoomByID(ctx, sess.RoomID); err != nil {
+			oldRoom = &domain.Room{ID: sess.RoomID}
+		}
+	}
+	if newRoom == nil {
+		newRoom = oldRoom
+	}
+	change := newSessionChange(sess, oldRoom, newRoom, newStart, newEnd)
+
 	updated, err := s.sessionRepo.UpdateSessionSchedule(ctx, sessionID, roomIDArg, startArg, endArg)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
@@ -476,12 +923,46 @@ func (s *eventService) UpdateSessionSchedule(ctx context.Context, eventID, sessi
 		}
 		return nil, fmt.Errorf("update session schedule: %w", err)
 	}
+	s.recordSessionChange(ctx, change)
+	s.refreshScheduleGrid(ctx, eventID)
 
 	return updated, nil
 }
 
+// newSessionChange describes sess moving from fromRoom to toRoom and the time slot from start to
+// end. A nil room stands for no room.
+func newSessionChange(sess *domain.Session, fromRoom, toRoom *domain.Room, start, end time.Time) *domain.SessionChange {
+	c := &domain.SessionChange{
+		EventID:       sess.EventID,
+		SessionID:     sess.ID,
+		FromStartTime: sess.StartTime,
+		FromEndTime:   sess.EndTime,
+		ToStartTime:   start,
+		ToEndTime:     end,
+	}
+	if fromRoom != nil {
+		c.FromRoomID, c.FromRoomName = fromRoom.ID, fromRoom.Name
+	}
+	if toRoom != nil {
+		c.ToRoomID, c.ToRoomName = toRoom.ID, toRoom.Name
+	}
+	return c
+}
+
+// recordSessionChange stores the change in the session's history when it moved the session. The
+// move has already been saved, so a failure to record it is reported as best effort rather than
+// returned.
+func (s *eventService) recordSessionChange(ctx context.Context, change *domain.SessionChange) {
+	if !change.RoomChanged() && !change.TimeChanged() {
+		return
+	}
+	if err := s.sessionChangeRepo.Create(ctx, change); err != nil {
+		domain.ReportBestEffort(ctx, fmt.Errorf("record change of session %s: %w", change.SessionID, err))
+	}
+}
+
 func (s *eventService) UpdateSessionContent(ctx context.Context, eventID, sessionID, ownerID string, title *string, description *string) (*domain.Session, error) {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -503,14 +984,7 @@ func (s *eventService) UpdateSessionContent(ctx context.Context, eventID, sessio
 		return nil, fmt.Errorf("get session: %w", err)
 	}
 
-	currentRoom, err := s.sessionRepo.GetRoomByID(ctx, sess.RoomID)
-	if err != nil {
-		if errors.Is(err, domain.ErrNotFound) {
-			return nil, domain.ErrNotFound
-		}
-		return nil, fmt.Errorf("get room: %w", err)
-	}
-	if currentRoom.EventID != eventID {
+	if sess.EventID != eventID {
 		return nil, domain.ErrNotFound
 	}
 
@@ -521,18 +995,81 @@ func (s *eventService) UpdateSessionContent(ctx context.Context, eventID, sessio
 		}
 		return nil, fmt.Errorf("update session content: %w", err)
 	}
+	s.refreshScheduleGrid(ctx, eventID)
 
 	return updated, nil
 }
 
-func (s *eventService) ListEventsByOwner(ctx context.Context, ownerID string) ([]*domain.Event, error) {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+func (s *eventService) ListMyEvents(ctx context.Context, userID string, params domain.MyEventsParams) ([]*domain.Event, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	var events []*domain.Event
+	var err error
+	if params.OwnerOnly {
+		events, err = s.eventRepo.ListByOwnerID(ctx, userID)
+	} else {
+		events, err = s.eventRepo.ListByOwnerOrTeamMemberID(ctx, userID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range events {
+		e.Role = domain.EventRoleTeamMember
+		if e.OwnerID == userID {
+			e.Role = domain.EventRoleOwner
+		}
+	}
+	if !params.WithStats || len(events) == 0 {
+		return events, nil
+	}
+	ids := make([]string, len(events))
+	for i, e := range events {
+		ids[i] = e.ID
+	}
+	stats, err := s.eventRepo.ListStats(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("list event stats: %w", err)
+	}
+	for _, e := range events {
+		if e.Stats = stats[e.ID]; e.Stats == nil {
+			e.Stats = &domain.EventStats{TeamSize: 1}
+		}
+	}
+	return events, nil
+}
+
+func (s *eventService) SearchEvents(ctx context.Context, userID string, params domain.EventSearchParams) ([]*domain.EventSearchResult, int, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	params.Query = strings.TrimSpace(params.Query)
+	for _, role := range params.Roles {
+		switch role {
+		case domain.EventRoleOwner, domain.EventRoleTeamMember, domain.EventRoleAttendee:
+		default:
+			return nil, 0, fmt.Errorf("role %q must be one of %s, %s or %s: %w",
+				role, domain.EventRoleOwner, domain.EventRoleTeamMember, domain.EventRoleAttendee, domain.ErrInvalidInput)
+		}
+	}
+	if params.From != nil && params.To != nil && !params.To.After(*params.From) {
+		return nil, 0, fmt.Errorf("to must be after from: %w", domain.ErrInvalidInput)
+	}
+	results, total, err := s.eventRepo.Search(ctx, userID, params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("search events: %w", err)
+	}
+	return results, total, nil
+}
+
+func (s *eventService) ListJoinedEvents(ctx context.Context, userID string) ([]*domain.Event, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
-	return s.eventRepo.ListByOwnerID(ctx, ownerID)
+	return s.eventRepo.ListByTeamMemberID(ctx, userID)
 }
 
-func (s *eventService) DeleteEvent(ctx context.Context, eventID string, ownerID string) error {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+func (s *eventService) DeleteEvent(ctx context.Context, eventID string, ownerID string, force bool) error {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -545,6 +1082,16 @@ func (s *eventService) DeleteEvent(ctx context.Context, eventID string, ownerID
 	if event.OwnerID != ownerID {
 		return domain.ErrForbidden
 	}
+	if !force {
+		registrations, err := s.eventRepo.CountRegistrations(ctx, eventID)
+		if err != nil {
+			return fmt.Errorf("count registrations: %w", err)
+		}
+		if registrations > 0 {
+			return fmt.Errorf("%d attendees are registered; delete with force to remove their registrations too: %w",
+				registrations, domain.ErrEventHasRegistrations)
+		}
+	}
 	if err := s.eventRepo.Delete(ctx, eventID); err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
 			return domain.ErrNotFound
@@ -555,7 +1102,7 @@ func (s *eventService) DeleteEvent(ctx context.Context, eventID string, ownerID
 }
 
 func (s *eventService) CreateEventRoom(ctx context.Context, eventID, ownerID, name string, capacity int, description, howToGetThere string, notBookable bool) (*domain.Room, error) {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -577,11 +1124,12 @@ func (s *eventService) CreateEventRoom(ctx context.Context, eventID, ownerID, na
 		}
 		return nil, fmt.Errorf("create room: %w", err)
 	}
+	s.refreshScheduleGrid(ctx, eventID)
 	return room, nil
 }
 
 func (s *eventService) ToggleRoomNotBookable(ctx context.Context, eventID, roomID, ownerID string) (*domain.Room, error) {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -610,11 +1158,12 @@ func (s *eventService) ToggleRoomNotBookable(ctx context.Context, eventID, roomI
 	if err != nil {
 		return nil, fmt.Errorf("set room not_bookable: %w", err)
 	}
+	s.refreshScheduleGrid(ctx, eventID)
 	return updated, nil
 }
 
 func (s *eventService) ListEventRooms(ctx context.Context, eventID, ownerID string) ([]*domain.Room, error) {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -637,8 +1186,8 @@ func (s *eventService) ListEventRooms(ctx context.Context, eventID, ownerID stri
 	return rooms, nil
 }
 
-func (s *eventService) GetEventRoom(ctx context.Context, eventID, roomID, ownerID string) (*domain.Room, error) {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+func (s *eventService) ReorderEventRooms(ctx context.Context, eventID, ownerID string, roomIDs []string) ([]*domain.Room, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -651,12 +1200,131 @@ func (s *eventService) GetEventRoom(ctx context.Context, eventID, roomID, ownerI
 	if event.OwnerID != ownerID {
 		return nil, domain.ErrForbidden
 	}
-	room, err := s.sessionRepo.GetRoomByID(ctx, roomID)
+	rooms, err := s.sessionRepo.ListRoomsByEventID(ctx, eventID)
 	if err != nil {
-		if errors.Is(err, domain.ErrNotFound) {
-			return nil, domain.ErrNotFound
+		return nil, fmt.Errorf("list rooms: %w", err)
+	}
+	// A partial list would leave the omitted rooms tied with the moved ones, so the
+	// order must name every room of the event exactly once.
+	if len(roomIDs) != len(rooms) {
+		return nil, fmt.Errorf("room order must list all %d rooms of the event: %w", len(rooms), domain.ErrInvalidInput)
+	}
+	seen := make(map[string]bool, len(roomIDs))
+	for _, id := range roomIDs {
+		if seen[id] {
+			return nil, fmt.Errorf("room %s listed twice: %w", id, domain.ErrInvalidInput)
 		}
-		return nil, fmt.Errorf("get room: %w", err)
+		seen[id] = true
+	}
+	for _, room := range rooms {
+		if !seen[room.ID] {
+			return nil, fmt.Errorf("room %s missing from order: %w", room.ID, domain.ErrInvalidInput)
+		}
+	}
+
+	if err := s.sessionRepo.ReorderRooms(ctx, eventID, roomIDs); err != nil {
+		return nil, fmt.Errorf("reorder rooms: %w", err)
+	}
+	s.refreshScheduleGrid(ctx, eventID)
+	rooms, err = s.sessionRepo.ListRoomsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list rooms: %w", err)
+	}
+	return rooms, nil
+}
+
+func (s *eventService) ListEventTracks(ctx context.Context, eventID, ownerID string) ([]string, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	return s.eventTracks(ctx, eventID)
+}
+
+func (s *eventService) ReorderEventTracks(ctx context.Context, eventID, ownerID string, tracks []string) ([]string, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	current, err := s.eventTracks(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	// As with rooms, the order must name every track of the event exactly once.
+	if len(tracks) != len(current) {
+		return nil, fmt.Errorf("track order must list all %d tracks of the event: %w", len(current), domain.ErrInvalidInput)
+	}
+	seen := make(map[string]bool, len(tracks))
+	for _, name := range tracks {
+		if seen[name] {
+			return nil, fmt.Errorf("track %q listed twice: %w", name, domain.ErrInvalidInput)
+		}
+		seen[name] = true
+	}
+	for _, name := range current {
+		if !seen[name] {
+			return nil, fmt.Errorf("track %q missing from order: %w", name, domain.ErrInvalidInput)
+		}
+	}
+
+	if err := s.sessionRepo.ReorderTracks(ctx, eventID, tracks); err != nil {
+		return nil, fmt.Errorf("reorder tracks: %w", err)
+	}
+	s.refreshScheduleGrid(ctx, eventID)
+	return s.eventTracks(ctx, eventID)
+}
+
+// eventTracks returns the tracks of the event's sessions in display order.
+func (s *eventService) eventTracks(ctx context.Context, eventID string) ([]string, error) {
+	sessions, err := s.sessionRepo.ListSessionsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list sessions: %w", err)
+	}
+	order, err := s.sessionRepo.ListTrackOrder(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list track order: %w", err)
+	}
+	return orderTracks(sessions, order), nil
+}
+
+func (s *eventService) GetEventRoom(ctx context.Context, eventID, roomID, ownerID string) (*domain.Room, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	room, err := s.sessionRepo.GetRoomByID(ctx, roomID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get room: %w", err)
 	}
 	if room.EventID != eventID {
 		return nil, domain.ErrNotFound
@@ -665,7 +1333,7 @@ func (s *eventService) GetEventRoom(ctx context.Context, eventID, roomID, ownerI
 }
 
 func (s *eventService) UpdateEventRoom(ctx context.Context, eventID, roomID, ownerID string, name *string, capacity int, description, howToGetThere string, notBookable *bool) (*domain.Room, error) {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -703,44 +1371,261 @@ func (s *eventService) UpdateEventRoom(ctx context.Context, eventID, roomID, own
 		}
 		return nil, fmt.Errorf("update room details: %w", err)
 	}
+	s.refreshScheduleGrid(ctx, eventID)
 	return updated, nil
 }
 
-func (s *eventService) DeleteEventRoom(ctx context.Context, eventID, roomID, ownerID string) error {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+func (s *eventService) DeleteEventRoom(ctx context.Context, eventID, roomID, ownerID, mode, targetRoomID string) error {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
-	event, err := s.eventRepo.GetByID(ctx, eventID)
+	room, target, sessions, err := s.roomDeletionPlan(ctx, eventID, roomID, ownerID, mode, targetRoomID)
+	if err != nil {
+		return err
+	}
+	switch mode {
+	case "", domain.RoomDeleteCascade:
+		err = s.sessionRepo.DeleteRoom(ctx, roomID)
+	case domain.RoomDeleteReassign:
+		err = s.sessionRepo.DeleteRoomKeepSessions(ctx, roomID, &targetRoomID)
+	case domain.RoomDeleteUnschedule:
+		err = s.sessionRepo.DeleteRoomKeepSessions(ctx, roomID, nil)
+	}
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
 			return domain.ErrNotFound
 		}
-		return fmt.Errorf("get event: %w", err)
+		return fmt.Errorf("delete room: %w", err)
+	}
+	// Sessions kept by the deletion moved out of the room; record the move.
+	if mode == domain.RoomDeleteReassign || mode == domain.RoomDeleteUnschedule {
+		for _, sess := range sessions {
+			s.recordSessionChange(ctx, newSessionChange(sess, room, target, sess.StartTime, sess.EndTime))
+		}
+	}
+	s.refreshScheduleGrid(ctx, eventID)
+	return nil
+}
+
+func (s *eventService) PreviewDeleteEventRoom(ctx context.Context, eventID, roomID, ownerID, mode, targetRoomID string) (*domain.RoomDeletionPreview, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	_, _, sessions, err := s.roomDeletionPlan(ctx, eventID, roomID, ownerID, mode, targetRoomID)
+	if err != nil {
+		return nil, err
+	}
+	if mode == "" {
+		mode = domain.RoomDeleteCascade
+	}
+	action := domain.DryRunMove
+	if mode == domain.RoomDeleteCascade {
+		action = domain.DryRunDelete
+	}
+	preview := &domain.RoomDeletionPreview{EventID: eventID, RoomID: roomID, Mode: mode, DryRun: true, Sessions: []*domain.DryRunChange{}}
+	for _, sess := range sessions {
+		preview.Sessions = append(preview.Sessions, &domain.DryRunChange{ID: sess.ID, Name: sess.Title, Action: action})
+	}
+	return preview, nil
+}
+
+// roomDeletionPlan checks that ownerID may delete the room in mode and returns the room, the
+// target room (reassign mode only) and the room's sessions.
+func (s *eventService) roomDeletionPlan(ctx context.Context, eventID, roomID, ownerID, mode, targetRoomID string) (room, target *domain.Room, sessions []*domain.Session, err error) {
+	switch mode {
+	case "", domain.RoomDeleteCascade, domain.RoomDeleteReassign, domain.RoomDeleteUnschedule:
+	default:
+		return nil, nil, nil, fmt.Errorf("unknown room delete mode %q: %w", mode, domain.ErrInvalidInput)
+	}
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, nil, nil, domain.ErrNotFound
+		}
+		return nil, nil, nil, fmt.Errorf("get event: %w", err)
 	}
 	if event.OwnerID != ownerID {
-		return domain.ErrForbidden
+		return nil, nil, nil, domain.ErrForbidden
 	}
-	room, err := s.sessionRepo.GetRoomByID(ctx, roomID)
+	room, err = s.sessionRepo.GetRoomByID(ctx, roomID)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
-			return domain.ErrNotFound
+			return nil, nil, nil, domain.ErrNotFound
 		}
-		return fmt.Errorf("get room: %w", err)
+		return nil, nil, nil, fmt.Errorf("get room: %w", err)
 	}
 	if room.EventID != eventID {
-		return domain.ErrNotFound
+		return nil, nil, nil, domain.ErrNotFound
+	}
+	if mode == domain.RoomDeleteReassign {
+		if targetRoomID == "" || targetRoomID == roomID {
+			return nil, nil, nil, fmt.Errorf("reassign needs another room of the event: %w", domain.ErrInvalidInput)
+		}
+		target, err = s.sessionRepo.GetRoomByID(ctx, targetRoomID)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return nil, nil, nil, fmt.Errorf("target room not found: %w", domain.ErrInvalidInput)
+			}
+			return nil, nil, nil, fmt.Errorf("get target room: %w", err)
+		}
+		if target.EventID != eventID {
+			return nil, nil, nil, fmt.Errorf("target room not found: %w", domain.ErrInvalidInput)
+		}
+	}
+	all, err := s.sessionRepo.ListSessionsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("list sessions: %w", err)
+	}
+	for _, sess := range all {
+		if sess.RoomID == roomID {
+			sessions = append(sessions, sess)
+		}
+	}
+	return room, target, sessions, nil
+}
+
+func (s *eventService) ListSessionHistory(ctx context.Context, eventID, sessionID, ownerID string) ([]*domain.SessionChange, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
 	}
-	if err := s.sessionRepo.DeleteRoom(ctx, roomID); err != nil {
+	sess, err := s.sessionRepo.GetSessionByID(ctx, sessionID)
+	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
-			return domain.ErrNotFound
+			return nil, domain.ErrNotFound
 		}
-		return fmt.Errorf("delete room: %w", err)
+		return nil, fmt.Errorf("get session: %w", err)
 	}
-	return nil
+	if sess.EventID != eventID {
+		return nil, domain.ErrNotFound
+	}
+	changes, err := s.sessionChangeRepo.ListBySessionID(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("list session history: %w", err)
+	}
+	return changes, nil
+}
+
+func (s *eventService) GetEventScheduleAsOf(ctx context.Context, eventID, ownerID string, asOf time.Time) (*domain.ScheduleSnapshot, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if asOf.After(time.Now()) {
+		return nil, fmt.Errorf("as_of cannot be in the future: %w", domain.ErrInvalidInput)
+	}
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+
+	rooms, sessions, trackOrder, err := scheduleEntities(ctx, s.sessionRepo, s.customFieldRepo, s.operatingHoursRepo, event)
+	if err != nil {
+		return nil, err
+	}
+	changes, err := s.sessionChangeRepo.ListAfter(ctx, eventID, asOf)
+	if err != nil {
+		return nil, fmt.Errorf("list schedule changes: %w", err)
+	}
+	deleted, err := s.sessionChangeRepo.ListDeletedSessionIDs(ctx, eventID, asOf)
+	if err != nil {
+		return nil, fmt.Errorf("list deleted sessions: %w", err)
+	}
+	edited := editedSessionIDs(sessions, changes, asOf)
+	rooms, sessions = rewindSchedule(rooms, sessions, changes, asOf)
+	schedule := groupSchedule(event, rooms, sessions, trackOrder)
+	return &domain.ScheduleSnapshot{
+		Event:             schedule.Event,
+		Rooms:             schedule.Rooms,
+		Tracks:            schedule.Tracks,
+		AsOf:              asOf,
+		Partial:           len(deleted) > 0 || len(edited) > 0,
+		DeletedSessionIDs: deleted,
+		EditedSessionIDs:  edited,
+	}, nil
+}
+
+// rewindSchedule returns the rooms and sessions as they were at asOf, given the changes made since,
+// oldest first. Rooms and sessions created later are left out, and each session that moved goes
+// back to the room and time slot its first change moved it from. Rooms deleted since come back,
+// after the others, under the name they had then.
+func rewindSchedule(rooms []*domain.Room, sessions []*domain.Session, changes []*domain.SessionChange, asOf time.Time) ([]*domain.Room, []*domain.Session) {
+	first := make(map[string]*domain.SessionChange, len(changes))
+	for _, c := range changes {
+		if _, ok := first[c.SessionID]; !ok {
+			first[c.SessionID] = c
+		}
+	}
+
+	known := make(map[string]bool, len(rooms))
+	roomsThen := make([]*domain.Room, 0, len(rooms))
+	for _, r := range rooms {
+		known[r.ID] = true
+		if !r.CreatedAt.After(asOf) {
+			roomsThen = append(roomsThen, r)
+		}
+	}
+
+	var deleted []*domain.Room
+	sessionsThen := make([]*domain.Session, 0, len(sessions))
+	for _, sess := range sessions {
+		if sess.CreatedAt.After(asOf) {
+			continue
+		}
+		if c, ok := first[sess.ID]; ok {
+			then := *sess
+			then.RoomID, then.StartTime, then.EndTime = c.FromRoomID, c.FromStartTime, c.FromEndTime
+			sess = &then
+			if c.FromRoomID != "" && !known[c.FromRoomID] {
+				known[c.FromRoomID] = true
+				deleted = append(deleted, &domain.Room{ID: c.FromRoomID, EventID: sess.EventID, Name: c.FromRoomName})
+			}
+		}
+		sessionsThen = append(sessionsThen, sess)
+	}
+	slices.SortFunc(deleted, func(a, b *domain.Room) int {
+		return cmp.Or(strings.Compare(a.Name, b.Name), strings.Compare(a.ID, b.ID))
+	})
+	return append(roomsThen, deleted...), sessionsThen
+}
+
+// editedSessionIDs returns the sessions that existed at asOf and were last updated after it by
+// something other than a move in changes, so their fields may differ from what they were then.
+func editedSessionIDs(sessions []*domain.Session, changes []*domain.SessionChange, asOf time.Time) []string {
+	lastMove := make(map[string]time.Time, len(changes))
+	for _, c := range changes {
+		lastMove[c.SessionID] = c.ChangedAt
+	}
+	ids := []string{}
+	for _, sess := range sessions {
+		if sess.CreatedAt.After(asOf) || !sess.UpdatedAt.After(asOf) {
+			continue
+		}
+		// A move saves the session before the change is recorded, so it is never updated after it.
+		if moved, ok := lastMove[sess.ID]; ok && !sess.UpdatedAt.After(moved) {
+			continue
+		}
+		ids = append(ids, sess.ID)
+	}
+	return ids
 }
 
 func (s *eventService) DeleteEventSession(ctx context.Context, eventID, sessionID, ownerID string) error {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -760,14 +1645,7 @@ func (s *eventService) DeleteEventSession(ctx context.Context, eventID, sessionI
 		}
 		return fmt.Errorf("get session: %w", err)
 	}
-	room, err := s.sessionRepo.GetRoomByID(ctx, sess.RoomID)
-	if err != nil {
-		if errors.Is(err, domain.ErrNotFound) {
-			return domain.ErrNotFound
-		}
-		return fmt.Errorf("get room: %w", err)
-	}
-	if room.EventID != eventID {
+	if sess.EventID != eventID {
 		return domain.ErrNotFound
 	}
 	if err := s.sessionRepo.DeleteSession(ctx, sessionID); err != nil {
@@ -776,11 +1654,12 @@ func (s *eventService) DeleteEventSession(ctx context.Context, eventID, sessionI
 		}
 		return fmt.Errorf("delete session: %w", err)
 	}
+	s.refreshScheduleGrid(ctx, eventID)
 	return nil
 }
 
 func (s *eventService) ListEventSpeakers(ctx context.Context, eventID, ownerID string) ([]*domain.Speaker, error) {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -800,11 +1679,18 @@ func (s *eventService) ListEventSpeakers(ctx context.Context, eventID, ownerID s
 	if speakers == nil {
 		speakers = []*domain.Speaker{}
 	}
+	values, err := s.customFieldRepo.ListValues(ctx, eventID, domain.CustomFieldSpeaker, false)
+	if err != nil {
+		return nil, fmt.Errorf("list speaker custom fields: %w", err)
+	}
+	for _, sp := range speakers {
+		sp.CustomFields = values[sp.ID]
+	}
 	return speakers, nil
 }
 
 func (s *eventService) GetEventSpeaker(ctx context.Context, eventID, speakerID, ownerID string) (*domain.Speaker, []*domain.Session, error) {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -827,6 +1713,11 @@ func (s *eventService) GetEventSpeaker(ctx context.Context, eventID, speakerID,
 	if speaker.EventID != eventID {
 		return nil, nil, domain.ErrNotFound
 	}
+	values, err := s.customFieldRepo.ListValues(ctx, eventID, domain.CustomFieldSpeaker, false)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list speaker custom fields: %w", err)
+	}
+	speaker.CustomFields = values[speaker.ID]
 	sessionIDs, err := s.sessionRepo.ListSessionIDsBySpeakerID(ctx, speakerID)
 	if err != nil {
 		return nil, nil, fmt.Errorf("list session IDs by speaker: %w", err)
@@ -842,7 +1733,7 @@ func (s *eventService) GetEventSpeaker(ctx context.Context, eventID, speakerID,
 }
 
 func (s *eventService) DeleteEventSpeaker(ctx context.Context, eventID, speakerID, ownerID string) error {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -882,8 +1773,8 @@ func generateManualSpeakerID() (string, error) {
 	return "manual-" + hex.EncodeToString(b), nil
 }
 
-func (s *eventService) CreateEventSpeaker(ctx context.Context, eventID, ownerID string, firstName, lastName, bio, tagLine, profilePicture string, isTopSpeaker bool) (*domain.Speaker, error) {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+func (s *eventService) CreateEventSpeaker(ctx context.Context, eventID, ownerID string, firstName, lastName, email, bio, tagLine, profilePicture string, isTopSpeaker bool) (*domain.Speaker, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -902,14 +1793,63 @@ func (s *eventService) CreateEventSpeaker(ctx context.Context, eventID, ownerID
 	}
 	now := time.Now()
 	speaker := domain.NewSpeaker(eventID, sessionizeSpeakerID, "admin_app", firstName, lastName, bio, tagLine, profilePicture, isTopSpeaker, now, now)
+	speaker.Email = email
 	if err := s.sessionRepo.CreateSpeaker(ctx, speaker); err != nil {
 		return nil, fmt.Errorf("create speaker: %w", err)
 	}
 	return speaker, nil
 }
 
+func (s *eventService) BulkUpdateSpeakers(ctx context.Context, eventID, ownerID string, updates []*domain.SpeakerUpdate, dryRun bool) (*domain.SpeakerBulkUpdateResult, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if len(updates) == 0 || len(updates) > domain.MaxSpeakerBulkUpdates {
+		return nil, domain.ErrInvalidInput
+	}
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	speakers, err := s.sessionRepo.ListSpeakersByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list speakers: %w", err)
+	}
+	byID := make(map[string]*domain.Speaker, len(speakers))
+	for _, sp := range speakers {
+		byID[sp.ID] = sp
+	}
+	results, valid := checkSpeakerUpdates(updates, byID)
+	if !valid {
+		return &domain.SpeakerBulkUpdateResult{Applied: false, DryRun: dryRun, Results: results}, nil
+	}
+	if dryRun {
+		for i, u := range updates {
+			results[i].Speaker = previewSpeakerUpdate(byID[u.SpeakerID], u)
+		}
+		return &domain.SpeakerBulkUpdateResult{Applied: false, DryRun: true, Results: results}, nil
+	}
+	updated, err := s.sessionRepo.UpdateSpeakers(ctx, updates)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("update speakers: %w", err)
+	}
+	for i, sp := range updated {
+		results[i].Speaker = sp
+	}
+	return &domain.SpeakerBulkUpdateResult{Applied: true, Results: results}, nil
+}
+
 func (s *eventService) AddEventTeamMember(ctx context.Context, eventID, userIDToAdd, ownerID string) error {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -934,38 +1874,916 @@ func (s *eventService) AddEventTeamMember(ctx context.Context, eventID, userIDTo
 	return nil
 }
 
-func (s *eventService) AddEventTeamMemberByEmail(ctx context.Context, eventID, email, ownerID string) (*domain.EventTeamMember, error) {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+func (s *eventService) AddEventTeamMemberByEmail(ctx context.Context, eventID, email, ownerID string) (*domain.EventTeamMember, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	email = strings.TrimSpace(strings.ToLower(email))
+	if email == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	user, err := s.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, domain.ErrUserNotFound) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("get user by email: %w", err)
+	}
+	if user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+	if err := s.AddEventTeamMember(ctx, eventID, user.ID, ownerID); err != nil {
+		return nil, err
+	}
+	return &domain.EventTeamMember{
+		EventID:   eventID,
+		UserID:    user.ID,
+		Name:      user.Name,
+		LastName:  user.LastName,
+		Email:     user.Email,
+		AvatarURL: user.AvatarURL,
+	}, nil
+}
+
+func (s *eventService) PromoteInvitation(ctx context.Context, eventID, invitationID, ownerID, role string) (*domain.EventTeamMember, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if role != "" && role != domain.EventRoleTeamMember {
+		return nil, fmt.Errorf("role must be %s: %w", domain.EventRoleTeamMember, domain.ErrInvalidInput)
+	}
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	inv, err := s.invitationRepo.GetByID(ctx, invitationID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrInvitationNotFound
+		}
+		return nil, fmt.Errorf("get invitation: %w", err)
+	}
+	if inv.EventID != eventID {
+		return nil, domain.ErrInvitationNotFound
+	}
+	return s.AddEventTeamMemberByEmail(ctx, eventID, inv.Email, ownerID)
+}
+
+func (s *eventService) ListEventTeamMembers(ctx context.Context, eventID, callerID string) ([]*domain.EventTeamMember, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != callerID {
+		return nil, domain.ErrForbidden
+	}
+	members, err := s.eventTeamMemberRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list team members: %w", err)
+	}
+	if members == nil {
+		members = []*domain.EventTeamMember{}
+	}
+	return members, nil
+}
+
+func (s *eventService) ListEventInvitations(ctx context.Context, eventID, callerID string, search string, params domain.PaginationParams) ([]*domain.EventInvitation, int, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, 0, domain.ErrNotFound
+		}
+		return nil, 0, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != callerID {
+		return nil, 0, domain.ErrForbidden
+	}
+	invs, total, err := s.invitationRepo.ListByEventID(ctx, eventID, search, params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list event invitations: %w", err)
+	}
+	if invs == nil {
+		invs = []*domain.EventInvitation{}
+	}
+	return invs, total, nil
+}
+
+func (s *eventService) StreamEventInvitations(ctx context.Context, eventID, callerID string, search string, fn func(*domain.EventInvitation) error) error {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != callerID {
+		return domain.ErrForbidden
+	}
+	if err := s.invitationRepo.StreamByEventID(ctx, eventID, search, fn); err != nil {
+		return fmt.Errorf("stream event invitations: %w", err)
+	}
+	return nil
+}
+
+func (s *eventService) StreamEventSessions(ctx context.Context, eventID, ownerID string, fn func(*domain.Session) error) error {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return domain.ErrForbidden
+	}
+	values, err := s.customFieldRepo.ListValues(ctx, eventID, domain.CustomFieldSession, false)
+	if err != nil {
+		return fmt.Errorf("list session custom fields: %w", err)
+	}
+	err = s.sessionRepo.StreamSessionsByEventID(ctx, eventID, func(sess *domain.Session) error {
+		sess.CustomFields = values[sess.ID]
+		return fn(sess)
+	})
+	if err != nil {
+		return fmt.Errorf("stream event sessions: %w", err)
+	}
+	return nil
+}
+
+func (s *eventService) GetImportMapping(ctx context.Context, eventID, ownerID string) (*domain.ImportMapping, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	return s.importMappingFor(ctx, eventID)
+}
+
+func (s *eventService) UpdateImportMapping(ctx context.Context, eventID, ownerID string, mapping *domain.ImportMapping) (*domain.ImportMapping, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	mapping.EventID = eventID
+	if mapping.TagCategories == nil {
+		mapping.TagCategories = []string{}
+	}
+	if mapping.RoomNameOverrides == nil {
+		mapping.RoomNameOverrides = map[string]string{}
+	}
+	if err := s.importMappingRepo.Upsert(ctx, mapping); err != nil {
+		return nil, fmt.Errorf("save import mapping: %w", err)
+	}
+	return mapping, nil
+}
+
+// scheduleRulesFor returns the event's saved schedule rules, or rules allowing any time slot when none are saved.
+func (s *eventService) scheduleRulesFor(ctx context.Context, eventID string) (*domain.ScheduleRules, error) {
+	rules, err := s.scheduleRulesRepo.GetByEventID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewScheduleRules(eventID), nil
+		}
+		return nil, fmt.Errorf("get schedule rules: %w", err)
+	}
+	return rules, nil
+}
+
+func (s *eventService) GetScheduleRules(ctx context.Context, eventID, ownerID string) (*domain.ScheduleRules, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	return s.scheduleRulesFor(ctx, eventID)
+}
+
+func (s *eventService) UpdateScheduleRules(ctx context.Context, eventID, ownerID string, rules *domain.ScheduleRules) (*domain.ScheduleRules, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	rules.EventID = eventID
+	normalizeScheduleRules(rules)
+	if err := s.scheduleRulesRepo.Upsert(ctx, rules); err != nil {
+		return nil, fmt.Errorf("save schedule rules: %w", err)
+	}
+	s.refreshScheduleGrid(ctx, eventID)
+	return rules, nil
+}
+
+func (s *eventService) GetEventTheme(ctx context.Context, eventID, ownerID string) (*domain.EventTheme, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	return eventThemeFor(ctx, s.eventRepo, eventID)
+}
+
+func (s *eventService) UpdateEventTheme(ctx context.Context, eventID, ownerID string, theme *domain.EventTheme) (*domain.EventTheme, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	theme.EventID = eventID
+	normalizeEventTheme(theme)
+	if err := s.eventRepo.UpsertTheme(ctx, theme); err != nil {
+		return nil, fmt.Errorf("save event theme: %w", err)
+	}
+	return theme, nil
+}
+
+// checkSessionSlot checks a session's time slot against the schedule policy, the event's schedule
+// rules and its operating hours.
+func (s *eventService) checkSessionSlot(ctx context.Context, eventID string, start, end time.Time) error {
+	if err := s.policy.checkOrder(start, end); err != nil {
+		return err
+	}
+	rules, err := s.scheduleRulesFor(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	if err := s.policy.checkRules(rules, start, end); err != nil {
+		return err
+	}
+	days, err := s.operatingHoursRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("list operating hours: %w", err)
+	}
+	return s.policy.checkOperatingHours(days, start, end)
+}
+
+// checkRoomFree returns an ErrSessionTimeConflict error when the time slot overlaps, by more than
+// the policy's tolerance, a session in the room other than sessionID. A session without a room
+// conflicts with none.
+func (s *eventService) checkRoomFree(ctx context.Context, eventID, roomID, sessionID string, start, end time.Time) error {
+	if roomID == "" {
+		return nil
+	}
+	sessions, err := s.sessionRepo.ListSessionsByEventID(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("list sessions: %w", err)
+	}
+	for _, other := range sessions {
+		if other.RoomID != roomID || other.ID == sessionID {
+			continue
+		}
+		if s.policy.overlap(start, end, other.StartTime, other.EndTime) {
+			return fmt.Errorf("overlaps %q from %s to %s in the same room: %w", other.Title,
+				other.StartTime.UTC().Format(time.RFC3339), other.EndTime.UTC().Format(time.RFC3339), domain.ErrSessionTimeConflict)
+		}
+	}
+	return nil
+}
+
+func (s *eventService) GetOperatingHours(ctx context.Context, eventID, ownerID string) ([]*domain.OperatingDay, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	days, err := s.operatingHoursRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list operating hours: %w", err)
+	}
+	return days, nil
+}
+
+func (s *eventService) UpdateOperatingHours(ctx context.Context, eventID, ownerID string, days []*domain.OperatingDay) ([]*domain.OperatingDay, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	if days == nil {
+		days = []*domain.OperatingDay{}
+	}
+	if err := normalizeOperatingHours(days); err != nil {
+		return nil, err
+	}
+	if err := s.operatingHoursRepo.Replace(ctx, eventID, days); err != nil {
+		return nil, fmt.Errorf("save operating hours: %w", err)
+	}
+	s.refreshScheduleGrid(ctx, eventID)
+	return days, nil
+}
+
+func (s *eventService) ListSpeakerMergeCandidates(ctx context.Context, eventID, ownerID string) ([]*domain.SpeakerMergeCandidate, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	candidates, err := s.speakerMergeRepo.ListPendingByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list speaker merge candidates: %w", err)
+	}
+	if len(candidates) == 0 {
+		return []*domain.SpeakerMergeCandidate{}, nil
+	}
+	speakers, err := s.sessionRepo.ListSpeakersByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list speakers: %w", err)
+	}
+	byID := make(map[string]*domain.Speaker, len(speakers))
+	for _, sp := range speakers {
+		byID[sp.ID] = sp
+	}
+	for _, c := range candidates {
+		c.Speaker = byID[c.SpeakerID]
+		c.Candidate = byID[c.CandidateID]
+	}
+	return candidates, nil
+}
+
+func (s *eventService) ResolveSpeakerMergeCandidate(ctx context.Context, eventID, candidateID, ownerID string, merge bool) (*domain.SpeakerMergeCandidate, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	candidate, err := s.speakerMergeRepo.GetByID(ctx, candidateID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get speaker merge candidate: %w", err)
+	}
+	if candidate.EventID != eventID {
+		return nil, domain.ErrNotFound
+	}
+	if candidate.Status != domain.MergeStatusPending {
+		return nil, domain.ErrAlreadyResolved
+	}
+	if !merge {
+		resolved, err := s.speakerMergeRepo.Resolve(ctx, candidateID, domain.MergeStatusRejected)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) || errors.Is(err, domain.ErrAlreadyResolved) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("reject speaker merge candidate: %w", err)
+		}
+		return resolved, nil
+	}
+	// The existing speaker absorbs the imported one. Deleting the imported speaker also removes
+	// this candidate and any other candidate for it, so the merge is the resolution.
+	if err := s.sessionRepo.MergeSpeakers(ctx, candidate.CandidateID, candidate.SpeakerID); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("merge speakers: %w", err)
+	}
+	now := time.Now()
+	candidate.Status = domain.MergeStatusMerged
+	candidate.ResolvedAt = &now
+	return candidate, nil
+}
+
+func (s *eventService) GetIntegrityReport(ctx context.Context, eventID, ownerID string) (*domain.IntegrityReport, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	issues, err := s.integrityRepo.FindIssues(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("find integrity issues: %w", err)
+	}
+	return newIntegrityReport(eventID, issues), nil
+}
+
+func (s *eventService) ValidateSchedule(ctx context.Context, eventID, ownerID string) (*domain.ScheduleValidation, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	rooms, err := s.sessionRepo.ListRoomsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list rooms: %w", err)
+	}
+	sessions, err := s.sessionRepo.ListSessionsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list sessions: %w", err)
+	}
+	sessionIDs := make([]string, len(sessions))
+	for i, sess := range sessions {
+		sessionIDs[i] = sess.ID
+	}
+	speakerIDs, err := s.sessionRepo.ListSpeakerIDsBySessionIDs(ctx, sessionIDs)
+	if err != nil {
+		return nil, fmt.Errorf("list session speakers: %w", err)
+	}
+	for _, sess := range sessions {
+		sess.SpeakerIDs = speakerIDs[sess.ID]
+	}
+	speakers, err := s.sessionRepo.ListSpeakersByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list speakers: %w", err)
+	}
+	rules, err := s.scheduleRulesFor(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	days, err := s.operatingHoursRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list operating hours: %w", err)
+	}
+	return s.policy.validateSchedule(eventID, rules, days, rooms, sessions, speakers)
+}
+
+// buildScheduleGrid lays out the event's current schedule.
+func (s *eventService) buildScheduleGrid(ctx context.Context, eventID string) (*domain.ScheduleGrid, error) {
+	rooms, err := s.sessionRepo.ListRoomsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list rooms: %w", err)
+	}
+	sessions, err := s.sessionRepo.ListSessionsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list sessions: %w", err)
+	}
+	rules, err := s.scheduleRulesFor(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	loc := time.UTC
+	if rules.Timezone != "" {
+		if loc, err = time.LoadLocation(rules.Timezone); err != nil {
+			return nil, fmt.Errorf("load schedule rules time zone: %w", err)
+		}
+	}
+	days, err := s.operatingHoursRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list operating hours: %w", err)
+	}
+	trackOrder, err := s.sessionRepo.ListTrackOrder(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list track order: %w", err)
+	}
+	grid := s.policy.scheduleGrid(eventID, loc, rooms, sessions, days)
+	grid.Tracks = orderTracks(sessions, trackOrder)
+	return grid, nil
+}
+
+// refreshScheduleGrid rebuilds the event's stored grid after its schedule changed. The change has
+// already been saved, so a failure is reported as best effort rather than returned, and the stale
+// grid is dropped so the next read rebuilds it.
+func (s *eventService) refreshScheduleGrid(ctx context.Context, eventID string) {
+	grid, err := s.buildScheduleGrid(ctx, eventID)
+	if err == nil {
+		err = s.scheduleGridRepo.Save(ctx, grid)
+	}
+	if err == nil {
+		return
+	}
+	domain.ReportBestEffort(ctx, fmt.Errorf("refresh schedule grid of event %s: %w", eventID, err))
+	if err := s.scheduleGridRepo.Delete(ctx, eventID); err != nil {
+		domain.ReportBestEffort(ctx, fmt.Errorf("drop stale schedule grid of event %s: %w", eventID, err))
+	}
+}
+
+func (s *eventService) GetScheduleGrid(ctx context.Context, eventID, callerID string) (*domain.ScheduleGrid, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if _, _, err := s.teamEvent(ctx, eventID, callerID); err != nil {
+		return nil, err
+	}
+	grid, err := s.scheduleGridRepo.Get(ctx, eventID)
+	if err == nil {
+		return grid, nil
+	}
+	if !errors.Is(err, domain.ErrNotFound) {
+		return nil, fmt.Errorf("get schedule grid: %w", err)
+	}
+	grid, err = s.buildScheduleGrid(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.scheduleGridRepo.Save(ctx, grid); err != nil {
+		domain.ReportBestEffort(ctx, fmt.Errorf("save schedule grid of event %s: %w", eventID, err))
+	}
+	return grid, nil
+}
+
+// teamEvent returns the event and its team when userID is the owner or a team member, and
+// ErrForbidden otherwise.
+func (s *eventService) teamEvent(ctx context.Context, eventID, userID string) (*domain.Event, []*domain.EventTeamMember, error) {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, nil, domain.ErrNotFound
+		}
+		return nil, nil, fmt.Errorf("get event: %w", err)
+	}
+	members, err := s.eventTeamMemberRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list team members: %w", err)
+	}
+	if event.OwnerID != userID && !slices.ContainsFunc(members, func(m *domain.EventTeamMember) bool {
+		return m.UserID == userID
+	}) {
+		return nil, nil, domain.ErrForbidden
+	}
+	return event, members, nil
+}
+
+// checklistState loads the event's checklist and completions with the names of whoever ticked them.
+func (s *eventService) checklistState(ctx context.Context, eventID string, members []*domain.EventTeamMember) (*checklistState, error) {
+	items, err := s.checklistRepo.ListItems(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list checklist items: %w", err)
+	}
+	completions, err := s.checklistRepo.ListCompletions(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list checklist completions: %w", err)
+	}
+	names := make(map[string]string, len(members))
+	for _, m := range members {
+		names[m.UserID] = displayName(&domain.User{Name: m.Name, LastName: m.LastName, Email: m.Email})
+	}
+	for _, c := range completions {
+		if _, ok := names[c.CompletedBy]; ok || c.CompletedBy == "" {
+			continue
+		}
+		// The owner, or a member who has since left the team.
+		u, err := s.userRepo.GetByID(ctx, c.CompletedBy)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) || errors.Is(err, domain.ErrUserNotFound) {
+				names[c.CompletedBy] = ""
+				continue
+			}
+			return nil, fmt.Errorf("get user: %w", err)
+		}
+		names[c.CompletedBy] = displayName(u)
+	}
+	return newChecklistState(items, completions, names), nil
+}
+
+func (s *eventService) GetChecklist(ctx context.Context, eventID, callerID string) ([]*domain.ChecklistItem, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if _, _, err := s.teamEvent(ctx, eventID, callerID); err != nil {
+		return nil, err
+	}
+	items, err := s.checklistRepo.ListItems(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list checklist items: %w", err)
+	}
+	return items, nil
+}
+
+func (s *eventService) UpdateChecklist(ctx context.Context, eventID, ownerID string, labels []string) ([]*domain.ChecklistItem, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	labels, err = normalizeChecklistLabels(labels)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.checklistRepo.ReplaceItems(ctx, eventID, labels)
+	if err != nil {
+		return nil, fmt.Errorf("save checklist: %w", err)
+	}
+	return items, nil
+}
+
+func (s *eventService) SetSessionChecklistItem(ctx context.Context, eventID, sessionID, itemID, userID string, done bool) (*domain.SessionChecklist, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	_, members, err := s.teamEvent(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	sess, err := s.sessionRepo.GetSessionByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get session: %w", err)
+	}
+	if sess.EventID != eventID {
+		return nil, domain.ErrNotFound
+	}
+	items, err := s.checklistRepo.ListItems(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list checklist items: %w", err)
+	}
+	if !slices.ContainsFunc(items, func(item *domain.ChecklistItem) bool { return item.ID == itemID }) {
+		return nil, domain.ErrNotFound
+	}
+	if done {
+		err = s.checklistRepo.SetCompletion(ctx, checklistCompletion(sessionID, itemID, userID, time.Now().UTC()))
+	} else {
+		err = s.checklistRepo.DeleteCompletion(ctx, sessionID, itemID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("save checklist item: %w", err)
+	}
+	st, err := s.checklistState(ctx, eventID, members)
+	if err != nil {
+		return nil, err
+	}
+	return st.sessionChecklist(sess), nil
+}
+
+func (s *eventService) GetChecklistProgress(ctx context.Context, eventID, callerID string, ready *bool) (*domain.ChecklistProgress, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	_, members, err := s.teamEvent(ctx, eventID, callerID)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := s.sessionRepo.ListSessionsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list sessions: %w", err)
+	}
+	st, err := s.checklistState(ctx, eventID, members)
+	if err != nil {
+		return nil, err
+	}
+	return st.progress(eventID, sessions, ready), nil
+}
+
+func (s *eventService) ListCustomFields(ctx context.Context, eventID, callerID string) ([]*domain.CustomField, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if _, _, err := s.teamEvent(ctx, eventID, callerID); err != nil {
+		return nil, err
+	}
+	fields, err := s.customFieldRepo.ListFields(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list custom fields: %w", err)
+	}
+	return fields, nil
+}
+
+func (s *eventService) CreateCustomField(ctx context.Context, eventID, ownerID string, field *domain.CustomField) (*domain.CustomField, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	if err := validateCustomField(field); err != nil {
+		return nil, err
+	}
+	fields, err := s.customFieldRepo.ListFields(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list custom fields: %w", err)
+	}
+	if len(fields) >= domain.MaxCustomFields {
+		return nil, fmt.Errorf("an event has at most %d custom fields: %w", domain.MaxCustomFields, domain.ErrQuotaExceeded)
+	}
+	field.EventID = eventID
+	if err := s.customFieldRepo.CreateField(ctx, field); err != nil {
+		if errors.Is(err, domain.ErrDuplicateCustomField) {
+			return nil, fmt.Errorf("%s field %q: %w", field.AppliesTo, field.Name, domain.ErrDuplicateCustomField)
+		}
+		return nil, fmt.Errorf("create custom field: %w", err)
+	}
+	return field, nil
+}
+
+func (s *eventService) UpdateCustomField(ctx context.Context, eventID, fieldID, ownerID string, name *string, public *bool) (*domain.CustomField, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if _, err := s.ownedCustomField(ctx, eventID, fieldID, ownerID); err != nil {
+		return nil, err
+	}
+	if name != nil {
+		n, err := normalizeCustomFieldName(*name)
+		if err != nil {
+			return nil, err
+		}
+		name = &n
+	}
+	field, err := s.customFieldRepo.UpdateField(ctx, fieldID, name, public)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		if errors.Is(err, domain.ErrDuplicateCustomField) {
+			return nil, fmt.Errorf("field %q: %w", *name, domain.ErrDuplicateCustomField)
+		}
+		return nil, fmt.Errorf("update custom field: %w", err)
+	}
+	return field, nil
+}
+
+func (s *eventService) DeleteCustomField(ctx context.Context, eventID, fieldID, ownerID string) error {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if _, err := s.ownedCustomField(ctx, eventID, fieldID, ownerID); err != nil {
+		return err
+	}
+	if err := s.customFieldRepo.DeleteField(ctx, fieldID); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("delete custom field: %w", err)
+	}
+	return nil
+}
+
+// ownedCustomField returns the field when the event exists, is owned by ownerID and has the field.
+func (s *eventService) ownedCustomField(ctx context.Context, eventID, fieldID, ownerID string) (*domain.CustomField, error) {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	field, err := s.customFieldRepo.GetField(ctx, fieldID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get custom field: %w", err)
+	}
+	if field.EventID != eventID {
+		return nil, domain.ErrNotFound
+	}
+	return field, nil
+}
+
+func (s *eventService) SetSessionCustomFields(ctx context.Context, eventID, sessionID, ownerID string, values map[string]any) ([]*domain.CustomFieldValue, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
-	email = strings.TrimSpace(strings.ToLower(email))
-	if email == "" {
-		return nil, domain.ErrInvalidInput
-	}
-	user, err := s.userRepo.GetByEmail(ctx, email)
+	event, err := s.eventRepo.GetByID(ctx, eventID)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, domain.ErrUserNotFound) {
-			return nil, domain.ErrUserNotFound
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
 		}
-		return nil, fmt.Errorf("get user by email: %w", err)
+		return nil, fmt.Errorf("get event: %w", err)
 	}
-	if user == nil {
-		return nil, domain.ErrUserNotFound
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
 	}
-	if err := s.AddEventTeamMember(ctx, eventID, user.ID, ownerID); err != nil {
-		return nil, err
+	sess, err := s.sessionRepo.GetSessionByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get session: %w", err)
 	}
-	return &domain.EventTeamMember{
-		EventID:  eventID,
-		UserID:   user.ID,
-		Name:     user.Name,
-		LastName: user.LastName,
-		Email:    user.Email,
-	}, nil
+	if sess.EventID != eventID {
+		return nil, domain.ErrNotFound
+	}
+	return s.setCustomFields(ctx, eventID, domain.CustomFieldSession, sessionID, values)
 }
 
-func (s *eventService) ListEventTeamMembers(ctx context.Context, eventID, callerID string) ([]*domain.EventTeamMember, error) {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+func (s *eventService) SetSpeakerCustomFields(ctx context.Context, eventID, speakerID, ownerID string, values map[string]any) ([]*domain.CustomFieldValue, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -975,45 +2793,74 @@ func (s *eventService) ListEventTeamMembers(ctx context.Context, eventID, caller
 		}
 		return nil, fmt.Errorf("get event: %w", err)
 	}
-	if event.OwnerID != callerID {
+	if event.OwnerID != ownerID {
 		return nil, domain.ErrForbidden
 	}
-	members, err := s.eventTeamMemberRepo.ListByEventID(ctx, eventID)
+	speaker, err := s.sessionRepo.GetSpeakerByID(ctx, speakerID)
 	if err != nil {
-		return nil, fmt.Errorf("list team members: %w", err)
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get speaker: %w", err)
 	}
-	if members == nil {
-		members = []*domain.EventTeamMember{}
+	if speaker.EventID != eventID {
+		return nil, domain.ErrNotFound
 	}
-	return members, nil
+	return s.setCustomFields(ctx, eventID, domain.CustomFieldSpeaker, speakerID, values)
 }
 
-func (s *eventService) ListEventInvitations(ctx context.Context, eventID, callerID string, search string, params domain.PaginationParams) ([]*domain.EventInvitation, int, error) {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+// setCustomFields checks values against the event's fields for appliesTo and stores them.
+func (s *eventService) setCustomFields(ctx context.Context, eventID, appliesTo, entityID string, values map[string]any) ([]*domain.CustomFieldValue, error) {
+	fields, err := s.customFieldRepo.ListFields(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list custom fields: %w", err)
+	}
+	list, err := customFieldValues(fields, appliesTo, values)
+	if err != nil {
+		return nil, err
+	}
+	saved, err := s.customFieldRepo.SetValues(ctx, appliesTo, entityID, list)
+	if err != nil {
+		return nil, fmt.Errorf("save custom fields: %w", err)
+	}
+	return saved, nil
+}
+
+func (s *eventService) CleanupIntegrityIssues(ctx context.Context, eventID, ownerID string, dryRun bool) (*domain.IntegrityCleanup, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
-			return nil, 0, domain.ErrNotFound
+			return nil, domain.ErrNotFound
 		}
-		return nil, 0, fmt.Errorf("get event: %w", err)
+		return nil, fmt.Errorf("get event: %w", err)
 	}
-	if event.OwnerID != callerID {
-		return nil, 0, domain.ErrForbidden
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
 	}
-	invs, total, err := s.invitationRepo.ListByEventID(ctx, eventID, search, params)
+	issues, err := s.integrityRepo.FindIssues(ctx, eventID)
 	if err != nil {
-		return nil, 0, fmt.Errorf("list event invitations: %w", err)
+		return nil, fmt.Errorf("find integrity issues: %w", err)
 	}
-	if invs == nil {
-		invs = []*domain.EventInvitation{}
+	cleanup := &domain.IntegrityCleanup{EventID: eventID, DryRun: dryRun, Issues: []*domain.IntegrityIssue{}}
+	for _, issue := range issues {
+		if issue.Fixable {
+			cleanup.Issues = append(cleanup.Issues, issue)
+		}
 	}
-	return invs, total, nil
+	if dryRun || len(cleanup.Issues) == 0 {
+		return cleanup, nil
+	}
+	if cleanup.Changed, err = s.integrityRepo.Cleanup(ctx, eventID); err != nil {
+		return nil, fmt.Errorf("clean up integrity issues: %w", err)
+	}
+	return cleanup, nil
 }
 
 func (s *eventService) RemoveEventTeamMember(ctx context.Context, eventID, userIDToRemove, ownerID string) error {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -1035,8 +2882,60 @@ func (s *eventService) RemoveEventTeamMember(ctx context.Context, eventID, userI
 	return nil
 }
 
+func (s *eventService) LeaveEvent(ctx context.Context, eventID, userID string) (bool, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return false, domain.ErrNotFound
+		}
+		return false, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID == userID {
+		return false, fmt.Errorf("the owner cannot leave their own event: %w", domain.ErrInvalidInput)
+	}
+	if err := s.eventTeamMemberRepo.Remove(ctx, eventID, userID); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return false, domain.ErrNotFound
+		}
+		return false, fmt.Errorf("remove team member: %w", err)
+	}
+
+	// The member has left either way; the notice is best effort.
+	owner, err := s.userRepo.GetByID(ctx, event.OwnerID)
+	if err != nil || owner == nil {
+		return false, nil
+	}
+	data := &domain.TeamMemberLeftEmailData{
+		EventID:   eventID,
+		Email:     owner.Email,
+		OwnerName: displayName(owner),
+		EventName: event.Name,
+	}
+	if member, err := s.userRepo.GetByID(ctx, userID); err == nil && member != nil {
+		data.MemberName = displayName(member)
+		data.MemberEmail = member.Email
+	} else {
+		data.MemberName = "A team member"
+	}
+	if err := s.emailService.SendTeamMemberLeft(ctx, data); err != nil {
+		return false, nil
+	}
+	return true, nil
+}
+
+// displayName returns the user's full name, or their email when they have not set one.
+func displayName(u *domain.User) string {
+	if name := strings.TrimSpace(u.Name + " " + u.LastName); name != "" {
+		return name
+	}
+	return u.Email
+}
+
 func (s *eventService) ListEventTags(ctx context.Context, eventID, callerID string) ([]*domain.Tag, error) {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -1060,7 +2959,7 @@ func (s *eventService) ListEventTags(ctx context.Context, eventID, callerID stri
 }
 
 func (s *eventService) AddEventTags(ctx context.Context, eventID, ownerID string, tagNames []string) ([]*domain.Tag, error) {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -1086,7 +2985,7 @@ func (s *eventService) AddEventTags(ctx context.Context, eventID, ownerID string
 }
 
 func (s *eventService) AddSessionTag(ctx context.Context, eventID, sessionID, ownerID, tagID string) error {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -1106,14 +3005,7 @@ func (s *eventService) AddSessionTag(ctx context.Context, eventID, sessionID, ow
 		}
 		return fmt.Errorf("get session: %w", err)
 	}
-	room, err := s.sessionRepo.GetRoomByID(ctx, sess.RoomID)
-	if err != nil {
-		if errors.Is(err, domain.ErrNotFound) {
-			return domain.ErrNotFound
-		}
-		return fmt.Errorf("get room: %w", err)
-	}
-	if room.EventID != eventID {
+	if sess.EventID != eventID {
 		return domain.ErrNotFound
 	}
 	eventTags, err := s.tagRepo.ListTagsByEventID(ctx, eventID)
@@ -1137,7 +3029,7 @@ func (s *eventService) AddSessionTag(ctx context.Context, eventID, sessionID, ow
 }
 
 func (s *eventService) RemoveSessionTag(ctx context.Context, eventID, sessionID, ownerID, tagID string) error {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -1157,14 +3049,7 @@ func (s *eventService) RemoveSessionTag(ctx context.Context, eventID, sessionID,
 		}
 		return fmt.Errorf("get session: %w", err)
 	}
-	room, err := s.sessionRepo.GetRoomByID(ctx, sess.RoomID)
-	if err != nil {
-		if errors.Is(err, domain.ErrNotFound) {
-			return domain.ErrNotFound
-		}
-		return fmt.Errorf("get room: %w", err)
-	}
-	if room.EventID != eventID {
+	if sess.EventID != eventID {
 		return domain.ErrNotFound
 	}
 	if err := s.tagRepo.RemoveSessionTag(ctx, sessionID, tagID); err != nil {
@@ -1174,7 +3059,7 @@ func (s *eventService) RemoveSessionTag(ctx context.Context, eventID, sessionID,
 }
 
 func (s *eventService) AddSessionSpeaker(ctx context.Context, eventID, sessionID, ownerID, speakerID string) error {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -1194,14 +3079,7 @@ func (s *eventService) AddSessionSpeaker(ctx context.Context, eventID, sessionID
 		}
 		return fmt.Errorf("get session: %w", err)
 	}
-	room, err := s.sessionRepo.GetRoomByID(ctx, sess.RoomID)
-	if err != nil {
-		if errors.Is(err, domain.ErrNotFound) {
-			return domain.ErrNotFound
-		}
-		return fmt.Errorf("get room: %w", err)
-	}
-	if room.EventID != eventID {
+	if sess.EventID != eventID {
 		return domain.ErrNotFound
 	}
 	speaker, err := s.sessionRepo.GetSpeakerByID(ctx, speakerID)
@@ -1221,7 +3099,7 @@ func (s *eventService) AddSessionSpeaker(ctx context.Context, eventID, sessionID
 }
 
 func (s *eventService) RemoveSessionSpeaker(ctx context.Context, eventID, sessionID, ownerID, speakerID string) error {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -1241,14 +3119,7 @@ func (s *eventService) RemoveSessionSpeaker(ctx context.Context, eventID, sessio
 		}
 		return fmt.Errorf("get session: %w", err)
 	}
-	room, err := s.sessionRepo.GetRoomByID(ctx, sess.RoomID)
-	if err != nil {
-		if errors.Is(err, domain.ErrNotFound) {
-			return domain.ErrNotFound
-		}
-		return fmt.Errorf("get room: %w", err)
-	}
-	if room.EventID != eventID {
+	if sess.EventID != eventID {
 		return domain.ErrNotFound
 	}
 	if err := s.sessionRepo.DeleteSessionSpeaker(ctx, sessionID, speakerID); err != nil {
@@ -1258,7 +3129,7 @@ func (s *eventService) RemoveSessionSpeaker(ctx context.Context, eventID, sessio
 }
 
 func (s *eventService) ListSessionSpeakers(ctx context.Context, eventID, sessionID, callerID string) ([]*domain.Speaker, error) {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -1279,14 +3150,7 @@ func (s *eventService) ListSessionSpeakers(ctx context.Context, eventID, session
 		}
 		return nil, fmt.Errorf("get session: %w", err)
 	}
-	room, err := s.sessionRepo.GetRoomByID(ctx, sess.RoomID)
-	if err != nil {
-		if errors.Is(err, domain.ErrNotFound) {
-			return nil, domain.ErrNotFound
-		}
-		return nil, fmt.Errorf("get room: %w", err)
-	}
-	if room.EventID != eventID {
+	if sess.EventID != eventID {
 		return nil, domain.ErrNotFound
 	}
 
@@ -1306,7 +3170,7 @@ func (s *eventService) ListSessionSpeakers(ctx context.Context, eventID, session
 }
 
 func (s *eventService) RemoveEventTag(ctx context.Context, eventID, ownerID, tagID string) error {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -1329,7 +3193,7 @@ func (s *eventService) RemoveEventTag(ctx context.Context, eventID, ownerID, tag
 }
 
 func (s *eventService) UpdateEventTag(ctx context.Context, eventID, tagID, ownerID, name string) (*domain.Tag, error) {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
@@ -1369,41 +3233,36 @@ func (s *eventService) UpdateEventTag(ctx context.Context, eventID, tagID, owner
 	return s.tagRepo.GetTagByID(ctx, tagID)
 }
 
-func (s *eventService) SendEventInvitations(ctx context.Context, eventID, ownerID string, emails []string) (sent int, failed []string, err error) {
-	ctx, cancel := context.WithTimeout(ctx, s.contextTimeout)
+func (s *eventService) SendEventInvitations(ctx context.Context, eventID, ownerID string, emails []string) (sent int, failed, skipped []string, err error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
 	defer cancel()
 
 	event, err := s.eventRepo.GetByID(ctx, eventID)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
-			return 0, nil, domain.ErrNotFound
+			return 0, nil, nil, domain.ErrNotFound
 		}
-		return 0, nil, fmt.Errorf("get event: %w", err)
+		return 0, nil, nil, fmt.Errorf("get event: %w", err)
 	}
 	if event.OwnerID != ownerID {
-		return 0, nil, domain.ErrForbidden
+		return 0, nil, nil, domain.ErrForbidden
 	}
 
-	owner, err := s.userRepo.GetByID(ctx, ownerID)
-	if err != nil || owner == nil {
-		// Proceed with empty owner name if user lookup fails
-	}
-	ownerName := "Event owner"
-	if owner != nil {
-		ownerName = strings.TrimSpace(owner.Name + " " + owner.LastName)
-		if ownerName == "" {
-			ownerName = owner.Email
-		}
-		if ownerName == "" {
-			ownerName = "Event owner"
-		}
+	rules, err := s.invitationDomainRulesFor(ctx, eventID)
+	if err != nil {
+		return 0, nil, nil, err
 	}
+	ownerName := s.inviterName(ctx, ownerID)
 
 	for _, email := range emails {
 		email = strings.TrimSpace(strings.ToLower(email))
 		if email == "" {
 			continue
 		}
+		if !rules.Permits(email) {
+			skipped = append(skipped, email)
+			continue
+		}
 		sentAt := time.Now()
 		inv := &domain.EventInvitation{
 			EventID: eventID,
@@ -1415,6 +3274,7 @@ func (s *eventService) SendEventInvitations(ctx context.Context, eventID, ownerI
 			continue
 		}
 		data := &domain.EventInvitationEmailData{
+			EventID:   eventID,
 			Email:     email,
 			OwnerName: ownerName,
 			EventName: event.Name,
@@ -1426,5 +3286,198 @@ func (s *eventService) SendEventInvitations(ctx context.Context, eventID, ownerI
 		}
 		sent++
 	}
-	return sent, failed, nil
+	return sent, failed, skipped, nil
+}
+
+func (s *eventService) AnonymizeEvent(ctx context.Context, eventID, ownerID string, dryRun bool) (*domain.EventAnonymization, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	if event.Date != nil && event.Date.After(time.Now()) {
+		return nil, fmt.Errorf("event has not taken place yet: %w", domain.ErrInvalidInput)
+	}
+	if dryRun {
+		preview, err := s.eventRepo.PreviewAnonymize(ctx, eventID, ownerID)
+		if err != nil {
+			return nil, fmt.Errorf("preview anonymization: %w", err)
+		}
+		return preview, nil
+	}
+	anonymization, err := s.eventRepo.Anonymize(ctx, eventID, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("anonymize event: %w", err)
+	}
+	return anonymization, nil
+}
+
+// invitationDomainRulesFor returns the event's saved invitation domain rules, or rules allowing every domain when none are saved.
+func (s *eventService) invitationDomainRulesFor(ctx context.Context, eventID string) (*domain.InvitationDomainRules, error) {
+	rules, err := s.invitationRepo.GetDomainRules(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewInvitationDomainRules(eventID), nil
+		}
+		return nil, fmt.Errorf("get invitation domain rules: %w", err)
+	}
+	return rules, nil
+}
+
+func (s *eventService) GetInvitationDomainRules(ctx context.Context, eventID, ownerID string) (*domain.InvitationDomainRules, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	return s.invitationDomainRulesFor(ctx, eventID)
+}
+
+func (s *eventService) UpdateInvitationDomainRules(ctx context.Context, eventID, ownerID string, rules *domain.InvitationDomainRules) (*domain.InvitationDomainRules, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	rules.EventID = eventID
+	rules.AllowedDomains = normalizeEmailDomains(rules.AllowedDomains)
+	rules.BlockedDomains = normalizeEmailDomains(rules.BlockedDomains)
+	if err := s.invitationRepo.UpsertDomainRules(ctx, rules); err != nil {
+		return nil, fmt.Errorf("save invitation domain rules: %w", err)
+	}
+	return rules, nil
+}
+
+// inviterName is how invitation emails name the event owner: their full name, else their email.
+// A failed user lookup falls back to "Event owner" rather than failing the invitations.
+func (s *eventService) inviterName(ctx context.Context, ownerID string) string {
+	owner, err := s.userRepo.GetByID(ctx, ownerID)
+	if err != nil || owner == nil {
+		return "Event owner"
+	}
+	name := strings.TrimSpace(owner.Name + " " + owner.LastName)
+	if name == "" {
+		name = owner.Email
+	}
+	if name == "" {
+		name = "Event owner"
+	}
+	return name
+}
+
+func (s *eventService) RemindEventInvitations(ctx context.Context, eventID, ownerID string, minInterval time.Duration) (*domain.InvitationReminderResult, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if minInterval == 0 {
+		minInterval = domain.DefaultInvitationReminderInterval
+	}
+	if minInterval < domain.MinInvitationReminderInterval {
+		return nil, fmt.Errorf("reminder interval must be at least %s: %w", domain.MinInvitationReminderInterval, domain.ErrInvalidInput)
+	}
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	pending, err := s.invitationRepo.ListPending(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list pending invitations: %w", err)
+	}
+
+	result := &domain.InvitationReminderResult{Failed: []string{}}
+	ownerName := s.inviterName(ctx, ownerID)
+	now := time.Now()
+	for _, inv := range pending {
+		if inv.RemindersSent >= domain.MaxInvitationReminders || now.Sub(inv.LastContactedAt) < minInterval {
+			result.Throttled++
+			continue
+		}
+		data := &domain.EventInvitationEmailData{
+			EventID:   eventID,
+			Email:     inv.Email,
+			OwnerName: ownerName,
+			EventName: event.Name,
+			EventCode: event.EventCode,
+		}
+		if err := s.emailService.SendEventInvitationReminder(ctx, data); err != nil {
+			result.Failed = append(result.Failed, inv.Email)
+			continue
+		}
+		if err := s.invitationRepo.MarkReminded(ctx, inv.ID, now); err != nil {
+			return nil, fmt.Errorf("mark invitation reminded: %w", err)
+		}
+		result.Sent++
+	}
+	return result, nil
+}
+
+func (s *eventService) ListEventEmails(ctx context.Context, eventID, ownerID string, filter domain.EventEmailFilter, params domain.PaginationParams) ([]*domain.EventEmail, int, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, 0, domain.ErrNotFound
+		}
+		return nil, 0, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, 0, domain.ErrForbidden
+	}
+	return s.emailService.ListEventEmails(ctx, eventID, filter, params)
+}
+
+func (s *eventService) ResendEventEmail(ctx context.Context, eventID, ownerID, emailID string) (*domain.EventEmail, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	email, err := s.emailService.ResendEventEmail(ctx, eventID, emailID)
+	if err != nil {
+		if errors.Is(err, domain.ErrEventEmailNotFound) {
+			return nil, domain.ErrEventEmailNotFound
+		}
+		return nil, fmt.Errorf("resend event email: %w", err)
+	}
+	return email, nil
 }
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+// eventDeletionTokenBytes is the length of an event deletion token before hex encoding.
+const eventDeletionTokenBytes = 16
+
+type eventDeletionService struct {
+	events         domain.EventService
+	eventRepo      domain.EventRepository
+	deletionRepo   domain.EventDeletionRepository
+	userRepo       domain.UserRepository
+	roleRepo       domain.RoleRepository
+	emailService   domain.EmailService
+	contextTimeout time.Duration
+}
+
+// NewEventDeletionService returns a domain.EventDeletionService. Confirmed deletions go through
+// events.DeleteEvent, so they are checked like any other deletion.
+func NewEventDeletionService(events domain.EventService, eventRepo domain.EventRepository, deletionRepo domain.EventDeletionRepository, userRepo domain.UserRepository, roleRepo domain.RoleRepository, emailService domain.EmailService, timeout time.Duration) domain.EventDeletionService {
+	return &eventDeletionService{
+		events:         events,
+		eventRepo:      eventRepo,
+		deletionRepo:   deletionRepo,
+		userRepo:       userRepo,
+		roleRepo:       roleRepo,
+		emailService:   emailService,
+		contextTimeout: timeout,
+	}
+}
+
+func (s *eventDeletionService) RequestEventDeletion(ctx context.Context, eventID, ownerID string, force bool) (*domain.PendingEventDeletion, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, err := s.ownedEvent(ctx, eventID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if !force {
+		registrations, err := s.eventRepo.CountRegistrations(ctx, eventID)
+		if err != nil {
+			return nil, fmt.Errorf("count registrations: %w", err)
+		}
+		if registrations > 0 {
+			return nil, fmt.Errorf("%d attendees are registered; delete with force to remove their registrations too: %w",
+				registrations, domain.ErrEventHasRegistrations)
+		}
+	}
+	owner, err := s.userRepo.GetByID(ctx, ownerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, domain.ErrUserNotFound) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("get owner: %w", err)
+	}
+
+	token, err := generateEventDeletionToken()
+	if err != nil {
+		return nil, fmt.Errorf("generate deletion token: %w", err)
+	}
+	pending := &domain.PendingEventDeletion{EventID: eventID, Force: force, ExpiresAt: time.Now().Add(domain.EventDeletionExpiry)}
+	if err := s.deletionRepo.Create(ctx, pending, ownerID, hashEventDeletionToken(token)); err != nil {
+		return nil, fmt.Errorf("store pending deletion: %w", err)
+	}
+	data := &domain.EventDeletionEmailData{
+		Email:            owner.Email,
+		OwnerName:        strings.TrimSpace(owner.Name + " " + owner.LastName),
+		EventName:        event.Name,
+		Token:            token,
+		ExpiresInMinutes: int(domain.EventDeletionExpiry / time.Minute),
+	}
+	if err := s.emailService.SendEventDeletionConfirmation(ctx, data); err != nil {
+		return nil, fmt.Errorf("send deletion confirmation: %w", err)
+	}
+	return pending, nil
+}
+
+func (s *eventDeletionService) ConfirmEventDeletion(ctx context.Context, eventID, ownerID, token string) error {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if _, err := s.ownedEvent(ctx, eventID, ownerID); err != nil {
+		return err
+	}
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return domain.ErrInvalidConfirmation
+	}
+	pending, ok, err := s.deletionRepo.Consume(ctx, eventID, hashEventDeletionToken(token))
+	if err != nil {
+		return fmt.Errorf("consume pending deletion: %w", err)
+	}
+	if !ok {
+		return domain.ErrInvalidConfirmation
+	}
+	return s.events.DeleteEvent(ctx, eventID, ownerID, pending.Force)
+}
+
+func (s *eventDeletionService) ForceDeleteEvent(ctx context.Context, eventID, adminID string) error {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := requireAdmin(ctx, s.roleRepo, adminID); err != nil {
+		return err
+	}
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("get event: %w", err)
+	}
+	return s.events.DeleteEvent(ctx, eventID, event.OwnerID, true)
+}
+
+// ownedEvent returns the event, ErrNotFound, or ErrForbidden unless ownerID owns it.
+func (s *eventDeletionService) ownedEvent(ctx context.Context, eventID, ownerID string) (*domain.Event, error) {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, domain.ErrForbidden
+	}
+	return event, nil
+}
+
+func generateEventDeletionToken() (string, error) {
+	b := make([]byte, eventDeletionTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// hashEventDeletionToken returns the stored form of a deletion token. Tokens are random, so a
+// plain SHA-256 is enough.
+func hashEventDeletionToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,108 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeEventDeletionRepo is an in-memory EventDeletionRepository.
+type fakeEventDeletionRepo struct {
+	pending map[string]*domain.PendingEventDeletion
+	hashes  map[string]string
+}
+
+func (f *fakeEventDeletionRepo) Create(ctx context.Context, d *domain.PendingEventDeletion, requestedBy, tokenHash string) error {
+	f.pending[d.EventID] = d
+	f.hashes[d.EventID] = tokenHash
+	return nil
+}
+
+func (f *fakeEventDeletionRepo) Consume(ctx context.Context, eventID, tokenHash string) (*domain.PendingEventDeletion, bool, error) {
+	d, ok := f.pending[eventID]
+	if !ok || f.hashes[eventID] != tokenHash || !time.Now().Before(d.ExpiresAt) {
+		return nil, false, nil
+	}
+	delete(f.pending, eventID)
+	return d, true, nil
+}
+
+func TestEventDeletionService(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func() (domain.EventDeletionService, *fakeEventRepo, *fakeEventDeletionRepo, *fakeEmailService) {
+		events := newFakeEventRepo()
+		events.byID["event-1"] = &domain.Event{ID: "event-1", Name: "GopherCon", OwnerID: "owner-1"}
+		events.registrations = map[string]int{}
+		deletions := &fakeEventDeletionRepo{pending: make(map[string]*domain.PendingEventDeletion), hashes: make(map[string]string)}
+		users := newFakeUserRepoForSchedule()
+		users.addUserWithName("owner@example.com", "owner-1", "Ada", "Lovelace")
+		roles := newFakeRoleRepo()
+		roles.listByUID["admin-1"] = []*domain.Role{{ID: "r-2", Code: domain.AdminRole}}
+		emails := newFakeEmailService()
+		eventSvc := newTestEventService(events, newFakeSessionRepo(), nil, 5*time.Second)
+		return NewEventDeletionService(eventSvc, events, deletions, users, roles, emails, 5*time.Second), events, deletions, emails
+	}
+
+	t.Run("deletes after the owner confirms", func(t *testing.T) {
+		svc, events, _, emails := setup()
+		pending, err := svc.RequestEventDeletion(ctx, "event-1", "owner-1", false)
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(domain.EventDeletionExpiry), pending.ExpiresAt, time.Minute)
+		require.Len(t, emails.sentDeletions, 1)
+		sent := emails.sentDeletions[0]
+		assert.Equal(t, "owner@example.com", sent.Email)
+		assert.Equal(t, "Ada Lovelace", sent.OwnerName)
+		assert.Contains(t, events.byID, "event-1", "requesting does not delete")
+
+		require.ErrorIs(t, svc.ConfirmEventDeletion(ctx, "event-1", "owner-1", "wrong"), domain.ErrInvalidConfirmation)
+		require.ErrorIs(t, svc.ConfirmEventDeletion(ctx, "event-1", "someone-else", sent.Token), domain.ErrForbidden)
+		require.NoError(t, svc.ConfirmEventDeletion(ctx, "event-1", "owner-1", sent.Token))
+		assert.NotContains(t, events.byID, "event-1")
+	})
+
+	t.Run("tokens are single use and expire", func(t *testing.T) {
+		svc, events, deletions, emails := setup()
+		_, err := svc.RequestEventDeletion(ctx, "event-1", "owner-1", false)
+		require.NoError(t, err)
+		deletions.pending["event-1"].ExpiresAt = time.Now().Add(-time.Second)
+		require.ErrorIs(t, svc.ConfirmEventDeletion(ctx, "event-1", "owner-1", emails.sentDeletions[0].Token), domain.ErrInvalidConfirmation)
+		assert.Contains(t, events.byID, "event-1")
+	})
+
+	t.Run("registrations need force", func(t *testing.T) {
+		svc, events, _, emails := setup()
+		events.registrations["event-1"] = 3
+		_, err := svc.RequestEventDeletion(ctx, "event-1", "owner-1", false)
+		require.ErrorIs(t, err, domain.ErrEventHasRegistrations)
+		assert.Empty(t, emails.sentDeletions)
+
+		pending, err := svc.RequestEventDeletion(ctx, "event-1", "owner-1", true)
+		require.NoError(t, err)
+		assert.True(t, pending.Force)
+		require.NoError(t, svc.ConfirmEventDeletion(ctx, "event-1", "owner-1", emails.sentDeletions[0].Token))
+		assert.NotContains(t, events.byID, "event-1")
+	})
+
+	t.Run("only the owner requests", func(t *testing.T) {
+		svc, _, _, _ := setup()
+		_, err := svc.RequestEventDeletion(ctx, "event-1", "someone-else", false)
+		require.ErrorIs(t, err, domain.ErrForbidden)
+		_, err = svc.RequestEventDeletion(ctx, "event-missing", "owner-1", false)
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("admins force deletion", func(t *testing.T) {
+		svc, events, _, _ := setup()
+		events.registrations["event-1"] = 3
+		require.ErrorIs(t, svc.ForceDeleteEvent(ctx, "event-1", "owner-1"), domain.ErrForbidden)
+		require.NoError(t, svc.ForceDeleteEvent(ctx, "event-1", "admin-1"))
+		assert.NotContains(t, events.byID, "event-1")
+		require.ErrorIs(t, svc.ForceDeleteEvent(ctx, "event-1", "admin-1"), domain.ErrNotFound)
+	})
+}
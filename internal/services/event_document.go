@@ -0,0 +1,308 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"multitrackticketing/internal/domain"
+)
+
+type eventDocumentService struct {
+	eventRepo      domain.EventRepository
+	documentRepo   domain.EventDocumentRepository
+	contextTimeout time.Duration
+}
+
+// NewEventDocumentService returns a domain.EventDocumentService. Registering for an event checks
+// the acceptances it records; see NewAttendeeService.
+func NewEventDocumentService(eventRepo domain.EventRepository, documentRepo domain.EventDocumentRepository, timeout time.Duration) domain.EventDocumentService {
+	return &eventDocumentService{
+		eventRepo:      eventRepo,
+		documentRepo:   documentRepo,
+		contextTimeout: timeout,
+	}
+}
+
+func (s *eventDocumentService) PublishDocument(ctx context.Context, eventID, ownerID, kind, title, body string) (*domain.EventDocument, bool, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := validDocumentKind(kind); err != nil {
+		return nil, false, err
+	}
+	title, body = strings.TrimSpace(title), strings.TrimSpace(body)
+	if title == "" || utf8.RuneCountInString(title) > domain.MaxDocumentTitleLength {
+		return nil, false, fmt.Errorf("title must be 1 to %d characters: %w", domain.MaxDocumentTitleLength, domain.ErrInvalidInput)
+	}
+	if body == "" || utf8.RuneCountInString(body) > domain.MaxDocumentBodyLength {
+		return nil, false, fmt.Errorf("body must be 1 to %d characters: %w", domain.MaxDocumentBodyLength, domain.ErrInvalidInput)
+	}
+	if err := s.ownedEvent(ctx, eventID, ownerID); err != nil {
+		return nil, false, err
+	}
+	current, err := s.documentRepo.ListCurrent(ctx, eventID)
+	if err != nil {
+		return nil, false, fmt.Errorf("list documents: %w", err)
+	}
+	// An unchanged document keeps its version, so attendees are not asked to accept it again.
+	for _, doc := range current {
+		if doc.Kind == kind && doc.Title == title && doc.Body == body {
+			return doc, false, nil
+		}
+	}
+	doc := &domain.EventDocument{EventID: eventID, Kind: kind, Title: title, Body: body}
+	if err := s.documentRepo.Publish(ctx, doc); err != nil {
+		return nil, false, fmt.Errorf("publish document: %w", err)
+	}
+	return doc, true, nil
+}
+
+func (s *eventDocumentService) ListDocuments(ctx context.Context, eventID, ownerID string) ([]*domain.EventDocument, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := s.ownedEvent(ctx, eventID, ownerID); err != nil {
+		return nil, err
+	}
+	docs, err := s.documentRepo.ListCurrent(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list documents: %w", err)
+	}
+	return docs, nil
+}
+
+func (s *eventDocumentService) ListDocumentVersions(ctx context.Context, eventID, ownerID, kind string) ([]*domain.EventDocument, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := validDocumentKind(kind); err != nil {
+		return nil, err
+	}
+	if err := s.ownedEvent(ctx, eventID, ownerID); err != nil {
+		return nil, err
+	}
+	versions, err := s.documentRepo.ListVersions(ctx, eventID, kind)
+	if err != nil {
+		return nil, fmt.Errorf("list document versions: %w", err)
+	}
+	if len(versions) == 0 {
+		return nil, domain.ErrNotFound
+	}
+	return versions, nil
+}
+
+func (s *eventDocumentService) WithdrawDocument(ctx context.Context, eventID, ownerID, kind string) error {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := validDocumentKind(kind); err != nil {
+		return err
+	}
+	if err := s.ownedEvent(ctx, eventID, ownerID); err != nil {
+		return err
+	}
+	if err := s.documentRepo.Withdraw(ctx, eventID, kind); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("withdraw document: %w", err)
+	}
+	return nil
+}
+
+func (s *eventDocumentService) GetAcceptanceReport(ctx context.Context, eventID, ownerID, kind string) (*domain.DocumentAcceptanceReport, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := validDocumentKind(kind); err != nil {
+		return nil, err
+	}
+	if err := s.ownedEvent(ctx, eventID, ownerID); err != nil {
+		return nil, err
+	}
+	current, err := s.documentRepo.ListCurrent(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list documents: %w", err)
+	}
+	i := slices.IndexFunc(current, func(doc *domain.EventDocument) bool { return doc.Kind == kind })
+	if i < 0 {
+		return nil, domain.ErrNotFound
+	}
+	attendees, err := s.documentRepo.ListAttendeeAcceptances(ctx, eventID, kind)
+	if err != nil {
+		return nil, fmt.Errorf("list acceptances: %w", err)
+	}
+	report := &domain.DocumentAcceptanceReport{
+		EventID:    eventID,
+		Kind:       kind,
+		Version:    current[i].Version,
+		Registered: len(attendees),
+		Attendees:  attendees,
+	}
+	for _, a := range attendees {
+		switch {
+		case a.AcceptedVersion == report.Version:
+			report.AcceptedCurrent++
+		case a.AcceptedVersion > 0:
+			report.AcceptedOlder++
+		default:
+			report.NeverAccepted++
+		}
+	}
+	return report, nil
+}
+
+func (s *eventDocumentService) ListMyDocuments(ctx context.Context, eventID, userID string) ([]*domain.AttendeeDocument, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := s.existingEvent(ctx, eventID); err != nil {
+		return nil, err
+	}
+	docs, err := s.documentRepo.ListCurrent(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list documents: %w", err)
+	}
+	return s.attendeeDocuments(ctx, eventID, userID, docs)
+}
+
+func (s *eventDocumentService) AcceptDocuments(ctx context.Context, eventID, userID string, accepted []domain.DocumentVersion) ([]*domain.AttendeeDocument, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if len(accepted) == 0 {
+		return nil, fmt.Errorf("accepted_documents is required: %w", domain.ErrInvalidInput)
+	}
+	if err := s.existingEvent(ctx, eventID); err != nil {
+		return nil, err
+	}
+	docs, err := s.documentRepo.ListCurrent(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list documents: %w", err)
+	}
+	// Accepting anything but the current text would record consent to a version nobody is shown.
+	for _, v := range accepted {
+		i := slices.IndexFunc(docs, func(doc *domain.EventDocument) bool { return doc.Kind == v.Kind })
+		if i < 0 {
+			return nil, fmt.Errorf("the event has no %s: %w", v.Kind, domain.ErrInvalidInput)
+		}
+		if docs[i].Version != v.Version {
+			return nil, fmt.Errorf("%s version %d is not the current version %d: %w", v.Kind, v.Version, docs[i].Version, domain.ErrInvalidInput)
+		}
+	}
+	if err := s.documentRepo.Accept(ctx, eventID, userID, accepted); err != nil {
+		return nil, fmt.Errorf("accept documents: %w", err)
+	}
+	return s.attendeeDocuments(ctx, eventID, userID, docs)
+}
+
+// attendeeDocuments returns docs with the versions of them userID accepted last.
+func (s *eventDocumentService) attendeeDocuments(ctx context.Context, eventID, userID string, docs []*domain.EventDocument) ([]*domain.AttendeeDocument, error) {
+	acceptances, err := s.documentRepo.ListAcceptances(ctx, eventID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list acceptances: %w", err)
+	}
+	out := make([]*domain.AttendeeDocument, 0, len(docs))
+	for _, doc := range docs {
+		ad := &domain.AttendeeDocument{Document: doc, NeedsAcceptance: true}
+		for _, a := range acceptances {
+			if a.Kind == doc.Kind {
+				ad.AcceptedVersion, ad.AcceptedAt = a.Version, &a.AcceptedAt
+				ad.NeedsAcceptance = a.Version != doc.Version
+			}
+		}
+		out = append(out, ad)
+	}
+	return out, nil
+}
+
+func (s *eventDocumentService) existingEvent(ctx context.Context, eventID string) error {
+	if _, err := s.eventRepo.GetByID(ctx, eventID); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("get event: %w", err)
+	}
+	return nil
+}
+
+func (s *eventDocumentService) ownedEvent(ctx context.Context, eventID, ownerID string) error {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return domain.ErrForbidden
+	}
+	return nil
+}
+
+func validDocumentKind(kind string) error {
+	if !slices.Contains(domain.DocumentKinds, kind) {
+		return fmt.Errorf("kind must be one of %s: %w", strings.Join(domain.DocumentKinds, ", "), domain.ErrInvalidInput)
+	}
+	return nil
+}
+
+// pendingDocuments returns the current documents of the event userID has accepted neither before nor
+// in accepted, and accepting, the versions in accepted that are current and not recorded yet. It
+// records nothing: the caller passes accepting to acceptDocuments once it goes ahead, so a refused
+// registration leaves no acceptances behind. A nil repo asks for none.
+func pendingDocuments(ctx context.Context, repo domain.EventDocumentRepository, eventID, userID string, accepted []domain.DocumentVersion) (pending []*domain.EventDocument, accepting []domain.DocumentVersion, err error) {
+	pending = []*domain.EventDocument{}
+	if repo == nil {
+		return pending, nil, nil
+	}
+	docs, err := repo.ListCurrent(ctx, eventID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list documents: %w", err)
+	}
+	if len(docs) == 0 {
+		return pending, nil, nil
+	}
+	acceptances, err := repo.ListAcceptances(ctx, eventID, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list acceptances: %w", err)
+	}
+	for _, doc := range docs {
+		current := domain.DocumentVersion{Kind: doc.Kind, Version: doc.Version}
+		switch {
+		case slices.ContainsFunc(acceptances, func(a *domain.DocumentAcceptance) bool { return a.Kind == doc.Kind && a.Version == doc.Version }):
+		case slices.Contains(accepted, current):
+			accepting = append(accepting, current)
+		default:
+			pending = append(pending, doc)
+		}
+	}
+	return pending, accepting, nil
+}
+
+// acceptDocuments records that userID accepts versions, as returned by pendingDocuments. A nil repo
+// records nothing.
+func acceptDocuments(ctx context.Context, repo domain.EventDocumentRepository, eventID, userID string, versions []domain.DocumentVersion) error {
+	if repo == nil || len(versions) == 0 {
+		return nil
+	}
+	if err := repo.Accept(ctx, eventID, userID, versions); err != nil {
+		return fmt.Errorf("accept documents: %w", err)
+	}
+	return nil
+}
+
+// documentsNotAccepted returns the error for registering without accepting pending, naming the
+// versions to accept.
+func documentsNotAccepted(pending []*domain.EventDocument) error {
+	names := make([]string, len(pending))
+	for i, doc := range pending {
+		names[i] = fmt.Sprintf("%s version %d", doc.Kind, doc.Version)
+	}
+	return fmt.Errorf("accept %s first: %w", strings.Join(names, ", "), domain.ErrDocumentsNotAccepted)
+}
@@ -0,0 +1,298 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeEventDocumentRepo is an in-memory EventDocumentRepository of a single event.
+type fakeEventDocumentRepo struct {
+	versions    []*domain.EventDocument
+	withdrawn   map[string]bool
+	acceptances []*domain.DocumentAcceptance
+	// attendees are the user IDs registered for the event, for ListAttendeeAcceptances.
+	attendees []string
+}
+
+func newFakeEventDocumentRepo() *fakeEventDocumentRepo {
+	return &fakeEventDocumentRepo{withdrawn: make(map[string]bool)}
+}
+
+func (f *fakeEventDocumentRepo) Publish(ctx context.Context, doc *domain.EventDocument) error {
+	doc.Version = len(f.kindVersions(doc.Kind)) + 1
+	doc.PublishedAt = time.Now()
+	f.versions = append(f.versions, doc)
+	f.withdrawn[doc.Kind] = false
+	return nil
+}
+
+func (f *fakeEventDocumentRepo) ListCurrent(ctx context.Context, eventID string) ([]*domain.EventDocument, error) {
+	out := []*domain.EventDocument{}
+	for _, kind := range domain.DocumentKinds {
+		if versions := f.kindVersions(kind); len(versions) > 0 && !f.withdrawn[kind] {
+			out = append(out, versions[0])
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeEventDocumentRepo) ListVersions(ctx context.Context, eventID, kind string) ([]*domain.EventDocument, error) {
+	return f.kindVersions(kind), nil
+}
+
+// kindVersions returns the versions of kind, newest first.
+func (f *fakeEventDocumentRepo) kindVersions(kind string) []*domain.EventDocument {
+	var out []*domain.EventDocument
+	for _, doc := range f.versions {
+		if doc.Kind == kind {
+			out = append([]*domain.EventDocument{doc}, out...)
+		}
+	}
+	return out
+}
+
+func (f *fakeEventDocumentRepo) Withdraw(ctx context.Context, eventID, kind string) error {
+	if len(f.kindVersions(kind)) == 0 || f.withdrawn[kind] {
+		return domain.ErrNotFound
+	}
+	f.withdrawn[kind] = true
+	return nil
+}
+
+func (f *fakeEventDocumentRepo) Accept(ctx context.Context, eventID, userID string, versions []domain.DocumentVersion) error {
+	for _, v := range versions {
+		if !slices.ContainsFunc(f.acceptances, func(a *domain.DocumentAcceptance) bool {
+			return a.UserID == userID && a.Kind == v.Kind && a.Version == v.Version
+		}) {
+			f.acceptances = append(f.acceptances, &domain.DocumentAcceptance{EventID: eventID, Kind: v.Kind, Version: v.Version, UserID: userID, AcceptedAt: time.Now()})
+		}
+	}
+	return nil
+}
+
+func (f *fakeEventDocumentRepo) ListAcceptances(ctx context.Context, eventID, userID string) ([]*domain.DocumentAcceptance, error) {
+	latest := make(map[string]*domain.DocumentAcceptance)
+	for _, a := range f.acceptances {
+		if a.UserID == userID && (latest[a.Kind] == nil || a.Version > latest[a.Kind].Version) {
+			latest[a.Kind] = a
+		}
+	}
+	out := []*domain.DocumentAcceptance{}
+	for _, kind := range domain.DocumentKinds {
+		if a := latest[kind]; a != nil {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeEventDocumentRepo) ListAttendeeAcceptances(ctx context.Context, eventID, kind string) ([]*domain.AttendeeAcceptance, error) {
+	out := []*domain.AttendeeAcceptance{}
+	for _, userID := range f.attendees {
+		aa := &domain.AttendeeAcceptance{UserID: userID}
+		accepted, _ := f.ListAcceptances(ctx, eventID, userID)
+		for _, a := range accepted {
+			if a.Kind == kind {
+				aa.AcceptedVersion, aa.AcceptedAt = a.Version, &a.AcceptedAt
+			}
+		}
+		out = append(out, aa)
+	}
+	return out, nil
+}
+
+func TestEventDocumentService_PublishDocument(t *testing.T) {
+	ctx := context.Background()
+	events := newFakeEventRepo()
+	require.NoError(t, events.Create(ctx, &domain.Event{Name: "Conf", OwnerID: "owner"}))
+	docs := newFakeEventDocumentRepo()
+	svc := NewEventDocumentService(events, docs, 5*time.Second)
+
+	doc, published, err := svc.PublishDocument(ctx, "ev-1", "owner", domain.DocumentKindCodeOfConduct, " Code of conduct ", "Be kind.")
+	require.NoError(t, err)
+	assert.True(t, published)
+	assert.Equal(t, 1, doc.Version)
+	assert.Equal(t, "Code of conduct", doc.Title)
+
+	doc, published, err = svc.PublishDocument(ctx, "ev-1", "owner", domain.DocumentKindCodeOfConduct, "Code of conduct", "Be kind.")
+	require.NoError(t, err)
+	assert.False(t, published, "unchanged text keeps the version")
+	assert.Equal(t, 1, doc.Version)
+
+	doc, published, err = svc.PublishDocument(ctx, "ev-1", "owner", domain.DocumentKindCodeOfConduct, "Code of conduct", "Be kind. No harassment.")
+	require.NoError(t, err)
+	assert.True(t, published)
+	assert.Equal(t, 2, doc.Version)
+
+	versions, err := svc.ListDocumentVersions(ctx, "ev-1", "owner", domain.DocumentKindCodeOfConduct)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, 2, versions[0].Version)
+
+	_, err = svc.ListDocumentVersions(ctx, "ev-1", "owner", domain.DocumentKindTerms)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	_, _, err = svc.PublishDocument(ctx, "ev-1", "owner", "privacy", "Privacy", "We keep nothing.")
+	require.ErrorIs(t, err, domain.ErrInvalidInput)
+	_, _, err = svc.PublishDocument(ctx, "ev-1", "owner", domain.DocumentKindTerms, "Terms", "  ")
+	require.ErrorIs(t, err, domain.ErrInvalidInput)
+	_, _, err = svc.PublishDocument(ctx, "ev-1", "someone", domain.DocumentKindTerms, "Terms", "No refunds.")
+	require.ErrorIs(t, err, domain.ErrForbidden)
+	_, _, err = svc.PublishDocument(ctx, "ev-9", "owner", domain.DocumentKindTerms, "Terms", "No refunds.")
+	require.ErrorIs(t, err, domain.ErrNotFound)
+
+	require.NoError(t, svc.WithdrawDocument(ctx, "ev-1", "owner", domain.DocumentKindCodeOfConduct))
+	current, err := svc.ListDocuments(ctx, "ev-1", "owner")
+	require.NoError(t, err)
+	assert.Empty(t, current)
+	require.ErrorIs(t, svc.WithdrawDocument(ctx, "ev-1", "owner", domain.DocumentKindCodeOfConduct), domain.ErrNotFound)
+}
+
+func TestEventDocumentService_AcceptAndReport(t *testing.T) {
+	ctx := context.Background()
+	events := newFakeEventRepo()
+	require.NoError(t, events.Create(ctx, &domain.Event{Name: "Conf", OwnerID: "owner"}))
+	docs := newFakeEventDocumentRepo()
+	docs.attendees = []string{"ada", "bob", "cy"}
+	svc := NewEventDocumentService(events, docs, 5*time.Second)
+	_, _, err := svc.PublishDocument(ctx, "ev-1", "owner", domain.DocumentKindCodeOfConduct, "Code of conduct", "Be kind.")
+	require.NoError(t, err)
+
+	mine, err := svc.AcceptDocuments(ctx, "ev-1", "bob", []domain.DocumentVersion{{Kind: domain.DocumentKindCodeOfConduct, Version: 1}})
+	require.NoError(t, err)
+	require.Len(t, mine, 1)
+	assert.False(t, mine[0].NeedsAcceptance)
+
+	_, _, err = svc.PublishDocument(ctx, "ev-1", "owner", domain.DocumentKindCodeOfConduct, "Code of conduct", "Be kind. No harassment.")
+	require.NoError(t, err)
+	_, err = svc.AcceptDocuments(ctx, "ev-1", "ada", []domain.DocumentVersion{{Kind: domain.DocumentKindCodeOfConduct, Version: 1}})
+	require.ErrorIs(t, err, domain.ErrInvalidInput, "only the current version can be accepted")
+	_, err = svc.AcceptDocuments(ctx, "ev-1", "ada", []domain.DocumentVersion{{Kind: domain.DocumentKindTerms, Version: 1}})
+	require.ErrorIs(t, err, domain.ErrInvalidInput)
+	_, err = svc.AcceptDocuments(ctx, "ev-1", "ada", []domain.DocumentVersion{{Kind: domain.DocumentKindCodeOfConduct, Version: 2}})
+	require.NoError(t, err)
+
+	mine, err = svc.ListMyDocuments(ctx, "ev-1", "bob")
+	require.NoError(t, err)
+	require.Len(t, mine, 1)
+	assert.True(t, mine[0].NeedsAcceptance, "the document changed since bob accepted it")
+	assert.Equal(t, 1, mine[0].AcceptedVersion)
+
+	report, err := svc.GetAcceptanceReport(ctx, "ev-1", "owner", domain.DocumentKindCodeOfConduct)
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.Version)
+	assert.Equal(t, 3, report.Registered)
+	assert.Equal(t, 1, report.AcceptedCurrent)
+	assert.Equal(t, 1, report.AcceptedOlder)
+	assert.Equal(t, 1, report.NeverAccepted)
+
+	_, err = svc.GetAcceptanceReport(ctx, "ev-1", "owner", domain.DocumentKindPhotoPolicy)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	_, err = svc.GetAcceptanceReport(ctx, "ev-1", "bob", domain.DocumentKindCodeOfConduct)
+	require.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = svc.ListMyDocuments(ctx, "ev-9", "bob")
+	require.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestAttendeeService_RegistrationNeedsDocuments(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	event := &domain.Event{ID: "e1", EventCode: "ab12", OwnerID: "owner1"}
+	events := &mockEventRepository{events: map[string]*domain.Event{"e1": event}, eventsByCode: map[string]*domain.Event{"ab12": event}}
+	regs := &mockEventRegistrationRepository{regByEventAndUser: map[string]*domain.EventRegistration{
+		"e1:u2": {ID: "reg-2", EventID: "e1", UserID: "u2", CreatedAt: now, UpdatedAt: now},
+	}}
+	docs := newFakeEventDocumentRepo()
+	coc := &domain.EventDocument{EventID: "e1", Kind: domain.DocumentKindCodeOfConduct, Title: "Code of conduct", Body: "Be kind."}
+	require.NoError(t, docs.Publish(ctx, coc))
+	svc := &attendeeService{eventRepo: events, registrationRepo: regs, sessionRepo: &mockSessionRepository{}, operatingHoursRepo: &mockOperatingHoursRepository{}, customFieldRepo: newFakeCustomFieldRepo(), occupancyRepo: newFakeRoomOccupancyRepo(), documentRepo: docs}
+
+	_, err := svc.JoinEventByCode(ctx, "ab12", "u1", nil)
+	require.ErrorIs(t, err, domain.ErrDocumentsNotAccepted)
+	assert.Contains(t, err.Error(), "code_of_conduct version 1")
+	_, _, err = svc.RegisterForEventByCode(ctx, "ab12", "u1", []domain.DocumentVersion{{Kind: domain.DocumentKindCodeOfConduct, Version: 9}})
+	require.ErrorIs(t, err, domain.ErrDocumentsNotAccepted, "an old or unknown version does not count")
+	_, _, err = svc.RegisterForEvent(ctx, "e1", "u1")
+	require.ErrorIs(t, err, domain.ErrDocumentsNotAccepted)
+
+	join, err := svc.JoinEventByCode(ctx, "ab12", "u1", []domain.DocumentVersion{{Kind: domain.DocumentKindCodeOfConduct, Version: 1}})
+	require.NoError(t, err)
+	assert.True(t, join.Joined)
+	assert.Empty(t, join.PendingDocuments)
+	accepted, _ := docs.ListAcceptances(ctx, "e1", "u1")
+	require.Len(t, accepted, 1, "the acceptance is recorded with the registration")
+
+	join, err = svc.JoinEventByCode(ctx, "ab12", "u2", nil)
+	require.NoError(t, err, "attendees registered before the document keep their place")
+	assert.False(t, join.Joined)
+	require.Len(t, join.PendingDocuments, 1, "and are asked to accept it")
+	assert.Equal(t, 1, join.PendingDocuments[0].Version)
+
+	join, err = svc.JoinEventByCode(ctx, "ab12", "u2", []domain.DocumentVersion{{Kind: domain.DocumentKindCodeOfConduct, Version: 1}})
+	require.NoError(t, err)
+	assert.Empty(t, join.PendingDocuments)
+
+	public, err := svc.GetPublicEventDocuments(ctx, " AB12 ")
+	require.NoError(t, err)
+	assert.Equal(t, "e1", public.EventID)
+	require.Len(t, public.Documents, 1)
+	_, err = svc.GetPublicEventDocuments(ctx, "zz99")
+	require.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestAttendeeService_RefusedRegistrationRecordsNoAcceptance(t *testing.T) {
+	ctx := context.Background()
+	event := &domain.Event{ID: "e1", EventCode: "ab12", OwnerID: "owner1"}
+	events := &mockEventRepository{events: map[string]*domain.Event{"e1": event}, eventsByCode: map[string]*domain.Event{"ab12": event}}
+	docs := newFakeEventDocumentRepo()
+	require.NoError(t, docs.Publish(ctx, &domain.EventDocument{EventID: "e1", Kind: domain.DocumentKindCodeOfConduct, Title: "Code of conduct", Body: "Be kind."}))
+	require.NoError(t, docs.Publish(ctx, &domain.EventDocument{EventID: "e1", Kind: domain.DocumentKindPhotoPolicy, Title: "Photos", Body: "We take photos."}))
+	abuse := newFakeAbuseRepo()
+	svc := &attendeeService{eventRepo: events, registrationRepo: &mockEventRegistrationRepository{}, sessionRepo: &mockSessionRepository{}, operatingHoursRepo: &mockOperatingHoursRepository{}, customFieldRepo: newFakeCustomFieldRepo(), occupancyRepo: newFakeRoomOccupancyRepo(), documentRepo: docs, abuseRepo: abuse}
+	coc := domain.DocumentVersion{Kind: domain.DocumentKindCodeOfConduct, Version: 1}
+	photos := domain.DocumentVersion{Kind: domain.DocumentKindPhotoPolicy, Version: 1}
+
+	_, _, err := svc.RegisterForEventByCode(ctx, "ab12", "u1", []domain.DocumentVersion{coc})
+	require.ErrorIs(t, err, domain.ErrDocumentsNotAccepted, "the photo policy is still pending")
+	_, err = svc.JoinEventByCode(ctx, "ab12", "u1", []domain.DocumentVersion{coc})
+	require.ErrorIs(t, err, domain.ErrDocumentsNotAccepted)
+	accepted, _ := docs.ListAcceptances(ctx, "e1", "u1")
+	assert.Empty(t, accepted, "a refused registration records no consent")
+
+	abuse.unlisted[domain.AbuseTarget{Type: domain.AbuseTargetEvent, ID: "e1"}] = "e1"
+	_, err = svc.JoinEventByCode(ctx, "ab12", "u1", []domain.DocumentVersion{coc, photos})
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	accepted, _ = docs.ListAcceptances(ctx, "e1", "u1")
+	assert.Empty(t, accepted, "nor does a join of an unlisted event")
+}
+
+func TestAttendeeService_FailedRegistrationRecordsNoAcceptance(t *testing.T) {
+	ctx := context.Background()
+	event := &domain.Event{ID: "e1", EventCode: "ab12", OwnerID: "owner1"}
+	events := &mockEventRepository{events: map[string]*domain.Event{"e1": event}, eventsByCode: map[string]*domain.Event{"ab12": event}}
+	docs := newFakeEventDocumentRepo()
+	require.NoError(t, docs.Publish(ctx, &domain.EventDocument{EventID: "e1", Kind: domain.DocumentKindCodeOfConduct, Title: "Code of conduct", Body: "Be kind."}))
+	regs := &mockEventRegistrationRepository{createErr: errors.New("duplicate key value violates unique constraint")}
+	svc := &attendeeService{eventRepo: events, registrationRepo: regs, sessionRepo: &mockSessionRepository{}, operatingHoursRepo: &mockOperatingHoursRepository{}, customFieldRepo: newFakeCustomFieldRepo(), occupancyRepo: newFakeRoomOccupancyRepo(), documentRepo: docs, abuseRepo: newFakeAbuseRepo()}
+	coc := domain.DocumentVersion{Kind: domain.DocumentKindCodeOfConduct, Version: 1}
+
+	_, _, err := svc.RegisterForEventByCode(ctx, "ab12", "u1", []domain.DocumentVersion{coc})
+	require.Error(t, err)
+	_, err = svc.JoinEventByCode(ctx, "ab12", "u1", []domain.DocumentVersion{coc})
+	require.Error(t, err)
+	accepted, _ := docs.ListAcceptances(ctx, "e1", "u1")
+	assert.Empty(t, accepted, "a registration that was not stored records no consent")
+
+	regs.createErr = nil
+	_, _, err = svc.RegisterForEventByCode(ctx, "ab12", "u1", []domain.DocumentVersion{coc})
+	require.NoError(t, err)
+	accepted, _ = docs.ListAcceptances(ctx, "e1", "u1")
+	assert.Len(t, accepted, 1, "recorded once the registration is stored")
+}
@@ -2,8 +2,10 @@ package services
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -19,6 +21,21 @@ type fakeEventRepo struct {
 	byID   map[string]*domain.Event
 	nextID int
 	err    error // if set, Create returns this error
+	// searchErr is returned by Search; lastSearch records the params it was called with.
+	searchErr  error
+	lastSearch *domain.EventSearchParams
+	// registrations is returned by CountRegistrations, keyed by event ID.
+	registrations map[string]int
+	// stats is returned by ListStats, keyed by event ID.
+	stats map[string]*domain.EventStats
+	// teamEvents lists the event IDs each user is a team member of, for ListByOwnerOrTeamMemberID.
+	teamEvents map[string][]string
+	// anonymized records the events Anonymize was called for, keyed by event ID.
+	anonymized map[string]*domain.EventAnonymization
+	// themes holds the saved themes by event ID.
+	themes map[string]*domain.EventTheme
+	// codeCollisions is how many more new event codes are refused as taken.
+	codeCollisions int
 }
 
 func newFakeEventRepo() *fakeEventRepo {
@@ -32,6 +49,10 @@ func (f *fakeEventRepo) Create(ctx context.Context, e *domain.Event) error {
 	if f.err != nil {
 		return f.err
 	}
+	if f.codeCollisions > 0 {
+		f.codeCollisions--
+		return domain.ErrDuplicateEventCode
+	}
 	e.ID = fmt.Sprintf("ev-%d", f.nextID)
 	f.nextID++
 	f.byID[e.ID] = e
@@ -73,6 +94,75 @@ func (f *fakeEventRepo) ListByOwnerID(ctx context.Context, ownerID string) ([]*d
 	return out, nil
 }
 
+func (f *fakeEventRepo) ListByOwnerOrTeamMemberID(ctx context.Context, userID string) ([]*domain.Event, error) {
+	out, _ := f.ListByOwnerID(ctx, userID)
+	for _, id := range f.teamEvents[userID] {
+		out = append(out, f.byID[id])
+	}
+	return out, nil
+}
+
+func (f *fakeEventRepo) ListByTeamMemberID(ctx context.Context, userID string) ([]*domain.Event, error) {
+	return []*domain.Event{}, nil
+}
+
+func (f *fakeEventRepo) Search(ctx context.Context, userID string, params domain.EventSearchParams) ([]*domain.EventSearchResult, int, error) {
+	f.lastSearch = &params
+	if f.searchErr != nil {
+		return nil, 0, f.searchErr
+	}
+	return []*domain.EventSearchResult{}, 0, nil
+}
+
+func (f *fakeEventRepo) ListStats(ctx context.Context, eventIDs []string) (map[string]*domain.EventStats, error) {
+	out := make(map[string]*domain.EventStats)
+	for _, id := range eventIDs {
+		if st, ok := f.stats[id]; ok {
+			out[id] = st
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeEventRepo) Anonymize(ctx context.Context, eventID, anonymizedBy string) (*domain.EventAnonymization, error) {
+	if f.anonymized == nil {
+		f.anonymized = make(map[string]*domain.EventAnonymization)
+	}
+	if a, ok := f.anonymized[eventID]; ok {
+		return a, nil
+	}
+	a := &domain.EventAnonymization{EventID: eventID, AnonymizedAt: time.Now(), AnonymizedBy: anonymizedBy, Registrations: f.registrations[eventID]}
+	f.anonymized[eventID] = a
+	return a, nil
+}
+
+func (f *fakeEventRepo) PreviewAnonymize(ctx context.Context, eventID, anonymizedBy string) (*domain.EventAnonymization, error) {
+	if a, ok := f.anonymized[eventID]; ok {
+		return a, nil
+	}
+	return &domain.EventAnonymization{EventID: eventID, AnonymizedAt: time.Now(), AnonymizedBy: anonymizedBy, Registrations: f.registrations[eventID], DryRun: true}, nil
+}
+
+func (f *fakeEventRepo) GetTheme(ctx context.Context, eventID string) (*domain.EventTheme, error) {
+	if t, ok := f.themes[eventID]; ok {
+		return t, nil
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (f *fakeEventRepo) UpsertTheme(ctx context.Context, theme *domain.EventTheme) error {
+	if f.themes == nil {
+		f.themes = make(map[string]*domain.EventTheme)
+	}
+	theme.UpdatedAt = time.Now()
+	f.themes[theme.EventID] = theme
+	return nil
+}
+
+func (f *fakeEventRepo) CountRegistrations(ctx context.Context, eventID string) (int, error) {
+	return f.registrations[eventID], nil
+}
+
 func (f *fakeEventRepo) Delete(ctx context.Context, id string) error {
 	if _, ok := f.byID[id]; !ok {
 		return domain.ErrNotFound
@@ -101,12 +191,26 @@ func (f *fakeEventRepo) Update(ctx context.Context, eventID string, date *time.T
 	return e, nil
 }
 
+func (f *fakeEventRepo) UpdateEventCode(ctx context.Context, eventID, code string) (*domain.Event, error) {
+	e, ok := f.byID[eventID]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	if f.codeCollisions > 0 {
+		f.codeCollisions--
+		return nil, domain.ErrDuplicateEventCode
+	}
+	e.EventCode = code
+	return e, nil
+}
+
 // fakeSessionRepo is an in-memory SessionRepository for tests.
 type fakeSessionRepo struct {
 	rooms                []*domain.Room
 	sessions             []*domain.Session
 	speakers             []*domain.Speaker
 	sessionSpeakers      []struct{ sessionID, speakerID string }
+	trackOrder           map[string][]string // event ID -> tracks given to ReorderTracks
 	roomID               int
 	sessID               int
 	speakerID            int
@@ -130,10 +234,19 @@ func newFakeSessionRepo() *fakeSessionRepo {
 	}
 }
 
+// CreateRoom, CreateSession and CreateSpeaker upsert on the same keys as the Postgres repository,
+// so re-imports keep IDs. Manual rooms all share source ID 0 and are always inserted.
 func (f *fakeSessionRepo) CreateRoom(ctx context.Context, r *domain.Room) error {
 	if f.createRoomErr != nil {
 		return f.createRoomErr
 	}
+	for _, existing := range f.rooms {
+		if r.Source == "sessionize" && existing.EventID == r.EventID && existing.SourceSessionID == r.SourceSessionID {
+			r.ID, r.CreatedAt = existing.ID, existing.CreatedAt
+			*existing = *r
+			return nil
+		}
+	}
 	r.ID = fmt.Sprintf("room-%d", f.roomID)
 	f.roomID++
 	f.rooms = append(f.rooms, r)
@@ -144,6 +257,13 @@ func (f *fakeSessionRepo) CreateSession(ctx context.Context, s *domain.Session)
 	if f.createSessionErr != nil {
 		return f.createSessionErr
 	}
+	for _, existing := range f.sessions {
+		if existing.EventID == s.EventID && existing.SourceSessionID == s.SourceSessionID {
+			s.ID, s.CreatedAt = existing.ID, existing.CreatedAt
+			*existing = *s
+			return nil
+		}
+	}
 	s.ID = fmt.Sprintf("sess-%d", f.sessID)
 	f.sessID++
 	f.sessions = append(f.sessions, s)
@@ -154,6 +274,13 @@ func (f *fakeSessionRepo) CreateSpeaker(ctx context.Context, sp *domain.Speaker)
 	if f.createSpeakerErr != nil {
 		return f.createSpeakerErr
 	}
+	for _, existing := range f.speakers {
+		if existing.EventID == sp.EventID && existing.SourceSessionID == sp.SourceSessionID {
+			sp.ID, sp.CreatedAt, sp.Email = existing.ID, existing.CreatedAt, existing.Email
+			*existing = *sp
+			return nil
+		}
+	}
 	sp.ID = fmt.Sprintf("sp-%d", f.speakerID)
 	f.speakerID++
 	f.speakers = append(f.speakers, sp)
@@ -176,23 +303,46 @@ func (f *fakeSessionRepo) DeleteSessionSpeaker(ctx context.Context, sessionID, s
 	return nil
 }
 
-func (f *fakeSessionRepo) DeleteScheduleByEventID(ctx context.Context, eventID string) error {
+func (f *fakeSessionRepo) SetSessionSpeakers(ctx context.Context, sessionID string, speakerIDs []string) error {
+	var kept []struct{ sessionID, speakerID string }
+	for _, ss := range f.sessionSpeakers {
+		if ss.sessionID != sessionID {
+			kept = append(kept, ss)
+		}
+	}
+	linked := make(map[string]bool)
+	for _, id := range speakerIDs {
+		if !linked[id] {
+			linked[id] = true
+			kept = append(kept, struct{ sessionID, speakerID string }{sessionID, id})
+		}
+	}
+	f.sessionSpeakers = kept
+	return nil
+}
+
+func (f *fakeSessionRepo) PruneScheduleByEventID(ctx context.Context, eventID string, keepRoomIDs, keepSessionIDs []string) error {
 	if f.deleteErr != nil {
 		return f.deleteErr
 	}
-	roomIDsForEvent := make(map[string]bool)
+	keepRooms := make(map[string]bool)
+	for _, id := range keepRoomIDs {
+		keepRooms[id] = true
+	}
+	keepSessions := make(map[string]bool)
+	for _, id := range keepSessionIDs {
+		keepSessions[id] = true
+	}
 	var rooms []*domain.Room
 	for _, r := range f.rooms {
-		if r.EventID == eventID {
-			roomIDsForEvent[r.ID] = true
-		} else {
+		if r.EventID != eventID || keepRooms[r.ID] {
 			rooms = append(rooms, r)
 		}
 	}
 	f.rooms = rooms
 	var sessions []*domain.Session
 	for _, s := range f.sessions {
-		if !roomIDsForEvent[s.RoomID] {
+		if s.EventID != eventID || (keepSessions[s.ID] && (s.RoomID == "" || keepRooms[s.RoomID])) {
 			sessions = append(sessions, s)
 		}
 	}
@@ -200,13 +350,17 @@ func (f *fakeSessionRepo) DeleteScheduleByEventID(ctx context.Context, eventID s
 	return nil
 }
 
-func (f *fakeSessionRepo) DeleteSpeakersByEventID(ctx context.Context, eventID string) error {
+func (f *fakeSessionRepo) PruneSpeakersByEventID(ctx context.Context, eventID string, keepSpeakerIDs []string) error {
 	if f.deleteErr != nil {
 		return f.deleteErr
 	}
+	keep := make(map[string]bool)
+	for _, id := range keepSpeakerIDs {
+		keep[id] = true
+	}
 	var speakers []*domain.Speaker
 	for _, sp := range f.speakers {
-		if sp.EventID != eventID {
+		if sp.EventID != eventID || keep[sp.ID] {
 			speakers = append(speakers, sp)
 		}
 	}
@@ -226,6 +380,78 @@ func (f *fakeSessionRepo) DeleteSpeakersByEventID(ctx context.Context, eventID s
 	return nil
 }
 
+func (f *fakeSessionRepo) UpdateSpeakerSource(ctx context.Context, speakerID, source, sourceSessionID string) error {
+	for _, sp := range f.speakers {
+		if sp.ID == speakerID {
+			sp.Source, sp.SourceSessionID = source, sourceSessionID
+			return nil
+		}
+	}
+	return domain.ErrNotFound
+}
+
+func (f *fakeSessionRepo) MergeSpeakers(ctx context.Context, keepID, dropID string) error {
+	var keep, drop *domain.Speaker
+	for _, sp := range f.speakers {
+		switch sp.ID {
+		case keepID:
+			keep = sp
+		case dropID:
+			drop = sp
+		}
+	}
+	if keep == nil || drop == nil {
+		return domain.ErrNotFound
+	}
+	for _, ss := range f.sessionSpeakers {
+		if ss.speakerID == dropID {
+			_ = f.CreateSessionSpeaker(ctx, ss.sessionID, keepID)
+		}
+	}
+	email := keep.Email
+	if email == "" {
+		email = drop.Email
+	}
+	id, createdAt := keep.ID, keep.CreatedAt
+	*keep = *drop
+	keep.ID, keep.CreatedAt, keep.Email = id, createdAt, email
+	return f.DeleteSpeaker(ctx, dropID)
+}
+
+// UpdateSpeakers checks every speaker exists before changing any, like the transaction does.
+func (f *fakeSessionRepo) UpdateSpeakers(ctx context.Context, updates []*domain.SpeakerUpdate) ([]*domain.Speaker, error) {
+	targets := make([]*domain.Speaker, len(updates))
+	for i, u := range updates {
+		sp, err := f.GetSpeakerByID(ctx, u.SpeakerID)
+		if err != nil {
+			return nil, err
+		}
+		targets[i] = sp
+	}
+	set := func(dst *string, src *string) {
+		if src != nil {
+			*dst = *src
+		}
+	}
+	out := make([]*domain.Speaker, len(updates))
+	for i, u := range updates {
+		sp := targets[i]
+		set(&sp.FirstName, u.FirstName)
+		set(&sp.LastName, u.LastName)
+		set(&sp.Email, u.Email)
+		set(&sp.Bio, u.Bio)
+		set(&sp.TagLine, u.TagLine)
+		set(&sp.ProfilePicture, u.ProfilePicture)
+		if u.IsTopSpeaker != nil {
+			sp.IsTopSpeaker = *u.IsTopSpeaker
+		}
+		sp.UpdatedAt = time.Now()
+		cp := *sp
+		out[i] = &cp
+	}
+	return out, nil
+}
+
 func (f *fakeSessionRepo) GetRoomByID(ctx context.Context, roomID string) (*domain.Room, error) {
 	for _, r := range f.rooms {
 		if r.ID == roomID {
@@ -248,6 +474,28 @@ func (f *fakeSessionRepo) ListRoomsByEventID(ctx context.Context, eventID string
 	return out, nil
 }
 
+func (f *fakeSessionRepo) ReorderRooms(ctx context.Context, eventID string, roomIDs []string) error {
+	for _, r := range f.rooms {
+		if i := slices.Index(roomIDs, r.ID); i >= 0 && r.EventID == eventID {
+			r.DisplayOrder = i + 1
+		}
+	}
+	slices.SortStableFunc(f.rooms, func(a, b *domain.Room) int { return a.DisplayOrder - b.DisplayOrder })
+	return nil
+}
+
+func (f *fakeSessionRepo) ListTrackOrder(ctx context.Context, eventID string) ([]string, error) {
+	return f.trackOrder[eventID], nil
+}
+
+func (f *fakeSessionRepo) ReorderTracks(ctx context.Context, eventID string, tracks []string) error {
+	if f.trackOrder == nil {
+		f.trackOrder = make(map[string][]string)
+	}
+	f.trackOrder[eventID] = tracks
+	return nil
+}
+
 func (f *fakeSessionRepo) SetRoomNotBookable(ctx context.Context, roomID string, notBookable bool) (*domain.Room, error) {
 	for _, r := range f.rooms {
 		if r.ID == roomID {
@@ -282,6 +530,35 @@ func (f *fakeSessionRepo) DeleteRoom(ctx context.Context, roomID string) error {
 	for i, r := range f.rooms {
 		if r.ID == roomID {
 			f.rooms = append(f.rooms[:i], f.rooms[i+1:]...)
+			var sessions []*domain.Session
+			for _, s := range f.sessions {
+				if s.RoomID != roomID {
+					sessions = append(sessions, s)
+				}
+			}
+			f.sessions = sessions
+			return nil
+		}
+	}
+	return domain.ErrNotFound
+}
+
+func (f *fakeSessionRepo) DeleteRoomKeepSessions(ctx context.Context, roomID string, targetRoomID *string) error {
+	if f.deleteRoomErr != nil {
+		return f.deleteRoomErr
+	}
+	for i, r := range f.rooms {
+		if r.ID == roomID {
+			f.rooms = append(f.rooms[:i], f.rooms[i+1:]...)
+			for _, s := range f.sessions {
+				if s.RoomID != roomID {
+					continue
+				}
+				s.RoomID = ""
+				if targetRoomID != nil {
+					s.RoomID = *targetRoomID
+				}
+			}
 			return nil
 		}
 	}
@@ -299,15 +576,9 @@ func (f *fakeSessionRepo) DeleteSession(ctx context.Context, sessionID string) e
 }
 
 func (f *fakeSessionRepo) ListSessionsByEventID(ctx context.Context, eventID string) ([]*domain.Session, error) {
-	roomIDs := make(map[string]bool)
-	for _, r := range f.rooms {
-		if r.EventID == eventID {
-			roomIDs[r.ID] = true
-		}
-	}
 	var out []*domain.Session
 	for _, s := range f.sessions {
-		if roomIDs[s.RoomID] {
+		if s.EventID == eventID {
 			out = append(out, s)
 		}
 	}
@@ -317,6 +588,19 @@ func (f *fakeSessionRepo) ListSessionsByEventID(ctx context.Context, eventID str
 	return out, nil
 }
 
+func (f *fakeSessionRepo) StreamSessionsByEventID(ctx context.Context, eventID string, fn func(*domain.Session) error) error {
+	sessions, err := f.ListSessionsByEventID(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	for _, s := range sessions {
+		if err := fn(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (f *fakeSessionRepo) ListSpeakerIDsBySessionIDs(ctx context.Context, sessionIDs []string) (map[string][]string, error) {
 	out := make(map[string][]string)
 	for _, sid := range sessionIDs {
@@ -594,19 +878,32 @@ func newTestEventService(eventRepo domain.EventRepository, sessionRepo domain.Se
 		newFakeEventTeamMemberRepo(),
 		newFakeUserRepoForSchedule(),
 		newFakeEventInvitationRepo(),
+		newFakeImportMappingRepo(),
+		newFakeSpeakerMergeRepo(),
+		newFakeIntegrityRepo(),
+		newFakeScheduleRulesRepo(),
+		newFakeOperatingHoursRepo(),
+		newFakeSessionChangeRepo(),
+		newFakeChecklistRepo(),
+		newFakeScheduleGridRepo(),
+		newFakeCustomFieldRepo(),
 		newFakeEmailService(),
 		fetcher,
+		SchedulePolicy{},
+		domain.DefaultEventCodeFormat,
 		timeout,
 	).(*eventService)
 }
 
 // fakeSessionizeFetcher returns fixed data or a configurable error.
 type fakeSessionizeFetcher struct {
-	data domain.SessionFetcherResponse
-	err  error
+	data             domain.SessionFetcherResponse
+	err              error
+	lastForceRefresh bool
 }
 
-func (f *fakeSessionizeFetcher) Fetch(ctx context.Context, sessionizeID string) (domain.SessionFetcherResponse, error) {
+func (f *fakeSessionizeFetcher) Fetch(ctx context.Context, sessionizeID string, forceRefresh bool) (domain.SessionFetcherResponse, error) {
+	f.lastForceRefresh = forceRefresh
 	if f.err != nil {
 		return domain.SessionFetcherResponse{}, f.err
 	}
@@ -704,99 +1001,663 @@ func (f *fakeUserRepoForSchedule) addUserWithName(email, id, name, lastName stri
 	f.byEmail[email] = &domain.User{ID: id, Email: email, Name: name, LastName: lastName}
 }
 
-// fakeEventInvitationRepo is an in-memory EventInvitationRepository for tests.
-type fakeEventInvitationRepo struct {
-	invitations []*domain.EventInvitation
-	nextID      int
-	createErr   error
+// fakeImportMappingRepo is an in-memory ImportMappingRepository for tests.
+type fakeImportMappingRepo struct {
+	byEventID map[string]*domain.ImportMapping
+	upserts   int
 }
 
-func newFakeEventInvitationRepo() *fakeEventInvitationRepo {
-	return &fakeEventInvitationRepo{
-		invitations: nil,
-		nextID:      1,
+func newFakeImportMappingRepo() *fakeImportMappingRepo {
+	return &fakeImportMappingRepo{byEventID: make(map[string]*domain.ImportMapping)}
+}
+
+func (f *fakeImportMappingRepo) GetByEventID(ctx context.Context, eventID string) (*domain.ImportMapping, error) {
+	m, ok := f.byEventID[eventID]
+	if !ok {
+		return nil, domain.ErrNotFound
 	}
+	return m, nil
 }
 
-func (f *fakeEventInvitationRepo) Create(ctx context.Context, inv *domain.EventInvitation) error {
-	if f.createErr != nil {
-		return f.createErr
+func (f *fakeImportMappingRepo) Upsert(ctx context.Context, mapping *domain.ImportMapping) error {
+	f.upserts++
+	mapping.UpdatedAt = time.Now()
+	f.byEventID[mapping.EventID] = mapping
+	return nil
+}
+
+// fakeScheduleRulesRepo is an in-memory ScheduleRulesRepository for tests.
+type fakeScheduleRulesRepo struct {
+	byEventID map[string]*domain.ScheduleRules
+}
+
+func newFakeScheduleRulesRepo() *fakeScheduleRulesRepo {
+	return &fakeScheduleRulesRepo{byEventID: make(map[string]*domain.ScheduleRules)}
+}
+
+func (f *fakeScheduleRulesRepo) GetByEventID(ctx context.Context, eventID string) (*domain.ScheduleRules, error) {
+	rules, ok := f.byEventID[eventID]
+	if !ok {
+		return nil, domain.ErrNotFound
 	}
-	for _, existing := range f.invitations {
-		if existing.EventID == inv.EventID && strings.ToLower(existing.Email) == strings.ToLower(inv.Email) {
-			return errors.New("duplicate key value violates unique constraint")
-		}
+	return rules, nil
+}
+
+func (f *fakeScheduleRulesRepo) Upsert(ctx context.Context, rules *domain.ScheduleRules) error {
+	rules.UpdatedAt = time.Now()
+	f.byEventID[rules.EventID] = rules
+	return nil
+}
+
+// fakeOperatingHoursRepo is an in-memory OperatingHoursRepository for tests.
+type fakeOperatingHoursRepo struct {
+	byEventID map[string][]*domain.OperatingDay
+}
+
+func newFakeOperatingHoursRepo() *fakeOperatingHoursRepo {
+	return &fakeOperatingHoursRepo{byEventID: make(map[string][]*domain.OperatingDay)}
+}
+
+func (f *fakeOperatingHoursRepo) ListByEventID(ctx context.Context, eventID string) ([]*domain.OperatingDay, error) {
+	days, ok := f.byEventID[eventID]
+	if !ok {
+		return []*domain.OperatingDay{}, nil
 	}
-	inv.ID = fmt.Sprintf("inv-%d", f.nextID)
-	f.nextID++
-	f.invitations = append(f.invitations, inv)
+	return days, nil
+}
+
+func (f *fakeOperatingHoursRepo) Replace(ctx context.Context, eventID string, days []*domain.OperatingDay) error {
+	f.byEventID[eventID] = days
 	return nil
 }
 
-func (f *fakeEventInvitationRepo) ListByEventID(ctx context.Context, eventID string, search string, params domain.PaginationParams) ([]*domain.EventInvitation, int, error) {
-	var out []*domain.EventInvitation
-	for _, inv := range f.invitations {
-		if inv.EventID != eventID {
-			continue
-		}
-		if search != "" && !strings.Contains(strings.ToLower(inv.Email), strings.ToLower(search)) {
-			continue
-		}
-		out = append(out, inv)
+// fakeSessionChangeRepo is an in-memory SessionChangeRepository for tests.
+type fakeSessionChangeRepo struct {
+	changes []*domain.SessionChange
+	deleted map[string]time.Time // session ID -> deleted at
+	err     error                // if set, Create returns this error
+}
+
+func newFakeSessionChangeRepo() *fakeSessionChangeRepo {
+	return &fakeSessionChangeRepo{}
+}
+
+func (f *fakeSessionChangeRepo) Create(ctx context.Context, change *domain.SessionChange) error {
+	if f.err != nil {
+		return f.err
 	}
-	if out == nil {
-		out = []*domain.EventInvitation{}
+	change.ID = fmt.Sprintf("change-%d", len(f.changes)+1)
+	change.ChangedAt = time.Now()
+	f.changes = append(f.changes, change)
+	return nil
+}
+
+func (f *fakeSessionChangeRepo) ListBySessionID(ctx context.Context, sessionID string) ([]*domain.SessionChange, error) {
+	out := []*domain.SessionChange{}
+	for _, c := range f.changes {
+		if c.SessionID == sessionID {
+			out = append(out, c)
+		}
 	}
-	total := len(out)
-	offset := params.Offset()
-	if offset > total {
-		offset = total
+	return out, nil
+}
+
+func (f *fakeSessionChangeRepo) ListByEventID(ctx context.Context, eventID string, since time.Time, limit int) ([]*domain.SessionChange, error) {
+	out := []*domain.SessionChange{}
+	for i := len(f.changes) - 1; i >= 0 && len(out) < limit; i-- {
+		if c := f.changes[i]; c.EventID == eventID && c.ChangedAt.After(since) {
+			out = append(out, c)
+		}
 	}
-	end := offset + params.PageSize
-	if end > total {
-		end = total
+	return out, nil
+}
+
+func (f *fakeSessionChangeRepo) ListAfter(ctx context.Context, eventID string, after time.Time) ([]*domain.SessionChange, error) {
+	out := []*domain.SessionChange{}
+	for _, c := range f.changes {
+		if c.EventID == eventID && c.ChangedAt.After(after) {
+			out = append(out, c)
+		}
 	}
-	page := out[offset:end]
-	if page == nil {
-		page = []*domain.EventInvitation{}
+	return out, nil
+}
+
+func (f *fakeSessionChangeRepo) ListDeletedSessionIDs(ctx context.Context, eventID string, after time.Time) ([]string, error) {
+	ids := []string{}
+	for id, at := range f.deleted {
+		if at.After(after) {
+			ids = append(ids, id)
+		}
 	}
-	return page, total, nil
+	slices.Sort(ids)
+	return ids, nil
 }
 
-// fakeEmailService is a test double for EmailService. Tracks SendEventInvitation calls; other methods no-op.
-type fakeEmailService struct {
-	sendEventInvitationErr error // if set, SendEventInvitation returns this
-	sentInvitations        []*domain.EventInvitationEmailData
+// fakeChecklistRepo is an in-memory ChecklistRepository for tests.
+type fakeChecklistRepo struct {
+	items       map[string][]*domain.ChecklistItem
+	completions []*domain.ChecklistCompletion
+	nextID      int
 }
 
-func newFakeEmailService() *fakeEmailService {
-	return &fakeEmailService{sentInvitations: []*domain.EventInvitationEmailData{}}
+func newFakeChecklistRepo() *fakeChecklistRepo {
+	return &fakeChecklistRepo{items: make(map[string][]*domain.ChecklistItem)}
 }
 
-func (f *fakeEmailService) SendWelcomeMessage(ctx context.Context, data *domain.WelcomeMessageEmailData) error {
-	return nil
+func (f *fakeChecklistRepo) ListItems(ctx context.Context, eventID string) ([]*domain.ChecklistItem, error) {
+	return append([]*domain.ChecklistItem{}, f.items[eventID]...), nil
 }
 
-func (f *fakeEmailService) SendLoginCode(ctx context.Context, data *domain.LoginCodeEmailData) error {
-	return nil
+func (f *fakeChecklistRepo) ReplaceItems(ctx context.Context, eventID string, labels []string) ([]*domain.ChecklistItem, error) {
+	var items []*domain.ChecklistItem
+	for i, label := range labels {
+		item := &domain.ChecklistItem{EventID: eventID, Label: label, Position: i}
+		for _, old := range f.items[eventID] {
+			if old.Label == label {
+				item.ID = old.ID
+			}
+		}
+		if item.ID == "" {
+			f.nextID++
+			item.ID = fmt.Sprintf("item-%d", f.nextID)
+		}
+		items = append(items, item)
+	}
+	// Completions of dropped items are left behind; ListCompletions only returns those of current items.
+	f.items[eventID] = items
+	return f.ListItems(ctx, eventID)
 }
 
-func (f *fakeEmailService) SendEventInvitation(ctx context.Context, data *domain.EventInvitationEmailData) error {
-	if f.sendEventInvitationErr != nil {
-		return f.sendEventInvitationErr
+func (f *fakeChecklistRepo) ListCompletions(ctx context.Context, eventID string) ([]*domain.ChecklistCompletion, error) {
+	out := []*domain.ChecklistCompletion{}
+	for _, c := range f.completions {
+		if slices.ContainsFunc(f.items[eventID], func(item *domain.ChecklistItem) bool { return item.ID == c.ItemID }) {
+			out = append(out, c)
+		}
 	}
-	f.sentInvitations = append(f.sentInvitations, data)
+	return out, nil
+}
+
+func (f *fakeChecklistRepo) SetCompletion(ctx context.Context, completion *domain.ChecklistCompletion) error {
+	for _, c := range f.completions {
+		if c.SessionID == completion.SessionID && c.ItemID == completion.ItemID {
+			return nil
+		}
+	}
+	f.completions = append(f.completions, completion)
 	return nil
 }
 
-// defaultSessionizeData returns a minimal valid Sessionize All API response for tests.
-func defaultSessionizeData() domain.SessionFetcherResponse {
-	return domain.SessionFetcherResponse{
-		Sessions: []domain.SessionFetcherSession{
-			{
-				ID:            "s1",
-				Title:         "Talk 1",
-				Description:   "A talk",
+func (f *fakeChecklistRepo) DeleteCompletion(ctx context.Context, sessionID, itemID string) error {
+	f.completions = slices.DeleteFunc(f.completions, func(c *domain.ChecklistCompletion) bool {
+		return c.SessionID == sessionID && c.ItemID == itemID
+	})
+	return nil
+}
+
+// fakeScheduleGridRepo is an in-memory ScheduleGridRepository for tests.
+type fakeScheduleGridRepo struct {
+	byEventID map[string]*domain.ScheduleGrid
+	saves     int
+	err       error // if set, Save returns this error
+}
+
+func newFakeScheduleGridRepo() *fakeScheduleGridRepo {
+	return &fakeScheduleGridRepo{byEventID: make(map[string]*domain.ScheduleGrid)}
+}
+
+func (f *fakeScheduleGridRepo) Get(ctx context.Context, eventID string) (*domain.ScheduleGrid, error) {
+	grid, ok := f.byEventID[eventID]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return grid, nil
+}
+
+func (f *fakeScheduleGridRepo) Save(ctx context.Context, grid *domain.ScheduleGrid) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.saves++
+	f.byEventID[grid.EventID] = grid
+	return nil
+}
+
+func (f *fakeScheduleGridRepo) Delete(ctx context.Context, eventID string) error {
+	delete(f.byEventID, eventID)
+	return nil
+}
+
+// fakeCustomFieldRepo is an in-memory CustomFieldRepository for tests.
+type fakeCustomFieldRepo struct {
+	fields []*domain.CustomField
+	// values holds the stored values by entity type and entity ID.
+	values map[string]map[string][]*domain.CustomFieldValue
+	nextID int
+}
+
+func newFakeCustomFieldRepo() *fakeCustomFieldRepo {
+	return &fakeCustomFieldRepo{values: map[string]map[string][]*domain.CustomFieldValue{
+		domain.CustomFieldSession: {},
+		domain.CustomFieldSpeaker: {},
+	}}
+}
+
+func (f *fakeCustomFieldRepo) field(fieldID string) *domain.CustomField {
+	for _, fd := range f.fields {
+		if fd.ID == fieldID {
+			return fd
+		}
+	}
+	return nil
+}
+
+func (f *fakeCustomFieldRepo) ListFields(ctx context.Context, eventID string) ([]*domain.CustomField, error) {
+	out := []*domain.CustomField{}
+	for _, fd := range f.fields {
+		if fd.EventID == eventID {
+			out = append(out, fd)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeCustomFieldRepo) GetField(ctx context.Context, fieldID string) (*domain.CustomField, error) {
+	if fd := f.field(fieldID); fd != nil {
+		return fd, nil
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (f *fakeCustomFieldRepo) CreateField(ctx context.Context, field *domain.CustomField) error {
+	for _, fd := range f.fields {
+		if fd.EventID == field.EventID && fd.AppliesTo == field.AppliesTo && fd.Name == field.Name {
+			return domain.ErrDuplicateCustomField
+		}
+	}
+	f.nextID++
+	field.ID = fmt.Sprintf("field-%d", f.nextID)
+	f.fields = append(f.fields, field)
+	return nil
+}
+
+func (f *fakeCustomFieldRepo) UpdateField(ctx context.Context, fieldID string, name *string, public *bool) (*domain.CustomField, error) {
+	fd := f.field(fieldID)
+	if fd == nil {
+		return nil, domain.ErrNotFound
+	}
+	if name != nil {
+		fd.Name = *name
+	}
+	if public != nil {
+		fd.Public = *public
+	}
+	return fd, nil
+}
+
+func (f *fakeCustomFieldRepo) DeleteField(ctx context.Context, fieldID string) error {
+	i := slices.IndexFunc(f.fields, func(fd *domain.CustomField) bool { return fd.ID == fieldID })
+	if i < 0 {
+		return domain.ErrNotFound
+	}
+	f.fields = slices.Delete(f.fields, i, i+1)
+	for _, byEntity := range f.values {
+		for id, values := range byEntity {
+			byEntity[id] = slices.DeleteFunc(values, func(v *domain.CustomFieldValue) bool { return v.FieldID == fieldID })
+		}
+	}
+	return nil
+}
+
+func (f *fakeCustomFieldRepo) SetValues(ctx context.Context, appliesTo, entityID string, values []*domain.CustomFieldValue) ([]*domain.CustomFieldValue, error) {
+	stored := f.values[appliesTo][entityID]
+	for _, v := range values {
+		stored = slices.DeleteFunc(stored, func(old *domain.CustomFieldValue) bool { return old.FieldID == v.FieldID })
+		if v.Value != nil {
+			stored = append(stored, v)
+		}
+	}
+	f.values[appliesTo][entityID] = stored
+	return stored, nil
+}
+
+func (f *fakeCustomFieldRepo) ListValues(ctx context.Context, eventID, appliesTo string, publicOnly bool) (map[string][]*domain.CustomFieldValue, error) {
+	out := make(map[string][]*domain.CustomFieldValue)
+	for id, values := range f.values[appliesTo] {
+		for _, v := range values {
+			fd := f.field(v.FieldID)
+			if fd == nil || fd.EventID != eventID || (publicOnly && !fd.Public) {
+				continue
+			}
+			out[id] = append(out[id], v)
+		}
+	}
+	return out, nil
+}
+
+// fakeSpeakerMergeRepo is an in-memory SpeakerMergeRepository for tests.
+type fakeSpeakerMergeRepo struct {
+	candidates []*domain.SpeakerMergeCandidate
+	nextID     int
+}
+
+func newFakeSpeakerMergeRepo() *fakeSpeakerMergeRepo {
+	return &fakeSpeakerMergeRepo{nextID: 1}
+}
+
+func (f *fakeSpeakerMergeRepo) Create(ctx context.Context, c *domain.SpeakerMergeCandidate) error {
+	for _, existing := range f.candidates {
+		if existing.SpeakerID == c.SpeakerID && existing.CandidateID == c.CandidateID {
+			c.ID, c.Status, c.CreatedAt = existing.ID, existing.Status, existing.CreatedAt
+			return nil
+		}
+	}
+	c.ID = fmt.Sprintf("merge-%d", f.nextID)
+	f.nextID++
+	c.Status = domain.MergeStatusPending
+	c.CreatedAt = time.Now()
+	f.candidates = append(f.candidates, c)
+	return nil
+}
+
+func (f *fakeSpeakerMergeRepo) GetByID(ctx context.Context, id string) (*domain.SpeakerMergeCandidate, error) {
+	for _, c := range f.candidates {
+		if c.ID == id {
+			return c, nil
+		}
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (f *fakeSpeakerMergeRepo) ListPendingByEventID(ctx context.Context, eventID string) ([]*domain.SpeakerMergeCandidate, error) {
+	var out []*domain.SpeakerMergeCandidate
+	for _, c := range f.candidates {
+		if c.EventID == eventID && c.Status == domain.MergeStatusPending {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeSpeakerMergeRepo) Resolve(ctx context.Context, id string, status string) (*domain.SpeakerMergeCandidate, error) {
+	c, err := f.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if c.Status != domain.MergeStatusPending {
+		return nil, domain.ErrAlreadyResolved
+	}
+	now := time.Now()
+	c.Status, c.ResolvedAt = status, &now
+	return c, nil
+}
+
+// fakeIntegrityRepo returns canned issues per event and records cleanups.
+type fakeIntegrityRepo struct {
+	eventIDs      []string
+	issues        map[string][]*domain.IntegrityIssue
+	findErr       map[string]error
+	cleanupErr    error
+	cleanedEvents []string
+	changed       int64
+	orphanTags    int64
+}
+
+func newFakeIntegrityRepo() *fakeIntegrityRepo {
+	return &fakeIntegrityRepo{issues: make(map[string][]*domain.IntegrityIssue), findErr: make(map[string]error)}
+}
+
+func (f *fakeIntegrityRepo) FindIssues(ctx context.Context, eventID string) ([]*domain.IntegrityIssue, error) {
+	if err := f.findErr[eventID]; err != nil {
+		return nil, err
+	}
+	return f.issues[eventID], nil
+}
+
+func (f *fakeIntegrityRepo) Cleanup(ctx context.Context, eventID string) (int64, error) {
+	if f.cleanupErr != nil {
+		return 0, f.cleanupErr
+	}
+	f.cleanedEvents = append(f.cleanedEvents, eventID)
+	return f.changed, nil
+}
+
+func (f *fakeIntegrityRepo) ListEventIDs(ctx context.Context) ([]string, error) {
+	return f.eventIDs, nil
+}
+
+func (f *fakeIntegrityRepo) DeleteOrphanTags(ctx context.Context) (int64, error) {
+	return f.orphanTags, nil
+}
+
+// fakeEventInvitationRepo is an in-memory EventInvitationRepository for tests.
+type fakeEventInvitationRepo struct {
+	invitations []*domain.EventInvitation
+	nextID      int
+	createErr   error
+	// pending holds the not yet accepted invitations by event ID, as returned by ListPending.
+	pending     map[string][]*domain.PendingInvitation
+	domainRules map[string]*domain.InvitationDomainRules
+}
+
+func newFakeEventInvitationRepo() *fakeEventInvitationRepo {
+	return &fakeEventInvitationRepo{
+		invitations: nil,
+		nextID:      1,
+	}
+}
+
+func (f *fakeEventInvitationRepo) Create(ctx context.Context, inv *domain.EventInvitation) error {
+	if f.createErr != nil {
+		return f.createErr
+	}
+	for _, existing := range f.invitations {
+		if existing.EventID == inv.EventID && strings.ToLower(existing.Email) == strings.ToLower(inv.Email) {
+			return errors.New("duplicate key value violates unique constraint")
+		}
+	}
+	inv.ID = fmt.Sprintf("inv-%d", f.nextID)
+	f.nextID++
+	f.invitations = append(f.invitations, inv)
+	return nil
+}
+
+func (f *fakeEventInvitationRepo) GetByID(ctx context.Context, id string) (*domain.EventInvitation, error) {
+	for _, inv := range f.invitations {
+		if inv.ID == id {
+			return inv, nil
+		}
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (f *fakeEventInvitationRepo) ListByEventID(ctx context.Context, eventID string, search string, params domain.PaginationParams) ([]*domain.EventInvitation, int, error) {
+	var out []*domain.EventInvitation
+	for _, inv := range f.invitations {
+		if inv.EventID != eventID {
+			continue
+		}
+		if search != "" && !strings.Contains(strings.ToLower(inv.Email), strings.ToLower(search)) {
+			continue
+		}
+		out = append(out, inv)
+	}
+	if out == nil {
+		out = []*domain.EventInvitation{}
+	}
+	total := len(out)
+	offset := params.Offset()
+	if offset > total {
+		offset = total
+	}
+	end := offset + params.PageSize
+	if end > total {
+		end = total
+	}
+	page := out[offset:end]
+	if page == nil {
+		page = []*domain.EventInvitation{}
+	}
+	return page, total, nil
+}
+
+func (f *fakeEventInvitationRepo) StreamByEventID(ctx context.Context, eventID string, search string, fn func(*domain.EventInvitation) error) error {
+	for _, inv := range f.invitations {
+		if inv.EventID != eventID {
+			continue
+		}
+		if search != "" && !strings.Contains(strings.ToLower(inv.Email), strings.ToLower(search)) {
+			continue
+		}
+		if err := fn(inv); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *fakeEventInvitationRepo) ListPending(ctx context.Context, eventID string) ([]*domain.PendingInvitation, error) {
+	out := []*domain.PendingInvitation{}
+	for _, p := range f.pending[eventID] {
+		cp := *p
+		out = append(out, &cp)
+	}
+	return out, nil
+}
+
+func (f *fakeEventInvitationRepo) MarkReminded(ctx context.Context, id string, at time.Time) error {
+	for _, list := range f.pending {
+		for _, p := range list {
+			if p.ID == id {
+				p.LastContactedAt = at
+				p.RemindersSent++
+				return nil
+			}
+		}
+	}
+	return domain.ErrNotFound
+}
+
+func (f *fakeEventInvitationRepo) GetDomainRules(ctx context.Context, eventID string) (*domain.InvitationDomainRules, error) {
+	rules, ok := f.domainRules[eventID]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return rules, nil
+}
+
+func (f *fakeEventInvitationRepo) UpsertDomainRules(ctx context.Context, rules *domain.InvitationDomainRules) error {
+	if f.domainRules == nil {
+		f.domainRules = make(map[string]*domain.InvitationDomainRules)
+	}
+	rules.UpdatedAt = time.Now()
+	f.domainRules[rules.EventID] = rules
+	return nil
+}
+
+// fakeEmailService is a test double for EmailService. Tracks SendEventInvitation, SendEventInvitationReminder and SendTeamMemberLeft calls; other methods no-op.
+type fakeEmailService struct {
+	sendEventInvitationErr error // if set, SendEventInvitation returns this
+	sentInvitations        []*domain.EventInvitationEmailData
+	sendTeamMemberLeftErr  error
+	sentTeamMemberLeft     []*domain.TeamMemberLeftEmailData
+	failReminderTo         map[string]bool // SendEventInvitationReminder fails for these emails
+	sentReminders          []*domain.EventInvitationEmailData
+	archived               []*domain.EventEmail // returned by ListEventEmails; ResendEventEmail resends from it
+	sendContactErr         error // if set, SendContactMessage and SendContactReply return this
+	sentContactMessages    []*domain.ContactMessageEmailData
+	sentContactReplies     []*domain.ContactReplyEmailData
+	sentDeletions          []*domain.EventDeletionEmailData
+	sentCapacityAlerts     []*domain.RoomCapacityAlertEmailData
+	sendCapacityAlertErr   error // if set, SendRoomCapacityAlert returns this
+}
+
+func newFakeEmailService() *fakeEmailService {
+	return &fakeEmailService{sentInvitations: []*domain.EventInvitationEmailData{}}
+}
+
+func (f *fakeEmailService) SendWelcomeMessage(ctx context.Context, data *domain.WelcomeMessageEmailData) error {
+	return nil
+}
+
+func (f *fakeEmailService) SendLoginCode(ctx context.Context, data *domain.LoginCodeEmailData) error {
+	return nil
+}
+
+func (f *fakeEmailService) SendEventDeletionConfirmation(ctx context.Context, data *domain.EventDeletionEmailData) error {
+	f.sentDeletions = append(f.sentDeletions, data)
+	return nil
+}
+
+func (f *fakeEmailService) SendEventInvitation(ctx context.Context, data *domain.EventInvitationEmailData) error {
+	if f.sendEventInvitationErr != nil {
+		return f.sendEventInvitationErr
+	}
+	f.sentInvitations = append(f.sentInvitations, data)
+	return nil
+}
+
+func (f *fakeEmailService) SendEventInvitationReminder(ctx context.Context, data *domain.EventInvitationEmailData) error {
+	if f.failReminderTo[data.Email] {
+		return errors.New("smtp error")
+	}
+	f.sentReminders = append(f.sentReminders, data)
+	return nil
+}
+
+func (f *fakeEmailService) SendTeamMemberLeft(ctx context.Context, data *domain.TeamMemberLeftEmailData) error {
+	if f.sendTeamMemberLeftErr != nil {
+		return f.sendTeamMemberLeftErr
+	}
+	f.sentTeamMemberLeft = append(f.sentTeamMemberLeft, data)
+	return nil
+}
+
+func (f *fakeEmailService) SendContactMessage(ctx context.Context, data *domain.ContactMessageEmailData) error {
+	f.sentContactMessages = append(f.sentContactMessages, data)
+	return f.sendContactErr
+}
+
+func (f *fakeEmailService) SendContactReply(ctx context.Context, data *domain.ContactReplyEmailData) error {
+	f.sentContactReplies = append(f.sentContactReplies, data)
+	return f.sendContactErr
+}
+
+func (f *fakeEmailService) SendRoomCapacityAlert(ctx context.Context, data *domain.RoomCapacityAlertEmailData) error {
+	f.sentCapacityAlerts = append(f.sentCapacityAlerts, data)
+	return f.sendCapacityAlertErr
+}
+
+func (f *fakeEmailService) ListEventEmails(ctx context.Context, eventID string, filter domain.EventEmailFilter, params domain.PaginationParams) ([]*domain.EventEmail, int, error) {
+	out := []*domain.EventEmail{}
+	for _, e := range f.archived {
+		if e.EventID == eventID {
+			out = append(out, e)
+		}
+	}
+	return out, len(out), nil
+}
+
+func (f *fakeEmailService) ResendEventEmail(ctx context.Context, eventID, emailID string) (*domain.EventEmail, error) {
+	for _, e := range f.archived {
+		if e.ID == emailID && e.EventID == eventID {
+			resent := *e
+			resent.ID, resent.ResendOf = e.ID+"-resent", e.ID
+			f.archived = append(f.archived, &resent)
+			return &resent, nil
+		}
+	}
+	return nil, domain.ErrEventEmailNotFound
+}
+
+// defaultSessionizeData returns a minimal valid Sessionize All API response for tests.
+func defaultSessionizeData() domain.SessionFetcherResponse {
+	return domain.SessionFetcherResponse{
+		Sessions: []domain.SessionFetcherSession{
+			{
+				ID:            "s1",
+				Title:         "Talk 1",
+				Description:   "A talk",
 				StartsAt:      time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC),
 				EndsAt:        time.Date(2025, 3, 1, 11, 0, 0, 0, time.UTC),
 				RoomID:        1,
@@ -887,12 +1748,37 @@ func TestEventService_CreateEvent(t *testing.T) {
 			wantErr: true,
 			assert:  func(t *testing.T, _ *fakeEventRepo, _ *domain.Event) {},
 		},
+		{
+			name: "retries a taken code",
+			setup: func() (domain.EventRepository, domain.SessionRepository, domain.SessionFetcher) {
+				er := newFakeEventRepo()
+				er.codeCollisions = domain.EventCodeAttempts - 1
+				return er, newFakeSessionRepo(), &fakeSessionizeFetcher{}
+			},
+			event:   &domain.Event{Name: "Conf", OwnerID: "user-1"},
+			wantErr: false,
+			assert: func(t *testing.T, eventRepo *fakeEventRepo, event *domain.Event) {
+				assert.Equal(t, 0, eventRepo.codeCollisions)
+				assert.Contains(t, eventRepo.byID, event.ID)
+			},
+		},
+		{
+			name: "every code taken",
+			setup: func() (domain.EventRepository, domain.SessionRepository, domain.SessionFetcher) {
+				er := newFakeEventRepo()
+				er.codeCollisions = domain.EventCodeAttempts
+				return er, newFakeSessionRepo(), &fakeSessionizeFetcher{}
+			},
+			event:   &domain.Event{Name: "Conf", OwnerID: "user-1"},
+			wantErr: true,
+			assert:  func(t *testing.T, _ *fakeEventRepo, _ *domain.Event) {},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			eventRepo, sessionRepo, fetcher := tt.setup()
-			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), fetcher, timeout)
+			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), fetcher, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 			ev := &domain.Event{Name: tt.event.Name, OwnerID: tt.event.OwnerID}
 			err := svc.CreateEvent(ctx, ev)
 			if tt.wantErr {
@@ -999,7 +1885,7 @@ func TestEventService_UpdateEvent(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			eventRepo, sessionRepo, fetcher := tt.setup()
-			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), fetcher, timeout)
+			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), fetcher, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 			got, err := svc.UpdateEvent(ctx, tt.eventID, tt.ownerID, tt.date, tt.description, tt.locationLat, tt.locationLng)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -1068,7 +1954,7 @@ func TestEventService_ImportSessionizeData(t *testing.T) {
 			assert:  func(t *testing.T, _ *fakeSessionRepo) {},
 		},
 		{
-			name: "DeleteScheduleByEventID error",
+			name: "PruneScheduleByEventID error",
 			setup: func() (domain.EventRepository, domain.SessionRepository, domain.SessionFetcher) {
 				sr := newFakeSessionRepo()
 				sr.deleteErr = errors.New("delete failed")
@@ -1108,8 +1994,8 @@ func TestEventService_ImportSessionizeData(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			eventRepo, sessionRepo, fetcher := tt.setup()
-			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), fetcher, timeout)
-			err := svc.ImportSessionizeData(ctx, tt.eventID, tt.sessID)
+			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), fetcher, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
+			err := svc.ImportSessionizeData(ctx, tt.eventID, tt.sessID, false)
 			if tt.wantErr {
 				require.Error(t, err)
 				return
@@ -1122,7 +2008,771 @@ func TestEventService_ImportSessionizeData(t *testing.T) {
 	}
 }
 
-func TestEventService_ListEventsByOwner(t *testing.T) {
+func TestEventService_ImportSessionizeData_ForceRefresh(t *testing.T) {
+	fetcher := &fakeSessionizeFetcher{data: defaultSessionizeData()}
+	svc := newTestEventService(newFakeEventRepo(), newFakeSessionRepo(), fetcher, 5*time.Second)
+
+	require.NoError(t, svc.ImportSessionizeData(context.Background(), "ev-1", "abc123", true))
+	assert.True(t, fetcher.lastForceRefresh)
+	require.NoError(t, svc.ImportSessionizeData(context.Background(), "ev-1", "abc123", false))
+	assert.False(t, fetcher.lastForceRefresh)
+}
+
+func TestEventService_ImportSessionizeData_AppliesImportMapping(t *testing.T) {
+	data := defaultSessionizeData()
+	data.Sessions[0].CategoryItems = []int{101, 102, 201, 301}
+	data.Categories = append(data.Categories,
+		domain.SessionFetcherCategory{ID: 2, Title: "Tipo de sesión", Items: []domain.SessionFetcherCategoryItem{{ID: 201, Name: "Taller"}}},
+		domain.SessionFetcherCategory{ID: 3, Title: "Track", Items: []domain.SessionFetcherCategoryItem{{ID: 301, Name: "Backend"}}},
+	)
+	sessionRepo := newFakeSessionRepo()
+	svc := newTestEventService(newFakeEventRepo(), sessionRepo, &fakeSessionizeFetcher{data: data}, 5*time.Second)
+	mappings := newFakeImportMappingRepo()
+	mappings.byEventID["ev-1"] = &domain.ImportMapping{
+		EventID:             "ev-1",
+		TagCategories:       []string{" event TAG "},
+		SessionTypeCategory: "tipo de sesión",
+		TrackCategory:       "TRACK",
+		RoomNameOverrides:   map[string]string{"Room A": "Main Hall"},
+	}
+	svc.importMappingRepo = mappings
+
+	require.NoError(t, svc.ImportSessionizeData(context.Background(), "ev-1", "abc123", false))
+
+	require.Len(t, sessionRepo.rooms, 1)
+	assert.Equal(t, "Main Hall", sessionRepo.rooms[0].Name)
+	require.Len(t, sessionRepo.sessions, 1)
+	sess := sessionRepo.sessions[0]
+	assert.Equal(t, "Taller", sess.SessionType)
+	assert.Equal(t, "Backend", sess.Track)
+	var tagNames []string
+	for _, tg := range sess.Tags {
+		tagNames = append(tagNames, tg.Name)
+	}
+	assert.ElementsMatch(t, []string{"Conferencia", "ai"}, tagNames)
+}
+
+func TestEventService_ImportSessionizeData_ReimportKeepsIDs(t *testing.T) {
+	ctx := context.Background()
+	data := defaultSessionizeData()
+	fetcher := &fakeSessionizeFetcher{data: data}
+	sessionRepo := newFakeSessionRepo()
+	svc := newTestEventService(newFakeEventRepo(), sessionRepo, fetcher, 5*time.Second)
+
+	require.NoError(t, svc.ImportSessionizeData(ctx, "ev-1", "abc123", false))
+	roomID, sessionID, speakerID := sessionRepo.rooms[0].ID, sessionRepo.sessions[0].ID, sessionRepo.speakers[0].ID
+
+	data.Sessions[0].Title = "Talk 1 (updated)"
+	data.Sessions = append(data.Sessions, domain.SessionFetcherSession{ID: "s2", Title: "Talk 2", RoomID: 1})
+	fetcher.data = data
+	require.NoError(t, svc.ImportSessionizeData(ctx, "ev-1", "abc123", false))
+
+	require.Len(t, sessionRepo.rooms, 1)
+	assert.Equal(t, roomID, sessionRepo.rooms[0].ID)
+	require.Len(t, sessionRepo.sessions, 2)
+	assert.Equal(t, sessionID, sessionRepo.sessions[0].ID)
+	assert.Equal(t, "Talk 1 (updated)", sessionRepo.sessions[0].Title)
+	require.Len(t, sessionRepo.speakers, 1)
+	assert.Equal(t, speakerID, sessionRepo.speakers[0].ID)
+
+	data.Sessions = data.Sessions[1:]
+	data.Speakers = nil
+	fetcher.data = data
+	require.NoError(t, svc.ImportSessionizeData(ctx, "ev-1", "abc123", false))
+
+	require.Len(t, sessionRepo.sessions, 1)
+	assert.Equal(t, "Talk 2", sessionRepo.sessions[0].Title)
+	assert.Empty(t, sessionRepo.speakers, "speakers no longer in Sessionize are removed")
+	assert.Empty(t, sessionRepo.sessionSpeakers)
+}
+
+func TestEventService_ImportSessionizeData_RecordsMoves(t *testing.T) {
+	ctx := context.Background()
+	data := defaultSessionizeData()
+	data.Rooms = append(data.Rooms, domain.SessionFetcherRoom{ID: 2, Name: "Room B"})
+	fetcher := &fakeSessionizeFetcher{data: data}
+	sessionRepo := newFakeSessionRepo()
+	changes := newFakeSessionChangeRepo()
+	svc := newTestEventService(newFakeEventRepo(), sessionRepo, fetcher, 5*time.Second)
+	svc.sessionChangeRepo = changes
+
+	require.NoError(t, svc.ImportSessionizeData(ctx, "ev-1", "abc123", false))
+	assert.Empty(t, changes.changes, "new sessions have no moves")
+
+	data.Sessions[0].RoomID = 2
+	data.Sessions[0].StartsAt = data.Sessions[0].StartsAt.Add(time.Hour)
+	data.Sessions[0].EndsAt = data.Sessions[0].EndsAt.Add(time.Hour)
+	fetcher.data = data
+	require.NoError(t, svc.ImportSessionizeData(ctx, "ev-1", "abc123", false))
+
+	require.Len(t, changes.changes, 1)
+	c := changes.changes[0]
+	assert.Equal(t, sessionRepo.sessions[0].ID, c.SessionID)
+	assert.Equal(t, "Room A", c.FromRoomName)
+	assert.Equal(t, "Room B", c.ToRoomName)
+	assert.Equal(t, time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC), c.FromStartTime)
+	assert.Equal(t, time.Date(2025, 3, 1, 11, 0, 0, 0, time.UTC), c.ToStartTime)
+
+	require.NoError(t, svc.ImportSessionizeData(ctx, "ev-1", "abc123", false))
+	assert.Len(t, changes.changes, 1, "an unchanged re-import records nothing")
+}
+
+func TestEventService_PreviewSessionizeImport(t *testing.T) {
+	ctx := context.Background()
+	data := defaultSessionizeData()
+	fetcher := &fakeSessionizeFetcher{data: data}
+	er := newFakeEventRepo()
+	require.NoError(t, er.Create(ctx, &domain.Event{Name: "Conf", OwnerID: "user-1"}))
+	sessionRepo := newFakeSessionRepo()
+	svc := newTestEventService(er, sessionRepo, fetcher, 5*time.Second)
+
+	require.NoError(t, svc.ImportSessionizeData(ctx, "ev-1", "abc123", false))
+	sessionID := sessionRepo.sessions[0].ID
+
+	data.Sessions = []domain.SessionFetcherSession{{ID: "s2", Title: "Talk 2", RoomID: 1}}
+	data.Speakers = nil
+	data.Rooms = append(data.Rooms, domain.SessionFetcherRoom{ID: 2, Name: "Room B"})
+	fetcher.data = data
+
+	preview, err := svc.PreviewSessionizeImport(ctx, "ev-1", "user-1", "abc123", false)
+	require.NoError(t, err)
+	assert.True(t, preview.DryRun)
+	assert.Equal(t, []*domain.DryRunChange{
+		{ID: sessionRepo.rooms[0].ID, Name: "Room A", Action: domain.DryRunUpdate},
+		{Name: "Room B", Action: domain.DryRunCreate},
+	}, preview.Rooms)
+	assert.Equal(t, []*domain.DryRunChange{
+		{Name: "Talk 2", Action: domain.DryRunCreate},
+		{ID: sessionID, Name: "Talk 1", Action: domain.DryRunDelete},
+	}, preview.Sessions)
+	assert.Equal(t, []*domain.DryRunChange{
+		{ID: sessionRepo.speakers[0].ID, Name: "Jane Doe", Action: domain.DryRunDelete},
+	}, preview.Speakers)
+
+	require.Len(t, sessionRepo.rooms, 1, "a preview changes nothing")
+	require.Len(t, sessionRepo.sessions, 1)
+	require.Len(t, sessionRepo.speakers, 1)
+
+	_, err = svc.PreviewSessionizeImport(ctx, "ev-1", "user-2", "abc123", false)
+	require.ErrorIs(t, err, domain.ErrForbidden)
+}
+
+func TestEventService_ImportSessionizeData_DeduplicatesSpeakers(t *testing.T) {
+	ctx := context.Background()
+	data := defaultSessionizeData()
+	fetcher := &fakeSessionizeFetcher{data: data}
+	sessionRepo := newFakeSessionRepo()
+	manual := domain.NewSpeaker("ev-1", "manual-1", "admin_app", "Jane", "Doe", "", "", "", false, time.Now(), time.Now())
+	manual.Email = "jane@example.com"
+	require.NoError(t, sessionRepo.CreateSpeaker(ctx, manual))
+	svc := newTestEventService(newFakeEventRepo(), sessionRepo, fetcher, 5*time.Second)
+	merges := newFakeSpeakerMergeRepo()
+	svc.speakerMergeRepo = merges
+
+	require.NoError(t, svc.ImportSessionizeData(ctx, "ev-1", "abc123", false))
+
+	require.Len(t, sessionRepo.speakers, 1, "the manual speaker with the same name is reused")
+	jane := sessionRepo.speakers[0]
+	assert.Equal(t, manual.ID, jane.ID)
+	assert.Equal(t, "sessionize", jane.Source)
+	assert.Equal(t, "sp-uuid-1", jane.SourceSessionID)
+	assert.Equal(t, "jane@example.com", jane.Email, "imports keep the email set by the owner")
+	assert.Equal(t, "Speaker bio", jane.Bio)
+
+	// Sessionize re-created the speaker under a new ID, with accents and different spacing.
+	data.Speakers[0].ID = "sp-uuid-2"
+	data.Speakers[0].FirstName = "Jáne "
+	data.Sessions[0].Speakers = []string{"sp-uuid-2"}
+	fetcher.data = data
+	require.NoError(t, svc.ImportSessionizeData(ctx, "ev-1", "abc123", false))
+
+	require.Len(t, sessionRepo.speakers, 1)
+	assert.Equal(t, manual.ID, sessionRepo.speakers[0].ID)
+	assert.Equal(t, "sp-uuid-2", sessionRepo.speakers[0].SourceSessionID)
+	require.Len(t, sessionRepo.sessionSpeakers, 1)
+	assert.Equal(t, manual.ID, sessionRepo.sessionSpeakers[0].speakerID)
+	assert.Empty(t, merges.candidates)
+}
+
+func TestEventService_ImportSessionizeData_QueuesAmbiguousSpeakers(t *testing.T) {
+	ctx := context.Background()
+	data := defaultSessionizeData()
+	fetcher := &fakeSessionizeFetcher{data: data}
+	sessionRepo := newFakeSessionRepo()
+	svc := newTestEventService(newFakeEventRepo(), sessionRepo, fetcher, 5*time.Second)
+	merges := newFakeSpeakerMergeRepo()
+	svc.speakerMergeRepo = merges
+	require.NoError(t, svc.ImportSessionizeData(ctx, "ev-1", "abc123", false))
+	existingID := sessionRepo.speakers[0].ID
+
+	data.Speakers[0].ID = "sp-uuid-2"
+	data.Speakers[0].FirstName = "Janet"
+	data.Sessions[0].Speakers = []string{"sp-uuid-2"}
+	fetcher.data = data
+	require.NoError(t, svc.ImportSessionizeData(ctx, "ev-1", "abc123", false))
+
+	require.Len(t, sessionRepo.speakers, 2, "the possible duplicate is kept until reviewed")
+	require.Len(t, merges.candidates, 1)
+	c := merges.candidates[0]
+	assert.Equal(t, existingID, c.CandidateID)
+	assert.NotEqual(t, existingID, c.SpeakerID)
+	assert.Equal(t, "similar name", c.Reason)
+	assert.Equal(t, domain.MergeStatusPending, c.Status)
+
+	// A later import keeps the reviewed speaker and does not queue the pair again.
+	require.NoError(t, svc.ImportSessionizeData(ctx, "ev-1", "abc123", false))
+	assert.Len(t, sessionRepo.speakers, 2)
+	assert.Len(t, merges.candidates, 1)
+}
+
+func TestEventService_ResolveSpeakerMergeCandidate(t *testing.T) {
+	ctx := context.Background()
+	setup := func(t *testing.T) (*eventService, *fakeSessionRepo, *fakeSpeakerMergeRepo, *domain.SpeakerMergeCandidate) {
+		eventRepo := newFakeEventRepo()
+		eventRepo.byID["ev-1"] = &domain.Event{ID: "ev-1", OwnerID: "owner-1"}
+		sessionRepo := newFakeSessionRepo()
+		existing := domain.NewSpeaker("ev-1", "old-id", "sessionize", "Jon", "Doe", "", "", "", false, time.Now(), time.Now())
+		imported := domain.NewSpeaker("ev-1", "new-id", "sessionize", "John", "Doe", "New bio", "", "", false, time.Now(), time.Now())
+		require.NoError(t, sessionRepo.CreateSpeaker(ctx, existing))
+		require.NoError(t, sessionRepo.CreateSpeaker(ctx, imported))
+		require.NoError(t, sessionRepo.CreateSessionSpeaker(ctx, "sess-1", imported.ID))
+		svc := newTestEventService(eventRepo, sessionRepo, &fakeSessionizeFetcher{}, 5*time.Second)
+		merges := newFakeSpeakerMergeRepo()
+		svc.speakerMergeRepo = merges
+		c := &domain.SpeakerMergeCandidate{EventID: "ev-1", SpeakerID: imported.ID, CandidateID: existing.ID, Reason: "similar name"}
+		require.NoError(t, merges.Create(ctx, c))
+		return svc, sessionRepo, merges, c
+	}
+
+	t.Run("list hydrates both speakers", func(t *testing.T) {
+		svc, _, _, c := setup(t)
+		got, err := svc.ListSpeakerMergeCandidates(ctx, "ev-1", "owner-1")
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, c.ID, got[0].ID)
+		require.NotNil(t, got[0].Speaker)
+		require.NotNil(t, got[0].Candidate)
+		assert.Equal(t, "John", got[0].Speaker.FirstName)
+		assert.Equal(t, "Jon", got[0].Candidate.FirstName)
+	})
+
+	t.Run("merge keeps the existing speaker", func(t *testing.T) {
+		svc, sessionRepo, _, c := setup(t)
+		got, err := svc.ResolveSpeakerMergeCandidate(ctx, "ev-1", c.ID, "owner-1", true)
+		require.NoError(t, err)
+		assert.Equal(t, domain.MergeStatusMerged, got.Status)
+		assert.NotNil(t, got.ResolvedAt)
+		require.Len(t, sessionRepo.speakers, 1)
+		kept := sessionRepo.speakers[0]
+		assert.Equal(t, c.CandidateID, kept.ID)
+		assert.Equal(t, "new-id", kept.SourceSessionID)
+		assert.Equal(t, "New bio", kept.Bio)
+		require.Len(t, sessionRepo.sessionSpeakers, 1)
+		assert.Equal(t, kept.ID, sessionRepo.sessionSpeakers[0].speakerID)
+	})
+
+	t.Run("reject keeps both speakers", func(t *testing.T) {
+		svc, sessionRepo, _, c := setup(t)
+		got, err := svc.ResolveSpeakerMergeCandidate(ctx, "ev-1", c.ID, "owner-1", false)
+		require.NoError(t, err)
+		assert.Equal(t, domain.MergeStatusRejected, got.Status)
+		assert.Len(t, sessionRepo.speakers, 2)
+
+		_, err = svc.ResolveSpeakerMergeCandidate(ctx, "ev-1", c.ID, "owner-1", true)
+		assert.ErrorIs(t, err, domain.ErrAlreadyResolved)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		svc, _, _, c := setup(t)
+		_, err := svc.ResolveSpeakerMergeCandidate(ctx, "ev-1", c.ID, "other", true)
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		_, err = svc.ResolveSpeakerMergeCandidate(ctx, "ev-1", "missing", "owner-1", true)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		c.EventID = "ev-2"
+		_, err = svc.ResolveSpeakerMergeCandidate(ctx, "ev-1", c.ID, "owner-1", true)
+		assert.ErrorIs(t, err, domain.ErrNotFound, "candidates of other events are not visible")
+	})
+}
+
+func TestEventService_IntegrityReport(t *testing.T) {
+	ctx := context.Background()
+	setup := func() (*eventService, *fakeIntegrityRepo) {
+		eventRepo := newFakeEventRepo()
+		eventRepo.byID["ev-1"] = &domain.Event{ID: "ev-1", OwnerID: "owner-1"}
+		svc := newTestEventService(eventRepo, newFakeSessionRepo(), &fakeSessionizeFetcher{}, 5*time.Second)
+		integrity := newFakeIntegrityRepo()
+		integrity.issues["ev-1"] = []*domain.IntegrityIssue{
+			{Kind: domain.IssueUnusedTag, EntityID: "tag-1", Detail: "go", Fixable: true},
+			{Kind: domain.IssueEmptyRoom, EntityID: "room-1", Detail: "Room A"},
+		}
+		integrity.changed = 1
+		svc.integrityRepo = integrity
+		return svc, integrity
+	}
+
+	t.Run("report counts fixable issues", func(t *testing.T) {
+		svc, _ := setup()
+		report, err := svc.GetIntegrityReport(ctx, "ev-1", "owner-1")
+		require.NoError(t, err)
+		assert.Equal(t, "ev-1", report.EventID)
+		assert.Len(t, report.Issues, 2)
+		assert.Equal(t, 1, report.Fixable)
+	})
+
+	t.Run("clean event reports an empty list", func(t *testing.T) {
+		svc, integrity := setup()
+		integrity.issues["ev-1"] = nil
+		report, err := svc.GetIntegrityReport(ctx, "ev-1", "owner-1")
+		require.NoError(t, err)
+		assert.NotNil(t, report.Issues)
+		assert.Empty(t, report.Issues)
+	})
+
+	t.Run("dry run changes nothing", func(t *testing.T) {
+		svc, integrity := setup()
+		got, err := svc.CleanupIntegrityIssues(ctx, "ev-1", "owner-1", true)
+		require.NoError(t, err)
+		assert.True(t, got.DryRun)
+		require.Len(t, got.Issues, 1)
+		assert.Equal(t, domain.IssueUnusedTag, got.Issues[0].Kind)
+		assert.Zero(t, got.Changed)
+		assert.Empty(t, integrity.cleanedEvents)
+	})
+
+	t.Run("cleanup fixes fixable issues", func(t *testing.T) {
+		svc, integrity := setup()
+		got, err := svc.CleanupIntegrityIssues(ctx, "ev-1", "owner-1", false)
+		require.NoError(t, err)
+		assert.False(t, got.DryRun)
+		assert.Len(t, got.Issues, 1)
+		assert.Equal(t, int64(1), got.Changed)
+		assert.Equal(t, []string{"ev-1"}, integrity.cleanedEvents)
+	})
+
+	t.Run("cleanup skips events without fixable issues", func(t *testing.T) {
+		svc, integrity := setup()
+		integrity.issues["ev-1"] = integrity.issues["ev-1"][1:]
+		got, err := svc.CleanupIntegrityIssues(ctx, "ev-1", "owner-1", false)
+		require.NoError(t, err)
+		assert.Empty(t, got.Issues)
+		assert.Empty(t, integrity.cleanedEvents)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		svc, integrity := setup()
+		_, err := svc.GetIntegrityReport(ctx, "ev-1", "someone-else")
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		_, err = svc.CleanupIntegrityIssues(ctx, "ev-missing", "owner-1", true)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		integrity.cleanupErr = errors.New("db down")
+		_, err = svc.CleanupIntegrityIssues(ctx, "ev-1", "owner-1", false)
+		assert.ErrorContains(t, err, "db down")
+	})
+}
+
+func TestMapCategoryItems(t *testing.T) {
+	items := buildCategoryItems([]domain.SessionFetcherCategory{
+		{Title: "Topics", Items: []domain.SessionFetcherCategoryItem{{ID: 1, Name: "go"}, {ID: 2, Name: "web"}}},
+		{Title: "Level", Items: []domain.SessionFetcherCategoryItem{{ID: 3, Name: "Intro"}}},
+		{Title: "Format", Items: []domain.SessionFetcherCategoryItem{{ID: 4, Name: "Talk"}, {ID: 5, Name: "Workshop"}}},
+	})
+
+	tests := []struct {
+		name            string
+		mapping         *domain.ImportMapping
+		wantTags        []string
+		wantSessionType string
+		wantTrack       string
+	}{
+		{
+			name:     "default mapping tags every item",
+			mapping:  domain.NewImportMapping("ev-1"),
+			wantTags: []string{"go", "web", "Intro", "Talk", "Workshop"},
+		},
+		{
+			name:            "type and track categories are not tags",
+			mapping:         &domain.ImportMapping{SessionTypeCategory: "format", TrackCategory: "Level"},
+			wantTags:        []string{"go", "web"},
+			wantSessionType: "Talk",
+			wantTrack:       "Intro",
+		},
+		{
+			name:     "tag categories restrict tags",
+			mapping:  &domain.ImportMapping{TagCategories: []string{"level"}},
+			wantTags: []string{"Intro"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tags, sessionType, track := mapCategoryItems([]int{1, 2, 1, 3, 4, 5, 99}, items, tt.mapping)
+			assert.Equal(t, tt.wantTags, tags)
+			assert.Equal(t, tt.wantSessionType, sessionType)
+			assert.Equal(t, tt.wantTrack, track)
+		})
+	}
+}
+
+func TestEventService_GetImportMapping(t *testing.T) {
+	ctx := context.Background()
+	eventRepo := newFakeEventRepo()
+	eventRepo.byID["ev-1"] = &domain.Event{ID: "ev-1", OwnerID: "owner-1"}
+	svc := newTestEventService(eventRepo, newFakeSessionRepo(), &fakeSessionizeFetcher{}, 5*time.Second)
+	mappings := newFakeImportMappingRepo()
+	svc.importMappingRepo = mappings
+
+	got, err := svc.GetImportMapping(ctx, "ev-1", "owner-1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.NewImportMapping("ev-1"), got, "unsaved mapping returns the defaults")
+
+	mappings.byEventID["ev-1"] = &domain.ImportMapping{EventID: "ev-1", TrackCategory: "Track"}
+	got, err = svc.GetImportMapping(ctx, "ev-1", "owner-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Track", got.TrackCategory)
+
+	_, err = svc.GetImportMapping(ctx, "ev-1", "other")
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = svc.GetImportMapping(ctx, "missing", "owner-1")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestEventService_UpdateImportMapping(t *testing.T) {
+	ctx := context.Background()
+	eventRepo := newFakeEventRepo()
+	eventRepo.byID["ev-1"] = &domain.Event{ID: "ev-1", OwnerID: "owner-1"}
+	svc := newTestEventService(eventRepo, newFakeSessionRepo(), &fakeSessionizeFetcher{}, 5*time.Second)
+	mappings := newFakeImportMappingRepo()
+	svc.importMappingRepo = mappings
+
+	got, err := svc.UpdateImportMapping(ctx, "ev-1", "owner-1", &domain.ImportMapping{EventID: "spoofed", SessionTypeCategory: "Format"})
+	require.NoError(t, err)
+	assert.Equal(t, "ev-1", got.EventID)
+	assert.Equal(t, []string{}, got.TagCategories)
+	assert.Equal(t, map[string]string{}, got.RoomNameOverrides)
+	assert.False(t, got.UpdatedAt.IsZero())
+	assert.Same(t, got, mappings.byEventID["ev-1"])
+
+	_, err = svc.UpdateImportMapping(ctx, "ev-1", "other", &domain.ImportMapping{})
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = svc.UpdateImportMapping(ctx, "missing", "owner-1", &domain.ImportMapping{})
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.Equal(t, 1, mappings.upserts)
+}
+
+func TestEventService_GetScheduleRules(t *testing.T) {
+	ctx := context.Background()
+	eventRepo := newFakeEventRepo()
+	eventRepo.byID["ev-1"] = &domain.Event{ID: "ev-1", OwnerID: "owner-1"}
+	svc := newTestEventService(eventRepo, newFakeSessionRepo(), &fakeSessionizeFetcher{}, 5*time.Second)
+	rules := newFakeScheduleRulesRepo()
+	svc.scheduleRulesRepo = rules
+
+	got, err := svc.GetScheduleRules(ctx, "ev-1", "owner-1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.NewScheduleRules("ev-1"), got, "unsaved rules allow any time slot")
+
+	rules.byEventID["ev-1"] = &domain.ScheduleRules{EventID: "ev-1", EarliestStart: "09:00"}
+	got, err = svc.GetScheduleRules(ctx, "ev-1", "owner-1")
+	require.NoError(t, err)
+	assert.Equal(t, "09:00", got.EarliestStart)
+
+	_, err = svc.GetScheduleRules(ctx, "ev-1", "other")
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = svc.GetScheduleRules(ctx, "missing", "owner-1")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestEventService_UpdateScheduleRules(t *testing.T) {
+	ctx := context.Background()
+	eventRepo := newFakeEventRepo()
+	eventRepo.byID["ev-1"] = &domain.Event{ID: "ev-1", OwnerID: "owner-1"}
+	svc := newTestEventService(eventRepo, newFakeSessionRepo(), &fakeSessionizeFetcher{}, 5*time.Second)
+	rules := newFakeScheduleRulesRepo()
+	svc.scheduleRulesRepo = rules
+
+	got, err := svc.UpdateScheduleRules(ctx, "ev-1", "owner-1", &domain.ScheduleRules{
+		EventID:          "spoofed",
+		AllowedDurations: []int{60, 30, 60},
+		AllowedDays:      []string{"2026-05-15", "2026-05-14"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ev-1", got.EventID)
+	assert.Equal(t, []int{30, 60}, got.AllowedDurations)
+	assert.Equal(t, []string{"2026-05-14", "2026-05-15"}, got.AllowedDays)
+	assert.False(t, got.UpdatedAt.IsZero())
+	assert.Same(t, got, rules.byEventID["ev-1"])
+
+	got, err = svc.UpdateScheduleRules(ctx, "ev-1", "owner-1", &domain.ScheduleRules{})
+	require.NoError(t, err)
+	assert.Equal(t, []int{}, got.AllowedDurations)
+	assert.Equal(t, []string{}, got.AllowedDays)
+
+	_, err = svc.UpdateScheduleRules(ctx, "ev-1", "other", &domain.ScheduleRules{})
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = svc.UpdateScheduleRules(ctx, "missing", "owner-1", &domain.ScheduleRules{})
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestEventService_ScheduleRulesAreEnforced(t *testing.T) {
+	ctx := context.Background()
+	day := time.Date(2026, 5, 14, 0, 0, 0, 0, time.UTC)
+	setup := func() *eventService {
+		eventRepo := newFakeEventRepo()
+		eventRepo.byID["ev-1"] = &domain.Event{ID: "ev-1", OwnerID: "owner-1"}
+		sessionRepo := newFakeSessionRepo()
+		sessionRepo.rooms = []*domain.Room{{ID: "room-1", EventID: "ev-1"}, {ID: "room-2", EventID: "ev-1"}}
+		sessionRepo.sessions = []*domain.Session{{
+			ID: "sess-1", EventID: "ev-1", RoomID: "room-1", Title: "Late talk",
+			StartTime: day.Add(22 * time.Hour), EndTime: day.Add(23 * time.Hour),
+		}}
+		svc := newTestEventService(eventRepo, sessionRepo, &fakeSessionizeFetcher{}, 5*time.Second)
+		rules := newFakeScheduleRulesRepo()
+		rules.byEventID["ev-1"] = &domain.ScheduleRules{EventID: "ev-1", AllowedDurations: []int{30, 60}, EarliestStart: "09:00", LatestEnd: "20:00"}
+		svc.scheduleRulesRepo = rules
+		return svc
+	}
+
+	t.Run("create at 3 a.m. is rejected", func(t *testing.T) {
+		svc := setup()
+		_, err := svc.CreateEventSession(ctx, "ev-1", "owner-1", "room-1", "Night talk", "", day.Add(3*time.Hour), day.Add(4*time.Hour), nil, nil)
+		require.ErrorIs(t, err, domain.ErrScheduleRuleViolation)
+		assert.Contains(t, err.Error(), "session starts at 03:00 but may not start before 09:00")
+	})
+	t.Run("create within the rules succeeds", func(t *testing.T) {
+		svc := setup()
+		_, err := svc.CreateEventSession(ctx, "ev-1", "owner-1", "room-1", "Morning talk", "", day.Add(10*time.Hour), day.Add(10*time.Hour+30*time.Minute), nil, nil)
+		require.NoError(t, err)
+	})
+	t.Run("moving to a new time slot is checked", func(t *testing.T) {
+		svc := setup()
+		start, end := day.Add(10*time.Hour), day.Add(10*time.Hour+50*time.Minute)
+		_, err := svc.UpdateSessionSchedule(ctx, "ev-1", "sess-1", "owner-1", nil, &start, &end)
+		require.ErrorIs(t, err, domain.ErrScheduleRuleViolation)
+		assert.Contains(t, err.Error(), "session lasts 50 minutes but must last 30 or 60 minutes")
+	})
+	t.Run("changing only the room is not checked", func(t *testing.T) {
+		svc := setup()
+		room := "room-2"
+		got, err := svc.UpdateSessionSchedule(ctx, "ev-1", "sess-1", "owner-1", &room, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "room-2", got.RoomID)
+	})
+}
+
+func TestEventService_UpdateOperatingHours(t *testing.T) {
+	ctx := context.Background()
+	day1 := time.Date(2026, 5, 14, 0, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+	eventRepo := newFakeEventRepo()
+	eventRepo.byID["ev-1"] = &domain.Event{ID: "ev-1", OwnerID: "owner-1"}
+	svc := newTestEventService(eventRepo, newFakeSessionRepo(), &fakeSessionizeFetcher{}, 5*time.Second)
+	hours := newFakeOperatingHoursRepo()
+	svc.operatingHoursRepo = hours
+
+	_, err := svc.UpdateOperatingHours(ctx, "ev-1", "owner-1", []*domain.OperatingDay{
+		{Day: "2026-05-15", OpensAt: day2.Add(9 * time.Hour), ClosesAt: day2.Add(18 * time.Hour)},
+		{Day: "2026-05-14", OpensAt: day1.Add(8 * time.Hour), ClosesAt: day1.Add(34 * time.Hour)},
+	})
+	require.ErrorIs(t, err, domain.ErrInvalidInput, "the first day runs past the second day's opening")
+	assert.Contains(t, err.Error(), "2026-05-15 opens before 2026-05-14 closes")
+	assert.Empty(t, hours.byEventID["ev-1"])
+
+	got, err := svc.UpdateOperatingHours(ctx, "ev-1", "owner-1", []*domain.OperatingDay{
+		{Day: "2026-05-15", OpensAt: day2.Add(9 * time.Hour), ClosesAt: day2.Add(18 * time.Hour)},
+		{Day: "2026-05-14", OpensAt: day1.Add(8 * time.Hour), ClosesAt: day1.Add(25 * time.Hour)},
+	})
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, "2026-05-14", got[0].Day, "days are sorted")
+	assert.Equal(t, got, hours.byEventID["ev-1"])
+
+	got, err = svc.UpdateOperatingHours(ctx, "ev-1", "owner-1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []*domain.OperatingDay{}, got)
+
+	for problem, days := range map[string][]*domain.OperatingDay{
+		"must close after it opens": {{Day: "2026-05-14", OpensAt: day1.Add(18 * time.Hour), ClosesAt: day1.Add(9 * time.Hour)}},
+		"is listed more than once": {
+			{Day: "2026-05-14", OpensAt: day1.Add(8 * time.Hour), ClosesAt: day1.Add(12 * time.Hour)},
+			{Day: "2026-05-14", OpensAt: day1.Add(14 * time.Hour), ClosesAt: day1.Add(18 * time.Hour)},
+		},
+		"must be YYYY-MM-DD": {{Day: "14/05/2026", OpensAt: day1.Add(8 * time.Hour), ClosesAt: day1.Add(18 * time.Hour)}},
+	} {
+		_, err := svc.UpdateOperatingHours(ctx, "ev-1", "owner-1", days)
+		assert.ErrorIs(t, err, domain.ErrInvalidInput, problem)
+		assert.ErrorContains(t, err, problem)
+	}
+
+	_, err = svc.GetOperatingHours(ctx, "ev-1", "other")
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = svc.UpdateOperatingHours(ctx, "missing", "owner-1", nil)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestEventService_OperatingHoursAreEnforced(t *testing.T) {
+	ctx := context.Background()
+	day := time.Date(2026, 5, 14, 0, 0, 0, 0, time.UTC)
+	setup := func() *eventService {
+		eventRepo := newFakeEventRepo()
+		eventRepo.byID["ev-1"] = &domain.Event{ID: "ev-1", OwnerID: "owner-1"}
+		sessionRepo := newFakeSessionRepo()
+		sessionRepo.rooms = []*domain.Room{{ID: "room-1", EventID: "ev-1"}}
+		sessionRepo.sessions = []*domain.Session{{
+			ID: "sess-1", EventID: "ev-1", RoomID: "room-1", Title: "Keynote",
+			StartTime: day.Add(9 * time.Hour), EndTime: day.Add(10 * time.Hour),
+		}}
+		svc := newTestEventService(eventRepo, sessionRepo, &fakeSessionizeFetcher{}, 5*time.Second)
+		hours := newFakeOperatingHoursRepo()
+		hours.byEventID["ev-1"] = []*domain.OperatingDay{
+			{Day: "2026-05-14", OpensAt: day.Add(8 * time.Hour), ClosesAt: day.Add(25 * time.Hour)},
+		}
+		svc.operatingHoursRepo = hours
+		return svc
+	}
+
+	t.Run("create before doors open is rejected", func(t *testing.T) {
+		svc := setup()
+		_, err := svc.CreateEventSession(ctx, "ev-1", "owner-1", "room-1", "Early talk", "", day.Add(7*time.Hour), day.Add(8*time.Hour), nil, nil)
+		require.ErrorIs(t, err, domain.ErrScheduleRuleViolation)
+		assert.Contains(t, err.Error(), "session starts at 2026-05-14T07:00:00Z, outside the event's operating hours")
+	})
+	t.Run("create after midnight inside a late day succeeds", func(t *testing.T) {
+		svc := setup()
+		_, err := svc.CreateEventSession(ctx, "ev-1", "owner-1", "room-1", "Late talk", "", day.Add(24*time.Hour), day.Add(25*time.Hour), nil, nil)
+		require.NoError(t, err)
+	})
+	t.Run("moving past closing time is rejected", func(t *testing.T) {
+		svc := setup()
+		start, end := day.Add(24*time.Hour), day.Add(26*time.Hour)
+		_, err := svc.UpdateSessionSchedule(ctx, "ev-1", "sess-1", "owner-1", nil, &start, &end)
+		require.ErrorIs(t, err, domain.ErrScheduleRuleViolation)
+		assert.Contains(t, err.Error(), "but 2026-05-14 closes at 2026-05-15T01:00:00Z")
+	})
+	t.Run("event payload includes the operating hours", func(t *testing.T) {
+		svc := setup()
+		event, _, _, err := svc.GetEventByID(ctx, "ev-1")
+		require.NoError(t, err)
+		require.Len(t, event.OperatingHours, 1)
+		assert.Equal(t, "2026-05-14", event.OperatingHours[0].Day)
+	})
+}
+
+func TestEventService_SessionTimesGoThroughThePolicy(t *testing.T) {
+	ctx := context.Background()
+	madrid, err := time.LoadLocation("Europe/Madrid")
+	require.NoError(t, err)
+	day := time.Date(2026, 5, 14, 0, 0, 0, 0, madrid)
+	setup := func(policy SchedulePolicy) (*eventService, *fakeSessionRepo) {
+		eventRepo := newFakeEventRepo()
+		eventRepo.byID["ev-1"] = &domain.Event{ID: "ev-1", OwnerID: "owner-1"}
+		sessionRepo := newFakeSessionRepo()
+		sessionRepo.rooms = []*domain.Room{{ID: "room-1", EventID: "ev-1"}}
+		sessionRepo.sessions = []*domain.Session{{
+			ID: "sess-keynote", EventID: "ev-1", RoomID: "room-1", Title: "Keynote",
+			StartTime: day.Add(10 * time.Hour).UTC(), EndTime: day.Add(11 * time.Hour).UTC(),
+		}}
+		svc := newTestEventService(eventRepo, sessionRepo, &fakeSessionizeFetcher{}, 5*time.Second)
+		svc.policy = policy
+		rules := newFakeScheduleRulesRepo()
+		rules.byEventID["ev-1"] = &domain.ScheduleRules{EventID: "ev-1", EarliestStart: "09:00", Timezone: "Europe/Madrid"}
+		svc.scheduleRulesRepo = rules
+		return svc, sessionRepo
+	}
+
+	t.Run("created sessions are stored in UTC", func(t *testing.T) {
+		svc, _ := setup(SchedulePolicy{})
+		got, err := svc.CreateEventSession(ctx, "ev-1", "owner-1", "room-1", "Talk", "", day.Add(9*time.Hour), day.Add(10*time.Hour+500*time.Nanosecond), nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 5, 14, 7, 0, 0, 0, time.UTC), got.StartTime)
+		assert.Equal(t, time.Date(2026, 5, 14, 8, 0, 0, 0, time.UTC), got.EndTime)
+	})
+	t.Run("tolerance absorbs clock skew", func(t *testing.T) {
+		start, end := day.Add(9*time.Hour-20*time.Second), day.Add(10*time.Hour)
+		svc, _ := setup(SchedulePolicy{})
+		_, err := svc.CreateEventSession(ctx, "ev-1", "owner-1", "room-1", "Talk", "", start, end, nil, nil)
+		require.ErrorIs(t, err, domain.ErrScheduleRuleViolation)
+
+		svc, _ = setup(SchedulePolicy{Tolerance: 30 * time.Second})
+		_, err = svc.CreateEventSession(ctx, "ev-1", "owner-1", "room-1", "Talk", "", start, end, nil, nil)
+		require.NoError(t, err)
+	})
+	t.Run("moved sessions are stored in UTC", func(t *testing.T) {
+		svc, _ := setup(SchedulePolicy{})
+		start, end := day.Add(12*time.Hour), day.Add(13*time.Hour)
+		got, err := svc.UpdateSessionSchedule(ctx, "ev-1", "sess-keynote", "owner-1", nil, &start, &end)
+		require.NoError(t, err)
+		assert.Equal(t, time.UTC, got.StartTime.Location())
+		assert.True(t, got.StartTime.Equal(start))
+	})
+	t.Run("a room change still needs a valid stored slot", func(t *testing.T) {
+		svc, sessionRepo := setup(SchedulePolicy{})
+		sessionRepo.sessions[0].EndTime = sessionRepo.sessions[0].StartTime
+		room := "room-1"
+		_, err := svc.UpdateSessionSchedule(ctx, "ev-1", "sess-keynote", "owner-1", &room, nil, nil)
+		require.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+}
+
+// FuzzEventService_ImportSessionizeData maps arbitrary Sessionize All API payloads. The import
+// must never panic, and everything it stores must be consistent with the payload: sessions only
+// in imported rooms, unique non-empty tags, and speaker links between stored rows.
+func FuzzEventService_ImportSessionizeData(f *testing.F) {
+	seed, err := json.Marshal(defaultSessionizeData())
+	require.NoError(f, err)
+	f.Add(seed)
+	f.Add([]byte(`{}`))
+	f.Add([]byte(`{"sessions":[{"id":"s1","roomId":7,"categoryItems":[1,1,2,-1]}],"rooms":[{"id":7},{"id":7}],"categories":[{"items":[{"id":1,"name":"go"},{"id":1,"name":""},{"id":2}]}]}`))
+	f.Add([]byte(`{"sessions":[{"id":"s1","roomId":1,"speakers":["x","x","missing"]},{"id":"s1","roomId":1}],"rooms":[{"id":1}],"speakers":[{"id":"x"},{"id":"x"}]}`))
+
+	f.Fuzz(func(t *testing.T, payload []byte) {
+		var data domain.SessionFetcherResponse
+		if err := json.Unmarshal(payload, &data); err != nil {
+			return
+		}
+		sessionRepo := newFakeSessionRepo()
+		svc := newTestEventService(newFakeEventRepo(), sessionRepo, &fakeSessionizeFetcher{data: data}, 5*time.Second)
+
+		require.NoError(t, svc.ImportSessionizeData(context.Background(), "ev-1", "abc123", false))
+
+		// Rooms and speakers are upserted by their Sessionize IDs.
+		distinctRooms := make(map[int]bool)
+		for _, r := range data.Rooms {
+			distinctRooms[r.ID] = true
+		}
+		distinctSpeakers := make(map[string]bool)
+		for _, sp := range data.Speakers {
+			distinctSpeakers[sp.ID] = true
+		}
+		assert.Len(t, sessionRepo.rooms, len(distinctRooms))
+		assert.Len(t, sessionRepo.speakers, len(distinctSpeakers))
+		assert.LessOrEqual(t, len(sessionRepo.sessions), len(data.Sessions))
+		roomIDs := make(map[string]bool)
+		for _, r := range sessionRepo.rooms {
+			roomIDs[r.ID] = true
+		}
+		sessionIDs := make(map[string]bool)
+		for _, sess := range sessionRepo.sessions {
+			sessionIDs[sess.ID] = true
+			assert.True(t, roomIDs[sess.RoomID], "session %s stored in unknown room %s", sess.ID, sess.RoomID)
+			seen := make(map[string]bool)
+			for _, tag := range sess.Tags {
+				assert.NotEmpty(t, tag.Name)
+				assert.False(t, seen[tag.Name], "duplicate tag %q", tag.Name)
+				seen[tag.Name] = true
+			}
+		}
+		speakerIDs := make(map[string]bool)
+		for _, sp := range sessionRepo.speakers {
+			speakerIDs[sp.ID] = true
+		}
+		for _, link := range sessionRepo.sessionSpeakers {
+			assert.True(t, sessionIDs[link.sessionID])
+			assert.True(t, speakerIDs[link.speakerID])
+		}
+	})
+}
+
+func TestEventService_ListMyEvents_OwnerOnly(t *testing.T) {
 	ctx := context.Background()
 	timeout := 5 * time.Second
 
@@ -1167,8 +2817,8 @@ func TestEventService_ListEventsByOwner(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			eventRepo, sessionRepo, fetcher := tt.setup()
-			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), fetcher, timeout)
-			events, err := svc.ListEventsByOwner(ctx, tt.ownerID)
+			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), fetcher, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
+			events, err := svc.ListMyEvents(ctx, tt.ownerID, domain.MyEventsParams{OwnerOnly: true})
 			require.NoError(t, err)
 			require.Len(t, events, tt.wantLen)
 			tt.assert(t, events)
@@ -1176,6 +2826,114 @@ func TestEventService_ListEventsByOwner(t *testing.T) {
 	}
 }
 
+func TestEventService_ListMyEvents_IncludesTeamEvents(t *testing.T) {
+	ctx := context.Background()
+	er := newFakeEventRepo()
+	require.NoError(t, er.Create(ctx, &domain.Event{Name: "Mine", OwnerID: "user-1", CreatedAt: time.Now()}))
+	require.NoError(t, er.Create(ctx, &domain.Event{Name: "Theirs", OwnerID: "user-2", CreatedAt: time.Now()}))
+	require.NoError(t, er.Create(ctx, &domain.Event{Name: "Unrelated", OwnerID: "user-3", CreatedAt: time.Now()}))
+	er.teamEvents = map[string][]string{"user-1": {"ev-2"}}
+	svc := newTestEventService(er, newFakeSessionRepo(), &fakeSessionizeFetcher{}, 5*time.Second)
+
+	events, err := svc.ListMyEvents(ctx, "user-1", domain.MyEventsParams{})
+	require.NoError(t, err)
+	roles := map[string]string{}
+	for _, e := range events {
+		roles[e.Name] = e.Role
+	}
+	assert.Equal(t, map[string]string{"Mine": domain.EventRoleOwner, "Theirs": domain.EventRoleTeamMember}, roles)
+
+	events, err = svc.ListMyEvents(ctx, "user-1", domain.MyEventsParams{OwnerOnly: true})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "Mine", events[0].Name)
+}
+
+func TestEventService_ListMyEvents_WithStats(t *testing.T) {
+	ctx := context.Background()
+	er := newFakeEventRepo()
+	require.NoError(t, er.Create(ctx, &domain.Event{Name: "E1", OwnerID: "user-1", CreatedAt: time.Now()}))
+	require.NoError(t, er.Create(ctx, &domain.Event{Name: "E2", OwnerID: "user-1", CreatedAt: time.Now().Add(time.Hour)}))
+	er.stats = map[string]*domain.EventStats{"ev-1": {Rooms: 2, Sessions: 7, Speakers: 5, InvitationsSent: 3, InvitationsAccepted: 1, TeamSize: 2}}
+	svc := newTestEventService(er, newFakeSessionRepo(), &fakeSessionizeFetcher{}, 5*time.Second)
+
+	events, err := svc.ListMyEvents(ctx, "user-1", domain.MyEventsParams{WithStats: true})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	byID := map[string]*domain.Event{events[0].ID: events[0], events[1].ID: events[1]}
+	assert.Equal(t, er.stats["ev-1"], byID["ev-1"].Stats)
+	assert.Equal(t, &domain.EventStats{TeamSize: 1}, byID["ev-2"].Stats, "events without rows count the owner")
+
+	events, err = svc.ListMyEvents(ctx, "user-2", domain.MyEventsParams{WithStats: true})
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+func TestEventService_SearchEvents(t *testing.T) {
+	ctx := context.Background()
+	may, june := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		params   domain.EventSearchParams
+		repoErr  error
+		wantErr  error
+		wantCall bool
+	}{
+		{
+			name:     "passes filters to the repository",
+			params:   domain.EventSearchParams{Query: "go", From: &may, To: &june, Roles: []string{domain.EventRoleOwner, domain.EventRoleAttendee}},
+			wantCall: true,
+		},
+		{
+			name:    "unknown role",
+			params:  domain.EventSearchParams{Roles: []string{"speaker"}},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:    "to not after from",
+			params:  domain.EventSearchParams{From: &june, To: &may},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:     "repository error",
+			repoErr:  errors.New("db down"),
+			wantCall: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventRepo := newFakeEventRepo()
+			eventRepo.searchErr = tt.repoErr
+			svc := newTestEventService(eventRepo, newFakeSessionRepo(), &fakeSessionizeFetcher{}, 5*time.Second)
+			_, _, err := svc.SearchEvents(ctx, "user-1", tt.params)
+			switch {
+			case tt.wantErr != nil:
+				require.ErrorIs(t, err, tt.wantErr)
+			case tt.repoErr != nil:
+				require.ErrorIs(t, err, tt.repoErr)
+			default:
+				require.NoError(t, err)
+			}
+			if !tt.wantCall {
+				assert.Nil(t, eventRepo.lastSearch, "invalid params must not reach the repository")
+				return
+			}
+			require.NotNil(t, eventRepo.lastSearch)
+			assert.Equal(t, tt.params, *eventRepo.lastSearch)
+		})
+	}
+
+	t.Run("trims the query", func(t *testing.T) {
+		eventRepo := newFakeEventRepo()
+		svc := newTestEventService(eventRepo, newFakeSessionRepo(), &fakeSessionizeFetcher{}, 5*time.Second)
+		_, _, err := svc.SearchEvents(ctx, "user-1", domain.EventSearchParams{Query: "  go  "})
+		require.NoError(t, err)
+		assert.Equal(t, "go", eventRepo.lastSearch.Query)
+	})
+}
+
 func TestEventService_GetEventByID(t *testing.T) {
 	ctx := context.Background()
 	timeout := 5 * time.Second
@@ -1196,7 +2954,7 @@ func TestEventService_GetEventByID(t *testing.T) {
 				ev, _ := er.GetByID(ctx, "ev-1")
 				sr := newFakeSessionRepo()
 				sr.rooms = []*domain.Room{{ID: "room-1", EventID: ev.ID, Name: "Room A"}}
-				sr.sessions = []*domain.Session{{ID: "sess-1", RoomID: "room-1", Title: "Talk 1", Tags: []*domain.Tag{}}}
+				sr.sessions = []*domain.Session{{ID: "sess-1", EventID: "ev-1", RoomID: "room-1", Title: "Talk 1", Tags: []*domain.Tag{}}}
 				return er, sr, &fakeSessionizeFetcher{}
 			},
 			eventID:      "ev-1",
@@ -1246,7 +3004,7 @@ func TestEventService_GetEventByID(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			eventRepo, sessionRepo, fetcher := tt.setup()
-			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), fetcher, timeout)
+			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), fetcher, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 			event, rooms, sessions, err := svc.GetEventByID(ctx, tt.eventID)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -1270,9 +3028,11 @@ func TestEventService_DeleteEvent(t *testing.T) {
 		setup         func() (domain.EventRepository, domain.SessionRepository, domain.SessionFetcher)
 		eventID       string
 		ownerID       string
+		force         bool
 		wantErr       bool
 		wantNotFound  bool
 		wantForbidden bool
+		wantConflict  bool
 		assertDeleted bool
 	}{
 		{
@@ -1309,13 +3069,39 @@ func TestEventService_DeleteEvent(t *testing.T) {
 			wantErr:       true,
 			wantForbidden: true,
 		},
+		{
+			name: "blocked by registrations",
+			setup: func() (domain.EventRepository, domain.SessionRepository, domain.SessionFetcher) {
+				er := newFakeEventRepo()
+				_ = er.Create(ctx, &domain.Event{Name: "Conf", OwnerID: "user-1", CreatedAt: time.Now(), UpdatedAt: time.Now()})
+				er.registrations = map[string]int{"ev-1": 3}
+				return er, newFakeSessionRepo(), &fakeSessionizeFetcher{}
+			},
+			eventID:      "ev-1",
+			ownerID:      "user-1",
+			wantErr:      true,
+			wantConflict: true,
+		},
+		{
+			name: "forced with registrations",
+			setup: func() (domain.EventRepository, domain.SessionRepository, domain.SessionFetcher) {
+				er := newFakeEventRepo()
+				_ = er.Create(ctx, &domain.Event{Name: "Conf", OwnerID: "user-1", CreatedAt: time.Now(), UpdatedAt: time.Now()})
+				er.registrations = map[string]int{"ev-1": 3}
+				return er, newFakeSessionRepo(), &fakeSessionizeFetcher{}
+			},
+			eventID:       "ev-1",
+			ownerID:       "user-1",
+			force:         true,
+			assertDeleted: true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			eventRepo, sessionRepo, fetcher := tt.setup()
-			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), fetcher, timeout)
-			err := svc.DeleteEvent(ctx, tt.eventID, tt.ownerID)
+			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), fetcher, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
+			err := svc.DeleteEvent(ctx, tt.eventID, tt.ownerID, tt.force)
 			if tt.wantErr {
 				require.Error(t, err)
 				if tt.wantNotFound {
@@ -1324,6 +3110,12 @@ func TestEventService_DeleteEvent(t *testing.T) {
 				if tt.wantForbidden {
 					require.True(t, errors.Is(err, domain.ErrForbidden))
 				}
+				if tt.wantConflict {
+					require.ErrorIs(t, err, domain.ErrEventHasRegistrations)
+					require.Contains(t, err.Error(), "3 attendees are registered")
+					_, getErr := eventRepo.GetByID(ctx, tt.eventID)
+					require.NoError(t, getErr)
+				}
 				return
 			}
 			require.NoError(t, err)
@@ -1449,7 +3241,7 @@ func TestEventService_CreateEventRoom(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			eventRepo, sessionRepo, fetcher := tt.setup()
 			sr, _ := sessionRepo.(*fakeSessionRepo)
-			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), fetcher, timeout)
+			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), fetcher, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 			room, err := svc.CreateEventRoom(ctx, tt.eventID, tt.ownerID, tt.nameArg, tt.capacity, tt.description, tt.howToGetThere, tt.notBookable)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -1580,7 +3372,7 @@ func TestEventService_ToggleRoomNotBookable(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			eventRepo, sessionRepo, fetcher := tt.setup()
-			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), fetcher, timeout)
+			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), fetcher, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 			room, err := svc.ToggleRoomNotBookable(ctx, tt.eventID, tt.roomID, tt.ownerID)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -1678,7 +3470,7 @@ func TestEventService_ListEventRooms(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			eventRepo, sessionRepo, fetcher := tt.setup()
-			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), fetcher, timeout)
+			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), fetcher, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 			rooms, err := svc.ListEventRooms(ctx, tt.eventID, tt.ownerID)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -1699,6 +3491,107 @@ func TestEventService_ListEventRooms(t *testing.T) {
 	}
 }
 
+func TestEventService_ReorderEventRooms(t *testing.T) {
+	ctx := context.Background()
+	timeout := 5 * time.Second
+
+	tests := []struct {
+		name    string
+		ownerID string
+		roomIDs []string
+		wantErr error
+		want    []string
+	}{
+		{name: "main stage first", ownerID: "user-1", roomIDs: []string{"room-2", "room-1"}, want: []string{"room-2", "room-1"}},
+		{name: "not owner", ownerID: "user-2", roomIDs: []string{"room-2", "room-1"}, wantErr: domain.ErrForbidden},
+		{name: "missing room", ownerID: "user-1", roomIDs: []string{"room-2"}, wantErr: domain.ErrInvalidInput},
+		{name: "duplicate room", ownerID: "user-1", roomIDs: []string{"room-2", "room-2"}, wantErr: domain.ErrInvalidInput},
+		{name: "room of another event", ownerID: "user-1", roomIDs: []string{"room-2", "room-x"}, wantErr: domain.ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			er := newFakeEventRepo()
+			_ = er.Create(ctx, &domain.Event{Name: "Conf", OwnerID: "user-1", CreatedAt: time.Now(), UpdatedAt: time.Now()})
+			sr := newFakeSessionRepo()
+			sr.rooms = []*domain.Room{
+				{ID: "room-1", EventID: "ev-1", Name: "Annex", DisplayOrder: 1},
+				{ID: "room-2", EventID: "ev-1", Name: "Main Stage", DisplayOrder: 2},
+				{ID: "room-x", EventID: "ev-2", Name: "Elsewhere", DisplayOrder: 1},
+			}
+			svc := NewEventService(er, sr, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
+			rooms, err := svc.ReorderEventRooms(ctx, "ev-1", tt.ownerID, tt.roomIDs)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			var got []string
+			for _, r := range rooms {
+				got = append(got, r.ID)
+			}
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, 1, rooms[0].DisplayOrder)
+		})
+	}
+}
+
+func TestEventService_ReorderEventTracks(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		ownerID string
+		tracks  []string
+		wantErr error
+		want    []string
+	}{
+		{name: "frontend first", ownerID: "user-1", tracks: []string{"Frontend", "Backend"}, want: []string{"Frontend", "Backend", "Data"}},
+		{name: "not owner", ownerID: "user-2", tracks: []string{"Frontend", "Backend"}, wantErr: domain.ErrForbidden},
+		{name: "missing track", ownerID: "user-1", tracks: []string{"Frontend"}, wantErr: domain.ErrInvalidInput},
+		{name: "duplicate track", ownerID: "user-1", tracks: []string{"Frontend", "Frontend"}, wantErr: domain.ErrInvalidInput},
+		{name: "track of another event", ownerID: "user-1", tracks: []string{"Frontend", "Design"}, wantErr: domain.ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			er := newFakeEventRepo()
+			_ = er.Create(ctx, &domain.Event{Name: "Conf", OwnerID: "user-1"})
+			sr := newFakeSessionRepo()
+			sr.rooms = []*domain.Room{{ID: "room-1", EventID: "ev-1", Name: "Annex"}}
+			sr.sessions = []*domain.Session{
+				{ID: "sess-1", EventID: "ev-1", RoomID: "room-1", Track: "Backend"},
+				{ID: "sess-2", EventID: "ev-1", RoomID: "room-1", Track: "Frontend"},
+				{ID: "sess-3", EventID: "ev-1", RoomID: "room-1", Track: "Backend"},
+				{ID: "sess-4", EventID: "ev-1", RoomID: "room-1"},
+				{ID: "sess-x", EventID: "ev-2", Track: "Design"},
+			}
+			svc := newTestEventService(er, sr, &fakeSessionizeFetcher{}, 5*time.Second)
+
+			tracks, err := svc.ListEventTracks(ctx, "ev-1", "user-1")
+			require.NoError(t, err)
+			assert.Equal(t, []string{"Backend", "Frontend"}, tracks, "tracks without an order are listed by name")
+
+			tracks, err = svc.ReorderEventTracks(ctx, "ev-1", tt.ownerID, tt.tracks)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want[:2], tracks)
+
+			// A track sessions get later goes after the ordered ones.
+			sr.sessions = append(sr.sessions, &domain.Session{ID: "sess-5", EventID: "ev-1", RoomID: "room-1", Track: "Data"})
+			tracks, err = svc.ListEventTracks(ctx, "ev-1", "user-1")
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, tracks)
+			grid, err := svc.buildScheduleGrid(ctx, "ev-1")
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, grid.Tracks)
+		})
+	}
+}
+
 func TestEventService_GetEventRoom(t *testing.T) {
 	ctx := context.Background()
 	timeout := 5 * time.Second
@@ -1793,7 +3686,7 @@ func TestEventService_GetEventRoom(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			eventRepo, sessionRepo, fetcher := tt.setup()
-			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), fetcher, timeout)
+			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), fetcher, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 			room, err := svc.GetEventRoom(ctx, tt.eventID, tt.roomID, tt.ownerID)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -1940,7 +3833,7 @@ func TestEventService_UpdateEventRoom(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			eventRepo, sessionRepo, fetcher := tt.setup()
-			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), fetcher, timeout)
+			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), fetcher, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 			room, err := svc.UpdateEventRoom(ctx, tt.eventID, tt.roomID, tt.ownerID, tt.roomName, tt.capacity, tt.description, tt.howToGetThere, tt.notBookable)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -1974,10 +3867,15 @@ func TestEventService_DeleteEventRoom(t *testing.T) {
 		eventID       string
 		roomID        string
 		ownerID       string
+		mode          string
+		targetRoomID  string
 		wantErr       bool
 		wantForbidden bool
 		wantNotFound  bool
+		wantInvalid   bool
 		assertDeleted bool
+		// wantSessionRooms maps each remaining session ID to its room after the delete.
+		wantSessionRooms map[string]string
 	}{
 		{
 			name: "success owner deletes room",
@@ -1993,6 +3891,79 @@ func TestEventService_DeleteEventRoom(t *testing.T) {
 			ownerID:       "user-1",
 			assertDeleted: true,
 		},
+		{
+			name:             "cascade deletes the room's sessions",
+			setup:            roomDeleteFixture,
+			eventID:          "ev-1",
+			roomID:           "room-1",
+			ownerID:          "user-1",
+			mode:             domain.RoomDeleteCascade,
+			assertDeleted:    true,
+			wantSessionRooms: map[string]string{"sess-2": "room-2"},
+		},
+		{
+			name:             "reassign moves sessions to the target room",
+			setup:            roomDeleteFixture,
+			eventID:          "ev-1",
+			roomID:           "room-1",
+			ownerID:          "user-1",
+			mode:             domain.RoomDeleteReassign,
+			targetRoomID:     "room-2",
+			assertDeleted:    true,
+			wantSessionRooms: map[string]string{"sess-1": "room-2", "sess-2": "room-2"},
+		},
+		{
+			name:             "unschedule keeps sessions without a room",
+			setup:            roomDeleteFixture,
+			eventID:          "ev-1",
+			roomID:           "room-1",
+			ownerID:          "user-1",
+			mode:             domain.RoomDeleteUnschedule,
+			assertDeleted:    true,
+			wantSessionRooms: map[string]string{"sess-1": "", "sess-2": "room-2"},
+		},
+		{
+			name:         "reassign to the deleted room",
+			setup:        roomDeleteFixture,
+			eventID:      "ev-1",
+			roomID:       "room-1",
+			ownerID:      "user-1",
+			mode:         domain.RoomDeleteReassign,
+			targetRoomID: "room-1",
+			wantErr:      true,
+			wantInvalid:  true,
+		},
+		{
+			name:         "reassign to another event's room",
+			setup:        roomDeleteFixture,
+			eventID:      "ev-1",
+			roomID:       "room-1",
+			ownerID:      "user-1",
+			mode:         domain.RoomDeleteReassign,
+			targetRoomID: "room-other",
+			wantErr:      true,
+			wantInvalid:  true,
+		},
+		{
+			name:        "reassign without target",
+			setup:       roomDeleteFixture,
+			eventID:     "ev-1",
+			roomID:      "room-1",
+			ownerID:     "user-1",
+			mode:        domain.RoomDeleteReassign,
+			wantErr:     true,
+			wantInvalid: true,
+		},
+		{
+			name:        "unknown mode",
+			setup:       roomDeleteFixture,
+			eventID:     "ev-1",
+			roomID:      "room-1",
+			ownerID:     "user-1",
+			mode:        "archive",
+			wantErr:     true,
+			wantInvalid: true,
+		},
 		{
 			name: "event not found",
 			setup: func() (domain.EventRepository, domain.SessionRepository, domain.SessionFetcher) {
@@ -2037,10 +4008,13 @@ func TestEventService_DeleteEventRoom(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			eventRepo, sessionRepo, fetcher := tt.setup()
-			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), fetcher, timeout)
-			err := svc.DeleteEventRoom(ctx, tt.eventID, tt.roomID, tt.ownerID)
+			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), fetcher, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
+			err := svc.DeleteEventRoom(ctx, tt.eventID, tt.roomID, tt.ownerID, tt.mode, tt.targetRoomID)
 			if tt.wantErr {
 				require.Error(t, err)
+				if tt.wantInvalid {
+					require.True(t, errors.Is(err, domain.ErrInvalidInput))
+				}
 				if tt.wantNotFound {
 					require.True(t, errors.Is(err, domain.ErrNotFound))
 				}
@@ -2055,10 +4029,36 @@ func TestEventService_DeleteEventRoom(t *testing.T) {
 				_, err := sr.GetRoomByID(ctx, tt.roomID)
 				require.True(t, errors.Is(err, domain.ErrNotFound), "room should be deleted")
 			}
+			if tt.wantSessionRooms != nil {
+				sessions, err := sessionRepo.ListSessionsByEventID(ctx, tt.eventID)
+				require.NoError(t, err)
+				got := make(map[string]string)
+				for _, sess := range sessions {
+					got[sess.ID] = sess.RoomID
+				}
+				assert.Equal(t, tt.wantSessionRooms, got)
+			}
 		})
 	}
 }
 
+// roomDeleteFixture has two rooms with one session each in ev-1 and a room in another event.
+func roomDeleteFixture() (domain.EventRepository, domain.SessionRepository, domain.SessionFetcher) {
+	er := newFakeEventRepo()
+	_ = er.Create(context.Background(), &domain.Event{Name: "Conf", OwnerID: "user-1", CreatedAt: time.Now(), UpdatedAt: time.Now()})
+	sr := newFakeSessionRepo()
+	sr.rooms = []*domain.Room{
+		{ID: "room-1", EventID: "ev-1", Name: "Room A"},
+		{ID: "room-2", EventID: "ev-1", Name: "Room B"},
+		{ID: "room-other", EventID: "ev-2", Name: "Elsewhere"},
+	}
+	sr.sessions = []*domain.Session{
+		{ID: "sess-1", EventID: "ev-1", RoomID: "room-1", Title: "Keynote"},
+		{ID: "sess-2", EventID: "ev-1", RoomID: "room-2", Title: "Workshop"},
+	}
+	return er, sr, &fakeSessionizeFetcher{}
+}
+
 func TestEventService_DeleteEventSession(t *testing.T) {
 	ctx := context.Background()
 	timeout := 5 * time.Second
@@ -2081,7 +4081,7 @@ func TestEventService_DeleteEventSession(t *testing.T) {
 				_ = er.Create(ctx, &domain.Event{Name: "Conf", OwnerID: "user-1", CreatedAt: time.Now(), UpdatedAt: time.Now()})
 				sr := newFakeSessionRepo()
 				sr.rooms = []*domain.Room{{ID: "room-1", EventID: "ev-1", Name: "Room A"}}
-				sr.sessions = []*domain.Session{{ID: "sess-1", RoomID: "room-1", Title: "Talk", StartTime: time.Now(), EndTime: time.Now(), CreatedAt: time.Now(), UpdatedAt: time.Now()}}
+				sr.sessions = []*domain.Session{{ID: "sess-1", EventID: "ev-1", RoomID: "room-1", Title: "Talk", StartTime: time.Now(), EndTime: time.Now(), CreatedAt: time.Now(), UpdatedAt: time.Now()}}
 				return er, sr, &fakeSessionizeFetcher{}
 			},
 			eventID:       "ev-1",
@@ -2107,7 +4107,7 @@ func TestEventService_DeleteEventSession(t *testing.T) {
 				_ = er.Create(ctx, &domain.Event{Name: "Conf", OwnerID: "user-1", CreatedAt: time.Now(), UpdatedAt: time.Now()})
 				sr := newFakeSessionRepo()
 				sr.rooms = []*domain.Room{{ID: "room-1", EventID: "ev-1", Name: "Room A"}}
-				sr.sessions = []*domain.Session{{ID: "sess-1", RoomID: "room-1", Title: "Talk", StartTime: time.Now(), EndTime: time.Now(), CreatedAt: time.Now(), UpdatedAt: time.Now()}}
+				sr.sessions = []*domain.Session{{ID: "sess-1", EventID: "ev-1", RoomID: "room-1", Title: "Talk", StartTime: time.Now(), EndTime: time.Now(), CreatedAt: time.Now(), UpdatedAt: time.Now()}}
 				return er, sr, &fakeSessionizeFetcher{}
 			},
 			eventID:       "ev-1",
@@ -2138,7 +4138,7 @@ func TestEventService_DeleteEventSession(t *testing.T) {
 				_ = er.Create(ctx, &domain.Event{Name: "Conf", OwnerID: "user-1", CreatedAt: time.Now(), UpdatedAt: time.Now()})
 				sr := newFakeSessionRepo()
 				sr.rooms = []*domain.Room{{ID: "room-1", EventID: "ev-other", Name: "Room A"}}
-				sr.sessions = []*domain.Session{{ID: "sess-1", RoomID: "room-1", Title: "Talk", StartTime: time.Now(), EndTime: time.Now(), CreatedAt: time.Now(), UpdatedAt: time.Now()}}
+				sr.sessions = []*domain.Session{{ID: "sess-1", EventID: "ev-other", RoomID: "room-1", Title: "Talk", StartTime: time.Now(), EndTime: time.Now(), CreatedAt: time.Now(), UpdatedAt: time.Now()}}
 				return er, sr, &fakeSessionizeFetcher{}
 			},
 			eventID:      "ev-1",
@@ -2152,7 +4152,7 @@ func TestEventService_DeleteEventSession(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			eventRepo, sessionRepo, fetcher := tt.setup()
-			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), fetcher, timeout)
+			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), fetcher, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 			err := svc.DeleteEventSession(ctx, tt.eventID, tt.sessionID, tt.ownerID)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -2361,8 +4361,19 @@ func TestEventService_CreateEventSession(t *testing.T) {
 				newFakeEventTeamMemberRepo(),
 				newFakeUserRepoForSchedule(),
 				newFakeEventInvitationRepo(),
+				newFakeImportMappingRepo(),
+				newFakeSpeakerMergeRepo(),
+				newFakeIntegrityRepo(),
+				newFakeScheduleRulesRepo(),
+				newFakeOperatingHoursRepo(),
+				newFakeSessionChangeRepo(),
+				newFakeChecklistRepo(),
+				newFakeScheduleGridRepo(),
+				newFakeCustomFieldRepo(),
 				newFakeEmailService(),
 				fetcher,
+				SchedulePolicy{},
+				domain.DefaultEventCodeFormat,
 				timeout,
 			)
 
@@ -2476,7 +4487,7 @@ func TestEventService_ListEventSpeakers(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			eventRepo, sessionRepo, fetcher := tt.setup()
-			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), fetcher, timeout)
+			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), fetcher, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 			speakers, err := svc.ListEventSpeakers(ctx, tt.eventID, tt.ownerID)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -2519,7 +4530,7 @@ func TestEventService_GetEventSpeaker(t *testing.T) {
 				_ = er.Create(ctx, &domain.Event{Name: "Conf", OwnerID: "user-1", CreatedAt: time.Now(), UpdatedAt: time.Now()})
 				sr := newFakeSessionRepo()
 				sr.rooms = []*domain.Room{{ID: "room-1", EventID: "ev-1", Name: "Room A"}}
-				sr.sessions = []*domain.Session{{ID: "sess-1", RoomID: "room-1", Title: "Talk", StartTime: time.Now(), EndTime: time.Now(), CreatedAt: time.Now(), UpdatedAt: time.Now()}}
+				sr.sessions = []*domain.Session{{ID: "sess-1", EventID: "ev-1", RoomID: "room-1", Title: "Talk", StartTime: time.Now(), EndTime: time.Now(), CreatedAt: time.Now(), UpdatedAt: time.Now()}}
 				sr.speakers = []*domain.Speaker{{ID: "sp-1", EventID: "ev-1", FirstName: "Alice", LastName: ""}}
 				sr.sessionSpeakers = []struct{ sessionID, speakerID string }{{"sess-1", "sp-1"}}
 				return er, sr, &fakeSessionizeFetcher{}
@@ -2595,7 +4606,7 @@ func TestEventService_GetEventSpeaker(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			eventRepo, sessionRepo, fetcher := tt.setup()
-			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), fetcher, timeout)
+			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), fetcher, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 			speaker, sessions, err := svc.GetEventSpeaker(ctx, tt.eventID, tt.speakerID, tt.ownerID)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -2688,7 +4699,7 @@ func TestEventService_DeleteEventSpeaker(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			eventRepo, sessionRepo, fetcher := tt.setup()
-			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), fetcher, timeout)
+			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), fetcher, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 			err := svc.DeleteEventSpeaker(ctx, tt.eventID, tt.speakerID, tt.ownerID)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -2801,8 +4812,8 @@ func TestEventService_CreateEventSpeaker(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			eventRepo, sessionRepo, fetcher := tt.setup()
-			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), fetcher, timeout)
-			speaker, err := svc.CreateEventSpeaker(ctx, tt.eventID, tt.ownerID, tt.firstName, tt.lastName, tt.bio, tt.tagLine, tt.profilePicture, tt.isTopSpeaker)
+			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), fetcher, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
+			speaker, err := svc.CreateEventSpeaker(ctx, tt.eventID, tt.ownerID, tt.firstName, tt.lastName, "", tt.bio, tt.tagLine, tt.profilePicture, tt.isTopSpeaker)
 			if tt.wantErr {
 				require.Error(t, err)
 				if tt.wantNotFound {
@@ -2902,7 +4913,7 @@ func TestEventService_AddEventTeamMember(t *testing.T) {
 			if tt.setupTeamRepo != nil {
 				tt.setupTeamRepo(teamRepo)
 			}
-			svc := NewEventService(eventRepo, newFakeSessionRepo(), newFakeTagRepo(), teamRepo, newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, timeout)
+			svc := NewEventService(eventRepo, newFakeSessionRepo(), newFakeTagRepo(), teamRepo, newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 			err := svc.AddEventTeamMember(ctx, tt.eventID, tt.userIDToAdd, tt.ownerID)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -2992,7 +5003,7 @@ func TestEventService_ListEventTeamMembers(t *testing.T) {
 			if tt.setupTeamRepo != nil {
 				tt.setupTeamRepo(teamRepo)
 			}
-			svc := NewEventService(eventRepo, newFakeSessionRepo(), newFakeTagRepo(), teamRepo, newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, timeout)
+			svc := NewEventService(eventRepo, newFakeSessionRepo(), newFakeTagRepo(), teamRepo, newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 			got, err := svc.ListEventTeamMembers(ctx, tt.eventID, tt.callerID)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -3111,7 +5122,7 @@ func TestEventService_ListEventInvitations(t *testing.T) {
 			if tt.setupInvitation != nil {
 				tt.setupInvitation(invRepo)
 			}
-			svc := NewEventService(eventRepo, newFakeSessionRepo(), newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), invRepo, newFakeEmailService(), &fakeSessionizeFetcher{}, timeout)
+			svc := NewEventService(eventRepo, newFakeSessionRepo(), newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), invRepo, newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 			got, total, err := svc.ListEventInvitations(ctx, tt.eventID, tt.callerID, tt.search, tt.params)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -3130,6 +5141,92 @@ func TestEventService_ListEventInvitations(t *testing.T) {
 	}
 }
 
+func TestEventService_StreamEventInvitations(t *testing.T) {
+	ctx := context.Background()
+	timeout := 5 * time.Second
+
+	tests := []struct {
+		name       string
+		eventID    string
+		callerID   string
+		search     string
+		wantEmails []string
+		wantErr    error
+	}{
+		{name: "owner streams matching invitations", eventID: "ev-1", callerID: "user-1", search: "example", wantEmails: []string{"a@example.com", "b@example.com"}},
+		{name: "forbidden not owner", eventID: "ev-1", callerID: "user-other", wantErr: domain.ErrForbidden},
+		{name: "event not found", eventID: "ev-missing", callerID: "user-1", wantErr: domain.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventRepo := newFakeEventRepo()
+			eventRepo.Create(ctx, &domain.Event{ID: "ev-1", Name: "Conf", OwnerID: "user-1", CreatedAt: time.Now(), UpdatedAt: time.Now()})
+			invRepo := newFakeEventInvitationRepo()
+			_ = invRepo.Create(ctx, &domain.EventInvitation{EventID: "ev-1", Email: "a@example.com", SentAt: time.Now()})
+			_ = invRepo.Create(ctx, &domain.EventInvitation{EventID: "ev-1", Email: "b@example.com", SentAt: time.Now()})
+			_ = invRepo.Create(ctx, &domain.EventInvitation{EventID: "ev-1", Email: "c@other.com", SentAt: time.Now()})
+			svc := NewEventService(eventRepo, newFakeSessionRepo(), newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), invRepo, newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
+
+			var got []string
+			err := svc.StreamEventInvitations(ctx, tt.eventID, tt.callerID, tt.search, func(inv *domain.EventInvitation) error {
+				got = append(got, inv.Email)
+				return nil
+			})
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Empty(t, got)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantEmails, got)
+		})
+	}
+}
+
+func TestEventService_StreamEventSessions(t *testing.T) {
+	ctx := context.Background()
+	timeout := 5 * time.Second
+	stopErr := errors.New("client gone")
+
+	tests := []struct {
+		name    string
+		eventID string
+		ownerID string
+		fnErr   error
+		wantIDs []string
+		wantErr error
+	}{
+		{name: "owner streams sessions", eventID: "ev-1", ownerID: "user-1", wantIDs: []string{"sess-1", "sess-2"}},
+		{name: "callback error is returned", eventID: "ev-1", ownerID: "user-1", fnErr: stopErr, wantIDs: []string{"sess-1"}, wantErr: stopErr},
+		{name: "forbidden not owner", eventID: "ev-1", ownerID: "user-other", wantErr: domain.ErrForbidden},
+		{name: "event not found", eventID: "ev-missing", ownerID: "user-1", wantErr: domain.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventRepo := newFakeEventRepo()
+			eventRepo.Create(ctx, &domain.Event{ID: "ev-1", Name: "Conf", OwnerID: "user-1", CreatedAt: time.Now(), UpdatedAt: time.Now()})
+			sessionRepo := newFakeSessionRepo()
+			sessionRepo.rooms = []*domain.Room{{ID: "room-1", EventID: "ev-1"}, {ID: "room-9", EventID: "ev-9"}}
+			sessionRepo.sessions = []*domain.Session{{ID: "sess-1", EventID: "ev-1", RoomID: "room-1"}, {ID: "sess-2", EventID: "ev-1", RoomID: "room-1"}, {ID: "sess-9", EventID: "ev-9", RoomID: "room-9"}}
+			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
+
+			var got []string
+			err := svc.StreamEventSessions(ctx, tt.eventID, tt.ownerID, func(sess *domain.Session) error {
+				got = append(got, sess.ID)
+				return tt.fnErr
+			})
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.wantIDs, got)
+		})
+	}
+}
+
 func TestEventService_RemoveEventTeamMember(t *testing.T) {
 	ctx := context.Background()
 	timeout := 5 * time.Second
@@ -3202,7 +5299,7 @@ func TestEventService_RemoveEventTeamMember(t *testing.T) {
 			if tt.setupTeamRepo != nil {
 				tt.setupTeamRepo(teamRepo)
 			}
-			svc := NewEventService(eventRepo, newFakeSessionRepo(), newFakeTagRepo(), teamRepo, newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, timeout)
+			svc := NewEventService(eventRepo, newFakeSessionRepo(), newFakeTagRepo(), teamRepo, newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 			err := svc.RemoveEventTeamMember(ctx, tt.eventID, tt.userIDToRemove, tt.ownerID)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -3290,7 +5387,7 @@ func TestEventService_AddEventTeamMemberByEmail(t *testing.T) {
 			if tt.setupUserRepo != nil {
 				tt.setupUserRepo(userRepo)
 			}
-			svc := NewEventService(eventRepo, newFakeSessionRepo(), newFakeTagRepo(), teamRepo, userRepo, newFakeEventInvitationRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, timeout)
+			svc := NewEventService(eventRepo, newFakeSessionRepo(), newFakeTagRepo(), teamRepo, userRepo, newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 			got, err := svc.AddEventTeamMemberByEmail(ctx, tt.eventID, tt.email, tt.ownerID)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -3310,6 +5407,122 @@ func TestEventService_AddEventTeamMemberByEmail(t *testing.T) {
 	}
 }
 
+func TestEventService_PromoteInvitation(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		invitationID string
+		callerID     string
+		role         string
+		wantErr      error
+	}{
+		{name: "adds the invitee", invitationID: "inv-1", callerID: "user-1"},
+		{name: "explicit team_member role", invitationID: "inv-1", callerID: "user-1", role: domain.EventRoleTeamMember},
+		{name: "other roles rejected", invitationID: "inv-1", callerID: "user-1", role: domain.EventRoleOwner, wantErr: domain.ErrInvalidInput},
+		{name: "not owner", invitationID: "inv-1", callerID: "user-2", wantErr: domain.ErrForbidden},
+		{name: "unknown invitation", invitationID: "inv-9", callerID: "user-1", wantErr: domain.ErrInvitationNotFound},
+		{name: "invitation of another event", invitationID: "inv-2", callerID: "user-1", wantErr: domain.ErrInvitationNotFound},
+		{name: "invitee without account", invitationID: "inv-3", callerID: "user-1", wantErr: domain.ErrUserNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventRepo := newFakeEventRepo()
+			eventRepo.byID["ev-1"] = &domain.Event{ID: "ev-1", OwnerID: "user-1"}
+			invRepo := newFakeEventInvitationRepo()
+			invRepo.invitations = []*domain.EventInvitation{
+				{ID: "inv-1", EventID: "ev-1", Email: "ada@example.com"},
+				{ID: "inv-2", EventID: "ev-2", Email: "ada@example.com"},
+				{ID: "inv-3", EventID: "ev-1", Email: "nobody@example.com"},
+			}
+			userRepo := newFakeUserRepoForSchedule()
+			userRepo.addUserWithName("ada@example.com", "user-ada", "Ada", "Lovelace")
+			teamRepo := newFakeEventTeamMemberRepo()
+			svc := newTestEventService(eventRepo, newFakeSessionRepo(), &fakeSessionizeFetcher{}, 5*time.Second)
+			svc.invitationRepo = invRepo
+			svc.userRepo = userRepo
+			svc.eventTeamMemberRepo = teamRepo
+
+			member, err := svc.PromoteInvitation(ctx, "ev-1", tt.invitationID, tt.callerID, tt.role)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, &domain.EventTeamMember{EventID: "ev-1", UserID: "user-ada", Name: "Ada", LastName: "Lovelace", Email: "ada@example.com"}, member)
+			members, err := teamRepo.ListByEventID(ctx, "ev-1")
+			require.NoError(t, err)
+			require.Len(t, members, 1)
+			assert.Equal(t, "user-ada", members[0].UserID)
+		})
+	}
+}
+
+func TestEventService_LeaveEvent(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func() (*eventService, *fakeEventTeamMemberRepo, *fakeEmailService) {
+		eventRepo := newFakeEventRepo()
+		eventRepo.byID["ev-1"] = &domain.Event{ID: "ev-1", Name: "GopherCon", OwnerID: "user-owner"}
+		userRepo := newFakeUserRepoForSchedule()
+		userRepo.addUserWithName("owner@example.com", "user-owner", "Olive", "Owner")
+		userRepo.addUserWithName("ada@example.com", "user-ada", "Ada", "Lovelace")
+		teamRepo := newFakeEventTeamMemberRepo()
+		require.NoError(t, teamRepo.Add(ctx, "ev-1", "user-ada"))
+		emails := newFakeEmailService()
+		svc := newTestEventService(eventRepo, newFakeSessionRepo(), &fakeSessionizeFetcher{}, 5*time.Second)
+		svc.userRepo = userRepo
+		svc.eventTeamMemberRepo = teamRepo
+		svc.emailService = emails
+		return svc, teamRepo, emails
+	}
+
+	t.Run("member leaves and the owner is told", func(t *testing.T) {
+		svc, teamRepo, emails := setup()
+		notified, err := svc.LeaveEvent(ctx, "ev-1", "user-ada")
+		require.NoError(t, err)
+		assert.True(t, notified)
+		members, err := teamRepo.ListByEventID(ctx, "ev-1")
+		require.NoError(t, err)
+		assert.Empty(t, members)
+		require.Len(t, emails.sentTeamMemberLeft, 1)
+		assert.Equal(t, &domain.TeamMemberLeftEmailData{
+			EventID: "ev-1", Email: "owner@example.com", OwnerName: "Olive Owner", MemberName: "Ada Lovelace", MemberEmail: "ada@example.com", EventName: "GopherCon",
+		}, emails.sentTeamMemberLeft[0])
+	})
+
+	t.Run("failed notice does not undo the leave", func(t *testing.T) {
+		svc, teamRepo, emails := setup()
+		emails.sendTeamMemberLeftErr = errors.New("smtp down")
+		notified, err := svc.LeaveEvent(ctx, "ev-1", "user-ada")
+		require.NoError(t, err)
+		assert.False(t, notified)
+		members, err := teamRepo.ListByEventID(ctx, "ev-1")
+		require.NoError(t, err)
+		assert.Empty(t, members)
+	})
+
+	t.Run("not a member", func(t *testing.T) {
+		svc, _, emails := setup()
+		_, err := svc.LeaveEvent(ctx, "ev-1", "user-stranger")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+		assert.Empty(t, emails.sentTeamMemberLeft)
+	})
+
+	t.Run("owner cannot leave", func(t *testing.T) {
+		svc, _, _ := setup()
+		_, err := svc.LeaveEvent(ctx, "ev-1", "user-owner")
+		require.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+
+	t.Run("unknown event", func(t *testing.T) {
+		svc, _, _ := setup()
+		_, err := svc.LeaveEvent(ctx, "ev-9", "user-ada")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
 func TestEventService_SendEventInvitations(t *testing.T) {
 	ctx := context.Background()
 	timeout := 5 * time.Second
@@ -3322,8 +5535,10 @@ func TestEventService_SendEventInvitations(t *testing.T) {
 		setupEvent       func(*fakeEventRepo)
 		setupUser        func(*fakeUserRepoForSchedule)
 		setupEmail       func(*fakeEmailService)
+		domainRules      *domain.InvitationDomainRules
 		wantSent         int
 		wantFailed       []string
+		wantSkipped      []string
 		wantErr          bool
 		wantErrNotFound  bool
 		wantErrForbidden bool
@@ -3403,6 +5618,20 @@ func TestEventService_SendEventInvitations(t *testing.T) {
 			wantFailed: nil,
 			wantErr:    false,
 		},
+		{
+			name:    "domain rules skip addresses they do not permit",
+			eventID: "ev-1",
+			ownerID: "user-1",
+			emails:  []string{"a@acme.com", "b@eu.acme.com", "c@gmail.com", "d@blocked.acme.com"},
+			setupEvent: func(er *fakeEventRepo) {
+				er.byID["ev-1"] = &domain.Event{ID: "ev-1", Name: "My Event", EventCode: "abc1", OwnerID: "user-1", CreatedAt: time.Now(), UpdatedAt: time.Now()}
+			},
+			setupUser:   func(ur *fakeUserRepoForSchedule) {},
+			setupEmail:  func(*fakeEmailService) {},
+			domainRules: &domain.InvitationDomainRules{EventID: "ev-1", AllowedDomains: []string{"acme.com"}, BlockedDomains: []string{"blocked.acme.com"}},
+			wantSent:    2,
+			wantSkipped: []string{"c@gmail.com", "d@blocked.acme.com"},
+		},
 		{
 			name:    "duplicate email in list: first sent, second failed",
 			eventID: "ev-1",
@@ -3432,13 +5661,16 @@ func TestEventService_SendEventInvitations(t *testing.T) {
 				tt.setupUser(userRepo)
 			}
 			invRepo := newFakeEventInvitationRepo()
+			if tt.domainRules != nil {
+				invRepo.domainRules = map[string]*domain.InvitationDomainRules{tt.eventID: tt.domainRules}
+			}
 			emailSvc := newFakeEmailService()
 			if tt.setupEmail != nil {
 				tt.setupEmail(emailSvc)
 			}
-			svc := NewEventService(eventRepo, newFakeSessionRepo(), newFakeTagRepo(), newFakeEventTeamMemberRepo(), userRepo, invRepo, emailSvc, &fakeSessionizeFetcher{}, timeout)
+			svc := NewEventService(eventRepo, newFakeSessionRepo(), newFakeTagRepo(), newFakeEventTeamMemberRepo(), userRepo, invRepo, newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), emailSvc, &fakeSessionizeFetcher{}, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 
-			sent, failed, err := svc.SendEventInvitations(ctx, tt.eventID, tt.ownerID, tt.emails)
+			sent, failed, skipped, err := svc.SendEventInvitations(ctx, tt.eventID, tt.ownerID, tt.emails)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -3453,6 +5685,7 @@ func TestEventService_SendEventInvitations(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, tt.wantSent, sent)
 			require.ElementsMatch(t, tt.wantFailed, failed)
+			require.ElementsMatch(t, tt.wantSkipped, skipped)
 			if tt.wantSent > 0 && len(tt.emails) > 0 {
 				list, _, _ := invRepo.ListByEventID(ctx, tt.eventID, "", domain.PaginationParams{Page: 1, PageSize: 1000})
 				require.Len(t, list, tt.wantSent, "invitations persisted should match sent count")
@@ -3503,7 +5736,7 @@ func TestEventService_UpdateSessionSchedule(t *testing.T) {
 					{ID: "room-2", EventID: "ev-1", Name: "Room B"},
 				}
 				sr.sessions = []*domain.Session{
-					{ID: "sess-1", RoomID: "room-1", Title: "Talk 1", StartTime: baseStart, EndTime: baseEnd},
+					{ID: "sess-1", EventID: "ev-1", RoomID: "room-1", Title: "Talk 1", StartTime: baseStart, EndTime: baseEnd},
 				}
 				return er, sr, &fakeSessionizeFetcher{}
 			},
@@ -3533,7 +5766,7 @@ func TestEventService_UpdateSessionSchedule(t *testing.T) {
 					{ID: "room-1", EventID: "ev-1", Name: "Room A"},
 				}
 				sr.sessions = []*domain.Session{
-					{ID: "sess-1", RoomID: "room-1", Title: "Talk 1", StartTime: baseStart, EndTime: baseEnd},
+					{ID: "sess-1", EventID: "ev-1", RoomID: "room-1", Title: "Talk 1", StartTime: baseStart, EndTime: baseEnd},
 				}
 				return er, sr, &fakeSessionizeFetcher{}
 			},
@@ -3562,7 +5795,7 @@ func TestEventService_UpdateSessionSchedule(t *testing.T) {
 					{ID: "room-2", EventID: "ev-1", Name: "Room B"},
 				}
 				sr.sessions = []*domain.Session{
-					{ID: "sess-1", RoomID: "room-1", Title: "Talk 1", StartTime: baseStart, EndTime: baseEnd},
+					{ID: "sess-1", EventID: "ev-1", RoomID: "room-1", Title: "Talk 1", StartTime: baseStart, EndTime: baseEnd},
 				}
 				return er, sr, &fakeSessionizeFetcher{}
 			},
@@ -3632,7 +5865,7 @@ func TestEventService_UpdateSessionSchedule(t *testing.T) {
 					{ID: "room-1", EventID: "ev-99", Name: "Other Event Room"},
 				}
 				sr.sessions = []*domain.Session{
-					{ID: "sess-1", RoomID: "room-1", Title: "Talk 1", StartTime: baseStart, EndTime: baseEnd},
+					{ID: "sess-1", EventID: "ev-99", RoomID: "room-1", Title: "Talk 1", StartTime: baseStart, EndTime: baseEnd},
 				}
 				return er, sr, &fakeSessionizeFetcher{}
 			},
@@ -3655,7 +5888,7 @@ func TestEventService_UpdateSessionSchedule(t *testing.T) {
 					{ID: "room-2", EventID: "ev-99", Name: "Other Event Room"},
 				}
 				sr.sessions = []*domain.Session{
-					{ID: "sess-1", RoomID: "room-1", Title: "Talk 1", StartTime: baseStart, EndTime: baseEnd},
+					{ID: "sess-1", EventID: "ev-1", RoomID: "room-1", Title: "Talk 1", StartTime: baseStart, EndTime: baseEnd},
 				}
 				return er, sr, &fakeSessionizeFetcher{}
 			},
@@ -3678,7 +5911,7 @@ func TestEventService_UpdateSessionSchedule(t *testing.T) {
 					{ID: "room-1", EventID: "ev-1", Name: "Room A"},
 				}
 				sr.sessions = []*domain.Session{
-					{ID: "sess-1", RoomID: "room-1", Title: "Talk 1", StartTime: baseStart, EndTime: baseEnd},
+					{ID: "sess-1", EventID: "ev-1", RoomID: "room-1", Title: "Talk 1", StartTime: baseStart, EndTime: baseEnd},
 				}
 				return er, sr, &fakeSessionizeFetcher{}
 			},
@@ -3697,7 +5930,7 @@ func TestEventService_UpdateSessionSchedule(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			eventRepo, sessionRepo, fetcher := tt.setup()
-			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), fetcher, timeout)
+			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), fetcher, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 			got, err := svc.UpdateSessionSchedule(ctx, tt.args.eventID, tt.args.sessionID, tt.args.ownerID, tt.args.roomID, tt.args.startTime, tt.args.endTime)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -3721,6 +5954,292 @@ func TestEventService_UpdateSessionSchedule(t *testing.T) {
 	}
 }
 
+func TestEventService_SessionTimeConflict(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	er := newFakeEventRepo()
+	_ = er.Create(ctx, &domain.Event{ID: "ev-1", Name: "Conf", OwnerID: "user-1"})
+	sr := newFakeSessionRepo()
+	sr.rooms = []*domain.Room{
+		{ID: "room-1", EventID: "ev-1", Name: "Room A"},
+		{ID: "room-2", EventID: "ev-1", Name: "Room B"},
+	}
+	sr.sessions = []*domain.Session{
+		{ID: "sess-1", EventID: "ev-1", RoomID: "room-1", Title: "Keynote", StartTime: start, EndTime: start.Add(time.Hour)},
+		{ID: "sess-2", EventID: "ev-1", RoomID: "room-2", Title: "Workshop", StartTime: start, EndTime: start.Add(time.Hour)},
+	}
+	svc := newTestEventService(er, sr, &fakeSessionizeFetcher{}, 5*time.Second)
+	svc.policy = SchedulePolicy{Tolerance: time.Minute}
+
+	_, err := svc.CreateEventSession(ctx, "ev-1", "user-1", "room-1", "Talk", "", start.Add(30*time.Minute), start.Add(90*time.Minute), nil, nil)
+	require.ErrorIs(t, err, domain.ErrSessionTimeConflict)
+	assert.Contains(t, err.Error(), "Keynote")
+	_, err = svc.CreateEventSession(ctx, "ev-1", "user-1", "room-1", "Talk", "", start.Add(59*time.Minute), start.Add(2*time.Hour), nil, nil)
+	require.NoError(t, err, "an overlap within the tolerance is clock skew")
+
+	room1 := "room-1"
+	_, err = svc.UpdateSessionSchedule(ctx, "ev-1", "sess-2", "user-1", &room1, nil, nil)
+	require.ErrorIs(t, err, domain.ErrSessionTimeConflict, "moving into a busy room")
+	later := start.Add(30 * time.Minute)
+	_, err = svc.UpdateSessionSchedule(ctx, "ev-1", "sess-1", "user-1", nil, &later, nil)
+	require.NoError(t, err, "a session does not conflict with itself")
+}
+
+func TestEventService_CustomFieldQuota(t *testing.T) {
+	ctx := context.Background()
+	er := newFakeEventRepo()
+	_ = er.Create(ctx, &domain.Event{Name: "Conf", OwnerID: "user-1"})
+	svc := newTestEventService(er, newFakeSessionRepo(), &fakeSessionizeFetcher{}, 5*time.Second)
+
+	for i := range domain.MaxCustomFields {
+		_, err := svc.CreateCustomField(ctx, "ev-1", "user-1", &domain.CustomField{Name: fmt.Sprintf("Field %d", i), Type: domain.CustomFieldText, AppliesTo: domain.CustomFieldSession})
+		require.NoError(t, err)
+	}
+	_, err := svc.CreateCustomField(ctx, "ev-1", "user-1", &domain.CustomField{Name: "One more", Type: domain.CustomFieldText, AppliesTo: domain.CustomFieldSession})
+	require.ErrorIs(t, err, domain.ErrQuotaExceeded)
+}
+
+func TestEventService_UpdateSessionSchedule_RecordsHistory(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	newStart := start.Add(2 * time.Hour)
+	newEnd := newStart.Add(time.Hour)
+	roomB := "room-2"
+
+	setup := func() (*eventService, *fakeSessionChangeRepo) {
+		er := newFakeEventRepo()
+		_ = er.Create(ctx, &domain.Event{Name: "Conf", OwnerID: "user-1"})
+		sr := newFakeSessionRepo()
+		sr.rooms = []*domain.Room{
+			{ID: "room-1", EventID: "ev-1", Name: "Room A"},
+			{ID: "room-2", EventID: "ev-1", Name: "Room B"},
+		}
+		sr.sessions = []*domain.Session{
+			{ID: "sess-1", EventID: "ev-1", RoomID: "room-1", Title: "Talk", StartTime: start, EndTime: end},
+		}
+		changes := newFakeSessionChangeRepo()
+		svc := newTestEventService(er, sr, &fakeSessionizeFetcher{}, 5*time.Second)
+		svc.sessionChangeRepo = changes
+		return svc, changes
+	}
+
+	t.Run("room and time move", func(t *testing.T) {
+		svc, changes := setup()
+		_, err := svc.UpdateSessionSchedule(ctx, "ev-1", "sess-1", "user-1", &roomB, &newStart, &newEnd)
+		require.NoError(t, err)
+		require.Len(t, changes.changes, 1)
+		c := changes.changes[0]
+		assert.Equal(t, "ev-1", c.EventID)
+		assert.Equal(t, "sess-1", c.SessionID)
+		assert.Equal(t, "room-1", c.FromRoomID)
+		assert.Equal(t, "Room A", c.FromRoomName)
+		assert.Equal(t, "room-2", c.ToRoomID)
+		assert.Equal(t, "Room B", c.ToRoomName)
+		assert.True(t, c.FromStartTime.Equal(start))
+		assert.True(t, c.FromEndTime.Equal(end))
+		assert.True(t, c.ToStartTime.Equal(newStart))
+		assert.True(t, c.ToEndTime.Equal(newEnd))
+	})
+
+	t.Run("time move keeps room names", func(t *testing.T) {
+		svc, changes := setup()
+		_, err := svc.UpdateSessionSchedule(ctx, "ev-1", "sess-1", "user-1", nil, &newStart, &newEnd)
+		require.NoError(t, err)
+		require.Len(t, changes.changes, 1)
+		assert.False(t, changes.changes[0].RoomChanged())
+		assert.True(t, changes.changes[0].TimeChanged())
+		assert.Equal(t, "Room A", changes.changes[0].ToRoomName)
+	})
+
+	t.Run("no move records nothing", func(t *testing.T) {
+		svc, changes := setup()
+		roomA := "room-1"
+		_, err := svc.UpdateSessionSchedule(ctx, "ev-1", "sess-1", "user-1", &roomA, nil, nil)
+		require.NoError(t, err)
+		assert.Empty(t, changes.changes)
+	})
+
+	t.Run("history failure does not fail the move", func(t *testing.T) {
+		svc, changes := setup()
+		changes.err = errors.New("db down")
+		ctx, failures := domain.WithBestEffortFailures(ctx)
+		sess, err := svc.UpdateSessionSchedule(ctx, "ev-1", "sess-1", "user-1", &roomB, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "room-2", sess.RoomID)
+		require.Len(t, failures.Errors(), 1, "the failure is reported instead")
+		assert.ErrorIs(t, failures.Errors()[0], changes.err)
+	})
+}
+
+func TestEventService_DeleteEventRoom_RecordsMoves(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		mode       string
+		target     string
+		wantRoomID string
+		wantName   string
+		wantMoves  int
+	}{
+		{name: "reassign", mode: domain.RoomDeleteReassign, target: "room-2", wantRoomID: "room-2", wantName: "Room B", wantMoves: 2},
+		{name: "unschedule", mode: domain.RoomDeleteUnschedule, wantMoves: 2},
+		{name: "cascade", mode: domain.RoomDeleteCascade},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			er := newFakeEventRepo()
+			_ = er.Create(ctx, &domain.Event{Name: "Conf", OwnerID: "user-1"})
+			sr := newFakeSessionRepo()
+			sr.rooms = []*domain.Room{
+				{ID: "room-1", EventID: "ev-1", Name: "Room A"},
+				{ID: "room-2", EventID: "ev-1", Name: "Room B"},
+			}
+			sr.sessions = []*domain.Session{
+				{ID: "sess-1", EventID: "ev-1", RoomID: "room-1", StartTime: start, EndTime: start.Add(time.Hour)},
+				{ID: "sess-2", EventID: "ev-1", RoomID: "room-1", StartTime: start.Add(time.Hour), EndTime: start.Add(2 * time.Hour)},
+				{ID: "sess-3", EventID: "ev-1", RoomID: "room-2", StartTime: start, EndTime: start.Add(time.Hour)},
+			}
+			changes := newFakeSessionChangeRepo()
+			svc := newTestEventService(er, sr, &fakeSessionizeFetcher{}, 5*time.Second)
+			svc.sessionChangeRepo = changes
+
+			require.NoError(t, svc.DeleteEventRoom(ctx, "ev-1", "room-1", "user-1", tt.mode, tt.target))
+			require.Len(t, changes.changes, tt.wantMoves)
+			for _, c := range changes.changes {
+				assert.Equal(t, "Room A", c.FromRoomName)
+				assert.Equal(t, tt.wantRoomID, c.ToRoomID)
+				assert.Equal(t, tt.wantName, c.ToRoomName)
+				assert.False(t, c.TimeChanged())
+			}
+		})
+	}
+}
+
+func TestEventService_PreviewDeleteEventRoom(t *testing.T) {
+	ctx := context.Background()
+	er := newFakeEventRepo()
+	_ = er.Create(ctx, &domain.Event{Name: "Conf", OwnerID: "user-1"})
+	sr := newFakeSessionRepo()
+	sr.rooms = []*domain.Room{
+		{ID: "room-1", EventID: "ev-1", Name: "Room A"},
+		{ID: "room-2", EventID: "ev-1", Name: "Room B"},
+	}
+	sr.sessions = []*domain.Session{
+		{ID: "sess-1", EventID: "ev-1", RoomID: "room-1", Title: "Keynote"},
+		{ID: "sess-2", EventID: "ev-1", RoomID: "room-2", Title: "Workshop"},
+	}
+	svc := newTestEventService(er, sr, &fakeSessionizeFetcher{}, 5*time.Second)
+
+	preview, err := svc.PreviewDeleteEventRoom(ctx, "ev-1", "room-1", "user-1", "", "")
+	require.NoError(t, err)
+	assert.Equal(t, domain.RoomDeleteCascade, preview.Mode)
+	assert.Equal(t, []*domain.DryRunChange{{ID: "sess-1", Name: "Keynote", Action: domain.DryRunDelete}}, preview.Sessions)
+
+	preview, err = svc.PreviewDeleteEventRoom(ctx, "ev-1", "room-1", "user-1", domain.RoomDeleteReassign, "room-2")
+	require.NoError(t, err)
+	assert.Equal(t, []*domain.DryRunChange{{ID: "sess-1", Name: "Keynote", Action: domain.DryRunMove}}, preview.Sessions)
+	require.Len(t, sr.rooms, 2, "a preview changes nothing")
+
+	_, err = svc.PreviewDeleteEventRoom(ctx, "ev-1", "room-1", "user-1", domain.RoomDeleteReassign, "room-1")
+	require.ErrorIs(t, err, domain.ErrInvalidInput)
+	_, err = svc.PreviewDeleteEventRoom(ctx, "ev-1", "room-1", "user-2", "", "")
+	require.ErrorIs(t, err, domain.ErrForbidden)
+}
+func TestEventService_ListSessionHistory(t *testing.T) {
+	ctx := context.Background()
+	er := newFakeEventRepo()
+	_ = er.Create(ctx, &domain.Event{Name: "Conf", OwnerID: "user-1"})
+	_ = er.Create(ctx, &domain.Event{Name: "Other", OwnerID: "user-1"})
+	sr := newFakeSessionRepo()
+	sr.sessions = []*domain.Session{{ID: "sess-1", EventID: "ev-1"}}
+	changes := newFakeSessionChangeRepo()
+	changes.changes = []*domain.SessionChange{
+		{ID: "c1", EventID: "ev-1", SessionID: "sess-1", FromRoomName: "Room A", ToRoomName: "Room B"},
+		{ID: "c2", EventID: "ev-1", SessionID: "sess-9"},
+	}
+	svc := newTestEventService(er, sr, &fakeSessionizeFetcher{}, 5*time.Second)
+	svc.sessionChangeRepo = changes
+
+	got, err := svc.ListSessionHistory(ctx, "ev-1", "sess-1", "user-1")
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "c1", got[0].ID)
+
+	_, err = svc.ListSessionHistory(ctx, "ev-1", "sess-1", "user-2")
+	require.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = svc.ListSessionHistory(ctx, "ev-2", "sess-1", "user-1")
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	_, err = svc.ListSessionHistory(ctx, "ev-1", "sess-missing", "user-1")
+	require.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestEventService_GetEventScheduleAsOf(t *testing.T) {
+	ctx := context.Background()
+	asOf := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	at := func(h, m int) time.Time { return time.Date(2025, 3, 2, h, m, 0, 0, time.UTC) }
+	before, after := asOf.Add(-24*time.Hour), asOf.Add(time.Hour)
+
+	er := newFakeEventRepo()
+	_ = er.Create(ctx, &domain.Event{Name: "Conf", OwnerID: "owner1"})
+	sr := newFakeSessionRepo()
+	sr.rooms = []*domain.Room{
+		{ID: "r1", EventID: "ev-1", Name: "Hall A", CreatedAt: before},
+		{ID: "r2", EventID: "ev-1", Name: "Hall B", CreatedAt: before},
+		{ID: "r3", EventID: "ev-1", Name: "Hall C", CreatedAt: after},
+	}
+	moved := &domain.Session{ID: "s1", EventID: "ev-1", RoomID: "r2", StartTime: at(11, 0), EndTime: at(12, 0), CreatedAt: before, UpdatedAt: asOf.Add(3 * time.Hour)}
+	sr.sessions = []*domain.Session{
+		moved,
+		{ID: "s2", EventID: "ev-1", RoomID: "r1", StartTime: at(14, 0), EndTime: at(15, 0), CreatedAt: before, UpdatedAt: before},
+		{ID: "s3", EventID: "ev-1", RoomID: "r3", StartTime: at(9, 0), EndTime: at(10, 0), CreatedAt: after, UpdatedAt: after},
+		{ID: "s4", EventID: "ev-1", RoomID: "r1", StartTime: at(16, 0), EndTime: at(17, 0), CreatedAt: before, UpdatedAt: after},
+	}
+	changes := newFakeSessionChangeRepo()
+	changes.changes = []*domain.SessionChange{
+		{EventID: "ev-1", SessionID: "s4", FromRoomID: "r2", ToRoomID: "r1", FromStartTime: at(16, 0), FromEndTime: at(17, 0), ToStartTime: at(16, 0), ToEndTime: at(17, 0), ChangedAt: asOf.Add(-time.Hour)},
+		{EventID: "ev-1", SessionID: "s1", FromRoomID: "r1", ToRoomID: "r2", FromStartTime: at(10, 0), FromEndTime: at(11, 0), ToStartTime: at(10, 30), ToEndTime: at(11, 30), ChangedAt: asOf.Add(time.Hour)},
+		{EventID: "ev-1", SessionID: "s2", FromRoomID: "r-gone", FromRoomName: "Annex", ToRoomID: "r1", FromStartTime: at(14, 0), FromEndTime: at(15, 0), ToStartTime: at(14, 0), ToEndTime: at(15, 0), ChangedAt: asOf.Add(2 * time.Hour)},
+		{EventID: "ev-1", SessionID: "s1", FromRoomID: "r2", ToRoomID: "r2", FromStartTime: at(10, 30), FromEndTime: at(11, 30), ToStartTime: at(11, 0), ToEndTime: at(12, 0), ChangedAt: asOf.Add(3 * time.Hour)},
+	}
+	changes.deleted = map[string]time.Time{"s5": after, "s0": before}
+	svc := newTestEventService(er, sr, &fakeSessionizeFetcher{}, 5*time.Second)
+	svc.sessionChangeRepo = changes
+
+	snapshot, err := svc.GetEventScheduleAsOf(ctx, "ev-1", "owner1", asOf)
+	require.NoError(t, err)
+	got := make(map[string][]string)
+	var rooms []string
+	for _, room := range snapshot.Rooms {
+		rooms = append(rooms, room.Room.Name)
+		for _, sess := range room.Sessions {
+			got[room.Room.Name] = append(got[room.Room.Name], sess.ID+"@"+sess.StartTime.Format("15:04"))
+		}
+	}
+	assert.Equal(t, []string{"Hall A", "Hall B", "Annex"}, rooms)
+	assert.Equal(t, []string{"s1@10:00", "s4@16:00"}, got["Hall A"])
+	assert.Equal(t, []string{"s2@14:00"}, got["Annex"], "rooms deleted since come back")
+	assert.Empty(t, got["Hall B"])
+	assert.Equal(t, "r2", moved.RoomID, "the current session is left as is")
+	assert.True(t, moved.StartTime.Equal(at(11, 0)))
+
+	assert.True(t, snapshot.AsOf.Equal(asOf))
+	assert.True(t, snapshot.Partial)
+	assert.Equal(t, []string{"s5"}, snapshot.DeletedSessionIDs)
+	assert.Equal(t, []string{"s4"}, snapshot.EditedSessionIDs, "s1 was only moved since")
+
+	_, err = svc.GetEventScheduleAsOf(ctx, "ev-1", "u2", asOf)
+	require.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = svc.GetEventScheduleAsOf(ctx, "ev-1", "owner1", time.Now().Add(time.Hour))
+	require.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	snapshot, err = svc.GetEventScheduleAsOf(ctx, "ev-1", "owner1", time.Now())
+	require.NoError(t, err)
+	assert.False(t, snapshot.Partial, "nothing changed after now")
+}
+
 func TestEventService_UpdateSessionContent(t *testing.T) {
 	ctx := context.Background()
 	timeout := 5 * time.Second
@@ -3753,7 +6272,7 @@ func TestEventService_UpdateSessionContent(t *testing.T) {
 				sr := newFakeSessionRepo()
 				sr.rooms = []*domain.Room{{ID: "room-1", EventID: "ev-1", Name: "Room A"}}
 				sr.sessions = []*domain.Session{
-					{ID: "sess-1", RoomID: "room-1", Title: "Old", Description: "Old desc"},
+					{ID: "sess-1", EventID: "ev-1", RoomID: "room-1", Title: "Old", Description: "Old desc"},
 				}
 				return er, sr, &fakeSessionizeFetcher{}
 			},
@@ -3779,7 +6298,7 @@ func TestEventService_UpdateSessionContent(t *testing.T) {
 				sr := newFakeSessionRepo()
 				sr.rooms = []*domain.Room{{ID: "room-1", EventID: "ev-1", Name: "Room A"}}
 				sr.sessions = []*domain.Session{
-					{ID: "sess-1", RoomID: "room-1", Title: "Old", Description: "Keep"},
+					{ID: "sess-1", EventID: "ev-1", RoomID: "room-1", Title: "Old", Description: "Keep"},
 				}
 				return er, sr, &fakeSessionizeFetcher{}
 			},
@@ -3849,7 +6368,7 @@ func TestEventService_UpdateSessionContent(t *testing.T) {
 				sr := newFakeSessionRepo()
 				sr.rooms = []*domain.Room{{ID: "room-1", EventID: "ev-99", Name: "Other"}}
 				sr.sessions = []*domain.Session{
-					{ID: "sess-1", RoomID: "room-1", Title: "Old", Description: ""},
+					{ID: "sess-1", EventID: "ev-99", RoomID: "room-1", Title: "Old", Description: ""},
 				}
 				return er, sr, &fakeSessionizeFetcher{}
 			},
@@ -3867,7 +6386,7 @@ func TestEventService_UpdateSessionContent(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			eventRepo, sessionRepo, fetcher := tt.setup()
-			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), fetcher, timeout)
+			svc := NewEventService(eventRepo, sessionRepo, newFakeTagRepo(), newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), fetcher, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 			got, err := svc.UpdateSessionContent(ctx, tt.args.eventID, tt.args.sessionID, tt.args.ownerID, tt.args.title, tt.args.description)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -3960,8 +6479,19 @@ func TestEventService_ListEventTags(t *testing.T) {
 				newFakeEventTeamMemberRepo(),
 				newFakeUserRepoForSchedule(),
 				newFakeEventInvitationRepo(),
+				newFakeImportMappingRepo(),
+				newFakeSpeakerMergeRepo(),
+				newFakeIntegrityRepo(),
+				newFakeScheduleRulesRepo(),
+				newFakeOperatingHoursRepo(),
+				newFakeSessionChangeRepo(),
+				newFakeChecklistRepo(),
+				newFakeScheduleGridRepo(),
+				newFakeCustomFieldRepo(),
 				newFakeEmailService(),
 				&fakeSessionizeFetcher{},
+				SchedulePolicy{},
+				domain.DefaultEventCodeFormat,
 				timeout,
 			)
 			tags, err := svc.ListEventTags(ctx, tt.eventID, tt.callerID)
@@ -4037,7 +6567,7 @@ func TestEventService_AddEventTags(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			er, sr, tr := tt.setup()
-			svc := NewEventService(er, sr, tr, newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, timeout)
+			svc := NewEventService(er, sr, tr, newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 			tags, err := svc.AddEventTags(ctx, tt.eventID, tt.ownerID, tt.tagNames)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -4078,7 +6608,7 @@ func TestEventService_AddSessionTag(t *testing.T) {
 				_ = er.Create(ctx, &domain.Event{Name: "Conf", OwnerID: "user-1", CreatedAt: time.Now(), UpdatedAt: time.Now()})
 				sr := newFakeSessionRepo()
 				sr.rooms = []*domain.Room{{ID: "room-1", EventID: "ev-1", Name: "Room A"}}
-				sr.sessions = []*domain.Session{{ID: "sess-1", RoomID: "room-1", Title: "Talk"}}
+				sr.sessions = []*domain.Session{{ID: "sess-1", EventID: "ev-1", RoomID: "room-1", Title: "Talk"}}
 				tr := newFakeTagRepo()
 				_, _ = tr.EnsureTagForEvent(ctx, "ev-1", "Go")
 				tags, _ := tr.ListTagsByEventID(ctx, "ev-1")
@@ -4112,7 +6642,7 @@ func TestEventService_AddSessionTag(t *testing.T) {
 				_ = er.Create(ctx, &domain.Event{Name: "Conf", OwnerID: "user-1", CreatedAt: time.Now(), UpdatedAt: time.Now()})
 				sr := newFakeSessionRepo()
 				sr.rooms = []*domain.Room{{ID: "room-1", EventID: "ev-1", Name: "Room A"}}
-				sr.sessions = []*domain.Session{{ID: "sess-1", RoomID: "room-1", Title: "Talk"}}
+				sr.sessions = []*domain.Session{{ID: "sess-1", EventID: "ev-1", RoomID: "room-1", Title: "Talk"}}
 				tr := newFakeTagRepo()
 				_, _ = tr.EnsureTagForEvent(ctx, "ev-1", "Go")
 				return er, sr, tr
@@ -4128,7 +6658,7 @@ func TestEventService_AddSessionTag(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			er, sr, tr := tt.setup()
-			svc := NewEventService(er, sr, tr, newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, timeout)
+			svc := NewEventService(er, sr, tr, newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 			err := svc.AddSessionTag(ctx, tt.eventID, tt.sessionID, tt.ownerID, tt.tagID)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -4170,7 +6700,7 @@ func TestEventService_RemoveSessionTag(t *testing.T) {
 				_ = er.Create(ctx, &domain.Event{Name: "Conf", OwnerID: "user-1", CreatedAt: time.Now(), UpdatedAt: time.Now()})
 				sr := newFakeSessionRepo()
 				sr.rooms = []*domain.Room{{ID: "room-1", EventID: "ev-1", Name: "Room A"}}
-				sr.sessions = []*domain.Session{{ID: "sess-1", RoomID: "room-1", Title: "Talk"}}
+				sr.sessions = []*domain.Session{{ID: "sess-1", EventID: "ev-1", RoomID: "room-1", Title: "Talk"}}
 				tr := newFakeTagRepo()
 				tr.sessionTags["sess-1"] = []string{"tag-1", "tag-2"}
 				_, _ = tr.EnsureTagForEvent(ctx, "ev-1", "Go")
@@ -4197,7 +6727,7 @@ func TestEventService_RemoveSessionTag(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			er, sr, tr := tt.setup()
-			svc := NewEventService(er, sr, tr, newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, timeout)
+			svc := NewEventService(er, sr, tr, newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 			err := svc.RemoveSessionTag(ctx, tt.eventID, tt.sessionID, tt.ownerID, tt.tagID)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -4240,7 +6770,7 @@ func TestEventService_AddSessionSpeaker(t *testing.T) {
 				_ = er.Create(ctx, &domain.Event{ID: "ev-1", Name: "Conf", OwnerID: "user-1", CreatedAt: time.Now(), UpdatedAt: time.Now()})
 				sr := newFakeSessionRepo()
 				sr.rooms = []*domain.Room{{ID: "room-1", EventID: "ev-1", Name: "Room A"}}
-				sr.sessions = []*domain.Session{{ID: "sess-1", RoomID: "room-1", Title: "Talk"}}
+				sr.sessions = []*domain.Session{{ID: "sess-1", EventID: "ev-1", RoomID: "room-1", Title: "Talk"}}
 				sr.speakers = []*domain.Speaker{{ID: "spk-1", EventID: "ev-1", FirstName: "Jane", LastName: "Doe"}}
 				return er, sr, newFakeTagRepo()
 			},
@@ -4278,7 +6808,7 @@ func TestEventService_AddSessionSpeaker(t *testing.T) {
 				_ = er.Create(ctx, &domain.Event{ID: "ev-1", Name: "Conf", OwnerID: "owner-1", CreatedAt: time.Now(), UpdatedAt: time.Now()})
 				sr := newFakeSessionRepo()
 				sr.rooms = []*domain.Room{{ID: "room-1", EventID: "ev-1", Name: "Room A"}}
-				sr.sessions = []*domain.Session{{ID: "sess-1", RoomID: "room-1", Title: "Talk"}}
+				sr.sessions = []*domain.Session{{ID: "sess-1", EventID: "ev-1", RoomID: "room-1", Title: "Talk"}}
 				sr.speakers = []*domain.Speaker{{ID: "spk-1", EventID: "ev-1"}}
 				return er, sr, newFakeTagRepo()
 			},
@@ -4296,7 +6826,7 @@ func TestEventService_AddSessionSpeaker(t *testing.T) {
 				_ = er.Create(ctx, &domain.Event{ID: "ev-1", Name: "Conf", OwnerID: "user-1", CreatedAt: time.Now(), UpdatedAt: time.Now()})
 				sr := newFakeSessionRepo()
 				sr.rooms = []*domain.Room{{ID: "room-1", EventID: "ev-1", Name: "Room A"}}
-				sr.sessions = []*domain.Session{{ID: "sess-1", RoomID: "room-1", Title: "Talk"}}
+				sr.sessions = []*domain.Session{{ID: "sess-1", EventID: "ev-1", RoomID: "room-1", Title: "Talk"}}
 				sr.speakers = []*domain.Speaker{{ID: "spk-1", EventID: "ev-other"}}
 				return er, sr, newFakeTagRepo()
 			},
@@ -4311,7 +6841,7 @@ func TestEventService_AddSessionSpeaker(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			er, sr, tr := tt.setup()
-			svc := NewEventService(er, sr, tr, newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, timeout)
+			svc := NewEventService(er, sr, tr, newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 			err := svc.AddSessionSpeaker(ctx, tt.eventID, tt.sessionID, tt.ownerID, tt.speakerID)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -4354,7 +6884,7 @@ func TestEventService_RemoveSessionSpeaker(t *testing.T) {
 				_ = er.Create(ctx, &domain.Event{ID: "ev-1", Name: "Conf", OwnerID: "user-1", CreatedAt: time.Now(), UpdatedAt: time.Now()})
 				sr := newFakeSessionRepo()
 				sr.rooms = []*domain.Room{{ID: "room-1", EventID: "ev-1", Name: "Room A"}}
-				sr.sessions = []*domain.Session{{ID: "sess-1", RoomID: "room-1", Title: "Talk"}}
+				sr.sessions = []*domain.Session{{ID: "sess-1", EventID: "ev-1", RoomID: "room-1", Title: "Talk"}}
 				sr.sessionSpeakers = []struct{ sessionID, speakerID string }{{"sess-1", "spk-1"}, {"sess-1", "spk-2"}}
 				return er, sr, newFakeTagRepo()
 			},
@@ -4390,7 +6920,7 @@ func TestEventService_RemoveSessionSpeaker(t *testing.T) {
 				_ = er.Create(ctx, &domain.Event{ID: "ev-1", Name: "Conf", OwnerID: "owner-1", CreatedAt: time.Now(), UpdatedAt: time.Now()})
 				sr := newFakeSessionRepo()
 				sr.rooms = []*domain.Room{{ID: "room-1", EventID: "ev-1", Name: "Room A"}}
-				sr.sessions = []*domain.Session{{ID: "sess-1", RoomID: "room-1", Title: "Talk"}}
+				sr.sessions = []*domain.Session{{ID: "sess-1", EventID: "ev-1", RoomID: "room-1", Title: "Talk"}}
 				sr.sessionSpeakers = []struct{ sessionID, speakerID string }{{"sess-1", "spk-1"}}
 				return er, sr, newFakeTagRepo()
 			},
@@ -4405,7 +6935,7 @@ func TestEventService_RemoveSessionSpeaker(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			er, sr, tr := tt.setup()
-			svc := NewEventService(er, sr, tr, newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, timeout)
+			svc := NewEventService(er, sr, tr, newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 			err := svc.RemoveSessionSpeaker(ctx, tt.eventID, tt.sessionID, tt.ownerID, tt.speakerID)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -4447,7 +6977,7 @@ func TestEventService_ListSessionSpeakers(t *testing.T) {
 				_ = er.Create(ctx, &domain.Event{ID: "ev-1", Name: "Conf", OwnerID: "user-1", CreatedAt: time.Now(), UpdatedAt: time.Now()})
 				sr := newFakeSessionRepo()
 				sr.rooms = []*domain.Room{{ID: "room-1", EventID: "ev-1", Name: "Room A"}}
-				sr.sessions = []*domain.Session{{ID: "sess-1", RoomID: "room-1", Title: "Talk"}}
+				sr.sessions = []*domain.Session{{ID: "sess-1", EventID: "ev-1", RoomID: "room-1", Title: "Talk"}}
 				sr.speakers = []*domain.Speaker{
 					{ID: "spk-1", EventID: "ev-1", FirstName: "A"},
 					{ID: "spk-2", EventID: "ev-1", FirstName: "B"},
@@ -4480,7 +7010,7 @@ func TestEventService_ListSessionSpeakers(t *testing.T) {
 				_ = er.Create(ctx, &domain.Event{ID: "ev-1", Name: "Conf", OwnerID: "user-1", CreatedAt: time.Now(), UpdatedAt: time.Now()})
 				sr := newFakeSessionRepo()
 				sr.rooms = []*domain.Room{{ID: "room-1", EventID: "ev-other", Name: "Room A"}}
-				sr.sessions = []*domain.Session{{ID: "sess-1", RoomID: "room-1", Title: "Talk"}}
+				sr.sessions = []*domain.Session{{ID: "sess-1", EventID: "ev-other", RoomID: "room-1", Title: "Talk"}}
 				return er, sr, newFakeTagRepo()
 			},
 			eventID:      "ev-1",
@@ -4493,7 +7023,7 @@ func TestEventService_ListSessionSpeakers(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			er, sr, tr := tt.setup()
-			svc := NewEventService(er, sr, tr, newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, timeout)
+			svc := NewEventService(er, sr, tr, newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 			speakers, err := svc.ListSessionSpeakers(ctx, tt.eventID, tt.sessionID, tt.callerID)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -4607,7 +7137,7 @@ func TestEventService_RemoveEventTag(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			er, tr := tt.setup()
-			svc := NewEventService(er, newFakeSessionRepo(), tr, newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, timeout)
+			svc := NewEventService(er, newFakeSessionRepo(), tr, newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 			err := svc.RemoveEventTag(ctx, tt.eventID, tt.ownerID, tt.tagID)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -4709,7 +7239,7 @@ func TestEventService_UpdateEventTag(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			er, tr := tt.setup()
-			svc := NewEventService(er, newFakeSessionRepo(), tr, newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, timeout)
+			svc := NewEventService(er, newFakeSessionRepo(), tr, newFakeEventTeamMemberRepo(), newFakeUserRepoForSchedule(), newFakeEventInvitationRepo(), newFakeImportMappingRepo(), newFakeSpeakerMergeRepo(), newFakeIntegrityRepo(), newFakeScheduleRulesRepo(), newFakeOperatingHoursRepo(), newFakeSessionChangeRepo(), newFakeChecklistRepo(), newFakeScheduleGridRepo(), newFakeCustomFieldRepo(), newFakeEmailService(), &fakeSessionizeFetcher{}, SchedulePolicy{}, domain.DefaultEventCodeFormat, timeout)
 			tag, err := svc.UpdateEventTag(ctx, tt.eventID, tt.tagID, tt.ownerID, tt.newName)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -4733,3 +7263,630 @@ func TestEventService_UpdateEventTag(t *testing.T) {
 		})
 	}
 }
+
+func TestEventService_ValidateSchedule(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2026, 5, 14, 9, 0, 0, 0, time.UTC)
+	er := newFakeEventRepo()
+	_ = er.Create(ctx, &domain.Event{Name: "Conf", OwnerID: "user-1"})
+	sr := newFakeSessionRepo()
+	sr.rooms = []*domain.Room{{ID: "room-1", EventID: "ev-1", Name: "Room A", Capacity: 50}}
+	sr.speakers = []*domain.Speaker{{ID: "sp-1", EventID: "ev-1", FirstName: "Ada"}}
+	sr.sessions = []*domain.Session{
+		{ID: "s1", EventID: "ev-1", RoomID: "room-1", Title: "One", StartTime: start, EndTime: start.Add(time.Hour)},
+		{ID: "s2", EventID: "ev-1", RoomID: "room-1", Title: "Two", StartTime: start.Add(2 * time.Hour), EndTime: start.Add(3 * time.Hour)},
+	}
+	sr.sessionSpeakers = []struct{ sessionID, speakerID string }{{"s1", "sp-1"}, {"s2", "sp-1"}}
+	svc := newTestEventService(er, sr, &fakeSessionizeFetcher{}, 5*time.Second)
+
+	v, err := svc.ValidateSchedule(ctx, "ev-1", "user-1")
+	require.NoError(t, err)
+	assert.True(t, v.Valid)
+	assert.Equal(t, "ev-1", v.EventID)
+	assert.Equal(t, 2, v.SessionsChecked)
+
+	_, err = svc.ValidateSchedule(ctx, "ev-1", "user-2")
+	require.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = svc.ValidateSchedule(ctx, "ev-9", "user-1")
+	require.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestEventService_UpdateChecklist(t *testing.T) {
+	ctx := context.Background()
+	er := newFakeEventRepo()
+	_ = er.Create(ctx, &domain.Event{Name: "Conf", OwnerID: "user-1"})
+	svc := newTestEventService(er, newFakeSessionRepo(), &fakeSessionizeFetcher{}, 5*time.Second)
+	checklist := newFakeChecklistRepo()
+	svc.checklistRepo = checklist
+
+	items, err := svc.UpdateChecklist(ctx, "ev-1", "user-1", []string{" Slides received ", "AV check"})
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, "Slides received", items[0].Label)
+	slidesID := items[0].ID
+
+	items, err = svc.UpdateChecklist(ctx, "ev-1", "user-1", []string{"Speaker briefed", "Slides received"})
+	require.NoError(t, err)
+	assert.Equal(t, slidesID, items[1].ID, "a kept label keeps its ID")
+
+	tests := []struct {
+		name    string
+		ownerID string
+		labels  []string
+		wantErr error
+	}{
+		{name: "empty label", ownerID: "user-1", labels: []string{"  "}, wantErr: domain.ErrInvalidInput},
+		{name: "duplicate label", ownerID: "user-1", labels: []string{"AV check", "av check"}, wantErr: domain.ErrInvalidInput},
+		{name: "too long", ownerID: "user-1", labels: []string{strings.Repeat("x", 256)}, wantErr: domain.ErrInvalidInput},
+		{name: "too many", ownerID: "user-1", labels: make([]string, domain.MaxChecklistItems+1), wantErr: domain.ErrInvalidInput},
+		{name: "not the owner", ownerID: "user-2", labels: []string{"AV check"}, wantErr: domain.ErrForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.UpdateChecklist(ctx, "ev-1", tt.ownerID, tt.labels)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestEventService_SessionChecklist(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2026, 5, 14, 9, 0, 0, 0, time.UTC)
+	er := newFakeEventRepo()
+	_ = er.Create(ctx, &domain.Event{Name: "Conf", OwnerID: "user-1"})
+	_ = er.Create(ctx, &domain.Event{Name: "Other", OwnerID: "user-1"})
+	sr := newFakeSessionRepo()
+	sr.sessions = []*domain.Session{
+		{ID: "s2", EventID: "ev-1", Title: "Two", StartTime: start.Add(time.Hour)},
+		{ID: "s1", EventID: "ev-1", Title: "One", StartTime: start},
+		{ID: "s9", EventID: "ev-2", Title: "Elsewhere", StartTime: start},
+	}
+	teamRepo := newFakeEventTeamMemberRepo()
+	require.NoError(t, teamRepo.Add(ctx, "ev-1", "user-ada"))
+	userRepo := newFakeUserRepoForSchedule()
+	userRepo.addUserWithName("owner@example.com", "user-1", "Olive", "Owner")
+	svc := newTestEventService(er, sr, &fakeSessionizeFetcher{}, 5*time.Second)
+	svc.eventTeamMemberRepo = teamRepo
+	svc.userRepo = userRepo
+	svc.checklistRepo = newFakeChecklistRepo()
+
+	items, err := svc.UpdateChecklist(ctx, "ev-1", "user-1", []string{"Slides received", "AV check"})
+	require.NoError(t, err)
+	slides, av := items[0].ID, items[1].ID
+
+	got, err := svc.GetChecklist(ctx, "ev-1", "user-ada")
+	require.NoError(t, err)
+	assert.Len(t, got, 2)
+	_, err = svc.GetChecklist(ctx, "ev-1", "user-2")
+	require.ErrorIs(t, err, domain.ErrForbidden)
+
+	sc, err := svc.SetSessionChecklistItem(ctx, "ev-1", "s1", slides, "user-ada", true)
+	require.NoError(t, err)
+	assert.Equal(t, 1, sc.Done)
+	assert.False(t, sc.Ready)
+	assert.Equal(t, "user-ada", sc.Items[0].CompletedBy)
+	require.NotNil(t, sc.Items[0].CompletedAt)
+	sc, err = svc.SetSessionChecklistItem(ctx, "ev-1", "s1", av, "user-1", true)
+	require.NoError(t, err)
+	assert.True(t, sc.Ready)
+	assert.Equal(t, "Olive Owner", sc.Items[1].CompletedByName)
+	_, err = svc.SetSessionChecklistItem(ctx, "ev-1", "s2", av, "user-1", true)
+	require.NoError(t, err)
+
+	progress, err := svc.GetChecklistProgress(ctx, "ev-1", "user-ada", nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, progress.SessionsTotal)
+	assert.Equal(t, 1, progress.SessionsReady)
+	require.Len(t, progress.Sessions, 2)
+	assert.Equal(t, "s1", progress.Sessions[0].SessionID, "sessions are ordered by start time")
+	require.Len(t, progress.Members, 2)
+	assert.Equal(t, &domain.ChecklistMemberProgress{UserID: "user-1", Name: "Olive Owner", Completed: 2}, progress.Members[0])
+	assert.Equal(t, 1, progress.Members[1].Completed)
+
+	notReady := false
+	progress, err = svc.GetChecklistProgress(ctx, "ev-1", "user-1", &notReady)
+	require.NoError(t, err)
+	require.Len(t, progress.Sessions, 1)
+	assert.Equal(t, "s2", progress.Sessions[0].SessionID)
+	assert.Equal(t, 2, progress.SessionsTotal)
+
+	sc, err = svc.SetSessionChecklistItem(ctx, "ev-1", "s1", slides, "user-1", false)
+	require.NoError(t, err)
+	assert.False(t, sc.Items[0].Done)
+	assert.Nil(t, sc.Items[0].CompletedAt)
+
+	_, err = svc.SetSessionChecklistItem(ctx, "ev-1", "s9", slides, "user-1", true)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	_, err = svc.SetSessionChecklistItem(ctx, "ev-1", "s1", "item-missing", "user-1", true)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	_, err = svc.SetSessionChecklistItem(ctx, "ev-1", "s1", slides, "user-2", true)
+	require.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = svc.GetChecklistProgress(ctx, "ev-9", "user-1", nil)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestEventService_GetScheduleGrid(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2026, 5, 14, 9, 0, 0, 0, time.UTC)
+	er := newFakeEventRepo()
+	_ = er.Create(ctx, &domain.Event{Name: "Conf", OwnerID: "user-1"})
+	sr := newFakeSessionRepo()
+	sr.rooms = []*domain.Room{{ID: "room-1", EventID: "ev-1", Name: "Room A"}}
+	sr.sessions = []*domain.Session{
+		{ID: "s1", EventID: "ev-1", RoomID: "room-1", Title: "One", StartTime: start, EndTime: start.Add(time.Hour)},
+	}
+	teamRepo := newFakeEventTeamMemberRepo()
+	require.NoError(t, teamRepo.Add(ctx, "ev-1", "user-ada"))
+	grids := newFakeScheduleGridRepo()
+	svc := newTestEventService(er, sr, &fakeSessionizeFetcher{}, 5*time.Second)
+	svc.eventTeamMemberRepo = teamRepo
+	svc.scheduleGridRepo = grids
+
+	grid, err := svc.GetScheduleGrid(ctx, "ev-1", "user-ada")
+	require.NoError(t, err)
+	require.Len(t, grid.Days, 1)
+	assert.Equal(t, 1, grids.saves, "a missing grid is built and stored")
+
+	_, err = svc.GetScheduleGrid(ctx, "ev-1", "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, grids.saves, "the stored grid is served")
+
+	newStart, newEnd := start.Add(24*time.Hour), start.Add(25*time.Hour)
+	_, err = svc.UpdateSessionSchedule(ctx, "ev-1", "s1", "user-1", nil, &newStart, &newEnd)
+	require.NoError(t, err)
+	assert.Equal(t, 2, grids.saves, "moving a session rebuilds the grid")
+	grid, err = svc.GetScheduleGrid(ctx, "ev-1", "user-1")
+	require.NoError(t, err)
+	require.Len(t, grid.Days, 1)
+	assert.Equal(t, "2026-05-15", grid.Days[0].Day)
+
+	grids.err = errors.New("db down")
+	failCtx, failures := domain.WithBestEffortFailures(ctx)
+	_, err = svc.UpdateSessionContent(failCtx, "ev-1", "s1", "user-1", ptrString("Renamed"), nil)
+	require.NoError(t, err, "a failed refresh does not fail the change")
+	_, stored := grids.byEventID["ev-1"]
+	assert.False(t, stored, "a grid that failed to refresh is dropped")
+	require.Len(t, failures.Errors(), 1, "the failure is reported instead")
+	assert.ErrorIs(t, failures.Errors()[0], grids.err)
+
+	_, err = svc.GetScheduleGrid(ctx, "ev-1", "user-2")
+	require.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = svc.GetScheduleGrid(ctx, "ev-9", "user-1")
+	require.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestEventService_CustomFields(t *testing.T) {
+	ctx := context.Background()
+	er := newFakeEventRepo()
+	_ = er.Create(ctx, &domain.Event{Name: "Conf", OwnerID: "user-1"})
+	_ = er.Create(ctx, &domain.Event{Name: "Other", OwnerID: "user-1"})
+	sr := newFakeSessionRepo()
+	sr.sessions = []*domain.Session{
+		{ID: "s1", EventID: "ev-1", Title: "One"},
+		{ID: "s9", EventID: "ev-2", Title: "Elsewhere"},
+	}
+	sr.speakers = []*domain.Speaker{{ID: "sp1", EventID: "ev-1", FirstName: "Ada"}}
+	teamRepo := newFakeEventTeamMemberRepo()
+	require.NoError(t, teamRepo.Add(ctx, "ev-1", "user-ada"))
+	svc := newTestEventService(er, sr, &fakeSessionizeFetcher{}, 5*time.Second)
+	svc.eventTeamMemberRepo = teamRepo
+
+	doi, err := svc.CreateCustomField(ctx, "ev-1", "user-1", &domain.CustomField{Name: " Paper DOI ", Type: domain.CustomFieldURL, AppliesTo: domain.CustomFieldSession, Public: true})
+	require.NoError(t, err)
+	assert.Equal(t, "Paper DOI", doi.Name)
+	consent, err := svc.CreateCustomField(ctx, "ev-1", "user-1", &domain.CustomField{Name: "Streaming consent", Type: domain.CustomFieldBoolean, AppliesTo: domain.CustomFieldSpeaker})
+	require.NoError(t, err)
+
+	_, err = svc.CreateCustomField(ctx, "ev-1", "user-1", &domain.CustomField{Name: "Paper DOI", Type: domain.CustomFieldText, AppliesTo: domain.CustomFieldSession})
+	require.ErrorIs(t, err, domain.ErrDuplicateCustomField)
+	_, err = svc.CreateCustomField(ctx, "ev-1", "user-1", &domain.CustomField{Name: "Color", Type: "color", AppliesTo: domain.CustomFieldSession})
+	require.ErrorIs(t, err, domain.ErrInvalidInput)
+	_, err = svc.CreateCustomField(ctx, "ev-1", "user-ada", &domain.CustomField{Name: "Room notes", Type: domain.CustomFieldText, AppliesTo: domain.CustomFieldSession})
+	require.ErrorIs(t, err, domain.ErrForbidden)
+
+	fields, err := svc.ListCustomFields(ctx, "ev-1", "user-ada")
+	require.NoError(t, err, "team members can read the fields")
+	require.Len(t, fields, 2)
+
+	values, err := svc.SetSessionCustomFields(ctx, "ev-1", "s1", "user-1", map[string]any{doi.ID: "https://doi.org/10.1000/182"})
+	require.NoError(t, err)
+	require.Len(t, values, 1)
+	assert.Equal(t, "https://doi.org/10.1000/182", values[0].Value)
+
+	tests := []struct {
+		name      string
+		sessionID string
+		values    map[string]any
+		wantErr   error
+	}{
+		{name: "wrong type", sessionID: "s1", values: map[string]any{doi.ID: 42.0}, wantErr: domain.ErrInvalidInput},
+		{name: "not a url", sessionID: "s1", values: map[string]any{doi.ID: "javascript:alert(1)"}, wantErr: domain.ErrInvalidInput},
+		{name: "speaker field on a session", sessionID: "s1", values: map[string]any{consent.ID: true}, wantErr: domain.ErrInvalidInput},
+		{name: "unknown field", sessionID: "s1", values: map[string]any{"field-x": "x"}, wantErr: domain.ErrInvalidInput},
+		{name: "session of another event", sessionID: "s9", values: map[string]any{doi.ID: nil}, wantErr: domain.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.SetSessionCustomFields(ctx, "ev-1", tt.sessionID, "user-1", tt.values)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+
+	_, err = svc.SetSpeakerCustomFields(ctx, "ev-1", "sp1", "user-1", map[string]any{consent.ID: true})
+	require.NoError(t, err)
+	speakers, err := svc.ListEventSpeakers(ctx, "ev-1", "user-1")
+	require.NoError(t, err)
+	require.Len(t, speakers[0].CustomFields, 1)
+	assert.Equal(t, true, speakers[0].CustomFields[0].Value)
+
+	var exported []*domain.Session
+	require.NoError(t, svc.StreamEventSessions(ctx, "ev-1", "user-1", func(sess *domain.Session) error {
+		exported = append(exported, sess)
+		return nil
+	}))
+	require.Len(t, exported, 1)
+	require.Len(t, exported[0].CustomFields, 1, "exports include the custom fields")
+
+	require.NoError(t, svc.DeleteCustomField(ctx, "ev-1", doi.ID, "user-1"))
+	values, err = svc.SetSessionCustomFields(ctx, "ev-1", "s1", "user-1", map[string]any{})
+	require.NoError(t, err)
+	assert.Empty(t, values, "deleting a field deletes its values")
+	require.ErrorIs(t, svc.DeleteCustomField(ctx, "ev-2", consent.ID, "user-1"), domain.ErrNotFound)
+}
+
+func TestEventService_BulkUpdateSpeakers(t *testing.T) {
+	ctx := context.Background()
+	str := func(s string) *string { return &s }
+	yes := true
+
+	setup := func(t *testing.T) (*eventService, *fakeSessionRepo) {
+		er := newFakeEventRepo()
+		require.NoError(t, er.Create(ctx, &domain.Event{Name: "Conf", OwnerID: "user-1"}))
+		sr := newFakeSessionRepo()
+		require.NoError(t, sr.CreateSpeaker(ctx, &domain.Speaker{EventID: "ev-1", SourceSessionID: "a", FirstName: "Ada", LastName: "Lovelace", TagLine: "Countess"}))
+		require.NoError(t, sr.CreateSpeaker(ctx, &domain.Speaker{EventID: "ev-1", SourceSessionID: "b", FirstName: "Alan", TagLine: "Codebreaker"}))
+		require.NoError(t, sr.CreateSpeaker(ctx, &domain.Speaker{EventID: "ev-2", SourceSessionID: "c", FirstName: "Grace"}))
+		return newTestEventService(er, sr, &fakeSessionizeFetcher{}, 5*time.Second), sr
+	}
+
+	t.Run("applies all updates", func(t *testing.T) {
+		svc, sr := setup(t)
+		result, err := svc.BulkUpdateSpeakers(ctx, "ev-1", "user-1", []*domain.SpeakerUpdate{
+			{SpeakerID: "sp-1", IsTopSpeaker: &yes, TagLine: str("")},
+			{SpeakerID: " sp-2 ", TagLine: str(""), Email: str(" alan@example.com ")},
+		}, false)
+		require.NoError(t, err)
+		assert.True(t, result.Applied)
+		require.Len(t, result.Results, 2)
+		assert.Equal(t, "sp-2", result.Results[1].SpeakerID)
+		require.NotNil(t, result.Results[1].Speaker)
+		assert.Equal(t, "alan@example.com", result.Results[1].Speaker.Email)
+		assert.True(t, sr.speakers[0].IsTopSpeaker)
+		assert.Empty(t, sr.speakers[0].TagLine)
+		assert.Equal(t, "Lovelace", sr.speakers[0].LastName)
+		assert.Empty(t, sr.speakers[1].TagLine)
+	})
+
+	t.Run("rejects the whole batch when an item is invalid", func(t *testing.T) {
+		svc, sr := setup(t)
+		result, err := svc.BulkUpdateSpeakers(ctx, "ev-1", "user-1", []*domain.SpeakerUpdate{
+			{SpeakerID: "sp-1", IsTopSpeaker: &yes},
+			{SpeakerID: "sp-3", Bio: str("other event")},
+			{SpeakerID: "sp-1", Bio: str("twice")},
+			{SpeakerID: "sp-2", FirstName: str(" ")},
+			{SpeakerID: "sp-2"},
+		}, false)
+		require.NoError(t, err)
+		assert.False(t, result.Applied)
+		errs := make([]string, len(result.Results))
+		for i, r := range result.Results {
+			errs[i] = r.Error
+			assert.Nil(t, r.Speaker)
+		}
+		assert.Equal(t, []string{"", "speaker not found", "speaker is updated more than once", "at least one of first_name or last_name must stay set", "speaker is updated more than once"}, errs)
+		assert.False(t, sr.speakers[0].IsTopSpeaker)
+	})
+
+	t.Run("dry run previews without applying", func(t *testing.T) {
+		svc, sr := setup(t)
+		result, err := svc.BulkUpdateSpeakers(ctx, "ev-1", "user-1", []*domain.SpeakerUpdate{
+			{SpeakerID: "sp-1", IsTopSpeaker: &yes, TagLine: str("")},
+		}, true)
+		require.NoError(t, err)
+		assert.False(t, result.Applied)
+		assert.True(t, result.DryRun)
+		require.NotNil(t, result.Results[0].Speaker)
+		assert.True(t, result.Results[0].Speaker.IsTopSpeaker)
+		assert.Empty(t, result.Results[0].Speaker.TagLine)
+		assert.Equal(t, "Lovelace", result.Results[0].Speaker.LastName)
+		assert.False(t, sr.speakers[0].IsTopSpeaker)
+		assert.Equal(t, "Countess", sr.speakers[0].TagLine)
+	})
+
+	t.Run("forbidden not owner", func(t *testing.T) {
+		svc, _ := setup(t)
+		_, err := svc.BulkUpdateSpeakers(ctx, "ev-1", "user-2", []*domain.SpeakerUpdate{{SpeakerID: "sp-1", IsTopSpeaker: &yes}}, false)
+		require.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		svc, _ := setup(t)
+		_, err := svc.BulkUpdateSpeakers(ctx, "ev-1", "user-1", nil, false)
+		require.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+}
+
+func TestEventService_RegenerateEventCode(t *testing.T) {
+	ctx := context.Background()
+	setup := func() (*eventService, *fakeEventRepo, *fakeEmailService) {
+		er := newFakeEventRepo()
+		er.byID["ev-1"] = &domain.Event{ID: "ev-1", Name: "My Event", EventCode: "abc1", OwnerID: "user-1"}
+		ur := newFakeUserRepoForSchedule()
+		ur.addUserWithName("owner@x.com", "user-1", "Jane", "Doe")
+		ir := newFakeEventInvitationRepo()
+		ir.pending = map[string][]*domain.PendingInvitation{"ev-1": {
+			{ID: "inv-1", Email: "ada@example.com"},
+			{ID: "inv-2", Email: "bounce@example.com"},
+		}}
+		es := newFakeEmailService()
+		svc := newTestEventService(er, newFakeSessionRepo(), &fakeSessionizeFetcher{}, 5*time.Second)
+		svc.userRepo = ur
+		svc.invitationRepo = ir
+		svc.emailService = es
+		svc.codeFormat = domain.EventCodeFormat{Length: 8, Alphabet: "0123456789"}
+		return svc, er, es
+	}
+
+	t.Run("new code is sent to pending invitees", func(t *testing.T) {
+		svc, er, es := setup()
+		er.codeCollisions = 2
+		change, err := svc.RegenerateEventCode(ctx, "ev-1", "user-1")
+		require.NoError(t, err)
+		assert.Equal(t, "abc1", change.PreviousCode)
+		assert.Regexp(t, "^[0-9]{8}$", change.Event.EventCode, "the configured format is used")
+		assert.Equal(t, change.Event.EventCode, er.byID["ev-1"].EventCode)
+		assert.Equal(t, 2, change.InvitationsResent)
+		assert.Empty(t, change.InvitationsFailed)
+		require.Len(t, es.sentInvitations, 2)
+		assert.Equal(t, change.Event.EventCode, es.sentInvitations[0].EventCode)
+		assert.Equal(t, "Jane Doe", es.sentInvitations[0].OwnerName)
+	})
+
+	t.Run("failed sends are reported", func(t *testing.T) {
+		svc, _, es := setup()
+		es.sendEventInvitationErr = errors.New("smtp down")
+		change, err := svc.RegenerateEventCode(ctx, "ev-1", "user-1")
+		require.NoError(t, err, "the code has changed even when the emails fail")
+		assert.Equal(t, 0, change.InvitationsResent)
+		assert.Equal(t, []string{"ada@example.com", "bounce@example.com"}, change.InvitationsFailed)
+	})
+
+	t.Run("every code taken", func(t *testing.T) {
+		svc, er, _ := setup()
+		er.codeCollisions = domain.EventCodeAttempts
+		_, err := svc.RegenerateEventCode(ctx, "ev-1", "user-1")
+		require.ErrorIs(t, err, domain.ErrDuplicateEventCode)
+		assert.Equal(t, "abc1", er.byID["ev-1"].EventCode)
+	})
+
+	t.Run("not the owner", func(t *testing.T) {
+		svc, _, _ := setup()
+		_, err := svc.RegenerateEventCode(ctx, "ev-1", "user-2")
+		require.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("unknown event", func(t *testing.T) {
+		svc, _, _ := setup()
+		_, err := svc.RegenerateEventCode(ctx, "ev-9", "user-1")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func TestEventService_RemindEventInvitations(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	setup := func() (*eventService, *fakeEventInvitationRepo, *fakeEmailService) {
+		er := newFakeEventRepo()
+		er.byID["ev-1"] = &domain.Event{ID: "ev-1", Name: "My Event", EventCode: "abc1", OwnerID: "user-1"}
+		ur := newFakeUserRepoForSchedule()
+		ur.addUserWithName("owner@x.com", "user-1", "Jane", "Doe")
+		ir := newFakeEventInvitationRepo()
+		ir.pending = map[string][]*domain.PendingInvitation{"ev-1": {
+			{ID: "inv-1", Email: "due@example.com", LastContactedAt: now.Add(-96 * time.Hour)},
+			{ID: "inv-2", Email: "recent@example.com", LastContactedAt: now.Add(-time.Hour)},
+			{ID: "inv-3", Email: "capped@example.com", LastContactedAt: now.Add(-30 * 24 * time.Hour), RemindersSent: domain.MaxInvitationReminders},
+			{ID: "inv-4", Email: "bounce@example.com", LastContactedAt: now.Add(-96 * time.Hour), RemindersSent: 1},
+		}}
+		es := newFakeEmailService()
+		es.failReminderTo = map[string]bool{"bounce@example.com": true}
+		svc := newTestEventService(er, newFakeSessionRepo(), &fakeSessionizeFetcher{}, 5*time.Second)
+		svc.userRepo = ur
+		svc.invitationRepo = ir
+		svc.emailService = es
+		return svc, ir, es
+	}
+
+	t.Run("reminds due recipients and throttles the rest", func(t *testing.T) {
+		svc, ir, es := setup()
+		result, err := svc.RemindEventInvitations(ctx, "ev-1", "user-1", 0)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Sent)
+		assert.Equal(t, []string{"bounce@example.com"}, result.Failed)
+		assert.Equal(t, 2, result.Throttled)
+		require.Len(t, es.sentReminders, 1)
+		assert.Equal(t, "due@example.com", es.sentReminders[0].Email)
+		assert.Equal(t, "Jane Doe", es.sentReminders[0].OwnerName)
+		assert.Equal(t, "ev-1", es.sentReminders[0].EventID, "reminders are archived under the event")
+		assert.Equal(t, 1, ir.pending["ev-1"][0].RemindersSent)
+		assert.Equal(t, 1, ir.pending["ev-1"][3].RemindersSent, "a failed send is not counted")
+
+		again, err := svc.RemindEventInvitations(ctx, "ev-1", "user-1", 0)
+		require.NoError(t, err)
+		assert.Equal(t, 0, again.Sent, "a just reminded recipient is throttled")
+		assert.Equal(t, 3, again.Throttled)
+	})
+
+	t.Run("longer interval throttles more", func(t *testing.T) {
+		svc, _, _ := setup()
+		result, err := svc.RemindEventInvitations(ctx, "ev-1", "user-1", 7*24*time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.Sent)
+		assert.Empty(t, result.Failed)
+		assert.Equal(t, 4, result.Throttled)
+	})
+
+	t.Run("interval below minimum", func(t *testing.T) {
+		svc, _, es := setup()
+		_, err := svc.RemindEventInvitations(ctx, "ev-1", "user-1", time.Hour)
+		require.ErrorIs(t, err, domain.ErrInvalidInput)
+		assert.Empty(t, es.sentReminders)
+	})
+
+	t.Run("not owner", func(t *testing.T) {
+		svc, _, _ := setup()
+		_, err := svc.RemindEventInvitations(ctx, "ev-1", "user-2", 0)
+		require.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("event not found", func(t *testing.T) {
+		svc, _, _ := setup()
+		_, err := svc.RemindEventInvitations(ctx, "ev-missing", "user-1", 0)
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func TestEventService_EventEmails(t *testing.T) {
+	ctx := context.Background()
+	er := newFakeEventRepo()
+	er.byID["ev-1"] = &domain.Event{ID: "ev-1", OwnerID: "user-1"}
+	er.byID["ev-2"] = &domain.Event{ID: "ev-2", OwnerID: "user-1"}
+	es := newFakeEmailService()
+	es.archived = []*domain.EventEmail{
+		{ID: "em-1", EventID: "ev-1", Kind: domain.EventEmailInvitation, To: "alice@example.com", Status: domain.EventEmailSent},
+		{ID: "em-2", EventID: "ev-2", Kind: domain.EventEmailInvitation, To: "bob@example.com", Status: domain.EventEmailFailed},
+	}
+	svc := newTestEventService(er, newFakeSessionRepo(), &fakeSessionizeFetcher{}, 5*time.Second)
+	svc.emailService = es
+
+	emails, total, err := svc.ListEventEmails(ctx, "ev-1", "user-1", domain.EventEmailFilter{}, domain.PaginationParams{Page: 1, PageSize: 20})
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, emails, 1)
+	assert.Equal(t, "em-1", emails[0].ID)
+
+	resent, err := svc.ResendEventEmail(ctx, "ev-1", "user-1", "em-1")
+	require.NoError(t, err)
+	assert.Equal(t, "em-1", resent.ResendOf)
+	assert.Equal(t, "alice@example.com", resent.To)
+
+	_, err = svc.ResendEventEmail(ctx, "ev-1", "user-1", "em-2")
+	require.ErrorIs(t, err, domain.ErrEventEmailNotFound, "another event's email cannot be resent")
+	_, err = svc.ResendEventEmail(ctx, "ev-1", "user-2", "em-1")
+	require.ErrorIs(t, err, domain.ErrForbidden)
+	_, _, err = svc.ListEventEmails(ctx, "ev-1", "user-2", domain.EventEmailFilter{}, domain.PaginationParams{Page: 1, PageSize: 20})
+	require.ErrorIs(t, err, domain.ErrForbidden)
+	_, _, err = svc.ListEventEmails(ctx, "ev-missing", "user-1", domain.EventEmailFilter{}, domain.PaginationParams{Page: 1, PageSize: 20})
+	require.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestEventService_UpdateInvitationDomainRules(t *testing.T) {
+	ctx := context.Background()
+	er := newFakeEventRepo()
+	er.byID["ev-1"] = &domain.Event{ID: "ev-1", OwnerID: "user-1"}
+	ir := newFakeEventInvitationRepo()
+	svc := newTestEventService(er, newFakeSessionRepo(), &fakeSessionizeFetcher{}, 5*time.Second)
+	svc.invitationRepo = ir
+
+	rules, err := svc.GetInvitationDomainRules(ctx, "ev-1", "user-1")
+	require.NoError(t, err)
+	assert.Empty(t, rules.AllowedDomains)
+	assert.Empty(t, rules.BlockedDomains)
+
+	saved, err := svc.UpdateInvitationDomainRules(ctx, "ev-1", "user-1", &domain.InvitationDomainRules{
+		AllowedDomains: []string{" Acme.COM ", "@beta.io", "acme.com"},
+		BlockedDomains: nil,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ev-1", saved.EventID)
+	assert.Equal(t, []string{"acme.com", "beta.io"}, saved.AllowedDomains)
+	assert.Equal(t, []string{}, saved.BlockedDomains)
+	assert.Same(t, saved, ir.domainRules["ev-1"])
+
+	_, err = svc.UpdateInvitationDomainRules(ctx, "ev-1", "user-2", &domain.InvitationDomainRules{})
+	require.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = svc.GetInvitationDomainRules(ctx, "ev-missing", "user-1")
+	require.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+
+func TestEventService_AnonymizeEvent(t *testing.T) {
+	ctx := context.Background()
+	past := time.Now().AddDate(0, -2, 0)
+	future := time.Now().AddDate(0, 1, 0)
+	er := newFakeEventRepo()
+	er.byID["ev-past"] = &domain.Event{ID: "ev-past", OwnerID: "user-1", Date: &past}
+	er.byID["ev-undated"] = &domain.Event{ID: "ev-undated", OwnerID: "user-1"}
+	er.byID["ev-future"] = &domain.Event{ID: "ev-future", OwnerID: "user-1", Date: &future}
+	er.registrations = map[string]int{"ev-past": 42}
+	svc := newTestEventService(er, newFakeSessionRepo(), &fakeSessionizeFetcher{}, 5*time.Second)
+
+	preview, err := svc.AnonymizeEvent(ctx, "ev-past", "user-1", true)
+	require.NoError(t, err)
+	assert.True(t, preview.DryRun)
+	assert.Equal(t, 42, preview.Registrations)
+	assert.NotContains(t, er.anonymized, "ev-past")
+
+	a, err := svc.AnonymizeEvent(ctx, "ev-past", "user-1", false)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", a.AnonymizedBy)
+	assert.Equal(t, 42, a.Registrations)
+
+	_, err = svc.AnonymizeEvent(ctx, "ev-undated", "user-1", false)
+	require.NoError(t, err)
+
+	_, err = svc.AnonymizeEvent(ctx, "ev-future", "user-1", false)
+	require.ErrorIs(t, err, domain.ErrInvalidInput)
+	assert.NotContains(t, er.anonymized, "ev-future")
+
+	_, err = svc.AnonymizeEvent(ctx, "ev-past", "user-2", false)
+	require.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = svc.AnonymizeEvent(ctx, "ev-missing", "user-1", false)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestEventService_EventTheme(t *testing.T) {
+	ctx := context.Background()
+	er := newFakeEventRepo()
+	er.byID["ev-1"] = &domain.Event{ID: "ev-1", OwnerID: "owner-1"}
+	svc := newTestEventService(er, newFakeSessionRepo(), &fakeSessionizeFetcher{}, 5*time.Second)
+
+	got, err := svc.GetEventTheme(ctx, "ev-1", "owner-1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.NewEventTheme("ev-1"), got, "unsaved themes use the app defaults")
+
+	got, err = svc.UpdateEventTheme(ctx, "ev-1", "owner-1", &domain.EventTheme{
+		EventID:      "spoofed",
+		PrimaryColor: " #A1B2C3 ",
+		FontFamily:   "  Inter ",
+		FooterText:   " © Conf 2026 ",
+		SupportEmail: "Help@Conf.Example",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ev-1", got.EventID)
+	assert.Equal(t, "#a1b2c3", got.PrimaryColor)
+	assert.Equal(t, "Inter", got.FontFamily)
+	assert.Equal(t, "© Conf 2026", got.FooterText)
+	assert.Equal(t, "help@conf.example", got.SupportEmail)
+	assert.False(t, got.UpdatedAt.IsZero())
+
+	saved, err := svc.GetEventTheme(ctx, "ev-1", "owner-1")
+	require.NoError(t, err)
+	assert.Same(t, got, saved)
+
+	_, err = svc.UpdateEventTheme(ctx, "ev-1", "other", &domain.EventTheme{})
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = svc.GetEventTheme(ctx, "ev-1", "other")
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = svc.GetEventTheme(ctx, "missing", "owner-1")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
@@ -0,0 +1,32 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"multitrackticketing/internal/domain"
+)
+
+// normalizeEventTheme trims every field and lowercases the colors and the support email.
+func normalizeEventTheme(theme *domain.EventTheme) {
+	for _, p := range []*string{&theme.PrimaryColor, &theme.SecondaryColor, &theme.BackgroundColor, &theme.TextColor, &theme.SupportEmail} {
+		*p = strings.ToLower(strings.TrimSpace(*p))
+	}
+	for _, p := range []*string{&theme.FontFamily, &theme.HeadingFontFamily, &theme.FooterText} {
+		*p = strings.TrimSpace(*p)
+	}
+}
+
+// eventThemeFor returns the event's saved theme, or the default theme when none is saved.
+func eventThemeFor(ctx context.Context, repo domain.EventRepository, eventID string) (*domain.EventTheme, error) {
+	theme, err := repo.GetTheme(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewEventTheme(eventID), nil
+		}
+		return nil, fmt.Errorf("get event theme: %w", err)
+	}
+	return theme, nil
+}
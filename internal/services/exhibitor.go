@@ -0,0 +1,374 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"multitrackticketing/internal/domain"
+)
+
+// leadCodeBytes is the randomness of an attendee's lead code; its hex form fills lead_consents.code.
+const leadCodeBytes = 16
+
+type exhibitorService struct {
+	eventRepo          domain.EventRepository
+	teamRepo           domain.EventTeamMemberRepository
+	userRepo           domain.UserRepository
+	registrationRepo   domain.EventRegistrationRepository
+	sessionRepo        domain.SessionRepository
+	operatingHoursRepo domain.OperatingHoursRepository
+	exhibitorRepo      domain.ExhibitorRepository
+	leadRepo           domain.LeadRepository
+	now                func() time.Time
+	contextTimeout     time.Duration
+}
+
+// NewExhibitorService returns a domain.ExhibitorService.
+func NewExhibitorService(eventRepo domain.EventRepository, teamRepo domain.EventTeamMemberRepository, userRepo domain.UserRepository, registrationRepo domain.EventRegistrationRepository, sessionRepo domain.SessionRepository, operatingHoursRepo domain.OperatingHoursRepository, exhibitorRepo domain.ExhibitorRepository, leadRepo domain.LeadRepository, timeout time.Duration) domain.ExhibitorService {
+	return &exhibitorService{
+		eventRepo:          eventRepo,
+		teamRepo:           teamRepo,
+		userRepo:           userRepo,
+		registrationRepo:   registrationRepo,
+		sessionRepo:        sessionRepo,
+		operatingHoursRepo: operatingHoursRepo,
+		exhibitorRepo:      exhibitorRepo,
+		leadRepo:           leadRepo,
+		now:                time.Now,
+		contextTimeout:     timeout,
+	}
+}
+
+func (s *exhibitorService) CreateExhibitor(ctx context.Context, eventID, callerID, name, boothNumber string) (*domain.Exhibitor, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	name = strings.TrimSpace(name)
+	boothNumber = strings.TrimSpace(boothNumber)
+	switch {
+	case name == "" || utf8.RuneCountInString(name) > 255:
+		return nil, fmt.Errorf("name must be 1 to 255 characters: %w", domain.ErrInvalidInput)
+	case utf8.RuneCountInString(boothNumber) > 20:
+		return nil, fmt.Errorf("booth_number must be at most 20 characters: %w", domain.ErrInvalidInput)
+	}
+	if _, err := s.teamEvent(ctx, eventID, callerID); err != nil {
+		return nil, err
+	}
+	e := &domain.Exhibitor{EventID: eventID, Name: name, BoothNumber: boothNumber}
+	if err := s.exhibitorRepo.Create(ctx, e); err != nil {
+		if errors.Is(err, domain.ErrDuplicateBooth) {
+			return nil, domain.ErrDuplicateBooth
+		}
+		return nil, fmt.Errorf("create exhibitor: %w", err)
+	}
+	return e, nil
+}
+
+func (s *exhibitorService) ListExhibitors(ctx context.Context, eventID, callerID string) ([]*domain.Exhibitor, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if _, err := s.teamEvent(ctx, eventID, callerID); err != nil {
+		return nil, err
+	}
+	exhibitors, err := s.exhibitorRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list exhibitors: %w", err)
+	}
+	return exhibitors, nil
+}
+
+func (s *exhibitorService) DeleteExhibitor(ctx context.Context, eventID, exhibitorID, callerID string) error {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if _, err := s.eventExhibitor(ctx, eventID, exhibitorID, callerID); err != nil {
+		return err
+	}
+	if err := s.exhibitorRepo.Delete(ctx, exhibitorID); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("delete exhibitor: %w", err)
+	}
+	return nil
+}
+
+func (s *exhibitorService) AddExhibitorStaff(ctx context.Context, eventID, exhibitorID, callerID, email string) (*domain.ExhibitorStaff, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	email = strings.TrimSpace(strings.ToLower(email))
+	if email == "" {
+		return nil, fmt.Errorf("email is required: %w", domain.ErrInvalidInput)
+	}
+	if _, err := s.eventExhibitor(ctx, eventID, exhibitorID, callerID); err != nil {
+		return nil, err
+	}
+	user, err := s.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, domain.ErrUserNotFound) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("get user by email: %w", err)
+	}
+	if user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+	if err := s.exhibitorRepo.AddStaff(ctx, exhibitorID, user.ID); err != nil {
+		return nil, fmt.Errorf("add exhibitor staff: %w", err)
+	}
+	return &domain.ExhibitorStaff{
+		ExhibitorID: exhibitorID,
+		UserID:      user.ID,
+		Name:        user.Name,
+		LastName:    user.LastName,
+		Email:       user.Email,
+	}, nil
+}
+
+func (s *exhibitorService) RemoveExhibitorStaff(ctx context.Context, eventID, exhibitorID, callerID, userID string) error {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if _, err := s.eventExhibitor(ctx, eventID, exhibitorID, callerID); err != nil {
+		return err
+	}
+	if err := s.exhibitorRepo.RemoveStaff(ctx, exhibitorID, userID); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("remove exhibitor staff: %w", err)
+	}
+	return nil
+}
+
+func (s *exhibitorService) GetLeadConsent(ctx context.Context, eventID, userID string) (*domain.LeadConsent, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := s.requireRegistered(ctx, eventID, userID); err != nil {
+		return nil, err
+	}
+	code, err := s.leadRepo.GetConsentCode(ctx, eventID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get lead consent: %w", err)
+	}
+	return &domain.LeadConsent{EventID: eventID, Consent: code != "", Code: code}, nil
+}
+
+func (s *exhibitorService) SetLeadConsent(ctx context.Context, eventID, userID string, consent bool) (*domain.LeadConsent, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := s.requireRegistered(ctx, eventID, userID); err != nil {
+		return nil, err
+	}
+	code, err := s.leadRepo.GetConsentCode(ctx, eventID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get lead consent: %w", err)
+	}
+	switch {
+	case consent && code == "":
+		if code, err = generateLeadCode(); err != nil {
+			return nil, fmt.Errorf("generate lead code: %w", err)
+		}
+	case !consent:
+		code = ""
+	default:
+		return &domain.LeadConsent{EventID: eventID, Consent: true, Code: code}, nil
+	}
+	if err := s.leadRepo.SetConsentCode(ctx, eventID, userID, code); err != nil {
+		return nil, fmt.Errorf("set lead consent: %w", err)
+	}
+	return &domain.LeadConsent{EventID: eventID, Consent: consent, Code: code}, nil
+}
+
+func (s *exhibitorService) ListMyBooths(ctx context.Context, userID string) ([]*domain.Exhibitor, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	exhibitors, err := s.exhibitorRepo.ListByStaffUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list booths: %w", err)
+	}
+	return exhibitors, nil
+}
+
+func (s *exhibitorService) ScanLead(ctx context.Context, exhibitorID, staffID, code, notes string) (*domain.Lead, bool, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	code = strings.ToLower(strings.TrimSpace(code))
+	notes = strings.TrimSpace(notes)
+	switch {
+	case code == "":
+		return nil, false, fmt.Errorf("code is required: %w", domain.ErrInvalidInput)
+	case utf8.RuneCountInString(notes) > domain.MaxLeadNotesLength:
+		return nil, false, fmt.Errorf("notes must be at most %d characters: %w", domain.MaxLeadNotesLength, domain.ErrInvalidInput)
+	}
+	exhibitor, err := s.staffExhibitor(ctx, exhibitorID, staffID)
+	if err != nil {
+		return nil, false, err
+	}
+	userID, err := s.leadRepo.FindConsent(ctx, exhibitor.EventID, code)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, false, domain.ErrNotFound
+		}
+		return nil, false, fmt.Errorf("find lead consent: %w", err)
+	}
+	lead := &domain.Lead{ExhibitorID: exhibitorID, EventID: exhibitor.EventID, UserID: userID, ScannedBy: staffID, Notes: notes}
+	created, err := s.leadRepo.UpsertLead(ctx, lead)
+	if err != nil {
+		return nil, false, fmt.Errorf("save lead: %w", err)
+	}
+	return lead, created, nil
+}
+
+func (s *exhibitorService) ExportLeads(ctx context.Context, exhibitorID, staffID string) ([]*domain.Lead, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	exhibitor, err := s.staffExhibitor(ctx, exhibitorID, staffID)
+	if err != nil {
+		return nil, err
+	}
+	end, err := s.eventEnd(ctx, exhibitor.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if !end.IsZero() && s.now().Before(end) {
+		return nil, domain.ErrEventNotOver
+	}
+	leads, err := s.leadRepo.ListLeads(ctx, exhibitorID)
+	if err != nil {
+		return nil, fmt.Errorf("list leads: %w", err)
+	}
+	return leads, nil
+}
+
+// teamEvent returns the event when userID is its owner or on its team, otherwise ErrForbidden.
+func (s *exhibitorService) teamEvent(ctx context.Context, eventID, userID string) (*domain.Event, error) {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID == userID {
+		return event, nil
+	}
+	members, err := s.teamRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list team members: %w", err)
+	}
+	if !slices.ContainsFunc(members, func(m *domain.EventTeamMember) bool { return m.UserID == userID }) {
+		return nil, domain.ErrForbidden
+	}
+	return event, nil
+}
+
+// eventExhibitor returns the exhibitor for the event's team, or ErrNotFound when it is not one of
+// the event's.
+func (s *exhibitorService) eventExhibitor(ctx context.Context, eventID, exhibitorID, callerID string) (*domain.Exhibitor, error) {
+	if _, err := s.teamEvent(ctx, eventID, callerID); err != nil {
+		return nil, err
+	}
+	exhibitor, err := s.exhibitorRepo.GetByID(ctx, exhibitorID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get exhibitor: %w", err)
+	}
+	if exhibitor.EventID != eventID {
+		return nil, domain.ErrNotFound
+	}
+	return exhibitor, nil
+}
+
+// staffExhibitor returns the exhibitor when staffID staffs it, otherwise ErrForbidden.
+func (s *exhibitorService) staffExhibitor(ctx context.Context, exhibitorID, staffID string) (*domain.Exhibitor, error) {
+	exhibitor, err := s.exhibitorRepo.GetByID(ctx, exhibitorID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get exhibitor: %w", err)
+	}
+	ok, err := s.exhibitorRepo.IsStaff(ctx, exhibitorID, staffID)
+	if err != nil {
+		return nil, fmt.Errorf("check exhibitor staff: %w", err)
+	}
+	if !ok {
+		return nil, domain.ErrForbidden
+	}
+	return exhibitor, nil
+}
+
+// requireRegistered returns ErrForbidden unless userID is registered for the event.
+func (s *exhibitorService) requireRegistered(ctx context.Context, eventID, userID string) error {
+	if _, err := s.registrationRepo.GetByEventAndUser(ctx, eventID, userID); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrForbidden
+		}
+		return fmt.Errorf("get registration: %w", err)
+	}
+	return nil
+}
+
+// eventEnd returns when the event ends: its last operating day's closing time, else its last
+// session's end, else the end of its day. Zero when the event has no date at all.
+func (s *exhibitorService) eventEnd(ctx context.Context, eventID string) (time.Time, error) {
+	days, err := s.operatingHoursRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("list operating hours: %w", err)
+	}
+	var end time.Time
+	for _, d := range days {
+		if d.ClosesAt.After(end) {
+			end = d.ClosesAt
+		}
+	}
+	if !end.IsZero() {
+		return end, nil
+	}
+	sessions, err := s.sessionRepo.ListSessionsByEventID(ctx, eventID)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("list sessions: %w", err)
+	}
+	for _, sess := range sessions {
+		if sess.EndTime.After(end) {
+			end = sess.EndTime
+		}
+	}
+	if !end.IsZero() {
+		return end, nil
+	}
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("get event: %w", err)
+	}
+	if event.Date != nil {
+		end = event.Date.Add(24 * time.Hour)
+	}
+	return end, nil
+}
+
+func generateLeadCode() (string, error) {
+	b := make([]byte, leadCodeBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
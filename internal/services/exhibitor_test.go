@@ -0,0 +1,289 @@
+package services
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeExhibitorRepo is an in-memory ExhibitorRepository.
+type fakeExhibitorRepo struct {
+	exhibitors map[string]*domain.Exhibitor
+	// staff maps an exhibitor ID to the user IDs staffing it.
+	staff map[string][]string
+}
+
+func newFakeExhibitorRepo() *fakeExhibitorRepo {
+	return &fakeExhibitorRepo{exhibitors: make(map[string]*domain.Exhibitor), staff: make(map[string][]string)}
+}
+
+func (f *fakeExhibitorRepo) Create(ctx context.Context, e *domain.Exhibitor) error {
+	for _, x := range f.exhibitors {
+		if x.EventID == e.EventID && e.BoothNumber != "" && x.BoothNumber == e.BoothNumber {
+			return domain.ErrDuplicateBooth
+		}
+	}
+	e.ID = "ex-" + strconv.Itoa(len(f.exhibitors)+1)
+	e.Staff = []*domain.ExhibitorStaff{}
+	f.exhibitors[e.ID] = e
+	return nil
+}
+
+func (f *fakeExhibitorRepo) GetByID(ctx context.Context, id string) (*domain.Exhibitor, error) {
+	if e, ok := f.exhibitors[id]; ok {
+		return e, nil
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (f *fakeExhibitorRepo) ListByEventID(ctx context.Context, eventID string) ([]*domain.Exhibitor, error) {
+	out := []*domain.Exhibitor{}
+	for _, e := range f.exhibitors {
+		if e.EventID == eventID {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeExhibitorRepo) ListByStaffUserID(ctx context.Context, userID string) ([]*domain.Exhibitor, error) {
+	out := []*domain.Exhibitor{}
+	for id, users := range f.staff {
+		for _, u := range users {
+			if u == userID {
+				out = append(out, f.exhibitors[id])
+			}
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeExhibitorRepo) Delete(ctx context.Context, id string) error {
+	if _, ok := f.exhibitors[id]; !ok {
+		return domain.ErrNotFound
+	}
+	delete(f.exhibitors, id)
+	delete(f.staff, id)
+	return nil
+}
+
+func (f *fakeExhibitorRepo) AddStaff(ctx context.Context, exhibitorID, userID string) error {
+	if ok, _ := f.IsStaff(ctx, exhibitorID, userID); !ok {
+		f.staff[exhibitorID] = append(f.staff[exhibitorID], userID)
+	}
+	return nil
+}
+
+func (f *fakeExhibitorRepo) RemoveStaff(ctx context.Context, exhibitorID, userID string) error {
+	for i, u := range f.staff[exhibitorID] {
+		if u == userID {
+			f.staff[exhibitorID] = append(f.staff[exhibitorID][:i], f.staff[exhibitorID][i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrNotFound
+}
+
+func (f *fakeExhibitorRepo) IsStaff(ctx context.Context, exhibitorID, userID string) (bool, error) {
+	for _, u := range f.staff[exhibitorID] {
+		if u == userID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// fakeLeadRepo is an in-memory LeadRepository.
+type fakeLeadRepo struct {
+	// codes maps "eventID:userID" to the attendee's lead code.
+	codes map[string]string
+	leads []*domain.Lead
+}
+
+func newFakeLeadRepo() *fakeLeadRepo {
+	return &fakeLeadRepo{codes: make(map[string]string)}
+}
+
+func (f *fakeLeadRepo) GetConsentCode(ctx context.Context, eventID, userID string) (string, error) {
+	return f.codes[eventID+":"+userID], nil
+}
+
+func (f *fakeLeadRepo) SetConsentCode(ctx context.Context, eventID, userID, code string) error {
+	if code == "" {
+		delete(f.codes, eventID+":"+userID)
+		return nil
+	}
+	f.codes[eventID+":"+userID] = code
+	return nil
+}
+
+func (f *fakeLeadRepo) FindConsent(ctx context.Context, eventID, code string) (string, error) {
+	for key, c := range f.codes {
+		if userID, ok := strings.CutPrefix(key, eventID+":"); ok && c == code {
+			return userID, nil
+		}
+	}
+	return "", domain.ErrNotFound
+}
+
+func (f *fakeLeadRepo) UpsertLead(ctx context.Context, lead *domain.Lead) (bool, error) {
+	for _, l := range f.leads {
+		if l.ExhibitorID == lead.ExhibitorID && l.UserID == lead.UserID {
+			if lead.Notes != "" {
+				l.Notes = lead.Notes
+			}
+			*lead = *l
+			return false, nil
+		}
+	}
+	lead.ID = "lead-" + strconv.Itoa(len(f.leads)+1)
+	stored := *lead
+	f.leads = append(f.leads, &stored)
+	return true, nil
+}
+
+func (f *fakeLeadRepo) ListLeads(ctx context.Context, exhibitorID string) ([]*domain.Lead, error) {
+	out := []*domain.Lead{}
+	for _, l := range f.leads {
+		if l.ExhibitorID == exhibitorID {
+			out = append(out, l)
+		}
+	}
+	return out, nil
+}
+
+func TestExhibitorService(t *testing.T) {
+	ctx := context.Background()
+	end := time.Date(2026, 5, 14, 18, 0, 0, 0, time.UTC)
+
+	setup := func() (*exhibitorService, *fakeExhibitorRepo, *fakeLeadRepo) {
+		eventRepo := newFakeEventRepo()
+		eventRepo.byID["ev-1"] = &domain.Event{ID: "ev-1", Name: "GopherCon", OwnerID: "user-owner"}
+		teamRepo := newFakeEventTeamMemberRepo()
+		require.NoError(t, teamRepo.Add(ctx, "ev-1", "user-team"))
+		userRepo := newFakeUserRepoForSchedule()
+		userRepo.addUserWithName("sam@acme.example", "user-staff", "Sam", "Staff")
+		regs := &mockEventRegistrationRepository{regByEventAndUser: map[string]*domain.EventRegistration{
+			"ev-1:user-ann": {ID: "reg-1", EventID: "ev-1", UserID: "user-ann"},
+		}}
+		hours := newFakeOperatingHoursRepo()
+		hours.byEventID["ev-1"] = []*domain.OperatingDay{{Day: "2026-05-14", OpensAt: end.Add(-9 * time.Hour), ClosesAt: end}}
+		exhibitors := newFakeExhibitorRepo()
+		leads := newFakeLeadRepo()
+		svc := NewExhibitorService(eventRepo, teamRepo, userRepo, regs, newFakeSessionRepo(), hours, exhibitors, leads, 5*time.Second).(*exhibitorService)
+		svc.now = func() time.Time { return end.Add(-time.Hour) }
+		return svc, exhibitors, leads
+	}
+
+	t.Run("team manages exhibitors", func(t *testing.T) {
+		svc, _, _ := setup()
+		_, err := svc.CreateExhibitor(ctx, "ev-1", "user-stranger", "Acme", "A12")
+		require.ErrorIs(t, err, domain.ErrForbidden)
+		_, err = svc.CreateExhibitor(ctx, "ev-1", "user-team", " ", "A12")
+		require.ErrorIs(t, err, domain.ErrInvalidInput)
+
+		e, err := svc.CreateExhibitor(ctx, "ev-1", "user-team", " Acme ", " A12 ")
+		require.NoError(t, err)
+		assert.Equal(t, "Acme", e.Name)
+		assert.Equal(t, "A12", e.BoothNumber)
+		_, err = svc.CreateExhibitor(ctx, "ev-1", "user-owner", "Initech", "A12")
+		require.ErrorIs(t, err, domain.ErrDuplicateBooth)
+
+		staff, err := svc.AddExhibitorStaff(ctx, "ev-1", e.ID, "user-owner", "Sam@Acme.example")
+		require.NoError(t, err)
+		assert.Equal(t, &domain.ExhibitorStaff{ExhibitorID: e.ID, UserID: "user-staff", Name: "Sam", LastName: "Staff", Email: "sam@acme.example"}, staff)
+		_, err = svc.AddExhibitorStaff(ctx, "ev-1", e.ID, "user-owner", "nobody@acme.example")
+		require.ErrorIs(t, err, domain.ErrUserNotFound)
+		_, err = svc.AddExhibitorStaff(ctx, "ev-2", e.ID, "user-owner", "sam@acme.example")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+
+		booths, err := svc.ListMyBooths(ctx, "user-staff")
+		require.NoError(t, err)
+		require.Len(t, booths, 1)
+
+		require.NoError(t, svc.RemoveExhibitorStaff(ctx, "ev-1", e.ID, "user-owner", "user-staff"))
+		require.ErrorIs(t, svc.RemoveExhibitorStaff(ctx, "ev-1", e.ID, "user-owner", "user-staff"), domain.ErrNotFound)
+		require.NoError(t, svc.DeleteExhibitor(ctx, "ev-1", e.ID, "user-team"))
+		list, err := svc.ListExhibitors(ctx, "ev-1", "user-team")
+		require.NoError(t, err)
+		assert.Empty(t, list)
+	})
+
+	t.Run("lead consent", func(t *testing.T) {
+		svc, _, _ := setup()
+		_, err := svc.GetLeadConsent(ctx, "ev-1", "user-stranger")
+		require.ErrorIs(t, err, domain.ErrForbidden)
+
+		c, err := svc.GetLeadConsent(ctx, "ev-1", "user-ann")
+		require.NoError(t, err)
+		assert.Equal(t, &domain.LeadConsent{EventID: "ev-1"}, c)
+
+		c, err = svc.SetLeadConsent(ctx, "ev-1", "user-ann", true)
+		require.NoError(t, err)
+		assert.True(t, c.Consent)
+		assert.Len(t, c.Code, 2*leadCodeBytes)
+		again, err := svc.SetLeadConsent(ctx, "ev-1", "user-ann", true)
+		require.NoError(t, err)
+		assert.Equal(t, c.Code, again.Code, "consenting again keeps the code")
+
+		c, err = svc.SetLeadConsent(ctx, "ev-1", "user-ann", false)
+		require.NoError(t, err)
+		assert.Equal(t, &domain.LeadConsent{EventID: "ev-1"}, c)
+	})
+
+	t.Run("staff scan leads and export them after the event", func(t *testing.T) {
+		svc, exhibitors, _ := setup()
+		e, err := svc.CreateExhibitor(ctx, "ev-1", "user-owner", "Acme", "A12")
+		require.NoError(t, err)
+		require.NoError(t, exhibitors.AddStaff(ctx, e.ID, "user-staff"))
+		consent, err := svc.SetLeadConsent(ctx, "ev-1", "user-ann", true)
+		require.NoError(t, err)
+
+		_, _, err = svc.ScanLead(ctx, e.ID, "user-stranger", consent.Code, "")
+		require.ErrorIs(t, err, domain.ErrForbidden)
+		_, _, err = svc.ScanLead(ctx, e.ID, "user-staff", "unknown", "")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+
+		lead, created, err := svc.ScanLead(ctx, e.ID, "user-staff", " "+consent.Code+" ", "wants a demo")
+		require.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, "user-ann", lead.UserID)
+		assert.Equal(t, "user-staff", lead.ScannedBy)
+		lead, created, err = svc.ScanLead(ctx, e.ID, "user-staff", consent.Code, "")
+		require.NoError(t, err)
+		assert.False(t, created)
+		assert.Equal(t, "wants a demo", lead.Notes, "a rescan without notes keeps them")
+
+		_, err = svc.ExportLeads(ctx, e.ID, "user-staff")
+		require.ErrorIs(t, err, domain.ErrEventNotOver)
+		svc.now = func() time.Time { return end.Add(time.Minute) }
+		_, err = svc.ExportLeads(ctx, e.ID, "user-team")
+		require.ErrorIs(t, err, domain.ErrForbidden)
+		leads, err := svc.ExportLeads(ctx, e.ID, "user-staff")
+		require.NoError(t, err)
+		require.Len(t, leads, 1)
+	})
+
+	t.Run("event end falls back to sessions and date", func(t *testing.T) {
+		svc, _, _ := setup()
+		svc.operatingHoursRepo = newFakeOperatingHoursRepo()
+		sessions := newFakeSessionRepo()
+		sessions.sessions = []*domain.Session{{ID: "s-1", EventID: "ev-1", StartTime: end.Add(-2 * time.Hour), EndTime: end.Add(-time.Hour)}}
+		svc.sessionRepo = sessions
+		got, err := svc.eventEnd(ctx, "ev-1")
+		require.NoError(t, err)
+		assert.Equal(t, end.Add(-time.Hour), got)
+
+		svc.sessionRepo = newFakeSessionRepo()
+		got, err = svc.eventEnd(ctx, "ev-1")
+		require.NoError(t, err)
+		assert.True(t, got.IsZero(), "an event without a date can always export")
+	})
+}
@@ -0,0 +1,91 @@
+package services
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"multitrackticketing/internal/domain"
+)
+
+// ValidateSessionizeExport runs the checks of ValidateSchedule on the schedule a Sessionize import
+// of data would leave, without an event or a database, so a schedule file can be checked before it
+// is published. Rooms, sessions and speakers keep their Sessionize IDs. rules and days are the
+// event's; pass domain.NewScheduleRules and no days to check the file on its own. mapping renames
+// rooms as the import would and may be nil.
+//
+// Sessionize has no room capacities, and the import clears them, so the capacity check is left to
+// the API. The returned notes list what the import would skip or drop.
+func (p SchedulePolicy) ValidateSessionizeExport(eventID string, data domain.SessionFetcherResponse, mapping *domain.ImportMapping, rules *domain.ScheduleRules, days []*domain.OperatingDay) (*domain.ScheduleValidation, []string, error) {
+	if mapping == nil {
+		mapping = domain.NewImportMapping(eventID)
+	}
+	notes := []string{}
+
+	// The import upserts by Sessionize ID, so of an ID listed twice the last entry wins.
+	rooms := make([]*domain.Room, 0, len(data.Rooms))
+	roomIndex := make(map[int]int, len(data.Rooms))
+	for _, room := range data.Rooms {
+		name := room.Name
+		if override, ok := mapping.RoomNameOverrides[room.Name]; ok {
+			name = override
+		}
+		r := &domain.Room{ID: strconv.Itoa(room.ID), EventID: eventID, Name: name, SourceSessionID: room.ID, Source: "sessionize"}
+		if i, ok := roomIndex[room.ID]; ok {
+			notes = append(notes, fmt.Sprintf("room %d is listed twice; the import keeps the last one (%s)", room.ID, room.Name))
+			rooms[i] = r
+			continue
+		}
+		roomIndex[room.ID] = len(rooms)
+		rooms = append(rooms, r)
+	}
+
+	speakerIDs := make(map[string]bool, len(data.Speakers))
+	speakers := make([]*domain.Speaker, 0, len(data.Speakers))
+	for _, sp := range data.Speakers {
+		if !speakerIDs[sp.ID] {
+			speakerIDs[sp.ID] = true
+			speakers = append(speakers, &domain.Speaker{ID: sp.ID, EventID: eventID, FirstName: sp.FirstName, LastName: sp.LastName})
+		}
+	}
+
+	sessions := make([]*domain.Session, 0, len(data.Sessions))
+	sessionIndex := make(map[string]int, len(data.Sessions))
+	for _, sess := range data.Sessions {
+		if _, ok := roomIndex[sess.RoomID]; !ok {
+			notes = append(notes, fmt.Sprintf("session %s (%q) is skipped: room %d is not in the rooms list", sess.ID, sess.Title, sess.RoomID))
+			continue
+		}
+		if strings.TrimSpace(sess.Title) == "" {
+			notes = append(notes, fmt.Sprintf("session %s has no title", sess.ID))
+		}
+		var linked []string
+		for _, speakerID := range sess.Speakers {
+			if !speakerIDs[speakerID] {
+				notes = append(notes, fmt.Sprintf("session %s (%q) lists speaker %s, who is not in the speakers list; the link is dropped", sess.ID, sess.Title, speakerID))
+				continue
+			}
+			linked = append(linked, speakerID)
+		}
+		domainSess := &domain.Session{
+			ID: sess.ID, EventID: eventID, RoomID: strconv.Itoa(sess.RoomID), SourceSessionID: sess.ID, Source: "sessionize",
+			Title: sess.Title, StartTime: p.normalize(sess.StartsAt), EndTime: p.normalize(sess.EndsAt), SpeakerIDs: linked,
+		}
+		if i, ok := sessionIndex[sess.ID]; ok {
+			notes = append(notes, fmt.Sprintf("session %s is listed twice; the import keeps the last one (%q)", sess.ID, sess.Title))
+			sessions[i] = domainSess
+			continue
+		}
+		sessionIndex[sess.ID] = len(sessions)
+		sessions = append(sessions, domainSess)
+	}
+
+	v, err := p.validateSchedule(eventID, rules, days, rooms, sessions, speakers)
+	if err != nil {
+		return nil, nil, err
+	}
+	v.ProblemCount -= len(v.Categories[domain.ValidationCapacity])
+	v.Categories[domain.ValidationCapacity] = []*domain.ScheduleProblem{}
+	v.Valid = v.ProblemCount == 0
+	return v, notes, nil
+}
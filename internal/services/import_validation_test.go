@@ -0,0 +1,79 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"multitrackticketing/internal/domain"
+)
+
+func TestSchedulePolicy_ValidateSessionizeExport(t *testing.T) {
+	day := time.Date(2026, 5, 14, 0, 0, 0, 0, time.UTC)
+	at := func(h, m int) time.Time { return day.Add(time.Duration(h)*time.Hour + time.Duration(m)*time.Minute) }
+	data := domain.SessionFetcherResponse{
+		Rooms: []domain.SessionFetcherRoom{{ID: 1, Name: "Sala 1"}, {ID: 2, Name: "Sala 2"}},
+		Speakers: []domain.SessionFetcherSpeaker{
+			{ID: "sp-1", FirstName: "Ada", LastName: "Lovelace"},
+		},
+		Sessions: []domain.SessionFetcherSession{
+			{ID: "10", Title: "Keynote", RoomID: 1, StartsAt: at(9, 0), EndsAt: at(10, 0), Speakers: []string{"sp-1"}},
+			{ID: "11", Title: "Overlaps keynote", RoomID: 1, StartsAt: at(9, 30), EndsAt: at(10, 30)},
+			{ID: "12", Title: "Parallel", RoomID: 2, StartsAt: at(9, 45), EndsAt: at(10, 45), Speakers: []string{"sp-1", "sp-9"}},
+			{ID: "13", Title: "Nowhere", RoomID: 7, StartsAt: at(11, 0), EndsAt: at(12, 0)},
+		},
+	}
+	mapping := &domain.ImportMapping{RoomNameOverrides: map[string]string{"Sala 1": "Main Stage"}}
+
+	t.Run("file on its own", func(t *testing.T) {
+		v, notes, err := SchedulePolicy{}.ValidateSessionizeExport("ev-1", data, mapping, domain.NewScheduleRules("ev-1"), nil)
+		require.NoError(t, err)
+		assert.False(t, v.Valid)
+		assert.Equal(t, 3, v.SessionsChecked)
+		assert.Equal(t, 2, v.ProblemCount)
+
+		overlaps := v.Categories[domain.ValidationRoomOverlap]
+		require.Len(t, overlaps, 1)
+		assert.Equal(t, `"Keynote" and "Overlaps keynote" overlap in Main Stage`, overlaps[0].Message)
+		assert.Equal(t, "1", overlaps[0].RoomID)
+		clashes := v.Categories[domain.ValidationSpeakerClash]
+		require.Len(t, clashes, 1)
+		assert.Equal(t, "sp-1", clashes[0].SpeakerID)
+		assert.Empty(t, v.Categories[domain.ValidationCapacity], "exports carry no capacities")
+
+		assert.Equal(t, []string{
+			`session 12 ("Parallel") lists speaker sp-9, who is not in the speakers list; the link is dropped`,
+			`session 13 ("Nowhere") is skipped: room 7 is not in the rooms list`,
+		}, notes)
+	})
+
+	t.Run("against the event's rules and hours", func(t *testing.T) {
+		rules := &domain.ScheduleRules{AllowedDurations: []int{60}}
+		days := []*domain.OperatingDay{{Day: "2026-05-14", OpensAt: at(9, 30), ClosesAt: at(18, 0)}}
+		v, _, err := SchedulePolicy{}.ValidateSessionizeExport("ev-1", data, nil, rules, days)
+		require.NoError(t, err)
+		require.Len(t, v.Categories[domain.ValidationOperatingHours], 1)
+		assert.Equal(t, "10", v.Categories[domain.ValidationOperatingHours][0].SessionID)
+		assert.Empty(t, v.Categories[domain.ValidationScheduleRules])
+		assert.Contains(t, v.Categories[domain.ValidationRoomOverlap][0].Message, "Sala 1")
+	})
+
+	t.Run("last duplicate wins", func(t *testing.T) {
+		dup := domain.SessionFetcherResponse{
+			Rooms: []domain.SessionFetcherRoom{{ID: 1, Name: "Sala 1"}},
+			Sessions: []domain.SessionFetcherSession{
+				{ID: "10", Title: "Draft", RoomID: 1, StartsAt: at(9, 0), EndsAt: at(10, 0)},
+				{ID: "11", Title: "Talk", RoomID: 1, StartsAt: at(10, 0), EndsAt: at(11, 0)},
+				{ID: "10", Title: "Final", RoomID: 1, StartsAt: at(10, 30), EndsAt: at(11, 30)},
+			},
+		}
+		v, notes, err := SchedulePolicy{}.ValidateSessionizeExport("ev-1", dup, nil, domain.NewScheduleRules("ev-1"), nil)
+		require.NoError(t, err)
+		assert.Equal(t, 2, v.SessionsChecked)
+		require.Len(t, v.Categories[domain.ValidationRoomOverlap], 1)
+		assert.Contains(t, v.Categories[domain.ValidationRoomOverlap][0].Message, `"Final"`)
+		assert.Equal(t, []string{`session 10 is listed twice; the import keeps the last one ("Final")`}, notes)
+	})
+}
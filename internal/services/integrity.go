@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+type integrityChecker struct {
+	integrityRepo  domain.IntegrityRepository
+	contextTimeout time.Duration
+}
+
+// NewIntegrityChecker returns a domain.IntegrityChecker. timeout bounds each repository call, so a
+// sweep over many events is not cut short by a single deadline.
+func NewIntegrityChecker(integrityRepo domain.IntegrityRepository, timeout time.Duration) domain.IntegrityChecker {
+	return &integrityChecker{
+		integrityRepo:  integrityRepo,
+		contextTimeout: timeout,
+	}
+}
+
+// CheckAll keeps going when one event fails to check; the failures are joined into the returned
+// error alongside the partial sweep.
+func (c *integrityChecker) CheckAll(ctx context.Context) (*domain.IntegritySweep, error) {
+	listCtx, cancel := context.WithTimeout(ctx, c.contextTimeout)
+	eventIDs, err := c.integrityRepo.ListEventIDs(listCtx)
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("list events: %w", err)
+	}
+
+	sweep := &domain.IntegritySweep{}
+	var errs []error
+	for _, eventID := range eventIDs {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, err)
+			break
+		}
+		eventCtx, cancel := context.WithTimeout(ctx, c.contextTimeout)
+		issues, err := c.integrityRepo.FindIssues(eventCtx, eventID)
+		cancel()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("find integrity issues for event %s: %w", eventID, err))
+			continue
+		}
+		sweep.EventsChecked++
+		if len(issues) > 0 {
+			sweep.Reports = append(sweep.Reports, newIntegrityReport(eventID, issues))
+		}
+	}
+
+	tagCtx, cancel := context.WithTimeout(ctx, c.contextTimeout)
+	sweep.OrphanTagsDeleted, err = c.integrityRepo.DeleteOrphanTags(tagCtx)
+	cancel()
+	if err != nil {
+		errs = append(errs, fmt.Errorf("delete orphan tags: %w", err))
+	}
+	return sweep, errors.Join(errs...)
+}
+
+func newIntegrityReport(eventID string, issues []*domain.IntegrityIssue) *domain.IntegrityReport {
+	report := &domain.IntegrityReport{
+		EventID:     eventID,
+		GeneratedAt: time.Now(),
+		Issues:      issues,
+	}
+	if report.Issues == nil {
+		report.Issues = []*domain.IntegrityIssue{}
+	}
+	for _, issue := range issues {
+		if issue.Fixable {
+			report.Fixable++
+		}
+	}
+	return report
+}
@@ -0,0 +1,35 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"multitrackticketing/internal/domain"
+)
+
+func TestIntegrityChecker_CheckAll(t *testing.T) {
+	repo := newFakeIntegrityRepo()
+	repo.eventIDs = []string{"ev-clean", "ev-dirty", "ev-broken"}
+	repo.issues["ev-dirty"] = []*domain.IntegrityIssue{
+		{Kind: domain.IssueCrossEventSpeaker, EntityID: "sess-1", RelatedID: "sp-9", Fixable: true},
+		{Kind: domain.IssueRoomOverlap, EntityID: "sess-1", RelatedID: "sess-2"},
+	}
+	repo.findErr["ev-broken"] = errors.New("db down")
+	repo.orphanTags = 3
+
+	sweep, err := NewIntegrityChecker(repo, time.Second).CheckAll(context.Background())
+
+	require.ErrorContains(t, err, "ev-broken")
+	require.NotNil(t, sweep)
+	assert.Equal(t, 2, sweep.EventsChecked)
+	require.Len(t, sweep.Reports, 1)
+	assert.Equal(t, "ev-dirty", sweep.Reports[0].EventID)
+	assert.Equal(t, 1, sweep.Reports[0].Fixable)
+	assert.Equal(t, int64(3), sweep.OrphanTagsDeleted)
+	assert.Empty(t, repo.cleanedEvents, "the periodic check must not change event data")
+}
@@ -0,0 +1,19 @@
+package services
+
+import (
+	"slices"
+
+	"multitrackticketing/internal/domain"
+)
+
+// normalizeEmailDomains normalizes, sorts and deduplicates domains, dropping empty ones.
+func normalizeEmailDomains(domains []string) []string {
+	out := make([]string, 0, len(domains))
+	for _, d := range domains {
+		if d = domain.NormalizeEmailDomain(d); d != "" {
+			out = append(out, d)
+		}
+	}
+	slices.Sort(out)
+	return slices.Compact(out)
+}
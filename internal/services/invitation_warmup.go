@@ -0,0 +1,308 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+// warmupBatchSize is how many invitations one SendEventInvitations call of the warm-up sender sends.
+const warmupBatchSize = 100
+
+// emailDomainRefreshAge is how old the shared domain report must be before an owner's refresh looks
+// the records up again, so owners cannot send DNS lookups on every call.
+const emailDomainRefreshAge = time.Minute
+
+type invitationWarmupService struct {
+	events         domain.EventService
+	eventRepo      domain.EventRepository
+	warmupRepo     domain.InvitationWarmupRepository
+	emailDomain    domain.EmailDomainChecker
+	now            func() time.Time
+	contextTimeout time.Duration
+}
+
+// NewInvitationWarmupService returns a domain.InvitationWarmupService. Invitations are sent through
+// events.SendEventInvitations as the event's owner, so the event's invitation domain rules apply
+// when each address's turn comes. emailDomain checks the sending domain for owners; it is nil when
+// mail is not sent through SES.
+func NewInvitationWarmupService(events domain.EventService, eventRepo domain.EventRepository, warmupRepo domain.InvitationWarmupRepository, emailDomain domain.EmailDomainChecker, timeout time.Duration) domain.InvitationWarmupService {
+	return &invitationWarmupService{
+		events:         events,
+		eventRepo:      eventRepo,
+		warmupRepo:     warmupRepo,
+		emailDomain:    emailDomain,
+		now:            time.Now,
+		contextTimeout: timeout,
+	}
+}
+
+func (s *invitationWarmupService) CreateWarmup(ctx context.Context, eventID, ownerID string, emails []string, days int, dailyVolumes []int, dryRun bool) (*domain.InvitationWarmup, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := s.ownedEvent(ctx, eventID, ownerID); err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool, len(emails))
+	queue := make([]string, 0, len(emails))
+	for _, email := range emails {
+		email = strings.TrimSpace(strings.ToLower(email))
+		if email == "" || seen[email] {
+			continue
+		}
+		seen[email] = true
+		queue = append(queue, email)
+	}
+	if len(queue) == 0 {
+		return nil, fmt.Errorf("emails is required: %w", domain.ErrInvalidInput)
+	}
+	if days < 0 || days > domain.MaxWarmupDays {
+		return nil, fmt.Errorf("days must be 1 to %d: %w", domain.MaxWarmupDays, domain.ErrInvalidInput)
+	}
+	if days > 0 && len(dailyVolumes) > 0 {
+		return nil, fmt.Errorf("give days or daily_volumes, not both: %w", domain.ErrInvalidInput)
+	}
+	if len(dailyVolumes) > domain.MaxWarmupDays {
+		return nil, fmt.Errorf("at most %d daily_volumes: %w", domain.MaxWarmupDays, domain.ErrInvalidInput)
+	}
+	for _, v := range dailyVolumes {
+		if v < 1 || v > domain.MaxWarmupDailyVolume {
+			return nil, fmt.Errorf("daily_volumes must be 1 to %d: %w", domain.MaxWarmupDailyVolume, domain.ErrInvalidInput)
+		}
+	}
+	switch {
+	case days > 0:
+		dailyVolumes = domain.RampWarmupVolumes(len(queue), days)
+	case len(dailyVolumes) == 0:
+		dailyVolumes = domain.DefaultWarmupDailyVolumes
+	}
+
+	w := &domain.InvitationWarmup{
+		EventID:      eventID,
+		DryRun:       dryRun,
+		Status:       domain.WarmupStatusActive,
+		DailyVolumes: dailyVolumes,
+		StartsAt:     s.now().UTC(),
+		Total:        len(queue),
+		Queued:       len(queue),
+	}
+	w.PlanSchedule()
+	if dryRun {
+		return w, nil
+	}
+	if err := s.warmupRepo.Create(ctx, w, queue); err != nil {
+		return nil, fmt.Errorf("create warm-up: %w", err)
+	}
+	return w, nil
+}
+
+func (s *invitationWarmupService) ListWarmups(ctx context.Context, eventID, ownerID string) ([]*domain.InvitationWarmup, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := s.ownedEvent(ctx, eventID, ownerID); err != nil {
+		return nil, err
+	}
+	warmups, err := s.warmupRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list warm-ups: %w", err)
+	}
+	for _, w := range warmups {
+		w.PlanSchedule()
+	}
+	return warmups, nil
+}
+
+func (s *invitationWarmupService) GetWarmup(ctx context.Context, eventID, warmupID, ownerID string) (*domain.InvitationWarmup, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	return s.ownedWarmup(ctx, eventID, warmupID, ownerID)
+}
+
+func (s *invitationWarmupService) PauseWarmup(ctx context.Context, eventID, warmupID, ownerID string) (*domain.InvitationWarmup, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	w, err := s.ownedWarmup(ctx, eventID, warmupID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	switch w.Status {
+	case domain.WarmupStatusCompleted:
+		return nil, domain.ErrWarmupCompleted
+	case domain.WarmupStatusPaused:
+		return w, nil
+	}
+	now := s.now().UTC()
+	w.Status, w.PausedAt = domain.WarmupStatusPaused, &now
+	if err := s.warmupRepo.UpdateStatus(ctx, w); err != nil {
+		return nil, fmt.Errorf("pause warm-up: %w", err)
+	}
+	return w, nil
+}
+
+func (s *invitationWarmupService) ResumeWarmup(ctx context.Context, eventID, warmupID, ownerID string) (*domain.InvitationWarmup, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	w, err := s.ownedWarmup(ctx, eventID, warmupID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	switch w.Status {
+	case domain.WarmupStatusCompleted:
+		return nil, domain.ErrWarmupCompleted
+	case domain.WarmupStatusActive:
+		return w, nil
+	}
+	if w.PausedAt != nil {
+		w.StartsAt = w.StartsAt.Add(s.now().Sub(*w.PausedAt))
+	}
+	w.Status, w.PausedAt = domain.WarmupStatusActive, nil
+	if err := s.warmupRepo.UpdateStatus(ctx, w); err != nil {
+		return nil, fmt.Errorf("resume warm-up: %w", err)
+	}
+	w.PlanSchedule()
+	return w, nil
+}
+
+// CheckEmailDomain reports the platform sender's records: there are no per-organization senders
+// yet, so every event's invitations come from the same domain. refresh is ignored while the cached
+// report is younger than emailDomainRefreshAge.
+func (s *invitationWarmupService) CheckEmailDomain(ctx context.Context, eventID, ownerID string, refresh bool) (*domain.EmailDomainReport, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := s.ownedEvent(ctx, eventID, ownerID); err != nil {
+		return nil, err
+	}
+	if s.emailDomain == nil {
+		return nil, domain.ErrEmailDomainNotChecked
+	}
+	report, err := s.emailDomain.CheckEmailDomain(ctx, false)
+	if err == nil && refresh && s.now().Sub(report.CheckedAt) >= emailDomainRefreshAge {
+		report, err = s.emailDomain.CheckEmailDomain(ctx, true)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("check email domain: %w", err)
+	}
+	return report, nil
+}
+
+func (s *invitationWarmupService) SendDue(ctx context.Context) (*domain.WarmupRun, error) {
+	warmups, err := s.warmupRepo.ListActive(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list active warm-ups: %w", err)
+	}
+	run := &domain.WarmupRun{Warmups: len(warmups)}
+	var errs []error
+	for _, w := range warmups {
+		if err := s.sendDue(ctx, w, run); err != nil {
+			errs = append(errs, fmt.Errorf("warm-up %s: %w", w.ID, err))
+		}
+	}
+	return run, errors.Join(errs...)
+}
+
+// sendDue sends the warm-up's due invitations, adding them to run, and completes it when none are
+// left.
+func (s *invitationWarmupService) sendDue(ctx context.Context, w *domain.InvitationWarmup, run *domain.WarmupRun) error {
+	event, err := s.eventRepo.GetByID(ctx, w.EventID)
+	if err != nil {
+		return fmt.Errorf("get event: %w", err)
+	}
+	for due := w.Due(s.now()) - w.Processed(); due > 0; {
+		emails, err := s.warmupRepo.NextQueued(ctx, w.ID, min(due, warmupBatchSize))
+		if err != nil {
+			return fmt.Errorf("next queued: %w", err)
+		}
+		if len(emails) == 0 {
+			break
+		}
+		sent, failed, skipped, err := s.events.SendEventInvitations(ctx, w.EventID, event.OwnerID, emails)
+		if err != nil {
+			return fmt.Errorf("send invitations: %w", err)
+		}
+		notSent := make(map[string]bool, len(failed)+len(skipped))
+		for _, email := range failed {
+			notSent[email] = true
+		}
+		for _, email := range skipped {
+			notSent[email] = true
+		}
+		var delivered []string
+		for _, email := range emails {
+			if !notSent[email] {
+				delivered = append(delivered, email)
+			}
+		}
+		for _, outcome := range []struct {
+			status string
+			emails []string
+		}{
+			{domain.WarmupRecipientSent, delivered},
+			{domain.WarmupRecipientFailed, failed},
+			{domain.WarmupRecipientSkipped, skipped},
+		} {
+			if err := s.warmupRepo.MarkRecipients(ctx, w.ID, outcome.emails, outcome.status); err != nil {
+				return fmt.Errorf("mark recipients %s: %w", outcome.status, err)
+			}
+		}
+		run.Sent += sent
+		run.Failed += len(failed)
+		run.Skipped += len(skipped)
+		w.Queued -= len(emails)
+		due -= len(emails)
+	}
+	if w.Queued > 0 {
+		return nil
+	}
+	now := s.now().UTC()
+	w.Status, w.CompletedAt = domain.WarmupStatusCompleted, &now
+	if err := s.warmupRepo.UpdateStatus(ctx, w); err != nil {
+		return fmt.Errorf("complete warm-up: %w", err)
+	}
+	run.Completed++
+	return nil
+}
+
+// ownedEvent returns ErrNotFound, or ErrForbidden unless ownerID owns the event.
+func (s *invitationWarmupService) ownedEvent(ctx context.Context, eventID, ownerID string) error {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return domain.ErrForbidden
+	}
+	return nil
+}
+
+// ownedWarmup returns the event's warm-up with its schedule, ErrNotFound, or ErrForbidden unless
+// ownerID owns the event.
+func (s *invitationWarmupService) ownedWarmup(ctx context.Context, eventID, warmupID, ownerID string) (*domain.InvitationWarmup, error) {
+	if err := s.ownedEvent(ctx, eventID, ownerID); err != nil {
+		return nil, err
+	}
+	w, err := s.warmupRepo.GetByID(ctx, warmupID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get warm-up: %w", err)
+	}
+	if w.EventID != eventID {
+		return nil, domain.ErrNotFound
+	}
+	w.PlanSchedule()
+	return w, nil
+}
@@ -0,0 +1,295 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"multitrackticketing/internal/domain"
+)
+
+type fakeWarmupRecipient struct {
+	email  string
+	status string
+}
+
+// fakeInvitationWarmupRepo is an in-memory InvitationWarmupRepository for tests.
+type fakeInvitationWarmupRepo struct {
+	warmups    map[string]*domain.InvitationWarmup
+	recipients map[string][]*fakeWarmupRecipient
+	nextID     int
+}
+
+func newFakeInvitationWarmupRepo() *fakeInvitationWarmupRepo {
+	return &fakeInvitationWarmupRepo{warmups: make(map[string]*domain.InvitationWarmup), recipients: make(map[string][]*fakeWarmupRecipient), nextID: 1}
+}
+
+func (f *fakeInvitationWarmupRepo) Create(ctx context.Context, w *domain.InvitationWarmup, emails []string) error {
+	w.ID = fmt.Sprintf("wu-%d", f.nextID)
+	f.nextID++
+	stored := *w
+	f.warmups[w.ID] = &stored
+	for _, email := range emails {
+		f.recipients[w.ID] = append(f.recipients[w.ID], &fakeWarmupRecipient{email: email, status: domain.WarmupRecipientQueued})
+	}
+	return nil
+}
+
+// withCounts returns a copy of the warm-up with its recipient counts, as the database would.
+func (f *fakeInvitationWarmupRepo) withCounts(w *domain.InvitationWarmup) *domain.InvitationWarmup {
+	out := *w
+	out.Total, out.Sent, out.Failed, out.Skipped, out.Queued = 0, 0, 0, 0, 0
+	for _, r := range f.recipients[w.ID] {
+		out.Total++
+		switch r.status {
+		case domain.WarmupRecipientSent:
+			out.Sent++
+		case domain.WarmupRecipientFailed:
+			out.Failed++
+		case domain.WarmupRecipientSkipped:
+			out.Skipped++
+		default:
+			out.Queued++
+		}
+	}
+	return &out
+}
+
+func (f *fakeInvitationWarmupRepo) GetByID(ctx context.Context, id string) (*domain.InvitationWarmup, error) {
+	w, ok := f.warmups[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return f.withCounts(w), nil
+}
+
+func (f *fakeInvitationWarmupRepo) ListByEventID(ctx context.Context, eventID string) ([]*domain.InvitationWarmup, error) {
+	out := []*domain.InvitationWarmup{}
+	for _, w := range f.warmups {
+		if w.EventID == eventID {
+			out = append(out, f.withCounts(w))
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeInvitationWarmupRepo) ListActive(ctx context.Context) ([]*domain.InvitationWarmup, error) {
+	out := []*domain.InvitationWarmup{}
+	for _, w := range f.warmups {
+		if w.Status == domain.WarmupStatusActive {
+			out = append(out, f.withCounts(w))
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeInvitationWarmupRepo) UpdateStatus(ctx context.Context, w *domain.InvitationWarmup) error {
+	stored, ok := f.warmups[w.ID]
+	if !ok {
+		return domain.ErrNotFound
+	}
+	stored.Status, stored.StartsAt, stored.PausedAt, stored.CompletedAt = w.Status, w.StartsAt, w.PausedAt, w.CompletedAt
+	return nil
+}
+
+func (f *fakeInvitationWarmupRepo) NextQueued(ctx context.Context, warmupID string, limit int) ([]string, error) {
+	emails := []string{}
+	for _, r := range f.recipients[warmupID] {
+		if r.status == domain.WarmupRecipientQueued && len(emails) < limit {
+			emails = append(emails, r.email)
+		}
+	}
+	return emails, nil
+}
+
+func (f *fakeInvitationWarmupRepo) MarkRecipients(ctx context.Context, warmupID string, emails []string, status string) error {
+	for _, email := range emails {
+		for _, r := range f.recipients[warmupID] {
+			if r.email == email && r.status == domain.WarmupRecipientQueued {
+				r.status = status
+			}
+		}
+	}
+	return nil
+}
+
+func TestInvitationWarmup_Schedule(t *testing.T) {
+	start := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	w := &domain.InvitationWarmup{Status: domain.WarmupStatusActive, DailyVolumes: []int{10, 20}, StartsAt: start, Total: 65}
+
+	w.PlanSchedule()
+	require.Len(t, w.Schedule, 4)
+	assert.Equal(t, []int{10, 20, 20, 15}, []int{w.Schedule[0].Volume, w.Schedule[1].Volume, w.Schedule[2].Volume, w.Schedule[3].Volume})
+	assert.Equal(t, start.Add(72*time.Hour), w.Schedule[3].StartsAt)
+
+	assert.Equal(t, 0, w.Due(start.Add(-time.Hour)))
+	assert.Equal(t, 5, w.Due(start.Add(12*time.Hour)), "half of day 1")
+	assert.Equal(t, 20, w.Due(start.Add(36*time.Hour)), "day 1 and half of day 2")
+	assert.Equal(t, 65, w.Due(start.Add(30*24*time.Hour)), "never more than the total")
+
+	assert.Equal(t, []int{143, 286, 572}, domain.RampWarmupVolumes(1000, 3))
+	assert.Equal(t, []int{1000}, domain.RampWarmupVolumes(1000, 1))
+}
+
+func TestInvitationWarmupService(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	setup := func() (*invitationWarmupService, *fakeInvitationWarmupRepo, *eventService) {
+		events := newFakeEventRepo()
+		events.byID["event-1"] = &domain.Event{ID: "event-1", Name: "GopherCon", EventCode: "gc26", OwnerID: "owner-1"}
+		eventSvc := newTestEventService(events, newFakeSessionRepo(), nil, 5*time.Second)
+		warmups := newFakeInvitationWarmupRepo()
+		svc := NewInvitationWarmupService(eventSvc, events, warmups, nil, 5*time.Second).(*invitationWarmupService)
+		svc.now = func() time.Time { return start }
+		return svc, warmups, eventSvc
+	}
+	emails := func(n int) []string {
+		out := make([]string, n)
+		for i := range out {
+			out[i] = fmt.Sprintf("guest%d@example.com", i)
+		}
+		return out
+	}
+
+	t.Run("dry run previews the schedule", func(t *testing.T) {
+		svc, warmups, _ := setup()
+
+		got, err := svc.CreateWarmup(ctx, "event-1", "owner-1", append(emails(1000), "GUEST0@example.com"), 3, nil, true)
+		require.NoError(t, err)
+		assert.True(t, got.DryRun)
+		assert.Empty(t, got.ID)
+		assert.Equal(t, 1000, got.Total)
+		require.Len(t, got.Schedule, 3)
+		assert.Equal(t, 143, got.Schedule[0].Volume)
+		assert.Equal(t, 571, got.Schedule[2].Volume)
+		assert.Empty(t, warmups.warmups)
+	})
+
+	t.Run("validates the plan", func(t *testing.T) {
+		svc, _, _ := setup()
+
+		_, err := svc.CreateWarmup(ctx, "event-1", "owner-1", emails(10), 2, []int{5}, false)
+		require.ErrorIs(t, err, domain.ErrInvalidInput)
+		_, err = svc.CreateWarmup(ctx, "event-1", "owner-1", emails(10), 0, []int{0}, false)
+		require.ErrorIs(t, err, domain.ErrInvalidInput)
+		_, err = svc.CreateWarmup(ctx, "event-1", "owner-1", emails(10), domain.MaxWarmupDays+1, nil, false)
+		require.ErrorIs(t, err, domain.ErrInvalidInput)
+		_, err = svc.CreateWarmup(ctx, "event-1", "owner-1", []string{" "}, 0, nil, false)
+		require.ErrorIs(t, err, domain.ErrInvalidInput)
+		_, err = svc.CreateWarmup(ctx, "event-1", "someone-else", emails(10), 0, nil, false)
+		require.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("sends what is due and completes", func(t *testing.T) {
+		svc, warmups, eventSvc := setup()
+		eventSvc.invitationRepo.(*fakeEventInvitationRepo).domainRules = map[string]*domain.InvitationDomainRules{
+			"event-1": {EventID: "event-1", AllowedDomains: []string{}, BlockedDomains: []string{"blocked.example"}},
+		}
+		queue := append(emails(29), "spam@blocked.example")
+
+		w, err := svc.CreateWarmup(ctx, "event-1", "owner-1", queue, 0, []int{10, 20}, false)
+		require.NoError(t, err)
+
+		svc.now = func() time.Time { return start.Add(12 * time.Hour) }
+		run, err := svc.SendDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, &domain.WarmupRun{Warmups: 1, Sent: 5}, run)
+
+		svc.now = func() time.Time { return start.Add(36 * time.Hour) }
+		run, err = svc.SendDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, &domain.WarmupRun{Warmups: 1, Sent: 15}, run)
+
+		svc.now = func() time.Time { return start.Add(48 * time.Hour) }
+		run, err = svc.SendDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, &domain.WarmupRun{Warmups: 1, Sent: 9, Skipped: 1, Completed: 1}, run)
+
+		got, err := svc.GetWarmup(ctx, "event-1", w.ID, "owner-1")
+		require.NoError(t, err)
+		assert.Equal(t, domain.WarmupStatusCompleted, got.Status)
+		assert.Equal(t, 29, got.Sent)
+		assert.Equal(t, 1, got.Skipped)
+		assert.Len(t, eventSvc.emailService.(*fakeEmailService).sentInvitations, 29)
+		assert.Equal(t, "guest0@example.com", eventSvc.emailService.(*fakeEmailService).sentInvitations[0].Email)
+		assert.NotNil(t, warmups.warmups[w.ID].CompletedAt)
+	})
+
+	t.Run("pause holds sending and resume shifts the schedule", func(t *testing.T) {
+		svc, _, eventSvc := setup()
+		w, err := svc.CreateWarmup(ctx, "event-1", "owner-1", emails(40), 0, []int{20}, false)
+		require.NoError(t, err)
+
+		svc.now = func() time.Time { return start.Add(6 * time.Hour) }
+		paused, err := svc.PauseWarmup(ctx, "event-1", w.ID, "owner-1")
+		require.NoError(t, err)
+		assert.Equal(t, domain.WarmupStatusPaused, paused.Status)
+
+		svc.now = func() time.Time { return start.Add(30 * time.Hour) }
+		run, err := svc.SendDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, run.Warmups)
+		assert.Empty(t, eventSvc.emailService.(*fakeEmailService).sentInvitations)
+
+		resumed, err := svc.ResumeWarmup(ctx, "event-1", w.ID, "owner-1")
+		require.NoError(t, err)
+		assert.Equal(t, domain.WarmupStatusActive, resumed.Status)
+		assert.Nil(t, resumed.PausedAt)
+		assert.Equal(t, start.Add(24*time.Hour), resumed.StartsAt)
+		assert.Equal(t, start.Add(24*time.Hour), resumed.Schedule[0].StartsAt)
+
+		run, err = svc.SendDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 5, run.Sent, "six hours of day 1 had passed before the pause")
+	})
+
+	t.Run("completed warm-ups cannot be paused", func(t *testing.T) {
+		svc, _, _ := setup()
+		w, err := svc.CreateWarmup(ctx, "event-1", "owner-1", emails(2), 0, []int{10}, false)
+		require.NoError(t, err)
+		svc.now = func() time.Time { return start.Add(24 * time.Hour) }
+		_, err = svc.SendDue(ctx)
+		require.NoError(t, err)
+
+		_, err = svc.PauseWarmup(ctx, "event-1", w.ID, "owner-1")
+		require.ErrorIs(t, err, domain.ErrWarmupCompleted)
+		_, err = svc.GetWarmup(ctx, "other-event", w.ID, "owner-1")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("owners check the sending domain", func(t *testing.T) {
+		svc, _, _ := setup()
+		_, err := svc.CheckEmailDomain(ctx, "event-1", "owner-1", false)
+		require.ErrorIs(t, err, domain.ErrEmailDomainNotChecked)
+
+		checker := &fakeEmailDomainChecker{report: &domain.EmailDomainReport{Domain: "example.com", OK: true}}
+		svc.emailDomain = checker
+		_, err = svc.CheckEmailDomain(ctx, "event-1", "someone-else", true)
+		require.ErrorIs(t, err, domain.ErrForbidden)
+		report, err := svc.CheckEmailDomain(ctx, "event-1", "owner-1", true)
+		require.NoError(t, err)
+		assert.Equal(t, "example.com", report.Domain)
+		assert.True(t, checker.refreshed)
+
+		checker.report.CheckedAt = svc.now().Add(-30 * time.Second)
+		checker.refreshed = false
+		_, err = svc.CheckEmailDomain(ctx, "event-1", "owner-1", true)
+		require.NoError(t, err)
+		assert.False(t, checker.refreshed, "a recent report is not looked up again")
+	})
+}
+
+type fakeEmailDomainChecker struct {
+	report    *domain.EmailDomainReport
+	refreshed bool
+}
+
+func (f *fakeEmailDomainChecker) CheckEmailDomain(ctx context.Context, refresh bool) (*domain.EmailDomainReport, error) {
+	f.refreshed = refresh
+	return f.report, nil
+}
@@ -0,0 +1,322 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+// ipAllowlistCacheTTL is how long a looked-up allowlist is trusted. Every authenticated request is
+// checked, so lookups are cached; a change made on another instance takes effect within this time.
+const ipAllowlistCacheTTL = 30 * time.Second
+
+// maxCachedAllowlists bounds the lookup cache; when full, expired entries are dropped first.
+const maxCachedAllowlists = 10000
+
+type ipAllowlistService struct {
+	allowlistRepo  domain.IPAllowlistRepository
+	userRepo       domain.UserRepository
+	clientRepo     domain.MachineClientRepository
+	roleRepo       domain.RoleRepository
+	contextTimeout time.Duration
+
+	mu    sync.Mutex
+	cache map[string]cachedAllowlist
+}
+
+type cachedAllowlist struct {
+	ranges  []netip.Prefix
+	expires time.Time
+}
+
+// NewIPAllowlistService returns a domain.IPAllowlistService.
+func NewIPAllowlistService(allowlistRepo domain.IPAllowlistRepository, userRepo domain.UserRepository, clientRepo domain.MachineClientRepository, roleRepo domain.RoleRepository, timeout time.Duration) domain.IPAllowlistService {
+	return &ipAllowlistService{
+		allowlistRepo:  allowlistRepo,
+		userRepo:       userRepo,
+		clientRepo:     clientRepo,
+		roleRepo:       roleRepo,
+		contextTimeout: timeout,
+		cache:          make(map[string]cachedAllowlist),
+	}
+}
+
+func (s *ipAllowlistService) CheckAddress(ctx context.Context, userID, ip string) (*domain.IPAllowlist, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	ranges, err := s.lookup(ctx, userID, func(ctx context.Context) ([]string, error) {
+		return s.allowlistRepo.Get(ctx, userID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	list := &domain.IPAllowlist{UserID: userID, Ranges: formatRanges(ranges)}
+	if len(ranges) == 0 || inRanges(ranges, ip) {
+		return list, nil
+	}
+	return list, domain.ErrIPNotAllowed
+}
+
+func (s *ipAllowlistService) CheckMachineAddress(ctx context.Context, clientID, ip string) (*domain.IPAllowlist, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	// The client is read with its ranges so a revoked client's tokens stop working within
+	// ipAllowlistCacheTTL rather than when they expire.
+	ranges, err := s.lookup(ctx, machineCacheKey(clientID), func(ctx context.Context) ([]string, error) {
+		client, _, err := s.clientRepo.GetByID(ctx, clientID)
+		if errors.Is(err, domain.ErrNotFound) || err == nil && client.RevokedAt != nil {
+			return nil, domain.ErrInvalidClient
+		}
+		if err != nil {
+			return nil, fmt.Errorf("get machine client: %w", err)
+		}
+		return s.allowlistRepo.GetMachineClient(ctx, clientID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	list := &domain.IPAllowlist{ClientID: clientID, Ranges: formatRanges(ranges)}
+	if len(ranges) == 0 || inRanges(ranges, ip) {
+		return list, nil
+	}
+	return list, domain.ErrIPNotAllowed
+}
+
+func (s *ipAllowlistService) GetIPAllowlist(ctx context.Context, userID string) (*domain.IPAllowlist, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	ranges, err := s.allowlistRepo.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get ip allowlist: %w", err)
+	}
+	return &domain.IPAllowlist{UserID: userID, Ranges: ranges}, nil
+}
+
+func (s *ipAllowlistService) SetIPAllowlist(ctx context.Context, userID, clientIP string, ranges []string) (*domain.IPAllowlist, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	prefixes, err := parseRanges(ranges)
+	if err != nil {
+		return nil, err
+	}
+	if len(prefixes) > 0 && !inRanges(prefixes, clientIP) {
+		return nil, fmt.Errorf("ranges must include your current address %s: %w", clientIP, domain.ErrInvalidInput)
+	}
+	return s.replace(ctx, userID, prefixes)
+}
+
+func (s *ipAllowlistService) GetUserIPAllowlist(ctx context.Context, adminID, userID string) (*domain.IPAllowlist, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := s.requireUser(ctx, adminID, userID); err != nil {
+		return nil, err
+	}
+	ranges, err := s.allowlistRepo.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get ip allowlist: %w", err)
+	}
+	return &domain.IPAllowlist{UserID: userID, Ranges: ranges}, nil
+}
+
+func (s *ipAllowlistService) SetUserIPAllowlist(ctx context.Context, adminID, userID string, ranges []string) (*domain.IPAllowlist, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	prefixes, err := parseRanges(ranges)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireUser(ctx, adminID, userID); err != nil {
+		return nil, err
+	}
+	return s.replace(ctx, userID, prefixes)
+}
+
+func (s *ipAllowlistService) GetMachineClientIPAllowlist(ctx context.Context, adminID, clientID string) (*domain.IPAllowlist, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := s.requireClient(ctx, adminID, clientID); err != nil {
+		return nil, err
+	}
+	ranges, err := s.allowlistRepo.GetMachineClient(ctx, clientID)
+	if err != nil {
+		return nil, fmt.Errorf("get machine client ip allowlist: %w", err)
+	}
+	return &domain.IPAllowlist{ClientID: clientID, Ranges: ranges}, nil
+}
+
+func (s *ipAllowlistService) SetMachineClientIPAllowlist(ctx context.Context, adminID, clientID string, ranges []string) (*domain.IPAllowlist, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	prefixes, err := parseRanges(ranges)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireClient(ctx, adminID, clientID); err != nil {
+		return nil, err
+	}
+	formatted := formatRanges(prefixes)
+	if err := s.allowlistRepo.ReplaceMachineClient(ctx, clientID, formatted); err != nil {
+		return nil, fmt.Errorf("replace machine client ip allowlist: %w", err)
+	}
+	s.forget(machineCacheKey(clientID))
+	return &domain.IPAllowlist{ClientID: clientID, Ranges: formatted}, nil
+}
+
+// requireClient checks that adminID is an admin and that the machine client exists.
+func (s *ipAllowlistService) requireClient(ctx context.Context, adminID, clientID string) error {
+	if err := requireAdmin(ctx, s.roleRepo, adminID); err != nil {
+		return err
+	}
+	if _, _, err := s.clientRepo.GetByID(ctx, clientID); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("get machine client: %w", err)
+	}
+	return nil
+}
+
+// requireUser checks that adminID is an admin and that userID exists.
+func (s *ipAllowlistService) requireUser(ctx context.Context, adminID, userID string) error {
+	if err := requireAdmin(ctx, s.roleRepo, adminID); err != nil {
+		return err
+	}
+	if _, err := s.userRepo.GetByID(ctx, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrUserNotFound
+		}
+		return fmt.Errorf("get user: %w", err)
+	}
+	return nil
+}
+
+func (s *ipAllowlistService) replace(ctx context.Context, userID string, prefixes []netip.Prefix) (*domain.IPAllowlist, error) {
+	ranges := formatRanges(prefixes)
+	if err := s.allowlistRepo.Replace(ctx, userID, ranges); err != nil {
+		return nil, fmt.Errorf("replace ip allowlist: %w", err)
+	}
+	s.forget(userID)
+	return &domain.IPAllowlist{UserID: userID, Ranges: ranges}, nil
+}
+
+// machineCacheKey is the cache key of a machine client's ranges, kept apart from user IDs.
+func machineCacheKey(clientID string) string {
+	return "machine:" + clientID
+}
+
+// forget drops the cached ranges under key, so the next check reads the new list.
+func (s *ipAllowlistService) forget(key string) {
+	s.mu.Lock()
+	delete(s.cache, key)
+	s.mu.Unlock()
+}
+
+// lookup returns the ranges cached under key when a recent lookup is there, and otherwise reads
+// them with get.
+func (s *ipAllowlistService) lookup(ctx context.Context, key string, get func(ctx context.Context) ([]string, error)) ([]netip.Prefix, error) {
+	now := time.Now()
+	s.mu.Lock()
+	entry, ok := s.cache[key]
+	s.mu.Unlock()
+	if ok && now.Before(entry.expires) {
+		return entry.ranges, nil
+	}
+
+	stored, err := get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get ip allowlist: %w", err)
+	}
+	var ranges []netip.Prefix
+	for _, r := range stored {
+		p, err := netip.ParsePrefix(r)
+		if err != nil {
+			return nil, fmt.Errorf("parse stored ip range %q: %w", r, err)
+		}
+		ranges = append(ranges, p)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.cache) >= maxCachedAllowlists {
+		for id, e := range s.cache {
+			if !now.Before(e.expires) {
+				delete(s.cache, id)
+			}
+		}
+		if len(s.cache) >= maxCachedAllowlists {
+			clear(s.cache)
+		}
+	}
+	s.cache[key] = cachedAllowlist{ranges: ranges, expires: now.Add(ipAllowlistCacheTTL)}
+	return ranges, nil
+}
+
+// parseRanges parses CIDR ranges and single addresses, dropping duplicates. Host bits are cleared,
+// so 10.1.2.3/8 becomes 10.0.0.0/8.
+func parseRanges(ranges []string) ([]netip.Prefix, error) {
+	if len(ranges) > domain.MaxIPAllowlistRanges {
+		return nil, fmt.Errorf("at most %d ranges are allowed: %w", domain.MaxIPAllowlistRanges, domain.ErrInvalidInput)
+	}
+	var out []netip.Prefix
+	for _, r := range ranges {
+		r = strings.TrimSpace(r)
+		var p netip.Prefix
+		if strings.Contains(r, "/") {
+			parsed, err := netip.ParsePrefix(r)
+			if err != nil {
+				return nil, fmt.Errorf("%q is not a CIDR range: %w", r, domain.ErrInvalidInput)
+			}
+			p = parsed.Masked()
+		} else {
+			addr, err := netip.ParseAddr(r)
+			if err != nil {
+				return nil, fmt.Errorf("%q is not an IP address: %w", r, domain.ErrInvalidInput)
+			}
+			addr = addr.Unmap().WithZone("")
+			p = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		if !slices.Contains(out, p) {
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+
+func formatRanges(prefixes []netip.Prefix) []string {
+	out := make([]string, 0, len(prefixes))
+	for _, p := range prefixes {
+		out = append(out, p.String())
+	}
+	return out
+}
+
+// inRanges reports whether ip is in any of the ranges. An address that does not parse is in none.
+func inRanges(ranges []netip.Prefix, ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, p := range ranges {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
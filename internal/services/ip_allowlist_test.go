@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAllowlistRepo is an in-memory IPAllowlistRepository that counts lookups.
+type fakeAllowlistRepo struct {
+	byUser   map[string][]string
+	byClient map[string][]string
+	gets     int
+}
+
+func (f *fakeAllowlistRepo) Get(ctx context.Context, userID string) ([]string, error) {
+	f.gets++
+	return append([]string{}, f.byUser[userID]...), nil
+}
+
+func (f *fakeAllowlistRepo) Replace(ctx context.Context, userID string, ranges []string) error {
+	f.byUser[userID] = ranges
+	return nil
+}
+
+func (f *fakeAllowlistRepo) GetMachineClient(ctx context.Context, clientID string) ([]string, error) {
+	f.gets++
+	return append([]string{}, f.byClient[clientID]...), nil
+}
+
+func (f *fakeAllowlistRepo) ReplaceMachineClient(ctx context.Context, clientID string, ranges []string) error {
+	f.byClient[clientID] = ranges
+	return nil
+}
+
+func TestIPAllowlistService(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func() (domain.IPAllowlistService, *fakeAllowlistRepo) {
+		repo := &fakeAllowlistRepo{byUser: make(map[string][]string), byClient: make(map[string][]string)}
+		users := newFakeUserRepo()
+		users.byID["user-1"] = &domain.User{ID: "user-1"}
+		clients := &fakeMachineClientRepo{byID: map[string]*domain.MachineClient{"client-1": {ID: "client-1"}}, hashes: map[string]string{}}
+		roles := newFakeRoleRepo()
+		roles.listByUID["admin-1"] = []*domain.Role{{ID: "r-2", Code: domain.AdminRole}}
+		return NewIPAllowlistService(repo, users, clients, roles, 5*time.Second), repo
+	}
+
+	t.Run("normalizes ranges and keeps the caller in", func(t *testing.T) {
+		svc, repo := setup()
+		list, err := svc.SetIPAllowlist(ctx, "user-1", "10.1.2.3", []string{"10.1.2.3/8", " 203.0.113.7 ", "2001:db8::/32", "10.0.0.0/8"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"10.0.0.0/8", "203.0.113.7/32", "2001:db8::/32"}, list.Ranges)
+		assert.Equal(t, list.Ranges, repo.byUser["user-1"])
+
+		_, err = svc.SetIPAllowlist(ctx, "user-1", "198.51.100.2", []string{"10.0.0.0/8"})
+		require.ErrorIs(t, err, domain.ErrInvalidInput, "would lock the caller out")
+		_, err = svc.SetIPAllowlist(ctx, "user-1", "10.1.2.3", []string{"10.0.0.0/33"})
+		require.ErrorIs(t, err, domain.ErrInvalidInput)
+		_, err = svc.SetIPAllowlist(ctx, "user-1", "10.1.2.3", []string{"office"})
+		require.ErrorIs(t, err, domain.ErrInvalidInput)
+
+		list, err = svc.SetIPAllowlist(ctx, "user-1", "198.51.100.2", nil)
+		require.NoError(t, err, "clearing is always allowed")
+		assert.Empty(t, list.Ranges)
+	})
+
+	t.Run("checks addresses", func(t *testing.T) {
+		svc, repo := setup()
+		_, err := svc.CheckAddress(ctx, "user-1", "198.51.100.2")
+		require.NoError(t, err, "no allowlist allows any address")
+
+		_, err = svc.SetIPAllowlist(ctx, "user-1", "10.1.2.3", []string{"10.0.0.0/8"})
+		require.NoError(t, err)
+		_, err = svc.CheckAddress(ctx, "user-1", "10.200.0.1")
+		require.NoError(t, err)
+		_, err = svc.CheckAddress(ctx, "user-1", "::ffff:10.200.0.1")
+		require.NoError(t, err, "IPv4-mapped addresses match IPv4 ranges")
+		list, err := svc.CheckAddress(ctx, "user-1", "198.51.100.2")
+		require.ErrorIs(t, err, domain.ErrIPNotAllowed)
+		assert.Equal(t, []string{"10.0.0.0/8"}, list.Ranges)
+		_, err = svc.CheckAddress(ctx, "user-1", "")
+		require.ErrorIs(t, err, domain.ErrIPNotAllowed)
+		assert.Equal(t, 2, repo.gets, "lookups are cached until the list changes")
+	})
+
+	t.Run("admins manage other accounts", func(t *testing.T) {
+		svc, repo := setup()
+		_, err := svc.SetUserIPAllowlist(ctx, "user-1", "user-1", nil)
+		require.ErrorIs(t, err, domain.ErrForbidden)
+		_, err = svc.GetUserIPAllowlist(ctx, "admin-1", "user-missing")
+		require.ErrorIs(t, err, domain.ErrUserNotFound)
+
+		list, err := svc.SetUserIPAllowlist(ctx, "admin-1", "user-1", []string{"192.0.2.0/24"})
+		require.NoError(t, err, "the admin's own address does not matter")
+		assert.Equal(t, []string{"192.0.2.0/24"}, repo.byUser["user-1"])
+		got, err := svc.GetUserIPAllowlist(ctx, "admin-1", "user-1")
+		require.NoError(t, err)
+		assert.Equal(t, list, got)
+	})
+	t.Run("admins manage machine clients", func(t *testing.T) {
+		svc, repo := setup()
+		_, err := svc.SetMachineClientIPAllowlist(ctx, "user-1", "client-1", nil)
+		require.ErrorIs(t, err, domain.ErrForbidden)
+		_, err = svc.GetMachineClientIPAllowlist(ctx, "admin-1", "client-missing")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+
+		_, err = svc.CheckMachineAddress(ctx, "client-1", "198.51.100.2")
+		require.NoError(t, err, "no allowlist allows any address")
+		list, err := svc.SetMachineClientIPAllowlist(ctx, "admin-1", "client-1", []string{"192.0.2.10"})
+		require.NoError(t, err)
+		assert.Equal(t, &domain.IPAllowlist{ClientID: "client-1", Ranges: []string{"192.0.2.10/32"}}, list)
+		assert.Empty(t, repo.byUser, "client lists are kept apart from accounts")
+
+		_, err = svc.CheckMachineAddress(ctx, "client-1", "192.0.2.10")
+		require.NoError(t, err, "a change applies at once on the server that made it")
+		list, err = svc.CheckMachineAddress(ctx, "client-1", "198.51.100.2")
+		require.ErrorIs(t, err, domain.ErrIPNotAllowed)
+		assert.Equal(t, []string{"192.0.2.10/32"}, list.Ranges)
+		_, err = svc.CheckAddress(ctx, "client-1", "198.51.100.2")
+		require.NoError(t, err, "a user ID never matches a client's list")
+	})
+
+	t.Run("refuses revoked machine clients", func(t *testing.T) {
+		repo := &fakeAllowlistRepo{byUser: make(map[string][]string), byClient: make(map[string][]string)}
+		revokedAt := time.Now()
+		clients := &fakeMachineClientRepo{byID: map[string]*domain.MachineClient{
+			"client-1": {ID: "client-1"},
+			"client-2": {ID: "client-2", RevokedAt: &revokedAt},
+		}, hashes: map[string]string{}}
+		svc := NewIPAllowlistService(repo, newFakeUserRepo(), clients, newFakeRoleRepo(), 5*time.Second)
+
+		_, err := svc.CheckMachineAddress(ctx, "client-2", "198.51.100.2")
+		require.ErrorIs(t, err, domain.ErrInvalidClient)
+		_, err = svc.CheckMachineAddress(ctx, "client-missing", "198.51.100.2")
+		require.ErrorIs(t, err, domain.ErrInvalidClient)
+		_, err = svc.CheckMachineAddress(ctx, "client-1", "198.51.100.2")
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,22 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"multitrackticketing/internal/domain"
+)
+
+// RunExclusive runs job while holding the named lock, so that only one replica runs it at a time.
+// ran is false, with no error, when another replica holds the lock.
+func RunExclusive(ctx context.Context, locker domain.JobLocker, name string, job func(ctx context.Context) error) (ran bool, err error) {
+	release, acquired, err := locker.TryLock(ctx, name)
+	if err != nil {
+		return false, fmt.Errorf("take %s lock: %w", name, err)
+	}
+	if !acquired {
+		return false, nil
+	}
+	defer release()
+	return true, job(ctx)
+}
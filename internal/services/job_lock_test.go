@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeJobLocker hands out each lock to one holder at a time.
+type fakeJobLocker struct {
+	held map[string]bool
+	err  error
+}
+
+func (l *fakeJobLocker) TryLock(ctx context.Context, name string) (func(), bool, error) {
+	if l.err != nil {
+		return nil, false, l.err
+	}
+	if l.held[name] {
+		return nil, false, nil
+	}
+	l.held[name] = true
+	return func() { delete(l.held, name) }, true, nil
+}
+
+func TestRunExclusive(t *testing.T) {
+	ctx := context.Background()
+	locker := &fakeJobLocker{held: map[string]bool{}}
+
+	var nestedRan bool
+	ran, err := RunExclusive(ctx, locker, "integrity-check", func(ctx context.Context) error {
+		// A second replica asking for the same lock while the job runs is turned away.
+		var err error
+		nestedRan, err = RunExclusive(ctx, locker, "integrity-check", func(context.Context) error {
+			t.Fatal("job ran twice")
+			return nil
+		})
+		return err
+	})
+	require.NoError(t, err)
+	assert.True(t, ran)
+	assert.False(t, nestedRan)
+	assert.False(t, locker.held["integrity-check"], "lock released")
+
+	jobErr := errors.New("sweep failed")
+	ran, err = RunExclusive(ctx, locker, "integrity-check", func(context.Context) error { return jobErr })
+	require.ErrorIs(t, err, jobErr)
+	assert.True(t, ran)
+	assert.False(t, locker.held["integrity-check"], "lock released after a failed job")
+
+	locker.err = errors.New("db down")
+	ran, err = RunExclusive(ctx, locker, "integrity-check", func(context.Context) error { return nil })
+	require.Error(t, err)
+	assert.False(t, ran)
+}
@@ -0,0 +1,213 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+// machineSecretBytes is the length of a machine client secret before encoding.
+const machineSecretBytes = 32
+
+type machineClientService struct {
+	clientRepo     domain.MachineClientRepository
+	eventRepo      domain.EventRepository
+	roleRepo       domain.RoleRepository
+	tokenIssuer    domain.MachineTokenIssuer
+	codeFormat     domain.EventCodeFormat
+	contextTimeout time.Duration
+}
+
+// NewMachineClientService returns a domain.MachineClientService. Sandbox events get codes in
+// codeFormat, like every other event.
+func NewMachineClientService(clientRepo domain.MachineClientRepository, eventRepo domain.EventRepository, roleRepo domain.RoleRepository, tokenIssuer domain.MachineTokenIssuer, codeFormat domain.EventCodeFormat, timeout time.Duration) domain.MachineClientService {
+	return &machineClientService{
+		clientRepo:     clientRepo,
+		eventRepo:      eventRepo,
+		roleRepo:       roleRepo,
+		tokenIssuer:    tokenIssuer,
+		codeFormat:     codeFormat,
+		contextTimeout: timeout,
+	}
+}
+
+func (s *machineClientService) CreateMachineClient(ctx context.Context, adminID, name, eventID string, scopes []string) (*domain.MachineClientCredentials, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > domain.MaxMachineClientNameLength {
+		return nil, fmt.Errorf("name must be 1 to %d characters: %w", domain.MaxMachineClientNameLength, domain.ErrInvalidInput)
+	}
+	scopes, err := normalizeScopes(scopes)
+	if err != nil {
+		return nil, err
+	}
+	sandbox := slices.Contains(scopes, domain.ScopeSandboxWrite)
+	if sandbox && len(scopes) > 1 {
+		return nil, fmt.Errorf("scope %s cannot be combined with other scopes: %w", domain.ScopeSandboxWrite, domain.ErrInvalidInput)
+	}
+	if sandbox && eventID != "" {
+		return nil, fmt.Errorf("sandbox clients are not bound to an event: %w", domain.ErrInvalidInput)
+	}
+	if !sandbox && eventID == "" {
+		return nil, fmt.Errorf("event_id is required: %w", domain.ErrInvalidInput)
+	}
+	if err := requireAdmin(ctx, s.roleRepo, adminID); err != nil {
+		return nil, err
+	}
+	if !sandbox {
+		if _, err := s.eventRepo.GetByID(ctx, eventID); err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return nil, domain.ErrNotFound
+			}
+			return nil, fmt.Errorf("get event: %w", err)
+		}
+	}
+
+	secret, err := generateClientSecret()
+	if err != nil {
+		return nil, fmt.Errorf("generate client secret: %w", err)
+	}
+	client := &domain.MachineClient{Name: name, EventID: eventID, Scopes: scopes, CreatedBy: adminID}
+	if err := s.clientRepo.Create(ctx, client, hashClientSecret(secret)); err != nil {
+		return nil, fmt.Errorf("create machine client: %w", err)
+	}
+	return &domain.MachineClientCredentials{MachineClient: *client, ClientSecret: secret}, nil
+}
+
+func (s *machineClientService) ListMachineClients(ctx context.Context, adminID string) ([]*domain.MachineClient, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := requireAdmin(ctx, s.roleRepo, adminID); err != nil {
+		return nil, err
+	}
+	clients, err := s.clientRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list machine clients: %w", err)
+	}
+	return clients, nil
+}
+
+func (s *machineClientService) RevokeMachineClient(ctx context.Context, adminID, clientID string) (*domain.MachineClient, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := requireAdmin(ctx, s.roleRepo, adminID); err != nil {
+		return nil, err
+	}
+	client, err := s.clientRepo.Revoke(ctx, clientID, time.Now())
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("revoke machine client: %w", err)
+	}
+	return client, nil
+}
+
+func (s *machineClientService) IssueMachineToken(ctx context.Context, clientID, secret string) (*domain.MachineToken, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	client, secretHash, err := s.clientRepo.GetByID(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrInvalidClient
+		}
+		return nil, fmt.Errorf("get machine client: %w", err)
+	}
+	if subtle.ConstantTimeCompare([]byte(hashClientSecret(secret)), []byte(secretHash)) != 1 || client.RevokedAt != nil {
+		return nil, domain.ErrInvalidClient
+	}
+
+	principal := &domain.MachinePrincipal{ClientID: client.ID, EventID: client.EventID, Scopes: client.Scopes}
+	token, err := s.tokenIssuer.IssueMachine(principal, domain.MachineTokenExpiry)
+	if err != nil {
+		return nil, fmt.Errorf("sign machine token: %w", err)
+	}
+	if err := s.clientRepo.TouchLastUsed(ctx, client.ID, time.Now()); err != nil {
+		return nil, fmt.Errorf("touch machine client: %w", err)
+	}
+	return &domain.MachineToken{
+		AccessToken: token,
+		TokenType:   "Bearer",
+		ExpiresIn:   int(domain.MachineTokenExpiry / time.Second),
+		Scope:       strings.Join(client.Scopes, " "),
+	}, nil
+}
+
+func (s *machineClientService) CreateSandboxEvent(ctx context.Context, clientID, name string) (*domain.Event, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > domain.MaxEventNameLength {
+		return nil, fmt.Errorf("name must be 1 to %d characters: %w", domain.MaxEventNameLength, domain.ErrInvalidInput)
+	}
+	// The token outlives a revocation by up to MachineTokenExpiry; checking the client stops a
+	// revoked client from creating events at once.
+	client, _, err := s.clientRepo.GetByID(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrInvalidClient
+		}
+		return nil, fmt.Errorf("get machine client: %w", err)
+	}
+	if client.RevokedAt != nil || client.CreatedBy == "" {
+		return nil, domain.ErrInvalidClient
+	}
+
+	now := time.Now()
+	event := domain.NewEvent(name, "", client.CreatedBy, now, now)
+	event.Sandbox = true
+	if err := createWithEventCode(ctx, s.eventRepo, s.codeFormat, event); err != nil {
+		return nil, fmt.Errorf("create sandbox event: %w", err)
+	}
+	return event, nil
+}
+
+// normalizeScopes trims and de-duplicates scopes, keeping their order. At least one is required
+// and each must be in domain.MachineScopes.
+func normalizeScopes(scopes []string) ([]string, error) {
+	var out []string
+	for _, sc := range scopes {
+		sc = strings.TrimSpace(sc)
+		if !slices.Contains(domain.MachineScopes, sc) {
+			return nil, fmt.Errorf("unknown scope %q; scopes are %s: %w", sc, strings.Join(domain.MachineScopes, ", "), domain.ErrInvalidInput)
+		}
+		if !slices.Contains(out, sc) {
+			out = append(out, sc)
+		}
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("at least one scope is required: %w", domain.ErrInvalidInput)
+	}
+	return out, nil
+}
+
+func generateClientSecret() (string, error) {
+	b := make([]byte, machineSecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashClientSecret returns the stored form of a client secret. Secrets are random, so a plain
+// SHA-256 is enough; there is nothing to guess.
+func hashClientSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,164 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeMachineClientRepo is an in-memory MachineClientRepository.
+type fakeMachineClientRepo struct {
+	byID   map[string]*domain.MachineClient
+	hashes map[string]string
+}
+
+func (f *fakeMachineClientRepo) Create(ctx context.Context, c *domain.MachineClient, secretHash string) error {
+	c.ID = fmt.Sprintf("client-%d", len(f.byID)+1)
+	c.CreatedAt = time.Now()
+	stored := *c
+	f.byID[c.ID] = &stored
+	f.hashes[c.ID] = secretHash
+	return nil
+}
+
+func (f *fakeMachineClientRepo) GetByID(ctx context.Context, id string) (*domain.MachineClient, string, error) {
+	c, ok := f.byID[id]
+	if !ok {
+		return nil, "", domain.ErrNotFound
+	}
+	out := *c
+	return &out, f.hashes[id], nil
+}
+
+func (f *fakeMachineClientRepo) List(ctx context.Context) ([]*domain.MachineClient, error) {
+	out := []*domain.MachineClient{}
+	for _, c := range f.byID {
+		out = append(out, c)
+	}
+	return out, nil
+}
+
+func (f *fakeMachineClientRepo) Revoke(ctx context.Context, id string, at time.Time) (*domain.MachineClient, error) {
+	c, ok := f.byID[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	if c.RevokedAt == nil {
+		c.RevokedAt = &at
+	}
+	return c, nil
+}
+
+func (f *fakeMachineClientRepo) TouchLastUsed(ctx context.Context, id string, at time.Time) error {
+	f.byID[id].LastUsedAt = &at
+	return nil
+}
+
+// fakeMachineIssuer returns the principal encoded as a string so tests can check what was signed.
+type fakeMachineIssuer struct{}
+
+func (fakeMachineIssuer) IssueMachine(p *domain.MachinePrincipal, expiry time.Duration) (string, error) {
+	return p.ClientID + "|" + p.EventID + "|" + strings.Join(p.Scopes, ","), nil
+}
+
+func TestMachineClientService(t *testing.T) {
+	ctx := context.Background()
+
+	var events *fakeEventRepo
+	setup := func() (domain.MachineClientService, *fakeMachineClientRepo) {
+		repo := &fakeMachineClientRepo{byID: make(map[string]*domain.MachineClient), hashes: make(map[string]string)}
+		events = newFakeEventRepo()
+		events.byID["event-1"] = &domain.Event{ID: "event-1"}
+		roles := newFakeRoleRepo()
+		roles.listByUID["admin-1"] = []*domain.Role{{ID: "r-2", Code: domain.AdminRole}}
+		return NewMachineClientService(repo, events, roles, fakeMachineIssuer{}, domain.DefaultEventCodeFormat, 5*time.Second), repo
+	}
+
+	t.Run("only admins manage clients", func(t *testing.T) {
+		svc, _ := setup()
+		_, err := svc.CreateMachineClient(ctx, "user-1", "Signage", "event-1", []string{domain.ScopeScheduleRead})
+		require.ErrorIs(t, err, domain.ErrForbidden)
+		_, err = svc.ListMachineClients(ctx, "user-1")
+		require.ErrorIs(t, err, domain.ErrForbidden)
+		_, err = svc.RevokeMachineClient(ctx, "user-1", "client-1")
+		require.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("validates the client", func(t *testing.T) {
+		svc, _ := setup()
+		_, err := svc.CreateMachineClient(ctx, "admin-1", " ", "event-1", []string{domain.ScopeScheduleRead})
+		require.ErrorIs(t, err, domain.ErrInvalidInput)
+		_, err = svc.CreateMachineClient(ctx, "admin-1", "Signage", "event-1", nil)
+		require.ErrorIs(t, err, domain.ErrInvalidInput)
+		_, err = svc.CreateMachineClient(ctx, "admin-1", "Signage", "event-1", []string{"events:delete"})
+		require.ErrorIs(t, err, domain.ErrInvalidInput)
+		_, err = svc.CreateMachineClient(ctx, "admin-1", "Signage", "event-missing", []string{domain.ScopeScheduleRead})
+		require.ErrorIs(t, err, domain.ErrNotFound)
+		_, err = svc.CreateMachineClient(ctx, "admin-1", "Signage", "", []string{domain.ScopeScheduleRead})
+		require.ErrorIs(t, err, domain.ErrInvalidInput, "only sandbox clients go without an event")
+		_, err = svc.CreateMachineClient(ctx, "admin-1", "CI", "event-1", []string{domain.ScopeSandboxWrite})
+		require.ErrorIs(t, err, domain.ErrInvalidInput, "sandbox clients are not bound to an event")
+		_, err = svc.CreateMachineClient(ctx, "admin-1", "CI", "", []string{domain.ScopeSandboxWrite, domain.ScopeScheduleRead})
+		require.ErrorIs(t, err, domain.ErrInvalidInput, "sandbox:write takes no other scope")
+	})
+
+	t.Run("sandbox clients create sandbox events", func(t *testing.T) {
+		svc, _ := setup()
+		creds, err := svc.CreateMachineClient(ctx, "admin-1", "CI", "", []string{domain.ScopeSandboxWrite})
+		require.NoError(t, err)
+		assert.Empty(t, creds.EventID)
+
+		event, err := svc.CreateSandboxEvent(ctx, creds.ID, " Test conf ")
+		require.NoError(t, err)
+		assert.Equal(t, "Test conf", event.Name)
+		assert.Equal(t, "admin-1", event.OwnerID, "owned by the client's creator")
+		assert.True(t, event.Sandbox)
+		assert.Len(t, event.EventCode, domain.DefaultEventCodeFormat.Length)
+		assert.Same(t, event, events.byID[event.ID])
+
+		_, err = svc.CreateSandboxEvent(ctx, creds.ID, " ")
+		require.ErrorIs(t, err, domain.ErrInvalidInput)
+		_, err = svc.RevokeMachineClient(ctx, "admin-1", creds.ID)
+		require.NoError(t, err)
+		_, err = svc.CreateSandboxEvent(ctx, creds.ID, "Test conf")
+		require.ErrorIs(t, err, domain.ErrInvalidClient, "revoked clients create no events")
+	})
+
+	t.Run("exchanges credentials for a token", func(t *testing.T) {
+		svc, repo := setup()
+		creds, err := svc.CreateMachineClient(ctx, "admin-1", " Signage ", "event-1", []string{domain.ScopeScheduleRead, domain.ScopeScheduleRead})
+		require.NoError(t, err)
+		assert.Equal(t, "Signage", creds.Name)
+		assert.Equal(t, []string{domain.ScopeScheduleRead}, creds.Scopes)
+		require.NotEmpty(t, creds.ClientSecret)
+		assert.NotContains(t, repo.hashes[creds.ID], creds.ClientSecret, "only a hash is stored")
+
+		_, err = svc.IssueMachineToken(ctx, creds.ID, "wrong")
+		require.ErrorIs(t, err, domain.ErrInvalidClient)
+		_, err = svc.IssueMachineToken(ctx, "client-missing", creds.ClientSecret)
+		require.ErrorIs(t, err, domain.ErrInvalidClient)
+
+		token, err := svc.IssueMachineToken(ctx, creds.ID, creds.ClientSecret)
+		require.NoError(t, err)
+		assert.Equal(t, creds.ID+"|event-1|"+domain.ScopeScheduleRead, token.AccessToken)
+		assert.Equal(t, "Bearer", token.TokenType)
+		assert.Equal(t, int(domain.MachineTokenExpiry/time.Second), token.ExpiresIn)
+		assert.Equal(t, domain.ScopeScheduleRead, token.Scope)
+		assert.NotNil(t, repo.byID[creds.ID].LastUsedAt)
+
+		revoked, err := svc.RevokeMachineClient(ctx, "admin-1", creds.ID)
+		require.NoError(t, err)
+		assert.NotNil(t, revoked.RevokedAt)
+		_, err = svc.IssueMachineToken(ctx, creds.ID, creds.ClientSecret)
+		require.ErrorIs(t, err, domain.ErrInvalidClient, "revoked clients get no new tokens")
+		_, err = svc.RevokeMachineClient(ctx, "admin-1", "client-missing")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+const (
+	// maxConcurrentThumbnails bounds how many speaker photos one bundle downloads at once.
+	maxConcurrentThumbnails = 4
+	// maxCachedBundles bounds how many events keep their last bundle in memory; the oldest bundle
+	// is evicted first.
+	maxCachedBundles = 16
+)
+
+// offlineBundlePhotoPath is where a speaker's thumbnail is stored in the archive.
+func offlineBundlePhotoPath(speakerID string) string {
+	return "photos/" + speakerID + ".jpg"
+}
+
+// offlineBundleVersion hashes the schedule and the photo URLs by speaker.
+func offlineBundleVersion(schedule *domain.OfflineSchedule, photoURLs map[string]string) (string, error) {
+	data, err := json.Marshal(schedule)
+	if err != nil {
+		return "", fmt.Errorf("encode offline schedule: %w", err)
+	}
+	h := sha256.New()
+	h.Write(data)
+	ids := make([]string, 0, len(photoURLs))
+	for id := range photoURLs {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	for _, id := range ids {
+		fmt.Fprintf(h, "\n%s %s", id, photoURLs[id])
+	}
+	return hex.EncodeToString(h.Sum(nil)[:16]), nil
+}
+
+// buildOfflineBundle writes the zip archive: manifest.json, schedule.json and photos/<speakerID>.jpg.
+func buildOfflineBundle(eventCode, version string, schedule *domain.OfflineSchedule, photos map[string][]byte) (*domain.OfflineBundle, error) {
+	scheduleJSON, err := json.Marshal(schedule)
+	if err != nil {
+		return nil, fmt.Errorf("encode offline schedule: %w", err)
+	}
+	type entry struct {
+		path string
+		data []byte
+		// JPEGs are already compressed, so they are stored as is.
+		method uint16
+	}
+	entries := []entry{{path: "schedule.json", data: scheduleJSON, method: zip.Deflate}}
+	for _, sp := range schedule.Speakers {
+		if thumb, ok := photos[sp.ID]; ok {
+			entries = append(entries, entry{path: offlineBundlePhotoPath(sp.ID), data: thumb, method: zip.Store})
+		}
+	}
+
+	manifest := &domain.OfflineBundleManifest{
+		EventCode:   eventCode,
+		Version:     version,
+		GeneratedAt: time.Now().UTC(),
+		Files:       make([]*domain.OfflineBundleFile, len(entries)),
+	}
+	for i, e := range entries {
+		sum := sha256.Sum256(e.data)
+		manifest.Files[i] = &domain.OfflineBundleFile{Path: e.path, Size: len(e.data), SHA256: hex.EncodeToString(sum[:])}
+	}
+	manifestJSON, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encode offline bundle manifest: %w", err)
+	}
+	entries = append([]entry{{path: "manifest.json", data: manifestJSON, method: zip.Deflate}}, entries...)
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, e := range entries {
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: e.path, Method: e.method, Modified: manifest.GeneratedAt})
+		if err != nil {
+			return nil, fmt.Errorf("write offline bundle: %w", err)
+		}
+		if _, err := w.Write(e.data); err != nil {
+			return nil, fmt.Errorf("write offline bundle: %w", err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("write offline bundle: %w", err)
+	}
+	return &domain.OfflineBundle{Manifest: manifest, Archive: buf.Bytes()}, nil
+}
+
+// offlineBundleCache keeps the last bundle built for each event, so repeated downloads of an
+// unchanged event do not fetch the speaker photos again. The zero value is ready to use.
+type offlineBundleCache struct {
+	mu      sync.Mutex
+	byEvent map[string]*domain.OfflineBundle
+}
+
+// get returns the event's cached bundle if it has the given version, or nil.
+func (c *offlineBundleCache) get(eventID, version string) *domain.OfflineBundle {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if b := c.byEvent[eventID]; b != nil && b.Manifest.Version == version {
+		return b
+	}
+	return nil
+}
+
+func (c *offlineBundleCache) put(eventID string, bundle *domain.OfflineBundle) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.byEvent == nil {
+		c.byEvent = make(map[string]*domain.OfflineBundle)
+	}
+	if _, ok := c.byEvent[eventID]; !ok && len(c.byEvent) >= maxCachedBundles {
+		var oldestID string
+		for id, b := range c.byEvent {
+			if oldestID == "" || b.Manifest.GeneratedAt.Before(c.byEvent[oldestID].Manifest.GeneratedAt) {
+				oldestID = id
+			}
+		}
+		delete(c.byEvent, oldestID)
+	}
+	c.byEvent[eventID] = bundle
+}
@@ -0,0 +1,43 @@
+package services
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+// normalizeOperatingHours sorts the days and returns an ErrInvalidInput error when a day is listed
+// twice, closes before it opens or overlaps the next one.
+func normalizeOperatingHours(days []*domain.OperatingDay) error {
+	var problems []string
+	for _, day := range days {
+		if _, err := time.Parse(time.DateOnly, day.Day); err != nil {
+			problems = append(problems, fmt.Sprintf("day %q must be YYYY-MM-DD", day.Day))
+			continue
+		}
+		if !day.ClosesAt.After(day.OpensAt) {
+			problems = append(problems, fmt.Sprintf("%s must close after it opens", day.Day))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%s: %w", strings.Join(problems, "; "), domain.ErrInvalidInput)
+	}
+	slices.SortFunc(days, func(a, b *domain.OperatingDay) int {
+		return strings.Compare(a.Day, b.Day)
+	})
+	for i := 1; i < len(days); i++ {
+		prev, day := days[i-1], days[i]
+		if prev.Day == day.Day {
+			problems = append(problems, fmt.Sprintf("%s is listed more than once", day.Day))
+		} else if day.OpensAt.Before(prev.ClosesAt) {
+			problems = append(problems, fmt.Sprintf("%s opens before %s closes", day.Day, prev.Day))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%s: %w", strings.Join(problems, "; "), domain.ErrInvalidInput)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+type retentionService struct {
+	retentionRepo  domain.RetentionRepository
+	policies       []domain.RetentionPolicy
+	contextTimeout time.Duration
+}
+
+// NewRetentionService returns a domain.RetentionService applying policies in order; policies with
+// no retention are skipped. timeout bounds each repository call.
+func NewRetentionService(retentionRepo domain.RetentionRepository, policies []domain.RetentionPolicy, timeout time.Duration) domain.RetentionService {
+	enabled := make([]domain.RetentionPolicy, 0, len(policies))
+	for _, p := range policies {
+		if p.Retention > 0 {
+			enabled = append(enabled, p)
+		}
+	}
+	return &retentionService{
+		retentionRepo:  retentionRepo,
+		policies:       enabled,
+		contextTimeout: timeout,
+	}
+}
+
+func (s *retentionService) Report(ctx context.Context) (*domain.RetentionReport, error) {
+	return s.run(ctx, s.retentionRepo.CountExpired)
+}
+
+// Purge keeps going when one data class fails; the failures are joined into the returned error
+// alongside the partial report.
+func (s *retentionService) Purge(ctx context.Context) (*domain.RetentionReport, error) {
+	return s.run(ctx, s.retentionRepo.PurgeExpired)
+}
+
+// run applies op to every data class with its cutoff, as of now.
+func (s *retentionService) run(ctx context.Context, op func(ctx context.Context, dataClass string, cutoff time.Time) (int64, error)) (*domain.RetentionReport, error) {
+	now := time.Now().UTC()
+	report := &domain.RetentionReport{GeneratedAt: now, Classes: []*domain.RetentionClassReport{}}
+	var errs []error
+	for _, p := range s.policies {
+		class := &domain.RetentionClassReport{
+			DataClass:     p.DataClass,
+			RetentionDays: int(p.Retention / (24 * time.Hour)),
+			Cutoff:        now.Add(-p.Retention),
+		}
+		opCtx, cancel := withTimeout(ctx, s.contextTimeout)
+		rows, err := op(opCtx, p.DataClass, class.Cutoff)
+		cancel()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", p.DataClass, err))
+			continue
+		}
+		class.Rows = rows
+		report.Classes = append(report.Classes, class)
+	}
+	return report, errors.Join(errs...)
+}
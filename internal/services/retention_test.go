@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"multitrackticketing/internal/domain"
+)
+
+// fakeRetentionRepo counts the expired rows per data class and records the cutoffs purged.
+type fakeRetentionRepo struct {
+	expired map[string]int64
+	errs    map[string]error
+	purged  map[string]time.Time
+}
+
+func (f *fakeRetentionRepo) CountExpired(ctx context.Context, dataClass string, cutoff time.Time) (int64, error) {
+	return f.expired[dataClass], f.errs[dataClass]
+}
+
+func (f *fakeRetentionRepo) PurgeExpired(ctx context.Context, dataClass string, cutoff time.Time) (int64, error) {
+	if err := f.errs[dataClass]; err != nil {
+		return 0, err
+	}
+	f.purged[dataClass] = cutoff
+	n := f.expired[dataClass]
+	f.expired[dataClass] = 0
+	return n, nil
+}
+
+func TestRetentionService(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeRetentionRepo{
+		expired: map[string]int64{domain.RetentionSessionChanges: 40, domain.RetentionLoginCodes: 7},
+		errs:    map[string]error{},
+		purged:  map[string]time.Time{},
+	}
+	svc := NewRetentionService(repo, []domain.RetentionPolicy{
+		{DataClass: domain.RetentionSessionChanges, Retention: 365 * 24 * time.Hour},
+		{DataClass: domain.RetentionLoginCodes, Retention: 7 * 24 * time.Hour},
+		{DataClass: domain.RetentionPendingInvitations}, // disabled
+	}, time.Second)
+
+	report, err := svc.Report(ctx)
+	require.NoError(t, err)
+	require.Len(t, report.Classes, 2)
+	assert.Equal(t, domain.RetentionSessionChanges, report.Classes[0].DataClass)
+	assert.Equal(t, 365, report.Classes[0].RetentionDays)
+	assert.Equal(t, int64(40), report.Classes[0].Rows)
+	assert.WithinDuration(t, time.Now().Add(-365*24*time.Hour), report.Classes[0].Cutoff, time.Minute)
+	assert.Empty(t, repo.purged, "a report deletes nothing")
+
+	purged, err := svc.Purge(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), purged.Classes[1].Rows)
+	assert.Contains(t, repo.purged, domain.RetentionLoginCodes)
+	assert.NotContains(t, repo.purged, domain.RetentionPendingInvitations)
+
+	report, err = svc.Report(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, report.Classes[0].Rows+report.Classes[1].Rows, "nothing left to purge")
+
+	t.Run("one failing class does not stop the others", func(t *testing.T) {
+		repo.expired[domain.RetentionLoginCodes] = 2
+		repo.errs[domain.RetentionSessionChanges] = errors.New("db down")
+		purged, err := svc.Purge(ctx)
+		require.ErrorContains(t, err, "session_changes: db down")
+		require.Len(t, purged.Classes, 1)
+		assert.Equal(t, int64(2), purged.Classes[0].Rows)
+	})
+}
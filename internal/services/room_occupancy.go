@@ -0,0 +1,119 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+type roomOccupancyService struct {
+	eventRepo      domain.EventRepository
+	sessionRepo    domain.SessionRepository
+	occupancyRepo  domain.RoomOccupancyRepository
+	userRepo       domain.UserRepository
+	emailService   domain.EmailService
+	now            func() time.Time
+	contextTimeout time.Duration
+}
+
+// NewRoomOccupancyService returns a domain.RoomOccupancyService. Capacity alerts are emailed to
+// the event owner through emailService.
+func NewRoomOccupancyService(eventRepo domain.EventRepository, sessionRepo domain.SessionRepository, occupancyRepo domain.RoomOccupancyRepository, userRepo domain.UserRepository, emailService domain.EmailService, timeout time.Duration) domain.RoomOccupancyService {
+	return &roomOccupancyService{
+		eventRepo:      eventRepo,
+		sessionRepo:    sessionRepo,
+		occupancyRepo:  occupancyRepo,
+		userRepo:       userRepo,
+		emailService:   emailService,
+		now:            time.Now,
+		contextTimeout: timeout,
+	}
+}
+
+func (s *roomOccupancyService) UpdateOccupancy(ctx context.Context, client *domain.MachinePrincipal, eventID, roomID string, delta int, count *int) (*domain.RoomOccupancy, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	switch {
+	case count != nil && (*count < 0 || *count > domain.MaxOccupancy):
+		return nil, fmt.Errorf("count must be 0 to %d: %w", domain.MaxOccupancy, domain.ErrInvalidInput)
+	case count == nil && (delta == 0 || delta < -domain.MaxOccupancyDelta || delta > domain.MaxOccupancyDelta):
+		return nil, fmt.Errorf("delta must be -%d to %d and not 0: %w", domain.MaxOccupancyDelta, domain.MaxOccupancyDelta, domain.ErrInvalidInput)
+	}
+	if client.EventID != eventID {
+		return nil, domain.ErrForbidden
+	}
+	room, err := s.sessionRepo.GetRoomByID(ctx, roomID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get room: %w", err)
+	}
+	if room.EventID != eventID {
+		return nil, domain.ErrNotFound
+	}
+
+	var occupancy *domain.RoomOccupancy
+	if count != nil {
+		occupancy, err = s.occupancyRepo.Set(ctx, eventID, roomID, *count)
+	} else {
+		occupancy, err = s.occupancyRepo.Add(ctx, eventID, roomID, delta)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("update occupancy: %w", err)
+	}
+	occupancy.SetCapacity(room.Capacity)
+
+	switch {
+	case occupancy.NearCapacity && occupancy.AlertedAt == nil:
+		now := s.now().UTC()
+		marked, err := s.occupancyRepo.MarkAlerted(ctx, roomID, now)
+		if err != nil {
+			return nil, fmt.Errorf("mark capacity alert: %w", err)
+		}
+		if marked {
+			occupancy.AlertedAt = &now
+			// The count is recorded either way; the alert is best effort. When it did not go out,
+			// the mark is cleared so that the next reading tries again.
+			if err := s.alertOwner(ctx, eventID, room, occupancy); err != nil {
+				domain.ReportBestEffort(ctx, fmt.Errorf("capacity alert for room %s: %w", roomID, err))
+				if err := s.occupancyRepo.ClearAlert(ctx, roomID); err != nil {
+					domain.ReportBestEffort(ctx, fmt.Errorf("clear unsent capacity alert for room %s: %w", roomID, err))
+				} else {
+					occupancy.AlertedAt = nil
+				}
+			}
+		}
+	case occupancy.AlertedAt != nil && occupancy.Rearmed():
+		if err := s.occupancyRepo.ClearAlert(ctx, roomID); err != nil {
+			return nil, fmt.Errorf("clear capacity alert: %w", err)
+		}
+		occupancy.AlertedAt = nil
+	}
+	return occupancy, nil
+}
+
+// alertOwner emails the event owner that the room is nearly full.
+func (s *roomOccupancyService) alertOwner(ctx context.Context, eventID string, room *domain.Room, occupancy *domain.RoomOccupancy) error {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("get event: %w", err)
+	}
+	owner, err := s.userRepo.GetByID(ctx, event.OwnerID)
+	if err != nil {
+		return fmt.Errorf("get owner: %w", err)
+	}
+	return s.emailService.SendRoomCapacityAlert(ctx, &domain.RoomCapacityAlertEmailData{
+		EventID:   eventID,
+		Email:     owner.Email,
+		OwnerName: displayName(owner),
+		EventName: event.Name,
+		RoomName:  room.Name,
+		Count:     occupancy.Count,
+		Capacity:  occupancy.Capacity,
+	})
+}
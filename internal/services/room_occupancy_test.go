@@ -0,0 +1,179 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"multitrackticketing/internal/domain"
+)
+
+// fakeRoomOccupancyRepo is an in-memory RoomOccupancyRepository for tests.
+type fakeRoomOccupancyRepo struct {
+	byRoom  map[string]*domain.RoomOccupancy
+	eventOf map[string]string
+}
+
+func newFakeRoomOccupancyRepo() *fakeRoomOccupancyRepo {
+	return &fakeRoomOccupancyRepo{byRoom: make(map[string]*domain.RoomOccupancy), eventOf: make(map[string]string)}
+}
+
+func (f *fakeRoomOccupancyRepo) upsert(eventID, roomID string, count func(int) int) *domain.RoomOccupancy {
+	o, ok := f.byRoom[roomID]
+	if !ok {
+		o = &domain.RoomOccupancy{RoomID: roomID}
+		f.byRoom[roomID], f.eventOf[roomID] = o, eventID
+	}
+	o.Count = count(o.Count)
+	o.UpdatedAt = time.Now()
+	cp := *o
+	return &cp
+}
+
+func (f *fakeRoomOccupancyRepo) Add(ctx context.Context, eventID, roomID string, delta int) (*domain.RoomOccupancy, error) {
+	return f.upsert(eventID, roomID, func(n int) int { return max(n+delta, 0) }), nil
+}
+
+func (f *fakeRoomOccupancyRepo) Set(ctx context.Context, eventID, roomID string, count int) (*domain.RoomOccupancy, error) {
+	return f.upsert(eventID, roomID, func(int) int { return count }), nil
+}
+
+func (f *fakeRoomOccupancyRepo) ListByEventID(ctx context.Context, eventID string) ([]*domain.RoomOccupancy, error) {
+	out := []*domain.RoomOccupancy{}
+	for roomID, o := range f.byRoom {
+		if f.eventOf[roomID] == eventID {
+			cp := *o
+			out = append(out, &cp)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeRoomOccupancyRepo) MarkAlerted(ctx context.Context, roomID string, at time.Time) (bool, error) {
+	o, ok := f.byRoom[roomID]
+	if !ok || o.AlertedAt != nil {
+		return false, nil
+	}
+	o.AlertedAt = &at
+	return true, nil
+}
+
+func (f *fakeRoomOccupancyRepo) ClearAlert(ctx context.Context, roomID string) error {
+	if o, ok := f.byRoom[roomID]; ok {
+		o.AlertedAt = nil
+	}
+	return nil
+}
+
+func TestRoomOccupancyService_UpdateOccupancy(t *testing.T) {
+	ctx := context.Background()
+	client := &domain.MachinePrincipal{ClientID: "clicker-1", EventID: "ev-1", Scopes: []string{domain.ScopeOccupancyWrite}}
+	intPtr := func(n int) *int { return &n }
+
+	setup := func() (domain.RoomOccupancyService, *fakeRoomOccupancyRepo, *fakeEmailService) {
+		events := newFakeEventRepo()
+		events.byID["ev-1"] = &domain.Event{ID: "ev-1", Name: "GopherCon", OwnerID: "user-owner"}
+		sessions := newFakeSessionRepo()
+		sessions.rooms = []*domain.Room{
+			{ID: "room-1", EventID: "ev-1", Name: "Main Hall", Capacity: 20},
+			{ID: "room-2", EventID: "ev-1", Name: "Hallway"},
+			{ID: "room-3", EventID: "ev-2", Name: "Elsewhere", Capacity: 20},
+		}
+		users := newFakeUserRepoForSchedule()
+		users.addUserWithName("owner@example.com", "user-owner", "Olive", "Owner")
+		occupancy := newFakeRoomOccupancyRepo()
+		emails := newFakeEmailService()
+		return NewRoomOccupancyService(events, sessions, occupancy, users, emails, 5*time.Second), occupancy, emails
+	}
+
+	t.Run("counts in and out, never below zero", func(t *testing.T) {
+		svc, _, _ := setup()
+
+		got, err := svc.UpdateOccupancy(ctx, client, "ev-1", "room-1", 1, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 1, got.Count)
+		assert.Equal(t, 20, got.Capacity)
+		assert.False(t, got.NearCapacity)
+
+		got, err = svc.UpdateOccupancy(ctx, client, "ev-1", "room-1", -5, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 0, got.Count)
+
+		got, err = svc.UpdateOccupancy(ctx, client, "ev-1", "room-2", 0, intPtr(300))
+		require.NoError(t, err)
+		assert.Equal(t, 300, got.Count)
+		assert.False(t, got.NearCapacity, "rooms without a capacity are never near it")
+	})
+
+	t.Run("alerts the owner once until the room empties", func(t *testing.T) {
+		svc, _, emails := setup()
+
+		got, err := svc.UpdateOccupancy(ctx, client, "ev-1", "room-1", 0, intPtr(17))
+		require.NoError(t, err)
+		assert.False(t, got.NearCapacity)
+		assert.Empty(t, emails.sentCapacityAlerts)
+
+		got, err = svc.UpdateOccupancy(ctx, client, "ev-1", "room-1", 1, nil)
+		require.NoError(t, err)
+		assert.True(t, got.NearCapacity)
+		assert.NotNil(t, got.AlertedAt)
+		require.Len(t, emails.sentCapacityAlerts, 1)
+		assert.Equal(t, &domain.RoomCapacityAlertEmailData{
+			EventID: "ev-1", Email: "owner@example.com", OwnerName: "Olive Owner", EventName: "GopherCon", RoomName: "Main Hall", Count: 18, Capacity: 20,
+		}, emails.sentCapacityAlerts[0])
+
+		for _, delta := range []int{1, -2, 1} {
+			_, err = svc.UpdateOccupancy(ctx, client, "ev-1", "room-1", delta, nil)
+			require.NoError(t, err)
+		}
+		assert.Len(t, emails.sentCapacityAlerts, 1, "wavering around the threshold does not alert again")
+
+		got, err = svc.UpdateOccupancy(ctx, client, "ev-1", "room-1", 0, intPtr(14))
+		require.NoError(t, err)
+		assert.Nil(t, got.AlertedAt)
+		_, err = svc.UpdateOccupancy(ctx, client, "ev-1", "room-1", 0, intPtr(20))
+		require.NoError(t, err)
+		assert.Len(t, emails.sentCapacityAlerts, 2)
+	})
+
+	t.Run("a failed alert is retried on the next reading", func(t *testing.T) {
+		svc, _, emails := setup()
+		emails.sendCapacityAlertErr = errors.New("ses down")
+		reqCtx, failures := domain.WithBestEffortFailures(ctx)
+
+		got, err := svc.UpdateOccupancy(reqCtx, client, "ev-1", "room-1", 0, intPtr(18))
+		require.NoError(t, err, "the count is recorded either way")
+		assert.Equal(t, 18, got.Count)
+		assert.Nil(t, got.AlertedAt)
+		require.Len(t, failures.Errors(), 1)
+		assert.ErrorContains(t, failures.Errors()[0], "capacity alert for room room-1: ses down")
+
+		emails.sendCapacityAlertErr = nil
+		got, err = svc.UpdateOccupancy(ctx, client, "ev-1", "room-1", 1, nil)
+		require.NoError(t, err)
+		assert.NotNil(t, got.AlertedAt)
+		assert.Len(t, emails.sentCapacityAlerts, 2, "the failed attempt and the retry")
+	})
+
+	t.Run("rejects", func(t *testing.T) {
+		svc, occupancy, _ := setup()
+
+		_, err := svc.UpdateOccupancy(ctx, client, "ev-1", "room-1", 0, nil)
+		require.ErrorIs(t, err, domain.ErrInvalidInput)
+		_, err = svc.UpdateOccupancy(ctx, client, "ev-1", "room-1", domain.MaxOccupancyDelta+1, nil)
+		require.ErrorIs(t, err, domain.ErrInvalidInput)
+		_, err = svc.UpdateOccupancy(ctx, client, "ev-1", "room-1", 0, intPtr(-1))
+		require.ErrorIs(t, err, domain.ErrInvalidInput)
+		_, err = svc.UpdateOccupancy(ctx, client, "ev-2", "room-3", 1, nil)
+		require.ErrorIs(t, err, domain.ErrForbidden)
+		_, err = svc.UpdateOccupancy(ctx, client, "ev-1", "room-3", 1, nil)
+		require.ErrorIs(t, err, domain.ErrNotFound)
+		_, err = svc.UpdateOccupancy(ctx, client, "ev-1", "room-9", 1, nil)
+		require.ErrorIs(t, err, domain.ErrNotFound)
+		assert.Empty(t, occupancy.byRoom)
+	})
+}
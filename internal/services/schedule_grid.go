@@ -0,0 +1,164 @@
+package services
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+// scheduleGrid lays the sessions out as days × rooms × time slots. A session belongs to the
+// operating day it starts in, or else to the date it starts on in loc; every operating day is
+// listed even without sessions. Sessions overlapping by no more than the tolerance are not reported
+// as overlaps, as that is clock skew, but still get their own lane.
+func (p SchedulePolicy) scheduleGrid(eventID string, loc *time.Location, rooms []*domain.Room, sessions []*domain.Session, operatingDays []*domain.OperatingDay) *domain.ScheduleGrid {
+	grid := &domain.ScheduleGrid{
+		EventID:     eventID,
+		BuiltAt:     time.Now().UTC(),
+		Timezone:    loc.String(),
+		Rooms:       make([]*domain.ScheduleGridRoom, len(rooms)),
+		Days:        []*domain.ScheduleGridDay{},
+		Unscheduled: []*domain.ScheduleGridSession{},
+	}
+	roomIDs := make(map[string]bool, len(rooms))
+	for i, room := range rooms {
+		grid.Rooms[i] = &domain.ScheduleGridRoom{ID: room.ID, Name: room.Name, Capacity: room.Capacity, NotBookable: room.NotBookable}
+		roomIDs[room.ID] = true
+	}
+
+	sessions = slices.Clone(sessions)
+	slices.SortFunc(sessions, func(a, b *domain.Session) int {
+		return cmp.Or(a.StartTime.Compare(b.StartTime), strings.Compare(a.ID, b.ID))
+	})
+
+	// Group the sessions by day; sessions in a room the event no longer has count as unscheduled.
+	byDay := make(map[string][]*domain.Session)
+	opened := make(map[string]*domain.OperatingDay, len(operatingDays))
+	for _, day := range operatingDays {
+		byDay[day.Day] = nil
+		opened[day.Day] = day
+	}
+	for _, sess := range sessions {
+		if !roomIDs[sess.RoomID] {
+			grid.Unscheduled = append(grid.Unscheduled, gridSession(sess))
+			continue
+		}
+		day := sess.StartTime.In(loc).Format(time.DateOnly)
+		for _, od := range operatingDays {
+			if !sess.StartTime.Before(od.OpensAt.Add(-p.Tolerance)) && sess.StartTime.Before(od.ClosesAt) {
+				day = od.Day
+				break
+			}
+		}
+		byDay[day] = append(byDay[day], sess)
+	}
+
+	for day, daySessions := range byDay {
+		grid.Days = append(grid.Days, p.scheduleGridDay(day, opened[day], rooms, daySessions))
+	}
+	slices.SortFunc(grid.Days, func(a, b *domain.ScheduleGridDay) int {
+		return strings.Compare(a.Day, b.Day)
+	})
+	return grid
+}
+
+// scheduleGridDay lays out one day. sessions must be sorted by start time; od is the day's
+// operating hours, or nil.
+func (p SchedulePolicy) scheduleGridDay(day string, od *domain.OperatingDay, rooms []*domain.Room, sessions []*domain.Session) *domain.ScheduleGridDay {
+	gd := &domain.ScheduleGridDay{Day: day, Columns: make([]*domain.ScheduleGridColumn, len(rooms))}
+	if od != nil {
+		gd.Start, gd.End = od.OpensAt.UTC(), od.ClosesAt.UTC()
+	} else if len(sessions) > 0 {
+		gd.Start, gd.End = sessions[0].StartTime.UTC(), sessions[0].StartTime.UTC()
+	}
+	slots := []time.Time{}
+	for _, sess := range sessions {
+		start, end := sess.StartTime.UTC(), sess.EndTime.UTC()
+		gd.Start = earliest(gd.Start, start)
+		gd.End = latest(gd.End, latest(start, end))
+		slots = append(slots, start, end)
+	}
+	slots = append(slots, gd.Start, gd.End)
+	slices.SortFunc(slots, time.Time.Compare)
+	gd.Slots = slices.CompactFunc(slots, time.Time.Equal)
+	slotOf := func(t time.Time) int {
+		i, _ := slices.BinarySearchFunc(gd.Slots, t.UTC(), time.Time.Compare)
+		return i
+	}
+
+	byRoom := make(map[string][]*domain.Session, len(rooms))
+	for _, sess := range sessions {
+		byRoom[sess.RoomID] = append(byRoom[sess.RoomID], sess)
+	}
+	for i, room := range rooms {
+		col := &domain.ScheduleGridColumn{
+			RoomID:   room.ID,
+			Sessions: []*domain.ScheduleGridSession{},
+			Gaps:     []*domain.ScheduleGridGap{},
+			Overlaps: []*domain.ScheduleGridOverlap{},
+		}
+		var laneEnds []time.Time
+		var timed []*domain.Session
+		cursor := gd.Start
+		for _, sess := range byRoom[room.ID] {
+			gs := gridSession(sess)
+			gs.Slot = slotOf(sess.StartTime)
+			if sess.EndTime.After(sess.StartTime) {
+				gs.Span = slotOf(sess.EndTime) - gs.Slot
+				timed = append(timed, sess)
+				// Use the first lane that is free by the time the session starts.
+				gs.Lane = slices.IndexFunc(laneEnds, func(end time.Time) bool { return !end.After(sess.StartTime) })
+				if gs.Lane < 0 {
+					gs.Lane = len(laneEnds)
+					laneEnds = append(laneEnds, time.Time{})
+				}
+				laneEnds[gs.Lane] = sess.EndTime
+				if sess.StartTime.After(cursor) {
+					col.Gaps = append(col.Gaps, &domain.ScheduleGridGap{Start: cursor, End: sess.StartTime.UTC()})
+				}
+				cursor = latest(cursor, sess.EndTime.UTC())
+			}
+			col.Sessions = append(col.Sessions, gs)
+		}
+		if gd.End.After(cursor) {
+			col.Gaps = append(col.Gaps, &domain.ScheduleGridGap{Start: cursor, End: gd.End})
+		}
+		p.eachOverlap(timed, func(a, b *domain.Session) {
+			col.Overlaps = append(col.Overlaps, &domain.ScheduleGridOverlap{
+				SessionID:        a.ID,
+				RelatedSessionID: b.ID,
+				Start:            b.StartTime.UTC(),
+				End:              earliest(a.EndTime, b.EndTime).UTC(),
+			})
+		})
+		gd.Columns[i] = col
+	}
+	return gd
+}
+
+// gridSession returns the session's grid entry without a position.
+func gridSession(sess *domain.Session) *domain.ScheduleGridSession {
+	return &domain.ScheduleGridSession{
+		SessionID: sess.ID,
+		Title:     sess.Title,
+		Track:     sess.Track,
+		StartTime: sess.StartTime.UTC(),
+		EndTime:   sess.EndTime.UTC(),
+	}
+}
+
+func earliest(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
+
+func latest(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,108 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"multitrackticketing/internal/domain"
+)
+
+func TestSchedulePolicy_ScheduleGrid(t *testing.T) {
+	day := time.Date(2026, 5, 14, 0, 0, 0, 0, time.UTC)
+	at := func(h, m int) time.Time { return day.Add(time.Duration(h)*time.Hour + time.Duration(m)*time.Minute) }
+	rooms := []*domain.Room{
+		{ID: "room-a", Name: "Room A", Capacity: 100},
+		{ID: "room-b", Name: "Room B", NotBookable: true},
+	}
+
+	t.Run("slots, gaps, lanes and overlaps", func(t *testing.T) {
+		days := []*domain.OperatingDay{{Day: "2026-05-14", OpensAt: at(8, 0), ClosesAt: at(18, 0)}}
+		sessions := []*domain.Session{
+			{ID: "s2", RoomID: "room-a", Title: "Overlaps keynote", StartTime: at(9, 30), EndTime: at(10, 30)},
+			{ID: "s1", RoomID: "room-a", Title: "Keynote", StartTime: at(9, 0), EndTime: at(10, 0)},
+			{ID: "s3", RoomID: "room-a", Title: "After", StartTime: at(11, 0), EndTime: at(12, 0)},
+			{ID: "s4", RoomID: "room-b", Title: "Workshop", StartTime: at(9, 0), EndTime: at(12, 0)},
+			{ID: "s5", Title: "Unscheduled", StartTime: at(13, 0), EndTime: at(14, 0)},
+		}
+		grid := SchedulePolicy{}.scheduleGrid("ev-1", time.UTC, rooms, sessions, days)
+
+		assert.Equal(t, "ev-1", grid.EventID)
+		assert.Equal(t, "UTC", grid.Timezone)
+		require.Len(t, grid.Rooms, 2)
+		assert.True(t, grid.Rooms[1].NotBookable)
+		require.Len(t, grid.Unscheduled, 1)
+		assert.Equal(t, "s5", grid.Unscheduled[0].SessionID)
+
+		require.Len(t, grid.Days, 1)
+		d := grid.Days[0]
+		assert.Equal(t, "2026-05-14", d.Day)
+		assert.Equal(t, at(8, 0), d.Start)
+		assert.Equal(t, at(18, 0), d.End)
+		assert.Equal(t, []time.Time{at(8, 0), at(9, 0), at(9, 30), at(10, 0), at(10, 30), at(11, 0), at(12, 0), at(18, 0)}, d.Slots)
+		require.Len(t, d.Columns, 2)
+
+		a := d.Columns[0]
+		assert.Equal(t, "room-a", a.RoomID)
+		require.Len(t, a.Sessions, 3)
+		assert.Equal(t, &domain.ScheduleGridSession{SessionID: "s1", Title: "Keynote", StartTime: at(9, 0), EndTime: at(10, 0), Slot: 1, Span: 2}, a.Sessions[0])
+		assert.Equal(t, &domain.ScheduleGridSession{SessionID: "s2", Title: "Overlaps keynote", StartTime: at(9, 30), EndTime: at(10, 30), Slot: 2, Span: 2, Lane: 1}, a.Sessions[1])
+		assert.Equal(t, 0, a.Sessions[2].Lane, "a lane is reused once free")
+		assert.Equal(t, []*domain.ScheduleGridGap{
+			{Start: at(8, 0), End: at(9, 0)},
+			{Start: at(10, 30), End: at(11, 0)},
+			{Start: at(12, 0), End: at(18, 0)},
+		}, a.Gaps)
+		assert.Equal(t, []*domain.ScheduleGridOverlap{{SessionID: "s1", RelatedSessionID: "s2", Start: at(9, 30), End: at(10, 0)}}, a.Overlaps)
+
+		b := d.Columns[1]
+		require.Len(t, b.Sessions, 1)
+		assert.Equal(t, 5, b.Sessions[0].Span)
+		assert.Empty(t, b.Overlaps)
+		assert.Len(t, b.Gaps, 2)
+	})
+
+	t.Run("days without operating hours follow the time zone", func(t *testing.T) {
+		madrid, err := time.LoadLocation("Europe/Madrid")
+		require.NoError(t, err)
+		sessions := []*domain.Session{
+			// 23:30 UTC on the 14th is 01:30 on the 15th in Madrid.
+			{ID: "s1", RoomID: "room-a", Title: "Late", StartTime: at(23, 30), EndTime: at(24, 30)},
+			{ID: "s2", RoomID: "room-a", Title: "Morning", StartTime: at(8, 0), EndTime: at(9, 0)},
+		}
+		grid := SchedulePolicy{}.scheduleGrid("ev-1", madrid, rooms, sessions, nil)
+		require.Len(t, grid.Days, 2)
+		assert.Equal(t, "2026-05-14", grid.Days[0].Day)
+		assert.Equal(t, at(8, 0), grid.Days[0].Start)
+		assert.Equal(t, at(9, 0), grid.Days[0].End)
+		assert.Empty(t, grid.Days[0].Columns[0].Gaps)
+		assert.Equal(t, "2026-05-15", grid.Days[1].Day)
+		assert.Empty(t, grid.Days[1].Columns[1].Sessions)
+		assert.Len(t, grid.Days[1].Columns[1].Gaps, 1, "an empty room is one gap")
+	})
+
+	t.Run("overlaps within the tolerance are not reported", func(t *testing.T) {
+		sessions := []*domain.Session{
+			{ID: "s1", RoomID: "room-a", Title: "One", StartTime: at(9, 0), EndTime: at(10, 0).Add(20 * time.Second)},
+			{ID: "s2", RoomID: "room-a", Title: "Two", StartTime: at(10, 0), EndTime: at(11, 0)},
+		}
+		grid := SchedulePolicy{Tolerance: 30 * time.Second}.scheduleGrid("ev-1", time.UTC, rooms, sessions, nil)
+		col := grid.Days[0].Columns[0]
+		assert.Empty(t, col.Overlaps)
+		assert.Equal(t, 1, col.Sessions[1].Lane)
+	})
+
+	t.Run("empty operating day and backwards session", func(t *testing.T) {
+		days := []*domain.OperatingDay{{Day: "2026-05-15", OpensAt: at(32, 0), ClosesAt: at(42, 0)}}
+		sessions := []*domain.Session{
+			{ID: "s1", RoomID: "room-a", Title: "Backwards", StartTime: at(12, 0), EndTime: at(11, 0)},
+		}
+		grid := SchedulePolicy{}.scheduleGrid("ev-1", time.UTC, rooms, sessions, days)
+		require.Len(t, grid.Days, 2)
+		assert.Equal(t, 0, grid.Days[0].Columns[0].Sessions[0].Span)
+		assert.Equal(t, "2026-05-15", grid.Days[1].Day)
+		assert.Equal(t, []time.Time{at(32, 0), at(42, 0)}, grid.Days[1].Slots)
+	})
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+func (s *eventService) GetScheduleOverlaps(ctx context.Context, eventID, callerID, groupBy string, bucketMinutes int) (*domain.ScheduleOverlaps, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if groupBy != domain.OverlapGroupByTag && groupBy != domain.OverlapGroupByTrack {
+		return nil, fmt.Errorf("group_by must be %s or %s: %w", domain.OverlapGroupByTag, domain.OverlapGroupByTrack, domain.ErrInvalidInput)
+	}
+	if bucketMinutes < domain.MinOverlapBucketMinutes || bucketMinutes > domain.MaxOverlapBucketMinutes || (24*60)%bucketMinutes != 0 {
+		return nil, fmt.Errorf("bucket_minutes must divide a day and be %d to %d: %w", domain.MinOverlapBucketMinutes, domain.MaxOverlapBucketMinutes, domain.ErrInvalidInput)
+	}
+	if _, _, err := s.teamEvent(ctx, eventID, callerID); err != nil {
+		return nil, err
+	}
+	sessions, err := s.sessionRepo.ListSessionsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list sessions: %w", err)
+	}
+	return scheduleOverlaps(eventID, groupBy, bucketMinutes, sessions), nil
+}
+
+// scheduleOverlaps cuts the time the sessions run into buckets of bucketMinutes, aligned to UTC
+// midnight, and groups the sessions running in each by tag or track. A session runs in every
+// bucket it overlaps; sessions that do not end after they start are left out.
+func scheduleOverlaps(eventID, groupBy string, bucketMinutes int, sessions []*domain.Session) *domain.ScheduleOverlaps {
+	overlaps := &domain.ScheduleOverlaps{
+		EventID:       eventID,
+		GroupBy:       groupBy,
+		BucketMinutes: bucketMinutes,
+		Buckets:       []*domain.OverlapBucket{},
+	}
+	size := time.Duration(bucketMinutes) * time.Minute
+
+	sessions = slices.Clone(sessions)
+	slices.SortFunc(sessions, func(a, b *domain.Session) int {
+		return cmp.Or(a.StartTime.Compare(b.StartTime), strings.Compare(a.ID, b.ID))
+	})
+	byStart := make(map[time.Time][]*domain.Session)
+	for _, sess := range sessions {
+		if !sess.EndTime.After(sess.StartTime) {
+			continue
+		}
+		for start := sess.StartTime.UTC().Truncate(size); start.Before(sess.EndTime); start = start.Add(size) {
+			byStart[start] = append(byStart[start], sess)
+		}
+	}
+
+	for start, running := range byStart {
+		bucket := &domain.OverlapBucket{Start: start, End: start.Add(size), SessionCount: len(running), Groups: []*domain.OverlapGroup{}}
+		groups := make(map[string]*domain.OverlapGroup)
+		for _, sess := range running {
+			for _, key := range overlapKeys(groupBy, sess) {
+				group, ok := groups[key]
+				if !ok {
+					group = &domain.OverlapGroup{Key: key, Sessions: []*domain.OverlapSession{}}
+					groups[key] = group
+					bucket.Groups = append(bucket.Groups, group)
+				}
+				group.Sessions = append(group.Sessions, &domain.OverlapSession{
+					SessionID: sess.ID,
+					Title:     sess.Title,
+					RoomID:    sess.RoomID,
+					StartTime: sess.StartTime.UTC(),
+					EndTime:   sess.EndTime.UTC(),
+				})
+				group.Clash = key != "" && len(group.Sessions) > 1
+			}
+		}
+		slices.SortFunc(bucket.Groups, func(a, b *domain.OverlapGroup) int {
+			if (a.Key == "") != (b.Key == "") {
+				if a.Key == "" {
+					return 1
+				}
+				return -1
+			}
+			return cmp.Or(cmp.Compare(len(b.Sessions), len(a.Sessions)), strings.Compare(a.Key, b.Key))
+		})
+		overlaps.Buckets = append(overlaps.Buckets, bucket)
+	}
+	slices.SortFunc(overlaps.Buckets, func(a, b *domain.OverlapBucket) int {
+		return a.Start.Compare(b.Start)
+	})
+	return overlaps
+}
+
+// overlapKeys returns the groups the session belongs to: its tag names or its track, or the
+// unnamed group when it has none.
+func overlapKeys(groupBy string, sess *domain.Session) []string {
+	if groupBy == domain.OverlapGroupByTrack {
+		return []string{sess.Track}
+	}
+	if len(sess.Tags) == 0 {
+		return []string{""}
+	}
+	keys := make([]string, 0, len(sess.Tags))
+	for _, tag := range sess.Tags {
+		if !slices.Contains(keys, tag.Name) {
+			keys = append(keys, tag.Name)
+		}
+	}
+	return keys
+}
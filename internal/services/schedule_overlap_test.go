@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"multitrackticketing/internal/domain"
+)
+
+func TestScheduleOverlaps(t *testing.T) {
+	day := time.Date(2026, 5, 14, 0, 0, 0, 0, time.UTC)
+	at := func(h, m int) time.Time { return day.Add(time.Duration(h)*time.Hour + time.Duration(m)*time.Minute) }
+	ai, goTag := &domain.Tag{ID: "tag-ai", Name: "AI"}, &domain.Tag{ID: "tag-go", Name: "Go"}
+	sessions := []*domain.Session{
+		{ID: "s1", RoomID: "room-a", Title: "LLMs in prod", Track: "Data", StartTime: at(9, 0), EndTime: at(10, 0), Tags: []*domain.Tag{ai}},
+		{ID: "s2", RoomID: "room-b", Title: "Go and AI", Track: "Backend", StartTime: at(9, 30), EndTime: at(10, 30), Tags: []*domain.Tag{ai, goTag}},
+		{ID: "s3", RoomID: "room-c", Title: "Lunch", StartTime: at(12, 0), EndTime: at(12, 20)},
+		{ID: "s4", RoomID: "room-c", Title: "No end", StartTime: at(14, 0), EndTime: at(14, 0)},
+	}
+
+	t.Run("by tag", func(t *testing.T) {
+		got := scheduleOverlaps("ev-1", domain.OverlapGroupByTag, 30, sessions)
+
+		assert.Equal(t, "ev-1", got.EventID)
+		assert.Equal(t, 30, got.BucketMinutes)
+		require.Len(t, got.Buckets, 4, "09:00, 09:30, 10:00 and 12:00; empty buckets and sessions without length are left out")
+		assert.Equal(t, at(9, 0), got.Buckets[0].Start)
+		assert.Equal(t, at(9, 30), got.Buckets[0].End)
+
+		first := got.Buckets[0]
+		assert.Equal(t, 1, first.SessionCount)
+		require.Len(t, first.Groups, 1)
+		assert.False(t, first.Groups[0].Clash)
+
+		second := got.Buckets[1]
+		assert.Equal(t, 2, second.SessionCount)
+		require.Len(t, second.Groups, 2)
+		assert.Equal(t, "AI", second.Groups[0].Key)
+		assert.True(t, second.Groups[0].Clash, "two AI talks at once")
+		require.Len(t, second.Groups[0].Sessions, 2)
+		assert.Equal(t, "s1", second.Groups[0].Sessions[0].SessionID)
+		assert.Equal(t, "room-b", second.Groups[0].Sessions[1].RoomID)
+		assert.Equal(t, "Go", second.Groups[1].Key)
+		assert.False(t, second.Groups[1].Clash)
+
+		assert.Equal(t, at(10, 0), got.Buckets[2].Start)
+		assert.Equal(t, 1, got.Buckets[2].SessionCount)
+
+		lunch := got.Buckets[3]
+		require.Len(t, lunch.Groups, 1)
+		assert.Equal(t, "", lunch.Groups[0].Key)
+	})
+
+	t.Run("by track", func(t *testing.T) {
+		got := scheduleOverlaps("ev-1", domain.OverlapGroupByTrack, 60, sessions)
+
+		require.Len(t, got.Buckets, 3)
+		require.Len(t, got.Buckets[0].Groups, 2)
+		assert.Equal(t, "Backend", got.Buckets[0].Groups[0].Key)
+		assert.Equal(t, "Data", got.Buckets[0].Groups[1].Key)
+		assert.False(t, got.Buckets[0].Groups[0].Clash)
+	})
+
+	t.Run("unnamed group is not a clash and comes last", func(t *testing.T) {
+		untagged := []*domain.Session{
+			{ID: "u1", StartTime: at(9, 0), EndTime: at(10, 0)},
+			{ID: "u2", StartTime: at(9, 0), EndTime: at(10, 0)},
+			{ID: "t1", StartTime: at(9, 0), EndTime: at(10, 0), Tags: []*domain.Tag{goTag}},
+		}
+		got := scheduleOverlaps("ev-1", domain.OverlapGroupByTag, 60, untagged)
+
+		require.Len(t, got.Buckets, 1)
+		require.Len(t, got.Buckets[0].Groups, 2)
+		assert.Equal(t, "Go", got.Buckets[0].Groups[0].Key)
+		assert.Equal(t, "", got.Buckets[0].Groups[1].Key)
+		assert.False(t, got.Buckets[0].Groups[1].Clash)
+	})
+}
+
+func TestEventService_GetScheduleOverlaps(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2026, 5, 14, 9, 0, 0, 0, time.UTC)
+	er := newFakeEventRepo()
+	_ = er.Create(ctx, &domain.Event{Name: "Conf", OwnerID: "user-1"})
+	sr := newFakeSessionRepo()
+	sr.sessions = []*domain.Session{
+		{ID: "s1", EventID: "ev-1", RoomID: "room-1", Title: "One", StartTime: start, EndTime: start.Add(time.Hour)},
+	}
+	teamRepo := newFakeEventTeamMemberRepo()
+	require.NoError(t, teamRepo.Add(ctx, "ev-1", "user-ada"))
+	svc := newTestEventService(er, sr, &fakeSessionizeFetcher{}, 5*time.Second)
+	svc.eventTeamMemberRepo = teamRepo
+
+	got, err := svc.GetScheduleOverlaps(ctx, "ev-1", "user-ada", domain.OverlapGroupByTag, 15)
+	require.NoError(t, err)
+	assert.Len(t, got.Buckets, 4)
+
+	for _, bucket := range []int{0, 4, 7, 241} {
+		_, err = svc.GetScheduleOverlaps(ctx, "ev-1", "user-1", domain.OverlapGroupByTag, bucket)
+		require.ErrorIs(t, err, domain.ErrInvalidInput, "bucket of %d minutes", bucket)
+	}
+	_, err = svc.GetScheduleOverlaps(ctx, "ev-1", "user-1", "room", 30)
+	require.ErrorIs(t, err, domain.ErrInvalidInput)
+	_, err = svc.GetScheduleOverlaps(ctx, "ev-1", "user-2", domain.OverlapGroupByTag, 30)
+	require.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = svc.GetScheduleOverlaps(ctx, "ev-9", "user-1", domain.OverlapGroupByTag, 30)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+}
@@ -0,0 +1,161 @@
+package services
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+// SchedulePolicy validates session time slots. Every check on session times goes through it, so
+// tolerance, time zones and daylight saving time are handled the same way everywhere. The zero value
+// applies every limit exactly.
+//
+// Lengths are elapsed time, so a session across a daylight saving change lasts as long as it
+// actually runs. Times of day are wall-clock times in the rules' time zone: on the day clocks go
+// forward a limit inside the skipped hour moves to the time the clocks jump to, and on the day they
+// go back a limit inside the repeated hour allows whichever occurrence is more lenient.
+type SchedulePolicy struct {
+	// Tolerance absorbs clock skew in client-sent timestamps. A session may start or end up to
+	// Tolerance outside a time-of-day limit, event day or operating day, and last up to Tolerance more
+	// or less than an allowed duration. It never lets a session end before it starts.
+	Tolerance time.Duration
+}
+
+// normalize returns t in UTC at the microsecond precision Postgres stores, so the times handed to
+// the repository are the times it returns.
+func (p SchedulePolicy) normalize(t time.Time) time.Time {
+	return t.UTC().Truncate(time.Microsecond)
+}
+
+// checkOrder returns an ErrInvalidInput error unless the time slot ends after it starts.
+func (p SchedulePolicy) checkOrder(start, end time.Time) error {
+	if !end.After(start) {
+		return fmt.Errorf("end_time must be after start_time: %w", domain.ErrInvalidInput)
+	}
+	return nil
+}
+
+// checkRules returns an ErrScheduleRuleViolation error describing every rule the time slot breaks,
+// or nil when the slot is allowed. Times of day and dates are read in the rules' time zone.
+func (p SchedulePolicy) checkRules(rules *domain.ScheduleRules, start, end time.Time) error {
+	loc := time.UTC
+	if rules.Timezone != "" {
+		var err error
+		if loc, err = time.LoadLocation(rules.Timezone); err != nil {
+			return fmt.Errorf("load schedule rules time zone: %w", err)
+		}
+	}
+	start, end = start.In(loc), end.In(loc)
+	// The session belongs to the day it starts on; one starting just before midnight within the
+	// tolerance belongs to the next day.
+	day := startOfDay(start.Add(p.Tolerance))
+	// A session ending at midnight, or just after it within the tolerance, ends on the day it started.
+	lastDay := startOfDay(end.Add(-p.Tolerance - time.Nanosecond))
+
+	var problems []string
+	if len(rules.AllowedDurations) > 0 {
+		length := end.Sub(start)
+		if !slices.ContainsFunc(rules.AllowedDurations, func(minutes int) bool {
+			return (length - time.Duration(minutes)*time.Minute).Abs() <= p.Tolerance
+		}) {
+			problems = append(problems, fmt.Sprintf("session lasts %s but must last %s minutes",
+				formatSessionLength(length), joinOr(intsToStrings(rules.AllowedDurations))))
+		}
+	}
+	if rules.EarliestStart != "" || rules.LatestEnd != "" {
+		if !lastDay.Equal(day) {
+			problems = append(problems, "session must start and end on the same day")
+		}
+	}
+	if rules.EarliestStart != "" {
+		earliest, err := parseTimeOfDay(rules.EarliestStart)
+		if err != nil {
+			return fmt.Errorf("parse earliest_start: %w", err)
+		}
+		if start.Before(wallClock(day, earliest, false).Add(-p.Tolerance)) {
+			problems = append(problems, fmt.Sprintf("session starts at %s but may not start before %s",
+				start.Format("15:04"), rules.EarliestStart))
+		}
+	}
+	if rules.LatestEnd != "" {
+		latest, err := parseTimeOfDay(rules.LatestEnd)
+		if err != nil {
+			return fmt.Errorf("parse latest_end: %w", err)
+		}
+		if end.After(wallClock(day, latest, true).Add(p.Tolerance)) {
+			problems = append(problems, fmt.Sprintf("session ends at %s but must end by %s",
+				end.Format("15:04"), rules.LatestEnd))
+		}
+	}
+	if len(rules.AllowedDays) > 0 {
+		for _, d := range []time.Time{day, lastDay} {
+			if !slices.Contains(rules.AllowedDays, d.Format(time.DateOnly)) {
+				problems = append(problems, fmt.Sprintf("session is on %s but sessions may only be on %s",
+					d.Format(time.DateOnly), strings.Join(rules.AllowedDays, ", ")))
+				break
+			}
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s: %w", strings.Join(problems, "; "), domain.ErrScheduleRuleViolation)
+}
+
+// checkOperatingHours returns an ErrScheduleRuleViolation error unless the time slot fits inside one
+// of the operating days. An event without operating days allows any time slot.
+func (p SchedulePolicy) checkOperatingHours(days []*domain.OperatingDay, start, end time.Time) error {
+	if len(days) == 0 {
+		return nil
+	}
+	for _, day := range days {
+		if start.Before(day.OpensAt.Add(-p.Tolerance)) || !start.Before(day.ClosesAt) {
+			continue
+		}
+		if end.After(day.ClosesAt.Add(p.Tolerance)) {
+			return fmt.Errorf("session ends at %s but %s closes at %s: %w",
+				end.UTC().Format(time.RFC3339), day.Day, day.ClosesAt.UTC().Format(time.RFC3339), domain.ErrScheduleRuleViolation)
+		}
+		return nil
+	}
+	return fmt.Errorf("session starts at %s, outside the event's operating hours: %w",
+		start.UTC().Format(time.RFC3339), domain.ErrScheduleRuleViolation)
+}
+
+// startOfDay returns midnight at the start of t's day in t's location.
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// wallClock returns the instant the clocks show sinceMidnight on day. A time skipped when clocks go
+// forward becomes the instant they jump to. A time repeated when clocks go back is the first
+// occurrence, or the last one when last is set.
+func wallClock(day time.Time, sinceMidnight time.Duration, last bool) time.Time {
+	y, m, d := day.Date()
+	h, mi := int(sinceMidnight/time.Hour), int(sinceMidnight%time.Hour/time.Minute)
+	t := time.Date(y, m, d, h, mi, 0, 0, day.Location())
+	if t.Hour() != h || t.Minute() != mi {
+		// Skipped: time.Date lands on either side of the jump, which is the edge of that side's zone.
+		zoneStart, zoneEnd := t.ZoneBounds()
+		if t.Hour()*60+t.Minute() > h*60+mi {
+			return zoneStart
+		}
+		return zoneEnd
+	}
+	// Daylight saving shifts are at most two hours; look for the other occurrence of a repeated time.
+	for _, shift := range []time.Duration{30 * time.Minute, time.Hour, 2 * time.Hour} {
+		for _, other := range []time.Time{t.Add(-shift), t.Add(shift)} {
+			if other.Hour() != h || other.Minute() != mi || other.Day() != d {
+				continue
+			}
+			if last == other.After(t) {
+				t = other
+			}
+		}
+	}
+	return t
+}
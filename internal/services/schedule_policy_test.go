@@ -0,0 +1,438 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"multitrackticketing/internal/domain"
+)
+
+func TestSchedulePolicy_CheckRules(t *testing.T) {
+	rules := &domain.ScheduleRules{
+		AllowedDurations: []int{30, 45, 60},
+		EarliestStart:    "09:00",
+		LatestEnd:        "20:00",
+		AllowedDays:      []string{"2026-05-14", "2026-05-15"},
+		Timezone:         "Europe/Madrid",
+	}
+	// Madrid is UTC+2 in May.
+	at := func(day, clock string) time.Time {
+		ts, err := time.Parse(time.RFC3339, day+"T"+clock+":00+02:00")
+		require.NoError(t, err)
+		return ts.UTC()
+	}
+
+	tests := []struct {
+		name      string
+		rules     *domain.ScheduleRules
+		start     time.Time
+		end       time.Time
+		wantParts []string
+	}{
+		{name: "allowed", rules: rules, start: at("2026-05-14", "09:00"), end: at("2026-05-14", "10:00")},
+		{name: "ends exactly at the latest end", rules: rules, start: at("2026-05-15", "19:15"), end: at("2026-05-15", "20:00")},
+		{name: "empty rules allow anything", rules: domain.NewScheduleRules("ev-1"), start: at("2026-01-01", "03:00"), end: at("2026-01-01", "03:07")},
+		{
+			name:      "duration not allowed",
+			rules:     rules,
+			start:     at("2026-05-14", "10:00"),
+			end:       at("2026-05-14", "10:50"),
+			wantParts: []string{"session lasts 50 minutes but must last 30, 45 or 60 minutes"},
+		},
+		{
+			name:      "starts at 3 a.m.",
+			rules:     rules,
+			start:     at("2026-05-14", "03:00"),
+			end:       at("2026-05-14", "04:00"),
+			wantParts: []string{"session starts at 03:00 but may not start before 09:00"},
+		},
+		{
+			name:      "ends too late",
+			rules:     rules,
+			start:     at("2026-05-14", "19:30"),
+			end:       at("2026-05-14", "20:30"),
+			wantParts: []string{"session ends at 20:30 but must end by 20:00"},
+		},
+		{
+			name:      "not an event day",
+			rules:     rules,
+			start:     at("2026-05-16", "10:00"),
+			end:       at("2026-05-16", "11:00"),
+			wantParts: []string{"session is on 2026-05-16 but sessions may only be on 2026-05-14, 2026-05-15"},
+		},
+		{
+			name:  "overnight session breaks several rules",
+			rules: rules,
+			start: at("2026-05-15", "23:00"),
+			end:   at("2026-05-16", "00:30"),
+			wantParts: []string{
+				"session lasts 90 minutes",
+				"session must start and end on the same day",
+				"session is on 2026-05-16",
+			},
+		},
+		{
+			name:      "times are read in UTC without a time zone",
+			rules:     &domain.ScheduleRules{EarliestStart: "09:00"},
+			start:     at("2026-05-14", "10:00"),
+			end:       at("2026-05-14", "11:00"),
+			wantParts: []string{"session starts at 08:00"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := SchedulePolicy{}.checkRules(tt.rules, tt.start, tt.end)
+			if len(tt.wantParts) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, domain.ErrScheduleRuleViolation))
+			for _, part := range tt.wantParts {
+				assert.Contains(t, err.Error(), part)
+			}
+		})
+	}
+}
+
+func TestSchedulePolicy_CheckRules_InvalidTimeZone(t *testing.T) {
+	now := time.Now()
+	err := SchedulePolicy{}.checkRules(&domain.ScheduleRules{Timezone: "Mars/Olympus"}, now, now.Add(time.Hour))
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, domain.ErrScheduleRuleViolation))
+}
+
+func TestSchedulePolicy_CheckOperatingHours(t *testing.T) {
+	day1 := time.Date(2026, 5, 14, 0, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+	days := []*domain.OperatingDay{
+		{Day: "2026-05-14", OpensAt: day1.Add(8 * time.Hour), ClosesAt: day1.Add(25 * time.Hour)},
+		{Day: "2026-05-15", OpensAt: day2.Add(9 * time.Hour), ClosesAt: day2.Add(18 * time.Hour)},
+	}
+
+	tests := []struct {
+		name     string
+		days     []*domain.OperatingDay
+		start    time.Time
+		end      time.Time
+		wantPart string
+	}{
+		{name: "inside the first day", days: days, start: day1.Add(8 * time.Hour), end: day1.Add(9 * time.Hour)},
+		{name: "ends exactly at closing after midnight", days: days, start: day2, end: day2.Add(time.Hour)},
+		{name: "inside the second day", days: days, start: day2.Add(17 * time.Hour), end: day2.Add(18 * time.Hour)},
+		{name: "no operating hours allow anything", start: day1.Add(3 * time.Hour), end: day1.Add(4 * time.Hour)},
+		{
+			name: "between days", days: days, start: day2.Add(2 * time.Hour), end: day2.Add(3 * time.Hour),
+			wantPart: "session starts at 2026-05-15T02:00:00Z, outside the event's operating hours",
+		},
+		{
+			name: "starts at closing time", days: days, start: day2.Add(18 * time.Hour), end: day2.Add(19 * time.Hour),
+			wantPart: "outside the event's operating hours",
+		},
+		{
+			name: "runs past closing", days: days, start: day2.Add(17 * time.Hour), end: day2.Add(19 * time.Hour),
+			wantPart: "session ends at 2026-05-15T19:00:00Z but 2026-05-15 closes at 2026-05-15T18:00:00Z",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := SchedulePolicy{}.checkOperatingHours(tt.days, tt.start, tt.end)
+			if tt.wantPart == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, domain.ErrScheduleRuleViolation)
+			assert.Contains(t, err.Error(), tt.wantPart)
+		})
+	}
+}
+
+func TestSchedulePolicy_Normalize(t *testing.T) {
+	madrid, err := time.LoadLocation("Europe/Madrid")
+	require.NoError(t, err)
+	local := time.Date(2026, 5, 14, 9, 30, 15, 123456789, madrid)
+
+	got := SchedulePolicy{}.normalize(local)
+
+	assert.Equal(t, time.UTC, got.Location())
+	assert.Equal(t, time.Date(2026, 5, 14, 7, 30, 15, 123456000, time.UTC), got)
+	assert.True(t, got.Equal(local.Truncate(time.Microsecond)), "the instant is unchanged")
+
+	now := SchedulePolicy{}.normalize(time.Now())
+	assert.Equal(t, now, now.Round(0), "the monotonic clock reading is dropped")
+}
+
+func TestSchedulePolicy_CheckOrder(t *testing.T) {
+	start := time.Date(2026, 5, 14, 9, 0, 0, 0, time.UTC)
+	policy := SchedulePolicy{Tolerance: time.Minute}
+
+	assert.NoError(t, policy.checkOrder(start, start.Add(time.Second)))
+	for name, end := range map[string]time.Time{
+		"same instant":         start,
+		"ends before it start": start.Add(-time.Second),
+	} {
+		err := policy.checkOrder(start, end)
+		assert.ErrorIs(t, err, domain.ErrInvalidInput, name)
+		assert.ErrorContains(t, err, "end_time must be after start_time", name)
+	}
+}
+
+func TestSchedulePolicy_Tolerance(t *testing.T) {
+	day := time.Date(2026, 5, 14, 0, 0, 0, 0, time.UTC)
+	rules := &domain.ScheduleRules{
+		AllowedDurations: []int{60},
+		EarliestStart:    "09:00",
+		LatestEnd:        "20:00",
+		AllowedDays:      []string{"2026-05-14"},
+	}
+	hours := []*domain.OperatingDay{{Day: "2026-05-14", OpensAt: day.Add(8 * time.Hour), ClosesAt: day.Add(21 * time.Hour)}}
+	at := func(clock string) time.Time {
+		d, err := time.ParseDuration(clock)
+		require.NoError(t, err)
+		return day.Add(d)
+	}
+
+	tests := []struct {
+		name       string
+		rules      *domain.ScheduleRules
+		start, end time.Time
+		exact      string
+		tolerant   string
+	}{
+		{
+			name:  "starts seconds before the earliest start",
+			rules: rules, start: at("8h59m45s"), end: at("9h59m45s"),
+			exact: "session starts at 08:59 but may not start before 09:00",
+		},
+		{
+			name:  "ends seconds after the latest end",
+			rules: rules, start: at("19h0m20s"), end: at("20h0m20s"),
+			exact: "session ends at 20:00 but must end by 20:00",
+		},
+		{
+			name:  "lasts seconds less than an allowed duration",
+			rules: rules, start: at("10h"), end: at("10h59m40s"),
+			exact: "session lasts 59m40s but must last 60 minutes",
+		},
+		{
+			name:  "lasts seconds more than an allowed duration",
+			rules: rules, start: at("10h"), end: at("11h0m25s"),
+			exact: "session lasts 1h0m25s but must last 60 minutes",
+		},
+		{
+			name:  "starts seconds before an allowed day",
+			rules: &domain.ScheduleRules{AllowedDays: []string{"2026-05-14"}}, start: at("-10s"), end: at("59m50s"),
+			exact: "session is on 2026-05-13",
+		},
+		{
+			name:  "ends seconds after midnight",
+			rules: &domain.ScheduleRules{EarliestStart: "09:00"}, start: at("23h"), end: at("24h0m15s"),
+			exact: "session must start and end on the same day",
+		},
+		{
+			name:  "a minute early is beyond the tolerance",
+			rules: rules, start: at("8h59m"), end: at("9h59m"),
+			exact:    "session starts at 08:59 but may not start before 09:00",
+			tolerant: "session starts at 08:59 but may not start before 09:00",
+		},
+		{
+			name:  "a minute short is beyond the tolerance",
+			rules: rules, start: at("10h"), end: at("10h59m"),
+			exact:    "session lasts 59 minutes but must last 60 minutes",
+			tolerant: "session lasts 59 minutes but must last 60 minutes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for policy, want := range map[SchedulePolicy]string{
+				{}:                            tt.exact,
+				{Tolerance: 30 * time.Second}: tt.tolerant,
+			} {
+				err := policy.checkRules(tt.rules, tt.start, tt.end)
+				if want == "" {
+					assert.NoError(t, err, "tolerance %s", policy.Tolerance)
+					continue
+				}
+				assert.ErrorIs(t, err, domain.ErrScheduleRuleViolation, "tolerance %s", policy.Tolerance)
+				assert.ErrorContains(t, err, want, "tolerance %s", policy.Tolerance)
+			}
+		})
+	}
+
+	t.Run("operating hours", func(t *testing.T) {
+		exact, tolerant := SchedulePolicy{}, SchedulePolicy{Tolerance: 30 * time.Second}
+		early, late := at("7h59m45s"), at("21h0m20s")
+
+		assert.ErrorIs(t, exact.checkOperatingHours(hours, early, at("9h")), domain.ErrScheduleRuleViolation)
+		assert.NoError(t, tolerant.checkOperatingHours(hours, early, at("9h")))
+		assert.ErrorIs(t, exact.checkOperatingHours(hours, at("20h"), late), domain.ErrScheduleRuleViolation)
+		assert.NoError(t, tolerant.checkOperatingHours(hours, at("20h"), late))
+		assert.ErrorIs(t, tolerant.checkOperatingHours(hours, at("7h59m"), at("9h")), domain.ErrScheduleRuleViolation)
+		assert.ErrorIs(t, tolerant.checkOperatingHours(hours, at("21h"), at("21h0m10s")), domain.ErrScheduleRuleViolation,
+			"a session cannot start at closing time")
+	})
+}
+
+// Madrid moves its clocks forward from 02:00 to 03:00 on 2026-03-29 and back from 03:00 to 02:00 on
+// 2026-10-25, both at 01:00 UTC.
+func TestSchedulePolicy_DaylightSavingTime(t *testing.T) {
+	utc := func(value string) time.Time {
+		ts, err := time.Parse(time.RFC3339, value)
+		require.NoError(t, err)
+		return ts
+	}
+	madrid := func(rules domain.ScheduleRules) *domain.ScheduleRules {
+		rules.Timezone = "Europe/Madrid"
+		return &rules
+	}
+
+	tests := []struct {
+		name       string
+		rules      *domain.ScheduleRules
+		start, end time.Time
+		wantPart   string
+	}{
+		{
+			name:  "length across the spring change is elapsed time",
+			rules: madrid(domain.ScheduleRules{AllowedDurations: []int{60}}),
+			// 01:30 CET to 03:30 CEST.
+			start: utc("2026-03-29T00:30:00Z"), end: utc("2026-03-29T01:30:00Z"),
+		},
+		{
+			name:  "length across the autumn change is elapsed time",
+			rules: madrid(domain.ScheduleRules{AllowedDurations: []int{60}}),
+			// 02:30 CEST to 02:30 CET.
+			start: utc("2026-10-25T00:30:00Z"), end: utc("2026-10-25T01:30:00Z"),
+		},
+		{
+			name:  "wall-clock length does not count",
+			rules: madrid(domain.ScheduleRules{AllowedDurations: []int{120}}),
+			start: utc("2026-03-29T00:30:00Z"), end: utc("2026-03-29T01:30:00Z"),
+			wantPart: "session lasts 60 minutes but must last 120 minutes",
+		},
+		{
+			name:  "earliest start in the skipped hour moves to the jump",
+			rules: madrid(domain.ScheduleRules{EarliestStart: "02:30"}),
+			// 03:00 CEST.
+			start: utc("2026-03-29T01:00:00Z"), end: utc("2026-03-29T02:00:00Z"),
+		},
+		{
+			name:  "starting before the jump breaks a skipped earliest start",
+			rules: madrid(domain.ScheduleRules{EarliestStart: "02:30"}),
+			start: utc("2026-03-29T00:59:00Z"), end: utc("2026-03-29T02:00:00Z"),
+			wantPart: "session starts at 01:59 but may not start before 02:30",
+		},
+		{
+			name:  "latest end in the skipped hour moves to the jump",
+			rules: madrid(domain.ScheduleRules{LatestEnd: "02:30"}),
+			start: utc("2026-03-29T00:00:00Z"), end: utc("2026-03-29T01:00:00Z"),
+		},
+		{
+			name:  "ending after the jump breaks a skipped latest end",
+			rules: madrid(domain.ScheduleRules{LatestEnd: "02:30"}),
+			start: utc("2026-03-29T00:00:00Z"), end: utc("2026-03-29T01:01:00Z"),
+			wantPart: "session ends at 03:01 but must end by 02:30",
+		},
+		{
+			name:  "repeated earliest start allows the first occurrence",
+			rules: madrid(domain.ScheduleRules{EarliestStart: "02:30"}),
+			start: utc("2026-10-25T00:30:00Z"), end: utc("2026-10-25T03:00:00Z"),
+		},
+		{
+			name:  "repeated earliest start is still a limit",
+			rules: madrid(domain.ScheduleRules{EarliestStart: "02:30"}),
+			start: utc("2026-10-25T00:29:00Z"), end: utc("2026-10-25T03:00:00Z"),
+			wantPart: "session starts at 02:29 but may not start before 02:30",
+		},
+		{
+			name:  "repeated latest end allows the second occurrence",
+			rules: madrid(domain.ScheduleRules{LatestEnd: "02:30"}),
+			start: utc("2026-10-24T23:00:00Z"), end: utc("2026-10-25T01:30:00Z"),
+		},
+		{
+			name:  "repeated latest end is still a limit",
+			rules: madrid(domain.ScheduleRules{LatestEnd: "02:30"}),
+			start: utc("2026-10-24T23:00:00Z"), end: utc("2026-10-25T01:31:00Z"),
+			wantPart: "session ends at 02:31 but must end by 02:30",
+		},
+		{
+			name:  "the 25-hour day ends at local midnight",
+			rules: madrid(domain.ScheduleRules{EarliestStart: "00:00", AllowedDays: []string{"2026-10-25"}}),
+			// 22:00 CET to 24:00 CET.
+			start: utc("2026-10-25T21:00:00Z"), end: utc("2026-10-25T23:00:00Z"),
+		},
+		{
+			name:  "the 23-hour day ends at local midnight",
+			rules: madrid(domain.ScheduleRules{EarliestStart: "00:00", AllowedDays: []string{"2026-03-29"}}),
+			// 23:00 CEST to 24:00 CEST.
+			start: utc("2026-03-29T21:00:00Z"), end: utc("2026-03-29T22:00:00Z"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := SchedulePolicy{}.checkRules(tt.rules, tt.start, tt.end)
+			if tt.wantPart == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, domain.ErrScheduleRuleViolation)
+			assert.ErrorContains(t, err, tt.wantPart)
+		})
+	}
+}
+
+func TestSchedulePolicy_LeapDay(t *testing.T) {
+	day := time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)
+	rules := &domain.ScheduleRules{EarliestStart: "09:00", LatestEnd: "23:59", AllowedDays: []string{"2028-02-29"}}
+	policy := SchedulePolicy{}
+
+	assert.NoError(t, policy.checkRules(rules, day.Add(22*time.Hour), day.Add(23*time.Hour)))
+	assert.NoError(t, policy.checkRules(&domain.ScheduleRules{AllowedDays: []string{"2028-02-29"}}, day.Add(23*time.Hour), day.Add(24*time.Hour)),
+		"a session ending at midnight stays on February 29")
+	err := policy.checkRules(&domain.ScheduleRules{AllowedDays: []string{"2028-02-29"}}, day.Add(24*time.Hour), day.Add(25*time.Hour))
+	assert.ErrorContains(t, err, "session is on 2028-03-01")
+	hours := []*domain.OperatingDay{{Day: "2028-02-29", OpensAt: day.Add(9 * time.Hour), ClosesAt: day.Add(26 * time.Hour)}}
+	assert.NoError(t, policy.checkOperatingHours(hours, day.Add(24*time.Hour), day.Add(25*time.Hour)),
+		"a leap day may run past midnight into March")
+}
+
+func TestWallClock(t *testing.T) {
+	madrid, err := time.LoadLocation("Europe/Madrid")
+	require.NoError(t, err)
+	utc := func(value string) time.Time {
+		ts, err := time.Parse(time.RFC3339, value)
+		require.NoError(t, err)
+		return ts
+	}
+	spring := time.Date(2026, 3, 29, 0, 0, 0, 0, madrid)
+	autumn := time.Date(2026, 10, 25, 0, 0, 0, 0, madrid)
+	ordinary := time.Date(2026, 5, 14, 0, 0, 0, 0, madrid)
+
+	tests := []struct {
+		name  string
+		day   time.Time
+		clock time.Duration
+		last  bool
+		want  time.Time
+	}{
+		{name: "ordinary day", day: ordinary, clock: 9 * time.Hour, want: utc("2026-05-14T07:00:00Z")},
+		{name: "ordinary day, last", day: ordinary, clock: 9 * time.Hour, last: true, want: utc("2026-05-14T07:00:00Z")},
+		{name: "skipped", day: spring, clock: 2*time.Hour + 30*time.Minute, want: utc("2026-03-29T01:00:00Z")},
+		{name: "skipped, last", day: spring, clock: 2*time.Hour + 30*time.Minute, last: true, want: utc("2026-03-29T01:00:00Z")},
+		{name: "start of the skipped hour", day: spring, clock: 2 * time.Hour, want: utc("2026-03-29T01:00:00Z")},
+		{name: "after the jump", day: spring, clock: 3 * time.Hour, want: utc("2026-03-29T01:00:00Z")},
+		{name: "repeated, first", day: autumn, clock: 2*time.Hour + 30*time.Minute, want: utc("2026-10-25T00:30:00Z")},
+		{name: "repeated, last", day: autumn, clock: 2*time.Hour + 30*time.Minute, last: true, want: utc("2026-10-25T01:30:00Z")},
+		{name: "after the repeated hour", day: autumn, clock: 3 * time.Hour, last: true, want: utc("2026-10-25T02:00:00Z")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := wallClock(tt.day, tt.clock, tt.last)
+			assert.True(t, got.Equal(tt.want), "got %s, want %s", got.UTC(), tt.want)
+		})
+	}
+}
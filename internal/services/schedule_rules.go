@@ -0,0 +1,54 @@
+package services
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+// normalizeScheduleRules sorts and deduplicates the allowed durations and days.
+func normalizeScheduleRules(rules *domain.ScheduleRules) {
+	rules.AllowedDurations = slices.Compact(slices.Sorted(slices.Values(rules.AllowedDurations)))
+	rules.AllowedDays = slices.Compact(slices.Sorted(slices.Values(rules.AllowedDays)))
+	if rules.AllowedDurations == nil {
+		rules.AllowedDurations = []int{}
+	}
+	if rules.AllowedDays == nil {
+		rules.AllowedDays = []string{}
+	}
+}
+
+// parseTimeOfDay parses "HH:MM" into the time since midnight.
+func parseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func formatSessionLength(d time.Duration) string {
+	if d%time.Minute == 0 {
+		return strconv.Itoa(int(d/time.Minute)) + " minutes"
+	}
+	return d.String()
+}
+
+func intsToStrings(values []int) []string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = strconv.Itoa(v)
+	}
+	return out
+}
+
+// joinOr joins values as "a", "a or b" or "a, b or c".
+func joinOr(values []string) string {
+	if len(values) <= 1 {
+		return strings.Join(values, "")
+	}
+	return strings.Join(values[:len(values)-1], ", ") + " or " + values[len(values)-1]
+}
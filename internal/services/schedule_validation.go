@@ -0,0 +1,126 @@
+package services
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+// validateSchedule runs every schedule check on the event's sessions: overlaps within a room,
+// speakers in overlapping sessions, rooms without a capacity, and the same schedule rules and
+// operating hours new sessions are held to. Sessions need their speaker IDs. Sessions overlapping
+// by no more than the tolerance are not reported, as that is clock skew.
+func (p SchedulePolicy) validateSchedule(eventID string, rules *domain.ScheduleRules, days []*domain.OperatingDay, rooms []*domain.Room, sessions []*domain.Session, speakers []*domain.Speaker) (*domain.ScheduleValidation, error) {
+	v := &domain.ScheduleValidation{
+		EventID:         eventID,
+		GeneratedAt:     time.Now(),
+		SessionsChecked: len(sessions),
+		Categories:      make(map[string][]*domain.ScheduleProblem, len(domain.ValidationCategories)),
+	}
+	for _, category := range domain.ValidationCategories {
+		v.Categories[category] = []*domain.ScheduleProblem{}
+	}
+	add := func(category string, problem *domain.ScheduleProblem) {
+		v.Categories[category] = append(v.Categories[category], problem)
+		v.ProblemCount++
+	}
+
+	sessions = slices.Clone(sessions)
+	slices.SortFunc(sessions, func(a, b *domain.Session) int {
+		return cmp.Or(a.StartTime.Compare(b.StartTime), strings.Compare(a.ID, b.ID))
+	})
+
+	// Time slot checks. Sessions that end before they start are left out of the overlap checks.
+	var timed []*domain.Session
+	for _, sess := range sessions {
+		if err := p.checkOrder(sess.StartTime, sess.EndTime); err != nil {
+			add(domain.ValidationScheduleRules, &domain.ScheduleProblem{SessionID: sess.ID, RoomID: sess.RoomID, Message: sessionProblem(sess, err)})
+			continue
+		}
+		timed = append(timed, sess)
+		if err := p.checkRules(rules, sess.StartTime, sess.EndTime); err != nil {
+			if !errors.Is(err, domain.ErrScheduleRuleViolation) {
+				return nil, err
+			}
+			add(domain.ValidationScheduleRules, &domain.ScheduleProblem{SessionID: sess.ID, RoomID: sess.RoomID, Message: sessionProblem(sess, err)})
+		}
+		if err := p.checkOperatingHours(days, sess.StartTime, sess.EndTime); err != nil {
+			add(domain.ValidationOperatingHours, &domain.ScheduleProblem{SessionID: sess.ID, RoomID: sess.RoomID, Message: sessionProblem(sess, err)})
+		}
+	}
+
+	// Room overlaps and capacity.
+	byRoom := make(map[string][]*domain.Session, len(rooms))
+	for _, sess := range timed {
+		if sess.RoomID != "" {
+			byRoom[sess.RoomID] = append(byRoom[sess.RoomID], sess)
+		}
+	}
+	for _, room := range rooms {
+		roomSessions := byRoom[room.ID]
+		p.eachOverlap(roomSessions, func(a, b *domain.Session) {
+			add(domain.ValidationRoomOverlap, &domain.ScheduleProblem{
+				SessionID: a.ID, RelatedSessionID: b.ID, RoomID: room.ID,
+				Message: fmt.Sprintf("%q and %q overlap in %s", a.Title, b.Title, room.Name),
+			})
+		})
+		if room.Capacity <= 0 && len(roomSessions) > 0 {
+			add(domain.ValidationCapacity, &domain.ScheduleProblem{
+				RoomID:  room.ID,
+				Message: fmt.Sprintf("%s holds %d sessions but has no capacity set", room.Name, len(roomSessions)),
+			})
+		}
+	}
+
+	// Speaker clashes, in any room.
+	bySpeaker := make(map[string][]*domain.Session)
+	for _, sess := range timed {
+		for _, speakerID := range sess.SpeakerIDs {
+			bySpeaker[speakerID] = append(bySpeaker[speakerID], sess)
+		}
+	}
+	for _, speaker := range speakers {
+		name := strings.TrimSpace(speaker.FirstName + " " + speaker.LastName)
+		p.eachOverlap(bySpeaker[speaker.ID], func(a, b *domain.Session) {
+			add(domain.ValidationSpeakerClash, &domain.ScheduleProblem{
+				SessionID: a.ID, RelatedSessionID: b.ID, SpeakerID: speaker.ID,
+				Message: fmt.Sprintf("%s speaks in %q and %q at overlapping times", name, a.Title, b.Title),
+			})
+		})
+	}
+
+	v.Valid = v.ProblemCount == 0
+	return v, nil
+}
+
+// overlap reports whether two time slots overlap by more than the tolerance.
+func (p SchedulePolicy) overlap(aStart, aEnd, bStart, bEnd time.Time) bool {
+	return bStart.Before(aEnd.Add(-p.Tolerance)) && aStart.Before(bEnd.Add(-p.Tolerance))
+}
+
+// eachOverlap calls fn for every pair of sessions that overlap by more than the tolerance. sessions
+// must be sorted by start time.
+func (p SchedulePolicy) eachOverlap(sessions []*domain.Session, fn func(a, b *domain.Session)) {
+	for i, a := range sessions {
+		for _, b := range sessions[i+1:] {
+			if !b.StartTime.Before(a.EndTime.Add(-p.Tolerance)) {
+				break
+			}
+			fn(a, b)
+		}
+	}
+}
+
+// sessionProblem describes a policy error for the session, without the error kind suffix.
+func sessionProblem(sess *domain.Session, err error) string {
+	msg := err.Error()
+	for _, kind := range []error{domain.ErrScheduleRuleViolation, domain.ErrInvalidInput} {
+		msg = strings.TrimSuffix(msg, ": "+kind.Error())
+	}
+	return fmt.Sprintf("%q: %s", sess.Title, msg)
+}
@@ -0,0 +1,97 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"multitrackticketing/internal/domain"
+)
+
+func TestSchedulePolicy_ValidateSchedule(t *testing.T) {
+	day := time.Date(2026, 5, 14, 0, 0, 0, 0, time.UTC)
+	at := func(h, m int) time.Time { return day.Add(time.Duration(h)*time.Hour + time.Duration(m)*time.Minute) }
+	rooms := []*domain.Room{
+		{ID: "room-a", Name: "Room A", Capacity: 100},
+		{ID: "room-b", Name: "Room B"},
+	}
+	speakers := []*domain.Speaker{{ID: "sp-1", FirstName: "Ada", LastName: "Lovelace"}}
+
+	t.Run("clean schedule", func(t *testing.T) {
+		sessions := []*domain.Session{
+			{ID: "s1", RoomID: "room-a", Title: "Opening", StartTime: at(9, 0), EndTime: at(10, 0), SpeakerIDs: []string{"sp-1"}},
+			{ID: "s2", RoomID: "room-a", Title: "Next", StartTime: at(10, 0), EndTime: at(11, 0), SpeakerIDs: []string{"sp-1"}},
+		}
+		v, err := SchedulePolicy{}.validateSchedule("ev-1", domain.NewScheduleRules("ev-1"), nil, rooms, sessions, speakers)
+		require.NoError(t, err)
+		assert.True(t, v.Valid)
+		assert.Equal(t, 2, v.SessionsChecked)
+		assert.Zero(t, v.ProblemCount)
+		for _, category := range domain.ValidationCategories {
+			assert.NotNil(t, v.Categories[category], category)
+			assert.Empty(t, v.Categories[category], category)
+		}
+	})
+
+	t.Run("every category", func(t *testing.T) {
+		rules := &domain.ScheduleRules{AllowedDurations: []int{60}}
+		days := []*domain.OperatingDay{{Day: "2026-05-14", OpensAt: at(9, 0), ClosesAt: at(18, 0)}}
+		sessions := []*domain.Session{
+			{ID: "s1", RoomID: "room-a", Title: "Keynote", StartTime: at(9, 0), EndTime: at(10, 0), SpeakerIDs: []string{"sp-1"}},
+			{ID: "s2", RoomID: "room-a", Title: "Overlaps keynote", StartTime: at(9, 30), EndTime: at(10, 30)},
+			{ID: "s3", RoomID: "room-b", Title: "Parallel", StartTime: at(9, 45), EndTime: at(10, 45), SpeakerIDs: []string{"sp-1"}},
+			{ID: "s4", RoomID: "room-b", Title: "Late", StartTime: at(18, 0), EndTime: at(19, 0)},
+			{ID: "s5", RoomID: "room-b", Title: "Backwards", StartTime: at(12, 0), EndTime: at(11, 0)},
+			{ID: "s6", Title: "Unscheduled", StartTime: at(11, 0), EndTime: at(11, 20)},
+		}
+		v, err := SchedulePolicy{}.validateSchedule("ev-1", rules, days, rooms, sessions, speakers)
+		require.NoError(t, err)
+		assert.False(t, v.Valid)
+
+		overlaps := v.Categories[domain.ValidationRoomOverlap]
+		require.Len(t, overlaps, 1)
+		assert.Equal(t, "s1", overlaps[0].SessionID)
+		assert.Equal(t, "s2", overlaps[0].RelatedSessionID)
+		assert.Equal(t, `"Keynote" and "Overlaps keynote" overlap in Room A`, overlaps[0].Message)
+
+		clashes := v.Categories[domain.ValidationSpeakerClash]
+		require.Len(t, clashes, 1)
+		assert.Equal(t, "sp-1", clashes[0].SpeakerID)
+		assert.Equal(t, `Ada Lovelace speaks in "Keynote" and "Parallel" at overlapping times`, clashes[0].Message)
+
+		capacity := v.Categories[domain.ValidationCapacity]
+		require.Len(t, capacity, 1)
+		assert.Equal(t, "room-b", capacity[0].RoomID)
+
+		hours := v.Categories[domain.ValidationOperatingHours]
+		require.Len(t, hours, 1)
+		assert.Equal(t, "s4", hours[0].SessionID)
+
+		// s5 ends before it starts; s6 lasts 20 minutes.
+		ruleProblems := v.Categories[domain.ValidationScheduleRules]
+		require.Len(t, ruleProblems, 2)
+		assert.Equal(t, "s6", ruleProblems[0].SessionID)
+		assert.Contains(t, ruleProblems[0].Message, `"Unscheduled": session lasts 20 minutes`)
+		assert.NotContains(t, ruleProblems[0].Message, domain.ErrScheduleRuleViolation.Error())
+		assert.Equal(t, "s5", ruleProblems[1].SessionID)
+		assert.Equal(t, `"Backwards": end_time must be after start_time`, ruleProblems[1].Message)
+
+		assert.Equal(t, 6, v.ProblemCount)
+	})
+
+	t.Run("overlap within tolerance is skew", func(t *testing.T) {
+		sessions := []*domain.Session{
+			{ID: "s1", RoomID: "room-a", StartTime: at(9, 0), EndTime: at(10, 0).Add(20 * time.Second)},
+			{ID: "s2", RoomID: "room-a", StartTime: at(10, 0), EndTime: at(11, 0)},
+		}
+		v, err := SchedulePolicy{Tolerance: 30 * time.Second}.validateSchedule("ev-1", domain.NewScheduleRules("ev-1"), nil, rooms, sessions, nil)
+		require.NoError(t, err)
+		assert.True(t, v.Valid)
+
+		v, err = SchedulePolicy{}.validateSchedule("ev-1", domain.NewScheduleRules("ev-1"), nil, rooms, sessions, nil)
+		require.NoError(t, err)
+		assert.Len(t, v.Categories[domain.ValidationRoomOverlap], 1)
+	})
+}
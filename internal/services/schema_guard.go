@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+type schemaGuard struct {
+	repo      domain.SchemaRepository
+	supported int64
+	refresh   time.Duration
+	now       func() time.Time
+
+	mu        sync.Mutex
+	status    *domain.SchemaStatus
+	checkedAt time.Time
+}
+
+// NewSchemaGuard returns a guard that refuses writes while the database's schema version is above
+// supported, the newest migration this build's queries know. The version is read at most once per
+// refresh, so the guard costs one query per interval rather than one per request.
+func NewSchemaGuard(repo domain.SchemaRepository, supported int64, refresh time.Duration) domain.SchemaGuard {
+	return &schemaGuard{repo: repo, supported: supported, refresh: refresh, now: time.Now}
+}
+
+// current returns the schema status, read again once refresh has passed. When reading fails it
+// returns the last status read, if any, with the error.
+func (g *schemaGuard) current(ctx context.Context) (*domain.SchemaStatus, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	now := g.now()
+	if g.status != nil && now.Sub(g.checkedAt) < g.refresh {
+		return g.status, nil
+	}
+	s, err := g.repo.SchemaStatus(ctx)
+	if err != nil {
+		return g.status, err
+	}
+	g.status, g.checkedAt = s, now
+	return s, nil
+}
+
+// AllowWrites lets writes through when the schema cannot be read: a database that is down fails
+// them anyway, and readiness reports it.
+func (g *schemaGuard) AllowWrites(ctx context.Context) error {
+	s, _ := g.current(ctx)
+	if s != nil && s.Version > g.supported {
+		return domain.ErrSchemaTooNew
+	}
+	return nil
+}
+
+func (g *schemaGuard) CheckReadiness(ctx context.Context) domain.DependencyHealth {
+	h := domain.DependencyHealth{Name: "schema", Status: domain.DependencyUp}
+	s, err := g.current(ctx)
+	switch {
+	case s == nil:
+		h.Status = domain.DependencyDegraded
+		h.Detail = err.Error()
+	case s.Version > g.supported:
+		h.Status = domain.DependencyDegraded
+		h.Detail = fmt.Sprintf("database is at migration %d, this server supports up to %d: writes are refused", s.Version, g.supported)
+	case s.Version < g.supported:
+		h.Status = domain.DependencyDegraded
+		h.Detail = fmt.Sprintf("database is at migration %d, this server expects %d: run the migrations", s.Version, g.supported)
+	case s.Dirty:
+		h.Status = domain.DependencyDegraded
+		h.Detail = fmt.Sprintf("migration %d is running or failed part way", s.Version)
+	default:
+		h.Detail = fmt.Sprintf("at migration %d", s.Version)
+	}
+	return h
+}
@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"multitrackticketing/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeSchemaRepo struct {
+	status *domain.SchemaStatus
+	err    error
+	calls  int
+}
+
+func (f *fakeSchemaRepo) SchemaStatus(ctx context.Context) (*domain.SchemaStatus, error) {
+	f.calls++
+	return f.status, f.err
+}
+
+func TestSchemaGuard_AllowWrites(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     *domain.SchemaStatus
+		err        error
+		wantErr    error
+		wantHealth string
+	}{
+		{name: "same version", status: &domain.SchemaStatus{Version: 24}, wantHealth: domain.DependencyUp},
+		{name: "older database", status: &domain.SchemaStatus{Version: 23}, wantHealth: domain.DependencyDegraded},
+		{name: "newer database", status: &domain.SchemaStatus{Version: 25}, wantErr: domain.ErrSchemaTooNew, wantHealth: domain.DependencyDegraded},
+		{name: "next migration running", status: &domain.SchemaStatus{Version: 25, Dirty: true}, wantErr: domain.ErrSchemaTooNew, wantHealth: domain.DependencyDegraded},
+		{name: "unreadable", err: errors.New("db down"), wantHealth: domain.DependencyDegraded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			guard := NewSchemaGuard(&fakeSchemaRepo{status: tt.status, err: tt.err}, 24, time.Minute)
+
+			assert.ErrorIs(t, guard.AllowWrites(context.Background()), tt.wantErr)
+			if tt.wantErr == nil {
+				assert.NoError(t, guard.AllowWrites(context.Background()))
+			}
+			h := guard.CheckReadiness(context.Background())
+			assert.Equal(t, "schema", h.Name)
+			assert.False(t, h.Critical)
+			assert.Equal(t, tt.wantHealth, h.Status, h.Detail)
+		})
+	}
+}
+
+func TestSchemaGuard_Refresh(t *testing.T) {
+	repo := &fakeSchemaRepo{status: &domain.SchemaStatus{Version: 24}}
+	guard := NewSchemaGuard(repo, 24, time.Minute).(*schemaGuard)
+	now := time.Now()
+	guard.now = func() time.Time { return now }
+
+	assert.NoError(t, guard.AllowWrites(context.Background()))
+	repo.status = &domain.SchemaStatus{Version: 25}
+	assert.NoError(t, guard.AllowWrites(context.Background()), "cached until refresh")
+	assert.Equal(t, 1, repo.calls)
+
+	now = now.Add(time.Minute)
+	assert.ErrorIs(t, guard.AllowWrites(context.Background()), domain.ErrSchemaTooNew)
+
+	// A failed read keeps the last status.
+	now = now.Add(time.Minute)
+	repo.err = errors.New("db down")
+	assert.ErrorIs(t, guard.AllowWrites(context.Background()), domain.ErrSchemaTooNew)
+	assert.Equal(t, 3, repo.calls)
+}
@@ -0,0 +1,169 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+type enrichmentService struct {
+	events         domain.EventService
+	eventRepo      domain.EventRepository
+	sessionRepo    domain.SessionRepository
+	tagRepo        domain.TagRepository
+	enricher       domain.SessionEnricher
+	contextTimeout time.Duration
+}
+
+// NewEnrichmentService returns a domain.EnrichmentService. A nil enricher leaves suggestions
+// unavailable. Approved suggestions are saved through events, so they are checked like any other
+// tag or description edit.
+func NewEnrichmentService(events domain.EventService, eventRepo domain.EventRepository, sessionRepo domain.SessionRepository, tagRepo domain.TagRepository, enricher domain.SessionEnricher, timeout time.Duration) domain.EnrichmentService {
+	return &enrichmentService{
+		events:         events,
+		eventRepo:      eventRepo,
+		sessionRepo:    sessionRepo,
+		tagRepo:        tagRepo,
+		enricher:       enricher,
+		contextTimeout: timeout,
+	}
+}
+
+func (s *enrichmentService) SuggestSessionEnrichment(ctx context.Context, eventID, sessionID, ownerID string) (*domain.SessionSuggestion, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	event, sess, err := s.ownedSession(ctx, eventID, sessionID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	suggestion := &domain.SessionSuggestion{SessionID: sess.ID, Tags: []*domain.SuggestedTag{}}
+	in := &domain.EnrichmentInput{
+		EventName:   event.Name,
+		Title:       sess.Title,
+		Description: sess.Description,
+		SessionType: sess.SessionType,
+		Track:       sess.Track,
+		WantTags:    len(sess.Tags) == 0,
+		WantSummary: strings.TrimSpace(sess.Description) == "",
+	}
+	if !in.WantTags && !in.WantSummary {
+		return suggestion, nil
+	}
+	if s.enricher == nil {
+		return nil, domain.ErrEnrichmentUnavailable
+	}
+	eventTags, err := s.tagRepo.ListTagsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list event tags: %w", err)
+	}
+	existing := make(map[string]*domain.Tag, len(eventTags))
+	for _, t := range eventTags {
+		in.EventTags = append(in.EventTags, t.Name)
+		existing[strings.ToLower(t.Name)] = t
+	}
+
+	out, err := s.enricher.EnrichSession(ctx, in)
+	if err != nil {
+		return nil, fmt.Errorf("enrich session: %v: %w", err, domain.ErrEnrichmentUnavailable)
+	}
+	if in.WantTags {
+		seen := make(map[string]bool)
+		for _, name := range out.Tags {
+			name = strings.TrimSpace(name)
+			key := strings.ToLower(name)
+			if name == "" || seen[key] || len(suggestion.Tags) == domain.MaxSuggestedTags {
+				continue
+			}
+			seen[key] = true
+			tag := &domain.SuggestedTag{Name: name}
+			if t, ok := existing[key]; ok {
+				tag.Name, tag.TagID = t.Name, t.ID
+			}
+			suggestion.Tags = append(suggestion.Tags, tag)
+		}
+	}
+	if in.WantSummary {
+		suggestion.Summary = truncateRunes(strings.TrimSpace(out.Summary), domain.MaxSuggestedSummaryLength)
+	}
+	return suggestion, nil
+}
+
+func (s *enrichmentService) ApplySessionEnrichment(ctx context.Context, eventID, sessionID, ownerID string, tags []string, summary string) (*domain.Session, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	_, sess, err := s.ownedSession(ctx, eventID, sessionID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	summary = strings.TrimSpace(summary)
+	if summary != "" && strings.TrimSpace(sess.Description) != "" {
+		return nil, fmt.Errorf("session already has a description: %w", domain.ErrInvalidInput)
+	}
+
+	if len(tags) > 0 {
+		eventTags, err := s.events.AddEventTags(ctx, eventID, ownerID, tags)
+		if err != nil {
+			return nil, fmt.Errorf("add event tags: %w", err)
+		}
+		tagIDs := make(map[string]string, len(eventTags))
+		for _, t := range eventTags {
+			tagIDs[t.Name] = t.ID
+		}
+		for _, name := range tags {
+			tagID, ok := tagIDs[strings.TrimSpace(name)]
+			if !ok {
+				continue
+			}
+			if err := s.events.AddSessionTag(ctx, eventID, sessionID, ownerID, tagID); err != nil {
+				return nil, fmt.Errorf("add session tag: %w", err)
+			}
+		}
+	}
+	if summary != "" {
+		if _, err := s.events.UpdateSessionContent(ctx, eventID, sessionID, ownerID, nil, &summary); err != nil {
+			return nil, fmt.Errorf("update session content: %w", err)
+		}
+	}
+	return s.sessionRepo.GetSessionByID(ctx, sessionID)
+}
+
+// ownedSession returns the event and its session, ErrNotFound, or ErrForbidden unless ownerID owns
+// the event.
+func (s *enrichmentService) ownedSession(ctx context.Context, eventID, sessionID, ownerID string) (*domain.Event, *domain.Session, error) {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, nil, domain.ErrNotFound
+		}
+		return nil, nil, fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return nil, nil, domain.ErrForbidden
+	}
+	sess, err := s.sessionRepo.GetSessionByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, nil, domain.ErrNotFound
+		}
+		return nil, nil, fmt.Errorf("get session: %w", err)
+	}
+	if sess.EventID != eventID {
+		return nil, nil, domain.ErrNotFound
+	}
+	return event, sess, nil
+}
+
+// truncateRunes shortens s to at most n characters.
+func truncateRunes(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return strings.TrimSpace(string(r[:n]))
+}
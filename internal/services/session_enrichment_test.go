@@ -0,0 +1,129 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"multitrackticketing/internal/domain"
+)
+
+// fakeSessionEnricher returns fixed suggestions and records what it was asked.
+type fakeSessionEnricher struct {
+	out   *domain.EnrichmentOutput
+	err   error
+	calls []*domain.EnrichmentInput
+}
+
+func (f *fakeSessionEnricher) EnrichSession(ctx context.Context, in *domain.EnrichmentInput) (*domain.EnrichmentOutput, error) {
+	f.calls = append(f.calls, in)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.out, nil
+}
+
+func TestEnrichmentService(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(enricher domain.SessionEnricher) (domain.EnrichmentService, *fakeSessionRepo, *fakeTagRepo) {
+		events := newFakeEventRepo()
+		events.byID["event-1"] = &domain.Event{ID: "event-1", Name: "GopherCon", OwnerID: "owner-1"}
+		sessions := newFakeSessionRepo()
+		sessions.sessions = []*domain.Session{
+			{ID: "sess-1", EventID: "event-1", Title: "Generics in practice", Tags: []*domain.Tag{}},
+			{ID: "sess-2", EventID: "event-1", Title: "Profiling", Description: "Finding hot paths.", Tags: []*domain.Tag{{ID: "t", Name: "perf"}}},
+		}
+		eventSvc := newTestEventService(events, sessions, nil, 5*time.Second)
+		tags := eventSvc.tagRepo.(*fakeTagRepo)
+		_, err := tags.EnsureTagForEvent(ctx, "event-1", "Go")
+		require.NoError(t, err)
+		return NewEnrichmentService(eventSvc, events, sessions, tags, enricher, 5*time.Second), sessions, tags
+	}
+
+	t.Run("suggests what the session lacks", func(t *testing.T) {
+		enricher := &fakeSessionEnricher{out: &domain.EnrichmentOutput{
+			Tags:    []string{"go", "Generics", " ", "generics", "a", "b", "c", "d"},
+			Summary: "  How to use generics well.  ",
+		}}
+		svc, _, _ := setup(enricher)
+
+		got, err := svc.SuggestSessionEnrichment(ctx, "event-1", "sess-1", "owner-1")
+		require.NoError(t, err)
+		require.Len(t, enricher.calls, 1)
+		in := enricher.calls[0]
+		assert.Equal(t, "GopherCon", in.EventName)
+		assert.Equal(t, []string{"Go"}, in.EventTags)
+		assert.True(t, in.WantTags)
+		assert.True(t, in.WantSummary)
+
+		assert.Equal(t, []*domain.SuggestedTag{
+			{Name: "Go", TagID: "tag-1"}, {Name: "Generics"}, {Name: "a"}, {Name: "b"}, {Name: "c"},
+		}, got.Tags)
+		assert.Equal(t, "How to use generics well.", got.Summary)
+	})
+
+	t.Run("nothing to suggest for a complete session", func(t *testing.T) {
+		enricher := &fakeSessionEnricher{}
+		svc, _, _ := setup(enricher)
+
+		got, err := svc.SuggestSessionEnrichment(ctx, "event-1", "sess-2", "owner-1")
+		require.NoError(t, err)
+		assert.Empty(t, enricher.calls)
+		assert.Empty(t, got.Tags)
+		assert.Empty(t, got.Summary)
+	})
+
+	t.Run("caps the summary", func(t *testing.T) {
+		svc, _, _ := setup(&fakeSessionEnricher{out: &domain.EnrichmentOutput{Summary: strings.Repeat("é", 600)}})
+
+		got, err := svc.SuggestSessionEnrichment(ctx, "event-1", "sess-1", "owner-1")
+		require.NoError(t, err)
+		assert.Equal(t, domain.MaxSuggestedSummaryLength, len([]rune(got.Summary)))
+	})
+
+	t.Run("unavailable without or with a failing provider", func(t *testing.T) {
+		svc, _, _ := setup(nil)
+		_, err := svc.SuggestSessionEnrichment(ctx, "event-1", "sess-1", "owner-1")
+		require.ErrorIs(t, err, domain.ErrEnrichmentUnavailable)
+
+		svc, _, _ = setup(&fakeSessionEnricher{err: errors.New("rate limited")})
+		_, err = svc.SuggestSessionEnrichment(ctx, "event-1", "sess-1", "owner-1")
+		require.ErrorIs(t, err, domain.ErrEnrichmentUnavailable)
+	})
+
+	t.Run("owner only", func(t *testing.T) {
+		svc, _, _ := setup(&fakeSessionEnricher{})
+		_, err := svc.SuggestSessionEnrichment(ctx, "event-1", "sess-1", "someone-else")
+		require.ErrorIs(t, err, domain.ErrForbidden)
+		_, err = svc.ApplySessionEnrichment(ctx, "event-1", "sess-1", "someone-else", []string{"Go"}, "")
+		require.ErrorIs(t, err, domain.ErrForbidden)
+		_, err = svc.SuggestSessionEnrichment(ctx, "event-1", "missing", "owner-1")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("applies the approved tags and summary", func(t *testing.T) {
+		svc, sessions, tags := setup(nil)
+
+		got, err := svc.ApplySessionEnrichment(ctx, "event-1", "sess-1", "owner-1", []string{"Go", " Generics "}, "How to use generics well.")
+		require.NoError(t, err)
+		assert.Equal(t, "How to use generics well.", got.Description)
+		assert.Equal(t, "How to use generics well.", sessions.sessions[0].Description)
+		assert.ElementsMatch(t, []string{"tag-1", "tag-2"}, tags.sessionTags["sess-1"])
+		assert.Equal(t, "Generics", tags.byID["tag-2"])
+	})
+
+	t.Run("does not overwrite a description", func(t *testing.T) {
+		svc, sessions, tags := setup(nil)
+
+		_, err := svc.ApplySessionEnrichment(ctx, "event-1", "sess-2", "owner-1", []string{"Go"}, "Something else.")
+		require.ErrorIs(t, err, domain.ErrInvalidInput)
+		assert.Equal(t, "Finding hot paths.", sessions.sessions[1].Description)
+		assert.Empty(t, tags.sessionTags["sess-2"])
+	})
+}
@@ -0,0 +1,141 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"multitrackticketing/internal/domain"
+)
+
+// languageCodeRegex matches language codes such as "en", "pt-BR" or "zh-Hant-TW".
+var languageCodeRegex = regexp.MustCompile(`^[A-Za-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+
+type sessionStreamService struct {
+	eventRepo      domain.EventRepository
+	sessionRepo    domain.SessionRepository
+	streamRepo     domain.SessionStreamRepository
+	contextTimeout time.Duration
+}
+
+// NewSessionStreamService returns a domain.SessionStreamService that stores the links in streamRepo.
+func NewSessionStreamService(eventRepo domain.EventRepository, sessionRepo domain.SessionRepository, streamRepo domain.SessionStreamRepository, timeout time.Duration) domain.SessionStreamService {
+	return &sessionStreamService{
+		eventRepo:      eventRepo,
+		sessionRepo:    sessionRepo,
+		streamRepo:     streamRepo,
+		contextTimeout: timeout,
+	}
+}
+
+func (s *sessionStreamService) ListSessionStreams(ctx context.Context, eventID, sessionID, ownerID string) ([]*domain.SessionStreamLink, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := s.ownedSession(ctx, eventID, sessionID, ownerID); err != nil {
+		return nil, err
+	}
+	links, err := s.streamRepo.ListBySessionID(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("list session streams: %w", err)
+	}
+	return links, nil
+}
+
+func (s *sessionStreamService) SetSessionStreams(ctx context.Context, eventID, sessionID, ownerID string, links []*domain.SessionStreamLink) ([]*domain.SessionStreamLink, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	links, err := normalizeStreamLinks(links)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ownedSession(ctx, eventID, sessionID, ownerID); err != nil {
+		return nil, err
+	}
+	if err := s.streamRepo.Replace(ctx, eventID, sessionID, links); err != nil {
+		return nil, fmt.Errorf("replace session streams: %w", err)
+	}
+	return links, nil
+}
+
+// normalizeStreamLinks trims the links and returns an ErrInvalidInput error for the first invalid
+// one. A session has one stream per kind and language.
+func normalizeStreamLinks(links []*domain.SessionStreamLink) ([]*domain.SessionStreamLink, error) {
+	if len(links) > domain.MaxSessionStreamLinks {
+		return nil, fmt.Errorf("a session has at most %d stream links: %w", domain.MaxSessionStreamLinks, domain.ErrInvalidInput)
+	}
+	out := make([]*domain.SessionStreamLink, 0, len(links))
+	seen := make(map[string]bool, len(links))
+	for i, l := range links {
+		invalid := func(format string, args ...any) error {
+			return fmt.Errorf("stream link %d: %s: %w", i+1, fmt.Sprintf(format, args...), domain.ErrInvalidInput)
+		}
+		if l == nil {
+			return nil, invalid("is empty")
+		}
+		n := *l
+		n.Kind = strings.ToLower(strings.TrimSpace(n.Kind))
+		n.Language = strings.TrimSpace(n.Language)
+		n.URL = strings.TrimSpace(n.URL)
+		if n.Kind != domain.StreamKindCaptions && n.Kind != domain.StreamKindTranslation {
+			return nil, invalid("kind must be captions or translation")
+		}
+		if !languageCodeRegex.MatchString(n.Language) {
+			return nil, invalid("language must be a language code such as en or pt-BR")
+		}
+		u, err := url.Parse(n.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, invalid("url must be an http or https URL")
+		}
+		if n.VisibleFrom != nil && n.VisibleUntil != nil && !n.VisibleFrom.Before(*n.VisibleUntil) {
+			return nil, invalid("visible_from must be before visible_until")
+		}
+		key := n.Kind + " " + strings.ToLower(n.Language)
+		if seen[key] {
+			return nil, invalid("the session already has %s in %s", n.Kind, n.Language)
+		}
+		seen[key] = true
+		out = append(out, &n)
+	}
+	return out, nil
+}
+
+// visibleStreamLinks returns the links attendees get at now, or nil when there are none.
+func visibleStreamLinks(links []*domain.SessionStreamLink, now time.Time) []*domain.SessionStreamLink {
+	var visible []*domain.SessionStreamLink
+	for _, l := range links {
+		if l.VisibleAt(now) {
+			visible = append(visible, l)
+		}
+	}
+	return visible
+}
+
+func (s *sessionStreamService) ownedSession(ctx context.Context, eventID, sessionID, ownerID string) error {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return domain.ErrForbidden
+	}
+	sess, err := s.sessionRepo.GetSessionByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("get session: %w", err)
+	}
+	if sess.EventID != eventID {
+		return domain.ErrNotFound
+	}
+	return nil
+}
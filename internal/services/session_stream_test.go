@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"multitrackticketing/internal/domain"
+)
+
+// fakeSessionStreamRepo keeps the links of each session, by event.
+type fakeSessionStreamRepo struct {
+	byEvent map[string]map[string][]*domain.SessionStreamLink
+}
+
+func newFakeSessionStreamRepo() *fakeSessionStreamRepo {
+	return &fakeSessionStreamRepo{byEvent: map[string]map[string][]*domain.SessionStreamLink{}}
+}
+
+func (f *fakeSessionStreamRepo) ListBySessionID(ctx context.Context, sessionID string) ([]*domain.SessionStreamLink, error) {
+	for _, bySession := range f.byEvent {
+		if links, ok := bySession[sessionID]; ok {
+			return links, nil
+		}
+	}
+	return []*domain.SessionStreamLink{}, nil
+}
+
+func (f *fakeSessionStreamRepo) ListByEventID(ctx context.Context, eventID string) (map[string][]*domain.SessionStreamLink, error) {
+	return f.byEvent[eventID], nil
+}
+
+func (f *fakeSessionStreamRepo) Replace(ctx context.Context, eventID, sessionID string, links []*domain.SessionStreamLink) error {
+	if f.byEvent[eventID] == nil {
+		f.byEvent[eventID] = map[string][]*domain.SessionStreamLink{}
+	}
+	f.byEvent[eventID][sessionID] = links
+	return nil
+}
+
+func TestSessionStreamService_SetSessionStreams(t *testing.T) {
+	ctx := context.Background()
+	from := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	until := from.Add(time.Hour)
+
+	newService := func() (domain.SessionStreamService, *fakeSessionStreamRepo) {
+		eventRepo := newFakeEventRepo()
+		eventRepo.byID["ev-1"] = &domain.Event{ID: "ev-1", OwnerID: "owner-1"}
+		eventRepo.byID["ev-2"] = &domain.Event{ID: "ev-2", OwnerID: "owner-1"}
+		sessionRepo := newFakeSessionRepo()
+		sessionRepo.sessions = []*domain.Session{{ID: "sess-1", EventID: "ev-1"}}
+		streamRepo := newFakeSessionStreamRepo()
+		return NewSessionStreamService(eventRepo, sessionRepo, streamRepo, time.Second), streamRepo
+	}
+
+	t.Run("replaces the links", func(t *testing.T) {
+		svc, streamRepo := newService()
+		got, err := svc.SetSessionStreams(ctx, "ev-1", "sess-1", "owner-1", []*domain.SessionStreamLink{
+			{Kind: " Captions ", Language: "en", URL: " https://captions.example/en ", VisibleFrom: &from, VisibleUntil: &until},
+			{Kind: "translation", Language: "pt-BR", URL: "https://live.example/pt"},
+		})
+		require.NoError(t, err)
+		want := []*domain.SessionStreamLink{
+			{Kind: domain.StreamKindCaptions, Language: "en", URL: "https://captions.example/en", VisibleFrom: &from, VisibleUntil: &until},
+			{Kind: domain.StreamKindTranslation, Language: "pt-BR", URL: "https://live.example/pt"},
+		}
+		require.Equal(t, want, got)
+		require.Equal(t, want, streamRepo.byEvent["ev-1"]["sess-1"])
+
+		listed, err := svc.ListSessionStreams(ctx, "ev-1", "sess-1", "owner-1")
+		require.NoError(t, err)
+		require.Equal(t, want, listed)
+	})
+
+	t.Run("invalid links", func(t *testing.T) {
+		tests := []struct {
+			name string
+			link domain.SessionStreamLink
+		}{
+			{name: "unknown kind", link: domain.SessionStreamLink{Kind: "audio", Language: "en", URL: "https://a.example"}},
+			{name: "bad language", link: domain.SessionStreamLink{Kind: "captions", Language: "english!", URL: "https://a.example"}},
+			{name: "not http", link: domain.SessionStreamLink{Kind: "captions", Language: "en", URL: "javascript:alert(1)"}},
+			{name: "window ends before it starts", link: domain.SessionStreamLink{Kind: "captions", Language: "en", URL: "https://a.example", VisibleFrom: &until, VisibleUntil: &from}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				svc, _ := newService()
+				_, err := svc.SetSessionStreams(ctx, "ev-1", "sess-1", "owner-1", []*domain.SessionStreamLink{&tt.link})
+				require.ErrorIs(t, err, domain.ErrInvalidInput)
+			})
+		}
+
+		svc, _ := newService()
+		link := &domain.SessionStreamLink{Kind: "captions", Language: "en", URL: "https://a.example"}
+		_, err := svc.SetSessionStreams(ctx, "ev-1", "sess-1", "owner-1", []*domain.SessionStreamLink{link, {Kind: "captions", Language: "EN", URL: "https://b.example"}})
+		require.ErrorIs(t, err, domain.ErrInvalidInput, "two caption streams in English")
+	})
+
+	t.Run("not the owner", func(t *testing.T) {
+		svc, _ := newService()
+		_, err := svc.SetSessionStreams(ctx, "ev-1", "sess-1", "other", nil)
+		require.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("another event's session", func(t *testing.T) {
+		svc, _ := newService()
+		_, err := svc.ListSessionStreams(ctx, "ev-2", "sess-1", "owner-1")
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func TestSessionStreamLink_VisibleAt(t *testing.T) {
+	from := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	until := from.Add(time.Hour)
+	link := &domain.SessionStreamLink{VisibleFrom: &from, VisibleUntil: &until}
+
+	require.False(t, link.VisibleAt(from.Add(-time.Second)))
+	require.True(t, link.VisibleAt(from))
+	require.False(t, link.VisibleAt(until))
+	require.True(t, (&domain.SessionStreamLink{}).VisibleAt(from), "open window")
+}
@@ -0,0 +1,86 @@
+package services
+
+import (
+	"strings"
+
+	"multitrackticketing/internal/domain"
+)
+
+// checkSpeakerUpdates validates a bulk speaker update against the event's speakers by ID. It
+// returns one result per update, in order, and whether all of them are valid. Text fields are
+// trimmed in place.
+func checkSpeakerUpdates(updates []*domain.SpeakerUpdate, speakers map[string]*domain.Speaker) ([]*domain.SpeakerBulkItemResult, bool) {
+	results := make([]*domain.SpeakerBulkItemResult, len(updates))
+	seen := make(map[string]bool, len(updates))
+	valid := true
+	for i, u := range updates {
+		u.SpeakerID = strings.TrimSpace(u.SpeakerID)
+		for _, p := range []*string{u.FirstName, u.LastName, u.Email, u.TagLine, u.ProfilePicture} {
+			if p != nil {
+				*p = strings.TrimSpace(*p)
+			}
+		}
+		results[i] = &domain.SpeakerBulkItemResult{SpeakerID: u.SpeakerID, Error: speakerUpdateError(u, speakers[u.SpeakerID], seen)}
+		seen[u.SpeakerID] = true
+		if results[i].Error != "" {
+			valid = false
+		}
+	}
+	return results, valid
+}
+
+// speakerUpdateError returns why u cannot be applied to current, or "" when it can. current is nil
+// when the speaker is not in the event.
+func speakerUpdateError(u *domain.SpeakerUpdate, current *domain.Speaker, seen map[string]bool) string {
+	switch {
+	case u.SpeakerID == "":
+		return "speaker_id is required"
+	case current == nil:
+		return "speaker not found"
+	case seen[u.SpeakerID]:
+		return "speaker is updated more than once"
+	case u.Empty():
+		return "no field to update"
+	}
+	firstName, lastName := current.FirstName, current.LastName
+	if u.FirstName != nil {
+		firstName = *u.FirstName
+	}
+	if u.LastName != nil {
+		lastName = *u.LastName
+	}
+	if strings.TrimSpace(firstName) == "" && strings.TrimSpace(lastName) == "" {
+		return "at least one of first_name or last_name must stay set"
+	}
+	if u.Email != nil && *u.Email != "" && (len(*u.Email) > 255 || !strings.Contains(*u.Email, "@")) {
+		return "email must be a valid email address of at most 255 characters"
+	}
+	return ""
+}
+
+// previewSpeakerUpdate returns a copy of sp with u applied, as a dry run reports it.
+func previewSpeakerUpdate(sp *domain.Speaker, u *domain.SpeakerUpdate) *domain.Speaker {
+	out := *sp
+	if u.FirstName != nil {
+		out.FirstName = *u.FirstName
+	}
+	if u.LastName != nil {
+		out.LastName = *u.LastName
+	}
+	if u.Email != nil {
+		out.Email = *u.Email
+	}
+	if u.Bio != nil {
+		out.Bio = *u.Bio
+	}
+	if u.TagLine != nil {
+		out.TagLine = *u.TagLine
+	}
+	if u.ProfilePicture != nil {
+		out.ProfilePicture = *u.ProfilePicture
+	}
+	if u.IsTopSpeaker != nil {
+		out.IsTopSpeaker = *u.IsTopSpeaker
+	}
+	return &out
+}
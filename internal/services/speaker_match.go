@@ -0,0 +1,128 @@
+package services
+
+import (
+	"strings"
+	"unicode"
+
+	"multitrackticketing/internal/domain"
+)
+
+// speakerMatch is how sure an import is that an imported speaker is an existing one.
+type speakerMatch int
+
+const (
+	noMatch speakerMatch = iota
+	// possibleMatch pairs are kept apart and queued for the event owner to review.
+	possibleMatch
+	// sameSpeaker pairs are merged during the import.
+	sameSpeaker
+)
+
+// minSimilarNameLen keeps short names from being flagged as typos of each other.
+const minSimilarNameLen = 6
+
+var accentFolder = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ä", "a", "ã", "a", "å", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "ö", "o", "õ", "o", "ø", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ñ", "n", "ç", "c", "ß", "ss",
+)
+
+// normalizeName lower-cases a name, folds common accents and reduces punctuation and runs of
+// whitespace to single spaces, so "José  Pérez-Gil" and "jose perez gil" compare equal.
+func normalizeName(name string) string {
+	name = accentFolder.Replace(strings.ToLower(name))
+	return strings.Join(strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
+
+// matchSpeakers compares an imported speaker with an existing one and returns how sure the
+// match is and why. When both have an email it decides: equal emails are the same speaker, and
+// different emails only leave equal names for review. Otherwise equal names are the same speaker,
+// while a likely typo or the same last name and first initial are left for review.
+func matchSpeakers(imported, existing *domain.Speaker) (speakerMatch, string) {
+	importedEmail := strings.ToLower(strings.TrimSpace(imported.Email))
+	existingEmail := strings.ToLower(strings.TrimSpace(existing.Email))
+	importedName := normalizeName(imported.FirstName + " " + imported.LastName)
+	existingName := normalizeName(existing.FirstName + " " + existing.LastName)
+
+	if importedEmail != "" && existingEmail != "" {
+		if importedEmail == existingEmail {
+			return sameSpeaker, "same email"
+		}
+		if importedName != "" && importedName == existingName {
+			return possibleMatch, "same name, different email"
+		}
+		return noMatch, ""
+	}
+	if importedName == "" || existingName == "" {
+		return noMatch, ""
+	}
+	if importedName == existingName {
+		return sameSpeaker, "same name"
+	}
+	if len([]rune(importedName)) >= minSimilarNameLen && len([]rune(existingName)) >= minSimilarNameLen &&
+		editDistance(importedName, existingName) <= 2 {
+		return possibleMatch, "similar name"
+	}
+	importedLast, existingLast := normalizeName(imported.LastName), normalizeName(existing.LastName)
+	importedFirst, existingFirst := []rune(normalizeName(imported.FirstName)), []rune(normalizeName(existing.FirstName))
+	if importedLast != "" && importedLast == existingLast && len(importedFirst) > 0 && len(existingFirst) > 0 &&
+		importedFirst[0] == existingFirst[0] {
+		return possibleMatch, "same last name and first initial"
+	}
+	return noMatch, ""
+}
+
+// speakerCandidate is an existing speaker an imported speaker may duplicate.
+type speakerCandidate struct {
+	speaker *domain.Speaker
+	reason  string
+}
+
+// findSpeakerMatch looks for imported among the existing speakers not yet claimed by this import.
+// It returns the existing speaker when exactly one is certainly the same person; otherwise every
+// certain or possible match is returned for review.
+func findSpeakerMatch(imported *domain.Speaker, existing []*domain.Speaker, claimed map[string]bool) (*domain.Speaker, []speakerCandidate) {
+	var same, possible []speakerCandidate
+	for _, sp := range existing {
+		if claimed[sp.ID] {
+			continue
+		}
+		switch match, reason := matchSpeakers(imported, sp); match {
+		case sameSpeaker:
+			same = append(same, speakerCandidate{speaker: sp, reason: reason})
+		case possibleMatch:
+			possible = append(possible, speakerCandidate{speaker: sp, reason: reason})
+		}
+	}
+	if len(same) == 1 {
+		return same[0].speaker, nil
+	}
+	return nil, append(same, possible...)
+}
+
+// editDistance returns the Levenshtein distance between a and b, counted in runes.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
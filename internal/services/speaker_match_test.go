@@ -0,0 +1,61 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"multitrackticketing/internal/domain"
+)
+
+func TestMatchSpeakers(t *testing.T) {
+	speaker := func(first, last, email string) *domain.Speaker {
+		return &domain.Speaker{FirstName: first, LastName: last, Email: email}
+	}
+
+	tests := []struct {
+		name       string
+		imported   *domain.Speaker
+		existing   *domain.Speaker
+		want       speakerMatch
+		wantReason string
+	}{
+		{"same name", speaker("Jane", "Doe", ""), speaker("jane", "doe", ""), sameSpeaker, "same name"},
+		{"accents and punctuation", speaker("José", "Pérez-Gil", ""), speaker("Jose ", "Perez Gil", ""), sameSpeaker, "same name"},
+		{"same email", speaker("J.", "Doe", "Jane@Example.com"), speaker("Jane", "Doe", "jane@example.com"), sameSpeaker, "same email"},
+		{"email on one side only", speaker("Jane", "Doe", ""), speaker("Jane", "Doe", "jane@example.com"), sameSpeaker, "same name"},
+		{"same name, different email", speaker("Jane", "Doe", "a@example.com"), speaker("Jane", "Doe", "b@example.com"), possibleMatch, "same name, different email"},
+		{"different emails", speaker("Jane", "Doe", "a@example.com"), speaker("Janet", "Doe", "b@example.com"), noMatch, ""},
+		{"typo", speaker("Jonathan", "Smith", ""), speaker("Johnathan", "Smith", ""), possibleMatch, "similar name"},
+		{"initial", speaker("J", "Smith", ""), speaker("John", "Smith", ""), possibleMatch, "same last name and first initial"},
+		{"short names are not typos", speaker("Li", "Wu", ""), speaker("Li", "Xu", ""), noMatch, ""},
+		{"different people", speaker("Ada", "Lovelace", ""), speaker("Grace", "Hopper", ""), noMatch, ""},
+		{"empty names", speaker("", "", ""), speaker("", "", ""), noMatch, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, reason := matchSpeakers(tt.imported, tt.existing)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantReason, reason)
+		})
+	}
+}
+
+func TestFindSpeakerMatch(t *testing.T) {
+	jane1 := &domain.Speaker{ID: "sp-1", FirstName: "Jane", LastName: "Doe"}
+	jane2 := &domain.Speaker{ID: "sp-2", FirstName: "Jane", LastName: "Doe"}
+	imported := &domain.Speaker{FirstName: "Jane", LastName: "Doe"}
+
+	same, possible := findSpeakerMatch(imported, []*domain.Speaker{jane1}, map[string]bool{})
+	assert.Equal(t, jane1, same)
+	assert.Empty(t, possible)
+
+	same, possible = findSpeakerMatch(imported, []*domain.Speaker{jane1, jane2}, map[string]bool{})
+	assert.Nil(t, same, "two equally good matches need a review")
+	assert.Len(t, possible, 2)
+
+	same, possible = findSpeakerMatch(imported, []*domain.Speaker{jane1, jane2}, map[string]bool{"sp-1": true})
+	assert.Equal(t, jane2, same, "speakers claimed by this import are skipped")
+	assert.Empty(t, possible)
+}
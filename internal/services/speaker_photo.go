@@ -0,0 +1,290 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"multitrackticketing/internal/domain"
+)
+
+const (
+	// maxConcurrentPhotoDownloads bounds how many speaker photos one export downloads at once.
+	maxConcurrentPhotoDownloads = 8
+	// speakerPhotoArchiveBatch is how many pending archives one BuildPendingArchives call builds.
+	speakerPhotoArchiveBatch = 5
+)
+
+type speakerPhotoService struct {
+	eventRepo      domain.EventRepository
+	sessionRepo    domain.SessionRepository
+	archiveRepo    domain.SpeakerPhotoArchiveRepository
+	pictures       domain.PictureFetcher
+	contextTimeout time.Duration
+}
+
+// NewSpeakerPhotoService returns a domain.SpeakerPhotoService that downloads the photos with
+// pictures.
+func NewSpeakerPhotoService(eventRepo domain.EventRepository, sessionRepo domain.SessionRepository, archiveRepo domain.SpeakerPhotoArchiveRepository, pictures domain.PictureFetcher, timeout time.Duration) domain.SpeakerPhotoService {
+	return &speakerPhotoService{
+		eventRepo:      eventRepo,
+		sessionRepo:    sessionRepo,
+		archiveRepo:    archiveRepo,
+		pictures:       pictures,
+		contextTimeout: timeout,
+	}
+}
+
+func (s *speakerPhotoService) ExportSpeakerPhotos(ctx context.Context, eventID, ownerID string, w io.Writer) (*domain.SpeakerPhotoArchive, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := s.ownedEvent(ctx, eventID, ownerID); err != nil {
+		return nil, err
+	}
+	speakers, err := s.speakersWithPhoto(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if len(speakers) <= domain.MaxStreamedSpeakerPhotos {
+		_, _, err := s.writeSpeakerPhotos(ctx, w, speakers)
+		return nil, err
+	}
+	a := &domain.SpeakerPhotoArchive{EventID: eventID, Status: domain.PhotoArchivePending, Speakers: len(speakers)}
+	if _, err := s.archiveRepo.Create(ctx, a); err != nil {
+		return nil, fmt.Errorf("create speaker photo archive: %w", err)
+	}
+	return a, nil
+}
+
+func (s *speakerPhotoService) GetSpeakerPhotoArchive(ctx context.Context, eventID, archiveID, ownerID string) (*domain.SpeakerPhotoArchive, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := s.ownedEvent(ctx, eventID, ownerID); err != nil {
+		return nil, err
+	}
+	return s.eventArchive(ctx, eventID, archiveID)
+}
+
+func (s *speakerPhotoService) DownloadSpeakerPhotoArchive(ctx context.Context, eventID, archiveID, ownerID string) ([]byte, error) {
+	ctx, cancel := withTimeout(ctx, s.contextTimeout)
+	defer cancel()
+
+	if err := s.ownedEvent(ctx, eventID, ownerID); err != nil {
+		return nil, err
+	}
+	a, err := s.eventArchive(ctx, eventID, archiveID)
+	if err != nil {
+		return nil, err
+	}
+	if a.Status != domain.PhotoArchiveReady {
+		return nil, domain.ErrArchiveNotReady
+	}
+	zip, err := s.archiveRepo.GetZip(ctx, archiveID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get speaker photo archive zip: %w", err)
+	}
+	return zip, nil
+}
+
+func (s *speakerPhotoService) BuildPendingArchives(ctx context.Context) (int, error) {
+	archives, err := s.archiveRepo.ListPending(ctx, speakerPhotoArchiveBatch)
+	if err != nil {
+		return 0, fmt.Errorf("list pending speaker photo archives: %w", err)
+	}
+	built := 0
+	var errs []error
+	for _, a := range archives {
+		if err := s.buildArchive(ctx, a); err != nil {
+			errs = append(errs, fmt.Errorf("speaker photo archive %s: %w", a.ID, err))
+			continue
+		}
+		built++
+	}
+	return built, errors.Join(errs...)
+}
+
+// buildArchive downloads the photos of the archive's event and completes it. An archive none of
+// whose photos could be downloaded fails; errors that may pass, such as the database's or the
+// context's, leave it pending for the next run.
+func (s *speakerPhotoService) buildArchive(ctx context.Context, a *domain.SpeakerPhotoArchive) error {
+	speakers, err := s.speakersWithPhoto(ctx, a.EventID)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	photos, missing, err := s.writeSpeakerPhotos(ctx, &buf, speakers)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	a.Speakers, a.Photos, a.Missing = len(speakers), photos, missing
+	a.Status = domain.PhotoArchiveReady
+	zip := buf.Bytes()
+	if photos == 0 && len(speakers) > 0 {
+		a.Status = domain.PhotoArchiveFailed
+		a.Error = "no speaker photo could be downloaded"
+		zip = nil
+	}
+	if err := s.archiveRepo.Complete(ctx, a, zip); err != nil {
+		return fmt.Errorf("complete: %w", err)
+	}
+	return nil
+}
+
+// speakersWithPhoto returns the event's speakers that have a photo, by name.
+func (s *speakerPhotoService) speakersWithPhoto(ctx context.Context, eventID string) ([]*domain.Speaker, error) {
+	all, err := s.sessionRepo.ListSpeakersByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("list speakers: %w", err)
+	}
+	speakers := make([]*domain.Speaker, 0, len(all))
+	for _, sp := range all {
+		if strings.TrimSpace(sp.ProfilePicture) != "" {
+			speakers = append(speakers, sp)
+		}
+	}
+	slices.SortStableFunc(speakers, func(a, b *domain.Speaker) int {
+		return cmp.Or(cmp.Compare(a.LastName, b.LastName), cmp.Compare(a.FirstName, b.FirstName), cmp.Compare(a.ID, b.ID))
+	})
+	return speakers, nil
+}
+
+// writeSpeakerPhotos writes a zip of the speakers' photos to w, each named after its speaker, and
+// returns how many it holds. Photos are downloaded a few at a time and written in the speakers'
+// order. Those that fail are listed in missing.txt, returned by speaker name and reported as best
+// effort.
+func (s *speakerPhotoService) writeSpeakerPhotos(ctx context.Context, w io.Writer, speakers []*domain.Speaker) (int, []string, error) {
+	type download struct {
+		data   []byte
+		format string
+		err    error
+		done   chan struct{}
+	}
+	downloads := make([]*download, len(speakers))
+	slots := make(chan struct{}, maxConcurrentPhotoDownloads)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for i, sp := range speakers {
+		d := &download{done: make(chan struct{})}
+		downloads[i] = d
+		wg.Go(func() {
+			defer close(d.done)
+			slots <- struct{}{}
+			defer func() { <-slots }()
+			d.data, d.format, d.err = s.pictures.FetchPicture(ctx, sp.ProfilePicture)
+		})
+	}
+
+	zw := zip.NewWriter(w)
+	names := make(map[string]int, len(speakers))
+	photos := 0
+	missing := []string{}
+	var missingList strings.Builder
+	for i, sp := range speakers {
+		d := downloads[i]
+		<-d.done
+		name := speakerDisplayName(sp)
+		if d.err != nil {
+			domain.ReportBestEffort(ctx, fmt.Errorf("photo of speaker %s: %w", sp.ID, d.err))
+			missing = append(missing, name)
+			fmt.Fprintf(&missingList, "%s\t%s\n", name, sp.ProfilePicture)
+			continue
+		}
+		// Photos are compressed already, so they are stored as they are.
+		f, err := zw.CreateHeader(&zip.FileHeader{Name: speakerPhotoFileName(name, sp.ID, d.format, names), Method: zip.Store, Modified: time.Now()})
+		if err != nil {
+			return 0, nil, fmt.Errorf("write speaker photo archive: %w", err)
+		}
+		if _, err := f.Write(d.data); err != nil {
+			return 0, nil, fmt.Errorf("write speaker photo archive: %w", err)
+		}
+		photos++
+	}
+	if len(missing) > 0 {
+		f, err := zw.Create("missing.txt")
+		if err == nil {
+			_, err = io.WriteString(f, missingList.String())
+		}
+		if err != nil {
+			return 0, nil, fmt.Errorf("write speaker photo archive: %w", err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return 0, nil, fmt.Errorf("write speaker photo archive: %w", err)
+	}
+	return photos, missing, nil
+}
+
+// speakerDisplayName is the speaker's first and last name, or their ID when they have no name.
+func speakerDisplayName(sp *domain.Speaker) string {
+	if name := strings.TrimSpace(sp.FirstName + " " + sp.LastName); name != "" {
+		return name
+	}
+	return "speaker " + sp.ID
+}
+
+// speakerPhotoFileName names a photo after its speaker, keeping letters, digits, spaces and dashes
+// so the name is safe on every file system. A name already taken gets " (2)", " (3)" and so on;
+// taken counts the names used so far.
+func speakerPhotoFileName(name, speakerID, format string, taken map[string]int) string {
+	base := strings.Join(strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-'
+	}), " ")
+	if base == "" {
+		base = "speaker-" + speakerID
+	}
+	key := strings.ToLower(base)
+	taken[key]++
+	if n := taken[key]; n > 1 {
+		base = fmt.Sprintf("%s (%d)", base, n)
+	}
+	ext := format
+	if ext == "jpeg" {
+		ext = "jpg"
+	}
+	return base + "." + ext
+}
+
+func (s *speakerPhotoService) eventArchive(ctx context.Context, eventID, archiveID string) (*domain.SpeakerPhotoArchive, error) {
+	a, err := s.archiveRepo.GetByID(ctx, archiveID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get speaker photo archive: %w", err)
+	}
+	if a.EventID != eventID {
+		return nil, domain.ErrNotFound
+	}
+	return a, nil
+}
+
+func (s *speakerPhotoService) ownedEvent(ctx context.Context, eventID, ownerID string) error {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("get event: %w", err)
+	}
+	if event.OwnerID != ownerID {
+		return domain.ErrForbidden
+	}
+	return nil
+}
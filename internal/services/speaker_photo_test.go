@@ -0,0 +1,219 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"multitrackticketing/internal/domain"
+)
+
+// fakePictures returns the picture for each known URL, as a JPEG, and an error for the others.
+type fakePictures struct {
+	pictures map[string][]byte
+}
+
+func (f *fakePictures) FetchPicture(ctx context.Context, url string) ([]byte, string, error) {
+	if data, ok := f.pictures[url]; ok {
+		return data, "jpeg", nil
+	}
+	return nil, "", errors.New("host unreachable")
+}
+
+type fakeSpeakerPhotoArchiveRepo struct {
+	byID map[string]*domain.SpeakerPhotoArchive
+	zips map[string][]byte
+}
+
+func newFakeSpeakerPhotoArchiveRepo() *fakeSpeakerPhotoArchiveRepo {
+	return &fakeSpeakerPhotoArchiveRepo{byID: map[string]*domain.SpeakerPhotoArchive{}, zips: map[string][]byte{}}
+}
+
+func (f *fakeSpeakerPhotoArchiveRepo) Create(ctx context.Context, a *domain.SpeakerPhotoArchive) (bool, error) {
+	for _, existing := range f.byID {
+		if existing.EventID == a.EventID && existing.Status == domain.PhotoArchivePending {
+			*a = *existing
+			return false, nil
+		}
+	}
+	a.ID = fmt.Sprintf("ar-%d", len(f.byID)+1)
+	a.Status = domain.PhotoArchivePending
+	a.Missing = []string{}
+	a.CreatedAt = time.Now()
+	stored := *a
+	f.byID[a.ID] = &stored
+	return true, nil
+}
+
+func (f *fakeSpeakerPhotoArchiveRepo) GetByID(ctx context.Context, archiveID string) (*domain.SpeakerPhotoArchive, error) {
+	a, ok := f.byID[archiveID]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	out := *a
+	return &out, nil
+}
+
+func (f *fakeSpeakerPhotoArchiveRepo) ListPending(ctx context.Context, limit int) ([]*domain.SpeakerPhotoArchive, error) {
+	var out []*domain.SpeakerPhotoArchive
+	for _, a := range f.byID {
+		if a.Status == domain.PhotoArchivePending && len(out) < limit {
+			c := *a
+			out = append(out, &c)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeSpeakerPhotoArchiveRepo) Complete(ctx context.Context, a *domain.SpeakerPhotoArchive, zip []byte) error {
+	if _, ok := f.byID[a.ID]; !ok {
+		return domain.ErrNotFound
+	}
+	now := time.Now()
+	a.CompletedAt = &now
+	stored := *a
+	f.byID[a.ID] = &stored
+	if zip != nil {
+		f.zips[a.ID] = zip
+	}
+	return nil
+}
+
+func (f *fakeSpeakerPhotoArchiveRepo) GetZip(ctx context.Context, archiveID string) ([]byte, error) {
+	zip, ok := f.zips[archiveID]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return zip, nil
+}
+
+// readZip returns the archive's files by name.
+func readZip(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	files := make(map[string]string, len(zr.File))
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		b, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		rc.Close()
+		files[f.Name] = string(b)
+	}
+	return files
+}
+
+func TestSpeakerPhotoService_ExportSpeakerPhotos(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("streams a small event's photos", func(t *testing.T) {
+		eventRepo := newFakeEventRepo()
+		eventRepo.byID["ev-1"] = &domain.Event{ID: "ev-1", OwnerID: "owner-1"}
+		sessionRepo := newFakeSessionRepo()
+		sessionRepo.speakers = []*domain.Speaker{
+			{ID: "sp-1", EventID: "ev-1", FirstName: "Ada", LastName: "Lovelace", ProfilePicture: "https://img/ada"},
+			{ID: "sp-2", EventID: "ev-1", FirstName: "Ada", LastName: "Lovelace", ProfilePicture: "https://img/ada-2"},
+			{ID: "sp-3", EventID: "ev-1", FirstName: "Grace", LastName: "Hopper/Navy", ProfilePicture: "https://img/grace"},
+			{ID: "sp-4", EventID: "ev-1", FirstName: "Alan", LastName: "Turing", ProfilePicture: "https://img/gone"},
+			{ID: "sp-5", EventID: "ev-1", FirstName: "No", LastName: "Photo"},
+		}
+		pictures := &fakePictures{pictures: map[string][]byte{"https://img/ada": []byte("a1"), "https://img/ada-2": []byte("a2"), "https://img/grace": []byte("g")}}
+		svc := NewSpeakerPhotoService(eventRepo, sessionRepo, newFakeSpeakerPhotoArchiveRepo(), pictures, time.Second)
+
+		var buf bytes.Buffer
+		archive, err := svc.ExportSpeakerPhotos(ctx, "ev-1", "owner-1", &buf)
+		require.NoError(t, err)
+		require.Nil(t, archive)
+		require.Equal(t, map[string]string{
+			"Ada Lovelace.jpg":      "a1",
+			"Ada Lovelace (2).jpg":  "a2",
+			"Grace Hopper Navy.jpg": "g",
+			"missing.txt":           "Alan Turing\thttps://img/gone\n",
+		}, readZip(t, buf.Bytes()))
+	})
+
+	t.Run("queues an archive for a large event", func(t *testing.T) {
+		eventRepo := newFakeEventRepo()
+		eventRepo.byID["ev-1"] = &domain.Event{ID: "ev-1", OwnerID: "owner-1"}
+		sessionRepo := newFakeSessionRepo()
+		for i := range domain.MaxStreamedSpeakerPhotos + 1 {
+			sessionRepo.speakers = append(sessionRepo.speakers, &domain.Speaker{ID: fmt.Sprintf("sp-%d", i), EventID: "ev-1", ProfilePicture: "https://img/x"})
+		}
+		svc := NewSpeakerPhotoService(eventRepo, sessionRepo, newFakeSpeakerPhotoArchiveRepo(), &fakePictures{}, time.Second)
+
+		var buf bytes.Buffer
+		archive, err := svc.ExportSpeakerPhotos(ctx, "ev-1", "owner-1", &buf)
+		require.NoError(t, err)
+		require.Equal(t, domain.PhotoArchivePending, archive.Status)
+		require.Equal(t, domain.MaxStreamedSpeakerPhotos+1, archive.Speakers)
+		require.Zero(t, buf.Len())
+
+		again, err := svc.ExportSpeakerPhotos(ctx, "ev-1", "owner-1", &buf)
+		require.NoError(t, err)
+		require.Equal(t, archive.ID, again.ID)
+	})
+
+	t.Run("not the owner", func(t *testing.T) {
+		eventRepo := newFakeEventRepo()
+		eventRepo.byID["ev-1"] = &domain.Event{ID: "ev-1", OwnerID: "owner-1"}
+		svc := NewSpeakerPhotoService(eventRepo, newFakeSessionRepo(), newFakeSpeakerPhotoArchiveRepo(), &fakePictures{}, time.Second)
+
+		_, err := svc.ExportSpeakerPhotos(ctx, "ev-1", "other", io.Discard)
+		require.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestSpeakerPhotoService_BuildPendingArchives(t *testing.T) {
+	ctx := context.Background()
+	eventRepo := newFakeEventRepo()
+	eventRepo.byID["ev-1"] = &domain.Event{ID: "ev-1", OwnerID: "owner-1"}
+	eventRepo.byID["ev-2"] = &domain.Event{ID: "ev-2", OwnerID: "owner-1"}
+	sessionRepo := newFakeSessionRepo()
+	sessionRepo.speakers = []*domain.Speaker{
+		{ID: "sp-1", EventID: "ev-1", FirstName: "Ada", LastName: "Lovelace", ProfilePicture: "https://img/ada"},
+		{ID: "sp-2", EventID: "ev-2", FirstName: "Alan", LastName: "Turing", ProfilePicture: "https://img/gone"},
+	}
+	archiveRepo := newFakeSpeakerPhotoArchiveRepo()
+	pending := &domain.SpeakerPhotoArchive{EventID: "ev-1"}
+	_, _ = archiveRepo.Create(ctx, pending)
+	unreachable := &domain.SpeakerPhotoArchive{EventID: "ev-2"}
+	_, _ = archiveRepo.Create(ctx, unreachable)
+	svc := NewSpeakerPhotoService(eventRepo, sessionRepo, archiveRepo, &fakePictures{pictures: map[string][]byte{"https://img/ada": []byte("a")}}, time.Second)
+
+	_, err := svc.DownloadSpeakerPhotoArchive(ctx, "ev-1", pending.ID, "owner-1")
+	require.ErrorIs(t, err, domain.ErrArchiveNotReady)
+
+	jobCtx, failures := domain.WithBestEffortFailures(ctx)
+	built, err := svc.BuildPendingArchives(jobCtx)
+	require.NoError(t, err)
+	require.Equal(t, 2, built)
+	require.Len(t, failures.Errors(), 1, "the photo that could not be downloaded is reported")
+	require.ErrorContains(t, failures.Errors()[0], "sp-2")
+
+	got, err := svc.GetSpeakerPhotoArchive(ctx, "ev-1", pending.ID, "owner-1")
+	require.NoError(t, err)
+	require.Equal(t, domain.PhotoArchiveReady, got.Status)
+	require.Equal(t, 1, got.Photos)
+	require.NotNil(t, got.CompletedAt)
+	zip, err := svc.DownloadSpeakerPhotoArchive(ctx, "ev-1", pending.ID, "owner-1")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"Ada Lovelace.jpg": "a"}, readZip(t, zip))
+
+	failed, err := svc.GetSpeakerPhotoArchive(ctx, "ev-2", unreachable.ID, "owner-1")
+	require.NoError(t, err)
+	require.Equal(t, domain.PhotoArchiveFailed, failed.Status)
+	require.Equal(t, []string{"Alan Turing"}, failed.Missing)
+	_, err = svc.DownloadSpeakerPhotoArchive(ctx, "ev-2", unreachable.ID, "owner-1")
+	require.ErrorIs(t, err, domain.ErrArchiveNotReady)
+
+	_, err = svc.GetSpeakerPhotoArchive(ctx, "ev-2", pending.ID, "owner-1")
+	require.ErrorIs(t, err, domain.ErrNotFound)
+}
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS event_import_mappings;
+
+ALTER TABLE sessions
+    DROP COLUMN IF EXISTS track,
+    DROP COLUMN IF EXISTS session_type;
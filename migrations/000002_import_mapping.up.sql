@@ -0,0 +1,14 @@
+-- Session type and track, filled from Sessionize categories according to the event's import mapping
+ALTER TABLE sessions
+    ADD COLUMN IF NOT EXISTS session_type VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS track VARCHAR(255) NOT NULL DEFAULT '';
+
+-- Per-event import mapping (one row per event; absent means every category becomes a tag)
+CREATE TABLE IF NOT EXISTS event_import_mappings (
+    event_id UUID PRIMARY KEY REFERENCES events(id) ON DELETE CASCADE,
+    tag_categories TEXT[] NOT NULL DEFAULT '{}',
+    session_type_category VARCHAR(255) NOT NULL DEFAULT '',
+    track_category VARCHAR(255) NOT NULL DEFAULT '',
+    room_name_overrides JSONB NOT NULL DEFAULT '{}',
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
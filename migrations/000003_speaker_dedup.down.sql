@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS speaker_merge_candidates;
+
+ALTER TABLE speakers
+    DROP COLUMN IF EXISTS email;
@@ -0,0 +1,19 @@
+-- Optional speaker email, used together with the name to match speakers across imports
+ALTER TABLE speakers
+    ADD COLUMN IF NOT EXISTS email VARCHAR(255) NOT NULL DEFAULT '';
+
+-- Possible duplicate speakers found during import that need the owner's confirmation
+CREATE TABLE IF NOT EXISTS speaker_merge_candidates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    speaker_id UUID NOT NULL REFERENCES speakers(id) ON DELETE CASCADE,
+    candidate_id UUID NOT NULL REFERENCES speakers(id) ON DELETE CASCADE,
+    reason VARCHAR(255) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'merged', 'rejected')),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMP WITH TIME ZONE,
+    UNIQUE(speaker_id, candidate_id)
+);
+
+CREATE INDEX idx_speaker_merge_candidates_event_id ON speaker_merge_candidates(event_id);
@@ -0,0 +1,12 @@
+-- Sessions without a room cannot be represented in the old schema
+DELETE FROM sessions WHERE room_id IS NULL;
+
+DROP INDEX IF EXISTS idx_sessions_event_id;
+
+ALTER TABLE sessions
+    DROP CONSTRAINT IF EXISTS sessions_event_id_source_session_id_key,
+    ADD CONSTRAINT sessions_room_id_source_session_id_key UNIQUE (room_id, source_session_id);
+
+ALTER TABLE sessions
+    ALTER COLUMN room_id SET NOT NULL,
+    DROP COLUMN IF EXISTS event_id;
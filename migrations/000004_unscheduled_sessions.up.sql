@@ -0,0 +1,19 @@
+-- Sessions belong to their event directly so they can exist without a room (unscheduled)
+ALTER TABLE sessions
+    ADD COLUMN IF NOT EXISTS event_id UUID REFERENCES events(id) ON DELETE CASCADE;
+
+UPDATE sessions s
+SET event_id = r.event_id
+FROM rooms r
+WHERE r.id = s.room_id AND s.event_id IS NULL;
+
+ALTER TABLE sessions
+    ALTER COLUMN event_id SET NOT NULL,
+    ALTER COLUMN room_id DROP NOT NULL;
+
+-- Imported sessions are matched per event, so an unscheduled one is found again on re-import
+ALTER TABLE sessions
+    DROP CONSTRAINT IF EXISTS sessions_room_id_source_session_id_key,
+    ADD CONSTRAINT sessions_event_id_source_session_id_key UNIQUE (event_id, source_session_id);
+
+CREATE INDEX idx_sessions_event_id ON sessions(event_id);
@@ -0,0 +1,11 @@
+-- Per-event schedule rules checked when sessions are created or moved (one row per event;
+-- absent or empty columns mean no limit)
+CREATE TABLE IF NOT EXISTS event_schedule_rules (
+    event_id UUID PRIMARY KEY REFERENCES events(id) ON DELETE CASCADE,
+    allowed_durations INTEGER[] NOT NULL DEFAULT '{}',
+    earliest_start VARCHAR(5) NOT NULL DEFAULT '',
+    latest_end VARCHAR(5) NOT NULL DEFAULT '',
+    allowed_days DATE[] NOT NULL DEFAULT '{}',
+    timezone VARCHAR(64) NOT NULL DEFAULT '',
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
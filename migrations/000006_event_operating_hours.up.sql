@@ -0,0 +1,10 @@
+-- Per-day operating hours of an event: doors open and the last session ends. When an event has
+-- any, sessions must fit inside one of them.
+CREATE TABLE IF NOT EXISTS event_operating_hours (
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    opens_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    closes_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (event_id, day),
+    CHECK (closes_at > opens_at)
+);
@@ -0,0 +1,2 @@
+-- Profile picture shown next to the user in team member and invitation lists.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS avatar_url TEXT;
@@ -0,0 +1,19 @@
+-- Room and time moves of sessions, so apps can show "moved from Room A". Room names are copied
+-- because the rooms may be renamed or deleted later.
+CREATE TABLE IF NOT EXISTS session_changes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    session_id UUID NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+    from_room_id UUID,
+    from_room_name VARCHAR(255) NOT NULL DEFAULT '',
+    to_room_id UUID,
+    to_room_name VARCHAR(255) NOT NULL DEFAULT '',
+    from_start_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    from_end_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    to_start_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    to_end_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    changed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_session_changes_session_id ON session_changes(session_id, changed_at);
+CREATE INDEX idx_session_changes_event_id ON session_changes(event_id, changed_at DESC);
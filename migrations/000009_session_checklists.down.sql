@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS session_checklist_completions;
+DROP TABLE IF EXISTS event_checklist_items;
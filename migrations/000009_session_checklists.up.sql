@@ -0,0 +1,20 @@
+-- Per-event checklist every session works through before it is ready (slides received, AV
+-- checked, ...), and which team member ticked each item for each session.
+CREATE TABLE IF NOT EXISTS event_checklist_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    label VARCHAR(255) NOT NULL,
+    position INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (event_id, label)
+);
+
+CREATE TABLE IF NOT EXISTS session_checklist_completions (
+    session_id UUID NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+    item_id UUID NOT NULL REFERENCES event_checklist_items(id) ON DELETE CASCADE,
+    completed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    completed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (session_id, item_id)
+);
+
+CREATE INDEX idx_session_checklist_completions_item_id ON session_checklist_completions(item_id);
@@ -0,0 +1,7 @@
+-- Read model of each event's schedule grid (days × rooms × time slots), rebuilt by the server
+-- whenever sessions, rooms, schedule rules or operating hours change. A missing row is rebuilt on read.
+CREATE TABLE IF NOT EXISTS event_schedule_grids (
+    event_id UUID PRIMARY KEY REFERENCES events(id) ON DELETE CASCADE,
+    grid JSONB NOT NULL,
+    built_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,24 @@
+DROP TRIGGER IF EXISTS events_sync_tombstones ON events;
+DROP TRIGGER IF EXISTS speakers_sync_tombstone ON speakers;
+DROP TRIGGER IF EXISTS sessions_sync_tombstone ON sessions;
+DROP TRIGGER IF EXISTS rooms_sync_tombstone ON rooms;
+DROP TRIGGER IF EXISTS tags_sync_version ON tags;
+DROP TRIGGER IF EXISTS session_speakers_sync_version ON session_speakers;
+DROP TRIGGER IF EXISTS session_tags_sync_version ON session_tags;
+DROP TRIGGER IF EXISTS speakers_sync_version ON speakers;
+DROP TRIGGER IF EXISTS sessions_sync_version ON sessions;
+DROP TRIGGER IF EXISTS rooms_sync_version ON rooms;
+
+DROP FUNCTION IF EXISTS delete_event_sync_tombstones();
+DROP FUNCTION IF EXISTS record_sync_tombstone();
+DROP FUNCTION IF EXISTS bump_tagged_sessions_sync_version();
+DROP FUNCTION IF EXISTS bump_session_sync_version();
+DROP FUNCTION IF EXISTS set_sync_version();
+
+DROP TABLE IF EXISTS sync_tombstones;
+
+ALTER TABLE speakers DROP COLUMN IF EXISTS sync_created_version, DROP COLUMN IF EXISTS sync_version;
+ALTER TABLE sessions DROP COLUMN IF EXISTS sync_created_version, DROP COLUMN IF EXISTS sync_version;
+ALTER TABLE rooms DROP COLUMN IF EXISTS sync_created_version, DROP COLUMN IF EXISTS sync_version;
+
+DROP SEQUENCE IF EXISTS sync_version_seq;
@@ -0,0 +1,119 @@
+-- Version counters for delta sync: every change to a room, session or speaker takes the next value
+-- of one sequence, so apps can ask for everything changed after the last version they saw.
+CREATE SEQUENCE IF NOT EXISTS sync_version_seq;
+
+ALTER TABLE rooms
+    ADD COLUMN IF NOT EXISTS sync_version BIGINT NOT NULL DEFAULT nextval('sync_version_seq'),
+    ADD COLUMN IF NOT EXISTS sync_created_version BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE sessions
+    ADD COLUMN IF NOT EXISTS sync_version BIGINT NOT NULL DEFAULT nextval('sync_version_seq'),
+    ADD COLUMN IF NOT EXISTS sync_created_version BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE speakers
+    ADD COLUMN IF NOT EXISTS sync_version BIGINT NOT NULL DEFAULT nextval('sync_version_seq'),
+    ADD COLUMN IF NOT EXISTS sync_created_version BIGINT NOT NULL DEFAULT 0;
+
+UPDATE rooms SET sync_created_version = sync_version;
+UPDATE sessions SET sync_created_version = sync_version;
+UPDATE speakers SET sync_created_version = sync_version;
+
+CREATE INDEX idx_rooms_event_sync_version ON rooms(event_id, sync_version);
+CREATE INDEX idx_sessions_event_sync_version ON sessions(event_id, sync_version);
+CREATE INDEX idx_speakers_event_sync_version ON speakers(event_id, sync_version);
+
+-- Deleted rooms, sessions and speakers, so apps learn to drop them. Rows of a deleted event are
+-- removed with it.
+CREATE TABLE IF NOT EXISTS sync_tombstones (
+    event_id UUID NOT NULL,
+    entity_type VARCHAR(20) NOT NULL
+        CHECK (entity_type IN ('room', 'session', 'speaker')),
+    entity_id UUID NOT NULL,
+    version BIGINT NOT NULL DEFAULT nextval('sync_version_seq'),
+    deleted_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (entity_type, entity_id)
+);
+
+CREATE INDEX idx_sync_tombstones_event_version ON sync_tombstones(event_id, version);
+
+-- Inserts take a new version. Updates take one only when a column other than updated_at changed,
+-- so re-importing an unchanged schedule does not make apps download it again; an update that sets
+-- sync_version itself (see bump_session_sync_version) keeps it.
+CREATE OR REPLACE FUNCTION set_sync_version() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        NEW.sync_version := nextval('sync_version_seq');
+        NEW.sync_created_version := NEW.sync_version;
+    ELSE
+        NEW.sync_created_version := OLD.sync_created_version;
+        IF NEW.sync_version = OLD.sync_version
+            AND (to_jsonb(NEW) - 'updated_at' - 'sync_version') <> (to_jsonb(OLD) - 'updated_at' - 'sync_version') THEN
+            NEW.sync_version := nextval('sync_version_seq');
+        END IF;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER rooms_sync_version BEFORE INSERT OR UPDATE ON rooms
+    FOR EACH ROW EXECUTE FUNCTION set_sync_version();
+CREATE TRIGGER sessions_sync_version BEFORE INSERT OR UPDATE ON sessions
+    FOR EACH ROW EXECUTE FUNCTION set_sync_version();
+CREATE TRIGGER speakers_sync_version BEFORE INSERT OR UPDATE ON speakers
+    FOR EACH ROW EXECUTE FUNCTION set_sync_version();
+
+-- A session's tags and speaker IDs are part of it, so linking or unlinking them changes the session.
+CREATE OR REPLACE FUNCTION bump_session_sync_version() RETURNS trigger AS $$
+BEGIN
+    UPDATE sessions SET sync_version = nextval('sync_version_seq')
+    WHERE id = CASE WHEN TG_OP = 'DELETE' THEN OLD.session_id ELSE NEW.session_id END;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER session_tags_sync_version AFTER INSERT OR DELETE ON session_tags
+    FOR EACH ROW EXECUTE FUNCTION bump_session_sync_version();
+CREATE TRIGGER session_speakers_sync_version AFTER INSERT OR DELETE ON session_speakers
+    FOR EACH ROW EXECUTE FUNCTION bump_session_sync_version();
+
+-- Tags are shared between events; renaming one changes every session carrying it.
+CREATE OR REPLACE FUNCTION bump_tagged_sessions_sync_version() RETURNS trigger AS $$
+BEGIN
+    IF NEW.name IS DISTINCT FROM OLD.name THEN
+        UPDATE sessions SET sync_version = nextval('sync_version_seq')
+        WHERE id IN (SELECT session_id FROM session_tags WHERE tag_id = NEW.id);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER tags_sync_version AFTER UPDATE ON tags
+    FOR EACH ROW EXECUTE FUNCTION bump_tagged_sessions_sync_version();
+
+-- Deleting the whole event needs no tombstones: its rows are already gone by the time the cascade
+-- reaches its rooms, sessions and speakers.
+CREATE OR REPLACE FUNCTION record_sync_tombstone() RETURNS trigger AS $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM events WHERE id = OLD.event_id) THEN
+        INSERT INTO sync_tombstones (event_id, entity_type, entity_id)
+        VALUES (OLD.event_id, TG_ARGV[0], OLD.id)
+        ON CONFLICT (entity_type, entity_id) DO NOTHING;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER rooms_sync_tombstone AFTER DELETE ON rooms
+    FOR EACH ROW EXECUTE FUNCTION record_sync_tombstone('room');
+CREATE TRIGGER sessions_sync_tombstone AFTER DELETE ON sessions
+    FOR EACH ROW EXECUTE FUNCTION record_sync_tombstone('session');
+CREATE TRIGGER speakers_sync_tombstone AFTER DELETE ON speakers
+    FOR EACH ROW EXECUTE FUNCTION record_sync_tombstone('speaker');
+
+CREATE OR REPLACE FUNCTION delete_event_sync_tombstones() RETURNS trigger AS $$
+BEGIN
+    DELETE FROM sync_tombstones WHERE event_id = OLD.id;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER events_sync_tombstones AFTER DELETE ON events
+    FOR EACH ROW EXECUTE FUNCTION delete_event_sync_tombstones();
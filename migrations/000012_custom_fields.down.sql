@@ -0,0 +1,10 @@
+DROP TRIGGER IF EXISTS event_custom_fields_sync_version ON event_custom_fields;
+DROP TRIGGER IF EXISTS speaker_custom_field_values_sync_version ON speaker_custom_field_values;
+DROP TRIGGER IF EXISTS session_custom_field_values_sync_version ON session_custom_field_values;
+
+DROP FUNCTION IF EXISTS bump_custom_field_sync_versions();
+DROP FUNCTION IF EXISTS bump_speaker_sync_version();
+
+DROP TABLE IF EXISTS speaker_custom_field_values;
+DROP TABLE IF EXISTS session_custom_field_values;
+DROP TABLE IF EXISTS event_custom_fields;
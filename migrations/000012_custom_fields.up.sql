@@ -0,0 +1,75 @@
+-- Extra fields an event defines for its sessions or speakers (e.g. "streaming consent", "paper DOI").
+-- Public fields are shown to attendees; the others only to the organizers.
+CREATE TABLE IF NOT EXISTS event_custom_fields (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    field_type VARCHAR(20) NOT NULL
+        CHECK (field_type IN ('text', 'number', 'boolean', 'date', 'url')),
+    applies_to VARCHAR(20) NOT NULL
+        CHECK (applies_to IN ('session', 'speaker')),
+    is_public BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (event_id, applies_to, name)
+);
+
+-- Values are stored in the column of the field's type; exactly one is set. Text and URL fields use
+-- value_text.
+CREATE TABLE IF NOT EXISTS session_custom_field_values (
+    session_id UUID NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+    field_id UUID NOT NULL REFERENCES event_custom_fields(id) ON DELETE CASCADE,
+    value_text TEXT,
+    value_number DOUBLE PRECISION,
+    value_boolean BOOLEAN,
+    value_date DATE,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (session_id, field_id),
+    CHECK (num_nonnulls(value_text, value_number, value_boolean, value_date) = 1)
+);
+
+CREATE TABLE IF NOT EXISTS speaker_custom_field_values (
+    speaker_id UUID NOT NULL REFERENCES speakers(id) ON DELETE CASCADE,
+    field_id UUID NOT NULL REFERENCES event_custom_fields(id) ON DELETE CASCADE,
+    value_text TEXT,
+    value_number DOUBLE PRECISION,
+    value_boolean BOOLEAN,
+    value_date DATE,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (speaker_id, field_id),
+    CHECK (num_nonnulls(value_text, value_number, value_boolean, value_date) = 1)
+);
+
+CREATE INDEX idx_session_custom_field_values_field_id ON session_custom_field_values(field_id);
+CREATE INDEX idx_speaker_custom_field_values_field_id ON speaker_custom_field_values(field_id);
+
+-- Custom field values are part of the session or speaker for delta sync (migration 000011).
+CREATE TRIGGER session_custom_field_values_sync_version AFTER INSERT OR UPDATE OR DELETE ON session_custom_field_values
+    FOR EACH ROW EXECUTE FUNCTION bump_session_sync_version();
+
+CREATE OR REPLACE FUNCTION bump_speaker_sync_version() RETURNS trigger AS $$
+BEGIN
+    UPDATE speakers SET sync_version = nextval('sync_version_seq')
+    WHERE id = CASE WHEN TG_OP = 'DELETE' THEN OLD.speaker_id ELSE NEW.speaker_id END;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER speaker_custom_field_values_sync_version AFTER INSERT OR UPDATE OR DELETE ON speaker_custom_field_values
+    FOR EACH ROW EXECUTE FUNCTION bump_speaker_sync_version();
+
+-- Renaming a field or changing who sees it changes every session or speaker with a value for it.
+CREATE OR REPLACE FUNCTION bump_custom_field_sync_versions() RETURNS trigger AS $$
+BEGIN
+    IF NEW.name IS DISTINCT FROM OLD.name OR NEW.is_public IS DISTINCT FROM OLD.is_public THEN
+        UPDATE sessions SET sync_version = nextval('sync_version_seq')
+        WHERE id IN (SELECT session_id FROM session_custom_field_values WHERE field_id = NEW.id);
+        UPDATE speakers SET sync_version = nextval('sync_version_seq')
+        WHERE id IN (SELECT speaker_id FROM speaker_custom_field_values WHERE field_id = NEW.id);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER event_custom_fields_sync_version AFTER UPDATE ON event_custom_fields
+    FOR EACH ROW EXECUTE FUNCTION bump_custom_field_sync_versions();
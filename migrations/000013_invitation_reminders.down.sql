@@ -0,0 +1,3 @@
+ALTER TABLE event_invitations
+    DROP COLUMN IF EXISTS reminders_sent,
+    DROP COLUMN IF EXISTS last_contacted_at;
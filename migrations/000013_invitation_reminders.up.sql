@@ -0,0 +1,11 @@
+-- When each invitee was last emailed (the invitation or a reminder) and how many reminders they got,
+-- so reminder campaigns can leave recently contacted invitees alone.
+ALTER TABLE event_invitations
+    ADD COLUMN IF NOT EXISTS last_contacted_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS reminders_sent INTEGER NOT NULL DEFAULT 0;
+
+UPDATE event_invitations SET last_contacted_at = sent_at WHERE last_contacted_at IS NULL;
+
+ALTER TABLE event_invitations
+    ALTER COLUMN last_contacted_at SET DEFAULT NOW(),
+    ALTER COLUMN last_contacted_at SET NOT NULL;
@@ -0,0 +1,8 @@
+-- Per-event email domain rules checked before invitations are sent (one row per event; empty
+-- allowed_domains means every domain that is not blocked is allowed)
+CREATE TABLE IF NOT EXISTS event_invitation_domain_rules (
+    event_id UUID PRIMARY KEY REFERENCES events(id) ON DELETE CASCADE,
+    allowed_domains TEXT[] NOT NULL DEFAULT '{}',
+    blocked_domains TEXT[] NOT NULL DEFAULT '{}',
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,13 @@
+-- One row per anonymized event: who anonymized it and when (anonymized_by is NULL when the
+-- retention policy did), the aggregate counts kept from before, and how much personal data was removed
+CREATE TABLE IF NOT EXISTS event_anonymizations (
+    event_id UUID PRIMARY KEY REFERENCES events(id) ON DELETE CASCADE,
+    anonymized_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    anonymized_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    registrations INTEGER NOT NULL DEFAULT 0,
+    invitations_sent INTEGER NOT NULL DEFAULT 0,
+    invitations_accepted INTEGER NOT NULL DEFAULT 0,
+    invitations_hashed INTEGER NOT NULL DEFAULT 0,
+    speaker_emails_cleared INTEGER NOT NULL DEFAULT 0,
+    custom_field_values_cleared INTEGER NOT NULL DEFAULT 0
+);
@@ -0,0 +1,14 @@
+-- Per-event white-label theme returned to attendee apps (one row per event; empty fields fall back
+-- to the app's defaults)
+CREATE TABLE IF NOT EXISTS event_themes (
+    event_id UUID PRIMARY KEY REFERENCES events(id) ON DELETE CASCADE,
+    primary_color VARCHAR(9) NOT NULL DEFAULT '',
+    secondary_color VARCHAR(9) NOT NULL DEFAULT '',
+    background_color VARCHAR(9) NOT NULL DEFAULT '',
+    text_color VARCHAR(9) NOT NULL DEFAULT '',
+    font_family VARCHAR(100) NOT NULL DEFAULT '',
+    heading_font_family VARCHAR(100) NOT NULL DEFAULT '',
+    footer_text VARCHAR(500) NOT NULL DEFAULT '',
+    support_email VARCHAR(255) NOT NULL DEFAULT '',
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
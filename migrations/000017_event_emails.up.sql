@@ -0,0 +1,17 @@
+-- Rendered emails sent on behalf of an event (invitations, reminders, team notices), so organizers
+-- can check what a recipient was sent and send it again
+CREATE TABLE IF NOT EXISTS event_emails (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    kind VARCHAR(50) NOT NULL,
+    recipient VARCHAR(255) NOT NULL,
+    subject VARCHAR(500) NOT NULL DEFAULT '',
+    html_body TEXT NOT NULL DEFAULT '',
+    text_body TEXT NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL,
+    error TEXT NOT NULL DEFAULT '',
+    resend_of UUID REFERENCES event_emails(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_event_emails_event_id ON event_emails(event_id, created_at DESC);
@@ -0,0 +1,22 @@
+-- Platform-wide product announcements for the apps' "what's new" panel, managed by admins
+CREATE TABLE IF NOT EXISTS announcements (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    title VARCHAR(200) NOT NULL,
+    body TEXT NOT NULL DEFAULT '',
+    category VARCHAR(20) NOT NULL,
+    -- NULL keeps the announcement a draft; a future time schedules it
+    published_at TIMESTAMP WITH TIME ZONE,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_announcements_published_at ON announcements(published_at DESC);
+
+-- Which announcements each user has read
+CREATE TABLE IF NOT EXISTS announcement_reads (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    announcement_id UUID NOT NULL REFERENCES announcements(id) ON DELETE CASCADE,
+    read_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, announcement_id)
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS contact_messages;
+DROP TABLE IF EXISTS contact_threads;
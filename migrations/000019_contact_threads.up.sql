@@ -0,0 +1,30 @@
+-- Messages attendees send an event's organizers from the public contact form, and the team's
+-- replies. Organizers' addresses are never shown to the sender.
+CREATE TABLE IF NOT EXISTS contact_threads (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    sender_name VARCHAR(255) NOT NULL,
+    sender_email VARCHAR(255) NOT NULL,
+    subject VARCHAR(200) NOT NULL,
+    -- Address the message came from, kept to rate-limit the contact form
+    remote_ip VARCHAR(45) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_contact_threads_event_id ON contact_threads(event_id, updated_at DESC);
+CREATE INDEX idx_contact_threads_remote_ip ON contact_threads(remote_ip, created_at);
+CREATE INDEX idx_contact_threads_sender_email ON contact_threads(LOWER(sender_email), created_at);
+
+CREATE TABLE IF NOT EXISTS contact_messages (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    thread_id UUID NOT NULL REFERENCES contact_threads(id) ON DELETE CASCADE,
+    -- sender or team
+    author VARCHAR(10) NOT NULL,
+    -- Team member who replied; NULL for the sender's messages
+    user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_contact_messages_thread_id ON contact_messages(thread_id, created_at);
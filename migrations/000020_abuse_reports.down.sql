@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS content_unlistings;
+DROP TABLE IF EXISTS abuse_reports;
@@ -0,0 +1,33 @@
+-- Abuse reports anyone can file against an event's public content, and the content hidden from
+-- public pages while they are reviewed.
+CREATE TABLE IF NOT EXISTS abuse_reports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    -- event, session or speaker
+    target_type VARCHAR(10) NOT NULL,
+    target_id UUID NOT NULL,
+    reason VARCHAR(20) NOT NULL,
+    details TEXT NOT NULL DEFAULT '',
+    -- SHA-256 of the reporter's address, so each reporter counts once without keeping the address
+    reporter_hash CHAR(64) NOT NULL,
+    -- open, dismissed or actioned
+    status VARCHAR(10) NOT NULL DEFAULT 'open',
+    resolved_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    resolved_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_abuse_reports_open_reporter ON abuse_reports(target_type, target_id, reporter_hash) WHERE status = 'open';
+CREATE INDEX idx_abuse_reports_status ON abuse_reports(status, created_at);
+
+CREATE TABLE IF NOT EXISTS content_unlistings (
+    target_type VARCHAR(10) NOT NULL,
+    target_id UUID NOT NULL,
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    -- NULL keeps the content unlisted until an admin relists it
+    unlisted_until TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (target_type, target_id)
+);
+
+CREATE INDEX idx_content_unlistings_event_id ON content_unlistings(event_id);
@@ -0,0 +1,8 @@
+-- Address ranges an account's authenticated requests must come from; an account without rows may
+-- be used from anywhere
+CREATE TABLE IF NOT EXISTS user_ip_allowlists (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    ip_range CIDR NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, ip_range)
+);
@@ -0,0 +1,16 @@
+-- Internal services (badge printer, signage) that exchange a client secret for short-lived, scoped
+-- access tokens; each client is bound to one event
+CREATE TABLE IF NOT EXISTS machine_clients (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(100) NOT NULL,
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    scopes TEXT[] NOT NULL,
+    -- SHA-256 of the client secret, hex encoded; the secret itself is only shown once
+    secret_hash VARCHAR(64) NOT NULL,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_machine_clients_event_id ON machine_clients(event_id);
@@ -0,0 +1,13 @@
+-- Requests admins made on a user's behalf with the X-Act-As header, shown to the user in their
+-- activity feed
+CREATE TABLE IF NOT EXISTS user_activity (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    actor_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    method VARCHAR(10) NOT NULL,
+    path TEXT NOT NULL,
+    status INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_user_activity_user_id_created_at ON user_activity(user_id, created_at DESC);
@@ -0,0 +1,11 @@
+-- Event deletions waiting for the owner to confirm them with the token emailed to them; at most one
+-- per event
+CREATE TABLE IF NOT EXISTS event_deletion_requests (
+    event_id UUID PRIMARY KEY REFERENCES events(id) ON DELETE CASCADE,
+    requested_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    -- SHA-256 of the confirmation token, hex encoded
+    token_hash VARCHAR(64) NOT NULL,
+    force BOOLEAN NOT NULL DEFAULT FALSE,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
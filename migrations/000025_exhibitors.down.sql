@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS exhibitor_leads;
+DROP TABLE IF EXISTS lead_consents;
+DROP TABLE IF EXISTS exhibitor_staff;
+DROP TABLE IF EXISTS exhibitors;
@@ -0,0 +1,46 @@
+-- Exhibitors with a booth at an event, and the users who staff it
+CREATE TABLE IF NOT EXISTS exhibitors (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    -- empty while the booth is unassigned
+    booth_number VARCHAR(20) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_exhibitors_event_id ON exhibitors(event_id);
+CREATE UNIQUE INDEX idx_exhibitors_event_booth ON exhibitors(event_id, booth_number) WHERE booth_number <> '';
+
+CREATE TABLE IF NOT EXISTS exhibitor_staff (
+    exhibitor_id UUID NOT NULL REFERENCES exhibitors(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (exhibitor_id, user_id)
+);
+
+CREATE INDEX idx_exhibitor_staff_user_id ON exhibitor_staff(user_id);
+
+-- Attendees who agreed to share their contact details with the exhibitors that scan their code;
+-- deleting the row withdraws the consent and retires the code
+CREATE TABLE IF NOT EXISTS lead_consents (
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code VARCHAR(32) NOT NULL UNIQUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (event_id, user_id)
+);
+
+-- Attendees an exhibitor's staff scanned, one row per exhibitor and attendee
+CREATE TABLE IF NOT EXISTS exhibitor_leads (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    exhibitor_id UUID NOT NULL REFERENCES exhibitors(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    scanned_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    notes TEXT NOT NULL DEFAULT '',
+    scanned_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (exhibitor_id, user_id)
+);
+
+CREATE INDEX idx_exhibitor_leads_event_id ON exhibitor_leads(event_id);
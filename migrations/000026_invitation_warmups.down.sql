@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS invitation_warmup_recipients;
+DROP TABLE IF EXISTS invitation_warmups;
@@ -0,0 +1,31 @@
+-- Large invitation sends spread over days while a new sending domain warms up
+CREATE TABLE IF NOT EXISTS invitation_warmups (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    -- active, paused or completed
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    daily_volumes INTEGER[] NOT NULL,
+    -- when day 1 began; moved later by the time spent paused
+    starts_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    paused_at TIMESTAMP WITH TIME ZONE,
+    completed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_invitation_warmups_event_id ON invitation_warmups(event_id);
+CREATE INDEX idx_invitation_warmups_active ON invitation_warmups(status) WHERE status = 'active';
+
+-- The addresses a warm-up invites, in the order they are sent
+CREATE TABLE IF NOT EXISTS invitation_warmup_recipients (
+    warmup_id UUID NOT NULL REFERENCES invitation_warmups(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    -- queued, sent, failed or skipped
+    status VARCHAR(20) NOT NULL DEFAULT 'queued',
+    processed_at TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (warmup_id, position),
+    UNIQUE (warmup_id, email)
+);
+
+CREATE INDEX idx_invitation_warmup_recipients_queued ON invitation_warmup_recipients(warmup_id, position) WHERE status = 'queued';
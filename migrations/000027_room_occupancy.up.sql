@@ -0,0 +1,11 @@
+-- How many people are in each room, as its door sensors and clickers last reported
+CREATE TABLE IF NOT EXISTS room_occupancy (
+    room_id UUID PRIMARY KEY REFERENCES rooms(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    count INTEGER NOT NULL DEFAULT 0 CHECK (count >= 0),
+    -- when the owner was alerted the room is nearly full; cleared once it empties enough to alert again
+    alerted_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_room_occupancy_event_id ON room_occupancy(event_id);
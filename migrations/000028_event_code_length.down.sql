@@ -0,0 +1,2 @@
+-- Fails while any event has a code longer than 4 characters, rather than cutting it short.
+ALTER TABLE events ALTER COLUMN event_code TYPE CHAR(4);
@@ -0,0 +1,2 @@
+-- Event codes may be longer than 4 characters (EVENT_CODE_LENGTH); existing codes are kept.
+ALTER TABLE events ALTER COLUMN event_code TYPE VARCHAR(16);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS event_document_acceptances;
+DROP TABLE IF EXISTS event_document_versions;
+DROP TABLE IF EXISTS event_documents;
@@ -0,0 +1,32 @@
+-- Documents attendees accept to register for an event, such as its code of conduct
+CREATE TABLE IF NOT EXISTS event_documents (
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    -- code_of_conduct, photo_policy or terms
+    kind VARCHAR(40) NOT NULL,
+    -- set while the document is no longer required; publishing it again clears it
+    withdrawn_at TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (event_id, kind)
+);
+
+-- Every published version of a document; the highest is current
+CREATE TABLE IF NOT EXISTS event_document_versions (
+    event_id UUID NOT NULL,
+    kind VARCHAR(40) NOT NULL,
+    version INTEGER NOT NULL,
+    title VARCHAR(200) NOT NULL,
+    body TEXT NOT NULL,
+    published_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (event_id, kind, version),
+    FOREIGN KEY (event_id, kind) REFERENCES event_documents(event_id, kind) ON DELETE CASCADE
+);
+
+-- Who accepted which version of a document, and when
+CREATE TABLE IF NOT EXISTS event_document_acceptances (
+    event_id UUID NOT NULL,
+    kind VARCHAR(40) NOT NULL,
+    version INTEGER NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    accepted_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (event_id, kind, user_id, version),
+    FOREIGN KEY (event_id, kind, version) REFERENCES event_document_versions(event_id, kind, version) ON DELETE CASCADE
+);
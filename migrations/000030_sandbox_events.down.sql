@@ -0,0 +1,6 @@
+-- Sandbox clients have no event to fall back to
+DELETE FROM machine_clients WHERE event_id IS NULL;
+ALTER TABLE machine_clients ALTER COLUMN event_id SET NOT NULL;
+
+DROP INDEX IF EXISTS idx_events_sandbox_created_at;
+ALTER TABLE events DROP COLUMN IF EXISTS sandbox;
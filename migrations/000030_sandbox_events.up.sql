@@ -0,0 +1,7 @@
+-- Disposable test events created by sandbox machine clients; they send no email and are purged
+-- after RETENTION_SANDBOX_EVENTS_DAYS
+ALTER TABLE events ADD COLUMN IF NOT EXISTS sandbox BOOLEAN NOT NULL DEFAULT FALSE;
+CREATE INDEX IF NOT EXISTS idx_events_sandbox_created_at ON events(created_at) WHERE sandbox;
+
+-- Sandbox clients create their own events instead of being bound to one
+ALTER TABLE machine_clients ALTER COLUMN event_id DROP NOT NULL;
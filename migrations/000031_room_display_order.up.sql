@@ -0,0 +1,7 @@
+-- Rooms (tracks) are shown in display_order, then by name. Existing rooms keep their alphabetical
+-- order; new rooms are appended after the event's last room.
+ALTER TABLE rooms ADD COLUMN IF NOT EXISTS display_order INTEGER NOT NULL DEFAULT 0;
+
+UPDATE rooms r SET display_order = o.n
+FROM (SELECT id, ROW_NUMBER() OVER (PARTITION BY event_id ORDER BY name, id) AS n FROM rooms) o
+WHERE o.id = r.id;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS speaker_photo_archives;
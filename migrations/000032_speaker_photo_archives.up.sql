@@ -0,0 +1,20 @@
+-- Zip archives of an event's speaker photos, built in the background for large events
+CREATE TABLE IF NOT EXISTS speaker_photo_archives (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    -- pending, ready or failed
+    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+    speakers INTEGER NOT NULL DEFAULT 0,
+    photos INTEGER NOT NULL DEFAULT 0,
+    -- names of the speakers whose photo could not be downloaded
+    missing TEXT[] NOT NULL DEFAULT '{}',
+    error TEXT NOT NULL DEFAULT '',
+    -- the zip file, set once the archive is ready
+    archive BYTEA,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMP WITH TIME ZONE
+);
+
+-- An event has at most one pending archive; exporting again while it builds returns it
+CREATE UNIQUE INDEX IF NOT EXISTS idx_speaker_photo_archives_pending ON speaker_photo_archives (event_id) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_speaker_photo_archives_created_at ON speaker_photo_archives (created_at);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS session_stream_links;
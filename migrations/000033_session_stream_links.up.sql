@@ -0,0 +1,19 @@
+-- Caption and translation streams of a session, for hybrid events
+CREATE TABLE IF NOT EXISTS session_stream_links (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    session_id UUID NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    -- captions or translation
+    kind VARCHAR(16) NOT NULL CHECK (kind IN ('captions', 'translation')),
+    -- language code of the stream, e.g. en or pt-BR
+    language VARCHAR(35) NOT NULL,
+    url TEXT NOT NULL,
+    -- attendees get the link between these times; NULL leaves that side open
+    visible_from TIMESTAMP WITH TIME ZONE,
+    visible_until TIMESTAMP WITH TIME ZONE,
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_session_stream_links_session_id ON session_stream_links (session_id, position);
+CREATE INDEX IF NOT EXISTS idx_session_stream_links_event_id ON session_stream_links (event_id);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS machine_client_ip_allowlists;
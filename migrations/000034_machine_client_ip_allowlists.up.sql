@@ -0,0 +1,8 @@
+-- Address ranges a machine client's requests must come from; a client without rows may be used
+-- from anywhere
+CREATE TABLE IF NOT EXISTS machine_client_ip_allowlists (
+    client_id UUID NOT NULL REFERENCES machine_clients(id) ON DELETE CASCADE,
+    ip_range CIDR NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (client_id, ip_range)
+);
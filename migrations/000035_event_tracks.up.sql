@@ -0,0 +1,8 @@
+-- The order an event's tracks (sessions.track) are shown in. Tracks without a row come after the
+-- listed ones, by name.
+CREATE TABLE IF NOT EXISTS event_tracks (
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    display_order INTEGER NOT NULL,
+    PRIMARY KEY (event_id, name)
+);
@@ -0,0 +1,29 @@
+CREATE OR REPLACE FUNCTION set_sync_version() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        NEW.sync_version := nextval('sync_version_seq');
+        NEW.sync_created_version := NEW.sync_version;
+    ELSE
+        NEW.sync_created_version := OLD.sync_created_version;
+        IF NEW.sync_version = OLD.sync_version
+            AND (to_jsonb(NEW) - 'updated_at' - 'sync_version') <> (to_jsonb(OLD) - 'updated_at' - 'sync_version') THEN
+            NEW.sync_version := nextval('sync_version_seq');
+        END IF;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP INDEX IF EXISTS idx_sync_tombstones_event_xid;
+DROP INDEX IF EXISTS idx_speakers_event_sync_xid;
+DROP INDEX IF EXISTS idx_sessions_event_sync_xid;
+DROP INDEX IF EXISTS idx_rooms_event_sync_xid;
+CREATE INDEX IF NOT EXISTS idx_rooms_event_sync_version ON rooms(event_id, sync_version);
+CREATE INDEX IF NOT EXISTS idx_sessions_event_sync_version ON sessions(event_id, sync_version);
+CREATE INDEX IF NOT EXISTS idx_speakers_event_sync_version ON speakers(event_id, sync_version);
+CREATE INDEX IF NOT EXISTS idx_sync_tombstones_event_version ON sync_tombstones(event_id, version);
+
+ALTER TABLE sync_tombstones DROP COLUMN IF EXISTS xid;
+ALTER TABLE speakers DROP COLUMN IF EXISTS sync_created_xid, DROP COLUMN IF EXISTS sync_xid;
+ALTER TABLE sessions DROP COLUMN IF EXISTS sync_created_xid, DROP COLUMN IF EXISTS sync_xid;
+ALTER TABLE rooms DROP COLUMN IF EXISTS sync_created_xid, DROP COLUMN IF EXISTS sync_xid;
@@ -0,0 +1,51 @@
+-- Delta sync reads changes in the order their transactions started writing, not by version alone:
+-- versions are taken when a row is written, so a long transaction (such as an import) can commit a
+-- version below one a client has already synced past. Each change records the transaction that
+-- wrote it, and only changes of transactions older than every one still running are listed, so no
+-- later commit can land behind a cursor. Rows written before this migration keep transaction 0.
+ALTER TABLE rooms
+    ADD COLUMN IF NOT EXISTS sync_xid XID8 NOT NULL DEFAULT '0',
+    ADD COLUMN IF NOT EXISTS sync_created_xid XID8 NOT NULL DEFAULT '0';
+ALTER TABLE sessions
+    ADD COLUMN IF NOT EXISTS sync_xid XID8 NOT NULL DEFAULT '0',
+    ADD COLUMN IF NOT EXISTS sync_created_xid XID8 NOT NULL DEFAULT '0';
+ALTER TABLE speakers
+    ADD COLUMN IF NOT EXISTS sync_xid XID8 NOT NULL DEFAULT '0',
+    ADD COLUMN IF NOT EXISTS sync_created_xid XID8 NOT NULL DEFAULT '0';
+ALTER TABLE sync_tombstones
+    ADD COLUMN IF NOT EXISTS xid XID8 NOT NULL DEFAULT '0';
+ALTER TABLE sync_tombstones ALTER COLUMN xid SET DEFAULT pg_current_xact_id();
+
+DROP INDEX IF EXISTS idx_rooms_event_sync_version;
+DROP INDEX IF EXISTS idx_sessions_event_sync_version;
+DROP INDEX IF EXISTS idx_speakers_event_sync_version;
+DROP INDEX IF EXISTS idx_sync_tombstones_event_version;
+CREATE INDEX idx_rooms_event_sync_xid ON rooms(event_id, sync_xid, sync_version);
+CREATE INDEX idx_sessions_event_sync_xid ON sessions(event_id, sync_xid, sync_version);
+CREATE INDEX idx_speakers_event_sync_xid ON speakers(event_id, sync_xid, sync_version);
+CREATE INDEX idx_sync_tombstones_event_xid ON sync_tombstones(event_id, xid, version);
+
+-- As in migration 000011, plus: whenever the version changes, the writing transaction is recorded
+-- with it.
+CREATE OR REPLACE FUNCTION set_sync_version() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        NEW.sync_version := nextval('sync_version_seq');
+        NEW.sync_created_version := NEW.sync_version;
+        NEW.sync_xid := pg_current_xact_id();
+        NEW.sync_created_xid := NEW.sync_xid;
+    ELSE
+        NEW.sync_created_version := OLD.sync_created_version;
+        NEW.sync_created_xid := OLD.sync_created_xid;
+        NEW.sync_xid := OLD.sync_xid;
+        IF NEW.sync_version = OLD.sync_version
+            AND (to_jsonb(NEW) - 'updated_at' - 'sync_version') <> (to_jsonb(OLD) - 'updated_at' - 'sync_version') THEN
+            NEW.sync_version := nextval('sync_version_seq');
+        END IF;
+        IF NEW.sync_version <> OLD.sync_version THEN
+            NEW.sync_xid := pg_current_xact_id();
+        END IF;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;